@@ -37,6 +37,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/encryption"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -143,6 +144,12 @@ type Config struct {
 	// MetaCache the config for objectio metacache
 	MetaCache objectio.CacheConfig `toml:"metacache"`
 
+	// ColumnEncoding the config for the lightweight column encodings of objects
+	ColumnEncoding objectio.EncodingConfig `toml:"column-encoding"`
+
+	// Encryption the config for the encryption at rest of objects and WAL entries
+	Encryption encryption.Config `toml:"encryption"`
+
 	// IsStandalone denotes the matrixone is running in standalone mode
 	// For the tn does not boost an independent queryservice.
 	// cn,tn shares the same queryservice in standalone mode.
@@ -294,6 +301,7 @@ func (c *Config) setDefaultValue() error {
 
 	// meta cache
 	c.initMetaCache()
+	c.initColumnEncoding()
 
 	return nil
 }
@@ -304,6 +312,10 @@ func (c *Config) initMetaCache() {
 	}
 }
 
+func (c *Config) initColumnEncoding() {
+	objectio.SetColumnEncoding(c.ColumnEncoding.Enable)
+}
+
 func (c *Config) defaultFileServiceDataDir(name string) string {
 	return filepath.Join(c.DataDir, strings.ToLower(name))
 }
@@ -42,6 +42,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/system"
 	"github.com/matrixorigin/matrixone/pkg/datasync"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/encryption"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/gossip"
@@ -217,6 +218,11 @@ func startService(
 
 	setupStatusServer(runtime.ServiceRuntime(cfg.mustGetServiceUUID()))
 
+	// encryption at rest, the key provider is shared by the services of the process
+	if err := encryption.InitOnce(cfg.Encryption); err != nil {
+		return err
+	}
+
 	goroutine.StartLeakCheck(stopper, cfg.Goroutine)
 
 	var gossipNode *gossip.Node
@@ -294,6 +300,7 @@ func startCNService(
 	return stopper.RunNamedTask("cn-service", func(ctx context.Context) {
 		defer serviceWG.Done()
 		cfg.initMetaCache()
+		cfg.initColumnEncoding()
 		c := cfg.getCNServiceConfig()
 		commonConfigKVMap, _ := dumpCommonConfig(*cfg)
 		s, err := cnservice.NewService(
@@ -339,6 +346,7 @@ func startTNService(
 	return stopper.RunNamedTask("tn-service", func(ctx context.Context) {
 		defer serviceWG.Done()
 		cfg.initMetaCache()
+		cfg.initColumnEncoding()
 		c := cfg.getTNServiceConfig()
 		//notify the tn service it is in the standalone cluster
 		c.InStandalone = cfg.IsStandalone
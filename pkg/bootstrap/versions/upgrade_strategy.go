@@ -71,6 +71,9 @@ const (
 	T_interval      = "INTERVAL"
 	T_array_float32 = "VECF32"
 	T_array_float64 = "VECF64"
+	T_array_float16 = "VECF16"
+	T_array_int8    = "VECI8"
+	T_array_bit     = "VECBIT"
 	T_enum          = "ENUM"
 )
 
@@ -18,12 +18,14 @@ import (
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/bootstrap/versions"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/matrixorigin/matrixone/pkg/util/sysview"
 )
 
 var tenantUpgEntries = []versions.UpgradeEntry{
 	upg_information_schema_statistics,
+	upg_mo_user_add_scram_verifier,
 }
 
 // Re-apply the STATISTICS view change in a newer tenant upgrade version so
@@ -46,3 +48,23 @@ var upg_information_schema_statistics = versions.UpgradeEntry{
 	},
 	PreSql: fmt.Sprintf("DROP VIEW IF EXISTS %s.%s;", sysview.InformationDBConst, "STATISTICS"),
 }
+
+// The SCRAM verifier of the password for the PostgreSQL protocol.
+// It is set when the password of the user is set again.
+var upg_mo_user_add_scram_verifier = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_USER,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    "alter table mo_catalog.mo_user add column scram_verifier varchar(256) default ''",
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_USER, "scram_verifier")
+		if err != nil {
+			return false, err
+		}
+
+		if colInfo.IsExits {
+			return true, nil
+		}
+		return false, nil
+	},
+}
@@ -16,13 +16,17 @@ package catalog
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
@@ -212,6 +216,73 @@ func GenColumnsFromDefs(accountId uint32, tableName, databaseName string,
 	return cols, nil
 }
 
+// GenColumnCompressFromDefs collects the columns whose codec is not the
+// default lz4. It must be called after GenColumnsFromDefs, which assigns
+// the seqnums of the attributes.
+//
+// NOTE: compress.None is the zero value of Attribute.Alg and is set by many
+// internal defs that never chose a codec, so it is treated as lz4 as well.
+func GenColumnCompressFromDefs(defs []engine.TableDef) []api.ColumnCompress {
+	var codecs []api.ColumnCompress
+	for _, def := range defs {
+		attrDef, ok := def.(*engine.AttributeDef)
+		if !ok || attrDef.Attr.Name == Row_ID {
+			continue
+		}
+		if attrDef.Attr.Alg != compress.Zstd {
+			continue
+		}
+		codecs = append(codecs, api.ColumnCompress{
+			Seqnum: uint32(attrDef.Attr.Seqnum),
+			Alg:    uint32(attrDef.Attr.Alg),
+			Level:  attrDef.Attr.AlgLevel,
+		})
+	}
+	return codecs
+}
+
+// GenColumnCompressFromPlanCols is GenColumnCompressFromDefs for the columns
+// of a plan.TableDef, whose seqnums are already assigned.
+func GenColumnCompressFromPlanCols(cols []*plan.ColDef) []api.ColumnCompress {
+	var codecs []api.ColumnCompress
+	for _, col := range cols {
+		if col.Name == Row_ID || col.Alg != plan.CompressType_Zstd {
+			continue
+		}
+		codecs = append(codecs, api.ColumnCompress{
+			Seqnum: col.Seqnum,
+			Alg:    uint32(compress.Zstd),
+			Level:  col.AlgLevel,
+		})
+	}
+	return codecs
+}
+
+// ApplyColumnCompressToPlanCols sets the codecs recorded in the schema extra
+// on the columns of a plan.TableDef. The codecs are not stored in mo_columns,
+// so the columns loaded from the catalog always carry the default lz4.
+func ApplyColumnCompressToPlanCols(cols []*plan.ColDef, codecs []api.ColumnCompress) {
+	if len(codecs) == 0 {
+		return
+	}
+	bySeqnum := make(map[uint32]api.ColumnCompress, len(codecs))
+	for _, c := range codecs {
+		bySeqnum[c.Seqnum] = c
+	}
+	for _, col := range cols {
+		if c, ok := bySeqnum[col.Seqnum]; ok && c.Alg == uint32(compress.Zstd) && col.Name != Row_ID {
+			col.Alg, col.AlgLevel = plan.CompressType_Zstd, c.Level
+		}
+	}
+}
+
+// EqualColumnCompress reports whether two lists of column codecs are the same.
+func EqualColumnCompress(a, b []api.ColumnCompress) bool {
+	return slices.EqualFunc(a, b, func(x, y api.ColumnCompress) bool {
+		return x.Seqnum == y.Seqnum && x.Alg == y.Alg && x.Level == y.Level
+	})
+}
+
 func GenCreateDatabaseTuple(sql string, accountId, userId, roleId uint32,
 	name string, databaseId uint64, typ string,
 	m *mpool.MPool, packer *types.Packer,
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/stretchr/testify/require"
 )
 
@@ -167,3 +168,31 @@ func TestGenRowsGeometry(t *testing.T) {
 	require.Equal(t, []byte("POINT(1 1)"), rows[0][0])
 	require.Equal(t, []byte("POINT(2 2)"), rows[1][0])
 }
+
+func TestColumnCompressPlanCols(t *testing.T) {
+	cols := []*plan.ColDef{
+		{Name: "a", Seqnum: 0, Alg: plan.CompressType_Lz4},
+		{Name: "b", Seqnum: 1, Alg: plan.CompressType_Zstd, AlgLevel: 7},
+		{Name: Row_ID, Seqnum: 2, Alg: plan.CompressType_Zstd},
+	}
+	codecs := GenColumnCompressFromPlanCols(cols)
+	require.Equal(t, 1, len(codecs))
+	require.Equal(t, uint32(1), codecs[0].Seqnum)
+	require.Equal(t, int32(7), codecs[0].Level)
+
+	// the columns loaded from mo_columns carry no codec
+	loaded := []*plan.ColDef{
+		{Name: "a", Seqnum: 0},
+		{Name: "b", Seqnum: 1},
+		{Name: Row_ID, Seqnum: 2},
+	}
+	ApplyColumnCompressToPlanCols(loaded, codecs)
+	require.Equal(t, plan.CompressType_None, loaded[0].Alg)
+	require.Equal(t, plan.CompressType_Zstd, loaded[1].Alg)
+	require.Equal(t, int32(7), loaded[1].AlgLevel)
+	require.True(t, EqualColumnCompress(codecs, GenColumnCompressFromPlanCols(loaded)))
+
+	loaded[1].AlgLevel = 3
+	require.False(t, EqualColumnCompress(codecs, GenColumnCompressFromPlanCols(loaded)))
+	require.False(t, EqualColumnCompress(codecs, nil))
+}
@@ -170,6 +170,8 @@ const (
 	MO_CDC_TASK      = "mo_cdc_task"
 	MO_CDC_WATERMARK = "mo_cdc_watermark"
 
+	MO_STREAM_OFFSETS = "mo_stream_offsets"
+
 	MO_DATA_KEY = "mo_data_key"
 
 	MO_TABLE_STATS = "mo_table_stats_alpha"
@@ -52,6 +52,9 @@ type DataProcessor struct {
 	delTsColIdx           int
 	delCompositedPkColIdx int
 
+	// Transformer applied on the insert batches, nil if the table has no transform rule
+	transformer *TableTransformer
+
 	// Current accumulated atomic batches
 	insertAtmBatch *AtomicBatch
 	deleteAtmBatch *AtomicBatch
@@ -152,6 +155,10 @@ func (dp *DataProcessor) ProcessChange(ctx context.Context, data *ChangeData) er
 
 // processSnapshot processes snapshot data
 func (dp *DataProcessor) processSnapshot(ctx context.Context, data *ChangeData) error {
+	if err := dp.transform(data); err != nil {
+		return err
+	}
+
 	rows := 0
 	if data.InsertBatch != nil {
 		rows = data.InsertBatch.RowCount()
@@ -226,6 +233,10 @@ func (dp *DataProcessor) processSnapshot(ctx context.Context, data *ChangeData)
 
 // processTailWip processes tail work-in-progress data (accumulate)
 func (dp *DataProcessor) processTailWip(ctx context.Context, data *ChangeData) error {
+	if err := dp.transform(data); err != nil {
+		return err
+	}
+
 	insertRows := 0
 	deleteRows := 0
 	if data.InsertBatch != nil {
@@ -278,6 +289,10 @@ func (dp *DataProcessor) processTailWip(ctx context.Context, data *ChangeData) e
 
 // processTailDone processes tail done data (accumulate and send)
 func (dp *DataProcessor) processTailDone(ctx context.Context, data *ChangeData) error {
+	if err := dp.transform(data); err != nil {
+		return err
+	}
+
 	// Get packer from pool
 	var packer *types.Packer
 	put := dp.packerPool.Get(&packer)
@@ -349,6 +364,32 @@ func (dp *DataProcessor) processTailDone(ctx context.Context, data *ChangeData)
 	return nil
 }
 
+// transform applies the transform rule of the table on the insert batch. The delete
+// batch only has the primary keys, which are never dropped or masked, so it is kept:
+// deleting a filtered out row is a no-op on the sink, and an update moving a row out
+// of the filter deletes it from the sink.
+func (dp *DataProcessor) transform(data *ChangeData) error {
+	if dp.transformer == nil || data.InsertBatch == nil {
+		return nil
+	}
+	if err := dp.transformer.Apply(data.InsertBatch); err != nil {
+		logutil.Error(
+			"cdc.data_processor.transform_failed",
+			zap.String("task-id", dp.taskId),
+			zap.String("db", dp.dbName),
+			zap.String("table", dp.tableName),
+			zap.Error(err),
+		)
+		return err
+	}
+	if data.InsertBatch.RowCount() == 0 {
+		// all the rows are filtered out
+		data.InsertBatch.Clean(dp.mp)
+		data.InsertBatch = nil
+	}
+	return nil
+}
+
 // processNoMoreData processes end of data (send heartbeat and commit)
 func (dp *DataProcessor) processNoMoreData(ctx context.Context) error {
 	// Send heartbeat (no more data marker)
@@ -423,11 +464,7 @@ func (dp *DataProcessor) processNoMoreData(ctx context.Context) error {
 			zap.String("to-ts", dp.toTs.ToString()),
 		)
 
-		if err := dp.txnManager.watermarkUpdater.UpdateWatermarkOnly(
-			ctx,
-			dp.txnManager.watermarkKey,
-			&dp.toTs,
-		); err != nil {
+		if err := dp.txnManager.updateWatermark(ctx, dp.toTs); err != nil {
 			logutil.Error(
 				"cdc.data_processor.no_more_data_update_watermark_failed",
 				zap.String("task-id", dp.taskId),
@@ -149,6 +149,22 @@ func (tm *TransactionManager) BeginTransaction(ctx context.Context, fromTs, toTs
 	return nil
 }
 
+// updateWatermark updates the watermark to toTs after the changes up to it are
+// sent. The watermark of a BufferedSinker is held back at its DurableTs, and is
+// not updated unless it advances.
+func (tm *TransactionManager) updateWatermark(ctx context.Context, toTs types.TS) error {
+	if bs, ok := tm.sinker.(BufferedSinker); ok {
+		if durable := bs.DurableTs(); durable.LT(&toTs) {
+			toTs = durable
+		}
+		watermark, err := tm.watermarkUpdater.GetFromCache(ctx, tm.watermarkKey)
+		if err == nil && !toTs.GT(&watermark) {
+			return nil
+		}
+	}
+	return tm.watermarkUpdater.UpdateWatermarkOnly(ctx, tm.watermarkKey, &toTs)
+}
+
 // CommitTransaction commits the current transaction
 // Key steps (ORDER MATTERS):
 // 1. Send COMMIT to sinker
@@ -211,11 +227,7 @@ func (tm *TransactionManager) CommitTransaction(ctx context.Context) error {
 
 	// Step 2: Update watermark (persistent proof of success)
 	// This MUST happen BEFORE marking tracker as committed
-	if err := tm.watermarkUpdater.UpdateWatermarkOnly(
-		ctx,
-		tm.watermarkKey,
-		&toTs,
-	); err != nil {
+	if err := tm.updateWatermark(ctx, toTs); err != nil {
 		logutil.Error(
 			"cdc.txn_manager.update_watermark_failed",
 			zap.String("task-id", tm.taskId),
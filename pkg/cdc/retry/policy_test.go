@@ -16,7 +16,9 @@ package retry
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
+	"fmt"
 	"io"
 	"net"
 	"sync/atomic"
@@ -24,6 +26,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 )
 
@@ -150,6 +153,38 @@ func TestDefaultClassifier(t *testing.T) {
 	}
 }
 
+func TestPostgresErrorClassifier(t *testing.T) {
+	classifier := PostgresErrorClassifier{}
+
+	retryableErrors := []error{
+		driver.ErrBadConn,
+		&pgconn.PgError{Code: "40001"},
+		&pgconn.PgError{Code: "40P01"},
+		&pgconn.PgError{Code: "57P01"},
+		&pgconn.PgError{Code: "08006"},
+		fmt.Errorf("exec: %w", &pgconn.PgError{Code: "53300"}),
+	}
+	for _, err := range retryableErrors {
+		if !classifier.IsRetryable(err) {
+			t.Fatalf("expected retryable for %v", err)
+		}
+	}
+
+	nonRetryableErrors := []error{
+		nil,
+		// unique_violation
+		&pgconn.PgError{Code: "23505"},
+		// undefined_table
+		&pgconn.PgError{Code: "42P01"},
+		moerr.NewInternalErrorNoCtx("permanent"),
+	}
+	for _, err := range nonRetryableErrors {
+		if classifier.IsRetryable(err) {
+			t.Fatalf("expected non-retryable for %v", err)
+		}
+	}
+}
+
 func TestPolicy_Do_CircuitOpenStops(t *testing.T) {
 	p := Policy{
 		MaxAttempts: 5,
@@ -0,0 +1,68 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// PostgresErrorClassifier recognises transient PostgreSQL errors that are worth retrying.
+type PostgresErrorClassifier struct{}
+
+var postgresRetryableSQLStates = map[string]struct{}{
+	// serialization_failure
+	"40001": {},
+	// deadlock_detected
+	"40P01": {},
+	// lock_not_available
+	"55P03": {},
+	// admin_shutdown
+	"57P01": {},
+	// crash_shutdown
+	"57P02": {},
+	// cannot_connect_now, the server is starting up or in recovery
+	"57P03": {},
+	// too_many_connections
+	"53300": {},
+}
+
+// postgresConnectionExceptionClass is the SQLSTATE class of the connection exceptions
+const postgresConnectionExceptionClass = "08"
+
+// IsRetryable implements ErrorClassifier.
+func (PostgresErrorClassifier) IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if _, ok := postgresRetryableSQLStates[pgErr.Code]; ok {
+			return true
+		}
+		return strings.HasPrefix(pgErr.Code, postgresConnectionExceptionClass)
+	}
+
+	// the statement never reached the server
+	return pgconn.SafeToRetry(err)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"go.uber.org/zap"
@@ -41,6 +42,43 @@ var NewSinker = func(
 		return NewConsoleSinker(dbTblInfo, watermarkUpdater), nil
 	}
 
+	if sinkUri.SinkTyp == CDCSinkType_Kafka {
+		return CreateKafkaSinker(
+			sinkUri,
+			accountId,
+			taskId,
+			dbTblInfo,
+			watermarkUpdater,
+			tableDef,
+		)
+	}
+
+	if sinkUri.SinkTyp == CDCSinkType_Stage {
+		return CreateStageSinker(
+			sinkUri,
+			accountId,
+			taskId,
+			dbTblInfo,
+			watermarkUpdater,
+			tableDef,
+		)
+	}
+
+	if sinkUri.SinkTyp == CDCSinkType_Postgres {
+		return CreatePostgresSinker(
+			sinkUri,
+			accountId,
+			taskId,
+			dbTblInfo,
+			watermarkUpdater,
+			tableDef,
+			retryTimes,
+			retryDuration,
+			ar,
+			sendSqlTimeout,
+		)
+	}
+
 	// Use the new v2 architecture
 	return CreateMysqlSinker2(
 		sinkUri,
@@ -57,6 +95,42 @@ var NewSinker = func(
 	)
 }
 
+// ApplySinkDDL applies the ALTER TABLE statements of an inplace schema change of the
+// source table to the sink table. The kafka and stage sinks have nothing to alter, the
+// new sinker writes the changes with the new schema. The statements are translated for
+// the postgres sink.
+var ApplySinkDDL = func(
+	ctx context.Context,
+	sinkUri UriInfo,
+	stmts []string,
+	sendSqlTimeout string,
+) error {
+	switch sinkUri.SinkTyp {
+	case CDCSinkType_Console, CDCSinkType_Kafka, CDCSinkType_Stage:
+		return nil
+	case CDCSinkType_Postgres:
+		return applyPostgresSinkDDL(ctx, sinkUri, stmts, sendSqlTimeout)
+	}
+
+	db, err := OpenDbConn(sinkUri.User, sinkUri.Password, sinkUri.Ip, sinkUri.Port, sendSqlTimeout)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	for _, stmt := range stmts {
+		if _, err = db.ExecContext(ctx, stmt); err != nil {
+			return moerr.NewInternalErrorf(ctx, "failed to execute %s on the sink, err: %v", stmt, err)
+		}
+		logutil.Info(
+			"cdc.sinker.apply_ddl",
+			zap.String("sink", sinkUri.String()),
+			zap.String("ddl", stmt),
+		)
+	}
+	return nil
+}
+
 var _ Sinker = new(consoleSinker)
 
 type consoleSinker struct {
@@ -0,0 +1,335 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// op codes of the changes, the debezium ones
+const (
+	changeOpCreate = "c"
+	changeOpUpdate = "u"
+	changeOpDelete = "d"
+	changeOpRead   = "r"
+)
+
+// changeRow is a decoded change of the table
+type changeRow struct {
+	op string
+	// commit ts of the change, the snapshot ts for the snapshot rows
+	ts types.TS
+	// before and after images, aligned with the columns of the table
+	before []any
+	after  []any
+	// primary key values, nil if the table has no visible primary key
+	key []any
+}
+
+type changeColumn struct {
+	name string
+	typ  types.Type
+}
+
+// changeDecoder decodes the snapshot and tail batches of a table into changes
+// with their before and after images, for the sinks writing change events
+// instead of applying the changes.
+//
+// Deletes only know the primary key, the other columns of the before image are null.
+type changeDecoder struct {
+	cols []changeColumn
+	// index of the primary key columns in cols, nil if the table has no visible primary key
+	pkIdxes  []int
+	singlePK bool
+}
+
+func newChangeDecoder(tableDef *plan.TableDef) *changeDecoder {
+	d := &changeDecoder{}
+
+	colIdx := make(map[string]int)
+	for _, col := range tableDef.Cols {
+		if _, ok := catalog.InternalColumns[col.Name]; ok {
+			continue
+		}
+		colIdx[col.Name] = len(d.cols)
+		d.cols = append(d.cols, changeColumn{
+			name: col.Name,
+			typ: types.Type{
+				Oid:   types.T(col.Typ.Id),
+				Width: col.Typ.Width,
+				Scale: col.Typ.Scale,
+			},
+		})
+	}
+
+	if tableDef.Pkey != nil {
+		d.singlePK = len(tableDef.Pkey.Names) == 1
+		for _, pkName := range tableDef.Pkey.Names {
+			idx, ok := colIdx[pkName]
+			if !ok {
+				d.pkIdxes = nil
+				break
+			}
+			d.pkIdxes = append(d.pkIdxes, idx)
+		}
+	}
+	return d
+}
+
+// decodeSnapshot appends the rows of the snapshot batch as read events
+func (d *changeDecoder) decodeSnapshot(
+	ctx context.Context,
+	rows []changeRow,
+	bat *batch.Batch,
+	ts types.TS,
+) ([]changeRow, error) {
+	if bat == nil {
+		return rows, nil
+	}
+	for i := 0; i < bat.RowCount(); i++ {
+		after, err := d.image(ctx, bat, i)
+		if err != nil {
+			return rows, err
+		}
+		rows = append(rows, changeRow{
+			op:    changeOpRead,
+			ts:    ts,
+			after: after,
+			key:   d.keyOf(after),
+		})
+	}
+	return rows, nil
+}
+
+// decodeTail appends the changes of the tail in commit ts order.
+// A delete and an insert of the same primary key at the same ts is an update.
+func (d *changeDecoder) decodeTail(
+	ctx context.Context,
+	rows []changeRow,
+	insertAtmBatch *AtomicBatch,
+	deleteAtmBatch *AtomicBatch,
+) ([]changeRow, error) {
+	insRows := atomicBatchRows(insertAtmBatch)
+	delRows := atomicBatchRows(deleteAtmBatch)
+
+	i, j := 0, 0
+	for i < len(insRows) || j < len(delRows) {
+		switch {
+		case j >= len(delRows) || (i < len(insRows) && insRows[i].Less(delRows[j])):
+			after, err := d.image(ctx, insRows[i].Src, insRows[i].Offset)
+			if err != nil {
+				return rows, err
+			}
+			rows = append(rows, changeRow{
+				op:    changeOpCreate,
+				ts:    insRows[i].Ts,
+				after: after,
+				key:   d.keyOf(after),
+			})
+			i++
+
+		case i >= len(insRows) || delRows[j].Less(insRows[i]):
+			before, err := d.deleteImage(ctx, delRows[j])
+			if err != nil {
+				return rows, err
+			}
+			rows = append(rows, changeRow{
+				op:     changeOpDelete,
+				ts:     delRows[j].Ts,
+				before: before,
+				key:    d.keyOf(before),
+			})
+			j++
+
+		default:
+			before, err := d.deleteImage(ctx, delRows[j])
+			if err != nil {
+				return rows, err
+			}
+			after, err := d.image(ctx, insRows[i].Src, insRows[i].Offset)
+			if err != nil {
+				return rows, err
+			}
+			rows = append(rows, changeRow{
+				op:     changeOpUpdate,
+				ts:     insRows[i].Ts,
+				before: before,
+				after:  after,
+				key:    d.keyOf(after),
+			})
+			i++
+			j++
+		}
+	}
+	return rows, nil
+}
+
+func atomicBatchRows(bat *AtomicBatch) []AtomicBatchRow {
+	if bat == nil || bat.Rows == nil {
+		return nil
+	}
+	res := make([]AtomicBatchRow, 0, bat.Rows.Len())
+	bat.Rows.Scan(func(row AtomicBatchRow) bool {
+		res = append(res, row)
+		return true
+	})
+	return res
+}
+
+// image extracts the row of the batch
+func (d *changeDecoder) image(ctx context.Context, bat *batch.Batch, rowIdx int) ([]any, error) {
+	row := make([]any, len(d.cols))
+	if err := extractRowFromEveryVector(ctx, bat, rowIdx, row); err != nil {
+		return nil, err
+	}
+	for i := range row {
+		row[i] = changeValue(row[i], &d.cols[i].typ)
+	}
+	return row, nil
+}
+
+// deleteImage builds the before image of the deleted row from its primary key
+func (d *changeDecoder) deleteImage(ctx context.Context, delRow AtomicBatchRow) ([]any, error) {
+	before := make([]any, len(d.cols))
+	if len(d.pkIdxes) == 0 {
+		return before, nil
+	}
+
+	pkRow := make([]any, 1)
+	if err := extractRowFromEveryVector(ctx, delRow.Src, delRow.Offset, pkRow); err != nil {
+		return nil, err
+	}
+
+	var pkValues types.Tuple
+	if d.singlePK {
+		pkValues = types.Tuple{pkRow[0]}
+	} else {
+		pkBytes, ok := pkRow[0].([]byte)
+		if !ok {
+			return nil, moerr.NewInternalErrorf(ctx, "composite PK must be []byte, got %T", pkRow[0])
+		}
+		tuple, _, err := types.UnpackWithSchema(pkBytes)
+		if err != nil {
+			return nil, err
+		}
+		if len(tuple) != len(d.pkIdxes) {
+			return nil, moerr.NewInternalErrorf(ctx, "PK tuple length mismatch: expected %d, got %d",
+				len(d.pkIdxes), len(tuple))
+		}
+		pkValues = tuple
+	}
+
+	for i, idx := range d.pkIdxes {
+		before[idx] = changeValue(pkValues[i], &d.cols[idx].typ)
+	}
+	return before, nil
+}
+
+func (d *changeDecoder) keyOf(image []any) []any {
+	if len(d.pkIdxes) == 0 {
+		return nil
+	}
+	key := make([]any, len(d.pkIdxes))
+	for i, idx := range d.pkIdxes {
+		key[i] = image[idx]
+	}
+	return key
+}
+
+// changeValue converts the value extracted from the vector into a value friendly to
+// the json, avro and parquet encoders
+func changeValue(val any, typ *types.Type) any {
+	switch v := val.(type) {
+	case []byte:
+		switch typ.Oid {
+		case types.T_binary, types.T_varbinary, types.T_blob:
+			return v
+		}
+		return string(v)
+	case bytejson.ByteJson:
+		return v.String()
+	case types.Date:
+		return v.String()
+	case types.Datetime:
+		return v.String2(typ.Scale)
+	case types.Time:
+		return v.String2(typ.Scale)
+	case types.Timestamp:
+		return v.String2(time.UTC, typ.Scale)
+	case types.Decimal64:
+		return v.Format(typ.Scale)
+	case types.Decimal128:
+		return v.Format(typ.Scale)
+	case types.Uuid:
+		return v.String()
+	case types.Enum:
+		return uint16(v)
+	}
+	return val
+}
+
+// changeJsonObject encodes the values as a json object, keeping the order of the names
+func changeJsonObject(names []string, values []any) ([]byte, error) {
+	buf := make([]byte, 0, 256)
+	buf = append(buf, '{')
+	for i, name := range names {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		jsonName, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, jsonName...)
+		buf = append(buf, ':')
+		jsonValue, err := json.Marshal(values[i])
+		if err != nil {
+			return nil, moerr.NewInternalErrorNoCtx(fmt.Sprintf("failed to encode column %s: %v", name, err))
+		}
+		buf = append(buf, jsonValue...)
+	}
+	buf = append(buf, '}')
+	return buf, nil
+}
+
+// parseChangeTs parses the ts in the physical-logical format of TS.ToString,
+// the sinks name their transactions and files by it
+func parseChangeTs(id string) (types.TS, bool) {
+	physical, logical, ok := strings.Cut(id, "-")
+	if !ok {
+		return types.TS{}, false
+	}
+	p, err := strconv.ParseInt(physical, 10, 64)
+	if err != nil {
+		return types.TS{}, false
+	}
+	l, err := strconv.ParseUint(logical, 10, 32)
+	if err != nil {
+		return types.TS{}, false
+	}
+	return types.BuildTS(p, uint32(l)), true
+}
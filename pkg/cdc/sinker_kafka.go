@@ -0,0 +1,887 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+	"go.uber.org/zap"
+)
+
+// parameters of the kafka sink uri, the other parameters are passed to
+// librdkafka as they are
+const (
+	KafkaSinkParam_TopicPrefix       = "topic_prefix"
+	KafkaSinkParam_Format            = "format"
+	KafkaSinkParam_TransactionTopic  = "transaction_topic"
+	KafkaSinkParam_SchemaTopic       = "schema_topic"
+	KafkaSinkParam_WatermarkTopic    = "watermark_topic"
+	KafkaSinkParam_ReplicationFactor = "replication_factor"
+)
+
+const (
+	KafkaSinkFormat_Json = "json"
+	KafkaSinkFormat_Avro = "avro"
+)
+
+const (
+	kafkaSinkDefaultTopicPrefix = "mo"
+	kafkaSinkConnector          = "matrixone"
+	kafkaSinkConsumerGroup      = "mo-cdc-sink"
+
+	// kafkaSinkQueryTimeoutMs bounds the waiting for the brokers
+	kafkaSinkQueryTimeoutMs = 10000
+	// kafkaSinkWatermarkSegmentMs rolls the segments of the watermark topic
+	// hourly, so the compaction keeps it at about one event per table
+	kafkaSinkWatermarkSegmentMs = "3600000"
+)
+
+const (
+	kafkaTxnStatusBegin = "BEGIN"
+	kafkaTxnStatusEnd   = "END"
+)
+
+var kafkaSinkParams = map[string]bool{
+	KafkaSinkParam_TopicPrefix:       true,
+	KafkaSinkParam_Format:            true,
+	KafkaSinkParam_TransactionTopic:  true,
+	KafkaSinkParam_SchemaTopic:       true,
+	KafkaSinkParam_WatermarkTopic:    true,
+	KafkaSinkParam_ReplicationFactor: true,
+}
+
+/*
+ExtractKafkaUriInfo extracts the uriInfo of the kafka sink uri in the format:
+
+	kafka://[user:password@]host1:port1[,host2:port2...][?param=value&...]
+
+return serialized uriInfo
+*/
+func ExtractKafkaUriInfo(
+	ctx context.Context,
+	uri string,
+) (string, UriInfo, error) {
+	invalid := func() (string, UriInfo, error) {
+		return "", UriInfo{}, moerr.NewInternalErrorf(ctx, "invalid uri format: %s", uri)
+	}
+	if !uriHasPrefix(uri, CDCKafkaSinkUriPrefix) {
+		return invalid()
+	}
+
+	info := UriInfo{}
+	rest := uri[len(CDCKafkaSinkUriPrefix):]
+	if idx := strings.IndexByte(rest, '?'); idx >= 0 {
+		values, err := url.ParseQuery(rest[idx+1:])
+		if err != nil {
+			return invalid()
+		}
+		info.Params = make(map[string]string, len(values))
+		for key := range values {
+			info.Params[key] = values.Get(key)
+		}
+		rest = rest[:idx]
+	}
+
+	//locate user password
+	if atIdx := strings.LastIndex(rest, "@"); atIdx >= 0 {
+		userInfo := rest[:atIdx]
+		colonIdx := strings.Index(userInfo, ":")
+		if colonIdx <= 0 {
+			return invalid()
+		}
+		userName, err := url.PathUnescape(userInfo[:colonIdx])
+		if err != nil || userName == "" {
+			return invalid()
+		}
+		info.User = userName
+		info.PasswordStart = len(CDCKafkaSinkUriPrefix) + colonIdx + 1
+		info.PasswordEnd = len(CDCKafkaSinkUriPrefix) + atIdx
+		if info.Password, err = url.PathUnescape(uri[info.PasswordStart:info.PasswordEnd]); err != nil {
+			return invalid()
+		}
+		rest = rest[atIdx+1:]
+	}
+
+	for _, broker := range strings.Split(rest, ",") {
+		host, port, err := net.SplitHostPort(broker)
+		if err != nil || host == "" {
+			return invalid()
+		}
+		if portInt, err := strconv.ParseUint(port, 10, 32); err != nil || portInt > 65535 {
+			return invalid()
+		}
+	}
+	info.Brokers = rest
+
+	switch format := info.Params[KafkaSinkParam_Format]; format {
+	case "", KafkaSinkFormat_Json, KafkaSinkFormat_Avro:
+	default:
+		return "", UriInfo{}, moerr.NewInternalErrorf(ctx, "unsupported kafka sink format: %s", format)
+	}
+	if factor, ok := info.Params[KafkaSinkParam_ReplicationFactor]; ok {
+		if n, err := strconv.Atoi(factor); err != nil || n <= 0 {
+			return "", UriInfo{}, moerr.NewInternalErrorf(ctx, "invalid kafka sink replication_factor: %s", factor)
+		}
+	}
+
+	jsonUriInfo, err := JsonEncode(&info)
+	if err != nil {
+		return "", UriInfo{}, err
+	}
+	return jsonUriInfo, info, nil
+}
+
+func kafkaSinkTopicPrefix(info *UriInfo) string {
+	if prefix := info.Params[KafkaSinkParam_TopicPrefix]; prefix != "" {
+		return prefix
+	}
+	return kafkaSinkDefaultTopicPrefix
+}
+
+func kafkaSinkFormat(info *UriInfo) string {
+	if format := info.Params[KafkaSinkParam_Format]; format != "" {
+		return format
+	}
+	return KafkaSinkFormat_Json
+}
+
+// KafkaSinkTransactionTopic returns the topic of the transaction BEGIN/END events
+func KafkaSinkTransactionTopic(info *UriInfo) string {
+	if topic := info.Params[KafkaSinkParam_TransactionTopic]; topic != "" {
+		return topic
+	}
+	return kafkaSinkTopicPrefix(info) + ".transaction"
+}
+
+// KafkaSinkSchemaTopic returns the topic the avro schemas are published to
+func KafkaSinkSchemaTopic(info *UriInfo) string {
+	if topic := info.Params[KafkaSinkParam_SchemaTopic]; topic != "" {
+		return topic
+	}
+	return kafkaSinkTopicPrefix(info) + ".schema"
+}
+
+// KafkaSinkWatermarkTopic returns the compacted topic of the committed
+// watermarks of the sink tables
+func KafkaSinkWatermarkTopic(info *UriInfo) string {
+	if topic := info.Params[KafkaSinkParam_WatermarkTopic]; topic != "" {
+		return topic
+	}
+	return kafkaSinkTopicPrefix(info) + ".watermark"
+}
+
+// KafkaSinkTableTopic returns the topic of the change events of the sink table
+func KafkaSinkTableTopic(info *UriInfo, dbName, tblName string) string {
+	return kafkaSinkTopicPrefix(info) + "." + dbName + "." + tblName
+}
+
+func kafkaSinkConfigMap(info *UriInfo) *kafka.ConfigMap {
+	configMap := &kafka.ConfigMap{
+		"bootstrap.servers":  info.Brokers,
+		"group.id":           kafkaSinkConsumerGroup,
+		"enable.auto.commit": false,
+		// the watermark topic is read up to its end on start
+		"enable.partition.eof": true,
+		// skip the events of aborted transactions
+		"isolation.level": "read_committed",
+		// idempotent producer, no duplicates on the internal retries
+		"enable.idempotence": true,
+		"acks":               "all",
+	}
+	if info.User != "" {
+		// SASL_SSL or another mechanism can be set by the uri parameters
+		if _, ok := info.Params["security.protocol"]; !ok {
+			_ = configMap.SetKey("security.protocol", "SASL_PLAINTEXT")
+		}
+		if _, ok := info.Params["sasl.mechanisms"]; !ok {
+			_ = configMap.SetKey("sasl.mechanisms", "PLAIN")
+		}
+		_ = configMap.SetKey("sasl.username", info.User)
+		_ = configMap.SetKey("sasl.password", info.Password)
+	}
+	for key, value := range info.Params {
+		if !kafkaSinkParams[key] {
+			_ = configMap.SetKey(key, value)
+		}
+	}
+	return configMap
+}
+
+// NewKafkaSinkAdapter creates the kafka client of the kafka sink
+// Defined as var for test mocking
+var NewKafkaSinkAdapter = func(info *UriInfo) (mokafka.KafkaAdapterInterface, error) {
+	return mokafka.NewKafkaAdapter(kafkaSinkConfigMap(info))
+}
+
+// NewKafkaSinkTxnProducer creates the transactional producer of a sink table
+// Defined as var for test mocking
+var NewKafkaSinkTxnProducer = func(
+	ctx context.Context,
+	info *UriInfo,
+	transactionalId string,
+) (mokafka.KafkaTxnProducerInterface, error) {
+	return mokafka.NewKafkaTxnProducer(ctx, kafkaSinkConfigMap(info), transactionalId)
+}
+
+// readKafkaPartition returns the committed messages of the partition 0 of the topic
+// Defined as var for test mocking
+var readKafkaPartition = func(
+	adapter mokafka.KafkaAdapterInterface,
+	topic string,
+) ([]*kafka.Message, error) {
+	consumer, err := adapter.GetKafkaConsumer()
+	if err != nil {
+		return nil, err
+	}
+	if consumer == nil {
+		return nil, moerr.NewInternalErrorNoCtx("kafka consumer not initialized")
+	}
+	if err = consumer.Assign([]kafka.TopicPartition{
+		{Topic: &topic, Partition: 0, Offset: kafka.OffsetBeginning},
+	}); err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = consumer.Unassign()
+	}()
+
+	// the offsets are not dense, compaction and transaction markers leave
+	// gaps, so read until the end of the partition is reported
+	var msgs []*kafka.Message
+	idle := time.Now()
+	for time.Since(idle) < kafkaSinkQueryTimeoutMs*time.Millisecond {
+		switch e := consumer.Poll(100).(type) {
+		case *kafka.Message:
+			if e.TopicPartition.Error != nil {
+				return nil, e.TopicPartition.Error
+			}
+			msgs = append(msgs, e)
+			idle = time.Now()
+		case kafka.PartitionEOF:
+			return msgs, nil
+		case kafka.Error:
+			return nil, e
+		}
+	}
+	return nil, moerr.NewInternalErrorNoCtxf("timeout reading kafka topic %s", topic)
+}
+
+// kafkaSinkWatermarks are the committed watermarks of the sink tables of a
+// task. The watermark topic is read once by the first sinker of the task, the
+// commits of the sinkers keep it up to date, so the other sinkers of the task
+// do not read the whole topic again. It is dropped when the last sinker of
+// the task is closed.
+type kafkaSinkWatermarks struct {
+	sync.Mutex
+	refs      int
+	loaded    bool
+	committed map[string]types.TS
+}
+
+var kafkaSinkWatermarkCache = struct {
+	sync.Mutex
+	tasks map[string]*kafkaSinkWatermarks
+}{
+	tasks: make(map[string]*kafkaSinkWatermarks),
+}
+
+func acquireKafkaSinkWatermarks(cacheKey string) *kafkaSinkWatermarks {
+	kafkaSinkWatermarkCache.Lock()
+	defer kafkaSinkWatermarkCache.Unlock()
+	w, ok := kafkaSinkWatermarkCache.tasks[cacheKey]
+	if !ok {
+		w = &kafkaSinkWatermarks{}
+		kafkaSinkWatermarkCache.tasks[cacheKey] = w
+	}
+	w.refs++
+	return w
+}
+
+func releaseKafkaSinkWatermarks(cacheKey string) {
+	kafkaSinkWatermarkCache.Lock()
+	defer kafkaSinkWatermarkCache.Unlock()
+	w, ok := kafkaSinkWatermarkCache.tasks[cacheKey]
+	if !ok {
+		return
+	}
+	if w.refs--; w.refs == 0 {
+		delete(kafkaSinkWatermarkCache.tasks, cacheKey)
+	}
+}
+
+// get returns the committed watermark of the key, the events of the task
+// are read from the watermark topic on the first call
+func (w *kafkaSinkWatermarks) get(
+	adapter mokafka.KafkaAdapterInterface,
+	topic string,
+	taskId string,
+	key string,
+) (types.TS, error) {
+	w.Lock()
+	defer w.Unlock()
+	if !w.loaded {
+		msgs, err := readKafkaPartition(adapter, topic)
+		if err != nil {
+			return types.TS{}, err
+		}
+		w.committed = make(map[string]types.TS)
+		prefix := []byte(taskId + "/")
+		for _, msg := range msgs {
+			if !bytes.HasPrefix(msg.Key, prefix) {
+				continue
+			}
+			var event kafkaWatermarkEvent
+			if err = json.Unmarshal(msg.Value, &event); err != nil {
+				// not written by the sinker
+				continue
+			}
+			ts, ok := parseChangeTs(event.CommittedTs)
+			if !ok {
+				continue
+			}
+			w.committed[string(msg.Key)] = ts
+		}
+		w.loaded = true
+	}
+	return w.committed[key], nil
+}
+
+// set records the watermark of the key committed in kafka
+func (w *kafkaSinkWatermarks) set(key string, ts types.TS) {
+	w.Lock()
+	defer w.Unlock()
+	if w.loaded {
+		w.committed[key] = ts
+	}
+}
+
+// createKafkaSinkTopic creates the topic with the topic configs
+// Defined as var for test mocking
+var createKafkaSinkTopic = func(
+	ctx context.Context,
+	adapter mokafka.KafkaAdapterInterface,
+	spec kafka.TopicSpecification,
+) error {
+	ka, ok := adapter.(*mokafka.KafkaAdapter)
+	if !ok {
+		return adapter.CreateTopic(ctx, spec.Topic, spec.NumPartitions, spec.ReplicationFactor)
+	}
+	results, err := ka.AdminClient.CreateTopics(ctx, []kafka.TopicSpecification{spec})
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Error.Code() != kafka.ErrNoError {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+// CheckKafkaSink checks the brokers are reachable and creates the transaction
+// and the watermark topics. The watermark topic is compacted and has only one
+// partition, so that it is read quickly on start.
+func CheckKafkaSink(ctx context.Context, info *UriInfo) error {
+	adapter, err := NewKafkaSinkAdapter(info)
+	if err != nil {
+		return err
+	}
+	defer adapter.Close()
+
+	replicationFactor := 1
+	if factor, ok := info.Params[KafkaSinkParam_ReplicationFactor]; ok {
+		if replicationFactor, err = strconv.Atoi(factor); err != nil {
+			return err
+		}
+	}
+	for _, spec := range []kafka.TopicSpecification{
+		{
+			Topic:             KafkaSinkTransactionTopic(info),
+			NumPartitions:     1,
+			ReplicationFactor: replicationFactor,
+		},
+		{
+			Topic:             KafkaSinkWatermarkTopic(info),
+			NumPartitions:     1,
+			ReplicationFactor: replicationFactor,
+			Config: map[string]string{
+				"cleanup.policy": "compact",
+				"segment.ms":     kafkaSinkWatermarkSegmentMs,
+			},
+		},
+	} {
+		if err = createKafkaSinkTopic(ctx, adapter, spec); err != nil {
+			if kerr, ok := err.(kafka.Error); !ok || kerr.Code() != kafka.ErrTopicAlreadyExists {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Compile-time check that kafkaSinker implements Sinker interface
+var _ Sinker = (*kafkaSinker)(nil)
+
+// kafkaSinker sinks the changes of a table into kafka as debezium-style change events.
+//
+// Every table has its own topic. The events of a transaction are buffered and
+// produced on commit in one kafka transaction, surrounded by BEGIN and END
+// events sent to the transaction topic, together with the toTs of the
+// transaction keyed by the task and the table in the compacted watermark
+// topic. The watermark event commits with the changes, so it is the proof the
+// transaction is in kafka:
+//   - on start, the sinker finds the last watermark event of the table, the
+//     watermark topic is read once by the sinkers of a task
+//   - transactions whose toTs is not after it were delivered before the
+//     watermark was updated, so they are dropped instead of produced again
+//   - the changes not after it are dropped from the replayed transactions
+//
+// A transaction interrupted before it commits is aborted, consumers reading
+// with isolation.level=read_committed never see its events.
+type kafkaSinker struct {
+	adapter          mokafka.KafkaAdapterInterface
+	producer         mokafka.KafkaTxnProducerInterface
+	sinkUri          UriInfo
+	accountId        uint64
+	taskId           string
+	dbTblInfo        *DbTableInfo
+	watermarkUpdater *CDCWatermarkUpdater
+	encoder          *kafkaEventEncoder
+
+	topic          string
+	txnTopic       string
+	watermarkTopic string
+	dataCollection string
+
+	// the committed watermarks shared by the sinkers of the task
+	watermarks    *kafkaSinkWatermarks
+	watermarksKey string
+
+	// committedTs is the toTs of the last transaction committed in kafka
+	committedTs types.TS
+
+	// the open transaction
+	txnActive bool
+	fromTs    types.TS
+	toTs      types.TS
+	rows      []changeRow
+
+	err atomic.Pointer[error]
+}
+
+// CreateKafkaSinker creates the kafka sinker of a table
+// Defined as var for test mocking
+var CreateKafkaSinker = func(
+	sinkUri UriInfo,
+	accountId uint64,
+	taskId string,
+	dbTblInfo *DbTableInfo,
+	watermarkUpdater *CDCWatermarkUpdater,
+	tableDef *plan.TableDef,
+) (Sinker, error) {
+	ctx := context.Background()
+	if tableDef == nil {
+		return nil, moerr.NewInternalErrorNoCtx("tableDef is required")
+	}
+	if tableDef.TableType == catalog.SystemClusterRel {
+		return nil, moerr.NewInternalErrorNoCtx("cluster table is not supported")
+	}
+	if tableDef.TableType == catalog.SystemExternalRel {
+		return nil, moerr.NewInternalErrorNoCtx("external table is not supported")
+	}
+	// the table is recreated in the sink by the consumers, nothing to drop
+	dbTblInfo.IdChanged = false
+
+	adapter, err := NewKafkaSinkAdapter(&sinkUri)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &kafkaSinker{
+		adapter:          adapter,
+		sinkUri:          sinkUri,
+		accountId:        accountId,
+		taskId:           taskId,
+		dbTblInfo:        dbTblInfo,
+		watermarkUpdater: watermarkUpdater,
+		encoder: newKafkaEventEncoder(
+			kafkaSinkFormat(&sinkUri),
+			kafkaSinkTopicPrefix(&sinkUri),
+			taskId,
+			dbTblInfo,
+			tableDef,
+		),
+		topic:          KafkaSinkTableTopic(&sinkUri, dbTblInfo.SinkDbName, dbTblInfo.SinkTblName),
+		txnTopic:       KafkaSinkTransactionTopic(&sinkUri),
+		watermarkTopic: KafkaSinkWatermarkTopic(&sinkUri),
+		dataCollection: GenDbTblKey(dbTblInfo.SinkDbName, dbTblInfo.SinkTblName),
+	}
+
+	// the producer fences the producers of a former run of the sinker before
+	// the committed watermark is read
+	if s.producer, err = NewKafkaSinkTxnProducer(ctx, &sinkUri, s.transactionalId()); err != nil {
+		adapter.Close()
+		return nil, err
+	}
+	s.watermarksKey = sinkUri.Brokers + "/" + s.watermarkTopic + "/" + taskId
+	s.watermarks = acquireKafkaSinkWatermarks(s.watermarksKey)
+	if err = s.recoverCommittedTs(ctx); err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	if s.encoder.format == KafkaSinkFormat_Avro {
+		// publish the schema, the key is the fingerprint carried by every event
+		if _, err = adapter.ProduceMessage(
+			KafkaSinkSchemaTopic(&sinkUri),
+			[]byte(s.encoder.avro.fingerprintHex()),
+			[]byte(s.encoder.avro.schema),
+		); err != nil {
+			s.Close()
+			return nil, err
+		}
+	}
+
+	logutil.Info("cdc.kafka_sinker.created",
+		zap.String("task-id", taskId),
+		zap.String("table", dbTblInfo.String()),
+		zap.String("topic", s.topic),
+		zap.String("format", s.encoder.format),
+		zap.String("committed-ts", s.committedTs.ToString()),
+	)
+	return s, nil
+}
+
+// transactionalId is the kafka transactional id of the sink table
+func (s *kafkaSinker) transactionalId() string {
+	return kafkaSinkConsumerGroup + "." + s.taskId + "." + s.dataCollection
+}
+
+// watermarkKey is the key of the events of the sink table in the watermark topic
+func (s *kafkaSinker) watermarkKey() []byte {
+	return []byte(s.taskId + "/" + s.dataCollection)
+}
+
+// recoverCommittedTs finds the last watermark event of the table in the watermark topic
+func (s *kafkaSinker) recoverCommittedTs(ctx context.Context) (err error) {
+	s.committedTs, err = s.watermarks.get(s.adapter, s.watermarkTopic, s.taskId, string(s.watermarkKey()))
+	return
+}
+
+func (s *kafkaSinker) Run(_ context.Context, _ *ActiveRoutine) {}
+
+// Sink decodes the changes into the buffer of the open transaction.
+// Changes sunk without SendBegin (split snapshot) are committed by the
+// following no more data heartbeat.
+func (s *kafkaSinker) Sink(ctx context.Context, data *DecoderOutput) {
+	defer data.Close()
+
+	if s.Error() != nil {
+		return
+	}
+
+	if s.watermarkUpdater != nil {
+		key := WatermarkKey{
+			AccountId: s.accountId,
+			TaskId:    s.taskId,
+			DBName:    s.dbTblInfo.SourceDbName,
+			TableName: s.dbTblInfo.SourceTblName,
+		}
+		watermark, err := s.watermarkUpdater.GetFromCache(ctx, &key)
+		if err != nil {
+			s.SetError(err)
+			return
+		}
+		if data.toTs.LT(&watermark) {
+			logutil.Error("cdc.kafka_sinker.unexpected_watermark",
+				zap.String("table", s.dbTblInfo.String()),
+				zap.String("toTs", data.toTs.ToString()),
+				zap.String("watermark", watermark.ToString()))
+			s.SetError(moerr.NewInternalError(ctx, "unexpected watermark"))
+			return
+		}
+	}
+
+	if data.noMoreData {
+		if !s.txnActive && len(s.rows) > 0 {
+			s.toTs = data.toTs
+			s.commit(ctx)
+		}
+		return
+	}
+
+	if !s.txnActive && len(s.rows) == 0 {
+		s.fromTs = data.fromTs
+	}
+	s.toTs = data.toTs
+
+	var err error
+	switch data.outputTyp {
+	case OutputTypeSnapshot:
+		s.rows, err = s.encoder.decodeSnapshot(ctx, s.rows, data.checkpointBat, data.toTs)
+	case OutputTypeTail:
+		s.rows, err = s.encoder.decodeTail(ctx, s.rows, data.insertAtmBatch, data.deleteAtmBatch)
+	default:
+		logutil.Error("cdc.kafka_sinker.unknown_output_type",
+			zap.String("table", s.dbTblInfo.String()),
+			zap.String("outputType", data.outputTyp.String()))
+	}
+	if err != nil {
+		s.SetError(err)
+	}
+}
+
+func (s *kafkaSinker) SendBegin() {
+	if s.Error() != nil {
+		return
+	}
+	s.txnActive = true
+	s.rows = s.rows[:0]
+}
+
+func (s *kafkaSinker) SendCommit() {
+	if s.Error() != nil || !s.txnActive {
+		return
+	}
+	s.commit(context.Background())
+}
+
+func (s *kafkaSinker) SendRollback() {
+	s.txnActive = false
+	s.rows = nil
+}
+
+// SendDummy does nothing, the sinker produces synchronously
+func (s *kafkaSinker) SendDummy() {}
+
+// commit produces the buffered changes between the BEGIN and END events,
+// unless the transaction is already in kafka
+func (s *kafkaSinker) commit(ctx context.Context) {
+	rows := s.rows
+	s.txnActive = false
+	s.rows = nil
+
+	if !s.toTs.GT(&s.committedTs) {
+		logutil.Info("cdc.kafka_sinker.skip_committed_txn",
+			zap.String("table", s.dbTblInfo.String()),
+			zap.String("to-ts", s.toTs.ToString()),
+			zap.String("committed-ts", s.committedTs.ToString()),
+			zap.Int("rows", len(rows)),
+		)
+		return
+	}
+	// a replay from an older watermark starts with the changes already in kafka
+	if !s.committedTs.IsEmpty() {
+		pending := rows[:0]
+		for _, row := range rows {
+			if row.ts.GT(&s.committedTs) {
+				pending = append(pending, row)
+			}
+		}
+		rows = pending
+	}
+	if len(rows) == 0 {
+		s.committedTs = s.toTs
+		return
+	}
+
+	if err := s.produce(ctx, rows); err != nil {
+		s.SetError(err)
+		return
+	}
+	s.committedTs = s.toTs
+	if s.watermarks != nil {
+		s.watermarks.set(string(s.watermarkKey()), s.toTs)
+	}
+}
+
+// produce produces the changes and the BEGIN, END and watermark events of
+// the transaction in one kafka transaction
+func (s *kafkaSinker) produce(ctx context.Context, rows []changeRow) (err error) {
+	if s.producer == nil {
+		// dropped after a fatal error
+		if s.producer, err = NewKafkaSinkTxnProducer(ctx, &s.sinkUri, s.transactionalId()); err != nil {
+			return err
+		}
+	}
+	if err = s.producer.BeginTransaction(); err != nil {
+		return s.abort(ctx, err)
+	}
+
+	txnId := s.toTs.ToString()
+	key := []byte(s.dataCollection)
+	begin, err := json.Marshal(&kafkaTxnEvent{
+		Status: kafkaTxnStatusBegin,
+		Id:     txnId,
+		TsMs:   time.Now().UnixMilli(),
+		TaskId: s.taskId,
+	})
+	if err != nil {
+		return s.abort(ctx, err)
+	}
+	if err = s.producer.Produce(s.txnTopic, key, begin); err != nil {
+		return s.abort(ctx, err)
+	}
+
+	for i := range rows {
+		msgKey, msgValue, err := s.encoder.encode(&rows[i], txnId, int64(i+1))
+		if err != nil {
+			return s.abort(ctx, err)
+		}
+		if err = s.producer.Produce(s.topic, msgKey, msgValue); err != nil {
+			return s.abort(ctx, err)
+		}
+	}
+
+	eventCount := int64(len(rows))
+	end, err := json.Marshal(&kafkaTxnEvent{
+		Status:     kafkaTxnStatusEnd,
+		Id:         txnId,
+		EventCount: &eventCount,
+		DataCollections: []kafkaDataCollection{{
+			DataCollection: s.dataCollection,
+			EventCount:     eventCount,
+		}},
+		TsMs:   time.Now().UnixMilli(),
+		TaskId: s.taskId,
+	})
+	if err != nil {
+		return s.abort(ctx, err)
+	}
+	if err = s.producer.Produce(s.txnTopic, key, end); err != nil {
+		return s.abort(ctx, err)
+	}
+
+	watermark, err := json.Marshal(&kafkaWatermarkEvent{
+		TaskId:         s.taskId,
+		DataCollection: s.dataCollection,
+		CommittedTs:    txnId,
+	})
+	if err != nil {
+		return s.abort(ctx, err)
+	}
+	if err = s.producer.Produce(s.watermarkTopic, s.watermarkKey(), watermark); err != nil {
+		return s.abort(ctx, err)
+	}
+
+	// waits for the delivery of all the events at once
+	if err = s.producer.CommitTransaction(ctx); err != nil {
+		return s.abort(ctx, err)
+	}
+	return nil
+}
+
+// abort aborts the kafka transaction failed with err. The producer is dropped
+// if it cannot be used any more, the next transaction creates a new one.
+func (s *kafkaSinker) abort(ctx context.Context, err error) error {
+	if kerr, ok := err.(kafka.Error); ok && kerr.IsFatal() {
+		s.closeProducer()
+		return err
+	}
+	if aerr := s.producer.AbortTransaction(ctx); aerr != nil {
+		logutil.Error("cdc.kafka_sinker.abort_failed",
+			zap.String("table", s.dbTblInfo.String()),
+			zap.Error(aerr))
+		s.closeProducer()
+	}
+	return err
+}
+
+func (s *kafkaSinker) closeProducer() {
+	if s.producer != nil {
+		s.producer.Close()
+		s.producer = nil
+	}
+}
+
+func (s *kafkaSinker) Error() error {
+	errPtr := s.err.Load()
+	if errPtr == nil {
+		return nil
+	}
+	return *errPtr
+}
+
+func (s *kafkaSinker) SetError(err error) {
+	if err == nil {
+		s.err.Store(nil)
+		return
+	}
+	if _, ok := err.(*moerr.Error); !ok {
+		err = moerr.ConvertGoError(context.Background(), err)
+	}
+	s.err.Store(&err)
+}
+
+func (s *kafkaSinker) ClearError() {
+	s.err.Store(nil)
+}
+
+func (s *kafkaSinker) Reset() {
+	s.SendRollback()
+	s.ClearError()
+}
+
+func (s *kafkaSinker) Close() {
+	s.SendRollback()
+	s.closeProducer()
+	if s.watermarks != nil {
+		releaseKafkaSinkWatermarks(s.watermarksKey)
+		s.watermarks = nil
+	}
+	if s.adapter != nil {
+		s.adapter.Close()
+		s.adapter = nil
+	}
+}
+
+// kafkaTxnEvent is the debezium transaction metadata event
+type kafkaTxnEvent struct {
+	Status          string                `json:"status"`
+	Id              string                `json:"id"`
+	EventCount      *int64                `json:"event_count"`
+	DataCollections []kafkaDataCollection `json:"data_collections"`
+	TsMs            int64                 `json:"ts_ms"`
+	TaskId          string                `json:"task_id"`
+}
+
+type kafkaDataCollection struct {
+	DataCollection string `json:"data_collection"`
+	EventCount     int64  `json:"event_count"`
+}
+
+// kafkaWatermarkEvent is the event of the watermark topic, the toTs of the
+// last transaction of a sink table committed in kafka
+type kafkaWatermarkEvent struct {
+	TaskId         string `json:"task_id"`
+	DataCollection string `json:"data_collection"`
+	CommittedTs    string `json:"committed_ts"`
+}
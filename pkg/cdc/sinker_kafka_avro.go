@@ -0,0 +1,336 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// avro types of the columns
+const (
+	avroBoolean     = "boolean"
+	avroInt         = "int"
+	avroLong        = "long"
+	avroFloat       = "float"
+	avroDouble      = "double"
+	avroBytes       = "bytes"
+	avroString      = "string"
+	avroFloatArray  = "float[]"
+	avroDoubleArray = "double[]"
+)
+
+// avro single object encoding marker
+var avroSingleObjectMarker = []byte{0xC3, 0x01}
+
+// kafkaAvroSchema is the avro schema of the change events of a table.
+//
+// Events are in avro single object encoding: the marker, the fingerprint of
+// the schema and the binary encoded envelope. The schema is in parsing
+// canonical form and published to the schema topic keyed by the fingerprint.
+type kafkaAvroSchema struct {
+	schema      string
+	fingerprint uint64
+	colTypes    []string
+}
+
+func newKafkaAvroSchema(name, dbName, tblName string, cols []changeColumn) *kafkaAvroSchema {
+	a := &kafkaAvroSchema{
+		colTypes: make([]string, len(cols)),
+	}
+	ns := avroName(name) + "." + avroName(dbName) + "." + avroName(tblName)
+
+	var buf strings.Builder
+	buf.WriteString(`{"name":"` + ns + `.Value","type":"record","fields":[`)
+	for i, col := range cols {
+		a.colTypes[i] = avroColumnType(&col.typ)
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(`{"name":"` + avroName(col.name) + `","type":["null",` + avroTypeSchema(a.colTypes[i]) + `]}`)
+	}
+	buf.WriteString(`]}`)
+	value := buf.String()
+
+	source := `{"name":"` + ns + `.Source","type":"record","fields":[` +
+		`{"name":"connector","type":"string"},` +
+		`{"name":"name","type":"string"},` +
+		`{"name":"ts_ms","type":"long"},` +
+		`{"name":"commit_ts","type":"string"},` +
+		`{"name":"snapshot","type":"string"},` +
+		`{"name":"db","type":"string"},` +
+		`{"name":"table","type":"string"},` +
+		`{"name":"task_id","type":"string"}]}`
+	txn := `{"name":"` + ns + `.Transaction","type":"record","fields":[` +
+		`{"name":"id","type":"string"},` +
+		`{"name":"total_order","type":"long"},` +
+		`{"name":"data_collection_order","type":"long"}]}`
+
+	a.schema = `{"name":"` + ns + `.Envelope","type":"record","fields":[` +
+		`{"name":"before","type":["null",` + value + `]},` +
+		`{"name":"after","type":["null","` + ns + `.Value"]},` +
+		`{"name":"source","type":` + source + `},` +
+		`{"name":"op","type":"string"},` +
+		`{"name":"ts_ms","type":"long"},` +
+		`{"name":"transaction","type":["null",` + txn + `]}]}`
+	a.fingerprint = avroFingerprint([]byte(a.schema))
+	return a
+}
+
+func (a *kafkaAvroSchema) fingerprintHex() string {
+	return fmt.Sprintf("%016x", a.fingerprint)
+}
+
+// avroName replaces the characters not allowed in avro names by '_'
+func avroName(name string) string {
+	var buf strings.Builder
+	for i, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+			buf.WriteRune(c)
+		case c >= '0' && c <= '9':
+			if i == 0 {
+				buf.WriteByte('_')
+			}
+			buf.WriteRune(c)
+		default:
+			buf.WriteByte('_')
+		}
+	}
+	if buf.Len() == 0 {
+		return "_"
+	}
+	return buf.String()
+}
+
+func avroColumnType(typ *types.Type) string {
+	switch typ.Oid {
+	case types.T_bool:
+		return avroBoolean
+	case types.T_int8, types.T_int16, types.T_int32, types.T_uint8, types.T_uint16, types.T_enum:
+		return avroInt
+	case types.T_int64, types.T_uint32, types.T_bit:
+		return avroLong
+	case types.T_float32:
+		return avroFloat
+	case types.T_float64:
+		return avroDouble
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return avroBytes
+	case types.T_array_float32:
+		return avroFloatArray
+	case types.T_array_float64:
+		return avroDoubleArray
+	default:
+		// uint64 does not fit in long, it is a string like decimals and times
+		return avroString
+	}
+}
+
+func avroTypeSchema(typ string) string {
+	switch typ {
+	case avroFloatArray:
+		return `{"type":"array","items":"float"}`
+	case avroDoubleArray:
+		return `{"type":"array","items":"double"}`
+	default:
+		return `"` + typ + `"`
+	}
+}
+
+// encode returns the envelope in avro single object encoding
+func (a *kafkaAvroSchema) encode(
+	before, after []any,
+	source *kafkaEventSource,
+	op string,
+	tsMs int64,
+	txn *kafkaEventTxn,
+) ([]byte, error) {
+	buf := make([]byte, 0, 256)
+	buf = append(buf, avroSingleObjectMarker...)
+	buf = binary.LittleEndian.AppendUint64(buf, a.fingerprint)
+
+	var err error
+	if buf, err = a.appendImage(buf, before); err != nil {
+		return nil, err
+	}
+	if buf, err = a.appendImage(buf, after); err != nil {
+		return nil, err
+	}
+
+	buf = avroAppendString(buf, source.Connector)
+	buf = avroAppendString(buf, source.Name)
+	buf = avroAppendLong(buf, source.TsMs)
+	buf = avroAppendString(buf, source.CommitTs)
+	buf = avroAppendString(buf, source.Snapshot)
+	buf = avroAppendString(buf, source.Db)
+	buf = avroAppendString(buf, source.Table)
+	buf = avroAppendString(buf, source.TaskId)
+
+	buf = avroAppendString(buf, op)
+	buf = avroAppendLong(buf, tsMs)
+
+	if txn == nil {
+		buf = avroAppendLong(buf, 0)
+	} else {
+		buf = avroAppendLong(buf, 1)
+		buf = avroAppendString(buf, txn.Id)
+		buf = avroAppendLong(buf, txn.TotalOrder)
+		buf = avroAppendLong(buf, txn.DataCollectionOrder)
+	}
+	return buf, nil
+}
+
+// appendImage appends the nullable Value record
+func (a *kafkaAvroSchema) appendImage(buf []byte, image []any) ([]byte, error) {
+	if image == nil {
+		return avroAppendLong(buf, 0), nil
+	}
+	buf = avroAppendLong(buf, 1)
+	for i, val := range image {
+		if val == nil {
+			buf = avroAppendLong(buf, 0)
+			continue
+		}
+		buf = avroAppendLong(buf, 1)
+		var err error
+		if buf, err = avroAppendValue(buf, a.colTypes[i], val); err != nil {
+			return nil, err
+		}
+	}
+	return buf, nil
+}
+
+func avroAppendValue(buf []byte, typ string, val any) ([]byte, error) {
+	switch typ {
+	case avroBoolean:
+		if v, ok := val.(bool); ok {
+			if v {
+				return append(buf, 1), nil
+			}
+			return append(buf, 0), nil
+		}
+	case avroInt, avroLong:
+		if v, ok := avroInt64(val); ok {
+			return avroAppendLong(buf, v), nil
+		}
+	case avroFloat:
+		if v, ok := val.(float32); ok {
+			return binary.LittleEndian.AppendUint32(buf, math.Float32bits(v)), nil
+		}
+	case avroDouble:
+		if v, ok := val.(float64); ok {
+			return binary.LittleEndian.AppendUint64(buf, math.Float64bits(v)), nil
+		}
+	case avroBytes:
+		if v, ok := val.([]byte); ok {
+			return avroAppendBytes(buf, v), nil
+		}
+	case avroString:
+		switch v := val.(type) {
+		case string:
+			return avroAppendString(buf, v), nil
+		case []byte:
+			return avroAppendBytes(buf, v), nil
+		default:
+			return avroAppendString(buf, fmt.Sprint(v)), nil
+		}
+	case avroFloatArray:
+		if v, ok := val.([]float32); ok {
+			if len(v) > 0 {
+				buf = avroAppendLong(buf, int64(len(v)))
+				for _, f := range v {
+					buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(f))
+				}
+			}
+			return avroAppendLong(buf, 0), nil
+		}
+	case avroDoubleArray:
+		if v, ok := val.([]float64); ok {
+			if len(v) > 0 {
+				buf = avroAppendLong(buf, int64(len(v)))
+				for _, f := range v {
+					buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(f))
+				}
+			}
+			return avroAppendLong(buf, 0), nil
+		}
+	}
+	return nil, moerr.NewInternalErrorNoCtx(fmt.Sprintf("cannot encode %T as avro %s", val, typ))
+}
+
+func avroInt64(val any) (int64, bool) {
+	switch v := val.(type) {
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case uint64:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// avroAppendLong appends the zig-zag varint encoding of int and long
+func avroAppendLong(buf []byte, v int64) []byte {
+	return binary.AppendUvarint(buf, uint64((v<<1)^(v>>63)))
+}
+
+func avroAppendBytes(buf []byte, v []byte) []byte {
+	buf = avroAppendLong(buf, int64(len(v)))
+	return append(buf, v...)
+}
+
+func avroAppendString(buf []byte, v string) []byte {
+	buf = avroAppendLong(buf, int64(len(v)))
+	return append(buf, v...)
+}
+
+const avroFingerprintEmpty uint64 = 0xc15d213aa4d7a795
+
+var avroFingerprintTable = func() (table [256]uint64) {
+	for i := range table {
+		fp := uint64(i)
+		for j := 0; j < 8; j++ {
+			fp = (fp >> 1) ^ (avroFingerprintEmpty & -(fp & 1))
+		}
+		table[i] = fp
+	}
+	return
+}()
+
+// avroFingerprint is the CRC-64-AVRO fingerprint of the schema in parsing canonical form
+func avroFingerprint(schema []byte) uint64 {
+	fp := avroFingerprintEmpty
+	for _, b := range schema {
+		fp = (fp >> 8) ^ avroFingerprintTable[byte(fp)^b]
+	}
+	return fp
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// kafkaEventEncoder turns the changes of a table into debezium-style change events.
+//
+// The key of an event is the json object of the primary key. The value is the
+// envelope {before, after, source, op, ts_ms, transaction} in json or in avro
+// single object encoding.
+//
+// Deletes only know the primary key, the other columns of the before image are null.
+type kafkaEventEncoder struct {
+	*changeDecoder
+
+	format string
+	// logical name of the sink, the topic prefix
+	name    string
+	taskId  string
+	dbName  string
+	tblName string
+
+	avro *kafkaAvroSchema
+}
+
+func newKafkaEventEncoder(
+	format string,
+	name string,
+	taskId string,
+	dbTblInfo *DbTableInfo,
+	tableDef *plan.TableDef,
+) *kafkaEventEncoder {
+	e := &kafkaEventEncoder{
+		changeDecoder: newChangeDecoder(tableDef),
+		format:        format,
+		name:          name,
+		taskId:        taskId,
+		dbName:        dbTblInfo.SourceDbName,
+		tblName:       dbTblInfo.SourceTblName,
+	}
+	if format == KafkaSinkFormat_Avro {
+		e.avro = newKafkaAvroSchema(name, e.dbName, e.tblName, e.cols)
+	}
+	return e
+}
+
+type kafkaEventSource struct {
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	CommitTs  string `json:"commit_ts"`
+	Snapshot  string `json:"snapshot"`
+	Db        string `json:"db"`
+	Table     string `json:"table"`
+	TaskId    string `json:"task_id"`
+}
+
+type kafkaEventTxn struct {
+	Id                  string `json:"id"`
+	TotalOrder          int64  `json:"total_order"`
+	DataCollectionOrder int64  `json:"data_collection_order"`
+}
+
+type kafkaEventEnvelope struct {
+	Before      json.RawMessage  `json:"before"`
+	After       json.RawMessage  `json:"after"`
+	Source      kafkaEventSource `json:"source"`
+	Op          string           `json:"op"`
+	TsMs        int64            `json:"ts_ms"`
+	Transaction *kafkaEventTxn   `json:"transaction"`
+}
+
+// encode returns the key and the value of the change event.
+// order is the position of the event in the transaction.
+func (e *kafkaEventEncoder) encode(row *changeRow, txnId string, order int64) (key, value []byte, err error) {
+	if row.key != nil {
+		pkNames := make([]string, len(e.pkIdxes))
+		for i, idx := range e.pkIdxes {
+			pkNames[i] = e.cols[idx].name
+		}
+		if key, err = changeJsonObject(pkNames, row.key); err != nil {
+			return
+		}
+	}
+
+	snapshot := "false"
+	if row.op == changeOpRead {
+		snapshot = "true"
+	}
+	source := kafkaEventSource{
+		Connector: kafkaSinkConnector,
+		Name:      e.name,
+		TsMs:      row.ts.Physical() / int64(time.Millisecond),
+		CommitTs:  row.ts.ToString(),
+		Snapshot:  snapshot,
+		Db:        e.dbName,
+		Table:     e.tblName,
+		TaskId:    e.taskId,
+	}
+	txn := &kafkaEventTxn{
+		Id:                  txnId,
+		TotalOrder:          order,
+		DataCollectionOrder: order,
+	}
+	tsMs := time.Now().UnixMilli()
+
+	if e.format == KafkaSinkFormat_Avro {
+		value, err = e.avro.encode(row.before, row.after, &source, row.op, tsMs, txn)
+		return
+	}
+
+	envelope := kafkaEventEnvelope{
+		Source:      source,
+		Op:          row.op,
+		TsMs:        tsMs,
+		Transaction: txn,
+	}
+	if row.before != nil {
+		if envelope.Before, err = e.jsonImage(row.before); err != nil {
+			return
+		}
+	}
+	if row.after != nil {
+		if envelope.After, err = e.jsonImage(row.after); err != nil {
+			return
+		}
+	}
+	value, err = json.Marshal(&envelope)
+	return
+}
+
+func (e *kafkaEventEncoder) jsonImage(image []any) (json.RawMessage, error) {
+	names := make([]string, len(e.cols))
+	for i := range e.cols {
+		names[i] = e.cols[i].name
+	}
+	return changeJsonObject(names, image)
+}
@@ -0,0 +1,356 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// postgresMaxParams is the max number of the bind parameters of a postgres statement
+const postgresMaxParams = 65535
+
+// postgresStatementBuilder builds the postgres statements applying the changes
+// decoded by the changeDecoder:
+//
+//   - inserts, updates and snapshot rows are upserted by
+//     INSERT ... ON CONFLICT (pk) DO UPDATE SET col = EXCLUDED.col
+//   - deletes are batched by the primary key, "pk" = ANY($1) for a single
+//     column primary key, unnest($1, $2, ...) for a composite one
+//
+// Consecutive changes of the same kind share a statement, so the statements
+// keep the commit ts order of the changes.
+type postgresStatementBuilder struct {
+	decoder *changeDecoder
+	// quoted "schema"."table"
+	table string
+	// enum values of the enum columns, aligned with the columns of the decoder
+	enumValues []string
+	notNull    []bool
+
+	// the upsert statement without the VALUES list
+	insertPrefix string
+	upsertSuffix string
+	deleteQuery  string
+	// max rows of an upsert statement
+	maxRows int
+}
+
+func newPostgresStatementBuilder(
+	ctx context.Context,
+	schema string,
+	table string,
+	tableDef *plan.TableDef,
+) (*postgresStatementBuilder, error) {
+	b := &postgresStatementBuilder{
+		decoder: newChangeDecoder(tableDef),
+		table:   quotePostgresIdent(schema) + "." + quotePostgresIdent(table),
+	}
+	if len(b.decoder.pkIdxes) == 0 {
+		return nil, moerr.NewInternalErrorf(ctx, "postgres sink requires a primary key, table %s has none", tableDef.Name)
+	}
+
+	for _, col := range tableDef.Cols {
+		if _, ok := catalog.InternalColumns[col.Name]; ok {
+			continue
+		}
+		b.enumValues = append(b.enumValues, col.Typ.Enumvalues)
+		b.notNull = append(b.notNull, col.Default != nil && !col.Default.NullAbility)
+	}
+
+	cols := b.decoder.cols
+	colNames := make([]string, len(cols))
+	for i := range cols {
+		colNames[i] = quotePostgresIdent(cols[i].name)
+	}
+	pkNames := make([]string, len(b.decoder.pkIdxes))
+	pkTypes := make([]string, len(b.decoder.pkIdxes))
+	isPk := make(map[int]bool, len(b.decoder.pkIdxes))
+	for i, idx := range b.decoder.pkIdxes {
+		pkNames[i] = colNames[idx]
+		typ, err := postgresColumnType(ctx, &cols[idx])
+		if err != nil {
+			return nil, err
+		}
+		pkTypes[i] = typ
+		isPk[idx] = true
+	}
+
+	b.insertPrefix = fmt.Sprintf("INSERT INTO %s (%s) VALUES ", b.table, strings.Join(colNames, ", "))
+	updates := make([]string, 0, len(cols))
+	for i := range cols {
+		if !isPk[i] {
+			updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", colNames[i], colNames[i]))
+		}
+	}
+	if len(updates) == 0 {
+		b.upsertSuffix = fmt.Sprintf(" ON CONFLICT (%s) DO NOTHING", strings.Join(pkNames, ", "))
+	} else {
+		b.upsertSuffix = fmt.Sprintf(" ON CONFLICT (%s) DO UPDATE SET %s",
+			strings.Join(pkNames, ", "), strings.Join(updates, ", "))
+	}
+
+	if len(pkNames) == 1 {
+		b.deleteQuery = fmt.Sprintf("DELETE FROM %s WHERE %s = ANY($1)", b.table, pkNames[0])
+	} else {
+		params := make([]string, len(pkNames))
+		for i := range pkNames {
+			params[i] = fmt.Sprintf("$%d::%s[]", i+1, pkTypes[i])
+		}
+		b.deleteQuery = fmt.Sprintf("DELETE FROM %s WHERE (%s) IN (SELECT * FROM unnest(%s))",
+			b.table, strings.Join(pkNames, ", "), strings.Join(params, ", "))
+	}
+
+	b.maxRows = postgresMaxParams / len(cols)
+	return b, nil
+}
+
+// createTableSQL returns the CREATE TABLE statement of the sink table
+func (b *postgresStatementBuilder) createTableSQL(ctx context.Context) (string, error) {
+	cols := b.decoder.cols
+	defs := make([]string, 0, len(cols)+1)
+	for i := range cols {
+		typ, err := postgresColumnType(ctx, &cols[i])
+		if err != nil {
+			return "", err
+		}
+		def := quotePostgresIdent(cols[i].name) + " " + typ
+		if b.notNull[i] {
+			def += " NOT NULL"
+		}
+		defs = append(defs, def)
+	}
+	pkNames := make([]string, len(b.decoder.pkIdxes))
+	for i, idx := range b.decoder.pkIdxes {
+		pkNames[i] = quotePostgresIdent(cols[idx].name)
+	}
+	defs = append(defs, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(pkNames, ", ")))
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (\n  %s\n)", b.table, strings.Join(defs, ",\n  ")), nil
+}
+
+// build builds the statements applying the changes in order
+func (b *postgresStatementBuilder) build(rows []changeRow) ([]sinkStatement, error) {
+	var (
+		stmts []sinkStatement
+		run   []changeRow
+		// primary keys of the upsert run, a row can not be upserted twice by a statement
+		keys     = make(map[string]struct{})
+		isDelete bool
+	)
+
+	flush := func() error {
+		if len(run) == 0 {
+			return nil
+		}
+		var err error
+		if isDelete {
+			stmts, err = b.appendDelete(stmts, run)
+		} else {
+			stmts, err = b.appendUpsert(stmts, run)
+		}
+		run = run[:0]
+		clear(keys)
+		return err
+	}
+
+	for i := range rows {
+		rowIsDelete := rows[i].op == changeOpDelete
+		if len(run) > 0 && rowIsDelete != isDelete {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+		isDelete = rowIsDelete
+		if !isDelete {
+			key := fmt.Sprintf("%#v", rows[i].key)
+			if _, ok := keys[key]; ok {
+				if err := flush(); err != nil {
+					return nil, err
+				}
+			}
+			keys[key] = struct{}{}
+		}
+		run = append(run, rows[i])
+		if len(run) >= b.maxRows {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return stmts, nil
+}
+
+func (b *postgresStatementBuilder) appendUpsert(stmts []sinkStatement, rows []changeRow) ([]sinkStatement, error) {
+	ncols := len(b.decoder.cols)
+	args := make([]any, 0, len(rows)*ncols)
+
+	var query strings.Builder
+	query.WriteString(b.insertPrefix)
+	for i := range rows {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteByte('(')
+		for j, val := range rows[i].after {
+			if j > 0 {
+				query.WriteString(", ")
+			}
+			pgVal, err := b.postgresValue(val, j)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, pgVal)
+			query.WriteByte('$')
+			query.WriteString(strconv.Itoa(len(args)))
+		}
+		query.WriteByte(')')
+	}
+	query.WriteString(b.upsertSuffix)
+
+	return append(stmts, sinkStatement{query: query.String(), args: args}), nil
+}
+
+func (b *postgresStatementBuilder) appendDelete(stmts []sinkStatement, rows []changeRow) ([]sinkStatement, error) {
+	pkIdxes := b.decoder.pkIdxes
+	args := make([]any, len(pkIdxes))
+	elems := make([]any, len(rows))
+	for i, idx := range pkIdxes {
+		for j := range rows {
+			pgVal, err := b.postgresValue(rows[j].key[i], idx)
+			if err != nil {
+				return nil, err
+			}
+			elems[j] = pgVal
+		}
+		args[i] = postgresArrayLiteral(elems)
+	}
+	return append(stmts, sinkStatement{query: b.deleteQuery, args: args}), nil
+}
+
+// postgresValue converts the value of changeValue into the bind parameter of the column
+func (b *postgresStatementBuilder) postgresValue(val any, colIdx int) (any, error) {
+	switch v := val.(type) {
+	case uint16:
+		if b.decoder.cols[colIdx].typ.Oid == types.T_enum {
+			return types.ParseEnumIndex(b.enumValues[colIdx], types.Enum(v))
+		}
+	case uint64:
+		// numeric(20, 0)
+		return strconv.FormatUint(v, 10), nil
+	}
+	return val, nil
+}
+
+// postgresColumnType maps the type of the column to the postgres type
+func postgresColumnType(ctx context.Context, col *changeColumn) (string, error) {
+	typ := &col.typ
+	switch typ.Oid {
+	case types.T_bool:
+		return "boolean", nil
+	case types.T_int8, types.T_int16, types.T_uint8:
+		return "smallint", nil
+	case types.T_int32, types.T_uint16:
+		return "integer", nil
+	case types.T_int64, types.T_uint32:
+		return "bigint", nil
+	case types.T_uint64, types.T_bit:
+		return "numeric(20, 0)", nil
+	case types.T_float32:
+		return "real", nil
+	case types.T_float64:
+		return "double precision", nil
+	case types.T_decimal64, types.T_decimal128, types.T_decimal256:
+		return fmt.Sprintf("numeric(%d, %d)", typ.Width, typ.Scale), nil
+	case types.T_char, types.T_varchar:
+		name := "varchar"
+		if typ.Oid == types.T_char {
+			name = "char"
+		}
+		if typ.Width <= 0 {
+			return "varchar", nil
+		}
+		return fmt.Sprintf("%s(%d)", name, typ.Width), nil
+	case types.T_text, types.T_datalink, types.T_enum:
+		return "text", nil
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return "bytea", nil
+	case types.T_json:
+		return "jsonb", nil
+	case types.T_uuid:
+		return "uuid", nil
+	case types.T_date:
+		return "date", nil
+	case types.T_time:
+		return fmt.Sprintf("time(%d)", typ.Scale), nil
+	case types.T_datetime:
+		return fmt.Sprintf("timestamp(%d)", typ.Scale), nil
+	case types.T_timestamp:
+		return fmt.Sprintf("timestamptz(%d)", typ.Scale), nil
+	case types.T_array_float32:
+		return "real[]", nil
+	case types.T_array_float64:
+		return "double precision[]", nil
+	}
+	return "", moerr.NewNotSupportedf(ctx, "postgres sink does not support column %s of type %s", col.name, typ.String())
+}
+
+func quotePostgresIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// postgresArrayLiteral formats the primary key values as the text of a postgres array,
+// the text format bind parameter is cast to the array type of the column by the server
+func postgresArrayLiteral(values []any) string {
+	var buf strings.Builder
+	buf.WriteByte('{')
+	for i, val := range values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		var elem string
+		switch v := val.(type) {
+		case nil:
+			buf.WriteString("NULL")
+			continue
+		case []byte:
+			elem = `\x` + hex.EncodeToString(v)
+		case string:
+			elem = v
+		default:
+			elem = fmt.Sprint(v)
+		}
+		buf.WriteByte('"')
+		for _, c := range []byte(elem) {
+			if c == '"' || c == '\\' {
+				buf.WriteByte('\\')
+			}
+			buf.WriteByte(c)
+		}
+		buf.WriteByte('"')
+	}
+	buf.WriteByte('}')
+	return buf.String()
+}
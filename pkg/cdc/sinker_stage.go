@@ -0,0 +1,667 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"go.uber.org/zap"
+)
+
+// parameters of the stage sink uri
+const (
+	StageSinkParam_Format        = "format"
+	StageSinkParam_Window        = "window"
+	StageSinkParam_FlushInterval = "flush_interval"
+	StageSinkParam_MaxFileSize   = "max_file_size"
+)
+
+const (
+	StageSinkFormat_Parquet = "parquet"
+	StageSinkFormat_Jsonl   = "jsonl"
+)
+
+const (
+	stageSinkDefaultWindow        = time.Hour
+	stageSinkDefaultFlushInterval = time.Minute
+	stageSinkDefaultMaxFileSize   = 128 << 20
+	stageSinkManifestDir          = "_manifest"
+	stageSinkWindowLayout         = "20060102T150405Z"
+
+	// columns added to the changes in the change files
+	stageSinkOpColumn       = "_op"
+	stageSinkCommitTsColumn = "_commit_ts"
+)
+
+var stageSinkParams = map[string]bool{
+	StageSinkParam_Format:        true,
+	StageSinkParam_Window:        true,
+	StageSinkParam_FlushInterval: true,
+	StageSinkParam_MaxFileSize:   true,
+}
+
+/*
+ExtractStageUriInfo extracts the uriInfo of the stage sink uri in the format:
+
+	stage://stage_name[/sub/path][?format=parquet|jsonl&window=1h&flush_interval=1m&max_file_size=134217728]
+
+The stage is resolved into the file service path by the caller, because
+it needs the catalog of the account creating the task.
+
+return serialized uriInfo
+*/
+func ExtractStageUriInfo(
+	ctx context.Context,
+	uri string,
+) (string, UriInfo, error) {
+	invalid := func() (string, UriInfo, error) {
+		return "", UriInfo{}, moerr.NewInternalErrorf(ctx, "invalid uri format: %s", uri)
+	}
+	if !uriHasPrefix(uri, CDCStageSinkUriPrefix) {
+		return invalid()
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil || u.Host == "" {
+		return invalid()
+	}
+
+	info := UriInfo{}
+	if u.RawQuery != "" {
+		values, err := url.ParseQuery(u.RawQuery)
+		if err != nil {
+			return invalid()
+		}
+		info.Params = make(map[string]string, len(values))
+		for key := range values {
+			if !stageSinkParams[key] {
+				return "", UriInfo{}, moerr.NewInternalErrorf(ctx, "unsupported stage sink parameter: %s", key)
+			}
+			info.Params[key] = values.Get(key)
+		}
+	}
+	u.RawQuery = ""
+	info.Stage = u.String()
+
+	switch format := info.Params[StageSinkParam_Format]; format {
+	case "", StageSinkFormat_Parquet, StageSinkFormat_Jsonl:
+	default:
+		return "", UriInfo{}, moerr.NewInternalErrorf(ctx, "unsupported stage sink format: %s", format)
+	}
+	if window, ok := info.Params[StageSinkParam_Window]; ok {
+		if d, err := time.ParseDuration(window); err != nil || d < time.Second {
+			return "", UriInfo{}, moerr.NewInternalErrorf(ctx, "invalid stage sink window: %s", window)
+		}
+	}
+	if interval, ok := info.Params[StageSinkParam_FlushInterval]; ok {
+		if d, err := time.ParseDuration(interval); err != nil || d < 0 {
+			return "", UriInfo{}, moerr.NewInternalErrorf(ctx, "invalid stage sink flush interval: %s", interval)
+		}
+	}
+	if size, ok := info.Params[StageSinkParam_MaxFileSize]; ok {
+		if n, err := strconv.ParseInt(size, 10, 64); err != nil || n <= 0 {
+			return "", UriInfo{}, moerr.NewInternalErrorf(ctx, "invalid stage sink max file size: %s", size)
+		}
+	}
+
+	jsonUriInfo, err := JsonEncode(&info)
+	if err != nil {
+		return "", UriInfo{}, err
+	}
+	return jsonUriInfo, info, nil
+}
+
+func stageSinkFormat(info *UriInfo) string {
+	if format := info.Params[StageSinkParam_Format]; format != "" {
+		return format
+	}
+	return StageSinkFormat_Parquet
+}
+
+func stageSinkWindow(info *UriInfo) time.Duration {
+	if window, err := time.ParseDuration(info.Params[StageSinkParam_Window]); err == nil {
+		return window
+	}
+	return stageSinkDefaultWindow
+}
+
+func stageSinkFlushInterval(info *UriInfo) time.Duration {
+	if interval, err := time.ParseDuration(info.Params[StageSinkParam_FlushInterval]); err == nil {
+		return interval
+	}
+	return stageSinkDefaultFlushInterval
+}
+
+func stageSinkMaxFileSize(info *UriInfo) int {
+	if size, err := strconv.ParseInt(info.Params[StageSinkParam_MaxFileSize], 10, 64); err == nil {
+		return int(size)
+	}
+	return stageSinkDefaultMaxFileSize
+}
+
+// CheckStageSink checks the resolved path of the stage sink is a valid file service path
+func CheckStageSink(ctx context.Context, info *UriInfo) error {
+	fs, _, err := fileservice.GetForETL(ctx, nil, info.StagePath)
+	if err != nil {
+		return err
+	}
+	fs.Close(ctx)
+	return nil
+}
+
+// Compile-time check that stageSinker implements BufferedSinker interface
+var _ BufferedSinker = (*stageSinker)(nil)
+
+// stageSinker writes the changes of a table into a stage as change files,
+// one open file per time window of the commit ts:
+//
+//	<db>/<table>/window=<start of the window>/<minTs>_<maxTs>_<n>.parquet|jsonl
+//	<db>/<table>/_manifest/<toTs>.json
+//
+// The rows of the change files are the after images of the changes, the
+// before images for deletes, with the _op and _commit_ts columns.
+//
+// The changes are encoded into the open files as they are sunk. A file is
+// closed and written when it reaches the max file size, and all open files
+// are once the flush interval elapsed, both at the end of a transaction only.
+// The manifest then lists the files written since the last one, a change file
+// not in any manifest is a leftover of a failed flush.
+//
+// The watermark is held back at the toTs of the last manifest (DurableTs)
+// while committed changes are in open files, and the stream reads on from
+// the changes taken (BufferedTs):
+//   - on start, the sinker lists the manifests for the toTs of the last one
+//   - the changes not after it are dropped from the replayed transactions
+//   - on rollback the open files are dropped and the changes after it are
+//     read again
+type stageSinker struct {
+	fs               fileservice.ETLFileService
+	accountId        uint64
+	taskId           string
+	dbTblInfo        *DbTableInfo
+	watermarkUpdater *CDCWatermarkUpdater
+	decoder          *changeDecoder
+
+	format        string
+	window        time.Duration
+	flushInterval time.Duration
+	maxFileSize   int
+	// root of the files of the table in the file service
+	tableDir string
+
+	// committedTs is the toTs of the last manifest of the table, or of the
+	// changes taken when no change file is pending
+	committedTs types.TS
+	// bufferedTs is the toTs of the last changes taken
+	bufferedTs types.TS
+
+	// the open transaction
+	txnActive bool
+	toTs      types.TS
+	rows      []changeRow
+
+	// the open change files by the start of their window
+	openFiles map[time.Time]*stageChangeFile
+	// the change files written since the last manifest
+	doneFiles []stageManifestFile
+	// fromTs of the first changes after the last manifest
+	fromTs types.TS
+	// when the first change file after the last manifest was opened
+	openedAt time.Time
+
+	err atomic.Pointer[error]
+}
+
+// stageChangeFile is an open change file
+type stageChangeFile struct {
+	window  string
+	encoder changeFileEncoder
+	rows    int64
+	minTs   types.TS
+	maxTs   types.TS
+}
+
+// stageManifest is the manifest of the change files written by a flush
+type stageManifest struct {
+	TaskId    string              `json:"task_id"`
+	Db        string              `json:"db"`
+	Table     string              `json:"table"`
+	Format    string              `json:"format"`
+	FromTs    string              `json:"from_ts"`
+	ToTs      string              `json:"to_ts"`
+	Files     []stageManifestFile `json:"files"`
+	CreatedAt string              `json:"created_at"`
+}
+
+type stageManifestFile struct {
+	// path relative to the directory of the table
+	Path        string `json:"path"`
+	Window      string `json:"window"`
+	Rows        int64  `json:"rows"`
+	Size        int64  `json:"size"`
+	MinCommitTs string `json:"min_commit_ts"`
+	MaxCommitTs string `json:"max_commit_ts"`
+}
+
+// CreateStageSinker creates the stage sinker of a table
+// Defined as var for test mocking
+var CreateStageSinker = func(
+	sinkUri UriInfo,
+	accountId uint64,
+	taskId string,
+	dbTblInfo *DbTableInfo,
+	watermarkUpdater *CDCWatermarkUpdater,
+	tableDef *plan.TableDef,
+) (Sinker, error) {
+	ctx := context.Background()
+	if tableDef == nil {
+		return nil, moerr.NewInternalErrorNoCtx("tableDef is required")
+	}
+	if tableDef.TableType == catalog.SystemClusterRel {
+		return nil, moerr.NewInternalErrorNoCtx("cluster table is not supported")
+	}
+	if tableDef.TableType == catalog.SystemExternalRel {
+		return nil, moerr.NewInternalErrorNoCtx("external table is not supported")
+	}
+	// the files of a recreated table are kept, nothing to drop
+	dbTblInfo.IdChanged = false
+
+	fs, readPath, err := fileservice.GetForETL(ctx, nil, sinkUri.StagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &stageSinker{
+		fs:               fs,
+		accountId:        accountId,
+		taskId:           taskId,
+		dbTblInfo:        dbTblInfo,
+		watermarkUpdater: watermarkUpdater,
+		decoder:          newChangeDecoder(tableDef),
+		format:           stageSinkFormat(&sinkUri),
+		window:           stageSinkWindow(&sinkUri),
+		flushInterval:    stageSinkFlushInterval(&sinkUri),
+		maxFileSize:      stageSinkMaxFileSize(&sinkUri),
+		tableDir:         path.Join(readPath, dbTblInfo.SinkDbName, dbTblInfo.SinkTblName),
+		openFiles:        make(map[time.Time]*stageChangeFile),
+	}
+
+	if err = s.recoverCommittedTs(ctx); err != nil {
+		fs.Close(ctx)
+		return nil, err
+	}
+	// the changes up to the watermark are durable as well, they were in a
+	// manifest or in no change file at all
+	if s.watermarkUpdater != nil {
+		if watermark, err := s.watermarkUpdater.GetFromCache(ctx, s.watermarkKey()); err == nil &&
+			watermark.GT(&s.committedTs) {
+			s.committedTs = watermark
+		}
+	}
+	s.bufferedTs = s.committedTs
+
+	logutil.Info("cdc.stage_sinker.created",
+		zap.String("task-id", taskId),
+		zap.String("table", dbTblInfo.String()),
+		zap.String("stage", sinkUri.Stage),
+		zap.String("format", s.format),
+		zap.Duration("window", s.window),
+		zap.Duration("flush-interval", s.flushInterval),
+		zap.Int("max-file-size", s.maxFileSize),
+		zap.String("committed-ts", s.committedTs.ToString()),
+	)
+	return s, nil
+}
+
+// recoverCommittedTs finds the toTs of the last manifest of the table
+func (s *stageSinker) recoverCommittedTs(ctx context.Context) error {
+	for entry, err := range s.fs.List(ctx, path.Join(s.tableDir, stageSinkManifestDir)) {
+		if err != nil {
+			if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir {
+			continue
+		}
+		ts, ok := parseChangeTs(strings.TrimSuffix(entry.Name, ".json"))
+		if !ok {
+			continue
+		}
+		if ts.GT(&s.committedTs) {
+			s.committedTs = ts
+		}
+	}
+	return nil
+}
+
+func (s *stageSinker) Run(_ context.Context, _ *ActiveRoutine) {}
+
+func (s *stageSinker) watermarkKey() *WatermarkKey {
+	return &WatermarkKey{
+		AccountId: s.accountId,
+		TaskId:    s.taskId,
+		DBName:    s.dbTblInfo.SourceDbName,
+		TableName: s.dbTblInfo.SourceTblName,
+	}
+}
+
+// DurableTs returns the toTs of the last manifest, or of the changes taken
+// when no change file is pending
+func (s *stageSinker) DurableTs() types.TS {
+	return s.committedTs
+}
+
+// BufferedTs returns the toTs of the changes taken into the change files
+func (s *stageSinker) BufferedTs() types.TS {
+	return s.bufferedTs
+}
+
+// Sink encodes the changes into the open change files of their windows.
+// Changes sunk without SendBegin (split snapshot) are taken by the following
+// no more data heartbeat.
+func (s *stageSinker) Sink(ctx context.Context, data *DecoderOutput) {
+	defer data.Close()
+
+	if s.Error() != nil {
+		return
+	}
+
+	if s.watermarkUpdater != nil {
+		watermark, err := s.watermarkUpdater.GetFromCache(ctx, s.watermarkKey())
+		if err != nil {
+			s.SetError(err)
+			return
+		}
+		if data.toTs.LT(&watermark) {
+			logutil.Error("cdc.stage_sinker.unexpected_watermark",
+				zap.String("table", s.dbTblInfo.String()),
+				zap.String("toTs", data.toTs.ToString()),
+				zap.String("watermark", watermark.ToString()))
+			s.SetError(moerr.NewInternalError(ctx, "unexpected watermark"))
+			return
+		}
+	}
+
+	if data.noMoreData {
+		// the open transaction is committed up to the heartbeat
+		if s.txnActive {
+			s.toTs = data.toTs
+		} else {
+			s.take(ctx, data.toTs)
+		}
+		return
+	}
+
+	if len(s.openFiles) == 0 && len(s.doneFiles) == 0 {
+		s.fromTs = data.fromTs
+	}
+	s.toTs = data.toTs
+
+	var err error
+	rows := s.rows[:0]
+	switch data.outputTyp {
+	case OutputTypeSnapshot:
+		rows, err = s.decoder.decodeSnapshot(ctx, rows, data.checkpointBat, data.toTs)
+	case OutputTypeTail:
+		rows, err = s.decoder.decodeTail(ctx, rows, data.insertAtmBatch, data.deleteAtmBatch)
+	default:
+		logutil.Error("cdc.stage_sinker.unknown_output_type",
+			zap.String("table", s.dbTblInfo.String()),
+			zap.String("outputType", data.outputTyp.String()))
+	}
+	if err == nil {
+		err = s.encode(ctx, rows)
+	}
+	clear(rows)
+	s.rows = rows[:0]
+	if err != nil {
+		s.SetError(err)
+	}
+}
+
+func (s *stageSinker) SendBegin() {
+	if s.Error() != nil {
+		return
+	}
+	s.txnActive = true
+}
+
+func (s *stageSinker) SendCommit() {
+	if s.Error() != nil || !s.txnActive {
+		return
+	}
+	s.txnActive = false
+	s.take(context.Background(), s.toTs)
+}
+
+// SendRollback drops the change files not in any manifest, the changes after
+// the last manifest are read again
+func (s *stageSinker) SendRollback() {
+	s.txnActive = false
+	clear(s.openFiles)
+	s.doneFiles = nil
+	s.fromTs = types.TS{}
+	s.bufferedTs = s.committedTs
+}
+
+// SendDummy does nothing, the sinker writes synchronously
+func (s *stageSinker) SendDummy() {}
+
+// encode encodes the changes into the open change files of their windows,
+// keeping the commit order in every window. A replay from an older watermark
+// starts with the changes already taken, they are dropped.
+func (s *stageSinker) encode(ctx context.Context, rows []changeRow) error {
+	for len(rows) > 0 {
+		if !rows[0].ts.GT(&s.bufferedTs) {
+			rows = rows[1:]
+			continue
+		}
+		start := time.Unix(0, rows[0].ts.Physical()).UTC().Truncate(s.window)
+		n := 1
+		for n < len(rows) && rows[n].ts.GT(&s.bufferedTs) &&
+			time.Unix(0, rows[n].ts.Physical()).UTC().Truncate(s.window).Equal(start) {
+			n++
+		}
+
+		file := s.openFiles[start]
+		if file == nil {
+			if len(s.openFiles) == 0 && len(s.doneFiles) == 0 {
+				s.openedAt = time.Now()
+			}
+			file = &stageChangeFile{
+				window:  start.Format(stageSinkWindowLayout),
+				encoder: newChangeFileEncoder(s.format, s.decoder.cols),
+				minTs:   rows[0].ts,
+			}
+			s.openFiles[start] = file
+		}
+		if err := file.encoder.encode(rows[:n]); err != nil {
+			return err
+		}
+		file.rows += int64(n)
+		file.maxTs = rows[n-1].ts
+		if file.encoder.size() >= s.maxFileSize {
+			if err := s.closeFile(ctx, start, file); err != nil {
+				return err
+			}
+		}
+		rows = rows[n:]
+	}
+	return nil
+}
+
+// closeFile writes the open change file of the window
+func (s *stageSinker) closeFile(ctx context.Context, start time.Time, file *stageChangeFile) error {
+	data, err := file.encoder.finish()
+	if err != nil {
+		return err
+	}
+	fileName := fmt.Sprintf("%s_%s_%d.%s", file.minTs.ToString(), file.maxTs.ToString(), len(s.doneFiles), s.format)
+	relPath := path.Join("window="+file.window, fileName)
+	if err = s.write(ctx, relPath, data); err != nil {
+		return err
+	}
+	s.doneFiles = append(s.doneFiles, stageManifestFile{
+		Path:        relPath,
+		Window:      file.window,
+		Rows:        file.rows,
+		Size:        int64(len(data)),
+		MinCommitTs: file.minTs.ToString(),
+		MaxCommitTs: file.maxTs.ToString(),
+	})
+	delete(s.openFiles, start)
+	return nil
+}
+
+// take takes the changes up to toTs at the end of a transaction. Once the
+// flush interval elapsed, or a change file was written at the max file size,
+// the open change files are written and then the manifest.
+func (s *stageSinker) take(ctx context.Context, toTs types.TS) {
+	if toTs.GT(&s.bufferedTs) {
+		s.bufferedTs = toTs
+	}
+	if len(s.openFiles) == 0 && len(s.doneFiles) == 0 {
+		s.committedTs = s.bufferedTs
+		return
+	}
+	if len(s.doneFiles) == 0 && time.Since(s.openedAt) < s.flushInterval {
+		return
+	}
+	if err := s.flush(ctx); err != nil {
+		s.SetError(err)
+	}
+}
+
+// flush writes the open change files, then the manifest of the change files
+// written since the last one
+func (s *stageSinker) flush(ctx context.Context) error {
+	windows := make([]time.Time, 0, len(s.openFiles))
+	for start := range s.openFiles {
+		windows = append(windows, start)
+	}
+	slices.SortFunc(windows, time.Time.Compare)
+	for _, start := range windows {
+		if err := s.closeFile(ctx, start, s.openFiles[start]); err != nil {
+			return err
+		}
+	}
+
+	manifest := stageManifest{
+		TaskId:    s.taskId,
+		Db:        s.dbTblInfo.SinkDbName,
+		Table:     s.dbTblInfo.SinkTblName,
+		Format:    s.format,
+		FromTs:    s.fromTs.ToString(),
+		ToTs:      s.bufferedTs.ToString(),
+		Files:     s.doneFiles,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	data, err := json.Marshal(&manifest)
+	if err != nil {
+		return err
+	}
+	if err = s.write(ctx, path.Join(stageSinkManifestDir, manifest.ToTs+".json"), data); err != nil {
+		return err
+	}
+	s.committedTs = s.bufferedTs
+	s.doneFiles = nil
+	s.fromTs = types.TS{}
+
+	logutil.Debug("cdc.stage_sinker.flushed",
+		zap.String("table", s.dbTblInfo.String()),
+		zap.String("to-ts", manifest.ToTs),
+		zap.Int("files", len(manifest.Files)),
+	)
+	return nil
+}
+
+// write writes the file under the directory of the table, replacing the
+// leftover of a failed flush
+func (s *stageSinker) write(ctx context.Context, relPath string, data []byte) error {
+	filePath := path.Join(s.tableDir, relPath)
+	vec := fileservice.IOVector{
+		FilePath: filePath,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   int64(len(data)),
+				Data:   data,
+			},
+		},
+	}
+	err := s.fs.Write(ctx, vec)
+	if moerr.IsMoErrCode(err, moerr.ErrFileAlreadyExists) {
+		if err = s.fs.Delete(ctx, filePath); err != nil {
+			return err
+		}
+		err = s.fs.Write(ctx, vec)
+	}
+	return err
+}
+
+func (s *stageSinker) Error() error {
+	errPtr := s.err.Load()
+	if errPtr == nil {
+		return nil
+	}
+	return *errPtr
+}
+
+func (s *stageSinker) SetError(err error) {
+	if err == nil {
+		s.err.Store(nil)
+		return
+	}
+	if _, ok := err.(*moerr.Error); !ok {
+		err = moerr.ConvertGoError(context.Background(), err)
+	}
+	s.err.Store(&err)
+}
+
+func (s *stageSinker) ClearError() {
+	s.err.Store(nil)
+}
+
+func (s *stageSinker) Reset() {
+	s.SendRollback()
+	s.ClearError()
+}
+
+// Close drops the change files not in any manifest, the watermark is not
+// after the changes in them
+func (s *stageSinker) Close() {
+	s.SendRollback()
+	if s.fs != nil {
+		s.fs.Close(context.Background())
+		s.fs = nil
+	}
+}
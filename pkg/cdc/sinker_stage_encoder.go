@@ -0,0 +1,152 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/util/parquetexport"
+	"github.com/parquet-go/parquet-go"
+)
+
+// rows of a parquet change file buffered before they are flushed as a row group
+const stageSinkRowGroupRows = 64 * 1024
+
+// changeFileImage returns the image of the change written into the change
+// files, the before image for deletes
+func changeFileImage(row *changeRow) []any {
+	if row.after != nil {
+		return row.after
+	}
+	return row.before
+}
+
+// changeFileEncoder encodes the changes of a change file as they are sunk
+type changeFileEncoder interface {
+	encode(rows []changeRow) error
+	// size is the size of the encoded changes so far
+	size() int
+	// finish returns the content of the change file
+	finish() ([]byte, error)
+}
+
+func newChangeFileEncoder(format string, cols []changeColumn) changeFileEncoder {
+	if format == StageSinkFormat_Jsonl {
+		return newJsonlChangeEncoder(cols)
+	}
+	return newParquetChangeEncoder(cols)
+}
+
+// jsonlChangeEncoder encodes the changes as json lines
+type jsonlChangeEncoder struct {
+	names  []string
+	values []any
+	buf    bytes.Buffer
+}
+
+func newJsonlChangeEncoder(cols []changeColumn) *jsonlChangeEncoder {
+	names := make([]string, 0, len(cols)+2)
+	names = append(names, stageSinkOpColumn, stageSinkCommitTsColumn)
+	for i := range cols {
+		names = append(names, cols[i].name)
+	}
+	return &jsonlChangeEncoder{
+		names:  names,
+		values: make([]any, len(names)),
+	}
+}
+
+func (e *jsonlChangeEncoder) encode(rows []changeRow) error {
+	for i := range rows {
+		e.values[0] = rows[i].op
+		e.values[1] = rows[i].ts.ToString()
+		copy(e.values[2:], changeFileImage(&rows[i]))
+		line, err := changeJsonObject(e.names, e.values)
+		if err != nil {
+			return err
+		}
+		e.buf.Write(line)
+		e.buf.WriteByte('\n')
+	}
+	return nil
+}
+
+func (e *jsonlChangeEncoder) size() int {
+	return e.buf.Len()
+}
+
+func (e *jsonlChangeEncoder) finish() ([]byte, error) {
+	return e.buf.Bytes(), nil
+}
+
+// parquetChangeEncoder encodes the changes as a parquet file, the columns are
+// mapped to parquet types the same way as SELECT ... INTO OUTFILE does
+type parquetChangeEncoder struct {
+	cols      []changeColumn
+	buf       bytes.Buffer
+	writer    *parquet.GenericWriter[any]
+	unflushed int
+}
+
+func newParquetChangeEncoder(cols []changeColumn) *parquetChangeEncoder {
+	group := make(parquet.Group)
+	group[stageSinkOpColumn] = parquet.String()
+	group[stageSinkCommitTsColumn] = parquet.String()
+	for i := range cols {
+		group[cols[i].name] = parquetexport.Node(cols[i].typ.Oid)
+	}
+	e := &parquetChangeEncoder{cols: cols}
+	e.writer = parquet.NewGenericWriter[any](&e.buf, parquet.NewSchema("cdc", group))
+	return e
+}
+
+func (e *parquetChangeEncoder) encode(rows []changeRow) error {
+	pqRows := make([]any, len(rows))
+	for i := range rows {
+		pqRow := make(map[string]any, len(e.cols)+2)
+		pqRow[stageSinkOpColumn] = rows[i].op
+		pqRow[stageSinkCommitTsColumn] = rows[i].ts.ToString()
+		for j, val := range changeFileImage(&rows[i]) {
+			v, err := parquetexport.Value(val, &e.cols[j].typ, time.UTC)
+			if err != nil {
+				return err
+			}
+			pqRow[e.cols[j].name] = v
+		}
+		pqRows[i] = pqRow
+	}
+	if _, err := e.writer.Write(pqRows); err != nil {
+		return err
+	}
+	// the buffered rows are not counted in the size until they are flushed
+	e.unflushed += len(rows)
+	if e.unflushed >= stageSinkRowGroupRows {
+		e.unflushed = 0
+		return e.writer.Flush()
+	}
+	return nil
+}
+
+func (e *parquetChangeEncoder) size() int {
+	return e.buf.Len()
+}
+
+func (e *parquetChangeEncoder) finish() ([]byte, error) {
+	if err := e.writer.Close(); err != nil {
+		return nil, err
+	}
+	return e.buf.Bytes(), nil
+}
@@ -16,10 +16,12 @@ package cdc
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -338,3 +340,54 @@ func TestRecordTxn(t *testing.T) {
 
 	_ = moerr.NewInvalidStateNoCtxf("for test coverage")
 }
+
+func TestApplySinkDDL(t *testing.T) {
+	ctx := context.Background()
+	stmts := []string{
+		"ALTER TABLE `db`.`t` RENAME COLUMN `b` TO `c`",
+		"ALTER TABLE `db`.`t` ADD INDEX `idx` (`c`)",
+	}
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	stub := gostub.Stub(&OpenDbConn, func(string, string, string, int, string) (*sql.DB, error) {
+		return db, nil
+	})
+	defer stub.Reset()
+
+	mock.ExpectExec(stmts[0]).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(stmts[1]).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectClose()
+	assert.NoError(t, ApplySinkDDL(ctx, UriInfo{SinkTyp: CDCSinkType_MySQL}, stmts, CDCDefaultSendSqlTimeout))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	// nothing to alter
+	assert.NoError(t, ApplySinkDDL(ctx, UriInfo{SinkTyp: CDCSinkType_Kafka}, stmts, CDCDefaultSendSqlTimeout))
+	assert.NoError(t, ApplySinkDDL(ctx, UriInfo{SinkTyp: CDCSinkType_Postgres}, stmts[1:], CDCDefaultSendSqlTimeout))
+
+	// the rename is translated for postgres and applied in a transaction
+	pgDb, pgMock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	pgStub := gostub.Stub(&OpenPostgresConn, func(
+		_, _ string, _ string, _ int, _ string, _ map[string]string, _ string,
+	) (*sql.DB, error) {
+		return pgDb, nil
+	})
+	defer pgStub.Reset()
+	pgMock.ExpectBegin()
+	pgMock.ExpectExec(`ALTER TABLE "db"."t" RENAME COLUMN "b" TO "c"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	pgMock.ExpectCommit()
+	pgMock.ExpectClose()
+	assert.NoError(t, ApplySinkDDL(ctx, UriInfo{SinkTyp: CDCSinkType_Postgres}, stmts, CDCDefaultSendSqlTimeout))
+	assert.NoError(t, pgMock.ExpectationsWereMet())
+
+	// the column changes are not inplace
+	for _, stmt := range []string{
+		"ALTER TABLE `db`.`t` ADD COLUMN `d` INT",
+		"ALTER TABLE `db`.`t` DROP COLUMN `b`",
+		"DROP TABLE `db`.`t`",
+	} {
+		err = ApplySinkDDL(ctx, UriInfo{SinkTyp: CDCSinkType_Postgres}, []string{stmt}, CDCDefaultSendSqlTimeout)
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported), stmt)
+	}
+}
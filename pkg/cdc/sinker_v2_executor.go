@@ -26,6 +26,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/matrixorigin/matrixone/pkg/cdc/retry"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -150,6 +151,9 @@ type Executor struct {
 	ip             string
 	port           int
 	timeout        string
+	// database and connection parameters of the postgres sink
+	database string
+	params   map[string]string
 
 	// Retry configuration
 	retryTimes    int           // -1 for infinite retry
@@ -199,9 +203,48 @@ func NewExecutor(
 	return e, nil
 }
 
+// NewPostgresExecutor creates a new Executor with the connection to the postgres sink
+func NewPostgresExecutor(
+	info *UriInfo,
+	retryTimes int,
+	retryDuration time.Duration,
+	timeout string,
+	doRecord bool,
+) (*Executor, error) {
+	e := &Executor{
+		user:          info.User,
+		password:      info.Password,
+		ip:            info.Ip,
+		port:          info.Port,
+		database:      info.Database,
+		params:        info.Params,
+		retryTimes:    retryTimes,
+		retryDuration: retryDuration,
+		timeout:       timeout,
+		sinkLabel:     CDCSinkType_Postgres,
+	}
+	e.debugTxnRecorder.doRecord = doRecord
+
+	if err := e.Connect(); err != nil {
+		return nil, err
+	}
+
+	e.initRetryPolicy()
+
+	return e, nil
+}
+
 // Connect establishes a database connection
 func (e *Executor) Connect() error {
-	conn, err := OpenDbConn(e.user, e.password, e.ip, e.port, e.timeout)
+	var (
+		conn *sql.DB
+		err  error
+	)
+	if e.sinkLabel == CDCSinkType_Postgres {
+		conn, err = OpenPostgresConn(e.user, e.password, e.ip, e.port, e.database, e.params, e.timeout)
+	} else {
+		conn, err = OpenDbConn(e.user, e.password, e.ip, e.port, e.timeout)
+	}
 	if err != nil {
 		return err
 	}
@@ -322,6 +365,55 @@ func (e *Executor) ExecSQL(
 	return e.execWithRetry(ctx, ar, execFunc)
 }
 
+// sinkStatement is a parameterized statement of the sink
+type sinkStatement struct {
+	query string
+	args  []any
+}
+
+// ExecTxStatements executes the parameterized statements in a transaction of its own
+//
+// The whole transaction is retried on retryable errors, because a failed
+// statement aborts the transaction of a postgres sink.
+// Returns error if a transaction of BeginTx is active.
+func (e *Executor) ExecTxStatements(
+	ctx context.Context,
+	ar *ActiveRoutine,
+	stmts []sinkStatement,
+) error {
+	if e.tx != nil {
+		return moerr.NewInternalError(ctx, "cannot execute statements while transaction is active")
+	}
+	if len(stmts) == 0 {
+		return nil
+	}
+
+	execFunc := func() error {
+		if err := e.ensureConnection(ctx); err != nil {
+			return err
+		}
+
+		e.resetRecordedTxn()
+		tx, err := e.conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		for i := range stmts {
+			e.recordTxnQuery(stmts[i].query)
+			if _, err = tx.ExecContext(ctx, stmts[i].query, stmts[i].args...); err != nil {
+				e.logFailedQuery(err, stmts[i].query)
+				_ = tx.Rollback()
+				return err
+			}
+		}
+		err = tx.Commit()
+		e.resetRecordedTxn()
+		return err
+	}
+
+	return e.execWithRetry(ctx, ar, execFunc)
+}
+
 func (e *Executor) execWithRetry(
 	ctx context.Context,
 	ar *ActiveRoutine,
@@ -461,6 +553,12 @@ func (e *Executor) initRetryPolicy() {
 		retry.DefaultClassifier{},
 		retry.MySQLErrorClassifier{},
 	}
+	if e.sinkLabel == CDCSinkType_Postgres {
+		classifier = retry.MultiClassifier{
+			retry.DefaultClassifier{},
+			retry.PostgresErrorClassifier{},
+		}
+	}
 
 	e.retryClassifier = classifier
 	e.retryPolicy = retry.Policy{
@@ -527,6 +625,11 @@ func classifyRetryReason(err error) string {
 		return "mysql_" + strconv.FormatUint(uint64(mysqlErr.Number), 10)
 	}
 
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return "postgres_" + pgErr.Code
+	}
+
 	return "unknown"
 }
 
@@ -593,6 +696,17 @@ func (e *Executor) recordTxnSQL(sqlBuf []byte) {
 	)
 }
 
+// recordTxnQuery records the query of a parameterized statement for debugging
+func (e *Executor) recordTxnQuery(query string) {
+	if !e.debugTxnRecorder.doRecord ||
+		len(e.debugTxnRecorder.txnSQL) >= maxDebugTxnSQLEntries {
+		return
+	}
+
+	e.debugTxnRecorder.sqlBytes += len(query)
+	e.debugTxnRecorder.txnSQL = append(e.debugTxnRecorder.txnSQL, query)
+}
+
 // resetRecordedTxn resets the recorded transaction SQL
 func (e *Executor) resetRecordedTxn() {
 	if !e.debugTxnRecorder.doRecord {
@@ -605,10 +719,15 @@ func (e *Executor) resetRecordedTxn() {
 // logFailedSQL logs failed SQL statement (truncated for readability)
 func (e *Executor) logFailedSQL(err error, sqlBuf []byte) {
 	maxLen := min(len(sqlBuf), v2SQLPrintLen+v2SQLBufReserved)
+	e.logFailedQuery(err, string(sqlBuf[v2SQLBufReserved:maxLen]))
+}
+
+// logFailedQuery logs failed query (truncated for readability)
+func (e *Executor) logFailedQuery(err error, query string) {
 	logutil.Error(
 		"cdc.executor.sql_failed",
 		zap.Error(err),
-		zap.String("sql", string(sqlBuf[v2SQLBufReserved:maxLen])),
+		zap.String("sql", query[:min(len(query), v2SQLPrintLen)]),
 	)
 
 	// Log full transaction if recording is enabled
@@ -89,7 +89,8 @@ const (
 		`source_uri, ` +
 		`sink_uri, ` +
 		`state, ` +
-		`err_msg ` +
+		`err_msg, ` +
+		`additional_config ` +
 		`FROM ` +
 		`mo_catalog.mo_cdc_task ` +
 		`WHERE ` +
@@ -382,6 +383,7 @@ var CDCSQLTemplates = [CDCSqlTemplateCount]struct {
 			"sink_uri",
 			"state",
 			"err_msg",
+			"additional_config",
 		},
 	},
 	CDCGetTaskIdSqlTemplate_Idx: {
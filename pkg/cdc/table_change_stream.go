@@ -82,6 +82,12 @@ type TableChangeStream struct {
 	initSnapshotSplitTxn bool
 	startTs, endTs       types.TS
 	noFull               bool
+	ddlPolicy            string
+	// transforms the data batches of the table, nil if the table has no transform rule
+	transformer *TableTransformer
+
+	// set once the schema change of the source table is logged, only accessed by the run loop
+	schemaChangeLogged bool
 
 	// Column indices (for AtomicBatch)
 	insTsColIdx           int
@@ -130,6 +136,8 @@ type tableChangeStreamOptions struct {
 	retryBackoffBase          time.Duration // Base delay for exponential backoff
 	retryBackoffMax           time.Duration // Max delay for exponential backoff
 	retryBackoffFactor        float64       // Factor for exponential backoff
+	ddlPolicy                 string        // Policy on the DDL of the source table
+	transformer               *TableTransformer
 }
 
 const (
@@ -166,6 +174,9 @@ func (opts *tableChangeStreamOptions) fillDefaults() {
 	if opts.retryBackoffFactor <= 1.0 {
 		opts.retryBackoffFactor = defaultRetryBackoffFactor
 	}
+	if opts.ddlPolicy == "" {
+		opts.ddlPolicy = CDCDefaultTaskExtra_DDLPolicy
+	}
 }
 
 // WithWatermarkStallThreshold configures how long snapshot stagnation is tolerated before surfacing an error.
@@ -198,6 +209,24 @@ func WithRetryBackoff(base, max time.Duration, factor float64) TableChangeStream
 	}
 }
 
+// WithDDLPolicy configures how the stream reacts to the schema changes of the source table.
+// Unless the policy is ignore, the stream stops reading at a schema change and waits for the
+// task executor to apply the policy.
+func WithDDLPolicy(policy string) TableChangeStreamOption {
+	return func(opts *tableChangeStreamOptions) {
+		opts.ddlPolicy = policy
+	}
+}
+
+// WithTransformer configures the transformer applied on the data batches before they
+// are sent to the sinker. The table def of the stream should be the one returned with
+// the transformer, as the column indices of the batches follow it.
+func WithTransformer(transformer *TableTransformer) TableChangeStreamOption {
+	return func(opts *tableChangeStreamOptions) {
+		opts.transformer = transformer
+	}
+}
+
 // NewTableChangeStream creates a new table change stream
 var NewTableChangeStream = func(
 	cnTxnClient client.TxnClient,
@@ -278,6 +307,7 @@ var NewTableChangeStream = func(
 		tableInfo.SourceDbName,
 		tableInfo.SourceTblName,
 	)
+	dataProcessor.transformer = opts.transformer
 
 	// Create progress tracker for observability
 	progressTracker := NewProgressTracker(
@@ -313,6 +343,8 @@ var NewTableChangeStream = func(
 		startTs:                   startTs,
 		endTs:                     endTs,
 		noFull:                    noFull,
+		ddlPolicy:                 opts.ddlPolicy,
+		transformer:               opts.transformer,
 		insTsColIdx:               insTsColIdx,
 		insCompositedPkColIdx:     insCompositedPkColIdx,
 		delTsColIdx:               delTsColIdx,
@@ -354,6 +386,7 @@ func (s *TableChangeStream) Run(ctx context.Context, ar *ActiveRoutine) {
 		)
 		s.wg.Done()
 		s.Close()
+		s.transformer.Close()
 		return
 	}
 
@@ -647,6 +680,7 @@ func (s *TableChangeStream) cleanup(ctx context.Context) {
 	// Close sinker
 	closeStart := time.Now()
 	s.Close()
+	s.transformer.Close()
 	logutil.Debug(
 		"cdc.table_stream.close_done",
 		zap.String("table", s.tableInfo.String()),
@@ -1138,11 +1172,23 @@ func (s *TableChangeStream) processWithTxn(
 		return err
 	}
 
+	// The changes after a DDL are not read with the old schema, the executor replaces
+	// or stops the stream according to the DDL policy of the task
+	if s.holdOnSchemaChange(ctx, rel) {
+		return nil
+	}
+
 	// Get time range
 	fromTs, err := s.watermarkUpdater.GetFromCache(ctx, s.watermarkKey)
 	if err != nil {
 		return err
 	}
+	// the watermark of a BufferedSinker lags behind the changes it has taken
+	if bs, ok := s.sinker.(BufferedSinker); ok {
+		if bufferedTs := bs.BufferedTs(); bufferedTs.GT(&fromTs) {
+			fromTs = bufferedTs
+		}
+	}
 
 	// Check if reached end time
 	if !s.endTs.IsEmpty() && fromTs.GE(&s.endTs) {
@@ -1520,6 +1566,30 @@ func (s *TableChangeStream) handleStaleRead(ctx context.Context, txnOp client.Tx
 	return nil
 }
 
+// holdOnSchemaChange reports whether the schema of the source table is no longer the
+// one the stream was built with, it never holds with the ignore policy
+func (s *TableChangeStream) holdOnSchemaChange(ctx context.Context, rel engine.Relation) bool {
+	if s.ddlPolicy == CDCDDLPolicy_Ignore {
+		return false
+	}
+	tableDef := rel.GetTableDef(ctx)
+	if tableDef == nil || tableDef.Createsql == s.tableDef.Createsql {
+		return false
+	}
+	if !s.schemaChangeLogged {
+		s.schemaChangeLogged = true
+		logutil.Info(
+			"cdc.table_stream.schema_changed",
+			zap.String("table", s.tableInfo.String()),
+			zap.String("task-id", s.taskId),
+			zap.String("ddl-policy", s.ddlPolicy),
+			zap.Uint32("old-version", s.tableDef.Version),
+			zap.Uint32("new-version", tableDef.Version),
+		)
+	}
+	return true
+}
+
 // GetRetryable returns the retryable flag in a thread-safe way
 func (s *TableChangeStream) GetRetryable() bool {
 	s.stateMu.Lock()
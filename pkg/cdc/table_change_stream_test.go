@@ -203,6 +203,43 @@ func readCounterValue(t *testing.T, counter prometheus.Counter) float64 {
 	return metric.GetCounter().GetValue()
 }
 
+type tableDefRelation struct {
+	engine.Relation
+	tableDef *plan.TableDef
+}
+
+func (r *tableDefRelation) GetTableDef(context.Context) *plan.TableDef {
+	return r.tableDef
+}
+
+func TestTableChangeStream_HoldOnSchemaChange(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer mpool.DeleteMPool(mp)
+
+	tableInfo := &DbTableInfo{
+		SourceDbName:  "db_ddl",
+		SourceTblName: "t_ddl",
+	}
+	ctx := context.Background()
+
+	stream := createTestStream(mp, tableInfo)
+	stream.tableDef.Createsql = "create table t_ddl (id int primary key, ts int)"
+	rel := &tableDefRelation{tableDef: &plan.TableDef{
+		Createsql: "create table t_ddl (id int primary key, ts bigint)",
+		Version:   1,
+	}}
+	// the default policy is ignore
+	assert.False(t, stream.holdOnSchemaChange(ctx, rel))
+
+	stream = createTestStream(mp, tableInfo, WithDDLPolicy(CDCDDLPolicy_Propagate))
+	stream.tableDef.Createsql = "create table t_ddl (id int primary key, ts int)"
+	assert.True(t, stream.holdOnSchemaChange(ctx, rel))
+	assert.True(t, stream.holdOnSchemaChange(ctx, rel))
+
+	rel.tableDef.Createsql = stream.tableDef.Createsql
+	assert.False(t, stream.holdOnSchemaChange(ctx, rel))
+}
+
 func TestTableChangeStream_HandleSnapshotNoProgress_WarningAndReset(t *testing.T) {
 	mp := mpool.MustNewZero()
 	defer mpool.DeleteMPool(mp)
@@ -0,0 +1,469 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// CDCMask_Hash replaces the value with the hex encoded sha256 of it
+	CDCMask_Hash = "hash"
+	// CDCMask_Mask replaces every character of the value with '*'
+	CDCMask_Mask = "mask"
+	// CDCMask_Partial keeps the last 4 characters of the value, the others are replaced with '*'
+	CDCMask_Partial = "partial"
+	// CDCMask_Null replaces the value with NULL
+	CDCMask_Null = "null"
+)
+
+// the width of the hex encoded sha256
+const hashMaskWidth = sha256.Size * 2
+
+// the number of the trailing characters kept by the partial mask
+const partialMaskKeep = 4
+
+// TableTransform is the transform rule of the tables matching Table. The columns
+// not sent to the sink are either listed by Exclude or not listed by Columns,
+// the rows not matching Filter are not sent to the sink, and the values of the
+// columns in Mask are replaced by the mask functions.
+type TableTransform struct {
+	// Table is db.table, both of them can be *
+	Table   string            `json:"table"`
+	Columns []string          `json:"columns,omitempty"`
+	Exclude []string          `json:"exclude,omitempty"`
+	Filter  string            `json:"filter,omitempty"`
+	Mask    map[string]string `json:"mask,omitempty"`
+
+	dbName  string
+	tblName string
+}
+
+// ParseTransforms parses and validates the transform rules of a task, the
+// rules are a json array of TableTransform
+func ParseTransforms(ctx context.Context, value string) ([]*TableTransform, error) {
+	var rules []*TableTransform
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, moerr.NewInvalidInputf(ctx, "invalid transforms: %v", err)
+	}
+
+	for _, rule := range rules {
+		if rule == nil {
+			return nil, moerr.NewInvalidInput(ctx, "invalid transforms: empty rule")
+		}
+		dbName, tblName, ok := strings.Cut(rule.Table, ".")
+		if !ok || dbName == "" || tblName == "" || strings.Contains(tblName, ".") {
+			return nil, moerr.NewInvalidInputf(ctx, "invalid transform table: %q, should be db.table", rule.Table)
+		}
+		rule.dbName, rule.tblName = dbName, tblName
+
+		if len(rule.Columns) > 0 && len(rule.Exclude) > 0 {
+			return nil, moerr.NewInvalidInputf(ctx, "transform of %s can not have both columns and exclude", rule.Table)
+		}
+		if rule.Filter != "" {
+			if _, err := parseTransformFilter(ctx, rule.Filter); err != nil {
+				return nil, err
+			}
+		}
+		for col, fn := range rule.Mask {
+			switch fn {
+			case CDCMask_Hash, CDCMask_Mask, CDCMask_Partial, CDCMask_Null:
+			default:
+				return nil, moerr.NewInvalidInputf(ctx, "invalid mask function %q of column %s, supported: %s, %s, %s, %s",
+					fn, col, CDCMask_Hash, CDCMask_Mask, CDCMask_Partial, CDCMask_Null)
+			}
+		}
+	}
+	return rules, nil
+}
+
+// MatchTransform returns the first rule matching the table, nil if there is none
+func MatchTransform(rules []*TableTransform, dbName, tblName string) *TableTransform {
+	for _, rule := range rules {
+		if (rule.dbName == CDCPitrGranularity_All || rule.dbName == dbName) &&
+			(rule.tblName == CDCPitrGranularity_All || rule.tblName == tblName) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// parseTransformFilter parses the filter as the WHERE condition of a query
+func parseTransformFilter(ctx context.Context, filter string) (tree.Expr, error) {
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, "select 1 where "+filter, 1)
+	if err != nil {
+		return nil, moerr.NewInvalidInputf(ctx, "invalid transform filter %q: %v", filter, err)
+	}
+	sel, ok := stmt.(*tree.Select)
+	if ok && sel.OrderBy == nil && sel.Limit == nil && sel.SelectLockInfo == nil {
+		if clause, ok := sel.Select.(*tree.SelectClause); ok &&
+			clause.GroupBy == nil && clause.Having == nil && clause.Where != nil {
+			return clause.Where.Expr, nil
+		}
+	}
+	return nil, moerr.NewInvalidInputf(ctx, "invalid transform filter %q: should be a single expression", filter)
+}
+
+// TableTransformer applies the transform rule of a table on the data batches
+// of it in place. The batches keep the layout of the data batches read from
+// the source table,
+//
+//	user defined cols | cpk (if needed) | commit-ts
+//
+// with the dropped columns removed, so it matches the table def returned by
+// NewTableTransformer.
+type TableTransformer struct {
+	mp   *mpool.MPool
+	proc *process.Process
+
+	filter colexec.ExpressionExecutor
+	// the source batch indices of the kept columns
+	keep []int
+	// the mask functions by the sink batch indices
+	masks map[int]string
+	// the column types of the sink batch
+	types []types.Type
+}
+
+// NewTableTransformer builds the transformer of the rule on the table, and the
+// table def of the sink table.
+func NewTableTransformer(
+	ctx context.Context,
+	mp *mpool.MPool,
+	rule *TableTransform,
+	tableDef *plan.TableDef,
+) (*TableTransformer, *plan.TableDef, error) {
+	pkCols := make(map[string]struct{}, len(tableDef.Pkey.Names))
+	for _, name := range tableDef.Pkey.Names {
+		pkCols[name] = struct{}{}
+	}
+	// the user defined columns, the last column is the commit-ts in the batches
+	userCols := make(map[string]*plan.ColDef, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		if i < len(tableDef.Cols)-1 && !col.Hidden {
+			userCols[col.Name] = col
+		}
+	}
+	checkCols := func(names []string, what string) (map[string]struct{}, error) {
+		set := make(map[string]struct{}, len(names))
+		for _, name := range names {
+			if _, ok := userCols[name]; !ok {
+				return nil, moerr.NewInvalidInputf(ctx, "%s column %s of the transform does not exist in table %s.%s",
+					what, name, tableDef.DbName, tableDef.Name)
+			}
+			set[name] = struct{}{}
+		}
+		return set, nil
+	}
+	allowed, err := checkCols(rule.Columns, "allowed")
+	if err != nil {
+		return nil, nil, err
+	}
+	excluded, err := checkCols(rule.Exclude, "excluded")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := &TableTransformer{
+		mp:    mp,
+		masks: make(map[int]string, len(rule.Mask)),
+	}
+	sinkDef := *tableDef
+	sinkDef.Cols = make([]*plan.ColDef, 0, len(tableDef.Cols))
+	sinkDef.Name2ColIndex = make(map[string]int32, len(tableDef.Cols))
+	dropped := make(map[string]struct{})
+	for i, col := range tableDef.Cols {
+		if _, ok := userCols[col.Name]; ok {
+			_, isPk := pkCols[col.Name]
+			_, isExcluded := excluded[col.Name]
+			_, isAllowed := allowed[col.Name]
+			if isExcluded || (len(allowed) > 0 && !isAllowed) {
+				if isPk {
+					return nil, nil, moerr.NewInvalidInputf(ctx, "primary key column %s of table %s.%s can not be dropped by the transform",
+						col.Name, tableDef.DbName, tableDef.Name)
+				}
+				dropped[col.Name] = struct{}{}
+				continue
+			}
+		}
+		t.keep = append(t.keep, i)
+		sinkDef.Name2ColIndex[col.Name] = int32(len(sinkDef.Cols))
+		sinkDef.Cols = append(sinkDef.Cols, col)
+	}
+
+	for name, fn := range rule.Mask {
+		col, ok := userCols[name]
+		if !ok {
+			return nil, nil, moerr.NewInvalidInputf(ctx, "masked column %s of the transform does not exist in table %s.%s",
+				name, tableDef.DbName, tableDef.Name)
+		}
+		if _, ok = dropped[name]; ok {
+			return nil, nil, moerr.NewInvalidInputf(ctx, "masked column %s of table %s.%s is dropped by the transform",
+				name, tableDef.DbName, tableDef.Name)
+		}
+		if _, ok = pkCols[name]; ok {
+			return nil, nil, moerr.NewInvalidInputf(ctx, "primary key column %s of table %s.%s can not be masked",
+				name, tableDef.DbName, tableDef.Name)
+		}
+		if err = checkMaskColumn(ctx, tableDef, col, fn); err != nil {
+			return nil, nil, err
+		}
+		idx := sinkDef.Name2ColIndex[name]
+		t.masks[int(idx)] = fn
+		if fn == CDCMask_Hash && col.Typ.Width < hashMaskWidth && types.T(col.Typ.Id) != types.T_text {
+			// widen the sink column to hold the hash
+			sinkCol := *col
+			sinkCol.Typ.Id = int32(types.T_varchar)
+			sinkCol.Typ.Width = hashMaskWidth
+			sinkDef.Cols[idx] = &sinkCol
+		}
+	}
+
+	if len(dropped) > 0 {
+		sinkDef.Indexes = nil
+		for _, index := range tableDef.Indexes {
+			if !transformRefsDropped(index.Parts, dropped) {
+				sinkDef.Indexes = append(sinkDef.Indexes, index)
+			}
+		}
+		if sinkDef.ClusterBy != nil {
+			clusterBy := []string{sinkDef.ClusterBy.Name}
+			if util.JudgeIsCompositeClusterByColumn(sinkDef.ClusterBy.Name) {
+				clusterBy = util.SplitCompositeClusterByColumnName(sinkDef.ClusterBy.Name)
+			}
+			if transformRefsDropped(clusterBy, dropped) {
+				sinkDef.ClusterBy = nil
+			}
+		}
+	}
+
+	for _, col := range sinkDef.Cols {
+		t.types = append(t.types, types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale))
+	}
+
+	if rule.Filter != "" {
+		if err = t.buildFilter(ctx, rule.Filter, tableDef, userCols); err != nil {
+			return nil, nil, err
+		}
+	}
+	return t, &sinkDef, nil
+}
+
+func (t *TableTransformer) buildFilter(
+	ctx context.Context,
+	filter string,
+	tableDef *plan.TableDef,
+	userCols map[string]*plan.ColDef,
+) error {
+	astExpr, err := parseTransformFilter(ctx, filter)
+	if err != nil {
+		return err
+	}
+	// bind the columns to their positions in the source batches
+	colNames := make([]string, len(tableDef.Cols))
+	colTypes := make([]plan.Type, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		if _, ok := userCols[col.Name]; ok {
+			colNames[i] = col.Name
+		}
+		colTypes[i] = col.Typ
+	}
+	expr, err := plan2.NewGeneratedColBinder(ctx, colNames, colTypes).BindExpr(astExpr, 0, true)
+	if err != nil {
+		return moerr.NewInvalidInputf(ctx, "invalid transform filter %q of table %s.%s: %v",
+			filter, tableDef.DbName, tableDef.Name, err)
+	}
+	if types.T(expr.Typ.Id) != types.T_bool {
+		return moerr.NewInvalidInputf(ctx, "transform filter %q of table %s.%s is not a boolean expression",
+			filter, tableDef.DbName, tableDef.Name)
+	}
+
+	t.proc = process.NewTopProcess(ctx, t.mp, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	if t.filter, err = colexec.NewExpressionExecutor(t.proc, expr); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkMaskColumn checks the mask function can be applied on the column
+func checkMaskColumn(ctx context.Context, tableDef *plan.TableDef, col *plan.ColDef, fn string) error {
+	if fn == CDCMask_Null {
+		if col.Default != nil && !col.Default.NullAbility {
+			return moerr.NewInvalidInputf(ctx, "column %s of table %s.%s is not nullable, can not be masked by %s",
+				col.Name, tableDef.DbName, tableDef.Name, fn)
+		}
+		return nil
+	}
+	switch types.T(col.Typ.Id) {
+	case types.T_char, types.T_varchar, types.T_text:
+		return nil
+	}
+	return moerr.NewInvalidInputf(ctx, "column %s of table %s.%s is not a string column, can not be masked by %s",
+		col.Name, tableDef.DbName, tableDef.Name, fn)
+}
+
+func transformRefsDropped(cols []string, dropped map[string]struct{}) bool {
+	for _, col := range cols {
+		if _, ok := dropped[col]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply filters the rows, drops the columns and masks the values of the data batch
+func (t *TableTransformer) Apply(bat *batch.Batch) error {
+	if bat == nil {
+		return nil
+	}
+
+	if t.filter != nil && bat.RowCount() > 0 {
+		vec, err := t.filter.Eval(t.proc, []*batch.Batch{bat}, nil)
+		if err != nil {
+			return err
+		}
+		vals := vector.MustFixedColNoTypeCheck[bool](vec)
+		sels := make([]int64, 0, bat.RowCount())
+		for i := 0; i < bat.RowCount(); i++ {
+			if vec.IsNull(uint64(i)) {
+				continue
+			}
+			if (vec.IsConst() && vals[0]) || (!vec.IsConst() && vals[i]) {
+				sels = append(sels, int64(i))
+			}
+		}
+		bat.Shrink(sels, false)
+	}
+
+	if len(t.keep) < len(bat.Vecs) {
+		kept := make(map[*vector.Vector]struct{}, len(t.keep))
+		vecs := make([]*vector.Vector, len(t.keep))
+		for i, idx := range t.keep {
+			vecs[i] = bat.Vecs[idx]
+			kept[vecs[i]] = struct{}{}
+		}
+		for _, vec := range bat.Vecs {
+			if _, ok := kept[vec]; !ok {
+				vec.Free(t.mp)
+			}
+		}
+		if len(bat.Attrs) == len(bat.Vecs) {
+			attrs := make([]string, len(t.keep))
+			for i, idx := range t.keep {
+				attrs[i] = bat.Attrs[idx]
+			}
+			bat.Attrs = attrs
+		}
+		bat.Vecs = vecs
+	}
+
+	for idx, fn := range t.masks {
+		vec, err := t.mask(bat.Vecs[idx], fn, t.types[idx], bat.RowCount())
+		if err != nil {
+			return err
+		}
+		bat.Vecs[idx].Free(t.mp)
+		bat.Vecs[idx] = vec
+	}
+	return nil
+}
+
+func (t *TableTransformer) mask(src *vector.Vector, fn string, typ types.Type, rows int) (*vector.Vector, error) {
+	vec := vector.NewVec(typ)
+	if fn == CDCMask_Null {
+		if err := vec.PreExtend(rows, t.mp); err != nil {
+			vec.Free(t.mp)
+			return nil, err
+		}
+		vec.SetLength(rows)
+		nulls.AddRange(vec.GetNulls(), 0, uint64(rows))
+		return vec, nil
+	}
+
+	for i := 0; i < rows; i++ {
+		var val []byte
+		isNull := src.IsNull(uint64(i))
+		if !isNull {
+			val = maskValue(src.GetBytesAt(i), fn)
+		}
+		if err := vector.AppendBytes(vec, val, isNull, t.mp); err != nil {
+			vec.Free(t.mp)
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
+func maskValue(val []byte, fn string) []byte {
+	switch fn {
+	case CDCMask_Hash:
+		sum := sha256.Sum256(val)
+		return []byte(hex.EncodeToString(sum[:]))
+	case CDCMask_Mask:
+		return bytes.Repeat([]byte{'*'}, utf8.RuneCount(val))
+	case CDCMask_Partial:
+		n := utf8.RuneCount(val)
+		if n <= partialMaskKeep {
+			return bytes.Repeat([]byte{'*'}, n)
+		}
+		// skip the masked runes
+		tail := val
+		for i := 0; i < n-partialMaskKeep; i++ {
+			_, size := utf8.DecodeRune(tail)
+			tail = tail[size:]
+		}
+		masked := bytes.Repeat([]byte{'*'}, n-partialMaskKeep)
+		return append(masked, tail...)
+	}
+	return val
+}
+
+// Close releases the filter of the transformer
+func (t *TableTransformer) Close() {
+	if t == nil {
+		return
+	}
+	if t.filter != nil {
+		t.filter.Free()
+		t.filter = nil
+	}
+	if t.proc != nil {
+		t.proc.Free()
+		t.proc = nil
+	}
+}
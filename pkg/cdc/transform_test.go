@@ -0,0 +1,273 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTransforms(t *testing.T) {
+	ctx := context.Background()
+
+	rules, err := ParseTransforms(ctx, `[
+		{"table": "db1.t1", "exclude": ["ssn"], "filter": "age >= 18 and city = 'x'", "mask": {"name": "partial"}},
+		{"table": "db1.*", "columns": ["id", "name"]}
+	]`)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, []string{"ssn"}, rules[0].Exclude)
+	assert.Equal(t, map[string]string{"name": CDCMask_Partial}, rules[0].Mask)
+
+	// the first matching rule wins
+	assert.Same(t, rules[0], MatchTransform(rules, "db1", "t1"))
+	assert.Same(t, rules[1], MatchTransform(rules, "db1", "t2"))
+	assert.Nil(t, MatchTransform(rules, "db2", "t1"))
+
+	for _, value := range []string{
+		`{"table": "db1.t1"}`,
+		`[{"table": "db1.t1", "unknown": 1}]`,
+		`[null]`,
+		`[{"table": "t1"}]`,
+		`[{"table": "db1."}]`,
+		`[{"table": "db1.t1.c"}]`,
+		`[{"table": "db1.t1", "columns": ["a"], "exclude": ["b"]}]`,
+		`[{"table": "db1.t1", "filter": "a >"}]`,
+		`[{"table": "db1.t1", "filter": "a > 1 limit 1"}]`,
+		`[{"table": "db1.t1", "filter": "a > 1 order by a"}]`,
+		`[{"table": "db1.t1", "mask": {"a": "reverse"}}]`,
+	} {
+		_, err = ParseTransforms(ctx, value)
+		assert.Error(t, err, value)
+	}
+}
+
+func newTransformTableDef() *plan.TableDef {
+	notNull := &plan.Default{NullAbility: false}
+	return &plan.TableDef{
+		DbName: "db1",
+		Name:   "t1",
+		Cols: []*plan.ColDef{
+			{Name: "id", Typ: plan.Type{Id: int32(types.T_int64)}, Default: notNull},
+			{Name: "name", Typ: plan.Type{Id: int32(types.T_varchar), Width: 16}},
+			{Name: "ssn", Typ: plan.Type{Id: int32(types.T_varchar), Width: 16}},
+			{Name: "age", Typ: plan.Type{Id: int32(types.T_int32)}},
+			{Name: catalog.Row_ID, Typ: plan.Type{Id: int32(types.T_Rowid)}, Hidden: true},
+		},
+		Pkey: &plan.PrimaryKeyDef{Names: []string{"id"}, PkeyColName: "id"},
+		Indexes: []*plan.IndexDef{
+			{IndexName: "idx_name", Parts: []string{"name"}},
+			{IndexName: "idx_age", Parts: []string{"age"}},
+		},
+	}
+}
+
+func newTransformBatch(t *testing.T, mp *mpool.MPool) *batch.Batch {
+	bat := batch.NewWithSize(5)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	bat.Vecs[2] = vector.NewVec(types.T_varchar.ToType())
+	bat.Vecs[3] = vector.NewVec(types.T_int32.ToType())
+	bat.Vecs[4] = vector.NewVec(types.T_TS.ToType())
+	rows := []struct {
+		id   int64
+		name string
+		ssn  string
+		age  int32
+	}{
+		{1, "alice", "123-45-6789", 17},
+		{2, "bob", "987-65-4321", 30},
+		{3, "carol", "", 45},
+	}
+	for i, row := range rows {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], row.id, false, mp))
+		require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte(row.name), false, mp))
+		require.NoError(t, vector.AppendBytes(bat.Vecs[2], []byte(row.ssn), row.ssn == "", mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[3], row.age, false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[4], types.BuildTS(int64(i+1), 0), false, mp))
+	}
+	bat.SetRowCount(len(rows))
+	return bat
+}
+
+func TestTableTransformer(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+
+	rules, err := ParseTransforms(ctx, `[{
+		"table": "db1.t1",
+		"exclude": ["age"],
+		"filter": "age >= 18",
+		"mask": {"name": "partial", "ssn": "hash"}
+	}]`)
+	require.NoError(t, err)
+
+	tableDef := newTransformTableDef()
+	transformer, sinkDef, err := NewTableTransformer(ctx, mp, rules[0], tableDef)
+	require.NoError(t, err)
+	defer transformer.Close()
+
+	// the source table def is not changed
+	require.Len(t, tableDef.Cols, 5)
+	require.Len(t, sinkDef.Cols, 4)
+	assert.Equal(t, map[string]int32{"id": 0, "name": 1, "ssn": 2, catalog.Row_ID: 3}, sinkDef.Name2ColIndex)
+	assert.Equal(t, int32(types.T_varchar), sinkDef.Cols[2].Typ.Id)
+	assert.Equal(t, int32(hashMaskWidth), sinkDef.Cols[2].Typ.Width)
+	assert.Equal(t, int32(16), tableDef.Cols[2].Typ.Width)
+	require.Len(t, sinkDef.Indexes, 1)
+	assert.Equal(t, "idx_name", sinkDef.Indexes[0].IndexName)
+
+	bat := newTransformBatch(t, mp)
+	require.NoError(t, transformer.Apply(bat))
+	require.Equal(t, 2, bat.RowCount())
+	require.Len(t, bat.Vecs, 4)
+	assert.Equal(t, []int64{2, 3}, vector.MustFixedColWithTypeCheck[int64](bat.Vecs[0]))
+	assert.Equal(t, "***", bat.Vecs[1].GetStringAt(0))
+	assert.Equal(t, "*arol", bat.Vecs[1].GetStringAt(1))
+	assert.Len(t, bat.Vecs[2].GetStringAt(0), hashMaskWidth)
+	assert.Equal(t, string(maskValue([]byte("987-65-4321"), CDCMask_Hash)), bat.Vecs[2].GetStringAt(0))
+	assert.True(t, bat.Vecs[2].IsNull(1))
+	assert.Equal(t, []types.TS{types.BuildTS(2, 0), types.BuildTS(3, 0)}, vector.MustFixedColWithTypeCheck[types.TS](bat.Vecs[3]))
+
+	bat.Clean(mp)
+	transformer.Close()
+	assert.Equal(t, int64(0), mp.CurrNB())
+}
+
+func TestTableTransformer_NullMaskAndAllowList(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+
+	rules, err := ParseTransforms(ctx, `[{"table": "db1.*", "columns": ["id", "ssn"], "mask": {"ssn": "null"}}]`)
+	require.NoError(t, err)
+	transformer, sinkDef, err := NewTableTransformer(ctx, mp, rules[0], newTransformTableDef())
+	require.NoError(t, err)
+	require.Len(t, sinkDef.Cols, 3)
+	assert.Empty(t, sinkDef.Indexes)
+
+	bat := newTransformBatch(t, mp)
+	require.NoError(t, transformer.Apply(bat))
+	require.Equal(t, 3, bat.RowCount())
+	require.Len(t, bat.Vecs, 3)
+	for i := 0; i < bat.RowCount(); i++ {
+		assert.True(t, bat.Vecs[1].IsNull(uint64(i)))
+	}
+	bat.Clean(mp)
+	transformer.Close()
+	assert.Equal(t, int64(0), mp.CurrNB())
+}
+
+func TestNewTableTransformer_Invalid(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+
+	for _, value := range []string{
+		// the primary key can be neither dropped nor masked
+		`[{"table": "db1.t1", "exclude": ["id"]}]`,
+		`[{"table": "db1.t1", "columns": ["name"]}]`,
+		`[{"table": "db1.t1", "mask": {"id": "null"}}]`,
+		// unknown columns
+		`[{"table": "db1.t1", "exclude": ["x"]}]`,
+		`[{"table": "db1.t1", "mask": {"x": "hash"}}]`,
+		`[{"table": "db1.t1", "filter": "x > 1"}]`,
+		// the masked column is dropped
+		`[{"table": "db1.t1", "exclude": ["name"], "mask": {"name": "hash"}}]`,
+		// the string masks only apply on the string columns
+		`[{"table": "db1.t1", "mask": {"age": "mask"}}]`,
+		// not a boolean filter
+		`[{"table": "db1.t1", "filter": "age + 1"}]`,
+	} {
+		rules, err := ParseTransforms(ctx, value)
+		require.NoError(t, err, value)
+		_, _, err = NewTableTransformer(ctx, mp, rules[0], newTransformTableDef())
+		assert.Error(t, err, value)
+	}
+	assert.Equal(t, int64(0), mp.CurrNB())
+}
+
+func TestMaskValue(t *testing.T) {
+	assert.Equal(t, "*****", string(maskValue([]byte("héllo"), CDCMask_Mask)))
+	assert.Equal(t, "****5678", string(maskValue([]byte("12345678"), CDCMask_Partial)))
+	assert.Equal(t, "**é文字x", string(maskValue([]byte("abé文字x"), CDCMask_Partial)))
+	assert.Equal(t, "****", string(maskValue([]byte("1234"), CDCMask_Partial)))
+	assert.Equal(t,
+		"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		string(maskValue([]byte("hello"), CDCMask_Hash)))
+}
+
+func TestDataProcessor_Transform(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+
+	rules, err := ParseTransforms(ctx, `[{"table": "db1.t1", "exclude": ["age"], "filter": "age > 100"}]`)
+	require.NoError(t, err)
+	transformer, _, err := NewTableTransformer(ctx, mp, rules[0], newTransformTableDef())
+	require.NoError(t, err)
+	defer transformer.Close()
+
+	sinker := &mockDataProcessorSinker{mockSinker: &mockSinker{}}
+	updater := newMockWatermarkUpdater()
+	txnMgr := NewTransactionManager(sinker, updater, 1, "task1", "db1", "t1")
+	packerPool := fileservice.NewPool(
+		128,
+		func() *types.Packer { return types.NewPacker() },
+		func(packer *types.Packer) { packer.Reset() },
+		func(packer *types.Packer) {},
+	)
+	// the indices of the transformed batches: id | name | ssn | commit-ts
+	dp := NewDataProcessor(
+		sinker, txnMgr, mp, packerPool,
+		3, 0, 1, 0, false,
+		1, "task1", "db1", "t1",
+	)
+	dp.transformer = transformer
+
+	fromTs := types.TS{}
+	toTs := (&fromTs).Next()
+	dp.SetTransactionRange(fromTs, toTs)
+
+	// all the rows of the snapshot are filtered out
+	data := &ChangeData{
+		Type:        ChangeTypeSnapshot,
+		InsertBatch: newTransformBatch(t, mp),
+	}
+	require.NoError(t, dp.ProcessChange(ctx, data))
+	assert.Nil(t, data.InsertBatch)
+	assert.False(t, sinker.beginCalled)
+	assert.Equal(t, 0, len(sinker.sinkCalls))
+
+	// the tail batches are appended with the layout of the sink table
+	data = &ChangeData{
+		Type:        ChangeTypeTailWip,
+		InsertBatch: newTransformBatch(t, mp),
+	}
+	require.NoError(t, dp.ProcessChange(ctx, data))
+	require.Nil(t, data.InsertBatch)
+	require.Equal(t, 0, dp.GetInsertAtmBatch().RowCount())
+
+	dp.Cleanup()
+	transformer.Close()
+	assert.Equal(t, int64(0), mp.CurrNB())
+}
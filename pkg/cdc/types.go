@@ -63,6 +63,11 @@ const (
 	CDCSourceUriPrefix = "mysql://"
 	CDCSinkUriPrefix   = "mysql://"
 
+	CDCKafkaSinkUriPrefix = "kafka://"
+	CDCStageSinkUriPrefix = "stage://"
+
+	CDCPostgresSinkUriPrefix = "postgres://"
+
 	CDCState_Common = "common"
 	CDCState_Error  = "error"
 )
@@ -73,12 +78,26 @@ const (
 	CDCDefaultRetryDuration                  = 10 * time.Minute
 	CDCDefaultTaskExtra_InitSnapshotSplitTxn = true
 	CDCDefaultTaskExtra_MaxSQLLen            = 4 * 1024 * 1024
+	CDCDefaultTaskExtra_DDLPolicy            = CDCDDLPolicy_Ignore
+)
+
+// the policies of a task on the DDL of the source tables
+const (
+	// CDCDDLPolicy_Propagate applies the DDL to the sink tables
+	CDCDDLPolicy_Propagate = "propagate"
+	// CDCDDLPolicy_Pause stops the task in the failed state until it is restarted
+	CDCDDLPolicy_Pause = "pause"
+	// CDCDDLPolicy_Ignore keeps replaying the DML only
+	CDCDDLPolicy_Ignore = "ignore"
 )
 
 const (
-	CDCSinkType_MySQL   = "mysql"
-	CDCSinkType_MO      = "matrixone"
-	CDCSinkType_Console = "console"
+	CDCSinkType_MySQL    = "mysql"
+	CDCSinkType_MO       = "matrixone"
+	CDCSinkType_Console  = "console"
+	CDCSinkType_Kafka    = "kafka"
+	CDCSinkType_Stage    = "stage"
+	CDCSinkType_Postgres = "postgres"
 )
 
 const (
@@ -100,6 +119,8 @@ const (
 	CDCRequestOptions_NoFull               = "NoFull"
 	CDCRequestOptions_ConfigFile           = "ConfigFile"
 	CDCRequestOptions_Frequency            = "Frequency"
+	CDCRequestOptions_DDLPolicy            = "DDLPolicy"
+	CDCRequestOptions_Transforms           = "Transforms"
 )
 
 const (
@@ -107,6 +128,8 @@ const (
 	CDCTaskExtraOptions_SendSqlTimeout       = CDCRequestOptions_SendSqlTimeout
 	CDCTaskExtraOptions_InitSnapshotSplitTxn = CDCRequestOptions_InitSnapshotSplitTxn
 	CDCTaskExtraOptions_Frequency            = CDCRequestOptions_Frequency
+	CDCTaskExtraOptions_DDLPolicy            = CDCRequestOptions_DDLPolicy
+	CDCTaskExtraOptions_Transforms           = CDCRequestOptions_Transforms
 )
 
 var CDCRequestOptions = []string{
@@ -120,6 +143,8 @@ var CDCRequestOptions = []string{
 	CDCRequestOptions_ConfigFile,
 	CDCRequestOptions_NoFull,
 	CDCRequestOptions_Frequency,
+	CDCRequestOptions_DDLPolicy,
+	CDCRequestOptions_Transforms,
 }
 
 var CDCTaskExtraOptions = []string{
@@ -127,6 +152,8 @@ var CDCTaskExtraOptions = []string{
 	CDCTaskExtraOptions_SendSqlTimeout,
 	CDCTaskExtraOptions_InitSnapshotSplitTxn,
 	CDCTaskExtraOptions_Frequency,
+	CDCTaskExtraOptions_DDLPolicy,
+	CDCTaskExtraOptions_Transforms,
 }
 
 var (
@@ -178,6 +205,17 @@ type Sinker interface {
 	Close()
 }
 
+// BufferedSinker is a Sinker keeping committed changes before they are
+// durable in the sink. The watermark is held back at DurableTs, and the
+// changes up to BufferedTs are not read again unless the sinker is reset.
+type BufferedSinker interface {
+	Sinker
+	// DurableTs returns the toTs up to which the changes sent are durable
+	DurableTs() types.TS
+	// BufferedTs returns the toTs of the changes sent
+	BufferedTs() types.TS
+}
+
 // Sink represents the destination mysql or matrixone
 type Sink interface {
 	Send(ctx context.Context, ar *ActiveRoutine, sqlBuf []byte, needRetry bool) error
@@ -529,13 +567,53 @@ type UriInfo struct {
 	PasswordStart int    `json:"-"`
 	PasswordEnd   int    `json:"-"`
 	Reserved      string `json:"reserved"`
-}
-
+	// Brokers is the bootstrap server list of the kafka sink
+	Brokers string `json:"brokers,omitempty"`
+	// Stage is the stage url of the stage sink, without the query parameters.
+	Stage string `json:"stage,omitempty"`
+	// StagePath is the file service path the stage resolves to with the
+	// credentials of the stage. It is kept encrypted in the sink password.
+	StagePath string `json:"-"`
+	// Database is the database of the postgres sink, the sink db names are its schemas
+	Database string `json:"database,omitempty"`
+	// Params holds the query parameters of the kafka, stage and postgres sink uri
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// GetEncodedPassword encodes the secret kept in the sink password of the
+// task, the stage path for the stage sink
 func (info *UriInfo) GetEncodedPassword() (string, error) {
+	if info.Stage != "" {
+		return AesCFBEncode([]byte(info.StagePath))
+	}
 	return AesCFBEncode([]byte(info.Password))
 }
 
+// SetDecodedPassword decodes the sink password of the task into the secret
+// encoded by GetEncodedPassword
+func (info *UriInfo) SetDecodedPassword(ctx context.Context, encoded string) error {
+	secret, err := AesCFBDecode(ctx, encoded)
+	if err != nil {
+		return err
+	}
+	if info.Stage != "" {
+		info.StagePath = secret
+	} else {
+		info.Password = secret
+	}
+	return nil
+}
+
 func (info *UriInfo) String() string {
+	if info.Stage != "" {
+		return info.Stage
+	}
+	if info.Brokers != "" {
+		return fmt.Sprintf("%s%s:%s@%s", CDCKafkaSinkUriPrefix, info.User, "******", info.Brokers)
+	}
+	if info.SinkTyp == CDCSinkType_Postgres {
+		return fmt.Sprintf("%s%s:%s@%s:%d/%s", CDCPostgresSinkUriPrefix, info.User, "******", info.Ip, info.Port, info.Database)
+	}
 	return fmt.Sprintf("%s%s:%s@%s:%d", CDCSourceUriPrefix, info.User, "******", info.Ip, info.Port)
 }
 
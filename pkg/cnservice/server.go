@@ -47,6 +47,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/monlp/llm"
 	"github.com/matrixorigin/matrixone/pkg/objectio/ioutil"
 	"github.com/matrixorigin/matrixone/pkg/partitionservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
@@ -114,6 +115,9 @@ func NewService(
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
+	if err := llm.Init(cfg.LLM); err != nil {
+		return nil, err
+	}
 
 	//set frontend parameters
 	cfg.Frontend.SetDefaultValues()
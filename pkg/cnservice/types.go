@@ -39,6 +39,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/monlp/llm"
 	"github.com/matrixorigin/matrixone/pkg/partitionservice"
 	logservicepb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
@@ -293,6 +294,9 @@ type Config struct {
 
 	PythonUdfClient pythonservice.ClientConfig `toml:"python-udf-client"`
 
+	// LLM holds the server side API keys of the llm functions.
+	LLM llm.Config `toml:"llm"`
+
 	// LogtailUpdateWorkerFactor is the times of CPU number of this node
 	// to start update workers.
 	LogtailUpdateWorkerFactor int `toml:"logtail-update-worker-factor"`
@@ -0,0 +1,253 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tdigest implements the merging t-digest of Dunning and Ertl,
+// a compact and mergeable sketch of a distribution which estimates
+// quantiles with a small error that shrinks towards the tails.
+package tdigest
+
+import (
+	"encoding/binary"
+	"math"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// DefaultCompression bounds the sketch to about 100 centroids, which
+	// keeps the quantile error around 1% in the middle of the distribution.
+	DefaultCompression = 100
+
+	version    = 1
+	headerSize = 1 + 8*3 + 4
+)
+
+// Centroid is a cluster of nearby values summarized by their mean.
+type Centroid struct {
+	Mean   float64
+	Weight float64
+}
+
+// TDigest is not safe for concurrent use.
+type TDigest struct {
+	compression float64
+	centroids   []Centroid
+	buffer      []Centroid
+	count       float64
+	min         float64
+	max         float64
+}
+
+func New(compression float64) *TDigest {
+	if compression < 10 {
+		compression = 10
+	}
+	return &TDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Count returns the total weight added to the digest.
+func (t *TDigest) Count() float64 {
+	return t.count
+}
+
+// Size returns the memory held by the digest in bytes.
+func (t *TDigest) Size() int {
+	return 16*(cap(t.centroids)+cap(t.buffer)) + 48
+}
+
+func (t *TDigest) Add(x float64) {
+	t.AddWeighted(x, 1)
+}
+
+// AddWeighted adds x with weight w, NaN values and non-positive weights
+// are ignored.
+func (t *TDigest) AddWeighted(x, w float64) {
+	if math.IsNaN(x) || !(w > 0) {
+		return
+	}
+	t.buffer = append(t.buffer, Centroid{Mean: x, Weight: w})
+	t.count += w
+	t.min = math.Min(t.min, x)
+	t.max = math.Max(t.max, x)
+	if len(t.buffer) >= t.bufferLimit() {
+		t.compress()
+	}
+}
+
+// Merge adds all the values summarized by o to t, o is not modified.
+func (t *TDigest) Merge(o *TDigest) {
+	if o == nil || o.count == 0 {
+		return
+	}
+	t.buffer = append(t.buffer, o.centroids...)
+	t.buffer = append(t.buffer, o.buffer...)
+	t.count += o.count
+	t.min = math.Min(t.min, o.min)
+	t.max = math.Max(t.max, o.max)
+	if len(t.buffer) >= t.bufferLimit() {
+		t.compress()
+	}
+}
+
+// Quantile returns the estimated value at quantile q, which is clamped
+// to [0, 1]. It returns NaN if the digest is empty.
+func (t *TDigest) Quantile(q float64) float64 {
+	t.compress()
+	if len(t.centroids) == 0 {
+		return math.NaN()
+	}
+	if len(t.centroids) == 1 || t.min == t.max {
+		return t.centroids[0].Mean
+	}
+	q = math.Max(0, math.Min(1, q))
+
+	// every centroid is placed at the middle of the weight it covers, the
+	// estimate interpolates linearly between the neighbouring centroids and
+	// between the outermost ones and the exact extremes.
+	index := q * t.count
+	first := t.centroids[0]
+	if index <= first.Weight/2 {
+		return t.min + (first.Mean-t.min)*index/(first.Weight/2)
+	}
+	last := t.centroids[len(t.centroids)-1]
+	if index >= t.count-last.Weight/2 {
+		return last.Mean + (t.max-last.Mean)*(index-(t.count-last.Weight/2))/(last.Weight/2)
+	}
+
+	center := first.Weight / 2
+	for i := 1; i < len(t.centroids); i++ {
+		prev, cur := t.centroids[i-1], t.centroids[i]
+		next := center + (prev.Weight+cur.Weight)/2
+		if index <= next {
+			return prev.Mean + (cur.Mean-prev.Mean)*(index-center)/(next-center)
+		}
+		center = next
+	}
+	return last.Mean
+}
+
+func (t *TDigest) bufferLimit() int {
+	return int(t.compression) * 5
+}
+
+// compress folds the buffered centroids into the sorted centroid list,
+// merging neighbours while they stay within one unit of the k1 scale
+// function, so that centroids near the median are large and the ones at
+// the tails are small.
+func (t *TDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+	all := append(t.buffer, t.centroids...)
+	slices.SortFunc(all, func(a, b Centroid) int {
+		switch {
+		case a.Mean < b.Mean:
+			return -1
+		case a.Mean > b.Mean:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	merged := make([]Centroid, 0, len(t.centroids)+1)
+	cur := all[0]
+	var weightSoFar float64
+	limit := t.count * t.kInv(t.k(0)+1)
+	for _, c := range all[1:] {
+		if weightSoFar+cur.Weight+c.Weight <= limit {
+			w := cur.Weight + c.Weight
+			cur.Mean += (c.Mean - cur.Mean) * c.Weight / w
+			cur.Weight = w
+			continue
+		}
+		merged = append(merged, cur)
+		weightSoFar += cur.Weight
+		limit = t.count * t.kInv(t.k(weightSoFar/t.count)+1)
+		cur = c
+	}
+	merged = append(merged, cur)
+
+	t.centroids = merged
+	t.buffer = t.buffer[:0]
+}
+
+// k is the k1 scale function, k(q) = δ/(2π) * asin(2q-1).
+func (t *TDigest) k(q float64) float64 {
+	return t.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// kInv is the inverse of k, saturated at 1 beyond the end of its domain.
+func (t *TDigest) kInv(k float64) float64 {
+	if k >= t.compression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/t.compression) + 1) / 2
+}
+
+// MarshalBinary encodes the digest as
+// [version][compression][min][max][n]([mean][weight])*n.
+func (t *TDigest) MarshalBinary() ([]byte, error) {
+	t.compress()
+	buf := make([]byte, headerSize+16*len(t.centroids))
+	buf[0] = version
+	binary.LittleEndian.PutUint64(buf[1:], math.Float64bits(t.compression))
+	binary.LittleEndian.PutUint64(buf[9:], math.Float64bits(t.min))
+	binary.LittleEndian.PutUint64(buf[17:], math.Float64bits(t.max))
+	binary.LittleEndian.PutUint32(buf[25:], uint32(len(t.centroids)))
+	off := headerSize
+	for _, c := range t.centroids {
+		binary.LittleEndian.PutUint64(buf[off:], math.Float64bits(c.Mean))
+		binary.LittleEndian.PutUint64(buf[off+8:], math.Float64bits(c.Weight))
+		off += 16
+	}
+	return buf, nil
+}
+
+func (t *TDigest) UnmarshalBinary(data []byte) error {
+	if len(data) < headerSize || data[0] != version {
+		return moerr.NewInvalidInputNoCtx("invalid t-digest sketch")
+	}
+	n := int(binary.LittleEndian.Uint32(data[25:]))
+	if len(data) != headerSize+16*n {
+		return moerr.NewInvalidInputNoCtx("invalid t-digest sketch")
+	}
+	compression := math.Float64frombits(binary.LittleEndian.Uint64(data[1:]))
+	if math.IsNaN(compression) || compression < 10 {
+		return moerr.NewInvalidInputNoCtx("invalid t-digest sketch")
+	}
+
+	t.compression = compression
+	t.min = math.Float64frombits(binary.LittleEndian.Uint64(data[9:]))
+	t.max = math.Float64frombits(binary.LittleEndian.Uint64(data[17:]))
+	t.centroids = make([]Centroid, n)
+	t.buffer = t.buffer[:0]
+	t.count = 0
+	off := headerSize
+	for i := range t.centroids {
+		t.centroids[i].Mean = math.Float64frombits(binary.LittleEndian.Uint64(data[off:]))
+		t.centroids[i].Weight = math.Float64frombits(binary.LittleEndian.Uint64(data[off+8:]))
+		if !(t.centroids[i].Weight > 0) {
+			return moerr.NewInvalidInputNoCtx("invalid t-digest sketch")
+		}
+		t.count += t.centroids[i].Weight
+		off += 16
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tdigest
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func exactQuantile(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(pos-float64(lo))
+}
+
+func TestEmptyAndSingle(t *testing.T) {
+	td := New(DefaultCompression)
+	require.True(t, math.IsNaN(td.Quantile(0.5)))
+	require.Equal(t, float64(0), td.Count())
+
+	td.Add(math.NaN())
+	require.Equal(t, float64(0), td.Count())
+
+	td.Add(42)
+	require.Equal(t, float64(1), td.Count())
+	for _, q := range []float64{0, 0.3, 1} {
+		require.Equal(t, float64(42), td.Quantile(q))
+	}
+}
+
+func TestQuantileAccuracy(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	vals := make([]float64, 100000)
+	td := New(DefaultCompression)
+	for i := range vals {
+		vals[i] = r.NormFloat64()*10 + 100
+		td.Add(vals[i])
+	}
+	slices.Sort(vals)
+
+	require.Equal(t, vals[0], td.Quantile(0))
+	require.Equal(t, vals[len(vals)-1], td.Quantile(1))
+	for _, q := range []float64{0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.99, 0.999} {
+		require.InDelta(t, exactQuantile(vals, q), td.Quantile(q), 0.5, "q=%v", q)
+	}
+	require.Less(t, len(td.centroids), 2*DefaultCompression)
+}
+
+func TestSmallInputIsExact(t *testing.T) {
+	td := New(DefaultCompression)
+	for _, v := range []float64{5, 1, 4, 2, 3} {
+		td.Add(v)
+	}
+	require.Equal(t, float64(1), td.Quantile(0))
+	require.Equal(t, float64(3), td.Quantile(0.5))
+	require.Equal(t, float64(5), td.Quantile(1))
+}
+
+func TestMerge(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	all := New(DefaultCompression)
+	parts := []*TDigest{New(DefaultCompression), New(DefaultCompression), New(DefaultCompression)}
+	vals := make([]float64, 30000)
+	for i := range vals {
+		vals[i] = r.ExpFloat64()
+		all.Add(vals[i])
+		parts[i%len(parts)].Add(vals[i])
+	}
+	slices.Sort(vals)
+
+	merged := New(DefaultCompression)
+	for _, p := range parts {
+		merged.Merge(p)
+	}
+	merged.Merge(New(DefaultCompression))
+	merged.Merge(nil)
+
+	require.Equal(t, all.Count(), merged.Count())
+	for _, q := range []float64{0.05, 0.5, 0.95, 0.99} {
+		require.InDelta(t, exactQuantile(vals, q), merged.Quantile(q), 0.05, "q=%v", q)
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	td := New(DefaultCompression)
+	for i := 0; i < 10000; i++ {
+		td.Add(float64(i))
+	}
+	bs, err := td.MarshalBinary()
+	require.NoError(t, err)
+
+	other := New(DefaultCompression)
+	require.NoError(t, other.UnmarshalBinary(bs))
+	require.Equal(t, td.Count(), other.Count())
+	for _, q := range []float64{0, 0.5, 0.95, 1} {
+		require.Equal(t, td.Quantile(q), other.Quantile(q))
+	}
+
+	empty, err := New(DefaultCompression).MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, other.UnmarshalBinary(empty))
+	require.Equal(t, float64(0), other.Count())
+	require.True(t, math.IsNaN(other.Quantile(0.5)))
+
+	require.Error(t, other.UnmarshalBinary(nil))
+	require.Error(t, other.UnmarshalBinary(bs[:len(bs)-1]))
+	bad := slices.Clone(bs)
+	bad[0] = 0
+	require.Error(t, other.UnmarshalBinary(bad))
+}
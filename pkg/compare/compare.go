@@ -149,7 +149,8 @@ func New(typ types.Type, desc, nullsLast bool) Compare {
 		}
 		return newCompare(types.GenericAscCompare[types.MoYear], genericCopy[types.MoYear], nullsLast)
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_json, types.T_text, types.T_datalink, types.T_geometry:
+		types.T_binary, types.T_varbinary, types.T_json, types.T_text, types.T_datalink, types.T_geometry,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		return &strCompare{
 			desc:        desc,
 			nullsLast:   nullsLast,
@@ -15,15 +15,76 @@
 package compress
 
 import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/pierrec/lz4/v4"
 )
 
 var Algorithms map[string]int = map[string]int{
 	"lz4":  Lz4,
 	"none": None,
+	"zstd": Zstd,
+}
+
+var (
+	// zstd encoders are expensive to build, keep one per level.
+	// EncodeAll and DecodeAll are safe for concurrent use.
+	zstdEncoders sync.Map // int -> *zstd.Encoder
+	zstdDecoder  *zstd.Decoder
+	zstdOnce     sync.Once
+)
+
+func getZstdEncoder(level int) (*zstd.Encoder, error) {
+	if v, ok := zstdEncoders.Load(level); ok {
+		return v.(*zstd.Encoder), nil
+	}
+	opts := []zstd.EOption{zstd.WithEncoderConcurrency(1)}
+	if level != DefaultLevel {
+		opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	}
+	enc, err := zstd.NewWriter(nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	v, _ := zstdEncoders.LoadOrStore(level, enc)
+	return v.(*zstd.Encoder), nil
+}
+
+func getZstdDecoder() *zstd.Decoder {
+	zstdOnce.Do(func() {
+		// only fails on invalid options
+		zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	})
+	return zstdDecoder
+}
+
+// CompressBound returns the max size of the compressed data of n bytes
+func CompressBound(typ int, n int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(n)
+	case Zstd:
+		// see ZSTD_COMPRESSBOUND in zstd.h
+		bound := n + n>>8
+		if n < 128<<10 {
+			bound += (128<<10 - n) >> 11
+		}
+		return bound
+	}
+	return n
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
+	return CompressWithLevel(src, dst, typ, DefaultLevel)
+}
+
+// CompressWithLevel compresses src into dst with the given level. The level
+// is ignored by codecs that do not support levels.
+func CompressWithLevel(src, dst []byte, typ int, level int) ([]byte, error) {
 	switch typ {
 	case Lz4:
 		n, err := lz4.CompressBlock(src, dst, nil)
@@ -31,6 +92,12 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		enc, err := getZstdEncoder(level)
+		if err != nil {
+			return nil, err
+		}
+		return enc.EncodeAll(src, dst[:0]), nil
 	}
 	return nil, nil
 }
@@ -43,6 +110,44 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		data, err := getZstdDecoder().DecodeAll(src, dst[:0])
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > cap(dst) {
+			return nil, moerr.NewInternalErrorNoCtxf(
+				"zstd decompressed size %d exceeds buffer size %d", len(data), cap(dst))
+		}
+		return data, nil
 	}
 	return nil, nil
 }
+
+// ParseAlgorithm parses a codec spec like "lz4", "none", "zstd" or "zstd(9)".
+func ParseAlgorithm(spec string) (T, int, error) {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	name, level := spec, DefaultLevel
+	if i := strings.IndexByte(spec, '('); i > 0 && strings.HasSuffix(spec, ")") {
+		name = strings.TrimSpace(spec[:i])
+		v, err := strconv.Atoi(strings.TrimSpace(spec[i+1 : len(spec)-1]))
+		if err != nil {
+			return None, 0, moerr.NewInvalidInputNoCtxf("invalid compression level in '%s'", spec)
+		}
+		level = v
+	}
+	alg, ok := Algorithms[name]
+	if !ok {
+		return None, 0, moerr.NewInvalidInputNoCtxf("unsupported compression algorithm '%s'", spec)
+	}
+	if level != DefaultLevel {
+		if alg != Zstd {
+			return None, 0, moerr.NewInvalidInputNoCtxf("compression algorithm '%s' does not support levels", name)
+		}
+		if level < MinZstdLevel || level > MaxZstdLevel {
+			return None, 0, moerr.NewInvalidInputNoCtxf(
+				"zstd compression level %d out of range [%d, %d]", level, MinZstdLevel, MaxZstdLevel)
+		}
+	}
+	return T(alg), level, nil
+}
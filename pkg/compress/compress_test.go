@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
 	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLz4(t *testing.T) {
@@ -41,3 +42,57 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestZstd(t *testing.T) {
+	xs := make([]int64, 0, 1024)
+	for i := 0; i < 1024; i++ {
+		xs = append(xs, int64(i%16))
+	}
+	raw := types.EncodeSlice(xs)
+	for _, level := range []int{DefaultLevel, MinZstdLevel, 9, MaxZstdLevel} {
+		buf := make([]byte, CompressBound(Zstd, len(raw)))
+		buf, err := CompressWithLevel(raw, buf, Zstd, level)
+		require.NoError(t, err)
+		require.Less(t, len(buf), len(raw))
+
+		dst := make([]byte, len(raw))
+		data, err := Decompress(buf, dst, Zstd)
+		require.NoError(t, err)
+		require.Equal(t, raw, data)
+	}
+
+	// the destination buffer is too small
+	buf, err := Compress(raw, make([]byte, CompressBound(Zstd, len(raw))), Zstd)
+	require.NoError(t, err)
+	_, err = Decompress(buf, make([]byte, len(raw)/2), Zstd)
+	require.Error(t, err)
+}
+
+func TestParseAlgorithm(t *testing.T) {
+	cases := []struct {
+		spec  string
+		alg   T
+		level int
+		ok    bool
+	}{
+		{"lz4", Lz4, DefaultLevel, true},
+		{" NONE ", None, DefaultLevel, true},
+		{"zstd", Zstd, DefaultLevel, true},
+		{"ZSTD(9)", Zstd, 9, true},
+		{"zstd( 22 )", Zstd, 22, true},
+		{"zstd(23)", None, 0, false},
+		{"zstd(x)", None, 0, false},
+		{"lz4(3)", None, 0, false},
+		{"zlib", None, 0, false},
+	}
+	for _, c := range cases {
+		alg, level, err := ParseAlgorithm(c.spec)
+		if !c.ok {
+			require.Error(t, err, c.spec)
+			continue
+		}
+		require.NoError(t, err, c.spec)
+		require.Equal(t, c.alg, alg, c.spec)
+		require.Equal(t, c.level, level, c.spec)
+	}
+}
@@ -19,6 +19,15 @@ import "fmt"
 const (
 	None = iota
 	Lz4
+	Zstd
+)
+
+const (
+	// DefaultLevel lets the codec pick its own default level.
+	DefaultLevel = 0
+	// MinZstdLevel and MaxZstdLevel bound the levels accepted for zstd.
+	MinZstdLevel = 1
+	MaxZstdLevel = 22
 )
 
 type T uint8
@@ -29,6 +38,8 @@ func (t T) String() string {
 		return "None"
 	case Lz4:
 		return "LZ4"
+	case Zstd:
+		return "ZSTD"
 	}
 	return fmt.Sprintf("unexpected compress type: %d", t)
 }
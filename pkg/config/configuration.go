@@ -52,6 +52,12 @@ var (
 	//listening unix domain socket
 	defaultUnixAddr = "/tmp/mysql.sock"
 
+	//pgPort defines which port the PostgreSQL protocol listener listens on
+	defaultPgPort = 5432
+
+	//httpSqlPort defines which port the HTTP SQL endpoint listens on
+	defaultHttpSqlPort = 6080
+
 	//guest mmu limitation.  1 << 40 = 1099511627776
 	defaultGuestMmuLimitation = 1099511627776
 
@@ -218,6 +224,21 @@ type FrontendParameters struct {
 	// UnixSocketAddress listening unix domain socket
 	UnixSocketAddress string `toml:"unix-socket" user_setting:"advanced"`
 
+	// EnablePgProtocol starts an extra listener which speaks the PostgreSQL v3 protocol.
+	// The users log in with SCRAM-SHA-256, whose verifier is saved when the password is
+	// set. The users created before the upgrade have none and must set their password
+	// again before they can log in over it.
+	EnablePgProtocol bool `toml:"enablePgProtocol" user_setting:"advanced"`
+
+	// PgPort defines which port the PostgreSQL protocol listener listens on
+	PgPort int64 `toml:"pgPort" user_setting:"advanced"`
+
+	// EnableHttpSql starts an extra listener which executes the statements sent by HTTP
+	EnableHttpSql bool `toml:"enableHttpSql" user_setting:"advanced"`
+
+	// HttpSqlPort defines which port the HTTP SQL endpoint listens on
+	HttpSqlPort int64 `toml:"httpSqlPort" user_setting:"advanced"`
+
 	//guest mmu limitation. default: 1 << 40 = 1099511627776
 	GuestMmuLimitation int64 `toml:"guestMmuLimitation"`
 
@@ -389,6 +410,14 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.UnixSocketAddress = defaultUnixAddr
 	}
 
+	if fp.PgPort == 0 {
+		fp.PgPort = int64(defaultPgPort)
+	}
+
+	if fp.HttpSqlPort == 0 {
+		fp.HttpSqlPort = int64(defaultHttpSqlPort)
+	}
+
 	if fp.GuestMmuLimitation == 0 {
 		fp.GuestMmuLimitation = int64(toml.ByteSize(defaultGuestMmuLimitation))
 	}
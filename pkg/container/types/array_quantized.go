@@ -0,0 +1,226 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// NOTE: vecf16, veci8 and vecbit in SQL are internally represented using T_array_float16,
+// T_array_int8 and T_array_bit. They are stored as varlena like vecf32 and vecf64:
+//   - vecf16: 2 bytes per element, IEEE 754 half precision, little-endian.
+//   - veci8:  1 byte per element, two's complement.
+//   - vecbit: 1 bit per element, packed 8 elements per byte, the first element
+//     is the most significant bit of the first byte. The dimension must be a
+//     multiple of 8.
+//
+// The scale of veci8 is fixed to 1, an element is stored as the integer of
+// its value on every path: the string input must be integers and the casts
+// from the float vectors round to the nearest integer. So the cast back to a
+// float vector returns the same integers, and the distances of veci8 are the
+// distances of those values. The embeddings of small magnitude, e.g. the
+// normalized ones, should be scaled before the cast, e.g. multiplied by 127.
+
+const (
+	MaxFloat16 = 65504
+)
+
+// Float16 is an IEEE 754 half precision float.
+type Float16 uint16
+
+// Float16FromFloat32 converts f to the nearest half precision float, ties to even.
+// Values out of the half precision range become +/-Inf.
+func Float16FromFloat32(f float32) Float16 {
+	b := math.Float32bits(f)
+	sign := uint16(b>>16) & 0x8000
+	exp := int32(b>>23&0xff) - 127 + 15
+	mant := b & 0x7fffff
+
+	switch {
+	case b&0x7fffffff > 0x7f800000:
+		// NaN
+		return Float16(sign | 0x7e00)
+	case exp >= 0x1f:
+		// overflow or Inf
+		return Float16(sign | 0x7c00)
+	case exp <= 0:
+		// subnormal or zero
+		if exp < -10 {
+			return Float16(sign)
+		}
+		mant |= 0x800000
+		shift := uint32(14 - exp)
+		half := mant >> shift
+		rem := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rem > halfway || (rem == halfway && half&1 == 1) {
+			half++
+		}
+		return Float16(sign | uint16(half))
+	default:
+		half := uint32(exp)<<10 | mant>>13
+		rem := mant & 0x1fff
+		// a carry out of the mantissa bumps the exponent, which is still correct
+		if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+			half++
+		}
+		return Float16(sign | uint16(half))
+	}
+}
+
+// Float32 converts h to float32 without loss.
+func (h Float16) Float32() float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// subnormal, normalize it
+		e := uint32(127 - 15 + 1)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		mant &= 0x3ff
+		return math.Float32frombits(sign | e<<23 | mant<<13)
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
+
+// QuantizedArrayDimension returns the dimension of the quantized array stored in input.
+func QuantizedArrayDimension(oid T, input []byte) int {
+	switch oid {
+	case T_array_float16:
+		return len(input) / 2
+	case T_array_int8:
+		return len(input)
+	case T_array_bit:
+		return len(input) * 8
+	}
+	panic(moerr.NewInternalErrorNoCtxf("unknown quantized array type %s", oid.String()))
+}
+
+// QuantizedBytesToFloat32 decodes the quantized array stored in input. Bits are decoded as 0 or 1.
+func QuantizedBytesToFloat32(oid T, input []byte) []float32 {
+	res := make([]float32, QuantizedArrayDimension(oid, input))
+	switch oid {
+	case T_array_float16:
+		for i := range res {
+			res[i] = Float16(uint16(input[2*i]) | uint16(input[2*i+1])<<8).Float32()
+		}
+	case T_array_int8:
+		for i, v := range input {
+			res[i] = float32(int8(v))
+		}
+	case T_array_bit:
+		for i := range res {
+			if input[i/8]&(0x80>>(i%8)) != 0 {
+				res[i] = 1
+			}
+		}
+	}
+	return res
+}
+
+// Float32ToQuantizedBytes encodes input as a quantized array of type oid.
+// vecf16 rounds to the nearest half precision float, veci8 rounds to the
+// nearest integer and vecbit maps the positive elements to 1 and the others
+// to 0. Elements out of the range of vecf16 and veci8 are errors.
+func Float32ToQuantizedBytes(oid T, input []float32) ([]byte, error) {
+	switch oid {
+	case T_array_float16:
+		res := make([]byte, 2*len(input))
+		for i, v := range input {
+			if v > MaxFloat16 || v < -MaxFloat16 {
+				return nil, moerr.NewOutOfRangeNoCtxf(oid.String(), "value %v", v)
+			}
+			h := Float16FromFloat32(v)
+			res[2*i] = byte(h)
+			res[2*i+1] = byte(h >> 8)
+		}
+		return res, nil
+	case T_array_int8:
+		res := make([]byte, len(input))
+		for i, v := range input {
+			r := math.RoundToEven(float64(v))
+			if r > math.MaxInt8 || r < math.MinInt8 || math.IsNaN(r) {
+				return nil, moerr.NewOutOfRangeNoCtxf(oid.String(), "value %v", v)
+			}
+			res[i] = byte(int8(r))
+		}
+		return res, nil
+	case T_array_bit:
+		if len(input)%8 != 0 {
+			return nil, moerr.NewInvalidInputNoCtxf("the dimension of %s must be a multiple of 8, got %d", oid.String(), len(input))
+		}
+		res := make([]byte, len(input)/8)
+		for i, v := range input {
+			if v > 0 {
+				res[i/8] |= 0x80 >> (i % 8)
+			}
+		}
+		return res, nil
+	}
+	return nil, moerr.NewInternalErrorNoCtxf("unknown quantized array type %s", oid.String())
+}
+
+// StringToQuantizedArrayBytes converts "[1,2,3]" to the quantized array of type oid.
+// Unlike the casts from vecf32, the elements of veci8 must be integers and the
+// elements of vecbit must be 0 or 1.
+func StringToQuantizedArrayBytes(oid T, str string) ([]byte, error) {
+	a, err := StringToArray[float32](str)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range a {
+		switch oid {
+		case T_array_int8:
+			if v != float32(math.Trunc(float64(v))) {
+				return nil, moerr.NewInvalidInputNoCtxf("malformed %s input: %s", oid.String(), str)
+			}
+		case T_array_bit:
+			if v != 0 && v != 1 {
+				return nil, moerr.NewInvalidInputNoCtxf("malformed %s input: %s", oid.String(), str)
+			}
+		}
+	}
+	return Float32ToQuantizedBytes(oid, a)
+}
+
+// QuantizedArrayBytesToString converts the quantized array of type oid to "[1, 2, 3]".
+func QuantizedArrayBytesToString(oid T, input []byte) string {
+	var buffer bytes.Buffer
+	_, _ = io.WriteString(&buffer, "[")
+	for i, value := range QuantizedBytesToFloat32(oid, input) {
+		if i > 0 {
+			_, _ = io.WriteString(&buffer, ", ")
+		}
+		_, _ = io.WriteString(&buffer, strconv.FormatFloat(float64(value), 'f', -1, 32))
+	}
+	_, _ = io.WriteString(&buffer, "]")
+	return buffer.String()
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloat16(t *testing.T) {
+	tests := []struct {
+		f float32
+		h Float16
+	}{
+		{0, 0x0000},
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{0.5, 0x3800},
+		{MaxFloat16, 0x7bff},
+		{float32(math.Inf(1)), 0x7c00},
+		// the smallest subnormal
+		{5.9604645e-8, 0x0001},
+		// the largest subnormal
+		{6.097555e-5, 0x03ff},
+		// the smallest normal
+		{6.1035156e-5, 0x0400},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.h, Float16FromFloat32(tt.f), "%v", tt.f)
+		require.Equal(t, tt.f, tt.h.Float32(), "%x", tt.h)
+	}
+
+	// ties to even
+	require.Equal(t, Float16(0x3c00), Float16FromFloat32(1+1.0/2048))
+	require.Equal(t, Float16(0x3c02), Float16FromFloat32(1+3.0/2048))
+	require.Equal(t, Float16(0x7c00), Float16FromFloat32(65520))
+	require.Equal(t, Float16(0x0000), Float16FromFloat32(1e-10))
+	require.True(t, math.IsNaN(float64(Float16FromFloat32(float32(math.NaN())).Float32())))
+
+	// every half precision float survives the round trip
+	for h := 0; h < 0x10000; h++ {
+		if h&0x7c00 == 0x7c00 && h&0x3ff != 0 {
+			continue
+		}
+		require.Equal(t, Float16(h), Float16FromFloat32(Float16(h).Float32()))
+	}
+}
+
+func TestQuantizedArray(t *testing.T) {
+	b, err := StringToQuantizedArrayBytes(T_array_float16, "[1, -2.5, 0.1]")
+	require.NoError(t, err)
+	require.Equal(t, 6, len(b))
+	require.Equal(t, 3, QuantizedArrayDimension(T_array_float16, b))
+	require.Equal(t, "[1, -2.5, 0.099975586]", QuantizedArrayBytesToString(T_array_float16, b))
+	_, err = StringToQuantizedArrayBytes(T_array_float16, "[1, 70000]")
+	require.Error(t, err)
+
+	b, err = StringToQuantizedArrayBytes(T_array_int8, "[1, -128, 127]")
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 0x80, 0x7f}, b)
+	require.Equal(t, "[1, -128, 127]", QuantizedArrayBytesToString(T_array_int8, b))
+	_, err = StringToQuantizedArrayBytes(T_array_int8, "[1.5]")
+	require.Error(t, err)
+	_, err = StringToQuantizedArrayBytes(T_array_int8, "[128]")
+	require.Error(t, err)
+
+	b, err = StringToQuantizedArrayBytes(T_array_bit, "[1,0,0,0,0,0,0,1, 0,1,0,0,0,0,0,0]")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x81, 0x40}, b)
+	require.Equal(t, 16, QuantizedArrayDimension(T_array_bit, b))
+	require.Equal(t, "[1, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 0, 0, 0, 0, 0]", QuantizedArrayBytesToString(T_array_bit, b))
+	_, err = StringToQuantizedArrayBytes(T_array_bit, "[1,0,1]")
+	require.Error(t, err)
+	_, err = StringToQuantizedArrayBytes(T_array_bit, "[2,0,0,0,0,0,0,0]")
+	require.Error(t, err)
+	_, err = StringToQuantizedArrayBytes(T_array_bit, "1,0")
+	require.Error(t, err)
+
+	// casts from vecf32
+	b, err = Float32ToQuantizedBytes(T_array_int8, []float32{1.4, -2.6, 2.5})
+	require.NoError(t, err)
+	require.Equal(t, []float32{1, -3, 2}, QuantizedBytesToFloat32(T_array_int8, b))
+	// the scale is fixed, the float vector is encoded as the string input
+	// and decoded back as it is
+	b, err = Float32ToQuantizedBytes(T_array_int8, []float32{1, 2, 3})
+	require.NoError(t, err)
+	fromStr, err := StringToQuantizedArrayBytes(T_array_int8, "[1, 2, 3]")
+	require.NoError(t, err)
+	require.Equal(t, fromStr, b)
+	require.Equal(t, []float32{1, 2, 3}, QuantizedBytesToFloat32(T_array_int8, b))
+	_, err = Float32ToQuantizedBytes(T_array_int8, []float32{float32(math.NaN()), 1})
+	require.Error(t, err)
+	b, err = Float32ToQuantizedBytes(T_array_bit, []float32{0.3, -1, 0, 2, 0, 0, 0, 0.1})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x91}, b)
+	_, err = Float32ToQuantizedBytes(T_array_float32, []float32{1})
+	require.Error(t, err)
+}
@@ -98,6 +98,9 @@ const (
 	// Array/Vector family
 	T_array_float32 T = 224 // In SQL , it is vecf32
 	T_array_float64 T = 225 // In SQL , it is vecf64
+	T_array_float16 T = 226 // In SQL , it is vecf16
+	T_array_int8    T = 227 // In SQL , it is veci8
+	T_array_bit     T = 228 // In SQL , it is vecbit
 
 	//note: max value of uint8 is 255
 )
@@ -426,6 +429,9 @@ var Types = map[string]T{
 
 	"array float32": T_array_float32,
 	"array float64": T_array_float64,
+	"array float16": T_array_float16,
+	"array int8":    T_array_int8,
+	"array bit":     T_array_bit,
 }
 
 func New(oid T, width, scale int32) Type {
@@ -571,6 +577,12 @@ func (t Type) DescString() string {
 		return fmt.Sprintf("VECF32(%d)", t.Width)
 	case T_array_float64:
 		return fmt.Sprintf("VECF64(%d)", t.Width)
+	case T_array_float16:
+		return fmt.Sprintf("VECF16(%d)", t.Width)
+	case T_array_int8:
+		return fmt.Sprintf("VECI8(%d)", t.Width)
+	case T_array_bit:
+		return fmt.Sprintf("VECBIT(%d)", t.Width)
 	}
 	return t.Oid.String()
 }
@@ -581,6 +593,10 @@ func (t Type) GetArrayElementSize() int {
 		return 4
 	case T_array_float64:
 		return 8
+	case T_array_float16:
+		return 2
+	case T_array_int8:
+		return 1
 	}
 	panic(moerr.NewInternalErrorNoCtx(fmt.Sprintf("unknown array type %d", t)))
 }
@@ -653,7 +669,7 @@ func (t T) ToType() Type {
 	case T_varchar:
 		typ.Size = VarlenaSize
 		typ.Width = MaxVarcharLen
-	case T_array_float32, T_array_float64:
+	case T_array_float32, T_array_float64, T_array_float16, T_array_int8, T_array_bit:
 		typ.Size = VarlenaSize
 		typ.Width = MaxArrayDimension
 	case T_binary:
@@ -761,6 +777,12 @@ func (t T) String() string {
 		return "VECF32"
 	case T_array_float64:
 		return "VECF64"
+	case T_array_float16:
+		return "VECF16"
+	case T_array_int8:
+		return "VECI8"
+	case T_array_bit:
+		return "VECBIT"
 	case T_enum:
 		return "ENUM"
 	}
@@ -846,6 +868,12 @@ func (t T) OidString() string {
 		return "T_array_float32"
 	case T_array_float64:
 		return "T_array_float64"
+	case T_array_float16:
+		return "T_array_float16"
+	case T_array_int8:
+		return "T_array_int8"
+	case T_array_bit:
+		return "T_array_bit"
 	}
 	return "unknown_type"
 }
@@ -879,7 +907,7 @@ func (t T) TypeLen() int {
 		return 4
 	case T_float64:
 		return 8
-	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64, T_datalink, T_geometry, T_geometry32:
+	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64, T_array_float16, T_array_int8, T_array_bit, T_datalink, T_geometry, T_geometry32:
 		return VarlenaSize
 	case T_decimal64:
 		return 8
@@ -934,7 +962,7 @@ func (t T) FixedLength() int {
 		return RowidSize
 	case T_Blockid:
 		return BlockidSize
-	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64, T_datalink, T_geometry, T_geometry32:
+	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64, T_array_float16, T_array_int8, T_array_bit, T_datalink, T_geometry, T_geometry32:
 		return -24
 	case T_enum:
 		return 2
@@ -1021,6 +1049,12 @@ func (t T) IsArrayRelate() bool {
 	return false
 }
 
+// IsQuantizedArray returns true for the vector types with a compact element
+// encoding, i.e. vecf16, veci8 and vecbit.
+func (t T) IsQuantizedArray() bool {
+	return t == T_array_float16 || t == T_array_int8 || t == T_array_bit
+}
+
 func (t T) IsDatalink() bool {
 	return t == T_datalink
 }
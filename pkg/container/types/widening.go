@@ -0,0 +1,36 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// widenings are the type promotions the parquet reader of external tables
+// applies to the columns of a file (see PARQUET_TYPE_CONVERSIONS.md in
+// pkg/sql/colexec/external), keyed by the source type.
+var widenings = map[T][]T{
+	T_int32:   {T_int64, T_float64},
+	T_int64:   {T_float64},
+	T_uint32:  {T_uint64},
+	T_float32: {T_float64},
+}
+
+// CanWiden reports whether the values of type from are read into a column of
+// type to the way external tables widen parquet columns.
+func CanWiden(from, to T) bool {
+	for _, t := range widenings[from] {
+		if t == to {
+			return true
+		}
+	}
+	return false
+}
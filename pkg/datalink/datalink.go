@@ -24,7 +24,12 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/datalink/docx"
+	"github.com/matrixorigin/matrixone/pkg/datalink/epub"
+	"github.com/matrixorigin/matrixone/pkg/datalink/html"
+	"github.com/matrixorigin/matrixone/pkg/datalink/markdown"
 	"github.com/matrixorigin/matrixone/pkg/datalink/pdf"
+	"github.com/matrixorigin/matrixone/pkg/datalink/pptx"
+	"github.com/matrixorigin/matrixone/pkg/datalink/xlsx"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/stage"
 	"github.com/matrixorigin/matrixone/pkg/stage/stageutil"
@@ -74,14 +79,65 @@ func (d Datalink) GetPlainText(proc *process.Process) ([]byte, error) {
 		return nil, err
 	}
 
-	ext := strings.ToLower(filepath.Ext(d.Url.Path))
+	extract := plainTextExtractor(d.Url.Path)
+	if extract == nil {
+		return fileBytes, nil
+	}
+	return extract(fileBytes)
+}
+
+// GetPlainTextPages returns the plain text of every page of a paged document
+// such as pdf, or nil if the document has no pages.
+func (d Datalink) GetPlainTextPages(proc *process.Process) ([][]byte, error) {
+
+	if strings.ToLower(filepath.Ext(d.Url.Path)) != ".pdf" {
+		return nil, nil
+	}
+
+	fileBytes, err := d.GetBytes(proc)
+	if err != nil {
+		return nil, err
+	}
+	return pdf.GetPages(fileBytes)
+}
+
+// HasPlainTextExtractor returns true if the file is a document whose plain
+// text has to be extracted, e.g. pdf or docx, rather than read as is.
+func (d Datalink) HasPlainTextExtractor() bool {
+	return plainTextExtractor(d.Url.Path) != nil
+}
+
+// IsMarkdown returns true if the file is a markdown document.
+func (d Datalink) IsMarkdown() bool {
+	switch strings.ToLower(filepath.Ext(d.Url.Path)) {
+	case ".md", ".markdown":
+		return true
+	default:
+		return false
+	}
+}
+
+// plainTextExtractor returns the function that extracts the plain text of
+// the file by its extension, or nil for plain text files.
+func plainTextExtractor(filePath string) func([]byte) ([]byte, error) {
+	ext := strings.ToLower(filepath.Ext(filePath))
 	switch ext {
 	case ".pdf":
-		return pdf.GetPlainText(fileBytes)
+		return pdf.GetPlainText
 	case ".docx":
-		return docx.GetPlainText(fileBytes)
+		return docx.GetPlainText
+	case ".html", ".htm":
+		return html.GetPlainText
+	case ".md", ".markdown":
+		return markdown.GetPlainText
+	case ".xlsx":
+		return xlsx.GetPlainText
+	case ".pptx":
+		return pptx.GetPlainText
+	case ".epub":
+		return epub.GetPlainText
 	default:
-		return fileBytes, nil
+		return nil
 	}
 }
 
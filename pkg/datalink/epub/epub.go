@@ -0,0 +1,166 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package epub
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/datalink/html"
+)
+
+// epub parser gets the text of the book in reading order.
+// epub file is zip file.  META-INF/container.xml points to the package
+// document (.opf), whose manifest lists the files of the book and whose spine
+// lists the XHTML documents in reading order.  The text of every document is
+// extracted by the html parser and documents are separated by an empty line.
+
+type container struct {
+	Rootfiles []struct {
+		FullPath  string `xml:"full-path,attr"`
+		MediaType string `xml:"media-type,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+type packageDoc struct {
+	Items []struct {
+		ID        string `xml:"id,attr"`
+		Href      string `xml:"href,attr"`
+		MediaType string `xml:"media-type,attr"`
+	} `xml:"manifest>item"`
+	ItemRefs []struct {
+		IDRef string `xml:"idref,attr"`
+	} `xml:"spine>itemref"`
+}
+
+func readZipFile(files map[string]*zip.File, name string) ([]byte, bool, error) {
+	f, ok := files[name]
+	if !ok {
+		return nil, false, nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, false, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+// rootfile returns the path of the package document.
+func rootfile(files map[string]*zip.File) (string, error) {
+	data, ok, err := readZipFile(files, "META-INF/container.xml")
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", moerr.NewInternalErrorNoCtx("META-INF/container.xml not found in epub file")
+	}
+	var c container
+	if err = xml.Unmarshal(data, &c); err != nil {
+		return "", err
+	}
+	for _, rf := range c.Rootfiles {
+		if rf.MediaType == "" || rf.MediaType == "application/oebps-package+xml" {
+			return rf.FullPath, nil
+		}
+	}
+	return "", moerr.NewInternalErrorNoCtx("package document not found in epub file")
+}
+
+func ParseTextFromReader(reader io.ReaderAt, size int64) (string, error) {
+	r, err := zip.NewReader(reader, size)
+	if err != nil {
+		return "", err
+	}
+	files := make(map[string]*zip.File, len(r.File))
+	for _, f := range r.File {
+		files[f.Name] = f
+	}
+
+	opfPath, err := rootfile(files)
+	if err != nil {
+		return "", err
+	}
+	data, ok, err := readZipFile(files, opfPath)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", moerr.NewInternalErrorNoCtxf("%s not found in epub file", opfPath)
+	}
+	var pkg packageDoc
+	if err = xml.Unmarshal(data, &pkg); err != nil {
+		return "", err
+	}
+
+	// manifest hrefs are relative to the package document
+	dir := path.Dir(opfPath)
+	hrefs := make(map[string]string, len(pkg.Items))
+	for _, item := range pkg.Items {
+		if item.MediaType != "application/xhtml+xml" && item.MediaType != "text/html" {
+			continue
+		}
+		href, err := url.PathUnescape(item.Href)
+		if err != nil {
+			href = item.Href
+		}
+		hrefs[item.ID] = path.Join(dir, href)
+	}
+
+	var buf bytes.Buffer
+	for _, ref := range pkg.ItemRefs {
+		name, ok := hrefs[ref.IDRef]
+		if !ok {
+			continue
+		}
+		doc, ok, err := readZipFile(files, name)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			continue
+		}
+		text, err := html.Extract(bytes.NewReader(doc))
+		if err != nil {
+			return "", err
+		}
+		if text == "" {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteString("\n\n")
+		}
+		buf.WriteString(text)
+	}
+	return buf.String(), nil
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	text, err := ParseTextFromReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strings.TrimSpace(text)), nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package epub
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newZip(t *testing.T, files [][2]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f[0])
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(f[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestGetPlainText(t *testing.T) {
+	data := newZip(t, [][2]string{
+		{"mimetype", "application/epub+zip"},
+		{"META-INF/container.xml", `<?xml version="1.0"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>`},
+		{"OEBPS/content.opf", `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0">
+  <manifest>
+    <item id="css" href="style.css" media-type="text/css"/>
+    <item id="ch1" href="text/chapter%201.xhtml" media-type="application/xhtml+xml"/>
+    <item id="ch2" href="text/chapter2.xhtml" media-type="application/xhtml+xml"/>
+  </manifest>
+  <spine>
+    <itemref idref="ch2"/>
+    <itemref idref="css"/>
+    <itemref idref="ch1"/>
+  </spine>
+</package>`},
+		{"OEBPS/style.css", "p { color: red; }"},
+		{"OEBPS/text/chapter 1.xhtml", `<?xml version="1.0" encoding="UTF-8"?>
+<html xmlns="http://www.w3.org/1999/xhtml"><head><title>One</title></head>
+<body><h1>Chapter One</h1><p>The end.</p></body></html>`},
+		{"OEBPS/text/chapter2.xhtml", `<?xml version="1.0" encoding="UTF-8"?>
+<html xmlns="http://www.w3.org/1999/xhtml"><head><title>Two</title></head>
+<body><h1>Prologue</h1><p>It began.</p></body></html>`},
+	})
+
+	text, err := GetPlainText(data)
+	require.NoError(t, err)
+	require.Equal(t, "Prologue\n\nIt began.\n\nChapter One\n\nThe end.", string(text))
+}
+
+func TestGetPlainTextInvalid(t *testing.T) {
+	_, err := GetPlainText([]byte("not a zip file"))
+	require.Error(t, err)
+
+	_, err = GetPlainText(newZip(t, [][2]string{{"mimetype", "application/epub+zip"}}))
+	require.Error(t, err)
+
+	_, err = GetPlainText(newZip(t, [][2]string{
+		{"META-INF/container.xml", `<container><rootfiles><rootfile full-path="missing.opf"/></rootfiles></container>`},
+	}))
+	require.Error(t, err)
+}
@@ -0,0 +1,200 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package html
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"golang.org/x/net/html"
+)
+
+// html parser gets the visible text of a html page.
+// The content of script, style and other non-visible elements is dropped,
+// block elements such as <p> and <h1> start a new paragraph, <div>, <li> and
+// <tr> start a new line and table cells are separated by tab.  Whitespace is collapsed as the browser
+// does, except inside <pre>.
+
+// skipped elements, their content is never rendered as text
+var skipTags = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"noscript": true,
+	"title":    true,
+	"template": true,
+	"svg":      true,
+	"canvas":   true,
+	"iframe":   true,
+	"object":   true,
+}
+
+// block elements start a new paragraph
+var paragraphTags = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true,
+	"dl": true, "fieldset": true, "figure": true, "footer": true,
+	"form": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true,
+	"h6": true, "header": true, "hr": true, "main": true, "nav": true,
+	"ol": true, "p": true, "pre": true, "section": true, "table": true,
+	"ul": true,
+}
+
+// line elements start a new line
+var lineTags = map[string]bool{
+	"br": true, "dd": true, "div": true, "dt": true, "figcaption": true,
+	"li": true, "tr": true,
+}
+
+// cell elements are separated by tab
+var cellTags = map[string]bool{
+	"td": true,
+	"th": true,
+}
+
+type extractor struct {
+	buf bytes.Buffer
+	// number of newlines to write before the next text
+	lines int
+	// write a tab before the next text
+	tab bool
+	// write a space before the next text
+	space bool
+	// depth of <pre> elements
+	pre int
+	// depth of skipped elements
+	skip int
+}
+
+func (e *extractor) newline(n int) {
+	e.lines = max(e.lines, n)
+}
+
+// separate writes the pending separator before the next text.
+func (e *extractor) separate() {
+	if e.buf.Len() > 0 {
+		switch {
+		case e.lines > 0:
+			e.buf.WriteString(strings.Repeat("\n", e.lines))
+		case e.tab:
+			e.buf.WriteByte('\t')
+		case e.space:
+			e.buf.WriteByte(' ')
+		}
+	}
+	e.lines, e.tab, e.space = 0, false, false
+}
+
+func (e *extractor) text(s string) {
+	if e.pre > 0 {
+		if s != "" {
+			e.separate()
+			e.buf.WriteString(s)
+		}
+		return
+	}
+	if len(s) > 0 && isSpace(s[0]) {
+		e.space = true
+	}
+	for i, f := range strings.Fields(s) {
+		if i == 0 {
+			e.separate()
+		} else {
+			e.buf.WriteByte(' ')
+		}
+		e.buf.WriteString(f)
+	}
+	if len(s) > 0 && isSpace(s[len(s)-1]) {
+		e.space = true
+	}
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// block starts a new paragraph, line or cell for the tag.
+func (e *extractor) block(tag string) {
+	switch {
+	case paragraphTags[tag]:
+		e.newline(2)
+	case lineTags[tag]:
+		e.newline(1)
+	case cellTags[tag]:
+		e.tab = true
+	}
+}
+
+// Extract returns the visible text of the html document read from r.
+func Extract(r io.Reader) (string, error) {
+	e := &extractor{}
+	z := html.NewTokenizer(r)
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if z.Err() == io.EOF {
+				return e.buf.String(), nil
+			}
+			return "", moerr.NewInternalErrorNoCtxf("Error parsing html - %s", z.Err())
+		case html.TextToken:
+			if e.skip == 0 {
+				e.text(string(z.Text()))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if skipTags[tag] {
+				if tt == html.StartTagToken {
+					e.skip++
+				}
+				continue
+			}
+			if e.skip > 0 {
+				continue
+			}
+			e.block(tag)
+			if tag == "pre" && tt == html.StartTagToken {
+				e.pre++
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if skipTags[tag] {
+				if e.skip > 0 {
+					e.skip--
+				}
+				continue
+			}
+			if e.skip > 0 {
+				continue
+			}
+			if tag == "pre" && e.pre > 0 {
+				e.pre--
+			}
+			if tag != "br" {
+				e.block(tag)
+			}
+		}
+	}
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	text, err := Extract(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
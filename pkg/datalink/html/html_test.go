@@ -0,0 +1,61 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package html
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlainText(t *testing.T) {
+	doc := `<!DOCTYPE html>
+<html>
+<head>
+  <title>Page title</title>
+  <style>body { color: red; }</style>
+  <script>var x = "<p>not text</p>";</script>
+</head>
+<body>
+  <h1>Heading</h1>
+  <p>This is   a <b>bold</b>
+     paragraph &amp; more.</p>
+  <noscript>enable javascript</noscript>
+  <ul><li>one</li><li>two</li></ul>
+  <table><tr><th>a</th><th>b</th></tr><tr><td>1</td><td>2</td></tr></table>
+  <pre>line 1
+  line 2</pre>
+  <div>x<br>y</div>
+</body>
+</html>`
+
+	text, err := GetPlainText([]byte(doc))
+	require.NoError(t, err)
+	require.Equal(t, "Heading\n\nThis is a bold paragraph & more.\n\none\ntwo\n\na\tb\n1\t2\n\nline 1\n  line 2\n\nx\ny", string(text))
+}
+
+func TestGetPlainTextInline(t *testing.T) {
+	text, err := GetPlainText([]byte("<span>foo</span><span>bar</span> <i>baz</i>"))
+	require.NoError(t, err)
+	require.Equal(t, "foobar baz", string(text))
+
+	text, err = GetPlainText([]byte("plain text without tags"))
+	require.NoError(t, err)
+	require.Equal(t, "plain text without tags", string(text))
+
+	text, err = GetPlainText(nil)
+	require.NoError(t, err)
+	require.Equal(t, "", string(text))
+}
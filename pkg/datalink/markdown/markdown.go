@@ -0,0 +1,183 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package markdown
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// markdown parser strips the markdown syntax and keeps the text.
+// Headings, block quotes, list markers, emphasis, inline code, links and
+// images are reduced to their text; the content of fenced code blocks is kept
+// as is.  YAML front matter, link reference definitions, horizontal rules,
+// html tags and table delimiter rows are dropped.
+
+var (
+	headingRe   = regexp.MustCompile(`^#{1,6}\s+`)
+	headingEnd  = regexp.MustCompile(`\s+#+\s*$`)
+	quoteRe     = regexp.MustCompile(`^(\s*>)+\s?`)
+	bulletRe    = regexp.MustCompile(`^\s*[-*+]\s+(\[[ xX]\]\s+)?`)
+	orderedRe   = regexp.MustCompile(`^\s*\d+[.)]\s+`)
+	ruleRe      = regexp.MustCompile(`^\s*([-*_])(\s*[-*_]){2,}\s*$`)
+	setextRe    = regexp.MustCompile(`^\s*(=+|-+)\s*$`)
+	refDefRe    = regexp.MustCompile(`^\s*\[[^\]]+\]:\s*\S+`)
+	tableSepRe  = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+	imageRe     = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	linkRe      = regexp.MustCompile(`\[([^\]]*)\](\([^)]*\)|\[[^\]]*\])`)
+	autoLinkRe  = regexp.MustCompile(`<((https?|ftp|mailto):[^>]+)>`)
+	htmlTagRe   = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+	codeSpanRe  = regexp.MustCompile("`+([^`]*)`+")
+	strongRe    = regexp.MustCompile(`(\*\*|__)(\S(.*?\S)?)(\*\*|__)`)
+	emphasisRe  = regexp.MustCompile(`(^|[^\w*])[*_](\S(.*?\S)?)[*_]($|[^\w*])`)
+	strikeRe    = regexp.MustCompile(`~~(.+?)~~`)
+	fenceRe     = regexp.MustCompile("^\\s*(```|~~~)")
+	frontMatter = "---"
+)
+
+// inline strips the inline markup of a line.
+func inline(line string) string {
+	// code spans are kept verbatim, protect them from the other rules
+	var spans []string
+	line = codeSpanRe.ReplaceAllStringFunc(line, func(m string) string {
+		spans = append(spans, codeSpanRe.FindStringSubmatch(m)[1])
+		return "\x00"
+	})
+
+	line = imageRe.ReplaceAllString(line, "$1")
+	line = linkRe.ReplaceAllString(line, "$1")
+	line = autoLinkRe.ReplaceAllString(line, "$1")
+	line = htmlTagRe.ReplaceAllString(line, "")
+	line = strongRe.ReplaceAllString(line, "$2")
+	line = emphasisRe.ReplaceAllString(line, "$1$2$4")
+	line = strikeRe.ReplaceAllString(line, "$1")
+
+	for _, span := range spans {
+		line = strings.Replace(line, "\x00", span, 1)
+	}
+	return line
+}
+
+// tableRow turns a table row into tab separated cells.
+func tableRow(line string) string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	line = strings.TrimSuffix(line, "|")
+	cells := strings.Split(line, "|")
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+	return strings.Join(cells, "\t")
+}
+
+// Extract returns the text of the markdown document.
+func Extract(data []byte) (string, error) {
+	var out []string
+	inFence := false
+	fence := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(data)+1)
+	first := true
+	inFrontMatter := false
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if first {
+			first = false
+			if strings.TrimSpace(line) == frontMatter {
+				inFrontMatter = true
+				continue
+			}
+		}
+		if inFrontMatter {
+			if t := strings.TrimSpace(line); t == frontMatter || t == "..." {
+				inFrontMatter = false
+			}
+			continue
+		}
+
+		if m := fenceRe.FindStringSubmatch(line); m != nil {
+			if !inFence {
+				inFence, fence = true, m[1]
+				continue
+			}
+			if m[1] == fence {
+				inFence = false
+				continue
+			}
+		}
+		if inFence {
+			out = append(out, line)
+			continue
+		}
+
+		switch {
+		case refDefRe.MatchString(line):
+			continue
+		case ruleRe.MatchString(line):
+			continue
+		case setextRe.MatchString(line) && len(out) > 0 && strings.TrimSpace(out[len(out)-1]) != "":
+			// underline of the heading in the previous line
+			continue
+		case tableSepRe.MatchString(line) && strings.Contains(line, "|"):
+			continue
+		}
+
+		line = quoteRe.ReplaceAllString(line, "")
+		if headingRe.MatchString(line) {
+			line = headingRe.ReplaceAllString(line, "")
+			line = headingEnd.ReplaceAllString(line, "")
+		}
+		line = bulletRe.ReplaceAllString(line, "")
+		line = orderedRe.ReplaceAllString(line, "")
+		if strings.HasPrefix(strings.TrimSpace(line), "|") {
+			line = tableRow(line)
+		}
+		out = append(out, strings.TrimRight(inline(line), " \t"))
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	// collapse runs of empty lines
+	var buf strings.Builder
+	empty := 0
+	for _, line := range out {
+		if strings.TrimSpace(line) == "" {
+			empty++
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte('\n')
+			if empty > 0 {
+				buf.WriteByte('\n')
+			}
+		}
+		empty = 0
+		buf.WriteString(line)
+	}
+	return buf.String(), nil
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	text, err := Extract(data)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlainText(t *testing.T) {
+	doc := "---\n" +
+		"title: front matter\n" +
+		"---\n" +
+		"# Title #\n" +
+		"\n" +
+		"Some **bold**, _italic_ and ~~struck~~ text with `a*b*c` code.\n" +
+		"See [the docs](https://example.com/docs) and ![logo](logo.png).\n" +
+		"\n" +
+		"Subtitle\n" +
+		"--------\n" +
+		"\n" +
+		"> quoted line\n" +
+		"\n" +
+		"- item one\n" +
+		"* item two\n" +
+		"1. first\n" +
+		"- [x] done\n" +
+		"\n" +
+		"***\n" +
+		"\n" +
+		"| a | b |\n" +
+		"|---|:-:|\n" +
+		"| 1 | 2 |\n" +
+		"\n" +
+		"```go\n" +
+		"# not a heading\n" +
+		"x := *p\n" +
+		"```\n" +
+		"\n" +
+		"[docs]: https://example.com\n" +
+		"<div>html</div> snake_case_name\n"
+
+	text, err := GetPlainText([]byte(doc))
+	require.NoError(t, err)
+	require.Equal(t, "Title\n\n"+
+		"Some bold, italic and struck text with a*b*c code.\n"+
+		"See the docs and logo.\n\n"+
+		"Subtitle\n\n"+
+		"quoted line\n\n"+
+		"item one\nitem two\nfirst\ndone\n\n"+
+		"a\tb\n1\t2\n\n"+
+		"# not a heading\nx := *p\n\n"+
+		"html snake_case_name", string(text))
+}
+
+func TestGetPlainTextEmpty(t *testing.T) {
+	text, err := GetPlainText(nil)
+	require.NoError(t, err)
+	require.Equal(t, "", string(text))
+}
@@ -36,22 +36,29 @@ func check_pdftotext() bool {
 	return err == nil
 }
 
-func GetPlainTextFromPdfToText(data []byte) ([]byte, error) {
+// GetPagesFromPdfToText returns the text of every page of the pdf.
+func GetPagesFromPdfToText(data []byte) ([][]byte, error) {
 
 	pages, err := pdftotext_extract(data)
 	if err != nil {
 		return nil, err
 	}
 
-	var buf bytes.Buffer
+	res := make([][]byte, len(pages))
 	for i, p := range pages {
-		if i > 0 {
-			buf.WriteString("\n")
-		}
-		buf.WriteString(p.Content)
+		res[i] = []byte(p.Content)
 	}
+	return res, nil
+}
 
-	return []byte(strings.TrimSpace(buf.String())), nil
+func GetPlainTextFromPdfToText(data []byte) ([]byte, error) {
+
+	pages, err := GetPagesFromPdfToText(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strings.TrimSpace(string(bytes.Join(pages, []byte("\n"))))), nil
 }
 
 func GetPlainText(data []byte) ([]byte, error) {
@@ -63,16 +70,38 @@ func GetPlainText(data []byte) ([]byte, error) {
 	}
 }
 
+// GetPages returns the text of every page of the pdf.
+func GetPages(data []byte) ([][]byte, error) {
+
+	if PDFTOTEXT_EXISTS {
+		return GetPagesFromPdfToText(data)
+	} else {
+		return GetPagesFromDslipakPdf(data)
+	}
+}
+
 func GetPlainTextFromDslipakPdf(data []byte) ([]byte, error) {
-	var buf bytes.Buffer
+
+	pages, err := GetPagesFromDslipakPdf(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strings.TrimSpace(string(bytes.Join(pages, nil)))), nil
+}
+
+// GetPagesFromDslipakPdf returns the text of every page of the pdf.
+func GetPagesFromDslipakPdf(data []byte) ([][]byte, error) {
 	pdfr, err := gopdf.NewReader(bytes.NewReader(data), int64(len(data)))
 	if err != nil {
 		return nil, err
 	}
 
 	npage := pdfr.NumPage()
+	pages := make([][]byte, 0, npage)
 	for i := 1; i <= npage; i++ {
 
+		var buf bytes.Buffer
 		p := pdfr.Page(i)
 		texts := p.Content().Text
 		var lastY = 0.0
@@ -93,7 +122,8 @@ func GetPlainTextFromDslipakPdf(data []byte) ([]byte, error) {
 			lastY = text.Y
 		}
 		buf.WriteString(line)
+		pages = append(pages, buf.Bytes())
 	}
 
-	return []byte(strings.TrimSpace(buf.String())), nil
+	return pages, nil
 }
@@ -118,3 +118,25 @@ func TestRealPdfToText(t *testing.T) {
 
 }
 */
+
+func TestPages(t *testing.T) {
+
+	defer reset(PDFTOTEXT_EXISTS)
+
+	bytes, err := os.ReadFile("test/test3.pdf")
+	require.Nil(t, err)
+
+	PDFTOTEXT_EXISTS = false
+	pages, err := GetPages(bytes)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(pages))
+	require.Equal(t, "OptimizingMySQL','Inthistutorial,weshow...", string(pages[0]))
+
+	PDFTOTEXT_EXISTS = true
+	pdftotext_extract = func(data []byte) ([]pdftotext.PdfPage, error) {
+		return []pdftotext.PdfPage{{Content: "Happy Birthday", Number: 1}, {Content: "Merry Christmas", Number: 2}}, nil
+	}
+	pages, err = GetPages(bytes)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("Happy Birthday"), []byte("Merry Christmas")}, pages)
+}
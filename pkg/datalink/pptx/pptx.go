@@ -0,0 +1,195 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// pptx parser gets the text of every slide of the presentation.
+// pptx file is zip file.  ppt/presentation.xml lists the slides in order and
+// ppt/_rels/presentation.xml.rels maps each slide to its ppt/slides/slideN.xml.
+// The text of a slide is in DrawingML paragraphs <a:p>, each with runs <a:r>
+// holding the text <a:t>.
+//
+// sample xml of a slide paragraph
+//
+//	<a:p>
+//	    <a:r><a:t>This is a </a:t></a:r>
+//	    <a:r><a:rPr b="1"/><a:t>title</a:t></a:r>
+//	</a:p>
+//
+// Every paragraph is a line and slides are separated by an empty line.
+
+const drawingML = "http://schemas.openxmlformats.org/drawingml/2006/main"
+
+type presentation struct {
+	Slides []struct {
+		ID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sldIdLst>sldId"`
+}
+
+type relationships struct {
+	Rels []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+func readZipFile(files map[string]*zip.File, name string) ([]byte, bool, error) {
+	f, ok := files[name]
+	if !ok {
+		return nil, false, nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, false, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+func unmarshalZipFile(files map[string]*zip.File, name string, v any) (bool, error) {
+	data, ok, err := readZipFile(files, name)
+	if err != nil || !ok {
+		return ok, err
+	}
+	return true, xml.Unmarshal(data, v)
+}
+
+// slideText writes the paragraphs of the slide xml into buf.
+func slideText(data []byte, buf *bytes.Buffer) error {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var line strings.Builder
+	inText := false
+	for {
+		t, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch se := t.(type) {
+		case xml.StartElement:
+			if se.Name.Space != drawingML {
+				continue
+			}
+			switch se.Name.Local {
+			case "t":
+				inText = true
+			case "br":
+				line.WriteByte('\n')
+			}
+		case xml.EndElement:
+			if se.Name.Space != drawingML {
+				continue
+			}
+			switch se.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				if s := strings.TrimSpace(line.String()); s != "" {
+					buf.WriteString(s)
+					buf.WriteByte('\n')
+				}
+				line.Reset()
+			}
+		case xml.CharData:
+			if inText {
+				line.Write(se)
+			}
+		}
+	}
+	return nil
+}
+
+func ParseTextFromReader(reader io.ReaderAt, size int64) (string, error) {
+	r, err := zip.NewReader(reader, size)
+	if err != nil {
+		return "", err
+	}
+	files := make(map[string]*zip.File, len(r.File))
+	for _, f := range r.File {
+		files[f.Name] = f
+	}
+
+	var pres presentation
+	ok, err := unmarshalZipFile(files, "ppt/presentation.xml", &pres)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", moerr.NewInternalErrorNoCtx("ppt/presentation.xml not found in pptx file")
+	}
+	var rels relationships
+	if _, err = unmarshalZipFile(files, "ppt/_rels/presentation.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	targets := make(map[string]string, len(rels.Rels))
+	for _, rel := range rels.Rels {
+		if strings.HasPrefix(rel.Target, "/") {
+			targets[rel.ID] = strings.TrimPrefix(rel.Target, "/")
+		} else {
+			targets[rel.ID] = path.Join("ppt", rel.Target)
+		}
+	}
+
+	var buf bytes.Buffer
+	for _, slide := range pres.Slides {
+		target, ok := targets[slide.ID]
+		if !ok {
+			continue
+		}
+		data, ok, err := readZipFile(files, target)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte('\n')
+		}
+		start := buf.Len()
+		if err = slideText(data, &buf); err != nil {
+			return "", err
+		}
+		// no empty line for a slide without text
+		if buf.Len() == start && start > 0 {
+			buf.Truncate(start - 1)
+		}
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	text, err := ParseTextFromReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newZip(t *testing.T, files [][2]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f[0])
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(f[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func slideXML(body string) string {
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<p:sld xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main">
+  <p:cSld><p:spTree><p:sp><p:txBody>` + body + `</p:txBody></p:sp></p:spTree></p:cSld>
+</p:sld>`
+}
+
+func TestGetPlainText(t *testing.T) {
+	data := newZip(t, [][2]string{
+		{"ppt/presentation.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<p:presentation xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+  <p:sldIdLst>
+    <p:sldId id="257" r:id="rId3"/>
+    <p:sldId id="258" r:id="rId4"/>
+    <p:sldId id="256" r:id="rId2"/>
+  </p:sldIdLst>
+</p:presentation>`},
+		{"ppt/_rels/presentation.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId2" Target="slides/slide1.xml"/>
+  <Relationship Id="rId3" Target="slides/slide2.xml"/>
+  <Relationship Id="rId4" Target="slides/slide3.xml"/>
+</Relationships>`},
+		{"ppt/slides/slide1.xml", slideXML(`<a:p><a:r><a:t>Last slide</a:t></a:r></a:p>`)},
+		{"ppt/slides/slide2.xml", slideXML(`<a:p><a:r><a:t>This is a </a:t></a:r><a:r><a:rPr b="1"/><a:t>title</a:t></a:r></a:p>` +
+			`<a:p><a:r><a:t>line 1</a:t></a:r><a:br/><a:r><a:t>line 2</a:t></a:r></a:p><a:p/>`)},
+		{"ppt/slides/slide3.xml", slideXML(`<a:p/>`)},
+	})
+
+	text, err := GetPlainText(data)
+	require.NoError(t, err)
+	require.Equal(t, "This is a title\nline 1\nline 2\n\nLast slide", string(text))
+}
+
+func TestGetPlainTextInvalid(t *testing.T) {
+	_, err := GetPlainText([]byte("not a zip file"))
+	require.Error(t, err)
+
+	_, err = GetPlainText(newZip(t, [][2]string{{"word/document.xml", "<document/>"}}))
+	require.Error(t, err)
+}
@@ -0,0 +1,240 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// xlsx parser gets the text of every sheet of the workbook.
+// xlsx file is zip file.  xl/workbook.xml lists the sheets in order and
+// xl/_rels/workbook.xml.rels maps each sheet to its xl/worksheets/sheetN.xml.
+// Strings are stored once in xl/sharedStrings.xml and referenced by index
+// from the cells.
+//
+// The text of a sheet is its name followed by one line per non-empty row with
+// the cells separated by tab.  Sheets are separated by an empty line.
+
+type workbook struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		ID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type relationships struct {
+	Rels []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type sharedStrings struct {
+	Items []richText `xml:"si"`
+}
+
+// richText is a plain <t> or a list of runs <r><t>, phonetic runs <rPh> are not text.
+type richText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (r richText) String() string {
+	if len(r.Runs) == 0 {
+		return r.T
+	}
+	var sb strings.Builder
+	for _, run := range r.Runs {
+		sb.WriteString(run.T)
+	}
+	return sb.String()
+}
+
+type worksheet struct {
+	Rows []struct {
+		Cells []struct {
+			Ref    string    `xml:"r,attr"`
+			Type   string    `xml:"t,attr"`
+			Value  string    `xml:"v"`
+			Inline *richText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// maxColumns is the number of columns of an Excel sheet
+const maxColumns = 16384
+
+func readZipFile(files map[string]*zip.File, name string, v any) (bool, error) {
+	f, ok := files[name]
+	if !ok {
+		return false, nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return false, err
+	}
+	if err = xml.Unmarshal(data, v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// columnIndex returns the 0-based column of a cell reference such as "AB12".
+func columnIndex(ref string) int {
+	col := 0
+	for _, c := range ref {
+		if c < 'A' || c > 'Z' {
+			break
+		}
+		col = col*26 + int(c-'A'+1)
+	}
+	return col - 1
+}
+
+func cellText(typ, value string, inline *richText, shared []string) string {
+	switch typ {
+	case "s":
+		idx, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || idx < 0 || idx >= len(shared) {
+			return ""
+		}
+		return shared[idx]
+	case "inlineStr":
+		if inline == nil {
+			return ""
+		}
+		return inline.String()
+	case "b":
+		if value == "1" {
+			return "TRUE"
+		}
+		return "FALSE"
+	default:
+		return value
+	}
+}
+
+func sheetText(ws *worksheet, shared []string, buf *bytes.Buffer) {
+	for _, row := range ws.Rows {
+		var cells []string
+		for _, c := range row.Cells {
+			text := cellText(c.Type, c.Value, c.Inline, shared)
+			// keep the column of the cell, cells without value are omitted
+			// in the xml
+			if c.Ref != "" {
+				if col := columnIndex(c.Ref); col < maxColumns {
+					for len(cells) < col {
+						cells = append(cells, "")
+					}
+				}
+			}
+			cells = append(cells, text)
+		}
+		for len(cells) > 0 && strings.TrimSpace(cells[len(cells)-1]) == "" {
+			cells = cells[:len(cells)-1]
+		}
+		if len(cells) == 0 {
+			continue
+		}
+		buf.WriteString(strings.Join(cells, "\t"))
+		buf.WriteByte('\n')
+	}
+}
+
+func ParseTextFromReader(reader io.ReaderAt, size int64) (string, error) {
+	r, err := zip.NewReader(reader, size)
+	if err != nil {
+		return "", err
+	}
+	files := make(map[string]*zip.File, len(r.File))
+	for _, f := range r.File {
+		files[f.Name] = f
+	}
+
+	var wb workbook
+	ok, err := readZipFile(files, "xl/workbook.xml", &wb)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", moerr.NewInternalErrorNoCtx("xl/workbook.xml not found in xlsx file")
+	}
+	var rels relationships
+	if _, err = readZipFile(files, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return "", err
+	}
+	targets := make(map[string]string, len(rels.Rels))
+	for _, rel := range rels.Rels {
+		if strings.HasPrefix(rel.Target, "/") {
+			targets[rel.ID] = strings.TrimPrefix(rel.Target, "/")
+		} else {
+			targets[rel.ID] = path.Join("xl", rel.Target)
+		}
+	}
+
+	var sst sharedStrings
+	if _, err = readZipFile(files, "xl/sharedStrings.xml", &sst); err != nil {
+		return "", err
+	}
+	shared := make([]string, len(sst.Items))
+	for i, item := range sst.Items {
+		shared[i] = item.String()
+	}
+
+	var buf bytes.Buffer
+	for _, sheet := range wb.Sheets {
+		target, ok := targets[sheet.ID]
+		if !ok {
+			continue
+		}
+		var ws worksheet
+		if ok, err = readZipFile(files, target, &ws); err != nil {
+			return "", err
+		}
+		if !ok {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte('\n')
+		}
+		buf.WriteString(sheet.Name)
+		buf.WriteByte('\n')
+		sheetText(&ws, shared, &buf)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func GetPlainText(data []byte) ([]byte, error) {
+	text, err := ParseTextFromReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(text), nil
+}
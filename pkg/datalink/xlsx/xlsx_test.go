@@ -0,0 +1,93 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newZip(t *testing.T, files [][2]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f[0])
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(f[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestGetPlainText(t *testing.T) {
+	data := newZip(t, [][2]string{
+		{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+  <sheets>
+    <sheet name="Prices" sheetId="1" r:id="rId2"/>
+    <sheet name="Notes" sheetId="2" r:id="rId1"/>
+  </sheets>
+</workbook>`},
+		{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet2.xml"/>
+  <Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="/xl/worksheets/sheet1.xml"/>
+</Relationships>`},
+		{"xl/sharedStrings.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="3" uniqueCount="3">
+  <si><t>item</t></si>
+  <si><t>price</t></si>
+  <si><r><t>rich </t></r><r><rPr><b/></rPr><t>text</t></r><rPh><t>skip</t></rPh></si>
+</sst>`},
+		{"xl/worksheets/sheet1.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <sheetData>
+    <row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+    <row r="2"><c r="A2" t="inlineStr"><is><t>apple</t></is></c><c r="B2"><v>1.5</v></c><c r="D2" t="b"><v>1</v></c></row>
+    <row r="3"><c r="A3"/></row>
+    <row r="4"><c r="B4" t="str"><f>A2</f><v>apple</v></c></row>
+  </sheetData>
+</worksheet>`},
+		{"xl/worksheets/sheet2.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <sheetData>
+    <row r="1"><c r="A1" t="s"><v>2</v></c></row>
+  </sheetData>
+</worksheet>`},
+	})
+
+	text, err := GetPlainText(data)
+	require.NoError(t, err)
+	require.Equal(t, "Prices\nitem\tprice\napple\t1.5\t\tTRUE\n\tapple\n\nNotes\nrich text", string(text))
+}
+
+func TestGetPlainTextInvalid(t *testing.T) {
+	_, err := GetPlainText([]byte("not a zip file"))
+	require.Error(t, err)
+
+	_, err = GetPlainText(newZip(t, [][2]string{{"word/document.xml", "<document/>"}}))
+	require.Error(t, err)
+}
+
+func TestColumnIndex(t *testing.T) {
+	require.Equal(t, 0, columnIndex("A1"))
+	require.Equal(t, 25, columnIndex("Z10"))
+	require.Equal(t, 26, columnIndex("AA3"))
+	require.Equal(t, 27, columnIndex("AB3"))
+}
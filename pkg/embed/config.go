@@ -31,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/encryption"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -113,6 +114,12 @@ type ServiceConfig struct {
 	// MetaCache the config for objectio metacache
 	MetaCache objectio.CacheConfig `toml:"metacache"`
 
+	// ColumnEncoding the config for the lightweight column encodings of objects
+	ColumnEncoding objectio.EncodingConfig `toml:"column-encoding"`
+
+	// Encryption the config for the encryption at rest of objects and WAL entries
+	Encryption encryption.Config `toml:"encryption"`
+
 	// IsStandalone denotes the matrixone is running in standalone mode
 	// For the tn does not boost an independent queryservice.
 	// cn,tn shares the same queryservice in standalone mode.
@@ -272,6 +279,7 @@ func (c *ServiceConfig) setDefaultValue() error {
 
 	// meta cache
 	c.initMetaCache()
+	c.initColumnEncoding()
 
 	return nil
 }
@@ -282,6 +290,10 @@ func (c *ServiceConfig) initMetaCache() {
 	}
 }
 
+func (c *ServiceConfig) initColumnEncoding() {
+	objectio.SetColumnEncoding(c.ColumnEncoding.Enable)
+}
+
 func (c *ServiceConfig) defaultFileServiceDataDir(name string) string {
 	return filepath.Join(c.DataDir, strings.ToLower(name))
 }
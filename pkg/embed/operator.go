@@ -31,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
 	"github.com/matrixorigin/matrixone/pkg/common/system"
+	"github.com/matrixorigin/matrixone/pkg/encryption"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/gossip"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
@@ -146,6 +147,11 @@ func (op *operator) Start() error {
 		return err
 	}
 
+	// encryption at rest, the key provider is shared by the services of the process
+	if err := encryption.InitOnce(op.cfg.Encryption); err != nil {
+		return err
+	}
+
 	fs, err := op.cfg.createFileService(
 		context.Background(),
 		op.serviceType,
@@ -226,6 +232,7 @@ func (op *operator) startTNServiceLocked(
 		return err
 	}
 	op.cfg.initMetaCache()
+	op.cfg.initColumnEncoding()
 	c := op.cfg.getTNServiceConfig()
 	//notify the tn service it is in the standalone cluster
 	c.InStandalone = op.cfg.IsStandalone
@@ -254,6 +261,7 @@ func (op *operator) startCNServiceLocked(
 		return err
 	}
 	op.cfg.initMetaCache()
+	op.cfg.initColumnEncoding()
 	c := op.cfg.getCNServiceConfig()
 	commonConfigKVMap, _ := dumpCommonConfig(op.cfg)
 	s, err := cnservice.NewService(
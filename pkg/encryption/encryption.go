@@ -0,0 +1,268 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"go.uber.org/zap"
+)
+
+type providerHolder struct {
+	provider KeyProvider
+	enable   bool
+}
+
+var defaultProvider atomic.Pointer[providerHolder]
+
+// Init sets the process wide key provider from the config.
+func Init(cfg Config) error {
+	var provider KeyProvider
+	switch strings.ToLower(cfg.Provider) {
+	case "":
+		if cfg.Enable {
+			return moerr.NewInvalidInputNoCtx("encryption is enabled without a key provider")
+		}
+		return nil
+	case KeyFileProviderName:
+		p, err := NewKeyFileProvider(cfg.KeyFile)
+		if err != nil {
+			return err
+		}
+		provider = p
+	default:
+		return moerr.NewInvalidInputNoCtxf("unknown key provider: %s", cfg.Provider)
+	}
+	SetKeyProvider(provider, cfg.Enable)
+	logutil.Info("encryption at rest initialized",
+		zap.String("provider", cfg.Provider),
+		zap.String("key-id", provider.CurrentKeyID()),
+		zap.Bool("enable", cfg.Enable),
+	)
+	return nil
+}
+
+var initOnce struct {
+	sync.Mutex
+	done bool
+	cfg  Config
+}
+
+// InitOnce calls Init at the start-up of the first service of the process.
+// The services started in one process share the key provider, so the later
+// services must have the same config.
+func InitOnce(cfg Config) error {
+	initOnce.Lock()
+	defer initOnce.Unlock()
+	if initOnce.done {
+		if initOnce.cfg != cfg {
+			return moerr.NewInvalidInputNoCtx("the services in one process have different encryption configs")
+		}
+		return nil
+	}
+	if err := Init(cfg); err != nil {
+		return err
+	}
+	initOnce.done = true
+	initOnce.cfg = cfg
+	return nil
+}
+
+// SetKeyProvider sets the process wide key provider. The new data is encrypted
+// with the current master key of the provider if enable is true.
+func SetKeyProvider(provider KeyProvider, enable bool) {
+	if provider == nil {
+		defaultProvider.Store(nil)
+		return
+	}
+	defaultProvider.Store(&providerHolder{
+		provider: provider,
+		enable:   enable,
+	})
+}
+
+// GetKeyProvider returns the process wide key provider, or nil if not set.
+func GetKeyProvider() KeyProvider {
+	if h := defaultProvider.Load(); h != nil {
+		return h.provider
+	}
+	return nil
+}
+
+// Enabled reports whether the new data should be encrypted.
+func Enabled() bool {
+	h := defaultProvider.Load()
+	return h != nil && h.enable
+}
+
+// Seal encrypts the plaintext with the data key, the result is the random
+// nonce followed by the ciphertext and the tag.
+func Seal(key []byte, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	sealed := make([]byte, NonceSize, NonceSize+len(plaintext)+TagSize)
+	if _, err := rand.Read(sealed); err != nil {
+		return nil, err
+	}
+	return aead.Seal(sealed, sealed, plaintext, nil), nil
+}
+
+// Open decrypts the data sealed by Seal.
+func Open(key []byte, sealed []byte) ([]byte, error) {
+	if len(sealed) < Overhead {
+		return nil, moerr.NewInternalErrorNoCtxf("invalid sealed data length %d", len(sealed))
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, sealed[:NonceSize], sealed[NonceSize:], nil)
+	if err != nil {
+		return nil, moerr.NewInternalErrorNoCtxf("decrypt: %v", err)
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, moerr.NewInvalidInputNoCtxf("invalid key: %v", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+const envelopeVersion = 1
+
+// Envelope is a data key wrapped by a master key, it is stored with the data
+// encrypted by the data key.
+//
+// Version | KeyIDLen | KeyID | WrappedKeyLen | WrappedKey
+// --------|----------|-------|---------------|-----------
+// 1       | 2        | n     | 2             | m
+type Envelope struct {
+	KeyID      string
+	WrappedKey []byte
+}
+
+func (e Envelope) Size() int {
+	return 1 + 2 + len(e.KeyID) + 2 + len(e.WrappedKey)
+}
+
+func (e Envelope) Marshal() []byte {
+	buf := make([]byte, 0, e.Size())
+	buf = append(buf, envelopeVersion)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(e.KeyID)))
+	buf = append(buf, e.KeyID...)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(e.WrappedKey)))
+	buf = append(buf, e.WrappedKey...)
+	return buf
+}
+
+// Unmarshal decodes the envelope at the beginning of buf and returns its size.
+func (e *Envelope) Unmarshal(buf []byte) (int, error) {
+	if len(buf) < 3 || buf[0] != envelopeVersion {
+		return 0, moerr.NewInternalErrorNoCtx("invalid key envelope")
+	}
+	n := 1
+	keyIDLen := int(binary.LittleEndian.Uint16(buf[n:]))
+	n += 2
+	if len(buf) < n+keyIDLen+2 {
+		return 0, moerr.NewInternalErrorNoCtx("invalid key envelope")
+	}
+	e.KeyID = string(buf[n : n+keyIDLen])
+	n += keyIDLen
+	wrappedLen := int(binary.LittleEndian.Uint16(buf[n:]))
+	n += 2
+	if len(buf) < n+wrappedLen {
+		return 0, moerr.NewInternalErrorNoCtx("invalid key envelope")
+	}
+	e.WrappedKey = append([]byte(nil), buf[n:n+wrappedLen]...)
+	n += wrappedLen
+	return n, nil
+}
+
+// NewDataKey generates a data key and wraps it with the current master key of the provider.
+func NewDataKey(ctx context.Context, provider KeyProvider) (key []byte, envelope Envelope, err error) {
+	key = make([]byte, DataKeySize)
+	if _, err = rand.Read(key); err != nil {
+		return
+	}
+	envelope.KeyID = provider.CurrentKeyID()
+	if envelope.WrappedKey, err = provider.WrapKey(ctx, envelope.KeyID, key); err != nil {
+		return
+	}
+	if len(envelope.KeyID) > 0xffff || len(envelope.WrappedKey) > 0xffff {
+		err = moerr.NewInternalErrorNoCtx("wrapped data key too large")
+		return
+	}
+	unwrappedKeys.set(envelope, key)
+	return
+}
+
+// UnwrapDataKey returns the data key of the envelope, the unwrapped data keys are cached.
+func UnwrapDataKey(ctx context.Context, provider KeyProvider, envelope Envelope) ([]byte, error) {
+	if key, ok := unwrappedKeys.get(envelope); ok {
+		return key, nil
+	}
+	if provider == nil {
+		return nil, moerr.NewInternalErrorNoCtxf("no key provider to unwrap the data key of master key %s", envelope.KeyID)
+	}
+	key, err := provider.UnwrapKey(ctx, envelope.KeyID, envelope.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	unwrappedKeys.set(envelope, key)
+	return key, nil
+}
+
+const maxCachedDataKeys = 4096
+
+// unwrappedKeys caches the unwrapped data keys to avoid calling the key provider,
+// which may be a remote service, for every read.
+var unwrappedKeys = &dataKeyCache{
+	keys: make(map[string][]byte),
+}
+
+type dataKeyCache struct {
+	mu   sync.Mutex
+	keys map[string][]byte
+}
+
+func (c *dataKeyCache) get(envelope Envelope) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key, ok := c.keys[string(envelope.Marshal())]
+	return key, ok
+}
+
+func (c *dataKeyCache) set(envelope Envelope, key []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.keys) >= maxCachedDataKeys {
+		clear(c.keys)
+	}
+	c.keys[string(envelope.Marshal())] = key
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealOpen(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, DataKeySize)
+	plaintext := []byte("hello world")
+
+	sealed, err := Seal(key, plaintext)
+	require.NoError(t, err)
+	require.Equal(t, len(plaintext)+Overhead, len(sealed))
+	require.False(t, bytes.Contains(sealed, plaintext))
+
+	// random nonce
+	sealed2, err := Seal(key, plaintext)
+	require.NoError(t, err)
+	require.NotEqual(t, sealed, sealed2)
+
+	opened, err := Open(key, sealed)
+	require.NoError(t, err)
+	require.Equal(t, plaintext, opened)
+
+	empty, err := Seal(key, nil)
+	require.NoError(t, err)
+	opened, err = Open(key, empty)
+	require.NoError(t, err)
+	require.Empty(t, opened)
+
+	// tampered
+	sealed[len(sealed)-1] ^= 1
+	_, err = Open(key, sealed)
+	require.Error(t, err)
+
+	// wrong key
+	_, err = Open(bytes.Repeat([]byte{2}, DataKeySize), sealed2)
+	require.Error(t, err)
+
+	_, err = Open(key, sealed[:Overhead-1])
+	require.Error(t, err)
+	_, err = Seal(key[:5], plaintext)
+	require.Error(t, err)
+}
+
+func TestEnvelope(t *testing.T) {
+	e := Envelope{
+		KeyID:      "key-1",
+		WrappedKey: []byte("wrapped"),
+	}
+	buf := e.Marshal()
+	require.Equal(t, e.Size(), len(buf))
+
+	var e2 Envelope
+	n, err := e2.Unmarshal(append(buf, 1, 2, 3))
+	require.NoError(t, err)
+	require.Equal(t, len(buf), n)
+	require.Equal(t, e, e2)
+
+	for i := 0; i < len(buf); i++ {
+		_, err = e2.Unmarshal(buf[:i])
+		require.Error(t, err)
+	}
+	buf[0] = envelopeVersion + 1
+	_, err = e2.Unmarshal(buf)
+	require.Error(t, err)
+}
+
+func writeKeyFile(t *testing.T, current string, ids ...string) string {
+	keys := make(map[string]string)
+	for i, id := range ids {
+		keys[id] = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{byte(i + 1)}, DataKeySize))
+	}
+	content := fmt.Sprintf(`{"current": %q, "keys": {`, current)
+	i := 0
+	for id, key := range keys {
+		if i > 0 {
+			content += ","
+		}
+		content += fmt.Sprintf("%q: %q", id, key)
+		i++
+	}
+	content += "}}"
+	path := filepath.Join(t.TempDir(), "keys.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestKeyFileProvider(t *testing.T) {
+	ctx := context.Background()
+
+	p, err := NewKeyFileProvider(writeKeyFile(t, "1", "1"))
+	require.NoError(t, err)
+	require.Equal(t, "1", p.CurrentKeyID())
+	key, envelope, err := NewDataKey(ctx, p)
+	require.NoError(t, err)
+	require.Equal(t, DataKeySize, len(key))
+	require.Equal(t, "1", envelope.KeyID)
+	unwrapped, err := p.UnwrapKey(ctx, envelope.KeyID, envelope.WrappedKey)
+	require.NoError(t, err)
+	require.Equal(t, key, unwrapped)
+
+	// rotate the master key, the data key wrapped by the old one is still readable
+	p2, err := NewKeyFileProvider(writeKeyFile(t, "2", "1", "2"))
+	require.NoError(t, err)
+	require.Equal(t, "2", p2.CurrentKeyID())
+	unwrapped, err = p2.UnwrapKey(ctx, envelope.KeyID, envelope.WrappedKey)
+	require.NoError(t, err)
+	require.Equal(t, key, unwrapped)
+	_, envelope2, err := NewDataKey(ctx, p2)
+	require.NoError(t, err)
+	require.Equal(t, "2", envelope2.KeyID)
+
+	// the old master key is retired
+	p3, err := NewKeyFileProvider(writeKeyFile(t, "2", "2"))
+	require.NoError(t, err)
+	_, err = p3.UnwrapKey(ctx, envelope.KeyID, envelope.WrappedKey)
+	require.Error(t, err)
+
+	_, err = NewKeyFileProvider(filepath.Join(t.TempDir(), "not-exist"))
+	require.Error(t, err)
+	_, err = newKeyFileProvider([]byte("not json"))
+	require.Error(t, err)
+	_, err = newKeyFileProvider([]byte(`{"current": "1", "keys": {"1": "not base64"}}`))
+	require.Error(t, err)
+	_, err = newKeyFileProvider([]byte(`{"current": "1", "keys": {"1": "AAAA"}}`))
+	require.Error(t, err)
+	_, err = NewKeyFileProvider(writeKeyFile(t, "3", "1", "2"))
+	require.Error(t, err)
+}
+
+func TestKMSProvider(t *testing.T) {
+	ctx := context.Background()
+	kms := NewMockKMS()
+	require.NoError(t, kms.CreateKey("k1"))
+	require.Error(t, kms.CreateKey("k1"))
+	p := NewKMSProvider(kms, "k1")
+
+	key, envelope, err := NewDataKey(ctx, p)
+	require.NoError(t, err)
+	require.Equal(t, "k1", envelope.KeyID)
+	require.Equal(t, int64(1), kms.NumEncrypt.Load())
+
+	// the unwrapped data keys are cached
+	for i := 0; i < 3; i++ {
+		unwrapped, err := UnwrapDataKey(ctx, p, envelope)
+		require.NoError(t, err)
+		require.Equal(t, key, unwrapped)
+	}
+	require.Equal(t, int64(0), kms.NumDecrypt.Load())
+	unwrappedKeys.mu.Lock()
+	clear(unwrappedKeys.keys)
+	unwrappedKeys.mu.Unlock()
+	unwrapped, err := UnwrapDataKey(ctx, p, envelope)
+	require.NoError(t, err)
+	require.Equal(t, key, unwrapped)
+	require.Equal(t, int64(1), kms.NumDecrypt.Load())
+	_, err = UnwrapDataKey(ctx, p, envelope)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), kms.NumDecrypt.Load())
+
+	require.NoError(t, kms.CreateKey("k2"))
+	p.SetCurrentKeyID("k2")
+	_, envelope2, err := NewDataKey(ctx, p)
+	require.NoError(t, err)
+	require.Equal(t, "k2", envelope2.KeyID)
+
+	kms.DeleteKey("k1")
+	_, err = p.UnwrapKey(ctx, envelope.KeyID, envelope.WrappedKey)
+	require.Error(t, err)
+	p.SetCurrentKeyID("k1")
+	_, _, err = NewDataKey(ctx, p)
+	require.Error(t, err)
+
+	_, err = UnwrapDataKey(ctx, nil, Envelope{KeyID: "unknown"})
+	require.Error(t, err)
+}
+
+func TestInit(t *testing.T) {
+	defer SetKeyProvider(nil, false)
+
+	require.NoError(t, Init(Config{}))
+	require.Nil(t, GetKeyProvider())
+	require.False(t, Enabled())
+
+	require.Error(t, Init(Config{Enable: true}))
+	require.Error(t, Init(Config{Provider: "unknown"}))
+	require.Error(t, Init(Config{Provider: KeyFileProviderName, KeyFile: filepath.Join(t.TempDir(), "not-exist")}))
+
+	path := writeKeyFile(t, "1", "1")
+	require.NoError(t, Init(Config{Provider: KeyFileProviderName, KeyFile: path}))
+	require.NotNil(t, GetKeyProvider())
+	require.False(t, Enabled())
+
+	require.NoError(t, Init(Config{Enable: true, Provider: KeyFileProviderName, KeyFile: path}))
+	require.True(t, Enabled())
+	require.Equal(t, "1", GetKeyProvider().CurrentKeyID())
+}
+
+func TestInitOnce(t *testing.T) {
+	defer func() {
+		SetKeyProvider(nil, false)
+		initOnce.done = false
+		initOnce.cfg = Config{}
+	}()
+
+	require.Error(t, InitOnce(Config{Enable: true}))
+	require.False(t, initOnce.done)
+
+	path := writeKeyFile(t, "1", "1")
+	cfg := Config{Enable: true, Provider: KeyFileProviderName, KeyFile: path}
+	require.NoError(t, InitOnce(cfg))
+	require.True(t, Enabled())
+
+	// the later services with the same config share the provider
+	provider := GetKeyProvider()
+	require.NoError(t, InitOnce(cfg))
+	require.Equal(t, provider, GetKeyProvider())
+
+	require.Error(t, InitOnce(Config{}))
+	require.True(t, Enabled())
+}
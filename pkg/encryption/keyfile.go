@@ -0,0 +1,110 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// KeyFileProvider wraps the data keys with the master keys loaded from a local file.
+type KeyFileProvider struct {
+	current string
+	keys    map[string][]byte
+}
+
+var _ KeyProvider = new(KeyFileProvider)
+
+type keyFile struct {
+	Current string            `json:"current"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// NewKeyFileProvider loads the master keys from the file at path:
+//
+//	{
+//	  "current": "2",
+//	  "keys": {
+//	    "1": "<base64 encoded 32 bytes key>",
+//	    "2": "<base64 encoded 32 bytes key>"
+//	  }
+//	}
+//
+// The master key is rotated by adding a new key and pointing current to it,
+// the old keys must be kept until all the data wrapped by them is rewritten.
+func NewKeyFileProvider(path string) (*KeyFileProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return newKeyFileProvider(content)
+}
+
+func newKeyFileProvider(content []byte) (*KeyFileProvider, error) {
+	var file keyFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, moerr.NewInvalidInputNoCtxf("invalid key file: %v", err)
+	}
+	p := &KeyFileProvider{
+		current: file.Current,
+		keys:    make(map[string][]byte, len(file.Keys)),
+	}
+	for id, str := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtxf("invalid master key %s: %v", id, err)
+		}
+		if len(key) != DataKeySize {
+			return nil, moerr.NewInvalidInputNoCtxf("invalid master key %s: expecting %d bytes, got %d", id, DataKeySize, len(key))
+		}
+		p.keys[id] = key
+	}
+	if _, ok := p.keys[p.current]; !ok {
+		return nil, moerr.NewInvalidInputNoCtxf("current master key %s not found in key file", p.current)
+	}
+	return p, nil
+}
+
+func (p *KeyFileProvider) CurrentKeyID() string {
+	return p.current
+}
+
+func (p *KeyFileProvider) WrapKey(_ context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	key, err := p.masterKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return Seal(key, dataKey)
+}
+
+func (p *KeyFileProvider) UnwrapKey(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	key, err := p.masterKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return Open(key, wrapped)
+}
+
+func (p *KeyFileProvider) masterKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtxf("master key %s not found", keyID)
+	}
+	return key, nil
+}
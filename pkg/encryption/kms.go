@@ -0,0 +1,125 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// KMSProvider wraps the data keys with the master keys managed by a KMS.
+type KMSProvider struct {
+	client  KMSClient
+	current atomic.Pointer[string]
+}
+
+var _ KeyProvider = new(KMSProvider)
+
+func NewKMSProvider(client KMSClient, keyID string) *KMSProvider {
+	p := &KMSProvider{
+		client: client,
+	}
+	p.current.Store(&keyID)
+	return p
+}
+
+// SetCurrentKeyID rotates the master key, the new data keys are wrapped by keyID.
+func (p *KMSProvider) SetCurrentKeyID(keyID string) {
+	p.current.Store(&keyID)
+}
+
+func (p *KMSProvider) CurrentKeyID() string {
+	return *p.current.Load()
+}
+
+func (p *KMSProvider) WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	return p.client.Encrypt(ctx, keyID, dataKey)
+}
+
+func (p *KMSProvider) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	return p.client.Decrypt(ctx, keyID, wrapped)
+}
+
+// MockKMS is a local KMS keeping the master keys in memory, used in tests.
+type MockKMS struct {
+	mu   sync.Mutex
+	keys map[string][]byte
+
+	// the number of requests, to check the caching of the unwrapped keys
+	NumEncrypt atomic.Int64
+	NumDecrypt atomic.Int64
+}
+
+var _ KMSClient = new(MockKMS)
+
+func NewMockKMS() *MockKMS {
+	return &MockKMS{
+		keys: make(map[string][]byte),
+	}
+}
+
+// CreateKey creates a random master key identified by keyID.
+func (m *MockKMS) CreateKey(keyID string) error {
+	key := make([]byte, DataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.keys[keyID]; ok {
+		return moerr.NewInvalidInputNoCtxf("master key %s already exists", keyID)
+	}
+	m.keys[keyID] = key
+	return nil
+}
+
+// DeleteKey deletes the master key, the data keys wrapped by it can not be unwrapped anymore.
+func (m *MockKMS) DeleteKey(keyID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.keys, keyID)
+}
+
+func (m *MockKMS) Encrypt(_ context.Context, keyID string, plaintext []byte) ([]byte, error) {
+	m.NumEncrypt.Add(1)
+	key, err := m.key(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return Seal(key, plaintext)
+}
+
+func (m *MockKMS) Decrypt(_ context.Context, keyID string, ciphertext []byte) ([]byte, error) {
+	m.NumDecrypt.Add(1)
+	key, err := m.key(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return Open(key, ciphertext)
+}
+
+func (m *MockKMS) key(keyID string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key, ok := m.keys[keyID]
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtxf("kms: master key %s not found", keyID)
+	}
+	return key, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import "context"
+
+const (
+	// DataKeySize is the size of the data keys, the data is encrypted with AES-256-GCM
+	DataKeySize = 32
+	// NonceSize is the size of the random nonce prefixed to the sealed data
+	NonceSize = 12
+	// TagSize is the size of the authentication tag appended to the sealed data
+	TagSize = 16
+	// Overhead is the number of bytes Seal adds to the plaintext
+	Overhead = NonceSize + TagSize
+)
+
+// KeyProvider wraps the data keys with the master keys. The data is encrypted
+// with the data keys, only the wrapped data keys are stored with the data.
+type KeyProvider interface {
+	// CurrentKeyID returns the id of the master key to wrap the new data keys.
+	// Rotating the master key changes the current key id, the previous master
+	// keys are still needed to unwrap the existing data keys.
+	CurrentKeyID() string
+	// WrapKey encrypts the data key with the master key identified by keyID.
+	WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error)
+	// UnwrapKey decrypts the data key wrapped by the master key identified by keyID.
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// KMSClient is the client of a key management service. The master keys never
+// leave the service, the data keys are sent to it to be wrapped and unwrapped.
+type KMSClient interface {
+	Encrypt(ctx context.Context, keyID string, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, keyID string, ciphertext []byte) ([]byte, error)
+}
+
+const (
+	// KeyFileProviderName is the provider loading the master keys from a local file
+	KeyFileProviderName = "keyfile"
+)
+
+// Config is the config of the encryption at rest.
+type Config struct {
+	// Enable encrypts the new objects and WAL entries. The key provider is
+	// still used to decrypt the existing data if it is disabled.
+	Enable bool `toml:"enable"`
+	// Provider is the key provider, [keyfile]
+	Provider string `toml:"provider"`
+	// KeyFile is the path of the master keys file of the keyfile provider,
+	// see NewKeyFileProvider for the format.
+	KeyFile string `toml:"key-file"`
+}
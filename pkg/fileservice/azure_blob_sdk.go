@@ -0,0 +1,993 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"os"
+	gotrace "runtime/trace"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	azureAPIVersion = "2021-08-06"
+	// azureMaxErrorBodySize limits the error response read into the error
+	azureMaxErrorBodySize = 64 * 1024
+)
+
+// AzureBlobSDK is the ObjectStorage of Azure Blob Storage. Objects are block
+// blobs in the container named by the bucket. It talks to the REST API
+// directly and authenticates with a SAS token, the client secret of a service
+// principal, a bearer token or the shared key of the account, in that order.
+type AzureBlobSDK struct {
+	name            string
+	account         string
+	containerURL    *url.URL
+	client          *http.Client
+	sasToken        url.Values
+	tokenSource     *oauthTokenSource
+	accountKey      []byte
+	perfCounterSets []*perfcounter.CounterSet
+	listMaxKeys     int
+}
+
+func NewAzureBlobSDK(
+	ctx context.Context,
+	args ObjectStorageArguments,
+	perfCounterSets []*perfcounter.CounterSet,
+) (_ *AzureBlobSDK, err error) {
+	defer catch(&err)
+
+	// args
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+	if args.Bucket == "" {
+		return nil, moerr.NewInvalidInputNoCtx("empty azure container")
+	}
+
+	// credential arguments
+	account := args.KeyID
+	accountKey := args.KeySecret
+	sasToken := args.SASToken
+	tenantID := args.TenantID
+	clientID := args.ClientID
+	clientSecret := args.ClientSecret
+	if args.shouldLoadDefaultCredentials() {
+		account = firstNonZero(
+			args.KeyID,
+			os.Getenv("AZURE_STORAGE_ACCOUNT"),
+		)
+		accountKey = firstNonZero(
+			args.KeySecret,
+			os.Getenv("AZURE_STORAGE_KEY"),
+		)
+		sasToken = firstNonZero(
+			args.SASToken,
+			os.Getenv("AZURE_STORAGE_SAS_TOKEN"),
+		)
+		tenantID = firstNonZero(
+			args.TenantID,
+			os.Getenv("AZURE_TENANT_ID"),
+		)
+		clientID = firstNonZero(
+			args.ClientID,
+			os.Getenv("AZURE_CLIENT_ID"),
+		)
+		clientSecret = firstNonZero(
+			args.ClientSecret,
+			os.Getenv("AZURE_CLIENT_SECRET"),
+		)
+	}
+
+	// endpoint
+	endpoint := args.Endpoint
+	if endpoint == "" {
+		if account == "" {
+			return nil, moerr.NewInvalidInputNoCtx("no azure endpoint or account")
+		}
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", account)
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if account == "" {
+		// <account>.blob.core.windows.net
+		if i := strings.Index(endpointURL.Hostname(), ".blob."); i > 0 {
+			account = endpointURL.Hostname()[:i]
+		}
+	}
+	containerURL := &url.URL{
+		Scheme: endpointURL.Scheme,
+		Host:   endpointURL.Host,
+		Path:   strings.TrimSuffix(endpointURL.Path, "/") + "/" + args.Bucket,
+	}
+
+	sdk := &AzureBlobSDK{
+		name:            args.Name,
+		account:         account,
+		containerURL:    containerURL,
+		client:          newHTTPClient(args),
+		perfCounterSets: perfCounterSets,
+	}
+
+	switch {
+	case sasToken != "":
+		sdk.sasToken, err = url.ParseQuery(strings.TrimPrefix(sasToken, "?"))
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtxf("invalid azure sas token: %v", err)
+		}
+	case clientSecret != "":
+		if tenantID == "" || clientID == "" {
+			return nil, moerr.NewInvalidInputNoCtx("no azure tenant id or client id for the client secret")
+		}
+		sdk.tokenSource = newAzureClientSecretTokenSource(sdk.client, tenantID, clientID, clientSecret)
+	case args.BearerToken != "":
+		// the bearer token is not refreshed, use the client secret for the long running jobs
+		sdk.tokenSource = newStaticTokenSource(args.BearerToken)
+	case accountKey != "":
+		if account == "" {
+			return nil, moerr.NewInvalidInputNoCtx("no azure account for the shared key")
+		}
+		sdk.accountKey, err = base64.StdEncoding.DecodeString(accountKey)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtxf("invalid azure account key: %v", err)
+		}
+	}
+
+	logutil.Info("new object storage",
+		zap.Any("sdk", "azure"),
+		zap.Any("arguments", args),
+	)
+
+	if !args.NoBucketValidation {
+		// validate container
+		_, err := DoWithRetry("azure container properties", func() (bool, error) {
+			resp, err := sdk.do(ctx, http.MethodHead, "", url.Values{
+				"restype": {"container"},
+			}, nil, nil, 0)
+			if err != nil {
+				return false, err
+			}
+			resp.Body.Close()
+			return true, nil
+		}, maxRetryAttemps, IsRetryableError)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sdk, nil
+}
+
+var _ ObjectStorage = new(AzureBlobSDK)
+var _ ParallelMultipartWriter = new(AzureBlobSDK)
+
+func (a *AzureBlobSDK) List(
+	ctx context.Context,
+	prefix string,
+) iter.Seq2[*DirEntry, error] {
+	return func(yield func(*DirEntry, error) bool) {
+		if err := ctx.Err(); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		var marker string
+
+	loop1:
+		for {
+			result, err := a.listBlobs(ctx, prefix, marker)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, blob := range result.Blobs {
+				if !yield(&DirEntry{
+					Name: blob.Name,
+					Size: blob.Properties.ContentLength,
+				}, nil) {
+					break loop1
+				}
+			}
+
+			for _, prefix := range result.Prefixes {
+				if !yield(&DirEntry{
+					IsDir: true,
+					Name:  prefix.Name,
+				}, nil) {
+					break loop1
+				}
+			}
+
+			if result.NextMarker == "" {
+				break
+			}
+			marker = result.NextMarker
+		}
+
+	}
+}
+
+func (a *AzureBlobSDK) Stat(
+	ctx context.Context,
+	key string,
+) (
+	size int64,
+	err error,
+) {
+
+	defer func() {
+		if isHTTPNotFound(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	header, err := a.getBlobProperties(ctx, key)
+	if err != nil {
+		return
+	}
+
+	if str := header.Get("Content-Length"); str != "" {
+		size, err = strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (a *AzureBlobSDK) Exists(
+	ctx context.Context,
+	key string,
+) (
+	bool,
+	error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	_, err := a.getBlobProperties(ctx, key)
+	if err != nil {
+		if isHTTPNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Write writes the small objects with Put Blob and the others by staging blocks.
+// Block blobs have no expiration, the lifecycle management policies of the
+// account are used instead, so expire is ignored.
+func (a *AzureBlobSDK) Write(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	sizeHint *int64,
+	expire *time.Time,
+) (
+	err error,
+) {
+	defer wrapSizeMismatchErr(&err)
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if sizeHint != nil && *sizeHint < smallObjectThreshold {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if int64(len(data)) != *sizeHint {
+			return moerr.NewSizeNotMatchNoCtx(key)
+		}
+		_, err = DoWithRetry("write", func() (bool, error) {
+			return true, a.putBlob(ctx, key, data)
+		}, maxRetryAttemps, IsRetryableError)
+		return err
+	}
+
+	return a.WriteMultipartParallel(ctx, key, r, sizeHint, &ParallelMultipartOption{
+		PartSize:    defaultParallelMultipartPartSize,
+		Concurrency: 1,
+		Expire:      expire,
+	})
+}
+
+func (a *AzureBlobSDK) SupportsParallelMultipart() bool {
+	return true
+}
+
+// WriteMultipartParallel stages the parts as blocks concurrently and commits
+// the block list. The uncommitted blocks of a failed upload are garbage
+// collected by the service, so there is nothing to abort.
+func (a *AzureBlobSDK) WriteMultipartParallel(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	sizeHint *int64,
+	opt *ParallelMultipartOption,
+) (err error) {
+	defer wrapSizeMismatchErr(&err)
+
+	options := normalizeParallelOption(opt)
+	if sizeHint != nil {
+		r = &exactSizeReader{
+			R:        r,
+			Expected: *sizeHint,
+			Key:      key,
+		}
+		if *sizeHint < minMultipartPartSize {
+			return a.Write(ctx, key, r, sizeHint, options.Expire)
+		}
+		expectedParts := (*sizeHint + options.PartSize - 1) / options.PartSize
+		if expectedParts > maxMultipartParts {
+			return moerr.NewInternalErrorNoCtxf("too many parts for multipart upload: %d", expectedParts)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	bufPool := sync.Pool{
+		New: func() any {
+			buf := make([]byte, options.PartSize)
+			return &buf
+		},
+	}
+
+	readChunk := func() (bufPtr *[]byte, buf []byte, n int, err error) {
+		bufPtr = bufPool.Get().(*[]byte)
+		raw := *bufPtr
+		n, err = io.ReadFull(r, raw)
+		switch {
+		case errors.Is(err, io.EOF):
+			bufPool.Put(bufPtr)
+			return nil, nil, 0, io.EOF
+		case errors.Is(err, io.ErrUnexpectedEOF):
+			err = io.EOF
+			return bufPtr, raw, n, err
+		case err != nil:
+			bufPool.Put(bufPtr)
+			return nil, nil, 0, err
+		default:
+			return bufPtr, raw, n, nil
+		}
+	}
+
+	firstBufPtr, firstBuf, firstN, err := readChunk()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if errors.Is(err, io.EOF) && int64(firstN) < minMultipartPartSize {
+		// including the empty one
+		data := make([]byte, firstN)
+		copy(data, firstBuf[:firstN])
+		if firstBufPtr != nil {
+			bufPool.Put(firstBufPtr)
+		}
+		size := int64(firstN)
+		return a.Write(ctx, key, bytes.NewReader(data), &size, options.Expire)
+	}
+
+	type partJob struct {
+		num    int32
+		buf    []byte
+		bufPtr *[]byte
+		n      int
+	}
+
+	var (
+		partNum  int32
+		staged   atomic.Int32
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	setErr := func(e error) {
+		if e == nil {
+			return
+		}
+		errOnce.Do(func() {
+			firstErr = e
+			cancel()
+		})
+	}
+
+	jobCh := make(chan partJob, options.Concurrency*2)
+
+	startWorker := func() error {
+		wg.Add(1)
+		return getParallelUploadPool().Submit(func() {
+			defer wg.Done()
+			for job := range jobCh {
+				if ctx.Err() != nil {
+					if job.bufPtr != nil {
+						bufPool.Put(job.bufPtr)
+					}
+					continue
+				}
+				_, stageErr := DoWithRetry("azure put block", func() (bool, error) {
+					return true, a.putBlock(ctx, key, azureBlockID(job.num), job.buf[:job.n])
+				}, maxRetryAttemps, IsRetryableError)
+				if job.bufPtr != nil {
+					bufPool.Put(job.bufPtr)
+				}
+				if stageErr != nil {
+					setErr(stageErr)
+					continue
+				}
+				staged.Add(1)
+			}
+		})
+	}
+
+	for i := 0; i < options.Concurrency; i++ {
+		if submitErr := startWorker(); submitErr != nil {
+			wg.Done()
+			setErr(submitErr)
+			break
+		}
+	}
+
+	sendJob := func(bufPtr *[]byte, buf []byte, n int) bool {
+		partNum++
+		if partNum > maxMultipartParts {
+			setErr(moerr.NewInternalErrorNoCtxf("too many parts for multipart upload: %d", partNum))
+			if bufPtr != nil {
+				bufPool.Put(bufPtr)
+			}
+			return false
+		}
+		job := partJob{
+			num:    partNum,
+			buf:    buf,
+			bufPtr: bufPtr,
+			n:      n,
+		}
+		select {
+		case jobCh <- job:
+			return true
+		case <-ctx.Done():
+			if bufPtr != nil {
+				bufPool.Put(bufPtr)
+			}
+			setErr(ctx.Err())
+			return false
+		}
+	}
+
+	if sendJob(firstBufPtr, firstBuf, firstN) && !errors.Is(err, io.EOF) {
+		for {
+			nextBufPtr, nextBuf, nextN, readErr := readChunk()
+			if readErr != nil && !errors.Is(readErr, io.EOF) {
+				setErr(readErr)
+				break
+			}
+			if nextN == 0 {
+				if nextBufPtr != nil {
+					bufPool.Put(nextBufPtr)
+				}
+				break
+			}
+			if !sendJob(nextBufPtr, nextBuf, nextN) {
+				break
+			}
+			if readErr != nil {
+				break
+			}
+		}
+	}
+
+	close(jobCh)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if staged.Load() != partNum {
+		return moerr.NewInternalErrorNoCtxf("multipart upload incomplete, expect %d parts got %d", partNum, staged.Load())
+	}
+
+	ids := make([]string, 0, partNum)
+	for i := int32(1); i <= partNum; i++ {
+		ids = append(ids, azureBlockID(i))
+	}
+	_, err = DoWithRetry("azure put block list", func() (bool, error) {
+		return true, a.putBlockList(ctx, key, ids)
+	}, maxRetryAttemps, IsRetryableError)
+	return err
+}
+
+func (a *AzureBlobSDK) Read(
+	ctx context.Context,
+	key string,
+	min *int64,
+	max *int64,
+) (
+	r io.ReadCloser,
+	err error,
+) {
+
+	defer func() {
+		if isHTTPNotFound(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if max == nil {
+		// read to end
+		r, err := a.getBlob(
+			ctx,
+			key,
+			min,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+
+	r, err = a.getBlob(
+		ctx,
+		key,
+		min,
+		max,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &readCloser{
+		r:         io.LimitReader(r, int64(*max-*min)),
+		closeFunc: r.Close,
+	}, nil
+}
+
+// Delete deletes the blobs one by one. The missing ones are ignored.
+func (a *AzureBlobSDK) Delete(
+	ctx context.Context,
+	keys ...string,
+) (
+	err error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := a.deleteBlob(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// azureBlockID returns the id of the nth block. All the ids of a blob must have the same length.
+func azureBlockID(n int32) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("block-%010d", n)))
+}
+
+type azureListBlobsResult struct {
+	Blobs []struct {
+		Name       string `xml:"Name"`
+		Properties struct {
+			ContentLength int64 `xml:"Content-Length"`
+		} `xml:"Properties"`
+	} `xml:"Blobs>Blob"`
+	Prefixes []struct {
+		Name string `xml:"Name"`
+	} `xml:"Blobs>BlobPrefix"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+func (a *AzureBlobSDK) listBlobs(ctx context.Context, prefix string, marker string) (*azureListBlobsResult, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureBlobSDK.listBlobs")
+	defer task.End()
+
+	query := url.Values{
+		"restype":   {"container"},
+		"comp":      {"list"},
+		"delimiter": {"/"},
+	}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if marker != "" {
+		query.Set("marker", marker)
+	}
+	if a.listMaxKeys > 0 {
+		query.Set("maxresults", strconv.Itoa(a.listMaxKeys))
+	}
+
+	return DoWithRetry(
+		"azure list blobs",
+		func() (*azureListBlobsResult, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.List.Add(1)
+			}, a.perfCounterSets...)
+			resp, err := a.do(ctx, http.MethodGet, "", query, nil, nil, 0)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+			result := new(azureListBlobsResult)
+			if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+		maxRetryAttemps,
+		IsRetryableError,
+	)
+}
+
+func (a *AzureBlobSDK) getBlobProperties(ctx context.Context, key string) (http.Header, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureBlobSDK.getBlobProperties")
+	defer task.End()
+
+	return DoWithRetry(
+		"azure get blob properties",
+		func() (http.Header, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.Head.Add(1)
+			}, a.perfCounterSets...)
+			resp, err := a.do(ctx, http.MethodHead, key, nil, nil, nil, 0)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body.Close()
+			return resp.Header, nil
+		},
+		maxRetryAttemps,
+		IsRetryableError,
+	)
+}
+
+func (a *AzureBlobSDK) putBlob(ctx context.Context, key string, data []byte) error {
+	ctx, task := gotrace.NewTask(ctx, "AzureBlobSDK.putBlob")
+	defer task.End()
+
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Put.Add(1)
+	}, a.perfCounterSets...)
+
+	header := http.Header{
+		"X-Ms-Blob-Type": {"BlockBlob"},
+	}
+	resp, err := a.do(ctx, http.MethodPut, key, nil, header, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (a *AzureBlobSDK) putBlock(ctx context.Context, key string, id string, data []byte) error {
+	ctx, task := gotrace.NewTask(ctx, "AzureBlobSDK.putBlock")
+	defer task.End()
+
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Put.Add(1)
+	}, a.perfCounterSets...)
+
+	resp, err := a.do(ctx, http.MethodPut, key, url.Values{
+		"comp":    {"block"},
+		"blockid": {id},
+	}, nil, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+type azureBlockList struct {
+	XMLName xml.Name `xml:"BlockList"`
+	Latest  []string `xml:"Latest"`
+}
+
+func (a *AzureBlobSDK) putBlockList(ctx context.Context, key string, ids []string) error {
+	ctx, task := gotrace.NewTask(ctx, "AzureBlobSDK.putBlockList")
+	defer task.End()
+
+	body, err := xml.Marshal(azureBlockList{
+		Latest: ids,
+	})
+	if err != nil {
+		return err
+	}
+	body = append([]byte(xml.Header), body...)
+
+	resp, err := a.do(ctx, http.MethodPut, key, url.Values{
+		"comp": {"blocklist"},
+	}, http.Header{
+		"Content-Type": {"application/xml"},
+	}, bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (a *AzureBlobSDK) getBlob(ctx context.Context, key string, min *int64, max *int64) (io.ReadCloser, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureBlobSDK.getBlob")
+	defer task.End()
+
+	if min == nil {
+		min = ptrTo[int64](0)
+	}
+
+	return newRetryableReader(
+		func(offset int64) (io.ReadCloser, error) {
+			var rang string
+			if max != nil {
+				rang = fmt.Sprintf("bytes=%d-%d", offset, *max)
+			} else {
+				rang = fmt.Sprintf("bytes=%d-", offset)
+			}
+			header := http.Header{
+				"X-Ms-Range": {rang},
+			}
+
+			return DoWithRetry(
+				"azure get blob",
+				func() (io.ReadCloser, error) {
+					perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+						counter.FileService.S3.Get.Add(1)
+					}, a.perfCounterSets...)
+					resp, err := a.do(ctx, http.MethodGet, key, nil, header, nil, 0)
+					if err != nil {
+						return nil, err
+					}
+					return &readCloser{
+						r: resp.Body,
+						closeFunc: func() error {
+							// drain
+							io.Copy(io.Discard, resp.Body)
+							return resp.Body.Close()
+						},
+					}, nil
+				},
+				maxRetryAttemps,
+				IsRetryableError,
+			)
+
+		},
+		*min,
+		IsRetryableError,
+	)
+}
+
+func (a *AzureBlobSDK) deleteBlob(ctx context.Context, key string) error {
+	ctx, span := trace.Start(ctx, "AzureBlobSDK.deleteBlob")
+	defer span.End()
+
+	_, err := DoWithRetry(
+		"azure delete blob",
+		func() (bool, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.Delete.Add(1)
+			}, a.perfCounterSets...)
+			resp, err := a.do(ctx, http.MethodDelete, key, nil, nil, nil, 0)
+			if err != nil {
+				if isHTTPNotFound(err) {
+					return true, nil
+				}
+				return false, err
+			}
+			resp.Body.Close()
+			return true, nil
+		},
+		maxRetryAttemps,
+		IsRetryableError,
+	)
+	return err
+}
+
+// do sends the request of the container or the blob. The responses other
+// than 2xx are returned as *httpStatusError.
+func (a *AzureBlobSDK) do(
+	ctx context.Context,
+	method string,
+	key string,
+	query url.Values,
+	header http.Header,
+	body io.Reader,
+	contentLength int64,
+) (*http.Response, error) {
+
+	u := *a.containerURL
+	if key != "" {
+		u.Path += "/" + key
+	}
+	values := url.Values{}
+	for k, v := range query {
+		values[k] = v
+	}
+	for k, v := range a.sasToken {
+		values[k] = v
+	}
+	u.RawQuery = values.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = contentLength
+	if contentLength == 0 {
+		req.Body = http.NoBody
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("X-Ms-Version", azureAPIVersion)
+	req.Header.Set("X-Ms-Date", time.Now().UTC().Format(http.TimeFormat))
+
+	switch {
+	case a.tokenSource != nil:
+		token, err := a.tokenSource.token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	case a.accountKey != nil:
+		req.Header.Set("Authorization", fmt.Sprintf(
+			"SharedKey %s:%s",
+			a.account,
+			azureSharedKeySignature(a.account, a.accountKey, req),
+		))
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+	httpErr := &httpStatusError{
+		Op:         "azure " + strings.ToLower(method),
+		StatusCode: resp.StatusCode,
+		Code:       resp.Header.Get("X-Ms-Error-Code"),
+	}
+	var errResp struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	content, _ := io.ReadAll(io.LimitReader(resp.Body, azureMaxErrorBodySize))
+	if xml.Unmarshal(content, &errResp) == nil {
+		httpErr.Code = firstNonZero(httpErr.Code, errResp.Code)
+		httpErr.Message = errResp.Message
+	} else {
+		httpErr.Message = string(content)
+	}
+	return nil, httpErr
+}
+
+// azureSharedKeySignature signs the request with the shared key of the account.
+// See https://learn.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func azureSharedKeySignature(account string, key []byte, req *http.Request) string {
+	var contentLength string
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	// canonicalized headers
+	var msHeaders []string
+	for k := range req.Header {
+		if k = strings.ToLower(k); strings.HasPrefix(k, "x-ms-") {
+			msHeaders = append(msHeaders, k)
+		}
+	}
+	sort.Strings(msHeaders)
+	var canonicalizedHeaders strings.Builder
+	for i, k := range msHeaders {
+		if i > 0 {
+			canonicalizedHeaders.WriteByte('\n')
+		}
+		canonicalizedHeaders.WriteString(k)
+		canonicalizedHeaders.WriteByte(':')
+		canonicalizedHeaders.WriteString(strings.Join(req.Header.Values(k), ","))
+	}
+
+	// canonicalized resource
+	var canonicalizedResource strings.Builder
+	canonicalizedResource.WriteString("/")
+	canonicalizedResource.WriteString(account)
+	if path := req.URL.EscapedPath(); path != "" {
+		canonicalizedResource.WriteString(path)
+	} else {
+		canonicalizedResource.WriteString("/")
+	}
+	query := req.URL.Query()
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		values := append([]string(nil), query[name]...)
+		sort.Strings(values)
+		canonicalizedResource.WriteByte('\n')
+		canonicalizedResource.WriteString(strings.ToLower(name))
+		canonicalizedResource.WriteByte(':')
+		canonicalizedResource.WriteString(strings.Join(values, ","))
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // date, x-ms-date is used
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		canonicalizedHeaders.String(),
+		canonicalizedResource.String(),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,575 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeListEntries lists the keys under prefix like the object storages. The
+// keys with the delimiter after the prefix are rolled up into directories.
+func fakeListEntries(keys []string, prefix string, delimiter string) (entries []DirEntry) {
+	sort.Strings(keys)
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		rest := key[len(prefix):]
+		if delimiter != "" {
+			if i := strings.Index(rest, delimiter); i >= 0 {
+				dir := prefix + rest[:i+len(delimiter)]
+				if !seen[dir] {
+					seen[dir] = true
+					entries = append(entries, DirEntry{
+						IsDir: true,
+						Name:  dir,
+					})
+				}
+				continue
+			}
+		}
+		entries = append(entries, DirEntry{
+			Name: key,
+		})
+	}
+	return
+}
+
+// fakeListPage returns the page of the entries at marker and the marker of the next page.
+func fakeListPage(entries []DirEntry, marker string, maxResults string) ([]DirEntry, string) {
+	start, _ := strconv.Atoi(marker)
+	if start > len(entries) {
+		start = len(entries)
+	}
+	end := len(entries)
+	if n, err := strconv.Atoi(maxResults); err == nil && n > 0 && start+n < end {
+		end = start + n
+	}
+	var next string
+	if end < len(entries) {
+		next = strconv.Itoa(end)
+	}
+	return entries[start:end], next
+}
+
+type fakeAzureBlobServer struct {
+	*httptest.Server
+	container string
+	account   string
+	key       []byte
+	sasToken  url.Values
+	// clientSecret enables the tokens of the service principal, only the last
+	// issued one is accepted
+	clientSecret string
+
+	mu         sync.Mutex
+	blobs      map[string][]byte
+	blocks     map[string]map[string][]byte
+	failBlock  string
+	blockLists int
+	tokens     int
+}
+
+func newFakeAzureBlobServer(t *testing.T) *fakeAzureBlobServer {
+	s := &fakeAzureBlobServer{
+		container: "test",
+		account:   "acct",
+		key:       []byte("account key"),
+		blobs:     make(map[string][]byte),
+		blocks:    make(map[string]map[string][]byte),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeAzureBlobServer) newSDK(t *testing.T) *AzureBlobSDK {
+	sdk, err := NewAzureBlobSDK(
+		context.Background(),
+		ObjectStorageArguments{
+			Name:                 "azure",
+			IsAzure:              true,
+			NoDefaultCredentials: true,
+			Endpoint:             s.URL,
+			Bucket:               s.container,
+			KeyID:                s.account,
+			KeySecret:            base64.StdEncoding.EncodeToString(s.key),
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sdk
+}
+
+// issueToken issues the tokens which expire in a second.
+func (s *fakeAzureBlobServer) issueToken(w http.ResponseWriter, r *http.Request) {
+	if r.PostFormValue("grant_type") != "client_credentials" ||
+		r.PostFormValue("client_id") != "client" ||
+		r.PostFormValue("client_secret") != s.clientSecret ||
+		r.PostFormValue("scope") != azureStorageScope ||
+		r.URL.Path != "/tenant/oauth2/v2.0/token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	s.mu.Lock()
+	s.tokens++
+	token := "token-" + strconv.Itoa(s.tokens)
+	s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"token_type":"Bearer","access_token":%q,"expires_in":1}`, token)
+}
+
+func (s *fakeAzureBlobServer) numTokens() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens
+}
+
+func (s *fakeAzureBlobServer) blob(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.blobs[key]
+	return data, ok
+}
+
+func (s *fakeAzureBlobServer) numBlockLists() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.blockLists
+}
+
+func (s *fakeAzureBlobServer) writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("X-Ms-Error-Code", code)
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>%s</Code><Message>%s</Message></Error>`, code, code)
+}
+
+func (s *fakeAzureBlobServer) serve(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// auth
+	if strings.HasSuffix(r.URL.Path, "/oauth2/v2.0/token") {
+		s.issueToken(w, r)
+		return
+	}
+	if s.clientSecret != "" {
+		s.mu.Lock()
+		expected := "Bearer token-" + strconv.Itoa(s.tokens)
+		s.mu.Unlock()
+		if r.Header.Get("Authorization") != expected {
+			s.writeError(w, http.StatusForbidden, "AuthenticationFailed")
+			return
+		}
+	} else if s.sasToken != nil {
+		for k := range s.sasToken {
+			if query.Get(k) != s.sasToken.Get(k) {
+				s.writeError(w, http.StatusForbidden, "AuthenticationFailed")
+				return
+			}
+		}
+		if r.Header.Get("Authorization") != "" {
+			s.writeError(w, http.StatusForbidden, "AuthenticationFailed")
+			return
+		}
+	} else if s.key != nil {
+		expected := "SharedKey " + s.account + ":" + azureSharedKeySignature(s.account, s.key, r)
+		if r.Header.Get("Authorization") != expected {
+			s.writeError(w, http.StatusForbidden, "AuthenticationFailed")
+			return
+		}
+	}
+	if r.Header.Get("X-Ms-Version") == "" || r.Header.Get("X-Ms-Date") == "" {
+		s.writeError(w, http.StatusBadRequest, "MissingRequiredHeader")
+		return
+	}
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+s.container)
+	if !ok {
+		s.writeError(w, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+	key = strings.TrimPrefix(key, "/")
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "InvalidInput")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+
+	case key == "" && query.Get("comp") == "list":
+		var keys []string
+		for k := range s.blobs {
+			keys = append(keys, k)
+		}
+		entries, next := fakeListPage(
+			fakeListEntries(keys, query.Get("prefix"), query.Get("delimiter")),
+			query.Get("marker"),
+			query.Get("maxresults"),
+		)
+		type blob struct {
+			Name          string `xml:"Name"`
+			ContentLength int64  `xml:"Properties>Content-Length"`
+		}
+		type prefix struct {
+			Name string `xml:"Name"`
+		}
+		var result struct {
+			XMLName    xml.Name `xml:"EnumerationResults"`
+			Blobs      []blob   `xml:"Blobs>Blob"`
+			Prefixes   []prefix `xml:"Blobs>BlobPrefix"`
+			NextMarker string   `xml:"NextMarker"`
+		}
+		for _, entry := range entries {
+			if entry.IsDir {
+				result.Prefixes = append(result.Prefixes, prefix{Name: entry.Name})
+			} else {
+				result.Blobs = append(result.Blobs, blob{
+					Name:          entry.Name,
+					ContentLength: int64(len(s.blobs[entry.Name])),
+				})
+			}
+		}
+		result.NextMarker = next
+		content, _ := xml.Marshal(result)
+		w.Header().Set("Content-Type", "application/xml")
+		w.Write(content)
+
+	case key == "" && query.Get("restype") == "container":
+		w.WriteHeader(http.StatusOK)
+
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		id := query.Get("blockid")
+		if id == s.failBlock {
+			s.writeError(w, http.StatusBadRequest, "InvalidBlockId")
+			return
+		}
+		if s.blocks[key] == nil {
+			s.blocks[key] = make(map[string][]byte)
+		}
+		s.blocks[key][id] = body
+		w.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var list azureBlockList
+		if err := xml.Unmarshal(body, &list); err != nil {
+			s.writeError(w, http.StatusBadRequest, "InvalidXmlDocument")
+			return
+		}
+		var data []byte
+		for _, id := range list.Latest {
+			block, ok := s.blocks[key][id]
+			if !ok {
+				s.writeError(w, http.StatusBadRequest, "InvalidBlockList")
+				return
+			}
+			data = append(data, block...)
+		}
+		s.blobs[key] = data
+		delete(s.blocks, key)
+		s.blockLists++
+		w.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodPut:
+		if r.Header.Get("X-Ms-Blob-Type") != "BlockBlob" {
+			s.writeError(w, http.StatusBadRequest, "InvalidHeaderValue")
+			return
+		}
+		s.blobs[key] = body
+		w.WriteHeader(http.StatusCreated)
+
+	case r.Method == http.MethodHead:
+		data, ok := s.blobs[key]
+		if !ok {
+			w.Header().Set("X-Ms-Error-Code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+
+	case r.Method == http.MethodGet:
+		data, ok := s.blobs[key]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		start, end := 0, len(data)
+		if rang, ok := strings.CutPrefix(r.Header.Get("X-Ms-Range"), "bytes="); ok {
+			first, last, _ := strings.Cut(rang, "-")
+			start, _ = strconv.Atoi(first)
+			if n, err := strconv.Atoi(last); err == nil && n+1 < end {
+				end = n + 1
+			}
+		}
+		if start > end {
+			start = end
+		}
+		w.WriteHeader(http.StatusPartialContent)
+		w.Write(data[start:end])
+
+	case r.Method == http.MethodDelete:
+		if _, ok := s.blobs[key]; !ok {
+			s.writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		delete(s.blobs, key)
+		w.WriteHeader(http.StatusAccepted)
+
+	default:
+		s.writeError(w, http.StatusBadRequest, "UnsupportedHttpVerb")
+	}
+}
+
+func TestAzureBlobSDK(t *testing.T) {
+	testObjectStorage(t, "azure", func(t *testing.T) *AzureBlobSDK {
+		return newFakeAzureBlobServer(t).newSDK(t)
+	})
+}
+
+func TestAzureBlobSDKStageBlocks(t *testing.T) {
+	server := newFakeAzureBlobServer(t)
+	sdk := server.newSDK(t)
+	ctx := context.Background()
+
+	data := bytes.Repeat([]byte("a"), int(minMultipartPartSize*2+3))
+	size := int64(len(data))
+	err := sdk.WriteMultipartParallel(ctx, "object", bytes.NewReader(data), &size, &ParallelMultipartOption{
+		PartSize:    minMultipartPartSize,
+		Concurrency: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, server.numBlockLists())
+	blob, _ := server.blob("object")
+	assert.Equal(t, data, blob)
+
+	// range read
+	r, err := sdk.Read(ctx, "object", ptrTo[int64](minMultipartPartSize-1), ptrTo[int64](minMultipartPartSize+1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Nil(t, r.Close())
+	assert.Equal(t, []byte("aa"), content)
+
+	// the large object without size hint
+	err = sdk.Write(ctx, "object2", bytes.NewReader(data), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 2, server.numBlockLists())
+	blob, _ = server.blob("object2")
+	assert.Equal(t, data, blob)
+
+	// size mismatch
+	size++
+	err = sdk.WriteMultipartParallel(ctx, "object3", bytes.NewReader(data), &size, &ParallelMultipartOption{
+		PartSize:    minMultipartPartSize,
+		Concurrency: 2,
+	})
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrSizeNotMatch), "got %v", err)
+	_, ok := server.blob("object3")
+	assert.False(t, ok)
+}
+
+func TestAzureBlobSDKStageBlockError(t *testing.T) {
+	server := newFakeAzureBlobServer(t)
+	server.failBlock = azureBlockID(2)
+	sdk := server.newSDK(t)
+
+	data := bytes.Repeat([]byte("b"), int(minMultipartPartSize*3))
+	size := int64(len(data))
+	err := sdk.WriteMultipartParallel(context.Background(), "object", bytes.NewReader(data), &size, &ParallelMultipartOption{
+		PartSize:    minMultipartPartSize,
+		Concurrency: 2,
+	})
+	if err == nil {
+		t.Fatal("expecting error")
+	}
+	assert.Equal(t, 0, server.numBlockLists())
+	_, ok := server.blob("object")
+	assert.False(t, ok)
+}
+
+func TestAzureBlobSDKSASToken(t *testing.T) {
+	server := newFakeAzureBlobServer(t)
+	server.key = nil
+	server.sasToken = url.Values{
+		"sv":  {"2021-08-06"},
+		"sig": {"signature"},
+	}
+
+	sdk, err := NewAzureBlobSDK(
+		context.Background(),
+		ObjectStorageArguments{
+			IsAzure:              true,
+			NoDefaultCredentials: true,
+			Endpoint:             server.URL,
+			Bucket:               server.container,
+			SASToken:             "?" + server.sasToken.Encode(),
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	err = sdk.Write(ctx, "foo", bytes.NewReader([]byte("foo")), ptrTo[int64](3), nil)
+	assert.Nil(t, err)
+	size, err := sdk.Stat(ctx, "foo")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), size)
+
+	// wrong token
+	_, err = NewAzureBlobSDK(
+		context.Background(),
+		ObjectStorageArguments{
+			IsAzure:              true,
+			NoDefaultCredentials: true,
+			Endpoint:             server.URL,
+			Bucket:               server.container,
+			SASToken:             "sv=2021-08-06&sig=wrong",
+		},
+		nil,
+	)
+	if err == nil {
+		t.Fatal("expecting error")
+	}
+}
+
+func TestAzureBlobSDKClientSecret(t *testing.T) {
+	server := newFakeAzureBlobServer(t)
+	server.key = nil
+	server.clientSecret = "secret"
+	t.Setenv("AZURE_AUTHORITY_HOST", server.URL)
+
+	sdk, err := NewAzureBlobSDK(
+		context.Background(),
+		ObjectStorageArguments{
+			IsAzure:              true,
+			NoDefaultCredentials: true,
+			Endpoint:             server.URL,
+			Bucket:               server.container,
+			TenantID:             "tenant",
+			ClientID:             "client",
+			ClientSecret:         server.clientSecret,
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	err = sdk.Write(ctx, "foo", bytes.NewReader([]byte("foo")), ptrTo[int64](3), nil)
+	assert.Nil(t, err)
+	size, err := sdk.Stat(ctx, "foo")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), size)
+	// the expiring token is refreshed instead of being rejected
+	assert.Greater(t, server.numTokens(), 1)
+
+	// no tenant
+	_, err = NewAzureBlobSDK(
+		context.Background(),
+		ObjectStorageArguments{
+			IsAzure:              true,
+			NoDefaultCredentials: true,
+			NoBucketValidation:   true,
+			Endpoint:             server.URL,
+			Bucket:               server.container,
+			ClientID:             "client",
+			ClientSecret:         server.clientSecret,
+		},
+		nil,
+	)
+	assert.NotNil(t, err)
+
+	// wrong secret
+	_, err = NewAzureBlobSDK(
+		context.Background(),
+		ObjectStorageArguments{
+			IsAzure:              true,
+			NoDefaultCredentials: true,
+			Endpoint:             server.URL,
+			Bucket:               server.container,
+			TenantID:             "tenant",
+			ClientID:             "client",
+			ClientSecret:         "wrong",
+		},
+		nil,
+	)
+	assert.NotNil(t, err)
+}
+
+func TestAzureSharedKeySignature(t *testing.T) {
+	key := []byte("key")
+	newRequest := func() *http.Request {
+		req, err := http.NewRequest(http.MethodPut, "https://acct.blob.core.windows.net/test/a%20b?comp=block&blockid=MQ%3D%3D", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.ContentLength = 3
+		req.Header.Set("X-Ms-Date", "Mon, 02 Jan 2006 15:04:05 GMT")
+		req.Header.Set("X-Ms-Version", azureAPIVersion)
+		return req
+	}
+
+	req := newRequest()
+	signature := azureSharedKeySignature("acct", key, req)
+	// stable
+	assert.Equal(t, signature, azureSharedKeySignature("acct", key, newRequest()))
+
+	// the signed parts
+	req = newRequest()
+	req.Header.Set("X-Ms-Meta-Foo", "bar")
+	assert.NotEqual(t, signature, azureSharedKeySignature("acct", key, req))
+	req = newRequest()
+	req.ContentLength = 4
+	assert.NotEqual(t, signature, azureSharedKeySignature("acct", key, req))
+	req = newRequest()
+	req.URL.RawQuery = "comp=block&blockid=Mg%3D%3D"
+	assert.NotEqual(t, signature, azureSharedKeySignature("acct", key, req))
+	assert.NotEqual(t, signature, azureSharedKeySignature("acct2", key, newRequest()))
+
+	// the other headers are not signed
+	req = newRequest()
+	req.Header.Set("User-Agent", "foo")
+	assert.Equal(t, signature, azureSharedKeySignature("acct", key, req))
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+const (
+	azureDefaultAuthorityHost = "https://login.microsoftonline.com"
+	azureStorageScope         = "https://storage.azure.com/.default"
+	azureFetchTokenOp         = "azure fetch token"
+)
+
+// newAzureClientSecretTokenSource fetches the Microsoft Entra ID token of the
+// service principal with its client secret. The token is refreshed before it
+// expires, unlike the bearer token given in the arguments.
+func newAzureClientSecretTokenSource(client *http.Client, tenantID, clientID, clientSecret string) *oauthTokenSource {
+	authorityHost := strings.TrimSuffix(
+		firstNonZero(os.Getenv("AZURE_AUTHORITY_HOST"), azureDefaultAuthorityHost),
+		"/",
+	)
+	tokenURI := authorityHost + "/" + url.PathEscape(tenantID) + "/oauth2/v2.0/token"
+	return &oauthTokenSource{
+		op: azureFetchTokenOp,
+		fetch: func(ctx context.Context) (oauthTokenResponse, error) {
+			return fetchOAuthToken(ctx, client, azureFetchTokenOp, tokenURI, url.Values{
+				"grant_type":    {"client_credentials"},
+				"client_id":     {clientID},
+				"client_secret": {clientSecret},
+				"scope":         {azureStorageScope},
+			})
+		},
+	}
+}
@@ -31,13 +31,15 @@ const (
 	diskTmpFileServiceBackend = "DISK-TMP"
 	s3FileServiceBackend      = "S3"
 	minioFileServiceBackend   = "MINIO"
+	azureFileServiceBackend   = "AZURE"
+	gcsFileServiceBackend     = "GCS"
 )
 
 // Config fileService config
 type Config struct {
 	// Name name of fileservice, describe what an instance of fileservice is used for
 	Name string `toml:"name"`
-	// Backend fileservice backend. [MEM|DISK|DISK-V2|DISK-ETL|S3|MINIO]
+	// Backend fileservice backend. [MEM|DISK|DISK-V2|DISK-ETL|S3|MINIO|AZURE|GCS]
 	// DISK uses the legacy per-2KB-block CRC32 format; DISK-V2 stores raw bytes
 	// matching the S3 (disk-backed) on-disk format.
 	Backend string `toml:"backend"`
@@ -76,6 +78,12 @@ func NewFileService(
 		return newMinioFileService(ctx, cfg, perfCounterSets)
 	case s3FileServiceBackend:
 		return newS3FileService(ctx, cfg, perfCounterSets)
+	case azureFileServiceBackend:
+		cfg.S3.IsAzure = true
+		return newS3FileService(ctx, cfg, perfCounterSets)
+	case gcsFileServiceBackend:
+		cfg.S3.IsGCS = true
+		return newS3FileService(ctx, cfg, perfCounterSets)
 	default:
 		return nil, moerr.NewInternalErrorNoCtxf("file service backend %s not implemented", cfg.Backend)
 	}
@@ -16,6 +16,7 @@ package fileservice
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
@@ -50,6 +51,15 @@ func IsRetryableError(err error) bool {
 		}
 	}
 
+	var httpErr *httpStatusError
+	if errors.As(err, &httpErr) {
+		if httpErr.StatusCode >= http.StatusInternalServerError ||
+			httpErr.StatusCode == http.StatusTooManyRequests ||
+			httpErr.StatusCode == http.StatusRequestTimeout {
+			return true
+		}
+	}
+
 	// unexpected EOF
 	if errors.Is(err, io.ErrUnexpectedEOF) {
 		return true
@@ -86,6 +96,23 @@ func IsRetryableError(err error) bool {
 	return false
 }
 
+// httpStatusError is the error response of the object storages accessed by their REST APIs
+type httpStatusError struct {
+	Op         string
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("%s: status %d, code %s: %s", e.Op, e.StatusCode, e.Code, e.Message)
+}
+
+func isHTTPNotFound(err error) bool {
+	var httpErr *httpStatusError
+	return errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound
+}
+
 type errorStr string
 
 func (e errorStr) Error() string {
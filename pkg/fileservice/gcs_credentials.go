@@ -0,0 +1,177 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	gcsDefaultTokenURI = "https://oauth2.googleapis.com/token"
+	gcsScope           = "https://www.googleapis.com/auth/devstorage.read_write"
+	gcsFetchTokenOp    = "gcs fetch token"
+)
+
+// gcsCredentials is the content of the credentials file, either a service
+// account key or the authorized user of gcloud.
+type gcsCredentials struct {
+	Type string `json:"type"`
+
+	// service_account
+	ClientEmail  string `json:"client_email"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	TokenURI     string `json:"token_uri"`
+
+	// authorized_user
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+func newGCSCredentialsFileTokenSource(client *http.Client, path string) (*oauthTokenSource, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return newGCSCredentialsTokenSource(client, content)
+}
+
+func newGCSCredentialsTokenSource(client *http.Client, content []byte) (*oauthTokenSource, error) {
+	var creds gcsCredentials
+	if err := json.Unmarshal(content, &creds); err != nil {
+		return nil, moerr.NewInvalidInputNoCtxf("invalid gcs credentials: %v", err)
+	}
+	tokenURI := firstNonZero(creds.TokenURI, gcsDefaultTokenURI)
+
+	switch creds.Type {
+
+	case "service_account":
+		key, err := parseGCSPrivateKey(creds.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		return &oauthTokenSource{
+			op: gcsFetchTokenOp,
+			fetch: func(ctx context.Context) (oauthTokenResponse, error) {
+				assertion, err := gcsJWTAssertion(creds.ClientEmail, creds.PrivateKeyID, tokenURI, key, time.Now())
+				if err != nil {
+					return oauthTokenResponse{}, err
+				}
+				return fetchOAuthToken(ctx, client, gcsFetchTokenOp, tokenURI, url.Values{
+					"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+					"assertion":  {assertion},
+				})
+			},
+		}, nil
+
+	case "authorized_user":
+		return &oauthTokenSource{
+			op: gcsFetchTokenOp,
+			fetch: func(ctx context.Context) (oauthTokenResponse, error) {
+				return fetchOAuthToken(ctx, client, gcsFetchTokenOp, tokenURI, url.Values{
+					"grant_type":    {"refresh_token"},
+					"client_id":     {creds.ClientID},
+					"client_secret": {creds.ClientSecret},
+					"refresh_token": {creds.RefreshToken},
+				})
+			},
+		}, nil
+
+	default:
+		return nil, moerr.NewInvalidInputNoCtxf("unsupported gcs credentials type: %s", creds.Type)
+	}
+}
+
+// newGCSMetadataTokenSource fetches the token of the service account of the
+// instance from the metadata server.
+func newGCSMetadataTokenSource(client *http.Client) *oauthTokenSource {
+	host := firstNonZero(os.Getenv("GCE_METADATA_HOST"), "metadata.google.internal")
+	tokenURL := "http://" + host + "/computeMetadata/v1/instance/service-accounts/default/token"
+	return &oauthTokenSource{
+		op: gcsFetchTokenOp,
+		fetch: func(ctx context.Context) (oauthTokenResponse, error) {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL, nil)
+			if err != nil {
+				return oauthTokenResponse{}, err
+			}
+			req.Header.Set("Metadata-Flavor", "Google")
+			return doOAuthTokenRequest(client, gcsFetchTokenOp, req)
+		},
+	}
+}
+
+func parseGCSPrivateKey(content string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(content))
+	if block == nil {
+		return nil, moerr.NewInvalidInputNoCtx("invalid gcs private key")
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, moerr.NewInvalidInputNoCtx("gcs private key is not a RSA key")
+		}
+		return rsaKey, nil
+	}
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, moerr.NewInvalidInputNoCtxf("invalid gcs private key: %v", err)
+	}
+	return key, nil
+}
+
+// gcsJWTAssertion returns the signed JWT to exchange for the access token of the service account.
+func gcsJWTAssertion(email string, keyID string, tokenURI string, key *rsa.PrivateKey, now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"kid": keyID,
+	})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]any{
+		"iss":   email,
+		"scope": gcsScope,
+		"aud":   tokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(claims)
+	sum := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
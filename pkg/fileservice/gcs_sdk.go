@@ -0,0 +1,805 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	gotrace "runtime/trace"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	gcsDefaultEndpoint = "https://storage.googleapis.com"
+	// gcsResumableChunkSize is the size of the chunks of the resumable uploads,
+	// it must be a multiple of 256KiB
+	gcsResumableChunkSize = 16 * (1 << 20)
+	// gcsMaxErrorBodySize limits the error response read into the error
+	gcsMaxErrorBodySize = 64 * 1024
+	// statusResumeIncomplete is returned for the chunks of the resumable uploads
+	statusResumeIncomplete = 308
+)
+
+// GCSSDK is the ObjectStorage of Google Cloud Storage. It talks to the JSON
+// API directly. Small objects are uploaded in one request and the others by
+// resumable uploads, whose chunks are resumed from the bytes persisted by the
+// service when they fail.
+type GCSSDK struct {
+	name            string
+	bucket          string
+	endpoint        string
+	client          *http.Client
+	tokenSource     *oauthTokenSource
+	perfCounterSets []*perfcounter.CounterSet
+	listMaxKeys     int
+}
+
+func NewGCSSDK(
+	ctx context.Context,
+	args ObjectStorageArguments,
+	perfCounterSets []*perfcounter.CounterSet,
+) (_ *GCSSDK, err error) {
+	defer catch(&err)
+
+	// args
+	if err := args.validate(); err != nil {
+		return nil, err
+	}
+	if args.Bucket == "" {
+		return nil, moerr.NewInvalidInputNoCtx("empty gcs bucket")
+	}
+
+	endpoint := strings.TrimSuffix(firstNonZero(args.Endpoint, gcsDefaultEndpoint), "/")
+	client := newHTTPClient(args)
+
+	// credentials
+	var tokenSource *oauthTokenSource
+	switch {
+	case args.BearerToken != "":
+		tokenSource = newStaticTokenSource(args.BearerToken)
+	case args.CredentialsJSON != "":
+		tokenSource, err = newGCSCredentialsTokenSource(client, []byte(args.CredentialsJSON))
+	case args.CredentialsFile != "":
+		// the file is on the CN, it may hold the credentials of the CN itself
+		if args.NoDefaultCredentials {
+			return nil, moerr.NewInvalidInputNoCtx("gcs credentials-file is not allowed when the default credentials are disabled")
+		}
+		tokenSource, err = newGCSCredentialsFileTokenSource(client, args.CredentialsFile)
+	case args.shouldLoadDefaultCredentials():
+		if path := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"); path != "" {
+			tokenSource, err = newGCSCredentialsFileTokenSource(client, path)
+		} else if strings.Contains(endpoint, "googleapis.com") {
+			// no credentials for the emulators
+			tokenSource = newGCSMetadataTokenSource(client)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logutil.Info("new object storage",
+		zap.Any("sdk", "gcs"),
+		zap.Any("arguments", args),
+	)
+
+	sdk := &GCSSDK{
+		name:            args.Name,
+		bucket:          args.Bucket,
+		endpoint:        endpoint,
+		client:          client,
+		tokenSource:     tokenSource,
+		perfCounterSets: perfCounterSets,
+	}
+
+	if !args.NoBucketValidation {
+		// validate bucket
+		_, err := DoWithRetry("gcs bucket get", func() (bool, error) {
+			resp, err := sdk.do(ctx, http.MethodGet, sdk.bucketURL(), nil, nil, 0)
+			if err != nil {
+				return false, err
+			}
+			resp.Body.Close()
+			return true, nil
+		}, maxRetryAttemps, IsRetryableError)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sdk, nil
+}
+
+var _ ObjectStorage = new(GCSSDK)
+
+func (g *GCSSDK) List(
+	ctx context.Context,
+	prefix string,
+) iter.Seq2[*DirEntry, error] {
+	return func(yield func(*DirEntry, error) bool) {
+		if err := ctx.Err(); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		var pageToken string
+
+	loop1:
+		for {
+			result, err := g.listObjects(ctx, prefix, pageToken)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, obj := range result.Items {
+				if !yield(&DirEntry{
+					Name: obj.Name,
+					Size: obj.Size,
+				}, nil) {
+					break loop1
+				}
+			}
+
+			for _, prefix := range result.Prefixes {
+				if !yield(&DirEntry{
+					IsDir: true,
+					Name:  prefix,
+				}, nil) {
+					break loop1
+				}
+			}
+
+			if result.NextPageToken == "" {
+				break
+			}
+			pageToken = result.NextPageToken
+		}
+
+	}
+}
+
+func (g *GCSSDK) Stat(
+	ctx context.Context,
+	key string,
+) (
+	size int64,
+	err error,
+) {
+
+	defer func() {
+		if isHTTPNotFound(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	obj, err := g.statObject(ctx, key)
+	if err != nil {
+		return
+	}
+
+	return obj.Size, nil
+}
+
+func (g *GCSSDK) Exists(
+	ctx context.Context,
+	key string,
+) (
+	bool,
+	error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	_, err := g.statObject(ctx, key)
+	if err != nil {
+		if isHTTPNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Write writes the small objects in one request and the others by a resumable
+// upload. expire is set as the custom time of the object, which the lifecycle
+// rules of the bucket can act on.
+func (g *GCSSDK) Write(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	sizeHint *int64,
+	expire *time.Time,
+) (
+	err error,
+) {
+	defer wrapSizeMismatchErr(&err)
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if sizeHint != nil && *sizeHint < smallObjectThreshold {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		if int64(len(data)) != *sizeHint {
+			return moerr.NewSizeNotMatchNoCtx(key)
+		}
+		_, err = DoWithRetry("write", func() (bool, error) {
+			return true, g.putObject(ctx, key, data, expire)
+		}, maxRetryAttemps, IsRetryableError)
+		return err
+	}
+
+	if sizeHint != nil {
+		r = &exactSizeReader{
+			R:        r,
+			Expected: *sizeHint,
+			Key:      key,
+		}
+	}
+	return g.writeResumable(ctx, key, r, expire)
+}
+
+func (g *GCSSDK) Read(
+	ctx context.Context,
+	key string,
+	min *int64,
+	max *int64,
+) (
+	r io.ReadCloser,
+	err error,
+) {
+
+	defer func() {
+		if isHTTPNotFound(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if max == nil {
+		// read to end
+		r, err := g.getObject(
+			ctx,
+			key,
+			min,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+
+	r, err = g.getObject(
+		ctx,
+		key,
+		min,
+		max,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &readCloser{
+		r:         io.LimitReader(r, int64(*max-*min)),
+		closeFunc: r.Close,
+	}, nil
+}
+
+// Delete deletes the objects one by one. The missing ones are ignored.
+func (g *GCSSDK) Delete(
+	ctx context.Context,
+	keys ...string,
+) (
+	err error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := g.deleteObject(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *GCSSDK) bucketURL() string {
+	return g.endpoint + "/storage/v1/b/" + url.PathEscape(g.bucket)
+}
+
+func (g *GCSSDK) objectURL(key string, query url.Values) string {
+	ret := g.bucketURL() + "/o/" + url.PathEscape(key)
+	if len(query) > 0 {
+		ret += "?" + query.Encode()
+	}
+	return ret
+}
+
+func (g *GCSSDK) uploadURL(query url.Values) string {
+	return g.endpoint + "/upload/storage/v1/b/" + url.PathEscape(g.bucket) + "/o?" + query.Encode()
+}
+
+type gcsObject struct {
+	Name string `json:"name"`
+	Size int64  `json:"size,string"`
+}
+
+type gcsListObjectsResult struct {
+	Items         []gcsObject `json:"items"`
+	Prefixes      []string    `json:"prefixes"`
+	NextPageToken string      `json:"nextPageToken"`
+}
+
+func (g *GCSSDK) listObjects(ctx context.Context, prefix string, pageToken string) (*gcsListObjectsResult, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.listObjects")
+	defer task.End()
+
+	query := url.Values{
+		"delimiter": {"/"},
+	}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if pageToken != "" {
+		query.Set("pageToken", pageToken)
+	}
+	if g.listMaxKeys > 0 {
+		query.Set("maxResults", strconv.Itoa(g.listMaxKeys))
+	}
+
+	return DoWithRetry(
+		"gcs list objects",
+		func() (*gcsListObjectsResult, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.List.Add(1)
+			}, g.perfCounterSets...)
+			resp, err := g.do(ctx, http.MethodGet, g.bucketURL()+"/o?"+query.Encode(), nil, nil, 0)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+			result := new(gcsListObjectsResult)
+			if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+				return nil, err
+			}
+			return result, nil
+		},
+		maxRetryAttemps,
+		IsRetryableError,
+	)
+}
+
+func (g *GCSSDK) statObject(ctx context.Context, key string) (*gcsObject, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.statObject")
+	defer task.End()
+
+	return DoWithRetry(
+		"gcs get object metadata",
+		func() (*gcsObject, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.Head.Add(1)
+			}, g.perfCounterSets...)
+			resp, err := g.do(ctx, http.MethodGet, g.objectURL(key, nil), nil, nil, 0)
+			if err != nil {
+				return nil, err
+			}
+			defer resp.Body.Close()
+			obj := new(gcsObject)
+			if err := json.NewDecoder(resp.Body).Decode(obj); err != nil {
+				return nil, err
+			}
+			return obj, nil
+		},
+		maxRetryAttemps,
+		IsRetryableError,
+	)
+}
+
+func gcsObjectMetadata(key string, expire *time.Time) ([]byte, error) {
+	metadata := map[string]string{
+		"name": key,
+	}
+	if expire != nil {
+		metadata["customTime"] = expire.UTC().Format(time.RFC3339)
+	}
+	return json.Marshal(metadata)
+}
+
+// putObject uploads the object with its metadata in a multipart request.
+func (g *GCSSDK) putObject(ctx context.Context, key string, data []byte, expire *time.Time) error {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.putObject")
+	defer task.End()
+
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Put.Add(1)
+	}, g.perfCounterSets...)
+
+	metadata, err := gcsObjectMetadata(key, expire)
+	if err != nil {
+		return err
+	}
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	part, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"application/json; charset=UTF-8"},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(metadata); err != nil {
+		return err
+	}
+	part, err = w.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"application/octet-stream"},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	resp, err := g.do(ctx, http.MethodPost, g.uploadURL(url.Values{
+		"uploadType": {"multipart"},
+	}), http.Header{
+		"Content-Type": {"multipart/related; boundary=" + w.Boundary()},
+	}, body, int64(body.Len()))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (g *GCSSDK) writeResumable(ctx context.Context, key string, r io.Reader, expire *time.Time) (err error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.writeResumable")
+	defer task.End()
+
+	session, err := DoWithRetry("gcs create resumable upload", func() (string, error) {
+		return g.createResumableUpload(ctx, key, expire)
+	}, maxRetryAttemps, IsRetryableError)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			g.cancelResumableUpload(context.WithoutCancel(ctx), session)
+		}
+	}()
+
+	buf := make([]byte, gcsResumableChunkSize)
+	var offset int64
+	for {
+		n, err := io.ReadFull(r, buf)
+		last := false
+		switch {
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			last = true
+		case err != nil:
+			return err
+		}
+
+		total := int64(-1)
+		if last {
+			total = offset + int64(n)
+		}
+		if err := g.uploadChunk(ctx, session, buf[:n], offset, total); err != nil {
+			return err
+		}
+		offset += int64(n)
+
+		if last {
+			return nil
+		}
+	}
+}
+
+func (g *GCSSDK) createResumableUpload(ctx context.Context, key string, expire *time.Time) (string, error) {
+	metadata, err := gcsObjectMetadata(key, expire)
+	if err != nil {
+		return "", err
+	}
+	resp, err := g.do(ctx, http.MethodPost, g.uploadURL(url.Values{
+		"uploadType": {"resumable"},
+	}), http.Header{
+		"Content-Type": {"application/json; charset=UTF-8"},
+	}, bytes.NewReader(metadata), int64(len(metadata)))
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	location, err := resp.Location()
+	if err != nil {
+		return "", err
+	}
+	return location.String(), nil
+}
+
+// uploadChunk uploads the chunk at offset of the object. total is the size of
+// the object for the last chunk, or -1 for the others. The failed requests
+// are resumed from the bytes persisted by the service.
+func (g *GCSSDK) uploadChunk(ctx context.Context, session string, chunk []byte, offset int64, total int64) error {
+	end := offset + int64(len(chunk))
+	persisted := offset
+	for {
+		var err error
+		persisted, err = DoWithRetry("gcs upload chunk", func() (int64, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.Put.Add(1)
+			}, g.perfCounterSets...)
+			n, err := g.putChunk(ctx, session, chunk[persisted-offset:], persisted, total)
+			if err != nil && IsRetryableError(err) {
+				if n, qerr := g.queryResumableUpload(ctx, session, total); qerr == nil &&
+					n >= offset && n <= end {
+					persisted = n
+				}
+			}
+			return n, err
+		}, maxRetryAttemps, IsRetryableError)
+		if err != nil {
+			return err
+		}
+		if persisted < offset || persisted > end {
+			return moerr.NewInternalErrorNoCtxf("gcs resumable upload persisted %d bytes, expecting %d", persisted, end)
+		}
+		if persisted == end {
+			return nil
+		}
+	}
+}
+
+// putChunk puts the data at offset and returns the size persisted by the service.
+func (g *GCSSDK) putChunk(ctx context.Context, session string, data []byte, offset int64, total int64) (int64, error) {
+	totalStr := "*"
+	if total >= 0 {
+		totalStr = strconv.FormatInt(total, 10)
+	}
+	var contentRange string
+	if len(data) == 0 {
+		contentRange = "bytes */" + totalStr
+	} else {
+		contentRange = fmt.Sprintf("bytes %d-%d/%s", offset, offset+int64(len(data))-1, totalStr)
+	}
+	resp, err := g.do(ctx, http.MethodPut, session, http.Header{
+		"Content-Range": {contentRange},
+	}, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return gcsPersistedSize(resp, total)
+}
+
+// queryResumableUpload returns the size persisted by the service.
+func (g *GCSSDK) queryResumableUpload(ctx context.Context, session string, total int64) (int64, error) {
+	totalStr := "*"
+	if total >= 0 {
+		totalStr = strconv.FormatInt(total, 10)
+	}
+	resp, err := g.do(ctx, http.MethodPut, session, http.Header{
+		"Content-Range": {"bytes */" + totalStr},
+	}, nil, 0)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return gcsPersistedSize(resp, total)
+}
+
+func gcsPersistedSize(resp *http.Response, total int64) (int64, error) {
+	if resp.StatusCode != statusResumeIncomplete {
+		// completed
+		if total < 0 {
+			return 0, moerr.NewInternalErrorNoCtx("gcs resumable upload completed before the last chunk")
+		}
+		return total, nil
+	}
+	// bytes=0-<last byte persisted>
+	rang := resp.Header.Get("Range")
+	if rang == "" {
+		return 0, nil
+	}
+	_, last, ok := strings.Cut(strings.TrimPrefix(rang, "bytes="), "-")
+	if !ok {
+		return 0, moerr.NewInternalErrorNoCtxf("invalid range of the resumable upload: %s", rang)
+	}
+	n, err := strconv.ParseInt(last, 10, 64)
+	if err != nil {
+		return 0, moerr.NewInternalErrorNoCtxf("invalid range of the resumable upload: %s", rang)
+	}
+	return n + 1, nil
+}
+
+func (g *GCSSDK) cancelResumableUpload(ctx context.Context, session string) {
+	resp, err := g.do(ctx, http.MethodDelete, session, nil, nil, 0)
+	if err == nil {
+		resp.Body.Close()
+	}
+	// 499 is returned for the canceled upload
+}
+
+func (g *GCSSDK) getObject(ctx context.Context, key string, min *int64, max *int64) (io.ReadCloser, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.getObject")
+	defer task.End()
+
+	if min == nil {
+		min = ptrTo[int64](0)
+	}
+
+	return newRetryableReader(
+		func(offset int64) (io.ReadCloser, error) {
+			var rang string
+			if max != nil {
+				rang = fmt.Sprintf("bytes=%d-%d", offset, *max)
+			} else {
+				rang = fmt.Sprintf("bytes=%d-", offset)
+			}
+			header := http.Header{
+				"Range": {rang},
+			}
+
+			return DoWithRetry(
+				"gcs get object",
+				func() (io.ReadCloser, error) {
+					perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+						counter.FileService.S3.Get.Add(1)
+					}, g.perfCounterSets...)
+					resp, err := g.do(ctx, http.MethodGet, g.objectURL(key, url.Values{
+						"alt": {"media"},
+					}), header, nil, 0)
+					if err != nil {
+						return nil, err
+					}
+					return &readCloser{
+						r: resp.Body,
+						closeFunc: func() error {
+							// drain
+							io.Copy(io.Discard, resp.Body)
+							return resp.Body.Close()
+						},
+					}, nil
+				},
+				maxRetryAttemps,
+				IsRetryableError,
+			)
+
+		},
+		*min,
+		IsRetryableError,
+	)
+}
+
+func (g *GCSSDK) deleteObject(ctx context.Context, key string) error {
+	ctx, span := trace.Start(ctx, "GCSSDK.deleteObject")
+	defer span.End()
+
+	_, err := DoWithRetry(
+		"gcs delete object",
+		func() (bool, error) {
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.Delete.Add(1)
+			}, g.perfCounterSets...)
+			resp, err := g.do(ctx, http.MethodDelete, g.objectURL(key, nil), nil, nil, 0)
+			if err != nil {
+				if isHTTPNotFound(err) {
+					return true, nil
+				}
+				return false, err
+			}
+			resp.Body.Close()
+			return true, nil
+		},
+		maxRetryAttemps,
+		IsRetryableError,
+	)
+	return err
+}
+
+// do sends the request. The responses other than 2xx and 308 are returned as *httpStatusError.
+func (g *GCSSDK) do(
+	ctx context.Context,
+	method string,
+	rawURL string,
+	header http.Header,
+	body io.Reader,
+	contentLength int64,
+) (*http.Response, error) {
+
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = contentLength
+	if contentLength == 0 {
+		req.Body = http.NoBody
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if g.tokenSource != nil {
+		token, err := g.tokenSource.token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 ||
+		resp.StatusCode == statusResumeIncomplete {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+	httpErr := &httpStatusError{
+		Op:         "gcs " + strings.ToLower(method),
+		StatusCode: resp.StatusCode,
+	}
+	var errResp struct {
+		Error struct {
+			Message string `json:"message"`
+			Errors  []struct {
+				Reason string `json:"reason"`
+			} `json:"errors"`
+		} `json:"error"`
+	}
+	content, _ := io.ReadAll(io.LimitReader(resp.Body, gcsMaxErrorBodySize))
+	if json.Unmarshal(content, &errResp) == nil && errResp.Error.Message != "" {
+		httpErr.Message = errResp.Error.Message
+		if len(errResp.Error.Errors) > 0 {
+			httpErr.Code = errResp.Error.Errors[0].Reason
+		}
+	} else {
+		httpErr.Message = string(content)
+	}
+	return nil, httpErr
+}
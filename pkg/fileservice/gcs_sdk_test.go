@@ -0,0 +1,577 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+type fakeGCSObject struct {
+	data       []byte
+	customTime string
+}
+
+type fakeGCSServer struct {
+	*httptest.Server
+	bucket string
+	// token is the access token the requests must carry if not empty
+	token string
+	// publicKey verifies the JWT assertions of the service account
+	publicKey *rsa.PublicKey
+
+	mu            sync.Mutex
+	objects       map[string]*fakeGCSObject
+	sessions      map[string]*fakeGCSObject
+	sessionNames  map[string]string
+	nextSession   int
+	chunks        int
+	canceled      int
+	tokenRequests int
+	// failChunkAt persists 256KiB of the chunk at the offset then fails the request
+	failChunkAt int64
+}
+
+func newFakeGCSServer(t *testing.T) *fakeGCSServer {
+	s := &fakeGCSServer{
+		bucket:       "test",
+		token:        "token",
+		objects:      make(map[string]*fakeGCSObject),
+		sessions:     make(map[string]*fakeGCSObject),
+		sessionNames: make(map[string]string),
+		failChunkAt:  -1,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeGCSServer) newSDK(t *testing.T) *GCSSDK {
+	sdk, err := NewGCSSDK(
+		context.Background(),
+		ObjectStorageArguments{
+			Name:                 "gcs",
+			IsGCS:                true,
+			NoDefaultCredentials: true,
+			Endpoint:             s.URL,
+			Bucket:               s.bucket,
+			BearerToken:          s.token,
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sdk
+}
+
+func (s *fakeGCSServer) object(key string) (*fakeGCSObject, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.objects[key]
+	return obj, ok
+}
+
+func (s *fakeGCSServer) stats() (chunks int, canceled int, tokenRequests int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.chunks, s.canceled, s.tokenRequests
+}
+
+func (s *fakeGCSServer) writeError(w http.ResponseWriter, status int, reason string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"error":{"code":%d,"message":"%s","errors":[{"reason":"%s"}]}}`, status, reason, reason)
+}
+
+func (s *fakeGCSServer) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func (s *fakeGCSServer) serve(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path == "/token" {
+		s.serveToken(w, r, body)
+		return
+	}
+	if s.token != "" && r.Header.Get("Authorization") != "Bearer "+s.token {
+		s.writeError(w, http.StatusUnauthorized, "authError")
+		return
+	}
+
+	query := r.URL.Query()
+	path := r.URL.EscapedPath()
+	bucketPath := "/storage/v1/b/" + s.bucket
+	uploadPath := "/upload/storage/v1/b/" + s.bucket + "/o"
+
+	switch {
+
+	case path == bucketPath && r.Method == http.MethodGet:
+		s.writeJSON(w, map[string]string{"name": s.bucket})
+
+	case path == bucketPath+"/o" && r.Method == http.MethodGet:
+		var keys []string
+		for k := range s.objects {
+			keys = append(keys, k)
+		}
+		entries, next := fakeListPage(
+			fakeListEntries(keys, query.Get("prefix"), query.Get("delimiter")),
+			query.Get("pageToken"),
+			query.Get("maxResults"),
+		)
+		result := gcsListObjectsResult{
+			NextPageToken: next,
+		}
+		for _, entry := range entries {
+			if entry.IsDir {
+				result.Prefixes = append(result.Prefixes, entry.Name)
+			} else {
+				result.Items = append(result.Items, gcsObject{
+					Name: entry.Name,
+					Size: int64(len(s.objects[entry.Name].data)),
+				})
+			}
+		}
+		s.writeJSON(w, result)
+
+	case strings.HasPrefix(path, bucketPath+"/o/"):
+		key, err := url.PathUnescape(strings.TrimPrefix(path, bucketPath+"/o/"))
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, "invalid")
+			return
+		}
+		obj, ok := s.objects[key]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "notFound")
+			return
+		}
+		switch {
+		case r.Method == http.MethodDelete:
+			delete(s.objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		case query.Get("alt") == "media":
+			start, end := 0, len(obj.data)
+			if rang, ok := strings.CutPrefix(r.Header.Get("Range"), "bytes="); ok {
+				first, last, _ := strings.Cut(rang, "-")
+				start, _ = strconv.Atoi(first)
+				if n, err := strconv.Atoi(last); err == nil && n+1 < end {
+					end = n + 1
+				}
+			}
+			if start > end {
+				start = end
+			}
+			w.WriteHeader(http.StatusPartialContent)
+			w.Write(obj.data[start:end])
+		default:
+			s.writeJSON(w, gcsObject{
+				Name: key,
+				Size: int64(len(obj.data)),
+			})
+		}
+
+	case path == uploadPath && r.Method == http.MethodPost && query.Get("uploadType") == "multipart":
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediaType != "multipart/related" {
+			s.writeError(w, http.StatusBadRequest, "invalid")
+			return
+		}
+		mr := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		var metadata struct {
+			Name       string `json:"name"`
+			CustomTime string `json:"customTime"`
+		}
+		part, err := mr.NextPart()
+		if err == nil {
+			err = json.NewDecoder(part).Decode(&metadata)
+		}
+		var data []byte
+		if err == nil {
+			part, err = mr.NextPart()
+			if err == nil {
+				data, err = io.ReadAll(part)
+			}
+		}
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, "invalid")
+			return
+		}
+		s.objects[metadata.Name] = &fakeGCSObject{
+			data:       data,
+			customTime: metadata.CustomTime,
+		}
+		s.writeJSON(w, gcsObject{Name: metadata.Name, Size: int64(len(data))})
+
+	case path == uploadPath && r.Method == http.MethodPost && query.Get("uploadType") == "resumable":
+		var metadata struct {
+			Name       string `json:"name"`
+			CustomTime string `json:"customTime"`
+		}
+		if err := json.Unmarshal(body, &metadata); err != nil {
+			s.writeError(w, http.StatusBadRequest, "invalid")
+			return
+		}
+		s.nextSession++
+		id := strconv.Itoa(s.nextSession)
+		s.sessions[id] = &fakeGCSObject{
+			customTime: metadata.CustomTime,
+		}
+		s.sessionNames[id] = metadata.Name
+		w.Header().Set("Location", s.URL+uploadPath+"?uploadType=resumable&upload_id="+id)
+		w.WriteHeader(http.StatusOK)
+
+	case path == uploadPath && query.Get("upload_id") != "":
+		id := query.Get("upload_id")
+		upload, ok := s.sessions[id]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "notFound")
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(s.sessions, id)
+			s.canceled++
+			w.WriteHeader(499)
+			return
+		}
+		s.serveChunk(w, r, id, upload, body)
+
+	default:
+		s.writeError(w, http.StatusBadRequest, "invalid")
+	}
+}
+
+func (s *fakeGCSServer) serveChunk(w http.ResponseWriter, r *http.Request, id string, upload *fakeGCSObject, body []byte) {
+	rang, totalStr, ok := strings.Cut(strings.TrimPrefix(r.Header.Get("Content-Range"), "bytes "), "/")
+	if !ok {
+		s.writeError(w, http.StatusBadRequest, "invalid")
+		return
+	}
+	if rang != "*" {
+		first, last, _ := strings.Cut(rang, "-")
+		start, err1 := strconv.ParseInt(first, 10, 64)
+		end, err2 := strconv.ParseInt(last, 10, 64)
+		if err1 != nil || err2 != nil ||
+			start != int64(len(upload.data)) ||
+			end-start+1 != int64(len(body)) {
+			s.writeError(w, http.StatusBadRequest, "invalid")
+			return
+		}
+		s.chunks++
+		if start == s.failChunkAt {
+			s.failChunkAt = -1
+			upload.data = append(upload.data, body[:256*1024]...)
+			s.writeError(w, http.StatusServiceUnavailable, "backendError")
+			return
+		}
+		upload.data = append(upload.data, body...)
+	}
+	if totalStr != "*" {
+		total, err := strconv.ParseInt(totalStr, 10, 64)
+		if err != nil || total < int64(len(upload.data)) {
+			s.writeError(w, http.StatusBadRequest, "invalid")
+			return
+		}
+		if total == int64(len(upload.data)) {
+			name := s.sessionNames[id]
+			s.objects[name] = upload
+			delete(s.sessions, id)
+			s.writeJSON(w, gcsObject{Name: name, Size: total})
+			return
+		}
+	}
+	if len(upload.data) > 0 {
+		w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(upload.data)-1))
+	}
+	w.WriteHeader(statusResumeIncomplete)
+}
+
+func (s *fakeGCSServer) serveToken(w http.ResponseWriter, r *http.Request, body []byte) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil || form.Get("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" {
+		s.writeError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+	parts := strings.Split(form.Get("assertion"), ".")
+	if len(parts) != 3 {
+		s.writeError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+	sum := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(s.publicKey, crypto.SHA256, sum[:], signature); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+	content, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	var claims struct {
+		Iss   string `json:"iss"`
+		Aud   string `json:"aud"`
+		Scope string `json:"scope"`
+		Exp   int64  `json:"exp"`
+	}
+	if err := json.Unmarshal(content, &claims); err != nil ||
+		claims.Iss != "mo@test.iam.gserviceaccount.com" ||
+		claims.Aud != s.URL+"/token" ||
+		claims.Scope != gcsScope ||
+		claims.Exp <= time.Now().Unix() {
+		s.writeError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+	s.tokenRequests++
+	s.writeJSON(w, oauthTokenResponse{
+		AccessToken: s.token,
+		ExpiresIn:   3600,
+	})
+}
+
+func TestGCSSDK(t *testing.T) {
+	testObjectStorage(t, "gcs", func(t *testing.T) *GCSSDK {
+		return newFakeGCSServer(t).newSDK(t)
+	})
+}
+
+func TestGCSSDKResumableUpload(t *testing.T) {
+	server := newFakeGCSServer(t)
+	sdk := server.newSDK(t)
+	ctx := context.Background()
+
+	data := make([]byte, gcsResumableChunkSize*2+100)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	expire := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	err := sdk.Write(ctx, "a/object", bytes.NewReader(data), nil, &expire)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, ok := server.object("a/object")
+	if !ok {
+		t.Fatal("object not found")
+	}
+	assert.Equal(t, data, obj.data)
+	assert.Equal(t, "2030-01-02T03:04:05Z", obj.customTime)
+	chunks, _, _ := server.stats()
+	assert.Equal(t, 3, chunks)
+
+	// range read
+	r, err := sdk.Read(ctx, "a/object", ptrTo[int64](gcsResumableChunkSize-1), ptrTo[int64](gcsResumableChunkSize+1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Nil(t, r.Close())
+	assert.Equal(t, data[gcsResumableChunkSize-1:gcsResumableChunkSize+1], content)
+
+	// the size of the object is a multiple of the chunk size
+	err = sdk.Write(ctx, "b", bytes.NewReader(data[:gcsResumableChunkSize]), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, ok = server.object("b")
+	if !ok {
+		t.Fatal("object not found")
+	}
+	assert.Equal(t, data[:gcsResumableChunkSize], obj.data)
+	assert.Equal(t, "", obj.customTime)
+}
+
+func TestGCSSDKResumableUploadResume(t *testing.T) {
+	server := newFakeGCSServer(t)
+	server.failChunkAt = gcsResumableChunkSize
+	sdk := server.newSDK(t)
+
+	data := bytes.Repeat([]byte("abcdefg"), gcsResumableChunkSize/3)
+	err := sdk.Write(context.Background(), "object", bytes.NewReader(data), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	obj, ok := server.object("object")
+	if !ok {
+		t.Fatal("object not found")
+	}
+	assert.Equal(t, data, obj.data)
+	// the failed chunk is resumed from the persisted bytes
+	chunks, canceled, _ := server.stats()
+	assert.Equal(t, 4, chunks)
+	assert.Equal(t, 0, canceled)
+}
+
+func TestGCSSDKResumableUploadCancel(t *testing.T) {
+	server := newFakeGCSServer(t)
+	sdk := server.newSDK(t)
+
+	readErr := errors.New("read error")
+	err := sdk.Write(context.Background(), "object", &failAfterBytesReader{
+		r:         bytes.NewReader(make([]byte, gcsResumableChunkSize*2)),
+		failAfter: gcsResumableChunkSize + 1,
+		errAfter:  readErr,
+	}, nil, nil)
+	assert.ErrorIs(t, err, readErr)
+	_, ok := server.object("object")
+	assert.False(t, ok)
+	chunks, canceled, _ := server.stats()
+	assert.Equal(t, 1, chunks)
+	assert.Equal(t, 1, canceled)
+}
+
+func TestGCSSDKServiceAccount(t *testing.T) {
+	server := newFakeGCSServer(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server.publicKey = &key.PublicKey
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentials, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"client_email":   "mo@test.iam.gserviceaccount.com",
+		"private_key_id": "1",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"token_uri":      server.URL + "/token",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sdk, err := NewGCSSDK(
+		context.Background(),
+		ObjectStorageArguments{
+			IsGCS:                true,
+			NoDefaultCredentials: true,
+			Endpoint:             server.URL,
+			Bucket:               server.bucket,
+			CredentialsJSON:      string(credentials),
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	err = sdk.Write(ctx, "foo", bytes.NewReader([]byte("foo")), ptrTo[int64](3), nil)
+	assert.Nil(t, err)
+	size, err := sdk.Stat(ctx, "foo")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), size)
+	// the token is cached
+	_, _, tokenRequests := server.stats()
+	assert.Equal(t, 1, tokenRequests)
+
+	// the local credentials file is rejected without the default credentials
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	if err := os.WriteFile(path, credentials, 0600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewGCSSDK(
+		context.Background(),
+		ObjectStorageArguments{
+			IsGCS:                true,
+			NoDefaultCredentials: true,
+			Endpoint:             server.URL,
+			Bucket:               server.bucket,
+			CredentialsFile:      path,
+		},
+		nil,
+	)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput), "got %v", err)
+	_, err = NewGCSSDK(
+		context.Background(),
+		ObjectStorageArguments{
+			IsGCS:           true,
+			Endpoint:        server.URL,
+			Bucket:          server.bucket,
+			CredentialsFile: path,
+		},
+		nil,
+	)
+	assert.Nil(t, err)
+
+	// unknown account
+	_, err = newGCSCredentialsTokenSource(http.DefaultClient, []byte(`{"type":"external_account"}`))
+	assert.NotNil(t, err)
+	_, err = newGCSCredentialsTokenSource(http.DefaultClient, []byte(`{"type":"service_account","private_key":"foo"}`))
+	assert.NotNil(t, err)
+}
+
+func TestGCSPersistedSize(t *testing.T) {
+	newResponse := func(status int, rang string) *http.Response {
+		resp := &http.Response{
+			StatusCode: status,
+			Header:     http.Header{},
+		}
+		if rang != "" {
+			resp.Header.Set("Range", rang)
+		}
+		return resp
+	}
+
+	n, err := gcsPersistedSize(newResponse(statusResumeIncomplete, ""), -1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), n)
+	n, err = gcsPersistedSize(newResponse(statusResumeIncomplete, "bytes=0-262143"), -1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(262144), n)
+	n, err = gcsPersistedSize(newResponse(http.StatusOK, ""), 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), n)
+	_, err = gcsPersistedSize(newResponse(http.StatusOK, ""), -1)
+	assert.NotNil(t, err)
+	_, err = gcsPersistedSize(newResponse(statusResumeIncomplete, "bytes=0"), -1)
+	assert.NotNil(t, err)
+}
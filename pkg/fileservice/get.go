@@ -72,6 +72,8 @@ func etlParallelMode(ctx context.Context) ParallelMode {
 // s3-no-key,<endpoint>,<region>,<bucket>,<prefix>
 // minio,<endpoint>,<region>,<bucket>,<key>,<secret>,<prefix>
 // s3-opts,endpoint=<endpoint>,region=<region>,bucket=<bucket>,key=<key>,secret=<secret>,prefix=<prefix>,role-arn=<role arn>,external-id=<external id>
+// azure,endpoint=<endpoint>,bucket=<container>,key=<account>,secret=<account key>,sas-token=<sas token>,prefix=<prefix>
+// gcs,endpoint=<endpoint>,bucket=<bucket>,credentials-file=<service account key file>,prefix=<prefix>
 //
 //	key value pairs can be in any order
 func GetForETL(ctx context.Context, fs FileService, path string) (res ETLFileService, readPath string, err error) {
@@ -162,13 +164,15 @@ func GetForETL(ctx context.Context, fs FileService, path string) (res ETLFileSer
 				NoDefaultCredentialsForETL,
 			)
 
-		case "s3-opts", "opts", "options", "hdfs":
+		case "s3-opts", "opts", "options", "hdfs", "azure", "gcs":
 			var args ObjectStorageArguments
 			if err := args.SetFromString(fsPath.ServiceArguments); err != nil {
 				return nil, "", err
 			}
 			args.NoBucketValidation = true
 			args.IsHDFS = fsPath.Service == "hdfs"
+			args.IsAzure = args.IsAzure || fsPath.Service == "azure"
+			args.IsGCS = args.IsGCS || fsPath.Service == "gcs"
 			args.ParallelMode = etlParallelMode(ctx)
 			res, err = NewS3FS(
 				ctx,
@@ -263,6 +267,8 @@ func LocalBackendOf(fs FileService) string {
 // if service part of path is argumented, a FileService instance will be created dynamically with those arguments
 // supported dynamic file service:
 // s3-opts,endpoint=<endpoint>,region=<region>,bucket=<bucket>,key=<key>,secret=<secret>,prefix=<prefix>,role-arn=<role arn>,external-id=<external id>,is-minio=<is-minio>
+// azure,<key value pairs of s3-opts>
+// gcs,<key value pairs of s3-opts>
 func GetForBackup(ctx context.Context, spec string, backend string) (res FileService, err error) {
 	fsPath, err := ParsePath(spec)
 	if err != nil {
@@ -284,12 +290,14 @@ func GetForBackup(ctx context.Context, spec string, backend string) (res FileSer
 		// service with arguments, create dynamically
 		switch fsPath.Service {
 
-		case "s3-opts":
+		case "s3-opts", "azure", "gcs":
 			var args ObjectStorageArguments
 			if err := args.SetFromString(fsPath.ServiceArguments); err != nil {
 				return nil, err
 			}
 			args.NoBucketValidation = true
+			args.IsAzure = args.IsAzure || fsPath.Service == "azure"
+			args.IsGCS = args.IsGCS || fsPath.Service == "gcs"
 			res, err = NewS3FS(
 				ctx,
 				args,
@@ -0,0 +1,111 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// tokenRefreshAhead refreshes the tokens before they expire
+	tokenRefreshAhead = time.Minute
+	// maxTokenResponseSize limits the token response read
+	maxTokenResponseSize = 64 * 1024
+)
+
+// oauthTokenSource caches the access token fetched by fetch until it is about to expire.
+type oauthTokenSource struct {
+	op    string
+	fetch func(ctx context.Context) (oauthTokenResponse, error)
+
+	mu      sync.Mutex
+	current string
+	expiry  time.Time
+}
+
+type oauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+func (s *oauthTokenSource) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.current != "" && (s.expiry.IsZero() || time.Now().Add(tokenRefreshAhead).Before(s.expiry)) {
+		return s.current, nil
+	}
+	token, err := DoWithRetry(s.op, func() (oauthTokenResponse, error) {
+		return s.fetch(ctx)
+	}, maxRetryAttemps, IsRetryableError)
+	if err != nil {
+		return "", err
+	}
+	s.current = token.AccessToken
+	if token.ExpiresIn > 0 {
+		s.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return s.current, nil
+}
+
+// newStaticTokenSource returns the token as it is, it is never refreshed.
+func newStaticTokenSource(token string) *oauthTokenSource {
+	return &oauthTokenSource{
+		current: token,
+	}
+}
+
+func fetchOAuthToken(ctx context.Context, client *http.Client, op string, tokenURI string, form url.Values) (oauthTokenResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return oauthTokenResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return doOAuthTokenRequest(client, op, req)
+}
+
+func doOAuthTokenRequest(client *http.Client, op string, req *http.Request) (token oauthTokenResponse, err error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxTokenResponseSize))
+	if err != nil {
+		return
+	}
+	if resp.StatusCode != http.StatusOK {
+		return token, &httpStatusError{
+			Op:         op,
+			StatusCode: resp.StatusCode,
+			Message:    string(content),
+		}
+	}
+	if err = json.Unmarshal(content, &token); err != nil {
+		return
+	}
+	if token.AccessToken == "" {
+		return token, moerr.NewInternalErrorNoCtxf("no access token in the response of %s", op)
+	}
+	return token, nil
+}
@@ -44,6 +44,11 @@ type ObjectStorageArguments struct {
 	Region    string   `toml:"region"`
 	CertFiles []string `toml:"cert-files"`
 
+	// azure blob storage, the bucket is the container and the key id is the account name
+	IsAzure bool `toml:"is-azure"`
+	// google cloud storage
+	IsGCS bool `toml:"is-gcs"`
+
 	// credentials
 	RoleARN         string `json:"-" toml:"role-arn"`
 	BearerToken     string `json:"-" toml:"bearer-token"`
@@ -54,6 +59,13 @@ type ObjectStorageArguments struct {
 	RoleSessionName string `json:"-" toml:"role-session-name"`
 	SecurityToken   string `json:"-" toml:"security-token"`
 	SessionToken    string `json:"-" toml:"session-token"`
+	SASToken        string `json:"-" toml:"sas-token"`
+	CredentialsFile string `toml:"credentials-file"`
+	CredentialsJSON string `json:"-" toml:"credentials-json"`
+	// the service principal of azure
+	TenantID     string `toml:"tenant-id"`
+	ClientID     string `toml:"client-id"`
+	ClientSecret string `json:"-" toml:"client-secret"`
 
 	// HDFS
 	IsHDFS                       bool   `toml:"is-hdfs"`
@@ -123,6 +135,10 @@ func (o *ObjectStorageArguments) SetFromString(arguments []string) error {
 			o.Region = value
 		case "cert-files":
 			o.CertFiles = strings.Split(value, ",")
+		case "is-azure", "azure":
+			o.IsAzure = value != "false" && value != "0"
+		case "is-gcs", "gcs":
+			o.IsGCS = value != "false" && value != "0"
 
 		case "role-arn":
 			o.RoleARN = value
@@ -142,6 +158,18 @@ func (o *ObjectStorageArguments) SetFromString(arguments []string) error {
 			o.SecurityToken = value
 		case "token", "session-token":
 			o.SessionToken = value
+		case "sas", "sas-token":
+			o.SASToken = value
+		case "credentials-file":
+			o.CredentialsFile = value
+		case "credentials-json":
+			o.CredentialsJSON = value
+		case "tenant-id":
+			o.TenantID = value
+		case "client-id":
+			o.ClientID = value
+		case "client-secret":
+			o.ClientSecret = value
 
 		case "user":
 			o.User = value
@@ -111,6 +111,10 @@ func testObjectStorage[T ObjectStorage](
 				s.listMaxKeys = 1
 			case *AwsSDKv2:
 				s.listMaxKeys = 1
+			case *AzureBlobSDK:
+				s.listMaxKeys = 1
+			case *GCSSDK:
+				s.listMaxKeys = 1
 			}
 
 			// list dir
@@ -114,6 +114,20 @@ func NewS3FS(
 			return nil, err
 		}
 
+	case args.IsAzure || strings.Contains(args.Endpoint, "blob.core.windows.net"):
+		// Azure Blob Storage
+		fs.storage, err = NewAzureBlobSDK(ctx, args, perfCounterSets)
+		if err != nil {
+			return nil, err
+		}
+
+	case args.IsGCS || strings.Contains(args.Endpoint, "storage.googleapis.com"):
+		// Google Cloud Storage
+		fs.storage, err = NewGCSSDK(ctx, args, perfCounterSets)
+		if err != nil {
+			return nil, err
+		}
+
 	case strings.EqualFold(args.Endpoint, "disk"):
 		// disk based
 		fs.storage, err = newDiskObjectStorage(ctx, args, perfCounterSets)
@@ -940,6 +940,7 @@ var (
 		"mo_snapshots":                0,
 		"mo_cdc_task":                 0,
 		"mo_cdc_watermark":            0,
+		catalog.MO_STREAM_OFFSETS:     0,
 		catalog.MO_TABLE_STATS:        0,
 		catalog.MO_MERGE_SETTINGS:     0,
 		catalog.MO_ISCP_LOG:           0,
@@ -988,6 +989,7 @@ var (
 		"mo_shards_metadata":          0,
 		"mo_cdc_task":                 0,
 		"mo_cdc_watermark":            0,
+		catalog.MO_STREAM_OFFSETS:     0,
 		catalog.MO_TABLE_STATS:        0,
 		catalog.MO_ACCOUNT_LOCK:       0,
 		catalog.MO_MERGE_SETTINGS:     0,
@@ -1032,6 +1034,7 @@ var (
 		MoCatalogMoCacheDDL,
 		MoCatalogMoCdcTaskDDL,
 		MoCatalogMoCdcWatermarkDDL,
+		MoCatalogMoStreamOffsetsDDL,
 		MoCatalogMoDataKeyDDL,
 		MoCatalogMoTableStatsDDL,
 		MoCatalogMoAccountLockDDL,
@@ -1261,6 +1264,10 @@ const (
 
 	updatePasswordOfUserFormat = `update mo_catalog.mo_user set authentication_string = "%s" , password_last_changed = utc_timestamp() where user_name = "%s" order by user_id;`
 
+	getScramVerifierOfUserFormat = `select scram_verifier from mo_catalog.mo_user where user_name = "%s" order by user_id;`
+
+	updateScramVerifierOfUserFormat = `update mo_catalog.mo_user set scram_verifier = "%s" where user_name = "%s" order by user_id;`
+
 	updateStatusUnlockOfUserFormat = `update mo_catalog.mo_user set status = "%s", login_attempts = 0 where user_name = "%s" order by user_id;`
 
 	updateLoginAttemptsOfUserFormat = `update mo_catalog.mo_user set login_attempts = login_attempts + 1  where user_name = "%s";`
@@ -1768,6 +1775,28 @@ func getSqlForUpdatePasswordOfUser(ctx context.Context, password, user string) (
 	return fmt.Sprintf(updatePasswordOfUserFormat, password, user), nil
 }
 
+func getSqlForScramVerifierOfUser(ctx context.Context, user string) (string, error) {
+	err := inputNameIsInvalid(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(getScramVerifierOfUserFormat, user), nil
+}
+
+// getSqlForUpdateScramVerifierOfUser makes the SCRAM verifier of the password
+// for the PostgreSQL protocol and the sql saving it.
+func getSqlForUpdateScramVerifierOfUser(ctx context.Context, password, user string) (string, error) {
+	err := inputNameIsInvalid(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	verifier, err := makeScramVerifier(password)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(updateScramVerifierOfUserFormat, verifier, user), nil
+}
+
 func getSqlForUpdateUnlcokStatusOfUser(status, user string) string {
 	return fmt.Sprintf(updateStatusUnlockOfUserFormat, status, user)
 }
@@ -3221,6 +3250,14 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 			if err != nil {
 				return err
 			}
+			sql, err = getSqlForUpdateScramVerifierOfUser(ctx, password, userName)
+			if err != nil {
+				return err
+			}
+			err = bh.Exec(ctx, sql)
+			if err != nil {
+				return err
+			}
 		} else {
 			if (targetUserIsAdmin && !currentUserIsAdmin) && currentUser != userName {
 				return moerr.NewInternalErrorf(ctx, "Operation ALTER USER failed for '%s'@'%s', don't have the privilege to alter", userName, hostName)
@@ -3233,6 +3270,14 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 			if err != nil {
 				return err
 			}
+			sql, err = getSqlForUpdateScramVerifierOfUser(ctx, password, userName)
+			if err != nil {
+				return err
+			}
+			err = bh.Exec(ctx, sql)
+			if err != nil {
+				return err
+			}
 		}
 	} else {
 		if doLockOrUnlock == lockUser {
@@ -3439,6 +3484,14 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *alterAccount) (err er
 				if rtnErr != nil {
 					return rtnErr
 				}
+				sql, rtnErr = getSqlForUpdateScramVerifierOfUser(ctx, aa.IdentStr, aa.AdminName)
+				if rtnErr != nil {
+					return rtnErr
+				}
+				rtnErr = bh.Exec(accountCtx, sql)
+				if rtnErr != nil {
+					return rtnErr
+				}
 			}
 
 			// Option 2: alter the comment of the account
@@ -10058,6 +10111,9 @@ func createTablesInMoCatalogOfGeneralTenant2(bh BackgroundExec, ca *createAccoun
 		if strings.HasPrefix(sql, "create table mo_catalog.mo_cdc_watermark") {
 			return true
 		}
+		if strings.HasPrefix(sql, fmt.Sprintf("create table mo_catalog.%s", catalog.MO_STREAM_OFFSETS)) {
+			return true
+		}
 		if strings.HasPrefix(sql, "create table mo_catalog.mo_data_key") {
 			return true
 		}
@@ -10153,6 +10209,11 @@ func createTablesInMoCatalogOfGeneralTenant2(bh BackgroundExec, ca *createAccoun
 		types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType,
 		newTenant.GetUserID(), newTenant.GetDefaultRoleID(), accountAdminRoleID)
 	addSqlIntoSet(initMoUser1)
+	updateScramVerifier, err := getSqlForUpdateScramVerifierOfUser(newTenantCtx, password, name)
+	if err != nil {
+		return err
+	}
+	addSqlIntoSet(updateScramVerifier)
 
 	// step4: add new entries to the mo_role_privs
 	// accountadmin role
@@ -10538,6 +10599,15 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *createU
 			return err
 		}
 
+		sql, err = getSqlForUpdateScramVerifierOfUser(ctx, password, user.Username)
+		if err != nil {
+			return err
+		}
+		err = bh.Exec(ctx, sql)
+		if err != nil {
+			return err
+		}
+
 		// query the id
 		bh.ClearExecResultSet()
 		sql, err = getSqlForPasswordOfUser(ctx, user.Username)
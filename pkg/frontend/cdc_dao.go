@@ -175,6 +175,7 @@ func (t *CDCDao) ShowTasks(
 		sinkUri      string
 		state        string
 		errMsg       string
+		transforms   string
 		watermarkStr string
 		executor     = t.MustGetBGExecutor(ctx)
 		pu           = getPu(t.ses.GetService())
@@ -259,6 +260,14 @@ func (t *CDCDao) ShowTasks(
 					if errMsg, err = result.GetString(ctx, rowIdx, uint64(colIdx)); err != nil {
 						return
 					}
+				case "additional_config":
+					var additionalConfig string
+					if additionalConfig, err = result.GetString(ctx, rowIdx, uint64(colIdx)); err != nil {
+						return
+					}
+					if transforms, err = getCDCTransforms(additionalConfig); err != nil {
+						return
+					}
 				}
 			}
 			var uriInfo cdc.UriInfo
@@ -286,6 +295,7 @@ func (t *CDCDao) ShowTasks(
 				errMsg,
 				watermarkStr,
 				snapTS,
+				transforms,
 			})
 		}
 	}
@@ -17,8 +17,10 @@ package frontend
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -34,6 +36,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/publication"
 	"github.com/matrixorigin/matrixone/pkg/taskservice"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
@@ -130,6 +133,9 @@ type CDCTaskExecutor struct {
 	watermarkUpdater *cdc.CDCWatermarkUpdater
 	// runningReaders store the running execute pipelines, map key pattern: db.table
 	runningReaders *sync.Map
+	// pipelineTables store the source tables the pipelines were built with, to detect the
+	// schema changes of the source tables, map key pattern: db.table
+	pipelineTables map[string]*cdc.DbTableInfo
 
 	// stateMachine manages executor state transitions
 	stateMachine *ExecutorStateMachine
@@ -279,6 +285,9 @@ func (exec *CDCTaskExecutor) Start(rootCtx context.Context) (err error) {
 	} else {
 		exec.runningReaders = &sync.Map{}
 	}
+	exec.Lock()
+	exec.pipelineTables = make(map[string]*cdc.DbTableInfo)
+	exec.Unlock()
 
 	// start watermarkUpdater
 	exec.watermarkUpdater = cdc.GetCDCWatermarkUpdater(exec.cnUUID, exec.ie)
@@ -1043,6 +1052,15 @@ func (exec *CDCTaskExecutor) handleNewTablesForGeneration(
 	successCount := 0
 
 	for key, info := range allAccountTbls[accountId] {
+		if pipelineTbl, ok := exec.pipelineTables[key]; ok && pipelineTbl.SourceCreateSql != info.SourceCreateSql {
+			if !exec.isCurrentCallbackGeneration(callbackGeneration) {
+				return nil
+			}
+			if err = exec.handleSchemaChange(ctx, key, pipelineTbl, info); err != nil {
+				return err
+			}
+		}
+
 		// already running
 		if val, ok := exec.runningReaders.Load(key); ok {
 			if reader, ok := val.(cdc.ChangeReader); ok {
@@ -1166,6 +1184,121 @@ func (exec *CDCTaskExecutor) isCurrentCallbackGeneration(callbackGeneration uint
 	return exec.callbackGeneration.Load() == callbackGeneration
 }
 
+// ddlPolicy returns the policy of the task on the DDL of the source tables
+func (exec *CDCTaskExecutor) ddlPolicy() string {
+	if policy, ok := exec.additionalConfig[cdc.CDCTaskExtraOptions_DDLPolicy].(string); ok && policy != "" {
+		return policy
+	}
+	// the tasks created before the option
+	return cdc.CDCDefaultTaskExtra_DDLPolicy
+}
+
+// transforms returns the transform rules of the task
+func (exec *CDCTaskExecutor) transforms(ctx context.Context) ([]*cdc.TableTransform, error) {
+	value, ok := exec.additionalConfig[cdc.CDCTaskExtraOptions_Transforms].(string)
+	if !ok || value == "" {
+		return nil, nil
+	}
+	return cdc.ParseTransforms(ctx, value)
+}
+
+// handleSchemaChange applies the DDL policy of the task when the schema of a source table
+// is no longer the one its pipeline was built with. With the propagate policy, the pipeline
+// is stopped and the sink table follows the source table, then the caller builds the new
+// pipeline:
+//
+//   - an inplace ALTER (same table id) is replayed by the equivalent ALTER TABLE statements
+//   - a table rebuilt by a copy ALTER (new table id) drops and creates the sink table, the
+//     new pipeline reads the rebuilt table since the watermark
+func (exec *CDCTaskExecutor) handleSchemaChange(
+	ctx context.Context,
+	key string,
+	pipelineTbl *cdc.DbTableInfo,
+	tbl *cdc.DbTableInfo,
+) (err error) {
+	policy := exec.ddlPolicy()
+	logutil.Info(
+		"cdc.frontend.task.schema_change_detected",
+		zap.String("task-id", exec.spec.TaskId),
+		zap.String("task-name", exec.spec.TaskName),
+		zap.String("table", key),
+		zap.Uint64("old-table-id", pipelineTbl.SourceTblId),
+		zap.Uint64("new-table-id", tbl.SourceTblId),
+		zap.String("ddl-policy", policy),
+	)
+
+	switch policy {
+	case cdc.CDCDDLPolicy_Ignore:
+		exec.pipelineTables[key] = tbl.Clone()
+		return nil
+	case cdc.CDCDDLPolicy_Pause:
+		return exec.failTaskForSchemaChange(ctx, tbl, "the ddl policy of the task is pause")
+	}
+
+	if val, ok := exec.runningReaders.Load(key); ok {
+		if reader, ok := val.(cdc.ChangeReader); ok {
+			reader.Close()
+			reader.Wait()
+		}
+	}
+	delete(exec.pipelineTables, key)
+
+	if tbl.SourceTblId != pipelineTbl.SourceTblId {
+		tbl.IdChanged = true
+		return nil
+	}
+
+	// the sink table of a transformed table does not have the source columns
+	rules, err := exec.transforms(ctx)
+	if err != nil {
+		return exec.failTaskForSchemaChange(ctx, tbl, err.Error())
+	}
+	if cdc.MatchTransform(rules, tbl.SourceDbName, tbl.SourceTblName) != nil {
+		return exec.failTaskForSchemaChange(ctx, tbl, "the ddl of a table with the transform rule can not be propagated")
+	}
+
+	stmts, err := publication.GenerateInplaceAlterStatements(
+		ctx,
+		pipelineTbl.SinkDbName,
+		pipelineTbl.SinkTblName,
+		pipelineTbl.SourceCreateSql,
+		tbl.SourceCreateSql,
+	)
+	if err != nil {
+		return exec.failTaskForSchemaChange(ctx, tbl, fmt.Sprintf("the ddl can not be propagated, %v", err))
+	}
+	// the sink tables are created without the foreign keys
+	ddls := stmts[:0]
+	for _, stmt := range stmts {
+		if !strings.Contains(stmt, " FOREIGN KEY ") {
+			ddls = append(ddls, stmt)
+		}
+	}
+	if err = cdc.ApplySinkDDL(
+		ctx,
+		exec.sinkUri,
+		ddls,
+		exec.additionalConfig[cdc.CDCTaskExtraOptions_SendSqlTimeout].(string),
+	); err != nil {
+		return exec.failTaskForSchemaChange(ctx, tbl, fmt.Sprintf("failed to propagate the ddl, %v", err))
+	}
+	return nil
+}
+
+// failTaskForSchemaChange fails the task on the schema change of a source table, the task
+// keeps failed until it is restarted
+func (exec *CDCTaskExecutor) failTaskForSchemaChange(ctx context.Context, tbl *cdc.DbTableInfo, reason string) error {
+	taskErr := moerr.NewInternalErrorf(
+		ctx,
+		"CDC task %s paused on the schema change of %s.%s: %s; restart the task once the sink table is ready",
+		exec.spec.TaskName,
+		tbl.SourceDbName,
+		tbl.SourceTblName,
+		reason,
+	)
+	return exec.failTaskForTableError(ctx, tbl, taskErr, "schema_change")
+}
+
 func (exec *CDCTaskExecutor) failTaskForPermanentTableError(ctx context.Context, tbl *cdc.DbTableInfo) error {
 	taskErr := moerr.NewInternalErrorf(
 		ctx,
@@ -1174,7 +1307,15 @@ func (exec *CDCTaskExecutor) failTaskForPermanentTableError(ctx context.Context,
 		tbl.SourceDbName,
 		tbl.SourceTblName,
 	)
+	return exec.failTaskForTableError(ctx, tbl, taskErr, "permanent_table_error")
+}
 
+func (exec *CDCTaskExecutor) failTaskForTableError(
+	ctx context.Context,
+	tbl *cdc.DbTableInfo,
+	taskErr error,
+	errType string,
+) error {
 	stateBeforeFail := StateIdle
 	if exec.stateMachine != nil {
 		stateBeforeFail = exec.stateMachine.State()
@@ -1221,11 +1362,12 @@ func (exec *CDCTaskExecutor) failTaskForPermanentTableError(ctx context.Context,
 		v2.CdcTaskStateChangeCounter.WithLabelValues("running", "failed").Inc()
 	}
 	v2.CdcTaskTotalGauge.WithLabelValues("failed").Inc()
-	v2.CdcTaskErrorCounter.WithLabelValues("permanent_table_error", "false").Inc()
+	v2.CdcTaskErrorCounter.WithLabelValues(errType, "false").Inc()
 
 	logutil.Error(
-		"cdc.frontend.task.failed_by_permanent_table_error",
+		"cdc.frontend.task.failed_by_table_error",
 		zap.String("task-id", exec.spec.TaskId),
+		zap.String("error-type", errType),
 		zap.String("task-name", exec.spec.TaskName),
 		zap.String("db", tbl.SourceDbName),
 		zap.String("table", tbl.SourceTblName),
@@ -1390,6 +1532,18 @@ func (exec *CDCTaskExecutor) addExecPipelineForTable(
 		return
 	}
 
+	// the sinker and the reader work on the table def of the sink table
+	rules, err := exec.transforms(ctx)
+	if err != nil {
+		return
+	}
+	var transformer *cdc.TableTransformer
+	if rule := cdc.MatchTransform(rules, info.SourceDbName, info.SourceTblName); rule != nil {
+		if transformer, tableDef, err = cdc.NewTableTransformer(ctx, exec.mp, rule, tableDef); err != nil {
+			return
+		}
+	}
+
 	// step 2. new sinker
 	sinker, err := cdc.NewSinker(
 		exec.sinkUri,
@@ -1405,6 +1559,7 @@ func (exec *CDCTaskExecutor) addExecPipelineForTable(
 		exec.additionalConfig[cdc.CDCTaskExtraOptions_SendSqlTimeout].(string),
 	)
 	if err != nil {
+		transformer.Close()
 		return err
 	}
 
@@ -1428,6 +1583,8 @@ func (exec *CDCTaskExecutor) addExecPipelineForTable(
 		exec.endTs,
 		exec.noFull,
 		frequency,
+		cdc.WithDDLPolicy(exec.ddlPolicy()),
+		cdc.WithTransformer(transformer),
 	)
 
 	// step 4. start goroutines (sinker first, then reader)
@@ -1436,6 +1593,11 @@ func (exec *CDCTaskExecutor) addExecPipelineForTable(
 	go sinker.Run(ctx, exec.activeRoutine)
 	go reader.Run(ctx, exec.activeRoutine)
 
+	if exec.pipelineTables == nil {
+		exec.pipelineTables = make(map[string]*cdc.DbTableInfo)
+	}
+	exec.pipelineTables[cdc.GenDbTblKey(info.SourceDbName, info.SourceTblName)] = info.Clone()
+
 	return
 }
 
@@ -1483,7 +1645,7 @@ func (exec *CDCTaskExecutor) retrieveCdcTask(ctx context.Context) error {
 			return err
 		}
 
-		if exec.sinkUri.Password, err = cdc.AesCFBDecode(ctx, sinkPwd); err != nil {
+		if err = exec.sinkUri.SetDecodedPassword(ctx, sinkPwd); err != nil {
 			return err
 		}
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/stage/stageutil"
 	"github.com/matrixorigin/matrixone/pkg/taskservice"
 )
 
@@ -117,7 +118,7 @@ func (opts *CDCCreateTaskOptions) ValidateAndFill(
 
 	// 1. Converts sink type to lowercase
 	// 2. Enables console sink if configured and requested
-	// 3. For non-console sinks, validates that only mysql or matrixone sinks are used
+	// 3. For non-console sinks, validates that only mysql, matrixone, kafka, stage or postgres sinks are used
 	// 4. Returns error for unsupported sink types
 	// target field: SinkType, UseConsole
 	{
@@ -125,21 +126,53 @@ func (opts *CDCCreateTaskOptions) ValidateAndFill(
 		if cdc.EnableConsoleSink && opts.SinkType == cdc.CDCSinkType_Console {
 			opts.UseConsole = true
 		}
-		if !opts.UseConsole && opts.SinkType != cdc.CDCSinkType_MySQL && opts.SinkType != cdc.CDCSinkType_MO {
+		if !opts.UseConsole && opts.SinkType != cdc.CDCSinkType_MySQL && opts.SinkType != cdc.CDCSinkType_MO &&
+			opts.SinkType != cdc.CDCSinkType_Kafka && opts.SinkType != cdc.CDCSinkType_Stage &&
+			opts.SinkType != cdc.CDCSinkType_Postgres {
 			err = moerr.NewInternalErrorf(ctx, "unsupported sink type: %s", req.SinkType)
 			return
 		}
 
-		if opts.SinkUri, opts.SinkUriInfo, err = cdc.ExtractUriInfo(
-			ctx, req.SinkUri, cdc.CDCSinkUriPrefix,
-		); err != nil {
-			return
-		}
-		if _, err = cdc.OpenDbConn(
-			opts.SinkUriInfo.User, opts.SinkUriInfo.Password, opts.SinkUriInfo.Ip, opts.SinkUriInfo.Port, cdc.CDCDefaultSendSqlTimeout,
-		); err != nil {
-			err = moerr.NewInternalErrorf(ctx, "failed to connect to sink, please check the connection, err: %v", err)
-			return
+		if opts.SinkType == cdc.CDCSinkType_Kafka {
+			if opts.SinkUri, opts.SinkUriInfo, err = cdc.ExtractKafkaUriInfo(ctx, req.SinkUri); err != nil {
+				return
+			}
+			if err = cdc.CheckKafkaSink(ctx, &opts.SinkUriInfo); err != nil {
+				err = moerr.NewInternalErrorf(ctx, "failed to connect to sink, please check the connection, err: %v", err)
+				return
+			}
+		} else if opts.SinkType == cdc.CDCSinkType_Stage {
+			if opts.SinkUri, opts.SinkUriInfo, err = cdc.ExtractStageUriInfo(ctx, req.SinkUri); err != nil {
+				return
+			}
+			// the sinkers write to the path resolved with the credentials of the stage
+			if opts.SinkUriInfo.StagePath, _, err = stageutil.UrlToPath(opts.SinkUriInfo.Stage, ses.proc); err != nil {
+				return
+			}
+			if err = cdc.CheckStageSink(ctx, &opts.SinkUriInfo); err != nil {
+				err = moerr.NewInternalErrorf(ctx, "failed to access the sink stage, err: %v", err)
+				return
+			}
+		} else if opts.SinkType == cdc.CDCSinkType_Postgres {
+			if opts.SinkUri, opts.SinkUriInfo, err = cdc.ExtractPostgresUriInfo(ctx, req.SinkUri); err != nil {
+				return
+			}
+			if err = cdc.CheckPostgresSink(ctx, &opts.SinkUriInfo); err != nil {
+				err = moerr.NewInternalErrorf(ctx, "failed to connect to sink, please check the connection, err: %v", err)
+				return
+			}
+		} else {
+			if opts.SinkUri, opts.SinkUriInfo, err = cdc.ExtractUriInfo(
+				ctx, req.SinkUri, cdc.CDCSinkUriPrefix,
+			); err != nil {
+				return
+			}
+			if _, err = cdc.OpenDbConn(
+				opts.SinkUriInfo.User, opts.SinkUriInfo.Password, opts.SinkUriInfo.Ip, opts.SinkUriInfo.Port, cdc.CDCDefaultSendSqlTimeout,
+			); err != nil {
+				err = moerr.NewInternalErrorf(ctx, "failed to connect to sink, please check the connection, err: %v", err)
+				return
+			}
 		}
 	}
 
@@ -214,6 +247,23 @@ func (opts *CDCCreateTaskOptions) ValidateAndFill(
 				}
 			}
 			extraOpts[cdc.CDCTaskExtraOptions_Frequency] = value
+		case cdc.CDCRequestOptions_DDLPolicy:
+			if value != "" {
+				value = strings.ToLower(value)
+				if value != cdc.CDCDDLPolicy_Propagate && value != cdc.CDCDDLPolicy_Pause && value != cdc.CDCDDLPolicy_Ignore {
+					err = moerr.NewInternalErrorf(ctx, "invalid ddlPolicy: %s, supported: %s, %s, %s",
+						value, cdc.CDCDDLPolicy_Propagate, cdc.CDCDDLPolicy_Pause, cdc.CDCDDLPolicy_Ignore)
+					return
+				}
+				extraOpts[cdc.CDCTaskExtraOptions_DDLPolicy] = value
+			}
+		case cdc.CDCRequestOptions_Transforms:
+			if value != "" {
+				if _, err = cdc.ParseTransforms(ctx, value); err != nil {
+					return
+				}
+				extraOpts[cdc.CDCTaskExtraOptions_Transforms] = value
+			}
 		}
 	}
 
@@ -232,6 +282,9 @@ func (opts *CDCCreateTaskOptions) ValidateAndFill(
 	if _, ok := extraOpts[cdc.CDCTaskExtraOptions_MaxSqlLength]; !ok {
 		extraOpts[cdc.CDCTaskExtraOptions_MaxSqlLength] = cdc.CDCDefaultTaskExtra_MaxSQLLen
 	}
+	if _, ok := extraOpts[cdc.CDCTaskExtraOptions_DDLPolicy]; !ok {
+		extraOpts[cdc.CDCTaskExtraOptions_DDLPolicy] = cdc.CDCDefaultTaskExtra_DDLPolicy
+	}
 
 	var extraOptsBytes []byte
 	if extraOptsBytes, err = json.Marshal(extraOpts); err != nil {
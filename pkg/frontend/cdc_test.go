@@ -2153,12 +2153,17 @@ func newMrsForGetTask(rows [][]interface{}) *MysqlResultSet {
 	col6.SetName("err_msg")
 	col6.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 
+	col7 := &MysqlColumn{}
+	col7.SetName("additional_config")
+	col7.SetColumnType(defines.MYSQL_TYPE_TEXT)
+
 	mrs.AddColumn(col1)
 	mrs.AddColumn(col2)
 	mrs.AddColumn(col3)
 	mrs.AddColumn(col4)
 	mrs.AddColumn(col5)
 	mrs.AddColumn(col6)
+	mrs.AddColumn(col7)
 
 	for _, row := range rows {
 		mrs.AddRow(row)
@@ -2208,7 +2213,7 @@ func Test_handleShowCdc(t *testing.T) {
 
 	sql := cdc.CDCSQLBuilder.ShowTaskSQL(sysAccountID, true, "")
 	mrs := newMrsForGetTask([][]interface{}{
-		{"taskID-1", "task1", sourceUri, sinkUri, cdc.CDCState_Running, ""},
+		{"taskID-1", "task1", sourceUri, sinkUri, cdc.CDCState_Running, "", `{"Transforms":"[{\"table\":\"db1.tb1\",\"exclude\":[\"c\"]}]"}`},
 	})
 	bh.sql2result[sql] = mrs
 
@@ -2288,6 +2293,9 @@ func Test_handleShowCdc(t *testing.T) {
 			taskId, err := rs.GetString(tt.args.execCtx.reqCtx, 0, 0)
 			assert.NoError(t, err)
 			assert.Equal(t, "taskID-1", taskId)
+			transforms, err := rs.GetString(tt.args.execCtx.reqCtx, 0, CDCShowOutputColumnsNameTransforms_Idx)
+			assert.NoError(t, err)
+			assert.Equal(t, `[{"table":"db1.tb1","exclude":["c"]}]`, transforms)
 		})
 	}
 }
@@ -2710,7 +2718,7 @@ func Test_getSqlForGetTask(t *testing.T) {
 				all:       true,
 				taskName:  "",
 			},
-			want: "SELECT task_id, task_name, source_uri, sink_uri, state, err_msg FROM mo_catalog.mo_cdc_task WHERE account_id = 0",
+			want: "SELECT task_id, task_name, source_uri, sink_uri, state, err_msg, additional_config FROM mo_catalog.mo_cdc_task WHERE account_id = 0",
 		},
 		{
 			name: "t2",
@@ -2719,7 +2727,7 @@ func Test_getSqlForGetTask(t *testing.T) {
 				all:       false,
 				taskName:  "task1",
 			},
-			want: "SELECT task_id, task_name, source_uri, sink_uri, state, err_msg FROM mo_catalog.mo_cdc_task WHERE account_id = 0 AND task_name = 'task1'",
+			want: "SELECT task_id, task_name, source_uri, sink_uri, state, err_msg, additional_config FROM mo_catalog.mo_cdc_task WHERE account_id = 0 AND task_name = 'task1'",
 		},
 	}
 	for _, tt := range tests {
@@ -3177,6 +3185,164 @@ func TestCdcTask_handleNewTables_PermanentTableErrorFailsTask(t *testing.T) {
 	require.Contains(t, executor.execSQLs[0], "task-1")
 }
 
+func TestCdcTask_handleNewTables_SchemaChangePausesTask(t *testing.T) {
+	stub1 := gostub.Stub(&cdc.GetTxnOp, func(context.Context, engine.Engine, client.TxnClient, string) (client.TxnOperator, error) {
+		return nil, nil
+	})
+	defer stub1.Reset()
+
+	stub2 := gostub.Stub(&cdc.FinishTxnOp, func(context.Context, error, client.TxnOperator, engine.Engine) {})
+	defer stub2.Reset()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eng := mock_frontend.NewMockEngine(ctrl)
+	eng.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	executor := &captureCDCExecutor{}
+	cdcTask := &CDCTaskExecutor{
+		spec: &task.CreateCdcDetails{
+			TaskId:   "task-1",
+			TaskName: "task-name",
+			Accounts: []*task.Account{
+				{Id: 0},
+			},
+		},
+		additionalConfig: map[string]interface{}{
+			cdc.CDCTaskExtraOptions_DDLPolicy: cdc.CDCDDLPolicy_Pause,
+		},
+		ie:             executor,
+		cnEngine:       eng,
+		runningReaders: &sync.Map{},
+		pipelineTables: map[string]*cdc.DbTableInfo{
+			"db1.tb1": {
+				SourceDbName:    "db1",
+				SourceTblId:     1,
+				SourceTblName:   "tb1",
+				SourceCreateSql: "create table tb1 (a int primary key, b int)",
+			},
+		},
+		stateMachine:  NewExecutorStateMachine(),
+		activeRoutine: cdc.NewCdcActiveRoutine(),
+		holdCh:        make(chan int, 1),
+	}
+	require.NoError(t, cdcTask.stateMachine.Transition(TransitionStart))
+	require.NoError(t, cdcTask.stateMachine.Transition(TransitionStartSuccess))
+
+	mp := map[uint32]cdc.TblMap{
+		0: {
+			"db1.tb1": &cdc.DbTableInfo{
+				SourceDbName:    "db1",
+				SourceTblId:     1,
+				SourceTblName:   "tb1",
+				SourceCreateSql: "create table tb1 (a int primary key, b int, c int)",
+			},
+		},
+	}
+	err := cdcTask.handleNewTables(mp)
+	require.Error(t, err)
+	require.Equal(t, StateFailed, cdcTask.stateMachine.State())
+	require.Contains(t, cdcTask.stateMachine.GetErrorMessage(), "paused on the schema change of db1.tb1")
+	require.Contains(t, cdcTask.stateMachine.GetErrorMessage(), "ddl policy of the task is pause")
+	require.Len(t, executor.execSQLs, 1)
+	require.Contains(t, executor.execSQLs[0], "SET state = 'failed'")
+}
+
+func TestCdcTask_handleSchemaChange(t *testing.T) {
+	var applied []string
+	stub := gostub.Stub(&cdc.ApplySinkDDL, func(_ context.Context, _ cdc.UriInfo, stmts []string, _ string) error {
+		applied = append(applied, stmts...)
+		return nil
+	})
+	defer stub.Reset()
+
+	newTask := func(policy string) *CDCTaskExecutor {
+		cdcTask := &CDCTaskExecutor{
+			spec: &task.CreateCdcDetails{
+				TaskId:   "task-1",
+				TaskName: "task-name",
+				Accounts: []*task.Account{
+					{Id: 0},
+				},
+			},
+			additionalConfig: map[string]interface{}{
+				cdc.CDCTaskExtraOptions_DDLPolicy:      policy,
+				cdc.CDCTaskExtraOptions_SendSqlTimeout: cdc.CDCDefaultSendSqlTimeout,
+			},
+			ie:             &captureCDCExecutor{},
+			runningReaders: &sync.Map{},
+			pipelineTables: make(map[string]*cdc.DbTableInfo),
+			stateMachine:   NewExecutorStateMachine(),
+			activeRoutine:  cdc.NewCdcActiveRoutine(),
+			holdCh:         make(chan int, 1),
+		}
+		require.NoError(t, cdcTask.stateMachine.Transition(TransitionStart))
+		require.NoError(t, cdcTask.stateMachine.Transition(TransitionStartSuccess))
+		return cdcTask
+	}
+	oldTbl := &cdc.DbTableInfo{
+		SourceDbName:    "db1",
+		SourceTblId:     1,
+		SourceTblName:   "tb1",
+		SourceCreateSql: "create table tb1 (a int primary key, b int)",
+		SinkDbName:      "db2",
+		SinkTblName:     "tb2",
+	}
+
+	// rename column, replayed on the sink table
+	cdcTask := newTask(cdc.CDCDDLPolicy_Propagate)
+	cdcTask.pipelineTables["db1.tb1"] = oldTbl
+	cdcTask.runningReaders.Store("db1.tb1", mockChangeReader{info: oldTbl})
+	tbl := oldTbl.Clone()
+	tbl.SourceCreateSql = "create table tb1 (a int primary key, c int)"
+	require.NoError(t, cdcTask.handleSchemaChange(context.Background(), "db1.tb1", oldTbl, tbl))
+	require.Equal(t, []string{"ALTER TABLE `db2`.`tb2` RENAME COLUMN `b` TO `c`"}, applied)
+	require.False(t, tbl.IdChanged)
+	require.NotContains(t, cdcTask.pipelineTables, "db1.tb1")
+	require.Equal(t, StateRunning, cdcTask.stateMachine.State())
+
+	// copy alter, the sink table is dropped and created again
+	applied = nil
+	cdcTask = newTask(cdc.CDCDDLPolicy_Propagate)
+	tbl = oldTbl.Clone()
+	tbl.SourceTblId = 2
+	tbl.SourceCreateSql = "create table tb1 (a int primary key, b bigint)"
+	require.NoError(t, cdcTask.handleSchemaChange(context.Background(), "db1.tb1", oldTbl, tbl))
+	require.Empty(t, applied)
+	require.True(t, tbl.IdChanged)
+
+	// column change of the same table can not be propagated
+	cdcTask = newTask(cdc.CDCDDLPolicy_Propagate)
+	tbl = oldTbl.Clone()
+	tbl.SourceCreateSql = "create table tb1 (a int primary key, b bigint)"
+	err := cdcTask.handleSchemaChange(context.Background(), "db1.tb1", oldTbl, tbl)
+	require.Error(t, err)
+	require.Equal(t, StateFailed, cdcTask.stateMachine.State())
+	require.Contains(t, cdcTask.stateMachine.GetErrorMessage(), "can not be propagated")
+
+	// the sink table of a transformed table does not follow the source table
+	applied = nil
+	cdcTask = newTask(cdc.CDCDDLPolicy_Propagate)
+	cdcTask.additionalConfig[cdc.CDCTaskExtraOptions_Transforms] = `[{"table":"db1.*","exclude":["b"]}]`
+	tbl = oldTbl.Clone()
+	tbl.SourceCreateSql = "create table tb1 (a int primary key, c int)"
+	err = cdcTask.handleSchemaChange(context.Background(), "db1.tb1", oldTbl, tbl)
+	require.Error(t, err)
+	require.Empty(t, applied)
+	require.Equal(t, StateFailed, cdcTask.stateMachine.State())
+	require.Contains(t, cdcTask.stateMachine.GetErrorMessage(), "transform rule")
+
+	// ignore keeps the pipeline
+	cdcTask = newTask(cdc.CDCDDLPolicy_Ignore)
+	cdcTask.pipelineTables["db1.tb1"] = oldTbl
+	tbl = oldTbl.Clone()
+	tbl.SourceCreateSql = "create table tb1 (a int primary key, b bigint)"
+	require.NoError(t, cdcTask.handleSchemaChange(context.Background(), "db1.tb1", oldTbl, tbl))
+	require.Equal(t, tbl.SourceCreateSql, cdcTask.pipelineTables["db1.tb1"].SourceCreateSql)
+	require.Equal(t, StateRunning, cdcTask.stateMachine.State())
+}
+
 func TestCdcTask_RestartRunningTaskUnregistersOldDetector(t *testing.T) {
 	detector := createMockTableDetectorForTest()("test-cn")
 	require.True(t, detector.RegisterIfAbsent("task-1", 0, []string{"db1"}, []string{"tb1"}, func(map[uint32]cdc.TblMap) error {
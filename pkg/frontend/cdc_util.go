@@ -17,6 +17,7 @@ package frontend
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"math"
 	"regexp"
 	"strconv"
@@ -42,8 +43,9 @@ const (
 	CDCShowOutputColumnsNameErrMsg_Idx     = 5
 	CDCShowOutputColumnsNameCheckpoint_Idx = 6
 	CDCShowOutputColumnsNameTimestamp_Idx  = 7
+	CDCShowOutputColumnsNameTransforms_Idx = 8
 
-	CDCShowOutputColumnsCount = 8
+	CDCShowOutputColumnsCount = 9
 )
 
 var CDCShowOutputColumnsSpec = map[int]struct {
@@ -58,6 +60,7 @@ var CDCShowOutputColumnsSpec = map[int]struct {
 	CDCShowOutputColumnsNameErrMsg_Idx:     {name: "err_msg", columnType: defines.MYSQL_TYPE_VARCHAR},
 	CDCShowOutputColumnsNameCheckpoint_Idx: {name: "checkpoint", columnType: defines.MYSQL_TYPE_VARCHAR},
 	CDCShowOutputColumnsNameTimestamp_Idx:  {name: "timestamp", columnType: defines.MYSQL_TYPE_VARCHAR},
+	CDCShowOutputColumnsNameTransforms_Idx: {name: "transforms", columnType: defines.MYSQL_TYPE_TEXT},
 }
 
 var CDCShowOutputColumns = [CDCShowOutputColumnsCount]Column{
@@ -109,6 +112,12 @@ var CDCShowOutputColumns = [CDCShowOutputColumnsCount]Column{
 			columnType: CDCShowOutputColumnsSpec[CDCShowOutputColumnsNameTimestamp_Idx].columnType,
 		},
 	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       CDCShowOutputColumnsSpec[CDCShowOutputColumnsNameTransforms_Idx].name,
+			columnType: CDCShowOutputColumnsSpec[CDCShowOutputColumnsNameTransforms_Idx].columnType,
+		},
+	},
 }
 
 func GetCDCShowOutputResultSet() *MysqlResultSet {
@@ -119,6 +128,19 @@ func GetCDCShowOutputResultSet() *MysqlResultSet {
 	return &rs
 }
 
+// getCDCTransforms returns the transform rules stored in the additional config of a task
+func getCDCTransforms(additionalConfig string) (string, error) {
+	if additionalConfig == "" {
+		return "", nil
+	}
+	var opts map[string]any
+	if err := json.Unmarshal([]byte(additionalConfig), &opts); err != nil {
+		return "", err
+	}
+	transforms, _ := opts[cdc.CDCTaskExtraOptions_Transforms].(string)
+	return transforms, nil
+}
+
 func TransformStdTimeString(tsStr string) (string, error) {
 	ts, err := timestamp.ParseTimestamp(tsStr)
 	if err != nil {
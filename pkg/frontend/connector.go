@@ -72,6 +72,7 @@ func handleCreateDynamicTable(ctx context.Context, ses *Session, st *tree.Create
 	if query != nil { // Checking if query is not nil
 		for _, node := range query.Nodes {
 			if node.NodeType == plan.Node_SOURCE_SCAN {
+				options[moconnector.OptConnectorSourceTable] = node.ObjRef.SchemaName + "." + node.TableDef.Name
 				//collect the stream tableDefs
 				streamTableDef := node.TableDef.Defs
 				for _, def := range streamTableDef {
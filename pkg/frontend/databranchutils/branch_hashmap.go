@@ -1825,7 +1825,7 @@ func encodeDecodedValue(p *types.Packer, typ types.Type, v any) error {
 		}
 	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_json,
 		types.T_binary, types.T_varbinary, types.T_datalink,
-		types.T_array_float32, types.T_array_float64, types.T_TS:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_TS:
 		bytesVal, ok := v.([]byte)
 		if !ok {
 			return moerr.NewInvalidInputNoCtx("expected byte slice value")
@@ -1908,7 +1908,7 @@ func encodeValue(p *types.Packer, vec *vector.Vector, row int) error {
 		p.EncodeUint16(uint16(v))
 	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_json,
 		types.T_binary, types.T_varbinary, types.T_datalink,
-		types.T_array_float32, types.T_array_float64:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		p.EncodeStringType(vec.GetBytesAt(row))
 	case types.T_TS:
 		v := vector.GetFixedAtNoTypeCheck[types.TS](vec, row)
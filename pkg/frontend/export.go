@@ -42,6 +42,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/sql/crt"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	planfunction "github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 )
@@ -70,8 +71,19 @@ type ExportConfig struct {
 	ctx         context.Context
 	service     string
 
-	// Parquet writer for parquet format export
-	parquetWriter *ParquetWriter
+	// Compressor for csv/jsonline output, writes into AsyncWriter; nil if uncompressed
+	compressWriter io.WriteCloser
+
+	// Writer for parquet and arrow export, which build each file in memory
+	columnarWriter columnarWriter
+}
+
+// columnarWriter encodes batches into a self-contained binary file
+// (parquet or arrow); Close returns the complete file data.
+type columnarWriter interface {
+	WriteBatch(bat *batch.Batch, mp *mpool.MPool, timeZone *time.Location) error
+	Size() int
+	Close() ([]byte, error)
 }
 
 type writeParam struct {
@@ -142,9 +154,9 @@ var openNewFile = func(ctx context.Context, ep *ExportConfig, mrs *MysqlResultSe
 	var filePath string
 	ep.CurFileSize = 0
 
-	// For parquet format, we don't use pipe-based writing
-	// Parquet data is accumulated in memory and written at the end
-	if ep.getExportFormat() == "parquet" {
+	// For parquet and arrow format, we don't use pipe-based writing
+	// The data is accumulated in memory and written at the end
+	if ep.isColumnarFormat() {
 		ep.Rows = 0
 		return nil
 	}
@@ -200,6 +212,12 @@ var openNewFile = func(ctx context.Context, ep *ExportConfig, mrs *MysqlResultSe
 	ep.AsyncGroup, _ = errgroup.WithContext(ctx)
 	ep.AsyncGroup.Go(asyncWriteFunc)
 
+	if comp := ep.userConfig.Compression; comp != "" && comp != tree.NOCOMPRESS {
+		if ep.compressWriter, err = crt.GetCompressWriter(ctx, comp, ep.AsyncWriter); err != nil {
+			return err
+		}
+	}
+
 	// Only write CSV header for CSV format (not for jsonline or parquet)
 	exportFormat := ep.userConfig.ExportFormat
 	if exportFormat == "" {
@@ -269,6 +287,15 @@ var formatOutputString = func(oq *ExportConfig, tmp, symbol []byte, enclosed byt
 
 var Close = func(ep *ExportConfig) error {
 	ep.FileCnt++
+	if ep.compressWriter != nil {
+		// flush the tail of the compressed stream before closing the pipe
+		err := ep.compressWriter.Close()
+		ep.compressWriter = nil
+		if err != nil {
+			_ = ep.AsyncWriter.CloseWithError(err)
+			return err
+		}
+	}
 	err := ep.AsyncWriter.Close()
 	if err != nil {
 		return err
@@ -288,7 +315,11 @@ var Close = func(ep *ExportConfig) error {
 }
 
 var Write = func(ep *ExportConfig, output []byte) (int, error) {
-	n, err := ep.AsyncWriter.Write(output)
+	var w io.Writer = ep.AsyncWriter
+	if ep.compressWriter != nil {
+		w = ep.compressWriter
+	}
+	n, err := w.Write(output)
 	if err != nil {
 		err2 := ep.AsyncWriter.CloseWithError(err)
 		if err2 != nil {
@@ -496,6 +527,10 @@ func constructByte(ctx context.Context, obj FeSession, bat *batch.Batch, index i
 				arrStr := types.BytesToArrayToString[float64](vec.GetBytesAt(i))
 				value := addEscapeToString(util2.UnsafeStringToBytes(arrStr), closeby)
 				formatOutputString(ep, value, symbol[j], closeby, true, buffer)
+			case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+				arrStr := types.QuantizedArrayBytesToString(vec.GetType().Oid, vec.GetBytesAt(i))
+				value := addEscapeToString(util2.UnsafeStringToBytes(arrStr), closeby)
+				formatOutputString(ep, value, symbol[j], closeby, true, buffer)
 			case types.T_date:
 				val := vector.GetFixedAtNoTypeCheck[types.Date](vec, i)
 				formatOutputString(ep, []byte(val.String()), symbol[j], closeby, flag[j], buffer)
@@ -823,9 +858,9 @@ func exportDataFromBatchToCSVFile(ep *ExportConfig) error {
 }
 
 func exportAllDataFromBatches(ep *ExportConfig) error {
-	// Handle parquet format separately
-	if ep.getExportFormat() == "parquet" {
-		return finalizeParquetExport(ep)
+	// Handle parquet and arrow format separately
+	if ep.isColumnarFormat() {
+		return finalizeColumnarExport(ep)
 	}
 
 	var tmp *BatchByte
@@ -863,13 +898,13 @@ func exportAllDataFromBatches(ep *ExportConfig) error {
 	return nil
 }
 
-// finalizeParquetExport closes the parquet writer and writes the complete parquet file
-func finalizeParquetExport(ep *ExportConfig) error {
-	return ep.flushParquetFile()
+// finalizeColumnarExport closes the parquet/arrow writer and writes the complete file
+func finalizeColumnarExport(ep *ExportConfig) error {
+	return ep.flushColumnarFile()
 }
 
-// writeParquetToFile writes the complete parquet data to the output file
-func writeParquetToFile(ep *ExportConfig, data []byte) error {
+// writeColumnarToFile writes the complete parquet/arrow data to the output file
+func writeColumnarToFile(ep *ExportConfig, data []byte) error {
 	var filePath string
 	if len(ep.userConfig.StageFilePath) != 0 {
 		filePath = getExportFilePath(ep.userConfig.StageFilePath, ep.FileCnt)
@@ -895,7 +930,7 @@ func writeParquetToFile(ep *ExportConfig, data []byte) error {
 		}
 	}
 
-	// Write the parquet data to file service
+	// Write the file data to file service
 	vec := fileservice.IOVector{
 		FilePath: readPath,
 		Entries: []fileservice.IOEntry{
@@ -929,9 +964,9 @@ func (ec *ExportConfig) Write(execCtx *ExecCtx, crs *perfcounter.CounterSet, bat
 	switch ec.getExportFormat() {
 	case "jsonline":
 		go constructJSONLine(execCtx.reqCtx, execCtx.ses, copied, ec.Index.Load(), ec.ByteChan, ec)
-	case "parquet":
-		// Parquet export: write batch to parquet writer
-		return ec.writeParquet(execCtx, copied)
+	case "parquet", "arrow":
+		// Parquet/arrow export: write batch to the columnar writer
+		return ec.writeColumnar(execCtx, copied)
 	default: // csv
 		go constructByte(execCtx.reqCtx, execCtx.ses, copied, ec.Index.Load(), ec.ByteChan, ec)
 	}
@@ -945,17 +980,17 @@ func (ec *ExportConfig) Write(execCtx *ExecCtx, crs *perfcounter.CounterSet, bat
 	return nil
 }
 
-// writeParquet writes a batch to the parquet writer
-func (ec *ExportConfig) writeParquet(execCtx *ExecCtx, bat *batch.Batch) error {
+// writeColumnar writes a batch to the parquet/arrow writer
+func (ec *ExportConfig) writeColumnar(execCtx *ExecCtx, bat *batch.Batch) error {
 	defer bat.Clean(execCtx.ses.GetMemPool())
 
-	// Initialize parquet writer if not already done
-	if ec.parquetWriter == nil {
+	// Initialize the writer if not already done
+	if ec.columnarWriter == nil {
 		var err error
 		if ec.mrs == nil {
-			return moerr.NewInternalError(execCtx.reqCtx, "mrs is nil for parquet export")
+			return moerr.NewInternalErrorf(execCtx.reqCtx, "mrs is nil for %s export", ec.getExportFormat())
 		}
-		ec.parquetWriter, err = NewParquetWriter(execCtx.reqCtx, ec.mrs)
+		ec.columnarWriter, err = ec.newColumnarWriter(execCtx.reqCtx, nil)
 		if err != nil {
 			return err
 		}
@@ -969,23 +1004,24 @@ func (ec *ExportConfig) writeParquet(execCtx *ExecCtx, bat *batch.Batch) error {
 		timeZone = time.UTC
 	}
 
-	// Write batch to parquet writer
-	if err := ec.parquetWriter.WriteBatch(bat, execCtx.ses.GetMemPool(), timeZone); err != nil {
+	// Write batch to the writer
+	if err := ec.columnarWriter.WriteBatch(bat, execCtx.ses.GetMemPool(), timeZone); err != nil {
 		return err
 	}
 
 	// Check if we need to split the file
 	splitSize := getEffectiveMaxFileSize(ec)
-	if shouldSplitParquetFile(uint64(ec.parquetWriter.Size()), splitSize) {
-		// Flush current parquet file
-		if err := ec.flushParquetFile(); err != nil {
+	if shouldSplitParquetFile(uint64(ec.columnarWriter.Size()), splitSize) {
+		prev := ec.columnarWriter
+		// Flush current file
+		if err := ec.flushColumnarFile(); err != nil {
 			return err
 		}
 		// Increment file counter
 		ec.FileCnt++
-		// Create new parquet writer for next file
+		// Create new writer for next file
 		var err error
-		ec.parquetWriter, err = NewParquetWriter(execCtx.reqCtx, ec.mrs)
+		ec.columnarWriter, err = ec.newColumnarWriter(execCtx.reqCtx, prev)
 		if err != nil {
 			return err
 		}
@@ -994,7 +1030,24 @@ func (ec *ExportConfig) writeParquet(execCtx *ExecCtx, bat *batch.Batch) error {
 	return nil
 }
 
-// shouldSplitParquetFile checks if the parquet file should be split based on current size
+// newColumnarWriter creates the writer of the export format. An arrow writer
+// takes over the schema of prev, the writer of the previous split file.
+func (ec *ExportConfig) newColumnarWriter(ctx context.Context, prev columnarWriter) (columnarWriter, error) {
+	if ec.getExportFormat() != tree.ARROW {
+		return NewParquetWriter(ctx, ec.mrs, ec.userConfig.Compression)
+	}
+	stream := strings.HasSuffix(strings.ToLower(ec.userConfig.FilePath), ".arrows")
+	aw, err := NewArrowWriter(ctx, ec.mrs, ec.userConfig.Compression, stream)
+	if err != nil {
+		return nil, err
+	}
+	if prevArrow, ok := prev.(*ArrowWriter); ok {
+		aw.SetSchema(prevArrow.Schema())
+	}
+	return aw, nil
+}
+
+// shouldSplitParquetFile checks if the parquet/arrow file should be split based on current size
 func shouldSplitParquetFile(currentSize, splitSize uint64) bool {
 	if splitSize == 0 {
 		return false
@@ -1002,24 +1055,24 @@ func shouldSplitParquetFile(currentSize, splitSize uint64) bool {
 	return currentSize >= splitSize
 }
 
-// flushParquetFile closes the current parquet writer and writes data to file
-func (ec *ExportConfig) flushParquetFile() error {
-	if ec.parquetWriter == nil {
+// flushColumnarFile closes the current parquet/arrow writer and writes data to file
+func (ec *ExportConfig) flushColumnarFile() error {
+	if ec.columnarWriter == nil {
 		return nil
 	}
 
-	// Close the parquet writer to get the complete parquet data (including footer)
-	parquetData, err := ec.parquetWriter.Close()
+	// Close the writer to get the complete file data (including footer)
+	data, err := ec.columnarWriter.Close()
 	if err != nil {
 		return err
 	}
 
-	// Write the parquet data to file
-	if err := writeParquetToFile(ec, parquetData); err != nil {
+	// Write the data to file
+	if err := writeColumnarToFile(ec, data); err != nil {
 		return err
 	}
 
-	ec.parquetWriter = nil
+	ec.columnarWriter = nil
 	return nil
 }
 
@@ -1038,10 +1091,44 @@ func (ec *ExportConfig) getExportFormat() string {
 	return ec.userConfig.ExportFormat
 }
 
-// inferFormatFromSuffix infers the export format from file suffix.
+// isColumnarFormat reports whether the export builds whole parquet/arrow
+// files in memory instead of streaming text through the pipe
+func (ec *ExportConfig) isColumnarFormat() bool {
+	format := ec.getExportFormat()
+	return format == tree.PARQUET || format == tree.ARROW
+}
+
+// exportCompressionSuffixes maps the suffixes of compressed text exports to
+// their compression, the same suffixes LOAD DATA auto-detects.
+var exportCompressionSuffixes = []struct {
+	suffix      string
+	compression string
+}{
+	{".gz", tree.GZIP},
+	{".gzip", tree.GZIP},
+	{".zst", tree.ZSTD},
+	{".zstd", tree.ZSTD},
+	{".sz", tree.SNAPPY},
+	{".snappy", tree.SNAPPY},
+}
+
+// splitCompressionSuffix splits "a.csv.gz" into "a.csv" and "gzip".
+// Returns the lower-cased path and empty compression if there is no compression suffix.
+func splitCompressionSuffix(filePath string) (string, string) {
+	lowerPath := strings.ToLower(filePath)
+	for _, s := range exportCompressionSuffixes {
+		if strings.HasSuffix(lowerPath, s.suffix) {
+			return strings.TrimSuffix(lowerPath, s.suffix), s.compression
+		}
+	}
+	return lowerPath, ""
+}
+
+// inferFormatFromSuffix infers the export format from file suffix,
+// ignoring a trailing compression suffix.
 // Returns empty string if suffix is not recognized.
 func inferFormatFromSuffix(filePath string) string {
-	lowerPath := strings.ToLower(filePath)
+	lowerPath, _ := splitCompressionSuffix(filePath)
 
 	// Check for known suffixes
 	switch {
@@ -1053,6 +1140,10 @@ func inferFormatFromSuffix(filePath string) string {
 		return "jsonline"
 	case strings.HasSuffix(lowerPath, ".parquet"):
 		return "parquet"
+	case strings.HasSuffix(lowerPath, ".arrow"),
+		strings.HasSuffix(lowerPath, ".arrows"),
+		strings.HasSuffix(lowerPath, ".feather"):
+		return "arrow"
 	default:
 		return ""
 	}
@@ -1075,15 +1166,15 @@ func validateExportFormat(ctx context.Context, ep *tree.ExportParam) error {
 			// Unknown suffix, default to csv
 			ep.ExportFormat = "csv"
 		}
-		return nil
+		return validateExportCompression(ctx, ep)
 	}
 
 	// Case 2: FORMAT specified - validate against suffix if suffix is recognized
 	if inferredFormat != "" && ep.ExportFormat != inferredFormat {
 		// Get the actual suffix for error message
-		lowerPath := strings.ToLower(ep.FilePath)
+		lowerPath, _ := splitCompressionSuffix(ep.FilePath)
 		var suffix string
-		for _, s := range []string{".csv", ".jsonl", ".jsonline", ".ndjson", ".parquet"} {
+		for _, s := range []string{".csv", ".jsonl", ".jsonline", ".ndjson", ".parquet", ".arrow", ".arrows", ".feather"} {
 			if strings.HasSuffix(lowerPath, s) {
 				suffix = s
 				break
@@ -1093,7 +1184,39 @@ func validateExportFormat(ctx context.Context, ep *tree.ExportParam) error {
 	}
 
 	// Case 3: FORMAT specified with unknown suffix - allow
-	return nil
+	return validateExportCompression(ctx, ep)
+}
+
+// validateExportCompression checks COMPRESSION against the export format.
+// Text formats are compressed as a whole stream, so an unspecified
+// COMPRESSION is inferred from the file suffix (e.g. "a.csv.zst").
+// Parquet and arrow compress pages / record batches internally and ignore
+// the suffix.
+func validateExportCompression(ctx context.Context, ep *tree.ExportParam) error {
+	if ep.ExportFormat == tree.ARROW {
+		switch ep.Compression {
+		case "", tree.NOCOMPRESS, tree.LZ4, tree.ZSTD:
+			return nil
+		}
+		return moerr.NewNotSupportedf(ctx, "compression '%s' for arrow export, must be none, lz4 or zstd", ep.Compression)
+	}
+	if ep.ExportFormat == tree.PARQUET {
+		switch ep.Compression {
+		case "", tree.NOCOMPRESS, tree.SNAPPY, tree.GZIP, tree.ZSTD, tree.BROTLI, tree.LZ4:
+			return nil
+		}
+		return moerr.NewNotSupportedf(ctx, "compression '%s' for parquet export, must be none, snappy, gzip, zstd, brotli or lz4", ep.Compression)
+	}
+
+	if ep.Compression == "" {
+		_, ep.Compression = splitCompressionSuffix(ep.FilePath)
+		return nil
+	}
+	switch ep.Compression {
+	case tree.NOCOMPRESS, tree.GZIP, tree.ZSTD, tree.SNAPPY:
+		return nil
+	}
+	return moerr.NewNotSupportedf(ctx, "compression '%s' for %s export, must be none, gzip, zstd or snappy", ep.Compression, ep.ExportFormat)
 }
 
 // constructJSONLine constructs JSONLINE format output from a batch
@@ -1211,6 +1334,8 @@ func vectorValueToJSON(vec *vector.Vector, i int, ss *Session, backSes *backSess
 		return types.BytesToArray[float32](vec.GetBytesAt(i)), nil
 	case types.T_array_float64:
 		return types.BytesToArray[float64](vec.GetBytesAt(i)), nil
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return types.QuantizedBytesToFloat32(vec.GetType().Oid, vec.GetBytesAt(i)), nil
 	case types.T_date:
 		val := vector.GetFixedAtNoTypeCheck[types.Date](vec, i)
 		return val.String(), nil
@@ -0,0 +1,301 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/decimal256"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// arrowIPCWriter is implemented by both ipc.FileWriter and ipc.Writer
+type arrowIPCWriter interface {
+	Write(rec arrow.Record) error
+	Close() error
+}
+
+// ArrowWriter handles writing data to Arrow IPC format. The file format
+// (Feather v2) is written by default, the stream format if stream is set.
+type ArrowWriter struct {
+	ctx         context.Context
+	buf         *bytes.Buffer
+	writer      arrowIPCWriter
+	schema      *arrow.Schema
+	columnNames []string
+	options     []ipc.Option
+	stream      bool
+}
+
+// NewArrowWriter creates a new ArrowWriter, compressing record batch bodies
+// with the given codec ("" and "none" leave them uncompressed).
+// The schema is derived from the vector types of the first batch.
+func NewArrowWriter(ctx context.Context, mrs *MysqlResultSet, compression string, stream bool) (*ArrowWriter, error) {
+	if mrs == nil || len(mrs.Columns) == 0 {
+		return nil, moerr.NewInternalError(ctx, "no columns for arrow export")
+	}
+	var options []ipc.Option
+	switch compression {
+	case "", tree.NOCOMPRESS:
+	case tree.LZ4:
+		options = append(options, ipc.WithLZ4())
+	case tree.ZSTD:
+		options = append(options, ipc.WithZstd())
+	default:
+		return nil, moerr.NewNotSupportedf(ctx, "compression '%s' for arrow export", compression)
+	}
+
+	columnNames := make([]string, len(mrs.Columns))
+	for i, col := range mrs.Columns {
+		columnNames[i] = col.Name()
+	}
+	return &ArrowWriter{
+		ctx:         ctx,
+		buf:         &bytes.Buffer{},
+		columnNames: columnNames,
+		options:     options,
+		stream:      stream,
+	}, nil
+}
+
+// buildArrowType maps a MatrixOne type to the Arrow type it is exported as
+func buildArrowType(ctx context.Context, typ *types.Type) (arrow.DataType, error) {
+	switch typ.Oid {
+	case types.T_bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case types.T_int8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case types.T_int16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case types.T_int32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case types.T_int64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case types.T_uint8:
+		return arrow.PrimitiveTypes.Uint8, nil
+	case types.T_uint16:
+		return arrow.PrimitiveTypes.Uint16, nil
+	case types.T_uint32:
+		return arrow.PrimitiveTypes.Uint32, nil
+	case types.T_uint64, types.T_bit:
+		return arrow.PrimitiveTypes.Uint64, nil
+	case types.T_float32:
+		return arrow.PrimitiveTypes.Float32, nil
+	case types.T_float64:
+		return arrow.PrimitiveTypes.Float64, nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_json, types.T_uuid, types.T_enum, types.T_datalink:
+		return arrow.BinaryTypes.String, nil
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return arrow.BinaryTypes.Binary, nil
+	case types.T_date:
+		return arrow.FixedWidthTypes.Date32, nil
+	case types.T_datetime:
+		return &arrow.TimestampType{Unit: arrow.Microsecond}, nil
+	case types.T_timestamp:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}, nil
+	case types.T_time:
+		return arrow.FixedWidthTypes.Time64us, nil
+	case types.T_decimal64, types.T_decimal128:
+		return &arrow.Decimal128Type{Precision: typ.Width, Scale: typ.Scale}, nil
+	case types.T_decimal256:
+		return &arrow.Decimal256Type{Precision: typ.Width, Scale: typ.Scale}, nil
+	case types.T_array_float32:
+		return arrow.ListOf(arrow.PrimitiveTypes.Float32), nil
+	case types.T_array_float64:
+		return arrow.ListOf(arrow.PrimitiveTypes.Float64), nil
+	default:
+		return nil, moerr.NewNotSupportedf(ctx, "type %s for arrow export", typ.String())
+	}
+}
+
+// buildArrowSchema derives the schema from the vector types of bat
+func (aw *ArrowWriter) buildArrowSchema(bat *batch.Batch) error {
+	if len(bat.Vecs) != len(aw.columnNames) {
+		return moerr.NewInternalErrorf(aw.ctx, "arrow export expects %d columns, got %d", len(aw.columnNames), len(bat.Vecs))
+	}
+	fields := make([]arrow.Field, len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		dt, err := buildArrowType(aw.ctx, vec.GetType())
+		if err != nil {
+			return err
+		}
+		fields[i] = arrow.Field{Name: aw.columnNames[i], Type: dt, Nullable: true}
+	}
+	aw.schema = arrow.NewSchema(fields, nil)
+	return nil
+}
+
+func (aw *ArrowWriter) openWriter() error {
+	options := append([]ipc.Option{ipc.WithSchema(aw.schema)}, aw.options...)
+	if aw.stream {
+		aw.writer = ipc.NewWriter(aw.buf, options...)
+		return nil
+	}
+	var err error
+	aw.writer, err = ipc.NewFileWriter(aw.buf, options...)
+	return err
+}
+
+// SetSchema makes the writer reuse the schema of a previous file of the same
+// export, so that a split file gets the same schema even if it has no rows.
+func (aw *ArrowWriter) SetSchema(schema *arrow.Schema) {
+	aw.schema = schema
+}
+
+// Schema returns the schema of the written data, nil before the first batch
+func (aw *ArrowWriter) Schema() *arrow.Schema {
+	return aw.schema
+}
+
+// WriteBatch writes a batch of data as one Arrow record batch
+func (aw *ArrowWriter) WriteBatch(bat *batch.Batch, mp *mpool.MPool, timeZone *time.Location) error {
+	if bat == nil {
+		return nil
+	}
+	if aw.schema == nil {
+		if err := aw.buildArrowSchema(bat); err != nil {
+			return err
+		}
+	}
+	if aw.writer == nil {
+		if err := aw.openWriter(); err != nil {
+			return err
+		}
+	}
+	if bat.RowCount() == 0 {
+		return nil
+	}
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, aw.schema)
+	defer builder.Release()
+	for colIdx, vec := range bat.Vecs {
+		if err := appendVectorToArrow(aw.ctx, builder.Field(colIdx), vec, bat.RowCount()); err != nil {
+			return err
+		}
+	}
+	rec := builder.NewRecord()
+	defer rec.Release()
+	return aw.writer.Write(rec)
+}
+
+// appendVectorToArrow appends the first n rows of vec to an Arrow builder
+// created for buildArrowType(vec.GetType())
+func appendVectorToArrow(ctx context.Context, b array.Builder, vec *vector.Vector, n int) error {
+	b.Reserve(n)
+	for i := 0; i < n; i++ {
+		if vec.IsNull(uint64(i)) {
+			b.AppendNull()
+			continue
+		}
+		switch vec.GetType().Oid {
+		case types.T_bool:
+			b.(*array.BooleanBuilder).Append(vector.GetFixedAtNoTypeCheck[bool](vec, i))
+		case types.T_int8:
+			b.(*array.Int8Builder).Append(vector.GetFixedAtNoTypeCheck[int8](vec, i))
+		case types.T_int16:
+			b.(*array.Int16Builder).Append(vector.GetFixedAtNoTypeCheck[int16](vec, i))
+		case types.T_int32:
+			b.(*array.Int32Builder).Append(vector.GetFixedAtNoTypeCheck[int32](vec, i))
+		case types.T_int64:
+			b.(*array.Int64Builder).Append(vector.GetFixedAtNoTypeCheck[int64](vec, i))
+		case types.T_uint8:
+			b.(*array.Uint8Builder).Append(vector.GetFixedAtNoTypeCheck[uint8](vec, i))
+		case types.T_uint16:
+			b.(*array.Uint16Builder).Append(vector.GetFixedAtNoTypeCheck[uint16](vec, i))
+		case types.T_uint32:
+			b.(*array.Uint32Builder).Append(vector.GetFixedAtNoTypeCheck[uint32](vec, i))
+		case types.T_uint64, types.T_bit:
+			b.(*array.Uint64Builder).Append(vector.GetFixedAtNoTypeCheck[uint64](vec, i))
+		case types.T_float32:
+			b.(*array.Float32Builder).Append(vector.GetFixedAtNoTypeCheck[float32](vec, i))
+		case types.T_float64:
+			b.(*array.Float64Builder).Append(vector.GetFixedAtNoTypeCheck[float64](vec, i))
+		case types.T_char, types.T_varchar, types.T_text, types.T_datalink:
+			b.(*array.StringBuilder).BinaryBuilder.Append(vec.GetBytesAt(i))
+		case types.T_json:
+			b.(*array.StringBuilder).Append(types.DecodeJson(vec.GetBytesAt(i)).String())
+		case types.T_uuid:
+			b.(*array.StringBuilder).Append(vector.GetFixedAtNoTypeCheck[types.Uuid](vec, i).String())
+		case types.T_enum:
+			b.(*array.StringBuilder).Append(vector.GetFixedAtNoTypeCheck[types.Enum](vec, i).String())
+		case types.T_binary, types.T_varbinary, types.T_blob:
+			b.(*array.BinaryBuilder).Append(vec.GetBytesAt(i))
+		case types.T_date:
+			val := vector.GetFixedAtNoTypeCheck[types.Date](vec, i)
+			b.(*array.Date32Builder).Append(arrow.Date32(val.DaysSinceUnixEpoch()))
+		case types.T_datetime:
+			val := vector.GetFixedAtNoTypeCheck[types.Datetime](vec, i)
+			b.(*array.TimestampBuilder).Append(arrow.Timestamp(int64(val) - types.GetUnixEpochSecs()))
+		case types.T_timestamp:
+			val := vector.GetFixedAtNoTypeCheck[types.Timestamp](vec, i)
+			b.(*array.TimestampBuilder).Append(arrow.Timestamp(int64(val) - types.GetUnixEpochSecs()))
+		case types.T_time:
+			b.(*array.Time64Builder).Append(arrow.Time64(vector.GetFixedAtNoTypeCheck[types.Time](vec, i)))
+		case types.T_decimal64:
+			val := vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, i)
+			b.(*array.Decimal128Builder).Append(decimal128.FromI64(int64(val)))
+		case types.T_decimal128:
+			val := vector.GetFixedAtNoTypeCheck[types.Decimal128](vec, i)
+			b.(*array.Decimal128Builder).Append(decimal128.New(int64(val.B64_127), val.B0_63))
+		case types.T_decimal256:
+			val := vector.GetFixedAtNoTypeCheck[types.Decimal256](vec, i)
+			b.(*array.Decimal256Builder).Append(decimal256.New(val.B192_255, val.B128_191, val.B64_127, val.B0_63))
+		case types.T_array_float32:
+			lb := b.(*array.ListBuilder)
+			lb.Append(true)
+			lb.ValueBuilder().(*array.Float32Builder).AppendValues(types.BytesToArray[float32](vec.GetBytesAt(i)), nil)
+		case types.T_array_float64:
+			lb := b.(*array.ListBuilder)
+			lb.Append(true)
+			lb.ValueBuilder().(*array.Float64Builder).AppendValues(types.BytesToArray[float64](vec.GetBytesAt(i)), nil)
+		default:
+			return moerr.NewNotSupportedf(ctx, "type %s for arrow export", vec.GetType().String())
+		}
+	}
+	return nil
+}
+
+// Close closes the arrow writer and returns the complete arrow data
+func (aw *ArrowWriter) Close() ([]byte, error) {
+	if aw.writer == nil {
+		if aw.schema == nil {
+			return nil, moerr.NewInternalError(aw.ctx, "arrow export closed before any batch was written")
+		}
+		if err := aw.openWriter(); err != nil {
+			return nil, err
+		}
+	}
+	if err := aw.writer.Close(); err != nil {
+		return nil, err
+	}
+	return aw.buf.Bytes(), nil
+}
+
+// Size returns the current buffer size in bytes
+func (aw *ArrowWriter) Size() int {
+	return aw.buf.Len()
+}
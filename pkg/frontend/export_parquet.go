@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/util/parquetexport"
 	"github.com/parquet-go/parquet-go"
 )
 
@@ -38,11 +39,20 @@ type ParquetWriter struct {
 	columnTypes []defines.MysqlType
 }
 
-// NewParquetWriter creates a new ParquetWriter
-func NewParquetWriter(ctx context.Context, mrs *MysqlResultSet) (*ParquetWriter, error) {
+// NewParquetWriter creates a new ParquetWriter, compressing pages with the
+// given codec ("" keeps the parquet-go default)
+func NewParquetWriter(ctx context.Context, mrs *MysqlResultSet, compression string) (*ParquetWriter, error) {
 	if mrs == nil || len(mrs.Columns) == 0 {
 		return nil, moerr.NewInternalError(ctx, "no columns for parquet export")
 	}
+	var options []parquet.WriterOption
+	if compression != "" {
+		codec, err := parquetexport.Codec(ctx, compression)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, parquet.Compression(codec))
+	}
 
 	columnNames := make([]string, len(mrs.Columns))
 	columnTypes := make([]defines.MysqlType, len(mrs.Columns))
@@ -62,7 +72,7 @@ func NewParquetWriter(ctx context.Context, mrs *MysqlResultSet) (*ParquetWriter,
 
 	schema := parquet.NewSchema("export", group)
 	buf := &bytes.Buffer{}
-	writer := parquet.NewGenericWriter[any](buf, schema)
+	writer := parquet.NewGenericWriter[any](buf, append(options, schema)...)
 
 	return &ParquetWriter{
 		ctx:         ctx,
@@ -74,43 +84,33 @@ func NewParquetWriter(ctx context.Context, mrs *MysqlResultSet) (*ParquetWriter,
 	}, nil
 }
 
-// buildParquetNode creates a parquet node from MySQL type
+// buildParquetNode creates a parquet node from MySQL type, mapping it to the
+// engine type whose parquet node it shares
 func buildParquetNode(typ defines.MysqlType, flag uint16) parquet.Node {
 	isUnsigned := flag&uint16(defines.UNSIGNED_FLAG) != 0
-	// All fields are optional (nullable) by default
 	switch typ {
 	case defines.MYSQL_TYPE_BOOL:
-		return parquet.Optional(parquet.Leaf(parquet.BooleanType))
+		return parquetexport.Node(types.T_bool)
 	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24:
-		return parquet.Optional(parquet.Leaf(parquet.Int32Type))
+		return parquetexport.Node(types.T_int32)
 	case defines.MYSQL_TYPE_LONG:
-		// For unsigned int32, use Int64 to avoid overflow
 		if isUnsigned {
-			return parquet.Optional(parquet.Leaf(parquet.Int64Type))
+			return parquetexport.Node(types.T_uint32)
 		}
-		return parquet.Optional(parquet.Leaf(parquet.Int32Type))
+		return parquetexport.Node(types.T_int32)
 	case defines.MYSQL_TYPE_BIT:
-		return parquet.Optional(parquet.Leaf(parquet.Int64Type))
+		return parquetexport.Node(types.T_bit)
 	case defines.MYSQL_TYPE_LONGLONG:
-		return parquet.Optional(parquet.Leaf(parquet.Int64Type))
+		return parquetexport.Node(types.T_int64)
 	case defines.MYSQL_TYPE_FLOAT:
-		return parquet.Optional(parquet.Leaf(parquet.FloatType))
+		return parquetexport.Node(types.T_float32)
 	case defines.MYSQL_TYPE_DOUBLE:
-		return parquet.Optional(parquet.Leaf(parquet.DoubleType))
-	case defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP, defines.MYSQL_TYPE_TIME:
-		// Use string representation for date/time types for simplicity and compatibility
-		return parquet.Optional(parquet.String())
-	case defines.MYSQL_TYPE_DECIMAL:
-		// Use string representation for decimals
-		return parquet.Optional(parquet.String())
-	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
-		defines.MYSQL_TYPE_JSON, defines.MYSQL_TYPE_UUID, defines.MYSQL_TYPE_TEXT:
-		return parquet.Optional(parquet.String())
+		return parquetexport.Node(types.T_float64)
 	case defines.MYSQL_TYPE_BLOB:
-		return parquet.Optional(parquet.Leaf(parquet.ByteArrayType))
+		return parquetexport.Node(types.T_blob)
 	default:
-		// Default to string for unknown types
-		return parquet.Optional(parquet.String())
+		// dates, times, decimals, json, uuid and strings are strings
+		return parquetexport.Node(types.T_varchar)
 	}
 }
 
@@ -149,78 +149,7 @@ func (pw *ParquetWriter) WriteBatch(bat *batch.Batch, mp *mpool.MPool, timeZone
 
 // vectorValueToParquet converts a vector value to a parquet-compatible Go value
 func vectorValueToParquet(vec *vector.Vector, i int, timeZone *time.Location) (any, error) {
-	switch vec.GetType().Oid {
-	case types.T_bool:
-		return vector.GetFixedAtNoTypeCheck[bool](vec, i), nil
-	case types.T_int8:
-		return int32(vector.GetFixedAtNoTypeCheck[int8](vec, i)), nil
-	case types.T_int16:
-		return int32(vector.GetFixedAtNoTypeCheck[int16](vec, i)), nil
-	case types.T_int32:
-		return vector.GetFixedAtNoTypeCheck[int32](vec, i), nil
-	case types.T_int64:
-		return vector.GetFixedAtNoTypeCheck[int64](vec, i), nil
-	case types.T_uint8:
-		return int32(vector.GetFixedAtNoTypeCheck[uint8](vec, i)), nil
-	case types.T_uint16:
-		return int32(vector.GetFixedAtNoTypeCheck[uint16](vec, i)), nil
-	case types.T_uint32:
-		// Use int64 to avoid overflow (uint32 max > int32 max)
-		return int64(vector.GetFixedAtNoTypeCheck[uint32](vec, i)), nil
-	case types.T_uint64:
-		return int64(vector.GetFixedAtNoTypeCheck[uint64](vec, i)), nil
-	case types.T_bit:
-		return int64(vector.GetFixedAtNoTypeCheck[uint64](vec, i)), nil
-	case types.T_float32:
-		return vector.GetFixedAtNoTypeCheck[float32](vec, i), nil
-	case types.T_float64:
-		return vector.GetFixedAtNoTypeCheck[float64](vec, i), nil
-	case types.T_char, types.T_varchar, types.T_text:
-		return string(vec.GetBytesAt(i)), nil
-	case types.T_binary, types.T_varbinary, types.T_blob:
-		return vec.GetBytesAt(i), nil
-	case types.T_json:
-		val := types.DecodeJson(vec.GetBytesAt(i))
-		return val.String(), nil
-	case types.T_date:
-		val := vector.GetFixedAtNoTypeCheck[types.Date](vec, i)
-		return val.String(), nil
-	case types.T_datetime:
-		scale := vec.GetType().Scale
-		val := vector.GetFixedAtNoTypeCheck[types.Datetime](vec, i).String2(scale)
-		return val, nil
-	case types.T_time:
-		scale := vec.GetType().Scale
-		val := vector.GetFixedAtNoTypeCheck[types.Time](vec, i).String2(scale)
-		return val, nil
-	case types.T_timestamp:
-		if timeZone == nil {
-			timeZone = time.UTC
-		}
-		scale := vec.GetType().Scale
-		val := vector.GetFixedAtNoTypeCheck[types.Timestamp](vec, i).String2(timeZone, scale)
-		return val, nil
-	case types.T_decimal64:
-		scale := vec.GetType().Scale
-		val := vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, i).Format(scale)
-		return val, nil
-	case types.T_decimal128:
-		scale := vec.GetType().Scale
-		val := vector.GetFixedAtNoTypeCheck[types.Decimal128](vec, i).Format(scale)
-		return val, nil
-	case types.T_decimal256:
-		scale := vec.GetType().Scale
-		val := vector.GetFixedAtNoTypeCheck[types.Decimal256](vec, i).Format(scale)
-		return val, nil
-	case types.T_uuid:
-		val := vector.GetFixedAtNoTypeCheck[types.Uuid](vec, i).String()
-		return val, nil
-	case types.T_enum:
-		val := vector.GetFixedAtNoTypeCheck[types.Enum](vec, i).String()
-		return val, nil
-	default:
-		return nil, moerr.NewInternalErrorf(context.Background(), "unsupported type for parquet export: %v", vec.GetType().Oid)
-	}
+	return parquetexport.VectorValue(vec, i, timeZone)
 }
 
 // Close closes the parquet writer and returns the complete parquet data
@@ -16,17 +16,32 @@ package frontend
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"io"
+	"path/filepath"
 	"strings"
 	"testing"
-
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/format"
 	"github.com/prashantv/gostub"
 	"github.com/smartystreets/goconvey/convey"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/crt"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 )
@@ -74,7 +89,7 @@ func Test_openNewFile(t *testing.T) {
 					EscapedBy:  &tree.EscapedBy{},
 				},
 				Header:   true,
-				FilePath: "test/export.csv",
+				FilePath: filepath.Join(t.TempDir(), "export.csv"),
 			},
 			mrs: &MysqlResultSet{},
 		}
@@ -90,6 +105,9 @@ func Test_openNewFile(t *testing.T) {
 		defer stubs.Reset()
 
 		convey.So(openNewFile(context.TODO(), ep, ep.mrs), convey.ShouldBeNil)
+		// finish the async write so no temporary file is left behind
+		convey.So(ep.AsyncWriter.Close(), convey.ShouldBeNil)
+		convey.So(ep.AsyncGroup.Wait(), convey.ShouldBeNil)
 	})
 }
 
@@ -854,14 +872,14 @@ func Test_NewParquetWriter(t *testing.T) {
 	convey.Convey("NewParquetWriter creates writer correctly", t, func() {
 		// Test with nil MysqlResultSet
 		convey.Convey("nil result set returns error", func() {
-			_, err := NewParquetWriter(context.Background(), nil)
+			_, err := NewParquetWriter(context.Background(), nil, "")
 			convey.So(err, convey.ShouldNotBeNil)
 		})
 
 		// Test with empty columns
 		convey.Convey("empty columns returns error", func() {
 			mrs := &MysqlResultSet{}
-			_, err := NewParquetWriter(context.Background(), mrs)
+			_, err := NewParquetWriter(context.Background(), mrs, "")
 			convey.So(err, convey.ShouldNotBeNil)
 		})
 
@@ -877,7 +895,7 @@ func Test_NewParquetWriter(t *testing.T) {
 			mrs.AddColumn(col1)
 			mrs.AddColumn(col2)
 
-			pw, err := NewParquetWriter(context.Background(), mrs)
+			pw, err := NewParquetWriter(context.Background(), mrs, "")
 			convey.So(err, convey.ShouldBeNil)
 			convey.So(pw, convey.ShouldNotBeNil)
 			convey.So(len(pw.columnNames), convey.ShouldEqual, 2)
@@ -895,7 +913,7 @@ func Test_ParquetWriter_Close(t *testing.T) {
 		col1.SetColumnType(defines.MYSQL_TYPE_LONG)
 		mrs.AddColumn(col1)
 
-		pw, err := NewParquetWriter(context.Background(), mrs)
+		pw, err := NewParquetWriter(context.Background(), mrs, "")
 		convey.So(err, convey.ShouldBeNil)
 
 		// Close without writing any data
@@ -926,11 +944,22 @@ func Test_inferFormatFromSuffix(t *testing.T) {
 		convey.So(inferFormatFromSuffix("data.PARQUET"), convey.ShouldEqual, "parquet")
 		convey.So(inferFormatFromSuffix("/path/to/data.parquet"), convey.ShouldEqual, "parquet")
 
+		// Arrow suffixes
+		convey.So(inferFormatFromSuffix("data.arrow"), convey.ShouldEqual, "arrow")
+		convey.So(inferFormatFromSuffix("data.arrows"), convey.ShouldEqual, "arrow")
+		convey.So(inferFormatFromSuffix("data.Feather"), convey.ShouldEqual, "arrow")
+
 		// With split pattern %d
 		convey.So(inferFormatFromSuffix("data_%05d.csv"), convey.ShouldEqual, "csv")
 		convey.So(inferFormatFromSuffix("data_%d.jsonl"), convey.ShouldEqual, "jsonline")
 		convey.So(inferFormatFromSuffix("data_%05d.parquet"), convey.ShouldEqual, "parquet")
 
+		// Compression suffix is skipped
+		convey.So(inferFormatFromSuffix("data.csv.gz"), convey.ShouldEqual, "csv")
+		convey.So(inferFormatFromSuffix("data_%05d.jsonl.ZST"), convey.ShouldEqual, "jsonline")
+		convey.So(inferFormatFromSuffix("data.ndjson.snappy"), convey.ShouldEqual, "jsonline")
+		convey.So(inferFormatFromSuffix("data.gz"), convey.ShouldEqual, "")
+
 		// Unknown suffix returns empty string
 		convey.So(inferFormatFromSuffix("data.txt"), convey.ShouldEqual, "")
 		convey.So(inferFormatFromSuffix("data.json"), convey.ShouldEqual, "")
@@ -1050,6 +1079,252 @@ func Test_validateExportFormat(t *testing.T) {
 	})
 }
 
+func Test_validateExportCompression(t *testing.T) {
+	ctx := context.Background()
+
+	convey.Convey("validateExportCompression infers and validates compression", t, func() {
+		cases := []struct {
+			filePath    string
+			format      string
+			compression string
+			expected    string
+			fail        bool
+		}{
+			{filePath: "data.csv", expected: ""},
+			{filePath: "data.csv.gz", expected: tree.GZIP},
+			{filePath: "data_%05d.jsonl.zst", expected: tree.ZSTD},
+			{filePath: "data.csv.sz", expected: tree.SNAPPY},
+			{filePath: "data.csv.gz", compression: tree.NOCOMPRESS, expected: tree.NOCOMPRESS},
+			{filePath: "data.txt", format: "jsonline", compression: tree.SNAPPY, expected: tree.SNAPPY},
+			{filePath: "data.csv", compression: tree.BROTLI, fail: true},
+			{filePath: "data.csv", compression: tree.LZ4, fail: true},
+			{filePath: "data.parquet", expected: ""},
+			{filePath: "data.parquet.gz", format: "parquet", expected: ""},
+			{filePath: "data.parquet", compression: tree.BROTLI, expected: tree.BROTLI},
+			{filePath: "data.parquet", compression: tree.LZ4, expected: tree.LZ4},
+			{filePath: "data.parquet", compression: tree.BZIP2, fail: true},
+			{filePath: "data.arrow", expected: ""},
+			{filePath: "data.feather", compression: tree.ZSTD, expected: tree.ZSTD},
+			{filePath: "data.arrows", compression: tree.LZ4, expected: tree.LZ4},
+			{filePath: "data.arrow", compression: tree.SNAPPY, fail: true},
+		}
+		for _, c := range cases {
+			ep := &tree.ExportParam{
+				FilePath:     c.filePath,
+				ExportFormat: c.format,
+				Compression:  c.compression,
+			}
+			err := validateExportFormat(ctx, ep)
+			if c.fail {
+				convey.So(err, convey.ShouldNotBeNil)
+				continue
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ep.Compression, convey.ShouldEqual, c.expected)
+		}
+	})
+}
+
+func Test_WriteCompressed(t *testing.T) {
+	convey.Convey("Write and Close compress text output", t, func() {
+		ctx := context.Background()
+		for _, comp := range []string{tree.GZIP, tree.ZSTD, tree.SNAPPY} {
+			ep := &ExportConfig{userConfig: &tree.ExportParam{Compression: comp}}
+			ep.AsyncReader, ep.AsyncWriter = io.Pipe()
+			var out bytes.Buffer
+			ep.AsyncGroup, _ = errgroup.WithContext(ctx)
+			ep.AsyncGroup.Go(func() error {
+				_, err := io.Copy(&out, ep.AsyncReader)
+				return err
+			})
+			var err error
+			ep.compressWriter, err = crt.GetCompressWriter(ctx, comp, ep.AsyncWriter)
+			convey.So(err, convey.ShouldBeNil)
+
+			line := []byte(strings.Repeat("1,abc,2024-01-01\n", 100))
+			for i := 0; i < 10; i++ {
+				convey.So(writeDataToCSVFile(ep, line), convey.ShouldBeNil)
+			}
+			convey.So(ep.CurFileSize, convey.ShouldEqual, 10*len(line))
+			convey.So(Close(ep), convey.ShouldBeNil)
+			convey.So(ep.compressWriter, convey.ShouldBeNil)
+			convey.So(out.Len(), convey.ShouldBeLessThan, 10*len(line))
+
+			var r io.Reader
+			switch comp {
+			case tree.GZIP:
+				r, err = gzip.NewReader(&out)
+			case tree.ZSTD:
+				r, err = zstd.NewReader(&out)
+			case tree.SNAPPY:
+				r = snappy.NewReader(&out)
+			}
+			convey.So(err, convey.ShouldBeNil)
+			plain, err := io.ReadAll(r)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(plain, convey.ShouldResemble, bytes.Repeat(line, 10))
+		}
+	})
+}
+
+func Test_ParquetWriter_Compression(t *testing.T) {
+	convey.Convey("ParquetWriter compresses pages with the selected codec", t, func() {
+		ctx := context.Background()
+		mp := mpool.MustNewZero()
+		mrs := &MysqlResultSet{}
+		col := new(MysqlColumn)
+		col.SetName("id")
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		mrs.AddColumn(col)
+
+		_, err := NewParquetWriter(ctx, mrs, tree.BZIP2)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		codecs := map[string]format.CompressionCodec{
+			tree.NOCOMPRESS: format.Uncompressed,
+			tree.SNAPPY:     format.Snappy,
+			tree.GZIP:       format.Gzip,
+			tree.ZSTD:       format.Zstd,
+			tree.BROTLI:     format.Brotli,
+			tree.LZ4:        format.Lz4Raw,
+		}
+		for comp, codec := range codecs {
+			pw, err := NewParquetWriter(ctx, mrs, comp)
+			convey.So(err, convey.ShouldBeNil)
+
+			bat := batch.NewWithSize(1)
+			bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+			for i := 0; i < 100; i++ {
+				convey.So(vector.AppendFixed(bat.Vecs[0], int64(i), false, mp), convey.ShouldBeNil)
+			}
+			bat.SetRowCount(100)
+			convey.So(pw.WriteBatch(bat, mp, time.UTC), convey.ShouldBeNil)
+			bat.Clean(mp)
+
+			data, err := pw.Close()
+			convey.So(err, convey.ShouldBeNil)
+			f, err := parquet.OpenFile(bytes.NewReader(data), int64(len(data)))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(f.NumRows(), convey.ShouldEqual, 100)
+			convey.So(f.Metadata().RowGroups[0].Columns[0].MetaData.Codec, convey.ShouldEqual, codec)
+		}
+	})
+}
+
+func Test_ArrowWriter(t *testing.T) {
+	convey.Convey("ArrowWriter writes typed record batches", t, func() {
+		ctx := context.Background()
+		mp := mpool.MustNewZero()
+		mrs := &MysqlResultSet{}
+		for _, name := range []string{"id", "name", "d", "ts", "price", "emb"} {
+			col := new(MysqlColumn)
+			col.SetName(name)
+			mrs.AddColumn(col)
+		}
+
+		_, err := NewArrowWriter(ctx, mrs, tree.BROTLI, false)
+		convey.So(err, convey.ShouldNotBeNil)
+
+		newBatch := func() *batch.Batch {
+			bat := batch.NewWithSize(6)
+			bat.Vecs[0] = vector.NewVec(types.T_int32.ToType())
+			bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+			bat.Vecs[2] = vector.NewVec(types.T_date.ToType())
+			bat.Vecs[3] = vector.NewVec(types.T_timestamp.ToType())
+			bat.Vecs[4] = vector.NewVec(types.New(types.T_decimal64, 10, 2))
+			bat.Vecs[5] = vector.NewVec(types.New(types.T_array_float32, 2, 0))
+			convey.So(vector.AppendFixed(bat.Vecs[0], int32(7), false, mp), convey.ShouldBeNil)
+			convey.So(vector.AppendFixed(bat.Vecs[0], int32(0), true, mp), convey.ShouldBeNil)
+			convey.So(vector.AppendBytes(bat.Vecs[1], []byte("abc"), false, mp), convey.ShouldBeNil)
+			convey.So(vector.AppendBytes(bat.Vecs[1], nil, true, mp), convey.ShouldBeNil)
+			date, _ := types.ParseDateCast("2024-02-29")
+			convey.So(vector.AppendFixed(bat.Vecs[2], date, false, mp), convey.ShouldBeNil)
+			convey.So(vector.AppendFixed(bat.Vecs[2], date, true, mp), convey.ShouldBeNil)
+			ts := types.UnixMicroToTimestamp(1700000000123456)
+			convey.So(vector.AppendFixed(bat.Vecs[3], ts, false, mp), convey.ShouldBeNil)
+			convey.So(vector.AppendFixed(bat.Vecs[3], ts, true, mp), convey.ShouldBeNil)
+			price := int64(-12345)
+			convey.So(vector.AppendFixed(bat.Vecs[4], types.Decimal64(price), false, mp), convey.ShouldBeNil)
+			convey.So(vector.AppendFixed(bat.Vecs[4], types.Decimal64(0), true, mp), convey.ShouldBeNil)
+			convey.So(vector.AppendBytes(bat.Vecs[5], types.ArrayToBytes([]float32{1.5, -2}), false, mp), convey.ShouldBeNil)
+			convey.So(vector.AppendBytes(bat.Vecs[5], nil, true, mp), convey.ShouldBeNil)
+			bat.SetRowCount(2)
+			return bat
+		}
+
+		for _, stream := range []bool{false, true} {
+			aw, err := NewArrowWriter(ctx, mrs, tree.ZSTD, stream)
+			convey.So(err, convey.ShouldBeNil)
+			bat := newBatch()
+			convey.So(aw.WriteBatch(bat, mp, time.UTC), convey.ShouldBeNil)
+			convey.So(aw.WriteBatch(bat, mp, time.UTC), convey.ShouldBeNil)
+			bat.Clean(mp)
+			convey.So(aw.Size(), convey.ShouldBeGreaterThan, 0)
+			data, err := aw.Close()
+			convey.So(err, convey.ShouldBeNil)
+
+			var recs []arrow.Record
+			if stream {
+				r, err := ipc.NewReader(bytes.NewReader(data))
+				convey.So(err, convey.ShouldBeNil)
+				for r.Next() {
+					rec := r.Record()
+					rec.Retain()
+					recs = append(recs, rec)
+				}
+				r.Release()
+			} else {
+				r, err := ipc.NewFileReader(bytes.NewReader(data))
+				convey.So(err, convey.ShouldBeNil)
+				for i := 0; i < r.NumRecords(); i++ {
+					rec, err := r.RecordAt(i)
+					convey.So(err, convey.ShouldBeNil)
+					recs = append(recs, rec)
+				}
+				convey.So(r.Close(), convey.ShouldBeNil)
+			}
+			convey.So(len(recs), convey.ShouldEqual, 2)
+
+			rec := recs[0]
+			convey.So(rec.Schema().Field(1).Name, convey.ShouldEqual, "name")
+			convey.So(rec.Column(0).(*array.Int32).Value(0), convey.ShouldEqual, 7)
+			convey.So(rec.Column(0).IsNull(1), convey.ShouldBeTrue)
+			convey.So(rec.Column(1).(*array.String).Value(0), convey.ShouldEqual, "abc")
+			convey.So(rec.Column(2).(*array.Date32).Value(0).ToTime().Format("2006-01-02"), convey.ShouldEqual, "2024-02-29")
+			convey.So(int64(rec.Column(3).(*array.Timestamp).Value(0)), convey.ShouldEqual, 1700000000123456)
+			convey.So(rec.Column(3).DataType().(*arrow.TimestampType).TimeZone, convey.ShouldEqual, "UTC")
+			dec := rec.Column(4).(*array.Decimal128)
+			convey.So(dec.Value(0).ToString(2), convey.ShouldEqual, "-123.45")
+			list := rec.Column(5).(*array.List)
+			start, end := list.ValueOffsets(0)
+			convey.So(list.ListValues().(*array.Float32).Float32Values()[start:end], convey.ShouldResemble, []float32{1.5, -2})
+			convey.So(list.IsNull(1), convey.ShouldBeTrue)
+			for _, rec := range recs {
+				rec.Release()
+			}
+		}
+
+		// a split file without rows keeps the schema of the previous file
+		aw, err := NewArrowWriter(ctx, mrs, "", false)
+		convey.So(err, convey.ShouldBeNil)
+		_, err = aw.Close()
+		convey.So(err, convey.ShouldNotBeNil)
+		bat := newBatch()
+		prev, err := NewArrowWriter(ctx, mrs, "", false)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(prev.WriteBatch(bat, mp, time.UTC), convey.ShouldBeNil)
+		bat.Clean(mp)
+		aw.SetSchema(prev.Schema())
+		data, err := aw.Close()
+		convey.So(err, convey.ShouldBeNil)
+		r, err := ipc.NewFileReader(bytes.NewReader(data))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(r.NumRecords(), convey.ShouldEqual, 0)
+		convey.So(r.Schema().Equal(prev.Schema()), convey.ShouldBeTrue)
+		convey.So(r.Close(), convey.ShouldBeNil)
+	})
+}
+
 func Test_ParquetWriter_Size(t *testing.T) {
 	convey.Convey("ParquetWriter Size returns current buffer size", t, func() {
 		ctx := context.Background()
@@ -1063,7 +1338,7 @@ func Test_ParquetWriter_Size(t *testing.T) {
 		mrs.AddColumn(col1)
 		mrs.AddColumn(col2)
 
-		pw, err := NewParquetWriter(ctx, mrs)
+		pw, err := NewParquetWriter(ctx, mrs, "")
 		convey.So(err, convey.ShouldBeNil)
 		convey.So(pw, convey.ShouldNotBeNil)
 
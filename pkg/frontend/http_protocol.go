@@ -0,0 +1,474 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"io"
+	"math"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var _ MysqlRrWr = &HttpProtocolImpl{}
+
+// HttpProtocolImpl writes the results of the statements sent by HTTP with
+// the httpResultWriter. It has no network connection of its own, the
+// statements are read and the response is flushed by the http server.
+type HttpProtocolImpl struct {
+	mu sync.Mutex
+
+	connectionID   uint32
+	peer           string
+	tlsEstablished bool
+	established    bool
+	capability     uint32
+	username       string
+	database       string
+
+	ses *Session
+	ctx context.Context
+
+	// the writer of the response and the function that sends the
+	// written bytes to the client. flush is nil for the async statement.
+	writer httpResultWriter
+	flush  func() error
+
+	// the columns of the result set being written
+	columns []Column
+	// the sum of the affected rows of the statements
+	affectedRows uint64
+	// the error of the statements
+	herr *httpError
+}
+
+func NewHttpProtocol(connectionID uint32, peer string, tlsEstablished bool) *HttpProtocolImpl {
+	return &HttpProtocolImpl{
+		connectionID:   connectionID,
+		peer:           peer,
+		tlsEstablished: tlsEstablished,
+		capability:     DefaultCapability,
+	}
+}
+
+// httpOutput counts the bytes written into the response for the session.
+type httpOutput struct {
+	hp *HttpProtocolImpl
+	w  io.Writer
+}
+
+func (o httpOutput) Write(p []byte) (int, error) {
+	n, err := o.w.Write(p)
+	// the writer is called with the lock of the protocol held
+	if o.hp.ses != nil {
+		o.hp.ses.CountOutputBytes(n)
+	}
+	return n, err
+}
+
+// SetOutput sets the format and the destination of the response.
+func (hp *HttpProtocolImpl) SetOutput(format string, w io.Writer, statementID string, flush func() error) {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	hp.writer = newHttpResultWriter(format, httpOutput{hp: hp, w: w}, statementID, hp.connectionID)
+	hp.flush = flush
+	hp.columns = hp.columns[:0]
+	hp.affectedRows = 0
+	hp.herr = nil
+}
+
+// ContentType returns the Content-Type of the response.
+func (hp *HttpProtocolImpl) ContentType() string {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	return hp.writer.contentType()
+}
+
+// Result returns the affected rows and the error of the statements.
+func (hp *HttpProtocolImpl) Result() (uint64, *httpError) {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	return hp.affectedRows, hp.herr
+}
+
+// SetError keeps the error of the statements if there is none.
+func (hp *HttpProtocolImpl) SetError(herr *httpError) {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	if hp.herr == nil {
+		hp.herr = herr
+	}
+}
+
+// Finish ends the response with the error of the statements.
+func (hp *HttpProtocolImpl) Finish() error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	if err := hp.writer.finish(hp.herr); err != nil {
+		return err
+	}
+	return hp.flushOutput()
+}
+
+func (hp *HttpProtocolImpl) flushOutput() error {
+	if hp.flush == nil {
+		return nil
+	}
+	if hp.ses != nil {
+		hp.ses.CountFlushPackage(1)
+	}
+	return hp.flush()
+}
+
+func (hp *HttpProtocolImpl) writeStatus(affectedRows uint64) error {
+	hp.affectedRows += affectedRows
+	if err := hp.writer.writeStatus(affectedRows); err != nil {
+		return err
+	}
+	return hp.flushOutput()
+}
+
+func (hp *HttpProtocolImpl) GetStr(id PropertyID) string {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	switch id {
+	case USERNAME:
+		return hp.username
+	case DBNAME:
+		return hp.database
+	case PEER:
+		return hp.peer
+	}
+	return ""
+}
+
+func (hp *HttpProtocolImpl) SetStr(id PropertyID, val string) {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	switch id {
+	case USERNAME:
+		hp.username = val
+	case DBNAME:
+		hp.database = val
+	}
+}
+
+func (hp *HttpProtocolImpl) SetU32(id PropertyID, val uint32) {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	if id == CAPABILITY {
+		hp.capability = val
+	}
+}
+
+func (hp *HttpProtocolImpl) GetU32(id PropertyID) uint32 {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	switch id {
+	case CONNID:
+		return hp.connectionID
+	case CAPABILITY:
+		return hp.capability
+	}
+	return math.MaxUint32
+}
+
+func (hp *HttpProtocolImpl) SetU8(PropertyID, uint8) {}
+
+func (hp *HttpProtocolImpl) GetU8(PropertyID) uint8 {
+	return 0
+}
+
+func (hp *HttpProtocolImpl) SetBool(id PropertyID, val bool) {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	if id == ESTABLISHED {
+		hp.established = val
+	}
+}
+
+func (hp *HttpProtocolImpl) GetBool(id PropertyID) bool {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	switch id {
+	case ESTABLISHED:
+		return hp.established
+	case TLS_ESTABLISHED:
+		return hp.tlsEstablished
+	}
+	return false
+}
+
+func (hp *HttpProtocolImpl) GetSession() *Session {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	return hp.ses
+}
+
+func (hp *HttpProtocolImpl) Write(execCtx *ExecCtx, crs *perfcounter.CounterSet, bat *batch.Batch) error {
+	ses := execCtx.ses.(*Session)
+	if ses.GetShowStmtType() == ShowTableStatus {
+		n := bat.RowCount()
+		row := make([]any, len(bat.Vecs))
+		for j := 0; j < n; j++ {
+			if err := extractRowFromEveryVector(execCtx.reqCtx, ses, bat, j, row, false); err != nil {
+				return err
+			}
+			row2 := make([]any, len(row))
+			copy(row2, row)
+			ses.AppendData(row2)
+		}
+		return nil
+	}
+
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	if err := hp.writer.writeBatch(execCtx.reqCtx, ses, bat); err != nil {
+		return err
+	}
+	return hp.flushOutput()
+}
+
+func (hp *HttpProtocolImpl) WriteHandshake() error {
+	return nil
+}
+
+func (hp *HttpProtocolImpl) WriteOK(affectedRows, lastInsertId uint64, status, warnings uint16, message string) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	return hp.writeStatus(affectedRows)
+}
+
+func (hp *HttpProtocolImpl) WriteOKtWithEOF(affectedRows, lastInsertId uint64, status, warnings uint16, message string) error {
+	return hp.WriteOK(affectedRows, lastInsertId, status, warnings, message)
+}
+
+func (hp *HttpProtocolImpl) WriteEOF(warnings, status uint16) error {
+	return nil
+}
+
+func (hp *HttpProtocolImpl) WriteEOFIF(warnings uint16, status uint16) error {
+	return nil
+}
+
+// WriteEOFIFAndNoFlush is called after the column definitions. The result set starts here.
+func (hp *HttpProtocolImpl) WriteEOFIFAndNoFlush(warnings uint16, status uint16) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	return hp.writer.beginResult(hp.columns)
+}
+
+// WriteEOFOrOK is called after the rows of the result set.
+func (hp *HttpProtocolImpl) WriteEOFOrOK(warnings uint16, status uint16) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	if err := hp.writer.endResult(); err != nil {
+		return err
+	}
+	return hp.flushOutput()
+}
+
+func (hp *HttpProtocolImpl) WriteERR(errorCode uint16, sqlState, errorMessage string) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	if hp.herr == nil {
+		hp.herr = &httpError{Code: errorCode, SQLState: sqlState, Message: errorMessage}
+	}
+	return nil
+}
+
+func (hp *HttpProtocolImpl) WriteLengthEncodedNumber(u uint64) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	hp.columns = hp.columns[:0]
+	return nil
+}
+
+func (hp *HttpProtocolImpl) WriteColumnDef(ctx context.Context, column Column, i int) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	hp.columns = append(hp.columns, column)
+	return nil
+}
+
+func (hp *HttpProtocolImpl) WriteColumnDefBytes(payload []byte) error {
+	return moerr.NewInternalErrorNoCtx("the column definition of mysql can not be sent by HTTP")
+}
+
+func (hp *HttpProtocolImpl) WriteRow() error {
+	return nil
+}
+
+func (hp *HttpProtocolImpl) WriteTextRow() error {
+	return nil
+}
+
+func (hp *HttpProtocolImpl) WriteBinaryRow() error {
+	return nil
+}
+
+func (hp *HttpProtocolImpl) WriteResultSetRow(mrs *MysqlResultSet, count uint64) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	for i := uint64(0); i < count; i++ {
+		if err := hp.writer.writeRow(mrs.Data[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (hp *HttpProtocolImpl) WriteResultSetRow2(mrs *MysqlResultSet, colSlices *ColumnSlices, count uint64) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	row := make([]any, len(colSlices.dataSet.Vecs))
+	for j := uint64(0); j < count; j++ {
+		if err := extractRowFromEveryVector(colSlices.ctx, hp.ses, colSlices.dataSet, int(j), row, true); err != nil {
+			return err
+		}
+		if err := hp.writer.writeRow(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (hp *HttpProtocolImpl) WriteResponse(ctx context.Context, resp *Response) error {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+
+	switch resp.category {
+	case OkResponse, EoFResponse:
+		return hp.writeStatus(resp.affectedRows)
+	case ErrorResponse:
+		err, _ := resp.data.(error)
+		if err == nil {
+			return hp.writeStatus(0)
+		}
+		if hp.herr == nil {
+			hp.herr = httpErrorOf(err, hp.username)
+		}
+		return nil
+	case ResultResponse:
+		mer := resp.data.(*MysqlExecutionResult)
+		if mer == nil || mer.Mrs() == nil {
+			var rows uint64
+			if mer != nil {
+				rows = mer.AffectedRows()
+			}
+			return hp.writeStatus(rows)
+		}
+		mrs := mer.Mrs()
+		if err := hp.writer.beginResult(mrs.Columns); err != nil {
+			return err
+		}
+		for _, row := range mrs.Data {
+			if err := hp.writer.writeRow(row); err != nil {
+				return err
+			}
+		}
+		if err := hp.writer.endResult(); err != nil {
+			return err
+		}
+		return hp.flushOutput()
+	case LocalInfileRequest:
+		return moerr.NewNotSupported(ctx, "LOAD DATA LOCAL by HTTP")
+	default:
+		return moerr.NewInternalErrorf(ctx, "unsupported response:%d ", resp.category)
+	}
+}
+
+func (hp *HttpProtocolImpl) WritePrepareResponse(ctx context.Context, stmt *PrepareStmt) error {
+	return moerr.NewNotSupported(ctx, "COM_STMT_PREPARE by HTTP")
+}
+
+func (hp *HttpProtocolImpl) WriteLocalInfileRequest(filepath string) error {
+	return moerr.NewNotSupportedNoCtx("LOAD DATA LOCAL by HTTP")
+}
+
+func (hp *HttpProtocolImpl) CalculateOutTrafficBytes(reset bool) (int64, int64) {
+	ses := hp.GetSession()
+	if ses == nil {
+		return 0, 0
+	}
+	bytes := int64(ses.GetOutputBytes()) + ses.writeCsvBytes.Load()
+	packets := ses.GetFlushPacketCnt()
+	if reset {
+		ses.ResetPacketCounter()
+	}
+	return bytes, packets
+}
+
+func (hp *HttpProtocolImpl) ResetStatistics() {}
+
+func (hp *HttpProtocolImpl) UpdateCtx(ctx context.Context) {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	hp.ctx = ctx
+}
+
+func (hp *HttpProtocolImpl) Reset(ses *Session) {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	hp.ses = ses
+}
+
+func (hp *HttpProtocolImpl) Close() {
+	hp.mu.Lock()
+	defer hp.mu.Unlock()
+	hp.ses = nil
+}
+
+func (hp *HttpProtocolImpl) Read() ([]byte, error) {
+	return nil, moerr.NewNotSupportedNoCtx("reading mysql packets by HTTP")
+}
+
+func (hp *HttpProtocolImpl) ReadLoadLocalPacket() ([]byte, error) {
+	return nil, moerr.NewNotSupportedNoCtx("LOAD DATA LOCAL by HTTP")
+}
+
+func (hp *HttpProtocolImpl) FreeLoadLocal() {}
+
+func (hp *HttpProtocolImpl) Free(buf []byte) {}
+
+func (hp *HttpProtocolImpl) HandleHandshake(ctx context.Context, payload []byte) (bool, error) {
+	return false, moerr.NewInternalError(ctx, "the mysql handshake by HTTP")
+}
+
+func (hp *HttpProtocolImpl) Authenticate(ctx context.Context) error {
+	return moerr.NewInternalError(ctx, "the mysql authentication by HTTP")
+}
+
+func (hp *HttpProtocolImpl) ParseSendLongData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error {
+	return moerr.NewNotSupported(ctx, "COM_STMT_SEND_LONG_DATA by HTTP")
+}
+
+func (hp *HttpProtocolImpl) ParseExecuteData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error {
+	return moerr.NewNotSupported(ctx, "COM_STMT_EXECUTE by HTTP")
+}
+
+// Disconnect does nothing. The http server cancels the statement when the client goes away.
+func (hp *HttpProtocolImpl) Disconnect() error {
+	return nil
+}
+
+func (hp *HttpProtocolImpl) MakeColumnDefData(ctx context.Context, columns []*planPb.ColDef) ([][]byte, error) {
+	return nil, nil
+}
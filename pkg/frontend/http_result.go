@@ -0,0 +1,791 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+)
+
+const (
+	httpFormatJSON   = "json"
+	httpFormatNDJSON = "ndjson"
+	httpFormatArrow  = "arrow"
+
+	httpContentTypeJSON   = "application/json"
+	httpContentTypeNDJSON = "application/x-ndjson"
+	httpContentTypeArrow  = "application/vnd.apache.arrow.stream"
+
+	// the rows of the result set built in memory are sent as
+	// the arrow record batches of this size.
+	httpArrowRowsPerRecord = 8192
+)
+
+// httpError is the error of the statement in the response.
+type httpError struct {
+	Code     uint16 `json:"code"`
+	SQLState string `json:"sql_state"`
+	Message  string `json:"message"`
+}
+
+func httpErrorOf(err error, username string) *httpError {
+	code, sqlState, msg := RewriteError(err, username)
+	return &httpError{Code: code, SQLState: sqlState, Message: msg}
+}
+
+// httpColumn is the description of a result column in the json formats.
+type httpColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// httpResultWriter encodes the results of the statements in an HTTP request.
+// The statements are executed one by one. Every one of them makes either
+// a result set or a status.
+type httpResultWriter interface {
+	// contentType returns the Content-Type of the response.
+	contentType() string
+	// beginResult starts a result set of the columns.
+	beginResult(columns []Column) error
+	// writeBatch writes the rows of the batch into the result set.
+	writeBatch(ctx context.Context, ses *Session, bat *batch.Batch) error
+	// writeRow writes a row built in memory, like the ones of SHOW, into the result set.
+	writeRow(row []any) error
+	// endResult ends the result set.
+	endResult() error
+	// writeStatus writes the status of the statement without the result set.
+	writeStatus(affectedRows uint64) error
+	// finish ends the response. herr is nil if all the statements succeed.
+	finish(herr *httpError) error
+}
+
+func newHttpResultWriter(format string, w io.Writer, statementID string, connectionID uint32) httpResultWriter {
+	switch format {
+	case httpFormatNDJSON:
+		return &ndjsonResultWriter{w: w, statementID: statementID, connectionID: connectionID}
+	case httpFormatArrow:
+		return &arrowResultWriter{w: w}
+	default:
+		return &jsonResultWriter{w: w, statementID: statementID, connectionID: connectionID}
+	}
+}
+
+// httpEngineTypeOf returns the type of the computation engine of the column.
+// The columns built without it, like the ones of SHOW, get the one
+// corresponding to the mysql type.
+func httpEngineTypeOf(col Column) types.Type {
+	mc, ok := col.(*MysqlColumn)
+	if ok && mc.engineType.Oid != types.T_any {
+		return mc.engineType
+	}
+	binary := ok && mc.Charset() == 0x3f
+	signed := col.IsSigned()
+	pick := func(s, u types.T) types.Type {
+		if signed {
+			return s.ToType()
+		}
+		return u.ToType()
+	}
+	switch col.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		return types.T_bool.ToType()
+	case defines.MYSQL_TYPE_TINY:
+		return pick(types.T_int8, types.T_uint8)
+	case defines.MYSQL_TYPE_SHORT:
+		return pick(types.T_int16, types.T_uint16)
+	case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+		return pick(types.T_int32, types.T_uint32)
+	case defines.MYSQL_TYPE_LONGLONG:
+		return pick(types.T_int64, types.T_uint64)
+	case defines.MYSQL_TYPE_YEAR:
+		return types.T_year.ToType()
+	case defines.MYSQL_TYPE_BIT:
+		return types.T_bit.ToType()
+	case defines.MYSQL_TYPE_FLOAT:
+		return types.T_float32.ToType()
+	case defines.MYSQL_TYPE_DOUBLE:
+		return types.T_float64.ToType()
+	case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL:
+		return types.T_decimal128.ToType()
+	case defines.MYSQL_TYPE_DATE:
+		return types.T_date.ToType()
+	case defines.MYSQL_TYPE_DATETIME:
+		return types.T_datetime.ToType()
+	case defines.MYSQL_TYPE_TIMESTAMP:
+		return types.T_timestamp.ToType()
+	case defines.MYSQL_TYPE_TIME:
+		return types.T_time.ToType()
+	case defines.MYSQL_TYPE_JSON:
+		return types.T_json.ToType()
+	case defines.MYSQL_TYPE_UUID:
+		return types.T_uuid.ToType()
+	case defines.MYSQL_TYPE_ENUM:
+		return types.T_enum.ToType()
+	case defines.MYSQL_TYPE_TEXT:
+		return types.T_text.ToType()
+	case defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB:
+		if binary {
+			return types.T_blob.ToType()
+		}
+		return types.T_text.ToType()
+	default:
+		if binary {
+			return types.T_varbinary.ToType()
+		}
+		return types.T_varchar.ToType()
+	}
+}
+
+func httpIsBinaryType(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return true
+	}
+	return false
+}
+
+// httpTypeNameOf returns the name of the type of the column. The width is
+// unknown for the columns without the type of the engine.
+func httpTypeNameOf(col Column) string {
+	typ := httpEngineTypeOf(col)
+	if mc, ok := col.(*MysqlColumn); ok && mc.engineType.Oid != types.T_any {
+		return typ.DescString()
+	}
+	return typ.Oid.String()
+}
+
+func httpColumnsOf(columns []Column) []httpColumn {
+	cols := make([]httpColumn, len(columns))
+	for i, col := range columns {
+		cols[i] = httpColumn{Name: col.Name(), Type: httpTypeNameOf(col)}
+	}
+	return cols
+}
+
+// httpJSONValueOf converts the value of a result row into the one encoded in json.
+// The binary strings are encoded in base64. The decimals, the date and time types
+// and the other ones without the counterpart in json are the strings.
+func httpJSONValueOf(typ types.Type, v any) any {
+	switch x := v.(type) {
+	case nil:
+		return nil
+	case []byte:
+		if httpIsBinaryType(typ) {
+			return base64.StdEncoding.EncodeToString(x)
+		}
+		return string(x)
+	case float32:
+		if math.IsNaN(float64(x)) || math.IsInf(float64(x), 0) {
+			return pgTextOf(x)
+		}
+		return x
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return pgTextOf(x)
+		}
+		return x
+	case bool, string, int8, int16, int32, int64, int, uint8, uint16, uint32, uint64, []float32, []float64:
+		return x
+	case types.MoYear:
+		return int64(x)
+	default:
+		return pgTextOf(x)
+	}
+}
+
+// jsonRowEncoder encodes the rows of a result set as json arrays.
+type jsonRowEncoder struct {
+	types []types.Type
+	row   []any
+	vals  []any
+}
+
+func (je *jsonRowEncoder) reset(columns []Column) {
+	je.types = je.types[:0]
+	for _, col := range columns {
+		je.types = append(je.types, httpEngineTypeOf(col))
+	}
+}
+
+func (je *jsonRowEncoder) encode(row []any) ([]byte, error) {
+	je.vals = je.vals[:0]
+	for i, v := range row {
+		var typ types.Type
+		if i < len(je.types) {
+			typ = je.types[i]
+		}
+		je.vals = append(je.vals, httpJSONValueOf(typ, v))
+	}
+	return json.Marshal(je.vals)
+}
+
+// forEachRow calls fn with every row of the batch.
+func (je *jsonRowEncoder) forEachRow(ctx context.Context, ses *Session, bat *batch.Batch, fn func([]any) error) error {
+	n := bat.RowCount()
+	if cap(je.row) < len(bat.Vecs) {
+		je.row = make([]any, len(bat.Vecs))
+	}
+	row := je.row[:len(bat.Vecs)]
+	for j := 0; j < n; j++ {
+		if err := extractRowFromEveryVector(ctx, ses, bat, j, row, true); err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+jsonResultWriter writes the response as a json object:
+
+	{
+	  "statement_id": "...",
+	  "connection_id": 1001,
+	  "results": [
+	    {"columns": [{"name": "a", "type": "BIGINT"}], "rows": [[1], [2]], "row_count": 2},
+	    {"affected_rows": 3}
+	  ],
+	  "error": {"code": 1064, "sql_state": "42000", "message": "..."}
+	}
+
+The error is absent if all the statements succeed.
+*/
+type jsonResultWriter struct {
+	w            io.Writer
+	statementID  string
+	connectionID uint32
+
+	started  bool
+	results  int
+	inResult bool
+	rows     uint64
+	enc      jsonRowEncoder
+	buf      bytes.Buffer
+}
+
+func (jw *jsonResultWriter) contentType() string {
+	return httpContentTypeJSON
+}
+
+func (jw *jsonResultWriter) start() error {
+	if jw.started {
+		return nil
+	}
+	jw.started = true
+	head, err := json.Marshal(struct {
+		StatementID  string `json:"statement_id"`
+		ConnectionID uint32 `json:"connection_id"`
+	}{jw.statementID, jw.connectionID})
+	if err != nil {
+		return err
+	}
+	jw.buf.Reset()
+	jw.buf.Write(head[:len(head)-1])
+	jw.buf.WriteString(`,"results":[`)
+	_, err = jw.w.Write(jw.buf.Bytes())
+	return err
+}
+
+// nextResult starts the next item of the results.
+func (jw *jsonResultWriter) nextResult() error {
+	if err := jw.start(); err != nil {
+		return err
+	}
+	jw.results++
+	if jw.results > 1 {
+		_, err := io.WriteString(jw.w, ",")
+		return err
+	}
+	return nil
+}
+
+func (jw *jsonResultWriter) beginResult(columns []Column) error {
+	if err := jw.nextResult(); err != nil {
+		return err
+	}
+	cols, err := json.Marshal(httpColumnsOf(columns))
+	if err != nil {
+		return err
+	}
+	jw.enc.reset(columns)
+	jw.inResult = true
+	jw.rows = 0
+	jw.buf.Reset()
+	jw.buf.WriteString(`{"columns":`)
+	jw.buf.Write(cols)
+	jw.buf.WriteString(`,"rows":[`)
+	_, err = jw.w.Write(jw.buf.Bytes())
+	return err
+}
+
+func (jw *jsonResultWriter) writeBatch(ctx context.Context, ses *Session, bat *batch.Batch) error {
+	return jw.enc.forEachRow(ctx, ses, bat, jw.writeRow)
+}
+
+func (jw *jsonResultWriter) writeRow(row []any) error {
+	data, err := jw.enc.encode(row)
+	if err != nil {
+		return err
+	}
+	jw.buf.Reset()
+	if jw.rows > 0 {
+		jw.buf.WriteByte(',')
+	}
+	jw.buf.Write(data)
+	jw.rows++
+	_, err = jw.w.Write(jw.buf.Bytes())
+	return err
+}
+
+func (jw *jsonResultWriter) endResult() error {
+	if !jw.inResult {
+		return nil
+	}
+	jw.inResult = false
+	_, err := io.WriteString(jw.w, `],"row_count":`+strconv.FormatUint(jw.rows, 10)+`}`)
+	return err
+}
+
+func (jw *jsonResultWriter) writeStatus(affectedRows uint64) error {
+	if err := jw.nextResult(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(jw.w, `{"affected_rows":`+strconv.FormatUint(affectedRows, 10)+`}`)
+	return err
+}
+
+func (jw *jsonResultWriter) finish(herr *httpError) error {
+	if err := jw.start(); err != nil {
+		return err
+	}
+	// the result set broken by the error
+	if err := jw.endResult(); err != nil {
+		return err
+	}
+	jw.buf.Reset()
+	jw.buf.WriteByte(']')
+	if herr != nil {
+		data, err := json.Marshal(herr)
+		if err != nil {
+			return err
+		}
+		jw.buf.WriteString(`,"error":`)
+		jw.buf.Write(data)
+	}
+	jw.buf.WriteString("}\n")
+	_, err := jw.w.Write(jw.buf.Bytes())
+	return err
+}
+
+/*
+ndjsonResultWriter writes the response as a json object per line:
+
+	{"statement_id":"...","connection_id":1001}
+	{"columns":[{"name":"a","type":"BIGINT"}]}
+	{"row":[1]}
+	{"row":[2]}
+	{"row_count":2}
+	{"affected_rows":3}
+	{"error":{"code":1064,"sql_state":"42000","message":"..."}}
+
+The error line is absent if all the statements succeed.
+*/
+type ndjsonResultWriter struct {
+	w            io.Writer
+	statementID  string
+	connectionID uint32
+
+	started  bool
+	inResult bool
+	rows     uint64
+	enc      jsonRowEncoder
+	buf      bytes.Buffer
+}
+
+func (nw *ndjsonResultWriter) contentType() string {
+	return httpContentTypeNDJSON
+}
+
+func (nw *ndjsonResultWriter) writeLine(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	nw.buf.Reset()
+	nw.buf.Write(data)
+	nw.buf.WriteByte('\n')
+	_, err = nw.w.Write(nw.buf.Bytes())
+	return err
+}
+
+func (nw *ndjsonResultWriter) start() error {
+	if nw.started {
+		return nil
+	}
+	nw.started = true
+	return nw.writeLine(struct {
+		StatementID  string `json:"statement_id"`
+		ConnectionID uint32 `json:"connection_id"`
+	}{nw.statementID, nw.connectionID})
+}
+
+func (nw *ndjsonResultWriter) beginResult(columns []Column) error {
+	if err := nw.start(); err != nil {
+		return err
+	}
+	nw.enc.reset(columns)
+	nw.inResult = true
+	nw.rows = 0
+	return nw.writeLine(struct {
+		Columns []httpColumn `json:"columns"`
+	}{httpColumnsOf(columns)})
+}
+
+func (nw *ndjsonResultWriter) writeBatch(ctx context.Context, ses *Session, bat *batch.Batch) error {
+	return nw.enc.forEachRow(ctx, ses, bat, nw.writeRow)
+}
+
+func (nw *ndjsonResultWriter) writeRow(row []any) error {
+	data, err := nw.enc.encode(row)
+	if err != nil {
+		return err
+	}
+	nw.buf.Reset()
+	nw.buf.WriteString(`{"row":`)
+	nw.buf.Write(data)
+	nw.buf.WriteString("}\n")
+	nw.rows++
+	_, err = nw.w.Write(nw.buf.Bytes())
+	return err
+}
+
+func (nw *ndjsonResultWriter) endResult() error {
+	if !nw.inResult {
+		return nil
+	}
+	nw.inResult = false
+	return nw.writeLine(struct {
+		RowCount uint64 `json:"row_count"`
+	}{nw.rows})
+}
+
+func (nw *ndjsonResultWriter) writeStatus(affectedRows uint64) error {
+	if err := nw.start(); err != nil {
+		return err
+	}
+	return nw.writeLine(struct {
+		AffectedRows uint64 `json:"affected_rows"`
+	}{affectedRows})
+}
+
+func (nw *ndjsonResultWriter) finish(herr *httpError) error {
+	if err := nw.start(); err != nil {
+		return err
+	}
+	if herr == nil {
+		return nil
+	}
+	return nw.writeLine(struct {
+		Error *httpError `json:"error"`
+	}{herr})
+}
+
+// arrowResultWriter writes every result set as an Arrow IPC stream.
+// The streams of the statements are concatenated in the response.
+// The statements without the result set write nothing, their affected
+// rows and the error are sent in the headers by the caller.
+type arrowResultWriter struct {
+	w io.Writer
+
+	columns []Column
+	schema  *arrow.Schema
+	writer  *ipc.Writer
+	// the columns which are not supported by the arrow export are sent as strings
+	textColumns []bool
+	textRow     []any
+	// the builder of the rows built in memory
+	builder *array.RecordBuilder
+	pending int
+}
+
+func (aw *arrowResultWriter) contentType() string {
+	return httpContentTypeArrow
+}
+
+func (aw *arrowResultWriter) beginResult(columns []Column) error {
+	aw.columns = columns
+	aw.schema = nil
+	aw.writer = nil
+	aw.textColumns = nil
+	aw.releaseBuilder()
+	return nil
+}
+
+func (aw *arrowResultWriter) releaseBuilder() {
+	if aw.builder != nil {
+		aw.builder.Release()
+		aw.builder = nil
+	}
+	aw.pending = 0
+}
+
+func (aw *arrowResultWriter) columnName(i int) string {
+	if i < len(aw.columns) {
+		return aw.columns[i].Name()
+	}
+	return "col" + strconv.Itoa(i)
+}
+
+// httpArrowTypeOfRow maps the type of the column built in memory to the arrow type.
+// The values of these rows are the go values, so only the numbers and the binary
+// strings keep their types.
+func httpArrowTypeOfRow(typ types.Type) arrow.DataType {
+	switch typ.Oid {
+	case types.T_bool:
+		return arrow.FixedWidthTypes.Boolean
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_year:
+		return arrow.PrimitiveTypes.Int64
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_bit:
+		return arrow.PrimitiveTypes.Uint64
+	case types.T_float32:
+		return arrow.PrimitiveTypes.Float32
+	case types.T_float64:
+		return arrow.PrimitiveTypes.Float64
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return arrow.BinaryTypes.Binary
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+func (aw *arrowResultWriter) open(schema *arrow.Schema) {
+	aw.schema = schema
+	aw.writer = ipc.NewWriter(aw.w, ipc.WithSchema(schema))
+}
+
+// rowSchema is the schema of the result set without any batch.
+func (aw *arrowResultWriter) rowSchema() *arrow.Schema {
+	fields := make([]arrow.Field, len(aw.columns))
+	for i, col := range aw.columns {
+		fields[i] = arrow.Field{Name: col.Name(), Type: httpArrowTypeOfRow(httpEngineTypeOf(col)), Nullable: true}
+	}
+	return arrow.NewSchema(fields, nil)
+}
+
+func (aw *arrowResultWriter) writeBatch(ctx context.Context, ses *Session, bat *batch.Batch) error {
+	if aw.builder != nil {
+		return moerr.NewInternalError(ctx, "arrow result set mixes the batches and the rows")
+	}
+	if aw.schema == nil {
+		fields := make([]arrow.Field, len(bat.Vecs))
+		aw.textColumns = make([]bool, len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			dt, err := buildArrowType(ctx, vec.GetType())
+			if err != nil {
+				dt = arrow.BinaryTypes.String
+				aw.textColumns[i] = true
+			}
+			fields[i] = arrow.Field{Name: aw.columnName(i), Type: dt, Nullable: true}
+		}
+		aw.open(arrow.NewSchema(fields, nil))
+	}
+	if len(bat.Vecs) != len(aw.schema.Fields()) {
+		return moerr.NewInternalErrorf(ctx, "arrow result set expects %d columns, got %d", len(aw.schema.Fields()), len(bat.Vecs))
+	}
+	n := bat.RowCount()
+	if n == 0 {
+		return nil
+	}
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, aw.schema)
+	defer builder.Release()
+	if cap(aw.textRow) < len(bat.Vecs) {
+		aw.textRow = make([]any, len(bat.Vecs))
+	}
+	for i, vec := range bat.Vecs {
+		b := builder.Field(i)
+		if !aw.textColumns[i] {
+			dt, err := buildArrowType(ctx, vec.GetType())
+			if err != nil {
+				return err
+			}
+			if !arrow.TypeEqual(dt, aw.schema.Field(i).Type) {
+				return moerr.NewInternalErrorf(ctx, "the type of the column %s changes in the arrow result set", aw.schema.Field(i).Name)
+			}
+			if err = appendVectorToArrow(ctx, b, vec, n); err != nil {
+				return err
+			}
+			continue
+		}
+		sb := b.(*array.StringBuilder)
+		for j := 0; j < n; j++ {
+			if vec.IsNull(uint64(j)) {
+				sb.AppendNull()
+				continue
+			}
+			rowIndex := j
+			if vec.IsConst() {
+				rowIndex = 0
+			}
+			if err := extractRowFromVector(ctx, ses, vec, i, aw.textRow, rowIndex, true); err != nil {
+				return err
+			}
+			sb.Append(pgTextOf(aw.textRow[i]))
+		}
+	}
+	rec := builder.NewRecord()
+	defer rec.Release()
+	return aw.writer.Write(rec)
+}
+
+func (aw *arrowResultWriter) writeRow(row []any) error {
+	if aw.schema == nil {
+		aw.open(aw.rowSchema())
+	}
+	if aw.builder == nil {
+		if aw.textColumns != nil {
+			return moerr.NewInternalErrorNoCtx("arrow result set mixes the batches and the rows")
+		}
+		aw.builder = array.NewRecordBuilder(memory.DefaultAllocator, aw.schema)
+	}
+	if len(row) != len(aw.schema.Fields()) {
+		return moerr.NewInternalErrorNoCtxf("arrow result set expects %d columns, got %d", len(aw.schema.Fields()), len(row))
+	}
+	for i, v := range row {
+		if err := appendValueToArrow(aw.builder.Field(i), v); err != nil {
+			return err
+		}
+	}
+	aw.pending++
+	if aw.pending >= httpArrowRowsPerRecord {
+		return aw.flushRows()
+	}
+	return nil
+}
+
+func (aw *arrowResultWriter) flushRows() error {
+	if aw.builder == nil || aw.pending == 0 {
+		return nil
+	}
+	aw.pending = 0
+	rec := aw.builder.NewRecord()
+	defer rec.Release()
+	return aw.writer.Write(rec)
+}
+
+// appendValueToArrow appends the go value of a row built in memory to
+// the builder of the type returned by httpArrowTypeOfRow.
+func appendValueToArrow(b array.Builder, v any) error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+	switch ab := b.(type) {
+	case *array.BooleanBuilder:
+		if x, ok := v.(bool); ok {
+			ab.Append(x)
+			return nil
+		}
+		i, err := pgInt64Of(v)
+		if err != nil {
+			return err
+		}
+		ab.Append(i != 0)
+	case *array.Int64Builder:
+		i, err := pgInt64Of(v)
+		if err != nil {
+			return err
+		}
+		ab.Append(i)
+	case *array.Uint64Builder:
+		u, err := strconv.ParseUint(pgTextOf(v), 10, 64)
+		if err != nil {
+			return err
+		}
+		ab.Append(u)
+	case *array.Float32Builder:
+		if x, ok := v.(float32); ok {
+			ab.Append(x)
+			return nil
+		}
+		f, err := strconv.ParseFloat(pgTextOf(v), 32)
+		if err != nil {
+			return err
+		}
+		ab.Append(float32(f))
+	case *array.Float64Builder:
+		if x, ok := v.(float64); ok {
+			ab.Append(x)
+			return nil
+		}
+		f, err := strconv.ParseFloat(pgTextOf(v), 64)
+		if err != nil {
+			return err
+		}
+		ab.Append(f)
+	case *array.BinaryBuilder:
+		if x, ok := v.([]byte); ok {
+			ab.Append(x)
+			return nil
+		}
+		ab.AppendString(pgTextOf(v))
+	case *array.StringBuilder:
+		ab.Append(pgTextOf(v))
+	default:
+		return moerr.NewInternalErrorNoCtxf("unsupported arrow builder %T", b)
+	}
+	return nil
+}
+
+func (aw *arrowResultWriter) endResult() error {
+	if err := aw.flushRows(); err != nil {
+		return err
+	}
+	aw.releaseBuilder()
+	if aw.writer == nil {
+		aw.open(aw.rowSchema())
+	}
+	err := aw.writer.Close()
+	aw.writer = nil
+	aw.columns = nil
+	return err
+}
+
+func (aw *arrowResultWriter) writeStatus(affectedRows uint64) error {
+	return nil
+}
+
+func (aw *arrowResultWriter) finish(herr *httpError) error {
+	// the result set broken by the error is left without the end of the stream
+	aw.releaseBuilder()
+	return nil
+}
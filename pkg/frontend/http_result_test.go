@@ -0,0 +1,205 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+)
+
+func newHttpTestColumns() []Column {
+	a := &MysqlColumn{}
+	a.SetName("a")
+	a.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	a.SetSigned(true)
+	b := &MysqlColumn{}
+	b.SetName("b")
+	b.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	c := &MysqlColumn{}
+	c.SetName("c")
+	c.SetColumnType(defines.MYSQL_TYPE_BLOB)
+	c.SetCharset(0x3f)
+	return []Column{a, b, c}
+}
+
+func Test_httpEngineTypeOf(t *testing.T) {
+	cols := newHttpTestColumns()
+	require.Equal(t, types.T_int64, httpEngineTypeOf(cols[0]).Oid)
+	require.Equal(t, types.T_varchar, httpEngineTypeOf(cols[1]).Oid)
+	require.Equal(t, types.T_blob, httpEngineTypeOf(cols[2]).Oid)
+
+	col := &MysqlColumn{}
+	col.SetColumnType(defines.MYSQL_TYPE_TINY)
+	require.Equal(t, types.T_uint8, httpEngineTypeOf(col).Oid)
+	require.Equal(t, "TINYINT UNSIGNED", httpTypeNameOf(col))
+
+	// the type of the engine is preferred
+	col.engineType = types.New(types.T_decimal64, 10, 2)
+	require.Equal(t, types.T_decimal64, httpEngineTypeOf(col).Oid)
+	require.Equal(t, "DECIMAL(10,2)", httpTypeNameOf(col))
+}
+
+func Test_httpJSONValueOf(t *testing.T) {
+	require.Nil(t, httpJSONValueOf(types.T_int64.ToType(), nil))
+	require.Equal(t, int64(1), httpJSONValueOf(types.T_int64.ToType(), int64(1)))
+	require.Equal(t, "abc", httpJSONValueOf(types.T_varchar.ToType(), []byte("abc")))
+	require.Equal(t, "AQI=", httpJSONValueOf(types.T_blob.ToType(), []byte{1, 2}))
+	require.Equal(t, "NaN", httpJSONValueOf(types.T_float64.ToType(), math.NaN()))
+	require.Equal(t, int64(2024), httpJSONValueOf(types.T_year.ToType(), types.MoYear(2024)))
+	require.Equal(t, "12.50", httpJSONValueOf(types.T_decimal64.ToType(), "12.50"))
+}
+
+func Test_jsonResultWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := newHttpResultWriter(httpFormatJSON, &buf, "id1", 1001)
+	require.Equal(t, httpContentTypeJSON, w.contentType())
+
+	require.NoError(t, w.beginResult(newHttpTestColumns()))
+	require.NoError(t, w.writeRow([]any{int64(1), "x", []byte{0xff}}))
+	require.NoError(t, w.writeRow([]any{nil, []byte("y"), nil}))
+	require.NoError(t, w.endResult())
+	require.NoError(t, w.writeStatus(3))
+	require.NoError(t, w.beginResult(newHttpTestColumns()))
+	// the result set is broken by the error
+	require.NoError(t, w.finish(&httpError{Code: 1064, SQLState: "42000", Message: "syntax error"}))
+
+	var resp struct {
+		StatementID  string `json:"statement_id"`
+		ConnectionID uint32 `json:"connection_id"`
+		Results      []struct {
+			Columns      []httpColumn `json:"columns"`
+			Rows         [][]any      `json:"rows"`
+			RowCount     *uint64      `json:"row_count"`
+			AffectedRows *uint64      `json:"affected_rows"`
+		} `json:"results"`
+		Error *httpError `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp), buf.String())
+	require.Equal(t, "id1", resp.StatementID)
+	require.Equal(t, uint32(1001), resp.ConnectionID)
+	require.Len(t, resp.Results, 3)
+	require.Equal(t, []httpColumn{{"a", "BIGINT"}, {"b", "VARCHAR"}, {"c", "BLOB"}}, resp.Results[0].Columns)
+	require.Equal(t, [][]any{{float64(1), "x", "/w=="}, {nil, "y", nil}}, resp.Results[0].Rows)
+	require.Equal(t, uint64(2), *resp.Results[0].RowCount)
+	require.Equal(t, uint64(3), *resp.Results[1].AffectedRows)
+	require.Empty(t, resp.Results[2].Rows)
+	require.Equal(t, uint16(1064), resp.Error.Code)
+
+	// no statement
+	buf.Reset()
+	w = newHttpResultWriter(httpFormatJSON, &buf, "id2", 1002)
+	require.NoError(t, w.finish(nil))
+	require.JSONEq(t, `{"statement_id":"id2","connection_id":1002,"results":[]}`, buf.String())
+}
+
+func Test_ndjsonResultWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := newHttpResultWriter(httpFormatNDJSON, &buf, "id1", 1001)
+	require.Equal(t, httpContentTypeNDJSON, w.contentType())
+
+	require.NoError(t, w.beginResult(newHttpTestColumns()[:2]))
+	require.NoError(t, w.writeRow([]any{int64(1), "x"}))
+	require.NoError(t, w.endResult())
+	require.NoError(t, w.writeStatus(2))
+	require.NoError(t, w.finish(&httpError{Code: 1146, SQLState: "42S02", Message: "no such table"}))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, []string{
+		`{"statement_id":"id1","connection_id":1001}`,
+		`{"columns":[{"name":"a","type":"BIGINT"},{"name":"b","type":"VARCHAR"}]}`,
+		`{"row":[1,"x"]}`,
+		`{"row_count":1}`,
+		`{"affected_rows":2}`,
+		`{"error":{"code":1146,"sql_state":"42S02","message":"no such table"}}`,
+	}, lines)
+}
+
+func Test_arrowResultWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := newHttpResultWriter(httpFormatArrow, &buf, "id1", 1001)
+	require.Equal(t, httpContentTypeArrow, w.contentType())
+
+	require.NoError(t, w.beginResult(newHttpTestColumns()))
+	require.NoError(t, w.writeRow([]any{int64(1), "x", []byte{1}}))
+	require.NoError(t, w.writeRow([]any{nil, []byte("y"), nil}))
+	require.NoError(t, w.endResult())
+	// the statement without the result set writes nothing
+	require.NoError(t, w.writeStatus(1))
+	// the empty result set is a stream with the schema only
+	require.NoError(t, w.beginResult(newHttpTestColumns()[:1]))
+	require.NoError(t, w.endResult())
+	require.NoError(t, w.finish(nil))
+
+	rd, err := ipc.NewReader(&buf)
+	require.NoError(t, err)
+	schema := rd.Schema()
+	require.Equal(t, "a", schema.Field(0).Name)
+	require.True(t, arrow.TypeEqual(arrow.PrimitiveTypes.Int64, schema.Field(0).Type))
+	require.True(t, arrow.TypeEqual(arrow.BinaryTypes.String, schema.Field(1).Type))
+	require.True(t, arrow.TypeEqual(arrow.BinaryTypes.Binary, schema.Field(2).Type))
+	require.True(t, rd.Next())
+	rec := rd.Record()
+	require.Equal(t, int64(2), rec.NumRows())
+	ints := rec.Column(0).(*array.Int64)
+	require.Equal(t, int64(1), ints.Value(0))
+	require.True(t, ints.IsNull(1))
+	require.Equal(t, "y", rec.Column(1).(*array.String).Value(1))
+	require.Equal(t, []byte{1}, rec.Column(2).(*array.Binary).Value(0))
+	require.False(t, rd.Next())
+	require.NoError(t, rd.Err())
+	rd.Release()
+
+	rd, err = ipc.NewReader(&buf)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rd.Schema().Fields()))
+	require.False(t, rd.Next())
+	rd.Release()
+	require.Zero(t, buf.Len())
+}
+
+func Test_appendValueToArrow(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "b", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+		{Name: "u", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+		{Name: "f", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	}, nil)
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+
+	require.NoError(t, appendValueToArrow(builder.Field(0), int8(1)))
+	require.NoError(t, appendValueToArrow(builder.Field(1), "18446744073709551615"))
+	require.NoError(t, appendValueToArrow(builder.Field(2), "1.5"))
+	require.NoError(t, appendValueToArrow(builder.Field(2), nil))
+	require.Error(t, appendValueToArrow(builder.Field(1), "x"))
+
+	rec := builder.NewRecord()
+	defer rec.Release()
+	require.True(t, rec.Column(0).(*array.Boolean).Value(0))
+	require.Equal(t, uint64(math.MaxUint64), rec.Column(1).(*array.Uint64).Value(0))
+	require.Equal(t, 1.5, rec.Column(2).(*array.Float64).Value(0))
+	require.True(t, rec.Column(2).IsNull(1))
+}
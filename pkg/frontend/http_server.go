@@ -0,0 +1,788 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+)
+
+/*
+The HTTP SQL endpoint.
+
+	POST   /v1/sql       executes the statements
+	GET    /v1/sql/{id}  gets the status or the result of the async statement
+	DELETE /v1/sql/{id}  cancels the statement, or drops the result of the async one
+
+The client authenticates with HTTP Basic. The user name is the one of the mysql
+protocol with '#' as the delimiter, like account#user#role, because HTTP Basic
+does not allow ':' in it.
+
+The body of the POST is a json object:
+
+	{"sql": "select 1; select 2", "database": "db", "format": "json", "timeout": "30s", "async": false}
+
+or the statements in plain text, with the other fields in the query parameters.
+The format is json, ndjson or arrow. The Accept header chooses it if it is not
+specified.
+
+Every request runs in a routine and a session of its own like a connection,
+so the account, the user and the role are checked as usual, the statements are
+shown in the processlist and KILL QUERY works with the connection id. They are
+released after the statements, so the session variables and the transactions
+do not live across the requests.
+
+The response of the statements without async is streamed. The affected rows and
+the error are sent in the trailers after the body, or in the headers if nothing
+of the body has been sent. The async statement returns the statement id at once.
+Its response is kept in memory until it is dropped or expires. The async
+statements kept of a user and of the server are limited, the POST beyond the
+limits gets 429.
+*/
+
+const (
+	httpSqlPath = "/v1/sql"
+
+	httpHeaderStatementID  = "X-Mo-Statement-Id"
+	httpHeaderConnectionID = "X-Mo-Connection-Id"
+	httpHeaderStatus       = "X-Mo-Status"
+	httpHeaderAffectedRows = "X-Mo-Affected-Rows"
+	httpHeaderErrorCode    = "X-Mo-Error-Code"
+	httpHeaderSQLState     = "X-Mo-Sql-State"
+	httpHeaderErrorMessage = "X-Mo-Error-Message"
+
+	// the limit of the body of the request
+	httpMaxRequestSize = 16 << 20
+	// the limit of the response of the async statement kept in memory
+	httpAsyncResultLimit = 64 << 20
+	// the response of the async statement is dropped after that
+	httpAsyncResultTTL = 10 * time.Minute
+	// the expired async statements are dropped at the interval
+	httpAsyncSweepInterval = time.Minute
+	// the async statements kept for a user and for the server
+	httpAsyncUserLimit  = 16
+	httpAsyncTotalLimit = 256
+	// the longest wait of the GET for the async statement
+	httpMaxPollWait = time.Minute
+)
+
+const (
+	httpStatusRunning   = "running"
+	httpStatusSucceeded = "succeeded"
+	httpStatusFailed    = "failed"
+	httpStatusCanceled  = "canceled"
+	httpStatusTimedOut  = "timed_out"
+)
+
+// httpResultHeaders are sent in the trailers after the streamed body.
+var httpResultHeaders = []string{
+	httpHeaderAffectedRows,
+	httpHeaderErrorCode,
+	httpHeaderSQLState,
+	httpHeaderErrorMessage,
+}
+
+// httpSqlRequest is the request of the POST.
+type httpSqlRequest struct {
+	SQL      string `json:"sql"`
+	Database string `json:"database"`
+	Format   string `json:"format"`
+	// Timeout is a duration like 30s. The statements are killed after it.
+	Timeout string `json:"timeout"`
+	Async   bool   `json:"async"`
+
+	timeout time.Duration
+}
+
+func parseHttpSqlRequest(r *http.Request) (*httpSqlRequest, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, httpMaxRequestSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > httpMaxRequestSize {
+		return nil, moerr.NewInvalidInputNoCtxf("the body of the request exceeds %d bytes", httpMaxRequestSize)
+	}
+
+	req := &httpSqlRequest{}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == httpContentTypeJSON {
+		if err = json.Unmarshal(body, req); err != nil {
+			return nil, moerr.NewInvalidInputNoCtxf("invalid json body: %v", err)
+		}
+	} else {
+		req.SQL = string(body)
+	}
+
+	q := r.URL.Query()
+	if req.Database == "" {
+		req.Database = q.Get("database")
+	}
+	if req.Format == "" {
+		req.Format = q.Get("format")
+	}
+	if req.Timeout == "" {
+		req.Timeout = q.Get("timeout")
+	}
+	if !req.Async && q.Has("async") {
+		if req.Async, err = strconv.ParseBool(q.Get("async")); err != nil {
+			return nil, moerr.NewInvalidInputNoCtxf("invalid async %s", q.Get("async"))
+		}
+	}
+
+	if isEmptyQuery(req.SQL) {
+		return nil, moerr.NewInvalidInputNoCtx("no statement in the request")
+	}
+	if req.Format == "" {
+		req.Format = httpFormatOfAccept(r.Header.Get("Accept"))
+	}
+	switch req.Format {
+	case httpFormatJSON, httpFormatNDJSON, httpFormatArrow:
+	default:
+		return nil, moerr.NewInvalidInputNoCtxf("unsupported format %s", req.Format)
+	}
+	if req.Timeout != "" {
+		req.timeout, err = time.ParseDuration(req.Timeout)
+		if err != nil || req.timeout < 0 {
+			return nil, moerr.NewInvalidInputNoCtxf("invalid timeout %s", req.Timeout)
+		}
+	}
+	return req, nil
+}
+
+// httpFormatOfAccept returns the first format in the Accept header that is supported.
+func httpFormatOfAccept(accept string) string {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case httpContentTypeArrow:
+			return httpFormatArrow
+		case httpContentTypeNDJSON, "application/ndjson":
+			return httpFormatNDJSON
+		case httpContentTypeJSON:
+			return httpFormatJSON
+		}
+	}
+	return httpFormatJSON
+}
+
+// httpStatementStatus is the status of the statement in the response of GET and DELETE.
+type httpStatementStatus struct {
+	StatementID  string     `json:"statement_id"`
+	ConnectionID uint32     `json:"connection_id"`
+	Status       string     `json:"status"`
+	AffectedRows uint64     `json:"affected_rows"`
+	Error        *httpError `json:"error,omitempty"`
+}
+
+// httpStatement is the statements of a POST. Only the client with the same
+// credentials can get or cancel it.
+type httpStatement struct {
+	id       string
+	connID   uint32
+	user     string
+	password [sha256.Size]byte
+	// owner is the account and the user the async statement is counted for
+	owner string
+	async bool
+
+	canceled atomic.Bool
+	timedOut atomic.Bool
+	done     chan struct{}
+
+	mu sync.Mutex
+	// rt is nil after the statements finish
+	rt           *Routine
+	status       string
+	finishedAt   time.Time
+	affectedRows uint64
+	herr         *httpError
+	contentType  string
+	body         []byte
+}
+
+func newHttpStatement(rt *Routine, user, password string) *httpStatement {
+	return &httpStatement{
+		id:       uuid.NewString(),
+		connID:   rt.getConnectionID(),
+		user:     user,
+		password: sha256.Sum256([]byte(password)),
+		done:     make(chan struct{}),
+		rt:       rt,
+		status:   httpStatusRunning,
+	}
+}
+
+func (st *httpStatement) ownedBy(user, password string) bool {
+	sum := sha256.Sum256([]byte(password))
+	return st.user == user && subtle.ConstantTimeCompare(sum[:], st.password[:]) == 1
+}
+
+// kill kills the running statements with the reason. It returns false if they have finished.
+func (st *httpStatement) kill(reason *atomic.Bool) bool {
+	st.mu.Lock()
+	rt := st.rt
+	st.mu.Unlock()
+	if rt == nil {
+		return false
+	}
+	reason.Store(true)
+	rt.killQuery(false, "")
+	return true
+}
+
+func (st *httpStatement) finish(affectedRows uint64, herr *httpError, contentType string, body []byte) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.rt = nil
+	st.affectedRows = affectedRows
+	st.herr = herr
+	st.contentType = contentType
+	st.body = body
+	switch {
+	case herr == nil:
+		st.status = httpStatusSucceeded
+	case st.timedOut.Load():
+		st.status = httpStatusTimedOut
+	case st.canceled.Load():
+		st.status = httpStatusCanceled
+	default:
+		st.status = httpStatusFailed
+	}
+	st.finishedAt = time.Now()
+	close(st.done)
+}
+
+func (st *httpStatement) state() httpStatementStatus {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return httpStatementStatus{
+		StatementID:  st.id,
+		ConnectionID: st.connID,
+		Status:       st.status,
+		AffectedRows: st.affectedRows,
+		Error:        st.herr,
+	}
+}
+
+func (st *httpStatement) result() (string, []byte) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.contentType, st.body
+}
+
+func (st *httpStatement) expired(now time.Time) bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.status != httpStatusRunning && now.Sub(st.finishedAt) > httpAsyncResultTTL
+}
+
+// httpStatementRegistry keeps the statements being executed and the async
+// ones until they are dropped or expire. The async ones are limited per user
+// and in total, as their responses are kept in memory.
+type httpStatementRegistry struct {
+	userLimit  int
+	totalLimit int
+
+	mu         sync.Mutex
+	statements map[string]*httpStatement
+	// the number of the async statements of each owner and of all
+	asyncOf map[string]int
+	async   int
+}
+
+func newHttpStatementRegistry(userLimit, totalLimit int) *httpStatementRegistry {
+	return &httpStatementRegistry{
+		userLimit:  userLimit,
+		totalLimit: totalLimit,
+		statements: make(map[string]*httpStatement),
+		asyncOf:    make(map[string]int),
+	}
+}
+
+func (reg *httpStatementRegistry) add(st *httpStatement) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.statements[st.id] = st
+}
+
+// addAsync adds the async statement of the owner. It returns false if the
+// owner or the server keeps too many of them.
+func (reg *httpStatementRegistry) addAsync(st *httpStatement, owner string) bool {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.sweepLocked(time.Now())
+	if reg.asyncOf[owner] >= reg.userLimit || reg.async >= reg.totalLimit {
+		return false
+	}
+	st.owner = owner
+	st.async = true
+	reg.asyncOf[owner]++
+	reg.async++
+	reg.statements[st.id] = st
+	return true
+}
+
+func (reg *httpStatementRegistry) get(id string) *httpStatement {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	return reg.statements[id]
+}
+
+func (reg *httpStatementRegistry) remove(id string) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.removeLocked(id)
+}
+
+// sweep drops the expired statements.
+func (reg *httpStatementRegistry) sweep(now time.Time) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.sweepLocked(now)
+}
+
+func (reg *httpStatementRegistry) sweepLocked(now time.Time) {
+	for id, st := range reg.statements {
+		if st.expired(now) {
+			reg.removeLocked(id)
+		}
+	}
+}
+
+func (reg *httpStatementRegistry) removeLocked(id string) {
+	st, ok := reg.statements[id]
+	if !ok {
+		return
+	}
+	delete(reg.statements, id)
+	if !st.async {
+		return
+	}
+	reg.async--
+	if reg.asyncOf[st.owner]--; reg.asyncOf[st.owner] <= 0 {
+		delete(reg.asyncOf, st.owner)
+	}
+}
+
+// httpAsyncBuffer keeps the response of the async statement in memory.
+type httpAsyncBuffer struct {
+	bytes.Buffer
+	limit int
+	err   error
+}
+
+func (b *httpAsyncBuffer) Write(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+	if b.Len()+len(p) > b.limit {
+		b.err = moerr.NewInternalErrorNoCtxf("the response of the async statement exceeds %d bytes", b.limit)
+		return 0, b.err
+	}
+	return b.Buffer.Write(p)
+}
+
+// httpResponseOutput streams the response to the client.
+type httpResponseOutput struct {
+	w      http.ResponseWriter
+	rc     *http.ResponseController
+	status int
+	// started is true after the headers are sent
+	started bool
+	// trailer is true if the result of the statements is sent in the trailers
+	trailer bool
+}
+
+func newHttpResponseOutput(w http.ResponseWriter) *httpResponseOutput {
+	return &httpResponseOutput{
+		w:      w,
+		rc:     http.NewResponseController(w),
+		status: http.StatusOK,
+	}
+}
+
+func (o *httpResponseOutput) Write(p []byte) (int, error) {
+	if !o.started {
+		o.start(true)
+	}
+	return o.w.Write(p)
+}
+
+// start sends the headers. The result of the statements is sent in the
+// trailers if it is unknown yet.
+func (o *httpResponseOutput) start(trailer bool) {
+	o.started = true
+	if trailer {
+		o.trailer = true
+		o.w.Header().Set("Trailer", strings.Join(httpResultHeaders, ", "))
+	}
+	o.w.WriteHeader(o.status)
+}
+
+func (o *httpResponseOutput) flush() error {
+	if !o.started {
+		return nil
+	}
+	return o.rc.Flush()
+}
+
+func httpSetResultHeader(h http.Header, affectedRows uint64, herr *httpError) {
+	h.Set(httpHeaderAffectedRows, strconv.FormatUint(affectedRows, 10))
+	if herr == nil {
+		return
+	}
+	h.Set(httpHeaderErrorCode, strconv.FormatUint(uint64(herr.Code), 10))
+	h.Set(httpHeaderSQLState, herr.SQLState)
+	// the control characters are not allowed in the header
+	h.Set(httpHeaderErrorMessage, strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return ' '
+		}
+		return r
+	}, herr.Message))
+}
+
+func httpWriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", httpContentTypeJSON)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func httpWriteError(w http.ResponseWriter, status int, herr *httpError) {
+	httpWriteJSON(w, status, struct {
+		Error *httpError `json:"error"`
+	}{herr})
+}
+
+func httpUnauthorized(w http.ResponseWriter, herr *httpError) {
+	w.Header().Set("WWW-Authenticate", `Basic realm="MatrixOne", charset="UTF-8"`)
+	httpWriteError(w, http.StatusUnauthorized, herr)
+}
+
+func (mo *MOServer) newHttpSqlServer() *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+httpSqlPath, mo.httpExecute)
+	mux.HandleFunc("GET "+httpSqlPath+"/{id}", mo.httpPoll)
+	mux.HandleFunc("DELETE "+httpSqlPath+"/{id}", mo.httpCancel)
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: getPu(mo.service).SV.ConnectTimeout.Duration,
+	}
+	if tlsConfig := mo.rm.getTlsConfig(); getPu(mo.service).SV.EnableTls && tlsConfig != nil {
+		srv.TLSConfig = tlsConfig.Clone()
+	}
+	return srv
+}
+
+func (mo *MOServer) serveHttp() {
+	defer mo.wg.Done()
+	var err error
+	if mo.httpServer.TLSConfig != nil {
+		err = mo.httpServer.ServeTLS(mo.httpListener, "", "")
+	} else {
+		err = mo.httpServer.Serve(mo.httpListener)
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logutil.Error("HTTP SQL endpoint stopped", zap.Error(err))
+	}
+}
+
+// sweepHttpStatements drops the expired async statements until ctx is done.
+func (mo *MOServer) sweepHttpStatements(ctx context.Context) {
+	defer mo.wg.Done()
+	ticker := time.NewTicker(httpAsyncSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			mo.httpStatements.sweep(now)
+		}
+	}
+}
+
+// httpExecute handles the POST.
+func (mo *MOServer) httpExecute(w http.ResponseWriter, r *http.Request) {
+	user, password, ok := r.BasicAuth()
+	if !ok {
+		httpUnauthorized(w, &httpError{Code: moerr.ER_ACCESS_DENIED_ERROR, SQLState: "28000", Message: "authentication required"})
+		return
+	}
+	req, err := parseHttpSqlRequest(r)
+	if err != nil {
+		httpWriteError(w, http.StatusBadRequest, httpErrorOf(err, user))
+		return
+	}
+
+	rt, hp, err := mo.rm.createdHttp(r.RemoteAddr, r.TLS != nil)
+	if err != nil {
+		logutil.Error("Create routine error", zap.Error(err))
+		httpWriteError(w, http.StatusInternalServerError, httpErrorOf(err, user))
+		return
+	}
+	ses := rt.getSession()
+	if err = mo.httpAuthenticate(ses, hp, user, password, req.Database); err != nil {
+		ses.Errorf(mo.rm.getCtx(), "authenticate user failed.error:%v", err)
+		mo.rm.closedHttp(rt)
+		httpUnauthorized(w, httpErrorOf(err, user))
+		return
+	}
+
+	st := newHttpStatement(rt, user, password)
+	if req.Async {
+		tenant := ses.GetTenantInfo()
+		if !mo.httpStatements.addAsync(st, tenant.GetTenant()+":"+tenant.GetUser()) {
+			mo.rm.closedHttp(rt)
+			httpWriteError(w, http.StatusTooManyRequests, &httpError{
+				Code:     moerr.ER_TOO_MANY_USER_CONNECTIONS,
+				SQLState: "42000",
+				Message:  "too many async statements are kept, get or drop the finished ones first",
+			})
+			return
+		}
+	} else {
+		mo.httpStatements.add(st)
+	}
+	w.Header().Set(httpHeaderStatementID, st.id)
+	w.Header().Set(httpHeaderConnectionID, strconv.FormatUint(uint64(st.connID), 10))
+
+	if req.Async {
+		buf := &httpAsyncBuffer{limit: httpAsyncResultLimit}
+		hp.SetOutput(req.Format, buf, st.id, nil)
+		go func() {
+			defer mo.rm.closedHttp(rt)
+			mo.httpRun(st, rt, hp, req, user)
+			affectedRows, herr := hp.Result()
+			body := []byte(nil)
+			if err := hp.Finish(); err == nil && buf.err == nil {
+				body = buf.Bytes()
+			} else if buf.err != nil {
+				// the response is incomplete, only the status is kept
+				herr = httpErrorOf(buf.err, user)
+			}
+			st.finish(affectedRows, herr, hp.ContentType(), body)
+		}()
+		w.Header().Set("Location", httpSqlPath+"/"+st.id)
+		httpWriteJSON(w, http.StatusAccepted, st.state())
+		return
+	}
+
+	defer mo.httpStatements.remove(st.id)
+	defer mo.rm.closedHttp(rt)
+	out := newHttpResponseOutput(w)
+	hp.SetOutput(req.Format, out, st.id, out.flush)
+	w.Header().Set("Content-Type", hp.ContentType())
+	// the statements are killed if the client goes away
+	stop := context.AfterFunc(r.Context(), func() {
+		st.kill(&st.canceled)
+	})
+	defer stop()
+
+	mo.httpRun(st, rt, hp, req, user)
+	affectedRows, herr := hp.Result()
+	if !out.started {
+		httpSetResultHeader(w.Header(), affectedRows, herr)
+		if herr != nil {
+			out.status = http.StatusBadRequest
+		}
+		out.start(false)
+	}
+	if err = hp.Finish(); err != nil {
+		ses.Debugf(mo.rm.getCtx(), "send the HTTP response failed. error:%v", err)
+		return
+	}
+	if out.trailer {
+		httpSetResultHeader(w.Header(), affectedRows, herr)
+	}
+}
+
+// httpRun executes the statements of the request in the routine.
+func (mo *MOServer) httpRun(st *httpStatement, rt *Routine, hp *HttpProtocolImpl, req *httpSqlRequest, user string) {
+	if req.timeout > 0 {
+		timer := time.AfterFunc(req.timeout, func() {
+			st.kill(&st.timedOut)
+		})
+		defer timer.Stop()
+	}
+
+	payload := make([]byte, 0, len(req.SQL)+1)
+	payload = append(payload, byte(COM_QUERY))
+	payload = append(payload, req.SQL...)
+	rt.setInProcessRequest(true)
+	err := rt.handleRequest(ToRequest(payload))
+	rt.setInProcessRequest(false)
+	if err != nil && !skipClientQuit(err.Error()) {
+		hp.SetError(httpErrorOf(err, user))
+	}
+
+	if st.timedOut.Load() {
+		if _, herr := hp.Result(); herr != nil {
+			herr.Message = fmt.Sprintf("the statement exceeds the timeout %s: %s", req.timeout, herr.Message)
+		}
+	}
+}
+
+// httpAuthenticate authenticates the user with the password of HTTP Basic.
+func (mo *MOServer) httpAuthenticate(ses *Session, hp *HttpProtocolImpl, user, password, dbName string) error {
+	ctx, span := trace.Start(mo.rm.getCtx(), "MOServer.httpAuthenticate",
+		trace.WithKind(trace.SpanKindStatement))
+	defer span.End()
+
+	hp.SetStr(USERNAME, user)
+	hp.SetStr(DBNAME, dbName)
+	ses.timestampMap[TSAuthenticateStart] = time.Now()
+	defer func() {
+		ses.timestampMap[TSAuthenticateEnd] = time.Now()
+		v2.AuthenticateDurationHistogram.Observe(ses.timestampMap[TSAuthenticateEnd].Sub(ses.timestampMap[TSAuthenticateStart]).Seconds())
+	}()
+
+	if getPu(mo.service).SV.SkipCheckUser {
+		ses.Debugf(ctx, "skip authenticate user")
+		tenant, err := GetTenantInfo(ctx, user)
+		if err != nil {
+			return err
+		}
+		ses.SetTenantInfo(tenant)
+	} else {
+		// SHA1(SHA1(password)) is kept for the user
+		hash := HashSha1(HashSha1([]byte(password)))
+		checkPassword := func(pwd []byte, _ []byte, _ []byte) bool {
+			return subtle.ConstantTimeCompare(pwd, hash) == 1
+		}
+		pwd, err := ses.AuthenticateUser(ctx, user, dbName, nil, nil, checkPassword)
+		if err != nil {
+			return err
+		}
+		// the special users do not check the password in AuthenticateUser
+		if !checkPassword(pwd, nil, nil) {
+			return moerr.NewInternalError(ctx, "check password failed")
+		}
+		bh := ses.GetBackgroundExec(ctx)
+		defer bh.Close()
+		if err = ses.InitSystemVariables(ctx, bh); err != nil {
+			return err
+		}
+	}
+	hp.SetBool(ESTABLISHED, true)
+
+	if dbName != "" {
+		ses.SetDatabaseName(dbName)
+	}
+	mo.rm.sessionManager.AddSession(ses)
+	return nil
+}
+
+// httpStatementOf returns the statement of the GET and the DELETE. The statement
+// of the others is reported as not found.
+func (mo *MOServer) httpStatementOf(w http.ResponseWriter, r *http.Request) (*httpStatement, bool) {
+	user, password, ok := r.BasicAuth()
+	if !ok {
+		httpUnauthorized(w, &httpError{Code: moerr.ER_ACCESS_DENIED_ERROR, SQLState: "28000", Message: "authentication required"})
+		return nil, false
+	}
+	id := r.PathValue("id")
+	st := mo.httpStatements.get(id)
+	if st == nil || !st.ownedBy(user, password) {
+		httpWriteError(w, http.StatusNotFound, &httpError{
+			Code:     moerr.ER_UNKNOWN_ERROR,
+			SQLState: DefaultMySQLState,
+			Message:  fmt.Sprintf("statement %s not found", id),
+		})
+		return nil, false
+	}
+	return st, true
+}
+
+// httpPoll handles the GET. The wait parameter is the time to wait for the statement.
+func (mo *MOServer) httpPoll(w http.ResponseWriter, r *http.Request) {
+	st, ok := mo.httpStatementOf(w, r)
+	if !ok {
+		return
+	}
+	if wait := r.URL.Query().Get("wait"); wait != "" {
+		d, err := time.ParseDuration(wait)
+		if err != nil || d < 0 {
+			httpWriteError(w, http.StatusBadRequest, httpErrorOf(moerr.NewInvalidInputNoCtxf("invalid wait %s", wait), st.user))
+			return
+		}
+		timer := time.NewTimer(min(d, httpMaxPollWait))
+		defer timer.Stop()
+		select {
+		case <-st.done:
+		case <-timer.C:
+		case <-r.Context().Done():
+		}
+	}
+
+	state := st.state()
+	h := w.Header()
+	h.Set(httpHeaderStatementID, state.StatementID)
+	h.Set(httpHeaderConnectionID, strconv.FormatUint(uint64(state.ConnectionID), 10))
+	h.Set(httpHeaderStatus, state.Status)
+	if state.Status == httpStatusRunning {
+		httpWriteJSON(w, http.StatusAccepted, state)
+		return
+	}
+	httpSetResultHeader(h, state.AffectedRows, state.Error)
+	contentType, body := st.result()
+	if body == nil {
+		httpWriteJSON(w, http.StatusOK, state)
+		return
+	}
+	h.Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+// httpCancel handles the DELETE. The running statement is killed. The result
+// of the finished one is dropped.
+func (mo *MOServer) httpCancel(w http.ResponseWriter, r *http.Request) {
+	st, ok := mo.httpStatementOf(w, r)
+	if !ok {
+		return
+	}
+	if st.kill(&st.canceled) {
+		logutil.Infof("cancel the HTTP statement %s of the connection %d", st.id, st.connID)
+		httpWriteJSON(w, http.StatusAccepted, st.state())
+		return
+	}
+	mo.httpStatements.remove(st.id)
+	httpWriteJSON(w, http.StatusOK, st.state())
+}
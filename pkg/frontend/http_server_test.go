@@ -0,0 +1,338 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func Test_parseHttpSqlRequest(t *testing.T) {
+	newRequest := func(target, contentType, body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+		if contentType != "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+		return r
+	}
+
+	req, err := parseHttpSqlRequest(newRequest("/v1/sql", "application/json; charset=utf-8",
+		`{"sql":"select 1","database":"db","format":"ndjson","timeout":"1m","async":true}`))
+	require.NoError(t, err)
+	require.Equal(t, "select 1", req.SQL)
+	require.Equal(t, "db", req.Database)
+	require.Equal(t, httpFormatNDJSON, req.Format)
+	require.Equal(t, time.Minute, req.timeout)
+	require.True(t, req.Async)
+
+	// the plain text with the query parameters
+	req, err = parseHttpSqlRequest(newRequest("/v1/sql?database=db&format=arrow&timeout=500ms&async=1", "text/plain", "select 1; select 2"))
+	require.NoError(t, err)
+	require.Equal(t, "select 1; select 2", req.SQL)
+	require.Equal(t, "db", req.Database)
+	require.Equal(t, httpFormatArrow, req.Format)
+	require.Equal(t, 500*time.Millisecond, req.timeout)
+	require.True(t, req.Async)
+
+	// the body is preferred
+	req, err = parseHttpSqlRequest(newRequest("/v1/sql?database=q", "application/json", `{"sql":"select 1","database":"b"}`))
+	require.NoError(t, err)
+	require.Equal(t, "b", req.Database)
+	require.Equal(t, httpFormatJSON, req.Format)
+	require.Zero(t, req.timeout)
+	require.False(t, req.Async)
+
+	r := newRequest("/v1/sql", "", "select 1")
+	r.Header.Set("Accept", "text/html, application/vnd.apache.arrow.stream;q=0.9, application/json")
+	req, err = parseHttpSqlRequest(r)
+	require.NoError(t, err)
+	require.Equal(t, httpFormatArrow, req.Format)
+
+	for _, c := range []struct {
+		target string
+		body   string
+	}{
+		{"/v1/sql", ""},
+		{"/v1/sql", " ; "},
+		{"/v1/sql?format=csv", "select 1"},
+		{"/v1/sql?timeout=1", "select 1"},
+		{"/v1/sql?timeout=-1s", "select 1"},
+		{"/v1/sql?async=maybe", "select 1"},
+	} {
+		_, err = parseHttpSqlRequest(newRequest(c.target, "text/plain", c.body))
+		require.Error(t, err, c.target)
+	}
+	_, err = parseHttpSqlRequest(newRequest("/v1/sql", "application/json", `{"sql":`))
+	require.Error(t, err)
+	_, err = parseHttpSqlRequest(newRequest("/v1/sql", "text/plain", strings.Repeat("x", httpMaxRequestSize+1)))
+	require.Error(t, err)
+}
+
+func Test_httpFormatOfAccept(t *testing.T) {
+	require.Equal(t, httpFormatJSON, httpFormatOfAccept(""))
+	require.Equal(t, httpFormatJSON, httpFormatOfAccept("*/*"))
+	require.Equal(t, httpFormatNDJSON, httpFormatOfAccept("application/x-ndjson"))
+	require.Equal(t, httpFormatNDJSON, httpFormatOfAccept("application/ndjson, application/json"))
+	require.Equal(t, httpFormatJSON, httpFormatOfAccept("application/json, application/vnd.apache.arrow.stream"))
+}
+
+func Test_httpStatement(t *testing.T) {
+	rt := &Routine{}
+	rt.protocol.Store(&holder[MysqlRrWr]{value: NewHttpProtocol(1001, "127.0.0.1:1", false)})
+	st := newHttpStatement(rt, "acc#u", "pwd")
+	require.True(t, st.ownedBy("acc#u", "pwd"))
+	require.False(t, st.ownedBy("acc#u", "pw"))
+	require.False(t, st.ownedBy("acc#v", "pwd"))
+	require.Equal(t, httpStatusRunning, st.state().Status)
+	require.Equal(t, uint32(1001), st.state().ConnectionID)
+
+	st.timedOut.Store(true)
+	st.finish(2, &httpError{Code: 1317, SQLState: "70100", Message: "interrupted"}, httpContentTypeJSON, []byte("{}"))
+	require.Equal(t, httpStatusTimedOut, st.state().Status)
+	require.False(t, st.kill(&st.canceled))
+	require.False(t, st.canceled.Load())
+	contentType, body := st.result()
+	require.Equal(t, httpContentTypeJSON, contentType)
+	require.Equal(t, "{}", string(body))
+	select {
+	case <-st.done:
+	default:
+		t.Fatal("the statement is not done")
+	}
+
+	st2 := newHttpStatement(rt, "acc#u", "pwd")
+	st2.finish(1, nil, httpContentTypeJSON, nil)
+	require.Equal(t, httpStatusSucceeded, st2.state().Status)
+	require.Equal(t, uint64(1), st2.state().AffectedRows)
+
+	reg := newHttpStatementRegistry(1, 2)
+	require.True(t, reg.addAsync(st, "acc:u"))
+	require.Equal(t, st, reg.get(st.id))
+	// the user keeps too many async statements
+	require.False(t, reg.addAsync(st2, "acc:u"))
+	require.Nil(t, reg.get(st2.id))
+	require.True(t, reg.addAsync(st2, "acc:v"))
+	// the server keeps too many async statements
+	st3 := newHttpStatement(rt, "acc#w", "pwd")
+	require.False(t, reg.addAsync(st3, "acc:w"))
+	// the statements without async are not limited
+	reg.add(st3)
+	require.Equal(t, st3, reg.get(st3.id))
+
+	// the expired one is dropped by the sweep
+	st.finishedAt = time.Now().Add(-2 * httpAsyncResultTTL)
+	reg.sweep(time.Now())
+	require.Nil(t, reg.get(st.id))
+	require.Equal(t, st2, reg.get(st2.id))
+	require.True(t, reg.addAsync(newHttpStatement(rt, "acc#u", "pwd"), "acc:u"))
+	reg.remove(st2.id)
+	require.Nil(t, reg.get(st2.id))
+	require.Equal(t, map[string]int{"acc:u": 1}, reg.asyncOf)
+	require.Equal(t, 1, reg.async)
+}
+
+func Test_httpAsyncBuffer(t *testing.T) {
+	buf := &httpAsyncBuffer{limit: 4}
+	_, err := buf.Write([]byte("abc"))
+	require.NoError(t, err)
+	_, err = buf.Write([]byte("de"))
+	require.Error(t, err)
+	_, err = buf.Write([]byte("d"))
+	require.Error(t, err)
+	require.Equal(t, "abc", buf.String())
+}
+
+func Test_httpResponseOutput(t *testing.T) {
+	// the result is sent in the trailers after the body
+	rec := httptest.NewRecorder()
+	out := newHttpResponseOutput(rec)
+	_, err := out.Write([]byte("abc"))
+	require.NoError(t, err)
+	require.NoError(t, out.flush())
+	require.True(t, out.trailer)
+	httpSetResultHeader(rec.Header(), 3, &httpError{Code: 1064, SQLState: "42000", Message: "line1\nline2"})
+	resp := rec.Result()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, "abc", string(body))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "3", resp.Trailer.Get(httpHeaderAffectedRows))
+	require.Equal(t, "1064", resp.Trailer.Get(httpHeaderErrorCode))
+	require.Equal(t, "42000", resp.Trailer.Get(httpHeaderSQLState))
+	require.Equal(t, "line1 line2", resp.Trailer.Get(httpHeaderErrorMessage))
+
+	// nothing has been sent, the result is sent in the headers
+	rec = httptest.NewRecorder()
+	out = newHttpResponseOutput(rec)
+	httpSetResultHeader(rec.Header(), 0, nil)
+	out.status = http.StatusBadRequest
+	out.start(false)
+	require.False(t, out.trailer)
+	resp = rec.Result()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.Equal(t, "0", resp.Header.Get(httpHeaderAffectedRows))
+	require.Empty(t, resp.Header.Get(httpHeaderErrorCode))
+	require.Empty(t, resp.Header.Get("Trailer"))
+}
+
+func Test_httpServer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	eng := mock_frontend.NewMockEngine(ctrl)
+	eng.EXPECT().New(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	eng.EXPECT().Hints().Return(engine.Hints{CommitOrRollbackTimeout: time.Second * 10}).AnyTimes()
+	txnClient := mock_frontend.NewMockTxnClient(ctrl)
+	txnClient.EXPECT().New(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, commitTS any, options ...any) (client.TxnOperator, error) {
+			return newTestTxnOp(), nil
+		}).AnyTimes()
+	pu, err := getParameterUnit("test/system_vars_config.toml", eng, txnClient)
+	require.NoError(t, err)
+	pu.SV.SkipCheckUser = true
+	pu.SV.KillRountinesInterval = 0
+	setPu("", pu)
+	setSessionAlloc("", NewLeakCheckAllocator())
+
+	sql1 := "select connection_id();"
+	sql2 := "select sleep(10);"
+	sql3 := "select sleep(30);"
+	resultSet := map[string]*result{
+		sql1: {
+			gen: func(ses *Session) *MysqlResultSet {
+				return newMrsForConnectionId([][]interface{}{{ses.GetConnectionID()}})
+			},
+		},
+	}
+	for _, sql := range []string{sql2, sql3} {
+		resultSet[sql] = &result{
+			gen: func(ses *Session) *MysqlResultSet {
+				return newMrsForSleep([][]interface{}{{uint8(0)}})
+			},
+			isSleepSql: true,
+			seconds:    30,
+			startedCh:  make(chan struct{}, 1),
+		}
+	}
+	resultSet[sql2].seconds = 10
+	stub := gostub.Stub(&GetComputationWrapper, func(execCtx *ExecCtx, db string, user string, eng engine.Engine, proc *process.Process, ses *Session) ([]ComputationWrapper, error) {
+		stmts, err := parsers.Parse(execCtx.reqCtx, dialect.MYSQL, execCtx.input.getSql(), 1)
+		if err != nil {
+			return nil, err
+		}
+		var cw []ComputationWrapper
+		for _, stmt := range stmts {
+			cw = append(cw, newMockWrapper(ctrl, ses, resultSet, nil, execCtx.input.getSql(), stmt, proc))
+		}
+		return cw, nil
+	})
+	defer stub.Reset()
+
+	ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+	setAicm("", &defines.AutoIncrCacheManager{})
+	rm, _ := NewRoutineManager(ctx, "")
+	setRtMgr("", rm)
+	mo := createInnerServer()
+	mo.httpStatements = newHttpStatementRegistry(1, 8)
+	srv := httptest.NewServer(mo.newHttpSqlServer().Handler)
+	defer srv.Close()
+
+	do := func(method, target, body string, auth bool) (*http.Response, []byte) {
+		req, err := http.NewRequest(method, srv.URL+target, strings.NewReader(body))
+		require.NoError(t, err)
+		if auth {
+			req.SetBasicAuth("sys:dump", "111")
+		}
+		resp, err := srv.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, data
+	}
+	statusOf := func(data []byte) httpStatementStatus {
+		var state httpStatementStatus
+		require.NoError(t, json.Unmarshal(data, &state))
+		return state
+	}
+
+	// the credentials are required
+	resp, _ := do(http.MethodPost, httpSqlPath, sql1, false)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, data := do(http.MethodPost, httpSqlPath, `{"sql":"`+sql1+`"}`, true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotEmpty(t, resp.Header.Get(httpHeaderStatementID))
+	require.Contains(t, string(data), "connection_id")
+	require.Nil(t, mo.httpStatements.get(resp.Header.Get(httpHeaderStatementID)))
+
+	// the statement is killed at the timeout
+	resp, _ = do(http.MethodPost, httpSqlPath, `{"sql":"`+sql2+`","timeout":"200ms"}`, true)
+	require.NotEmpty(t, resp.Header.Get(httpHeaderStatementID))
+	require.Equal(t, contextCancel, resultSet[sql2].resultX.Load())
+
+	// the async statement is canceled by the DELETE
+	resp, data = do(http.MethodPost, httpSqlPath, `{"sql":"`+sql3+`","async":true}`, true)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	id := statusOf(data).StatementID
+	require.Equal(t, httpSqlPath+"/"+id, resp.Header.Get("Location"))
+	select {
+	case <-resultSet[sql3].startedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the async statement does not start")
+	}
+	// the user keeps too many async statements
+	resp, _ = do(http.MethodPost, httpSqlPath, `{"sql":"`+sql1+`","async":true}`, true)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	resp, _ = do(http.MethodDelete, httpSqlPath+"/"+id, "", true)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	resp, _ = do(http.MethodGet, httpSqlPath+"/"+id+"?wait=5s", "", true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotEqual(t, httpStatusRunning, resp.Header.Get(httpHeaderStatus))
+	require.Equal(t, contextCancel, resultSet[sql3].resultX.Load())
+
+	// the finished one is dropped by the DELETE
+	resp, _ = do(http.MethodDelete, httpSqlPath+"/"+id, "", true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, _ = do(http.MethodGet, httpSqlPath+"/"+id, "", true)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, data = do(http.MethodPost, httpSqlPath, `{"sql":"`+sql1+`","async":true}`, true)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	resp, data = do(http.MethodGet, httpSqlPath+"/"+statusOf(data).StatementID+"?wait=5s", "", true)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, httpStatusSucceeded, resp.Header.Get(httpHeaderStatus))
+	require.Contains(t, string(data), "connection_id")
+}
@@ -19,6 +19,8 @@ import (
 	"math"
 	"sync"
 
+	"go.uber.org/zap"
+
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
@@ -167,6 +169,15 @@ func (ie *internalExecutor) ExecWithStatus(ctx context.Context, sql string, opts
 	res := ie.proto.swapOutResult()
 	status.AffectedRows = res.affectedRows
 	if err != nil {
+		// a failed statement after an explicit BEGIN only rolls back itself and
+		// leaves the txn open. The session is discarded below, so roll back the
+		// whole txn here to keep a BEGIN ... COMMIT batch all-or-nothing.
+		if sess.GetTxnHandler().InActiveTxn() {
+			tempExecCtx.txnOpt.byRollback = true
+			if rbErr := sess.GetTxnHandler().Rollback(&tempExecCtx); rbErr != nil {
+				sess.Error(ctx, "internal executor rollback failed", zap.Error(rbErr))
+			}
+		}
 		return status, moerr.AttachCause(ctx, err)
 	}
 	return status, nil
@@ -117,6 +117,8 @@ func extractRowFromVector(ctx context.Context, ses FeSession, vec *vector.Vector
 		} else {
 			row[i] = append([]float64(nil), arr...)
 		}
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		row[i] = []byte(types.QuantizedArrayBytesToString(vec.GetType().Oid, vec.GetBytesAt(rowIndex)))
 	case types.T_date:
 		row[i] = vector.GetFixedAtNoTypeCheck[types.Date](vec, rowIndex)
 	case types.T_datetime:
@@ -248,6 +250,8 @@ func extractRowFromVector2(ctx context.Context, ses FeSession, vec *vector.Vecto
 		} else {
 			row[i] = append([]float64(nil), arr...)
 		}
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		row[i] = []byte(types.QuantizedArrayBytesToString(vec.GetType().Oid, vec.GetBytesAt2(colSlices.arrVarlena[sliceIdx], rowIndex)))
 	case types.T_date:
 		row[i] = colSlices.arrDate[sliceIdx][rowIndex]
 	case types.T_datetime:
@@ -570,6 +574,8 @@ func (slices *ColumnSlices) GetStringBased(r uint64, i uint64) (string, error) {
 		return types.ArrayToString[float32](vector.GetArrayAt2[float32](vec, slices.arrVarlena[sliceIdx], int(r))), nil
 	case types.T_array_float64:
 		return types.ArrayToString[float64](vector.GetArrayAt2[float64](vec, slices.arrVarlena[sliceIdx], int(r))), nil
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return types.QuantizedArrayBytesToString(vec.GetType().Oid, vec.GetBytesAt2(slices.arrVarlena[sliceIdx], int(r))), nil
 	case types.T_Rowid:
 		return slices.arrRowid[sliceIdx][r].String(), nil
 	case types.T_Blockid:
@@ -777,7 +783,7 @@ func convertVectorToSlice(ctx context.Context, ses FeSession, vec *vector.Vector
 		//+------------------------------+
 		colSlices.colIdx2SliceIdx[i] = len(colSlices.arrVarlena)
 		colSlices.arrVarlena = append(colSlices.arrVarlena, vector.ToSliceNoTypeCheck2[types.Varlena](vec))
-	case types.T_array_float64:
+	case types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		colSlices.colIdx2SliceIdx[i] = len(colSlices.arrVarlena)
 		colSlices.arrVarlena = append(colSlices.arrVarlena, vector.ToSliceNoTypeCheck2[types.Varlena](vec))
 	case types.T_date:
@@ -0,0 +1,666 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var _ MysqlRrWr = &PgProtocolImpl{}
+
+// pgStatement is the statement created by the Parse message.
+type pgStatement struct {
+	// sql is the statement translated into the mysql dialect
+	sql string
+	// ordinals[i] is the number n of the placeholder $n which
+	// the i-th parameter of the translated statement comes from.
+	ordinals []int
+	// paramOIDs are the types of the parameters specified by the client.
+	paramOIDs []uint32
+	// prepared is false when MO can not prepare the statement.
+	// It is executed as a simple query then.
+	prepared    bool
+	prepareStmt *PrepareStmt
+}
+
+// numParams returns the number of the parameters the Bind message should supply.
+func (st *pgStatement) numParams() int {
+	n := len(st.paramOIDs)
+	for _, o := range st.ordinals {
+		n = max(n, o)
+	}
+	return n
+}
+
+// paramOID returns the type of the parameter $n.
+// Zero means that the client leaves the type unspecified.
+func (st *pgStatement) paramOID(n int) uint32 {
+	if n > 0 && n <= len(st.paramOIDs) {
+		return st.paramOIDs[n-1]
+	}
+	return 0
+}
+
+// pgPortal is the statement bound with the parameters by the Bind message.
+type pgPortal struct {
+	stmt          *pgStatement
+	params        [][]byte
+	paramFormats  []int16
+	resultFormats []int16
+	// described is true after the RowDescription of the portal
+	// is sent for the Describe message.
+	described bool
+}
+
+func (pt *pgPortal) paramFormat(n int) int16 {
+	return pgFormatOf(pt.paramFormats, n-1)
+}
+
+func (pt *pgPortal) resultFormat(i int) int16 {
+	return pgFormatOf(pt.resultFormats, i)
+}
+
+// pgFormatOf returns the format code of the i-th item.
+// No format code means text for all, one means the same for all.
+func pgFormatOf(formats []int16, i int) int16 {
+	switch {
+	case len(formats) == 0:
+		return pgtype.TextFormatCode
+	case len(formats) == 1:
+		return formats[0]
+	case i < len(formats):
+		return formats[i]
+	default:
+		return pgtype.TextFormatCode
+	}
+}
+
+// PgProtocolImpl writes the results in the PostgreSQL frontend/backend protocol.
+// It reuses the connection management, the properties and the statistics
+// of the MysqlProtocolImpl, and replaces all the reading and writing.
+type PgProtocolImpl struct {
+	*MysqlProtocolImpl
+
+	mu sync.Mutex
+
+	backend *pgproto3.Backend
+	typeMap *pgtype.Map
+
+	//the secret key sent in the BackendKeyData. The client needs it to cancel the query.
+	secretKey uint32
+
+	//the columns of the result set being sent
+	columns  []pgColumn
+	rowCount uint64
+	//the count of the bytes that are not flushed
+	unflushed int
+	//for encoding the values of a row
+	rowBuf []byte
+
+	//the portal being executed by the Execute message.
+	//It is nil for the simple query.
+	portal *pgPortal
+
+	//capture is true when the Parse message prepares the statement.
+	//The response is kept in capturedStmt or capturedErr instead of
+	//being sent to the client.
+	capture      bool
+	capturedStmt *PrepareStmt
+	capturedErr  error
+
+	//errorSent is true after an ErrorResponse is sent.
+	errorSent bool
+}
+
+func NewPgProtocol(sid string, connectionID uint32, tcp *Conn, SV *config.FrontendParameters) *PgProtocolImpl {
+	var key [4]byte
+	_, _ = rand.Read(key[:])
+	return &PgProtocolImpl{
+		MysqlProtocolImpl: NewMysqlClientProtocol(sid, connectionID, tcp, int(SV.MaxBytesInOutbufToFlush), SV),
+		typeMap:           pgtype.NewMap(),
+		secretKey:         binary.BigEndian.Uint32(key[:]),
+	}
+}
+
+func (pp *PgProtocolImpl) setBackend(backend *pgproto3.Backend) {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	pp.backend = backend
+}
+
+// send buffers the message. It is flushed when the buffered messages are too many.
+func (pp *PgProtocolImpl) send(msg pgproto3.BackendMessage, size int) error {
+	pp.backend.Send(msg)
+	pp.unflushed += size
+	if pp.unflushed >= int(pp.SV.MaxBytesInOutbufToFlush*1024) {
+		return pp.flush()
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) flush() error {
+	// the output bytes are counted by the Conn
+	pp.tcpConn.CountFlushPackage(1)
+	pp.unflushed = 0
+	return pp.backend.Flush()
+}
+
+// Flush sends the buffered messages to the client.
+func (pp *PgProtocolImpl) Flush() error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	return pp.flush()
+}
+
+// SendMessage buffers the message without flushing.
+func (pp *PgProtocolImpl) SendMessage(msg pgproto3.BackendMessage) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	return pp.send(msg, 0)
+}
+
+// SendError buffers the ErrorResponse without flushing.
+func (pp *PgProtocolImpl) SendError(code, message string) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	return pp.sendError(code, message)
+}
+
+// ResetExecution clears the state of the last execution.
+func (pp *PgProtocolImpl) ResetExecution(portal *pgPortal, capture bool) {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	pp.portal = portal
+	pp.capture = capture
+	pp.capturedStmt = nil
+	pp.capturedErr = nil
+	pp.errorSent = false
+	pp.columns = pp.columns[:0]
+	pp.rowCount = 0
+}
+
+// Captured returns the response of the Parse message.
+func (pp *PgProtocolImpl) Captured() (*PrepareStmt, error) {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	return pp.capturedStmt, pp.capturedErr
+}
+
+// ErrorSent returns whether an ErrorResponse has been sent in the last execution.
+func (pp *PgProtocolImpl) ErrorSent() bool {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	return pp.errorSent
+}
+
+func (pp *PgProtocolImpl) rowDescription(columns []pgColumn, formats func(int) int16) *pgproto3.RowDescription {
+	fields := make([]pgproto3.FieldDescription, len(columns))
+	for i, c := range columns {
+		fields[i] = pgproto3.FieldDescription{
+			Name:         []byte(c.name),
+			DataTypeOID:  c.oid,
+			DataTypeSize: c.size,
+			TypeModifier: c.typmod,
+			Format:       formats(i),
+		}
+	}
+	return &pgproto3.RowDescription{Fields: fields}
+}
+
+func (pp *PgProtocolImpl) resultFormat(i int) int16 {
+	if pp.portal == nil {
+		return pgtype.TextFormatCode
+	}
+	return pp.portal.resultFormat(i)
+}
+
+func (pp *PgProtocolImpl) sendRowDescription() error {
+	// the RowDescription has been sent for the Describe message
+	if pp.portal != nil && pp.portal.described {
+		return nil
+	}
+	return pp.send(pp.rowDescription(pp.columns, pp.resultFormat), 0)
+}
+
+func (pp *PgProtocolImpl) sendDataRow(row []any) error {
+	ses := pp.GetSession()
+	loc := ses.GetTimeZone()
+	values := make([][]byte, len(row))
+	pp.rowBuf = pp.rowBuf[:0]
+	size := 0
+	for i, v := range row {
+		if i >= len(pp.columns) {
+			break
+		}
+		val, err := pgValueOf(pp.columns[i].oid, v, loc)
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			continue
+		}
+		start := len(pp.rowBuf)
+		pp.rowBuf, err = pp.typeMap.Encode(pp.columns[i].oid, pp.resultFormat(i), val, pp.rowBuf)
+		if err != nil {
+			return err
+		}
+		values[i] = pp.rowBuf[start:len(pp.rowBuf):len(pp.rowBuf)]
+		size += len(values[i]) + 4
+	}
+	// the Send encodes the message at once, so rowBuf can be reused after that.
+	if err := pp.send(&pgproto3.DataRow{Values: values}, size); err != nil {
+		return err
+	}
+	pp.rowCount++
+	return nil
+}
+
+func (pp *PgProtocolImpl) sendCommandComplete(tag string) error {
+	return pp.send(&pgproto3.CommandComplete{CommandTag: []byte(tag)}, 0)
+}
+
+func (pp *PgProtocolImpl) sendError(code, message string) error {
+	pp.errorSent = true
+	return pp.send(&pgproto3.ErrorResponse{
+		Severity:            "ERROR",
+		SeverityUnlocalized: "ERROR",
+		Code:                code,
+		Message:             message,
+	}, 0)
+}
+
+// pgErrorOf converts the error into the SQLSTATE and the message.
+func pgErrorOf(err error) (string, string) {
+	if myerr, ok := err.(*moerr.Error); ok {
+		code := myerr.MySQLCode()
+		if code == moerr.ER_UNKNOWN_ERROR {
+			code = myerr.ErrorCode()
+		}
+		return pgSQLState(code, myerr.SqlState()), myerr.Error()
+	}
+	return pgSQLState(moerr.ER_UNKNOWN_ERROR, DefaultMySQLState), err.Error()
+}
+
+// pgSQLState converts the mysql error code and SQLSTATE into the one of
+// PostgreSQL. The clients check some of them, like unique_violation.
+func pgSQLState(code uint16, sqlState string) string {
+	switch code {
+	case moerr.ER_DUP_ENTRY, moerr.ER_DUP_KEY:
+		return "23505"
+	case moerr.ER_NO_SUCH_TABLE:
+		return "42P01"
+	case moerr.ER_TABLE_EXISTS_ERROR:
+		return "42P07"
+	case moerr.ER_BAD_FIELD_ERROR:
+		return "42703"
+	case moerr.ER_BAD_DB_ERROR:
+		return "3D000"
+	case moerr.ER_DB_CREATE_EXISTS:
+		return "42P04"
+	case moerr.ER_PARSE_ERROR, moerr.ER_SYNTAX_ERROR:
+		return "42601"
+	case moerr.ER_ACCESS_DENIED_ERROR:
+		return "28P01"
+	case moerr.ER_LOCK_DEADLOCK:
+		return "40P01"
+	case moerr.ER_QUERY_INTERRUPTED:
+		return "57014"
+	case moerr.ER_DIVISION_BY_ZERO:
+		return "22012"
+	}
+	if len(sqlState) != 5 || sqlState == DefaultMySQLState {
+		// internal_error
+		return "XX000"
+	}
+	return sqlState
+}
+
+// pgCommandTag makes the tag of the CommandComplete for the statement.
+func pgCommandTag(stmt tree.Statement, rows uint64) string {
+	n := strconv.FormatUint(rows, 10)
+	switch stmt.(type) {
+	case nil:
+		return "OK"
+	case *tree.Select, *tree.ValuesStatement:
+		return "SELECT " + n
+	case *tree.Insert, *tree.Replace:
+		return "INSERT 0 " + n
+	case *tree.Update:
+		return "UPDATE " + n
+	case *tree.Delete:
+		return "DELETE " + n
+	case *tree.Load:
+		return "COPY " + n
+	case *tree.BeginTransaction:
+		return "BEGIN"
+	case *tree.CommitTransaction:
+		return "COMMIT"
+	case *tree.RollbackTransaction:
+		return "ROLLBACK"
+	case *tree.SetVar:
+		return "SET"
+	default:
+		return strings.ToUpper(stmt.GetStatementType())
+	}
+}
+
+func (pp *PgProtocolImpl) commandTag(rows uint64) string {
+	ses := pp.GetSession()
+	if ses == nil {
+		return pgCommandTag(nil, rows)
+	}
+	return pgCommandTag(ses.ast, rows)
+}
+
+func (pp *PgProtocolImpl) Write(execCtx *ExecCtx, crs *perfcounter.CounterSet, bat *batch.Batch) error {
+	n := bat.Vecs[0].Length()
+	ses := execCtx.ses.(*Session)
+	row := make([]any, len(bat.Vecs))
+	if ses.GetShowStmtType() == ShowTableStatus {
+		for j := 0; j < n; j++ {
+			if err := extractRowFromEveryVector(execCtx.reqCtx, ses, bat, j, row, false); err != nil {
+				return err
+			}
+			row2 := make([]any, len(row))
+			copy(row2, row)
+			ses.AppendData(row2)
+		}
+		return nil
+	}
+
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	for j := 0; j < n; j++ {
+		if err := extractRowFromEveryVector(execCtx.reqCtx, ses, bat, j, row, true); err != nil {
+			return err
+		}
+		if err := pp.sendDataRow(row); err != nil {
+			ses.Error(execCtx.reqCtx,
+				"Flush error",
+				zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteHandshake() error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteOK(affectedRows, lastInsertId uint64, status, warnings uint16, message string) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	if pp.capture {
+		return nil
+	}
+	return pp.sendCommandComplete(pp.commandTag(affectedRows))
+}
+
+func (pp *PgProtocolImpl) WriteOKtWithEOF(affectedRows, lastInsertId uint64, status, warnings uint16, message string) error {
+	return pp.WriteOK(affectedRows, lastInsertId, status, warnings, message)
+}
+
+func (pp *PgProtocolImpl) WriteEOF(warnings, status uint16) error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteEOFIF(warnings uint16, status uint16) error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteEOFIFAndNoFlush(warnings uint16, status uint16) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	return pp.sendRowDescription()
+}
+
+func (pp *PgProtocolImpl) WriteEOFOrOK(warnings uint16, status uint16) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	return pp.sendCommandComplete(pgCommandTag(&tree.Select{}, pp.rowCount))
+}
+
+func (pp *PgProtocolImpl) WriteERR(errorCode uint16, sqlState, errorMessage string) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	return pp.sendError(pgSQLState(errorCode, sqlState), errorMessage)
+}
+
+func (pp *PgProtocolImpl) WriteLengthEncodedNumber(u uint64) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	pp.columns = pp.columns[:0]
+	pp.rowCount = 0
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteColumnDef(ctx context.Context, column Column, i int) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	pp.columns = append(pp.columns, pgColumnOf(column))
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteColumnDefBytes(payload []byte) error {
+	return moerr.NewInternalErrorNoCtx("the column definition of mysql can not be sent in the PostgreSQL protocol")
+}
+
+func (pp *PgProtocolImpl) WriteRow() error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteTextRow() error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteBinaryRow() error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteResultSetRow(mrs *MysqlResultSet, count uint64) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	for i := uint64(0); i < count; i++ {
+		if err := pp.sendDataRow(mrs.Data[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteResultSetRow2(mrs *MysqlResultSet, colSlices *ColumnSlices, count uint64) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	ses := pp.GetSession()
+	row := make([]any, len(colSlices.dataSet.Vecs))
+	for j := uint64(0); j < count; j++ {
+		if err := extractRowFromEveryVector(colSlices.ctx, ses, colSlices.dataSet, int(j), row, true); err != nil {
+			return err
+		}
+		if err := pp.sendDataRow(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteResponse(ctx context.Context, resp *Response) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+
+	switch resp.category {
+	case OkResponse, EoFResponse:
+		if pp.capture {
+			return nil
+		}
+		return pp.sendCommandComplete(pp.commandTag(resp.affectedRows))
+	case ErrorResponse:
+		err, _ := resp.data.(error)
+		if err == nil {
+			if pp.capture {
+				return nil
+			}
+			return pp.sendCommandComplete(pp.commandTag(0))
+		}
+		if pp.capture {
+			pp.capturedErr = err
+			return nil
+		}
+		code, msg := pgErrorOf(err)
+		return pp.sendError(code, msg)
+	case ResultResponse:
+		mer := resp.data.(*MysqlExecutionResult)
+		if mer == nil || mer.Mrs() == nil {
+			var rows uint64
+			if mer != nil {
+				rows = mer.AffectedRows()
+			}
+			return pp.sendCommandComplete(pp.commandTag(rows))
+		}
+		mrs := mer.Mrs()
+		pp.columns = pp.columns[:0]
+		pp.rowCount = 0
+		for _, col := range mrs.Columns {
+			pp.columns = append(pp.columns, pgColumnOf(col))
+		}
+		if err := pp.sendRowDescription(); err != nil {
+			return err
+		}
+		for _, row := range mrs.Data {
+			if err := pp.sendDataRow(row); err != nil {
+				return err
+			}
+		}
+		return pp.sendCommandComplete(pgCommandTag(&tree.Select{}, pp.rowCount))
+	case LocalInfileRequest:
+		return moerr.NewNotSupported(ctx, "LOAD DATA LOCAL in the PostgreSQL protocol")
+	default:
+		return moerr.NewInternalErrorf(ctx, "unsupported response:%d ", resp.category)
+	}
+}
+
+func (pp *PgProtocolImpl) WritePrepareResponse(ctx context.Context, stmt *PrepareStmt) error {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	pp.capturedStmt = stmt
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteLocalInfileRequest(filepath string) error {
+	return moerr.NewNotSupportedNoCtx("LOAD DATA LOCAL in the PostgreSQL protocol")
+}
+
+func (pp *PgProtocolImpl) Read() ([]byte, error) {
+	return nil, moerr.NewNotSupportedNoCtx("reading mysql packets in the PostgreSQL protocol")
+}
+
+func (pp *PgProtocolImpl) ReadLoadLocalPacket() ([]byte, error) {
+	return nil, moerr.NewNotSupportedNoCtx("LOAD DATA LOCAL in the PostgreSQL protocol")
+}
+
+func (pp *PgProtocolImpl) HandleHandshake(ctx context.Context, payload []byte) (bool, error) {
+	return false, moerr.NewInternalError(ctx, "the mysql handshake in the PostgreSQL protocol")
+}
+
+func (pp *PgProtocolImpl) Authenticate(ctx context.Context) error {
+	return moerr.NewInternalError(ctx, "the mysql authentication in the PostgreSQL protocol")
+}
+
+func (pp *PgProtocolImpl) ParseSendLongData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error {
+	return moerr.NewNotSupported(ctx, "COM_STMT_SEND_LONG_DATA in the PostgreSQL protocol")
+}
+
+// ParseExecuteData sets the parameters of the Bind message into the prepared statement.
+func (pp *PgProtocolImpl) ParseExecuteData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error {
+	var err error
+	pp.mu.Lock()
+	portal := pp.portal
+	pp.mu.Unlock()
+	if portal == nil {
+		return moerr.NewInternalError(ctx, "no portal is bound to the prepared statement")
+	}
+
+	stmt.proc = proc
+	dcPrepare, ok := stmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return moerr.NewInternalError(ctx, "can not get Prepare plan in prepareStmt")
+	}
+	numParams := len(dcPrepare.Prepare.ParamTypes)
+
+	if stmt.params == nil {
+		stmt.params = vector.NewVec(types.T_text.ToType())
+		for i := 0; i < numParams; i++ {
+			err = vector.AppendBytes(stmt.params, []byte{}, false, proc.GetMPool())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	loc := pp.GetSession().GetTimeZone()
+	for i := 0; i < numParams; i++ {
+		n := i + 1
+		if i < len(portal.stmt.ordinals) {
+			n = portal.stmt.ordinals[i]
+		}
+		if n > len(portal.params) {
+			return moerr.NewInvalidInputf(ctx, "bind message supplies %d parameters, but prepared statement requires %d",
+				len(portal.params), portal.stmt.numParams())
+		}
+		val, err := pgDecodeParam(pp.typeMap, portal.stmt.paramOID(n), portal.paramFormat(n), portal.params[n-1], loc)
+		if err != nil {
+			return err
+		}
+		// the parameter may be NULL in the last execution
+		stmt.params.GetNulls().Unset(uint64(i))
+		if err = util.SetAnyToStringVector(proc, val, stmt.params, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) MakeColumnDefData(ctx context.Context, columns []*planPb.ColDef) ([][]byte, error) {
+	return nil, nil
+}
+
+// pgServerStatus returns the status of the transaction in the ReadyForQuery.
+func pgServerStatus(status uint16) byte {
+	if status&SERVER_STATUS_IN_TRANS != 0 {
+		return 'T'
+	}
+	return 'I'
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func Test_pgErrorOf(t *testing.T) {
+	ctx := context.TODO()
+	cases := []struct {
+		err  error
+		code string
+	}{
+		{moerr.NewDuplicateEntry(ctx, "1", "PRIMARY"), "23505"},
+		{moerr.NewNoSuchTable(ctx, "db", "t"), "42P01"},
+		{moerr.NewBadDB(ctx, "db"), "3D000"},
+		{moerr.NewQueryInterrupted(ctx), "57014"},
+		{moerr.NewInternalError(ctx, "check password failed"), "XX000"},
+		{errors.New("unknown"), "XX000"},
+	}
+	for _, c := range cases {
+		code, msg := pgErrorOf(c.err)
+		require.Equal(t, c.code, code, c.err.Error())
+		require.Equal(t, c.err.Error(), msg)
+	}
+
+	require.Equal(t, "28P01", pgSQLState(moerr.ER_ACCESS_DENIED_ERROR, "28000"))
+	require.Equal(t, "22003", pgSQLState(moerr.ER_UNKNOWN_ERROR, "22003"))
+}
+
+func Test_pgCommandTag(t *testing.T) {
+	require.Equal(t, "SELECT 3", pgCommandTag(&tree.Select{}, 3))
+	require.Equal(t, "INSERT 0 2", pgCommandTag(&tree.Insert{}, 2))
+	require.Equal(t, "UPDATE 1", pgCommandTag(&tree.Update{}, 1))
+	require.Equal(t, "DELETE 0", pgCommandTag(&tree.Delete{}, 0))
+	require.Equal(t, "BEGIN", pgCommandTag(&tree.BeginTransaction{}, 0))
+	require.Equal(t, "COMMIT", pgCommandTag(&tree.CommitTransaction{}, 0))
+	require.Equal(t, "ROLLBACK", pgCommandTag(&tree.RollbackTransaction{}, 0))
+	require.Equal(t, "SET", pgCommandTag(&tree.SetVar{}, 0))
+	require.Equal(t, "CREATE TABLE", pgCommandTag(&tree.CreateTable{}, 0))
+	require.Equal(t, "OK", pgCommandTag(nil, 0))
+}
+
+func Test_pgStatement(t *testing.T) {
+	st := &pgStatement{ordinals: []int{2, 1, 2}}
+	require.Equal(t, 2, st.numParams())
+	require.Equal(t, uint32(0), st.paramOID(1))
+
+	st.paramOIDs = []uint32{pgtype.Int8OID, pgtype.TextOID, pgtype.DateOID}
+	require.Equal(t, 3, st.numParams())
+	require.Equal(t, uint32(pgtype.TextOID), st.paramOID(2))
+	require.Equal(t, uint32(0), st.paramOID(4))
+
+	pt := &pgPortal{stmt: st}
+	require.Equal(t, int16(pgtype.TextFormatCode), pt.paramFormat(1))
+	require.Equal(t, int16(pgtype.TextFormatCode), pt.resultFormat(5))
+	pt.resultFormats = []int16{pgtype.BinaryFormatCode}
+	require.Equal(t, int16(pgtype.BinaryFormatCode), pt.resultFormat(5))
+	pt.paramFormats = []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode}
+	require.Equal(t, int16(pgtype.BinaryFormatCode), pt.paramFormat(1))
+	require.Equal(t, int16(pgtype.TextFormatCode), pt.paramFormat(2))
+
+	require.Equal(t, byte('I'), pgServerStatus(SERVER_STATUS_AUTOCOMMIT))
+	require.Equal(t, byte('T'), pgServerStatus(SERVER_STATUS_IN_TRANS|SERVER_STATUS_AUTOCOMMIT))
+}
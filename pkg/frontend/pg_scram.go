@@ -0,0 +1,256 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+/*
+SCRAM-SHA-256 authentication of the PostgreSQL protocol.
+See RFC 5802 and RFC 7677.
+
+The password of the user is stored as *HEX(SHA1(SHA1(password))) for the mysql
+protocol, which can not be used by SCRAM. So the SCRAM verifier is stored in the
+scram_verifier column of mo_user when the password is set. It has the same format
+as the one of PostgreSQL:
+
+	SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey>
+*/
+
+const (
+	scramSHA256Mechanism = "SCRAM-SHA-256"
+	scramIterations      = 4096
+	scramSaltLength      = 16
+	scramNonceLength     = 18
+)
+
+type scramVerifier struct {
+	iterations int
+	salt       []byte
+	storedKey  []byte
+	serverKey  []byte
+}
+
+// makeScramVerifier makes the verifier of the password with a random salt.
+func makeScramVerifier(password string) (string, error) {
+	salt := make([]byte, scramSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	v, err := newScramVerifier(password, salt, scramIterations)
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
+
+func newScramVerifier(password string, salt []byte, iterations int) (*scramVerifier, error) {
+	saltedPassword, err := pbkdf2.Key(sha256.New, password, salt, iterations, sha256.Size)
+	if err != nil {
+		return nil, err
+	}
+	clientKey := scramHmac(saltedPassword, "Client Key")
+	storedKey := sha256.Sum256(clientKey)
+	return &scramVerifier{
+		iterations: iterations,
+		salt:       salt,
+		storedKey:  storedKey[:],
+		serverKey:  scramHmac(saltedPassword, "Server Key"),
+	}, nil
+}
+
+func (v *scramVerifier) String() string {
+	enc := base64.StdEncoding
+	return scramSHA256Mechanism + "$" + strconv.Itoa(v.iterations) + ":" + enc.EncodeToString(v.salt) +
+		"$" + enc.EncodeToString(v.storedKey) + ":" + enc.EncodeToString(v.serverKey)
+}
+
+func parseScramVerifier(s string) (*scramVerifier, error) {
+	parts := strings.Split(s, "$")
+	if len(parts) != 3 || parts[0] != scramSHA256Mechanism {
+		return nil, moerr.NewInvalidInputNoCtx("invalid SCRAM verifier")
+	}
+	iterAndSalt := strings.SplitN(parts[1], ":", 2)
+	keys := strings.SplitN(parts[2], ":", 2)
+	if len(iterAndSalt) != 2 || len(keys) != 2 {
+		return nil, moerr.NewInvalidInputNoCtx("invalid SCRAM verifier")
+	}
+	v := &scramVerifier{}
+	var err error
+	if v.iterations, err = strconv.Atoi(iterAndSalt[0]); err != nil || v.iterations <= 0 {
+		return nil, moerr.NewInvalidInputNoCtx("invalid SCRAM verifier")
+	}
+	enc := base64.StdEncoding
+	if v.salt, err = enc.DecodeString(iterAndSalt[1]); err != nil {
+		return nil, moerr.NewInvalidInputNoCtx("invalid SCRAM verifier")
+	}
+	if v.storedKey, err = enc.DecodeString(keys[0]); err != nil || len(v.storedKey) != sha256.Size {
+		return nil, moerr.NewInvalidInputNoCtx("invalid SCRAM verifier")
+	}
+	if v.serverKey, err = enc.DecodeString(keys[1]); err != nil || len(v.serverKey) != sha256.Size {
+		return nil, moerr.NewInvalidInputNoCtx("invalid SCRAM verifier")
+	}
+	return v, nil
+}
+
+// scramMockSecret is the secret of the server to derive the salts and the keys
+// of the mocked verifiers, as the mock authentication nonce of PostgreSQL.
+var scramMockSecret = sync.OnceValue(func() []byte {
+	secret := make([]byte, sha256.Size)
+	_, _ = rand.Read(secret)
+	return secret
+})
+
+// scramMockSalt returns the salt of the user that is the same in every attempt.
+func scramMockSalt(username string) []byte {
+	return scramHmac(scramMockSecret(), "salt:"+username)[:scramSaltLength]
+}
+
+// mockScramVerifier is used for the user that does not exist or has no verifier,
+// so that the client can not tell it from a wrong password. The salt and the keys
+// are derived from the user name, so that they do not change between the attempts
+// as the ones of an existing user.
+func mockScramVerifier(username string) *scramVerifier {
+	secret := scramMockSecret()
+	return &scramVerifier{
+		iterations: scramIterations,
+		salt:       scramMockSalt(username),
+		storedKey:  scramHmac(secret, "stored key:"+username),
+		serverKey:  scramHmac(secret, "server key:"+username),
+	}
+}
+
+// scramServer is the server side of one SCRAM-SHA-256 exchange.
+type scramServer struct {
+	verifier *scramVerifier
+
+	gs2Header       string
+	clientFirstBare string
+	serverFirst     string
+	nonce           string
+}
+
+func newScramServer(verifier *scramVerifier) *scramServer {
+	return &scramServer{verifier: verifier}
+}
+
+// handleClientFirst handles the client-first-message and returns the server-first-message.
+func (s *scramServer) handleClientFirst(msg []byte) ([]byte, error) {
+	// gs2-header: gs2-cbind-flag "," [ authzid ] ","
+	str := string(msg)
+	var rest string
+	switch {
+	case strings.HasPrefix(str, "n,"), strings.HasPrefix(str, "y,"):
+	case strings.HasPrefix(str, "p="):
+		return nil, moerr.NewInvalidInputNoCtx("SCRAM channel binding is not supported")
+	default:
+		return nil, moerr.NewInvalidInputNoCtx("malformed SCRAM message")
+	}
+	idx := strings.Index(str[2:], ",")
+	if idx < 0 {
+		return nil, moerr.NewInvalidInputNoCtx("malformed SCRAM message")
+	}
+	s.gs2Header = str[:2+idx+1]
+	rest = str[2+idx+1:]
+	s.clientFirstBare = rest
+
+	// client-first-message-bare: [reserved-mext ","] username "," nonce ["," extensions]
+	// The user name is ignored, the one in the startup message is used.
+	var clientNonce string
+	for _, attr := range strings.Split(rest, ",") {
+		if strings.HasPrefix(attr, "m=") {
+			return nil, moerr.NewInvalidInputNoCtx("SCRAM extensions are not supported")
+		}
+		if strings.HasPrefix(attr, "r=") {
+			clientNonce = attr[2:]
+		}
+	}
+	if clientNonce == "" {
+		return nil, moerr.NewInvalidInputNoCtx("malformed SCRAM message")
+	}
+
+	serverNonce := make([]byte, scramNonceLength)
+	if _, err := rand.Read(serverNonce); err != nil {
+		return nil, err
+	}
+	s.nonce = clientNonce + base64.RawStdEncoding.EncodeToString(serverNonce)
+	s.serverFirst = "r=" + s.nonce +
+		",s=" + base64.StdEncoding.EncodeToString(s.verifier.salt) +
+		",i=" + strconv.Itoa(s.verifier.iterations)
+	return []byte(s.serverFirst), nil
+}
+
+// handleClientFinal verifies the proof in the client-final-message.
+// It returns the server-final-message if the proof is right.
+func (s *scramServer) handleClientFinal(msg []byte) ([]byte, bool, error) {
+	str := string(msg)
+	idx := strings.LastIndex(str, ",p=")
+	if idx < 0 {
+		return nil, false, moerr.NewInvalidInputNoCtx("malformed SCRAM message")
+	}
+	withoutProof := str[:idx]
+	proof, err := base64.StdEncoding.DecodeString(str[idx+3:])
+	if err != nil || len(proof) != sha256.Size {
+		return nil, false, moerr.NewInvalidInputNoCtx("malformed SCRAM message")
+	}
+
+	var channelBinding, nonce string
+	for _, attr := range strings.Split(withoutProof, ",") {
+		switch {
+		case strings.HasPrefix(attr, "c="):
+			channelBinding = attr[2:]
+		case strings.HasPrefix(attr, "r="):
+			nonce = attr[2:]
+		}
+	}
+	cb, err := base64.StdEncoding.DecodeString(channelBinding)
+	if err != nil || !bytes.Equal(cb, []byte(s.gs2Header)) {
+		return nil, false, moerr.NewInvalidInputNoCtx("SCRAM channel binding check failed")
+	}
+	if nonce != s.nonce {
+		return nil, false, moerr.NewInvalidInputNoCtx("SCRAM nonce mismatch")
+	}
+
+	authMessage := s.clientFirstBare + "," + s.serverFirst + "," + withoutProof
+	clientSignature := scramHmac(s.verifier.storedKey, authMessage)
+	clientKey := make([]byte, sha256.Size)
+	for i := range clientKey {
+		clientKey[i] = proof[i] ^ clientSignature[i]
+	}
+	storedKey := sha256.Sum256(clientKey)
+	if subtle.ConstantTimeCompare(storedKey[:], s.verifier.storedKey) != 1 {
+		return nil, false, nil
+	}
+	serverSignature := scramHmac(s.verifier.serverKey, authMessage)
+	return []byte("v=" + base64.StdEncoding.EncodeToString(serverSignature)), true, nil
+}
+
+func scramHmac(key []byte, msg string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(msg))
+	return h.Sum(nil)
+}
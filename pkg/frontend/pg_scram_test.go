@@ -0,0 +1,153 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_scramVerifier(t *testing.T) {
+	s, err := makeScramVerifier("pencil")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(s, "SCRAM-SHA-256$4096:"))
+
+	v, err := parseScramVerifier(s)
+	require.NoError(t, err)
+	require.Equal(t, s, v.String())
+
+	expected, err := newScramVerifier("pencil", v.salt, v.iterations)
+	require.NoError(t, err)
+	require.Equal(t, expected, v)
+
+	for _, bad := range []string{
+		"",
+		"md5abc",
+		"SCRAM-SHA-256$4096$a:b",
+		"SCRAM-SHA-256$x:c2FsdA==$" + base64.StdEncoding.EncodeToString(v.storedKey) + ":" + base64.StdEncoding.EncodeToString(v.serverKey),
+		"SCRAM-SHA-256$4096:c2FsdA==$YQ==:YQ==",
+	} {
+		_, err = parseScramVerifier(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+// the example of RFC 7677
+func Test_scramServer_RFC7677(t *testing.T) {
+	salt, err := base64.StdEncoding.DecodeString("W22ZaJ0SNY7soEsUEjb6gQ==")
+	require.NoError(t, err)
+	v, err := newScramVerifier("pencil", salt, 4096)
+	require.NoError(t, err)
+
+	s := newScramServer(v)
+	_, err = s.handleClientFirst([]byte("n,,n=user,r=rOprNGfwEbeRWgbNEkqO"))
+	require.NoError(t, err)
+	// replace the random nonce with the one of the example
+	s.nonce = "rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0"
+	s.serverFirst = "r=" + s.nonce + ",s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096"
+
+	final, ok, err := s.handleClientFinal([]byte("c=biws,r=rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0,p=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ="))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "v=6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4=", string(final))
+}
+
+func Test_scramServer(t *testing.T) {
+	s, err := makeScramVerifier("pencil")
+	require.NoError(t, err)
+	v, err := parseScramVerifier(s)
+	require.NoError(t, err)
+
+	exchange := func(password string) (bool, error) {
+		server := newScramServer(v)
+		clientFirstBare := "n=,r=clientnonce"
+		serverFirst, err := server.handleClientFirst([]byte("n,," + clientFirstBare))
+		if err != nil {
+			return false, err
+		}
+		require.True(t, strings.HasPrefix(string(serverFirst), "r=clientnonce"))
+
+		// the client side
+		saltedPassword, err := pbkdf2.Key(sha256.New, password, v.salt, v.iterations, sha256.Size)
+		require.NoError(t, err)
+		clientKey := scramHmac(saltedPassword, "Client Key")
+		storedKey := sha256.Sum256(clientKey)
+		withoutProof := "c=biws,r=" + server.nonce
+		authMessage := clientFirstBare + "," + string(serverFirst) + "," + withoutProof
+		signature := scramHmac(storedKey[:], authMessage)
+		proof := make([]byte, len(clientKey))
+		for i := range proof {
+			proof[i] = clientKey[i] ^ signature[i]
+		}
+		final, ok, err := server.handleClientFinal([]byte(withoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof)))
+		if ok {
+			serverSignature := scramHmac(scramHmac(saltedPassword, "Server Key"), authMessage)
+			require.Equal(t, "v="+base64.StdEncoding.EncodeToString(serverSignature), string(final))
+		}
+		return ok, err
+	}
+
+	ok, err := exchange("pencil")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = exchange("pen")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// the mocked verifier never matches
+	v = mockScramVerifier("sys:user")
+	ok, err = exchange("pencil")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// the mocked verifier of a user does not change between the attempts
+	require.Equal(t, v, mockScramVerifier("sys:user"))
+	require.NotEqual(t, v.salt, mockScramVerifier("sys:other").salt)
+	require.Equal(t, scramMockSalt("sys:user"), v.salt)
+}
+
+func Test_scramServer_malformed(t *testing.T) {
+	v := mockScramVerifier("sys:user")
+	for _, msg := range []string{
+		"p=tls-server-end-point,,n=user,r=abc",
+		"x,,n=user,r=abc",
+		"n,,n=user",
+		"n,,m=ext,n=user,r=abc",
+	} {
+		_, err := newScramServer(v).handleClientFirst([]byte(msg))
+		require.Error(t, err, msg)
+	}
+
+	s := newScramServer(v)
+	_, err := s.handleClientFirst([]byte("n,,n=user,r=abc"))
+	require.NoError(t, err)
+	proof := base64.StdEncoding.EncodeToString(make([]byte, sha256.Size))
+	for _, msg := range []string{
+		"c=biws,r=" + s.nonce,
+		"c=biws,r=" + s.nonce + ",p=YQ==",
+		"c=eSws,r=" + s.nonce + ",p=" + proof,
+		"c=biws,r=abc,p=" + proof,
+	} {
+		_, ok, err := s.handleClientFinal([]byte(msg))
+		require.Error(t, err, msg)
+		require.False(t, ok)
+	}
+}
@@ -0,0 +1,769 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/postgresql"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+)
+
+/*
+The listener of the PostgreSQL frontend/backend protocol v3.
+
+The statements are translated into the mysql dialect and executed by the same
+routine and session as the mysql protocol:
+
+	Query   -> COM_QUERY
+	Parse   -> COM_STMT_PREPARE
+	Execute -> COM_STMT_EXECUTE, or COM_QUERY for the statement can not be prepared
+	Close   -> COM_STMT_CLOSE
+
+The PgProtocolImpl writes the results of them in the PostgreSQL protocol.
+*/
+
+const pgServerVersion = "14.0 (MatrixOne)"
+
+// pgConnIO reads and writes the messages on the Conn, which
+// applies the read timeout and counts the output bytes.
+type pgConnIO struct {
+	rs *Conn
+}
+
+func (c pgConnIO) Read(p []byte) (int, error) {
+	return c.rs.ReadFromConn(p)
+}
+
+func (c pgConnIO) Write(p []byte) (int, error) {
+	if err := c.rs.WriteToConn(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func newPgBackend(rs *Conn) *pgproto3.Backend {
+	return pgproto3.NewBackend(pgConnIO{rs: rs}, pgConnIO{rs: rs})
+}
+
+func (mo *MOServer) handlePgConn(ctx context.Context, conn net.Conn) {
+	var rs *Conn
+	var err error
+	defer func() {
+		if rs != nil {
+			if err := rs.Close(); err != nil {
+				logutil.LogConnectionCloseError("Close conn error", err)
+			}
+		}
+	}()
+
+	rs, err = NewIOSession(conn, mo.pu, mo.service)
+	if err != nil {
+		logutil.Error("NewIOSession error", zap.Error(err))
+		return
+	}
+	//set connect timeout
+	rs.SetTimeout(getPu(mo.service).SV.ConnectTimeout.Duration)
+
+	backend, startup, err := mo.pgStartup(rs)
+	if err != nil {
+		logutil.Error("PostgreSQL startup error", zap.Error(err))
+		return
+	}
+	if startup == nil {
+		// the CancelRequest
+		return
+	}
+	err = mo.rm.createdPg(rs)
+	if err != nil {
+		logutil.Error("Create routine error", zap.Error(err))
+		return
+	}
+	err = mo.pgHandshake(rs, backend, startup)
+	if err != nil {
+		logutil.Error("HandShake error", zap.Error(err))
+		return
+	}
+	if err = mo.pgHandleLoop(ctx, rs, backend); err != nil {
+		logutil.LogConnectionCloseError("handle session failed", err)
+	}
+}
+
+// pgStartup receives the startup message. It upgrades the connection to TLS
+// for the SSLRequest and handles the CancelRequest, which has no startup message.
+func (mo *MOServer) pgStartup(rs *Conn) (*pgproto3.Backend, *pgproto3.StartupMessage, error) {
+	backend := newPgBackend(rs)
+	for {
+		msg, err := backend.ReceiveStartupMessage()
+		if err != nil {
+			return nil, nil, err
+		}
+		switch m := msg.(type) {
+		case *pgproto3.SSLRequest:
+			tlsConfig := mo.rm.getTlsConfig()
+			if !getPu(mo.service).SV.EnableTls || tlsConfig == nil {
+				if err = rs.WriteToConn([]byte{'N'}); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+			if err = rs.WriteToConn([]byte{'S'}); err != nil {
+				return nil, nil, err
+			}
+			tlsConn := tls.Server(rs.RawConn(), tlsConfig)
+			tlsCtx, cancelFun := context.WithTimeoutCause(mo.rm.getCtx(), 20*time.Second, moerr.CauseHandshake2)
+			err = tlsConn.HandshakeContext(tlsCtx)
+			if err != nil {
+				err = moerr.AttachCause(tlsCtx, err)
+			}
+			cancelFun()
+			if err != nil {
+				return nil, nil, err
+			}
+			rs.UseConn(tlsConn)
+			backend = newPgBackend(rs)
+		case *pgproto3.GSSEncRequest:
+			if err = rs.WriteToConn([]byte{'N'}); err != nil {
+				return nil, nil, err
+			}
+		case *pgproto3.CancelRequest:
+			mo.pgCancel(m)
+			return backend, nil, nil
+		case *pgproto3.StartupMessage:
+			return backend, m, nil
+		default:
+			return nil, nil, moerr.NewInvalidInputNoCtxf("unexpected startup message %T", msg)
+		}
+	}
+}
+
+// pgCancel cancels the query running in the connection with the process id
+// and the secret key in the BackendKeyData.
+func (mo *MOServer) pgCancel(req *pgproto3.CancelRequest) {
+	rt := mo.rm.getRoutineByConnID(req.ProcessID)
+	if rt == nil {
+		return
+	}
+	pp, ok := rt.getProtocol().(*PgProtocolImpl)
+	if !ok || pp.secretKey != req.SecretKey {
+		return
+	}
+	logutil.Infof("cancel the query of the connection %d", req.ProcessID)
+	rt.killQuery(false, "")
+}
+
+func (mo *MOServer) pgHandshake(rs *Conn, backend *pgproto3.Backend, startup *pgproto3.StartupMessage) error {
+	rm := mo.rm
+	ctx, span := trace.Start(rm.getCtx(), "MOServer.pgHandshake",
+		trace.WithKind(trace.SpanKindStatement))
+	defer span.End()
+
+	routine := rm.getRoutine(rs)
+	if routine == nil {
+		return moerr.NewInternalError(ctx, "routine does not exist")
+	}
+	pp, ok := routine.getProtocol().(*PgProtocolImpl)
+	if !ok {
+		return moerr.NewInternalError(ctx, "the routine is not of the PostgreSQL protocol")
+	}
+	pp.setBackend(backend)
+
+	ses := routine.getSession()
+	ts := ses.timestampMap
+	ts[TSEstablishStart] = time.Now()
+
+	user := startup.Parameters["user"]
+	dbName := startup.Parameters["database"]
+	if user == "" {
+		_ = pp.SendError("28000", "no PostgreSQL user name specified in startup packet")
+		_ = pp.Flush()
+		return moerr.NewInvalidInput(ctx, "no user name in the startup message")
+	}
+	pp.SetStr(USERNAME, user)
+	pp.SetStr(DBNAME, dbName)
+
+	if err := mo.pgAuthenticate(ctx, ses, pp, backend, user, dbName); err != nil {
+		ses.Errorf(ctx, "authenticate user failed.error:%v", err)
+		errorCode, sqlState, msg := RewriteError(err, user)
+		_ = pp.SendError(pgSQLState(errorCode, sqlState), msg)
+		_ = pp.Flush()
+		return err
+	}
+
+	for _, param := range []pgproto3.ParameterStatus{
+		{Name: "server_version", Value: pgServerVersion},
+		{Name: "server_encoding", Value: "UTF8"},
+		{Name: "client_encoding", Value: "UTF8"},
+		{Name: "DateStyle", Value: "ISO, MDY"},
+		{Name: "IntervalStyle", Value: "postgres"},
+		{Name: "TimeZone", Value: ses.GetTimeZone().String()},
+		{Name: "integer_datetimes", Value: "on"},
+		{Name: "standard_conforming_strings", Value: "on"},
+	} {
+		if err := pp.SendMessage(&param); err != nil {
+			return err
+		}
+	}
+	if err := pp.SendMessage(&pgproto3.BackendKeyData{ProcessID: pp.connectionID, SecretKey: pp.secretKey}); err != nil {
+		return err
+	}
+	if err := mo.pgReadyForQuery(ses, pp); err != nil {
+		return err
+	}
+	pp.SetBool(ESTABLISHED, true)
+
+	ts[TSEstablishEnd] = time.Now()
+	v2.EstablishDurationHistogram.Observe(ts[TSEstablishEnd].Sub(ts[TSEstablishStart]).Seconds())
+	ses.Infof(ctx, "mo accept PostgreSQL connection, time cost of Created: %s, Establish: %s, Authenticate: %s",
+		ts[TSCreatedEnd].Sub(ts[TSCreatedStart]).String(),
+		ts[TSEstablishEnd].Sub(ts[TSEstablishStart]).String(),
+		ts[TSAuthenticateEnd].Sub(ts[TSAuthenticateStart]).String())
+
+	if dbName != "" {
+		ses.SetDatabaseName(dbName)
+	}
+	rm.sessionManager.AddSession(ses)
+	return nil
+}
+
+// pgAuthenticate authenticates the user with SCRAM-SHA-256.
+func (mo *MOServer) pgAuthenticate(ctx context.Context, ses *Session, pp *PgProtocolImpl, backend *pgproto3.Backend, user, dbName string) error {
+	ses.timestampMap[TSAuthenticateStart] = time.Now()
+	defer func() {
+		ses.timestampMap[TSAuthenticateEnd] = time.Now()
+		v2.AuthenticateDurationHistogram.Observe(ses.timestampMap[TSAuthenticateEnd].Sub(ses.timestampMap[TSAuthenticateStart]).Seconds())
+	}()
+
+	if getPu(mo.service).SV.SkipCheckUser {
+		ses.Debugf(ctx, "skip authenticate user")
+		tenant, err := GetTenantInfo(ctx, user)
+		if err != nil {
+			return err
+		}
+		ses.SetTenantInfo(tenant)
+		return pp.SendMessage(&pgproto3.AuthenticationOk{})
+	}
+
+	verifier, err := getScramVerifierOfUser(ctx, ses, user)
+	if err != nil {
+		return err
+	}
+	server := newScramServer(verifier)
+
+	if err = pp.SendMessage(&pgproto3.AuthenticationSASL{AuthMechanisms: []string{scramSHA256Mechanism}}); err != nil {
+		return err
+	}
+	if err = pp.Flush(); err != nil {
+		return err
+	}
+	backend.SetAuthType(pgproto3.AuthTypeSASL)
+	msg, err := backend.Receive()
+	if err != nil {
+		return err
+	}
+	initial, ok := msg.(*pgproto3.SASLInitialResponse)
+	if !ok {
+		return moerr.NewInvalidInputf(ctx, "unexpected message %T in the authentication", msg)
+	}
+	if initial.AuthMechanism != scramSHA256Mechanism {
+		return moerr.NewInvalidInputf(ctx, "unsupported SASL authentication mechanism %s", initial.AuthMechanism)
+	}
+	serverFirst, err := server.handleClientFirst(initial.Data)
+	if err != nil {
+		return err
+	}
+
+	if err = pp.SendMessage(&pgproto3.AuthenticationSASLContinue{Data: serverFirst}); err != nil {
+		return err
+	}
+	if err = pp.Flush(); err != nil {
+		return err
+	}
+	backend.SetAuthType(pgproto3.AuthTypeSASLContinue)
+	msg, err = backend.Receive()
+	if err != nil {
+		return err
+	}
+	final, ok := msg.(*pgproto3.SASLResponse)
+	if !ok {
+		return moerr.NewInvalidInputf(ctx, "unexpected message %T in the authentication", msg)
+	}
+	serverFinal, passed, err := server.handleClientFinal(final.Data)
+	if err != nil {
+		return err
+	}
+
+	// AuthenticateUser checks the account, the user, the role and the database,
+	// and records the failed login attempts.
+	_, err = ses.AuthenticateUser(ctx, user, dbName, nil, nil, func([]byte, []byte, []byte) bool {
+		return passed
+	})
+	if err != nil {
+		return err
+	}
+	// the special users do not check the password in AuthenticateUser
+	if !passed {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	if err = ses.InitSystemVariables(ctx, bh); err != nil {
+		return err
+	}
+
+	if err = pp.SendMessage(&pgproto3.AuthenticationSASLFinal{Data: serverFinal}); err != nil {
+		return err
+	}
+	return pp.SendMessage(&pgproto3.AuthenticationOk{})
+}
+
+// getScramVerifierOfUser gets the SCRAM verifier of the user. The one of the user that
+// does not exist or has no verifier is a mocked one, so that the authentication fails
+// as a wrong password.
+func getScramVerifierOfUser(ctx context.Context, ses *Session, userInput string) (*scramVerifier, error) {
+	tenant, err := GetTenantInfo(ctx, userInput)
+	if err != nil {
+		return nil, err
+	}
+
+	username := tenant.GetTenant() + ":" + tenant.GetUser()
+
+	// the password of the special user is kept in memory
+	if isSpecial, pwdBytes, specialAccount := isSpecialUser(tenant.GetUser()); isSpecial && specialAccount.IsMoAdminRole() {
+		return newScramVerifier(string(pwdBytes), scramMockSalt(username), scramIterations)
+	}
+
+	bh := ses.GetBackgroundExec(ctx, &BackgroundExecOption{fromRealUser: true})
+	defer bh.Close()
+
+	sysTenantCtx := defines.AttachAccount(ctx, uint32(sysAccountID), uint32(rootID), uint32(moAdminRoleID))
+	sql, err := getSqlForCheckTenant(sysTenantCtx, tenant.GetTenant())
+	if err != nil {
+		return nil, err
+	}
+	rsset, err := executeSQLInBackgroundSession(sysTenantCtx, bh, sql)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(rsset) {
+		return mockScramVerifier(username), nil
+	}
+	tenantID, err := rsset[0].GetInt64(sysTenantCtx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantCtx := defines.AttachAccountId(ctx, uint32(tenantID))
+	sql, err = getSqlForScramVerifierOfUser(tenantCtx, tenant.GetUser())
+	if err != nil {
+		return nil, err
+	}
+	rsset, err = executeSQLInBackgroundSession(tenantCtx, bh, sql)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(rsset) {
+		return mockScramVerifier(username), nil
+	}
+	verifier, err := rsset[0].GetString(tenantCtx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if verifier == "" {
+		// the password is set before the PostgreSQL protocol is supported. It fails
+		// as a wrong password, so that the client can not tell the user exists.
+		logutil.Warn("the user has no SCRAM verifier, set the password again to login with the PostgreSQL protocol",
+			zap.String("tenant", tenant.GetTenant()),
+			zap.String("user", tenant.GetUser()))
+		return mockScramVerifier(username), nil
+	}
+	return parseScramVerifier(verifier)
+}
+
+func (mo *MOServer) pgReadyForQuery(ses *Session, pp *PgProtocolImpl) error {
+	status := pgServerStatus(ses.GetTxnHandler().GetServerStatus())
+	if err := pp.SendMessage(&pgproto3.ReadyForQuery{TxStatus: status}); err != nil {
+		return err
+	}
+	return pp.Flush()
+}
+
+// pgHandler keeps the statements and the portals of the extended query.
+type pgHandler struct {
+	mo      *MOServer
+	rs      *Conn
+	backend *pgproto3.Backend
+	pp      *PgProtocolImpl
+	ses     *Session
+
+	statements map[string]*pgStatement
+	portals    map[string]*pgPortal
+	// skipToSync is true after an error in the extended query.
+	// The messages are discarded until the Sync.
+	skipToSync bool
+}
+
+func (mo *MOServer) pgHandleLoop(ctx context.Context, rs *Conn, backend *pgproto3.Backend) error {
+	routine := mo.rm.getRoutine(rs)
+	if routine == nil {
+		return moerr.NewInternalError(ctx, "routine does not exist")
+	}
+	h := &pgHandler{
+		mo:         mo,
+		rs:         rs,
+		backend:    backend,
+		pp:         routine.getProtocol().(*PgProtocolImpl),
+		ses:        routine.getSession(),
+		statements: make(map[string]*pgStatement),
+		portals:    make(map[string]*pgPortal),
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		if !mo.IsRunning() {
+			return nil
+		}
+		mo.applyIdleTimeout(rs)
+		msg, err := backend.Receive()
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return err
+		}
+		quit, err := h.handle(msg)
+		if err != nil {
+			if skipClientQuit(err.Error()) {
+				return nil
+			}
+			return err
+		}
+		if quit {
+			return nil
+		}
+	}
+}
+
+// handle handles one message. It returns true when the client quits.
+func (h *pgHandler) handle(msg pgproto3.FrontendMessage) (bool, error) {
+	if h.skipToSync {
+		switch msg.(type) {
+		case *pgproto3.Sync:
+		case *pgproto3.Terminate:
+			return true, nil
+		default:
+			return false, nil
+		}
+	}
+
+	var err error
+	switch m := msg.(type) {
+	case *pgproto3.Query:
+		err = h.handleQuery(m.String)
+	case *pgproto3.Parse:
+		err = h.handleParse(m)
+	case *pgproto3.Bind:
+		err = h.handleBind(m)
+	case *pgproto3.Describe:
+		err = h.handleDescribe(m)
+	case *pgproto3.Execute:
+		err = h.handleExecute(m)
+	case *pgproto3.Close:
+		err = h.handleClose(m)
+	case *pgproto3.Sync:
+		h.skipToSync = false
+		err = h.mo.pgReadyForQuery(h.ses, h.pp)
+	case *pgproto3.Flush:
+		err = h.pp.Flush()
+	case *pgproto3.Terminate:
+		return true, nil
+	default:
+		err = h.extendedError("0A000", "unsupported message "+strings.TrimPrefix(fmt.Sprintf("%T", msg), "*pgproto3."))
+	}
+	return false, err
+}
+
+// extendedError sends the error of the extended query and discards the messages until the Sync.
+func (h *pgHandler) extendedError(code, message string) error {
+	h.skipToSync = true
+	return h.pp.SendError(code, message)
+}
+
+// isEmptyQuery returns whether the query has no statement.
+func isEmptyQuery(sql string) bool {
+	return strings.TrimSpace(strings.Trim(strings.TrimSpace(sql), ";")) == ""
+}
+
+// execRequest executes the request in the routine like the one of the mysql protocol.
+func (h *pgHandler) execRequest(cmd CommandType, data []byte, portal *pgPortal, capture bool) error {
+	h.pp.ResetExecution(portal, capture)
+	payload := make([]byte, 0, len(data)+1)
+	payload = append(payload, byte(cmd))
+	payload = append(payload, data...)
+	err := h.mo.rm.Handler(h.rs, payload)
+	if err != nil && skipClientQuit(err.Error()) {
+		return nil
+	}
+	return err
+}
+
+func (h *pgHandler) handleQuery(query string) error {
+	sql, ordinals, err := postgresql.Translate(query)
+	if err != nil {
+		code, msg := pgErrorOf(err)
+		if err = h.pp.SendError(code, msg); err != nil {
+			return err
+		}
+		return h.mo.pgReadyForQuery(h.ses, h.pp)
+	}
+	if len(ordinals) > 0 {
+		if err = h.pp.SendError("42P02", "there is no parameter $1"); err != nil {
+			return err
+		}
+		return h.mo.pgReadyForQuery(h.ses, h.pp)
+	}
+	if isEmptyQuery(sql) {
+		if err = h.pp.SendMessage(&pgproto3.EmptyQueryResponse{}); err != nil {
+			return err
+		}
+		return h.mo.pgReadyForQuery(h.ses, h.pp)
+	}
+	if err = h.execRequest(COM_QUERY, []byte(sql), nil, false); err != nil {
+		return err
+	}
+	return h.mo.pgReadyForQuery(h.ses, h.pp)
+}
+
+func (h *pgHandler) handleParse(m *pgproto3.Parse) error {
+	sql, ordinals, err := postgresql.Translate(m.Query)
+	if err != nil {
+		return h.extendedError(pgErrorOf(err))
+	}
+	if m.Name != "" {
+		if _, ok := h.statements[m.Name]; ok {
+			return h.extendedError("42P05", "prepared statement \""+m.Name+"\" already exists")
+		}
+	} else if err = h.closeStatement(""); err != nil {
+		return err
+	}
+
+	stmt := &pgStatement{
+		sql:       sql,
+		ordinals:  ordinals,
+		paramOIDs: append([]uint32(nil), m.ParameterOIDs...),
+	}
+	if !isEmptyQuery(sql) {
+		if err = h.execRequest(COM_STMT_PREPARE, []byte(sql), nil, true); err != nil {
+			return err
+		}
+		prepareStmt, prepareErr := h.pp.Captured()
+		switch {
+		case prepareErr == nil && prepareStmt != nil:
+			stmt.prepared = true
+			stmt.prepareStmt = prepareStmt
+		case len(ordinals) > 0:
+			// the statement with parameters can only be executed by the prepared statement
+			if prepareErr == nil {
+				prepareErr = moerr.NewInternalErrorNoCtx("can not prepare the statement")
+			}
+			return h.extendedError(pgErrorOf(prepareErr))
+		default:
+			// some statements, like SET and SHOW, can not be prepared.
+			// They are executed as the simple query.
+		}
+	}
+	h.statements[m.Name] = stmt
+	return h.pp.SendMessage(&pgproto3.ParseComplete{})
+}
+
+func (h *pgHandler) handleBind(m *pgproto3.Bind) error {
+	stmt, ok := h.statements[m.PreparedStatement]
+	if !ok {
+		return h.extendedError("26000", "prepared statement \""+m.PreparedStatement+"\" does not exist")
+	}
+	if len(m.Parameters) != stmt.numParams() {
+		return h.extendedError("08P01", "bind message supplies "+strconv.Itoa(len(m.Parameters))+
+			" parameters, but prepared statement \""+m.PreparedStatement+"\" requires "+strconv.Itoa(stmt.numParams()))
+	}
+	// the message is reused by the next Receive
+	params := make([][]byte, len(m.Parameters))
+	for i, p := range m.Parameters {
+		if p != nil {
+			params[i] = append([]byte{}, p...)
+		}
+	}
+	h.portals[m.DestinationPortal] = &pgPortal{
+		stmt:          stmt,
+		params:        params,
+		paramFormats:  append([]int16(nil), m.ParameterFormatCodes...),
+		resultFormats: append([]int16(nil), m.ResultFormatCodes...),
+	}
+	return h.pp.SendMessage(&pgproto3.BindComplete{})
+}
+
+func (h *pgHandler) handleDescribe(m *pgproto3.Describe) error {
+	switch m.ObjectType {
+	case 'S':
+		stmt, ok := h.statements[m.Name]
+		if !ok {
+			return h.extendedError("26000", "prepared statement \""+m.Name+"\" does not exist")
+		}
+		oids := make([]uint32, stmt.numParams())
+		for i := range oids {
+			oids[i] = stmt.paramOID(i + 1)
+		}
+		if err := h.pp.SendMessage(&pgproto3.ParameterDescription{ParameterOIDs: oids}); err != nil {
+			return err
+		}
+		columns, err := h.resultColumns(stmt)
+		if err != nil {
+			return h.extendedError(pgErrorOf(err))
+		}
+		if len(columns) == 0 {
+			return h.pp.SendMessage(&pgproto3.NoData{})
+		}
+		// the formats are unknown before the Bind
+		return h.pp.SendMessage(h.pp.rowDescription(columns, func(int) int16 { return 0 }))
+	case 'P':
+		portal, ok := h.portals[m.Name]
+		if !ok {
+			return h.extendedError("34000", "portal \""+m.Name+"\" does not exist")
+		}
+		columns, err := h.resultColumns(portal.stmt)
+		if err != nil {
+			return h.extendedError(pgErrorOf(err))
+		}
+		if len(columns) == 0 {
+			return h.pp.SendMessage(&pgproto3.NoData{})
+		}
+		portal.described = true
+		return h.pp.SendMessage(h.pp.rowDescription(columns, portal.resultFormat))
+	default:
+		return h.extendedError("08P01", "invalid DESCRIBE message subtype "+string(m.ObjectType))
+	}
+}
+
+// resultColumns returns the columns of the result set of the prepared statement.
+func (h *pgHandler) resultColumns(stmt *pgStatement) ([]pgColumn, error) {
+	if !stmt.prepared {
+		return nil, nil
+	}
+	dcPrepare, ok := stmt.prepareStmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("can not get Prepare plan in prepareStmt")
+	}
+	ctx := h.mo.rm.getCtx()
+	colDefs := plan2.GetResultColumnsFromPlan(dcPrepare.Prepare.Plan)
+	columns := make([]pgColumn, 0, len(colDefs))
+	for _, def := range colDefs {
+		col, err := colDef2MysqlColumn(ctx, def)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, pgColumnOf(col))
+	}
+	return columns, nil
+}
+
+func (h *pgHandler) handleExecute(m *pgproto3.Execute) error {
+	portal, ok := h.portals[m.Portal]
+	if !ok {
+		return h.extendedError("34000", "portal \""+m.Portal+"\" does not exist")
+	}
+	stmt := portal.stmt
+	var err error
+	switch {
+	case isEmptyQuery(stmt.sql):
+		return h.pp.SendMessage(&pgproto3.EmptyQueryResponse{})
+	case stmt.prepared:
+		var stmtID int
+		stmtID, err = GetPrepareStmtID(context.Background(), stmt.prepareStmt.Name)
+		if err != nil {
+			return h.extendedError(pgErrorOf(err))
+		}
+		data := make([]byte, 4)
+		binary.LittleEndian.PutUint32(data, uint32(stmtID))
+		err = h.execRequest(COM_STMT_EXECUTE, data, portal, false)
+	default:
+		err = h.execRequest(COM_QUERY, []byte(stmt.sql), portal, false)
+	}
+	if err != nil {
+		return err
+	}
+	// MaxRows is ignored. All the rows are sent.
+	if h.pp.ErrorSent() {
+		h.skipToSync = true
+	}
+	return nil
+}
+
+func (h *pgHandler) handleClose(m *pgproto3.Close) error {
+	switch m.ObjectType {
+	case 'S':
+		if err := h.closeStatement(m.Name); err != nil {
+			return err
+		}
+	case 'P':
+		delete(h.portals, m.Name)
+	default:
+		return h.extendedError("08P01", "invalid CLOSE message subtype "+string(m.ObjectType))
+	}
+	return h.pp.SendMessage(&pgproto3.CloseComplete{})
+}
+
+// closeStatement deallocates the prepared statement and drops the portals of it.
+func (h *pgHandler) closeStatement(name string) error {
+	stmt, ok := h.statements[name]
+	if !ok {
+		return nil
+	}
+	delete(h.statements, name)
+	for portalName, portal := range h.portals {
+		if portal.stmt == stmt {
+			delete(h.portals, portalName)
+		}
+	}
+	if !stmt.prepared {
+		return nil
+	}
+	stmtID, err := GetPrepareStmtID(context.Background(), stmt.prepareStmt.Name)
+	if err != nil {
+		return nil
+	}
+	data := make([]byte, 4)
+	binary.LittleEndian.PutUint32(data, uint32(stmtID))
+	return h.execRequest(COM_STMT_CLOSE, data, nil, true)
+}
@@ -0,0 +1,435 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+)
+
+// pgColumn is the description of a result column sent in the RowDescription.
+type pgColumn struct {
+	name   string
+	oid    uint32
+	size   int16
+	typmod int32
+}
+
+// pgColumnOf describes the column to the PostgreSQL client.
+// The type of the computation engine is preferred. The mysql type
+// is used for the columns built without it, like the ones of SHOW.
+func pgColumnOf(col Column) pgColumn {
+	c := pgColumn{name: col.Name(), typmod: -1}
+	if mc, ok := col.(*MysqlColumn); ok && mc.engineType.Oid != types.T_any {
+		c.oid, c.size, c.typmod = pgTypeOfEngineType(mc.engineType)
+		return c
+	}
+	c.oid, c.size = pgTypeOfMysqlType(col.ColumnType(), col.IsSigned())
+	return c
+}
+
+// pgTypeOfEngineType maps the type of the computation engine to
+// the OID, the size and the type modifier of the PostgreSQL type.
+func pgTypeOfEngineType(typ types.Type) (oid uint32, size int16, typmod int32) {
+	typmod = -1
+	switch typ.Oid {
+	case types.T_bool:
+		return pgtype.BoolOID, 1, typmod
+	case types.T_int8, types.T_uint8, types.T_int16, types.T_year:
+		return pgtype.Int2OID, 2, typmod
+	case types.T_uint16, types.T_int32:
+		return pgtype.Int4OID, 4, typmod
+	case types.T_uint32, types.T_int64:
+		return pgtype.Int8OID, 8, typmod
+	case types.T_uint64, types.T_bit:
+		// no unsigned 64-bit integer in PostgreSQL
+		return pgtype.NumericOID, -1, typmod
+	case types.T_float32:
+		return pgtype.Float4OID, 4, typmod
+	case types.T_float64:
+		return pgtype.Float8OID, 8, typmod
+	case types.T_decimal64, types.T_decimal128, types.T_decimal256:
+		// the same as numeric(precision, scale) in PostgreSQL
+		return pgtype.NumericOID, -1, (typ.Width<<16 | typ.Scale) + 4
+	case types.T_char:
+		return pgtype.BPCharOID, -1, pgVarcharTypmod(typ.Width)
+	case types.T_varchar:
+		return pgtype.VarcharOID, -1, pgVarcharTypmod(typ.Width)
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return pgtype.ByteaOID, -1, typmod
+	case types.T_json:
+		return pgtype.JSONOID, -1, typmod
+	case types.T_date:
+		return pgtype.DateOID, 4, typmod
+	case types.T_datetime:
+		return pgtype.TimestampOID, 8, typmod
+	case types.T_timestamp:
+		return pgtype.TimestamptzOID, 8, typmod
+	case types.T_time:
+		return pgtype.TimeOID, 8, typmod
+	case types.T_uuid:
+		return pgtype.UUIDOID, 16, typmod
+	default:
+		// text, enum, vectors, geometry and the internal types are sent as text
+		return pgtype.TextOID, -1, typmod
+	}
+}
+
+func pgVarcharTypmod(width int32) int32 {
+	if width <= 0 || width >= types.MaxVarcharLen {
+		return -1
+	}
+	// the header size is included in the type modifier of varchar
+	return width + 4
+}
+
+// pgTypeOfMysqlType maps the mysql type to the OID and the size of the PostgreSQL type.
+func pgTypeOfMysqlType(typ defines.MysqlType, signed bool) (oid uint32, size int16) {
+	switch typ {
+	case defines.MYSQL_TYPE_BOOL:
+		return pgtype.BoolOID, 1
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+		return pgtype.Int2OID, 2
+	case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+		if !signed {
+			return pgtype.Int8OID, 8
+		}
+		return pgtype.Int4OID, 4
+	case defines.MYSQL_TYPE_LONGLONG:
+		if !signed {
+			return pgtype.NumericOID, -1
+		}
+		return pgtype.Int8OID, 8
+	case defines.MYSQL_TYPE_BIT, defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL:
+		return pgtype.NumericOID, -1
+	case defines.MYSQL_TYPE_FLOAT:
+		return pgtype.Float4OID, 4
+	case defines.MYSQL_TYPE_DOUBLE:
+		return pgtype.Float8OID, 8
+	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
+		return pgtype.VarcharOID, -1
+	case defines.MYSQL_TYPE_JSON:
+		return pgtype.JSONOID, -1
+	case defines.MYSQL_TYPE_DATE:
+		return pgtype.DateOID, 4
+	case defines.MYSQL_TYPE_DATETIME:
+		return pgtype.TimestampOID, 8
+	case defines.MYSQL_TYPE_TIMESTAMP:
+		return pgtype.TimestamptzOID, 8
+	case defines.MYSQL_TYPE_TIME:
+		return pgtype.TimeOID, 8
+	case defines.MYSQL_TYPE_UUID:
+		return pgtype.UUIDOID, 16
+	default:
+		return pgtype.TextOID, -1
+	}
+}
+
+// pgValueOf converts the value of a result row into the value which can
+// be encoded as the PostgreSQL type oid by the pgtype.Map.
+// The value is the one extracted by extractRowFromVector or
+// the one in the MysqlResultSet, so datetime, timestamp, time
+// and decimal are strings already.
+func pgValueOf(oid uint32, v any, loc *time.Location) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	switch oid {
+	case pgtype.BoolOID:
+		switch x := v.(type) {
+		case bool:
+			return x, nil
+		case string:
+			return types.ParseBool(x)
+		case []byte:
+			return types.ParseBool(string(x))
+		}
+		i, err := pgInt64Of(v)
+		if err != nil {
+			return nil, err
+		}
+		return i != 0, nil
+	case pgtype.Int2OID, pgtype.Int4OID, pgtype.Int8OID:
+		return pgInt64Of(v)
+	case pgtype.Float4OID:
+		switch x := v.(type) {
+		case float32:
+			return x, nil
+		case float64:
+			return float32(x), nil
+		}
+		f, err := strconv.ParseFloat(pgTextOf(v), 32)
+		return float32(f), err
+	case pgtype.Float8OID:
+		switch x := v.(type) {
+		case float32:
+			return float64(x), nil
+		case float64:
+			return x, nil
+		}
+		return strconv.ParseFloat(pgTextOf(v), 64)
+	case pgtype.NumericOID:
+		var n pgtype.Numeric
+		if err := n.ScanScientific(pgTextOf(v)); err != nil {
+			return nil, err
+		}
+		return n, nil
+	case pgtype.DateOID:
+		switch x := v.(type) {
+		case types.Date:
+			y, m, d, _ := x.Calendar(true)
+			return time.Date(int(y), time.Month(m), int(d), 0, 0, 0, 0, time.UTC), nil
+		case time.Time:
+			return x, nil
+		}
+		d, err := types.ParseDateCast(pgTextOf(v))
+		if err != nil {
+			return nil, err
+		}
+		return pgValueOf(oid, d, loc)
+	case pgtype.TimestampOID, pgtype.TimestamptzOID:
+		if t, ok := v.(time.Time); ok {
+			return t, nil
+		}
+		dt, err := types.ParseDatetime(pgTextOf(v), 6)
+		if err != nil {
+			return nil, err
+		}
+		if oid == pgtype.TimestampOID {
+			return dt.ConvertToGoTime(time.UTC), nil
+		}
+		// the timestamp has been converted into the time zone of the session
+		return dt.ConvertToGoTime(loc), nil
+	case pgtype.TimeOID:
+		t, err := types.ParseTime(pgTextOf(v), 6)
+		if err != nil {
+			return nil, err
+		}
+		return pgtype.Time{Microseconds: int64(t), Valid: true}, nil
+	case pgtype.UUIDOID:
+		switch x := v.(type) {
+		case types.Uuid:
+			return pgtype.UUID{Bytes: x, Valid: true}, nil
+		}
+		u, err := types.ParseUuid(pgTextOf(v))
+		if err != nil {
+			return nil, err
+		}
+		return pgtype.UUID{Bytes: u, Valid: true}, nil
+	case pgtype.ByteaOID:
+		switch x := v.(type) {
+		case []byte:
+			return x, nil
+		}
+		return []byte(pgTextOf(v)), nil
+	default:
+		return pgTextOf(v), nil
+	}
+}
+
+func pgInt64Of(v any) (int64, error) {
+	switch x := v.(type) {
+	case int8:
+		return int64(x), nil
+	case int16:
+		return int64(x), nil
+	case int32:
+		return int64(x), nil
+	case int64:
+		return x, nil
+	case int:
+		return int64(x), nil
+	case uint8:
+		return int64(x), nil
+	case uint16:
+		return int64(x), nil
+	case uint32:
+		return int64(x), nil
+	case uint64:
+		if x > math.MaxInt64 {
+			return 0, moerr.NewOutOfRangeNoCtxf("int8", "value '%d'", x)
+		}
+		return int64(x), nil
+	case types.MoYear:
+		return int64(x), nil
+	case types.Enum:
+		return int64(x), nil
+	case bool:
+		if x {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return strconv.ParseInt(pgTextOf(v), 10, 64)
+}
+
+// pgTextOf formats the value of a result row as text.
+func pgTextOf(v any) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case []byte:
+		return string(x)
+	case bool:
+		return strconv.FormatBool(x)
+	case int8:
+		return strconv.FormatInt(int64(x), 10)
+	case int16:
+		return strconv.FormatInt(int64(x), 10)
+	case int32:
+		return strconv.FormatInt(int64(x), 10)
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case int:
+		return strconv.Itoa(x)
+	case uint8:
+		return strconv.FormatUint(uint64(x), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(x), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(x), 10)
+	case uint64:
+		return strconv.FormatUint(x, 10)
+	case float32:
+		return strconv.FormatFloat(float64(x), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(x, 'g', -1, 64)
+	case types.MoYear:
+		return strconv.FormatInt(int64(x), 10)
+	case types.Enum:
+		return strconv.FormatUint(uint64(x), 10)
+	case types.Date:
+		return x.String()
+	case bytejson.ByteJson:
+		return x.String()
+	case []float32:
+		return types.ArrayToString(x)
+	case []float64:
+		return types.ArrayToString(x)
+	case fmt.Stringer:
+		return x.String()
+	default:
+		return fmt.Sprintf("%v", x)
+	}
+}
+
+// pgDecodeParam decodes the parameter in the Bind message into the
+// value which is set into the parameter vector of the prepared statement.
+// MO plans every parameter as a string, so the value is the text
+// that MO can cast to the type the parameter is used as.
+func pgDecodeParam(m *pgtype.Map, oid uint32, format int16, src []byte, loc *time.Location) (any, error) {
+	if src == nil {
+		return nil, nil
+	}
+	if format == pgtype.TextFormatCode {
+		switch oid {
+		case pgtype.ByteaOID, pgtype.TimestamptzOID:
+		default:
+			return string(src), nil
+		}
+	}
+	switch oid {
+	case pgtype.BoolOID:
+		var v bool
+		if err := m.Scan(oid, format, src, &v); err != nil {
+			return nil, err
+		}
+		return strconv.FormatBool(v), nil
+	case pgtype.Int2OID, pgtype.Int4OID, pgtype.Int8OID:
+		var v int64
+		if err := m.Scan(oid, format, src, &v); err != nil {
+			return nil, err
+		}
+		return strconv.FormatInt(v, 10), nil
+	case pgtype.Float4OID, pgtype.Float8OID:
+		var v float64
+		if err := m.Scan(oid, format, src, &v); err != nil {
+			return nil, err
+		}
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	case pgtype.NumericOID:
+		var v pgtype.Numeric
+		if err := m.Scan(oid, format, src, &v); err != nil {
+			return nil, err
+		}
+		return v.Value()
+	case pgtype.DateOID:
+		var v pgtype.Date
+		if err := m.Scan(oid, format, src, &v); err != nil {
+			return nil, err
+		}
+		if v.InfinityModifier != pgtype.Finite {
+			return nil, moerr.NewInvalidInputNoCtx("infinite date is not supported")
+		}
+		return v.Time.Format(time.DateOnly), nil
+	case pgtype.TimestampOID:
+		var v pgtype.Timestamp
+		if err := m.Scan(oid, format, src, &v); err != nil {
+			return nil, err
+		}
+		if v.InfinityModifier != pgtype.Finite {
+			return nil, moerr.NewInvalidInputNoCtx("infinite timestamp is not supported")
+		}
+		return v.Time.Format(pgTimestampLayout), nil
+	case pgtype.TimestamptzOID:
+		var v pgtype.Timestamptz
+		if err := m.Scan(oid, format, src, &v); err != nil {
+			return nil, err
+		}
+		if v.InfinityModifier != pgtype.Finite {
+			return nil, moerr.NewInvalidInputNoCtx("infinite timestamp is not supported")
+		}
+		// MO takes the timestamp without time zone in the time zone of the session
+		return v.Time.In(loc).Format(pgTimestampLayout), nil
+	case pgtype.TimeOID:
+		var v pgtype.Time
+		if err := m.Scan(oid, format, src, &v); err != nil {
+			return nil, err
+		}
+		return types.Time(v.Microseconds).String2(6), nil
+	case pgtype.UUIDOID:
+		var v pgtype.UUID
+		if err := m.Scan(oid, format, src, &v); err != nil {
+			return nil, err
+		}
+		return v.Value()
+	case pgtype.ByteaOID:
+		var v []byte
+		if err := m.Scan(oid, format, src, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case pgtype.JSONBOID:
+		var v string
+		if err := m.Scan(oid, format, src, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	default:
+		// text, varchar, json and the parameters whose type is unspecified
+		return string(src), nil
+	}
+}
+
+const pgTimestampLayout = "2006-01-02 15:04:05.999999"
@@ -0,0 +1,143 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+)
+
+func Test_pgTypeOfEngineType(t *testing.T) {
+	cases := []struct {
+		typ    types.Type
+		oid    uint32
+		size   int16
+		typmod int32
+	}{
+		{types.T_bool.ToType(), pgtype.BoolOID, 1, -1},
+		{types.T_int8.ToType(), pgtype.Int2OID, 2, -1},
+		{types.T_int32.ToType(), pgtype.Int4OID, 4, -1},
+		{types.T_uint32.ToType(), pgtype.Int8OID, 8, -1},
+		{types.T_int64.ToType(), pgtype.Int8OID, 8, -1},
+		{types.T_uint64.ToType(), pgtype.NumericOID, -1, -1},
+		{types.T_float32.ToType(), pgtype.Float4OID, 4, -1},
+		{types.T_float64.ToType(), pgtype.Float8OID, 8, -1},
+		{types.New(types.T_decimal64, 10, 2), pgtype.NumericOID, -1, 10<<16 | 2 + 4},
+		{types.New(types.T_varchar, 20, 0), pgtype.VarcharOID, -1, 24},
+		{types.New(types.T_varchar, types.MaxVarcharLen, 0), pgtype.VarcharOID, -1, -1},
+		{types.New(types.T_char, 1, 0), pgtype.BPCharOID, -1, 5},
+		{types.T_text.ToType(), pgtype.TextOID, -1, -1},
+		{types.T_blob.ToType(), pgtype.ByteaOID, -1, -1},
+		{types.T_json.ToType(), pgtype.JSONOID, -1, -1},
+		{types.T_date.ToType(), pgtype.DateOID, 4, -1},
+		{types.T_datetime.ToType(), pgtype.TimestampOID, 8, -1},
+		{types.T_timestamp.ToType(), pgtype.TimestamptzOID, 8, -1},
+		{types.T_time.ToType(), pgtype.TimeOID, 8, -1},
+		{types.T_uuid.ToType(), pgtype.UUIDOID, 16, -1},
+		{types.T_array_float32.ToType(), pgtype.TextOID, -1, -1},
+	}
+	for _, c := range cases {
+		oid, size, typmod := pgTypeOfEngineType(c.typ)
+		require.Equal(t, c.oid, oid, c.typ.String())
+		require.Equal(t, c.size, size, c.typ.String())
+		require.Equal(t, c.typmod, typmod, c.typ.String())
+	}
+}
+
+func Test_pgColumnOf(t *testing.T) {
+	col := &MysqlColumn{}
+	col.SetName("a")
+	col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	col.SetSigned(true)
+	c := pgColumnOf(col)
+	require.Equal(t, "a", c.name)
+	require.Equal(t, uint32(pgtype.Int8OID), c.oid)
+
+	// the type of the engine is preferred
+	col.engineType = types.T_int16.ToType()
+	c = pgColumnOf(col)
+	require.Equal(t, uint32(pgtype.Int2OID), c.oid)
+}
+
+func Test_pgValueOf(t *testing.T) {
+	m := pgtype.NewMap()
+	encode := func(oid uint32, v any) string {
+		val, err := pgValueOf(oid, v, time.UTC)
+		require.NoError(t, err)
+		buf, err := m.Encode(oid, pgtype.TextFormatCode, val, nil)
+		require.NoError(t, err)
+		return string(buf)
+	}
+
+	require.Equal(t, "t", encode(pgtype.BoolOID, true))
+	require.Equal(t, "f", encode(pgtype.BoolOID, int8(0)))
+	require.Equal(t, "-5", encode(pgtype.Int2OID, int8(-5)))
+	require.Equal(t, "42", encode(pgtype.Int8OID, uint32(42)))
+	require.Equal(t, "1.5", encode(pgtype.Float8OID, float32(1.5)))
+	require.Equal(t, "12.50", encode(pgtype.NumericOID, "12.50"))
+	require.Equal(t, "18446744073709551615", encode(pgtype.NumericOID, uint64(18446744073709551615)))
+	require.Equal(t, "2024-01-02", encode(pgtype.DateOID, "2024-01-02"))
+	require.Equal(t, "2024-01-02 03:04:05.123456", encode(pgtype.TimestampOID, "2024-01-02 03:04:05.123456"))
+	require.Equal(t, "03:04:05.000000", encode(pgtype.TimeOID, "03:04:05"))
+	require.Equal(t, "abc", encode(pgtype.TextOID, []byte("abc")))
+
+	v, err := pgValueOf(pgtype.Int4OID, nil, time.UTC)
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	_, err = pgValueOf(pgtype.Int8OID, uint64(18446744073709551615), time.UTC)
+	require.Error(t, err)
+}
+
+func Test_pgDecodeParam(t *testing.T) {
+	m := pgtype.NewMap()
+	decode := func(oid uint32, format int16, v any) any {
+		buf, err := m.Encode(oid, format, v, nil)
+		require.NoError(t, err)
+		val, err := pgDecodeParam(m, oid, format, buf, time.UTC)
+		require.NoError(t, err)
+		return val
+	}
+
+	require.Equal(t, "42", decode(pgtype.Int8OID, pgtype.BinaryFormatCode, int64(42)))
+	require.Equal(t, "-7", decode(pgtype.Int2OID, pgtype.BinaryFormatCode, int16(-7)))
+	require.Equal(t, "true", decode(pgtype.BoolOID, pgtype.BinaryFormatCode, true))
+	require.Equal(t, "1.5", decode(pgtype.Float8OID, pgtype.BinaryFormatCode, 1.5))
+	require.Equal(t, "2024-01-02", decode(pgtype.DateOID, pgtype.BinaryFormatCode, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+	require.Equal(t, "2024-01-02 03:04:05.5", decode(pgtype.TimestampOID, pgtype.BinaryFormatCode, time.Date(2024, 1, 2, 3, 4, 5, 500000000, time.UTC)))
+	require.Equal(t, "2024-01-02 03:04:05", decode(pgtype.TimestamptzOID, pgtype.BinaryFormatCode, time.Date(2024, 1, 2, 4, 4, 5, 0, time.FixedZone("", 3600))))
+	require.Equal(t, []byte{1, 2}, decode(pgtype.ByteaOID, pgtype.BinaryFormatCode, []byte{1, 2}))
+	require.Equal(t, "abc", decode(pgtype.TextOID, pgtype.TextFormatCode, "abc"))
+	require.Equal(t, "12", decode(pgtype.Int4OID, pgtype.TextFormatCode, int32(12)))
+
+	// the type is unspecified
+	v, err := pgDecodeParam(m, 0, pgtype.TextFormatCode, []byte("x"), time.UTC)
+	require.NoError(t, err)
+	require.Equal(t, "x", v)
+
+	// NULL
+	v, err = pgDecodeParam(m, pgtype.Int8OID, pgtype.BinaryFormatCode, nil, time.UTC)
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	_, err = pgDecodeParam(m, pgtype.Int8OID, pgtype.BinaryFormatCode, []byte{1}, time.UTC)
+	require.Error(t, err)
+}
@@ -39,7 +39,8 @@ var (
 				login_type  varchar(16),
 				creator int signed,
 				owner int signed,
-				default_role int signed
+				default_role int signed,
+				scram_verifier varchar(256) default ''
     		)`
 
 	MoCatalogMoAccountDDL = `create table mo_catalog.mo_account (
@@ -270,6 +271,17 @@ var (
     			primary key(account_id,task_id,db_name,table_name)
 			)`
 
+	MoCatalogMoStreamOffsetsDDL = `create table mo_catalog.mo_stream_offsets (
+    			group_id varchar(1024),
+    			topic varchar(256),
+    			partition_id int,
+    			db_name varchar(256),
+    			table_name varchar(256),
+    			next_offset bigint,
+    			update_time timestamp,
+    			primary key(group_id,topic,partition_id)
+			)`
+
 	MoCatalogMoISCPLogDDL = `CREATE TABLE mo_catalog.mo_iscp_log (
 				account_id INT UNSIGNED NOT NULL,
 				table_id BIGINT UNSIGNED NOT NULL,
@@ -133,6 +133,10 @@ type MysqlColumn struct {
 
 	//default value
 	defaultValue []byte
+
+	//the type of the column in the computation engine.
+	//its Oid is T_any when the column is not built from the engine type.
+	engineType types.Type
 }
 
 func (mc *MysqlColumn) DefaultValue() []byte {
@@ -242,6 +242,23 @@ func (rm *RoutineManager) GetAccountRoutineManager() *AccountRoutineManager {
 }
 
 func (rm *RoutineManager) Created(rs *Conn) error {
+	return rm.created(rs, func(sid string, connID uint32) (MysqlRrWr, *MysqlProtocolImpl) {
+		pro := NewMysqlClientProtocol(sid, connID, rs, int(getPu(rm.service).SV.MaxBytesInOutbufToFlush), getPu(rm.service).SV)
+		return pro, pro
+	})
+}
+
+// createdPg is the Created of the connection of the PostgreSQL protocol.
+func (rm *RoutineManager) createdPg(rs *Conn) error {
+	return rm.created(rs, func(sid string, connID uint32) (MysqlRrWr, *MysqlProtocolImpl) {
+		pro := NewPgProtocol(sid, connID, rs, getPu(rm.service).SV)
+		return pro, pro.MysqlProtocolImpl
+	})
+}
+
+// created makes the routine and the session of the connection. newProtocol returns
+// the protocol for the routine and the mysql protocol under it.
+func (rm *RoutineManager) created(rs *Conn, newProtocol func(sid string, connID uint32) (MysqlRrWr, *MysqlProtocolImpl)) error {
 	logutil.Debugf("get the connection from %s", rs.RemoteAddress())
 	createdStart := time.Now()
 	connID, err := rm.getConnID()
@@ -253,8 +270,8 @@ func (rm *RoutineManager) Created(rs *Conn) error {
 	if rm.baseService != nil {
 		sid = rm.baseService.ID()
 	}
-	pro := NewMysqlClientProtocol(sid, connID, rs, int(getPu(rm.service).SV.MaxBytesInOutbufToFlush), getPu(rm.service).SV)
-	routine := NewRoutine(rm.getCtx(), pro, getPu(rm.service).SV)
+	rw, pro := newProtocol(sid, connID)
+	routine := NewRoutine(rm.getCtx(), rw, getPu(rm.service).SV)
 	v2.CreatedRoutineCounter.Inc()
 
 	cancelCtx := routine.getCancelRoutineCtx()
@@ -283,7 +300,8 @@ func (rm *RoutineManager) Created(rs *Conn) error {
 	ses.Debugf(cancelCtx, "have done some preparation for the connection %s", rs.RemoteAddress())
 
 	// With proxy module enabled, we try to update salt value and label info from proxy.
-	if getPu(rm.service).SV.ProxyEnabled {
+	// The proxy only serves the mysql protocol.
+	if _, ok := rw.(*PgProtocolImpl); !ok && getPu(rm.service).SV.ProxyEnabled {
 		pro.receiveExtraInfo(rs)
 	}
 	rm.setRoutine(rs, pro.connectionID, routine)
@@ -300,16 +318,25 @@ func (rm *RoutineManager) Closed(rs *Conn) {
 		return
 	}
 
-	defer func() {
-		v2.CloseRoutineCounter.Inc()
-	}()
-
 	ses := rt.getSession()
 	if ses != nil {
 		ses.Debugf(rm.getCtx(), "clean resource of the connection %d:%s", rs.ID(), rs.RemoteAddress())
 		defer func() {
 			ses.Debugf(rm.getCtx(), "resource of the connection %d:%s has been cleaned", rs.ID(), rs.RemoteAddress())
 		}()
+	}
+	rm.releaseRoutine(rt)
+}
+
+// releaseRoutine releases the session and the resources of the routine
+// that has been removed from the manager.
+func (rm *RoutineManager) releaseRoutine(rt *Routine) {
+	defer func() {
+		v2.CloseRoutineCounter.Inc()
+	}()
+
+	ses := rt.getSession()
+	if ses != nil {
 		rt.decreaseCount(func() {
 			account := ses.GetTenantInfo()
 			accountName := sysAccountName
@@ -327,6 +354,55 @@ func (rm *RoutineManager) Closed(rs *Conn) {
 	rt.cleanup()
 }
 
+// createdHttp makes the routine and the session for an HTTP request. There is no
+// Conn under them, so the routine is kept by the connection id only, which is enough
+// for KILL and the processlist to find it.
+func (rm *RoutineManager) createdHttp(peer string, tlsEstablished bool) (*Routine, *HttpProtocolImpl, error) {
+	connID, err := rm.getConnID()
+	if err != nil {
+		logutil.Errorf("failed to get connection ID from HAKeeper: %v", err)
+		return nil, nil, err
+	}
+	sid := ""
+	if rm.baseService != nil {
+		sid = rm.baseService.ID()
+	}
+	hp := NewHttpProtocol(connID, peer, tlsEstablished)
+	routine := NewRoutine(rm.getCtx(), hp, getPu(rm.service).SV)
+	v2.CreatedRoutineCounter.Inc()
+
+	cancelCtx := routine.getCancelRoutineCtx()
+	if rm.baseService != nil {
+		cancelCtx = context.WithValue(cancelCtx, defines.NodeIDKey{}, rm.baseService.ID())
+	}
+
+	ses := NewSession(cancelCtx, sid, hp, nil)
+	ses.SetFromRealUser(true)
+	ses.setRoutineManager(rm)
+	ses.setRoutine(routine)
+	ses.clientAddr = peer
+
+	routine.setSession(ses)
+	hp.Reset(ses)
+
+	rm.mu.Lock()
+	rm.routinesByConnID[connID] = routine
+	rm.mu.Unlock()
+	ses.UpdateDebugString()
+	return routine, hp, nil
+}
+
+// closedHttp releases the routine made by createdHttp.
+func (rm *RoutineManager) closedHttp(rt *Routine) {
+	connID := rt.getConnectionID()
+	rm.mu.Lock()
+	if rm.routinesByConnID[connID] == rt {
+		delete(rm.routinesByConnID, connID)
+	}
+	rm.mu.Unlock()
+	rm.releaseRoutine(rt)
+}
+
 /*
 kill a connection or query.
 if killConnection is true, the query will be canceled first, then the network will be closed.
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -59,7 +60,15 @@ type MOServer struct {
 
 	pu        *config.ParameterUnit
 	listeners []net.Listener
-	service   string
+	// pgListener accepts the connections speaking the PostgreSQL protocol.
+	// It is nil if the PostgreSQL protocol is not enabled.
+	pgListener net.Listener
+	// httpListener and httpServer serve the HTTP SQL endpoint.
+	// They are nil if the endpoint is not enabled.
+	httpListener   net.Listener
+	httpServer     *http.Server
+	httpStatements *httpStatementRegistry
+	service        string
 }
 
 // Server interface is for mock MOServer
@@ -114,6 +123,16 @@ func (mo *MOServer) Stop() error {
 			errors = append(errors, err)
 		}
 	}
+	if mo.pgListener != nil {
+		if err := mo.pgListener.Close(); err != nil {
+			errors = append(errors, err)
+		}
+	}
+	if mo.httpServer != nil {
+		if err := mo.httpServer.Close(); err != nil {
+			errors = append(errors, err)
+		}
+	}
 	if len(errors) > 0 {
 		return errors[0]
 	}
@@ -146,11 +165,20 @@ func (mo *MOServer) startListener() {
 
 	for _, listener := range mo.listeners {
 		mo.wg.Add(1)
-		go mo.startAccept(mo.rm.ctx, listener)
+		go mo.startAccept(mo.rm.ctx, listener, mo.handleConn)
+	}
+	if mo.pgListener != nil {
+		mo.wg.Add(1)
+		go mo.startAccept(mo.rm.ctx, mo.pgListener, mo.handlePgConn)
+	}
+	if mo.httpServer != nil {
+		mo.wg.Add(2)
+		go mo.serveHttp()
+		go mo.sweepHttpStatements(mo.rm.ctx)
 	}
 }
 
-func (mo *MOServer) startAccept(ctx context.Context, listener net.Listener) {
+func (mo *MOServer) startAccept(ctx context.Context, listener net.Listener, handle func(context.Context, net.Conn)) {
 	defer mo.wg.Done()
 
 	var tempDelay time.Duration
@@ -184,7 +212,7 @@ func (mo *MOServer) startAccept(ctx context.Context, listener net.Listener) {
 		}
 		tempDelay = 0
 
-		go mo.handleConn(ctx, conn)
+		go handle(ctx, conn)
 	}
 }
 
@@ -632,6 +660,25 @@ func NewMOServer(
 		}
 		mo.listeners = append(mo.listeners, listenerUnix)
 	}
+	if pu.SV.EnablePgProtocol {
+		pgAddr := fmt.Sprintf("%s:%d", pu.SV.Host, pu.SV.PgPort)
+		mo.pgListener, err = net.Listen("tcp", pgAddr)
+		if err != nil {
+			logutil.Panicf("start server failed with %+v", err)
+		}
+		logutil.Infof("PostgreSQL protocol Listening on : %s ", pgAddr)
+		logutil.Warn("the users whose password is set before the upgrade must set it again to login with the PostgreSQL protocol")
+	}
+	if pu.SV.EnableHttpSql {
+		httpAddr := fmt.Sprintf("%s:%d", pu.SV.Host, pu.SV.HttpSqlPort)
+		mo.httpListener, err = net.Listen("tcp", httpAddr)
+		if err != nil {
+			logutil.Panicf("start server failed with %+v", err)
+		}
+		mo.httpServer = mo.newHttpSqlServer()
+		mo.httpStatements = newHttpStatementRegistry(httpAsyncUserLimit, httpAsyncTotalLimit)
+		logutil.Infof("HTTP SQL endpoint Listening on : %s ", httpAddr)
+	}
 	return mo
 }
 
@@ -77,9 +77,9 @@ func executeStatusStmt(ses *Session, execCtx *ExecCtx) (err error) {
 				return
 			}
 
-			// For parquet format, file is written in exportAllDataFromBatches
+			// For parquet and arrow format, file is written in exportAllDataFromBatches
 			// No need to close pipe-based writer
-			if ep.getExportFormat() != "parquet" {
+			if !ep.isColumnarFormat() {
 				if err = Close(ep); err != nil {
 					return
 				}
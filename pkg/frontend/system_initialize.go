@@ -92,6 +92,13 @@ func createTablesInMoCatalog(ctx context.Context, txn executor.TxnExecutor, fina
 	initMoUser2 := fmt.Sprintf(initMoUserFormat, dumpID, dumpHost, dumpName, encryption, dumpStatus, types.CurrentTimestamp().String2(time.UTC, 0), dumpExpiredTime, dumpLoginType, dumpCreatorID, dumpOwnerRoleID, dumpDefaultRoleID)
 	addSqlIntoSet(initMoUser1)
 	addSqlIntoSet(initMoUser2)
+	for _, name := range []string{rootName, dumpName} {
+		updateScramVerifier, err := getSqlForUpdateScramVerifierOfUser(ctx, defaultPassword, name)
+		if err != nil {
+			return err
+		}
+		addSqlIntoSet(updateScramVerifier)
+	}
 
 	//step4: add new entries to the mo_role_privs
 	//moadmin role
@@ -352,6 +352,8 @@ func getValueFromVector(ctx context.Context, vec *vector.Vector, feSes FeSession
 		return vector.GetArrayAt[float32](vec, 0), nil
 	case types.T_array_float64:
 		return vector.GetArrayAt[float64](vec, 0), nil
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return types.QuantizedArrayBytesToString(vec.GetType().Oid, vec.GetBytesAt(0)), nil
 	case types.T_decimal64:
 		val := vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, 0)
 		return val.Format(expr.Typ.Scale), nil
@@ -1460,6 +1462,7 @@ func setMysqlColumnTypeInfo(ctx context.Context, typ types.Type, col *MysqlColum
 		return err
 	}
 	setMysqlColumnTypeMetadata(col, typ)
+	col.engineType = typ
 	return nil
 }
 
@@ -35,6 +35,9 @@ func NewConsumer(
 	if info.ConsumerType == int8(ConsumerType_IndexSync) {
 		return NewIndexConsumer(cnUUID, cnEngine, cnTxnClient, tableDef, jobID, info)
 	}
+	if info.ConsumerType == int8(ConsumerType_GeneratedCol) {
+		return NewGeneratedColConsumer(cnUUID, cnEngine, cnTxnClient, tableDef, jobID, info)
+	}
 	panic("todo")
 
 }
@@ -0,0 +1,366 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iscp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/sqlquote"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vectorindex/sqlexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// MaxGeneratedColBatch is the max number of rows recomputed by one UPDATE.
+const MaxGeneratedColBatch = 1024
+
+// GenColJobName returns the ISCP job name of the async generated column.
+func GenColJobName(colName string) string {
+	return "gencol_" + colName
+}
+
+// GeneratedColConsumer fills a STORED ASYNC generated column.
+//
+// DML writes NULL into an async column.  The consumer collects the primary
+// keys of the inserted rows whose column is still NULL and recomputes them by
+//
+//	UPDATE db.t SET col = DEFAULT WHERE col IS NULL AND pk IN (...)
+//
+// so a slow expression such as llm_embedding() runs in the background and
+// over a whole batch of rows.  The UPDATE goes through the normal DML path so
+// indexes on the column (including async HNSW/IVF indexes) are maintained.
+type GeneratedColConsumer struct {
+	cnUUID      string
+	cnEngine    engine.Engine
+	cnTxnClient client.TxnClient
+	jobID       JobID
+	info        *ConsumerInfo
+	tableDef    *plan.TableDef
+	colName     string
+	colPos      int
+	pkPos       []int
+	pkType      []*types.Type
+	rowdata     []any
+	keys        []string
+	flushed     []string
+	// skip are the keys updated by the previous iteration, updated are the
+	// keys updated by this one, both with the commit ts of the update.
+	skip    map[string]types.TS
+	updated map[string]types.TS
+}
+
+var _ Consumer = new(GeneratedColConsumer)
+
+// genColUpdated remembers, per job, the keys of the rows the last iteration
+// has updated and the commit ts of the updates.  Consumers are created for
+// every iteration, so the keys are kept here rather than in the consumer.
+// The UPDATE itself shows up as a change in the next iteration; if the
+// expression evaluated to NULL the row would be picked up again forever, so
+// the change of such a key at that commit ts is skipped.  The changes of the
+// key by other transactions are still recomputed.  The next iteration sees
+// all those changes, so it replaces the keys with its own and the map never
+// outgrows one iteration.  The map is lost on restart, which only costs one
+// more UPDATE of the rows whose expression is NULL.
+var genColUpdated = struct {
+	sync.Mutex
+	jobs map[JobID]map[string]types.TS
+}{jobs: make(map[JobID]map[string]types.TS)}
+
+// forgetGenColUpdated drops the updated keys of a dropped job.
+func forgetGenColUpdated(jobID JobID) {
+	genColUpdated.Lock()
+	defer genColUpdated.Unlock()
+	delete(genColUpdated.jobs, jobID)
+}
+
+func NewGeneratedColConsumer(cnUUID string,
+	cnEngine engine.Engine,
+	cnTxnClient client.TxnClient,
+	tableDef *plan.TableDef,
+	jobID JobID,
+	info *ConsumerInfo) (Consumer, error) {
+
+	if len(info.Columns) != 1 {
+		return nil, moerr.NewInternalErrorNoCtxf("generated column consumer: invalid columns %v", info.Columns)
+	}
+	colName := info.Columns[0]
+	colPos, ok := tableDef.Name2ColIndex[colName]
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtxf("generated column consumer: column %s not found", colName)
+	}
+	col := tableDef.Cols[colPos]
+	if col.GeneratedCol == nil || !col.GeneratedCol.IsAsync {
+		return nil, moerr.NewInternalErrorNoCtxf("generated column consumer: column %s is not an async generated column", colName)
+	}
+	if tableDef.Pkey == nil || len(tableDef.Pkey.Names) == 0 {
+		return nil, moerr.NewInternalErrorNoCtxf("generated column consumer: table %s has no primary key", tableDef.Name)
+	}
+
+	c := &GeneratedColConsumer{
+		cnUUID:      cnUUID,
+		cnEngine:    cnEngine,
+		cnTxnClient: cnTxnClient,
+		jobID:       jobID,
+		info:        info,
+		tableDef:    tableDef,
+		colName:     colName,
+		colPos:      int(colPos),
+		rowdata:     make([]any, len(tableDef.Cols)),
+	}
+	for _, name := range tableDef.Pkey.Names {
+		pos, ok := tableDef.Name2ColIndex[name]
+		if !ok {
+			return nil, moerr.NewInternalErrorNoCtxf("generated column consumer: primary key column %s not found", name)
+		}
+		typ := tableDef.Cols[pos].Typ
+		c.pkPos = append(c.pkPos, int(pos))
+		c.pkType = append(c.pkType, &types.Type{Oid: types.T(typ.Id), Width: typ.Width, Scale: typ.Scale})
+	}
+	return c, nil
+}
+
+func (c *GeneratedColConsumer) Consume(ctx context.Context, r DataRetriever) error {
+	datatype := r.GetDataType()
+	c.keys = c.keys[:0]
+
+	genColUpdated.Lock()
+	c.skip = genColUpdated.jobs[c.jobID]
+	genColUpdated.Unlock()
+	c.updated = make(map[string]types.TS)
+
+	err := c.consume(ctx, r, datatype)
+
+	genColUpdated.Lock()
+	defer genColUpdated.Unlock()
+	if err != nil {
+		// the iteration is retried from the same watermark, keep the old keys
+		// along with the keys of the chunks committed so far.
+		if len(c.updated) > 0 {
+			for key, ts := range c.skip {
+				if _, ok := c.updated[key]; !ok {
+					c.updated[key] = ts
+				}
+			}
+			genColUpdated.jobs[c.jobID] = c.updated
+		}
+		return err
+	}
+	if len(c.updated) == 0 {
+		delete(genColUpdated.jobs, c.jobID)
+	} else {
+		genColUpdated.jobs[c.jobID] = c.updated
+	}
+	return nil
+}
+
+func (c *GeneratedColConsumer) consume(ctx context.Context, r DataRetriever, datatype int8) error {
+	for {
+		noMoreData, err := c.processISCPData(ctx, r.Next(), datatype)
+		if err != nil {
+			return err
+		}
+		if noMoreData {
+			break
+		}
+		// snapshot data may be the whole table, recompute it in chunks and
+		// commit every chunk.
+		if datatype == ISCPDataType_Snapshot && len(c.keys) >= MaxGeneratedColBatch {
+			if err = c.runTxn(ctx, r, 30*time.Minute, func(sqlproc *sqlexec.SqlProcess) error {
+				return c.flush(sqlproc)
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if datatype == ISCPDataType_Snapshot {
+		if len(c.keys) == 0 {
+			return nil
+		}
+		return c.runTxn(ctx, r, 30*time.Minute, func(sqlproc *sqlexec.SqlProcess) error {
+			return c.flush(sqlproc)
+		})
+	}
+
+	// all updates of the tail and the watermark are committed together.
+	return c.runTxn(ctx, r, 24*time.Hour, func(sqlproc *sqlexec.SqlProcess) error {
+		if err := c.flush(sqlproc); err != nil {
+			return err
+		}
+		return r.UpdateWatermark(sqlproc.GetContext(), sqlproc.SqlCtx.GetService(), sqlproc.SqlCtx.Txn())
+	})
+}
+
+// runTxn runs cb in a new transaction and, once it is committed, adds the
+// keys flushed by cb to c.updated with the commit ts.
+func (c *GeneratedColConsumer) runTxn(
+	ctx context.Context,
+	r DataRetriever,
+	timeout time.Duration,
+	cb func(sqlproc *sqlexec.SqlProcess) error,
+) error {
+	c.flushed = c.flushed[:0]
+	var txnOp client.TxnOperator
+	err := sqlexec.RunTxnWithSqlContext(ctx, c.cnEngine, c.cnTxnClient, c.cnUUID, r.GetAccountID(), timeout, nil, nil,
+		func(sqlproc *sqlexec.SqlProcess, _ any) error {
+			txnOp = sqlproc.SqlCtx.Txn()
+			return cb(sqlproc)
+		})
+	if err != nil {
+		return err
+	}
+	if len(c.flushed) == 0 {
+		return nil
+	}
+	commitTS := types.TimestampToTS(txnOp.Txn().CommitTS)
+	for _, key := range c.flushed {
+		c.updated[key] = commitTS
+	}
+	return nil
+}
+
+func (c *GeneratedColConsumer) processISCPData(ctx context.Context, data *ISCPData, datatype int8) (bool, error) {
+	if data == nil {
+		return true, nil
+	}
+	defer data.Done()
+
+	if data.err != nil {
+		return true, data.err
+	}
+	if data.noMoreData {
+		return true, nil
+	}
+	// deletes need nothing, the row is gone with its generated value.
+	if data.insertBatch == nil {
+		return false, nil
+	}
+
+	if datatype == ISCPDataType_Snapshot {
+		// the snapshot has no commit ts of the rows, nothing is skipped
+		for _, bat := range data.insertBatch.Batches {
+			for i := 0; i < batchRowCount(bat); i++ {
+				if err := extractRowFromEveryVector(ctx, bat, i, c.rowdata); err != nil {
+					return true, err
+				}
+				if err := c.addRow(ctx, types.TS{}); err != nil {
+					return true, err
+				}
+			}
+		}
+		return false, nil
+	}
+
+	if data.insertBatch.Rows == nil {
+		return false, nil
+	}
+	iter := data.insertBatch.GetRowIterator().(*atomicBatchRowIter)
+	defer iter.Close()
+	for iter.Next() {
+		if err := iter.Row(ctx, c.rowdata); err != nil {
+			return true, err
+		}
+		if err := c.addRow(ctx, iter.Item().Ts); err != nil {
+			return true, err
+		}
+	}
+	return false, nil
+}
+
+// addRow queues the row in c.rowdata if its generated column is NULL and the
+// row is not the one written by the last iteration at ts.
+func (c *GeneratedColConsumer) addRow(ctx context.Context, ts types.TS) error {
+	if c.rowdata[c.colPos] != nil {
+		return nil
+	}
+	key, err := c.rowKey(ctx)
+	if err != nil {
+		return err
+	}
+	if updatedTS, ok := c.skip[key]; ok && !ts.IsEmpty() && updatedTS.EQ(&ts) {
+		return nil
+	}
+	c.keys = append(c.keys, key)
+	return nil
+}
+
+// rowKey returns the primary key of the row in c.rowdata as a SQL literal,
+// or as a conjunction over the key parts for a composite primary key.
+func (c *GeneratedColConsumer) rowKey(ctx context.Context) (string, error) {
+	var err error
+	buf := make([]byte, 0, 32)
+	if len(c.pkPos) == 1 {
+		if buf, err = convertColIntoSql(ctx, c.rowdata[c.pkPos[0]], c.pkType[0], buf); err != nil {
+			return "", err
+		}
+		return string(buf), nil
+	}
+
+	buf = append(buf, '(')
+	for i, pos := range c.pkPos {
+		if i > 0 {
+			buf = append(buf, " AND "...)
+		}
+		buf = append(buf, sqlquote.Ident(c.tableDef.Cols[pos].Name)...)
+		buf = append(buf, " = "...)
+		if buf, err = convertColIntoSql(ctx, c.rowdata[pos], c.pkType[i], buf); err != nil {
+			return "", err
+		}
+	}
+	buf = append(buf, ')')
+	return string(buf), nil
+}
+
+// ToSql returns the UPDATE statement that recomputes the rows of keys.
+func (c *GeneratedColConsumer) ToSql(keys []string) string {
+	var where string
+	if len(c.pkPos) == 1 {
+		where = fmt.Sprintf("%s IN (%s)", sqlquote.Ident(c.tableDef.Cols[c.pkPos[0]].Name), strings.Join(keys, ","))
+	} else {
+		where = strings.Join(keys, " OR ")
+	}
+	col := sqlquote.Ident(c.colName)
+	return fmt.Sprintf("UPDATE %s SET %s = DEFAULT WHERE %s IS NULL AND (%s)",
+		sqlquote.QualifiedIdent(c.info.DBName, c.info.TableName), col, col, where)
+}
+
+// flush recomputes the queued rows, MaxGeneratedColBatch rows per statement.
+func (c *GeneratedColConsumer) flush(sqlproc *sqlexec.SqlProcess) error {
+	sqlctx := sqlproc.SqlCtx
+	for len(c.keys) > 0 {
+		n := min(len(c.keys), MaxGeneratedColBatch)
+		keys := c.keys[:n]
+
+		sql := c.ToSql(keys)
+		res, err := ExecWithResult(sqlproc.GetContext(), sql, sqlctx.GetService(), sqlctx.Txn())
+		if err != nil {
+			logutil.Errorf("iscp generated column consumer(%v) update failed, err: %v, sql: %s", c.jobID, err, sql)
+			return err
+		}
+		res.Close()
+
+		c.flushed = append(c.flushed, keys...)
+		c.keys = c.keys[n:]
+	}
+	return nil
+}
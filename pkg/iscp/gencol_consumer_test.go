@@ -0,0 +1,212 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iscp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/testutil/testengine"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/btree"
+)
+
+func newTestGenColTableDef() *plan.TableDef {
+	return &plan.TableDef{
+		Name: "test_tbl",
+		Name2ColIndex: map[string]int32{
+			"pk":      0,
+			"content": 1,
+			"emb":     2,
+		},
+		Cols: []*plan.ColDef{
+			{Name: "pk", Typ: plan.Type{Id: int32(types.T_int64)}},
+			{Name: "content", Typ: plan.Type{Id: int32(types.T_varchar), Width: 100}},
+			{
+				Name:         "emb",
+				Typ:          plan.Type{Id: int32(types.T_array_float32), Width: 2},
+				GeneratedCol: &plan.GeneratedCol{IsStored: true, IsAsync: true},
+			},
+		},
+		Pkey: &plan.PrimaryKeyDef{
+			Names:       []string{"pk"},
+			PkeyColName: "pk",
+		},
+	}
+}
+
+func newTestGenColConsumerInfo() *ConsumerInfo {
+	return &ConsumerInfo{
+		ConsumerType: int8(ConsumerType_GeneratedCol),
+		DBName:       "test_db",
+		TableName:    "test_tbl",
+		Columns:      []string{"emb"},
+	}
+}
+
+func TestNewGeneratedColConsumer(t *testing.T) {
+	job := JobID{DBName: "test_db", TableName: "test_tbl", JobName: GenColJobName("emb")}
+
+	info := newTestGenColConsumerInfo()
+	_, err := NewGeneratedColConsumer("a-b-c-d", nil, nil, newTestGenColTableDef(), job, info)
+	require.NoError(t, err)
+
+	info.Columns = []string{"content"}
+	_, err = NewGeneratedColConsumer("a-b-c-d", nil, nil, newTestGenColTableDef(), job, info)
+	require.Error(t, err)
+
+	info.Columns = []string{"missing"}
+	_, err = NewGeneratedColConsumer("a-b-c-d", nil, nil, newTestGenColTableDef(), job, info)
+	require.Error(t, err)
+
+	info.Columns = nil
+	_, err = NewGeneratedColConsumer("a-b-c-d", nil, nil, newTestGenColTableDef(), job, info)
+	require.Error(t, err)
+
+	tblDef := newTestGenColTableDef()
+	tblDef.Pkey = nil
+	_, err = NewGeneratedColConsumer("a-b-c-d", nil, nil, tblDef, job, newTestGenColConsumerInfo())
+	require.Error(t, err)
+}
+
+func TestGeneratedColConsumerSnapshot(t *testing.T) {
+	proc := testutil.NewProcess(t)
+
+	ctx := context.WithValue(context.Background(), defines.TenantIDKey{}, catalog.System_Account)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sqls := make([]string, 0, 1)
+	stub1 := gostub.Stub(&ExecWithResult, func(_ context.Context, sql string, _ string, _ client.TxnOperator) (executor.Result, error) {
+		sqls = append(sqls, sql)
+		return executor.Result{}, nil
+	})
+	defer stub1.Reset()
+
+	tblDef := newTestGenColTableDef()
+	info := newTestGenColConsumerInfo()
+	job := JobID{DBName: "test_db", TableName: "test_tbl", JobName: GenColJobName("emb")}
+	catalog.SetupDefines("")
+	cnEngine, cnClient, _ := testengine.New(ctx)
+
+	newRetriever := func() *MockRetriever {
+		embVec := testutil.NewVector(3, types.T_array_float32.ToType(), proc.Mp(), false, [][]float32{{0.1, 0.2}, {0.3, 0.4}, {0.5, 0.6}})
+		embVec.GetNulls().Add(0)
+		embVec.GetNulls().Add(2)
+		bat := testutil.NewBatchWithVectors(
+			[]*vector.Vector{
+				testutil.NewVector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3}),
+				testutil.NewVector(3, types.T_varchar.ToType(), proc.Mp(), false, []string{"a", "b", "c"}),
+				embVec,
+			}, nil)
+		return &MockRetriever{
+			dtype: ISCPDataType_Snapshot,
+			insertBatch: &AtomicBatch{
+				Batches: []*batch.Batch{bat},
+				Rows:    btree.NewBTreeGOptions(AtomicBatchRow.Less, btree.Options{Degree: 64}),
+			},
+		}
+	}
+
+	consumer, err := NewConsumer("a-b-c-d", cnEngine, cnClient, tblDef, job, info)
+	require.NoError(t, err)
+	require.NoError(t, consumer.Consume(ctx, newRetriever()))
+	require.Equal(t, []string{
+		"UPDATE `test_db`.`test_tbl` SET `emb` = DEFAULT WHERE `emb` IS NULL AND (`pk` IN (1,3))",
+	}, sqls)
+
+	// the snapshot has no commit ts, a retried snapshot recomputes the rows
+	// still NULL.
+	sqls = sqls[:0]
+	require.NoError(t, consumer.Consume(ctx, newRetriever()))
+	require.Len(t, sqls, 1)
+
+	genColUpdated.Lock()
+	updated := genColUpdated.jobs[job]
+	genColUpdated.Unlock()
+	require.Len(t, updated, 2)
+	ts1, ts3 := updated["1"], updated["3"]
+	require.False(t, ts1.IsEmpty())
+
+	// the rows changed by the UPDATE come back in the tail; if the expression
+	// gave NULL again they must not be recomputed forever.  The row 3 is
+	// changed by the user after the UPDATE and reset to NULL, it is recomputed.
+	newTailRetriever := func(tss ...types.TS) *MockRetriever {
+		embVec := testutil.NewVector(2, types.T_array_float32.ToType(), proc.Mp(), false, [][]float32{{0.1, 0.2}, {0.5, 0.6}})
+		embVec.GetNulls().Add(0)
+		embVec.GetNulls().Add(1)
+		bat := testutil.NewBatchWithVectors(
+			[]*vector.Vector{
+				testutil.NewVector(2, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 3}),
+				testutil.NewVector(2, types.T_varchar.ToType(), proc.Mp(), false, []string{"a", "cc"}),
+				embVec,
+			}, nil)
+		insertBatch := NewAtomicBatch(proc.Mp())
+		for i, ts := range tss {
+			insertBatch.Rows.Set(AtomicBatchRow{Ts: ts, Pk: []byte{byte(i)}, Offset: i, Src: bat})
+		}
+		insertBatch.Batches = append(insertBatch.Batches, bat)
+		return &MockRetriever{
+			dtype:       ISCPDataType_Tail,
+			insertBatch: insertBatch,
+		}
+	}
+	sqls = sqls[:0]
+	require.NoError(t, consumer.Consume(ctx, newTailRetriever(ts1, ts3.Next())))
+	require.Equal(t, []string{
+		"UPDATE `test_db`.`test_tbl` SET `emb` = DEFAULT WHERE `emb` IS NULL AND (`pk` IN (3))",
+	}, sqls)
+
+	// only the keys of the last iteration are kept, and none once the job
+	// is dropped.
+	genColUpdated.Lock()
+	require.Len(t, genColUpdated.jobs[job], 1)
+	genColUpdated.Unlock()
+	forgetGenColUpdated(job)
+	genColUpdated.Lock()
+	require.NotContains(t, genColUpdated.jobs, job)
+	genColUpdated.Unlock()
+}
+
+func TestGeneratedColConsumerCompositeKey(t *testing.T) {
+	tblDef := newTestGenColTableDef()
+	tblDef.Pkey = &plan.PrimaryKeyDef{
+		Names:       []string{"pk", "content"},
+		PkeyColName: catalog.CPrimaryKeyColName,
+	}
+	job := JobID{DBName: "test_db", TableName: "test_tbl", JobName: GenColJobName("emb")}
+	consumer, err := NewGeneratedColConsumer("a-b-c-d", nil, nil, tblDef, job, newTestGenColConsumerInfo())
+	require.NoError(t, err)
+
+	c := consumer.(*GeneratedColConsumer)
+	c.rowdata[0] = int64(7)
+	c.rowdata[1] = []byte("x")
+	key, err := c.rowKey(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "(`pk` = 7 AND `content` = 'x')", key)
+	require.Equal(t,
+		"UPDATE `test_db`.`test_tbl` SET `emb` = DEFAULT WHERE `emb` IS NULL AND ((`pk` = 7 AND `content` = 'x') OR (`pk` = 8 AND `content` = 'y'))",
+		c.ToSql([]string{key, "(`pk` = 8 AND `content` = 'y')"}))
+}
@@ -441,8 +441,11 @@ func NewHnswSqlWriter(algo string, jobID JobID, info *ConsumerInfo, tabledef *pl
 		return NewGenericHnswSqlWriter[float32](algo, jobID, info, tabledef, indexdef)
 	case int32(types.T_array_float64):
 		return NewGenericHnswSqlWriter[float64](algo, jobID, info, tabledef, indexdef)
+	case int32(types.T_array_float16), int32(types.T_array_int8), int32(types.T_array_bit):
+		// decoded to float32 and quantized again by the usearch index
+		return NewGenericHnswSqlWriter[float32](algo, jobID, info, tabledef, indexdef)
 	default:
-		return nil, moerr.NewInternalErrorNoCtx("NewHnswSqlWriter: part is not vecf32 or vecf64")
+		return nil, moerr.NewInternalErrorNoCtx("NewHnswSqlWriter: part is not a vector type")
 	}
 }
 
@@ -117,6 +117,7 @@ func (t *TableEntry) gcInMemoryJob(threshold time.Duration) (isEmpty bool) {
 	}
 	for _, jobName := range jobsToDelete {
 		delete(t.jobs, jobName)
+		forgetGenColUpdated(JobID{DBName: t.dbName, TableName: t.tableName, JobName: jobName.JobName})
 	}
 	if len(jobsToDelete) != 0 {
 		logutil.Info(
@@ -38,6 +38,7 @@ type ConsumerType int8
 const (
 	ConsumerType_IndexSync ConsumerType = iota
 	ConsumerType_CNConsumer
+	ConsumerType_GeneratedCol
 
 	ConsumerType_CustomizedStart = 1000
 )
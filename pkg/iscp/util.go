@@ -118,6 +118,8 @@ func extractRowFromVector(ctx context.Context, vec *vector.Vector, i int, row []
 		row[i] = vector.GetArrayAt[float32](vec, rowIndex)
 	case types.T_array_float64:
 		row[i] = vector.GetArrayAt[float64](vec, rowIndex)
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		row[i] = types.QuantizedBytesToFloat32(vec.GetType().Oid, vec.GetBytesAt(rowIndex))
 	case types.T_date:
 		row[i] = vector.GetFixedAtWithTypeCheck[types.Date](vec, rowIndex)
 	case types.T_datetime:
@@ -257,6 +259,11 @@ func convertColIntoSql(
 		value := data.([]float64)
 		typstr := typ.DescString()
 		sqlBuff = appendString(sqlBuff, fmt.Sprintf("CAST('%s' as %s)", types.ArrayToString(value), typstr))
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		// extracted as float32 by extractRowFromVector
+		value := data.([]float32)
+		typstr := typ.DescString()
+		sqlBuff = appendString(sqlBuff, fmt.Sprintf("CAST('%s' as %s)", types.ArrayToString(value), typstr))
 	case types.T_date:
 		value := data.(types.Date)
 		sqlBuff = appendByte(sqlBuff, '\'')
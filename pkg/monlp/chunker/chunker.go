@@ -0,0 +1,556 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chunker
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/monlp/tokenizer"
+)
+
+// chunker splits a text into chunks for retrieval augmented
+// generation.  Besides fixed size chunks it can keep sentences, paragraphs
+// and markdown sections together, measure the chunk size in bytes or in
+// tokens, and repeat the end of a chunk at the start of the next one.
+//
+// A text is first cut into segments by the strategy.  Segments are packed
+// into chunks until the next one does not fit; a segment larger than the
+// chunk size is cut by the next finer strategy, paragraph -> sentence ->
+// fixed.  Chunks never cross a markdown section so every chunk has a single
+// heading path.
+
+const (
+	StrategyFixed     = "fixed"
+	StrategySentence  = "sentence"
+	StrategyParagraph = "paragraph"
+	StrategyMarkdown  = "markdown"
+
+	UnitByte  = "byte"
+	UnitToken = "token"
+
+	TokenizerSimple = "simple"
+	TokenizerJieba  = "jieba"
+
+	// HeadingSeparator separates the headings of a heading path
+	HeadingSeparator = " > "
+)
+
+// Options of the chunking.  Size and Overlap are counted in Unit.
+type Options struct {
+	Strategy  string `json:"strategy"`
+	Unit      string `json:"unit"`
+	Tokenizer string `json:"tokenizer"`
+	Size      int    `json:"size"`
+	Overlap   int    `json:"overlap"`
+}
+
+// Chunk is the byte range [Start, End) of the text.  Heading is the heading
+// path of the markdown section of the chunk.
+type Chunk struct {
+	Start   int
+	End     int
+	Heading string
+}
+
+// ParseOptions parses the json options, e.g.
+//
+//	{"strategy": "paragraph", "unit": "token", "tokenizer": "jieba", "overlap": 32}
+//
+// The options not given keep their default: fixed chunks of size bytes
+// without overlap.
+func ParseOptions(s string, size int) (Options, error) {
+	opts := Options{
+		Strategy:  StrategyFixed,
+		Unit:      UnitByte,
+		Tokenizer: TokenizerSimple,
+		Size:      size,
+	}
+	if strings.TrimSpace(s) != "" {
+		if err := json.Unmarshal([]byte(s), &opts); err != nil {
+			return Options{}, moerr.NewInvalidInputNoCtxf("invalid chunking options: %v", err)
+		}
+		opts.Strategy = strings.ToLower(opts.Strategy)
+		opts.Unit = strings.ToLower(opts.Unit)
+		opts.Tokenizer = strings.ToLower(opts.Tokenizer)
+	}
+	return opts, opts.Validate()
+}
+
+func (o Options) Validate() error {
+	switch o.Strategy {
+	case StrategyFixed, StrategySentence, StrategyParagraph, StrategyMarkdown:
+	default:
+		return moerr.NewInvalidInputNoCtxf("invalid chunking strategy '%s'", o.Strategy)
+	}
+	switch o.Unit {
+	case UnitByte, UnitToken:
+	default:
+		return moerr.NewInvalidInputNoCtxf("invalid chunking unit '%s'", o.Unit)
+	}
+	switch o.Tokenizer {
+	case TokenizerSimple, TokenizerJieba:
+	default:
+		return moerr.NewInvalidInputNoCtxf("invalid chunking tokenizer '%s'", o.Tokenizer)
+	}
+	if o.Size <= 0 {
+		return moerr.NewInvalidInputNoCtx("chunk size must be positive")
+	}
+	if o.Overlap < 0 || o.Overlap >= o.Size {
+		return moerr.NewInvalidInputNoCtxf("chunk overlap %d must be between 0 and chunk size %d", o.Overlap, o.Size)
+	}
+	return nil
+}
+
+// segment is the byte range [start, end) of the text with its size in unit
+type segment struct {
+	start int
+	end   int
+	size  int
+}
+
+type chunker struct {
+	opts Options
+	text []byte
+	tok  tokenizer.Tokenizer
+}
+
+// Split splits the text into chunks.
+func Split(text []byte, opts Options) ([]Chunk, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	c := &chunker{opts: opts, text: text}
+	if opts.Unit == UnitToken {
+		switch opts.Tokenizer {
+		case TokenizerJieba:
+			tok, err := tokenizer.SharedJiebaTokenizer(false)
+			if err != nil {
+				return nil, err
+			}
+			c.tok = tok
+		default:
+			c.tok = tokenizer.NewSimpleTokenizer()
+		}
+	}
+
+	var chunks []Chunk
+	if opts.Strategy == StrategyMarkdown {
+		for _, sec := range markdownSections(text) {
+			segs, err := c.paragraphs(sec.start, sec.end)
+			if err != nil {
+				return nil, err
+			}
+			for _, s := range c.pack(segs) {
+				chunks = append(chunks, Chunk{Start: s.start, End: s.end, Heading: sec.heading})
+			}
+		}
+		return chunks, nil
+	}
+
+	var segs []segment
+	var err error
+	switch opts.Strategy {
+	case StrategyParagraph:
+		segs, err = c.paragraphs(0, len(text))
+	case StrategySentence:
+		segs, err = c.sentences(0, len(text))
+	default:
+		// fixed segments are the chunks already
+		if segs, err = c.fixed(0, len(text)); err != nil {
+			return nil, err
+		}
+		for _, s := range segs {
+			chunks = append(chunks, Chunk{Start: s.start, End: s.end})
+		}
+		return chunks, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range c.pack(segs) {
+		chunks = append(chunks, Chunk{Start: s.start, End: s.end})
+	}
+	return chunks, nil
+}
+
+// trim returns the range without the leading and trailing white space.
+func (c *chunker) trim(start, end int) (int, int) {
+	for start < end {
+		r, n := utf8.DecodeRune(c.text[start:end])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		start += n
+	}
+	for end > start {
+		r, n := utf8.DecodeLastRune(c.text[start:end])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		end -= n
+	}
+	return start, end
+}
+
+// tokens returns the byte position of every token of the range.
+func (c *chunker) tokens(start, end int) ([]int, error) {
+	var pos []int
+	for tk, err := range c.tok.Tokenize(c.text[start:end]) {
+		if err != nil {
+			return nil, err
+		}
+		p := start + int(tk.BytePos)
+		// the simple tokenizer emits overlapping CJK tokens, count a
+		// position once
+		if len(pos) > 0 && p <= pos[len(pos)-1] {
+			continue
+		}
+		pos = append(pos, p)
+	}
+	return pos, nil
+}
+
+// newSegment returns the trimmed segment of the range, ok is false if the
+// range is blank.
+func (c *chunker) newSegment(start, end int) (segment, bool, error) {
+	start, end = c.trim(start, end)
+	if start >= end {
+		return segment{}, false, nil
+	}
+	s := segment{start: start, end: end, size: end - start}
+	if c.opts.Unit == UnitToken {
+		pos, err := c.tokens(start, end)
+		if err != nil {
+			return segment{}, false, err
+		}
+		s.size = len(pos)
+	}
+	return s, true, nil
+}
+
+// fixed cuts the range into segments of the chunk size, overlapping by the
+// chunk overlap.
+func (c *chunker) fixed(start, end int) ([]segment, error) {
+	start, end = c.trim(start, end)
+	if start >= end {
+		return nil, nil
+	}
+	size, step := c.opts.Size, c.opts.Size-c.opts.Overlap
+
+	var segs []segment
+	if c.opts.Unit == UnitToken {
+		pos, err := c.tokens(start, end)
+		if err != nil {
+			return nil, err
+		}
+		if len(pos) == 0 {
+			return []segment{{start: start, end: end}}, nil
+		}
+		for i := 0; i < len(pos); i += step {
+			j := min(i+size, len(pos))
+			segEnd := end
+			if j < len(pos) {
+				segEnd = pos[j]
+			}
+			s, e := c.trim(pos[i], segEnd)
+			segs = append(segs, segment{start: s, end: e, size: j - i})
+			if j == len(pos) {
+				break
+			}
+		}
+		return segs, nil
+	}
+
+	for i := start; i < end; {
+		j := c.runeStart(min(i+size, end), i)
+		s, e := c.trim(i, j)
+		if s < e {
+			segs = append(segs, segment{start: s, end: e, size: e - s})
+		}
+		if j == end {
+			break
+		}
+		// always move on, even if the overlap goes back beyond i
+		i = c.runeStart(max(j-c.opts.Overlap, i+1), i)
+	}
+	return segs, nil
+}
+
+// runeStart moves pos back to the start of a utf8 character, but not to or
+// before lower.
+func (c *chunker) runeStart(pos, lower int) int {
+	if pos >= len(c.text) {
+		return len(c.text)
+	}
+	p := pos
+	for p > lower+1 && !utf8.RuneStart(c.text[p]) {
+		p--
+	}
+	if !utf8.RuneStart(c.text[p]) {
+		// a single character larger than the chunk
+		for pos < len(c.text) && !utf8.RuneStart(c.text[pos]) {
+			pos++
+		}
+		return pos
+	}
+	return p
+}
+
+// split cuts the oversized segments by split.
+func (c *chunker) split(segs []segment, split func(start, end int) ([]segment, error)) ([]segment, error) {
+	var out []segment
+	for _, s := range segs {
+		if s.size <= c.opts.Size {
+			out = append(out, s)
+			continue
+		}
+		sub, err := split(s.start, s.end)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, sub...)
+	}
+	return out, nil
+}
+
+// sentences cuts the range into sentences.  A sentence ends at . ! ? followed
+// by white space, at the CJK full stops and at an empty line.
+func (c *chunker) sentences(start, end int) ([]segment, error) {
+	var segs []segment
+	add := func(s, e int) error {
+		seg, ok, err := c.newSegment(s, e)
+		if err != nil || !ok {
+			return err
+		}
+		segs = append(segs, seg)
+		return nil
+	}
+
+	text := c.text[:end]
+	begin := start
+	for i := start; i < end; {
+		r, n := utf8.DecodeRune(text[i:])
+		next := i + n
+		cut := false
+		switch r {
+		case '。', '！', '？', '；', '…':
+			cut = true
+		case '.', '!', '?':
+			// closing quotes and brackets belong to the sentence
+			for next < end {
+				q, m := utf8.DecodeRune(text[next:])
+				if !strings.ContainsRune(`"')]”’」』`, q) {
+					break
+				}
+				next += m
+			}
+			if next >= end {
+				cut = true
+			} else {
+				q, _ := utf8.DecodeRune(text[next:])
+				cut = unicode.IsSpace(q)
+			}
+		case '\n':
+			cut = blankLineAt(text, next)
+		}
+		if cut {
+			if err := add(begin, next); err != nil {
+				return nil, err
+			}
+			begin = next
+		}
+		i = next
+	}
+	if err := add(begin, end); err != nil {
+		return nil, err
+	}
+	return c.split(segs, c.fixed)
+}
+
+// blankLineAt returns true if the line starting at pos is blank.
+func blankLineAt(text []byte, pos int) bool {
+	for pos < len(text) {
+		switch text[pos] {
+		case ' ', '\t', '\r':
+			pos++
+		case '\n':
+			return true
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// paragraphs cuts the range into paragraphs separated by empty lines.
+func (c *chunker) paragraphs(start, end int) ([]segment, error) {
+	var segs []segment
+	begin := start
+	for i := start; i < end; i++ {
+		if c.text[i] != '\n' || !blankLineAt(c.text[:end], i+1) {
+			continue
+		}
+		seg, ok, err := c.newSegment(begin, i)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			segs = append(segs, seg)
+		}
+		begin = i + 1
+	}
+	seg, ok, err := c.newSegment(begin, end)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		segs = append(segs, seg)
+	}
+	return c.split(segs, c.sentences)
+}
+
+// pack packs the segments into chunks of at most the chunk size.  The next
+// chunk starts with the last segments of the previous one that fit in the
+// overlap.
+func (c *chunker) pack(segs []segment) []segment {
+	var chunks []segment
+	for i := 0; i < len(segs); {
+		j, size := i, 0
+		for j < len(segs) {
+			n := c.measure(segs[i:j+1], size)
+			if j > i && n > c.opts.Size {
+				break
+			}
+			size = n
+			j++
+		}
+		chunks = append(chunks, segment{start: segs[i].start, end: segs[j-1].end, size: size})
+		if j == len(segs) {
+			break
+		}
+
+		// step back over the segments in the overlap, but always move on
+		k := j
+		for k-1 > i && c.measure(segs[k-1:j], 0) <= c.opts.Overlap {
+			k--
+		}
+		// the next chunk must have room for a new segment
+		for k < j && c.measure(segs[k:j+1], 0) > c.opts.Size {
+			k++
+		}
+		i = k
+	}
+	return chunks
+}
+
+// measure returns the size of the chunk made of segs, size is the size of
+// segs without the last segment.
+func (c *chunker) measure(segs []segment, size int) int {
+	if c.opts.Unit == UnitByte {
+		return segs[len(segs)-1].end - segs[0].start
+	}
+	if size == 0 {
+		for _, s := range segs {
+			size += s.size
+		}
+		return size
+	}
+	return size + segs[len(segs)-1].size
+}
+
+type section struct {
+	start   int
+	end     int
+	heading string
+}
+
+// markdownSections cuts the markdown text into sections at ATX headings
+// outside fenced code blocks.  The heading line is the first line of its
+// section.
+func markdownSections(text []byte) []section {
+	var sections []section
+	var path []string
+	var levels []int
+	begin := 0
+	heading := ""
+	inFence := false
+	fence := ""
+
+	for pos := 0; pos < len(text); {
+		lineEnd := pos
+		for lineEnd < len(text) && text[lineEnd] != '\n' {
+			lineEnd++
+		}
+		line := strings.TrimSpace(string(text[pos:lineEnd]))
+		next := min(lineEnd+1, len(text))
+
+		if strings.HasPrefix(line, "```") || strings.HasPrefix(line, "~~~") {
+			if !inFence {
+				inFence, fence = true, line[:3]
+			} else if strings.HasPrefix(line, fence) {
+				inFence = false
+			}
+			pos = next
+			continue
+		}
+		if inFence {
+			pos = next
+			continue
+		}
+
+		level, title := atxHeading(line)
+		if level > 0 {
+			if pos > begin {
+				sections = append(sections, section{start: begin, end: pos, heading: heading})
+			}
+			for len(levels) > 0 && levels[len(levels)-1] >= level {
+				levels = levels[:len(levels)-1]
+				path = path[:len(path)-1]
+			}
+			levels = append(levels, level)
+			path = append(path, title)
+			heading = strings.Join(path, HeadingSeparator)
+			begin = pos
+		}
+		pos = next
+	}
+	if begin < len(text) {
+		sections = append(sections, section{start: begin, end: len(text), heading: heading})
+	}
+	return sections
+}
+
+// atxHeading returns the level and the title of a "## title" line, level is
+// 0 if the line is not a heading.
+func atxHeading(line string) (int, string) {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 {
+		return 0, ""
+	}
+	if level < len(line) && line[level] != ' ' && line[level] != '\t' {
+		return 0, ""
+	}
+	title := strings.TrimSpace(line[level:])
+	// optional closing sequence
+	title = strings.TrimSpace(strings.TrimRight(title, "#"))
+	return level, title
+}
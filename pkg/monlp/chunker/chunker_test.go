@@ -0,0 +1,173 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chunker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func split(t *testing.T, text string, opts string, size int) ([]string, []Chunk) {
+	o, err := ParseOptions(opts, size)
+	require.NoError(t, err)
+	chunks, err := Split([]byte(text), o)
+	require.NoError(t, err)
+	var out []string
+	for _, c := range chunks {
+		out = append(out, text[c.Start:c.End])
+	}
+	return out, chunks
+}
+
+func TestParseOptions(t *testing.T) {
+	opts, err := ParseOptions("", 10)
+	require.NoError(t, err)
+	require.Equal(t, Options{Strategy: StrategyFixed, Unit: UnitByte, Tokenizer: TokenizerSimple, Size: 10}, opts)
+
+	opts, err = ParseOptions(`{"strategy": "Paragraph", "unit": "TOKEN", "overlap": 2}`, 10)
+	require.NoError(t, err)
+	require.Equal(t, Options{Strategy: StrategyParagraph, Unit: UnitToken, Tokenizer: TokenizerSimple, Size: 10, Overlap: 2}, opts)
+
+	for _, s := range []string{
+		`{"strategy": "words"}`,
+		`{"unit": "line"}`,
+		`{"tokenizer": "ngram"}`,
+		`{"overlap": 10}`,
+		`{"overlap": -1}`,
+		`{"size": 0}`,
+		`not json`,
+	} {
+		_, err = ParseOptions(s, 10)
+		require.Error(t, err, s)
+	}
+}
+
+func TestFixed(t *testing.T) {
+	out, chunks := split(t, "abcdefghij", "", 4)
+	require.Equal(t, []string{"abcd", "efgh", "ij"}, out)
+	require.Equal(t, Chunk{Start: 4, End: 8}, chunks[1])
+
+	out, _ = split(t, "abcdefghij", `{"overlap": 2}`, 4)
+	require.Equal(t, []string{"abcd", "cdef", "efgh", "ghij"}, out)
+
+	// utf8 characters are not split
+	out, _ = split(t, "你好世界", "", 4)
+	require.Equal(t, []string{"你", "好", "世", "界"}, out)
+
+	// a character larger than the chunk
+	out, _ = split(t, "你好", "", 2)
+	require.Equal(t, []string{"你", "好"}, out)
+
+	out, _ = split(t, "one two three four five", `{"unit": "token", "overlap": 1}`, 3)
+	require.Equal(t, []string{"one two three", "three four five"}, out)
+
+	out, _ = split(t, "  \n ", "", 4)
+	require.Empty(t, out)
+}
+
+func TestSentence(t *testing.T) {
+	text := "First sentence. Second one! Third? \"Quoted.\" Last"
+	out, _ := split(t, text, `{"strategy": "sentence"}`, 30)
+	require.Equal(t, []string{"First sentence. Second one!", "Third? \"Quoted.\" Last"}, out)
+
+	// decimals and abbreviations without space do not end a sentence
+	out, _ = split(t, "Pi is 3.14 today. Yes.", `{"strategy": "sentence"}`, 17)
+	require.Equal(t, []string{"Pi is 3.14 today.", "Yes."}, out)
+
+	out, _ = split(t, "你好。世界！再见", `{"strategy": "sentence"}`, 9)
+	require.Equal(t, []string{"你好。", "世界！", "再见"}, out)
+
+	// overlap repeats the last sentence
+	out, _ = split(t, "A a. B b. C c. D d.", `{"strategy": "sentence", "overlap": 4}`, 9)
+	require.Equal(t, []string{"A a. B b.", "B b. C c.", "C c. D d."}, out)
+
+	// no chunk made of the overlap only
+	out, _ = split(t, "one. two. three four.", `{"strategy": "sentence", "overlap": 4}`, 12)
+	require.Equal(t, []string{"one. two.", "three four."}, out)
+
+	// a sentence larger than the chunk is cut
+	out, _ = split(t, "abcdefgh. ij.", `{"strategy": "sentence"}`, 5)
+	require.Equal(t, []string{"abcde", "fgh.", "ij."}, out)
+}
+
+func TestParagraph(t *testing.T) {
+	text := "Para one line one.\nline two.\n\nPara two.\n  \nPara three is longer. It has two sentences."
+	out, _ := split(t, text, `{"strategy": "paragraph"}`, 40)
+	require.Equal(t, []string{
+		"Para one line one.\nline two.\n\nPara two.",
+		"Para three is longer.",
+		"It has two sentences.",
+	}, out)
+
+	out, _ = split(t, text, `{"strategy": "paragraph", "unit": "token"}`, 8)
+	require.Equal(t, []string{
+		"Para one line one.\nline two.\n\nPara two.",
+		"Para three is longer. It has two sentences.",
+	}, out)
+}
+
+func TestMarkdown(t *testing.T) {
+	text := "Intro text.\n" +
+		"# Guide\n" +
+		"Welcome.\n" +
+		"## Install\n" +
+		"Run it.\n" +
+		"```sh\n" +
+		"# not a heading\n" +
+		"```\n" +
+		"### Linux ###\n" +
+		"apt.\n" +
+		"## Usage\n" +
+		"Use it.\n" +
+		"# Appendix\n"
+	out, chunks := split(t, text, `{"strategy": "markdown"}`, 100)
+	require.Equal(t, []string{
+		"Intro text.",
+		"# Guide\nWelcome.",
+		"## Install\nRun it.\n```sh\n# not a heading\n```",
+		"### Linux ###\napt.",
+		"## Usage\nUse it.",
+		"# Appendix",
+	}, out)
+	var headings []string
+	for _, c := range chunks {
+		headings = append(headings, c.Heading)
+	}
+	require.Equal(t, []string{
+		"",
+		"Guide",
+		"Guide > Install",
+		"Guide > Install > Linux",
+		"Guide > Usage",
+		"Appendix",
+	}, headings)
+
+	// sections larger than the chunk are cut by paragraph
+	out, chunks = split(t, "# T\n\nfirst para.\n\nsecond para.", `{"strategy": "markdown"}`, 16)
+	require.Equal(t, []string{"# T\n\nfirst para.", "second para."}, out)
+	require.Equal(t, "T", chunks[1].Heading)
+}
+
+func TestAtxHeading(t *testing.T) {
+	level, title := atxHeading("## Title ##")
+	require.Equal(t, 2, level)
+	require.Equal(t, "Title", title)
+
+	level, _ = atxHeading("#hashtag")
+	require.Equal(t, 0, level)
+	level, _ = atxHeading("####### seven")
+	require.Equal(t, 0, level)
+}
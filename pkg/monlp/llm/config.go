@@ -0,0 +1,112 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llm
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// ServerKey is an API key configured by the administrator. SQL refers to it
+// by name with the api_key_name option and never sees the key itself. The
+// key is only ever sent to Addr, so a user cannot point it at a server of
+// their own.
+type ServerKey struct {
+	// Name is the name SQL uses to select the key.
+	Name string `toml:"name"`
+	// Addr is the only server address the key is sent to.
+	Addr string `toml:"addr"`
+	// Key is the API key. Exactly one of Key, KeyEnv and KeyFile is set.
+	Key string `toml:"key"`
+	// KeyEnv is the environment variable holding the API key.
+	KeyEnv string `toml:"key-env"`
+	// KeyFile is the file holding the API key, such as a mounted secret.
+	KeyFile string `toml:"key-file"`
+}
+
+// Config is the llm config of a CN.
+type Config struct {
+	// Keys are the server side API keys available to llm functions.
+	Keys []ServerKey `toml:"keys"`
+}
+
+type serverKey struct {
+	addr string
+	key  string
+}
+
+var serverKeys atomic.Pointer[map[string]serverKey]
+
+// Init resolves the server side API keys of the config. It is called once
+// when the CN starts, so keys from environment variables and files are read
+// with the privileges of the administrator rather than on behalf of a SQL
+// user.
+func Init(cfg Config) error {
+	ctx := context.TODO()
+	keys := make(map[string]serverKey, len(cfg.Keys))
+	for _, k := range cfg.Keys {
+		if k.Name == "" {
+			return moerr.NewInvalidInput(ctx, "llm key without a name")
+		}
+		if _, ok := keys[k.Name]; ok {
+			return moerr.NewInvalidInputf(ctx, "duplicate llm key %s", k.Name)
+		}
+		addr := strings.TrimSuffix(k.Addr, "/")
+		if !strings.HasPrefix(addr, "http://") && !strings.HasPrefix(addr, "https://") {
+			return moerr.NewInvalidInputf(ctx, "invalid addr %q of llm key %s", k.Addr, k.Name)
+		}
+
+		var key string
+		found := 0
+		if k.Key != "" {
+			key = k.Key
+			found++
+		}
+		if k.KeyEnv != "" {
+			if key = os.Getenv(k.KeyEnv); key == "" {
+				return moerr.NewInvalidInputf(ctx, "environment variable %s of llm key %s is not set", k.KeyEnv, k.Name)
+			}
+			found++
+		}
+		if k.KeyFile != "" {
+			data, err := os.ReadFile(k.KeyFile)
+			if err != nil {
+				return moerr.NewInvalidInputf(ctx, "cannot read key file of llm key %s: %v", k.Name, err)
+			}
+			key = strings.TrimSpace(string(data))
+			found++
+		}
+		if found != 1 {
+			return moerr.NewInvalidInputf(ctx, "llm key %s needs exactly one of key, key-env and key-file", k.Name)
+		}
+		keys[k.Name] = serverKey{addr: addr, key: key}
+	}
+	serverKeys.Store(&keys)
+	return nil
+}
+
+// lookupServerKey returns the server side key of name.
+func lookupServerKey(name string) (serverKey, bool) {
+	keys := serverKeys.Load()
+	if keys == nil {
+		return serverKey{}, false
+	}
+	k, ok := (*keys)[name]
+	return k, ok
+}
@@ -22,8 +22,10 @@ import (
 )
 
 const (
-	MockServer   = ""
-	OllamaServer = "ollama"
+	MockServer     = ""
+	OllamaServer   = "ollama"
+	OpenAIServer   = "openai"
+	LlamaCppServer = "llama.cpp"
 
 	MockEchoModel = "echo"
 
@@ -41,6 +43,8 @@ type LLMClient interface {
 	ChatMsg(ctx context.Context, messages []Message) (string, error)
 	Chat(ctx context.Context, prompt string) (string, error)
 	CreateEmbedding(ctx context.Context, text string) ([]float32, error)
+	// CreateEmbeddings returns one embedding per text, in the order of texts.
+	CreateEmbeddings(ctx context.Context, texts []string) ([][]float32, error)
 }
 
 func NewLLMClient(server string, addr string, model string, options string) (LLMClient, error) {
@@ -49,6 +53,10 @@ func NewLLMClient(server string, addr string, model string, options string) (LLM
 		return NewMockClient(model, options)
 	case OllamaServer:
 		return NewOllamaClient(addr, model, options)
+	case OpenAIServer:
+		return NewOpenAIClient(addr, model, options)
+	case LlamaCppServer:
+		return NewLlamaCppClient(addr, model, options)
 	default:
 		return nil, moerr.NewInvalidInputf(context.TODO(), "invalid server: %s", server)
 	}
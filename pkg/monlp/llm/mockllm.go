@@ -60,3 +60,15 @@ func (c *MockClient) CreateEmbedding(ctx context.Context, text string) ([]float3
 	ret[1] = float32(xxhash.Sum64([]byte(text))) / 1e10
 	return ret, nil
 }
+
+func (c *MockClient) CreateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	ret := make([][]float32, len(texts))
+	for i, text := range texts {
+		emb, err := c.CreateEmbedding(ctx, text)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = emb
+	}
+	return ret, nil
+}
@@ -81,3 +81,28 @@ func TestMockEchoModel(t *testing.T) {
 		t.Fatal("embedding is not correct")
 	}
 }
+
+func TestMockEmbeddings(t *testing.T) {
+	client, err := NewMockClient(MockEchoModel, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	texts := []string{"a", "Hello, world!", ""}
+	embs, err := client.CreateEmbeddings(context.Background(), texts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(embs) != len(texts) {
+		t.Fatal("embeddings count is not correct")
+	}
+	for i, text := range texts {
+		emb, err := client.CreateEmbedding(context.Background(), text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if emb[0] != embs[i][0] || emb[1] != embs[i][1] {
+			t.Fatal("batch embedding is not correct")
+		}
+	}
+}
@@ -118,3 +118,21 @@ func (o *OllamaClient) CreateEmbedding(ctx context.Context, text string) ([]floa
 	}
 	return ret[0], nil
 }
+
+func (o *OllamaClient) CreateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	if o.llm == nil {
+		return nil, moerr.NewInvalidInputf(ctx, "ollama client not initialized")
+	}
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	ret, err := o.llm.CreateEmbedding(ctx, texts)
+	if err != nil {
+		return nil, err
+	}
+	if len(ret) != len(texts) {
+		return nil, moerr.NewInternalErrorf(ctx, "ollama returned %d embeddings for %d texts", len(ret), len(texts))
+	}
+	return ret, nil
+}
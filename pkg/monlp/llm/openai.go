@@ -0,0 +1,364 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	defaultOpenAIAddr   = "https://api.openai.com/v1"
+	defaultLlamaCppAddr = "http://127.0.0.1:8080/v1"
+
+	defaultEmbeddingBatchSize = 32
+	defaultMaxRetries         = 3
+	defaultRequestTimeout     = 60 * time.Second
+	defaultRetryBackoff       = 500 * time.Millisecond
+	maxRetryBackoff           = 30 * time.Second
+)
+
+// OpenAIClient talks to servers speaking the OpenAI chat completions and
+// embeddings API, such as OpenAI, vLLM, LocalAI or llama.cpp server.
+//
+// The options are a json object of
+//
+//	temperature      sampling temperature in [0, 2], default 0.1
+//	max_tokens       maximum number of tokens of a reply, default unlimited
+//	embedding_model  model of CreateEmbedding, default the chat model
+//	api_key          API key sent as a bearer token
+//	api_key_name     name of an API key configured on the server, see Config
+//	batch_size       number of texts per embeddings request, default 32
+//	max_retries      retries of failed requests, default 3
+//	timeout          timeout of a request in seconds, default 60
+type OpenAIClient struct {
+	addr           string
+	model          string
+	embeddingModel string
+	apiKey         string
+	temperature    float64
+	maxTokens      int
+	batchSize      int
+	maxRetries     int
+	backoff        time.Duration
+	httpClient     *http.Client
+}
+
+func NewOpenAIClient(addr string, model string, options string) (*OpenAIClient, error) {
+	return newOpenAIClient(addr, defaultOpenAIAddr, model, options)
+}
+
+// NewLlamaCppClient creates a client of the OpenAI compatible API of a
+// llama.cpp server, which needs no API key and serves the model it was
+// started with.
+func NewLlamaCppClient(addr string, model string, options string) (*OpenAIClient, error) {
+	return newOpenAIClient(addr, defaultLlamaCppAddr, model, options)
+}
+
+func newOpenAIClient(addr string, defaultAddr string, model string, options string) (*OpenAIClient, error) {
+	ctx := context.TODO()
+	cli := &OpenAIClient{
+		model:       model,
+		temperature: 0.1,
+		batchSize:   defaultEmbeddingBatchSize,
+		maxRetries:  defaultMaxRetries,
+		backoff:     defaultRetryBackoff,
+	}
+
+	var opts map[string]any
+	if options != "" {
+		if err := json.Unmarshal([]byte(options), &opts); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	if cli.addr, cli.apiKey, err = readAPIKey(ctx, addr, opts); err != nil {
+		return nil, err
+	}
+	if cli.addr == "" {
+		cli.addr = defaultAddr
+	}
+	if !strings.HasPrefix(cli.addr, "http://") && !strings.HasPrefix(cli.addr, "https://") {
+		return nil, moerr.NewInvalidInputf(ctx, "invalid llm server address: %s", addr)
+	}
+
+	if v, ok := opts["temperature"]; ok {
+		temp, ok := v.(float64)
+		if !ok || temp < 0 || temp > 2 {
+			return nil, moerr.NewInvalidInputf(ctx, "invalid temperature: %v", v)
+		}
+		cli.temperature = temp
+	}
+	intOpt := func(name string, min int, dst *int) error {
+		v, ok := opts[name]
+		if !ok {
+			return nil
+		}
+		f, ok := v.(float64)
+		if !ok || f != float64(int(f)) || int(f) < min {
+			return moerr.NewInvalidInputf(ctx, "invalid %s: %v", name, v)
+		}
+		*dst = int(f)
+		return nil
+	}
+	timeout := int(defaultRequestTimeout / time.Second)
+	if err := intOpt("max_tokens", 1, &cli.maxTokens); err != nil {
+		return nil, err
+	}
+	if err := intOpt("batch_size", 1, &cli.batchSize); err != nil {
+		return nil, err
+	}
+	if err := intOpt("max_retries", 0, &cli.maxRetries); err != nil {
+		return nil, err
+	}
+	if err := intOpt("timeout", 1, &timeout); err != nil {
+		return nil, err
+	}
+	cli.httpClient = &http.Client{Timeout: time.Duration(timeout) * time.Second}
+
+	cli.embeddingModel = model
+	if v, ok := opts["embedding_model"]; ok {
+		name, ok := v.(string)
+		if !ok || name == "" {
+			return nil, moerr.NewInvalidInputf(ctx, "invalid embedding_model: %v", v)
+		}
+		cli.embeddingModel = name
+	}
+	return cli, nil
+}
+
+// readAPIKey returns the server address and the API key of the options.
+// A key given by api_key_name is configured by the administrator and is only
+// sent to the address configured with it, an empty addr selects that address.
+// The options never read environment variables or files of the CN.
+func readAPIKey(ctx context.Context, addr string, opts map[string]any) (string, string, error) {
+	addr = strings.TrimSuffix(addr, "/")
+	_, hasKey := opts["api_key"]
+	_, hasName := opts["api_key_name"]
+	if hasKey && hasName {
+		return "", "", moerr.NewInvalidInput(ctx, "only one of api_key and api_key_name may be set")
+	}
+	if hasKey {
+		key, ok := opts["api_key"].(string)
+		if !ok || key == "" {
+			return "", "", moerr.NewInvalidInput(ctx, "invalid api_key")
+		}
+		return addr, key, nil
+	}
+	if hasName {
+		name, ok := opts["api_key_name"].(string)
+		if !ok || name == "" {
+			return "", "", moerr.NewInvalidInput(ctx, "invalid api_key_name")
+		}
+		k, ok := lookupServerKey(name)
+		if !ok {
+			return "", "", moerr.NewInvalidInputf(ctx, "llm key %s is not configured", name)
+		}
+		if addr != "" && addr != k.addr {
+			return "", "", moerr.NewInvalidInputf(ctx, "llm key %s cannot be used with server address %s", name, addr)
+		}
+		return k.addr, k.key, nil
+	}
+	return addr, "", nil
+}
+
+type openAIMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatRequest struct {
+	Model       string          `json:"model"`
+	Messages    []openAIMessage `json:"messages"`
+	Temperature float64         `json:"temperature"`
+	MaxTokens   int             `json:"max_tokens,omitempty"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message openAIMessage `json:"message"`
+	} `json:"choices"`
+}
+
+type openAIEmbeddingRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type openAIEmbeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+type openAIErrorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// openAIRole maps our roles to the roles of the API, which calls the model
+// the assistant.
+func openAIRole(role string) string {
+	if role == LLMRoleAI {
+		return "assistant"
+	}
+	return role
+}
+
+func (c *OpenAIClient) ChatMsg(ctx context.Context, messages []Message) (string, error) {
+	req := openAIChatRequest{
+		Model:       c.model,
+		Messages:    make([]openAIMessage, len(messages)),
+		Temperature: c.temperature,
+		MaxTokens:   c.maxTokens,
+	}
+	for i, msg := range messages {
+		req.Messages[i] = openAIMessage{Role: openAIRole(msg.Role), Content: msg.Content}
+	}
+	var resp openAIChatResponse
+	if err := c.post(ctx, "/chat/completions", &req, &resp); err != nil {
+		return "", err
+	}
+	if len(resp.Choices) == 0 {
+		return "", moerr.NewInternalError(ctx, "no response from llm server")
+	}
+	return resp.Choices[0].Message.Content, nil
+}
+
+func (c *OpenAIClient) Chat(ctx context.Context, prompt string) (string, error) {
+	messages, err := stringToMessage(prompt)
+	if err != nil {
+		return "", err
+	}
+	return c.ChatMsg(ctx, messages)
+}
+
+func (c *OpenAIClient) CreateEmbedding(ctx context.Context, text string) ([]float32, error) {
+	ret, err := c.CreateEmbeddings(ctx, []string{text})
+	if err != nil {
+		return nil, err
+	}
+	return ret[0], nil
+}
+
+// CreateEmbeddings returns the embeddings of texts, sending batch_size texts
+// per request.
+func (c *OpenAIClient) CreateEmbeddings(ctx context.Context, texts []string) ([][]float32, error) {
+	ret := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += c.batchSize {
+		batch := texts[start:min(start+c.batchSize, len(texts))]
+		var resp openAIEmbeddingResponse
+		if err := c.post(ctx, "/embeddings", &openAIEmbeddingRequest{Model: c.embeddingModel, Input: batch}, &resp); err != nil {
+			return nil, err
+		}
+		if len(resp.Data) != len(batch) {
+			return nil, moerr.NewInternalErrorf(ctx, "llm server returned %d embeddings for %d texts", len(resp.Data), len(batch))
+		}
+		embeddings := make([][]float32, len(batch))
+		for _, d := range resp.Data {
+			if d.Index < 0 || d.Index >= len(batch) || embeddings[d.Index] != nil {
+				return nil, moerr.NewInternalErrorf(ctx, "llm server returned invalid embedding index %d", d.Index)
+			}
+			embeddings[d.Index] = d.Embedding
+		}
+		ret = append(ret, embeddings...)
+	}
+	return ret, nil
+}
+
+// post sends a request to the server, retrying with exponential backoff on
+// network errors, rate limiting and server errors.
+func (c *OpenAIClient) post(ctx context.Context, path string, req any, resp any) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	backoff := c.backoff
+	for attempt := 0; ; attempt++ {
+		retryAfter, err := c.doPost(ctx, path, body, resp)
+		if err == nil {
+			return nil
+		}
+		if retryAfter < 0 || attempt >= c.maxRetries {
+			return err
+		}
+		wait := max(backoff, retryAfter)
+		backoff = min(backoff*2, maxRetryBackoff)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// doPost sends one request. A failed request may be retried after
+// retryAfter unless it is negative.
+func (c *OpenAIClient) doPost(ctx context.Context, path string, body []byte, resp any) (retryAfter time.Duration, err error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.addr+path, bytes.NewReader(body))
+	if err != nil {
+		return -1, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		if ctx.Err() != nil {
+			return -1, ctx.Err()
+		}
+		return 0, moerr.NewInternalErrorf(ctx, "llm server request failed: %v", err)
+	}
+	defer httpResp.Body.Close()
+	data, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return 0, moerr.NewInternalErrorf(ctx, "llm server request failed: %v", err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		msg := strings.TrimSpace(string(data))
+		var errResp openAIErrorResponse
+		if json.Unmarshal(data, &errResp) == nil && errResp.Error.Message != "" {
+			msg = errResp.Error.Message
+		}
+		err = moerr.NewInternalErrorf(ctx, "llm server returned %s: %s", httpResp.Status, msg)
+		if httpResp.StatusCode == http.StatusTooManyRequests || httpResp.StatusCode >= http.StatusInternalServerError {
+			retryAfter = 0
+			if secs, err := strconv.Atoi(httpResp.Header.Get("Retry-After")); err == nil && secs > 0 {
+				retryAfter = min(time.Duration(secs)*time.Second, maxRetryBackoff)
+			}
+			return retryAfter, err
+		}
+		return -1, err
+	}
+	if err = json.Unmarshal(data, resp); err != nil {
+		return -1, moerr.NewInternalErrorf(ctx, "invalid response from llm server: %v", err)
+	}
+	return 0, nil
+}
@@ -0,0 +1,293 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newOpenAITestServer serves chat completions that echo the last message and
+// embeddings of [len(text), index in batch].
+func newOpenAITestServer(t *testing.T, apiKey string) (*httptest.Server, *[]int) {
+	var batches []int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if apiKey != "" && r.Header.Get("Authorization") != "Bearer "+apiKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"message":"invalid api key"}}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/chat/completions":
+			var req openAIChatRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			last := req.Messages[len(req.Messages)-1]
+			json.NewEncoder(w).Encode(map[string]any{
+				"choices": []any{map[string]any{
+					"message": map[string]any{"role": "assistant", "content": req.Model + ":" + last.Role + ":" + last.Content},
+				}},
+			})
+		case "/v1/embeddings":
+			var req openAIEmbeddingRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			batches = append(batches, len(req.Input))
+			// reply out of order, the client must order by index
+			data := make([]any, 0, len(req.Input))
+			for i := len(req.Input) - 1; i >= 0; i-- {
+				data = append(data, map[string]any{
+					"index":     i,
+					"embedding": []float32{float32(len(req.Input[i])), float32(i)},
+				})
+			}
+			json.NewEncoder(w).Encode(map[string]any{"data": data})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &batches
+}
+
+func TestOpenAIParams(t *testing.T) {
+	badOptions := []string{
+		`["temperature", 0.1]`,
+		`{"temperature": 2.5}`,
+		`{"max_tokens": 0}`,
+		`{"batch_size": 1.5}`,
+		`{"max_retries": -1}`,
+		`{"timeout": "10"}`,
+		`{"embedding_model": ""}`,
+		`{"api_key": 1}`,
+		`{"api_key": "a", "api_key_name": "b"}`,
+		`{"api_key_name": 1}`,
+		`{"api_key_name": "not-configured"}`,
+	}
+	for _, opts := range badOptions {
+		if cli, err := NewOpenAIClient("", "m", opts); cli != nil || err == nil {
+			t.Errorf("should not create openai client with options %s", opts)
+		}
+	}
+	if cli, err := NewOpenAIClient("localhost:8000", "m", ""); cli != nil || err == nil {
+		t.Error("should not create openai client without a scheme")
+	}
+
+	cli, err := NewOpenAIClient("", "m", "")
+	if err != nil || cli.addr != defaultOpenAIAddr || cli.temperature != 0.1 || cli.batchSize != defaultEmbeddingBatchSize ||
+		cli.embeddingModel != "m" || cli.apiKey != "" {
+		t.Errorf("wrong default openai client: %v, %+v", err, cli)
+	}
+	cli, err = NewLlamaCppClient("", "", `{"temperature": 1.5, "max_tokens": 64, "batch_size": 8, "embedding_model": "e"}`)
+	if err != nil || cli.addr != defaultLlamaCppAddr || cli.temperature != 1.5 || cli.maxTokens != 64 || cli.batchSize != 8 ||
+		cli.embeddingModel != "e" {
+		t.Errorf("wrong llama.cpp client: %v, %+v", err, cli)
+	}
+
+	// options cannot read keys from the environment or files of the CN
+	t.Setenv("MO_TEST_OPENAI_KEY", "env-key")
+	for _, opts := range []string{`{"api_key_env": "MO_TEST_OPENAI_KEY"}`, `{"api_key_file": "/etc/hostname"}`} {
+		if cli, err = NewOpenAIClient("", "m", opts); err != nil || cli.apiKey != "" {
+			t.Errorf("should not read api key with options %s: %v", opts, err)
+		}
+	}
+
+	// Use factory method
+	for _, server := range []string{OpenAIServer, LlamaCppServer} {
+		if c, err := NewLLMClient(server, "http://127.0.0.1:1/v1", "m", ""); c == nil || err != nil {
+			t.Errorf("should create %s client: %v", server, err)
+		}
+	}
+}
+
+func TestOpenAIChat(t *testing.T) {
+	srv, _ := newOpenAITestServer(t, "secret")
+	cli, err := NewOpenAIClient(srv.URL+"/v1/", "m", `{"api_key": "secret"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msgs := []Message{
+		{Role: LLMRoleSystem, Content: "You are a helpful assistant."},
+		{Role: LLMRoleAI, Content: "2+2=?"},
+	}
+	reply, err := cli.ChatMsg(context.Background(), msgs)
+	if err != nil || reply != "m:assistant:2+2=?" {
+		t.Errorf("wrong reply: %v, %s", err, reply)
+	}
+
+	prompt, err := json.Marshal(msgs[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply, err = cli.Chat(context.Background(), string(prompt))
+	if err != nil || reply != "m:system:You are a helpful assistant." {
+		t.Errorf("wrong reply: %v, %s", err, reply)
+	}
+
+	if _, err = cli.Chat(context.Background(), "not json"); err == nil {
+		t.Error("should fail to chat with an invalid prompt")
+	}
+
+	// a bad api key is not retried
+	cli.apiKey = "bad"
+	if _, err = cli.ChatMsg(context.Background(), msgs); err == nil || !strings.Contains(err.Error(), "invalid api key") {
+		t.Errorf("should fail with a bad api key: %v", err)
+	}
+}
+
+func TestOpenAIEmbedding(t *testing.T) {
+	srv, batches := newOpenAITestServer(t, "")
+	cli, err := NewOpenAIClient(srv.URL+"/v1", "m", `{"batch_size": 2}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	emb, err := cli.CreateEmbedding(context.Background(), "hello")
+	if err != nil || len(emb) != 2 || emb[0] != 5 {
+		t.Errorf("wrong embedding: %v, %v", err, emb)
+	}
+
+	*batches = nil
+	texts := []string{"a", "bb", "ccc", "dddd", "eeeee"}
+	embs, err := cli.CreateEmbeddings(context.Background(), texts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*batches) != 3 || (*batches)[0] != 2 || (*batches)[2] != 1 {
+		t.Errorf("wrong batches: %v", *batches)
+	}
+	for i, e := range embs {
+		if e[0] != float32(len(texts[i])) || e[1] != float32(i%2) {
+			t.Errorf("wrong embedding %d: %v", i, e)
+		}
+	}
+
+	embs, err = cli.CreateEmbeddings(context.Background(), nil)
+	if err != nil || len(embs) != 0 {
+		t.Errorf("wrong embeddings of no texts: %v, %v", err, embs)
+	}
+}
+
+func TestOpenAIRetry(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"ok"}}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	cli, err := NewOpenAIClient(srv.URL, "m", `{"max_retries": 2}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cli.backoff = time.Millisecond
+	reply, err := cli.ChatMsg(context.Background(), []Message{{Role: LLMRoleUser, Content: "hi"}})
+	if err != nil || reply != "ok" || calls.Load() != 3 {
+		t.Errorf("wrong reply after retries: %v, %s, %d calls", err, reply, calls.Load())
+	}
+
+	// out of retries
+	calls.Store(0)
+	cli.maxRetries = 1
+	if _, err = cli.ChatMsg(context.Background(), []Message{{Role: LLMRoleUser, Content: "hi"}}); err == nil || calls.Load() != 2 {
+		t.Errorf("should fail after retries: %v, %d calls", err, calls.Load())
+	}
+
+	// the context ends the backoff
+	calls.Store(0)
+	cli.backoff = time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err = cli.ChatMsg(ctx, []Message{{Role: LLMRoleUser, Content: "hi"}}); err == nil || calls.Load() != 1 {
+		t.Errorf("should fail when the context is done: %v, %d calls", err, calls.Load())
+	}
+
+	// a server that is down
+	srv.Close()
+	cli.backoff = time.Millisecond
+	if _, err = cli.CreateEmbedding(context.Background(), "hi"); err == nil {
+		t.Error("should fail when the server is down")
+	}
+}
+
+func TestOpenAIServerKey(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "key")
+	if err := os.WriteFile(keyFile, []byte("file-key\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("MO_TEST_OPENAI_KEY", "env-key")
+	badConfigs := [][]ServerKey{
+		{{Addr: "http://127.0.0.1:1/v1", Key: "k"}},
+		{{Name: "a", Addr: "127.0.0.1:1", Key: "k"}},
+		{{Name: "a", Addr: "http://127.0.0.1:1/v1"}},
+		{{Name: "a", Addr: "http://127.0.0.1:1/v1", Key: "k", KeyEnv: "MO_TEST_OPENAI_KEY"}},
+		{{Name: "a", Addr: "http://127.0.0.1:1/v1", KeyEnv: "MO_TEST_OPENAI_KEY_NOT_SET"}},
+		{{Name: "a", Addr: "http://127.0.0.1:1/v1", KeyFile: "/nonexistent/key"}},
+		{{Name: "a", Addr: "http://127.0.0.1:1/v1", Key: "k"}, {Name: "a", Addr: "http://127.0.0.1:2/v1", Key: "k"}},
+	}
+	for _, keys := range badConfigs {
+		if err := Init(Config{Keys: keys}); err == nil {
+			t.Errorf("should not accept llm keys %+v", keys)
+		}
+	}
+
+	err := Init(Config{Keys: []ServerKey{
+		{Name: "plain", Addr: "http://127.0.0.1:1/v1/", Key: "plain-key"},
+		{Name: "env", Addr: "http://127.0.0.1:2/v1", KeyEnv: "MO_TEST_OPENAI_KEY"},
+		{Name: "file", Addr: "http://127.0.0.1:3/v1", KeyFile: keyFile},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = Init(Config{}) })
+
+	for name, want := range map[string][2]string{
+		"plain": {"http://127.0.0.1:1/v1", "plain-key"},
+		"env":   {"http://127.0.0.1:2/v1", "env-key"},
+		"file":  {"http://127.0.0.1:3/v1", "file-key"},
+	} {
+		// an empty address selects the address of the key
+		cli, err := NewOpenAIClient("", "m", `{"api_key_name": "`+name+`"}`)
+		if err != nil || cli.addr != want[0] || cli.apiKey != want[1] {
+			t.Errorf("wrong client of llm key %s: %v, %+v", name, err, cli)
+		}
+		cli, err = NewLlamaCppClient(want[0]+"/", "m", `{"api_key_name": "`+name+`"}`)
+		if err != nil || cli.addr != want[0] || cli.apiKey != want[1] {
+			t.Errorf("wrong client of llm key %s: %v, %+v", name, err, cli)
+		}
+	}
+
+	// the key is pinned to its address
+	if cli, err := NewOpenAIClient("http://evil.example.com/v1", "m", `{"api_key_name": "plain"}`); cli != nil || err == nil {
+		t.Error("should not send llm key to another server")
+	}
+}
@@ -32,8 +32,10 @@ const (
 	checkSumLen     = 4
 	zoneMapOff      = checkSumOff + checkSumLen
 	zoneMapLen      = 64
-	colMetaDummyOff = zoneMapOff + zoneMapLen
-	colMetaDummyLen = 32
+	encodingOff     = zoneMapOff + zoneMapLen
+	encodingLen     = 1
+	colMetaDummyOff = encodingOff + encodingLen
+	colMetaDummyLen = 31
 	colMetaLen      = colMetaDummyOff + colMetaDummyLen
 )
 
@@ -98,6 +100,18 @@ func (cm ColumnMeta) SetZoneMap(zm ZoneMap) {
 	copy(cm[zoneMapOff:zoneMapOff+zoneMapLen], zm)
 }
 
+// Encoding returns the lightweight encoding applied to the column data
+// before compression. Objects written before encodings were introduced
+// read back as EncodingPlain because the byte was part of the zeroed
+// dummy area.
+func (cm ColumnMeta) Encoding() EncodingType {
+	return EncodingType(cm[encodingOff])
+}
+
+func (cm ColumnMeta) setEncoding(enc EncodingType) {
+	cm[encodingOff] = uint8(enc)
+}
+
 func (cm ColumnMeta) Checksum() uint32 {
 	return types.DecodeUint32(cm[checkSumOff : checkSumOff+checkSumLen])
 }
@@ -48,9 +48,9 @@ func constructorFactory(size int64, algo uint8) CacheConstructor {
 			return cacheData, nil
 		}
 
-		// lz4 compress
+		// the extent records the codec of each column, objects may mix codecs
 		decompressedData := allocator.AllocateCacheDataWithHint(ctx, int(size), malloc.NoClear)
-		bs, err := compress.Decompress(data, decompressedData.Bytes(), compress.Lz4)
+		bs, err := compress.Decompress(data, decompressedData.Bytes(), int(algo))
 		if err != nil {
 			decompressedData.Release()
 			return
@@ -86,6 +86,13 @@ func MustVectorTo(toVec *vector.Vector, buf []byte) (err error) {
 	if header.Version == IOET_ColumnData_V2 {
 		err = toVec.UnmarshalBinary(buf[IOEntryHeaderSize:])
 		return
+	} else if header.Version == IOET_ColumnData_V3 {
+		var plain []byte
+		if plain, err = decodeColumnDataV3(buf[IOEntryHeaderSize:]); err != nil {
+			return
+		}
+		err = toVec.UnmarshalBinary(plain)
+		return
 	} else if header.Version == IOET_ColumnData_V1 {
 		err = toVec.UnmarshalBinaryV1(buf[IOEntryHeaderSize:])
 		return
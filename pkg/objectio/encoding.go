@@ -0,0 +1,890 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// EncodingType is the lightweight, type aware encoding applied to a column
+// of a block before it is compressed. It is chosen automatically when the
+// block is written and recorded in the column meta.
+type EncodingType uint8
+
+const (
+	EncodingPlain EncodingType = iota
+	// EncodingDict stores the distinct values of a varlen column once and
+	// replaces every row with a bit-packed code into that dictionary.
+	EncodingDict
+	// EncodingRLE stores runs of equal fixed-size values as (value, length).
+	EncodingRLE
+	// EncodingDelta stores the bit-packed differences between consecutive
+	// integer values. It suits monotonic columns such as timestamps.
+	EncodingDelta
+	// EncodingFOR stores the integer values as bit-packed offsets from the
+	// minimum value of the block (frame of reference).
+	EncodingFOR
+)
+
+func (e EncodingType) String() string {
+	switch e {
+	case EncodingPlain:
+		return "PLAIN"
+	case EncodingDict:
+		return "DICT"
+	case EncodingRLE:
+		return "RLE"
+	case EncodingDelta:
+		return "DELTA"
+	case EncodingFOR:
+		return "FOR"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", uint8(e))
+	}
+}
+
+const (
+	// a column is only dictionary encoded if it has at most one distinct
+	// value for every dictMinRowsPerValue rows
+	dictMinRowsPerValue = 2
+	dictMaxValues       = 1 << 16
+)
+
+// EncodingConfig is the config of the lightweight column encodings.
+type EncodingConfig struct {
+	// Enable writes the columns of new blocks as IOET_ColumnData_V3 entries
+	// when an encoding is smaller than the plain layout. Services older than
+	// V3 cannot read these objects, so it must only be turned on after the
+	// whole cluster has been upgraded.
+	Enable bool `toml:"enable"`
+}
+
+var columnEncodingEnabled atomic.Bool
+
+// SetColumnEncoding turns the lightweight column encodings of the blocks
+// written by this process on or off. It is off by default.
+func SetColumnEncoding(enable bool) {
+	columnEncodingEnabled.Store(enable)
+}
+
+// ColumnEncodingEnabled returns whether new blocks are written with the
+// lightweight column encodings.
+func ColumnEncodingEnabled() bool {
+	return columnEncodingEnabled.Load()
+}
+
+// encoded column data layout (IOET_ColumnData_V3):
+//
+//	| encoding(1) | class(1) | type(TSize) | rows(4) | nspLen(4) | nsp | sorted(1) | body |
+//
+// The body depends on the encoding:
+//
+//	FOR:   | min(8) | width(1) | packed offsets |
+//	Delta: | first(8) | min(8) | width(1) | packed zigzag deltas |
+//	RLE:   | runs(4) | min(8) | width(1) | packed values | width(1) | packed run lengths |
+//	Dict:  | count(4) | varlenas(24*count) | areaLen(4) | area | width(1) | packed codes |
+type encodedColumn struct {
+	enc    EncodingType
+	class  uint8
+	typ    []byte
+	rows   uint32
+	nsp    []byte
+	sorted byte
+	body   []byte
+}
+
+func decodeEncodedColumn(buf []byte) (col encodedColumn, err error) {
+	if len(buf) < 2+types.TSize+8 {
+		err = moerr.NewInternalErrorNoCtx("bad encoded column data")
+		return
+	}
+	col.enc = EncodingType(buf[0])
+	col.class = buf[1]
+	buf = buf[2:]
+	col.typ = buf[:types.TSize]
+	buf = buf[types.TSize:]
+	col.rows = types.DecodeUint32(buf[:4])
+	buf = buf[4:]
+	nspLen := types.DecodeUint32(buf[:4])
+	buf = buf[4:]
+	if uint32(len(buf)) < nspLen+1 {
+		err = moerr.NewInternalErrorNoCtx("bad encoded column data")
+		return
+	}
+	col.nsp = buf[:nspLen]
+	buf = buf[nspLen:]
+	col.sorted = buf[0]
+	col.body = buf[1:]
+	return
+}
+
+// encodeColumnData writes vec into buf as an encoded IO entry if one of the
+// lightweight encodings is smaller than the plain layout. It writes nothing
+// and returns EncodingPlain otherwise.
+func encodeColumnData(buf *bytes.Buffer, vec *vector.Vector) (EncodingType, error) {
+	if !columnEncodingEnabled.Load() || vec.IsConst() || vec.Length() == 0 {
+		return EncodingPlain, nil
+	}
+	typ := vec.GetType()
+	if typ.IsVarlen() {
+		return encodeDict(buf, vec)
+	}
+	if _, _, ok := integerLayout(typ.Oid); ok {
+		return encodeInteger(buf, vec)
+	}
+	return EncodingPlain, nil
+}
+
+func writeEncodedHeader(buf *bytes.Buffer, vec *vector.Vector, enc EncodingType) error {
+	nsp, err := vec.GetNulls().Show()
+	if err != nil {
+		return err
+	}
+	h := IOEntryHeader{IOET_ColData, IOET_ColumnData_V3}
+	buf.Write(EncodeIOEntryHeader(&h))
+	buf.WriteByte(uint8(enc))
+	buf.WriteByte(vector.FLAT)
+	buf.Write(types.EncodeType(vec.GetType()))
+	rows := uint32(vec.Length())
+	buf.Write(types.EncodeUint32(&rows))
+	nspLen := uint32(len(nsp))
+	buf.Write(types.EncodeUint32(&nspLen))
+	buf.Write(nsp)
+	sorted := vec.GetSorted()
+	buf.Write(types.EncodeBool(&sorted))
+	return nil
+}
+
+// integerLayout returns the value size of the integer-like types that the
+// FOR, delta and RLE encodings apply to.
+func integerLayout(oid types.T) (size int, signed bool, ok bool) {
+	switch oid {
+	case types.T_bool, types.T_int8, types.T_uint8:
+		size = 1
+	case types.T_int16, types.T_uint16, types.T_year, types.T_enum:
+		size = 2
+	case types.T_int32, types.T_uint32, types.T_date:
+		size = 4
+	case types.T_int64, types.T_uint64, types.T_bit, types.T_time,
+		types.T_datetime, types.T_timestamp, types.T_decimal64:
+		size = 8
+	default:
+		return 0, false, false
+	}
+	switch oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64, types.T_year,
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp, types.T_decimal64:
+		signed = true
+	}
+	return size, signed, true
+}
+
+// loadKey maps the value at row i to an unsigned key that keeps the order
+// of the original value, so that FOR offsets are never negative.
+func loadKey(data []byte, i, size int, signed bool) uint64 {
+	var u uint64
+	switch size {
+	case 1:
+		u = uint64(data[i])
+		if signed {
+			u = uint64(int64(int8(u)))
+		}
+	case 2:
+		u = uint64(binary.LittleEndian.Uint16(data[i*2:]))
+		if signed {
+			u = uint64(int64(int16(u)))
+		}
+	case 4:
+		u = uint64(binary.LittleEndian.Uint32(data[i*4:]))
+		if signed {
+			u = uint64(int64(int32(u)))
+		}
+	default:
+		u = binary.LittleEndian.Uint64(data[i*8:])
+	}
+	if signed {
+		u ^= 1 << 63
+	}
+	return u
+}
+
+func storeKey(data []byte, i, size int, signed bool, u uint64) {
+	if signed {
+		u ^= 1 << 63
+	}
+	switch size {
+	case 1:
+		data[i] = byte(u)
+	case 2:
+		binary.LittleEndian.PutUint16(data[i*2:], uint16(u))
+	case 4:
+		binary.LittleEndian.PutUint32(data[i*4:], uint32(u))
+	default:
+		binary.LittleEndian.PutUint64(data[i*8:], u)
+	}
+}
+
+func zigzag(d uint64) uint64 {
+	return uint64((int64(d) << 1) ^ (int64(d) >> 63))
+}
+
+func unzigzag(z uint64) uint64 {
+	return (z >> 1) ^ -(z & 1)
+}
+
+func packedLen(n int, width uint8) int {
+	return (n*int(width) + 7) / 8
+}
+
+// appendPacked appends the low width bits of every value to dst as a
+// little-endian bit stream.
+func appendPacked(dst []byte, vals []uint64, width uint8) []byte {
+	if width == 0 {
+		return dst
+	}
+	var (
+		acc   uint64
+		nbits uint
+		w     = uint(width)
+	)
+	for _, v := range vals {
+		acc |= v << nbits
+		nbits += w
+		if nbits >= 64 {
+			dst = binary.LittleEndian.AppendUint64(dst, acc)
+			nbits -= 64
+			if nbits > 0 {
+				acc = v >> (w - nbits)
+			} else {
+				acc = 0
+			}
+		}
+	}
+	for nbits > 0 {
+		dst = append(dst, byte(acc))
+		acc >>= 8
+		nbits -= min(nbits, 8)
+	}
+	return dst
+}
+
+// unpackAt returns the i-th width bits value of a stream written by
+// appendPacked.
+func unpackAt(src []byte, i int, width uint8) uint64 {
+	if width == 0 {
+		return 0
+	}
+	w := uint(width)
+	pos := uint(i) * w
+	off, shift := pos>>3, pos&7
+	var word uint64
+	if int(off)+8 <= len(src) {
+		word = binary.LittleEndian.Uint64(src[off:])
+	} else {
+		for j := 0; int(off)+j < len(src); j++ {
+			word |= uint64(src[int(off)+j]) << (8 * j)
+		}
+	}
+	v := word >> shift
+	if shift+w > 64 {
+		v |= uint64(src[off+8]) << (64 - shift)
+	}
+	return v & (1<<w - 1)
+}
+
+func unpack(src []byte, out []uint64, width uint8) error {
+	if len(src) < packedLen(len(out), width) {
+		return moerr.NewInternalErrorNoCtx("bad encoded column data")
+	}
+	for i := range out {
+		out[i] = unpackAt(src, i, width)
+	}
+	return nil
+}
+
+func encodeInteger(buf *bytes.Buffer, vec *vector.Vector) (EncodingType, error) {
+	size, signed, _ := integerLayout(vec.GetType().Oid)
+	data := vec.GetData()
+	rows := vec.Length()
+
+	keys := make([]uint64, rows)
+	minKey, maxKey := uint64(0), uint64(0)
+	runs := 1
+	for i := range keys {
+		keys[i] = loadKey(data, i, size, signed)
+		if i == 0 {
+			minKey, maxKey = keys[0], keys[0]
+			continue
+		}
+		minKey, maxKey = min(minKey, keys[i]), max(maxKey, keys[i])
+		if keys[i] != keys[i-1] {
+			runs++
+		}
+	}
+	var deltas []uint64
+	var minDelta, maxDelta uint64
+	if rows > 1 {
+		deltas = make([]uint64, rows-1)
+		for i := 1; i < rows; i++ {
+			deltas[i-1] = zigzag(keys[i] - keys[i-1])
+		}
+		minDelta, maxDelta = deltas[0], deltas[0]
+		for _, d := range deltas[1:] {
+			minDelta, maxDelta = min(minDelta, d), max(maxDelta, d)
+		}
+	}
+
+	forWidth := uint8(bits.Len64(maxKey - minKey))
+	deltaWidth := uint8(bits.Len64(maxDelta - minDelta))
+	longest, cur := 1, 1
+	for i := 1; i < rows; i++ {
+		if keys[i] == keys[i-1] {
+			cur++
+			longest = max(longest, cur)
+		} else {
+			cur = 1
+		}
+	}
+	runWidth := uint8(bits.Len64(uint64(longest - 1)))
+
+	best, bestSize := EncodingPlain, rows*size
+	if n := 9 + packedLen(rows, forWidth); n < bestSize {
+		best, bestSize = EncodingFOR, n
+	}
+	if n := 17 + packedLen(len(deltas), deltaWidth); rows > 1 && n < bestSize {
+		best, bestSize = EncodingDelta, n
+	}
+	if n := 14 + packedLen(runs, forWidth) + packedLen(runs, runWidth); n < bestSize {
+		best = EncodingRLE
+	}
+	if best == EncodingPlain {
+		return best, nil
+	}
+
+	if err := writeEncodedHeader(buf, vec, best); err != nil {
+		return EncodingPlain, err
+	}
+	var body []byte
+	switch best {
+	case EncodingFOR:
+		body = binary.LittleEndian.AppendUint64(body, minKey)
+		body = append(body, forWidth)
+		for i := range keys {
+			keys[i] -= minKey
+		}
+		body = appendPacked(body, keys, forWidth)
+	case EncodingDelta:
+		body = binary.LittleEndian.AppendUint64(body, keys[0])
+		body = binary.LittleEndian.AppendUint64(body, minDelta)
+		body = append(body, deltaWidth)
+		for i := range deltas {
+			deltas[i] -= minDelta
+		}
+		body = appendPacked(body, deltas, deltaWidth)
+	case EncodingRLE:
+		values := make([]uint64, 0, runs)
+		lengths := make([]uint64, 0, runs)
+		for i := 0; i < rows; {
+			j := i + 1
+			for j < rows && keys[j] == keys[i] {
+				j++
+			}
+			values = append(values, keys[i]-minKey)
+			lengths = append(lengths, uint64(j-i-1))
+			i = j
+		}
+		n := uint32(runs)
+		body = append(body, types.EncodeUint32(&n)...)
+		body = binary.LittleEndian.AppendUint64(body, minKey)
+		body = append(body, forWidth)
+		body = appendPacked(body, values, forWidth)
+		body = append(body, runWidth)
+		body = appendPacked(body, lengths, runWidth)
+	}
+	buf.Write(body)
+	return best, nil
+}
+
+func decodeInteger(col encodedColumn, typ types.Type) ([]byte, error) {
+	size, signed, ok := integerLayout(typ.Oid)
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx(
+			fmt.Sprintf("%s encoding on type %s", col.enc.String(), typ.String()))
+	}
+	rows := int(col.rows)
+	data := make([]byte, rows*size)
+	body := col.body
+	bad := moerr.NewInternalErrorNoCtx("bad encoded column data")
+	switch col.enc {
+	case EncodingFOR:
+		if len(body) < 9 {
+			return nil, bad
+		}
+		minKey, width := binary.LittleEndian.Uint64(body), body[8]
+		body = body[9:]
+		if len(body) < packedLen(rows, width) {
+			return nil, bad
+		}
+		for i := 0; i < rows; i++ {
+			storeKey(data, i, size, signed, minKey+unpackAt(body, i, width))
+		}
+	case EncodingDelta:
+		if len(body) < 17 {
+			return nil, bad
+		}
+		key := binary.LittleEndian.Uint64(body)
+		minDelta, width := binary.LittleEndian.Uint64(body[8:]), body[16]
+		body = body[17:]
+		if len(body) < packedLen(rows-1, width) {
+			return nil, bad
+		}
+		storeKey(data, 0, size, signed, key)
+		for i := 1; i < rows; i++ {
+			key += unzigzag(minDelta + unpackAt(body, i-1, width))
+			storeKey(data, i, size, signed, key)
+		}
+	case EncodingRLE:
+		values, lengths, err := decodeRLEBody(body, rows)
+		if err != nil {
+			return nil, err
+		}
+		row := 0
+		for i := range values {
+			for j := uint64(0); j <= lengths[i]; j++ {
+				storeKey(data, row, size, signed, values[i])
+				row++
+			}
+		}
+	default:
+		return nil, bad
+	}
+	return data, nil
+}
+
+// decodeRLEBody returns the key and the stored length (length-1) of every
+// run of a run-length encoded body holding rows rows.
+func decodeRLEBody(body []byte, rows int) (values, lengths []uint64, err error) {
+	bad := moerr.NewInternalErrorNoCtx("bad encoded column data")
+	if len(body) < 13 {
+		return nil, nil, bad
+	}
+	runs := int(types.DecodeUint32(body))
+	minKey, width := binary.LittleEndian.Uint64(body[4:]), body[12]
+	body = body[13:]
+	values = make([]uint64, runs)
+	if err = unpack(body, values, width); err != nil {
+		return nil, nil, err
+	}
+	body = body[packedLen(runs, width):]
+	if len(body) < 1 {
+		return nil, nil, bad
+	}
+	runWidth := body[0]
+	lengths = make([]uint64, runs)
+	if err = unpack(body[1:], lengths, runWidth); err != nil {
+		return nil, nil, err
+	}
+	total := 0
+	for i := range values {
+		values[i] += minKey
+		total += int(lengths[i]) + 1
+		if total > rows {
+			return nil, nil, bad
+		}
+	}
+	if total != rows {
+		return nil, nil, bad
+	}
+	return values, lengths, nil
+}
+
+func encodeDict(buf *bytes.Buffer, vec *vector.Vector) (EncodingType, error) {
+	rows := vec.Length()
+	maxValues := min(rows/dictMinRowsPerValue, dictMaxValues)
+	if maxValues == 0 {
+		return EncodingPlain, nil
+	}
+	col, area := vector.MustVarlenaRawData(vec)
+	nsp := vec.GetNulls()
+
+	var (
+		index    = make(map[string]uint64, maxValues)
+		codes    = make([]uint64, rows)
+		dict     []types.Varlena
+		dictArea []byte
+		err      error
+	)
+	for i := range col {
+		if nsp.Contains(uint64(i)) {
+			continue
+		}
+		val := col[i].GetByteSlice(area)
+		code, ok := index[string(val)]
+		if !ok {
+			if len(dict) == maxValues {
+				return EncodingPlain, nil
+			}
+			code = uint64(len(dict))
+			index[string(val)] = code
+			var v types.Varlena
+			if v, dictArea, err = types.BuildVarlena(val, dictArea, nil); err != nil {
+				return EncodingPlain, err
+			}
+			dict = append(dict, v)
+		}
+		codes[i] = code
+	}
+
+	width := uint8(0)
+	if len(dict) > 1 {
+		width = uint8(bits.Len64(uint64(len(dict) - 1)))
+	}
+	encodedSize := 9 + len(dict)*types.VarlenaSize + len(dictArea) + packedLen(rows, width)
+	if encodedSize >= rows*types.VarlenaSize+len(area) {
+		return EncodingPlain, nil
+	}
+
+	if err = writeEncodedHeader(buf, vec, EncodingDict); err != nil {
+		return EncodingPlain, err
+	}
+	count := uint32(len(dict))
+	buf.Write(types.EncodeUint32(&count))
+	for i := range dict {
+		buf.Write(dict[i][:])
+	}
+	areaLen := uint32(len(dictArea))
+	buf.Write(types.EncodeUint32(&areaLen))
+	buf.Write(dictArea)
+	buf.WriteByte(width)
+	buf.Write(appendPacked(nil, codes, width))
+	return EncodingDict, nil
+}
+
+// dictColumn is a view over the body of a dictionary encoded column.
+type dictColumn struct {
+	count int
+	dict  []byte
+	area  []byte
+	width uint8
+	codes []byte
+}
+
+func decodeDictBody(body []byte, rows int) (d dictColumn, err error) {
+	bad := moerr.NewInternalErrorNoCtx("bad encoded column data")
+	if len(body) < 4 {
+		return d, bad
+	}
+	d.count = int(types.DecodeUint32(body))
+	body = body[4:]
+	if len(body) < d.count*types.VarlenaSize+4 {
+		return d, bad
+	}
+	d.dict = body[:d.count*types.VarlenaSize]
+	body = body[d.count*types.VarlenaSize:]
+	areaLen := int(types.DecodeUint32(body))
+	body = body[4:]
+	if len(body) < areaLen+1 {
+		return d, bad
+	}
+	d.area = body[:areaLen]
+	d.width = body[areaLen]
+	d.codes = body[areaLen+1:]
+	if len(d.codes) < packedLen(rows, d.width) {
+		return d, bad
+	}
+	return d, nil
+}
+
+func (d dictColumn) code(row int) int {
+	return int(unpackAt(d.codes, row, d.width))
+}
+
+func decodeDict(col encodedColumn) (data, area []byte, err error) {
+	rows := int(col.rows)
+	var d dictColumn
+	if d, err = decodeDictBody(col.body, rows); err != nil {
+		return
+	}
+	data = make([]byte, rows*types.VarlenaSize)
+	if d.count == 0 {
+		// all rows are null
+		return data, nil, nil
+	}
+	var nsp nulls.Nulls
+	if len(col.nsp) > 0 {
+		if err = nsp.ReadNoCopy(col.nsp); err != nil {
+			return
+		}
+	}
+	for i := 0; i < rows; i++ {
+		// null rows keep an empty varlena like the plain layout
+		if nsp.Contains(uint64(i)) {
+			continue
+		}
+		code := d.code(i)
+		if code >= d.count {
+			return nil, nil, moerr.NewInternalErrorNoCtx("bad encoded column data")
+		}
+		copy(
+			data[i*types.VarlenaSize:(i+1)*types.VarlenaSize],
+			d.dict[code*types.VarlenaSize:(code+1)*types.VarlenaSize],
+		)
+	}
+	return data, d.area, nil
+}
+
+// decodeColumnDataV3 rebuilds the plain vector layout of an encoded column
+// so that it can be unmarshalled like IOET_ColumnData_V2.
+func decodeColumnDataV3(buf []byte) ([]byte, error) {
+	col, err := decodeEncodedColumn(buf)
+	if err != nil {
+		return nil, err
+	}
+	typ := types.DecodeType(col.typ)
+	var data, area []byte
+	switch col.enc {
+	case EncodingDict:
+		if !typ.IsVarlen() {
+			return nil, moerr.NewInternalErrorNoCtx(
+				fmt.Sprintf("%s encoding on type %s", col.enc.String(), typ.String()))
+		}
+		data, area, err = decodeDict(col)
+	case EncodingFOR, EncodingDelta, EncodingRLE:
+		data, err = decodeInteger(col, typ)
+	default:
+		err = moerr.NewInternalErrorNoCtx(fmt.Sprintf("unknown column encoding %s", col.enc.String()))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	plain := bytes.NewBuffer(make([]byte, 0, 1+types.TSize+17+len(data)+len(area)+len(col.nsp)))
+	plain.WriteByte(col.class)
+	plain.Write(col.typ)
+	plain.Write(types.EncodeUint32(&col.rows))
+	dataLen := uint32(len(data))
+	plain.Write(types.EncodeUint32(&dataLen))
+	plain.Write(data)
+	areaLen := uint32(len(area))
+	plain.Write(types.EncodeUint32(&areaLen))
+	plain.Write(area)
+	nspLen := uint32(len(col.nsp))
+	plain.Write(types.EncodeUint32(&nspLen))
+	plain.Write(col.nsp)
+	plain.WriteByte(col.sorted)
+	return plain.Bytes(), nil
+}
+
+func DecodeColumnDataV3(buf []byte) (ioe any, err error) {
+	var plain []byte
+	if plain, err = decodeColumnDataV3(buf); err != nil {
+		return
+	}
+	return DecodeColumnDataV2(plain)
+}
+
+// SearchEncodedColumn evaluates searchFunc against the distinct values of a
+// dictionary or run-length encoded column entry, see SearchDictColumn and
+// SearchRLEColumn. ok is false if buf uses another encoding.
+func SearchEncodedColumn(
+	buf []byte,
+	searchFunc func(*vector.Vector) []int64,
+) (sels []int64, ok bool, err error) {
+	header := DecodeIOEntryHeader(buf)
+	if header.Type != IOET_ColData || header.Version != IOET_ColumnData_V3 {
+		return
+	}
+	switch EncodingType(buf[IOEntryHeaderSize]) {
+	case EncodingDict:
+		return SearchDictColumn(buf, searchFunc)
+	case EncodingRLE:
+		return SearchRLEColumn(buf, searchFunc)
+	}
+	return
+}
+
+// IsSearchableEncodingType returns whether a column of type typ may be
+// dictionary or run-length encoded, see SearchEncodedColumn.
+func IsSearchableEncodingType(typ types.Type) bool {
+	if typ.IsVarlen() {
+		return true
+	}
+	_, _, ok := integerLayout(typ.Oid)
+	return ok
+}
+
+// newSearchVector builds the flat vector without nulls of count values that
+// is handed to the search funcs.
+func newSearchVector(typ []byte, count int, data, area []byte) (*vector.Vector, error) {
+	vec := vector.NewVec(types.DecodeType(typ))
+	plain := bytes.NewBuffer(make([]byte, 0, 1+types.TSize+17+len(data)+len(area)))
+	plain.WriteByte(vector.FLAT)
+	plain.Write(typ)
+	rows := uint32(count)
+	plain.Write(types.EncodeUint32(&rows))
+	dataLen := uint32(len(data))
+	plain.Write(types.EncodeUint32(&dataLen))
+	plain.Write(data)
+	areaLen := uint32(len(area))
+	plain.Write(types.EncodeUint32(&areaLen))
+	plain.Write(area)
+	var nspLen uint32
+	plain.Write(types.EncodeUint32(&nspLen))
+	plain.WriteByte(0)
+	if err := vec.UnmarshalBinary(plain.Bytes()); err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
+// SearchDictColumn evaluates searchFunc against the dictionary of a
+// dictionary encoded column entry instead of the decoded column. searchFunc
+// receives a vector holding the distinct values and returns the offsets of
+// the matching ones; the rows whose code is one of them are returned. Null
+// rows never match. ok is false if buf is not a dictionary encoded column.
+func SearchDictColumn(
+	buf []byte,
+	searchFunc func(*vector.Vector) []int64,
+) (sels []int64, ok bool, err error) {
+	header := DecodeIOEntryHeader(buf)
+	if header.Type != IOET_ColData || header.Version != IOET_ColumnData_V3 {
+		return
+	}
+	var col encodedColumn
+	if col, err = decodeEncodedColumn(buf[IOEntryHeaderSize:]); err != nil {
+		return
+	}
+	if col.enc != EncodingDict {
+		return
+	}
+	rows := int(col.rows)
+	var d dictColumn
+	if d, err = decodeDictBody(col.body, rows); err != nil {
+		return
+	}
+	ok = true
+	if d.count == 0 {
+		return
+	}
+
+	var dictVec *vector.Vector
+	if dictVec, err = newSearchVector(col.typ, d.count, d.dict, d.area); err != nil {
+		return
+	}
+
+	matched := searchFunc(dictVec)
+	if len(matched) == 0 {
+		return
+	}
+	hits := make([]bool, d.count)
+	for _, code := range matched {
+		hits[code] = true
+	}
+	var nsp nulls.Nulls
+	if len(col.nsp) > 0 {
+		if err = nsp.ReadNoCopy(col.nsp); err != nil {
+			return
+		}
+	}
+	for i := 0; i < rows; i++ {
+		code := d.code(i)
+		if code >= d.count {
+			return nil, false, moerr.NewInternalErrorNoCtx("bad encoded column data")
+		}
+		if !hits[code] || nsp.Contains(uint64(i)) {
+			continue
+		}
+		sels = append(sels, int64(i))
+	}
+	return
+}
+
+// SearchRLEColumn evaluates searchFunc against the runs of a run-length
+// encoded column entry instead of the decoded column. searchFunc receives a
+// vector holding the value of every run and returns the offsets of the
+// matching ones; the rows of these runs are returned. Null rows never match.
+// ok is false if buf is not a run-length encoded column.
+func SearchRLEColumn(
+	buf []byte,
+	searchFunc func(*vector.Vector) []int64,
+) (sels []int64, ok bool, err error) {
+	header := DecodeIOEntryHeader(buf)
+	if header.Type != IOET_ColData || header.Version != IOET_ColumnData_V3 {
+		return
+	}
+	var col encodedColumn
+	if col, err = decodeEncodedColumn(buf[IOEntryHeaderSize:]); err != nil {
+		return
+	}
+	if col.enc != EncodingRLE {
+		return
+	}
+	typ := types.DecodeType(col.typ)
+	size, signed, isInt := integerLayout(typ.Oid)
+	if !isInt {
+		return nil, false, moerr.NewInternalErrorNoCtx(
+			fmt.Sprintf("%s encoding on type %s", col.enc.String(), typ.String()))
+	}
+	var values, lengths []uint64
+	if values, lengths, err = decodeRLEBody(col.body, int(col.rows)); err != nil {
+		return
+	}
+	ok = true
+
+	data := make([]byte, len(values)*size)
+	for i := range values {
+		storeKey(data, i, size, signed, values[i])
+	}
+	var runVec *vector.Vector
+	if runVec, err = newSearchVector(col.typ, len(values), data, nil); err != nil {
+		return
+	}
+	matched := searchFunc(runVec)
+	if len(matched) == 0 {
+		return
+	}
+	hits := make([]bool, len(values))
+	for _, run := range matched {
+		hits[run] = true
+	}
+	var nsp nulls.Nulls
+	if len(col.nsp) > 0 {
+		if err = nsp.ReadNoCopy(col.nsp); err != nil {
+			return
+		}
+	}
+	row := 0
+	for i := range values {
+		n := int(lengths[i]) + 1
+		if hits[i] {
+			for j := row; j < row+n; j++ {
+				if !nsp.Contains(uint64(j)) {
+					sels = append(sels, int64(j))
+				}
+			}
+		}
+		row += n
+	}
+	return
+}
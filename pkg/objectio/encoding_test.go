@@ -0,0 +1,347 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitPacking(t *testing.T) {
+	for width := 0; width <= 64; width++ {
+		vals := make([]uint64, 100)
+		for i := range vals {
+			vals[i] = rand.Uint64()
+			if width < 64 {
+				vals[i] &= 1<<width - 1
+			}
+		}
+		packed := appendPacked(nil, vals, uint8(width))
+		require.Equal(t, packedLen(len(vals), uint8(width)), len(packed))
+		out := make([]uint64, len(vals))
+		require.NoError(t, unpack(packed, out, uint8(width)))
+		require.Equal(t, vals, out, "width %d", width)
+	}
+}
+
+func enableColumnEncoding(t *testing.T) {
+	SetColumnEncoding(true)
+	t.Cleanup(func() { SetColumnEncoding(false) })
+}
+
+func newEncodingTestVectors(t *testing.T, mp *mpool.MPool, rows int) (vecs []*vector.Vector, expects []EncodingType) {
+	add := func(vec *vector.Vector, enc EncodingType) {
+		vecs = append(vecs, vec)
+		expects = append(expects, enc)
+	}
+
+	seq := vector.NewVec(types.T_int64.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(seq, int64(1000000+i*3), false, mp))
+	}
+	seq.SetSorted(true)
+	add(seq, EncodingDelta)
+
+	ts := vector.NewVec(types.T_timestamp.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(ts, types.Timestamp(1700000000000000-int64(i)*1000), false, mp))
+	}
+	add(ts, EncodingDelta)
+
+	small := vector.NewVec(types.T_int32.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(small, int32(rand.Intn(100)-50), i%7 == 0, mp))
+	}
+	add(small, EncodingFOR)
+
+	runs := vector.NewVec(types.T_uint16.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(runs, uint16(i/100), false, mp))
+	}
+	add(runs, EncodingRLE)
+
+	flags := vector.NewVec(types.T_bool.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(flags, i < rows/3, false, mp))
+	}
+	add(flags, EncodingRLE)
+
+	// alternating extremes wrap around to tiny deltas
+	extreme := vector.NewVec(types.T_int64.ToType())
+	for i := 0; i < rows; i++ {
+		v := int64(math.MinInt64)
+		if i%2 == 1 {
+			v = math.MaxInt64
+		}
+		require.NoError(t, vector.AppendFixed(extreme, v, false, mp))
+	}
+	add(extreme, EncodingDelta)
+
+	random := vector.NewVec(types.T_uint64.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(random, rand.Uint64(), false, mp))
+	}
+	add(random, EncodingPlain)
+
+	floats := vector.NewVec(types.T_float64.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(floats, float64(i%3), false, mp))
+	}
+	add(floats, EncodingPlain)
+
+	status := vector.NewVec(types.T_varchar.ToType())
+	names := []string{"active", "closed", "pending", strings.Repeat("a long status value ", 3)}
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendBytes(status, []byte(names[i%len(names)]), i%11 == 0, mp))
+	}
+	add(status, EncodingDict)
+
+	unique := vector.NewVec(types.T_varchar.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendBytes(unique, []byte(fmt.Sprintf("value-%d", i)), false, mp))
+	}
+	add(unique, EncodingPlain)
+
+	allNull := vector.NewVec(types.T_char.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendBytes(allNull, nil, true, mp))
+	}
+	add(allNull, EncodingDict)
+	return
+}
+
+func TestEncodeColumnData(t *testing.T) {
+	enableColumnEncoding(t)
+	mp := mpool.MustNewZero()
+	vecs, expects := newEncodingTestVectors(t, mp, 8192)
+	for i, vec := range vecs {
+		var buf bytes.Buffer
+		enc, err := encodeColumnData(&buf, vec)
+		require.NoError(t, err)
+		require.Equal(t, expects[i], enc, "vector %d: %s", i, vec.GetType().String())
+		if enc == EncodingPlain {
+			require.Zero(t, buf.Len())
+			continue
+		}
+		plain, err := vec.MarshalBinary()
+		require.NoError(t, err)
+		require.Less(t, buf.Len(), len(plain))
+
+		var got vector.Vector
+		require.NoError(t, MustVectorTo(&got, buf.Bytes()))
+		require.Equal(t, vec.Length(), got.Length())
+		require.Equal(t, *vec.GetType(), *got.GetType())
+		require.Equal(t, vec.GetSorted(), got.GetSorted())
+		require.Equal(t, vec.String(), got.String())
+		for row := 0; row < vec.Length(); row++ {
+			require.Equal(t, vec.IsNull(uint64(row)), got.IsNull(uint64(row)))
+		}
+
+		obj, err := Decode(buf.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, vec.String(), obj.(*vector.Vector).String())
+		vec.Free(mp)
+	}
+}
+
+func TestColumnEncodingDisabled(t *testing.T) {
+	mp := mpool.MustNewZero()
+	vecs, _ := newEncodingTestVectors(t, mp, 1024)
+	for _, vec := range vecs {
+		var buf bytes.Buffer
+		enc, err := encodeColumnData(&buf, vec)
+		require.NoError(t, err)
+		require.Equal(t, EncodingPlain, enc)
+		require.Zero(t, buf.Len())
+		vec.Free(mp)
+	}
+}
+
+func TestSearchDictColumn(t *testing.T) {
+	enableColumnEncoding(t)
+	mp := mpool.MustNewZero()
+	vec := vector.NewVec(types.T_varchar.ToType())
+	defer vec.Free(mp)
+	names := []string{"cn", "us", "de", "fr"}
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, vector.AppendBytes(vec, []byte(names[i%len(names)]), i%10 == 0, mp))
+	}
+	var buf bytes.Buffer
+	enc, err := encodeColumnData(&buf, vec)
+	require.NoError(t, err)
+	require.Equal(t, EncodingDict, enc)
+
+	// country IN ('us', 'fr')
+	searchFunc := func(dict *vector.Vector) []int64 {
+		require.Equal(t, len(names), dict.Length())
+		var sels []int64
+		for i := 0; i < dict.Length(); i++ {
+			if s := dict.GetStringAt(i); s == "us" || s == "fr" {
+				sels = append(sels, int64(i))
+			}
+		}
+		return sels
+	}
+	sels, ok, err := SearchDictColumn(buf.Bytes(), searchFunc)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	var expected []int64
+	for i := 0; i < vec.Length(); i++ {
+		if vec.IsNull(uint64(i)) {
+			continue
+		}
+		if s := vec.GetStringAt(i); s == "us" || s == "fr" {
+			expected = append(expected, int64(i))
+		}
+	}
+	require.Equal(t, expected, sels)
+
+	sels, ok, err = SearchDictColumn(buf.Bytes(), func(*vector.Vector) []int64 { return nil })
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, sels)
+
+	plain := &bytes.Buffer{}
+	h := IOEntryHeader{IOET_ColData, IOET_ColumnData_CurrVer}
+	plain.Write(EncodeIOEntryHeader(&h))
+	require.NoError(t, vec.MarshalBinaryWithBuffer(plain))
+	_, ok, err = SearchDictColumn(plain.Bytes(), searchFunc)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestWriteEncodedColumns(t *testing.T) {
+	enableColumnEncoding(t)
+	ctx := context.Background()
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	name := "encoded.blk"
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+		Cache:   fileservice.DisabledCacheConfig,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	require.NoError(t, err)
+	defer service.Close(ctx)
+
+	mp := mpool.MustNewZero()
+	vecs, expects := newEncodingTestVectors(t, mp, 4096)
+	bat := batch.NewWithSize(len(vecs))
+	bat.Attrs = make([]string, len(vecs))
+	typs := make([]types.Type, len(vecs))
+	idxs := make([]uint16, len(vecs))
+	for i, vec := range vecs {
+		bat.Vecs[i] = vec
+		bat.Attrs[i] = fmt.Sprintf("c%d", i)
+		typs[i] = *vec.GetType()
+		idxs[i] = uint16(i)
+	}
+	bat.SetRowCount(vecs[0].Length())
+	defer bat.Clean(mp)
+
+	writer, err := NewObjectWriterSpecial(WriterNormal, name, service)
+	require.NoError(t, err)
+	_, err = writer.Write(bat)
+	require.NoError(t, err)
+	blocks, err := writer.WriteEnd(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blocks))
+	for i := range vecs {
+		require.Equal(t, expects[i], blocks[0].MustGetColumn(uint16(i)).Encoding())
+	}
+
+	reader, err := NewObjectReaderWithStr(name, service)
+	require.NoError(t, err)
+	ext := blocks[0].BlockHeader().MetaLocation()
+	reader.CacheMetaExtent(&ext)
+	meta, err := reader.ReadMeta(ctx, mp)
+	require.NoError(t, err)
+	dataMeta := meta.MustDataMeta()
+	for i := range vecs {
+		require.Equal(t, expects[i], dataMeta.GetColumnMeta(0, uint16(i)).Encoding())
+	}
+
+	ioVec, err := reader.ReadOneBlock(ctx, idxs, typs, 0, mp)
+	require.NoError(t, err)
+	defer ioVec.Release()
+	for i := range idxs {
+		var vec vector.Vector
+		require.NoError(t, MustVectorTo(&vec, ioVec.Entries[i].CachedData.Bytes()))
+		require.Equal(t, bat.Vecs[i].String(), vec.String())
+	}
+}
+
+func TestSearchRLEColumn(t *testing.T) {
+	enableColumnEncoding(t)
+	mp := mpool.MustNewZero()
+	vec := vector.NewVec(types.T_int32.ToType())
+	defer vec.Free(mp)
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, vector.AppendFixed(vec, int32(i/100-5), i%150 == 0, mp))
+	}
+	var buf bytes.Buffer
+	enc, err := encodeColumnData(&buf, vec)
+	require.NoError(t, err)
+	require.Equal(t, EncodingRLE, enc)
+
+	// v IN (-4, 2)
+	searchFunc := func(runs *vector.Vector) []int64 {
+		var sels []int64
+		for i, v := range vector.MustFixedColNoTypeCheck[int32](runs) {
+			if v == -4 || v == 2 {
+				sels = append(sels, int64(i))
+			}
+		}
+		return sels
+	}
+	sels, ok, err := SearchRLEColumn(buf.Bytes(), searchFunc)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	var expected []int64
+	values := vector.MustFixedColNoTypeCheck[int32](vec)
+	for i, v := range values {
+		if !vec.IsNull(uint64(i)) && (v == -4 || v == 2) {
+			expected = append(expected, int64(i))
+		}
+	}
+	require.Equal(t, expected, sels)
+
+	sels, ok, err = SearchEncodedColumn(buf.Bytes(), searchFunc)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, expected, sels)
+
+	_, ok, err = SearchDictColumn(buf.Bytes(), searchFunc)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -0,0 +1,275 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/encryption"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/fileservice/fifocache"
+	"github.com/matrixorigin/matrixone/pkg/fileservice/fscache"
+)
+
+// Every extent of an encrypted object is sealed by AES-GCM after compression
+// with a data key, which is shared by the objects written in an epoch. The
+// data key wrapped by the master key of the key provider is stored in the key
+// block, which follows the object header:
+//
+// Header | KeyBlock (encryption.Envelope) | Data ...
+//
+// The header records the encryption algorithm and the length of the key block.
+
+const (
+	EncryptionNone uint8 = iota
+	EncryptionAES256GCM
+)
+
+const dataKeyCacheCapacity = 16 * 1024 * 1024
+
+// dataKeyCache caches the unwrapped data keys of the objects by name, so the
+// header is read only once for an encrypted object.
+var dataKeyCache = fifocache.New[string, []byte](
+	fscache.ConstCapacity(dataKeyCacheCapacity),
+	xxhash.Sum64String,
+	nil, nil, nil,
+)
+
+// objectEncryption is the data key of the object being written
+type objectEncryption struct {
+	key      []byte
+	envelope []byte
+}
+
+const (
+	// objectDataKeyEpoch is how long a data key is shared by the objects
+	// written by the process
+	objectDataKeyEpoch = time.Hour
+	// newDataKeyTimeout bounds the remote call to wrap a data key
+	newDataKeyTimeout = time.Second * 30
+)
+
+// sharedObjectDataKey is the data key shared by the objects written by the process
+// in an epoch. A new one is generated when the epoch ends or the master key
+// is rotated, so the key provider is not called for every object writer.
+var sharedObjectDataKey struct {
+	sync.Mutex
+	provider encryption.KeyProvider
+	keyID    string
+	expireAt time.Time
+	current  *objectEncryption
+}
+
+func newObjectEncryption() (*objectEncryption, error) {
+	if !encryption.Enabled() {
+		return nil, nil
+	}
+	provider := encryption.GetKeyProvider()
+	keyID := provider.CurrentKeyID()
+	now := time.Now()
+
+	sharedObjectDataKey.Lock()
+	defer sharedObjectDataKey.Unlock()
+	if sharedObjectDataKey.current != nil &&
+		sharedObjectDataKey.provider == provider &&
+		sharedObjectDataKey.keyID == keyID &&
+		now.Before(sharedObjectDataKey.expireAt) {
+		return sharedObjectDataKey.current, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), newDataKeyTimeout)
+	defer cancel()
+	key, envelope, err := encryption.NewDataKey(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+	sharedObjectDataKey.provider = provider
+	sharedObjectDataKey.keyID = envelope.KeyID
+	sharedObjectDataKey.expireAt = now.Add(objectDataKeyEpoch)
+	sharedObjectDataKey.current = &objectEncryption{
+		key:      key,
+		envelope: envelope.Marshal(),
+	}
+	return sharedObjectDataKey.current, nil
+}
+
+// seal encrypts the data of the extent and sets the encrypted flag of it
+func (e *objectEncryption) seal(data []byte, extent Extent) ([]byte, Extent, error) {
+	if e == nil {
+		return data, extent, nil
+	}
+	sealed, err := encryption.Seal(e.key, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	extent.SetAlg(extent.Alg() | ExtentEncrypted)
+	extent.SetLength(uint32(len(sealed)))
+	return sealed, extent, nil
+}
+
+// objectDataKey wraps the cache constructors of the extents of an object to
+// decrypt the encrypted ones. The data key is loaded before reading if any of
+// the extents to read is encrypted.
+type objectDataKey struct {
+	name   string
+	fs     fileservice.FileService
+	needed bool
+	key    []byte
+}
+
+func newObjectDataKey(name string, fs fileservice.FileService) *objectDataKey {
+	return &objectDataKey{
+		name: name,
+		fs:   fs,
+	}
+}
+
+func (k *objectDataKey) wrap(factory CacheConstructorFactory) CacheConstructorFactory {
+	return func(size int64, algo uint8) CacheConstructor {
+		if algo&ExtentEncrypted == 0 {
+			return factory(size, algo)
+		}
+		k.needed = true
+		constructor := factory(size, algo&^ExtentEncrypted)
+		return func(ctx context.Context, reader io.Reader, data []byte, allocator fileservice.CacheDataAllocator) (fscache.Data, error) {
+			if len(data) == 0 {
+				var err error
+				if data, err = io.ReadAll(reader); err != nil {
+					return nil, err
+				}
+			}
+			plain, err := encryption.Open(k.key, data)
+			if err != nil {
+				return nil, moerr.NewInternalErrorNoCtxf("objectio %s: %v", k.name, err)
+			}
+			return constructor(ctx, bytes.NewReader(plain), plain, allocator)
+		}
+	}
+}
+
+func (k *objectDataKey) load(ctx context.Context) (err error) {
+	if !k.needed || k.key != nil {
+		return
+	}
+	k.key, err = GetObjectDataKey(ctx, k.name, k.fs)
+	return
+}
+
+// GetObjectDataKey returns the data key of the encrypted object.
+func GetObjectDataKey(
+	ctx context.Context,
+	name string,
+	fs fileservice.FileService,
+) ([]byte, error) {
+	if key, ok := dataKeyCache.Get(ctx, name); ok {
+		return key, nil
+	}
+	envelope, encrypted, err := ReadObjectEnvelope(ctx, name, fs)
+	if err != nil {
+		return nil, err
+	}
+	if !encrypted {
+		return nil, moerr.NewInternalErrorNoCtxf("objectio %s: object is not encrypted", name)
+	}
+	key, err := encryption.UnwrapDataKey(ctx, encryption.GetKeyProvider(), envelope)
+	if err != nil {
+		return nil, err
+	}
+	dataKeyCache.Set(ctx, name, key, int64(len(name)+len(key)))
+	return key, nil
+}
+
+// ReadObjectEnvelope returns the wrapped data key of the object, encrypted
+// is false if the object is not encrypted.
+func ReadObjectEnvelope(
+	ctx context.Context,
+	name string,
+	fs fileservice.FileService,
+) (envelope encryption.Envelope, encrypted bool, err error) {
+	ext := NewExtent(0, 0, HeaderSize, HeaderSize)
+	v, err := ReadExtent(ctx, name, &ext, fileservice.SkipMemoryCache, fs, constructorFactory)
+	if err != nil {
+		return
+	}
+	h := Header(v)
+	if len(h) < HeaderSize || h.Magic() != uint64(Magic) {
+		err = moerr.NewInternalErrorNoCtxf("objectio %s: bad header magic %x", name, h.Magic())
+		return
+	}
+	alg, keyBlockLen := h.Encryption()
+	if alg == EncryptionNone {
+		return
+	}
+	if alg != EncryptionAES256GCM {
+		err = moerr.NewInternalErrorNoCtxf("objectio %s: unknown encryption algorithm %d", name, alg)
+		return
+	}
+	ext = NewExtent(0, HeaderSize, uint32(keyBlockLen), uint32(keyBlockLen))
+	if v, err = ReadExtent(ctx, name, &ext, fileservice.SkipMemoryCache, fs, constructorFactory); err != nil {
+		return
+	}
+	if _, err = envelope.Unmarshal(v); err != nil {
+		return
+	}
+	encrypted = true
+	return
+}
+
+// DecryptExtentContent returns the data of the extent in the content of the
+// whole object and the compression algorithm of it. The data is decrypted if
+// the extent is encrypted.
+func DecryptExtentContent(
+	ctx context.Context,
+	objectContent []byte,
+	ext Extent,
+) (data []byte, alg uint8, err error) {
+	if int(ext.End()) > len(objectContent) {
+		err = moerr.NewInternalErrorf(ctx, "object content too small for extent %s", ext.String())
+		return
+	}
+	data = objectContent[ext.Offset():ext.End()]
+	if !ext.Encrypted() {
+		return data, ext.Alg(), nil
+	}
+	h := Header(objectContent)
+	if len(h) < HeaderSize || h.Magic() != uint64(Magic) {
+		err = moerr.NewInternalErrorf(ctx, "bad object header magic %x", h.Magic())
+		return
+	}
+	encAlg, keyBlockLen := h.Encryption()
+	if encAlg != EncryptionAES256GCM || HeaderSize+int(keyBlockLen) > len(objectContent) {
+		err = moerr.NewInternalErrorf(ctx, "invalid object encryption header")
+		return
+	}
+	var envelope encryption.Envelope
+	if _, err = envelope.Unmarshal(objectContent[HeaderSize : HeaderSize+int(keyBlockLen)]); err != nil {
+		return
+	}
+	key, err := encryption.UnwrapDataKey(ctx, encryption.GetKeyProvider(), envelope)
+	if err != nil {
+		return
+	}
+	if data, err = encryption.Open(key, data); err != nil {
+		return
+	}
+	return data, ext.CompressAlg(), nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/encryption"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectEncryption(t *testing.T) {
+	ctx := context.Background()
+
+	kms := encryption.NewMockKMS()
+	require.NoError(t, kms.CreateKey("k1"))
+	provider := encryption.NewKMSProvider(kms, "k1")
+	encryption.SetKeyProvider(provider, true)
+	defer encryption.SetKeyProvider(nil, false)
+
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	mp := mpool.MustNewZero()
+	bat := newBatch(mp)
+	defer bat.Clean(mp)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+		Cache:   fileservice.DisabledCacheConfig,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	require.NoError(t, err)
+	defer service.Close(ctx)
+
+	writeObject := func(name string) BlockObject {
+		writer, err := NewObjectWriterSpecial(WriterNormal, name, service)
+		require.NoError(t, err)
+		writer.SetColumnCompress(0, compress.None, compress.DefaultLevel)
+		_, err = writer.Write(bat)
+		require.NoError(t, err)
+		blocks, err := writer.WriteEnd(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(blocks))
+		return blocks[0]
+	}
+
+	idxs := []uint16{0, 1, 2, 3}
+	typs := []types.Type{
+		types.T_int8.ToType(), types.T_int16.ToType(), types.T_int32.ToType(), types.T_int64.ToType(),
+	}
+	checkRead := func(name string, block BlockObject) {
+		reader, err := NewObjectReaderWithStr(name, service)
+		require.NoError(t, err)
+		ext := block.BlockHeader().MetaLocation()
+		reader.CacheMetaExtent(&ext)
+		ioVec, err := reader.ReadOneBlock(ctx, idxs, typs, 0, mp)
+		require.NoError(t, err)
+		defer ioVec.Release()
+		for i, idx := range idxs {
+			obj, err := Decode(ioVec.Entries[i].CachedData.Bytes())
+			require.NoError(t, err)
+			vec := obj.(*vector.Vector)
+			require.Equal(t, bat.Vecs[idx].String(), vec.String())
+		}
+	}
+
+	name1 := "encrypted_1.blk"
+	block := writeObject(name1)
+	for _, idx := range idxs {
+		ext := block.MustGetColumn(idx).Location()
+		require.True(t, ext.Encrypted())
+	}
+	ext := block.MustGetColumn(0).Location()
+	require.Equal(t, uint8(compress.None), ext.CompressAlg())
+	require.Equal(t, ext.OriginSize()+encryption.Overhead, ext.Length())
+	require.True(t, block.BlockHeader().MetaLocation().Encrypted())
+
+	envelope, encrypted, err := ReadObjectEnvelope(ctx, name1, service)
+	require.NoError(t, err)
+	require.True(t, encrypted)
+	require.Equal(t, "k1", envelope.KeyID)
+
+	// the objects written in an epoch share the data key
+	name1Shared := "encrypted_1_shared.blk"
+	writeObject(name1Shared)
+	sharedEnvelope, _, err := ReadObjectEnvelope(ctx, name1Shared, service)
+	require.NoError(t, err)
+	require.Equal(t, envelope, sharedEnvelope)
+
+	// the data key is cached by the writer
+	checkRead(name1, block)
+	// read the data key from the object
+	dataKeyCache.Delete(ctx, name1)
+	checkRead(name1, block)
+
+	// the column data in the file is not readable without the data key
+	content, err := os.ReadFile(path.Join(dir, name1))
+	require.NoError(t, err)
+	raw := content[ext.Offset():ext.End()]
+	data, alg, err := DecryptExtentContent(ctx, content, ext)
+	require.NoError(t, err)
+	require.Equal(t, uint8(compress.None), alg)
+	require.Equal(t, int(ext.OriginSize()), len(data))
+	require.NotContains(t, string(raw), string(data))
+	obj, err := Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, bat.Vecs[0].String(), obj.(*vector.Vector).String())
+
+	// rotate the master key, the old objects are still readable
+	require.NoError(t, kms.CreateKey("k2"))
+	provider.SetCurrentKeyID("k2")
+	name2 := "encrypted_2.blk"
+	block2 := writeObject(name2)
+	envelope, encrypted, err = ReadObjectEnvelope(ctx, name2, service)
+	require.NoError(t, err)
+	require.True(t, encrypted)
+	require.Equal(t, "k2", envelope.KeyID)
+	checkRead(name2, block2)
+	dataKeyCache.Delete(ctx, name1)
+	checkRead(name1, block)
+
+	// the objects written without encryption are readable
+	encryption.SetKeyProvider(provider, false)
+	name3 := "plain.blk"
+	block3 := writeObject(name3)
+	require.False(t, block3.MustGetColumn(0).Location().Encrypted())
+	_, encrypted, err = ReadObjectEnvelope(ctx, name3, service)
+	require.NoError(t, err)
+	require.False(t, encrypted)
+	checkRead(name3, block3)
+	checkRead(name2, block2)
+}
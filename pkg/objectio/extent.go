@@ -24,7 +24,7 @@ import (
 // Alg | Offset | Length | OriginSize
 // ----|--------|--------|------------
 // 1   | 4      | 4      | 4
-// Alg: Specifies the compression algorithm
+// Alg: Specifies the compression algorithm, the ExtentEncrypted bit is set if the data is encrypted
 // Offset: The offset of the compressed data in the file
 // Length: The length of the compressed data
 // OriginSize: The length of the original data
@@ -41,6 +41,10 @@ const (
 	ExtentSize      = extentOriginOff + extentOriginLen
 )
 
+// ExtentEncrypted is set in the Alg of the extents sealed with the data key
+// of the object, the low bits are still the compression algorithm.
+const ExtentEncrypted uint8 = 0x80
+
 func NewExtent(alg uint8, offset, length, originSize uint32) Extent {
 	var extent [ExtentSize]byte
 	copy(extent[:extentAlgLen], types.EncodeUint8(&alg))
@@ -62,6 +66,16 @@ func (ex Extent) Alg() uint8 {
 	return types.DecodeUint8(ex[:extentAlgLen])
 }
 
+// Encrypted reports whether the data of the extent is encrypted
+func (ex Extent) Encrypted() bool {
+	return ex.Alg()&ExtentEncrypted != 0
+}
+
+// CompressAlg returns the compression algorithm of the extent
+func (ex Extent) CompressAlg() uint8 {
+	return ex.Alg() &^ ExtentEncrypted
+}
+
 func (ex Extent) SetAlg(alg uint8) {
 	copy(ex[:extentAlgLen], types.EncodeUint8(&alg))
 }
@@ -50,11 +50,16 @@ func ReadExtent(
 		Policy:   policy,
 	}
 
+	dataKey := newObjectDataKey(name, fs)
+	factory = dataKey.wrap(factory)
 	ioVec.Entries[0] = fileservice.IOEntry{
 		Offset:      int64(extent.Offset()),
 		Size:        int64(extent.Length()),
 		ToCacheData: factory(int64(extent.OriginSize()), extent.Alg()),
 	}
+	if err = dataKey.load(ctx); err != nil {
+		return
+	}
 	if err = fs.Read(ctx, ioVec); err != nil {
 		ioVec.ReleaseReadResultOnError()
 		return
@@ -166,6 +171,8 @@ func ReadOneBlockWithMeta(
 		}
 	}
 
+	dataKey := newObjectDataKey(name, fs)
+	factory = dataKey.wrap(factory)
 	blkmeta := meta.GetBlockMeta(uint32(blk))
 	maxSeqnum := blkmeta.GetMaxSeqnum()
 	for i, seqnum := range seqnums {
@@ -213,6 +220,9 @@ func ReadOneBlockWithMeta(
 		})
 	}
 	if len(ioVec.Entries) > 0 {
+		if err = dataKey.load(ctx); err != nil {
+			return
+		}
 		err = fs.Read(ctx, &ioVec)
 		if err != nil {
 			return
@@ -280,6 +290,8 @@ func ReadAllBlocksWithMeta(
 		Entries:  make([]fileservice.IOEntry, 0, len(cols)*int(meta.BlockCount())),
 		Policy:   policy,
 	}
+	dataKey := newObjectDataKey(name, fs)
+	factory = dataKey.wrap(factory)
 	for blk := uint32(0); blk < meta.BlockCount(); blk++ {
 		for _, seqnum := range cols {
 			blkmeta := meta.GetBlockMeta(blk)
@@ -299,6 +311,10 @@ func ReadAllBlocksWithMeta(
 		}
 	}
 
+	if err = dataKey.load(ctx); err != nil {
+		ioVec = fileservice.IOVector{}
+		return
+	}
 	err = fs.Read(ctx, &ioVec)
 	if err != nil {
 		ioVec.ReleaseReadResultOnError()
@@ -323,6 +339,8 @@ func ReadOneBlockAllColumns(
 		Entries:  make([]fileservice.IOEntry, 0),
 		Policy:   cachePolicy,
 	}
+	dataKey := newObjectDataKey(name, fs)
+	factory := dataKey.wrap(constructorFactory)
 	for _, seqnum := range cols {
 		blkmeta := meta.GetBlockMeta(id)
 		col := blkmeta.ColumnMeta(seqnum)
@@ -331,10 +349,13 @@ func ReadOneBlockAllColumns(
 			Offset: int64(ext.Offset()),
 			Size:   int64(ext.Length()),
 
-			ToCacheData: constructorFactory(int64(ext.OriginSize()), ext.Alg()),
+			ToCacheData: factory(int64(ext.OriginSize()), ext.Alg()),
 		})
 	}
 
+	if err = dataKey.load(ctx); err != nil {
+		return nil, err
+	}
 	err = fs.Read(ctx, ioVec)
 	if err != nil {
 		ioVec.ReleaseReadResultOnError()
@@ -184,6 +184,48 @@ func LoadColumns(
 	return
 }
 
+// SearchEncodedColumn evaluates searchFunc against the distinct values of
+// the column if it is dictionary or run-length encoded in the block. ok is
+// false if the column uses another encoding and the caller has to load and
+// decode it instead.
+func SearchEncodedColumn(
+	ctx context.Context,
+	col uint16,
+	typ types.Type,
+	fs fileservice.FileService,
+	location objectio.Location,
+	m *mpool.MPool,
+	policy fileservice.Policy,
+	searchFunc objectio.ReadFilterDictSearchFuncType,
+) (sels []int64, ok bool, err error) {
+	var meta objectio.ObjectMeta
+	if meta, err = objectio.FastLoadObjectMeta(ctx, &location, false, fs); err != nil {
+		return
+	}
+	dataMeta := meta.MustGetMeta(objectio.SchemaData)
+	switch dataMeta.GetColumnMeta(uint32(location.ID()), col).Encoding() {
+	case objectio.EncodingDict, objectio.EncodingRLE:
+	default:
+		return
+	}
+	var vectors fileservice.IOVector
+	if vectors, err = objectio.ReadOneBlock(
+		ctx,
+		&dataMeta,
+		location.Name().UnsafeString(),
+		location.ID(),
+		[]uint16{col},
+		[]types.Type{typ},
+		m,
+		fs,
+		policy,
+	); err != nil {
+		return
+	}
+	defer objectio.ReleaseIOVector(&vectors)
+	return objectio.SearchEncodedColumn(vectors.Entries[0].CachedData.Bytes(), searchFunc)
+}
+
 // LoadColumns2 load columns data from file service for TN
 // need to copy data from vPool to avoid releasing cache
 func LoadColumns2(
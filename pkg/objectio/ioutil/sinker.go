@@ -30,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/objectio/mergeutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 )
 
@@ -112,6 +113,7 @@ type FSinkerImpl struct {
 	seqnums         []uint16
 	schemaVersion   uint32
 	hiddenSelection objectio.HiddenColumnSelection
+	columnCompress  []api.ColumnCompress
 }
 
 func (s *FSinkerImpl) Sink(ctx context.Context, b *batch.Batch) error {
@@ -136,6 +138,7 @@ func (s *FSinkerImpl) Sink(ctx context.Context, b *batch.Batch) error {
 				s.fs,
 				s.arena,
 			)
+			s.writer.SetColumnCompresses(s.columnCompress)
 		}
 	}
 
@@ -221,6 +224,30 @@ func NewFSinkerImplFactory(
 	}
 }
 
+// NewFSinkerImplFactoryWithCompress is NewFSinkerImplFactory for the tables
+// whose columns are not all encoded with the default lz4 codec
+func NewFSinkerImplFactoryWithCompress(
+	seqnums []uint16,
+	sortKeyPos int,
+	isPrimaryKey bool,
+	schemaVersion uint32,
+	codecs []api.ColumnCompress,
+) FileSinkerFactory {
+	return func(mp *mpool.MPool, fs fileservice.FileService) FileSinker {
+		s := NewFSinkerImpl(
+			seqnums,
+			sortKeyPos,
+			isPrimaryKey,
+			false,
+			schemaVersion,
+			mp,
+			fs,
+		)
+		s.columnCompress = codecs
+		return s
+	}
+}
+
 func NewTombstoneFSinkerImpl(
 	hidden objectio.HiddenColumnSelection,
 	mp *mpool.MPool,
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
@@ -239,6 +240,19 @@ func (w *BlockWriter) SetFakePK(idx uint16) {
 	w.fakePK = idx
 }
 
+// SetColumnCompress sets the codec of the column identified by seqnum
+func (w *BlockWriter) SetColumnCompress(seqnum uint16, alg uint8, level int) {
+	w.writer.SetColumnCompress(seqnum, alg, level)
+}
+
+// SetColumnCompresses applies the per-column codecs recorded in the schema
+// extra of a table. Columns not listed keep the default lz4 codec.
+func (w *BlockWriter) SetColumnCompresses(codecs []api.ColumnCompress) {
+	for _, c := range codecs {
+		w.writer.SetColumnCompress(uint16(c.Seqnum), uint8(c.Alg), int(c.Level))
+	}
+}
+
 func (w *BlockWriter) SetAppendable() {
 	w.writer.SetAppendable()
 }
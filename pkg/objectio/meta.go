@@ -358,6 +358,24 @@ func (h Header) SchemaVersion(ver uint32) {
 	types.DecodeUint32(h[8+2+ExtentSize : 8+2+ExtentSize+4])
 }
 
+const (
+	headerEncryptionOff    = 8 + 2 + ExtentSize + 4
+	headerKeyBlockLenOff   = headerEncryptionOff + 1
+	headerKeyBlockLenLen   = 2
+	headerEncryptionEndOff = headerKeyBlockLenOff + headerKeyBlockLenLen
+)
+
+// SetEncryption records the encryption algorithm of the object and the
+// length of the key block following the header.
+func (h Header) SetEncryption(alg uint8, keyBlockLen uint16) {
+	h[headerEncryptionOff] = alg
+	copy(h[headerKeyBlockLenOff:headerEncryptionEndOff], types.EncodeUint16(&keyBlockLen))
+}
+
+func (h Header) Encryption() (alg uint8, keyBlockLen uint16) {
+	return h[headerEncryptionOff], types.DecodeUint16(h[headerKeyBlockLenOff:headerEncryptionEndOff])
+}
+
 // Magic returns the object magic stored in the first 8 bytes of the header
 // (written by BuildHeader). A value other than Magic means the bytes are not a
 // valid raw objectio header — e.g. a legacy CRC-framed (DISK) file read through
@@ -289,6 +289,8 @@ func (r *objectReaderV1) ReadMultiSubBlocks(
 		FilePath: r.name,
 		Entries:  make([]fileservice.IOEntry, 0),
 	}
+	dataKey := newObjectDataKey(r.name, r.fs)
+	factory := dataKey.wrap(constructorFactory)
 	for _, opt := range opts {
 		meta, _ := metaHeader.SubMeta(opt.DataType)
 		for seqnum := range opt.Idxes {
@@ -302,11 +304,14 @@ func (r *objectReaderV1) ReadMultiSubBlocks(
 				Offset: int64(col.Location().Offset()),
 				Size:   int64(col.Location().Length()),
 
-				ToCacheData: constructorFactory(int64(col.Location().OriginSize()), col.Location().Alg()),
+				ToCacheData: factory(int64(col.Location().OriginSize()), col.Location().Alg()),
 			})
 		}
 	}
 
+	if err = dataKey.load(ctx); err != nil {
+		return
+	}
 	err = r.fs.Read(ctx, ioVec)
 	return
 }
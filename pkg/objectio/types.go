@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vectorindex/metric"
@@ -45,12 +46,27 @@ type ColumnMetaFetcher interface {
 
 type ReadFilterSearchFuncType func(containers.Vectors) []int64
 
+// ReadFilterDictSearchFuncType is evaluated against the distinct values of a
+// dictionary encoded column and returns the offsets of the matching values.
+type ReadFilterDictSearchFuncType func(*vector.Vector) []int64
+
 type BlockReadFilter struct {
 	HasFakePK          bool
 	Valid              bool
 	SortedSearchFunc   ReadFilterSearchFuncType
 	UnSortedSearchFunc ReadFilterSearchFuncType
-	Cleanup            func() // Cleanup function to release resources (e.g., reusableTempVec)
+	// DictSearchFunc, if set, is used instead of the search funcs on blocks
+	// where the filter column is dictionary or run-length encoded, see
+	// SearchEncodedColumn
+	DictSearchFunc ReadFilterDictSearchFuncType
+	// ColumnSearchFunc, if set, narrows the rows to read on blocks where the
+	// non primary key column ColumnSeqnum is dictionary or run-length
+	// encoded. It is a pre-filter only, the rows it keeps are still checked
+	// by the filter expression.
+	ColumnSearchFunc ReadFilterDictSearchFuncType
+	ColumnSeqnum     uint16
+	ColumnType       types.Type
+	Cleanup          func() // Cleanup function to release resources (e.g., reusableTempVec)
 }
 
 func (f BlockReadFilter) DecideSearchFunc(isSortedBlk bool) ReadFilterSearchFuncType {
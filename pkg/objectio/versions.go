@@ -39,6 +39,7 @@ const (
 	IOET_ObjectMeta_V3  = 3
 	IOET_ColumnData_V1  = 1
 	IOET_ColumnData_V2  = 2
+	IOET_ColumnData_V3  = 3
 	IOET_BloomFilter_V1 = 1
 	IOET_BloomFilter_V2 = 2
 	IOET_ZoneMap_V1     = 1
@@ -57,6 +58,9 @@ func init() {
 	// Break by MustVector. Need to update MustVector to support new version.
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ColData, IOET_ColumnData_V1}, EncodeColumnDataV1, DecodeColumnDataV1)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ColData, IOET_ColumnData_V2}, EncodeColumnDataV1, DecodeColumnDataV2)
+	// V3 is written by the object writer for columns with a lightweight
+	// encoding, see encoding.go
+	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ColData, IOET_ColumnData_V3}, nil, DecodeColumnDataV3)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_BF, IOET_BloomFilter_V1}, nil, nil)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_BF, IOET_BloomFilter_V2}, nil, nil)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ZM, IOET_ZoneMap_V1}, nil, nil)
@@ -164,6 +164,8 @@ type objectWriterV1 struct {
 	lastId            uint32
 	name              ObjectName
 	compressBuf       []byte
+	colCompress       map[uint16]columnCompress
+	encryption        *objectEncryption
 	buf               bytes.Buffer
 	bloomFilter       []byte
 	objStats          ObjectStats
@@ -173,6 +175,12 @@ type objectWriterV1 struct {
 	size              uint32
 }
 
+// columnCompress is the codec used to encode a column, see SetColumnCompress
+type columnCompress struct {
+	alg   uint8
+	level int
+}
+
 type blockData struct {
 	meta        BlockObject
 	seqnums     *Seqnums
@@ -219,6 +227,10 @@ func newObjectWriterSpecialV1(wt WriterType, fileName string, fs fileservice.Fil
 	case WriterDumpTable:
 		name = BuildDumpTableName()
 	}
+	encryption, err := newObjectEncryption()
+	if err != nil {
+		return nil, err
+	}
 	writer := &objectWriterV1{
 		seqnums:       NewSeqnums(nil),
 		fileName:      fileName,
@@ -228,6 +240,7 @@ func newObjectWriterSpecialV1(wt WriterType, fileName string, fs fileservice.Fil
 		blocks:        make([][]blockData, 2),
 		lastId:        0,
 		sortKeySeqnum: math.MaxUint16,
+		encryption:    encryption,
 	}
 	writer.blocks[SchemaData] = make([]blockData, 0)
 	writer.blocks[SchemaTombstone] = make([]blockData, 0)
@@ -237,6 +250,10 @@ func newObjectWriterSpecialV1(wt WriterType, fileName string, fs fileservice.Fil
 func newObjectWriterV1(name ObjectName, fs fileservice.FileService, schemaVersion uint32, seqnums []uint16, arena *WriteArena) (*objectWriterV1, error) {
 	fileName := name.String()
 	object := NewObject(fileName, fs)
+	encryption, err := newObjectEncryption()
+	if err != nil {
+		return nil, err
+	}
 	writer := &objectWriterV1{
 		arena:         arena,
 		schemaVer:     schemaVersion,
@@ -248,6 +265,7 @@ func newObjectWriterV1(name ObjectName, fs fileservice.FileService, schemaVersio
 		blocks:        make([][]blockData, 2),
 		lastId:        0,
 		sortKeySeqnum: math.MaxUint16,
+		encryption:    encryption,
 	}
 	writer.blocks[SchemaData] = make([]blockData, 0)
 	writer.blocks[SchemaTombstone] = make([]blockData, 0)
@@ -508,7 +526,7 @@ func (w *objectWriterV1) prepareBloomFilter(blocks []blockData, blockCount uint3
 	copy(data[off:], w.bloomFilter)
 	length := uint32(total)
 	extent := NewExtent(compress.None, offset, length, length)
-	return data, extent, nil
+	return w.encryption.seal(data, extent)
 }
 
 func (w *objectWriterV1) prepareZoneMapArea(blocks []blockData, blockCount uint32, offset uint32) ([]byte, Extent, error) {
@@ -578,6 +596,12 @@ func (w *objectWriterV1) WriteEnd(ctx context.Context, items ...WriteOptions) ([
 	objectHeader.SetSchemaVersion(w.schemaVer)
 	offset := uint32(HeaderSize)
 	w.originSize += HeaderSize
+	if w.encryption != nil {
+		keyBlockLen := uint32(len(w.encryption.envelope))
+		objectHeader.SetEncryption(EncryptionAES256GCM, uint16(keyBlockLen))
+		offset += keyBlockLen
+		w.originSize += keyBlockLen
+	}
 
 	for i := range w.blocks {
 		if i == int(SchemaData) {
@@ -663,6 +687,9 @@ func (w *objectWriterV1) WriteEnd(ctx context.Context, items ...WriteOptions) ([
 
 	// writer object header
 	w.buffer.Write(objectHeader)
+	if w.encryption != nil {
+		w.buffer.Write(w.encryption.envelope)
+	}
 
 	// writer data
 	for i := range w.blocks {
@@ -736,6 +763,11 @@ func (w *objectWriterV1) Sync(ctx context.Context, items ...WriteOptions) error
 	if err != nil {
 		return err
 	}
+	if w.encryption != nil {
+		// the object may be rewritten with a new data key
+		dataKeyCache.Delete(ctx, w.fileName)
+		dataKeyCache.Set(ctx, w.fileName, w.encryption.key, int64(len(w.fileName)+len(w.encryption.key)))
+	}
 
 	w.objStats, err = w.DescribeObject()
 	return err
@@ -745,30 +777,62 @@ func (w *objectWriterV1) GetDataStats() ObjectStats {
 	return w.objStats
 }
 
+// SetColumnCompress sets the codec of the column identified by seqnum.
+// Columns without a codec are compressed with lz4.
+func (w *objectWriterV1) SetColumnCompress(seqnum uint16, alg uint8, level int) {
+	if w.colCompress == nil {
+		w.colCompress = make(map[uint16]columnCompress)
+	}
+	w.colCompress[seqnum] = columnCompress{alg: alg, level: level}
+}
+
+func (w *objectWriterV1) getColumnCompress(seqnum uint16) (uint8, int) {
+	if c, ok := w.colCompress[seqnum]; ok {
+		return c.alg, c.level
+	}
+	return compress.Lz4, compress.DefaultLevel
+}
+
 func (w *objectWriterV1) WriteWithCompress(offset uint32, buf []byte) (data []byte, extent Extent, err error) {
+	return w.writeWithCompress(offset, buf, compress.Lz4, compress.DefaultLevel)
+}
+
+func (w *objectWriterV1) writeWithCompress(offset uint32, buf []byte, alg uint8, level int) (data []byte, extent Extent, err error) {
 	dataLen := len(buf)
-	compressBlockBound := lz4.CompressBlockBound(dataLen)
-	var compressBuf []byte
-	if w.arena != nil {
-		compressBuf = w.arena.CompressBuf(compressBlockBound)
-	} else {
-		if len(w.compressBuf) < compressBlockBound {
-			w.compressBuf = make([]byte, compressBlockBound)
+	compressed := buf
+	if alg != compress.None {
+		compressBlockBound := compress.CompressBound(int(alg), dataLen)
+		var compressBuf []byte
+		if w.arena != nil {
+			compressBuf = w.arena.CompressBuf(compressBlockBound)
+		} else {
+			if len(w.compressBuf) < compressBlockBound {
+				w.compressBuf = make([]byte, compressBlockBound)
+			}
+			compressBuf = w.compressBuf[:compressBlockBound]
+		}
+		if alg == compress.Lz4 {
+			var n int
+			if n, err = w.lz4c.CompressBlock(buf, compressBuf); err != nil {
+				return
+			}
+			compressed = compressBuf[:n]
+		} else if compressed, err = compress.CompressWithLevel(buf, compressBuf, int(alg), level); err != nil {
+			return
 		}
-		compressBuf = w.compressBuf[:compressBlockBound]
 	}
-	n, err := w.lz4c.CompressBlock(buf, compressBuf)
-	if err != nil {
-		return
+	length := uint32(len(compressed))
+	if w.encryption != nil {
+		// sealing copies the data out of the compress buffer
+		return w.encryption.seal(compressed, NewExtent(alg, offset, length, uint32(dataLen)))
 	}
-	length := uint32(n)
 	if w.arena != nil {
 		data = w.arena.Alloc(int(length))
 	} else {
 		data = make([]byte, length)
 	}
-	copy(data, compressBuf[:length])
-	extent = NewExtent(compress.Lz4, offset, length, uint32(dataLen))
+	copy(data, compressed)
+	extent = NewExtent(alg, offset, length, uint32(dataLen))
 	return
 }
 
@@ -806,20 +870,29 @@ func (w *objectWriterV1) addBlock(blocks *[]blockData, blockMeta BlockObject, ba
 		if needed := vec.Size() + 64; needed > sbuf.Cap() {
 			sbuf.Grow(needed)
 		}
-		h := IOEntryHeader{IOET_ColData, IOET_ColumnData_CurrVer}
-		sbuf.Write(EncodeIOEntryHeader(&h))
-		if err := vec.MarshalBinaryWithBuffer(sbuf); err != nil {
+		// Try a lightweight encoding first, fall back to the plain layout
+		// if none of them is smaller.
+		enc, err := encodeColumnData(sbuf, vec)
+		if err != nil {
 			return 0, err
 		}
+		if enc == EncodingPlain {
+			h := IOEntryHeader{IOET_ColData, IOET_ColumnData_CurrVer}
+			sbuf.Write(EncodeIOEntryHeader(&h))
+			if err = vec.MarshalBinaryWithBuffer(sbuf); err != nil {
+				return 0, err
+			}
+		}
 		var ext Extent
-		var err error
-		if data, ext, err = w.WriteWithCompress(0, sbuf.Bytes()); err != nil {
+		alg, level := w.getColumnCompress(seqnums.Seqs[i])
+		if data, ext, err = w.writeWithCompress(0, sbuf.Bytes(), alg, level); err != nil {
 			return 0, err
 		}
 		size += len(data)
 		block.data = append(block.data, data)
 		blockMeta.ColumnMeta(seqnums.Seqs[i]).setLocation(ext)
 		blockMeta.ColumnMeta(seqnums.Seqs[i]).setDataType(uint8(vec.GetType().Oid))
+		blockMeta.ColumnMeta(seqnums.Seqs[i]).setEncoding(enc)
 		if vec.GetType().Oid == types.T_any {
 			panic("any type batch")
 		}
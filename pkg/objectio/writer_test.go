@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -338,6 +339,64 @@ func TestNewObjectReader(t *testing.T) {
 	assert.Equal(t, uint32(1), meta.BlockCount())
 }
 
+func TestColumnCompress(t *testing.T) {
+	ctx := context.Background()
+
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	name := "mixed_codec.blk"
+	mp := mpool.MustNewZero()
+	bat := newBatch(mp)
+	defer bat.Clean(mp)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+		Cache:   fileservice.DisabledCacheConfig,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	require.NoError(t, err)
+	defer service.Close(ctx)
+
+	objectWriter, err := NewObjectWriterSpecial(WriterNormal, name, service)
+	require.NoError(t, err)
+	objectWriter.SetColumnCompress(0, compress.None, compress.DefaultLevel)
+	objectWriter.SetColumnCompress(2, compress.Zstd, 9)
+	objectWriter.SetColumnCompress(3, compress.Zstd, compress.DefaultLevel)
+	_, err = objectWriter.Write(bat)
+	require.NoError(t, err)
+	blocks, err := objectWriter.WriteEnd(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blocks))
+
+	require.Equal(t, uint8(compress.None), blocks[0].MustGetColumn(0).Location().Alg())
+	require.Equal(t, uint8(compress.Lz4), blocks[0].MustGetColumn(1).Location().Alg())
+	require.Equal(t, uint8(compress.Zstd), blocks[0].MustGetColumn(2).Location().Alg())
+	require.Equal(t, uint8(compress.Zstd), blocks[0].MustGetColumn(3).Location().Alg())
+
+	pool, err := mpool.NewMPool("objectio_test", 0, mpool.NoFixed)
+	require.NoError(t, err)
+	objectReader, err := NewObjectReaderWithStr(name, service)
+	require.NoError(t, err)
+	ext := blocks[0].BlockHeader().MetaLocation()
+	objectReader.CacheMetaExtent(&ext)
+	idxs := []uint16{0, 1, 2, 3}
+	typs := []types.Type{
+		types.T_int8.ToType(), types.T_int16.ToType(), types.T_int32.ToType(), types.T_int64.ToType(),
+	}
+	ioVec, err := objectReader.ReadOneBlock(ctx, idxs, typs, 0, pool)
+	require.NoError(t, err)
+	defer ioVec.Release()
+
+	for i, idx := range idxs {
+		obj, err := Decode(ioVec.Entries[i].CachedData.Bytes())
+		require.NoError(t, err)
+		vec := obj.(*vector.Vector)
+		require.Equal(t, bat.Vecs[idx].Length(), vec.Length())
+		require.Equal(t, bat.Vecs[idx].String(), vec.String())
+	}
+}
+
 func newBatch(mp *mpool.MPool) *batch.Batch {
 	types := []types.Type{
 		types.T_int8.ToType(),
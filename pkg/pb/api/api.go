@@ -76,6 +76,7 @@ func CloneExtra(info *SchemaExtra) *SchemaExtra {
 		FeatureFlag:       info.FeatureFlag,
 		IndexTables:       append([]uint64{}, info.IndexTables...),
 		ParentTableID:     info.ParentTableID,
+		ColumnCompress:    append([]ColumnCompress{}, info.ColumnCompress...),
 	}
 }
 
@@ -177,6 +178,19 @@ func NewReplaceDefReq(did, tid uint64, planDef *plan.TableDef) *AlterTableReq {
 	}
 }
 
+func NewUpdateColumnCompressReq(did, tid uint64, codecs []ColumnCompress) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_UpdateColumnCompress,
+		Operation: &AlterTableReq_UpdateColumnCompress{
+			&AlterTableColumnCompress{
+				ColumnCompress: codecs,
+			},
+		},
+	}
+}
+
 func (m *SyncLogTailReq) MarshalBinary() ([]byte, error) {
 	return m.Marshal()
 }
@@ -108,42 +108,45 @@ func (OpCode) EnumDescriptor() ([]byte, []int) {
 type AlterKind int32
 
 const (
-	AlterKind_Invalid          AlterKind = 0
-	AlterKind_AddColumn        AlterKind = 1
-	AlterKind_DropColumn       AlterKind = 2
-	AlterKind_RenameTable      AlterKind = 3
-	AlterKind_UpdateComment    AlterKind = 4
-	AlterKind_UpdateConstraint AlterKind = 5
-	AlterKind_UpdatePolicy     AlterKind = 6
-	AlterKind_AddPartition     AlterKind = 7
-	AlterKind_RenameColumn     AlterKind = 8
-	AlterKind_ReplaceDef       AlterKind = 9
+	AlterKind_Invalid              AlterKind = 0
+	AlterKind_AddColumn            AlterKind = 1
+	AlterKind_DropColumn           AlterKind = 2
+	AlterKind_RenameTable          AlterKind = 3
+	AlterKind_UpdateComment        AlterKind = 4
+	AlterKind_UpdateConstraint     AlterKind = 5
+	AlterKind_UpdatePolicy         AlterKind = 6
+	AlterKind_AddPartition         AlterKind = 7
+	AlterKind_RenameColumn         AlterKind = 8
+	AlterKind_ReplaceDef           AlterKind = 9
+	AlterKind_UpdateColumnCompress AlterKind = 10
 )
 
 var AlterKind_name = map[int32]string{
-	0: "Invalid",
-	1: "AddColumn",
-	2: "DropColumn",
-	3: "RenameTable",
-	4: "UpdateComment",
-	5: "UpdateConstraint",
-	6: "UpdatePolicy",
-	7: "AddPartition",
-	8: "RenameColumn",
-	9: "ReplaceDef",
+	0:  "Invalid",
+	1:  "AddColumn",
+	2:  "DropColumn",
+	3:  "RenameTable",
+	4:  "UpdateComment",
+	5:  "UpdateConstraint",
+	6:  "UpdatePolicy",
+	7:  "AddPartition",
+	8:  "RenameColumn",
+	9:  "ReplaceDef",
+	10: "UpdateColumnCompress",
 }
 
 var AlterKind_value = map[string]int32{
-	"Invalid":          0,
-	"AddColumn":        1,
-	"DropColumn":       2,
-	"RenameTable":      3,
-	"UpdateComment":    4,
-	"UpdateConstraint": 5,
-	"UpdatePolicy":     6,
-	"AddPartition":     7,
-	"RenameColumn":     8,
-	"ReplaceDef":       9,
+	"Invalid":              0,
+	"AddColumn":            1,
+	"DropColumn":           2,
+	"RenameTable":          3,
+	"UpdateComment":        4,
+	"UpdateConstraint":     5,
+	"UpdatePolicy":         6,
+	"AddPartition":         7,
+	"RenameColumn":         8,
+	"ReplaceDef":           9,
+	"UpdateColumnCompress": 10,
 }
 
 func (x AlterKind) String() string {
@@ -1532,6 +1535,56 @@ func (m *AlterTableReplaceDef) GetDef() *plan.TableDef {
 	return nil
 }
 
+// AlterTableColumnCompress replaces the codecs of the columns not encoded
+// with the default lz4 codec. Existing objects keep their codecs until they
+// are rewritten by merge.
+type AlterTableColumnCompress struct {
+	ColumnCompress       []ColumnCompress `protobuf:"bytes,1,rep,name=column_compress,json=columnCompress,proto3" json:"column_compress"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *AlterTableColumnCompress) Reset()         { *m = AlterTableColumnCompress{} }
+func (m *AlterTableColumnCompress) String() string { return proto.CompactTextString(m) }
+func (*AlterTableColumnCompress) ProtoMessage()    {}
+func (*AlterTableColumnCompress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+}
+func (m *AlterTableColumnCompress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableColumnCompress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableColumnCompress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableColumnCompress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableColumnCompress.Merge(m, src)
+}
+func (m *AlterTableColumnCompress) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableColumnCompress) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableColumnCompress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableColumnCompress proto.InternalMessageInfo
+
+func (m *AlterTableColumnCompress) GetColumnCompress() []ColumnCompress {
+	if m != nil {
+		return m.ColumnCompress
+	}
+	return nil
+}
+
 type AlterTableDropColumn struct {
 	LogicalIdx           uint32   `protobuf:"varint,1,opt,name=logical_idx,json=logicalIdx,proto3" json:"logical_idx,omitempty"`
 	SequenceNum          uint32   `protobuf:"varint,2,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
@@ -1544,7 +1597,7 @@ func (m *AlterTableDropColumn) Reset()         { *m = AlterTableDropColumn{} }
 func (m *AlterTableDropColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropColumn) ProtoMessage()    {}
 func (*AlterTableDropColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
 }
 func (m *AlterTableDropColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1600,6 +1653,7 @@ type AlterTableReq struct {
 	//	*AlterTableReq_UpdatePolicy
 	//	*AlterTableReq_RenameCol
 	//	*AlterTableReq_ReplaceDef
+	//	*AlterTableReq_UpdateColumnCompress
 	Operation            isAlterTableReq_Operation `protobuf_oneof:"operation"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -1610,7 +1664,7 @@ func (m *AlterTableReq) Reset()         { *m = AlterTableReq{} }
 func (m *AlterTableReq) String() string { return proto.CompactTextString(m) }
 func (*AlterTableReq) ProtoMessage()    {}
 func (*AlterTableReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
 }
 func (m *AlterTableReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1669,15 +1723,19 @@ type AlterTableReq_RenameCol struct {
 type AlterTableReq_ReplaceDef struct {
 	ReplaceDef *AlterTableReplaceDef `protobuf:"bytes,12,opt,name=replace_def,json=replaceDef,proto3,oneof" json:"replace_def,omitempty"`
 }
+type AlterTableReq_UpdateColumnCompress struct {
+	UpdateColumnCompress *AlterTableColumnCompress `protobuf:"bytes,13,opt,name=update_column_compress,json=updateColumnCompress,proto3,oneof" json:"update_column_compress,omitempty"`
+}
 
-func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()     {}
-func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()    {}
-func (*AlterTableReq_RenameTable) isAlterTableReq_Operation()   {}
-func (*AlterTableReq_UpdateComment) isAlterTableReq_Operation() {}
-func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()    {}
-func (*AlterTableReq_UpdatePolicy) isAlterTableReq_Operation()  {}
-func (*AlterTableReq_RenameCol) isAlterTableReq_Operation()     {}
-func (*AlterTableReq_ReplaceDef) isAlterTableReq_Operation()    {}
+func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()            {}
+func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()           {}
+func (*AlterTableReq_RenameTable) isAlterTableReq_Operation()          {}
+func (*AlterTableReq_UpdateComment) isAlterTableReq_Operation()        {}
+func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()           {}
+func (*AlterTableReq_UpdatePolicy) isAlterTableReq_Operation()         {}
+func (*AlterTableReq_RenameCol) isAlterTableReq_Operation()            {}
+func (*AlterTableReq_ReplaceDef) isAlterTableReq_Operation()           {}
+func (*AlterTableReq_UpdateColumnCompress) isAlterTableReq_Operation() {}
 
 func (m *AlterTableReq) GetOperation() isAlterTableReq_Operation {
 	if m != nil {
@@ -1763,6 +1821,13 @@ func (m *AlterTableReq) GetReplaceDef() *AlterTableReplaceDef {
 	return nil
 }
 
+func (m *AlterTableReq) GetUpdateColumnCompress() *AlterTableColumnCompress {
+	if x, ok := m.GetOperation().(*AlterTableReq_UpdateColumnCompress); ok {
+		return x.UpdateColumnCompress
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1774,6 +1839,7 @@ func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 		(*AlterTableReq_UpdatePolicy)(nil),
 		(*AlterTableReq_RenameCol)(nil),
 		(*AlterTableReq_ReplaceDef)(nil),
+		(*AlterTableReq_UpdateColumnCompress)(nil),
 	}
 }
 
@@ -1795,17 +1861,19 @@ type SchemaExtra struct {
 	IndexTables       []uint64    `protobuf:"varint,13,rep,packed,name=IndexTables,proto3" json:"IndexTables,omitempty"`
 	ParentTableID     uint64      `protobuf:"varint,14,opt,name=ParentTableID,proto3" json:"ParentTableID,omitempty"`
 	// mark if table is created by publication (CCPR), should skip merge
-	FromPublication      bool     `protobuf:"varint,15,opt,name=FromPublication,proto3" json:"FromPublication,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	FromPublication bool `protobuf:"varint,15,opt,name=FromPublication,proto3" json:"FromPublication,omitempty"`
+	// columns not encoded with the default lz4 codec
+	ColumnCompress       []ColumnCompress `protobuf:"bytes,16,rep,name=column_compress,json=columnCompress,proto3" json:"column_compress"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *SchemaExtra) Reset()         { *m = SchemaExtra{} }
 func (m *SchemaExtra) String() string { return proto.CompactTextString(m) }
 func (*SchemaExtra) ProtoMessage()    {}
 func (*SchemaExtra) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 func (m *SchemaExtra) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1939,6 +2007,76 @@ func (m *SchemaExtra) GetFromPublication() bool {
 	return false
 }
 
+func (m *SchemaExtra) GetColumnCompress() []ColumnCompress {
+	if m != nil {
+		return m.ColumnCompress
+	}
+	return nil
+}
+
+type ColumnCompress struct {
+	Seqnum               uint32   `protobuf:"varint,1,opt,name=seqnum,proto3" json:"seqnum,omitempty"`
+	Alg                  uint32   `protobuf:"varint,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	Level                int32    `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ColumnCompress) Reset()         { *m = ColumnCompress{} }
+func (m *ColumnCompress) String() string { return proto.CompactTextString(m) }
+func (*ColumnCompress) ProtoMessage()    {}
+func (*ColumnCompress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+}
+func (m *ColumnCompress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ColumnCompress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ColumnCompress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ColumnCompress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ColumnCompress.Merge(m, src)
+}
+func (m *ColumnCompress) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ColumnCompress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ColumnCompress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ColumnCompress proto.InternalMessageInfo
+
+func (m *ColumnCompress) GetSeqnum() uint32 {
+	if m != nil {
+		return m.Seqnum
+	}
+	return 0
+}
+
+func (m *ColumnCompress) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *ColumnCompress) GetLevel() int32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
 // Int64Map mainly used in unit test
 type Int64Map struct {
 	M                    map[int64]int64 `protobuf:"bytes,1,rep,name=m,proto3" json:"m,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
@@ -1951,7 +2089,7 @@ func (m *Int64Map) Reset()         { *m = Int64Map{} }
 func (m *Int64Map) String() string { return proto.CompactTextString(m) }
 func (*Int64Map) ProtoMessage()    {}
 func (*Int64Map) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
 func (m *Int64Map) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2000,7 +2138,7 @@ func (m *TransDestPos) Reset()         { *m = TransDestPos{} }
 func (m *TransDestPos) String() string { return proto.CompactTextString(m) }
 func (*TransDestPos) ProtoMessage()    {}
 func (*TransDestPos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
 func (m *TransDestPos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2061,7 +2199,7 @@ func (m *BlkTransMap) Reset()         { *m = BlkTransMap{} }
 func (m *BlkTransMap) String() string { return proto.CompactTextString(m) }
 func (*BlkTransMap) ProtoMessage()    {}
 func (*BlkTransMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
 }
 func (m *BlkTransMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2108,7 +2246,7 @@ func (m *BlkTransferBooking) Reset()         { *m = BlkTransferBooking{} }
 func (m *BlkTransferBooking) String() string { return proto.CompactTextString(m) }
 func (*BlkTransferBooking) ProtoMessage()    {}
 func (*BlkTransferBooking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
 func (m *BlkTransferBooking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2164,7 +2302,7 @@ func (m *MergeCommitEntry) Reset()         { *m = MergeCommitEntry{} }
 func (m *MergeCommitEntry) String() string { return proto.CompactTextString(m) }
 func (*MergeCommitEntry) ProtoMessage()    {}
 func (*MergeCommitEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 func (m *MergeCommitEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2283,7 +2421,7 @@ func (m *MergeTaskEntry) Reset()         { *m = MergeTaskEntry{} }
 func (m *MergeTaskEntry) String() string { return proto.CompactTextString(m) }
 func (*MergeTaskEntry) ProtoMessage()    {}
 func (*MergeTaskEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
 func (m *MergeTaskEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2387,7 +2525,7 @@ func (m *CheckpointResp) Reset()         { *m = CheckpointResp{} }
 func (m *CheckpointResp) String() string { return proto.CompactTextString(m) }
 func (*CheckpointResp) ProtoMessage()    {}
 func (*CheckpointResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
 }
 func (m *CheckpointResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2455,9 +2593,11 @@ func init() {
 	proto.RegisterType((*AlterTableRenameCol)(nil), "api.AlterTableRenameCol")
 	proto.RegisterType((*AlterTableAddColumn)(nil), "api.AlterTableAddColumn")
 	proto.RegisterType((*AlterTableReplaceDef)(nil), "api.AlterTableReplaceDef")
+	proto.RegisterType((*AlterTableColumnCompress)(nil), "api.AlterTableColumnCompress")
 	proto.RegisterType((*AlterTableDropColumn)(nil), "api.AlterTableDropColumn")
 	proto.RegisterType((*AlterTableReq)(nil), "api.AlterTableReq")
 	proto.RegisterType((*SchemaExtra)(nil), "api.SchemaExtra")
+	proto.RegisterType((*ColumnCompress)(nil), "api.ColumnCompress")
 	proto.RegisterType((*Int64Map)(nil), "api.Int64Map")
 	proto.RegisterMapType((map[int64]int64)(nil), "api.Int64Map.MEntry")
 	proto.RegisterType((*TransDestPos)(nil), "api.TransDestPos")
@@ -2472,172 +2612,177 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 2630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5f, 0x6f, 0x5c, 0x47,
-	0x15, 0xf7, 0x7a, 0xff, 0x9f, 0xfd, 0x77, 0x3d, 0x4e, 0xd2, 0xad, 0x29, 0x89, 0xd9, 0xa6, 0xad,
-	0x9b, 0x52, 0x47, 0xb8, 0x05, 0xda, 0x2a, 0xa2, 0x8a, 0xed, 0x26, 0xde, 0x12, 0xc7, 0xe6, 0x7a,
-	0xd3, 0x4a, 0x15, 0xd2, 0xd5, 0xec, 0xbd, 0x93, 0xf5, 0xcd, 0xde, 0x3b, 0x73, 0x33, 0x33, 0xeb,
-	0xd8, 0x7d, 0x05, 0x1e, 0x91, 0x10, 0x6f, 0xbc, 0xb5, 0x1f, 0x01, 0x09, 0x89, 0x6f, 0x80, 0xfa,
-	0x58, 0x44, 0xf9, 0x57, 0xa0, 0x84, 0xf2, 0x02, 0x7c, 0x0a, 0x34, 0x67, 0xe6, 0xee, 0xae, 0x9d,
-	0xb4, 0x50, 0x84, 0xd4, 0x07, 0x5b, 0x33, 0xbf, 0x73, 0xce, 0xdc, 0xdf, 0x39, 0x73, 0xe6, 0xcc,
-	0x99, 0x85, 0x3a, 0xcd, 0xe2, 0xf5, 0x4c, 0x0a, 0x2d, 0x48, 0x91, 0x66, 0xf1, 0xca, 0x8b, 0xa3,
-	0x58, 0x1f, 0x4e, 0x86, 0xeb, 0xa1, 0x48, 0xaf, 0x8e, 0xc4, 0x48, 0x5c, 0x45, 0xd9, 0x70, 0x72,
-	0x17, 0x67, 0x38, 0xc1, 0x91, 0xb5, 0x59, 0xe9, 0xe8, 0x38, 0x65, 0x4a, 0xd3, 0x34, 0x73, 0x00,
-	0x64, 0x09, 0xe5, 0x76, 0xdc, 0xfb, 0x36, 0xb4, 0x06, 0xb7, 0xf7, 0x63, 0x3e, 0xf2, 0xd9, 0xfd,
-	0x09, 0x53, 0x9a, 0x3c, 0x05, 0xf5, 0x8c, 0x4a, 0x9a, 0x32, 0xcd, 0x64, 0xb7, 0xb0, 0x5a, 0x58,
-	0xab, 0xfb, 0x33, 0xe0, 0xb5, 0xda, 0x7b, 0xef, 0x5f, 0x2a, 0x3c, 0x7c, 0xff, 0xd2, 0x42, 0xef,
-	0x17, 0x05, 0x68, 0xe7, 0x96, 0x2a, 0x13, 0x5c, 0x31, 0xd2, 0x85, 0xaa, 0xd2, 0x42, 0xb2, 0xfe,
-	0xb6, 0x33, 0xcc, 0xa7, 0xe4, 0x59, 0x68, 0x2b, 0x26, 0x8f, 0xe2, 0x90, 0x5d, 0x8f, 0x22, 0xc9,
-	0x94, 0xea, 0x2e, 0xa2, 0xc2, 0x19, 0x14, 0x57, 0x38, 0xa4, 0x32, 0xea, 0x6f, 0x77, 0x8b, 0xab,
-	0x85, 0xb5, 0x92, 0x9f, 0x4f, 0x0d, 0x2d, 0xc9, 0xb2, 0x24, 0x0e, 0x69, 0x7f, 0xbb, 0x5b, 0x42,
-	0xd9, 0x0c, 0x20, 0x17, 0x01, 0x12, 0x31, 0x3a, 0x70, 0xa6, 0x65, 0x14, 0xcf, 0x21, 0x73, 0xb4,
-	0x5f, 0x03, 0x6f, 0x70, 0xfb, 0x40, 0xcb, 0x79, 0xde, 0xb8, 0xb6, 0x9e, 0x48, 0x7e, 0xa0, 0xa7,
-	0x2e, 0x4f, 0x81, 0x39, 0xdb, 0x9f, 0x17, 0xa0, 0xf2, 0x16, 0x0b, 0xb5, 0x90, 0x84, 0x40, 0x29,
-	0xa2, 0x9a, 0xa2, 0x76, 0xd3, 0xc7, 0x31, 0xb9, 0x0c, 0x25, 0x7d, 0x92, 0x31, 0x74, 0xad, 0xb1,
-	0x01, 0xeb, 0x18, 0xe5, 0xc1, 0x49, 0xc6, 0x36, 0x4b, 0x1f, 0x7c, 0x72, 0x69, 0xc1, 0x47, 0x29,
-	0x59, 0x81, 0x1a, 0x9f, 0x24, 0x09, 0x1d, 0x26, 0x0c, 0x7d, 0xac, 0xf9, 0xd3, 0x39, 0xf1, 0xa0,
-	0xc8, 0x55, 0x86, 0xee, 0x35, 0x7d, 0x33, 0x24, 0x4f, 0x42, 0x2d, 0x56, 0x41, 0x28, 0xb8, 0xd2,
-	0xe8, 0x56, 0xcd, 0xaf, 0xc6, 0x6a, 0xcb, 0x4c, 0x8d, 0x72, 0xc2, 0x78, 0xb7, 0xb2, 0x5a, 0x58,
-	0x6b, 0xf9, 0x66, 0x68, 0x48, 0x51, 0xc9, 0x68, 0xb7, 0x6a, 0x49, 0x99, 0x71, 0xef, 0x4d, 0x28,
-	0x6f, 0x52, 0x1d, 0x1e, 0x92, 0x15, 0x28, 0x53, 0xad, 0xa5, 0xea, 0x16, 0x56, 0x8b, 0x6b, 0x75,
-	0x47, 0xc9, 0x42, 0xe4, 0x19, 0x28, 0x1d, 0xb1, 0xd0, 0x6c, 0x4a, 0x71, 0xad, 0xb1, 0xd1, 0x58,
-	0x37, 0xf9, 0x66, 0x1d, 0xcd, 0xa9, 0x1b, 0x71, 0xef, 0x57, 0x05, 0xa8, 0x0e, 0x0c, 0xd1, 0xfe,
-	0x36, 0x59, 0x86, 0x72, 0x34, 0x0c, 0xe2, 0x08, 0x23, 0x50, 0xf2, 0x4b, 0xd1, 0xb0, 0x1f, 0x19,
-	0x50, 0x23, 0xb8, 0x68, 0x41, 0x6d, 0xc0, 0xaf, 0x41, 0x33, 0xa3, 0x52, 0xc7, 0x3a, 0x16, 0xdc,
-	0xc8, 0xec, 0xc6, 0x36, 0xa6, 0x58, 0x3f, 0x22, 0xe7, 0xa1, 0x42, 0xc3, 0xd0, 0x08, 0x4b, 0xe8,
-	0x4d, 0x99, 0x86, 0x61, 0x3f, 0x22, 0x4f, 0x40, 0x35, 0x1a, 0x06, 0x9c, 0xa6, 0x0c, 0x7d, 0xaf,
-	0xfb, 0x95, 0x68, 0x78, 0x9b, 0xa6, 0xcc, 0x08, 0xb4, 0x13, 0x54, 0xac, 0x40, 0x5b, 0xc1, 0x33,
-	0xd0, 0xce, 0x64, 0x9c, 0x52, 0x79, 0x12, 0x28, 0x76, 0x9f, 0x4f, 0x52, 0x8c, 0x45, 0xcb, 0x6f,
-	0x39, 0xf4, 0x00, 0xc1, 0xde, 0x4f, 0x0b, 0xd0, 0x3e, 0x38, 0xe1, 0xe1, 0x2d, 0x31, 0x1a, 0xd0,
-	0x38, 0xf1, 0xd9, 0x7d, 0xf2, 0x22, 0x54, 0x43, 0x1e, 0x1c, 0xd2, 0x23, 0x86, 0x1e, 0x35, 0x36,
-	0xce, 0xad, 0xcf, 0x8e, 0xcd, 0x20, 0x1f, 0xf9, 0x95, 0x90, 0xef, 0xd0, 0x23, 0xe6, 0xd4, 0x1f,
-	0x50, 0xae, 0xdd, 0x76, 0x7f, 0xa6, 0xfa, 0xdb, 0x94, 0x6b, 0xd2, 0x83, 0xb2, 0x9e, 0xee, 0x78,
-	0x63, 0xa3, 0x89, 0x11, 0x76, 0xa1, 0xf4, 0xad, 0xa8, 0xf7, 0x7d, 0xe8, 0x9c, 0xe2, 0xa4, 0x32,
-	0x13, 0xba, 0x70, 0x9c, 0x05, 0x89, 0x08, 0xa9, 0x89, 0x94, 0xcb, 0xcd, 0x46, 0x38, 0xce, 0x6e,
-	0x39, 0x88, 0x3c, 0x0b, 0xb5, 0x50, 0xa4, 0x29, 0xe5, 0x51, 0xbe, 0x7d, 0x80, 0x8b, 0xbf, 0xc1,
-	0xb5, 0x3c, 0xf1, 0xa7, 0xb2, 0x9e, 0x82, 0xa5, 0x7d, 0xc9, 0xcc, 0x34, 0xd6, 0x6f, 0xcb, 0x58,
-	0xb3, 0xad, 0x34, 0x22, 0xcf, 0x03, 0x30, 0xa3, 0x17, 0x24, 0xb1, 0xd2, 0x98, 0x18, 0xa7, 0xcd,
-	0xeb, 0x28, 0xbd, 0x15, 0x2b, 0x4d, 0x5e, 0x82, 0x0b, 0xea, 0x84, 0x87, 0x81, 0xa9, 0x1a, 0x2c,
-	0xc4, 0xbd, 0xbc, 0x27, 0xa6, 0x7b, 0x5d, 0xf7, 0x97, 0x8d, 0x74, 0x7f, 0x2a, 0x7c, 0x53, 0x0c,
-	0xfb, 0x51, 0xef, 0x27, 0x45, 0x28, 0xe3, 0x4a, 0xe4, 0xa5, 0xfc, 0x4b, 0x78, 0x42, 0x8c, 0x1f,
-	0xed, 0x8d, 0x73, 0xb3, 0x2f, 0xd9, 0xff, 0xe6, 0xac, 0xb8, 0x6f, 0x9a, 0xa1, 0x49, 0x7e, 0x0c,
-	0xcd, 0x2c, 0xa3, 0xaa, 0x38, 0xef, 0x47, 0xe4, 0x12, 0x34, 0xcc, 0x99, 0x1b, 0x52, 0xc5, 0x66,
-	0x39, 0x05, 0x39, 0xd4, 0x8f, 0xc8, 0x57, 0x01, 0xac, 0x2d, 0x66, 0x49, 0xc9, 0x1e, 0x6a, 0x44,
-	0x30, 0x51, 0x9e, 0x86, 0xd6, 0xd4, 0x7e, 0x2e, 0xc1, 0x9a, 0x39, 0x88, 0x4a, 0x5f, 0x81, 0xfa,
-	0xdd, 0x38, 0x5f, 0xc2, 0x26, 0x5a, 0xcd, 0x00, 0x28, 0x7c, 0x0a, 0x8a, 0x43, 0xaa, 0x31, 0xbf,
-	0xf2, 0xa0, 0xe1, 0x41, 0xf3, 0x0d, 0x4c, 0x9e, 0x86, 0x76, 0x36, 0x0e, 0xc2, 0x43, 0x16, 0x8e,
-	0x83, 0xe1, 0x49, 0xa0, 0x79, 0xb7, 0xb6, 0x5a, 0x58, 0x2b, 0xfb, 0x8d, 0x6c, 0xbc, 0x65, 0xc0,
-	0xcd, 0x93, 0x01, 0xef, 0x49, 0xa8, 0x4f, 0xfd, 0x26, 0x00, 0x95, 0x3e, 0x57, 0x4c, 0x6a, 0x6f,
-	0xc1, 0x8c, 0xb7, 0x59, 0xc2, 0x34, 0xf3, 0x0a, 0x66, 0x7c, 0x27, 0x8b, 0xa8, 0x66, 0xde, 0x22,
-	0xa9, 0x43, 0xf9, 0x7a, 0xa2, 0x99, 0xf4, 0x8a, 0x64, 0x09, 0x5a, 0x07, 0x19, 0x0b, 0x63, 0x9a,
-	0x38, 0xcd, 0x12, 0x69, 0x03, 0x6c, 0x53, 0x4d, 0xf7, 0x86, 0xf7, 0x58, 0xa8, 0xbd, 0x32, 0x59,
-	0x86, 0xce, 0x40, 0xa4, 0x43, 0xa5, 0x05, 0x67, 0x0e, 0xac, 0xf4, 0x7e, 0x58, 0x00, 0x40, 0x06,
-	0x99, 0x88, 0xb9, 0x26, 0x2f, 0x40, 0x25, 0x8d, 0x79, 0xa0, 0xd5, 0xe7, 0x66, 0x7d, 0x39, 0x8d,
-	0xf9, 0x40, 0xa1, 0x32, 0x3d, 0x36, 0xca, 0x8b, 0x9f, 0xab, 0x4c, 0x8f, 0x07, 0x2a, 0x8f, 0x4f,
-	0xf1, 0xb1, 0xf1, 0xb1, 0x34, 0xa8, 0xa6, 0x89, 0x18, 0x6d, 0x8d, 0xb3, 0x2f, 0x8d, 0xc6, 0x8f,
-	0x0a, 0xd0, 0xd8, 0x65, 0x9a, 0x9a, 0x6d, 0xff, 0x32, 0x79, 0xfc, 0xab, 0x00, 0x1e, 0xee, 0x2c,
-	0xd6, 0x84, 0x7d, 0x91, 0xc4, 0xe1, 0x09, 0x59, 0x87, 0x65, 0x43, 0x46, 0xa8, 0xf8, 0x5d, 0x16,
-	0xdc, 0x9f, 0xd0, 0x38, 0x89, 0xef, 0x32, 0x5b, 0x70, 0x5b, 0xfe, 0x52, 0x1a, 0xf3, 0x3d, 0x23,
-	0xf9, 0x5e, 0x2e, 0x20, 0x97, 0xa1, 0x6d, 0xf8, 0x88, 0xe1, 0xbd, 0x40, 0x70, 0x26, 0x27, 0x1c,
-	0x79, 0xb5, 0xfc, 0x66, 0x4a, 0x8f, 0xf7, 0x86, 0xf7, 0xf6, 0x10, 0x23, 0x57, 0xe1, 0x1c, 0x6a,
-	0xe1, 0xaa, 0x29, 0x93, 0x23, 0x16, 0x19, 0x13, 0x64, 0x66, 0x96, 0xa5, 0xc7, 0xb8, 0xec, 0x2e,
-	0x4a, 0xf6, 0x86, 0xf7, 0xc8, 0x65, 0x28, 0x1f, 0xc6, 0x5c, 0xab, 0x6e, 0x69, 0xb5, 0xb8, 0xd6,
-	0xde, 0x68, 0x23, 0x77, 0x14, 0xef, 0xc4, 0x5c, 0xfb, 0x56, 0x48, 0x9e, 0x07, 0xc3, 0x28, 0x08,
-	0xb9, 0x5d, 0x33, 0x30, 0x6b, 0xb8, 0x8b, 0xb8, 0x9d, 0xc6, 0x7c, 0x8b, 0xa3, 0xc5, 0x41, 0xfc,
-	0x2e, 0xeb, 0xbd, 0x02, 0xe7, 0x66, 0xbe, 0xe2, 0x5d, 0x26, 0xa9, 0xc9, 0xc5, 0x55, 0x68, 0x84,
-	0xd3, 0x99, 0x72, 0x57, 0xeb, 0x3c, 0xd4, 0x7b, 0x11, 0x96, 0xe6, 0x2d, 0xd3, 0x94, 0x71, 0x6d,
-	0x7a, 0x86, 0xd0, 0x0e, 0xf3, 0xae, 0xc3, 0x4d, 0x7b, 0xbb, 0x70, 0x7e, 0xa6, 0xee, 0x33, 0x73,
-	0x8c, 0x71, 0x68, 0x0a, 0x8b, 0x48, 0x22, 0x7b, 0xae, 0x9d, 0x8d, 0x48, 0x22, 0x3c, 0xd6, 0x4f,
-	0x42, 0x8d, 0xb3, 0x07, 0x56, 0x64, 0x2b, 0x5b, 0x95, 0xb3, 0x07, 0x46, 0xd4, 0xe3, 0xb0, 0x7c,
-	0x76, 0xb9, 0x2d, 0x91, 0xfc, 0x6f, 0x8b, 0x99, 0xd2, 0xae, 0x4c, 0xc7, 0xc5, 0x43, 0x16, 0x98,
-	0x7b, 0xca, 0x86, 0xbf, 0x91, 0x63, 0xb7, 0x27, 0x69, 0x2f, 0x9a, 0xff, 0xde, 0xf5, 0x28, 0xda,
-	0x12, 0xc9, 0x24, 0xe5, 0xe4, 0x32, 0x54, 0x42, 0x1c, 0xb9, 0x1c, 0x6d, 0xda, 0x46, 0x63, 0x4b,
-	0x24, 0xdb, 0xec, 0xae, 0xef, 0x64, 0xe4, 0x39, 0xe8, 0xc4, 0x58, 0x4e, 0x82, 0x4c, 0x28, 0xbc,
-	0x67, 0x91, 0x41, 0xd9, 0x6f, 0x5b, 0x78, 0xdf, 0xa1, 0xa7, 0x77, 0xc3, 0x67, 0x59, 0x42, 0x43,
-	0xb6, 0xcd, 0xee, 0x92, 0x55, 0x28, 0x46, 0xec, 0xae, 0xfb, 0x46, 0xdb, 0x35, 0x33, 0x46, 0xc7,
-	0x7c, 0xc5, 0x88, 0x7a, 0xef, 0xcc, 0x5b, 0x6e, 0x4b, 0x91, 0x39, 0x82, 0x97, 0xa0, 0x91, 0x88,
-	0x51, 0x1c, 0xd2, 0x24, 0x88, 0xa3, 0x63, 0x97, 0xaf, 0xe0, 0xa0, 0x7e, 0x74, 0xfc, 0x88, 0xef,
-	0x8b, 0x8f, 0xfa, 0xfe, 0xb0, 0x04, 0xad, 0x79, 0x5a, 0xf7, 0x4f, 0x5d, 0x06, 0x85, 0xd3, 0x97,
-	0xc1, 0xb4, 0x17, 0x59, 0x9c, 0xeb, 0x45, 0x7a, 0x50, 0x1a, 0xc7, 0xdc, 0x5e, 0x0d, 0x79, 0xd6,
-	0xe2, 0x8a, 0xdf, 0x8d, 0x79, 0xe4, 0xa3, 0x8c, 0xbc, 0x0a, 0x40, 0xa3, 0x28, 0x70, 0xe1, 0x2c,
-	0xa1, 0xab, 0xdd, 0x99, 0xe6, 0xe9, 0xc0, 0xef, 0x2c, 0xf8, 0x75, 0x3a, 0xdd, 0x85, 0x6b, 0xd0,
-	0x88, 0xa4, 0xc8, 0x72, 0xdb, 0x32, 0xda, 0x3e, 0x79, 0xc6, 0x76, 0x16, 0x94, 0x9d, 0x05, 0x1f,
-	0xa2, 0x59, 0x88, 0x5e, 0x87, 0xa6, 0xc4, 0x04, 0x0a, 0x6c, 0x5b, 0x50, 0x41, 0xf3, 0x95, 0x33,
-	0xe6, 0x73, 0x29, 0xbb, 0xb3, 0xe0, 0x37, 0xe4, 0x5c, 0x06, 0xbf, 0x0e, 0xed, 0x09, 0xde, 0x0a,
-	0x41, 0x9e, 0xfb, 0xf6, 0x22, 0xba, 0x70, 0x66, 0x09, 0x77, 0x48, 0x76, 0x16, 0xfc, 0x96, 0xd5,
-	0xcf, 0x4f, 0xcd, 0x35, 0x68, 0xe4, 0x0b, 0x28, 0x2d, 0xf1, 0x76, 0x7a, 0x94, 0xff, 0xec, 0x70,
-	0x1a, 0xfe, 0x6e, 0x01, 0xa5, 0x25, 0xb9, 0x06, 0x6e, 0xb9, 0x20, 0xc3, 0x5a, 0xd5, 0xad, 0xa3,
-	0xfd, 0xf9, 0x33, 0xf6, 0xb6, 0x90, 0xed, 0x2c, 0xf8, 0x4d, 0xab, 0xed, 0x0a, 0xdb, 0xab, 0x00,
-	0xce, 0xfb, 0x50, 0x24, 0xdd, 0xc6, 0x63, 0xc3, 0x3e, 0x3d, 0x5f, 0x26, 0xec, 0x72, 0x7a, 0xd8,
-	0xae, 0x41, 0x43, 0xda, 0x1c, 0x0d, 0x4c, 0x76, 0x36, 0x1f, 0x4b, 0x7b, 0x96, 0xc5, 0x86, 0xb6,
-	0x9c, 0xce, 0x36, 0x1b, 0x50, 0x17, 0x19, 0x93, 0xd8, 0x39, 0xf5, 0x3e, 0x2e, 0x41, 0xe3, 0x20,
-	0x3c, 0x64, 0x29, 0x7d, 0xe3, 0x58, 0x4b, 0x4a, 0x9e, 0x85, 0x0e, 0x67, 0xc7, 0xda, 0x70, 0xca,
-	0x9b, 0x47, 0x9b, 0xba, 0x2d, 0x03, 0x6f, 0x89, 0xc4, 0x36, 0x8f, 0xd8, 0x3a, 0x48, 0x91, 0x65,
-	0x2c, 0x0a, 0x6c, 0x43, 0x6d, 0xda, 0x2e, 0xd3, 0x3a, 0x58, 0xf0, 0xba, 0xeb, 0xa8, 0xdb, 0x36,
-	0x33, 0x82, 0xf0, 0x90, 0xf2, 0x11, 0x8b, 0x5c, 0xaf, 0xdf, 0xb2, 0xe8, 0x96, 0x05, 0x4f, 0xd5,
-	0x8e, 0xd2, 0xe9, 0xda, 0xf1, 0x19, 0xd5, 0xbf, 0xfc, 0xdf, 0x57, 0xff, 0xca, 0x17, 0xa8, 0xfe,
-	0xd5, 0xff, 0x58, 0xfd, 0x6b, 0x5f, 0xb8, 0xfa, 0xd7, 0x1f, 0x57, 0xfd, 0x0d, 0xcf, 0x61, 0x22,
-	0xc2, 0x71, 0x60, 0x78, 0x48, 0xf1, 0x40, 0x75, 0xc1, 0xf2, 0x44, 0x74, 0x97, 0x1e, 0xfb, 0xe2,
-	0x81, 0x22, 0x57, 0x60, 0x49, 0x60, 0xcb, 0x82, 0x6a, 0x28, 0x52, 0x98, 0x29, 0x2d, 0xbf, 0x63,
-	0x05, 0xbb, 0xf4, 0x78, 0x13, 0x61, 0x73, 0x6f, 0xdc, 0x60, 0x54, 0x4f, 0x24, 0xbb, 0x91, 0xd0,
-	0x11, 0xe6, 0x44, 0xc9, 0x9f, 0x87, 0x8c, 0x46, 0x9f, 0x47, 0xec, 0x18, 0xb3, 0x43, 0x75, 0x5b,
-	0xab, 0x45, 0xa3, 0x31, 0x07, 0x91, 0xcb, 0xd0, 0xda, 0xa7, 0x92, 0x71, 0xed, 0x9a, 0xf2, 0x6e,
-	0x1b, 0x57, 0x39, 0x0d, 0x92, 0x35, 0xe8, 0xdc, 0x90, 0x22, 0xdd, 0x9f, 0x0c, 0xcd, 0xbb, 0x13,
-	0x8b, 0x6a, 0x07, 0xb7, 0xf5, 0x2c, 0xdc, 0x8b, 0xa0, 0xd6, 0xe7, 0xfa, 0x5b, 0x2f, 0xef, 0xd2,
-	0x8c, 0xf4, 0xa0, 0x90, 0xba, 0xe6, 0xda, 0xb6, 0xbc, 0xb9, 0x64, 0x7d, 0xd7, 0xb6, 0xd9, 0x85,
-	0x74, 0xe5, 0x65, 0xa8, 0xd8, 0x89, 0x79, 0xd6, 0x8d, 0xd9, 0x09, 0xa6, 0x5e, 0xd1, 0x37, 0x43,
-	0x72, 0x0e, 0xca, 0x47, 0x34, 0x99, 0xd8, 0x2b, 0xa4, 0xe8, 0xdb, 0xc9, 0x6b, 0x8b, 0xaf, 0x14,
-	0x7a, 0x6f, 0x41, 0x73, 0x20, 0x29, 0x57, 0xdb, 0x4c, 0x99, 0x82, 0x4e, 0x2e, 0x40, 0x45, 0x0c,
-	0xef, 0xf5, 0x5d, 0xd1, 0x2d, 0xfb, 0x6e, 0x66, 0xf0, 0x61, 0x32, 0x36, 0xb8, 0xbd, 0x03, 0xdc,
-	0xcc, 0xe0, 0x52, 0x3c, 0x30, 0x78, 0xd1, 0xe2, 0x76, 0xd6, 0xfb, 0x41, 0x01, 0x1a, 0x9b, 0xc9,
-	0x18, 0xd7, 0x36, 0x1e, 0xbc, 0x30, 0xf3, 0xe0, 0x09, 0xdb, 0xba, 0xcc, 0x84, 0xce, 0x09, 0xf7,
-	0x50, 0x2c, 0xa4, 0x2b, 0x37, 0x1f, 0xe7, 0x4a, 0xd9, 0xba, 0xf2, 0xdc, 0xbc, 0x2b, 0x8d, 0x8d,
-	0x25, 0xfb, 0x0e, 0x9a, 0x73, 0x61, 0xde, 0xbb, 0x1d, 0x20, 0xf9, 0x77, 0xee, 0x32, 0xb9, 0x29,
-	0xc4, 0x38, 0xe6, 0x23, 0xb2, 0x01, 0xb5, 0x94, 0x66, 0x59, 0xcc, 0x47, 0xca, 0x51, 0xf2, 0xce,
-	0x52, 0x72, 0x5c, 0xa6, 0x7a, 0xbd, 0x8f, 0x16, 0xc1, 0xc3, 0x0c, 0xdc, 0xc2, 0xf7, 0x8f, 0x65,
-	0xf7, 0xd8, 0x17, 0xec, 0x79, 0xa8, 0xe8, 0x61, 0x32, 0xbb, 0x4b, 0xca, 0x7a, 0x98, 0x3c, 0xf2,
-	0x9a, 0x28, 0x9e, 0x7d, 0x4d, 0x7c, 0x13, 0x6a, 0x4a, 0x53, 0xa9, 0x03, 0xec, 0x92, 0x3e, 0xb3,
-	0x17, 0x74, 0xbc, 0xaa, 0xa8, 0x3b, 0x50, 0xe6, 0xa2, 0x9c, 0x1d, 0x41, 0xd5, 0x2d, 0xaf, 0x16,
-	0xd7, 0x9a, 0x3e, 0xa4, 0xf9, 0xd9, 0x53, 0xf8, 0xfe, 0x93, 0x8c, 0xea, 0x5c, 0xa3, 0x82, 0x1a,
-	0x0d, 0x87, 0xa1, 0xca, 0x37, 0xa0, 0x3a, 0xb4, 0x91, 0x71, 0x37, 0xc0, 0xe9, 0x0d, 0x9a, 0x05,
-	0xce, 0xcf, 0xf5, 0xcc, 0x67, 0xdd, 0xd0, 0xbc, 0x2c, 0xf1, 0x60, 0xd7, 0x7d, 0x70, 0xd0, 0x2d,
-	0x11, 0x9a, 0x7d, 0x63, 0x52, 0xe2, 0xf9, 0xad, 0xfb, 0x66, 0x68, 0x52, 0x30, 0x61, 0x47, 0x2c,
-	0xc1, 0xb3, 0x5a, 0xf6, 0xed, 0xa4, 0xf7, 0xb3, 0x45, 0x68, 0x63, 0x58, 0x07, 0x54, 0x8d, 0xff,
-	0xef, 0x41, 0x9d, 0x7b, 0xfd, 0x97, 0x4e, 0xbd, 0xfe, 0x7b, 0xd0, 0xd2, 0xc2, 0x15, 0x9a, 0xb9,
-	0xc0, 0x35, 0xb4, 0x40, 0x32, 0x18, 0x96, 0x75, 0x58, 0x66, 0x4a, 0xc7, 0x29, 0xc6, 0x2e, 0x65,
-	0x69, 0x30, 0x51, 0x74, 0x64, 0xef, 0xd9, 0x92, 0xbf, 0x34, 0x15, 0xed, 0xb2, 0xf4, 0x8e, 0x11,
-	0x18, 0x2e, 0x34, 0x0c, 0xc5, 0x84, 0x6b, 0x43, 0xd3, 0x56, 0xc3, 0xba, 0x43, 0xec, 0x2f, 0x11,
-	0x13, 0xc5, 0xa4, 0x91, 0xd5, 0x50, 0x56, 0x31, 0x53, 0x2b, 0x90, 0xc2, 0x36, 0x25, 0x75, 0x2b,
-	0x30, 0xd3, 0x7e, 0xd4, 0xbb, 0x0d, 0xed, 0xd9, 0x33, 0x0b, 0x1f, 0xf3, 0x2b, 0x50, 0xbb, 0x75,
-	0xfa, 0x21, 0x3f, 0x9d, 0x9b, 0x02, 0xa5, 0xe5, 0x84, 0x87, 0x54, 0xb3, 0x5b, 0x8a, 0xbb, 0x30,
-	0xcd, 0x43, 0x57, 0x7e, 0x5c, 0x84, 0xca, 0x5e, 0xb6, 0x25, 0x22, 0x46, 0xaa, 0x50, 0xbc, 0x2d,
-	0x32, 0x6f, 0x81, 0x2c, 0x41, 0x73, 0x2f, 0xbb, 0xc9, 0xb4, 0xfb, 0xc9, 0xc0, 0xfb, 0x47, 0x95,
-	0x78, 0xd0, 0xd8, 0xcb, 0xf6, 0xa5, 0x4b, 0x74, 0xef, 0x9f, 0x55, 0xd2, 0x30, 0x76, 0xfb, 0x31,
-	0x1f, 0x79, 0x1f, 0x76, 0x48, 0x13, 0xaa, 0x7b, 0xd9, 0x8d, 0x64, 0xa2, 0x0e, 0xbd, 0x5f, 0x77,
-	0xac, 0xfd, 0x8c, 0xa5, 0xf7, 0x9b, 0x0e, 0x69, 0x43, 0x7d, 0x2f, 0xeb, 0x73, 0x95, 0x99, 0xd7,
-	0xe2, 0x47, 0x1d, 0x72, 0x0e, 0x3a, 0x7b, 0xd9, 0xf5, 0x28, 0xba, 0x41, 0x27, 0x89, 0xde, 0x47,
-	0xad, 0xdf, 0x76, 0x48, 0x0b, 0x6a, 0x7b, 0xd9, 0x26, 0x0d, 0xc7, 0x93, 0xcc, 0xfb, 0x5d, 0xc7,
-	0x7e, 0x74, 0x20, 0x69, 0xc8, 0x0e, 0x32, 0xca, 0xbd, 0xdf, 0x77, 0xc8, 0x32, 0xb4, 0xf7, 0xb2,
-	0x03, 0x2d, 0x24, 0x1d, 0x31, 0x0c, 0xb0, 0xf7, 0x87, 0x0e, 0x79, 0x02, 0xc8, 0x5e, 0x76, 0x33,
-	0x11, 0x43, 0x9a, 0xcc, 0x7d, 0xf4, 0x8f, 0x1d, 0x72, 0x01, 0x96, 0xcc, 0x47, 0x35, 0x93, 0x21,
-	0xcb, 0xb4, 0xa3, 0xfe, 0x71, 0x87, 0x10, 0x68, 0x19, 0x97, 0xcd, 0x14, 0x77, 0xd6, 0xfb, 0x93,
-	0xd3, 0xdd, 0x8e, 0xd5, 0xd8, 0xfc, 0x6d, 0x25, 0x8c, 0x72, 0x26, 0xbd, 0x3f, 0x3b, 0x4a, 0x3e,
-	0xa3, 0x11, 0x93, 0xde, 0x5f, 0x3a, 0x64, 0x05, 0xce, 0xdb, 0xd0, 0x50, 0xcd, 0x94, 0x9e, 0xfb,
-	0xdc, 0x27, 0x39, 0x39, 0x4e, 0x33, 0x75, 0x28, 0xb4, 0x31, 0xf1, 0xfe, 0x3a, 0x33, 0x70, 0x37,
-	0x33, 0x56, 0xfc, 0x5b, 0xb1, 0xd2, 0xde, 0x43, 0xc7, 0x03, 0x23, 0xd0, 0xe7, 0xf8, 0x8c, 0xfe,
-	0x5b, 0xe7, 0xca, 0x2f, 0x0b, 0x50, 0x9f, 0xb6, 0x93, 0xa4, 0x01, 0xd5, 0x3e, 0x3f, 0xa2, 0x49,
-	0x1c, 0x79, 0x0b, 0xa4, 0x05, 0xf5, 0x69, 0xd3, 0xe8, 0x15, 0xf0, 0x59, 0x3e, 0xed, 0xfc, 0xbc,
-	0x45, 0xd2, 0x81, 0xc6, 0x5c, 0x63, 0x67, 0x9f, 0xf2, 0x77, 0xe6, 0x7b, 0x33, 0xaf, 0x44, 0xce,
-	0x81, 0x97, 0x43, 0x79, 0x07, 0xe6, 0x95, 0x89, 0x07, 0xcd, 0x3b, 0x73, 0x7d, 0x94, 0x57, 0x31,
-	0xc8, 0xf5, 0x28, 0xda, 0xcf, 0x7f, 0x4a, 0xf3, 0x4c, 0x02, 0x34, 0xa7, 0xad, 0x93, 0xf9, 0x5e,
-	0xcd, 0x7c, 0x7f, 0xd6, 0x10, 0x79, 0xf5, 0x2b, 0x37, 0xa1, 0x3e, 0xbd, 0xbf, 0x49, 0x0d, 0x4a,
-	0xd7, 0x27, 0x5a, 0x58, 0xd6, 0xb7, 0x85, 0xfd, 0x2d, 0x41, 0x79, 0x05, 0xd2, 0x84, 0xda, 0x66,
-	0x3c, 0xb2, 0x14, 0x17, 0xc9, 0x32, 0x74, 0xb6, 0x04, 0xd7, 0x31, 0x9f, 0x88, 0x89, 0xc2, 0x9f,
-	0x8f, 0xbc, 0xe2, 0xe6, 0x77, 0x3e, 0xf8, 0xf4, 0x62, 0xe1, 0xc3, 0x4f, 0x2f, 0x16, 0x1e, 0x7e,
-	0x7a, 0x71, 0xe1, 0xbd, 0xbf, 0x5f, 0x2c, 0xbc, 0xf3, 0xf5, 0xb9, 0x1f, 0xa6, 0x53, 0xaa, 0x65,
-	0x7c, 0x2c, 0x64, 0x3c, 0x8a, 0x79, 0x3e, 0xe1, 0xec, 0x6a, 0x36, 0x1e, 0x5d, 0xcd, 0x86, 0x57,
-	0x69, 0x16, 0x0f, 0x2b, 0xf8, 0x0b, 0xf4, 0x4b, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x84, 0x64,
-	0x0a, 0xc7, 0xdf, 0x16, 0x00, 0x00,
+	// 2719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0x1c, 0xc7,
+	0xb1, 0xe7, 0x72, 0xff, 0xd7, 0xfe, 0x1b, 0x36, 0x29, 0x79, 0xcd, 0x67, 0x4b, 0x7c, 0x6b, 0xd9,
+	0xa6, 0xe5, 0x67, 0x0a, 0x8f, 0x76, 0x12, 0xc7, 0x30, 0x62, 0x88, 0xa4, 0x25, 0xae, 0x23, 0x6a,
+	0x99, 0xe1, 0xca, 0x06, 0x8c, 0x20, 0x83, 0xde, 0x99, 0xd6, 0x72, 0xb4, 0x33, 0xdd, 0xa3, 0x9e,
+	0x5e, 0x89, 0xf4, 0x35, 0xc9, 0x31, 0x40, 0x90, 0x5b, 0x2e, 0x81, 0xfd, 0x11, 0x02, 0x04, 0xc8,
+	0x37, 0x08, 0x7c, 0xb4, 0x11, 0xe7, 0x7f, 0xe2, 0x38, 0xce, 0x25, 0xc9, 0xa7, 0x08, 0xba, 0xba,
+	0x67, 0x77, 0x96, 0xa2, 0x9d, 0x38, 0x08, 0xe0, 0xc3, 0x2e, 0xba, 0x7e, 0x55, 0xd5, 0x5d, 0x55,
+	0x53, 0xdd, 0x55, 0xdd, 0x50, 0xa7, 0x49, 0xb8, 0x95, 0x48, 0xa1, 0x04, 0x29, 0xd2, 0x24, 0x5c,
+	0x7f, 0x61, 0x1c, 0xaa, 0xe3, 0xe9, 0x68, 0xcb, 0x17, 0xf1, 0xb5, 0xb1, 0x18, 0x8b, 0x6b, 0xc8,
+	0x1b, 0x4d, 0xef, 0x22, 0x85, 0x04, 0x8e, 0x8c, 0xce, 0x7a, 0x47, 0x85, 0x31, 0x4b, 0x15, 0x8d,
+	0x13, 0x0b, 0x40, 0x12, 0x51, 0x6e, 0xc6, 0xbd, 0xaf, 0x41, 0x6b, 0x78, 0xfb, 0x30, 0xe4, 0x63,
+	0x97, 0xdd, 0x9f, 0xb2, 0x54, 0x91, 0x27, 0xa0, 0x9e, 0x50, 0x49, 0x63, 0xa6, 0x98, 0xec, 0x16,
+	0x36, 0x0a, 0x9b, 0x75, 0x77, 0x0e, 0xbc, 0x52, 0x7b, 0xf7, 0xbd, 0xcb, 0x85, 0x4f, 0xde, 0xbb,
+	0xbc, 0xd4, 0xfb, 0x59, 0x01, 0xda, 0x99, 0x66, 0x9a, 0x08, 0x9e, 0x32, 0xd2, 0x85, 0x6a, 0xaa,
+	0x84, 0x64, 0xfd, 0x3d, 0xab, 0x98, 0x91, 0xe4, 0x19, 0x68, 0xa7, 0x4c, 0x3e, 0x08, 0x7d, 0x76,
+	0x3d, 0x08, 0x24, 0x4b, 0xd3, 0xee, 0x32, 0x0a, 0x9c, 0x41, 0x71, 0x86, 0x63, 0x2a, 0x83, 0xfe,
+	0x5e, 0xb7, 0xb8, 0x51, 0xd8, 0x2c, 0xb9, 0x19, 0xa9, 0xcd, 0x92, 0x2c, 0x89, 0x42, 0x9f, 0xf6,
+	0xf7, 0xba, 0x25, 0xe4, 0xcd, 0x01, 0x72, 0x09, 0x20, 0x12, 0xe3, 0x23, 0xab, 0x5a, 0x46, 0x76,
+	0x0e, 0xc9, 0x99, 0xfd, 0x0a, 0x38, 0xc3, 0xdb, 0x47, 0x4a, 0xe6, 0xed, 0xc6, 0xb9, 0xd5, 0x54,
+	0xf2, 0x23, 0x35, 0x73, 0x79, 0x06, 0xe4, 0x74, 0x7f, 0x5a, 0x80, 0xca, 0x9b, 0xcc, 0x57, 0x42,
+	0x12, 0x02, 0xa5, 0x80, 0x2a, 0x8a, 0xd2, 0x4d, 0x17, 0xc7, 0xe4, 0x0a, 0x94, 0xd4, 0x69, 0xc2,
+	0xd0, 0xb5, 0xc6, 0x36, 0x6c, 0x61, 0x94, 0x87, 0xa7, 0x09, 0xdb, 0x29, 0xbd, 0xff, 0xf1, 0xe5,
+	0x25, 0x17, 0xb9, 0x64, 0x1d, 0x6a, 0x7c, 0x1a, 0x45, 0x74, 0x14, 0x31, 0xf4, 0xb1, 0xe6, 0xce,
+	0x68, 0xe2, 0x40, 0x91, 0xa7, 0x09, 0xba, 0xd7, 0x74, 0xf5, 0x90, 0x3c, 0x0e, 0xb5, 0x30, 0xf5,
+	0x7c, 0xc1, 0x53, 0x85, 0x6e, 0xd5, 0xdc, 0x6a, 0x98, 0xee, 0x6a, 0x52, 0x0b, 0x47, 0x8c, 0x77,
+	0x2b, 0x1b, 0x85, 0xcd, 0x96, 0xab, 0x87, 0xda, 0x28, 0x2a, 0x19, 0xed, 0x56, 0x8d, 0x51, 0x7a,
+	0xdc, 0x7b, 0x03, 0xca, 0x3b, 0x54, 0xf9, 0xc7, 0x64, 0x1d, 0xca, 0x54, 0x29, 0x99, 0x76, 0x0b,
+	0x1b, 0xc5, 0xcd, 0xba, 0x35, 0xc9, 0x40, 0xe4, 0x69, 0x28, 0x3d, 0x60, 0xbe, 0xfe, 0x28, 0xc5,
+	0xcd, 0xc6, 0x76, 0x63, 0x4b, 0xe7, 0x9b, 0x71, 0x34, 0x33, 0x5d, 0xb3, 0x7b, 0xbf, 0x28, 0x40,
+	0x75, 0xa8, 0x0d, 0xed, 0xef, 0x91, 0x55, 0x28, 0x07, 0x23, 0x2f, 0x0c, 0x30, 0x02, 0x25, 0xb7,
+	0x14, 0x8c, 0xfa, 0x81, 0x06, 0x15, 0x82, 0xcb, 0x06, 0x54, 0x1a, 0xfc, 0x5f, 0x68, 0x26, 0x54,
+	0xaa, 0x50, 0x85, 0x82, 0x6b, 0x9e, 0xf9, 0xb0, 0x8d, 0x19, 0xd6, 0x0f, 0xc8, 0x05, 0xa8, 0x50,
+	0xdf, 0xd7, 0xcc, 0x12, 0x7a, 0x53, 0xa6, 0xbe, 0xdf, 0x0f, 0xc8, 0x63, 0x50, 0x0d, 0x46, 0x1e,
+	0xa7, 0x31, 0x43, 0xdf, 0xeb, 0x6e, 0x25, 0x18, 0xdd, 0xa6, 0x31, 0xd3, 0x0c, 0x65, 0x19, 0x15,
+	0xc3, 0x50, 0x86, 0xf1, 0x34, 0xb4, 0x13, 0x19, 0xc6, 0x54, 0x9e, 0x7a, 0x29, 0xbb, 0xcf, 0xa7,
+	0x31, 0xc6, 0xa2, 0xe5, 0xb6, 0x2c, 0x7a, 0x84, 0x60, 0xef, 0x47, 0x05, 0x68, 0x1f, 0x9d, 0x72,
+	0xff, 0x96, 0x18, 0x0f, 0x69, 0x18, 0xb9, 0xec, 0x3e, 0x79, 0x01, 0xaa, 0x3e, 0xf7, 0x8e, 0xe9,
+	0x03, 0x86, 0x1e, 0x35, 0xb6, 0xd7, 0xb6, 0xe6, 0xdb, 0x66, 0x98, 0x8d, 0xdc, 0x8a, 0xcf, 0xf7,
+	0xe9, 0x03, 0x66, 0xc5, 0x1f, 0x52, 0xae, 0xba, 0xcb, 0x9f, 0x2f, 0xfe, 0x16, 0xe5, 0x8a, 0xf4,
+	0xa0, 0xac, 0x66, 0x5f, 0xbc, 0xb1, 0xdd, 0xc4, 0x08, 0xdb, 0x50, 0xba, 0x86, 0xd5, 0xfb, 0x36,
+	0x74, 0x16, 0x6c, 0x4a, 0x13, 0x1d, 0x3a, 0x7f, 0x92, 0x78, 0x91, 0xf0, 0xa9, 0x8e, 0x94, 0xcd,
+	0xcd, 0x86, 0x3f, 0x49, 0x6e, 0x59, 0x88, 0x3c, 0x03, 0x35, 0x5f, 0xc4, 0x31, 0xe5, 0x41, 0xf6,
+	0xf9, 0x00, 0x27, 0x7f, 0x9d, 0x2b, 0x79, 0xea, 0xce, 0x78, 0xbd, 0x14, 0x56, 0x0e, 0x25, 0xd3,
+	0x64, 0xa8, 0xde, 0x92, 0xa1, 0x62, 0xbb, 0x71, 0x40, 0x9e, 0x03, 0x60, 0x5a, 0xce, 0x8b, 0xc2,
+	0x54, 0x75, 0x0b, 0x8f, 0xa8, 0xd7, 0x91, 0x7b, 0x2b, 0x4c, 0x15, 0x79, 0x11, 0x2e, 0xa6, 0xa7,
+	0xdc, 0xf7, 0xf4, 0xa9, 0xc1, 0x7c, 0xfc, 0x96, 0xf7, 0xc4, 0xec, 0x5b, 0xd7, 0xdd, 0x55, 0xcd,
+	0x3d, 0x9c, 0x31, 0xdf, 0x10, 0xa3, 0x7e, 0xd0, 0xfb, 0x61, 0x11, 0xca, 0x38, 0x13, 0x79, 0x31,
+	0x5b, 0x09, 0x77, 0x88, 0xf6, 0xa3, 0xbd, 0xbd, 0x36, 0x5f, 0xc9, 0xfc, 0xeb, 0xbd, 0x62, 0xd7,
+	0xd4, 0x43, 0x9d, 0xfc, 0x18, 0x9a, 0x79, 0x46, 0x55, 0x91, 0xee, 0x07, 0xe4, 0x32, 0x34, 0xf4,
+	0x9e, 0x1b, 0xd1, 0x94, 0xcd, 0x73, 0x0a, 0x32, 0xa8, 0x1f, 0x90, 0x27, 0x01, 0x8c, 0x2e, 0x66,
+	0x49, 0xc9, 0x6c, 0x6a, 0x44, 0x30, 0x51, 0x9e, 0x82, 0xd6, 0x4c, 0x3f, 0x97, 0x60, 0xcd, 0x0c,
+	0x44, 0xa1, 0xff, 0x81, 0xfa, 0xdd, 0x30, 0x62, 0xf9, 0x44, 0xab, 0x69, 0x00, 0x99, 0x4f, 0x40,
+	0x71, 0x44, 0x15, 0xe6, 0x57, 0x16, 0x34, 0xdc, 0x68, 0xae, 0x86, 0xc9, 0x53, 0xd0, 0x4e, 0x26,
+	0x9e, 0x7f, 0xcc, 0xfc, 0x89, 0x37, 0x3a, 0xf5, 0x14, 0xef, 0xd6, 0x36, 0x0a, 0x9b, 0x65, 0xb7,
+	0x91, 0x4c, 0x76, 0x35, 0xb8, 0x73, 0x3a, 0xe4, 0x3d, 0x09, 0xf5, 0x99, 0xdf, 0x04, 0xa0, 0xd2,
+	0xe7, 0x29, 0x93, 0xca, 0x59, 0xd2, 0xe3, 0x3d, 0x16, 0x31, 0xc5, 0x9c, 0x82, 0x1e, 0xdf, 0x49,
+	0x02, 0xaa, 0x98, 0xb3, 0x4c, 0xea, 0x50, 0xbe, 0x1e, 0x29, 0x26, 0x9d, 0x22, 0x59, 0x81, 0xd6,
+	0x51, 0xc2, 0xfc, 0x90, 0x46, 0x56, 0xb2, 0x44, 0xda, 0x00, 0x7b, 0x54, 0xd1, 0xc1, 0xe8, 0x1e,
+	0xf3, 0x95, 0x53, 0x26, 0xab, 0xd0, 0x19, 0x8a, 0x78, 0x94, 0x2a, 0xc1, 0x99, 0x05, 0x2b, 0xbd,
+	0xef, 0x15, 0x00, 0xd0, 0x82, 0x44, 0x84, 0x5c, 0x91, 0xe7, 0xa1, 0x12, 0x87, 0xdc, 0x53, 0xe9,
+	0xe7, 0x66, 0x7d, 0x39, 0x0e, 0xf9, 0x30, 0x45, 0x61, 0x7a, 0xa2, 0x85, 0x97, 0x3f, 0x57, 0x98,
+	0x9e, 0x0c, 0xd3, 0x2c, 0x3e, 0xc5, 0x73, 0xe3, 0x63, 0xcc, 0xa0, 0x8a, 0x46, 0x62, 0xbc, 0x3b,
+	0x49, 0xbe, 0x34, 0x33, 0xbe, 0x5f, 0x80, 0xc6, 0x01, 0x53, 0x54, 0x7f, 0xf6, 0x2f, 0xd3, 0x8e,
+	0x7f, 0x14, 0xc0, 0xc1, 0x2f, 0x8b, 0x67, 0xc2, 0xa1, 0x88, 0x42, 0xff, 0x94, 0x6c, 0xc1, 0xaa,
+	0x36, 0x46, 0xa4, 0xe1, 0x3b, 0xcc, 0xbb, 0x3f, 0xa5, 0x61, 0x14, 0xde, 0x65, 0xe6, 0xc0, 0x6d,
+	0xb9, 0x2b, 0x71, 0xc8, 0x07, 0x9a, 0xf3, 0xad, 0x8c, 0x41, 0xae, 0x40, 0x5b, 0xdb, 0x23, 0x46,
+	0xf7, 0x3c, 0xc1, 0x99, 0x9c, 0x72, 0xb4, 0xab, 0xe5, 0x36, 0x63, 0x7a, 0x32, 0x18, 0xdd, 0x1b,
+	0x20, 0x46, 0xae, 0xc1, 0x1a, 0x4a, 0xe1, 0xac, 0x31, 0x93, 0x63, 0x16, 0x68, 0x95, 0x6e, 0xd1,
+	0x4e, 0x4b, 0x4f, 0x70, 0xda, 0x03, 0xe4, 0x0c, 0x46, 0xf7, 0xc8, 0x15, 0x28, 0x1f, 0x87, 0x5c,
+	0xa5, 0xdd, 0xd2, 0x46, 0x71, 0xb3, 0xbd, 0xdd, 0x46, 0xdb, 0x91, 0xbd, 0x1f, 0x72, 0xe5, 0x1a,
+	0x26, 0x79, 0x0e, 0xb4, 0x45, 0x9e, 0xcf, 0xcd, 0x9c, 0x9e, 0x9e, 0xc3, 0x16, 0xe2, 0x76, 0x1c,
+	0xf2, 0x5d, 0x8e, 0x1a, 0x47, 0xe1, 0x3b, 0xac, 0xf7, 0x32, 0xac, 0xcd, 0x7d, 0xc5, 0x5a, 0x26,
+	0xa9, 0xce, 0xc5, 0x0d, 0x68, 0xf8, 0x33, 0x2a, 0xb5, 0xa5, 0x35, 0x0f, 0xf5, 0x5e, 0x80, 0x95,
+	0xbc, 0x66, 0x1c, 0x33, 0xae, 0x74, 0xcf, 0xe0, 0x9b, 0x61, 0xd6, 0x75, 0x58, 0xb2, 0x77, 0x00,
+	0x17, 0xe6, 0xe2, 0x2e, 0xd3, 0xdb, 0x18, 0x87, 0xfa, 0x60, 0x11, 0x51, 0x60, 0xf6, 0xb5, 0xd5,
+	0x11, 0x51, 0x80, 0xdb, 0xfa, 0x71, 0xa8, 0x71, 0xf6, 0xd0, 0xb0, 0xcc, 0xc9, 0x56, 0xe5, 0xec,
+	0xa1, 0x66, 0xf5, 0x38, 0xac, 0x9e, 0x9d, 0x6e, 0x57, 0x44, 0xff, 0xd9, 0x64, 0xfa, 0x68, 0x4f,
+	0x75, 0xc7, 0xc5, 0x7d, 0xe6, 0xe9, 0x3a, 0x65, 0xc2, 0xdf, 0xc8, 0xb0, 0xdb, 0xd3, 0xb8, 0x17,
+	0xe4, 0xd7, 0xbb, 0x1e, 0x04, 0xbb, 0x22, 0x9a, 0xc6, 0x9c, 0x5c, 0x81, 0x8a, 0x8f, 0x23, 0x9b,
+	0xa3, 0x4d, 0xd3, 0x68, 0xec, 0x8a, 0x68, 0x8f, 0xdd, 0x75, 0x2d, 0x8f, 0x3c, 0x0b, 0x9d, 0x10,
+	0x8f, 0x13, 0x2f, 0x11, 0x29, 0xd6, 0x59, 0xb4, 0xa0, 0xec, 0xb6, 0x0d, 0x7c, 0x68, 0xd1, 0xc5,
+	0xaf, 0xe1, 0xb2, 0x24, 0xa2, 0x3e, 0xdb, 0x63, 0x77, 0xc9, 0x06, 0x14, 0x03, 0x76, 0xd7, 0xae,
+	0xd1, 0xb6, 0xcd, 0x8c, 0x96, 0xd1, 0xab, 0x68, 0x56, 0xef, 0x3b, 0xd0, 0xcd, 0x7f, 0x0d, 0xbd,
+	0xec, 0xae, 0x88, 0x13, 0x6c, 0xe4, 0x76, 0xa0, 0x63, 0x0c, 0xf1, 0x7c, 0x0b, 0xd9, 0xf2, 0xb2,
+	0x8a, 0xe9, 0xb3, 0x28, 0x6d, 0x9b, 0x8c, 0xb6, 0xbf, 0x80, 0xf6, 0xde, 0xce, 0x5b, 0xb6, 0x27,
+	0x45, 0x62, 0x03, 0x70, 0x19, 0x1a, 0x91, 0x18, 0x87, 0x3e, 0x8d, 0xbc, 0x30, 0x38, 0xb1, 0xfb,
+	0x01, 0x2c, 0xd4, 0x0f, 0x4e, 0x1e, 0x89, 0xed, 0xf2, 0xa3, 0xb1, 0xfd, 0x79, 0x19, 0x5a, 0x79,
+	0xb7, 0xef, 0x2f, 0x14, 0x9b, 0xc2, 0x62, 0xb1, 0x99, 0xf5, 0x3a, 0xcb, 0xb9, 0x5e, 0xa7, 0x07,
+	0xa5, 0x49, 0xc8, 0x4d, 0xe9, 0xc9, 0x76, 0x05, 0xce, 0xf8, 0xcd, 0x90, 0x07, 0x2e, 0xf2, 0xc8,
+	0xd7, 0x01, 0x68, 0x10, 0x78, 0xf6, 0x73, 0x95, 0x30, 0x94, 0xdd, 0xb9, 0xe4, 0xe2, 0x87, 0xdd,
+	0x5f, 0x72, 0xeb, 0x34, 0x23, 0xc8, 0xab, 0xd0, 0x08, 0xa4, 0x48, 0x32, 0xdd, 0x32, 0xea, 0x3e,
+	0x7e, 0x46, 0x77, 0x1e, 0x94, 0xfd, 0x25, 0x17, 0x82, 0x19, 0x45, 0x5e, 0x83, 0xa6, 0xc4, 0x04,
+	0xf5, 0x4c, 0xdb, 0x51, 0x41, 0xf5, 0xf5, 0x33, 0xea, 0xb9, 0x2d, 0xb1, 0xbf, 0xe4, 0x36, 0xe4,
+	0x9c, 0x24, 0xaf, 0x41, 0x7b, 0x8a, 0x55, 0xc7, 0xcb, 0xf6, 0x96, 0x29, 0x74, 0x17, 0xcf, 0x4c,
+	0x61, 0x37, 0xe1, 0xfe, 0x92, 0xdb, 0x32, 0xf2, 0x16, 0xd0, 0xf6, 0x67, 0x13, 0xa4, 0x4a, 0x76,
+	0x6b, 0xe7, 0xda, 0x3f, 0xdf, 0xfc, 0xda, 0x7e, 0x3b, 0x41, 0xaa, 0x24, 0x79, 0x15, 0xec, 0x74,
+	0x5e, 0x82, 0x67, 0x61, 0xb7, 0x8e, 0xfa, 0x17, 0xce, 0xe8, 0x9b, 0x83, 0x72, 0x7f, 0xc9, 0x6d,
+	0x1a, 0x69, 0x43, 0xeb, 0xb0, 0x5b, 0xef, 0x7d, 0x11, 0x75, 0x1b, 0xe7, 0x86, 0x7d, 0xb6, 0x7f,
+	0x75, 0xd8, 0x65, 0x46, 0x68, 0xb3, 0xa5, 0xd9, 0x03, 0x9e, 0xce, 0xfe, 0xe6, 0xb9, 0x66, 0xcf,
+	0x77, 0x89, 0x36, 0x5b, 0xce, 0x28, 0x72, 0x07, 0x2e, 0xce, 0xa2, 0xb6, 0x98, 0xfc, 0x2d, 0x9c,
+	0xe8, 0xc9, 0x47, 0xfc, 0xcf, 0x27, 0xfc, 0xfe, 0x92, 0xbb, 0x96, 0x05, 0x71, 0x61, 0x7b, 0x34,
+	0xa0, 0x2e, 0x12, 0x26, 0xb1, 0xe1, 0xeb, 0xfd, 0xa4, 0x0c, 0x8d, 0x23, 0xff, 0x98, 0xc5, 0xf4,
+	0xf5, 0x13, 0x25, 0x29, 0x79, 0x06, 0x3a, 0x9c, 0x9d, 0x28, 0xbd, 0x62, 0xd6, 0xf3, 0x9a, 0x1d,
+	0xd1, 0xd2, 0xf0, 0xae, 0x88, 0x4c, 0xcf, 0x8b, 0x1d, 0x8f, 0x14, 0x49, 0xc2, 0x02, 0xcf, 0xdc,
+	0x03, 0x74, 0xb7, 0xa8, 0x3b, 0x1e, 0x03, 0x5e, 0xb7, 0x17, 0x81, 0x76, 0x66, 0xf9, 0x31, 0xe5,
+	0x63, 0x16, 0xd8, 0x2b, 0x4a, 0xcb, 0x6e, 0x4d, 0x03, 0x2e, 0x1c, 0x79, 0xa5, 0xc5, 0x23, 0xef,
+	0x33, 0x8a, 0x56, 0xf9, 0xdf, 0x2f, 0x5a, 0x95, 0x2f, 0x50, 0xb4, 0xaa, 0xff, 0xb2, 0x68, 0xd5,
+	0xbe, 0x70, 0xd1, 0xaa, 0x9f, 0x57, 0xb4, 0xb4, 0x9d, 0xa3, 0x48, 0xf8, 0x13, 0x4f, 0xdb, 0x21,
+	0xc5, 0xc3, 0xb4, 0x0b, 0xc6, 0x4e, 0x44, 0x0f, 0xe8, 0x89, 0x2b, 0x1e, 0xa6, 0xe4, 0x2a, 0xac,
+	0x08, 0xec, 0xb4, 0x50, 0x0c, 0x59, 0x29, 0x26, 0x60, 0xcb, 0xed, 0x18, 0xc6, 0x01, 0x3d, 0xd9,
+	0x41, 0x58, 0x97, 0xbb, 0x1b, 0x8c, 0xaa, 0xa9, 0x64, 0x37, 0x22, 0x3a, 0xc6, 0x54, 0x2b, 0xb9,
+	0x79, 0x48, 0x4b, 0xf4, 0x79, 0xc0, 0x4e, 0x30, 0x57, 0x74, 0x0e, 0x15, 0xb5, 0x44, 0x0e, 0x22,
+	0x57, 0xa0, 0x75, 0x48, 0x25, 0xe3, 0xca, 0xde, 0x25, 0xba, 0x6d, 0x9c, 0x65, 0x11, 0x24, 0x9b,
+	0xd0, 0xb9, 0x21, 0x45, 0x7c, 0x38, 0x1d, 0xe9, 0xeb, 0x32, 0xd6, 0x82, 0x0e, 0x7e, 0xd6, 0xb3,
+	0xf0, 0x79, 0xc7, 0xb6, 0xf3, 0x45, 0x8f, 0xed, 0x43, 0x68, 0x2f, 0xca, 0x91, 0x8b, 0x50, 0x59,
+	0xc8, 0x4c, 0x4b, 0xe9, 0x1b, 0x2c, 0x8d, 0xc6, 0xf6, 0x78, 0xd6, 0x43, 0xb2, 0x06, 0xe5, 0x88,
+	0x3d, 0x60, 0x11, 0xa6, 0x5d, 0xd9, 0x35, 0x44, 0x2f, 0x80, 0x5a, 0x9f, 0xab, 0xaf, 0xbe, 0x74,
+	0x40, 0x13, 0xd2, 0x83, 0x42, 0x6c, 0x4b, 0x89, 0xb9, 0x3f, 0x64, 0x9c, 0xad, 0x03, 0x73, 0x67,
+	0x29, 0xc4, 0xeb, 0x2f, 0x41, 0xc5, 0x10, 0x7a, 0x85, 0x09, 0x3b, 0xc5, 0x65, 0x8b, 0xae, 0x1e,
+	0xea, 0x15, 0x1e, 0xd0, 0x68, 0x6a, 0xea, 0x71, 0xd1, 0x35, 0xc4, 0x2b, 0xcb, 0x2f, 0x17, 0x7a,
+	0x6f, 0x42, 0x73, 0x28, 0x29, 0x4f, 0xf7, 0x58, 0xaa, 0xab, 0xa3, 0xb6, 0x5a, 0x8c, 0xee, 0xf5,
+	0x6d, 0x85, 0x29, 0xbb, 0x96, 0xd2, 0xf8, 0x28, 0x9a, 0x68, 0xdc, 0x14, 0x54, 0x4b, 0x69, 0x5c,
+	0x8a, 0x87, 0x1a, 0x37, 0xc6, 0x5b, 0xaa, 0xf7, 0xdd, 0x02, 0x34, 0x76, 0xa2, 0x09, 0xce, 0xad,
+	0x3d, 0x78, 0x7e, 0xee, 0xc1, 0x63, 0xa6, 0x0f, 0x9c, 0x33, 0xad, 0x13, 0x36, 0xb2, 0x85, 0x78,
+	0xfd, 0xe6, 0x79, 0xae, 0x94, 0x8d, 0x2b, 0xcf, 0xe6, 0x5d, 0x69, 0x6c, 0xaf, 0x98, 0x4b, 0x65,
+	0xce, 0x85, 0xbc, 0x77, 0xfb, 0x40, 0xb2, 0x75, 0xee, 0x32, 0xb9, 0x23, 0xc4, 0x24, 0xe4, 0x63,
+	0xb2, 0x0d, 0xb5, 0x98, 0x26, 0x49, 0xc8, 0xc7, 0x59, 0x7d, 0x76, 0xce, 0x9a, 0x64, 0x6d, 0x99,
+	0xc9, 0xf5, 0x3e, 0x5a, 0x06, 0x07, 0xf7, 0xc5, 0x2e, 0x5e, 0x26, 0x8d, 0x75, 0xe7, 0x3e, 0x07,
+	0x5c, 0x80, 0x8a, 0x1a, 0x45, 0xf3, 0xc2, 0x59, 0x56, 0xa3, 0xe8, 0x91, 0xab, 0x59, 0xf1, 0xec,
+	0xd5, 0xec, 0x2b, 0x50, 0x4b, 0x15, 0x95, 0xca, 0xc3, 0x96, 0xf3, 0x33, 0x1b, 0x6b, 0x6b, 0x57,
+	0x15, 0x65, 0x87, 0xa9, 0xee, 0x0a, 0xe6, 0x07, 0x43, 0xda, 0x2d, 0x6f, 0x14, 0x37, 0x9b, 0x2e,
+	0xc4, 0xd9, 0x89, 0x90, 0xe2, 0x65, 0x5a, 0x32, 0xaa, 0x32, 0x89, 0x0a, 0x4a, 0x34, 0x2c, 0x86,
+	0x22, 0xff, 0x0f, 0xd5, 0x91, 0x89, 0x8c, 0x2d, 0x77, 0x8b, 0x1f, 0x68, 0x1e, 0x38, 0x37, 0x93,
+	0xd3, 0xcb, 0xda, 0xa1, 0xbe, 0xa6, 0xe3, 0x71, 0x53, 0x77, 0xc1, 0x42, 0xb7, 0x84, 0xaf, 0xbf,
+	0x1b, 0x93, 0x12, 0x4f, 0x95, 0xba, 0xab, 0x87, 0xf3, 0x24, 0x87, 0x7c, 0x92, 0xff, 0x78, 0x19,
+	0xda, 0x18, 0xd6, 0x21, 0x4d, 0x27, 0xff, 0xf5, 0xa0, 0xe6, 0x9e, 0x52, 0x4a, 0x0b, 0x4f, 0x29,
+	0x3d, 0x68, 0x29, 0x61, 0x8f, 0xbf, 0x5c, 0xe0, 0x1a, 0x4a, 0xa0, 0x31, 0x18, 0x96, 0x2d, 0x58,
+	0x65, 0xa9, 0x0a, 0x63, 0x8c, 0x5d, 0xcc, 0x62, 0x6f, 0x9a, 0xd2, 0xb1, 0x69, 0x2a, 0x4a, 0xee,
+	0xca, 0x8c, 0x75, 0xc0, 0xe2, 0x3b, 0x9a, 0xa1, 0x6d, 0xa1, 0xbe, 0x2f, 0xa6, 0x5c, 0x69, 0x33,
+	0xcd, 0x19, 0x5d, 0xb7, 0x88, 0x79, 0xd6, 0x99, 0xa6, 0x4c, 0x6a, 0x5e, 0xcd, 0x9c, 0x07, 0x9a,
+	0x34, 0x0c, 0x29, 0x4c, 0x07, 0x56, 0x37, 0x0c, 0x4d, 0xf6, 0x83, 0xde, 0x6d, 0x68, 0xcf, 0xef,
+	0xac, 0xf8, 0x32, 0xb2, 0x0e, 0xb5, 0x5b, 0x8b, 0xaf, 0x22, 0x33, 0x5a, 0x1f, 0x9b, 0x4a, 0x4e,
+	0xb9, 0x4f, 0x15, 0xbb, 0x95, 0x72, 0x1b, 0xa6, 0x3c, 0x74, 0xf5, 0x07, 0x45, 0xa8, 0x0c, 0x92,
+	0x5d, 0x11, 0x30, 0x52, 0x85, 0xe2, 0x6d, 0x91, 0x38, 0x4b, 0x64, 0x05, 0x9a, 0x83, 0xe4, 0x26,
+	0x53, 0xf6, 0xfd, 0xc5, 0xf9, 0x5b, 0x95, 0x38, 0xd0, 0x18, 0x24, 0x87, 0xd2, 0x26, 0xba, 0xf3,
+	0xf7, 0x2a, 0x69, 0x68, 0x3d, 0xfd, 0xe6, 0xe9, 0x7c, 0xd0, 0x21, 0x4d, 0xa8, 0x0e, 0x92, 0x1b,
+	0xd1, 0x34, 0x3d, 0x76, 0x3e, 0xec, 0x18, 0xfd, 0xb9, 0x95, 0xce, 0x2f, 0x3b, 0xa4, 0x0d, 0xf5,
+	0x41, 0xd2, 0xe7, 0x69, 0xa2, 0xaf, 0xde, 0x1f, 0x75, 0xc8, 0x1a, 0x74, 0x06, 0xc9, 0xf5, 0x20,
+	0xb8, 0x41, 0xa7, 0x91, 0x3a, 0x44, 0xa9, 0x5f, 0x75, 0x48, 0x0b, 0x6a, 0x83, 0x64, 0x87, 0xfa,
+	0x93, 0x69, 0xe2, 0xfc, 0xba, 0x63, 0x16, 0x1d, 0x4a, 0xea, 0xb3, 0xa3, 0x84, 0x72, 0xe7, 0x37,
+	0x1d, 0xb2, 0x0a, 0xed, 0x41, 0x72, 0xa4, 0x84, 0xa4, 0x63, 0x86, 0x01, 0x76, 0x7e, 0xdb, 0x21,
+	0x8f, 0x01, 0x19, 0x24, 0x37, 0x23, 0x31, 0xa2, 0x51, 0x6e, 0xd1, 0xdf, 0x75, 0xc8, 0x45, 0x58,
+	0xd1, 0x8b, 0x2a, 0x26, 0x7d, 0x96, 0x28, 0x6b, 0xfa, 0xef, 0x3b, 0x84, 0x40, 0x6b, 0x90, 0x18,
+	0x12, 0xbf, 0xac, 0xf3, 0x07, 0x2b, 0xbb, 0x17, 0xa6, 0x13, 0xfd, 0xdb, 0x8d, 0x18, 0xe5, 0x4c,
+	0x3a, 0x7f, 0xb4, 0x26, 0xb9, 0x8c, 0x06, 0x4c, 0x3a, 0x7f, 0xea, 0x90, 0x75, 0xb8, 0x60, 0x42,
+	0x43, 0x15, 0x4b, 0x55, 0x6e, 0xb9, 0x8f, 0x33, 0xe3, 0x38, 0x4d, 0xd2, 0x63, 0xa1, 0xb4, 0x8a,
+	0xf3, 0xe7, 0xb9, 0x82, 0xed, 0x17, 0xb0, 0x0e, 0xe9, 0x57, 0x24, 0xe7, 0x13, 0x6b, 0x07, 0x46,
+	0xa0, 0xcf, 0xf1, 0x4d, 0xe2, 0x2f, 0x9d, 0xab, 0x1f, 0x16, 0xa0, 0x3e, 0xeb, 0x9d, 0x49, 0x03,
+	0xaa, 0x7d, 0xfe, 0x80, 0x46, 0x61, 0xe0, 0x2c, 0x91, 0x16, 0xd4, 0x67, 0x1d, 0xb2, 0x53, 0xc0,
+	0x37, 0x8e, 0x59, 0x9b, 0xeb, 0x2c, 0x93, 0x0e, 0x34, 0x72, 0x5d, 0xac, 0x79, 0x17, 0xb9, 0x93,
+	0x6f, 0x44, 0x9d, 0x12, 0x59, 0x03, 0x27, 0x83, 0xb2, 0x76, 0xd3, 0x29, 0x13, 0x07, 0x9a, 0x77,
+	0x72, 0x4d, 0xa3, 0x53, 0xd1, 0xc8, 0xf5, 0x20, 0x38, 0xcc, 0xde, 0x25, 0x1d, 0x9d, 0x00, 0xcd,
+	0x59, 0x9f, 0xa8, 0xd7, 0xab, 0xe9, 0xf5, 0xe7, 0xdd, 0x9f, 0x53, 0x27, 0x5d, 0x58, 0xbb, 0x73,
+	0x4e, 0xcb, 0xe6, 0xc0, 0xd5, 0x9b, 0x50, 0x9f, 0xf5, 0x1b, 0xa4, 0x06, 0xa5, 0xeb, 0x53, 0x25,
+	0x8c, 0x3f, 0xb7, 0x85, 0x79, 0xb2, 0x49, 0x9d, 0x02, 0x69, 0x42, 0x6d, 0x27, 0x1c, 0x1b, 0xe3,
+	0x97, 0xf5, 0x8b, 0xcd, 0xae, 0xe0, 0x2a, 0xe4, 0x53, 0x31, 0x4d, 0xf1, 0x95, 0xce, 0x29, 0xee,
+	0x7c, 0xe3, 0xfd, 0x4f, 0x2f, 0x15, 0x3e, 0xf8, 0xf4, 0x52, 0xe1, 0x93, 0x4f, 0x2f, 0x2d, 0xbd,
+	0xfb, 0xd7, 0x4b, 0x85, 0xb7, 0xff, 0x2f, 0xf7, 0xfe, 0x1f, 0x53, 0x25, 0xc3, 0x13, 0x21, 0xc3,
+	0x71, 0xc8, 0x33, 0x82, 0xb3, 0x6b, 0xc9, 0x64, 0x7c, 0x2d, 0x19, 0x5d, 0xa3, 0x49, 0x38, 0xaa,
+	0xe0, 0x43, 0xff, 0x8b, 0xff, 0x1c, 0x00, 0x9e, 0xf0, 0x59, 0x17, 0x46, 0x18, 0x00, 0x00,
 }
 
 func (m *TNPingRequest) Marshal() (dAtA []byte, err error) {
@@ -3694,6 +3839,47 @@ func (m *AlterTableReplaceDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableColumnCompress) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableColumnCompress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableColumnCompress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ColumnCompress) > 0 {
+		for iNdEx := len(m.ColumnCompress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ColumnCompress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableDropColumn) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -3950,6 +4136,27 @@ func (m *AlterTableReq_ReplaceDef) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTableReq_UpdateColumnCompress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_UpdateColumnCompress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdateColumnCompress != nil {
+		{
+			size, err := m.UpdateColumnCompress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SchemaExtra) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -3974,6 +4181,22 @@ func (m *SchemaExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ColumnCompress) > 0 {
+		for iNdEx := len(m.ColumnCompress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ColumnCompress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if m.FromPublication {
 		i--
 		if m.FromPublication {
@@ -3990,20 +4213,20 @@ func (m *SchemaExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if len(m.IndexTables) > 0 {
-		dAtA28 := make([]byte, len(m.IndexTables)*10)
-		var j27 int
+		dAtA29 := make([]byte, len(m.IndexTables)*10)
+		var j28 int
 		for _, num := range m.IndexTables {
 			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j27++
+				j28++
 			}
-			dAtA28[j27] = uint8(num)
-			j27++
+			dAtA29[j28] = uint8(num)
+			j28++
 		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintApi(dAtA, i, uint64(j27))
+		i -= j28
+		copy(dAtA[i:], dAtA29[:j28])
+		i = encodeVarintApi(dAtA, i, uint64(j28))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -4028,20 +4251,20 @@ func (m *SchemaExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if len(m.Hints) > 0 {
-		dAtA30 := make([]byte, len(m.Hints)*10)
-		var j29 int
+		dAtA31 := make([]byte, len(m.Hints)*10)
+		var j30 int
 		for _, num := range m.Hints {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j30++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA31[j30] = uint8(num)
+			j30++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintApi(dAtA, i, uint64(j29))
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintApi(dAtA, i, uint64(j30))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -4094,6 +4317,48 @@ func (m *SchemaExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ColumnCompress) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ColumnCompress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ColumnCompress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Level != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Alg != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Alg))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Seqnum != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Seqnum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Int64Map) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -4979,6 +5244,24 @@ func (m *AlterTableReplaceDef) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableColumnCompress) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ColumnCompress) > 0 {
+		for _, e := range m.ColumnCompress {
+			l = e.ProtoSize()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableDropColumn) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -5117,6 +5400,18 @@ func (m *AlterTableReq_ReplaceDef) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTableReq_UpdateColumnCompress) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateColumnCompress != nil {
+		l = m.UpdateColumnCompress.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
 func (m *SchemaExtra) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -5180,6 +5475,33 @@ func (m *SchemaExtra) ProtoSize() (n int) {
 	if m.FromPublication {
 		n += 2
 	}
+	if len(m.ColumnCompress) > 0 {
+		for _, e := range m.ColumnCompress {
+			l = e.ProtoSize()
+			n += 2 + l + sovApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ColumnCompress) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seqnum != 0 {
+		n += 1 + sovApi(uint64(m.Seqnum))
+	}
+	if m.Alg != 0 {
+		n += 1 + sovApi(uint64(m.Alg))
+	}
+	if m.Level != 0 {
+		n += 1 + sovApi(uint64(m.Level))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8233,6 +8555,91 @@ func (m *AlterTableReplaceDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlterTableColumnCompress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableColumnCompress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableColumnCompress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnCompress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnCompress = append(m.ColumnCompress, ColumnCompress{})
+			if err := m.ColumnCompress[len(m.ColumnCompress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AlterTableDropColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8688,6 +9095,41 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Operation = &AlterTableReq_ReplaceDef{v}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateColumnCompress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableColumnCompress{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_UpdateColumnCompress{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -9159,6 +9601,148 @@ func (m *SchemaExtra) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.FromPublication = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnCompress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnCompress = append(m.ColumnCompress, ColumnCompress{})
+			if err := m.ColumnCompress[len(m.ColumnCompress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ColumnCompress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColumnCompress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColumnCompress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seqnum", wireType)
+			}
+			m.Seqnum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seqnum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alg", wireType)
+			}
+			m.Alg = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Alg |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -31,16 +31,19 @@ type CompressType int32
 const (
 	CompressType_None CompressType = 0
 	CompressType_Lz4  CompressType = 1
+	CompressType_Zstd CompressType = 2
 )
 
 var CompressType_name = map[int32]string{
 	0: "None",
 	1: "Lz4",
+	2: "Zstd",
 }
 
 var CompressType_value = map[string]int32{
 	"None": 0,
 	"Lz4":  1,
+	"Zstd": 2,
 }
 
 func (x CompressType) String() string {
@@ -3277,11 +3280,13 @@ type ColDef struct {
 	TblName   string `protobuf:"bytes,17,opt,name=tbl_name,json=tblName,proto3" json:"tbl_name,omitempty"`
 	DbName    string `protobuf:"bytes,18,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// get origin_name by ColDef.GetOriginCaseName(), letter case: origin
-	OriginName           string        `protobuf:"bytes,19,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
-	GeneratedCol         *GeneratedCol `protobuf:"bytes,20,opt,name=generated_col,json=generatedCol,proto3" json:"generated_col,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	OriginName   string        `protobuf:"bytes,19,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
+	GeneratedCol *GeneratedCol `protobuf:"bytes,20,opt,name=generated_col,json=generatedCol,proto3" json:"generated_col,omitempty"`
+	// compression level of alg, 0 means the codec default
+	AlgLevel             int32    `protobuf:"varint,21,opt,name=alg_level,json=algLevel,proto3" json:"alg_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ColDef) Reset()         { *m = ColDef{} }
@@ -3457,6 +3462,13 @@ func (m *ColDef) GetGeneratedCol() *GeneratedCol {
 	return nil
 }
 
+func (m *ColDef) GetAlgLevel() int32 {
+	if m != nil {
+		return m.AlgLevel
+	}
+	return 0
+}
+
 type Default struct {
 	Expr         *Expr  `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString string `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
@@ -3577,9 +3589,12 @@ func (m *OnUpdate) GetOriginString() string {
 }
 
 type GeneratedCol struct {
-	Expr                 *Expr    `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
-	OriginString         string   `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
-	IsStored             bool     `protobuf:"varint,3,opt,name=is_stored,json=isStored,proto3" json:"is_stored,omitempty"`
+	Expr         *Expr  `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	OriginString string `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
+	IsStored     bool   `protobuf:"varint,3,opt,name=is_stored,json=isStored,proto3" json:"is_stored,omitempty"`
+	// is_async columns are written as NULL by DML and filled in by a
+	// background ISCP job.
+	IsAsync              bool     `protobuf:"varint,4,opt,name=is_async,json=isAsync,proto3" json:"is_async,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3639,6 +3654,13 @@ func (m *GeneratedCol) GetIsStored() bool {
 	return false
 }
 
+func (m *GeneratedCol) GetIsAsync() bool {
+	if m != nil {
+		return m.IsAsync
+	}
+	return false
+}
+
 type IndexOption struct {
 	CreateExtraTable     bool     `protobuf:"varint,1,opt,name=create_extra_table,json=createExtraTable,proto3" json:"create_extra_table,omitempty"`
 	ParserName           string   `protobuf:"bytes,2,opt,name=parser_name,json=parserName,proto3" json:"parser_name,omitempty"`
@@ -13707,827 +13729,821 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 13105 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0xbd, 0x5d, 0x8c, 0x1b, 0x57,
-	0x96, 0x18, 0xdc, 0x6c, 0x92, 0x4d, 0xf2, 0xf0, 0xa7, 0xab, 0xaf, 0x5a, 0x12, 0x25, 0xcb, 0x52,
-	0xbb, 0x24, 0xcb, 0xb2, 0x6c, 0xcb, 0xb6, 0xe4, 0x1f, 0x79, 0x76, 0x76, 0x67, 0xd8, 0x24, 0x5b,
-	0x4d, 0x9b, 0x4d, 0xf6, 0x14, 0xd9, 0x92, 0x3d, 0xfb, 0x2d, 0x0a, 0x45, 0x56, 0xb1, 0xbb, 0xdc,
-	0xc5, 0x2a, 0xba, 0xaa, 0xa8, 0xee, 0x1e, 0x60, 0xf1, 0x0d, 0xbe, 0x87, 0xfd, 0xf9, 0x5e, 0x83,
-	0x6c, 0x5e, 0xb2, 0xc8, 0x26, 0x40, 0x10, 0x20, 0x48, 0x10, 0x24, 0x18, 0x60, 0x77, 0xdf, 0xb2,
-	0x08, 0x10, 0x6c, 0x10, 0x64, 0xb3, 0x40, 0x90, 0x04, 0x9b, 0x00, 0x9b, 0x64, 0xf2, 0x1c, 0x24,
-	0x40, 0xf2, 0x9e, 0xe0, 0x9c, 0x7b, 0xab, 0xea, 0x16, 0x49, 0x59, 0xb6, 0x67, 0x16, 0xc8, 0x4b,
-	0x77, 0xdd, 0xf3, 0x73, 0xff, 0xef, 0xb9, 0xe7, 0x9c, 0x7b, 0xee, 0x25, 0xc0, 0xcc, 0x31, 0xdc,
-	0x07, 0x33, 0xdf, 0x0b, 0x3d, 0x96, 0xc3, 0xef, 0xeb, 0xef, 0x1c, 0xdb, 0xe1, 0xc9, 0x7c, 0xf4,
-	0x60, 0xec, 0x4d, 0xdf, 0x3d, 0xf6, 0x8e, 0xbd, 0x77, 0x09, 0x39, 0x9a, 0x4f, 0x28, 0x45, 0x09,
-	0xfa, 0xe2, 0x4c, 0xd7, 0xc1, 0xf1, 0xc6, 0xa7, 0xe2, 0x7b, 0x33, 0xb4, 0xa7, 0x56, 0x10, 0x1a,
-	0xd3, 0x19, 0x07, 0xa8, 0x7f, 0x98, 0x81, 0xdc, 0xf0, 0x62, 0x66, 0xb1, 0x1a, 0xac, 0xdb, 0x66,
-	0x3d, 0xb3, 0x93, 0xb9, 0x97, 0xd7, 0xd6, 0x6d, 0x93, 0xed, 0x40, 0xd9, 0xf5, 0xc2, 0xde, 0xdc,
-	0x71, 0x8c, 0x91, 0x63, 0xd5, 0xd7, 0x77, 0x32, 0xf7, 0x8a, 0x9a, 0x0c, 0x62, 0xaf, 0x40, 0xc9,
-	0x98, 0x87, 0x9e, 0x6e, 0xbb, 0x63, 0xbf, 0x9e, 0x25, 0x7c, 0x11, 0x01, 0x1d, 0x77, 0xec, 0xb3,
-	0x6d, 0xc8, 0x9f, 0xd9, 0x66, 0x78, 0x52, 0xcf, 0x51, 0x8e, 0x3c, 0x81, 0xd0, 0x60, 0x6c, 0x38,
-	0x56, 0x3d, 0xcf, 0xa1, 0x94, 0x40, 0x68, 0x48, 0x85, 0x6c, 0xec, 0x64, 0xee, 0x95, 0x34, 0x9e,
-	0x60, 0x37, 0x01, 0x2c, 0x77, 0x3e, 0x7d, 0x6e, 0x38, 0x73, 0x2b, 0xa8, 0x17, 0x08, 0x25, 0x41,
-	0xd4, 0x1f, 0x40, 0x69, 0x1a, 0x1c, 0xef, 0x5b, 0x86, 0x69, 0xf9, 0xec, 0x2a, 0x14, 0xa6, 0xc1,
-	0xb1, 0x1e, 0x1a, 0xc7, 0xa2, 0x09, 0x1b, 0xd3, 0xe0, 0x78, 0x68, 0x1c, 0xb3, 0x6b, 0x50, 0x24,
-	0xc4, 0xc5, 0x8c, 0xb7, 0x21, 0xaf, 0x21, 0x21, 0xb6, 0x58, 0xfd, 0xdd, 0x0d, 0x28, 0x74, 0xed,
-	0xd0, 0xf2, 0x0d, 0x87, 0x5d, 0x81, 0x0d, 0x3b, 0x70, 0xe7, 0x8e, 0x43, 0xec, 0x45, 0x4d, 0xa4,
-	0xd8, 0x15, 0xc8, 0xdb, 0x8f, 0x9f, 0x1b, 0x0e, 0xe7, 0xdd, 0x5f, 0xd3, 0x78, 0x92, 0xd5, 0x61,
-	0xc3, 0x7e, 0xff, 0x23, 0x44, 0x64, 0x05, 0x42, 0xa4, 0x09, 0xf3, 0xe8, 0x21, 0x62, 0x72, 0x31,
-	0x86, 0xd2, 0x84, 0xf9, 0xe8, 0x03, 0xc4, 0x60, 0xeb, 0xb3, 0x84, 0xa1, 0x34, 0x96, 0x32, 0xa7,
-	0x52, 0xb0, 0x03, 0xaa, 0x58, 0xca, 0x3c, 0x2a, 0x65, 0xce, 0x4b, 0x29, 0x08, 0x84, 0x48, 0x13,
-	0x86, 0x97, 0x52, 0x8c, 0x31, 0x71, 0x29, 0x73, 0x5e, 0x4a, 0x69, 0x27, 0x73, 0x2f, 0x47, 0x18,
-	0x5e, 0xca, 0x36, 0xe4, 0x4c, 0x84, 0xc3, 0x4e, 0xe6, 0x5e, 0x66, 0x7f, 0x4d, 0xa3, 0x14, 0x42,
-	0x03, 0x84, 0x96, 0xb1, 0x83, 0x11, 0x1a, 0x08, 0xe8, 0x08, 0xa1, 0x15, 0xec, 0x0d, 0x84, 0x8e,
-	0x04, 0x74, 0x82, 0xd0, 0xea, 0x4e, 0xe6, 0xde, 0x3a, 0x42, 0x31, 0xc5, 0xae, 0x43, 0xc1, 0x34,
-	0x42, 0x0b, 0x11, 0x35, 0xd1, 0xe4, 0x08, 0x80, 0x38, 0x9c, 0x71, 0x88, 0xdb, 0x14, 0x8d, 0x8e,
-	0x00, 0x4c, 0x85, 0x32, 0x92, 0x45, 0x78, 0x45, 0xe0, 0x65, 0x20, 0xfb, 0x10, 0x2a, 0xa6, 0x35,
-	0xb6, 0xa7, 0x86, 0xc3, 0xdb, 0xb4, 0xb5, 0x93, 0xb9, 0x57, 0x7e, 0xb8, 0xf9, 0x80, 0xd6, 0x44,
-	0x8c, 0xd9, 0x5f, 0xd3, 0x52, 0x64, 0xec, 0x31, 0x54, 0x45, 0xfa, 0xfd, 0x87, 0xd4, 0xb1, 0x8c,
-	0xf8, 0x94, 0x14, 0xdf, 0xfb, 0x0f, 0x1f, 0xef, 0xaf, 0x69, 0x69, 0x42, 0x76, 0x07, 0x2a, 0xf1,
-	0x12, 0x41, 0xc6, 0x4b, 0xa2, 0x56, 0x29, 0x28, 0x36, 0xeb, 0xcb, 0xc0, 0x73, 0x91, 0x60, 0x5b,
-	0xf4, 0x5b, 0x04, 0x60, 0x3b, 0x00, 0xa6, 0x35, 0x31, 0xe6, 0x4e, 0x88, 0xe8, 0xcb, 0xa2, 0x03,
-	0x25, 0x18, 0xbb, 0x09, 0xa5, 0xf9, 0x0c, 0x5b, 0xf9, 0xd4, 0x70, 0xea, 0x57, 0x04, 0x41, 0x02,
-	0xc2, 0xdc, 0x71, 0x9e, 0x23, 0xf6, 0xaa, 0x18, 0xdd, 0x08, 0x80, 0xc3, 0xfb, 0xdc, 0x1a, 0x23,
-	0xaa, 0x2e, 0x0a, 0x16, 0x69, 0x5c, 0x45, 0x76, 0xb0, 0x6b, 0xbb, 0xf5, 0x6b, 0x34, 0x83, 0x79,
-	0x82, 0xdd, 0x80, 0x6c, 0xe0, 0x8f, 0xeb, 0xd7, 0xa9, 0xfd, 0xc0, 0xdb, 0xdf, 0x3e, 0x9f, 0xf9,
-	0x1a, 0x82, 0x77, 0x0b, 0x90, 0xa7, 0xd5, 0xa4, 0xde, 0x80, 0xe2, 0xa1, 0xe1, 0x1b, 0x53, 0xcd,
-	0x9a, 0x30, 0x05, 0xb2, 0x33, 0x2f, 0x10, 0xeb, 0x08, 0x3f, 0xd5, 0x2e, 0x6c, 0x3c, 0x35, 0x7c,
-	0xc4, 0x31, 0xc8, 0xb9, 0xc6, 0xd4, 0x22, 0x64, 0x49, 0xa3, 0x6f, 0x5c, 0x3b, 0xc1, 0x45, 0x10,
-	0x5a, 0x53, 0x21, 0x24, 0x44, 0x0a, 0xe1, 0xc7, 0x8e, 0x37, 0x12, 0x6b, 0xa4, 0xa8, 0x89, 0x94,
-	0xfa, 0xff, 0x65, 0x60, 0xa3, 0xe9, 0x39, 0x98, 0xdd, 0x55, 0x28, 0xf8, 0x96, 0xa3, 0x27, 0xc5,
-	0x6d, 0xf8, 0x96, 0x73, 0xe8, 0x05, 0x88, 0x18, 0x7b, 0x1c, 0xc1, 0x57, 0xed, 0xc6, 0xd8, 0x23,
-	0x44, 0x54, 0x81, 0xac, 0x54, 0x81, 0x6b, 0x50, 0x0c, 0x47, 0x8e, 0x4e, 0xf0, 0x1c, 0xc1, 0x0b,
-	0xe1, 0xc8, 0xe9, 0x21, 0xea, 0x2a, 0x14, 0xcc, 0x11, 0xc7, 0xe4, 0x09, 0xb3, 0x61, 0x8e, 0x10,
-	0xa1, 0x7e, 0x02, 0x25, 0xcd, 0x38, 0x13, 0xd5, 0xb8, 0x0c, 0x1b, 0x98, 0x81, 0x90, 0x7f, 0x39,
-	0x2d, 0x1f, 0x8e, 0x9c, 0x8e, 0x89, 0x60, 0xac, 0x84, 0x6d, 0x52, 0x1d, 0x72, 0x5a, 0x7e, 0xec,
-	0x39, 0x1d, 0x53, 0x1d, 0x02, 0x34, 0x3d, 0xdf, 0xff, 0xce, 0x4d, 0xd8, 0x86, 0xbc, 0x69, 0xcd,
-	0xc2, 0x13, 0x2e, 0x3a, 0x34, 0x9e, 0x50, 0xef, 0x43, 0x11, 0xc7, 0xa5, 0x6b, 0x07, 0x21, 0xbb,
-	0x09, 0x39, 0xc7, 0x0e, 0xc2, 0x7a, 0x66, 0x27, 0xbb, 0x30, 0x6a, 0x04, 0x57, 0x77, 0xa0, 0x78,
-	0x60, 0x9c, 0x3f, 0xc5, 0x91, 0xc3, 0xdc, 0x68, 0x08, 0xc5, 0x90, 0x88, 0xf1, 0xac, 0x00, 0x0c,
-	0x0d, 0xff, 0xd8, 0x0a, 0x49, 0xd2, 0xfd, 0xcf, 0x0c, 0x94, 0x07, 0xf3, 0xd1, 0x57, 0x73, 0xcb,
-	0xbf, 0xc0, 0x3a, 0xdf, 0x83, 0x6c, 0x78, 0x31, 0x23, 0x8e, 0xda, 0xc3, 0x2b, 0x3c, 0x7b, 0x09,
-	0xff, 0x00, 0x99, 0x34, 0x24, 0xc1, 0x46, 0xb8, 0x9e, 0x69, 0x45, 0x7d, 0x90, 0xd7, 0x36, 0x30,
-	0xd9, 0x31, 0x71, 0xbb, 0xf0, 0x66, 0x62, 0x14, 0xd6, 0xbd, 0x19, 0xdb, 0x81, 0xfc, 0xf8, 0xc4,
-	0x76, 0x4c, 0x1a, 0x80, 0x74, 0x9d, 0x39, 0x02, 0x47, 0xc9, 0xf7, 0xce, 0xf4, 0xc0, 0xfe, 0x49,
-	0x24, 0xfe, 0x0b, 0xbe, 0x77, 0x36, 0xb0, 0x7f, 0x62, 0xa9, 0x43, 0xb1, 0x07, 0x01, 0x6c, 0x0c,
-	0x9a, 0x8d, 0x6e, 0x43, 0x53, 0xd6, 0xf0, 0xbb, 0xfd, 0x79, 0x67, 0x30, 0x1c, 0x28, 0x19, 0x56,
-	0x03, 0xe8, 0xf5, 0x87, 0xba, 0x48, 0xaf, 0xb3, 0x0d, 0x58, 0xef, 0xf4, 0x94, 0x2c, 0xd2, 0x20,
-	0xbc, 0xd3, 0x53, 0x72, 0xac, 0x00, 0xd9, 0x46, 0xef, 0x0b, 0x25, 0x4f, 0x1f, 0xdd, 0xae, 0xb2,
-	0xa1, 0xfe, 0xd9, 0x3a, 0x94, 0xfa, 0xa3, 0x2f, 0xad, 0x71, 0x88, 0x6d, 0xc6, 0x59, 0x6a, 0xf9,
-	0xcf, 0x2d, 0x9f, 0x9a, 0x9d, 0xd5, 0x44, 0x0a, 0x1b, 0x62, 0x8e, 0xa8, 0x71, 0x59, 0x6d, 0xdd,
-	0x1c, 0x11, 0xdd, 0xf8, 0xc4, 0x9a, 0x1a, 0xd4, 0x38, 0xa4, 0xa3, 0x14, 0xae, 0x0a, 0x6f, 0xf4,
-	0x25, 0x35, 0x2f, 0xab, 0xe1, 0x27, 0xbb, 0x05, 0x65, 0x9e, 0x87, 0x3c, 0xbf, 0x80, 0x83, 0x16,
-	0x27, 0xdf, 0x86, 0x3c, 0xf9, 0x88, 0x93, 0x72, 0xe5, 0x48, 0xb1, 0xb7, 0x71, 0x50, 0x4f, 0xcc,
-	0x68, 0x6f, 0xf4, 0x25, 0xc7, 0x16, 0xf9, 0x8c, 0xf6, 0x46, 0x5f, 0x12, 0xea, 0x2d, 0xd8, 0x0a,
-	0xe6, 0xa3, 0x60, 0xec, 0xdb, 0xb3, 0xd0, 0xf6, 0x5c, 0x4e, 0x53, 0x22, 0x1a, 0x45, 0x46, 0x10,
-	0xf1, 0x3d, 0x28, 0xce, 0xe6, 0x23, 0xdd, 0x76, 0x27, 0x1e, 0x89, 0xfd, 0xf2, 0xc3, 0x2a, 0x1f,
-	0x98, 0xc3, 0xf9, 0xa8, 0xe3, 0x4e, 0x3c, 0xad, 0x30, 0xe3, 0x1f, 0x4c, 0x85, 0xaa, 0xeb, 0x85,
-	0x3a, 0xaa, 0x0a, 0xfa, 0xd4, 0x0a, 0x0d, 0xda, 0x0f, 0xf8, 0x86, 0xdf, 0xf5, 0xc6, 0xa7, 0x07,
-	0x56, 0x68, 0xa8, 0x77, 0xa1, 0x20, 0xf8, 0x70, 0xef, 0x0f, 0x2d, 0xd7, 0x70, 0x43, 0x3d, 0x56,
-	0x1a, 0x8a, 0x1c, 0xd0, 0x31, 0xd5, 0x9f, 0x65, 0x40, 0x19, 0x48, 0x55, 0x41, 0xe6, 0x95, 0x92,
-	0xe3, 0x55, 0x00, 0x63, 0x3c, 0xf6, 0xe6, 0x3c, 0x1b, 0x3e, 0xc1, 0x4a, 0x02, 0xd2, 0x31, 0xe5,
-	0xfe, 0xcb, 0xa6, 0xfa, 0xef, 0x35, 0xa8, 0x44, 0x7c, 0xd2, 0xa2, 0x2f, 0x0b, 0x58, 0xd4, 0x83,
-	0xc1, 0x3c, 0xb5, 0xf2, 0x0b, 0xc1, 0x9c, 0x73, 0x5f, 0x81, 0x0d, 0xd2, 0x30, 0x82, 0x68, 0x54,
-	0x78, 0x4a, 0xfd, 0xf7, 0x19, 0xa8, 0x76, 0x5c, 0xd3, 0x3a, 0x1f, 0x8c, 0x0d, 0x37, 0xea, 0x14,
-	0x3b, 0xd0, 0x6d, 0x84, 0xe9, 0xc1, 0xd8, 0x70, 0x85, 0x72, 0x50, 0xb6, 0x83, 0x98, 0x0e, 0xdb,
-	0xc0, 0x09, 0xa8, 0xa8, 0x75, 0xca, 0xb1, 0x44, 0x10, 0x2a, 0xec, 0x2e, 0x6c, 0x8e, 0x2c, 0xc7,
-	0x73, 0x8f, 0xf5, 0xd0, 0xd3, 0xb9, 0x96, 0xc3, 0xdb, 0x52, 0xe5, 0xe0, 0xa1, 0x37, 0x24, 0x6d,
-	0x67, 0x1b, 0xf2, 0x33, 0xc3, 0x0f, 0x83, 0x7a, 0x6e, 0x27, 0x8b, 0xcb, 0x98, 0x12, 0xd8, 0xcd,
-	0x76, 0xa0, 0xcf, 0x5d, 0xfb, 0xab, 0x39, 0x6f, 0x46, 0x51, 0x2b, 0xda, 0xc1, 0x11, 0xa5, 0xd9,
-	0x3d, 0x50, 0x78, 0xc9, 0x94, 0xad, 0x3c, 0xcf, 0x6a, 0x04, 0xa7, 0x8c, 0x49, 0xd8, 0xfd, 0xff,
-	0xeb, 0x50, 0xdc, 0x9b, 0xbb, 0x63, 0x1c, 0x0c, 0x76, 0x1b, 0x72, 0x93, 0xb9, 0x3b, 0xa6, 0xb6,
-	0xc4, 0x5b, 0x69, 0xbc, 0x4e, 0x34, 0x42, 0xa2, 0x04, 0x32, 0xfc, 0x63, 0x94, 0x5c, 0x4b, 0x12,
-	0x08, 0xe1, 0xea, 0x1f, 0x65, 0x78, 0x8e, 0x7b, 0x8e, 0x71, 0xcc, 0x8a, 0x90, 0xeb, 0xf5, 0x7b,
-	0x6d, 0x65, 0x8d, 0x55, 0xa0, 0xd8, 0xe9, 0x0d, 0xdb, 0x5a, 0xaf, 0xd1, 0x55, 0x32, 0xb4, 0x9c,
-	0x87, 0x8d, 0xdd, 0x6e, 0x5b, 0x59, 0x47, 0xcc, 0xd3, 0x7e, 0xb7, 0x31, 0xec, 0x74, 0xdb, 0x4a,
-	0x8e, 0x63, 0xb4, 0x4e, 0x73, 0xa8, 0x14, 0x99, 0x02, 0x95, 0x43, 0xad, 0xdf, 0x3a, 0x6a, 0xb6,
-	0xf5, 0xde, 0x51, 0xb7, 0xab, 0x28, 0xec, 0x12, 0x6c, 0xc6, 0x90, 0x3e, 0x07, 0xee, 0x20, 0xcb,
-	0xd3, 0x86, 0xd6, 0xd0, 0x9e, 0x28, 0x3f, 0x64, 0x45, 0xc8, 0x36, 0x9e, 0x3c, 0x51, 0x7e, 0x8a,
-	0x92, 0xa1, 0xf4, 0xac, 0xd3, 0xd3, 0x9f, 0x36, 0xba, 0x47, 0x6d, 0xe5, 0xa7, 0xeb, 0x51, 0xba,
-	0xaf, 0xb5, 0xda, 0x9a, 0xf2, 0xd3, 0x1c, 0xdb, 0x82, 0xca, 0x8f, 0xfb, 0xbd, 0xf6, 0x41, 0xe3,
-	0xf0, 0x90, 0x2a, 0xf2, 0xd3, 0xa2, 0xfa, 0xdf, 0x72, 0x90, 0xc3, 0x96, 0x30, 0x35, 0x91, 0x82,
-	0x71, 0x13, 0x51, 0x0c, 0xed, 0xe6, 0xfe, 0xf4, 0x2f, 0x6f, 0xad, 0x71, 0xf9, 0xf7, 0x1a, 0x64,
-	0x1d, 0x3b, 0xa4, 0x61, 0x8d, 0xd7, 0x8e, 0xd0, 0x19, 0xf7, 0xd7, 0x34, 0xc4, 0xb1, 0x9b, 0x90,
-	0xe1, 0x82, 0xb0, 0xfc, 0xb0, 0x26, 0x16, 0x97, 0xd8, 0x49, 0xf7, 0xd7, 0xb4, 0xcc, 0x8c, 0xdd,
-	0x80, 0xcc, 0x73, 0x21, 0x15, 0x2b, 0x1c, 0xcf, 0xf7, 0x52, 0xc4, 0x3e, 0x67, 0x3b, 0x90, 0x1d,
-	0x7b, 0x5c, 0x23, 0x8c, 0xf1, 0x7c, 0x67, 0xc1, 0xfc, 0xc7, 0x9e, 0xc3, 0x6e, 0x43, 0xd6, 0x37,
-	0xce, 0x68, 0x64, 0xe3, 0xe1, 0x8a, 0xb7, 0x2e, 0x24, 0xf2, 0x8d, 0x33, 0xac, 0xc4, 0x84, 0xe4,
-	0x48, 0x5c, 0x89, 0x68, 0xbc, 0xb1, 0x98, 0x09, 0xdb, 0x81, 0xcc, 0x19, 0x49, 0x92, 0x58, 0x09,
-	0x7a, 0x66, 0xbb, 0xa6, 0x77, 0x36, 0x98, 0x59, 0x63, 0xa4, 0x38, 0x63, 0xaf, 0x43, 0x36, 0x98,
-	0x8f, 0x48, 0x92, 0x94, 0x1f, 0x6e, 0x2d, 0xed, 0x09, 0x58, 0x50, 0x30, 0x1f, 0xb1, 0xbb, 0x90,
-	0x1b, 0x7b, 0xbe, 0x2f, 0xa4, 0x89, 0x12, 0x55, 0x38, 0xda, 0x0e, 0x51, 0x29, 0x44, 0x3c, 0x16,
-	0x18, 0x92, 0x0c, 0x89, 0x89, 0x92, 0xfd, 0x08, 0x0b, 0x0c, 0xd9, 0x1d, 0xb1, 0xc9, 0x55, 0xe4,
-	0x5a, 0x47, 0x5b, 0x20, 0xe6, 0x83, 0x58, 0x1c, 0xa4, 0xa9, 0x71, 0x4e, 0x1a, 0x67, 0x4c, 0x14,
-	0xed, 0x7d, 0x58, 0xa7, 0xa9, 0x71, 0xce, 0xee, 0x40, 0xf6, 0xb9, 0x35, 0x26, 0xe5, 0x33, 0x2e,
-	0x4d, 0x0c, 0xd2, 0x53, 0x6a, 0x1e, 0xa2, 0x69, 0xde, 0x7b, 0x8e, 0x49, 0x7a, 0x68, 0x3c, 0x96,
-	0x7b, 0x9e, 0x63, 0x3e, 0xa5, 0xb1, 0x24, 0x24, 0x6e, 0xf9, 0xc6, 0xfc, 0x1c, 0xa5, 0x91, 0xc2,
-	0x37, 0x67, 0x63, 0x7e, 0xde, 0x31, 0x51, 0xf8, 0xbb, 0xe6, 0x73, 0xd2, 0x3e, 0x33, 0x1a, 0x7e,
-	0xa2, 0x79, 0x14, 0x58, 0x8e, 0x35, 0x0e, 0xed, 0xe7, 0x76, 0x78, 0x41, 0xfa, 0x65, 0x46, 0x93,
-	0x41, 0xbb, 0x1b, 0x90, 0xb3, 0xce, 0x67, 0xbe, 0xba, 0x0f, 0x05, 0x51, 0xca, 0x92, 0x8d, 0x75,
-	0x0d, 0x8a, 0x76, 0xa0, 0x8f, 0x3d, 0x37, 0x08, 0x85, 0xee, 0x54, 0xb0, 0x83, 0x26, 0x26, 0x51,
-	0x5c, 0x9a, 0x46, 0xc8, 0x37, 0xa1, 0x8a, 0x46, 0xdf, 0xea, 0x43, 0x80, 0xa4, 0x59, 0x58, 0x27,
-	0xc7, 0x72, 0x23, 0x35, 0xcd, 0xb1, 0xdc, 0x98, 0x67, 0x5d, 0xe2, 0xb9, 0x06, 0xa5, 0x58, 0x33,
-	0x66, 0x15, 0xc8, 0x18, 0x62, 0xfb, 0xcb, 0x18, 0xea, 0x3d, 0x54, 0x54, 0x23, 0xdd, 0x37, 0x8d,
-	0xc3, 0x54, 0xb4, 0x29, 0x66, 0x46, 0xea, 0xf7, 0xa1, 0xa2, 0x59, 0xc1, 0xdc, 0x09, 0x9b, 0x9e,
-	0xd3, 0xb2, 0x26, 0xec, 0x6d, 0x80, 0x38, 0x1d, 0x08, 0x2d, 0x25, 0x99, 0xbb, 0x2d, 0x6b, 0xa2,
-	0x49, 0x78, 0xf5, 0xbf, 0xe4, 0x48, 0xdf, 0x6b, 0x71, 0x45, 0x4b, 0x68, 0x54, 0x19, 0x49, 0xa3,
-	0x8a, 0xf7, 0x86, 0xf5, 0xb4, 0x56, 0x79, 0x62, 0x9b, 0xa6, 0xe5, 0x46, 0xda, 0x23, 0x4f, 0xe1,
-	0x60, 0x1b, 0xce, 0x31, 0x2d, 0xa8, 0xda, 0x43, 0x16, 0x15, 0x3a, 0x9d, 0xf9, 0x56, 0x10, 0x70,
-	0xbd, 0xc5, 0x70, 0x8e, 0xa3, 0xb5, 0x9d, 0xff, 0xba, 0xb5, 0x7d, 0x0d, 0x8a, 0xb8, 0xe5, 0x91,
-	0xd5, 0xb7, 0xc1, 0x7b, 0x5f, 0x98, 0xb7, 0xec, 0x0d, 0x28, 0x08, 0x7d, 0x5d, 0x2c, 0x2a, 0x31,
-	0x5d, 0x5a, 0x1c, 0xa8, 0x45, 0x58, 0x56, 0x47, 0x25, 0x6f, 0x3a, 0xb5, 0xdc, 0x30, 0xda, 0xa7,
-	0x45, 0x92, 0xbd, 0x05, 0x25, 0xcf, 0xd5, 0xb9, 0x52, 0x2f, 0x56, 0x95, 0x98, 0xbe, 0x7d, 0xf7,
-	0x88, 0xa0, 0x5a, 0xd1, 0x13, 0x5f, 0x58, 0x15, 0xc7, 0x3b, 0xd3, 0xc7, 0x86, 0x6f, 0xd2, 0xca,
-	0x2a, 0x6a, 0x05, 0xc7, 0x3b, 0x6b, 0x1a, 0xbe, 0xc9, 0xf5, 0x96, 0xaf, 0xdc, 0xf9, 0x94, 0x56,
-	0x53, 0x55, 0x13, 0x29, 0x76, 0x03, 0x4a, 0x63, 0x67, 0x1e, 0x84, 0x96, 0xbf, 0x7b, 0xc1, 0xcd,
-	0x34, 0x2d, 0x01, 0x60, 0xbd, 0x66, 0xbe, 0x3d, 0x35, 0xfc, 0x0b, 0x5a, 0x3a, 0x45, 0x2d, 0x4a,
-	0xd2, 0x46, 0x73, 0x6a, 0x9b, 0xe7, 0xdc, 0x56, 0xd3, 0x78, 0x02, 0xe9, 0x4f, 0xc8, 0x92, 0x0e,
-	0x68, 0x7d, 0x14, 0xb5, 0x28, 0x49, 0xe3, 0x40, 0x9f, 0xb4, 0x22, 0x4a, 0x9a, 0x48, 0xa5, 0x94,
-	0xee, 0xad, 0x17, 0x2a, 0xdd, 0x6c, 0x51, 0xef, 0xf1, 0x7c, 0xfb, 0xd8, 0x16, 0x5a, 0xcb, 0x25,
-	0xae, 0xf7, 0x70, 0x10, 0x11, 0x7c, 0x0c, 0xd5, 0x63, 0xcb, 0xb5, 0x7c, 0x23, 0xb4, 0x4c, 0x1d,
-	0xe5, 0xe2, 0x36, 0x75, 0x9c, 0x18, 0xe6, 0x27, 0x11, 0x0a, 0x65, 0x4d, 0xe5, 0x58, 0x4a, 0xa9,
-	0x5f, 0x41, 0x41, 0x8c, 0x0d, 0x6e, 0x5d, 0xb8, 0xee, 0xd2, 0x72, 0x9d, 0x6f, 0x5d, 0x08, 0x67,
-	0xb7, 0xa1, 0x2a, 0x2a, 0x11, 0x84, 0xbe, 0xed, 0x1e, 0x8b, 0x59, 0x57, 0xe1, 0xc0, 0x01, 0xc1,
-	0x50, 0xc3, 0xc0, 0x79, 0xa1, 0x1b, 0x23, 0xdb, 0xc1, 0xf5, 0x9d, 0x15, 0xda, 0xd0, 0xdc, 0x71,
-	0x1a, 0x1c, 0xa4, 0xf6, 0xa1, 0x18, 0x8d, 0xe4, 0x2f, 0xa5, 0x4c, 0x75, 0x06, 0x15, 0xb9, 0x85,
-	0xbf, 0x9c, 0x86, 0x70, 0x0d, 0x22, 0x08, 0x3d, 0xdf, 0x32, 0x23, 0x27, 0x8d, 0x1d, 0x0c, 0x28,
-	0xad, 0xfe, 0x56, 0x06, 0xca, 0xa4, 0xc9, 0xf4, 0x49, 0x4f, 0x63, 0x6f, 0x03, 0x1b, 0xfb, 0x96,
-	0x11, 0x5a, 0xba, 0x75, 0x1e, 0xfa, 0x86, 0xd0, 0x57, 0xb8, 0xd2, 0xa3, 0x70, 0x4c, 0x1b, 0x11,
-	0x5c, 0x65, 0xb9, 0x05, 0xe5, 0x99, 0xe1, 0x07, 0x91, 0xfe, 0xcb, 0x4b, 0x07, 0x0e, 0x12, 0xda,
-	0xa7, 0xe2, 0x1e, 0xfb, 0xc6, 0x54, 0x0f, 0xbd, 0x53, 0xcb, 0xe5, 0x9a, 0x3f, 0xb7, 0x79, 0x6a,
-	0x04, 0x1f, 0x22, 0x98, 0x0c, 0x80, 0xff, 0x90, 0x81, 0xea, 0x21, 0x9f, 0xa0, 0x9f, 0x59, 0x17,
-	0x2d, 0x6e, 0x68, 0x8e, 0x23, 0xe1, 0x92, 0xd3, 0xe8, 0x9b, 0xdd, 0x84, 0xf2, 0xec, 0xd4, 0xba,
-	0xd0, 0x53, 0x46, 0x59, 0x09, 0x41, 0x4d, 0x12, 0x23, 0x6f, 0xc2, 0x86, 0x47, 0x0d, 0x11, 0xdb,
-	0xb1, 0xd8, 0xc5, 0xa4, 0x16, 0x6a, 0x82, 0x00, 0x35, 0xbb, 0x38, 0x2b, 0x59, 0x85, 0x14, 0x99,
-	0x51, 0xf5, 0xb7, 0x21, 0x8f, 0xa8, 0xa0, 0x9e, 0xe7, 0x2a, 0x19, 0x25, 0xd8, 0x7b, 0x50, 0x1d,
-	0x7b, 0xd3, 0x99, 0x1e, 0xb1, 0x8b, 0x8d, 0x39, 0x2d, 0xfe, 0xca, 0x48, 0x72, 0xc8, 0xf3, 0x52,
-	0x7f, 0x2f, 0x0b, 0x45, 0xaa, 0x83, 0x90, 0x80, 0xb6, 0x79, 0x1e, 0x49, 0xc0, 0x92, 0x96, 0xb7,
-	0x4d, 0xdc, 0x60, 0x5e, 0xa2, 0x45, 0xc6, 0xda, 0x61, 0x56, 0xd6, 0x0e, 0xaf, 0xc0, 0x86, 0x50,
-	0x0d, 0x73, 0x5c, 0x44, 0xce, 0x5f, 0xac, 0x18, 0xe6, 0x57, 0x29, 0x86, 0x38, 0x84, 0x9c, 0xc6,
-	0x3a, 0xc7, 0xad, 0x98, 0x4b, 0x41, 0x20, 0x50, 0x1b, 0x21, 0xb2, 0x7c, 0x2b, 0xa4, 0xe5, 0x5b,
-	0x1d, 0x0a, 0xcf, 0xed, 0xc0, 0xc6, 0x09, 0x52, 0xe4, 0x12, 0x43, 0x24, 0xa5, 0x61, 0x28, 0xbd,
-	0x6c, 0x18, 0xe2, 0x66, 0x1b, 0xce, 0x31, 0xb7, 0x50, 0xa2, 0x66, 0x37, 0x9c, 0x63, 0x8f, 0xbd,
-	0x0f, 0x97, 0x13, 0xb4, 0x68, 0x0d, 0x79, 0xf2, 0xc8, 0x59, 0xa5, 0xb1, 0x98, 0x92, 0x5a, 0x44,
-	0x26, 0xe4, 0x7d, 0xd8, 0x92, 0x58, 0x66, 0xa8, 0x89, 0x05, 0x24, 0x1e, 0x4b, 0xda, 0x66, 0x4c,
-	0x4e, 0x0a, 0x5a, 0xa0, 0xfe, 0x8b, 0x75, 0xa8, 0xee, 0x79, 0xbe, 0x65, 0x1f, 0xbb, 0xc9, 0xac,
-	0x5b, 0x32, 0x52, 0xa2, 0x99, 0xb8, 0x2e, 0xcd, 0xc4, 0x5b, 0x50, 0x9e, 0x70, 0x46, 0x3d, 0x1c,
-	0x71, 0xff, 0x46, 0x4e, 0x03, 0x01, 0x1a, 0x8e, 0x1c, 0x94, 0x1f, 0x11, 0x01, 0x31, 0xe7, 0x88,
-	0x39, 0x62, 0xc2, 0x6d, 0x91, 0x7d, 0x8f, 0x36, 0x08, 0xd3, 0x72, 0xac, 0x90, 0x0f, 0x4f, 0xed,
-	0xe1, 0xab, 0x91, 0x52, 0x22, 0xd5, 0xe9, 0x81, 0x66, 0x4d, 0x1a, 0xa4, 0xc9, 0xe1, 0x7e, 0xd1,
-	0x22, 0x72, 0xc1, 0x2b, 0x36, 0x97, 0x8d, 0x6f, 0xc8, 0xcb, 0x65, 0x95, 0x3a, 0x84, 0x52, 0x0c,
-	0x46, 0xb5, 0x5c, 0x6b, 0x0b, 0x55, 0x7c, 0x8d, 0x95, 0xa1, 0xd0, 0x6c, 0x0c, 0x9a, 0x8d, 0x56,
-	0x5b, 0xc9, 0x20, 0x6a, 0xd0, 0x1e, 0x72, 0xf5, 0x7b, 0x9d, 0x6d, 0x42, 0x19, 0x53, 0xad, 0xf6,
-	0x5e, 0xe3, 0xa8, 0x3b, 0x54, 0xb2, 0xac, 0x0a, 0xa5, 0x5e, 0x5f, 0x6f, 0x34, 0x87, 0x9d, 0x7e,
-	0x4f, 0xc9, 0xa9, 0x3f, 0x84, 0x62, 0xf3, 0xc4, 0x1a, 0x9f, 0xbe, 0xa8, 0x17, 0xc9, 0x3f, 0x60,
-	0x8d, 0x4f, 0x85, 0x2a, 0xbd, 0xe0, 0x1f, 0xb0, 0xc6, 0xa7, 0x6a, 0x1b, 0x4a, 0x87, 0x86, 0x1f,
-	0xda, 0x54, 0xaf, 0xc7, 0x50, 0x8d, 0x13, 0x2d, 0x6b, 0x12, 0x29, 0x19, 0x2c, 0x56, 0xb0, 0x63,
-	0x94, 0x96, 0x26, 0x54, 0xdf, 0x86, 0x8a, 0x0c, 0x60, 0x37, 0x20, 0x6b, 0x5a, 0x93, 0x15, 0x42,
-	0x14, 0xc1, 0xea, 0x53, 0xa8, 0x34, 0xa3, 0x4d, 0xf3, 0x45, 0x55, 0x7f, 0x08, 0x35, 0x5a, 0xf1,
-	0xe3, 0x51, 0xb4, 0xe4, 0xd7, 0x57, 0x2c, 0xf9, 0x0a, 0xd2, 0x34, 0x47, 0x62, 0xcd, 0x7f, 0x08,
-	0xe5, 0x43, 0xdf, 0x9b, 0x59, 0x7e, 0x48, 0xd9, 0x2a, 0x90, 0x3d, 0xb5, 0x2e, 0x44, 0xae, 0xf8,
-	0x99, 0xb8, 0x6d, 0xd6, 0x65, 0xb7, 0xcd, 0x43, 0x28, 0x46, 0x6c, 0xdf, 0x98, 0xe7, 0x07, 0x28,
-	0x3a, 0x89, 0xc7, 0xb6, 0x02, 0x2c, 0xec, 0x01, 0xc0, 0x2c, 0x06, 0x88, 0x8e, 0x8b, 0x2c, 0x13,
-	0x91, 0xb9, 0x26, 0x51, 0xa8, 0xaf, 0x42, 0xe1, 0xa9, 0x6d, 0x9d, 0x89, 0xe6, 0x3f, 0xb7, 0xad,
-	0xb3, 0xa8, 0xf9, 0xf8, 0xad, 0xfe, 0xeb, 0x12, 0x14, 0x69, 0x7d, 0xb5, 0x5e, 0xec, 0x29, 0xfb,
-	0x36, 0x0a, 0xdc, 0x8e, 0x58, 0x4f, 0xb9, 0x15, 0x6a, 0x23, 0x5f, 0x5d, 0xaf, 0x02, 0x48, 0x6b,
-	0x9d, 0x4b, 0xae, 0x52, 0x18, 0x2f, 0x71, 0xd4, 0x7c, 0x68, 0x2f, 0x0a, 0xbe, 0x72, 0x84, 0xc1,
-	0x9b, 0x00, 0xd8, 0x03, 0xae, 0x97, 0x90, 0x89, 0xcb, 0x75, 0xb7, 0x4b, 0x91, 0xfd, 0x31, 0x72,
-	0xac, 0xc8, 0x2a, 0x22, 0x65, 0x05, 0x13, 0x24, 0xc7, 0x2c, 0x3f, 0x40, 0x71, 0x45, 0xae, 0x74,
-	0x2d, 0x4a, 0xb2, 0x37, 0x20, 0x87, 0x42, 0x5e, 0x58, 0x31, 0x97, 0xa2, 0x1e, 0x94, 0x76, 0x29,
-	0x8d, 0x08, 0xd8, 0x3d, 0x28, 0x90, 0x68, 0xb1, 0x50, 0xd2, 0x48, 0xbd, 0x1d, 0x09, 0x7d, 0x2d,
-	0x42, 0xb3, 0x37, 0x21, 0x3f, 0x39, 0xb5, 0x2e, 0x82, 0x7a, 0x95, 0xe8, 0x2e, 0xad, 0x58, 0xb3,
-	0x1a, 0xa7, 0x60, 0x77, 0xa0, 0xe6, 0x5b, 0x13, 0x9d, 0x7c, 0x67, 0x28, 0x64, 0x82, 0x7a, 0x8d,
-	0x64, 0x48, 0xc5, 0xb7, 0x26, 0x4d, 0x04, 0x0e, 0x47, 0x4e, 0xc0, 0xee, 0xc2, 0x06, 0xad, 0x1e,
-	0x54, 0xdb, 0xa4, 0x92, 0xa3, 0xa5, 0xa8, 0x09, 0x2c, 0x7b, 0x1f, 0x40, 0x28, 0x87, 0xfa, 0xe8,
-	0x82, 0x7c, 0xce, 0xf1, 0x62, 0x92, 0xe7, 0xbf, 0xac, 0x42, 0xbe, 0x01, 0x79, 0x9c, 0x24, 0x41,
-	0xfd, 0x2a, 0xe5, 0xbc, 0x95, 0x9e, 0x41, 0x54, 0x53, 0xc2, 0xb3, 0x7b, 0x50, 0xc4, 0x89, 0xa2,
-	0xe3, 0x70, 0xd4, 0x65, 0x6d, 0x59, 0xcc, 0x2a, 0xdc, 0x19, 0xac, 0xb3, 0xc1, 0x57, 0x0e, 0xbb,
-	0x0f, 0x39, 0x13, 0x17, 0xf3, 0x35, 0xca, 0xf1, 0x8a, 0x34, 0x2e, 0x28, 0xac, 0x5a, 0xd6, 0x84,
-	0x14, 0x78, 0xa2, 0x61, 0xfb, 0x50, 0xc3, 0x69, 0xf4, 0x90, 0x36, 0x7b, 0xec, 0xbe, 0xfa, 0x75,
-	0xe2, 0x7a, 0x6d, 0x81, 0xab, 0x27, 0x88, 0xa8, 0xb3, 0xdb, 0x6e, 0xe8, 0x5f, 0x68, 0x55, 0x57,
-	0x86, 0xb1, 0xeb, 0x68, 0x65, 0x75, 0xbd, 0xf1, 0xa9, 0x65, 0xd6, 0x5f, 0x89, 0x34, 0x20, 0x9e,
-	0x66, 0x9f, 0x40, 0x95, 0x26, 0x16, 0x26, 0xb1, 0xf0, 0xfa, 0x0d, 0x12, 0xa6, 0xf2, 0x94, 0x89,
-	0x50, 0x5a, 0x9a, 0x12, 0x45, 0xbc, 0x1d, 0xe8, 0xa1, 0x35, 0x9d, 0x79, 0x3e, 0xea, 0xd9, 0xaf,
-	0x46, 0xbe, 0xa1, 0x61, 0x04, 0xc2, 0x8d, 0x38, 0x3e, 0x21, 0xd3, 0xbd, 0xc9, 0x24, 0xb0, 0xc2,
-	0xfa, 0x4d, 0x5a, 0x37, 0xb5, 0xe8, 0xa0, 0xac, 0x4f, 0x50, 0xda, 0x08, 0x03, 0xdd, 0xbc, 0x70,
-	0x8d, 0xa9, 0x3d, 0xae, 0xdf, 0xe2, 0xea, 0xbc, 0x1d, 0xb4, 0x38, 0x40, 0xd6, 0xa8, 0x77, 0x52,
-	0x1a, 0xf5, 0x25, 0xc8, 0x9b, 0x23, 0x5c, 0x8e, 0xaf, 0x51, 0xb6, 0x39, 0x73, 0xd4, 0x31, 0xd9,
-	0x3b, 0x50, 0x9a, 0x45, 0x22, 0xb0, 0xae, 0xca, 0x7e, 0x83, 0x58, 0x32, 0x6a, 0x09, 0x05, 0x9a,
-	0xb2, 0x7b, 0x96, 0x11, 0xce, 0x7d, 0x6b, 0xcf, 0x31, 0x8e, 0xeb, 0xb7, 0x29, 0x27, 0x19, 0x84,
-	0xb5, 0x73, 0xbc, 0x63, 0x7b, 0x6c, 0xd0, 0xca, 0xbf, 0xc3, 0xf5, 0x2e, 0x01, 0xe9, 0x98, 0x89,
-	0x22, 0x6a, 0x08, 0x65, 0xea, 0x75, 0x59, 0x11, 0x35, 0x48, 0x9b, 0xba, 0xfe, 0x84, 0x34, 0x74,
-	0xea, 0xb9, 0x0f, 0x17, 0x04, 0x54, 0x6a, 0x79, 0x49, 0x92, 0x6c, 0x7f, 0x4d, 0x96, 0x53, 0xbb,
-	0x79, 0x92, 0xe4, 0xd7, 0x7f, 0x08, 0x6c, 0x79, 0xcc, 0x5f, 0x26, 0x2d, 0xf3, 0x42, 0x5a, 0x7e,
-	0x6f, 0xfd, 0x71, 0x46, 0x7d, 0x0a, 0xd5, 0x94, 0x30, 0x58, 0x29, 0xf5, 0xb9, 0xca, 0x65, 0x4c,
-	0x85, 0x35, 0xcd, 0x13, 0x91, 0x3a, 0x6d, 0xbb, 0xc7, 0xc2, 0x91, 0xc7, 0xd5, 0x69, 0x4a, 0xab,
-	0x7f, 0x96, 0x85, 0xca, 0xbe, 0x11, 0x9c, 0x1c, 0x18, 0xb3, 0x41, 0x68, 0x84, 0x01, 0x4e, 0x91,
-	0x13, 0x23, 0x38, 0x99, 0x1a, 0x33, 0xae, 0xfc, 0x66, 0xb8, 0x97, 0x40, 0xc0, 0x50, 0xf3, 0xc5,
-	0xc9, 0x89, 0xc9, 0xbe, 0x7b, 0xf8, 0x99, 0x70, 0x01, 0xc4, 0x69, 0x14, 0x4d, 0xc1, 0xc9, 0x7c,
-	0x32, 0x89, 0x8b, 0x8a, 0x92, 0xec, 0x0e, 0x54, 0xc5, 0x27, 0x69, 0xbe, 0xe7, 0xe2, 0x94, 0x35,
-	0x0d, 0x64, 0x8f, 0xa0, 0x2c, 0x00, 0xc3, 0x48, 0x90, 0xd6, 0x62, 0xd7, 0x4e, 0x82, 0xd0, 0x64,
-	0x2a, 0xf6, 0x23, 0xb8, 0x2c, 0x25, 0xf7, 0x3c, 0xff, 0x60, 0xee, 0x84, 0x76, 0xb3, 0x27, 0xd4,
-	0x8c, 0x57, 0x96, 0xd8, 0x13, 0x12, 0x6d, 0x35, 0x67, 0xba, 0xb6, 0x07, 0xb6, 0x4b, 0x72, 0x39,
-	0xab, 0xa5, 0x81, 0x0b, 0x54, 0xc6, 0x39, 0x89, 0xe3, 0x34, 0x95, 0x71, 0x8e, 0x0b, 0x56, 0x00,
-	0x0e, 0xac, 0xf0, 0xc4, 0x33, 0x49, 0xc7, 0x8c, 0x17, 0xec, 0x40, 0x46, 0x69, 0x69, 0x4a, 0xec,
-	0x4e, 0xb4, 0xdf, 0xc6, 0x6e, 0x48, 0x9a, 0x66, 0x56, 0x8b, 0x92, 0xb8, 0x55, 0xf9, 0x86, 0x7b,
-	0x6c, 0x05, 0xf5, 0xf2, 0x4e, 0xf6, 0x5e, 0x46, 0x13, 0x29, 0xf5, 0xef, 0xae, 0x43, 0x9e, 0x8f,
-	0xe4, 0x2b, 0x50, 0x1a, 0x91, 0x6f, 0x1c, 0x0d, 0x71, 0xe1, 0xef, 0x26, 0x40, 0x6f, 0x3e, 0xe5,
-	0x1a, 0xa2, 0x70, 0xe1, 0x64, 0x34, 0xfa, 0xc6, 0x2c, 0xbd, 0x79, 0x88, 0x65, 0x65, 0x09, 0x2a,
-	0x52, 0x58, 0x09, 0xdf, 0x3b, 0xa3, 0xd9, 0x90, 0x23, 0x44, 0x94, 0x24, 0x97, 0x3a, 0xed, 0x7a,
-	0xc8, 0x94, 0x27, 0x5c, 0x91, 0x00, 0x4d, 0x37, 0x5c, 0x74, 0x37, 0x6d, 0x2c, 0xb9, 0x9b, 0xd8,
-	0x4d, 0x40, 0xfd, 0x73, 0x6c, 0xf5, 0x5d, 0xab, 0xd9, 0xa3, 0x1e, 0x2e, 0x6a, 0x12, 0x04, 0x17,
-	0x88, 0xe9, 0xcd, 0xa8, 0x53, 0xf3, 0x1a, 0x7e, 0xb2, 0x8f, 0xe2, 0xd9, 0x49, 0x6d, 0x14, 0xda,
-	0xba, 0xd8, 0x15, 0xe4, 0x79, 0xac, 0xa5, 0xe8, 0x30, 0x27, 0x14, 0xf5, 0x5c, 0x5b, 0xc7, 0x4f,
-	0xb5, 0x0d, 0xa0, 0x79, 0x67, 0x81, 0x15, 0x92, 0x5f, 0xf5, 0x2a, 0x35, 0x31, 0x75, 0x22, 0xe6,
-	0x9d, 0x1d, 0x7a, 0x41, 0x6c, 0xd0, 0xae, 0xaf, 0x36, 0x68, 0xd5, 0x77, 0xa1, 0x80, 0x7a, 0x80,
-	0x11, 0x1a, 0xec, 0x8e, 0x70, 0x65, 0x71, 0xed, 0x45, 0xf8, 0xf4, 0x92, 0x32, 0x84, 0x73, 0xab,
-	0x1b, 0x95, 0x4b, 0x3c, 0xaf, 0x49, 0x26, 0x63, 0xbc, 0x07, 0x89, 0x0c, 0x85, 0x66, 0xf1, 0x0a,
-	0x94, 0xb0, 0x6a, 0x74, 0x4c, 0x20, 0xe4, 0x42, 0xd1, 0xf7, 0xce, 0x9a, 0x98, 0x56, 0xff, 0x63,
-	0x06, 0xca, 0x7d, 0xdf, 0xc4, 0xcd, 0x6f, 0x30, 0xb3, 0xc6, 0x2f, 0xb5, 0xbf, 0x51, 0x0f, 0xf1,
-	0x1c, 0xc7, 0x20, 0x31, 0x2b, 0x4c, 0xb6, 0x18, 0xc0, 0xde, 0x87, 0xdc, 0x04, 0xc5, 0x69, 0x56,
-	0xd6, 0xce, 0xa5, 0xec, 0xa3, 0x6f, 0x14, 0xb0, 0x1a, 0x91, 0xaa, 0xbf, 0x1e, 0x97, 0x4f, 0x52,
-	0x57, 0x76, 0xa6, 0xaf, 0xd1, 0xb1, 0xd6, 0xa0, 0xa9, 0x64, 0x58, 0x11, 0x72, 0xad, 0xf6, 0xa0,
-	0xc9, 0x75, 0x72, 0xd4, 0xce, 0x07, 0xfa, 0x5e, 0x47, 0x1b, 0x0c, 0x95, 0x1c, 0x9d, 0x93, 0x11,
-	0xa0, 0xdb, 0x18, 0x0c, 0x95, 0x22, 0x03, 0xd8, 0x38, 0xea, 0x75, 0x7e, 0x74, 0xd4, 0x56, 0x14,
-	0xf5, 0xdf, 0x64, 0x00, 0x12, 0x9f, 0x2f, 0x7b, 0x0b, 0xca, 0x67, 0x94, 0xd2, 0xa5, 0xc3, 0x00,
-	0xb9, 0x8d, 0xc0, 0xd1, 0xa4, 0x23, 0xbd, 0x03, 0x95, 0x78, 0xbb, 0x40, 0xfd, 0x61, 0xf9, 0x54,
-	0xa0, 0x1c, 0xe3, 0x77, 0x2f, 0xd8, 0xdb, 0x50, 0xf4, 0xb0, 0x1d, 0x48, 0x9a, 0x95, 0x95, 0x07,
-	0xa9, 0xf9, 0x5a, 0xc1, 0xe3, 0x09, 0xd4, 0x33, 0x26, 0x7e, 0x64, 0x82, 0xc7, 0xa4, 0x7b, 0x08,
-	0x6a, 0x3a, 0xc6, 0x3c, 0xb0, 0x34, 0x8e, 0x8f, 0xa5, 0x74, 0x3e, 0x91, 0xd2, 0xea, 0x8f, 0xa1,
-	0x36, 0x30, 0xa6, 0x33, 0x2e, 0xcb, 0xa9, 0x61, 0x0c, 0x72, 0x38, 0x27, 0xc4, 0xd4, 0xa3, 0x6f,
-	0x5c, 0x74, 0x87, 0x96, 0x3f, 0xb6, 0xdc, 0x68, 0x8d, 0x46, 0x49, 0x14, 0xbf, 0x47, 0x28, 0xcd,
-	0x35, 0xef, 0x2c, 0x12, 0xe7, 0x51, 0x5a, 0xfd, 0x07, 0x19, 0x28, 0x4b, 0xd5, 0x60, 0xef, 0x42,
-	0x8e, 0x14, 0xd2, 0x8c, 0x2c, 0x08, 0x25, 0x02, 0xfe, 0xcd, 0x55, 0x18, 0x24, 0x64, 0x77, 0x21,
-	0x1f, 0x84, 0x86, 0x1f, 0x1d, 0x1f, 0x28, 0x12, 0xc7, 0xae, 0x37, 0x77, 0x4d, 0x8d, 0xa3, 0x99,
-	0x0a, 0x59, 0xcb, 0x35, 0x85, 0xd3, 0x62, 0x99, 0x0a, 0x91, 0xea, 0x0e, 0x94, 0xe2, 0xec, 0x71,
-	0x0a, 0x68, 0xfd, 0x67, 0x03, 0x65, 0x8d, 0x95, 0x20, 0xaf, 0x35, 0x7a, 0x4f, 0xda, 0x4a, 0x46,
-	0xfd, 0x59, 0x06, 0x20, 0xe1, 0x62, 0x0f, 0x52, 0xb5, 0xbd, 0xbe, 0x98, 0xeb, 0x03, 0xfa, 0x2b,
-	0x55, 0xf6, 0x06, 0x94, 0xe6, 0x2e, 0x01, 0x2d, 0x53, 0xec, 0x44, 0x09, 0x00, 0xad, 0xa8, 0x28,
-	0xd8, 0x65, 0xc1, 0x8a, 0x7a, 0x6e, 0x38, 0xea, 0xf7, 0xa0, 0x14, 0x67, 0x87, 0x86, 0xe1, 0x5e,
-	0xbf, 0xdb, 0xed, 0x3f, 0xeb, 0xf4, 0x9e, 0x28, 0x6b, 0x98, 0x3c, 0xd4, 0xda, 0xcd, 0x76, 0x0b,
-	0x93, 0x19, 0x9c, 0xb3, 0xcd, 0x23, 0x4d, 0x6b, 0xf7, 0x86, 0xba, 0xd6, 0x7f, 0xa6, 0xac, 0xab,
-	0xbf, 0x9d, 0x81, 0x6a, 0xdf, 0x31, 0x9b, 0x9e, 0xd3, 0x34, 0x66, 0xa8, 0x71, 0xb0, 0x1f, 0xc0,
-	0xd6, 0x68, 0x8e, 0x5a, 0xef, 0xcc, 0x31, 0xc6, 0xd6, 0x89, 0xe7, 0x98, 0x56, 0xb4, 0x08, 0x53,
-	0x07, 0x24, 0xc2, 0x97, 0xab, 0x10, 0xf1, 0x61, 0x42, 0xcb, 0x3e, 0x84, 0xca, 0xcc, 0xf7, 0x46,
-	0x96, 0x1e, 0x78, 0x73, 0x7f, 0x6c, 0x2d, 0x99, 0x6b, 0x09, 0x6f, 0x99, 0xe8, 0x06, 0x44, 0xa6,
-	0xfe, 0xa3, 0x75, 0xa8, 0xb4, 0x2c, 0x73, 0x3e, 0xfb, 0xd4, 0xb3, 0xdd, 0x66, 0x78, 0xce, 0x3e,
-	0x80, 0x8a, 0xe7, 0x90, 0x1f, 0x52, 0x97, 0x8e, 0xe3, 0x57, 0xe5, 0x03, 0x1e, 0xb5, 0x80, 0x0e,
-	0xef, 0xdf, 0x81, 0x4b, 0xdc, 0x30, 0x17, 0x7e, 0xaa, 0x73, 0xce, 0x8c, 0x6b, 0x26, 0xaf, 0x29,
-	0x1c, 0xc5, 0x37, 0x68, 0x22, 0xff, 0x15, 0xd8, 0x96, 0xc8, 0x51, 0xb0, 0x70, 0xfa, 0xec, 0xd2,
-	0x1a, 0xdb, 0x8a, 0x79, 0xe3, 0x40, 0x81, 0x4f, 0x61, 0x3b, 0xaa, 0xe1, 0x98, 0xf7, 0x1e, 0x67,
-	0xce, 0xc9, 0xe6, 0x45, 0xaa, 0x77, 0x45, 0x85, 0xb7, 0x3c, 0x19, 0x48, 0x79, 0xbd, 0x0f, 0x97,
-	0x4d, 0x6c, 0xbd, 0xce, 0x3b, 0xff, 0xd4, 0xb2, 0x66, 0xba, 0x63, 0x04, 0xa1, 0x38, 0x77, 0x64,
-	0x84, 0xdc, 0x45, 0xdc, 0x67, 0x96, 0x35, 0xeb, 0x1a, 0x41, 0xa8, 0xfe, 0xad, 0x2c, 0x94, 0xb8,
-	0x57, 0x01, 0xbb, 0xeb, 0x1e, 0x14, 0xbc, 0xd1, 0x97, 0xba, 0x1f, 0x5b, 0xdb, 0x4b, 0x67, 0x8b,
-	0x1b, 0xde, 0xe8, 0x4b, 0xcd, 0x9a, 0xb0, 0xb7, 0xa2, 0xad, 0x0e, 0x2d, 0xf3, 0x75, 0xd9, 0x37,
-	0x1e, 0xa9, 0xf5, 0x62, 0xeb, 0x43, 0x93, 0xf3, 0x11, 0x94, 0x6d, 0x37, 0xb0, 0xfc, 0x90, 0x7b,
-	0x52, 0x0a, 0x2f, 0x1e, 0x04, 0x4e, 0x46, 0xce, 0x95, 0x47, 0x50, 0xe6, 0x9e, 0x15, 0xce, 0x54,
-	0x7c, 0x31, 0x13, 0x27, 0x23, 0xa6, 0x4f, 0xa0, 0x96, 0x88, 0x39, 0xe2, 0x2b, 0xbd, 0x90, 0xaf,
-	0x1a, 0x53, 0x12, 0xeb, 0x43, 0xb8, 0x12, 0x9c, 0xda, 0x33, 0x5d, 0xd4, 0xd4, 0x73, 0xe9, 0x58,
-	0x41, 0x9f, 0x9d, 0x0a, 0x77, 0x3e, 0x43, 0x6c, 0x87, 0x90, 0x7d, 0xb7, 0x37, 0x77, 0x9c, 0xc3,
-	0x53, 0xf6, 0x26, 0x6c, 0x09, 0xf2, 0xd9, 0x69, 0x34, 0x57, 0xc8, 0xd8, 0xcc, 0x6b, 0x35, 0x8e,
-	0x38, 0x3c, 0x15, 0x9a, 0xdc, 0xc7, 0x50, 0xe7, 0xc7, 0xdd, 0xa2, 0x51, 0xc6, 0x64, 0x62, 0x8d,
-	0x43, 0x1d, 0x55, 0x07, 0xe1, 0xfb, 0xbf, 0x4c, 0x78, 0xee, 0x1e, 0x6a, 0x10, 0x16, 0x25, 0x9f,
-	0xfa, 0x8f, 0x33, 0x50, 0xe2, 0xc5, 0xe2, 0x08, 0xbd, 0x06, 0xd9, 0xaf, 0x19, 0x1d, 0xc4, 0xb1,
-	0xfb, 0xb0, 0x65, 0x98, 0xa6, 0x28, 0xc0, 0x32, 0x79, 0x11, 0x5c, 0x1c, 0x6c, 0x1a, 0xa6, 0xd9,
-	0x10, 0x70, 0x12, 0xab, 0xf7, 0x40, 0xb1, 0x03, 0x3d, 0xb2, 0x2b, 0x93, 0xc3, 0xed, 0xa2, 0x56,
-	0xb3, 0x03, 0x61, 0x56, 0x72, 0x57, 0x71, 0x6a, 0xc0, 0x73, 0x5f, 0x3f, 0xe0, 0x2a, 0x03, 0xd0,
-	0x2c, 0x5a, 0xfb, 0xcd, 0xf0, 0xfc, 0xd3, 0x5c, 0x31, 0xa3, 0x80, 0xfa, 0x2f, 0xcb, 0x50, 0x6e,
-	0xb8, 0x86, 0x73, 0xf1, 0x13, 0x8b, 0x4e, 0xe6, 0xc9, 0x71, 0x38, 0x9b, 0x8b, 0x2e, 0xe0, 0xc7,
-	0x56, 0x25, 0x82, 0x50, 0xcd, 0x6e, 0x41, 0xd9, 0x9b, 0x87, 0x31, 0x9e, 0x1f, 0x64, 0x01, 0x07,
-	0x11, 0x41, 0xcc, 0x1f, 0x3b, 0xa5, 0x23, 0x7e, 0xd2, 0xca, 0x13, 0xfe, 0x58, 0x53, 0x8b, 0xf9,
-	0x89, 0xe0, 0x36, 0x54, 0x43, 0x7b, 0x6a, 0xd1, 0xd9, 0xdd, 0x7c, 0x6a, 0x99, 0x3c, 0xa4, 0x8f,
-	0x47, 0x89, 0x35, 0x05, 0x0c, 0x73, 0x99, 0x5a, 0x53, 0xcf, 0xbf, 0xe0, 0xb9, 0x6c, 0xf0, 0x5c,
-	0x38, 0x88, 0x72, 0x79, 0x1b, 0xd8, 0x99, 0x61, 0x87, 0x7a, 0x3a, 0x2b, 0xae, 0x1d, 0x2b, 0x88,
-	0x19, 0xca, 0xd9, 0x5d, 0x81, 0x0d, 0xd3, 0x0e, 0x4e, 0x3b, 0x7d, 0xa1, 0x19, 0x8b, 0x14, 0xb6,
-	0x25, 0x18, 0x1b, 0xb8, 0x31, 0x87, 0x16, 0xd7, 0xe2, 0xb2, 0x5a, 0x09, 0x21, 0xbb, 0x08, 0x40,
-	0xc1, 0xee, 0x5a, 0xe1, 0x99, 0xe7, 0x23, 0x27, 0x57, 0x7c, 0x13, 0x00, 0x6e, 0x80, 0x48, 0x8a,
-	0x05, 0xd1, 0xdc, 0xcb, 0x6a, 0x71, 0x1a, 0x55, 0x4a, 0x3e, 0x0f, 0x09, 0x5b, 0xe1, 0xd5, 0x4f,
-	0x20, 0xec, 0x0e, 0xd4, 0xa8, 0xfa, 0xa4, 0x18, 0x63, 0x1b, 0xe8, 0xac, 0x29, 0xab, 0x55, 0x10,
-	0x4a, 0x76, 0x32, 0x52, 0x7d, 0x02, 0xd7, 0x52, 0xed, 0xd3, 0x0d, 0xdf, 0x37, 0x2e, 0xf4, 0xa9,
-	0xf1, 0xa5, 0xe7, 0x93, 0x4f, 0x23, 0xab, 0x5d, 0x91, 0xbb, 0xad, 0x81, 0xe8, 0x03, 0xc4, 0xbe,
-	0x90, 0xd5, 0x76, 0x3d, 0x9f, 0x1c, 0x1e, 0x2b, 0x59, 0x11, 0x4b, 0xd6, 0x39, 0x0d, 0x30, 0x69,
-	0xe9, 0x01, 0x8f, 0x2e, 0xd4, 0xca, 0x04, 0xdb, 0x25, 0x10, 0xea, 0xa9, 0xc1, 0x23, 0x2e, 0x2f,
-	0xb7, 0x44, 0xa8, 0xcf, 0x23, 0x92, 0x84, 0x1c, 0x71, 0x62, 0x19, 0x26, 0x9d, 0x5f, 0x11, 0x62,
-	0xdf, 0x32, 0xe8, 0x74, 0x38, 0x78, 0xa4, 0xcf, 0xe6, 0x21, 0x0f, 0x0b, 0xd4, 0xf2, 0xc1, 0xa3,
-	0xc3, 0x79, 0x28, 0xc0, 0xc7, 0x56, 0x48, 0xc7, 0x55, 0x04, 0x7e, 0x62, 0x85, 0xa8, 0x6c, 0x06,
-	0x8f, 0x22, 0x07, 0xef, 0x65, 0xd1, 0xb7, 0x8f, 0x84, 0x07, 0x57, 0x85, 0x6a, 0x8c, 0xd4, 0xa7,
-	0x73, 0x1e, 0x07, 0x98, 0xd5, 0xca, 0x11, 0xc1, 0xc1, 0xdc, 0xc1, 0x81, 0x1d, 0x1b, 0xe3, 0x13,
-	0x4b, 0xf7, 0xb1, 0x2a, 0x57, 0xf9, 0xd0, 0x11, 0x44, 0xc3, 0xda, 0xbc, 0x02, 0x3c, 0xa1, 0x9f,
-	0xd8, 0x21, 0x39, 0x5e, 0xb2, 0x5a, 0x91, 0x00, 0xfb, 0x76, 0x88, 0xeb, 0x98, 0x23, 0xc5, 0x0c,
-	0xa4, 0x2c, 0xae, 0x11, 0xd1, 0x26, 0x21, 0x0e, 0x08, 0x4e, 0x19, 0xdd, 0x03, 0x25, 0x45, 0x8b,
-	0xf9, 0x5d, 0x27, 0xd2, 0x9a, 0x44, 0x8a, 0xb9, 0xde, 0x05, 0xce, 0xac, 0xe3, 0xd4, 0xe3, 0x79,
-	0xbe, 0xc2, 0xad, 0x34, 0x02, 0xb7, 0xec, 0xe0, 0x94, 0x72, 0xbc, 0x03, 0x35, 0x89, 0x0e, 0xf3,
-	0xbb, 0xc1, 0x67, 0x46, 0x4c, 0x96, 0xaa, 0xa3, 0x6f, 0x4d, 0xbd, 0x50, 0x34, 0xf3, 0x55, 0xa9,
-	0x8e, 0x1a, 0xc1, 0xd3, 0x75, 0x14, 0xb4, 0x98, 0xe7, 0x4d, 0xa9, 0x8e, 0x9c, 0x14, 0x73, 0x7d,
-	0x0d, 0x2a, 0x67, 0xbe, 0x1d, 0x86, 0x96, 0xcb, 0x17, 0xff, 0x2d, 0xde, 0xb1, 0x02, 0x46, 0xab,
-	0xff, 0x35, 0xa8, 0xf0, 0x9e, 0x17, 0xf2, 0x6d, 0x87, 0x93, 0x08, 0x58, 0x24, 0x20, 0x44, 0x6f,
-	0x4c, 0x6d, 0x97, 0xbc, 0x2b, 0x59, 0xad, 0xc4, 0x21, 0x68, 0xac, 0x4a, 0x68, 0xe3, 0x9c, 0x7c,
-	0x2c, 0x09, 0xda, 0x38, 0xa7, 0x25, 0x39, 0xb3, 0x1d, 0x87, 0x2f, 0xfc, 0xdb, 0x62, 0x49, 0x22,
-	0x84, 0xd6, 0x7d, 0x8c, 0xa6, 0xd2, 0xef, 0x48, 0x68, 0x2a, 0x1b, 0x27, 0x0e, 0xa1, 0xb1, 0xe8,
-	0xd7, 0xc5, 0xc4, 0x41, 0x00, 0x96, 0x9c, 0x20, 0x8d, 0xf3, 0xfa, 0x5d, 0x19, 0x69, 0x9c, 0x0b,
-	0xb9, 0x85, 0xb9, 0x12, 0xef, 0x1b, 0xb1, 0xdc, 0x42, 0x10, 0x72, 0xcb, 0x04, 0xc6, 0x79, 0xfd,
-	0x5e, 0x9a, 0xc0, 0x38, 0x27, 0x0b, 0xc9, 0x32, 0x4c, 0x5e, 0xf1, 0x37, 0x79, 0xf6, 0x08, 0xa0,
-	0x7a, 0xef, 0x40, 0x25, 0x78, 0xa4, 0x27, 0xf8, 0xfb, 0x9c, 0x3d, 0x78, 0xa4, 0x45, 0x14, 0x77,
-	0xa0, 0x16, 0x4f, 0x0d, 0x4e, 0xf3, 0x16, 0x1f, 0x78, 0x53, 0x4c, 0x0d, 0x3a, 0xee, 0xfb, 0x69,
-	0x06, 0xae, 0xf7, 0xc9, 0x39, 0x44, 0xe2, 0xff, 0xc0, 0x0a, 0x02, 0xe3, 0xd8, 0xda, 0xf3, 0xfc,
-	0xbd, 0xf9, 0x4f, 0x7e, 0x72, 0xc1, 0xee, 0xc1, 0xe6, 0xa1, 0xe1, 0x5b, 0x6e, 0x18, 0x1f, 0x54,
-	0x09, 0xcf, 0xcc, 0x22, 0x98, 0x3d, 0x06, 0x85, 0x83, 0x78, 0x48, 0x54, 0x33, 0x3a, 0xa7, 0x59,
-	0xf4, 0x2b, 0x2f, 0x51, 0xa1, 0xb1, 0x57, 0x6a, 0xd9, 0x41, 0xa8, 0xa1, 0xa5, 0xcf, 0x3e, 0x01,
-	0xc5, 0xf1, 0xce, 0xd0, 0x62, 0x41, 0x35, 0x56, 0x97, 0x14, 0x67, 0xb1, 0x4b, 0x26, 0xda, 0x72,
-	0x8d, 0x08, 0x13, 0x75, 0xf7, 0x13, 0x50, 0xe6, 0xb3, 0x59, 0x9a, 0x75, 0xfd, 0x05, 0xac, 0x44,
-	0x98, 0xb0, 0xbe, 0x05, 0x65, 0xa9, 0xd4, 0x15, 0xca, 0x35, 0x24, 0x65, 0x21, 0xb1, 0x54, 0xce,
-	0x8a, 0x30, 0x4b, 0x48, 0x72, 0x57, 0xff, 0x28, 0x03, 0x0a, 0x39, 0xc7, 0x34, 0x3a, 0xac, 0xa7,
-	0xf3, 0xae, 0x94, 0x59, 0x96, 0x79, 0xa9, 0x59, 0xb6, 0x03, 0x79, 0xc7, 0x9e, 0xc6, 0xb1, 0x4f,
-	0xa9, 0x03, 0x1b, 0x42, 0xe0, 0x58, 0x7b, 0xbe, 0x7d, 0x4c, 0x06, 0xa4, 0x1c, 0xa5, 0x47, 0x7e,
-	0x3f, 0xb4, 0xc7, 0x68, 0x88, 0x1e, 0x00, 0x98, 0x76, 0x10, 0xea, 0xe4, 0x52, 0x11, 0xd5, 0x16,
-	0x3d, 0x13, 0xf7, 0xbf, 0x56, 0x32, 0xa3, 0x4f, 0xf5, 0xcf, 0x5f, 0x83, 0x5c, 0xcf, 0x33, 0x2d,
-	0xf6, 0x1e, 0x94, 0x28, 0xf4, 0x54, 0x1a, 0x0c, 0xa1, 0xd0, 0x22, 0x9a, 0xfe, 0x50, 0xaf, 0x16,
-	0x5d, 0xf1, 0xf5, 0xe2, 0x60, 0xd5, 0xd7, 0xc8, 0x10, 0xa3, 0xe3, 0x53, 0x2c, 0xbe, 0x2c, 0x9c,
-	0x45, 0xe4, 0xdb, 0xe0, 0x18, 0xdc, 0x07, 0xc9, 0xd5, 0xee, 0x5b, 0x2e, 0x69, 0xcf, 0x79, 0x2d,
-	0x4e, 0x93, 0xf9, 0xeb, 0x7b, 0xa8, 0x26, 0xf1, 0xdd, 0x22, 0xbf, 0xc2, 0xfc, 0xe5, 0x78, 0xda,
-	0x3e, 0xde, 0x83, 0xd2, 0x97, 0x9e, 0xed, 0xf2, 0x8a, 0x6f, 0x2c, 0x55, 0x1c, 0xad, 0x0b, 0x5e,
-	0xf1, 0x2f, 0xc5, 0x17, 0xbb, 0x0d, 0x05, 0xcf, 0xe5, 0x79, 0x17, 0x96, 0xf2, 0xde, 0xf0, 0xdc,
-	0x2e, 0x8f, 0x84, 0xaa, 0xda, 0x81, 0xee, 0xdb, 0xc7, 0x27, 0xa1, 0x8e, 0x9c, 0xe2, 0xd8, 0xb5,
-	0x6c, 0x07, 0x1a, 0xc2, 0x30, 0x5b, 0x9c, 0x24, 0x13, 0xdb, 0x41, 0x6d, 0x8c, 0x32, 0x2b, 0x2d,
-	0x65, 0x06, 0x1c, 0x4d, 0x19, 0xbe, 0x0e, 0xc5, 0x63, 0xdf, 0x43, 0x85, 0xff, 0xa2, 0x0e, 0x4b,
-	0x94, 0x05, 0xc2, 0xed, 0x5e, 0xa0, 0xaa, 0x43, 0x9f, 0xb6, 0x7b, 0xac, 0x93, 0x47, 0xa3, 0xbc,
-	0x93, 0xbd, 0x57, 0xd4, 0x2a, 0x11, 0x90, 0x7c, 0x15, 0xaf, 0x43, 0xd1, 0x38, 0x3e, 0xd6, 0x45,
-	0x40, 0xd7, 0x52, 0x5e, 0xc6, 0xf1, 0x31, 0x15, 0xf9, 0x00, 0xaa, 0x67, 0xb6, 0xab, 0x07, 0x33,
-	0x6b, 0xcc, 0x69, 0xab, 0xcb, 0x5d, 0x79, 0x66, 0xbb, 0x38, 0x13, 0x89, 0x5e, 0x9e, 0xb2, 0xb5,
-	0x6f, 0x3e, 0x65, 0x37, 0x5f, 0x34, 0x65, 0x55, 0xd8, 0x10, 0x6e, 0x78, 0x65, 0x89, 0x44, 0x60,
-	0xd8, 0xfb, 0x50, 0xf6, 0x0d, 0xf7, 0x54, 0x17, 0x67, 0xd8, 0x5f, 0xc8, 0x56, 0xb9, 0x66, 0xb8,
-	0xa7, 0xe2, 0x08, 0x1b, 0xfc, 0xf8, 0x3b, 0xad, 0xde, 0x6e, 0xbd, 0xc4, 0x9e, 0x91, 0xcc, 0x24,
-	0xf6, 0xf5, 0x66, 0xd2, 0x87, 0x64, 0x8f, 0x58, 0x6e, 0xa8, 0x47, 0x0c, 0x97, 0x56, 0x33, 0x54,
-	0x38, 0x59, 0x9f, 0xb3, 0x61, 0x03, 0xc8, 0x2b, 0xa6, 0x93, 0x0b, 0x6d, 0x3b, 0xd5, 0x80, 0xd8,
-	0x5d, 0xa6, 0x81, 0x9f, 0xb8, 0xce, 0x1a, 0xb0, 0x99, 0x44, 0xb9, 0xf2, 0x70, 0xe1, 0xcb, 0xb2,
-	0x5b, 0x3e, 0x15, 0x16, 0x1b, 0x59, 0x40, 0x76, 0x2a, 0x56, 0xf6, 0x36, 0x54, 0x79, 0xd0, 0x08,
-	0xef, 0xb7, 0x80, 0x14, 0x9a, 0x92, 0x56, 0x21, 0x20, 0xef, 0xa7, 0x80, 0x84, 0x81, 0x30, 0xcb,
-	0xc2, 0x73, 0xd2, 0x68, 0x12, 0x61, 0xc0, 0xed, 0xb0, 0xf0, 0x5c, 0x2b, 0x99, 0xd1, 0x27, 0x6e,
-	0xd4, 0x23, 0xdb, 0x35, 0x71, 0xea, 0x85, 0xc6, 0x71, 0x50, 0xaf, 0xd3, 0xca, 0x2c, 0x0b, 0xd8,
-	0xd0, 0x38, 0x0e, 0xd0, 0x48, 0x37, 0xb8, 0x61, 0xc0, 0xeb, 0x7d, 0x4d, 0xf6, 0x22, 0x49, 0x26,
-	0x83, 0x56, 0x36, 0x24, 0xfb, 0xe1, 0x63, 0x60, 0xd1, 0x29, 0xa1, 0x64, 0x73, 0x5f, 0x5f, 0x9a,
-	0x8d, 0x9b, 0xe2, 0x98, 0x30, 0xb6, 0xb8, 0x6f, 0x41, 0x99, 0x7b, 0x15, 0xf4, 0x20, 0xb4, 0x66,
-	0xf5, 0x57, 0xa8, 0x42, 0xc0, 0x41, 0x83, 0xd0, 0x9a, 0xb1, 0x8f, 0xa1, 0x9a, 0xb6, 0x88, 0x6e,
-	0xac, 0x38, 0x6c, 0xa3, 0x69, 0xa1, 0x55, 0xc6, 0xb2, 0x8d, 0x74, 0x9b, 0x47, 0x60, 0x93, 0x36,
-	0x43, 0x8c, 0xfc, 0x40, 0xa9, 0xe2, 0x7a, 0x61, 0x33, 0x82, 0x61, 0x07, 0x46, 0xc6, 0x70, 0x78,
-	0x4e, 0x0a, 0x50, 0xdc, 0x81, 0xb1, 0x99, 0x87, 0x86, 0x4c, 0x64, 0xf1, 0xb5, 0x80, 0x07, 0x49,
-	0xd0, 0x86, 0x6c, 0xf9, 0x3c, 0x20, 0x82, 0xf4, 0x9d, 0xf8, 0xfc, 0x6d, 0x71, 0x9f, 0xd0, 0x78,
-	0xf0, 0x88, 0xbc, 0x73, 0x3c, 0x86, 0xda, 0xcc, 0xb7, 0x74, 0xa9, 0x64, 0x55, 0x6e, 0xd4, 0xa1,
-	0x6f, 0x25, 0x85, 0x57, 0x66, 0x52, 0x8a, 0xfd, 0x00, 0xb6, 0x24, 0xce, 0xf9, 0x29, 0x31, 0xdf,
-	0x26, 0xe6, 0xed, 0x05, 0xe6, 0xa3, 0x53, 0x64, 0xaf, 0xcd, 0x52, 0x69, 0xb6, 0x0d, 0xf9, 0x4e,
-	0xd0, 0x76, 0x4d, 0xd2, 0x83, 0x8a, 0x1a, 0x4f, 0xb0, 0x47, 0x50, 0xe1, 0x46, 0x07, 0xc5, 0x94,
-	0x06, 0xf5, 0xbb, 0xb2, 0x9b, 0x98, 0x2c, 0x0f, 0x42, 0x68, 0x65, 0x27, 0xfe, 0x0e, 0xd8, 0x47,
-	0xb0, 0xc5, 0x7d, 0xf8, 0xb2, 0x88, 0x7c, 0x63, 0x79, 0xc8, 0x89, 0x68, 0x2f, 0x91, 0x93, 0x1a,
-	0x5c, 0xf3, 0xe7, 0x2e, 0x19, 0x22, 0x82, 0x93, 0x7b, 0x97, 0x88, 0xff, 0x1e, 0xf1, 0x5f, 0x15,
-	0xab, 0x8b, 0x93, 0x71, 0x5e, 0x92, 0x4d, 0x57, 0x7c, 0x19, 0x74, 0x88, 0x7c, 0x2f, 0xc8, 0x93,
-	0x7b, 0x5d, 0x28, 0xcf, 0x37, 0xbf, 0x4d, 0x9e, 0xe4, 0x91, 0xa1, 0x3c, 0x19, 0xe4, 0xe6, 0x73,
-	0xdb, 0x24, 0xad, 0xac, 0xa2, 0xd1, 0x37, 0x7b, 0x1d, 0x6a, 0xbe, 0x35, 0x9e, 0xfb, 0x81, 0xfd,
-	0xdc, 0xd2, 0x03, 0xdb, 0x3d, 0x25, 0x7d, 0xac, 0xa8, 0x55, 0x63, 0xe8, 0xc0, 0x76, 0x4f, 0x51,
-	0x64, 0x58, 0xe7, 0xa1, 0xe5, 0xbb, 0x3c, 0xcc, 0xfd, 0x6d, 0x59, 0x64, 0xb4, 0x09, 0x81, 0xeb,
-	0x5c, 0x03, 0x2b, 0xfe, 0x5e, 0x18, 0xd9, 0x80, 0x8f, 0xec, 0x83, 0x6f, 0x34, 0xb2, 0x03, 0x1a,
-	0xd9, 0xbb, 0x50, 0xb4, 0xdd, 0xd0, 0xf2, 0x9f, 0x1b, 0x4e, 0xfd, 0xdd, 0x25, 0x69, 0x1c, 0xe3,
-	0xd8, 0x1d, 0x28, 0x04, 0x8e, 0x8d, 0xeb, 0xbd, 0xfe, 0xde, 0x12, 0x59, 0x84, 0x62, 0xf7, 0xa0,
-	0x14, 0xdf, 0xd0, 0xaa, 0xbf, 0xbf, 0x44, 0x97, 0x20, 0xd9, 0x4d, 0xc8, 0x9d, 0xe1, 0x84, 0x7a,
-	0xb8, 0xec, 0xd6, 0x47, 0x38, 0x6e, 0xdf, 0x13, 0xd4, 0xaf, 0x69, 0xfb, 0x7e, 0xb4, 0xb4, 0x7d,
-	0xef, 0xd9, 0x8e, 0xc3, 0xb7, 0xef, 0x89, 0xf8, 0xc2, 0xcd, 0x8f, 0x38, 0xb0, 0x25, 0x1f, 0x2c,
-	0x6f, 0x7e, 0x88, 0x7b, 0x4a, 0x77, 0xd9, 0xca, 0x01, 0xf9, 0xaa, 0xb9, 0xcb, 0xfd, 0x43, 0xb9,
-	0xaf, 0xd2, 0x4e, 0x6c, 0x0d, 0x82, 0x38, 0x8d, 0xc6, 0x82, 0xf0, 0xd4, 0xdb, 0xe6, 0x79, 0xfd,
-	0x23, 0x7e, 0x49, 0x82, 0x43, 0x3a, 0xe6, 0x39, 0x7b, 0x0f, 0xaa, 0x51, 0x68, 0x0f, 0x16, 0x17,
-	0xd4, 0x3f, 0x5e, 0xaa, 0x41, 0x9a, 0x80, 0xb5, 0xa0, 0x32, 0x41, 0x3d, 0x7b, 0xca, 0xd5, 0xee,
-	0xfa, 0x63, 0xaa, 0xc8, 0x4e, 0xb4, 0xb1, 0xbe, 0x48, 0x2d, 0xd7, 0x52, 0x5c, 0xec, 0x01, 0x30,
-	0x7b, 0xc2, 0xc7, 0x73, 0xcf, 0xf7, 0xa6, 0x5c, 0xb5, 0xae, 0x7f, 0xc2, 0xbd, 0x5d, 0xcb, 0x18,
-	0x3a, 0xb8, 0xb3, 0x5c, 0x53, 0x9f, 0x06, 0x42, 0x4d, 0xf8, 0x1e, 0xd5, 0x53, 0x08, 0xaf, 0xf8,
-	0x26, 0x67, 0xe4, 0x98, 0x45, 0xda, 0x83, 0x80, 0x6b, 0x0d, 0x9f, 0x00, 0x4e, 0xd7, 0xe7, 0x09,
-	0xeb, 0xaf, 0x7c, 0x2d, 0x2b, 0xd2, 0x46, 0xac, 0x8f, 0xa1, 0xc6, 0x9d, 0x9a, 0xa4, 0x91, 0xe1,
-	0x14, 0xfd, 0xbe, 0x2c, 0xb9, 0x64, 0x77, 0xaf, 0x56, 0x31, 0x65, 0xe7, 0xef, 0xc7, 0xb0, 0x19,
-	0xf9, 0x65, 0x43, 0xe1, 0xc2, 0xfd, 0x55, 0xb9, 0xd8, 0xd8, 0xef, 0xa9, 0x55, 0xe7, 0xd1, 0x27,
-	0x15, 0xf9, 0x08, 0xaa, 0xb4, 0x8b, 0x06, 0xae, 0x31, 0x0b, 0x4e, 0xbc, 0xb0, 0xfe, 0x6b, 0xb2,
-	0x42, 0x30, 0x10, 0x50, 0xad, 0x82, 0x44, 0x51, 0x0a, 0x85, 0x7f, 0xb2, 0x4e, 0xc7, 0xa1, 0x55,
-	0xff, 0x01, 0x17, 0xfe, 0x31, 0xb0, 0x19, 0x5a, 0xec, 0x11, 0x80, 0x31, 0x9b, 0x39, 0x17, 0x7c,
-	0x6a, 0xfe, 0x90, 0xa6, 0xe6, 0xb6, 0x34, 0x35, 0x1b, 0x88, 0xa4, 0xb9, 0x59, 0x32, 0xa2, 0x4f,
-	0xf6, 0x10, 0x2a, 0x33, 0x2f, 0x08, 0x75, 0x73, 0xea, 0x50, 0xfb, 0x1b, 0xf2, 0xda, 0x3e, 0xf4,
-	0x82, 0xb0, 0x35, 0x75, 0xb0, 0x15, 0x30, 0x8b, 0xbf, 0x59, 0x17, 0x2e, 0x79, 0xae, 0x6e, 0xce,
-	0x67, 0x8e, 0x3d, 0xc6, 0x1e, 0x30, 0xe8, 0x98, 0xbc, 0xbe, 0x4b, 0x25, 0xde, 0x90, 0x4a, 0xec,
-	0xbb, 0xad, 0x88, 0x48, 0xc4, 0x99, 0x6d, 0x79, 0x8b, 0x20, 0xb2, 0x09, 0x69, 0x0c, 0xe2, 0x60,
-	0xcb, 0x26, 0x57, 0x0d, 0x08, 0x1a, 0x45, 0x5b, 0x3e, 0x86, 0xcd, 0x84, 0x0a, 0x1b, 0x18, 0xd4,
-	0x5b, 0xf2, 0x4c, 0x96, 0xa2, 0xb7, 0xab, 0x11, 0x23, 0xc2, 0x02, 0xea, 0x3b, 0xcf, 0x71, 0xe6,
-	0x33, 0x21, 0x4a, 0xeb, 0x6d, 0xd1, 0x77, 0x04, 0xe4, 0x52, 0x52, 0x32, 0x9b, 0xad, 0x69, 0x7d,
-	0x4f, 0x36, 0x9b, 0xad, 0x29, 0xaa, 0x19, 0x22, 0x92, 0xf6, 0xb9, 0x6d, 0x9d, 0x05, 0xf5, 0x27,
-	0x14, 0x65, 0x29, 0x62, 0x95, 0x9f, 0x22, 0x08, 0xf7, 0x7d, 0xd3, 0xf6, 0xd1, 0x04, 0xa0, 0x00,
-	0xa9, 0x7d, 0x1e, 0xec, 0xca, 0x41, 0x48, 0xa1, 0xfe, 0x2c, 0x0f, 0xc5, 0xc8, 0x26, 0x61, 0x65,
-	0x28, 0x1c, 0xf5, 0x3e, 0xeb, 0xf5, 0x9f, 0xf5, 0xf8, 0x4d, 0xb6, 0xc6, 0x60, 0xd0, 0xd6, 0x86,
-	0x8a, 0xc9, 0x6a, 0x00, 0x74, 0x57, 0x45, 0x1f, 0x34, 0x1b, 0x3d, 0x7e, 0xb3, 0x8d, 0x6e, 0xc8,
-	0xf0, 0xf4, 0x3a, 0xdb, 0x82, 0xea, 0xde, 0x51, 0x8f, 0x82, 0xea, 0x38, 0x28, 0x8b, 0xa0, 0xf6,
-	0xe7, 0xfc, 0x18, 0x90, 0x83, 0x72, 0x08, 0x3a, 0x68, 0x0c, 0xdb, 0x5a, 0x27, 0x02, 0xe5, 0x29,
-	0x3e, 0xaf, 0x7f, 0xa4, 0x35, 0x45, 0x4e, 0x1b, 0xec, 0x32, 0x6c, 0xc5, 0x6c, 0x51, 0x96, 0x4a,
-	0x01, 0x6b, 0x76, 0xa8, 0xf5, 0x3f, 0x6d, 0x37, 0x87, 0x0a, 0xd0, 0x99, 0xe2, 0x93, 0x27, 0x4a,
-	0x99, 0x55, 0xa0, 0xd8, 0xea, 0x0c, 0x86, 0x9d, 0x5e, 0x73, 0xa8, 0x54, 0xb0, 0xc2, 0x7b, 0x9d,
-	0xee, 0xb0, 0xad, 0x29, 0x55, 0x56, 0x84, 0xdc, 0xa7, 0xfd, 0x4e, 0x4f, 0xa9, 0xd1, 0x9d, 0x9d,
-	0xc6, 0xc1, 0x61, 0xb7, 0xad, 0x6c, 0x22, 0x74, 0xd0, 0xd7, 0x86, 0x8a, 0x82, 0xd0, 0x67, 0x9d,
-	0x5e, 0xab, 0xff, 0x4c, 0xd9, 0x62, 0x25, 0xc8, 0x1f, 0xf5, 0xb0, 0x18, 0xc6, 0xaa, 0x50, 0xa2,
-	0x4f, 0xbd, 0xd1, 0xed, 0x2a, 0x97, 0xa4, 0x83, 0xc8, 0x6d, 0x44, 0xd1, 0xb1, 0xe6, 0x00, 0xeb,
-	0x70, 0x19, 0xdb, 0x12, 0x27, 0x89, 0xfa, 0x0a, 0xe6, 0x73, 0xd0, 0xe9, 0x1d, 0x0d, 0x94, 0xab,
-	0x48, 0x4c, 0x9f, 0x84, 0xa9, 0x63, 0x3e, 0x9d, 0x1e, 0x75, 0xe5, 0x4d, 0xfc, 0x6e, 0xb5, 0xbb,
-	0xed, 0x61, 0x5b, 0xb9, 0x85, 0xad, 0xea, 0xf6, 0x9b, 0x9f, 0xe9, 0xfd, 0x43, 0xe5, 0x35, 0xec,
-	0xd3, 0x43, 0xad, 0xad, 0x0b, 0xc2, 0xdb, 0x4c, 0x81, 0xca, 0xde, 0xd1, 0x8f, 0x7f, 0xfc, 0x85,
-	0x2e, 0x1a, 0xf5, 0x3a, 0x96, 0x99, 0x50, 0xe8, 0x47, 0x9f, 0x29, 0x77, 0x17, 0x40, 0x83, 0xcf,
-	0x94, 0x37, 0xb0, 0x53, 0xa2, 0x5e, 0x56, 0xee, 0x21, 0x81, 0xd6, 0x6e, 0x1e, 0x69, 0x83, 0xce,
-	0xd3, 0xb6, 0xde, 0x1c, 0xb6, 0x95, 0x37, 0xa9, 0x17, 0x3a, 0xbd, 0xcf, 0x94, 0xfb, 0x58, 0x4d,
-	0xfc, 0xe2, 0x7d, 0xff, 0x16, 0x63, 0x50, 0x4b, 0x68, 0x09, 0xf6, 0x36, 0x92, 0xec, 0x6a, 0xfd,
-	0x46, 0xab, 0xd9, 0x18, 0x0c, 0x95, 0x77, 0xb0, 0x8d, 0x83, 0xc3, 0x6e, 0x67, 0xa8, 0x3c, 0xc0,
-	0x86, 0x3c, 0x69, 0x0c, 0xf7, 0xdb, 0x9a, 0xf2, 0x2e, 0x0e, 0xe3, 0xb0, 0x73, 0xd0, 0xd6, 0x45,
-	0x9f, 0x3e, 0xc4, 0x32, 0xf6, 0x3a, 0xdd, 0xae, 0xf2, 0x88, 0x0e, 0xd2, 0x1a, 0xda, 0xb0, 0x43,
-	0x03, 0xf9, 0x01, 0x66, 0xd0, 0x38, 0x3c, 0xec, 0x7e, 0xa1, 0x7c, 0x88, 0x0d, 0x3c, 0x38, 0xea,
-	0x0e, 0x3b, 0xfa, 0xd1, 0x61, 0xab, 0x31, 0x6c, 0x2b, 0x1f, 0xd1, 0x28, 0xf7, 0x07, 0xc3, 0xd6,
-	0x41, 0x57, 0xf9, 0x98, 0xf2, 0xa4, 0x39, 0xd6, 0xec, 0xf6, 0x7b, 0x6d, 0xe5, 0xb1, 0x9a, 0x2b,
-	0xee, 0x28, 0x3b, 0x6a, 0xae, 0xa8, 0x2a, 0xaa, 0xfa, 0x9b, 0x50, 0x8c, 0x0c, 0x52, 0xcc, 0xb2,
-	0xd3, 0xeb, 0xb5, 0x35, 0x65, 0x0d, 0x8b, 0xed, 0xb6, 0xf7, 0x86, 0x4a, 0x86, 0x4e, 0x18, 0x3b,
-	0x4f, 0xf6, 0x87, 0xca, 0x3a, 0x7e, 0xf6, 0x8f, 0xb0, 0x07, 0xb3, 0xd4, 0xf4, 0xf6, 0x41, 0x47,
-	0xc9, 0xe1, 0x57, 0xa3, 0x37, 0xec, 0x28, 0x79, 0x9a, 0x20, 0x9d, 0xde, 0x93, 0x6e, 0x5b, 0xd9,
-	0x40, 0xe8, 0x41, 0x43, 0xfb, 0x4c, 0x29, 0xf0, 0x4c, 0x5b, 0xed, 0xcf, 0x95, 0x22, 0xdb, 0x80,
-	0xf5, 0xee, 0x43, 0xa5, 0x84, 0xa0, 0x56, 0xbb, 0x75, 0x74, 0xa8, 0x80, 0x7a, 0x0f, 0x0a, 0x8d,
-	0xe3, 0xe3, 0x03, 0xb4, 0xf7, 0xb1, 0xa5, 0x47, 0xdd, 0x2e, 0x5f, 0x30, 0xbb, 0xfd, 0xe1, 0xb0,
-	0x7f, 0xa0, 0x64, 0x70, 0x8a, 0x0e, 0xfb, 0x87, 0xca, 0xba, 0xda, 0x81, 0x62, 0xb4, 0xf5, 0x4a,
-	0x17, 0xce, 0x8a, 0x90, 0x3b, 0xd4, 0xda, 0x4f, 0xf9, 0xb1, 0x78, 0xaf, 0xfd, 0x39, 0x56, 0x13,
-	0xbf, 0x30, 0xa3, 0x2c, 0x16, 0xc4, 0x6f, 0x86, 0xd1, 0x8d, 0xb3, 0x6e, 0xa7, 0xd7, 0x6e, 0x68,
-	0x4a, 0x5e, 0xfd, 0x10, 0xb6, 0x96, 0x04, 0x17, 0x15, 0xdf, 0xe8, 0x88, 0xe2, 0x3b, 0x4f, 0x7a,
-	0x7d, 0xad, 0xcd, 0xaf, 0xb0, 0x89, 0x4e, 0x5d, 0x57, 0xdf, 0x82, 0x52, 0x2c, 0x61, 0x71, 0x92,
-	0x35, 0xb5, 0xfe, 0x60, 0xc0, 0xc7, 0x60, 0x0d, 0xd3, 0xd4, 0x37, 0x3c, 0x9d, 0xf9, 0x34, 0x57,
-	0xbc, 0xa5, 0xec, 0x7c, 0x9a, 0x2b, 0xde, 0x51, 0x5e, 0x57, 0x07, 0xb0, 0x15, 0x09, 0x7a, 0x0a,
-	0x9f, 0x27, 0x0b, 0x64, 0x1b, 0xf2, 0x5d, 0xeb, 0xb9, 0xe5, 0x44, 0x71, 0xe0, 0x94, 0x40, 0x68,
-	0x7f, 0xf4, 0x65, 0x27, 0xbe, 0x71, 0x4c, 0x09, 0xd4, 0xec, 0x7a, 0xd2, 0xa5, 0x67, 0xba, 0xd3,
-	0xf7, 0xd7, 0x33, 0x50, 0x8c, 0xb7, 0x8f, 0x3b, 0xb0, 0x3e, 0x1c, 0x88, 0x73, 0x9d, 0xed, 0x07,
-	0xc9, 0x1b, 0x0f, 0xc3, 0xe8, 0x4b, 0x5b, 0x1f, 0x0e, 0xd8, 0xdb, 0xb0, 0xc1, 0xef, 0x68, 0x0a,
-	0x9f, 0xce, 0x76, 0x7a, 0x4b, 0x1a, 0x12, 0x4e, 0x13, 0x34, 0xec, 0x43, 0x28, 0xc5, 0xb5, 0x15,
-	0x8e, 0x93, 0xab, 0x69, 0x86, 0x18, 0xad, 0x25, 0x94, 0x6a, 0x17, 0x6a, 0xe9, 0x0c, 0xd9, 0x4d,
-	0x00, 0x9e, 0xa5, 0xe4, 0xc9, 0x93, 0x20, 0xec, 0x3a, 0x44, 0x57, 0x47, 0x5b, 0x54, 0xb1, 0x6a,
-	0x7c, 0x95, 0xb4, 0xa5, 0xfe, 0xcd, 0x2c, 0x40, 0xa2, 0x80, 0x62, 0x47, 0xc4, 0xee, 0xa0, 0xbc,
-	0x38, 0xb8, 0x7e, 0x05, 0x4a, 0x8e, 0x67, 0x98, 0xf2, 0x13, 0x0f, 0x45, 0x04, 0xd0, 0x30, 0xc9,
-	0xd7, 0xa8, 0x4a, 0x3c, 0x6a, 0x84, 0x5d, 0x81, 0x8d, 0x89, 0xe7, 0x4f, 0x8d, 0x50, 0x04, 0xfd,
-	0x8b, 0x14, 0xee, 0x23, 0xf6, 0xb1, 0xeb, 0xf9, 0x16, 0xaa, 0xe1, 0x2e, 0xc5, 0xfd, 0xe3, 0x18,
-	0x54, 0x04, 0xb0, 0x8b, 0x30, 0xdc, 0x07, 0x2c, 0x77, 0xec, 0x78, 0x81, 0x65, 0xea, 0x23, 0x1e,
-	0x88, 0x53, 0xd1, 0x20, 0x02, 0xed, 0x5e, 0xf0, 0xd6, 0xfa, 0x53, 0xdb, 0x35, 0x42, 0x71, 0x96,
-	0x43, 0xad, 0x8d, 0x20, 0x58, 0xdd, 0x2f, 0x03, 0x4f, 0x78, 0x87, 0xf8, 0x9d, 0xa1, 0x22, 0x02,
-	0xa8, 0xba, 0xaf, 0x02, 0x58, 0xc1, 0xd8, 0x98, 0xf1, 0xcc, 0x4b, 0x94, 0x79, 0x49, 0x40, 0x76,
-	0x2f, 0x58, 0x17, 0x6a, 0xc3, 0x11, 0xee, 0x7b, 0x1e, 0x5a, 0xed, 0x4d, 0xcf, 0x11, 0x7e, 0x9b,
-	0x3b, 0x8b, 0x9a, 0xfa, 0x83, 0x34, 0x19, 0x0f, 0x8b, 0x5c, 0xe0, 0xbd, 0xde, 0x80, 0x4b, 0x2b,
-	0xc8, 0xbe, 0x55, 0x24, 0x9d, 0x13, 0x8d, 0x4e, 0x23, 0x0c, 0xe9, 0x4a, 0x50, 0xbc, 0xc5, 0x67,
-	0xa2, 0xdb, 0x02, 0x7c, 0x77, 0x7f, 0x85, 0x62, 0x65, 0x44, 0x20, 0xa7, 0x18, 0xa4, 0x38, 0x40,
-	0xf3, 0x2e, 0x6c, 0x22, 0x72, 0x62, 0x5b, 0x8e, 0x29, 0x48, 0xf8, 0x35, 0x91, 0xea, 0xd8, 0x73,
-	0xf6, 0x10, 0x4a, 0x74, 0xea, 0xdf, 0xc8, 0x03, 0x24, 0xc6, 0x1d, 0xdd, 0x40, 0x22, 0xaf, 0x4b,
-	0x1c, 0x8d, 0x5c, 0xa0, 0x74, 0xc7, 0x64, 0x0f, 0xe1, 0x8a, 0xb8, 0xef, 0x14, 0x1f, 0xc2, 0xdb,
-	0xae, 0x3e, 0x32, 0xa2, 0xa0, 0x1f, 0x26, 0xb0, 0xfc, 0x78, 0xb5, 0xe3, 0xee, 0x1a, 0xa8, 0x2a,
-	0x6e, 0xca, 0x3c, 0xe1, 0xc5, 0x2c, 0xed, 0x91, 0x95, 0x15, 0x90, 0x84, 0x7d, 0x78, 0x31, 0x63,
-	0xef, 0xc1, 0x65, 0xdf, 0x9a, 0xf8, 0x56, 0x70, 0xa2, 0x87, 0x81, 0x5c, 0x18, 0x8f, 0xca, 0xdb,
-	0x12, 0xc8, 0x61, 0x10, 0x97, 0xf5, 0x1e, 0x5c, 0x16, 0x66, 0xdf, 0x42, 0xf5, 0xf8, 0xc5, 0xf8,
-	0x2d, 0x8e, 0x94, 0x6b, 0x47, 0x21, 0x98, 0x64, 0xf1, 0x46, 0x0f, 0xa5, 0x14, 0xb5, 0x12, 0xb7,
-	0x6e, 0xc5, 0xf5, 0x61, 0x32, 0x5b, 0x69, 0xce, 0x14, 0x35, 0x9e, 0x60, 0x2a, 0xe4, 0x50, 0xb4,
-	0xd2, 0xa9, 0x5f, 0xed, 0x61, 0xed, 0x01, 0x3d, 0x04, 0x43, 0xd7, 0xb9, 0x3d, 0xd3, 0xd2, 0x08,
-	0xc7, 0xde, 0x81, 0x4b, 0x72, 0xb3, 0xa3, 0xb7, 0x0c, 0xf8, 0x39, 0xb4, 0x92, 0x34, 0x54, 0xe3,
-	0xaf, 0x1a, 0xbc, 0x05, 0x4c, 0xaa, 0x79, 0x44, 0x5d, 0x21, 0xea, 0xcd, 0xb8, 0xda, 0x82, 0xf8,
-	0x0d, 0xa0, 0x2a, 0xf2, 0x63, 0x8e, 0xea, 0xb2, 0x8d, 0x87, 0x48, 0x3a, 0xf1, 0x78, 0x0f, 0x2e,
-	0x27, 0xad, 0xd3, 0x8d, 0x50, 0x0f, 0x4f, 0x2c, 0xdd, 0x72, 0x4d, 0xba, 0xa4, 0x56, 0xd4, 0xb6,
-	0xe2, 0x86, 0x36, 0xc2, 0xe1, 0x89, 0x85, 0x56, 0x9a, 0xe4, 0x45, 0xdb, 0xfc, 0x7a, 0x2f, 0xda,
-	0x47, 0x50, 0x4f, 0x9d, 0xea, 0xcb, 0xdd, 0xcd, 0x2f, 0x79, 0x6e, 0xcb, 0x67, 0xf9, 0x71, 0x8f,
-	0xdf, 0x87, 0xad, 0x13, 0x23, 0xd0, 0x53, 0xbc, 0xe4, 0xdc, 0x2b, 0x6a, 0x9b, 0x27, 0x46, 0x70,
-	0x28, 0xf1, 0xa8, 0xbf, 0x9f, 0x81, 0x5a, 0xda, 0xdc, 0xe5, 0x37, 0x67, 0x9c, 0xf9, 0xd4, 0xe5,
-	0x01, 0x69, 0x79, 0x2d, 0x4a, 0xe2, 0x5a, 0xe0, 0x07, 0xfe, 0xf3, 0xa9, 0x1b, 0xad, 0x85, 0xd9,
-	0x69, 0x93, 0xd2, 0xec, 0x4d, 0x28, 0xcc, 0x4e, 0xb9, 0x70, 0x78, 0xd1, 0xec, 0xdb, 0x98, 0xf1,
-	0x00, 0xe4, 0x37, 0xa1, 0x30, 0x17, 0xa4, 0xb9, 0x17, 0x91, 0xce, 0x89, 0x54, 0xfd, 0x57, 0xeb,
-	0x50, 0x91, 0xbd, 0x34, 0xdf, 0x24, 0x12, 0xe0, 0x5b, 0x05, 0x69, 0xec, 0x50, 0x2c, 0xa1, 0x4e,
-	0xd1, 0xce, 0xd8, 0x4f, 0x3c, 0x0c, 0x00, 0x4e, 0x8c, 0xa0, 0x31, 0x0f, 0xbd, 0xa6, 0xc7, 0x0f,
-	0x33, 0x3d, 0x27, 0x8a, 0x82, 0xe6, 0x2b, 0x03, 0x65, 0x82, 0x08, 0x80, 0x7e, 0x4f, 0x5c, 0xb2,
-	0xa0, 0x6b, 0x55, 0x14, 0x76, 0x97, 0x5f, 0x9a, 0x2f, 0x95, 0xe8, 0x56, 0x15, 0x85, 0x19, 0x3e,
-	0x84, 0xcd, 0x24, 0xa4, 0x9d, 0xb3, 0x6c, 0x2c, 0xb1, 0x54, 0xe3, 0x78, 0x76, 0x71, 0xe5, 0xbb,
-	0x6a, 0x07, 0xba, 0xe7, 0x98, 0xd1, 0xdd, 0x99, 0x42, 0xe4, 0x43, 0xef, 0x3b, 0xa6, 0xb8, 0xcb,
-	0xc7, 0x69, 0x5c, 0xeb, 0x2c, 0xa2, 0x89, 0xfd, 0xec, 0x3d, 0xeb, 0x4c, 0xdc, 0xa1, 0xf9, 0x8b,
-	0x0c, 0x6c, 0x2d, 0x39, 0x66, 0x50, 0x72, 0x26, 0x0f, 0x10, 0xe1, 0x27, 0x1a, 0x16, 0x53, 0x23,
-	0x1c, 0x9f, 0xe8, 0x33, 0xdf, 0x9a, 0xd8, 0xe7, 0xd1, 0x2b, 0x4a, 0x04, 0x3b, 0x24, 0x10, 0x6e,
-	0x28, 0xfc, 0x5c, 0x87, 0xbb, 0xae, 0xb9, 0xe0, 0xe3, 0x67, 0x39, 0x5d, 0xf2, 0x59, 0x47, 0x61,
-	0x88, 0xb9, 0x17, 0x84, 0x21, 0x5e, 0x87, 0x92, 0xeb, 0x51, 0xc8, 0xc9, 0xec, 0x54, 0xc4, 0xea,
-	0x14, 0x5c, 0x2f, 0xec, 0xbb, 0x87, 0xa7, 0xec, 0x21, 0x5c, 0x9e, 0x07, 0x74, 0xae, 0x3b, 0xb2,
-	0xfc, 0xe0, 0xc4, 0x8e, 0x4d, 0x24, 0x2e, 0x40, 0x2e, 0xcd, 0x03, 0xeb, 0x20, 0xc6, 0xf1, 0x96,
-	0xa8, 0x37, 0x60, 0xa3, 0x13, 0x3b, 0x94, 0xe2, 0xb8, 0xa7, 0xac, 0x78, 0x7a, 0xc4, 0x83, 0x52,
-	0x93, 0x9e, 0x31, 0x39, 0x30, 0x66, 0xec, 0x3e, 0x64, 0xa7, 0xc6, 0x4c, 0x1c, 0x26, 0xd5, 0xe3,
-	0x13, 0x37, 0x8e, 0x7d, 0x70, 0x60, 0xcc, 0xf8, 0xc6, 0x83, 0x44, 0xd7, 0x3f, 0x82, 0x62, 0x04,
-	0xf8, 0x56, 0x5b, 0xcc, 0xbf, 0x5b, 0x87, 0x52, 0x4b, 0x76, 0x08, 0xa3, 0x6d, 0x1d, 0xfa, 0x73,
-	0x17, 0x75, 0xb7, 0xe8, 0x41, 0x86, 0xb1, 0xe1, 0x0e, 0x05, 0x28, 0x9a, 0xda, 0xeb, 0x5f, 0x33,
-	0xb5, 0x6f, 0x00, 0xf8, 0xe4, 0x4f, 0x21, 0x97, 0x4a, 0x36, 0x8e, 0x03, 0xed, 0x98, 0x1d, 0xf3,
-	0x7c, 0x75, 0x08, 0x4c, 0xee, 0x9b, 0x87, 0xc0, 0xe4, 0x57, 0x86, 0xc0, 0xdc, 0x4d, 0xb6, 0x17,
-	0x9c, 0xe2, 0x58, 0x70, 0x89, 0x6f, 0x72, 0xb3, 0xf8, 0x4a, 0x09, 0x96, 0xfe, 0x3d, 0xa8, 0x45,
-	0xad, 0x13, 0xf9, 0x41, 0xea, 0x16, 0x8b, 0xc0, 0x71, 0x0f, 0x72, 0x35, 0x94, 0x93, 0xe9, 0x25,
-	0x5b, 0x7e, 0x49, 0x98, 0xcd, 0xdf, 0xce, 0x00, 0x13, 0xf6, 0xff, 0xde, 0xdc, 0x71, 0x86, 0xd6,
-	0x39, 0x49, 0x86, 0xfb, 0xb0, 0x25, 0x1c, 0xdc, 0xd2, 0xed, 0x41, 0x71, 0xfc, 0xca, 0x11, 0xc9,
-	0xf1, 0xeb, 0xaa, 0x8b, 0x86, 0xeb, 0x2b, 0x2f, 0x1a, 0xae, 0xbe, 0xc0, 0x78, 0x0b, 0xca, 0xf2,
-	0x35, 0x3d, 0xae, 0x8e, 0x81, 0x91, 0xdc, 0xd0, 0xfb, 0x8b, 0x75, 0x80, 0xc4, 0x47, 0xf1, 0xcb,
-	0x8e, 0x5f, 0x5a, 0x31, 0x24, 0xd9, 0x55, 0x43, 0x72, 0x0f, 0x14, 0x99, 0x4e, 0xba, 0x2f, 0x5a,
-	0x4b, 0x08, 0x23, 0x35, 0xc7, 0x0e, 0xe4, 0x3b, 0x7d, 0x74, 0x3d, 0x40, 0x84, 0x7c, 0x70, 0x24,
-	0x77, 0x98, 0x8a, 0x05, 0x58, 0xb4, 0x03, 0x2e, 0x93, 0xd9, 0x27, 0x70, 0x2d, 0xe6, 0xd4, 0xcf,
-	0xec, 0xf0, 0xc4, 0x9b, 0x87, 0x62, 0xb1, 0x06, 0x42, 0x4a, 0x5d, 0x89, 0x72, 0x7a, 0xc6, 0xd1,
-	0x7c, 0xbd, 0x06, 0xa8, 0xa8, 0x4f, 0xe6, 0x8e, 0xa3, 0x87, 0xd6, 0x79, 0x28, 0xde, 0x78, 0xa8,
-	0xa7, 0xdc, 0x3b, 0xd2, 0xf0, 0x6a, 0xc5, 0x89, 0x48, 0xa8, 0xff, 0x3c, 0x0b, 0xf9, 0x1f, 0xcd,
-	0x2d, 0xff, 0x82, 0x7d, 0x04, 0xa5, 0x20, 0x9c, 0x86, 0xf2, 0x49, 0xeb, 0x35, 0x9e, 0x01, 0xe1,
-	0xe9, 0xa0, 0xd4, 0x9a, 0x5a, 0x6e, 0xc8, 0xfd, 0x9e, 0x48, 0x4b, 0x1b, 0xd0, 0x36, 0xe4, 0x83,
-	0xd0, 0x9a, 0x05, 0x22, 0xb6, 0x91, 0x27, 0xd8, 0x0e, 0xe4, 0x5d, 0xcf, 0xb4, 0x82, 0x74, 0x04,
-	0x63, 0x0f, 0x35, 0x0e, 0x8e, 0x60, 0x2a, 0x6c, 0xc4, 0x23, 0xbe, 0x74, 0xda, 0xc9, 0x31, 0x74,
-	0x2f, 0xc2, 0x32, 0x4c, 0xdb, 0x3d, 0x8e, 0xee, 0xdf, 0xc6, 0x69, 0xdc, 0x5a, 0x49, 0xc1, 0x37,
-	0x8e, 0xa3, 0x7b, 0xfb, 0x22, 0xc9, 0x76, 0xa0, 0x8c, 0x9f, 0xcf, 0x7c, 0x3b, 0xb4, 0x06, 0x8f,
-	0x22, 0xe9, 0x2e, 0x81, 0x50, 0x3d, 0x37, 0xad, 0xd0, 0x1a, 0x87, 0x83, 0xaf, 0x44, 0x5c, 0x60,
-	0x49, 0x93, 0x20, 0xec, 0x7b, 0xc0, 0x46, 0xc6, 0xf8, 0xf4, 0xd8, 0xa7, 0xd3, 0xfc, 0xaf, 0xe6,
-	0x96, 0x6f, 0x5b, 0x51, 0x1c, 0x60, 0x59, 0xea, 0x14, 0x6d, 0x2b, 0x21, 0xfb, 0x11, 0xa7, 0x42,
-	0xc3, 0x62, 0x6a, 0x9c, 0xb7, 0xbc, 0x99, 0x08, 0xb3, 0x12, 0x29, 0xf5, 0x37, 0xa0, 0x9a, 0xea,
-	0xc2, 0x25, 0xf7, 0xd0, 0xa0, 0xdd, 0x6d, 0x37, 0x87, 0xdc, 0xdc, 0x14, 0x6e, 0x8b, 0x75, 0xc9,
-	0xbf, 0x91, 0x93, 0xcc, 0xd0, 0x3c, 0x79, 0x47, 0xda, 0xda, 0x93, 0xb6, 0xb2, 0xa1, 0xe6, 0x8a,
-	0x59, 0x25, 0xab, 0xfe, 0x9d, 0x75, 0xd8, 0x1a, 0xfa, 0x86, 0x1b, 0x18, 0x5c, 0x1d, 0x71, 0x43,
-	0xdf, 0x73, 0xd8, 0xf7, 0xa0, 0x18, 0x8e, 0x1d, 0x79, 0x4c, 0x6f, 0x45, 0x12, 0x64, 0x81, 0xf4,
-	0xc1, 0x70, 0xcc, 0x3d, 0xda, 0x85, 0x90, 0x7f, 0xb0, 0x77, 0x20, 0x3f, 0xb2, 0x8e, 0x6d, 0x57,
-	0x08, 0xd1, 0xcb, 0x8b, 0x8c, 0xbb, 0x88, 0xdc, 0x5f, 0xd3, 0x38, 0x15, 0x7b, 0x0f, 0x36, 0xc6,
-	0xde, 0x34, 0xda, 0xbd, 0x92, 0x8b, 0x5d, 0x52, 0x41, 0x88, 0xdd, 0x5f, 0xd3, 0x04, 0x1d, 0xfb,
-	0x08, 0x8a, 0xbe, 0xe7, 0x38, 0xd8, 0x85, 0x62, 0x5f, 0xab, 0x2f, 0xf2, 0x68, 0x02, 0xbf, 0xbf,
-	0xa6, 0xc5, 0xb4, 0xea, 0x03, 0x28, 0x88, 0xca, 0x62, 0x37, 0xec, 0xb6, 0x9f, 0x74, 0x44, 0x0f,
-	0x36, 0xfb, 0x07, 0x07, 0x9d, 0x21, 0xbf, 0xb5, 0xaa, 0xf5, 0xbb, 0xdd, 0xdd, 0x46, 0xf3, 0x33,
-	0x65, 0x7d, 0xb7, 0x08, 0x1b, 0xdc, 0x77, 0xa9, 0xfe, 0x56, 0x06, 0x36, 0x17, 0x1a, 0xc0, 0x1e,
-	0x43, 0x6e, 0x8a, 0xea, 0x31, 0xef, 0x9e, 0x3b, 0x2b, 0x5b, 0x29, 0xa5, 0xb9, 0xd2, 0x8c, 0x1c,
-	0xea, 0x27, 0x50, 0x4b, 0xc3, 0x25, 0x97, 0x44, 0x15, 0x4a, 0x5a, 0xbb, 0xd1, 0xd2, 0xfb, 0xbd,
-	0xee, 0x17, 0xdc, 0xc3, 0x47, 0xc9, 0x67, 0x5a, 0x87, 0xbc, 0x08, 0xbf, 0x0e, 0xca, 0x62, 0xc7,
-	0xb0, 0x27, 0x68, 0x00, 0x4d, 0x67, 0x8e, 0x45, 0x7a, 0xa6, 0x34, 0x64, 0x37, 0x57, 0xf4, 0xa4,
-	0x20, 0xe3, 0x11, 0x25, 0xe3, 0x54, 0x5a, 0xfd, 0x0d, 0x60, 0xcb, 0x3d, 0xf8, 0xcb, 0xcb, 0xfe,
-	0x7f, 0x65, 0x20, 0x77, 0xe8, 0x18, 0x2e, 0xbb, 0x0d, 0x79, 0x7a, 0x18, 0x46, 0x88, 0x62, 0x79,
-	0x61, 0xe0, 0xb4, 0x20, 0x1c, 0x7b, 0x0b, 0xb2, 0xe1, 0x38, 0xba, 0x2c, 0x7b, 0xf5, 0x05, 0x93,
-	0x6f, 0x7f, 0x4d, 0x43, 0x2a, 0x76, 0x0f, 0xb2, 0xa6, 0x19, 0x05, 0x98, 0x0b, 0xc7, 0x04, 0x9a,
-	0xa5, 0x2d, 0x6b, 0x62, 0xbb, 0xb6, 0x78, 0xc8, 0x06, 0x49, 0xd8, 0xeb, 0x90, 0x35, 0xc7, 0x4e,
-	0xfa, 0xb6, 0x00, 0x37, 0x60, 0xe3, 0x0c, 0xcd, 0xb1, 0x83, 0x6a, 0x5c, 0xe8, 0x5f, 0xe8, 0xfe,
-	0xdc, 0xa5, 0xd0, 0xc4, 0x40, 0x98, 0x56, 0x65, 0x54, 0x48, 0xe6, 0x14, 0xdf, 0x18, 0x88, 0x5b,
-	0x77, 0x33, 0xdf, 0x9a, 0x19, 0x7e, 0x6c, 0x54, 0xd9, 0xc1, 0x21, 0x07, 0xec, 0x6e, 0x00, 0xbd,
-	0xb7, 0xa9, 0xbe, 0x4d, 0xaf, 0x96, 0xa0, 0x76, 0xae, 0x46, 0x5f, 0x2b, 0x9e, 0x66, 0x13, 0x18,
-	0xf5, 0x2f, 0xb3, 0x50, 0x96, 0xea, 0xc3, 0x3e, 0x80, 0xa2, 0x99, 0x5e, 0x88, 0xd7, 0x96, 0x2a,
-	0xfd, 0xa0, 0x15, 0x2d, 0x41, 0x53, 0x4c, 0x6f, 0x3a, 0x2e, 0x09, 0xf5, 0xe7, 0x86, 0x6f, 0xf3,
-	0xb7, 0xaa, 0xd6, 0xe5, 0x73, 0x8b, 0x81, 0x15, 0x3e, 0x8d, 0x30, 0xfb, 0x6b, 0x5a, 0x25, 0x90,
-	0xd2, 0x64, 0x42, 0x88, 0x26, 0x65, 0x53, 0x6f, 0x7e, 0x71, 0xe0, 0xfe, 0x9a, 0x16, 0xe1, 0x91,
-	0xd4, 0x3a, 0xb7, 0xc6, 0xf3, 0x30, 0x32, 0x21, 0xaa, 0x51, 0x83, 0x08, 0x48, 0x0f, 0x0f, 0xf2,
-	0x4f, 0xf6, 0x10, 0x05, 0xa7, 0xe1, 0x38, 0x1e, 0xe9, 0x5d, 0x79, 0xf9, 0x14, 0xa1, 0x15, 0xc3,
-	0xf9, 0x43, 0x87, 0x51, 0x8a, 0xdd, 0x85, 0xbc, 0x17, 0x9e, 0x58, 0x91, 0x62, 0x1e, 0xbd, 0x7f,
-	0x82, 0xa0, 0x56, 0xb3, 0x8b, 0x33, 0x85, 0xd0, 0xea, 0x1f, 0x64, 0xa0, 0x20, 0x7a, 0x80, 0x6d,
-	0x41, 0x75, 0xd0, 0x1e, 0xea, 0x4f, 0x1b, 0x5a, 0xa7, 0xb1, 0xdb, 0x6d, 0x8b, 0x4b, 0x0e, 0x4f,
-	0xb4, 0x46, 0x4f, 0x08, 0x48, 0xad, 0xfd, 0xb4, 0xff, 0x59, 0x9b, 0xfb, 0xf9, 0x5a, 0xed, 0xde,
-	0x17, 0x4a, 0x96, 0x3b, 0xb5, 0xdb, 0x87, 0x0d, 0x0d, 0x65, 0x65, 0x19, 0x0a, 0xed, 0xcf, 0xdb,
-	0xcd, 0x23, 0x12, 0x96, 0x35, 0x80, 0x56, 0xbb, 0xd1, 0xed, 0xf6, 0x9b, 0x28, 0x3c, 0x37, 0x18,
-	0x83, 0x5a, 0x53, 0x6b, 0x37, 0x86, 0x6d, 0xbd, 0xd1, 0x6c, 0xf6, 0x8f, 0x7a, 0x43, 0xa5, 0x80,
-	0x25, 0x36, 0xba, 0xc3, 0xb6, 0x16, 0x83, 0xe8, 0x4d, 0xaa, 0x96, 0xd6, 0x3f, 0x8c, 0x21, 0xa5,
-	0xdd, 0x12, 0x9a, 0x73, 0x34, 0x56, 0xea, 0x1f, 0x6f, 0x41, 0x2d, 0x3d, 0x35, 0xd9, 0xc7, 0x50,
-	0x34, 0xcd, 0xd4, 0x18, 0xdf, 0x58, 0x35, 0x85, 0x1f, 0xb4, 0xcc, 0x68, 0x98, 0xf9, 0x07, 0x7b,
-	0x2d, 0x5a, 0x48, 0xeb, 0x4b, 0x0b, 0x29, 0x5a, 0x46, 0x3f, 0x80, 0x4d, 0xf1, 0x28, 0x87, 0x69,
-	0x84, 0xc6, 0xc8, 0x08, 0xac, 0xf4, 0x2a, 0x69, 0x12, 0xb2, 0x25, 0x70, 0xfb, 0x6b, 0x5a, 0x6d,
-	0x9c, 0x82, 0xb0, 0xef, 0x43, 0xcd, 0x20, 0x8b, 0x3d, 0xe6, 0xcf, 0xc9, 0x1a, 0x65, 0x03, 0x71,
-	0x12, 0x7b, 0xd5, 0x90, 0x01, 0x38, 0x11, 0x4d, 0xdf, 0x9b, 0x25, 0xcc, 0xf9, 0xd4, 0x01, 0x9a,
-	0xef, 0xcd, 0x24, 0xde, 0x8a, 0x29, 0xa5, 0xd9, 0x47, 0x50, 0x11, 0x35, 0x4f, 0xbc, 0x16, 0xf1,
-	0x92, 0xe5, 0xd5, 0x26, 0x0d, 0x71, 0x7f, 0x4d, 0x2b, 0x8f, 0x93, 0x24, 0x7b, 0x84, 0x6a, 0x61,
-	0xa2, 0x4f, 0x17, 0xe4, 0xb9, 0x46, 0xb5, 0x8d, 0xb8, 0xc0, 0x88, 0x53, 0xec, 0x3d, 0x00, 0xaa,
-	0x27, 0xe7, 0x29, 0xa6, 0x42, 0x4b, 0x7c, 0x6f, 0x16, 0xb1, 0x94, 0xcc, 0x28, 0x21, 0x55, 0x8f,
-	0xfb, 0x9c, 0x4a, 0xcb, 0xd5, 0x23, 0xbf, 0x53, 0x52, 0x3d, 0xee, 0xae, 0x8a, 0xab, 0xc7, 0xd9,
-	0x60, 0xa9, 0x7a, 0x11, 0x17, 0xaf, 0x1e, 0x67, 0x8a, 0xaa, 0xc7, 0x79, 0xca, 0x8b, 0xd5, 0x8b,
-	0x58, 0xa8, 0x7a, 0x9c, 0xe3, 0xfb, 0x4b, 0x86, 0x40, 0xe5, 0x85, 0x86, 0x00, 0x0e, 0x5b, 0xda,
-	0x14, 0xf8, 0x3e, 0xd4, 0x82, 0x13, 0xef, 0x4c, 0x12, 0x20, 0x55, 0x99, 0x7b, 0x70, 0xe2, 0x9d,
-	0xc9, 0x12, 0xa4, 0x1a, 0xc8, 0x00, 0xac, 0x2d, 0x6f, 0x22, 0x1d, 0x76, 0xd5, 0xe4, 0xda, 0x52,
-	0x0b, 0x9f, 0xda, 0xd6, 0x19, 0xd6, 0xd6, 0x88, 0x12, 0xd8, 0x29, 0x89, 0x07, 0x27, 0x10, 0x3e,
-	0x99, 0x54, 0x40, 0x86, 0x28, 0x09, 0x62, 0x5f, 0x4e, 0x80, 0x73, 0x6b, 0xee, 0xca, 0x6c, 0x8a,
-	0x3c, 0xb7, 0x8e, 0xdc, 0x14, 0x63, 0x85, 0x93, 0x0a, 0xd6, 0x64, 0x55, 0x04, 0xd6, 0x57, 0x73,
-	0xcb, 0x1d, 0x5b, 0x22, 0xf0, 0x2a, 0xb5, 0x2a, 0x06, 0x02, 0x97, 0xac, 0x8a, 0x08, 0x12, 0xcf,
-	0xeb, 0x98, 0x9d, 0x2d, 0xce, 0x6b, 0x89, 0x99, 0xe6, 0x75, 0xcc, 0x1a, 0x2f, 0xa8, 0x98, 0xf7,
-	0xd2, 0xd2, 0x82, 0x92, 0x98, 0xf9, 0x82, 0x8a, 0xb9, 0x1f, 0x81, 0x98, 0x4d, 0xbc, 0x73, 0x53,
-	0xe1, 0x59, 0xbc, 0xd6, 0xa2, 0x77, 0x61, 0x1c, 0xa7, 0x70, 0xae, 0xfa, 0x16, 0x1a, 0x1e, 0x62,
-	0x2a, 0x5c, 0x96, 0xe7, 0xaa, 0x46, 0x98, 0x78, 0x29, 0xf9, 0x49, 0x52, 0x2a, 0x6c, 0x66, 0x87,
-	0x7e, 0xdd, 0x5c, 0x2e, 0xec, 0xd0, 0x0e, 0xfd, 0xa4, 0x30, 0x4c, 0xb1, 0x77, 0x80, 0xa6, 0x21,
-	0x67, 0xb1, 0x64, 0xd1, 0x8d, 0xdd, 0x22, 0x18, 0x8a, 0xa6, 0xf8, 0xc6, 0xc9, 0x22, 0xca, 0x18,
-	0x9b, 0xe3, 0xfa, 0x44, 0x9e, 0x2c, 0xbc, 0x88, 0x66, 0xab, 0x89, 0x93, 0x85, 0x13, 0x35, 0xcd,
-	0x31, 0xbb, 0x0f, 0xc4, 0x4d, 0xf4, 0xc7, 0xa9, 0xf7, 0xb5, 0x7c, 0x6f, 0xc6, 0xa9, 0x0b, 0x48,
-	0x80, 0xb4, 0xd8, 0x02, 0xc7, 0x73, 0xa3, 0x86, 0x9f, 0xa4, 0x5a, 0x80, 0x88, 0x58, 0x18, 0x8c,
-	0xe3, 0x94, 0xfa, 0x3b, 0x1b, 0x50, 0x10, 0xb2, 0x96, 0x5d, 0x82, 0x4d, 0x21, 0xf2, 0x5b, 0x8d,
-	0x61, 0x63, 0xb7, 0x31, 0x40, 0x25, 0x8d, 0x41, 0x8d, 0xcb, 0xfc, 0x18, 0x96, 0xc1, 0x7d, 0x80,
-	0x84, 0x7e, 0x0c, 0x5a, 0xc7, 0x7d, 0x40, 0xf0, 0xf2, 0x77, 0x0c, 0xb3, 0x6c, 0x13, 0xca, 0x9c,
-	0x91, 0x03, 0xe8, 0xce, 0x25, 0x71, 0xf1, 0x74, 0x5e, 0x62, 0xe1, 0xc7, 0x5e, 0x1b, 0x09, 0x0b,
-	0x07, 0x14, 0x62, 0x96, 0xe8, 0x5c, 0x8c, 0x41, 0x6d, 0xa8, 0x1d, 0xf5, 0x9a, 0x49, 0x39, 0x25,
-	0xba, 0x27, 0xc7, 0xb3, 0x79, 0xda, 0x69, 0x3f, 0x53, 0x00, 0x99, 0x78, 0x2e, 0x94, 0x2e, 0xa3,
-	0x9a, 0x49, 0x99, 0x50, 0xb2, 0xc2, 0xae, 0xc2, 0xa5, 0xc1, 0x7e, 0xff, 0x99, 0xce, 0x99, 0xe2,
-	0x26, 0x54, 0xd9, 0x36, 0x28, 0x12, 0x82, 0x67, 0x5f, 0xc3, 0x22, 0x09, 0x1a, 0x11, 0x0e, 0x94,
-	0x4d, 0x3a, 0x42, 0x46, 0xd8, 0x90, 0xef, 0xbb, 0x0a, 0x36, 0x85, 0xb3, 0xf6, 0xbb, 0x47, 0x07,
-	0xbd, 0x81, 0xb2, 0x85, 0x95, 0x20, 0x08, 0xaf, 0x39, 0x8b, 0xb3, 0x49, 0x76, 0xeb, 0x4b, 0xb4,
-	0x81, 0x23, 0xec, 0x59, 0x43, 0xeb, 0x75, 0x7a, 0x4f, 0x06, 0xca, 0x76, 0x9c, 0x73, 0x5b, 0xd3,
-	0xfa, 0xda, 0x40, 0xb9, 0x1c, 0x03, 0x06, 0xc3, 0xc6, 0xf0, 0x68, 0xa0, 0x5c, 0x89, 0x6b, 0x79,
-	0xa8, 0xf5, 0x9b, 0xed, 0xc1, 0xa0, 0xdb, 0x19, 0x0c, 0x95, 0xab, 0xec, 0x32, 0x6c, 0x25, 0x35,
-	0x8a, 0x88, 0xeb, 0x52, 0x45, 0xb5, 0x27, 0xed, 0xa1, 0x72, 0x2d, 0xae, 0x46, 0xb3, 0xdf, 0xed,
-	0x36, 0xe8, 0x7c, 0xf4, 0x3a, 0x12, 0xd1, 0x91, 0xb0, 0x68, 0xcd, 0x2b, 0x58, 0xaf, 0xa3, 0x9e,
-	0x0c, 0xba, 0x21, 0x4d, 0x8d, 0x41, 0xfb, 0x47, 0x47, 0xed, 0x5e, 0xb3, 0xad, 0xbc, 0x9a, 0x4c,
-	0x8d, 0x18, 0x76, 0x33, 0x9e, 0x1a, 0x31, 0xe8, 0x56, 0x5c, 0x66, 0x04, 0x1a, 0x28, 0x3b, 0x98,
-	0x9f, 0xa8, 0x47, 0xaf, 0xd7, 0x6e, 0x0e, 0xb1, 0xad, 0xaf, 0xc5, 0xbd, 0x78, 0x74, 0xf8, 0x44,
-	0x6b, 0xb4, 0xda, 0x8a, 0x8a, 0x10, 0xad, 0xdd, 0x6b, 0x1c, 0x44, 0xa3, 0x7d, 0x5b, 0x1a, 0xed,
-	0xc3, 0xce, 0x50, 0x53, 0xee, 0xc4, 0xa3, 0x4b, 0xc9, 0xd7, 0xd9, 0x2b, 0x70, 0x55, 0x9e, 0x87,
-	0xfa, 0xb3, 0xce, 0x70, 0x5f, 0x1c, 0xe7, 0xde, 0xe5, 0x47, 0x91, 0x84, 0x6c, 0xb6, 0x9a, 0xfc,
-	0xdc, 0x9a, 0x78, 0x31, 0x75, 0x6f, 0xb7, 0x42, 0xcf, 0x51, 0x0b, 0x05, 0x44, 0xfd, 0x14, 0x98,
-	0xfc, 0x32, 0xab, 0x88, 0x5f, 0x65, 0x90, 0x9b, 0xf8, 0xde, 0x34, 0x7a, 0xff, 0x00, 0xbf, 0xd1,
-	0x94, 0x9e, 0xcd, 0x47, 0x74, 0x44, 0x9a, 0xdc, 0x6f, 0x96, 0x41, 0xea, 0x3f, 0xcc, 0x40, 0x2d,
-	0xad, 0x7c, 0x90, 0xef, 0x74, 0xa2, 0xbb, 0x5e, 0xc8, 0x1f, 0x94, 0x0a, 0xe2, 0x07, 0x53, 0x27,
-	0x3d, 0x2f, 0xa4, 0x17, 0xa5, 0xc8, 0xb2, 0x8f, 0x75, 0x09, 0x9e, 0x6b, 0x9c, 0x66, 0x1d, 0xb8,
-	0x94, 0x7a, 0xdc, 0x36, 0xf5, 0x9c, 0x57, 0x3d, 0x7e, 0x94, 0x72, 0xa1, 0xfe, 0x1a, 0x0b, 0x96,
-	0xdb, 0x24, 0x6e, 0xa9, 0xe7, 0x92, 0x5b, 0xea, 0xfb, 0x50, 0x4d, 0xe9, 0x3a, 0xe4, 0x90, 0x99,
-	0xa4, 0x6b, 0x5a, 0xb4, 0x27, 0x2f, 0xaf, 0xa6, 0xfa, 0xf7, 0x33, 0x50, 0x91, 0x35, 0x9f, 0xef,
-	0x9c, 0x13, 0x85, 0x95, 0x88, 0x6f, 0xdd, 0x36, 0xa3, 0x87, 0xa4, 0x22, 0x50, 0x87, 0x9e, 0xe1,
-	0xe7, 0x6e, 0xe8, 0xbd, 0xd3, 0x41, 0xdc, 0x1c, 0x19, 0xc4, 0x6e, 0x02, 0xd0, 0x23, 0x2f, 0x7b,
-	0x9f, 0x21, 0x81, 0x78, 0x85, 0x38, 0x81, 0xa8, 0xb7, 0xa0, 0xb4, 0x77, 0x1a, 0x45, 0xd9, 0xc8,
-	0xcf, 0xaa, 0x95, 0xf8, 0xa5, 0x78, 0xf5, 0x0f, 0x33, 0x50, 0x4b, 0x9e, 0xa0, 0xa1, 0xc3, 0x69,
-	0xfe, 0x28, 0x32, 0x9f, 0x0e, 0xeb, 0xe6, 0x28, 0x79, 0xa1, 0x7f, 0x5d, 0x7e, 0xa1, 0xff, 0xb6,
-	0xc8, 0x2c, 0x2b, 0x8b, 0xfc, 0xb8, 0x2c, 0x71, 0xe5, 0xfe, 0x11, 0x54, 0xf0, 0xbf, 0x66, 0x4d,
-	0x2c, 0xdf, 0xb7, 0xcc, 0xf4, 0x0d, 0x80, 0x84, 0x38, 0x45, 0x44, 0x36, 0x9e, 0x35, 0x11, 0xaa,
-	0xe6, 0xca, 0x57, 0x72, 0xe8, 0xf5, 0xa6, 0xff, 0x91, 0x85, 0xb2, 0xa4, 0x47, 0x7e, 0xa3, 0xe9,
-	0x77, 0x03, 0x4a, 0xc9, 0x9b, 0x2d, 0xe2, 0x9e, 0x73, 0x0c, 0x48, 0x8d, 0x55, 0x76, 0x61, 0xac,
-	0xea, 0x50, 0xf0, 0xf9, 0xbd, 0x44, 0xe1, 0x0d, 0x8e, 0x92, 0x69, 0xbf, 0x6b, 0xfe, 0x25, 0x47,
-	0x25, 0xef, 0x43, 0x45, 0x72, 0x9a, 0x06, 0xf5, 0x0d, 0xf9, 0xe1, 0x9e, 0x98, 0xbe, 0x9c, 0x38,
-	0x50, 0x03, 0x76, 0x19, 0x36, 0x26, 0xa7, 0xba, 0x39, 0xe2, 0xb7, 0x5f, 0x4b, 0x5a, 0x7e, 0x72,
-	0xda, 0x1a, 0xd1, 0x41, 0xd2, 0x24, 0x56, 0x9d, 0xb8, 0x2b, 0xab, 0x38, 0x89, 0x14, 0xa4, 0x7b,
-	0x50, 0x98, 0x9c, 0xca, 0xb7, 0x58, 0x97, 0xba, 0x7c, 0x63, 0x72, 0x4a, 0x77, 0x57, 0xdf, 0x85,
-	0x6d, 0xb1, 0x7f, 0x1b, 0x81, 0xce, 0x9f, 0x94, 0xa0, 0xb7, 0x7c, 0xf8, 0x23, 0x6b, 0x5b, 0x1c,
-	0xd7, 0x08, 0x06, 0x84, 0xc1, 0x19, 0xa7, 0x42, 0x45, 0x9a, 0x80, 0xfc, 0xd1, 0xa3, 0x92, 0x96,
-	0x82, 0xb1, 0xc7, 0x50, 0x99, 0x9c, 0xf2, 0x01, 0x1d, 0x7a, 0x07, 0x96, 0x08, 0xf4, 0xdf, 0x5e,
-	0x1c, 0x4a, 0x8a, 0x1e, 0x48, 0x51, 0xb2, 0x2b, 0xb0, 0xa1, 0x19, 0x67, 0x83, 0x1f, 0x75, 0x49,
-	0x89, 0x2c, 0x69, 0x22, 0xf5, 0x69, 0xae, 0x58, 0x53, 0x36, 0xd5, 0x7f, 0x96, 0x81, 0x5a, 0x62,
-	0x03, 0xe0, 0x22, 0x64, 0xf7, 0xe5, 0xd7, 0xcc, 0xeb, 0x8b, 0x66, 0x02, 0x92, 0x3c, 0x18, 0x5e,
-	0xcc, 0xf8, 0x9b, 0x9f, 0xab, 0x1e, 0xaa, 0x5a, 0xe5, 0xc5, 0xce, 0xae, 0x7c, 0x47, 0xf9, 0x09,
-	0x64, 0x87, 0x17, 0x33, 0xee, 0x6f, 0xc2, 0x2d, 0x91, 0xdb, 0xa6, 0x7c, 0x33, 0xa4, 0x50, 0x95,
-	0xcf, 0xda, 0x5f, 0xf0, 0x77, 0x19, 0x0e, 0xb5, 0xce, 0x41, 0x43, 0xfb, 0x42, 0x47, 0x00, 0x29,
-	0x0d, 0x7b, 0x7d, 0xad, 0xdd, 0x79, 0xd2, 0x23, 0x40, 0x8e, 0xbc, 0x51, 0x49, 0x15, 0x1b, 0xa6,
-	0xb9, 0x77, 0x2a, 0xbf, 0xf1, 0x93, 0x49, 0xbd, 0xf1, 0x13, 0x3f, 0x87, 0x25, 0xbf, 0x0d, 0x18,
-	0xc6, 0xae, 0x75, 0x16, 0xaf, 0xc2, 0x78, 0x49, 0xb3, 0x37, 0x20, 0x37, 0x39, 0xb5, 0x2e, 0xd2,
-	0x86, 0x5e, 0x7a, 0x01, 0x11, 0x81, 0xfa, 0xf3, 0x0c, 0xb0, 0x54, 0x45, 0xb8, 0xed, 0xf1, 0x5d,
-	0xeb, 0xf2, 0x31, 0xd4, 0x45, 0x20, 0x1d, 0xa7, 0x92, 0xdc, 0xe6, 0xa2, 0x4b, 0x2f, 0x7b, 0x49,
-	0x80, 0x69, 0xf2, 0x96, 0x16, 0x7b, 0x17, 0xf8, 0xb3, 0x7f, 0x14, 0x6c, 0x92, 0x7b, 0x81, 0x9d,
-	0xa8, 0x25, 0x34, 0xc9, 0x3b, 0x7f, 0xf2, 0xfb, 0x85, 0xdc, 0xe3, 0xbe, 0x99, 0x8c, 0x1a, 0xad,
-	0x79, 0xf5, 0xf7, 0x32, 0x70, 0x29, 0x3d, 0x21, 0x7e, 0xb1, 0x56, 0xa6, 0x1f, 0x6b, 0xcc, 0x2e,
-	0x3e, 0xd6, 0xb8, 0x6a, 0x3e, 0xe5, 0x56, 0xce, 0xa7, 0xdf, 0xce, 0xc0, 0xb6, 0xd4, 0xfb, 0x89,
-	0xb5, 0xf8, 0x57, 0x54, 0x33, 0xe9, 0xcd, 0xc6, 0x5c, 0xea, 0xcd, 0x46, 0xf5, 0x4f, 0x32, 0x70,
-	0x65, 0xa1, 0x26, 0x9a, 0xf5, 0x57, 0x5a, 0x97, 0xf4, 0xdb, 0x8e, 0xe4, 0xf5, 0x8f, 0xde, 0x2e,
-	0xc8, 0xdc, 0xcb, 0x4a, 0x6f, 0x3b, 0xd2, 0x51, 0x10, 0x9d, 0x47, 0xbe, 0x2a, 0x9e, 0xb8, 0xd1,
-	0x83, 0x0b, 0x77, 0x2c, 0x06, 0xbb, 0x44, 0x90, 0xc1, 0x85, 0x3b, 0x56, 0xff, 0x38, 0x03, 0xd7,
-	0x16, 0xda, 0xd0, 0x98, 0x87, 0x9e, 0x38, 0xd6, 0xfd, 0x2b, 0x6a, 0xc6, 0x2d, 0x28, 0xd3, 0xa1,
-	0xb7, 0x38, 0x2b, 0xe6, 0xdd, 0x0a, 0x46, 0x52, 0xae, 0x02, 0x59, 0xd3, 0xb8, 0x10, 0xf7, 0xc3,
-	0xf1, 0x13, 0x17, 0xec, 0x89, 0x37, 0xf7, 0xc5, 0x7d, 0x70, 0xfa, 0x56, 0x3f, 0x80, 0xad, 0xa4,
-	0xea, 0x4d, 0xf1, 0xc4, 0xe6, 0x2d, 0x28, 0xbb, 0xd6, 0x99, 0x1e, 0x3d, 0xc0, 0x29, 0x22, 0xa7,
-	0x5c, 0xeb, 0x4c, 0x10, 0xa8, 0x7b, 0xb2, 0x2c, 0x8c, 0x7f, 0x38, 0xc0, 0x31, 0x53, 0x21, 0x38,
-	0x9e, 0x63, 0x46, 0x28, 0xcc, 0x4d, 0x6a, 0x65, 0xc1, 0xb5, 0xce, 0x68, 0x1e, 0x9e, 0x89, 0x7c,
-	0x1a, 0xa6, 0x29, 0xc2, 0x10, 0x56, 0xbd, 0x88, 0x75, 0x0d, 0x8a, 0x33, 0x3f, 0xd5, 0x4d, 0x85,
-	0x99, 0xcf, 0x8b, 0xbd, 0x23, 0xe2, 0xb2, 0x5e, 0x14, 0xb2, 0xc0, 0x23, 0xb5, 0xc4, 0x0f, 0x8b,
-	0xe4, 0x92, 0x1f, 0x16, 0xf9, 0x50, 0x88, 0x41, 0xb2, 0xfb, 0x78, 0xc9, 0x0a, 0x64, 0x6d, 0xf3,
-	0x9c, 0x0a, 0xae, 0x6a, 0xf8, 0x49, 0x9a, 0x9c, 0xf5, 0x95, 0x08, 0x0d, 0xc3, 0x4f, 0x75, 0x17,
-	0xca, 0x5a, 0xca, 0xc8, 0xad, 0x48, 0xfe, 0xa2, 0x20, 0xfd, 0x68, 0x50, 0xd2, 0x41, 0x5a, 0x39,
-	0x71, 0x17, 0x05, 0x6a, 0x20, 0x04, 0xdf, 0x53, 0xc3, 0x1f, 0x9f, 0x18, 0x7e, 0xd7, 0x72, 0x8f,
-	0xc3, 0x13, 0xec, 0x72, 0xee, 0xc6, 0x95, 0xbb, 0x10, 0x38, 0x28, 0x9a, 0x0e, 0xd8, 0x8b, 0x0e,
-	0x91, 0x47, 0x3f, 0x59, 0xe0, 0x5a, 0x67, 0x82, 0xff, 0x55, 0x00, 0xec, 0x7f, 0x81, 0xe6, 0xa7,
-	0x89, 0x25, 0xcf, 0x31, 0x39, 0x5a, 0xdd, 0x12, 0xed, 0x15, 0xef, 0x1b, 0xb4, 0xac, 0x89, 0xea,
-	0x88, 0x91, 0xe7, 0x0d, 0x12, 0x9d, 0xf0, 0x9d, 0x86, 0x91, 0xbd, 0x06, 0x95, 0xc8, 0x23, 0x41,
-	0xef, 0x54, 0xf1, 0xe2, 0xcb, 0x11, 0xac, 0x37, 0x9f, 0xaa, 0xbf, 0x9f, 0x85, 0x4a, 0x83, 0x07,
-	0xe9, 0xcc, 0x2e, 0xfa, 0xb3, 0x90, 0xfd, 0x06, 0x5c, 0xa6, 0x87, 0x2b, 0xf8, 0xd3, 0xb1, 0x14,
-	0x1b, 0x43, 0xd1, 0xd5, 0xa2, 0x13, 0xef, 0x4b, 0x9d, 0x28, 0x58, 0x1e, 0x0c, 0x4e, 0xed, 0x19,
-	0x0f, 0xea, 0xef, 0x98, 0xe7, 0x14, 0x41, 0xcf, 0x8f, 0xf9, 0xe9, 0x8d, 0x8b, 0x34, 0x82, 0xae,
-	0xb9, 0x63, 0xf6, 0xb3, 0x53, 0x91, 0xad, 0x88, 0x80, 0x40, 0xe0, 0xe1, 0x29, 0xa7, 0xb9, 0x0f,
-	0x5b, 0xfc, 0x1e, 0xcf, 0xf2, 0x06, 0xbc, 0xc9, 0x11, 0xc9, 0xfc, 0x1e, 0xc0, 0x96, 0x78, 0x67,
-	0x83, 0x1e, 0x54, 0xd4, 0xc7, 0xde, 0xec, 0x42, 0x9c, 0x22, 0xbe, 0xf1, 0x82, 0xaa, 0x76, 0x38,
-	0x29, 0x82, 0x78, 0x3d, 0x37, 0x83, 0x34, 0xf4, 0x7a, 0x1b, 0xae, 0xbe, 0xa0, 0x4d, 0x2f, 0x8b,
-	0x54, 0x28, 0x4a, 0x91, 0x0a, 0xd7, 0x77, 0x61, 0x7b, 0x55, 0x79, 0xdf, 0x26, 0x0f, 0xf5, 0x67,
-	0x55, 0x80, 0x64, 0xc6, 0xa6, 0xd4, 0xd1, 0xcc, 0x82, 0x3a, 0xfa, 0xad, 0xe2, 0x73, 0x3e, 0x80,
-	0x1a, 0x76, 0x95, 0x9e, 0x70, 0x64, 0x57, 0x72, 0x54, 0x90, 0x6a, 0x98, 0x5c, 0x55, 0x5c, 0x8e,
-	0x6e, 0xc8, 0xad, 0x8c, 0x6e, 0x78, 0x1f, 0x0a, 0xfc, 0xa0, 0x2d, 0x10, 0xb7, 0x63, 0xaf, 0x2e,
-	0xae, 0xbe, 0x07, 0xe2, 0x82, 0x40, 0x44, 0xc7, 0xda, 0x50, 0x43, 0xd1, 0xef, 0xdb, 0xe1, 0xc9,
-	0x54, 0xbe, 0x2b, 0x7b, 0x73, 0x99, 0x33, 0x22, 0xe3, 0xcf, 0x30, 0x1a, 0x72, 0x52, 0xd2, 0x5e,
-	0xc3, 0xa9, 0xf0, 0xfe, 0x92, 0xf6, 0x5a, 0x90, 0xb5, 0xd7, 0xe1, 0x94, 0xfb, 0x7c, 0x51, 0x7b,
-	0x7d, 0x07, 0x2e, 0x89, 0x4b, 0x4b, 0xc8, 0x80, 0xdd, 0x49, 0xf4, 0x3c, 0x14, 0x53, 0x11, 0xcf,
-	0x5b, 0x4c, 0xc9, 0xb6, 0x43, 0xf2, 0xcf, 0x61, 0x7b, 0x7c, 0x62, 0xb8, 0xc7, 0x96, 0x1e, 0x8e,
-	0x1c, 0x9d, 0xde, 0xab, 0xd7, 0xa7, 0xc6, 0x4c, 0x28, 0xd5, 0x6f, 0x2c, 0x55, 0xb6, 0x49, 0xc4,
-	0xc3, 0x91, 0x43, 0xb1, 0x64, 0x71, 0x0c, 0xcc, 0xd6, 0x78, 0x11, 0xbe, 0x70, 0x14, 0x0d, 0x4b,
-	0x47, 0xd1, 0x8b, 0x6a, 0x76, 0x79, 0x85, 0x9a, 0x9d, 0x28, 0xcb, 0x15, 0x59, 0x59, 0x66, 0x6f,
-	0x43, 0x41, 0xdc, 0xb9, 0x14, 0x7e, 0x5f, 0xb6, 0xbc, 0x3a, 0xb4, 0x88, 0x04, 0x4b, 0x8a, 0x02,
-	0x23, 0xe8, 0x0a, 0x7d, 0x8d, 0x97, 0x24, 0xc3, 0xd8, 0xae, 0x70, 0x7a, 0xc6, 0x71, 0x6f, 0xc2,
-	0xc7, 0x7b, 0x5d, 0xca, 0x38, 0xc6, 0x09, 0xbb, 0x7c, 0x81, 0xe3, 0xfa, 0x3f, 0xdd, 0x80, 0x0d,
-	0x11, 0x6e, 0x7d, 0x1f, 0x72, 0xa6, 0xef, 0xcd, 0xe2, 0x98, 0xe5, 0x15, 0x5a, 0x3b, 0xfd, 0x44,
-	0x19, 0x2a, 0xf8, 0x0f, 0x60, 0xc3, 0x30, 0x4d, 0x7d, 0x72, 0x9a, 0x3e, 0x8f, 0x5e, 0x50, 0xa0,
-	0xf7, 0xd7, 0xb4, 0xbc, 0x41, 0x9a, 0xf4, 0xc7, 0x50, 0x42, 0xfa, 0x24, 0x92, 0xb4, 0xbc, 0x6c,
-	0x16, 0x44, 0xaa, 0xee, 0xfe, 0x9a, 0x56, 0x34, 0x22, 0xb5, 0xf7, 0x57, 0xd3, 0x9e, 0xfd, 0xdc,
-	0x52, 0x03, 0x17, 0xf4, 0xb4, 0x05, 0x1f, 0xff, 0xaf, 0x01, 0x77, 0xf5, 0xc6, 0x3b, 0x76, 0x5e,
-	0x3e, 0xfa, 0x5c, 0xda, 0xdf, 0xf7, 0xd7, 0x34, 0xbe, 0x6f, 0x45, 0xfb, 0xfd, 0x87, 0x91, 0xd7,
-	0x3d, 0xfe, 0x29, 0x97, 0x15, 0x3d, 0x83, 0x62, 0x30, 0x76, 0xbd, 0x93, 0x4c, 0x44, 0x36, 0xd3,
-	0x8c, 0x02, 0x0a, 0x0b, 0x4b, 0x6c, 0xf1, 0xae, 0x4e, 0x6c, 0xf1, 0x16, 0xff, 0x18, 0xca, 0xdc,
-	0x09, 0xcb, 0xf9, 0x8a, 0x4b, 0x5d, 0x9b, 0x6c, 0xca, 0x74, 0xac, 0x97, 0x6c, 0xd1, 0xcd, 0xa8,
-	0x9d, 0xbe, 0x25, 0x9f, 0x9c, 0xdc, 0x58, 0xd9, 0x51, 0x5a, 0x7c, 0x88, 0xc2, 0x1b, 0xab, 0x71,
-	0x1e, 0xd6, 0x85, 0x6d, 0x71, 0xc4, 0xc0, 0x37, 0xe0, 0x68, 0xcf, 0x84, 0xa5, 0xf1, 0x4a, 0xed,
-	0xd0, 0xfb, 0x6b, 0x1a, 0x33, 0x96, 0xf7, 0xed, 0x26, 0x6c, 0x45, 0x55, 0xa2, 0x9d, 0x55, 0x8a,
-	0x80, 0x92, 0x9b, 0x94, 0xec, 0xbb, 0xfb, 0x6b, 0xda, 0xa6, 0x91, 0x06, 0xb1, 0x0e, 0x5c, 0x8a,
-	0x32, 0x21, 0x57, 0xbb, 0xe8, 0x99, 0xca, 0xd2, 0x28, 0xca, 0x7b, 0xf5, 0xfe, 0x9a, 0xb6, 0x65,
-	0x2c, 0x6d, 0xe0, 0x07, 0x51, 0x7d, 0x64, 0xe5, 0x90, 0xaf, 0xc4, 0x5b, 0x2b, 0xbb, 0x29, 0xd1,
-	0x54, 0xe3, 0x9a, 0x25, 0xa0, 0x24, 0x8e, 0xe1, 0xba, 0x06, 0x57, 0x56, 0x4b, 0x18, 0x79, 0x9b,
-	0xc9, 0xf1, 0x6d, 0x46, 0x95, 0xb7, 0x99, 0xc5, 0x27, 0x31, 0xa4, 0x4d, 0xe7, 0x87, 0x50, 0x4d,
-	0x89, 0x58, 0x56, 0x86, 0x42, 0xf4, 0xd0, 0x37, 0x5d, 0xb1, 0x68, 0xf6, 0x0f, 0xbf, 0x50, 0x32,
-	0x08, 0xee, 0xf4, 0x06, 0xc3, 0x46, 0x6f, 0xa8, 0xac, 0xf3, 0xc4, 0x61, 0xb7, 0xd1, 0x6c, 0x2b,
-	0x59, 0xf5, 0x4f, 0xb2, 0x50, 0x8a, 0x4f, 0xd9, 0xbe, 0xbb, 0x37, 0x2c, 0x76, 0x33, 0x65, 0x65,
-	0x37, 0xd3, 0x82, 0xa9, 0xc7, 0xdf, 0xe4, 0xe7, 0x3f, 0x93, 0xb4, 0x99, 0x36, 0xa8, 0x82, 0xe5,
-	0xdb, 0xd7, 0xf9, 0x6f, 0x78, 0xfb, 0x5a, 0x0e, 0x26, 0xdf, 0x48, 0x07, 0x93, 0x2f, 0x3c, 0xf6,
-	0x5e, 0xa0, 0x67, 0x98, 0xe5, 0xc7, 0xde, 0xe9, 0xc7, 0x23, 0x9f, 0xda, 0xd6, 0x99, 0x88, 0xbe,
-	0x16, 0xa9, 0xf4, 0x0e, 0x0d, 0x2f, 0xd9, 0xa1, 0xbf, 0x89, 0xb4, 0x7f, 0x08, 0xdb, 0x93, 0xd3,
-	0xf8, 0xf1, 0xe7, 0xc4, 0xb9, 0x52, 0xa1, 0x2a, 0xad, 0xc4, 0xb1, 0x37, 0xe2, 0x5f, 0xbb, 0xaa,
-	0xca, 0x6e, 0xa0, 0x78, 0xb4, 0xe2, 0x9f, 0xbf, 0xfa, 0x6b, 0x19, 0x80, 0xe4, 0xfc, 0xe9, 0x17,
-	0x76, 0xe5, 0x4a, 0xde, 0xb2, 0xec, 0xd7, 0x78, 0xcb, 0x5e, 0xf6, 0x18, 0xd8, 0x57, 0x50, 0x8a,
-	0x4f, 0x1c, 0xbf, 0xfb, 0xc4, 0xfa, 0x56, 0x45, 0xfe, 0x66, 0xe4, 0xd6, 0x8e, 0x8f, 0xec, 0x7e,
-	0xd1, 0xbe, 0x48, 0x15, 0x9f, 0x7d, 0x49, 0xf1, 0xe7, 0xdc, 0xb7, 0x1c, 0x17, 0xfe, 0x4b, 0x5e,
-	0x4d, 0xf2, 0x44, 0xcf, 0xa5, 0x26, 0xba, 0x3a, 0x17, 0x0e, 0xf2, 0x5f, 0xbc, 0xe8, 0x6f, 0xd5,
-	0xe0, 0xff, 0x9e, 0x89, 0xbc, 0xb8, 0xf1, 0x7b, 0xdd, 0x2f, 0x54, 0x7a, 0x57, 0x3b, 0xa2, 0xbf,
-	0x4d, 0x71, 0x5f, 0xeb, 0xa3, 0xca, 0x7d, 0x9d, 0x8f, 0xea, 0x0d, 0xc8, 0xf3, 0xed, 0x2e, 0xff,
-	0x22, 0xff, 0x14, 0xc7, 0xbf, 0xf4, 0x57, 0x35, 0x54, 0x55, 0x28, 0xf9, 0xbc, 0xbd, 0xdb, 0x51,
-	0xbe, 0xd1, 0x2f, 0x82, 0xd0, 0x65, 0x97, 0xff, 0x87, 0x4b, 0xd4, 0xef, 0xda, 0x25, 0x5f, 0xef,
-	0xb6, 0x50, 0xff, 0x77, 0x06, 0xaa, 0xa9, 0x08, 0x82, 0xef, 0x50, 0xc4, 0x4a, 0xb9, 0x9c, 0xfd,
-	0xbf, 0x48, 0x2e, 0xa7, 0xa2, 0x71, 0x8b, 0xe9, 0x68, 0x5c, 0x14, 0x77, 0x95, 0x94, 0x09, 0xb3,
-	0xca, 0xd8, 0xc9, 0xac, 0x34, 0x76, 0x6e, 0xc6, 0x3f, 0x5b, 0xd8, 0x69, 0xf1, 0xe0, 0xd7, 0xaa,
-	0x26, 0x41, 0xd8, 0x27, 0x70, 0x4d, 0x38, 0x11, 0x78, 0xff, 0x78, 0x13, 0x3d, 0xfe, 0x51, 0x43,
-	0x61, 0x94, 0x5f, 0xe1, 0x04, 0xfc, 0x37, 0x51, 0x26, 0x8d, 0x08, 0xab, 0x76, 0xa0, 0x9a, 0x0a,
-	0xcd, 0x90, 0x7e, 0x44, 0x35, 0x23, 0xff, 0x88, 0x2a, 0xdb, 0x81, 0xfc, 0xd9, 0x89, 0xe5, 0x5b,
-	0x2b, 0xde, 0xe2, 0xe5, 0x08, 0xf5, 0xfb, 0x50, 0x91, 0xc3, 0xc4, 0xd8, 0xdb, 0x90, 0xb7, 0x43,
-	0x6b, 0x1a, 0xb9, 0x47, 0xae, 0x2c, 0x47, 0x92, 0x75, 0x42, 0x6b, 0xaa, 0x71, 0x22, 0xf5, 0x0f,
-	0x32, 0xa0, 0x2c, 0xe2, 0xa4, 0x5f, 0x7a, 0xcd, 0xbc, 0xe0, 0x97, 0x5e, 0xd7, 0x53, 0x95, 0x5c,
-	0xf5, 0x63, 0xad, 0x3b, 0x91, 0x52, 0xb2, 0xe2, 0x87, 0x42, 0x09, 0xc1, 0xee, 0x42, 0xd1, 0xb7,
-	0xe8, 0x67, 0x34, 0xcd, 0x15, 0x97, 0x40, 0x62, 0x9c, 0xfa, 0xbb, 0x19, 0x28, 0x88, 0x98, 0xb6,
-	0x95, 0xfe, 0xaa, 0x37, 0xa1, 0xc0, 0x7f, 0x52, 0x33, 0x7a, 0x13, 0x6c, 0x29, 0x62, 0x3c, 0xc2,
-	0xb3, 0x9b, 0x3c, 0xd2, 0x2f, 0xed, 0xbf, 0x3a, 0x74, 0x0c, 0x57, 0x23, 0xb8, 0xf8, 0xa9, 0x23,
-	0x63, 0x2a, 0x2e, 0xa6, 0xf3, 0x07, 0xa2, 0x80, 0x40, 0x74, 0x07, 0x5d, 0xfd, 0x55, 0x28, 0x88,
-	0x98, 0xb9, 0x95, 0x55, 0x79, 0xd9, 0xcf, 0x29, 0xee, 0x00, 0x24, 0x41, 0x74, 0xab, 0x72, 0x50,
-	0xef, 0x43, 0x31, 0x8a, 0x9b, 0xc3, 0xf9, 0x97, 0x14, 0x2d, 0x6e, 0x17, 0xc9, 0x95, 0x71, 0xc4,
-	0xfb, 0xf6, 0x5d, 0x6f, 0x7c, 0x4a, 0xce, 0xf2, 0x77, 0x81, 0xae, 0x5a, 0x0d, 0x97, 0x5e, 0xd2,
-	0x4a, 0xff, 0xc0, 0x41, 0x4c, 0xc4, 0xee, 0x43, 0x2c, 0x2f, 0x5f, 0xe6, 0x5a, 0x50, 0x1b, 0xd1,
-	0xa5, 0x3c, 0x9a, 0x65, 0x8f, 0x84, 0x37, 0xb5, 0x4b, 0xaf, 0x2e, 0x66, 0xe4, 0x77, 0x68, 0x53,
-	0x75, 0xd2, 0x24, 0x32, 0xb5, 0x06, 0x15, 0x39, 0xd8, 0x47, 0x6d, 0xc0, 0xd6, 0x81, 0x15, 0x1a,
-	0x28, 0x7f, 0xa2, 0xf7, 0x85, 0xf8, 0xfc, 0xc5, 0x8f, 0xf4, 0xfc, 0x5d, 0xa4, 0xd3, 0x38, 0x91,
-	0xfa, 0x27, 0x39, 0x50, 0x16, 0x71, 0x5f, 0x77, 0x41, 0xf1, 0x16, 0x94, 0x3d, 0x9a, 0x17, 0xa9,
-	0x1f, 0xb3, 0xe2, 0x20, 0x29, 0xb4, 0x3f, 0xf5, 0x8b, 0x26, 0x45, 0x3b, 0xd8, 0xe7, 0xbf, 0x69,
-	0x72, 0x95, 0xdf, 0x46, 0x73, 0xbc, 0x31, 0x4d, 0xeb, 0x0a, 0x5d, 0x3e, 0xeb, 0x7a, 0x63, 0xba,
-	0xf7, 0x28, 0xbc, 0x13, 0x3c, 0x02, 0xb5, 0xa2, 0x15, 0x85, 0x4b, 0x82, 0xce, 0xef, 0x44, 0xc0,
-	0x7f, 0x18, 0x88, 0x9b, 0xa4, 0x45, 0x0e, 0x18, 0x06, 0xd1, 0x2b, 0xea, 0x63, 0xf1, 0xcb, 0x4b,
-	0x59, 0x7a, 0x45, 0xbd, 0xe9, 0xd2, 0xb5, 0x47, 0x7a, 0x5b, 0x76, 0x2c, 0x7e, 0x73, 0x4e, 0xbc,
-	0x63, 0x8f, 0xa8, 0xdb, 0xfc, 0xb7, 0xa9, 0x7c, 0x2b, 0x08, 0xf8, 0xe3, 0x7b, 0x25, 0xf1, 0xea,
-	0xa2, 0x00, 0xc6, 0x6f, 0x9b, 0x8a, 0x9f, 0x0d, 0x43, 0x12, 0x10, 0x4f, 0x00, 0xf2, 0x1f, 0x0d,
-	0x43, 0x82, 0x6b, 0x50, 0xfc, 0x89, 0xe7, 0x5a, 0xe4, 0xe5, 0x28, 0x53, 0xad, 0x0a, 0x98, 0x3e,
-	0x30, 0x66, 0xb8, 0x11, 0x38, 0x74, 0x69, 0x99, 0x5f, 0xf8, 0xe3, 0x09, 0xf5, 0xdf, 0x66, 0x60,
-	0x7b, 0xb1, 0xaf, 0x69, 0x1a, 0x55, 0xa0, 0xd8, 0xec, 0x77, 0xf5, 0x5e, 0xe3, 0xa0, 0xad, 0xac,
-	0xb1, 0x4d, 0x28, 0xf7, 0x77, 0x3f, 0x6d, 0x37, 0x87, 0x1c, 0x90, 0xa1, 0x97, 0x00, 0x06, 0xfa,
-	0x7e, 0xa7, 0xd5, 0x6a, 0xf7, 0xb8, 0x45, 0xd1, 0xdf, 0xfd, 0x54, 0xef, 0xf6, 0x9b, 0xfc, 0xe7,
-	0x85, 0xa2, 0x88, 0x87, 0x81, 0x92, 0xa3, 0x78, 0x08, 0x0a, 0x86, 0xc7, 0x64, 0x9e, 0x47, 0x79,
-	0x3f, 0x1b, 0xe8, 0xcd, 0xde, 0x50, 0xd9, 0xc0, 0x54, 0xef, 0xa8, 0xdb, 0xa5, 0x14, 0x85, 0x73,
-	0x36, 0xfb, 0x07, 0x87, 0x5a, 0x7b, 0x30, 0xd0, 0x07, 0x9d, 0x1f, 0xb7, 0x95, 0x22, 0x95, 0xac,
-	0x75, 0x9e, 0x74, 0x7a, 0x1c, 0x50, 0x62, 0x05, 0xc8, 0x1e, 0x74, 0x7a, 0xfc, 0x05, 0x84, 0x83,
-	0xc6, 0xe7, 0x4a, 0x19, 0x3f, 0x06, 0x47, 0x07, 0x4a, 0x85, 0x95, 0x20, 0xdf, 0x6d, 0x3f, 0x6d,
-	0x77, 0x95, 0xaa, 0xfa, 0x9f, 0xb2, 0x91, 0x46, 0x4c, 0x71, 0x4e, 0xdf, 0x44, 0x0b, 0x5c, 0x75,
-	0xbe, 0x18, 0x77, 0x5a, 0x56, 0xea, 0xb4, 0x6f, 0xf2, 0x1b, 0xb6, 0xb7, 0xa1, 0x1a, 0x07, 0x07,
-	0x48, 0x2f, 0x9f, 0x57, 0x22, 0xe0, 0x8a, 0xe3, 0x8b, 0x8d, 0x15, 0xc7, 0x17, 0x33, 0x3b, 0x44,
-	0x2b, 0x1b, 0x65, 0x2e, 0x9f, 0x49, 0x25, 0x84, 0xf0, 0x5f, 0x8f, 0x7e, 0x05, 0x28, 0xa1, 0xcf,
-	0x5d, 0x3b, 0xfa, 0x05, 0xc3, 0x22, 0x02, 0x8e, 0x5c, 0x3b, 0x5c, 0x0c, 0x4e, 0x28, 0x2d, 0x05,
-	0x27, 0xc8, 0x9b, 0x33, 0xa4, 0x37, 0xe7, 0xf4, 0x4f, 0xfb, 0xf2, 0x9f, 0x2e, 0x94, 0x7e, 0xda,
-	0xf7, 0x6d, 0x60, 0xe3, 0xb9, 0x4f, 0x6f, 0x9b, 0x49, 0x64, 0x15, 0x22, 0x53, 0x04, 0x26, 0xde,
-	0x15, 0xd9, 0x1b, 0xb0, 0xb9, 0x40, 0x4d, 0xb6, 0x74, 0x49, 0xab, 0xa5, 0x49, 0xd9, 0x03, 0xb8,
-	0x24, 0xe6, 0x76, 0xaa, 0x6f, 0xc5, 0x2d, 0x52, 0x8e, 0x6a, 0x24, 0x3d, 0xac, 0xfe, 0x0a, 0x14,
-	0xa3, 0x90, 0xb6, 0xaf, 0x57, 0x76, 0x57, 0x8c, 0xab, 0xfa, 0x4f, 0xd6, 0xa1, 0x14, 0x07, 0xb8,
-	0x7d, 0xa3, 0xd9, 0x41, 0x3f, 0xf1, 0x10, 0x9c, 0xca, 0x22, 0xa6, 0x88, 0x80, 0x68, 0xa4, 0xc4,
-	0xc5, 0xab, 0xb9, 0x6f, 0x47, 0x1a, 0x1b, 0x87, 0x1c, 0xf9, 0x36, 0x3d, 0x60, 0x62, 0xbb, 0xd2,
-	0x7d, 0xcf, 0x92, 0x56, 0x44, 0x00, 0x2d, 0xb4, 0x6b, 0x40, 0xdf, 0xc4, 0x19, 0xfd, 0xda, 0xb1,
-	0xed, 0x9e, 0x22, 0xdf, 0x0b, 0x7e, 0xed, 0x98, 0x7e, 0xa0, 0x82, 0x47, 0xd7, 0xf0, 0x98, 0x82,
-	0xe8, 0x27, 0xd9, 0x5e, 0x81, 0xd2, 0x3c, 0xfe, 0x4d, 0x3f, 0x31, 0x23, 0xe6, 0xd1, 0x2f, 0xfa,
-	0xa5, 0x47, 0xb5, 0xb4, 0x38, 0xaa, 0x8b, 0x73, 0x1a, 0x96, 0xe6, 0xb4, 0x1a, 0x42, 0x41, 0x04,
-	0xf9, 0x7d, 0x7d, 0x87, 0x7f, 0x6d, 0x57, 0x29, 0x90, 0x35, 0x9c, 0xe8, 0x92, 0x29, 0x7e, 0x2e,
-	0x54, 0x2c, 0xb7, 0x50, 0x31, 0xf5, 0xef, 0xad, 0x03, 0x24, 0xc1, 0x82, 0xec, 0x9d, 0x85, 0xc0,
-	0xe4, 0xcc, 0xd2, 0xb6, 0xbf, 0x10, 0x8f, 0xbc, 0xf0, 0xa2, 0xcf, 0xfa, 0x37, 0x78, 0xd1, 0xe7,
-	0x21, 0x54, 0x03, 0x7f, 0xfc, 0x52, 0x87, 0x7b, 0x39, 0xf0, 0xc7, 0xb1, 0xbf, 0xfd, 0x5d, 0xc0,
-	0x24, 0xbd, 0xf6, 0x97, 0x18, 0xaa, 0x4b, 0x5a, 0x4b, 0x29, 0xf0, 0xc7, 0xfd, 0xd1, 0x97, 0x2d,
-	0x7e, 0xdb, 0xcd, 0x0c, 0x42, 0x7d, 0x95, 0x94, 0xd8, 0x34, 0x83, 0xb0, 0x25, 0x0b, 0x8a, 0x3b,
-	0x50, 0x43, 0xda, 0x25, 0x61, 0x51, 0x31, 0x83, 0xe4, 0x80, 0x45, 0xfd, 0x9d, 0xe8, 0x48, 0x7a,
-	0xc1, 0x97, 0xcb, 0x3e, 0x12, 0x86, 0xb8, 0xa4, 0x44, 0xd4, 0x57, 0xb9, 0x7e, 0xf9, 0xfb, 0x43,
-	0x31, 0xe9, 0xf2, 0x2f, 0xb9, 0xad, 0x7f, 0xd3, 0x5f, 0x72, 0xdb, 0x01, 0x48, 0xde, 0x5b, 0xc4,
-	0x15, 0x18, 0x5f, 0xd6, 0x29, 0xf1, 0x6b, 0x38, 0xf7, 0x5f, 0x83, 0x8a, 0xfc, 0xd3, 0xaf, 0x74,
-	0x09, 0xc7, 0x73, 0x2d, 0xfe, 0x6b, 0x19, 0xdd, 0x9f, 0x7c, 0xa0, 0x64, 0xee, 0xab, 0x50, 0x96,
-	0x7e, 0xab, 0x06, 0x29, 0xf6, 0x8d, 0xe0, 0x44, 0xfc, 0x72, 0x82, 0xe1, 0x1e, 0x5b, 0x4a, 0xe6,
-	0xfe, 0x5d, 0x54, 0xba, 0xe5, 0x5f, 0x8a, 0x01, 0xd8, 0xe8, 0x79, 0xfe, 0xd4, 0x70, 0x04, 0x9d,
-	0x35, 0x0f, 0x90, 0xee, 0x5d, 0xb8, 0xbc, 0xf2, 0x77, 0x6f, 0xe8, 0x0a, 0x97, 0x3d, 0x9d, 0x39,
-	0x16, 0xbf, 0x8c, 0xb4, 0x7f, 0x31, 0xf2, 0x6d, 0x53, 0xc9, 0xdc, 0x7f, 0xbc, 0xf0, 0xbb, 0x08,
-	0x47, 0xbd, 0xdd, 0xfe, 0x51, 0xaf, 0xd5, 0x6e, 0xf1, 0x6b, 0x42, 0x9d, 0x5e, 0xb3, 0x7b, 0x34,
-	0xe8, 0x3c, 0x15, 0x7b, 0x61, 0xfb, 0xf3, 0x28, 0xb9, 0x7e, 0xff, 0x71, 0xf4, 0xb0, 0x42, 0x54,
-	0xeb, 0x6e, 0xbf, 0xd1, 0xe2, 0x7b, 0x68, 0xfc, 0xaa, 0xcf, 0x70, 0x97, 0xff, 0x9e, 0x82, 0xd6,
-	0x1e, 0x1c, 0x75, 0x87, 0xe2, 0x05, 0xa1, 0xfb, 0x3f, 0x84, 0xfa, 0x8b, 0xee, 0xf3, 0x60, 0x5b,
-	0x9a, 0xfb, 0x0d, 0xba, 0x33, 0x85, 0x7b, 0x66, 0x5f, 0xe7, 0x29, 0xf2, 0xec, 0x69, 0xed, 0x6e,
-	0x9b, 0x82, 0x5e, 0xef, 0xff, 0x34, 0x23, 0xe9, 0x8f, 0xd1, 0x9d, 0x8c, 0x18, 0x20, 0x3a, 0x58,
-	0x06, 0x69, 0x96, 0x61, 0x2a, 0x19, 0x76, 0x05, 0x58, 0x0a, 0xd4, 0xf5, 0xc6, 0x86, 0xa3, 0xac,
-	0x53, 0x78, 0x6b, 0x04, 0xa7, 0x6b, 0x78, 0x4a, 0x96, 0xbd, 0x0a, 0xd7, 0x62, 0x58, 0xd7, 0x3b,
-	0x3b, 0xf4, 0x6d, 0xcf, 0xb7, 0xc3, 0x0b, 0x8e, 0xce, 0xdd, 0xff, 0x7f, 0xc5, 0xe1, 0x6c, 0x6a,
-	0x56, 0x61, 0x01, 0x0d, 0xd3, 0x4c, 0x60, 0x24, 0xc8, 0x94, 0x35, 0x76, 0x15, 0x2e, 0x91, 0x14,
-	0x5f, 0x40, 0x64, 0xd8, 0x2b, 0x70, 0x35, 0x32, 0x72, 0x17, 0x91, 0xeb, 0x88, 0xd4, 0x2c, 0x0a,
-	0x8d, 0x5c, 0x42, 0x66, 0x77, 0x7f, 0xf0, 0xa7, 0x3f, 0xbf, 0x99, 0xf9, 0xf3, 0x9f, 0xdf, 0xcc,
-	0xfc, 0xe7, 0x9f, 0xdf, 0x5c, 0xfb, 0x83, 0xff, 0x7a, 0x33, 0xf3, 0xe3, 0x77, 0x8e, 0xed, 0xf0,
-	0x64, 0x3e, 0x7a, 0x30, 0xf6, 0xa6, 0xef, 0x4e, 0x8d, 0xd0, 0xb7, 0xcf, 0xf9, 0x76, 0x12, 0x25,
-	0x5c, 0xeb, 0xdd, 0xd9, 0xe9, 0xf1, 0xbb, 0xb3, 0xd1, 0xbb, 0x38, 0xb1, 0x47, 0x1b, 0x33, 0xdf,
-	0x0b, 0xbd, 0x47, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x75, 0x78, 0x94, 0xad, 0xbe, 0x87, 0x00,
-	0x00,
+	// 13015 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0xbd, 0x6d, 0x8c, 0x23, 0x57,
+	0x92, 0x18, 0xd8, 0x2c, 0x92, 0x45, 0x32, 0x48, 0x56, 0x65, 0x65, 0x7f, 0xb1, 0x5b, 0xad, 0x56,
+	0x29, 0xd5, 0x92, 0x5a, 0x2d, 0xa9, 0x5b, 0xea, 0xd6, 0x47, 0x4b, 0x3b, 0xbb, 0x33, 0x2c, 0x92,
+	0xdd, 0x4d, 0x89, 0x45, 0xd6, 0x24, 0x59, 0xdd, 0x9a, 0xd9, 0x5b, 0x10, 0x59, 0xcc, 0x64, 0x55,
+	0xaa, 0x92, 0x4c, 0x2a, 0x33, 0xd9, 0x55, 0x35, 0xc0, 0xe2, 0x06, 0xf7, 0xe3, 0x76, 0xf7, 0xee,
+	0xe7, 0xe1, 0xf6, 0xfe, 0xdc, 0xe2, 0xf6, 0x0c, 0x18, 0x06, 0x0c, 0x1b, 0x86, 0x8d, 0x05, 0xec,
+	0xfd, 0xe7, 0x85, 0xff, 0xac, 0x61, 0x78, 0xbd, 0x80, 0x61, 0x1b, 0x6b, 0x03, 0x6b, 0x63, 0xfc,
+	0xdb, 0xb0, 0x01, 0xfb, 0x9f, 0x01, 0xdb, 0x88, 0x88, 0xf7, 0x32, 0x5f, 0x92, 0xac, 0x6e, 0x49,
+	0x33, 0x0b, 0xf8, 0x4f, 0x15, 0x5f, 0x44, 0xbc, 0xef, 0xf7, 0xe2, 0xc5, 0x8b, 0x88, 0x17, 0x09,
+	0x30, 0xf3, 0xac, 0xe9, 0xdd, 0x59, 0xe0, 0x47, 0xbe, 0x9e, 0xc3, 0xdf, 0xd7, 0xdf, 0x3f, 0x74,
+	0xa3, 0xa3, 0xf9, 0xc1, 0xdd, 0x91, 0x3f, 0xb9, 0x77, 0xe8, 0x1f, 0xfa, 0xf7, 0x08, 0x79, 0x30,
+	0x1f, 0x53, 0x8a, 0x12, 0xf4, 0x8b, 0x33, 0x5d, 0x07, 0xcf, 0x1f, 0x1d, 0x8b, 0xdf, 0x9b, 0x91,
+	0x3b, 0x71, 0xc2, 0xc8, 0x9a, 0xcc, 0x18, 0x60, 0xfc, 0xfd, 0x0c, 0xe4, 0x06, 0x67, 0x33, 0x47,
+	0xdf, 0x80, 0x35, 0xd7, 0xae, 0x65, 0xb6, 0x33, 0xb7, 0xf3, 0xe6, 0x9a, 0x6b, 0xeb, 0xdb, 0x50,
+	0x9e, 0xfa, 0x51, 0x77, 0xee, 0x79, 0xd6, 0x81, 0xe7, 0xd4, 0xd6, 0xb6, 0x33, 0xb7, 0x8b, 0xa6,
+	0x0a, 0xd2, 0x5f, 0x81, 0x92, 0x35, 0x8f, 0xfc, 0xa1, 0x3b, 0x1d, 0x05, 0xb5, 0x2c, 0xe1, 0x8b,
+	0x08, 0x68, 0x4f, 0x47, 0x81, 0x7e, 0x09, 0xf2, 0x27, 0xae, 0x1d, 0x1d, 0xd5, 0x72, 0x54, 0x22,
+	0x27, 0x10, 0x1a, 0x8e, 0x2c, 0xcf, 0xa9, 0xe5, 0x19, 0x4a, 0x09, 0x84, 0x46, 0x54, 0xc9, 0xfa,
+	0x76, 0xe6, 0x76, 0xc9, 0xe4, 0x84, 0x7e, 0x13, 0xc0, 0x99, 0xce, 0x27, 0xcf, 0x2d, 0x6f, 0xee,
+	0x84, 0xb5, 0x02, 0xa1, 0x14, 0x88, 0xf1, 0x43, 0x28, 0x4d, 0xc2, 0xc3, 0x27, 0x8e, 0x65, 0x3b,
+	0x81, 0x7e, 0x15, 0x0a, 0x93, 0xf0, 0x70, 0x18, 0x59, 0x87, 0xa2, 0x0b, 0xeb, 0x93, 0xf0, 0x70,
+	0x60, 0x1d, 0xea, 0xd7, 0xa0, 0x48, 0x88, 0xb3, 0x19, 0xf7, 0x21, 0x6f, 0x22, 0x21, 0xf6, 0xd8,
+	0xf8, 0xbd, 0x75, 0x28, 0x74, 0xdc, 0xc8, 0x09, 0x2c, 0x4f, 0xbf, 0x02, 0xeb, 0x6e, 0x38, 0x9d,
+	0x7b, 0x1e, 0x65, 0x2f, 0x9a, 0x22, 0xa5, 0x5f, 0x81, 0xbc, 0xfb, 0xf0, 0xb9, 0xe5, 0x71, 0xde,
+	0x27, 0x17, 0x4c, 0x4e, 0xea, 0x35, 0x58, 0x77, 0x3f, 0xfc, 0x04, 0x11, 0x59, 0x81, 0x10, 0x69,
+	0xc2, 0x3c, 0xb8, 0x8f, 0x98, 0x5c, 0x8c, 0x79, 0x70, 0x5f, 0x62, 0x3e, 0xf9, 0x08, 0x31, 0xd8,
+	0xfb, 0x2c, 0x61, 0x28, 0x8d, 0xb5, 0xcc, 0xa9, 0x16, 0x1c, 0x80, 0x2a, 0xd6, 0x32, 0x97, 0xb5,
+	0xcc, 0xb9, 0x96, 0x82, 0x40, 0x88, 0x34, 0x61, 0xb8, 0x96, 0x62, 0x8c, 0x89, 0x6b, 0x99, 0x73,
+	0x2d, 0xa5, 0xed, 0xcc, 0xed, 0x1c, 0x61, 0xb8, 0x96, 0x4b, 0x90, 0xb3, 0x11, 0x0e, 0xdb, 0x99,
+	0xdb, 0x99, 0x27, 0x17, 0xcc, 0x9c, 0x2d, 0xa0, 0x21, 0x42, 0xcb, 0x38, 0xc0, 0x08, 0x0d, 0x05,
+	0xf4, 0x00, 0xa1, 0x15, 0x1c, 0x0d, 0x84, 0x1e, 0x08, 0xe8, 0x18, 0xa1, 0xd5, 0xed, 0xcc, 0xed,
+	0x35, 0x84, 0x62, 0x4a, 0xbf, 0x0e, 0x05, 0xdb, 0x8a, 0x1c, 0x44, 0x6c, 0x88, 0x2e, 0x4b, 0x00,
+	0xe2, 0x70, 0xc5, 0x21, 0x6e, 0x53, 0x74, 0x5a, 0x02, 0x74, 0x03, 0xca, 0x48, 0x26, 0xf1, 0x9a,
+	0xc0, 0xab, 0x40, 0xfd, 0x63, 0xa8, 0xd8, 0xce, 0xc8, 0x9d, 0x58, 0x1e, 0xf7, 0x69, 0x6b, 0x3b,
+	0x73, 0xbb, 0x7c, 0x7f, 0xf3, 0x2e, 0xed, 0x89, 0x18, 0xf3, 0xe4, 0x82, 0x99, 0x22, 0xd3, 0x1f,
+	0x42, 0x55, 0xa4, 0x3f, 0xbc, 0x4f, 0x03, 0xab, 0x53, 0x3e, 0x2d, 0x95, 0xef, 0xc3, 0xfb, 0x0f,
+	0x9f, 0x5c, 0x30, 0xd3, 0x84, 0xfa, 0x2d, 0xa8, 0xc4, 0x5b, 0x04, 0x33, 0x5e, 0x14, 0xad, 0x4a,
+	0x41, 0xb1, 0x5b, 0x5f, 0x87, 0xfe, 0x14, 0x09, 0x2e, 0x89, 0x71, 0x93, 0x00, 0x7d, 0x1b, 0xc0,
+	0x76, 0xc6, 0xd6, 0xdc, 0x8b, 0x10, 0x7d, 0x59, 0x0c, 0xa0, 0x02, 0xd3, 0x6f, 0x42, 0x69, 0x3e,
+	0xc3, 0x5e, 0x3e, 0xb5, 0xbc, 0xda, 0x15, 0x41, 0x90, 0x80, 0xb0, 0x74, 0x5c, 0xe7, 0x88, 0xbd,
+	0x2a, 0x66, 0x57, 0x02, 0x70, 0x7a, 0x9f, 0x3b, 0x23, 0x44, 0xd5, 0x44, 0xc5, 0x22, 0x8d, 0xbb,
+	0xc8, 0x0d, 0x77, 0xdc, 0x69, 0xed, 0x1a, 0xad, 0x60, 0x4e, 0xe8, 0x37, 0x20, 0x1b, 0x06, 0xa3,
+	0xda, 0x75, 0xea, 0x3f, 0x70, 0xff, 0x5b, 0xa7, 0xb3, 0xc0, 0x44, 0xf0, 0x4e, 0x01, 0xf2, 0xb4,
+	0x9b, 0x8c, 0x1b, 0x50, 0xdc, 0xb3, 0x02, 0x6b, 0x62, 0x3a, 0x63, 0x5d, 0x83, 0xec, 0xcc, 0x0f,
+	0xc5, 0x3e, 0xc2, 0x9f, 0x46, 0x07, 0xd6, 0x9f, 0x5a, 0x01, 0xe2, 0x74, 0xc8, 0x4d, 0xad, 0x89,
+	0x43, 0xc8, 0x92, 0x49, 0xbf, 0x71, 0xef, 0x84, 0x67, 0x61, 0xe4, 0x4c, 0x04, 0x93, 0x10, 0x29,
+	0x84, 0x1f, 0x7a, 0xfe, 0x81, 0xd8, 0x23, 0x45, 0x53, 0xa4, 0x8c, 0xff, 0x2d, 0x03, 0xeb, 0x0d,
+	0xdf, 0xc3, 0xe2, 0xae, 0x42, 0x21, 0x70, 0xbc, 0x61, 0x52, 0xdd, 0x7a, 0xe0, 0x78, 0x7b, 0x7e,
+	0x88, 0x88, 0x91, 0xcf, 0x08, 0xde, 0xb5, 0xeb, 0x23, 0x9f, 0x10, 0xb2, 0x01, 0x59, 0xa5, 0x01,
+	0xd7, 0xa0, 0x18, 0x1d, 0x78, 0x43, 0x82, 0xe7, 0x08, 0x5e, 0x88, 0x0e, 0xbc, 0x2e, 0xa2, 0xae,
+	0x42, 0xc1, 0x3e, 0x60, 0x4c, 0x9e, 0x30, 0xeb, 0xf6, 0x01, 0x22, 0x8c, 0xcf, 0xa0, 0x64, 0x5a,
+	0x27, 0xa2, 0x19, 0x97, 0x61, 0x1d, 0x0b, 0x10, 0xfc, 0x2f, 0x67, 0xe6, 0xa3, 0x03, 0xaf, 0x6d,
+	0x23, 0x18, 0x1b, 0xe1, 0xda, 0xd4, 0x86, 0x9c, 0x99, 0x1f, 0xf9, 0x5e, 0xdb, 0x36, 0x06, 0x00,
+	0x0d, 0x3f, 0x08, 0xbe, 0x77, 0x17, 0x2e, 0x41, 0xde, 0x76, 0x66, 0xd1, 0x11, 0xb3, 0x0e, 0x93,
+	0x13, 0xc6, 0x1d, 0x28, 0xe2, 0xbc, 0x74, 0xdc, 0x30, 0xd2, 0x6f, 0x42, 0xce, 0x73, 0xc3, 0xa8,
+	0x96, 0xd9, 0xce, 0x2e, 0xcc, 0x1a, 0xc1, 0x8d, 0x6d, 0x28, 0xee, 0x5a, 0xa7, 0x4f, 0x71, 0xe6,
+	0xf4, 0x4b, 0x62, 0x0a, 0xc5, 0x94, 0x88, 0xf9, 0xac, 0x00, 0x0c, 0xac, 0xe0, 0xd0, 0x89, 0x88,
+	0xd3, 0xfd, 0xe7, 0x0c, 0x94, 0xfb, 0xf3, 0x83, 0x6f, 0xe6, 0x4e, 0x70, 0x86, 0x6d, 0xbe, 0x0d,
+	0xd9, 0xe8, 0x6c, 0x46, 0x39, 0x36, 0xee, 0x5f, 0xe1, 0xe2, 0x15, 0xfc, 0x5d, 0xcc, 0x64, 0x22,
+	0x09, 0x76, 0x62, 0xea, 0xdb, 0x8e, 0x1c, 0x83, 0xbc, 0xb9, 0x8e, 0xc9, 0xb6, 0x8d, 0xc7, 0x85,
+	0x3f, 0x13, 0xb3, 0xb0, 0xe6, 0xcf, 0xf4, 0x6d, 0xc8, 0x8f, 0x8e, 0x5c, 0xcf, 0xa6, 0x09, 0x48,
+	0xb7, 0x99, 0x11, 0x38, 0x4b, 0x81, 0x7f, 0x32, 0x0c, 0xdd, 0x9f, 0x49, 0xf6, 0x5f, 0x08, 0xfc,
+	0x93, 0xbe, 0xfb, 0x33, 0xc7, 0x18, 0x88, 0x33, 0x08, 0x60, 0xbd, 0xdf, 0xa8, 0x77, 0xea, 0xa6,
+	0x76, 0x01, 0x7f, 0xb7, 0xbe, 0x6a, 0xf7, 0x07, 0x7d, 0x2d, 0xa3, 0x6f, 0x00, 0x74, 0x7b, 0x83,
+	0xa1, 0x48, 0xaf, 0xe9, 0xeb, 0xb0, 0xd6, 0xee, 0x6a, 0x59, 0xa4, 0x41, 0x78, 0xbb, 0xab, 0xe5,
+	0xf4, 0x02, 0x64, 0xeb, 0xdd, 0x9f, 0x68, 0x79, 0xfa, 0xd1, 0xe9, 0x68, 0xeb, 0xc6, 0x9f, 0xad,
+	0x41, 0xa9, 0x77, 0xf0, 0xb5, 0x33, 0x8a, 0xb0, 0xcf, 0xb8, 0x4a, 0x9d, 0xe0, 0xb9, 0x13, 0x50,
+	0xb7, 0xb3, 0xa6, 0x48, 0x61, 0x47, 0xec, 0x03, 0xea, 0x5c, 0xd6, 0x5c, 0xb3, 0x0f, 0x88, 0x6e,
+	0x74, 0xe4, 0x4c, 0xac, 0x5a, 0x56, 0xd0, 0x51, 0x0a, 0x77, 0x85, 0x7f, 0xf0, 0x35, 0x75, 0x2f,
+	0x6b, 0xe2, 0x4f, 0xfd, 0x35, 0x28, 0x73, 0x19, 0xea, 0xfa, 0x02, 0x06, 0x2d, 0x2e, 0xbe, 0x75,
+	0x75, 0xf1, 0x51, 0x4e, 0x2a, 0x95, 0x91, 0xe2, 0x6c, 0x63, 0x50, 0x57, 0xac, 0x68, 0xff, 0xe0,
+	0x6b, 0xc6, 0x16, 0x79, 0x45, 0xfb, 0x07, 0x5f, 0x13, 0xea, 0x5d, 0xd8, 0x0a, 0xe7, 0x07, 0xe1,
+	0x28, 0x70, 0x67, 0x91, 0xeb, 0x4f, 0x99, 0xa6, 0x44, 0x34, 0x9a, 0x8a, 0x20, 0xe2, 0xdb, 0x50,
+	0x9c, 0xcd, 0x0f, 0x86, 0xee, 0x74, 0xec, 0x13, 0xdb, 0x2f, 0xdf, 0xaf, 0xf2, 0xc4, 0xec, 0xcd,
+	0x0f, 0xda, 0xd3, 0xb1, 0x6f, 0x16, 0x66, 0xfc, 0x43, 0x37, 0xa0, 0x3a, 0xf5, 0xa3, 0x21, 0x8a,
+	0x0a, 0xc3, 0x89, 0x13, 0x59, 0xb5, 0x72, 0x7c, 0xe0, 0x77, 0xfc, 0xd1, 0xf1, 0xae, 0x13, 0x59,
+	0xc6, 0x5b, 0x50, 0x10, 0xf9, 0xf0, 0xec, 0x8f, 0x9c, 0xa9, 0x35, 0x8d, 0x86, 0xb1, 0xd0, 0x50,
+	0x64, 0x40, 0xdb, 0x36, 0xfe, 0x28, 0x03, 0x5a, 0x5f, 0x69, 0x0a, 0x66, 0x5e, 0xc9, 0x39, 0x5e,
+	0x05, 0xb0, 0x46, 0x23, 0x7f, 0xce, 0xc5, 0xf0, 0x02, 0x2b, 0x09, 0x48, 0xdb, 0x56, 0xc7, 0x2f,
+	0x9b, 0x1a, 0xbf, 0xd7, 0xa1, 0x22, 0xf3, 0x29, 0x9b, 0xbe, 0x2c, 0x60, 0x72, 0x04, 0xc3, 0x79,
+	0x6a, 0xe7, 0x17, 0xc2, 0x39, 0xe7, 0xbe, 0x02, 0xeb, 0x24, 0x61, 0x84, 0x72, 0x56, 0x38, 0x65,
+	0xfc, 0xab, 0x0c, 0x54, 0xdb, 0x53, 0xdb, 0x39, 0xed, 0x8f, 0xac, 0xa9, 0x1c, 0x14, 0x37, 0x1c,
+	0xba, 0x08, 0x1b, 0x86, 0x23, 0x6b, 0x2a, 0x84, 0x83, 0xb2, 0x1b, 0xc6, 0x74, 0xd8, 0x07, 0x26,
+	0xa0, 0xaa, 0xd6, 0xa8, 0xc4, 0x12, 0x41, 0xa8, 0xb2, 0xb7, 0x60, 0xf3, 0xc0, 0xf1, 0xfc, 0xe9,
+	0xe1, 0x30, 0xf2, 0x87, 0x54, 0x91, 0xe8, 0x4b, 0x95, 0xc1, 0x03, 0x7f, 0x80, 0x40, 0xdc, 0xc6,
+	0x33, 0x2b, 0x88, 0xc2, 0x5a, 0x6e, 0x3b, 0x8b, 0xdb, 0x98, 0x12, 0x38, 0xcc, 0x6e, 0x38, 0x9c,
+	0x4f, 0xdd, 0x6f, 0xe6, 0xdc, 0x8d, 0xa2, 0x59, 0x74, 0xc3, 0x7d, 0x4a, 0xeb, 0xb7, 0x41, 0xe3,
+	0x9a, 0xa9, 0x58, 0x75, 0x9d, 0x6d, 0x10, 0x9c, 0x0a, 0x26, 0x66, 0xf7, 0x7f, 0xac, 0x41, 0xf1,
+	0xd1, 0x7c, 0x3a, 0xc2, 0xc9, 0xd0, 0xdf, 0x80, 0xdc, 0x78, 0x3e, 0x1d, 0xd5, 0x32, 0xea, 0x51,
+	0x1a, 0xef, 0x13, 0x93, 0x90, 0xc8, 0x81, 0xac, 0xe0, 0x10, 0x39, 0xd7, 0x12, 0x07, 0x42, 0xb8,
+	0xf1, 0x0f, 0x32, 0x5c, 0xe2, 0x23, 0xcf, 0x3a, 0xd4, 0x8b, 0x90, 0xeb, 0xf6, 0xba, 0x2d, 0xed,
+	0x82, 0x5e, 0x81, 0x62, 0xbb, 0x3b, 0x68, 0x99, 0xdd, 0x7a, 0x47, 0xcb, 0xd0, 0x76, 0x1e, 0xd4,
+	0x77, 0x3a, 0x2d, 0x6d, 0x0d, 0x31, 0x4f, 0x7b, 0x9d, 0xfa, 0xa0, 0xdd, 0x69, 0x69, 0x39, 0xc6,
+	0x98, 0xed, 0xc6, 0x40, 0x2b, 0xea, 0x1a, 0x54, 0xf6, 0xcc, 0x5e, 0x73, 0xbf, 0xd1, 0x1a, 0x76,
+	0xf7, 0x3b, 0x1d, 0x4d, 0xd3, 0x2f, 0xc2, 0x66, 0x0c, 0xe9, 0x31, 0x70, 0x1b, 0xb3, 0x3c, 0xad,
+	0x9b, 0x75, 0xf3, 0xb1, 0xf6, 0x23, 0xbd, 0x08, 0xd9, 0xfa, 0xe3, 0xc7, 0xda, 0xcf, 0x91, 0x33,
+	0x94, 0x9e, 0xb5, 0xbb, 0xc3, 0xa7, 0xf5, 0xce, 0x7e, 0x4b, 0xfb, 0xf9, 0x9a, 0x4c, 0xf7, 0xcc,
+	0x66, 0xcb, 0xd4, 0x7e, 0x9e, 0xd3, 0xb7, 0xa0, 0xf2, 0xd3, 0x5e, 0xb7, 0xb5, 0x5b, 0xdf, 0xdb,
+	0xa3, 0x86, 0xfc, 0xbc, 0x68, 0xfc, 0x87, 0x1c, 0xe4, 0xb0, 0x27, 0xba, 0x91, 0x70, 0xc1, 0xb8,
+	0x8b, 0xc8, 0x86, 0x76, 0x72, 0x7f, 0xfa, 0x97, 0xaf, 0x5d, 0x60, 0xfe, 0xf7, 0x3a, 0x64, 0x3d,
+	0x37, 0xaa, 0xad, 0xa9, 0x7b, 0x47, 0xc8, 0x8c, 0x4f, 0x2e, 0x98, 0x88, 0xd3, 0x6f, 0x42, 0x86,
+	0x19, 0x61, 0xf9, 0xfe, 0x86, 0xd8, 0x5c, 0xe2, 0x24, 0x7d, 0x72, 0xc1, 0xcc, 0xcc, 0xf4, 0x1b,
+	0x90, 0x79, 0x2e, 0xb8, 0x62, 0x85, 0xf1, 0x7c, 0x96, 0x22, 0xf6, 0xb9, 0xbe, 0x0d, 0xd9, 0x91,
+	0xcf, 0x12, 0x61, 0x8c, 0xe7, 0x93, 0x05, 0xcb, 0x1f, 0xf9, 0x9e, 0xfe, 0x06, 0x64, 0x03, 0xeb,
+	0xa4, 0xb6, 0xae, 0x4e, 0x57, 0x7c, 0x74, 0x21, 0x51, 0x60, 0x9d, 0x60, 0x23, 0xc6, 0xb5, 0x82,
+	0xda, 0x08, 0x39, 0xdf, 0x58, 0xcd, 0x58, 0xdf, 0x86, 0xcc, 0x49, 0xad, 0xa8, 0x0a, 0x41, 0xcf,
+	0xdc, 0xa9, 0xed, 0x9f, 0xf4, 0x67, 0xce, 0x08, 0x29, 0x4e, 0xf4, 0x37, 0x21, 0x1b, 0xce, 0x0f,
+	0x88, 0x93, 0x94, 0xef, 0x6f, 0x2d, 0x9d, 0x09, 0x58, 0x51, 0x38, 0x3f, 0xd0, 0xdf, 0x82, 0xdc,
+	0xc8, 0x0f, 0x82, 0x1a, 0xa8, 0x65, 0x25, 0xc7, 0x21, 0x0a, 0x85, 0x88, 0xc7, 0x0a, 0xa3, 0x5a,
+	0x59, 0x25, 0x4a, 0xce, 0x23, 0xac, 0x30, 0xd2, 0x6f, 0x89, 0x43, 0xae, 0xa2, 0xb6, 0x5a, 0x1e,
+	0x81, 0x58, 0x0e, 0x62, 0x71, 0x92, 0x26, 0xd6, 0x69, 0xad, 0xaa, 0x12, 0xc9, 0xb3, 0x0f, 0xdb,
+	0x34, 0xb1, 0x4e, 0xf5, 0x5b, 0x90, 0x7d, 0xee, 0x8c, 0x6a, 0x1b, 0x6a, 0x6d, 0x62, 0x92, 0x9e,
+	0x52, 0xf7, 0x10, 0x4d, 0xeb, 0xde, 0xf7, 0xec, 0xda, 0xa6, 0x3a, 0x97, 0x8f, 0x7c, 0xcf, 0x7e,
+	0x4a, 0x73, 0x49, 0x48, 0x3c, 0xf2, 0xad, 0xf9, 0x29, 0x72, 0x23, 0x8d, 0x0f, 0x67, 0x6b, 0x7e,
+	0xda, 0xb6, 0x91, 0xf9, 0x4f, 0xed, 0xe7, 0x24, 0x7d, 0x66, 0x4c, 0xfc, 0x89, 0xd7, 0xa3, 0xd0,
+	0xf1, 0x9c, 0x51, 0xe4, 0x3e, 0x77, 0xa3, 0x33, 0x92, 0x2f, 0x33, 0xa6, 0x0a, 0xda, 0x59, 0x87,
+	0x9c, 0x73, 0x3a, 0x0b, 0x8c, 0x27, 0x50, 0x10, 0xb5, 0x2c, 0xdd, 0xb1, 0xae, 0x41, 0xd1, 0x0d,
+	0x87, 0x23, 0x7f, 0x1a, 0x46, 0x42, 0x76, 0x2a, 0xb8, 0x61, 0x03, 0x93, 0xc8, 0x2e, 0x6d, 0x2b,
+	0xe2, 0x43, 0xa8, 0x62, 0xd2, 0x6f, 0xe3, 0x3e, 0x40, 0xd2, 0x2d, 0x6c, 0x93, 0xe7, 0x4c, 0xa5,
+	0x98, 0xe6, 0x39, 0xd3, 0x38, 0xcf, 0x9a, 0x92, 0xe7, 0x1a, 0x94, 0x62, 0xc9, 0x58, 0xaf, 0x40,
+	0xc6, 0x12, 0xc7, 0x5f, 0xc6, 0x32, 0x6e, 0x03, 0x08, 0xd4, 0x87, 0xf7, 0x1f, 0xa6, 0x71, 0x98,
+	0x92, 0x87, 0x62, 0xe6, 0xc0, 0xf8, 0x01, 0x54, 0x4c, 0x27, 0x9c, 0x7b, 0x51, 0xc3, 0xf7, 0x9a,
+	0xce, 0x58, 0x7f, 0x0f, 0x20, 0x4e, 0x87, 0x42, 0x4a, 0x49, 0xd6, 0x6e, 0xd3, 0x19, 0x9b, 0x0a,
+	0xde, 0xf8, 0x6f, 0x39, 0x58, 0x17, 0x19, 0x13, 0x89, 0x2a, 0xa3, 0x48, 0x54, 0xf1, 0xd9, 0xb0,
+	0x96, 0x96, 0x2a, 0x8f, 0x5c, 0xdb, 0x76, 0xa6, 0x52, 0x7a, 0xe4, 0x14, 0x4e, 0xb6, 0xe5, 0x1d,
+	0xd2, 0x86, 0xda, 0xb8, 0xaf, 0xcb, 0x4a, 0x27, 0xb3, 0xc0, 0x09, 0x43, 0x96, 0x5b, 0x2c, 0xef,
+	0x50, 0xee, 0xed, 0xfc, 0x8b, 0xf6, 0xf6, 0x35, 0x28, 0xe2, 0x91, 0x47, 0xb7, 0xbe, 0x75, 0x1e,
+	0x7d, 0x71, 0xbd, 0xd5, 0xdf, 0x86, 0x82, 0x90, 0xd7, 0x6b, 0x05, 0x75, 0xb9, 0x34, 0x19, 0x68,
+	0x4a, 0xac, 0x5e, 0x43, 0x21, 0x6f, 0x32, 0x71, 0xa6, 0x91, 0x3c, 0xa7, 0x45, 0x52, 0x7f, 0x17,
+	0x4a, 0xfe, 0x74, 0xc8, 0x42, 0x7d, 0xad, 0xa4, 0x2e, 0xdf, 0xde, 0x74, 0x9f, 0xa0, 0x66, 0xd1,
+	0x17, 0xbf, 0xb0, 0x29, 0x9e, 0x7f, 0x32, 0x1c, 0x59, 0x81, 0x4d, 0x3b, 0xab, 0x68, 0x16, 0x3c,
+	0xff, 0xa4, 0x61, 0x05, 0x36, 0xcb, 0x2d, 0xdf, 0x4c, 0xe7, 0x13, 0xda, 0x4d, 0x55, 0x53, 0xa4,
+	0xf4, 0x1b, 0x50, 0x1a, 0x79, 0xf3, 0x30, 0x72, 0x82, 0x9d, 0x33, 0xbe, 0xa6, 0x99, 0x09, 0x00,
+	0xdb, 0x35, 0x0b, 0xdc, 0x89, 0x15, 0x9c, 0xd1, 0xd6, 0x29, 0x9a, 0x32, 0x49, 0x07, 0xcd, 0xb1,
+	0x6b, 0x9f, 0xf2, 0x5d, 0xcd, 0xe4, 0x04, 0xd2, 0x1f, 0xd1, 0x4d, 0x3a, 0xa4, 0xfd, 0x51, 0x34,
+	0x65, 0x92, 0xe6, 0x81, 0x7e, 0xd2, 0x8e, 0x28, 0x99, 0x22, 0x95, 0x12, 0xba, 0xb7, 0xce, 0x15,
+	0xba, 0xf5, 0x45, 0xb9, 0xc7, 0x0f, 0xdc, 0x43, 0x57, 0x48, 0x2d, 0x17, 0x09, 0x09, 0x0c, 0x22,
+	0x82, 0x4f, 0xa1, 0x7a, 0xe8, 0x4c, 0x9d, 0xc0, 0x8a, 0x1c, 0x7b, 0x38, 0xf2, 0xf9, 0x76, 0x55,
+	0x96, 0xd3, 0xfc, 0x58, 0xa2, 0x90, 0xd7, 0x54, 0x0e, 0x95, 0x14, 0xe9, 0x22, 0xbc, 0xc3, 0xa1,
+	0xe7, 0x3c, 0x77, 0xf8, 0xce, 0x95, 0x37, 0x8b, 0x96, 0x77, 0xd8, 0xc1, 0xb4, 0xf1, 0x0d, 0x14,
+	0xc4, 0xc4, 0xe9, 0x37, 0x79, 0x53, 0xa6, 0x99, 0x3e, 0x9f, 0x6b, 0x08, 0xd7, 0xdf, 0x80, 0xaa,
+	0x68, 0x61, 0x18, 0x05, 0xee, 0xf4, 0x50, 0x2c, 0xc9, 0x0a, 0x03, 0xfb, 0x04, 0x43, 0xf1, 0x03,
+	0x17, 0xcd, 0xd0, 0x3a, 0x70, 0x3d, 0xdc, 0xfc, 0x59, 0x21, 0x2a, 0xcd, 0x3d, 0xaf, 0xce, 0x20,
+	0xa3, 0x07, 0x45, 0x39, 0xcd, 0xbf, 0x92, 0x3a, 0x8d, 0xff, 0x33, 0x03, 0x15, 0xb5, 0xff, 0xbf,
+	0x9a, 0x9e, 0xb0, 0x7c, 0x11, 0x46, 0x7e, 0xe0, 0xd8, 0x52, 0x85, 0xe3, 0x86, 0x7d, 0x4a, 0x0b,
+	0xee, 0x64, 0x85, 0x67, 0xd3, 0x51, 0x2d, 0x27, 0xb9, 0x53, 0x1d, 0x93, 0xc6, 0xff, 0x9e, 0x81,
+	0x32, 0x89, 0x40, 0x3d, 0x12, 0xf0, 0xf4, 0xf7, 0x40, 0x1f, 0x05, 0x8e, 0x15, 0x39, 0x43, 0xe7,
+	0x34, 0x0a, 0x2c, 0x21, 0xe8, 0xb0, 0xb4, 0xa4, 0x31, 0xa6, 0x85, 0x08, 0x96, 0x75, 0x5e, 0x83,
+	0xf2, 0xcc, 0x0a, 0x42, 0x29, 0x38, 0x73, 0xc3, 0x80, 0x41, 0x42, 0x6c, 0xd5, 0xa6, 0x87, 0x81,
+	0x35, 0x19, 0x46, 0xfe, 0xb1, 0x33, 0xe5, 0x2b, 0x03, 0x5f, 0x96, 0x36, 0x08, 0x3e, 0x40, 0x30,
+	0xdd, 0x1c, 0xfe, 0x75, 0x06, 0xaa, 0x7b, 0xbc, 0xb2, 0xbf, 0x74, 0xce, 0x9a, 0x7c, 0x43, 0x1d,
+	0x49, 0xae, 0x94, 0x33, 0xe9, 0xb7, 0x7e, 0x13, 0xca, 0xb3, 0x63, 0xe7, 0x6c, 0x98, 0xba, 0xcd,
+	0x95, 0x10, 0xd4, 0x20, 0xfe, 0xf3, 0x0e, 0xac, 0xfb, 0xd4, 0x91, 0x5a, 0x56, 0x3d, 0xfe, 0x94,
+	0x1e, 0x9a, 0x82, 0x00, 0x45, 0xc2, 0xb8, 0x28, 0x55, 0xf6, 0x14, 0x85, 0x51, 0xf3, 0x2f, 0x41,
+	0x1e, 0x51, 0x61, 0x2d, 0xcf, 0xb2, 0x1c, 0x25, 0xf4, 0x0f, 0xa0, 0x3a, 0xf2, 0x27, 0xb3, 0xa1,
+	0xcc, 0x2e, 0x4e, 0xf4, 0x34, 0xdf, 0x2c, 0x23, 0xc9, 0x1e, 0x97, 0x65, 0xfc, 0x7e, 0x16, 0x8a,
+	0xd4, 0x06, 0xc1, 0x3a, 0x5d, 0xfb, 0x54, 0xb2, 0xce, 0x92, 0x99, 0x77, 0x6d, 0x3c, 0x99, 0x5e,
+	0x22, 0x7e, 0xc6, 0x62, 0x65, 0x56, 0x15, 0x2b, 0xaf, 0xc0, 0xba, 0x90, 0x29, 0x79, 0x5e, 0x45,
+	0x6a, 0xa5, 0x44, 0x99, 0x5f, 0x25, 0x51, 0xe2, 0x14, 0x32, 0x8d, 0x73, 0x8a, 0x67, 0x38, 0xb3,
+	0x4f, 0x20, 0x50, 0x0b, 0x21, 0x2a, 0x63, 0x2c, 0xa4, 0x19, 0x63, 0x0d, 0x0a, 0xcf, 0xdd, 0xd0,
+	0xc5, 0x05, 0x52, 0xe4, 0x55, 0x25, 0x92, 0xca, 0x34, 0x94, 0x5e, 0x36, 0x0d, 0x71, 0xb7, 0x2d,
+	0xef, 0x90, 0xaf, 0x36, 0xb2, 0xdb, 0x75, 0xef, 0xd0, 0xd7, 0x3f, 0x84, 0xcb, 0x09, 0x5a, 0xf4,
+	0x86, 0x54, 0x80, 0xa4, 0xe5, 0x32, 0xf5, 0x98, 0x92, 0x7a, 0x44, 0x77, 0xcf, 0x3b, 0xb0, 0xa5,
+	0x64, 0x99, 0xa1, 0x08, 0x17, 0x12, 0x5f, 0x2d, 0x99, 0x9b, 0x31, 0x39, 0x49, 0x76, 0xa1, 0xf1,
+	0x8f, 0xd7, 0xa0, 0xfa, 0xc8, 0x0f, 0x1c, 0xf7, 0x70, 0x9a, 0xac, 0xba, 0xa5, 0xdb, 0x8d, 0x5c,
+	0x89, 0x6b, 0xca, 0x4a, 0x7c, 0x0d, 0xca, 0x63, 0xce, 0x38, 0x8c, 0x0e, 0x58, 0x31, 0x92, 0x33,
+	0x41, 0x80, 0x06, 0x07, 0x1e, 0xf2, 0x16, 0x49, 0x40, 0x99, 0x73, 0x94, 0x59, 0x66, 0xc2, 0xf3,
+	0x54, 0xff, 0x9c, 0x4e, 0x16, 0xdb, 0xf1, 0x9c, 0x88, 0xa7, 0x67, 0xe3, 0xfe, 0xab, 0x52, 0x9a,
+	0x51, 0xda, 0x74, 0xd7, 0x74, 0xc6, 0x75, 0x12, 0x01, 0xf1, 0xa0, 0x69, 0x12, 0xb9, 0xfe, 0xb9,
+	0x7a, 0x2a, 0xad, 0x7f, 0xcb, 0xbc, 0xcc, 0xc7, 0x8c, 0x01, 0x94, 0x62, 0x30, 0xca, 0xf3, 0x66,
+	0x4b, 0xc8, 0xf0, 0x17, 0xf4, 0x32, 0x14, 0x1a, 0xf5, 0x7e, 0xa3, 0xde, 0x6c, 0x69, 0x19, 0x44,
+	0xf5, 0x5b, 0x03, 0x96, 0xdb, 0xd7, 0xf4, 0x4d, 0x28, 0x63, 0xaa, 0xd9, 0x7a, 0x54, 0xdf, 0xef,
+	0x0c, 0xb4, 0xac, 0x5e, 0x85, 0x52, 0xb7, 0x37, 0xac, 0x37, 0x06, 0xed, 0x5e, 0x57, 0xcb, 0x19,
+	0x3f, 0x82, 0x62, 0xe3, 0xc8, 0x19, 0x1d, 0x9f, 0x37, 0x8a, 0xa4, 0x58, 0x70, 0x46, 0xc7, 0xb5,
+	0xb5, 0x25, 0x46, 0xc7, 0x08, 0xa3, 0x05, 0xa5, 0x3d, 0x2b, 0x88, 0x5c, 0x6a, 0xd7, 0x43, 0xa8,
+	0xc6, 0x89, 0xa6, 0x33, 0x96, 0xd2, 0x89, 0x1e, 0x4b, 0xe6, 0x31, 0xca, 0x4c, 0x13, 0x1a, 0xef,
+	0x41, 0x45, 0x05, 0xa0, 0xe6, 0xcc, 0x76, 0xc6, 0x2b, 0xf8, 0x2b, 0x82, 0x8d, 0xa7, 0x50, 0x69,
+	0xc8, 0xd3, 0xf6, 0xbc, 0xa6, 0xdf, 0x87, 0x0d, 0xda, 0xf1, 0xa3, 0x03, 0xb9, 0xe5, 0xd7, 0x56,
+	0x6c, 0xf9, 0x0a, 0xd2, 0x34, 0x0e, 0xc4, 0x9e, 0xff, 0x18, 0xca, 0x7b, 0x81, 0x3f, 0x73, 0x82,
+	0x88, 0x8a, 0xd5, 0x20, 0x7b, 0xec, 0x9c, 0x89, 0x52, 0xf1, 0x67, 0xa2, 0xef, 0x59, 0x53, 0xf5,
+	0x3d, 0xf7, 0xa1, 0x28, 0xb3, 0x7d, 0xeb, 0x3c, 0x3f, 0x84, 0xaa, 0xc8, 0xe3, 0x3a, 0x21, 0x56,
+	0x76, 0x17, 0x60, 0x16, 0x03, 0xc4, 0xc0, 0xc9, 0x2b, 0x8d, 0x28, 0xdc, 0x54, 0x28, 0x8c, 0x57,
+	0xa1, 0xf0, 0xd4, 0x75, 0x4e, 0x44, 0xf7, 0x9f, 0xbb, 0xce, 0x89, 0xec, 0x3e, 0xfe, 0x36, 0xfe,
+	0x59, 0x09, 0x8a, 0xb4, 0xbf, 0x9a, 0xe7, 0xab, 0xd8, 0xbe, 0x8b, 0xe4, 0xb7, 0x0d, 0xb9, 0x78,
+	0x4b, 0x2c, 0x0e, 0x22, 0x61, 0x90, 0x2b, 0x28, 0x7b, 0x9d, 0x39, 0x57, 0x29, 0x8a, 0xb7, 0x38,
+	0x8a, 0x4c, 0x74, 0x16, 0x85, 0xdf, 0x78, 0xe2, 0xa6, 0x9c, 0x00, 0xf4, 0xbb, 0x2c, 0xd0, 0xd0,
+	0xdd, 0x98, 0x85, 0xbe, 0x8b, 0xf2, 0xe2, 0x72, 0xe0, 0x39, 0xf2, 0x3a, 0x45, 0x52, 0x0e, 0x26,
+	0x88, 0x8f, 0x39, 0x41, 0x88, 0xec, 0x8a, 0x74, 0xf0, 0xa6, 0x4c, 0xea, 0x6f, 0x43, 0x0e, 0x99,
+	0x7c, 0xad, 0xac, 0x96, 0x92, 0x3a, 0xa5, 0x4c, 0x22, 0xd0, 0x6f, 0x43, 0x81, 0x58, 0x8b, 0x83,
+	0x9c, 0x46, 0x19, 0x6d, 0xc9, 0xf4, 0x4d, 0x89, 0xd6, 0xdf, 0x81, 0xfc, 0xf8, 0xd8, 0x39, 0x0b,
+	0x6b, 0xd5, 0xed, 0x6c, 0x52, 0x66, 0x6a, 0xcf, 0x9a, 0x4c, 0xa1, 0xdf, 0x82, 0x8d, 0xc0, 0x19,
+	0x0f, 0x49, 0xe9, 0x86, 0x4c, 0x26, 0xac, 0x6d, 0x10, 0x0f, 0xa9, 0x04, 0xce, 0xb8, 0x81, 0xc0,
+	0xc1, 0x81, 0x17, 0xea, 0x6f, 0xc1, 0x3a, 0xed, 0x1e, 0x94, 0xf7, 0x94, 0x9a, 0xe5, 0x56, 0x34,
+	0x05, 0x56, 0xff, 0x10, 0x40, 0x48, 0x95, 0xc3, 0x83, 0x33, 0x52, 0x56, 0xc7, 0x9b, 0x49, 0x5d,
+	0xff, 0xaa, 0xec, 0xf9, 0x36, 0xe4, 0x71, 0x91, 0x84, 0xb5, 0xab, 0xdb, 0xd9, 0x84, 0x8b, 0x2b,
+	0xab, 0xda, 0x64, 0x3c, 0x6a, 0xa7, 0x70, 0xa1, 0x0c, 0xc3, 0x6f, 0x58, 0x9b, 0x1d, 0x8b, 0xd9,
+	0x62, 0x55, 0xe1, 0xc9, 0xe0, 0x9c, 0xf4, 0xbf, 0xf1, 0xf4, 0x3b, 0x90, 0xb3, 0x71, 0x33, 0x5f,
+	0xa3, 0x12, 0xaf, 0x28, 0xf3, 0x82, 0xcc, 0xaa, 0xe9, 0x8c, 0x49, 0xf2, 0x27, 0x1a, 0xfd, 0x09,
+	0x6c, 0xe0, 0x32, 0xba, 0x4f, 0x87, 0x3d, 0x0e, 0x5f, 0xed, 0x3a, 0xe5, 0x7a, 0x7d, 0x21, 0x57,
+	0x57, 0x10, 0xd1, 0x60, 0xb7, 0xa6, 0x51, 0x70, 0x66, 0x56, 0xa7, 0x2a, 0x4c, 0xbf, 0x8e, 0x02,
+	0x10, 0x6a, 0xbf, 0x1c, 0xbb, 0xf6, 0x8a, 0x14, 0x8e, 0x38, 0xad, 0x7f, 0x06, 0x55, 0x5a, 0x58,
+	0x98, 0xc4, 0xca, 0x6b, 0x37, 0x88, 0x99, 0xaa, 0x4b, 0x46, 0xa2, 0xcc, 0x34, 0x25, 0xb2, 0x78,
+	0x37, 0x1c, 0x46, 0xce, 0x64, 0xe6, 0x07, 0x28, 0xa0, 0xbf, 0x2a, 0x95, 0x4a, 0x03, 0x09, 0xc2,
+	0x83, 0x38, 0x36, 0xad, 0x0d, 0xfd, 0xf1, 0x38, 0x74, 0xa2, 0xda, 0x4d, 0xda, 0x37, 0x1b, 0xd2,
+	0xc2, 0xd6, 0x23, 0x28, 0x1d, 0x84, 0xe1, 0xd0, 0x3e, 0x9b, 0x5a, 0x13, 0x77, 0x54, 0x7b, 0x8d,
+	0xef, 0x01, 0x6e, 0xd8, 0x64, 0x80, 0x2a, 0x8a, 0x6f, 0xa7, 0x44, 0xf1, 0x8b, 0x90, 0xb7, 0x0f,
+	0x70, 0x3b, 0xbe, 0x4e, 0xc5, 0xe6, 0xec, 0x83, 0xb6, 0xad, 0xbf, 0x0f, 0xa5, 0x99, 0x64, 0x81,
+	0x35, 0x43, 0x55, 0x38, 0xc4, 0x9c, 0xd1, 0x4c, 0x28, 0xf0, 0x0e, 0xfc, 0xc8, 0xb1, 0xa2, 0x79,
+	0xe0, 0xa0, 0x1a, 0xa8, 0xf6, 0x06, 0x95, 0xa4, 0x82, 0xb0, 0x75, 0x9e, 0x7f, 0xe8, 0x8e, 0x2c,
+	0xda, 0xf9, 0xb7, 0x58, 0xee, 0x12, 0x90, 0xb6, 0x9d, 0xc8, 0xa8, 0x96, 0x10, 0xa6, 0xde, 0x54,
+	0x65, 0x54, 0x8b, 0xa4, 0xa9, 0xeb, 0x8f, 0x49, 0x7a, 0xa7, 0x91, 0xfb, 0x78, 0x81, 0x41, 0xa5,
+	0xb6, 0x97, 0xc2, 0xc9, 0xd0, 0xde, 0x92, 0x10, 0xee, 0xe4, 0x89, 0x93, 0x5f, 0xff, 0x11, 0xe8,
+	0xcb, 0x73, 0xfe, 0x32, 0x6e, 0x99, 0x17, 0xdc, 0xf2, 0xf3, 0xb5, 0x87, 0x19, 0xe3, 0x29, 0x54,
+	0x53, 0xcc, 0x60, 0x25, 0xd7, 0x67, 0x91, 0xcb, 0x9a, 0x88, 0x6b, 0x38, 0x27, 0xa4, 0xa4, 0xed,
+	0x4e, 0x0f, 0x85, 0x06, 0x90, 0x25, 0x6d, 0x4a, 0x1b, 0x7f, 0x96, 0x85, 0xca, 0x13, 0x2b, 0x3c,
+	0xda, 0xb5, 0x66, 0xfd, 0xc8, 0x8a, 0x42, 0x5c, 0x22, 0x47, 0x56, 0x78, 0x34, 0xb1, 0x66, 0x2c,
+	0xfc, 0x66, 0x58, 0xbd, 0x20, 0x60, 0x28, 0xf9, 0xe2, 0xe2, 0xc4, 0x64, 0x6f, 0xba, 0xf7, 0xa5,
+	0xd0, 0x1d, 0xc4, 0x69, 0x64, 0x4d, 0xe1, 0xd1, 0x7c, 0x3c, 0x8e, 0xab, 0x92, 0x49, 0xfd, 0x16,
+	0x54, 0xc5, 0x4f, 0x92, 0x7c, 0x4f, 0x85, 0x79, 0x36, 0x0d, 0xd4, 0x1f, 0x40, 0x59, 0x00, 0x06,
+	0x92, 0x91, 0x6e, 0xc4, 0x3a, 0xa1, 0x04, 0x61, 0xaa, 0x54, 0xfa, 0x8f, 0xe1, 0xb2, 0x92, 0x7c,
+	0xe4, 0x07, 0xbb, 0x73, 0x2f, 0x72, 0x1b, 0x5d, 0x21, 0x66, 0xbc, 0xb2, 0x94, 0x3d, 0x21, 0x31,
+	0x57, 0xe7, 0x4c, 0xb7, 0x76, 0xd7, 0x9d, 0x12, 0x5f, 0xce, 0x9a, 0x69, 0xe0, 0x02, 0x95, 0x75,
+	0x5a, 0x2b, 0x2e, 0x51, 0x59, 0xa7, 0xb8, 0x61, 0x05, 0x60, 0xd7, 0x89, 0x8e, 0x7c, 0xbb, 0x56,
+	0x52, 0x37, 0x6c, 0x5f, 0x45, 0x99, 0x69, 0x4a, 0x1c, 0x4e, 0xbc, 0xdb, 0x8d, 0xa6, 0x11, 0x49,
+	0x9a, 0x59, 0x53, 0x26, 0xf1, 0xa8, 0x0a, 0xac, 0xe9, 0xa1, 0x13, 0xd6, 0xca, 0xdb, 0xd9, 0xdb,
+	0x19, 0x53, 0xa4, 0x8c, 0xbf, 0xbe, 0x06, 0x79, 0x9e, 0xc9, 0x57, 0xa0, 0x74, 0x40, 0x4a, 0x75,
+	0xbc, 0xc1, 0x0b, 0x45, 0x39, 0x01, 0xba, 0xf3, 0x09, 0x4b, 0x88, 0x42, 0xf7, 0x93, 0x31, 0xe9,
+	0x37, 0x16, 0xe9, 0xcf, 0x23, 0xac, 0x2b, 0x4b, 0x50, 0x91, 0xc2, 0x46, 0x04, 0xfe, 0x09, 0xad,
+	0x86, 0x1c, 0x21, 0x64, 0x12, 0xab, 0xe0, 0x53, 0x0f, 0x33, 0xe5, 0x09, 0x57, 0x24, 0x40, 0x63,
+	0x1a, 0x2d, 0xea, 0xa9, 0xd6, 0x97, 0xf4, 0x54, 0x68, 0x67, 0x1f, 0xfb, 0xc1, 0xc8, 0xe9, 0x4d,
+	0x9d, 0x46, 0x97, 0x46, 0xb8, 0x68, 0x2a, 0x10, 0xdc, 0x20, 0xb6, 0x3f, 0xa3, 0x41, 0xcd, 0x9b,
+	0xf8, 0x53, 0xff, 0x24, 0x5e, 0x9d, 0xd4, 0xc7, 0x5a, 0x49, 0x3d, 0x15, 0xd4, 0x75, 0x6c, 0xa6,
+	0xe8, 0xb0, 0x24, 0x64, 0xf5, 0x2c, 0xad, 0xe3, 0x4f, 0xa3, 0x05, 0x60, 0xfa, 0x27, 0xa1, 0x13,
+	0x91, 0x42, 0xf6, 0x2a, 0x75, 0x31, 0x65, 0x4a, 0xf3, 0x4f, 0xd0, 0x62, 0x26, 0xef, 0xba, 0x6b,
+	0xab, 0xef, 0xba, 0xc6, 0x3d, 0x28, 0xa0, 0x1c, 0x60, 0x45, 0x16, 0x6a, 0x15, 0x49, 0x07, 0xc6,
+	0xd2, 0x8b, 0x50, 0x06, 0x26, 0x75, 0x08, 0xad, 0x58, 0x47, 0xd6, 0x4b, 0x79, 0x5e, 0x57, 0xae,
+	0x8c, 0xf1, 0x19, 0x24, 0x0a, 0x14, 0x92, 0xc5, 0x2b, 0x50, 0xc2, 0xa6, 0x91, 0x7d, 0x41, 0xf0,
+	0x05, 0xb4, 0x66, 0x35, 0x30, 0x6d, 0xfc, 0x9b, 0x0c, 0x94, 0x7b, 0x81, 0x8d, 0x87, 0x1f, 0xea,
+	0x53, 0x5f, 0x7a, 0x35, 0x47, 0x39, 0xc4, 0xf7, 0x3c, 0x8b, 0xd8, 0xac, 0xb8, 0xb2, 0xc5, 0x00,
+	0xfd, 0x43, 0xc8, 0x8d, 0x91, 0x9d, 0x66, 0x55, 0xe9, 0x5c, 0x29, 0x5e, 0xfe, 0x46, 0x06, 0x6b,
+	0x12, 0xa9, 0xf1, 0x9b, 0x50, 0x56, 0x80, 0x29, 0x2d, 0xfc, 0x05, 0xb2, 0x87, 0xf5, 0x1b, 0x5a,
+	0x06, 0xd5, 0xf4, 0xcd, 0x56, 0xbf, 0xc1, 0x32, 0x39, 0x4a, 0xe7, 0xfd, 0xe1, 0xa3, 0xb6, 0xd9,
+	0x1f, 0x68, 0x39, 0x32, 0xb0, 0x11, 0xa0, 0x53, 0xef, 0xa3, 0x4e, 0x1e, 0x60, 0x7d, 0xbf, 0xdb,
+	0xfe, 0xf1, 0x7e, 0x4b, 0xd3, 0x8c, 0x7f, 0x9e, 0x01, 0x48, 0x94, 0xc5, 0xfa, 0xbb, 0x50, 0x3e,
+	0xa1, 0xd4, 0x50, 0xb1, 0x22, 0xa8, 0x7d, 0x04, 0x46, 0x93, 0x8c, 0xf4, 0x3e, 0x54, 0xe2, 0xe3,
+	0x02, 0xe5, 0x87, 0x65, 0x73, 0x42, 0x39, 0xc6, 0xef, 0x9c, 0xe9, 0xef, 0x41, 0xd1, 0xc7, 0x7e,
+	0x20, 0x69, 0x56, 0x15, 0x1e, 0x94, 0xee, 0x9b, 0x05, 0x3f, 0xb0, 0xa5, 0x9c, 0x31, 0x0e, 0xe4,
+	0x15, 0x3c, 0x26, 0x7d, 0x84, 0xa0, 0x86, 0x67, 0xcd, 0x43, 0xc7, 0x64, 0x7c, 0xcc, 0xa5, 0xf3,
+	0x09, 0x97, 0x36, 0x7e, 0x0a, 0x1b, 0x7d, 0x6b, 0x32, 0x63, 0x5e, 0x4e, 0x1d, 0xd3, 0x21, 0x87,
+	0x6b, 0x42, 0x2c, 0x3d, 0xfa, 0x8d, 0x9b, 0x6e, 0xcf, 0x09, 0x46, 0xce, 0x54, 0xee, 0x51, 0x99,
+	0x44, 0xf6, 0xbb, 0x8f, 0xdc, 0xdc, 0xf4, 0x4f, 0x24, 0x3b, 0x97, 0x69, 0xe3, 0x6f, 0x65, 0xa0,
+	0xac, 0x34, 0x43, 0xbf, 0x07, 0x39, 0x12, 0x48, 0x33, 0x2a, 0x23, 0x54, 0x08, 0xf8, 0x37, 0x8b,
+	0x30, 0x48, 0xa8, 0xbf, 0x05, 0xf9, 0x30, 0xb2, 0x02, 0x69, 0x77, 0xd0, 0x94, 0x1c, 0x3b, 0xfe,
+	0x7c, 0x6a, 0x9b, 0x8c, 0x46, 0x2d, 0xa7, 0x33, 0xb5, 0x6b, 0xd9, 0x73, 0xa8, 0x10, 0x69, 0x6c,
+	0x43, 0x29, 0x2e, 0x1e, 0x97, 0x80, 0xd9, 0x7b, 0xd6, 0xd7, 0x2e, 0xe8, 0x25, 0xc8, 0x9b, 0xf5,
+	0xee, 0xe3, 0x96, 0x96, 0x41, 0x73, 0x1d, 0x24, 0xb9, 0xf4, 0xbb, 0xa9, 0xd6, 0x5e, 0x5f, 0x2c,
+	0xf5, 0x2e, 0xfd, 0x55, 0x1a, 0x7b, 0x03, 0x4a, 0xf3, 0x29, 0x01, 0x1d, 0x5b, 0x9c, 0x44, 0x09,
+	0x00, 0x6f, 0x51, 0xd2, 0x4b, 0x66, 0xe1, 0x16, 0xf5, 0xdc, 0xf2, 0x8c, 0xcf, 0xa1, 0x14, 0x17,
+	0x87, 0x17, 0xc3, 0x47, 0xbd, 0x4e, 0xa7, 0xf7, 0xac, 0xdd, 0x7d, 0xac, 0x5d, 0xc0, 0xe4, 0x9e,
+	0xd9, 0x6a, 0xb4, 0x9a, 0x98, 0xcc, 0xe0, 0x9a, 0x6d, 0xec, 0x9b, 0x66, 0xab, 0x3b, 0x18, 0x9a,
+	0xbd, 0x67, 0xda, 0x9a, 0xf1, 0x3b, 0x19, 0xa8, 0xf6, 0x3c, 0xd4, 0x85, 0x35, 0xac, 0x19, 0x4a,
+	0x1c, 0xfa, 0x0f, 0x61, 0xeb, 0x60, 0x8e, 0x52, 0xef, 0xcc, 0xb3, 0x46, 0xce, 0x91, 0xef, 0xd9,
+	0x8e, 0xdc, 0x84, 0x29, 0xcb, 0x8a, 0x50, 0x02, 0x6b, 0x44, 0xbc, 0x97, 0xd0, 0xa2, 0xb7, 0xc9,
+	0x2c, 0xf0, 0x0f, 0x9c, 0x61, 0xe8, 0xcf, 0x83, 0x91, 0x53, 0x5b, 0x3b, 0x37, 0x6f, 0x99, 0xe8,
+	0xfa, 0x44, 0x66, 0xfc, 0x9d, 0x35, 0xa8, 0x34, 0x1d, 0x7b, 0x3e, 0xfb, 0xc2, 0x77, 0xa7, 0x8d,
+	0xe8, 0x54, 0xff, 0x08, 0x2a, 0xbe, 0x47, 0x0a, 0xcc, 0xa1, 0x62, 0xc7, 0x5f, 0x55, 0x0e, 0xf8,
+	0xd4, 0x03, 0xb2, 0xfa, 0xbf, 0x0f, 0x17, 0xf9, 0x62, 0x2e, 0xf4, 0x54, 0xa7, 0x9c, 0x19, 0xf7,
+	0x4c, 0xde, 0xd4, 0x18, 0xc5, 0x07, 0x34, 0x91, 0xff, 0x1a, 0x5c, 0x52, 0xc8, 0x91, 0xb1, 0x30,
+	0x7d, 0x76, 0x69, 0x8f, 0x6d, 0xc5, 0x79, 0x63, 0x0f, 0x83, 0x2f, 0xe0, 0x92, 0x6c, 0xe1, 0x88,
+	0x47, 0x8f, 0x33, 0xe7, 0xd4, 0xeb, 0x45, 0x6a, 0x74, 0x45, 0x83, 0xb7, 0x7c, 0x15, 0x48, 0x65,
+	0x7d, 0x08, 0x97, 0x6d, 0xec, 0xfd, 0x90, 0x07, 0xff, 0xd8, 0x71, 0x66, 0x43, 0xcf, 0x0a, 0x23,
+	0x61, 0xb0, 0xd4, 0x09, 0xb9, 0x83, 0xb8, 0x2f, 0x1d, 0x67, 0xd6, 0xb1, 0xc2, 0xc8, 0xf8, 0xff,
+	0xb2, 0x50, 0x62, 0xad, 0x02, 0x0e, 0xd7, 0x6d, 0x40, 0xeb, 0xf6, 0x30, 0x88, 0x6f, 0xdb, 0x4b,
+	0x46, 0xc9, 0x75, 0xff, 0xe0, 0x6b, 0x34, 0xe2, 0xbf, 0x2b, 0x8f, 0x3a, 0xbc, 0x99, 0xaf, 0xa9,
+	0x4a, 0x75, 0x29, 0xd6, 0x8b, 0xa3, 0x0f, 0xaf, 0x9c, 0x0f, 0xa0, 0xec, 0x4e, 0x43, 0x27, 0x88,
+	0x58, 0x93, 0x52, 0x38, 0x7f, 0x12, 0x98, 0x8c, 0x94, 0x2b, 0x0f, 0xa0, 0xcc, 0x9a, 0x15, 0xce,
+	0x54, 0x3c, 0x3f, 0x13, 0x93, 0x51, 0xa6, 0xcf, 0x60, 0x23, 0x61, 0x73, 0x94, 0xaf, 0x74, 0x6e,
+	0xbe, 0x6a, 0x4c, 0x49, 0x59, 0xef, 0xc3, 0x95, 0xf0, 0xd8, 0x9d, 0x0d, 0x45, 0x4b, 0xd1, 0xa0,
+	0x8f, 0xaa, 0xe5, 0xd9, 0xb1, 0xb0, 0x03, 0xe8, 0x88, 0x6d, 0x13, 0xb2, 0x37, 0x45, 0xdb, 0xc4,
+	0xde, 0xb1, 0xfe, 0x0e, 0xaa, 0xaa, 0x88, 0x7c, 0x76, 0x2c, 0xd7, 0x0a, 0x5d, 0x36, 0xf3, 0xe6,
+	0x06, 0x23, 0xf6, 0x8e, 0x85, 0x24, 0xf7, 0x29, 0xd4, 0xd8, 0x4e, 0x2e, 0x3a, 0x65, 0x8d, 0xc7,
+	0xce, 0x28, 0x1a, 0xa2, 0xe8, 0x20, 0x8c, 0x06, 0x97, 0x09, 0xcf, 0xea, 0xa1, 0x3a, 0x61, 0x91,
+	0xf3, 0x19, 0x7f, 0x37, 0x03, 0x25, 0xae, 0x16, 0x67, 0xe8, 0x75, 0xc8, 0xbe, 0x60, 0x76, 0x10,
+	0x87, 0xfa, 0x33, 0xcb, 0xb6, 0x45, 0x05, 0x8e, 0xcd, 0x55, 0x30, 0x3b, 0xd8, 0xb4, 0x6c, 0xbb,
+	0x2e, 0xe0, 0xc4, 0x56, 0x51, 0xcf, 0x18, 0x0e, 0xe5, 0xbd, 0x32, 0xb1, 0x8a, 0x17, 0xcd, 0x0d,
+	0x37, 0x14, 0xd7, 0x4a, 0x56, 0x15, 0xa7, 0x26, 0x3c, 0xf7, 0xe2, 0x09, 0x37, 0x74, 0x34, 0x4b,
+	0xd1, 0xde, 0x6f, 0x44, 0xa7, 0x5f, 0xe4, 0x8a, 0x19, 0x0d, 0x8c, 0x7f, 0x52, 0x86, 0x72, 0x7d,
+	0x6a, 0x79, 0x67, 0x3f, 0x73, 0xc8, 0xa4, 0x4f, 0x8a, 0xc3, 0xd9, 0x5c, 0x0c, 0x01, 0xdb, 0xbb,
+	0x4a, 0x04, 0xa1, 0x96, 0xa1, 0x85, 0x62, 0x1e, 0xc5, 0x78, 0xb6, 0x80, 0x01, 0x83, 0x88, 0x20,
+	0xce, 0x1f, 0x2b, 0xa5, 0x65, 0x7e, 0x92, 0xca, 0x93, 0xfc, 0xb1, 0xa4, 0x16, 0xe7, 0x27, 0x82,
+	0x37, 0xa0, 0x8a, 0x9e, 0x64, 0x64, 0xf4, 0x9b, 0x4f, 0x1c, 0x9b, 0x7d, 0x01, 0xd9, 0xbd, 0xac,
+	0x21, 0x60, 0x58, 0xca, 0xc4, 0x99, 0xf8, 0xc1, 0x19, 0x97, 0xb2, 0xce, 0xa5, 0x30, 0x88, 0x4a,
+	0x79, 0x0f, 0xf4, 0x13, 0xcb, 0x8d, 0x86, 0xe9, 0xa2, 0x58, 0x3a, 0xd6, 0x10, 0x33, 0x50, 0x8b,
+	0xbb, 0x02, 0xeb, 0xb6, 0x1b, 0x1e, 0xb7, 0x7b, 0x42, 0x32, 0x16, 0x29, 0xec, 0x0b, 0x7a, 0x35,
+	0x0c, 0x0f, 0xce, 0x22, 0x87, 0xa5, 0xb8, 0xac, 0x59, 0x42, 0xc8, 0x0e, 0x02, 0x90, 0xb1, 0x4f,
+	0x9d, 0xe8, 0xc4, 0x0f, 0x30, 0x27, 0x0b, 0xbe, 0x09, 0x00, 0x0f, 0x40, 0x24, 0xc5, 0x8a, 0x68,
+	0xed, 0x65, 0xcd, 0x38, 0x8d, 0x22, 0x25, 0xaf, 0x43, 0xc2, 0x56, 0xb8, 0xf9, 0x09, 0x04, 0x55,
+	0x14, 0xd4, 0x7c, 0x12, 0x8c, 0xb1, 0x0f, 0x64, 0xa4, 0xca, 0x9a, 0x15, 0x84, 0xd2, 0x3d, 0x19,
+	0xa9, 0x3e, 0x83, 0x6b, 0xa9, 0xfe, 0x0d, 0xad, 0x20, 0xb0, 0xce, 0x86, 0x13, 0xeb, 0x6b, 0x3f,
+	0x20, 0x9d, 0x46, 0xd6, 0xbc, 0xa2, 0x0e, 0x5b, 0x1d, 0xd1, 0xbb, 0x88, 0x3d, 0x37, 0xab, 0x3b,
+	0xf5, 0x83, 0xda, 0xe6, 0x79, 0x59, 0x11, 0x4b, 0xb7, 0x73, 0x9a, 0x60, 0x92, 0xd2, 0x43, 0x76,
+	0x4b, 0x34, 0xcb, 0x04, 0xdb, 0x21, 0x10, 0xca, 0xa9, 0xe1, 0x03, 0xe6, 0x97, 0x5b, 0x3c, 0xa0,
+	0xe1, 0x03, 0xe2, 0x84, 0x8c, 0x40, 0x03, 0x59, 0x4d, 0x97, 0x08, 0x74, 0x50, 0x45, 0xed, 0x57,
+	0xf8, 0x60, 0x38, 0x9b, 0x47, 0xec, 0x4f, 0x68, 0xe6, 0xc3, 0x07, 0x7b, 0xf3, 0x48, 0x80, 0x0f,
+	0x9d, 0xa8, 0x76, 0x49, 0x82, 0x1f, 0x3b, 0x11, 0x0a, 0x9b, 0xe1, 0x03, 0xa9, 0xe0, 0xbd, 0x2c,
+	0xc6, 0xf6, 0x81, 0xd0, 0xe0, 0x1a, 0x50, 0x8d, 0x91, 0xc3, 0xc9, 0x9c, 0x1d, 0x08, 0xb3, 0x66,
+	0x59, 0x12, 0xec, 0xce, 0x3d, 0x9c, 0xd8, 0x91, 0x35, 0x3a, 0x72, 0x86, 0x01, 0x36, 0xe5, 0x2a,
+	0x4f, 0x1d, 0x41, 0x4c, 0x6c, 0xcd, 0x2b, 0xc0, 0x89, 0xe1, 0x91, 0x1b, 0x91, 0xe2, 0x25, 0x6b,
+	0x16, 0x09, 0xf0, 0xc4, 0x8d, 0x70, 0x1f, 0x33, 0x52, 0xac, 0x40, 0x2a, 0xe2, 0x1a, 0x11, 0x6d,
+	0x12, 0x62, 0x97, 0xe0, 0x54, 0xd0, 0x6d, 0xd0, 0x52, 0xb4, 0x58, 0xde, 0x75, 0x22, 0xdd, 0x50,
+	0x48, 0xb1, 0xd4, 0xb7, 0x80, 0x33, 0x0f, 0x71, 0xe9, 0x71, 0x99, 0xaf, 0xf0, 0x2d, 0x8d, 0xc0,
+	0x4d, 0x37, 0x3c, 0xa6, 0x12, 0x6f, 0xc1, 0x86, 0x42, 0x87, 0xe5, 0xdd, 0xe0, 0x95, 0x11, 0x93,
+	0xa5, 0xda, 0x18, 0x38, 0x13, 0x3f, 0x12, 0xdd, 0x7c, 0x55, 0x69, 0xa3, 0x49, 0xf0, 0x74, 0x1b,
+	0x05, 0xed, 0x91, 0xcb, 0xaa, 0x14, 0xd9, 0x46, 0x26, 0xc5, 0x52, 0x5f, 0x87, 0xca, 0x49, 0xe0,
+	0x46, 0x91, 0x33, 0xe5, 0xcd, 0xff, 0x1a, 0x0f, 0xac, 0x80, 0xd1, 0xee, 0x7f, 0x1d, 0xdd, 0x51,
+	0x3d, 0x27, 0xe6, 0x6f, 0xdb, 0x4c, 0x22, 0x60, 0x92, 0x41, 0x88, 0xd1, 0x98, 0xb8, 0x53, 0xd2,
+	0xae, 0x64, 0xcd, 0x12, 0x43, 0xf0, 0xb2, 0xaa, 0xa0, 0xad, 0xd3, 0x9a, 0x91, 0x42, 0x5b, 0xa7,
+	0x88, 0x0e, 0x67, 0xae, 0xe7, 0xf1, 0xc6, 0x7f, 0x83, 0xd1, 0x04, 0xa1, 0x7d, 0x1f, 0xa3, 0xa9,
+	0xf6, 0x5b, 0x0a, 0x9a, 0xea, 0x7e, 0x05, 0x38, 0x41, 0x55, 0xbf, 0x29, 0x16, 0x0e, 0x02, 0xb0,
+	0xe6, 0x04, 0x69, 0x9d, 0xd6, 0xde, 0x52, 0x91, 0xd6, 0xa9, 0xe0, 0x5b, 0x58, 0x2a, 0xe5, 0x7d,
+	0x3b, 0xe6, 0x5b, 0x08, 0xc2, 0xdc, 0x2a, 0x81, 0x75, 0x5a, 0xbb, 0x9d, 0x26, 0xb0, 0x4e, 0xb1,
+	0x78, 0x9c, 0x06, 0x6e, 0xf8, 0x3b, 0x5c, 0x3c, 0x02, 0xa8, 0xdd, 0xdb, 0x50, 0x09, 0x1f, 0x0c,
+	0x13, 0xfc, 0x1d, 0xce, 0x1e, 0x3e, 0x30, 0x25, 0xc5, 0x2d, 0xd8, 0x88, 0x97, 0x06, 0xd3, 0xbc,
+	0xcb, 0x13, 0x6f, 0x8b, 0xa5, 0x81, 0x54, 0xc6, 0xcf, 0x33, 0x70, 0xbd, 0x47, 0xca, 0x21, 0x62,
+	0xff, 0xbb, 0x4e, 0x18, 0x5a, 0x87, 0xa8, 0x31, 0x78, 0x34, 0xff, 0xd9, 0xcf, 0x50, 0x6d, 0xb6,
+	0xb9, 0x67, 0x05, 0xce, 0x34, 0x8a, 0x0d, 0x55, 0x42, 0x33, 0xb3, 0x08, 0xd6, 0x1f, 0x82, 0xc6,
+	0x20, 0xf6, 0xa5, 0x6a, 0x48, 0x3b, 0xcd, 0xa2, 0x5e, 0x79, 0x89, 0x0a, 0x2f, 0x7b, 0xa5, 0xa6,
+	0x1b, 0x46, 0x26, 0xde, 0xf4, 0xf5, 0xcf, 0x40, 0xf3, 0xfc, 0x13, 0xbc, 0xb1, 0xa0, 0x18, 0x3b,
+	0x54, 0x04, 0x67, 0x71, 0x4a, 0x26, 0xd2, 0xf2, 0x06, 0x11, 0xc6, 0x69, 0xcc, 0x3a, 0x9f, 0xcd,
+	0xd2, 0x59, 0xd7, 0xce, 0xc9, 0x4a, 0x84, 0x49, 0xd6, 0x77, 0xa1, 0xac, 0xd4, 0xba, 0x42, 0xb8,
+	0x86, 0xa4, 0x2e, 0x24, 0x56, 0xea, 0x59, 0xe1, 0x9f, 0x09, 0x49, 0xe9, 0xe8, 0xd7, 0xa5, 0x91,
+	0x72, 0xcc, 0x24, 0x2b, 0x3f, 0xd9, 0xbb, 0x52, 0xd7, 0xb2, 0xcc, 0x4b, 0xaf, 0x65, 0xdb, 0x90,
+	0xf7, 0xdc, 0x49, 0xec, 0x34, 0x95, 0x32, 0xd8, 0x10, 0x02, 0xe7, 0x1a, 0x35, 0x7c, 0x74, 0x81,
+	0x54, 0xdd, 0xfb, 0x48, 0xef, 0x87, 0xf7, 0x31, 0x9a, 0xa2, 0xbb, 0x00, 0xb6, 0x1b, 0x46, 0x43,
+	0x52, 0xa9, 0x88, 0x66, 0x8b, 0x91, 0x89, 0xc7, 0xdf, 0x2c, 0xd9, 0xf2, 0xa7, 0xf1, 0xe7, 0xaf,
+	0x43, 0xae, 0xeb, 0xdb, 0x8e, 0xfe, 0x01, 0x94, 0xc8, 0x67, 0x55, 0x99, 0x0c, 0x21, 0xd0, 0x22,
+	0x9a, 0xfe, 0xd0, 0xa8, 0x16, 0xa7, 0xe2, 0xd7, 0xf9, 0x5e, 0xae, 0xaf, 0xd3, 0x45, 0x8c, 0xcc,
+	0xa7, 0x58, 0x7d, 0x59, 0x28, 0x8b, 0x10, 0x64, 0x32, 0x06, 0xcf, 0x41, 0x52, 0xb5, 0x07, 0xce,
+	0x94, 0xa4, 0xe7, 0xbc, 0x19, 0xa7, 0xe9, 0xfa, 0x1b, 0xf8, 0x28, 0x26, 0xf1, 0x69, 0x91, 0x5f,
+	0x71, 0xfd, 0x65, 0x3c, 0x1d, 0x1f, 0x1f, 0x40, 0xe9, 0x6b, 0xdf, 0x9d, 0x72, 0xc3, 0xd7, 0x97,
+	0x1a, 0x8e, 0xb7, 0x0b, 0x6e, 0xf8, 0xd7, 0xe2, 0x97, 0xfe, 0x06, 0x14, 0xfc, 0x29, 0x97, 0x5d,
+	0x58, 0x2a, 0x7b, 0xdd, 0x9f, 0x76, 0xd8, 0x85, 0x0a, 0xbd, 0x18, 0x03, 0xf7, 0xf0, 0x28, 0x1a,
+	0x62, 0x4e, 0x61, 0x76, 0x2d, 0xbb, 0xa1, 0x89, 0x30, 0x2c, 0x16, 0x17, 0xc9, 0xd8, 0xf5, 0x50,
+	0x1a, 0xa3, 0xc2, 0x4a, 0x4b, 0x85, 0x01, 0xa3, 0xa9, 0xc0, 0x37, 0xa1, 0x78, 0x18, 0xf8, 0x28,
+	0xf0, 0x9f, 0xd5, 0x60, 0x89, 0xb2, 0x40, 0xb8, 0x9d, 0x33, 0x14, 0x75, 0xe8, 0xa7, 0x3b, 0x3d,
+	0x1c, 0x92, 0x46, 0x03, 0x75, 0x64, 0x45, 0xb3, 0x22, 0x81, 0xa4, 0xab, 0x78, 0x13, 0x8a, 0xd6,
+	0xe1, 0xe1, 0x50, 0x78, 0x82, 0x2d, 0x95, 0x65, 0x1d, 0x1e, 0x52, 0x95, 0x77, 0xa1, 0x7a, 0x82,
+	0xae, 0x0c, 0x33, 0x67, 0xc4, 0xb4, 0xd5, 0xe5, 0xa1, 0x3c, 0x71, 0xa7, 0xb8, 0x12, 0x89, 0x5e,
+	0x5d, 0xb2, 0x1b, 0xdf, 0x7e, 0xc9, 0x6e, 0x9e, 0xb7, 0x64, 0x0d, 0x58, 0x17, 0x6a, 0x78, 0x6d,
+	0x89, 0x44, 0x60, 0xf4, 0x0f, 0xa1, 0x1c, 0x58, 0xd3, 0xe3, 0xa1, 0xb0, 0x61, 0xff, 0x44, 0xbd,
+	0x95, 0x9b, 0xd6, 0xf4, 0x58, 0x98, 0xb0, 0x21, 0x88, 0x7f, 0xa7, 0xc5, 0xdb, 0xad, 0x97, 0xdc,
+	0x67, 0x94, 0x6b, 0x92, 0xfe, 0xe2, 0x6b, 0xd2, 0xc7, 0x74, 0x1f, 0x71, 0xa6, 0xd1, 0x50, 0x66,
+	0xb8, 0xb8, 0x3a, 0x43, 0x85, 0xc9, 0x7a, 0x9c, 0x0d, 0x3b, 0x40, 0x5a, 0xb1, 0x21, 0xa9, 0xd0,
+	0x2e, 0xa5, 0x3a, 0x10, 0xab, 0xcb, 0x4c, 0x08, 0xe2, 0xdf, 0x7a, 0x1d, 0x36, 0x13, 0xf7, 0x58,
+	0xf6, 0x33, 0xbe, 0xac, 0xaa, 0xe5, 0x53, 0xfe, 0xb4, 0xf2, 0x06, 0xe4, 0xaa, 0x40, 0x5c, 0x26,
+	0xec, 0x34, 0xc2, 0xe3, 0x16, 0x92, 0x40, 0x53, 0x32, 0x2b, 0x04, 0xe4, 0x71, 0x0a, 0x89, 0x19,
+	0x88, 0x6b, 0x59, 0x74, 0x5a, 0xbb, 0xaa, 0xf6, 0x86, 0xc5, 0x9e, 0x46, 0x74, 0x6a, 0x96, 0x6c,
+	0xf9, 0x13, 0x0f, 0xea, 0x03, 0x77, 0x6a, 0xe3, 0xd2, 0x8b, 0xac, 0xc3, 0xb0, 0x56, 0xa3, 0x9d,
+	0x59, 0x16, 0xb0, 0x81, 0x75, 0x18, 0xe2, 0x25, 0xdd, 0xe2, 0x8b, 0x01, 0xb7, 0xfb, 0x9a, 0xaa,
+	0x45, 0x52, 0xae, 0x0c, 0x66, 0xd9, 0x4a, 0x12, 0xfa, 0xa7, 0xa0, 0x4b, 0x2b, 0xa1, 0x72, 0xe7,
+	0xbe, 0xbe, 0xb4, 0x1a, 0x37, 0x85, 0x99, 0x30, 0xbe, 0x71, 0xa3, 0xcf, 0x37, 0xa9, 0x0b, 0x86,
+	0x61, 0xe4, 0xcc, 0x6a, 0xaf, 0x50, 0x83, 0x80, 0x41, 0xfd, 0xc8, 0x99, 0xa1, 0xef, 0x53, 0xfa,
+	0x46, 0x74, 0x63, 0x85, 0xb1, 0x8d, 0x96, 0x85, 0x59, 0x19, 0x29, 0x29, 0x1c, 0x40, 0xf4, 0x63,
+	0x23, 0x69, 0x86, 0x32, 0xb2, 0x41, 0xa9, 0x32, 0xf5, 0xa3, 0x86, 0x84, 0xe1, 0x00, 0xca, 0xcb,
+	0x70, 0x74, 0x5a, 0xbb, 0xa9, 0x0e, 0x60, 0x7c, 0xcd, 0xc3, 0x8b, 0x8c, 0xf8, 0xa9, 0x37, 0x81,
+	0x9d, 0x24, 0xe8, 0x40, 0x76, 0x02, 0x76, 0x88, 0x20, 0x79, 0x27, 0xb6, 0xbf, 0x2d, 0x9e, 0x13,
+	0xa6, 0xe6, 0x2e, 0x40, 0xf4, 0x87, 0xb0, 0x31, 0x0b, 0x9c, 0xa1, 0x52, 0xb3, 0xa1, 0x76, 0x6a,
+	0x2f, 0x70, 0x92, 0xca, 0x2b, 0x33, 0x25, 0x85, 0xba, 0x1c, 0x25, 0xe7, 0xfc, 0x98, 0x32, 0xbf,
+	0x41, 0x99, 0x2f, 0x2d, 0x64, 0xde, 0x3f, 0xc6, 0xec, 0x1b, 0xb3, 0x54, 0x1a, 0xcd, 0x30, 0xed,
+	0xb0, 0x35, 0xb5, 0x49, 0x0e, 0x2a, 0x9a, 0x9c, 0xd0, 0x1f, 0x40, 0x85, 0x2f, 0x1d, 0xe4, 0x8c,
+	0x1a, 0xd6, 0xde, 0x52, 0xd5, 0xc4, 0x74, 0xf3, 0x20, 0x84, 0x59, 0xf6, 0xe2, 0xdf, 0xa1, 0xfe,
+	0x09, 0x6c, 0xb1, 0x0e, 0x5f, 0x65, 0x91, 0x6f, 0x2f, 0x4f, 0x39, 0x11, 0x3d, 0x4a, 0xf8, 0xa4,
+	0x09, 0xd7, 0x82, 0xf9, 0x94, 0x2e, 0x22, 0x22, 0x27, 0x6b, 0x97, 0x28, 0xff, 0x6d, 0xca, 0x7f,
+	0x55, 0xec, 0x2e, 0x26, 0xe3, 0xbc, 0xc4, 0x9b, 0xae, 0x04, 0x2a, 0x68, 0x0f, 0xf3, 0x9d, 0x53,
+	0x26, 0x6b, 0x5d, 0xa8, 0xcc, 0x77, 0xbe, 0x4b, 0x99, 0xa4, 0x91, 0xa1, 0x32, 0x75, 0xc8, 0xcd,
+	0xe7, 0xae, 0x4d, 0x52, 0x59, 0xc5, 0xa4, 0xdf, 0xfa, 0x9b, 0x68, 0x45, 0x1e, 0xcd, 0x83, 0xd0,
+	0x7d, 0xee, 0xa0, 0xd9, 0xea, 0x98, 0xe4, 0xb1, 0xa2, 0x59, 0x8d, 0xa1, 0x7d, 0x77, 0x7a, 0x8c,
+	0x2c, 0xc3, 0x39, 0x8d, 0x9c, 0x60, 0xca, 0xfe, 0xf1, 0xef, 0xa9, 0x2c, 0xa3, 0x45, 0x08, 0xdc,
+	0xe7, 0x26, 0x38, 0xf1, 0xef, 0x85, 0x99, 0x0d, 0x79, 0x66, 0xef, 0x7e, 0xab, 0x99, 0xed, 0xd3,
+	0xcc, 0xbe, 0x05, 0x45, 0x77, 0x1a, 0x39, 0x01, 0xea, 0x15, 0xef, 0x2d, 0x71, 0xe3, 0x18, 0xa7,
+	0xdf, 0x82, 0x42, 0xe8, 0xb9, 0xb8, 0xdf, 0x6b, 0x1f, 0x2c, 0x91, 0x49, 0x94, 0x7e, 0x1b, 0x4a,
+	0xf1, 0xd3, 0xae, 0xda, 0x87, 0x4b, 0x74, 0x09, 0x12, 0xd5, 0xfa, 0x27, 0xb8, 0xa0, 0xee, 0x2f,
+	0x11, 0x11, 0x1c, 0x8f, 0xef, 0x31, 0xca, 0xd7, 0x74, 0x7c, 0x3f, 0x58, 0x3a, 0xbe, 0x1f, 0xb9,
+	0x9e, 0xc7, 0xc7, 0xf7, 0x58, 0xfc, 0xc2, 0xc3, 0x8f, 0x72, 0x60, 0x4f, 0x3e, 0x5a, 0x3e, 0xfc,
+	0x10, 0xf7, 0x94, 0x1e, 0xc1, 0x95, 0x43, 0xd2, 0x55, 0xb3, 0xca, 0xfd, 0x63, 0x75, 0xac, 0xd2,
+	0x4a, 0x6c, 0x13, 0xc2, 0x38, 0x8d, 0x97, 0x05, 0xa1, 0xa9, 0x47, 0xfd, 0xd0, 0x27, 0xfc, 0xba,
+	0x82, 0x21, 0xa8, 0x1a, 0xfa, 0x00, 0xaa, 0xd2, 0xb5, 0x07, 0xab, 0x0b, 0x6b, 0x9f, 0x2e, 0xb5,
+	0x20, 0x4d, 0xa0, 0x37, 0xa1, 0x32, 0x46, 0x39, 0x7b, 0xc2, 0x62, 0x77, 0xed, 0x21, 0x35, 0x64,
+	0x5b, 0x1e, 0xac, 0xe7, 0x89, 0xe5, 0x66, 0x2a, 0x97, 0x7e, 0x17, 0x74, 0x77, 0xcc, 0xf3, 0xf9,
+	0x28, 0xf0, 0x27, 0x2c, 0x5a, 0xd7, 0x3e, 0x63, 0x6d, 0xd7, 0x32, 0x86, 0x0c, 0x77, 0xce, 0xd4,
+	0x1e, 0xe2, 0x33, 0x4e, 0x5a, 0xe5, 0x9f, 0x6f, 0x67, 0x13, 0xe6, 0x15, 0x3f, 0x01, 0x95, 0x8a,
+	0x59, 0xa4, 0xdd, 0x0d, 0x59, 0x6a, 0xf8, 0x0c, 0x70, 0xb9, 0x3e, 0x4f, 0xb2, 0xfe, 0xda, 0x0b,
+	0xb3, 0x22, 0xad, 0xcc, 0xfa, 0x10, 0x36, 0x58, 0xa9, 0x49, 0x12, 0x19, 0x2e, 0xd1, 0x1f, 0xa8,
+	0x9c, 0x4b, 0x55, 0xf7, 0xe2, 0xdb, 0xc3, 0x24, 0xa5, 0x7f, 0x0a, 0x9b, 0x52, 0x2f, 0x1b, 0x09,
+	0x15, 0xee, 0xaf, 0xab, 0xd5, 0xc6, 0x7a, 0x4f, 0xb3, 0x3a, 0x97, 0x3f, 0xa9, 0xca, 0x07, 0x50,
+	0xa5, 0x53, 0x34, 0x9c, 0x5a, 0xb3, 0xf0, 0xc8, 0x8f, 0x6a, 0xbf, 0xa1, 0x0a, 0x04, 0x7d, 0x01,
+	0x35, 0x2b, 0x48, 0x24, 0x53, 0xc8, 0xfc, 0x93, 0x7d, 0x3a, 0x8a, 0x9c, 0xda, 0x0f, 0x99, 0xf9,
+	0xc7, 0xc0, 0x46, 0xe4, 0xe8, 0x0f, 0x00, 0xac, 0xd9, 0xcc, 0x3b, 0xe3, 0xa5, 0xf9, 0x23, 0x5a,
+	0x9a, 0x97, 0x94, 0xa5, 0x59, 0x47, 0x24, 0xad, 0xcd, 0x92, 0x25, 0x7f, 0xea, 0xf7, 0xa1, 0x32,
+	0xf3, 0xc3, 0x68, 0x68, 0x4f, 0x3c, 0xea, 0x7f, 0x5d, 0xdd, 0xdb, 0x7b, 0x7e, 0x18, 0x35, 0x27,
+	0x1e, 0xf6, 0x02, 0x66, 0xf1, 0x6f, 0xbd, 0x03, 0x17, 0xd1, 0x35, 0x6d, 0x3e, 0xf3, 0xdc, 0x11,
+	0x8e, 0x80, 0x45, 0x66, 0xf2, 0xda, 0x0e, 0xd5, 0x78, 0x43, 0xa9, 0xb1, 0x37, 0x6d, 0x4a, 0x22,
+	0xe1, 0x67, 0xb6, 0xe5, 0x2f, 0x82, 0xe8, 0x4e, 0x48, 0x73, 0x10, 0x3b, 0x5b, 0x36, 0x58, 0x34,
+	0x20, 0xa8, 0xf4, 0xb6, 0x7c, 0x08, 0x9b, 0x09, 0x15, 0x76, 0x30, 0xac, 0x35, 0xd5, 0x95, 0xac,
+	0xb8, 0x7d, 0x57, 0x65, 0x46, 0x84, 0x85, 0x34, 0x76, 0xbe, 0xe7, 0xcd, 0x67, 0x82, 0x95, 0xd6,
+	0x5a, 0x62, 0xec, 0x08, 0xc8, 0x5c, 0x52, 0xb9, 0x36, 0x3b, 0x93, 0xda, 0x23, 0xf5, 0xda, 0xec,
+	0x4c, 0x50, 0xcc, 0x10, 0x4e, 0xb6, 0xe8, 0xa9, 0x12, 0xd6, 0x1e, 0x93, 0x97, 0xa5, 0x70, 0x72,
+	0x46, 0x2f, 0x16, 0xd2, 0x28, 0xda, 0x6e, 0x80, 0x57, 0x00, 0x24, 0xa9, 0x3d, 0xa1, 0x1e, 0x00,
+	0x83, 0x90, 0xc2, 0xf8, 0xa3, 0x3c, 0x14, 0xe5, 0x9d, 0x04, 0x1d, 0xe9, 0xf6, 0xbb, 0x5f, 0x76,
+	0x7b, 0xcf, 0xba, 0xfc, 0x04, 0xae, 0xde, 0xef, 0xb7, 0xcc, 0x81, 0x86, 0xef, 0xed, 0x80, 0x1e,
+	0xb9, 0x0c, 0xfb, 0x8d, 0x7a, 0x97, 0x9f, 0xc4, 0xd1, 0xd3, 0x1a, 0x4e, 0xaf, 0xe9, 0x5b, 0x50,
+	0x7d, 0xb4, 0xdf, 0x25, 0xa7, 0x3a, 0x06, 0x65, 0x11, 0xd4, 0xfa, 0x8a, 0xcd, 0x80, 0x0c, 0xc2,
+	0xe7, 0x30, 0xd5, 0xdd, 0xfa, 0xa0, 0x65, 0xb6, 0x25, 0x28, 0x4f, 0xfe, 0x79, 0xbd, 0x7d, 0xb3,
+	0x21, 0x4a, 0x5a, 0xd7, 0x2f, 0xc3, 0x56, 0x9c, 0x4d, 0x16, 0xa9, 0x15, 0xb0, 0x65, 0x7b, 0x66,
+	0xef, 0x8b, 0x56, 0x63, 0xa0, 0x01, 0xd9, 0x14, 0x1f, 0x3f, 0xd6, 0xca, 0x68, 0x6a, 0x6c, 0xb6,
+	0xfb, 0x83, 0x76, 0xb7, 0x31, 0xd0, 0x2a, 0xd8, 0xe0, 0x47, 0xed, 0xce, 0xa0, 0x65, 0x6a, 0x55,
+	0x34, 0x35, 0x7d, 0xd1, 0x6b, 0x77, 0xb5, 0x0d, 0x84, 0xf6, 0xeb, 0xbb, 0x7b, 0x9d, 0x96, 0xb6,
+	0x89, 0xd0, 0x7e, 0xcf, 0x1c, 0x68, 0x1a, 0x42, 0x9f, 0xb5, 0xbb, 0xcd, 0xde, 0x33, 0x6d, 0x0b,
+	0x8d, 0x51, 0xfb, 0x5d, 0xac, 0x46, 0x47, 0xab, 0x0f, 0xfd, 0x1c, 0xe2, 0x1b, 0xbe, 0x8b, 0x8a,
+	0x21, 0xf2, 0x12, 0xa2, 0xc8, 0xac, 0xd9, 0xc7, 0x36, 0x5c, 0xc6, 0xbe, 0xc4, 0x49, 0xa2, 0xbe,
+	0x82, 0xe5, 0xec, 0xb6, 0xbb, 0xfb, 0x7d, 0xed, 0x2a, 0x12, 0xd3, 0x4f, 0xc2, 0xd4, 0xb0, 0x9c,
+	0x76, 0x97, 0x86, 0xf2, 0x26, 0xfe, 0x6e, 0xb6, 0x3a, 0xad, 0x41, 0x4b, 0x7b, 0x0d, 0x7b, 0xd5,
+	0xe9, 0x35, 0xbe, 0x1c, 0xf6, 0xf6, 0xb4, 0xd7, 0x71, 0x4c, 0xf7, 0xcc, 0xd6, 0x50, 0x10, 0xbe,
+	0x81, 0x2f, 0x93, 0x1e, 0xed, 0xff, 0xf4, 0xa7, 0x3f, 0x19, 0x8a, 0x4e, 0xbd, 0x89, 0x75, 0x26,
+	0x14, 0xc3, 0xfd, 0x2f, 0xb5, 0xb7, 0x16, 0x40, 0xfd, 0x2f, 0xb5, 0xb7, 0x71, 0x50, 0xe4, 0x28,
+	0x6b, 0xb7, 0x91, 0xc0, 0x6c, 0x35, 0xf6, 0xcd, 0x7e, 0xfb, 0x69, 0x6b, 0xd8, 0x18, 0xb4, 0xb4,
+	0x77, 0x68, 0x14, 0xda, 0xdd, 0x2f, 0xb5, 0x3b, 0xd8, 0x4c, 0xfc, 0xc5, 0x63, 0xff, 0xae, 0xae,
+	0xc3, 0x46, 0x42, 0x4b, 0xb0, 0xf7, 0x90, 0x64, 0xc7, 0xec, 0xd5, 0x9b, 0x0d, 0x34, 0xcd, 0xbe,
+	0x8f, 0x7d, 0xec, 0xef, 0x75, 0xda, 0x03, 0xed, 0x2e, 0x76, 0xe4, 0x71, 0x7d, 0xf0, 0xa4, 0x65,
+	0x6a, 0xf7, 0x70, 0x1a, 0x07, 0xed, 0xdd, 0xd6, 0x50, 0x8c, 0xe9, 0x7d, 0xac, 0xe3, 0x51, 0xbb,
+	0xd3, 0xd1, 0x1e, 0x90, 0x21, 0xad, 0x6e, 0x0e, 0xda, 0x34, 0x91, 0x1f, 0x61, 0x01, 0xf5, 0xbd,
+	0xbd, 0xce, 0x4f, 0xb4, 0x8f, 0xb1, 0x83, 0xbb, 0xfb, 0x9d, 0x41, 0x7b, 0xb8, 0xbf, 0xd7, 0xac,
+	0x0f, 0x5a, 0xda, 0x27, 0x34, 0xcb, 0xbd, 0xfe, 0xa0, 0xb9, 0xdb, 0xd1, 0x3e, 0xa5, 0x32, 0x69,
+	0x8d, 0x35, 0x3a, 0xf8, 0xbc, 0xeb, 0xa1, 0x91, 0x2b, 0x6e, 0x6b, 0xdb, 0x46, 0xae, 0x68, 0x68,
+	0x86, 0xf1, 0xdb, 0x50, 0x94, 0x17, 0x52, 0x2c, 0xb2, 0xdd, 0xed, 0xb6, 0xf0, 0xd9, 0x66, 0x11,
+	0x72, 0x9d, 0xd6, 0xa3, 0x81, 0x96, 0x41, 0xa0, 0xd9, 0x7e, 0xfc, 0x64, 0xa0, 0xad, 0xe1, 0xcf,
+	0xde, 0x3e, 0x8e, 0x60, 0x96, 0xba, 0xde, 0xda, 0x6d, 0x6b, 0x39, 0xfc, 0x55, 0xef, 0x0e, 0xda,
+	0x5a, 0x9e, 0x16, 0x48, 0xbb, 0xfb, 0xb8, 0xd3, 0xd2, 0xd6, 0x11, 0xba, 0x5b, 0x37, 0xbf, 0xd4,
+	0x0a, 0x5c, 0x68, 0xb3, 0xf5, 0x95, 0x56, 0xc4, 0xf7, 0x9e, 0x9d, 0xfb, 0x5a, 0x09, 0x41, 0xcd,
+	0x56, 0x73, 0x7f, 0x4f, 0x03, 0xe3, 0x36, 0x14, 0xea, 0x87, 0x87, 0xbb, 0x78, 0xdf, 0xc7, 0x9e,
+	0xa2, 0xaf, 0x29, 0x6d, 0x98, 0x9d, 0xde, 0x60, 0xd0, 0xdb, 0xd5, 0x32, 0xb8, 0x44, 0x07, 0xbd,
+	0x3d, 0x6d, 0xcd, 0x68, 0x43, 0x51, 0x1e, 0xbd, 0xca, 0x4b, 0xb5, 0x22, 0xe4, 0xf6, 0xcc, 0xd6,
+	0x53, 0x36, 0x8b, 0x77, 0x5b, 0x5f, 0x61, 0x33, 0xf1, 0x17, 0x16, 0x94, 0xc5, 0x8a, 0xf8, 0x49,
+	0x19, 0x3d, 0x55, 0xeb, 0xb4, 0xbb, 0xad, 0xba, 0xa9, 0xe5, 0x8d, 0x8f, 0x61, 0x6b, 0x89, 0x71,
+	0x51, 0xf5, 0xf5, 0xb6, 0xa8, 0xbe, 0xfd, 0xb8, 0xdb, 0x33, 0x5b, 0xfc, 0xf6, 0x4d, 0x0c, 0xea,
+	0x9a, 0xf1, 0x2e, 0x94, 0x62, 0x0e, 0x8b, 0x8b, 0xac, 0x61, 0xf6, 0xfa, 0x7d, 0x9e, 0x83, 0x0b,
+	0x98, 0xa6, 0xb1, 0xe1, 0x74, 0xe6, 0x8b, 0x5c, 0xf1, 0x35, 0x6d, 0xfb, 0x8b, 0x5c, 0xf1, 0x96,
+	0xf6, 0xa6, 0xd1, 0x87, 0x2d, 0xc9, 0xe8, 0xc9, 0x7d, 0x9e, 0x6e, 0x20, 0x97, 0x20, 0x4f, 0xcf,
+	0x1a, 0xa4, 0x1f, 0x38, 0x25, 0x10, 0xda, 0x3b, 0xf8, 0xba, 0x1d, 0x3f, 0x55, 0xa6, 0x04, 0x4a,
+	0x76, 0x5d, 0xe5, 0xb5, 0x34, 0xfe, 0x36, 0xfe, 0xef, 0x0c, 0x14, 0xe3, 0xe3, 0xe3, 0x16, 0xac,
+	0x0d, 0xfa, 0xc2, 0xae, 0x73, 0xe9, 0x6e, 0x12, 0x1c, 0x62, 0x20, 0x7f, 0x99, 0x6b, 0x83, 0xbe,
+	0xfe, 0x1e, 0xac, 0xf3, 0xe3, 0x4e, 0xa1, 0xd3, 0xb9, 0x94, 0x3e, 0x92, 0x06, 0x84, 0x33, 0x05,
+	0x8d, 0xfe, 0x31, 0x94, 0xe2, 0xd6, 0x0a, 0xc5, 0xc9, 0xd5, 0x74, 0x86, 0x18, 0x6d, 0x26, 0x94,
+	0x46, 0x07, 0x36, 0xd2, 0x05, 0xa2, 0x19, 0x81, 0x8b, 0x54, 0x34, 0x79, 0x0a, 0x04, 0x55, 0x2f,
+	0x9c, 0x6a, 0x37, 0xa9, 0x61, 0xd5, 0xf8, 0x0d, 0x6a, 0xd3, 0xf8, 0x7f, 0xb3, 0x00, 0x89, 0x00,
+	0x8a, 0x03, 0x11, 0xab, 0x83, 0xf2, 0xc2, 0x70, 0xfd, 0x0a, 0x94, 0x3c, 0xdf, 0xb2, 0xd5, 0xd8,
+	0x10, 0x45, 0x04, 0xd0, 0x34, 0xa9, 0xef, 0xaf, 0x4a, 0xec, 0x35, 0x82, 0x76, 0x94, 0xb1, 0x1f,
+	0x4c, 0xac, 0x48, 0x38, 0xfd, 0x8b, 0x14, 0x9e, 0x23, 0xee, 0xe1, 0x14, 0xdf, 0x4c, 0x0c, 0x3d,
+	0x77, 0x4a, 0x7e, 0xff, 0x38, 0x07, 0x15, 0x01, 0xec, 0x20, 0x0c, 0xcf, 0x01, 0x67, 0x3a, 0xf2,
+	0xfc, 0xd0, 0xb1, 0x51, 0x29, 0xb1, 0x4e, 0xb2, 0x36, 0x48, 0xd0, 0xce, 0x19, 0xf7, 0x36, 0x98,
+	0xb8, 0x53, 0x2b, 0x12, 0xb6, 0x9c, 0x92, 0xa9, 0x40, 0xb0, 0xb9, 0x18, 0x62, 0x80, 0x9b, 0xcb,
+	0x8f, 0x8d, 0x8a, 0x08, 0xa0, 0xe6, 0xbe, 0x0a, 0xe0, 0x84, 0x23, 0x6b, 0xc6, 0x85, 0x97, 0xa8,
+	0xf0, 0x92, 0x80, 0xec, 0x9c, 0xe9, 0x1d, 0xd8, 0x18, 0x1c, 0xe0, 0xb9, 0xe7, 0xe3, 0xad, 0xbd,
+	0xe1, 0x7b, 0x42, 0x6f, 0x73, 0x6b, 0x51, 0x52, 0xbf, 0x9b, 0x26, 0x63, 0xb7, 0xc8, 0x85, 0xbc,
+	0xd7, 0xeb, 0x70, 0x71, 0x05, 0xd9, 0x77, 0xf2, 0xa4, 0xf3, 0xe4, 0xec, 0xd4, 0xa3, 0x88, 0xde,
+	0x12, 0xc5, 0x47, 0x7c, 0x46, 0xbe, 0x16, 0xe0, 0xd3, 0xfd, 0x15, 0x28, 0x25, 0x8e, 0x9c, 0x62,
+	0x92, 0x62, 0x07, 0x4d, 0xb4, 0x2a, 0xf8, 0xde, 0x70, 0xec, 0x3a, 0x9e, 0x2d, 0x48, 0xf8, 0x99,
+	0x48, 0x75, 0xe4, 0x7b, 0x8f, 0x10, 0x4a, 0x74, 0xc6, 0xff, 0x93, 0x07, 0x48, 0x2e, 0x77, 0x58,
+	0x1d, 0x6b, 0x5d, 0x62, 0x6f, 0xe4, 0x02, 0xa5, 0xdb, 0x36, 0x9a, 0x63, 0xc5, 0x43, 0xa9, 0xd8,
+	0x08, 0xef, 0x4e, 0x87, 0x07, 0x96, 0x74, 0xfa, 0xd1, 0x05, 0x96, 0xcd, 0xab, 0xed, 0xe9, 0x8e,
+	0x85, 0xa2, 0xe2, 0xa6, 0x9a, 0x07, 0xdf, 0x9d, 0x65, 0xcf, 0x79, 0x77, 0x56, 0x4d, 0xb2, 0x0f,
+	0xce, 0x66, 0xfa, 0x07, 0x70, 0x39, 0x70, 0xc6, 0x81, 0x13, 0x1e, 0x0d, 0xa3, 0x50, 0xad, 0x8c,
+	0xbd, 0xf2, 0xb6, 0x04, 0x72, 0x10, 0xc6, 0x75, 0x7d, 0x00, 0x97, 0xc5, 0xb5, 0x6f, 0xa1, 0x79,
+	0xfc, 0xa2, 0x7e, 0x8b, 0x91, 0x6a, 0xeb, 0xc8, 0x05, 0x93, 0x6e, 0xbc, 0x32, 0xc2, 0x4a, 0x11,
+	0x5d, 0x30, 0x71, 0x30, 0xc4, 0xbb, 0x63, 0xba, 0xb6, 0xd2, 0x9a, 0x29, 0x9a, 0x9c, 0xd0, 0x0d,
+	0xc8, 0x21, 0x6b, 0x25, 0xab, 0xdf, 0xc6, 0xfd, 0x8d, 0xbb, 0x08, 0xa4, 0xeb, 0x31, 0x42, 0x4d,
+	0xc2, 0xa1, 0xbb, 0x82, 0xda, 0x6d, 0x19, 0x04, 0x81, 0xed, 0xd0, 0x5a, 0xd2, 0x51, 0x93, 0xc3,
+	0x21, 0xbc, 0x0b, 0xba, 0xd2, 0x72, 0x49, 0x5d, 0x21, 0xea, 0xcd, 0xb8, 0xd9, 0x82, 0xf8, 0x6d,
+	0xa0, 0x26, 0xb2, 0x99, 0xa3, 0xba, 0x7c, 0xc7, 0x43, 0x24, 0x59, 0x3c, 0x3e, 0x80, 0xcb, 0x49,
+	0xef, 0x86, 0x56, 0x34, 0x8c, 0x8e, 0x9c, 0x21, 0xfa, 0xc4, 0x6c, 0x50, 0x77, 0xb6, 0xe2, 0x8e,
+	0xd6, 0xa3, 0xc1, 0x91, 0x83, 0xb7, 0x34, 0x45, 0x8b, 0xb6, 0xf9, 0x62, 0x2d, 0xda, 0x27, 0x50,
+	0x4b, 0x59, 0xf5, 0xd5, 0xe1, 0xe6, 0xd7, 0xa1, 0x97, 0x54, 0x5b, 0x7e, 0x3c, 0xe2, 0x77, 0x60,
+	0xeb, 0xc8, 0x0a, 0x87, 0xa9, 0xbc, 0xa4, 0xdc, 0x2b, 0x9a, 0x9b, 0x47, 0x56, 0xb8, 0xa7, 0xe4,
+	0x31, 0xfe, 0x20, 0x03, 0x1b, 0xe9, 0xeb, 0x2e, 0xbf, 0x9c, 0xf1, 0xe6, 0x93, 0x29, 0x3b, 0xa4,
+	0xe5, 0x4d, 0x99, 0xc4, 0xbd, 0xc0, 0x06, 0xff, 0xf9, 0x64, 0x2a, 0xf7, 0xc2, 0x0c, 0x2d, 0xfd,
+	0xf3, 0xc9, 0x54, 0x7f, 0x07, 0x0a, 0xb3, 0x63, 0x66, 0x0e, 0xe7, 0xad, 0xbe, 0xf5, 0x19, 0x3b,
+	0x20, 0xbf, 0x03, 0x85, 0xb9, 0x20, 0xcd, 0x9d, 0x47, 0x3a, 0x27, 0x52, 0xe3, 0x9f, 0xae, 0x41,
+	0x45, 0xd5, 0xd2, 0x7c, 0x1b, 0x4f, 0x80, 0xef, 0xe4, 0xa4, 0xb1, 0x4d, 0xbe, 0x84, 0x43, 0xf2,
+	0x76, 0xc6, 0x71, 0x62, 0x37, 0x00, 0x38, 0xb2, 0xc2, 0xfa, 0x3c, 0xf2, 0x1b, 0x3e, 0x1b, 0x33,
+	0x7d, 0x4f, 0x7a, 0x41, 0xf3, 0xce, 0x40, 0x9e, 0x20, 0x1c, 0xa0, 0x3f, 0x10, 0x8f, 0x2c, 0xe8,
+	0x59, 0x15, 0xb9, 0xdd, 0xe5, 0x97, 0xd6, 0x4b, 0x45, 0xbe, 0xaa, 0xc2, 0x94, 0x7e, 0x1f, 0x36,
+	0x13, 0x97, 0x76, 0xce, 0xb2, 0xbe, 0x94, 0xa5, 0x1a, 0xfb, 0xb3, 0x8b, 0xb7, 0xe2, 0xa8, 0x43,
+	0x47, 0x97, 0x19, 0xf1, 0x76, 0xa6, 0x20, 0x75, 0xe8, 0x3d, 0xcf, 0x16, 0xef, 0xfc, 0x98, 0x66,
+	0xea, 0x9c, 0x48, 0x9a, 0x58, 0xcf, 0xde, 0x75, 0x4e, 0x98, 0xc6, 0xf8, 0x8b, 0x0c, 0x6c, 0x2d,
+	0x29, 0x66, 0x90, 0x73, 0x26, 0x91, 0x8b, 0xf0, 0x27, 0x5e, 0x2c, 0x26, 0x56, 0x34, 0x3a, 0x1a,
+	0xce, 0x02, 0x67, 0xec, 0x9e, 0xca, 0xf0, 0x4b, 0x04, 0xdb, 0x23, 0x10, 0x1e, 0x28, 0x6c, 0xd7,
+	0x61, 0xd5, 0x35, 0x33, 0x3e, 0xb6, 0xe5, 0x74, 0x10, 0x12, 0xbb, 0x21, 0xe6, 0xce, 0x71, 0x43,
+	0xbc, 0x8e, 0x76, 0x12, 0x72, 0x39, 0x99, 0x1d, 0xd7, 0xf2, 0xf1, 0x03, 0xd8, 0xde, 0x74, 0xef,
+	0x58, 0xbf, 0x0f, 0x97, 0xe7, 0x21, 0xd9, 0x75, 0x0f, 0x9c, 0x20, 0x3c, 0x72, 0xe3, 0x2b, 0x12,
+	0x33, 0x90, 0x8b, 0xf3, 0xd0, 0xd9, 0x8d, 0x71, 0xdc, 0x13, 0xe3, 0x06, 0xac, 0xb7, 0x63, 0x85,
+	0x52, 0xec, 0xf7, 0x94, 0x15, 0x31, 0x4b, 0x7c, 0x28, 0x35, 0x28, 0xfe, 0xc9, 0xae, 0x35, 0xd3,
+	0xef, 0xe0, 0xab, 0xee, 0x99, 0x30, 0x26, 0xd5, 0x62, 0x8b, 0x1b, 0x63, 0xef, 0xee, 0x5a, 0x33,
+	0x3e, 0x78, 0x90, 0xe8, 0xfa, 0x27, 0x50, 0x94, 0x80, 0xef, 0x74, 0xc4, 0xfc, 0xcb, 0x35, 0x28,
+	0x35, 0x55, 0x85, 0x30, 0xde, 0xad, 0xa3, 0x60, 0x3e, 0x45, 0xd9, 0x4d, 0x46, 0x72, 0x40, 0x8f,
+	0x05, 0x01, 0x92, 0x4b, 0x7b, 0xed, 0x05, 0x4b, 0xfb, 0x06, 0xa0, 0xf2, 0x7b, 0xe8, 0xda, 0xa4,
+	0x52, 0xc9, 0xc6, 0x7e, 0xa0, 0x6d, 0x1b, 0x35, 0x2a, 0x2b, 0x5d, 0x60, 0x72, 0xdf, 0xde, 0x05,
+	0x26, 0xbf, 0xd2, 0x05, 0xe6, 0xad, 0xe4, 0x78, 0xc1, 0x25, 0x8e, 0x15, 0x97, 0xf8, 0x90, 0x9b,
+	0xc5, 0x4f, 0x4a, 0xb0, 0xf6, 0xcf, 0x61, 0x43, 0xf6, 0x4e, 0x94, 0x07, 0xa9, 0x57, 0x2c, 0x02,
+	0x47, 0x85, 0x9a, 0xd5, 0x48, 0x4d, 0xa6, 0xb7, 0x6c, 0xf9, 0x25, 0x6e, 0x36, 0xff, 0x7f, 0x06,
+	0x74, 0x71, 0xff, 0x7f, 0x34, 0xf7, 0xbc, 0x81, 0x73, 0x4a, 0x9c, 0xe1, 0x0e, 0x6c, 0x09, 0x05,
+	0xb7, 0xf2, 0x7a, 0x50, 0x98, 0x5f, 0x19, 0x91, 0x98, 0x5f, 0x57, 0x3d, 0x34, 0x5c, 0x5b, 0xf9,
+	0xd0, 0x70, 0xf5, 0x03, 0xc6, 0xd7, 0xa0, 0xac, 0x3e, 0xd3, 0x63, 0x71, 0x0c, 0xac, 0xe4, 0x85,
+	0xde, 0x5f, 0xac, 0x01, 0x24, 0x3a, 0x8a, 0x5f, 0xb5, 0xff, 0xd2, 0x8a, 0x29, 0xc9, 0xae, 0x9a,
+	0x92, 0xdb, 0xa0, 0xa9, 0x74, 0xca, 0x7b, 0xd1, 0x8d, 0x84, 0x50, 0x8a, 0x39, 0xf8, 0x8c, 0x23,
+	0x79, 0xd3, 0x47, 0xcf, 0x03, 0x84, 0xcb, 0x07, 0x23, 0x59, 0x61, 0x2a, 0x36, 0x60, 0x11, 0x43,
+	0x90, 0x60, 0x1a, 0x5d, 0x5d, 0xe2, 0x9c, 0xc3, 0x13, 0x37, 0x3a, 0xf2, 0xe7, 0x91, 0xd8, 0xac,
+	0xa1, 0xe0, 0x52, 0x57, 0x64, 0x49, 0xcf, 0x18, 0xcd, 0xfb, 0x35, 0x44, 0x41, 0x7d, 0x8c, 0xce,
+	0x66, 0x91, 0x73, 0x1a, 0x89, 0xe0, 0x10, 0xb5, 0x94, 0x7a, 0x47, 0x99, 0x5e, 0xb3, 0x38, 0x16,
+	0x09, 0xe3, 0xbf, 0x66, 0x21, 0xff, 0x63, 0x0c, 0x0b, 0xa1, 0x7f, 0x02, 0xa5, 0x30, 0x9a, 0x44,
+	0xaa, 0xa5, 0xf5, 0x1a, 0x17, 0x40, 0x78, 0x32, 0x94, 0x3a, 0xf8, 0xe8, 0x93, 0xf5, 0x9e, 0x48,
+	0x8b, 0xbf, 0x70, 0x52, 0xd1, 0x08, 0x12, 0x0a, 0xdf, 0x46, 0x4e, 0xa0, 0x15, 0x0e, 0xcd, 0xae,
+	0x61, 0xda, 0x83, 0x11, 0x55, 0x23, 0x26, 0x23, 0xd0, 0x0a, 0x17, 0xcf, 0xf8, 0x92, 0xb5, 0x93,
+	0x31, 0xf4, 0x2e, 0xc2, 0xb1, 0x50, 0xb5, 0x2b, 0xdf, 0xdf, 0xc6, 0x69, 0x3c, 0x5a, 0x49, 0xc0,
+	0xb7, 0x0e, 0xe5, 0x83, 0x7f, 0x91, 0x44, 0x37, 0x79, 0xfc, 0xf9, 0x2c, 0x70, 0x23, 0xa7, 0xff,
+	0x40, 0x72, 0x77, 0x05, 0x84, 0xe2, 0xb9, 0xed, 0x44, 0xce, 0x28, 0xea, 0x7f, 0x23, 0xfc, 0x02,
+	0x4b, 0xa6, 0x02, 0xd1, 0x3f, 0x07, 0xfd, 0xc0, 0x1a, 0x1d, 0xa3, 0x71, 0x73, 0x6a, 0x0f, 0x31,
+	0x6c, 0x86, 0xeb, 0x48, 0x3f, 0xc0, 0xb2, 0x32, 0x28, 0xe6, 0x56, 0x42, 0xf6, 0x63, 0xa6, 0xc2,
+	0x8b, 0xc5, 0xc4, 0x3a, 0x6d, 0xfa, 0x33, 0xe1, 0x66, 0x25, 0x52, 0xfa, 0x03, 0xb8, 0x82, 0x87,
+	0xa3, 0x7c, 0x10, 0x8a, 0x6b, 0x48, 0x68, 0xd4, 0x38, 0x3a, 0xcf, 0xc5, 0x23, 0x2b, 0x4c, 0xde,
+	0x80, 0xf1, 0x7d, 0xd4, 0xf8, 0x2d, 0xa8, 0xa6, 0xc6, 0x7d, 0x49, 0xa7, 0xd4, 0x6f, 0x75, 0x50,
+	0x83, 0x92, 0x51, 0x94, 0x22, 0x6b, 0x8a, 0x52, 0x24, 0xa7, 0xdc, 0x5d, 0xf3, 0xa4, 0x52, 0x69,
+	0x99, 0x8f, 0x5b, 0xda, 0xba, 0x91, 0x2b, 0x66, 0xb5, 0xac, 0xf1, 0xd7, 0xd6, 0x60, 0x6b, 0x10,
+	0x58, 0xd3, 0xd0, 0x62, 0x19, 0x66, 0x1a, 0x05, 0xbe, 0xa7, 0x7f, 0x0e, 0xc5, 0x68, 0xe4, 0xa9,
+	0x0b, 0xe1, 0x35, 0xc9, 0x76, 0x16, 0x48, 0xef, 0x0e, 0x46, 0xac, 0x06, 0x2f, 0x44, 0xfc, 0x43,
+	0x7f, 0x1f, 0xf2, 0x07, 0xce, 0xa1, 0x3b, 0x15, 0x9c, 0xf7, 0xf2, 0x62, 0xc6, 0x1d, 0x44, 0x62,
+	0x50, 0x3c, 0xa2, 0xd2, 0x3f, 0xc0, 0x18, 0x12, 0x13, 0x79, 0xe4, 0x25, 0xaf, 0xc1, 0x94, 0x8a,
+	0x10, 0x8b, 0x91, 0xd1, 0x98, 0x4e, 0xff, 0x04, 0x23, 0x4f, 0x79, 0x1e, 0x8e, 0x7b, 0x2d, 0xa7,
+	0x2e, 0xf3, 0x24, 0x8f, 0x29, 0xf0, 0x4f, 0x2e, 0x98, 0x31, 0xad, 0x71, 0x17, 0x0a, 0xa2, 0xb1,
+	0x38, 0x0c, 0x3b, 0xad, 0xc7, 0x6d, 0x31, 0x82, 0x8d, 0xde, 0xee, 0x6e, 0x7b, 0xc0, 0x4f, 0x5d,
+	0xcd, 0x5e, 0xa7, 0xb3, 0x53, 0x6f, 0x7c, 0xa9, 0xad, 0xed, 0x14, 0x61, 0x9d, 0xa7, 0x07, 0xdf,
+	0xc7, 0x6f, 0x2e, 0x74, 0x40, 0x7f, 0x08, 0xb9, 0x89, 0x6f, 0xcb, 0xe1, 0xb9, 0xb5, 0xb2, 0x97,
+	0x4a, 0x9a, 0x25, 0x6d, 0xcc, 0x61, 0x7c, 0x06, 0x1b, 0x69, 0xb8, 0xa2, 0xc7, 0xa8, 0x42, 0xc9,
+	0x6c, 0xd5, 0x9b, 0xc3, 0x5e, 0x17, 0xb5, 0x07, 0xa8, 0x4d, 0xa0, 0xe4, 0x33, 0xb3, 0x4d, 0xaa,
+	0x87, 0xdf, 0x04, 0x6d, 0x71, 0x60, 0xf4, 0xc7, 0x78, 0x6b, 0x42, 0x2b, 0x01, 0xc2, 0xd4, 0x29,
+	0xbb, 0xb9, 0x62, 0x24, 0x05, 0x19, 0xbb, 0xa1, 0x8c, 0x52, 0x69, 0xe3, 0xb7, 0x40, 0x5f, 0x1e,
+	0xc1, 0x5f, 0x5d, 0xf1, 0xff, 0x25, 0x03, 0xb9, 0x3d, 0xcf, 0xc2, 0x88, 0x45, 0x79, 0x0a, 0x43,
+	0x23, 0xf8, 0xb7, 0xba, 0x9b, 0x70, 0x59, 0x10, 0x4e, 0x7f, 0x17, 0xb2, 0xd1, 0x48, 0xbe, 0xb0,
+	0xbd, 0x7a, 0xce, 0xe2, 0xc3, 0x58, 0x30, 0xd1, 0xc8, 0xc3, 0x00, 0x68, 0xb6, 0x2d, 0xbd, 0xd2,
+	0x85, 0x36, 0x03, 0xef, 0xb2, 0x4d, 0x67, 0xec, 0x4e, 0x5d, 0x11, 0x36, 0x07, 0x49, 0x30, 0x2c,
+	0x8e, 0x3d, 0xf2, 0xd2, 0x4f, 0x0c, 0xf8, 0xd6, 0x1b, 0x17, 0x68, 0x8f, 0x30, 0x96, 0x61, 0x35,
+	0x42, 0x67, 0xb9, 0xf9, 0x94, 0xfc, 0x19, 0x43, 0x71, 0x1f, 0x2b, 0xa3, 0x14, 0x33, 0x27, 0xa7,
+	0xc8, 0x50, 0x3c, 0xd5, 0x9b, 0x05, 0xce, 0xcc, 0x0a, 0xe2, 0x9b, 0x98, 0x1b, 0xee, 0x31, 0x00,
+	0xe3, 0xc5, 0x60, 0xe9, 0xc6, 0x7b, 0xb8, 0xbe, 0x49, 0xa4, 0x37, 0xe4, 0xaf, 0x15, 0x81, 0xe0,
+	0x04, 0xc6, 0xf8, 0xcb, 0x2c, 0x94, 0x95, 0xf6, 0xe8, 0x1f, 0x41, 0xd1, 0x1e, 0x79, 0x2b, 0x38,
+	0xb2, 0x42, 0x74, 0xb7, 0x29, 0xb7, 0xa0, 0xcd, 0x3f, 0xd8, 0xc6, 0x12, 0x0d, 0x9f, 0x5b, 0x81,
+	0xcb, 0x91, 0xb1, 0xd6, 0x54, 0x63, 0x47, 0xdf, 0x89, 0x9e, 0x4a, 0x0c, 0x86, 0x42, 0x0c, 0x95,
+	0x34, 0xdd, 0x3b, 0x44, 0x97, 0xb2, 0xa9, 0x08, 0x63, 0x0c, 0xc4, 0xd8, 0x85, 0x02, 0x8f, 0xa4,
+	0xce, 0xa9, 0x33, 0x9a, 0x47, 0xf2, 0xde, 0x51, 0x95, 0x1d, 0x22, 0x20, 0x92, 0x0a, 0xbc, 0x7e,
+	0x1f, 0xb9, 0xad, 0xe5, 0x79, 0x3e, 0x09, 0x6b, 0x79, 0xd5, 0xf4, 0xd0, 0x8c, 0xe1, 0x1c, 0x56,
+	0x51, 0xa6, 0xf0, 0xd5, 0x84, 0x1f, 0x1d, 0x39, 0x52, 0x9a, 0x97, 0xd1, 0x56, 0x10, 0xd4, 0x6c,
+	0x74, 0x70, 0xa5, 0x10, 0xda, 0xf8, 0xc3, 0x0c, 0x14, 0xc4, 0x08, 0xa0, 0x72, 0x16, 0x5f, 0xa7,
+	0x3f, 0xad, 0x9b, 0x6d, 0xd4, 0x74, 0x8a, 0x97, 0x11, 0x8f, 0xcd, 0x7a, 0x57, 0x30, 0x48, 0xb3,
+	0xf5, 0xb4, 0xf7, 0x65, 0x8b, 0x95, 0x83, 0xcd, 0x56, 0xf7, 0x27, 0x5a, 0x96, 0x35, 0xe1, 0xad,
+	0xbd, 0xba, 0x89, 0xbc, 0xb2, 0x0c, 0x85, 0xd6, 0x57, 0xad, 0xc6, 0x3e, 0x31, 0xcb, 0x0d, 0x80,
+	0x66, 0xab, 0xde, 0xe9, 0xf4, 0x1a, 0xc8, 0x3c, 0xd7, 0x51, 0x9d, 0xdb, 0x30, 0x5b, 0xf5, 0x41,
+	0x6b, 0x58, 0x6f, 0x34, 0x7a, 0xfb, 0xdd, 0x81, 0x56, 0xc0, 0x1a, 0xeb, 0xa8, 0x4d, 0x8e, 0x41,
+	0x14, 0x01, 0xab, 0x69, 0xf6, 0xf6, 0x62, 0x48, 0x69, 0xa7, 0x84, 0x77, 0x40, 0x9a, 0x2b, 0xe3,
+	0x8f, 0xb7, 0x60, 0x23, 0xbd, 0x34, 0xf5, 0x4f, 0xa1, 0x68, 0xdb, 0xa9, 0x39, 0xbe, 0xb1, 0x6a,
+	0x09, 0xdf, 0x6d, 0xda, 0x72, 0x9a, 0xf9, 0x07, 0xba, 0x33, 0xf1, 0x46, 0x5a, 0x5b, 0xda, 0x48,
+	0x72, 0x1b, 0xfd, 0x10, 0x36, 0x45, 0x24, 0x0f, 0x54, 0x79, 0x1d, 0x58, 0xa1, 0x93, 0xde, 0x25,
+	0x0d, 0x42, 0x36, 0x05, 0xee, 0xc9, 0x05, 0x73, 0x63, 0x94, 0x82, 0xe8, 0x3f, 0x80, 0x0d, 0x8b,
+	0xae, 0xf9, 0x71, 0xfe, 0x9c, 0x2a, 0x86, 0xd6, 0x11, 0xa7, 0x64, 0xaf, 0x5a, 0x2a, 0x00, 0x17,
+	0xa2, 0x1d, 0xf8, 0xb3, 0x24, 0x73, 0x3e, 0x65, 0x75, 0x0b, 0xfc, 0x99, 0x92, 0xb7, 0x62, 0x2b,
+	0x69, 0x7c, 0x95, 0x26, 0x5a, 0x9e, 0xa8, 0x3a, 0xe2, 0x2d, 0xcb, 0xcd, 0x26, 0xb1, 0x12, 0x43,
+	0x8c, 0x8e, 0x92, 0x24, 0x7a, 0xfc, 0x73, 0x83, 0x39, 0x5b, 0x41, 0x5d, 0x6b, 0xd4, 0x5a, 0x99,
+	0x0b, 0xac, 0x38, 0xa5, 0x7f, 0x00, 0x40, 0xed, 0xe4, 0x3c, 0xc5, 0x94, 0x3f, 0x4a, 0xe0, 0xcf,
+	0x64, 0x96, 0x92, 0x2d, 0x13, 0x4a, 0xf3, 0x58, 0x51, 0x55, 0x5a, 0x6e, 0x1e, 0x29, 0xab, 0x92,
+	0xe6, 0x51, 0x32, 0x69, 0x1e, 0x67, 0x83, 0xa5, 0xe6, 0xc9, 0x5c, 0x60, 0xc5, 0xa9, 0xb8, 0x79,
+	0x9c, 0xa7, 0xbc, 0xd8, 0x3c, 0x99, 0xa5, 0x64, 0xcb, 0x04, 0x4e, 0xdb, 0xc2, 0xed, 0xa1, 0x72,
+	0xee, 0xed, 0x01, 0xa7, 0x2d, 0x7d, 0x7f, 0xf8, 0x01, 0x6c, 0x84, 0x47, 0xfe, 0x89, 0xc2, 0x40,
+	0xaa, 0x6a, 0xee, 0xfe, 0x91, 0x7f, 0xa2, 0x72, 0x90, 0x6a, 0xa8, 0x02, 0xb0, 0xb5, 0xdc, 0x45,
+	0xb2, 0x90, 0x6d, 0xa8, 0xad, 0xa5, 0x1e, 0xa2, 0x99, 0x0c, 0x5b, 0x6b, 0xc9, 0x04, 0x0e, 0x4a,
+	0xa2, 0xf6, 0x09, 0x6b, 0x9b, 0xea, 0xa0, 0x74, 0xa4, 0xca, 0x07, 0x6b, 0x82, 0x58, 0x01, 0x84,
+	0xaf, 0x34, 0xaa, 0xf3, 0xa9, 0x9a, 0x4d, 0x53, 0xd7, 0xd6, 0xfe, 0x34, 0x95, 0xb1, 0xc2, 0xa4,
+	0x22, 0x6b, 0xb2, 0x2b, 0x42, 0xe7, 0x9b, 0xb9, 0x33, 0x1d, 0x39, 0xb5, 0xad, 0xe5, 0x5d, 0xd1,
+	0x17, 0xb8, 0x64, 0x57, 0x48, 0x48, 0xbc, 0xae, 0xe3, 0xec, 0xfa, 0xe2, 0xba, 0x56, 0x32, 0x57,
+	0x6c, 0x25, 0x9d, 0x6c, 0xa8, 0x38, 0xef, 0xc5, 0xa5, 0x0d, 0xa5, 0x64, 0xae, 0x5a, 0x2a, 0x00,
+	0x47, 0x4a, 0xb4, 0x9c, 0x06, 0x37, 0xe5, 0xd3, 0xc5, 0xad, 0x16, 0xa3, 0x0b, 0xa3, 0x38, 0x85,
+	0x6b, 0x35, 0x70, 0xf0, 0xb6, 0x22, 0x96, 0xc2, 0x65, 0x75, 0xad, 0x9a, 0x84, 0x89, 0xb7, 0x52,
+	0x90, 0x24, 0x95, 0xca, 0x66, 0x6e, 0x14, 0xd4, 0xec, 0xe5, 0xca, 0xf6, 0xdc, 0x28, 0x48, 0x2a,
+	0xc3, 0x14, 0x3e, 0x3a, 0xa7, 0xa1, 0xa1, 0x2c, 0x8e, 0xca, 0xba, 0x71, 0x58, 0x44, 0x86, 0xa2,
+	0x2d, 0x7e, 0xe3, 0x62, 0x11, 0x75, 0x8c, 0xec, 0x51, 0x6d, 0xac, 0x2e, 0x16, 0xae, 0xa2, 0xd1,
+	0x6c, 0xe0, 0x62, 0x61, 0xa2, 0x86, 0x3d, 0xd2, 0xef, 0x00, 0xe5, 0x26, 0xfa, 0xc3, 0x54, 0x34,
+	0xaf, 0xc0, 0x9f, 0x31, 0x75, 0x01, 0x09, 0x90, 0x16, 0x7b, 0xe0, 0xf9, 0x53, 0xd9, 0xf1, 0xa3,
+	0x54, 0x0f, 0x10, 0x11, 0x33, 0x83, 0x51, 0x9c, 0x32, 0x7e, 0x77, 0x1d, 0x0a, 0x82, 0xd7, 0x62,
+	0xec, 0x42, 0xc1, 0xf2, 0x9b, 0xf5, 0x41, 0x7d, 0xa7, 0xde, 0x47, 0x21, 0x4d, 0x87, 0x0d, 0xe6,
+	0xf9, 0x31, 0x2c, 0x83, 0xe7, 0x00, 0x31, 0xfd, 0x18, 0xb4, 0x86, 0xe7, 0x80, 0xc8, 0xcb, 0x51,
+	0x13, 0xb3, 0x68, 0x81, 0xe3, 0x8c, 0x0c, 0xa0, 0x87, 0x9a, 0x94, 0x8b, 0xd3, 0x79, 0x25, 0x0b,
+	0xdb, 0xca, 0xd6, 0x93, 0x2c, 0x0c, 0x28, 0xc4, 0x59, 0xa4, 0x31, 0x4d, 0x87, 0x8d, 0x81, 0xb9,
+	0xdf, 0x6d, 0x24, 0xf5, 0x94, 0x30, 0x93, 0x28, 0xe6, 0x69, 0xbb, 0xf5, 0x4c, 0x03, 0xcc, 0xc4,
+	0xa5, 0x50, 0xba, 0x8c, 0x62, 0x26, 0x15, 0x42, 0xc9, 0x8a, 0x7e, 0x15, 0x2e, 0xf6, 0x9f, 0xf4,
+	0x9e, 0x0d, 0x39, 0x53, 0xdc, 0x85, 0xaa, 0x7e, 0x09, 0x34, 0x05, 0xc1, 0xc5, 0x6f, 0x60, 0x95,
+	0x04, 0x95, 0x84, 0x7d, 0x6d, 0x93, 0xec, 0xce, 0x08, 0x1b, 0xf0, 0xb9, 0xab, 0x61, 0x57, 0x38,
+	0x6b, 0xaf, 0xb3, 0xbf, 0xdb, 0xed, 0x6b, 0x5b, 0xd8, 0x08, 0x82, 0x70, 0xcb, 0xf5, 0xb8, 0x98,
+	0xe4, 0xb4, 0xbe, 0x48, 0x07, 0x38, 0xc2, 0x9e, 0xd5, 0xcd, 0x6e, 0xbb, 0xfb, 0xb8, 0xaf, 0x5d,
+	0x8a, 0x4b, 0x6e, 0x99, 0x66, 0xcf, 0xec, 0x6b, 0x97, 0x63, 0x40, 0x7f, 0x50, 0x1f, 0xec, 0xf7,
+	0xb5, 0x2b, 0x71, 0x2b, 0xf7, 0xcc, 0x5e, 0xa3, 0xd5, 0xef, 0x77, 0xda, 0xfd, 0x81, 0x76, 0x15,
+	0x0d, 0xdf, 0x49, 0x8b, 0x24, 0x71, 0x4d, 0x69, 0xa8, 0xf9, 0xb8, 0x35, 0xd0, 0xae, 0xc5, 0xcd,
+	0x68, 0xf4, 0x3a, 0x18, 0xd0, 0xb2, 0xd7, 0xd5, 0xae, 0x23, 0x11, 0xd9, 0x91, 0x45, 0x6f, 0x5e,
+	0xc1, 0x76, 0xed, 0x77, 0x55, 0xd0, 0x0d, 0x65, 0x69, 0xf4, 0x5b, 0x3f, 0xde, 0x6f, 0x75, 0x1b,
+	0x2d, 0xed, 0xd5, 0x64, 0x69, 0xc4, 0xb0, 0x9b, 0xf1, 0xd2, 0x88, 0x41, 0xaf, 0xc5, 0x75, 0x4a,
+	0x50, 0x5f, 0xdb, 0xc6, 0xf2, 0x44, 0x3b, 0xba, 0xdd, 0x56, 0x63, 0x80, 0x7d, 0x7d, 0x3d, 0x1e,
+	0xc5, 0xfd, 0xbd, 0xc7, 0x26, 0x86, 0xe3, 0x31, 0x10, 0x62, 0xb6, 0xba, 0xf5, 0x5d, 0x39, 0xdb,
+	0x6f, 0x28, 0xb3, 0xbd, 0xd7, 0x1e, 0x98, 0xda, 0xad, 0x78, 0x76, 0x29, 0xf9, 0xa6, 0xfe, 0x0a,
+	0x5c, 0x55, 0xd7, 0xe1, 0xf0, 0x59, 0x7b, 0xf0, 0x44, 0xd8, 0x80, 0xdf, 0x62, 0xfb, 0x25, 0x21,
+	0x1b, 0xcd, 0x06, 0x1b, 0xbb, 0x29, 0x2f, 0xa6, 0x6e, 0xef, 0x54, 0x28, 0xf8, 0xb5, 0x10, 0x40,
+	0x8c, 0x2f, 0x40, 0x57, 0xe3, 0xc0, 0x0a, 0xa7, 0x57, 0x1d, 0x72, 0xe3, 0xc0, 0x9f, 0xc8, 0xa0,
+	0x09, 0xf8, 0x1b, 0xef, 0xdf, 0xb3, 0xf9, 0x01, 0xd9, 0x55, 0x93, 0x47, 0xd1, 0x2a, 0xc8, 0xf8,
+	0xdb, 0x19, 0xd8, 0x48, 0x0b, 0x1f, 0xa4, 0x70, 0x1d, 0x0f, 0x51, 0x87, 0x49, 0x51, 0xa8, 0xc2,
+	0x38, 0x3c, 0xeb, 0xb8, 0x8b, 0x36, 0x46, 0x04, 0xa1, 0x3a, 0x20, 0x96, 0x25, 0xb8, 0xd4, 0x38,
+	0xad, 0xb7, 0xe1, 0x62, 0x2a, 0x94, 0x6e, 0x2a, 0x06, 0x58, 0x2d, 0x0e, 0x81, 0xb9, 0xd0, 0x7e,
+	0x53, 0x0f, 0x97, 0xfb, 0x24, 0x9e, 0xb6, 0xe7, 0x92, 0xa7, 0xed, 0x4f, 0xa0, 0x9a, 0x92, 0x75,
+	0x48, 0x8b, 0x33, 0x4e, 0xb7, 0xb4, 0xe8, 0x8e, 0x5f, 0xde, 0x4c, 0xe3, 0x6f, 0x66, 0xa0, 0xa2,
+	0x4a, 0x3e, 0xdf, 0xbb, 0x24, 0xf2, 0x45, 0x11, 0xbf, 0xd1, 0xf6, 0x25, 0xa2, 0x4f, 0x49, 0x50,
+	0x9b, 0x82, 0xfe, 0xb3, 0xee, 0xfa, 0xd1, 0x71, 0x3f, 0xee, 0x8e, 0x0a, 0x42, 0x35, 0x08, 0x45,
+	0x86, 0x79, 0xf4, 0x25, 0x12, 0x88, 0x98, 0xc7, 0x09, 0xc4, 0x78, 0x0d, 0x4a, 0x8f, 0x8e, 0xa5,
+	0x6b, 0x8e, 0x1a, 0x8b, 0xad, 0xc4, 0x2f, 0xe9, 0xf1, 0x83, 0x03, 0x1b, 0x89, 0xce, 0x82, 0x2c,
+	0xda, 0x1c, 0x82, 0x99, 0x97, 0x03, 0x86, 0x60, 0x8e, 0xbf, 0x07, 0xb0, 0xa6, 0x7e, 0x0f, 0xe0,
+	0x0d, 0x51, 0x58, 0x56, 0x65, 0xf9, 0x71, 0x5d, 0x5c, 0x3a, 0xfa, 0x77, 0xe2, 0x7f, 0xd3, 0x19,
+	0x3b, 0x41, 0xe0, 0xd8, 0xb5, 0xdc, 0x6a, 0xe2, 0x14, 0x11, 0xdd, 0xf1, 0x9c, 0x71, 0x2d, 0xaf,
+	0x1e, 0xab, 0xe9, 0xd0, 0x3a, 0x88, 0x37, 0xfe, 0x53, 0x16, 0xca, 0x8a, 0x1c, 0xf9, 0xad, 0x96,
+	0xdf, 0x0d, 0x8c, 0x93, 0x2c, 0x03, 0xbd, 0x88, 0xc7, 0xd1, 0x31, 0x20, 0x35, 0x57, 0xd9, 0x85,
+	0xb9, 0xc2, 0x78, 0x0f, 0xfc, 0x98, 0x51, 0x06, 0xdf, 0x13, 0xc9, 0xb4, 0xb2, 0x36, 0xff, 0x12,
+	0xfb, 0xca, 0x87, 0x50, 0x51, 0x34, 0xad, 0x18, 0xf2, 0x38, 0xbb, 0x82, 0xbe, 0x9c, 0x68, 0x5d,
+	0x43, 0x7c, 0x95, 0x36, 0x3e, 0x1e, 0xda, 0x07, 0xfc, 0x64, 0xb6, 0x84, 0x71, 0x85, 0x9a, 0x07,
+	0x64, 0x7d, 0x1a, 0xc7, 0xa2, 0x13, 0xeb, 0xbf, 0x8a, 0x63, 0x29, 0x20, 0xdd, 0x86, 0xc2, 0xf8,
+	0x58, 0x7d, 0xfa, 0xba, 0x34, 0xe4, 0xeb, 0xe3, 0x63, 0x7a, 0xf0, 0x7a, 0x0f, 0x2e, 0x89, 0xf3,
+	0xdb, 0x0a, 0x87, 0x1c, 0x87, 0x82, 0x02, 0x00, 0x71, 0x64, 0xb6, 0x2d, 0xc6, 0xd5, 0xc3, 0x3e,
+	0x61, 0x70, 0xc5, 0x19, 0x50, 0x51, 0x16, 0x20, 0x47, 0x4a, 0x2a, 0x99, 0x29, 0x98, 0xfe, 0x10,
+	0x2a, 0xe3, 0x63, 0x9e, 0xd0, 0x81, 0xbf, 0xeb, 0x88, 0xd7, 0x01, 0x97, 0x16, 0xa7, 0x92, 0x5c,
+	0x0e, 0x52, 0x94, 0xa8, 0x7a, 0x33, 0xad, 0x93, 0xfe, 0x8f, 0x3b, 0x24, 0x44, 0x96, 0x4c, 0x91,
+	0xfa, 0x22, 0x57, 0xdc, 0xd0, 0x36, 0x8d, 0x7f, 0x94, 0x81, 0x8d, 0xe4, 0x0e, 0x80, 0x9b, 0x10,
+	0x4d, 0x17, 0x49, 0xec, 0xf4, 0xda, 0xe2, 0x35, 0x01, 0x49, 0xd0, 0x8e, 0xc6, 0x11, 0x46, 0x57,
+	0x45, 0xb7, 0x5a, 0xa5, 0xfa, 0xce, 0xae, 0x8c, 0xda, 0xfc, 0x18, 0xb2, 0x68, 0x24, 0x26, 0x7d,
+	0x13, 0x1e, 0x89, 0x7c, 0x37, 0xe5, 0xc3, 0x90, 0xfc, 0x5b, 0xbe, 0x6c, 0xfd, 0x84, 0x83, 0x39,
+	0xec, 0x99, 0xed, 0xdd, 0xba, 0xf9, 0x93, 0x21, 0x02, 0x48, 0x68, 0x78, 0xd4, 0x33, 0x5b, 0xed,
+	0xc7, 0x5d, 0x02, 0xe4, 0x48, 0x1b, 0x95, 0x34, 0xb1, 0x6e, 0xdb, 0x8f, 0x8e, 0xd5, 0xc0, 0x40,
+	0x99, 0x54, 0x60, 0xa0, 0x38, 0x86, 0x96, 0xd2, 0x72, 0x5e, 0x6f, 0xa9, 0x2d, 0x9d, 0x4d, 0xb6,
+	0x34, 0xc6, 0xbb, 0xc2, 0xd0, 0x53, 0xe9, 0x8b, 0x5e, 0x7a, 0x03, 0x11, 0x81, 0xf1, 0x8b, 0x0c,
+	0xe8, 0xa9, 0x86, 0xf0, 0xdd, 0xe3, 0xfb, 0xb6, 0xe5, 0x53, 0xa8, 0x09, 0xef, 0x3b, 0xa6, 0x52,
+	0x74, 0xed, 0x62, 0x48, 0x2f, 0xfb, 0x89, 0x57, 0x6a, 0x12, 0x80, 0x4b, 0xbf, 0x07, 0x1c, 0x2b,
+	0x90, 0x3c, 0x54, 0x72, 0xe7, 0xdc, 0x13, 0xcd, 0x84, 0x26, 0x09, 0x0e, 0xa8, 0x06, 0x3d, 0x64,
+	0x35, 0xfd, 0x66, 0x32, 0x6b, 0xb4, 0xe7, 0x8d, 0xdf, 0xcf, 0xc0, 0xc5, 0xf4, 0x82, 0xf8, 0xe5,
+	0x7a, 0x99, 0x8e, 0xf0, 0x98, 0x5d, 0x8c, 0xf0, 0xb8, 0x6a, 0x3d, 0xe5, 0x56, 0xae, 0xa7, 0xdf,
+	0xc9, 0xc0, 0x25, 0x65, 0xf4, 0x93, 0xdb, 0xe2, 0x5f, 0x51, 0xcb, 0x94, 0x40, 0x8f, 0xb9, 0x54,
+	0xa0, 0x47, 0xe3, 0x4f, 0x32, 0x70, 0x65, 0xa1, 0x25, 0xa6, 0xf3, 0x57, 0xda, 0x96, 0x74, 0x40,
+	0x48, 0x32, 0x15, 0xc8, 0x80, 0x07, 0xa8, 0x70, 0xd7, 0xd3, 0x11, 0x1e, 0xc9, 0x88, 0xf9, 0xaa,
+	0x88, 0x8b, 0x33, 0xa4, 0x00, 0xa8, 0x3c, 0xd9, 0x25, 0x82, 0xf4, 0x31, 0x04, 0xea, 0x1f, 0x67,
+	0xe0, 0xda, 0x42, 0x1f, 0xd0, 0x62, 0x2d, 0x6c, 0xc1, 0x7f, 0x45, 0xdd, 0x40, 0xbb, 0x17, 0x5a,
+	0xca, 0x85, 0x81, 0x99, 0x87, 0x15, 0xac, 0xa4, 0x5e, 0x0c, 0xd6, 0x63, 0x9d, 0x89, 0x47, 0xe5,
+	0xf8, 0x13, 0x37, 0xec, 0x91, 0x3f, 0x0f, 0xc4, 0x23, 0x72, 0xfa, 0x6d, 0x7c, 0x04, 0x5b, 0x49,
+	0xd3, 0x1b, 0x22, 0x2e, 0xe7, 0x6b, 0x50, 0x46, 0xdb, 0xb5, 0x8c, 0xda, 0xc9, 0xcd, 0x86, 0xa9,
+	0x73, 0x22, 0x08, 0x8c, 0x47, 0x2a, 0x2f, 0x8c, 0x3f, 0x53, 0xe0, 0xd9, 0x29, 0xbf, 0x1d, 0xdf,
+	0xb3, 0x25, 0x0a, 0x4b, 0x53, 0x7a, 0x59, 0x98, 0x3a, 0x27, 0xb4, 0x0e, 0x4f, 0x44, 0x39, 0x75,
+	0xdb, 0x16, 0xbe, 0x0b, 0xab, 0xc2, 0x68, 0x5d, 0x83, 0x22, 0x3e, 0x13, 0x50, 0x0b, 0x98, 0x05,
+	0x5c, 0xed, 0x2d, 0xe1, 0xcc, 0x75, 0x9e, 0x9f, 0x03, 0x61, 0xe5, 0x67, 0x4c, 0x72, 0xc9, 0x67,
+	0x4c, 0x3e, 0x16, 0x6c, 0x90, 0xee, 0x7d, 0x5c, 0xb3, 0x06, 0x59, 0xb4, 0xde, 0x65, 0xc8, 0x7b,
+	0x0c, 0x7f, 0x22, 0x24, 0x74, 0xbe, 0x11, 0xfe, 0x64, 0xf8, 0xd3, 0xd8, 0x81, 0xb2, 0x99, 0xba,
+	0xe4, 0x56, 0x14, 0x7d, 0x51, 0x98, 0x8e, 0x34, 0x94, 0x0c, 0x90, 0x59, 0x4e, 0xd4, 0x45, 0xa1,
+	0x11, 0x0a, 0xc6, 0xf7, 0xd4, 0x0a, 0x46, 0x47, 0x56, 0xd0, 0x71, 0xa6, 0x87, 0xd1, 0x11, 0x0e,
+	0x39, 0xab, 0x71, 0xd5, 0x21, 0x04, 0x06, 0xc9, 0xe5, 0x80, 0xa3, 0xe8, 0x11, 0xb9, 0xfc, 0x40,
+	0xc2, 0xd4, 0x39, 0x11, 0xf9, 0x5f, 0x05, 0x8c, 0x1f, 0x22, 0xd1, 0x6c, 0x82, 0x2c, 0xf9, 0x9e,
+	0xcd, 0x68, 0x63, 0x4b, 0xf4, 0x57, 0x04, 0x45, 0x40, 0xdb, 0xad, 0x27, 0x66, 0x9e, 0x3b, 0x24,
+	0x06, 0xe1, 0x7b, 0x4d, 0x23, 0x5a, 0xd4, 0xa5, 0x46, 0x82, 0x82, 0x5b, 0x71, 0xf5, 0x65, 0x09,
+	0xeb, 0xce, 0x27, 0xc6, 0x1f, 0x64, 0xa1, 0x52, 0x67, 0xcf, 0x9e, 0xd9, 0x59, 0x6f, 0x16, 0xe9,
+	0xbf, 0x05, 0x97, 0x29, 0xda, 0x05, 0xc7, 0x9b, 0x25, 0x87, 0x1a, 0x72, 0xc9, 0x16, 0x83, 0x78,
+	0x47, 0x19, 0x44, 0x91, 0xe5, 0x6e, 0xff, 0xd8, 0x9d, 0xf1, 0x4b, 0x80, 0xb6, 0x7d, 0x4a, 0x6e,
+	0xf7, 0xec, 0x1b, 0xa0, 0x87, 0x4b, 0x08, 0x7a, 0x1b, 0x8f, 0xc5, 0xcf, 0x8e, 0x45, 0xb1, 0xc2,
+	0x6d, 0x02, 0x81, 0x7b, 0xc7, 0x4c, 0x73, 0x07, 0xb6, 0xf8, 0xf1, 0xcf, 0xf2, 0x01, 0xbc, 0xc9,
+	0x88, 0x64, 0x7d, 0xf7, 0x61, 0x4b, 0x04, 0xe7, 0xa0, 0x28, 0x8c, 0xc3, 0x91, 0x3f, 0x3b, 0x13,
+	0xa6, 0xc7, 0xb7, 0xcf, 0x69, 0x6a, 0x9b, 0x49, 0x11, 0xc4, 0xed, 0xdc, 0x0c, 0xd3, 0xd0, 0xeb,
+	0x2d, 0xb8, 0x7a, 0x4e, 0x9f, 0x5e, 0xe6, 0xde, 0x50, 0x54, 0xdc, 0x1b, 0xae, 0xef, 0xc0, 0xa5,
+	0x55, 0xf5, 0x7d, 0x97, 0x32, 0x8c, 0x3f, 0xaa, 0x02, 0x24, 0x2b, 0x36, 0x25, 0x8e, 0x66, 0x16,
+	0xc4, 0xd1, 0xef, 0xe4, 0xd4, 0xf3, 0x11, 0xfa, 0xe4, 0xcc, 0xce, 0x86, 0x49, 0x8e, 0xec, 0xca,
+	0x1c, 0x15, 0xa4, 0x1a, 0x24, 0xef, 0x1b, 0x97, 0x5d, 0x22, 0x72, 0x2b, 0x5d, 0x22, 0x3e, 0x84,
+	0x02, 0x1b, 0xda, 0x42, 0xf1, 0xa4, 0xf6, 0xea, 0xe2, 0xee, 0xbb, 0x2b, 0x5e, 0x15, 0x48, 0x3a,
+	0xbd, 0x85, 0x5a, 0xb4, 0x43, 0x3f, 0x70, 0xa3, 0xa3, 0x89, 0xfa, 0xc0, 0xf6, 0xe6, 0x72, 0x4e,
+	0x49, 0xc6, 0xb1, 0x1b, 0x2d, 0x35, 0xa9, 0x48, 0xaf, 0xd1, 0x44, 0x68, 0x7f, 0x49, 0x7a, 0x2d,
+	0xa8, 0xd2, 0xeb, 0x60, 0xc2, 0x3a, 0x5f, 0x94, 0x5e, 0xdf, 0x87, 0x8b, 0xe2, 0xa5, 0x13, 0x66,
+	0xc0, 0xe1, 0x24, 0x7a, 0xf6, 0xdf, 0xd4, 0x18, 0x35, 0x98, 0xd0, 0xdd, 0x0e, 0xc9, 0xbf, 0x82,
+	0x4b, 0xa3, 0x23, 0x7c, 0xce, 0x8c, 0x91, 0x3b, 0x87, 0x14, 0x1d, 0x7f, 0x88, 0x9e, 0x32, 0xa5,
+	0xa5, 0x45, 0xc7, 0x8d, 0x6d, 0x10, 0xf1, 0xe0, 0xc0, 0x23, 0x07, 0xb4, 0xd8, 0x71, 0x66, 0x6b,
+	0xb4, 0x08, 0x5f, 0xb0, 0x5f, 0xc3, 0x92, 0xfd, 0x7a, 0x51, 0xcc, 0x2e, 0xaf, 0x10, 0xb3, 0x13,
+	0x61, 0xb9, 0xa2, 0x0a, 0xcb, 0xfa, 0x7b, 0x50, 0x10, 0x0f, 0x35, 0x6b, 0x55, 0x55, 0xaf, 0xa9,
+	0xee, 0x0e, 0x53, 0x92, 0x60, 0x4d, 0xd2, 0x9b, 0x82, 0xde, 0xdd, 0x6f, 0x70, 0x4d, 0x2a, 0x4c,
+	0xdf, 0x11, 0x4a, 0xcf, 0xd8, 0x59, 0x4e, 0xe8, 0x78, 0xaf, 0x2b, 0x05, 0xc7, 0x38, 0x2e, 0xd8,
+	0x5c, 0xc8, 0x71, 0xfd, 0x1f, 0xae, 0xc3, 0xba, 0xf0, 0xd1, 0xc6, 0xf8, 0xa1, 0x81, 0x3f, 0x8b,
+	0x1d, 0x9d, 0x57, 0x48, 0xed, 0xf4, 0x41, 0x34, 0x14, 0xf0, 0xef, 0xc2, 0x3a, 0x3a, 0x82, 0x8c,
+	0x8f, 0xd3, 0xf6, 0xe8, 0x05, 0x01, 0x1a, 0xcd, 0x49, 0x16, 0xfe, 0xd0, 0x3f, 0x85, 0x12, 0xd2,
+	0x27, 0xee, 0xa7, 0xe5, 0xe5, 0x6b, 0x81, 0x14, 0x75, 0x51, 0x93, 0x69, 0x89, 0xdf, 0xfa, 0xaf,
+	0xa7, 0x35, 0xfb, 0xb9, 0xa5, 0x0e, 0x2e, 0xc8, 0x69, 0x0b, 0x3a, 0xfe, 0xdf, 0x00, 0x56, 0xf5,
+	0xc6, 0x27, 0x76, 0x5e, 0x35, 0x7d, 0x2e, 0x9d, 0xef, 0xa8, 0x57, 0xb6, 0x78, 0x3e, 0x28, 0x8d,
+	0xc1, 0x34, 0x39, 0x7f, 0xfc, 0xe1, 0x98, 0x15, 0x23, 0x83, 0x6c, 0x30, 0x56, 0xbd, 0x63, 0x82,
+	0xb2, 0xd9, 0xb6, 0xf4, 0x42, 0x2c, 0x2c, 0x65, 0x8b, 0x4f, 0x75, 0xca, 0x26, 0x13, 0xfa, 0x43,
+	0x28, 0xb3, 0x12, 0x96, 0xf3, 0x15, 0x97, 0x86, 0x36, 0x39, 0x94, 0xc9, 0xac, 0x17, 0xa7, 0xf4,
+	0x86, 0xec, 0x67, 0xe0, 0xa8, 0x96, 0x93, 0x1b, 0x2b, 0x07, 0xca, 0x8c, 0x8d, 0x28, 0xdc, 0x59,
+	0x93, 0xf3, 0xe8, 0x1d, 0xb8, 0x24, 0x4c, 0x0c, 0x7c, 0x00, 0xcb, 0x33, 0x13, 0x96, 0xe6, 0x2b,
+	0x75, 0x42, 0x3f, 0xb9, 0x60, 0xea, 0xd6, 0x12, 0x54, 0x6f, 0xc0, 0x96, 0x6c, 0x12, 0x9d, 0xac,
+	0x8a, 0xdb, 0x94, 0xda, 0xa5, 0xe4, 0xdc, 0x7d, 0x72, 0xc1, 0xdc, 0xb4, 0xd2, 0x20, 0xd4, 0x3e,
+	0xc9, 0x42, 0x48, 0xd5, 0x2e, 0x46, 0xa6, 0xb2, 0x34, 0x8b, 0xea, 0x59, 0xfd, 0xe4, 0x82, 0xb9,
+	0x65, 0x2d, 0x02, 0xf5, 0x5d, 0xd9, 0x1e, 0x55, 0x38, 0xe4, 0x9d, 0xf8, 0xda, 0xca, 0x61, 0x4a,
+	0x24, 0xd5, 0xb8, 0x65, 0x09, 0x28, 0xf1, 0x63, 0xb8, 0x6e, 0xc2, 0x95, 0xd5, 0x1c, 0x46, 0x3d,
+	0x66, 0x72, 0x7c, 0xcc, 0x18, 0xea, 0x31, 0xb3, 0x18, 0x47, 0x43, 0x39, 0x74, 0x7e, 0x84, 0x8a,
+	0x31, 0x95, 0xa7, 0x96, 0xa1, 0x20, 0xa3, 0x83, 0xd3, 0xbb, 0x8c, 0x46, 0x6f, 0x0f, 0x5d, 0x19,
+	0xca, 0x50, 0x68, 0x77, 0xfb, 0x03, 0xb4, 0xc4, 0xae, 0x71, 0x62, 0xaf, 0x53, 0x6f, 0xb4, 0xb4,
+	0xac, 0xf1, 0x27, 0x59, 0x28, 0xc5, 0x56, 0xb6, 0xef, 0xaf, 0x0d, 0x8b, 0xd5, 0x4c, 0x59, 0x55,
+	0xcd, 0xb4, 0x70, 0xd5, 0xe3, 0x40, 0xfe, 0xfc, 0x51, 0xa6, 0xcd, 0xf4, 0x85, 0x2a, 0x5c, 0x7e,
+	0xb2, 0x9d, 0xff, 0x96, 0x4f, 0xb6, 0x55, 0x0f, 0xf4, 0xf5, 0xb4, 0x07, 0xfa, 0x42, 0x84, 0xf8,
+	0x02, 0xc5, 0x6e, 0x56, 0x23, 0xc4, 0xd3, 0xa7, 0x2a, 0xd1, 0x4c, 0x23, 0x5c, 0xb6, 0x45, 0x2a,
+	0x7d, 0x42, 0xc3, 0x4b, 0x4e, 0xe8, 0x6f, 0xc3, 0xed, 0xef, 0xc3, 0xa5, 0xf1, 0x71, 0x1c, 0x31,
+	0x3a, 0x51, 0xae, 0x54, 0xa8, 0x49, 0x2b, 0x71, 0xfa, 0xdb, 0xf1, 0xb7, 0xb5, 0xaa, 0xaa, 0x1a,
+	0x28, 0x9e, 0xad, 0xf8, 0x63, 0x5b, 0xff, 0x57, 0x06, 0x20, 0xb1, 0x3f, 0xfd, 0xd2, 0xaa, 0x5c,
+	0x45, 0x5b, 0x96, 0x7d, 0x81, 0xb6, 0xec, 0x65, 0x11, 0xc4, 0xbe, 0x81, 0x52, 0x6c, 0x71, 0xfc,
+	0xfe, 0x0b, 0xeb, 0x3b, 0x55, 0xf9, 0xdb, 0x52, 0xad, 0x1d, 0x9b, 0xec, 0x7e, 0xd9, 0xb1, 0x48,
+	0x55, 0x9f, 0x7d, 0x49, 0xf5, 0xa7, 0xac, 0x5b, 0x8e, 0x2b, 0xff, 0x15, 0xef, 0x26, 0x75, 0xa1,
+	0xe7, 0x52, 0x0b, 0xdd, 0x98, 0x0b, 0x05, 0xf9, 0x2f, 0x5f, 0xf5, 0x77, 0xea, 0xf0, 0x7f, 0xcc,
+	0x48, 0x2d, 0x6e, 0x1c, 0xe4, 0xfb, 0x5c, 0xa1, 0x77, 0xb5, 0x22, 0xfa, 0xbb, 0x54, 0xf7, 0x42,
+	0x1d, 0x55, 0xee, 0x45, 0x3a, 0xaa, 0xb7, 0x21, 0xcf, 0xc7, 0x5d, 0xfe, 0x3c, 0xfd, 0x14, 0xe3,
+	0x5f, 0xfa, 0x29, 0x0e, 0xc3, 0x10, 0x42, 0x3e, 0xf7, 0xf7, 0x92, 0x2c, 0x57, 0x7e, 0x46, 0x04,
+	0x13, 0xc6, 0xff, 0xc2, 0x1c, 0xf5, 0xfb, 0x0e, 0xc9, 0x8b, 0xd5, 0x16, 0xc6, 0x7f, 0xcf, 0x40,
+	0x35, 0xe5, 0x41, 0xf0, 0x3d, 0xaa, 0x58, 0xc9, 0x97, 0xb3, 0xff, 0x13, 0xf1, 0xe5, 0x94, 0x0b,
+	0x6f, 0x31, 0xed, 0xc2, 0x8b, 0xec, 0xae, 0xa2, 0xd6, 0xbb, 0xf2, 0xb2, 0x93, 0x59, 0x79, 0xd9,
+	0xb9, 0x19, 0x7f, 0x24, 0xb1, 0xdd, 0x64, 0x8f, 0xd9, 0xaa, 0xa9, 0x40, 0xd0, 0x01, 0x58, 0x28,
+	0x11, 0x78, 0x7c, 0xfc, 0xf1, 0x50, 0x62, 0x6d, 0x71, 0x29, 0xbf, 0xc2, 0x04, 0xfc, 0x21, 0x95,
+	0x71, 0x5d, 0x62, 0x8d, 0x36, 0x54, 0x53, 0xae, 0x19, 0xca, 0x27, 0x5b, 0x33, 0xea, 0x27, 0x5b,
+	0xd1, 0x35, 0xf7, 0xe4, 0xc8, 0x09, 0x9c, 0x15, 0x01, 0x7c, 0x19, 0x81, 0x9f, 0x08, 0x53, 0xdd,
+	0xc4, 0xf4, 0xf7, 0x20, 0xef, 0x46, 0xce, 0x44, 0xaa, 0x47, 0xae, 0x2c, 0x7b, 0x92, 0xb5, 0x23,
+	0x67, 0x62, 0x32, 0x11, 0xba, 0x64, 0x69, 0x8b, 0x38, 0xe5, 0xbb, 0xb2, 0x99, 0x73, 0xbe, 0x2b,
+	0xbb, 0x96, 0x6a, 0xe4, 0xaa, 0x4f, 0xc3, 0x6e, 0x4b, 0xa1, 0x64, 0xc5, 0x67, 0x49, 0x09, 0x81,
+	0xd1, 0x24, 0x02, 0x87, 0x3e, 0xda, 0x69, 0xaf, 0x78, 0x39, 0x12, 0xe3, 0x8c, 0xdf, 0xcb, 0x40,
+	0x41, 0xf8, 0xb4, 0xad, 0xd4, 0x57, 0xbd, 0x03, 0x05, 0xfe, 0x80, 0xa7, 0x0c, 0x24, 0xb6, 0xe4,
+	0x66, 0x2e, 0xf1, 0xf8, 0x30, 0x03, 0x51, 0x69, 0xfd, 0x15, 0x7a, 0x3a, 0x9a, 0x04, 0x17, 0xdf,
+	0x47, 0xb2, 0x26, 0xe2, 0x35, 0x3b, 0x47, 0x95, 0x02, 0x02, 0xd1, 0xc3, 0x75, 0xe3, 0xd7, 0xa1,
+	0x20, 0x7c, 0xe6, 0x56, 0x36, 0xe5, 0x65, 0x1f, 0x6f, 0xdc, 0x06, 0x48, 0x9c, 0xe8, 0x56, 0x95,
+	0x80, 0x1f, 0xa3, 0x95, 0x7e, 0x73, 0xb8, 0xfe, 0x92, 0xaa, 0xc5, 0x93, 0x24, 0xb5, 0x31, 0x9e,
+	0x08, 0x8a, 0x8f, 0xee, 0x33, 0xa4, 0x2c, 0xbf, 0x07, 0xf4, 0x3e, 0x6b, 0xb0, 0x14, 0x7e, 0x2b,
+	0xfd, 0x55, 0x84, 0x98, 0x08, 0x1d, 0x34, 0x24, 0xbf, 0x7c, 0x99, 0x6a, 0xc1, 0xa8, 0xcb, 0x97,
+	0x7c, 0xb4, 0xca, 0x1e, 0x08, 0x6d, 0x6a, 0x87, 0x42, 0x35, 0x66, 0xd4, 0xe0, 0xb5, 0xa9, 0x36,
+	0x99, 0x0a, 0x99, 0xb1, 0x01, 0x15, 0xd5, 0xd9, 0xc7, 0xa8, 0xc3, 0x16, 0x7e, 0xa1, 0x14, 0xf9,
+	0x8f, 0x0c, 0x4a, 0xc4, 0xeb, 0x17, 0x7f, 0xa4, 0xd7, 0xef, 0x22, 0x9d, 0xc9, 0x44, 0xc6, 0x9f,
+	0xe4, 0x40, 0x5b, 0xc4, 0xbd, 0xe8, 0x55, 0x23, 0x46, 0xd3, 0xa3, 0x75, 0x91, 0xfa, 0x02, 0x16,
+	0x83, 0x94, 0xf7, 0x00, 0xa9, 0xcf, 0xa0, 0x14, 0xdd, 0xf0, 0x09, 0xa5, 0xf5, 0xab, 0xfc, 0x84,
+	0xcd, 0xf3, 0xf9, 0xbb, 0x5c, 0x15, 0x7a, 0xb1, 0xd6, 0xf1, 0x47, 0x98, 0x4b, 0x6a, 0x27, 0xd8,
+	0x03, 0xb5, 0x62, 0x16, 0x19, 0x30, 0x20, 0xfb, 0x9d, 0x78, 0x25, 0x10, 0x85, 0xe2, 0xf9, 0x69,
+	0x91, 0x01, 0x83, 0x50, 0x86, 0x5e, 0x1f, 0x89, 0xcf, 0x35, 0x65, 0x29, 0xf4, 0x3a, 0xc6, 0x8f,
+	0x47, 0x05, 0x20, 0xbe, 0x11, 0x18, 0x89, 0x2f, 0xdc, 0x89, 0xe0, 0xf7, 0x88, 0x7a, 0x83, 0x3f,
+	0x68, 0x15, 0x38, 0x61, 0xc8, 0x11, 0xfb, 0x4a, 0x22, 0x54, 0xa3, 0x00, 0xc6, 0x01, 0x51, 0xc5,
+	0x67, 0xc8, 0x90, 0x04, 0x44, 0xdc, 0x40, 0x02, 0x11, 0xc1, 0x35, 0x28, 0xfe, 0x0c, 0x1d, 0x6e,
+	0x50, 0xcb, 0x51, 0xa6, 0x56, 0x15, 0x30, 0x8d, 0x2a, 0x8b, 0x4b, 0x90, 0xe7, 0x0f, 0xba, 0xf1,
+	0x2b, 0x41, 0x4e, 0x18, 0xff, 0x22, 0x03, 0x97, 0x16, 0xc7, 0x9a, 0x96, 0x51, 0x05, 0x8a, 0x8d,
+	0x5e, 0x67, 0x88, 0xae, 0x0e, 0xda, 0x05, 0xb4, 0x82, 0xf5, 0x76, 0x30, 0x5c, 0x01, 0x03, 0x32,
+	0x14, 0x3e, 0xa0, 0x3f, 0x7c, 0xd2, 0x6e, 0x36, 0x5b, 0x5d, 0xbe, 0x51, 0xf4, 0x76, 0xbe, 0x18,
+	0x76, 0x7a, 0x0d, 0xfe, 0x26, 0x91, 0xf4, 0x78, 0xe8, 0x6b, 0x39, 0x4c, 0xb2, 0x33, 0x3c, 0x26,
+	0xf3, 0xec, 0xe5, 0xfd, 0xac, 0x3f, 0x6c, 0x74, 0x07, 0xda, 0x3a, 0xa6, 0xf0, 0x95, 0xf8, 0xb0,
+	0x21, 0xdd, 0x39, 0x1b, 0xbd, 0xdd, 0x3d, 0xb3, 0xd5, 0xef, 0x0f, 0xfb, 0xed, 0x9f, 0xb6, 0xb4,
+	0x22, 0xd5, 0x6c, 0xb6, 0x1f, 0xb7, 0xbb, 0x0c, 0x28, 0xa1, 0xa9, 0x6e, 0xb7, 0xdd, 0xe5, 0xb0,
+	0x09, 0xbb, 0xf5, 0xaf, 0xb4, 0x32, 0xfe, 0xe8, 0xef, 0xef, 0x6a, 0x15, 0x34, 0xe8, 0x75, 0x5a,
+	0x4f, 0x5b, 0x1d, 0xad, 0x6a, 0xfc, 0xdb, 0xac, 0x94, 0x88, 0xc9, 0xcf, 0xe9, 0xdb, 0x48, 0x81,
+	0xab, 0xec, 0x8b, 0xf1, 0xa0, 0x65, 0x95, 0x41, 0xfb, 0x36, 0x5f, 0xcc, 0x7d, 0x03, 0xaa, 0xb1,
+	0x73, 0x80, 0x12, 0x2e, 0xbd, 0x22, 0x81, 0x2b, 0xcc, 0x17, 0xeb, 0x2b, 0xcc, 0x17, 0xe8, 0xc6,
+	0x35, 0x64, 0x9e, 0xcb, 0x2b, 0xa9, 0x84, 0x10, 0xfe, 0x56, 0x35, 0x3e, 0x60, 0x44, 0xf4, 0x7c,
+	0xea, 0xca, 0xef, 0x25, 0x16, 0x11, 0xb0, 0x3f, 0x75, 0xa3, 0x45, 0xe7, 0x84, 0xd2, 0x92, 0x73,
+	0x82, 0x7a, 0x38, 0x43, 0xfa, 0x70, 0x4e, 0x7f, 0x48, 0x98, 0x3f, 0x94, 0xa8, 0x7c, 0x48, 0x18,
+	0x3f, 0x4f, 0x37, 0x0f, 0x28, 0x20, 0x9a, 0x42, 0x56, 0x21, 0x32, 0x4d, 0x60, 0xe2, 0x53, 0x51,
+	0x7f, 0x1b, 0x36, 0x17, 0xa8, 0xe9, 0x2e, 0x5d, 0x32, 0x37, 0xd2, 0xa4, 0xfa, 0x5d, 0xb8, 0x28,
+	0xd6, 0x76, 0x6a, 0x6c, 0xc5, 0xd3, 0x53, 0x46, 0xd5, 0x93, 0x11, 0x36, 0x7e, 0x0d, 0x8a, 0xd2,
+	0xa5, 0xed, 0xc5, 0xc2, 0xee, 0x8a, 0x79, 0x35, 0xfe, 0xde, 0x1a, 0x94, 0x62, 0x07, 0xb7, 0x6f,
+	0xb5, 0x3a, 0xe8, 0xbb, 0x10, 0xe1, 0xb1, 0xca, 0x62, 0x8a, 0x08, 0x90, 0x33, 0x25, 0x5e, 0x6b,
+	0xcd, 0x03, 0x57, 0x4a, 0x6c, 0x0c, 0xd9, 0x0f, 0x5c, 0xcc, 0x8b, 0x41, 0x9f, 0x92, 0x47, 0xa2,
+	0x25, 0xb3, 0x88, 0x00, 0xda, 0x68, 0xf8, 0x6d, 0x65, 0x44, 0x62, 0x4e, 0xf9, 0x6d, 0x65, 0x77,
+	0x7a, 0x8c, 0xf9, 0xce, 0xf9, 0xb6, 0x32, 0xc2, 0x85, 0x77, 0x0d, 0xfb, 0x14, 0x88, 0x14, 0xd6,
+	0x33, 0x8f, 0x3f, 0x04, 0x28, 0x56, 0xc4, 0x5c, 0x7e, 0x06, 0x30, 0x3d, 0xab, 0xa5, 0xc5, 0x59,
+	0x5d, 0x5c, 0xd3, 0xb0, 0xb4, 0xa6, 0x8d, 0x08, 0x0a, 0xc2, 0xc9, 0xef, 0xc5, 0x03, 0xfe, 0xc2,
+	0xa1, 0xd2, 0xf0, 0x8b, 0xa3, 0xf2, 0x65, 0x2a, 0xfe, 0x5c, 0x68, 0x58, 0x6e, 0xa1, 0x61, 0xc6,
+	0xdf, 0x58, 0x03, 0x48, 0x9c, 0x05, 0x31, 0x0a, 0x64, 0xca, 0x31, 0x39, 0xb3, 0x74, 0xec, 0x2f,
+	0xf8, 0x23, 0x2f, 0x84, 0x01, 0x5a, 0xfb, 0x16, 0x61, 0x80, 0xee, 0x43, 0x35, 0x0c, 0x46, 0x2f,
+	0x55, 0xb8, 0x97, 0xc3, 0x60, 0x24, 0x13, 0xfa, 0x3d, 0xc0, 0x24, 0x85, 0x08, 0x4c, 0x2e, 0xaa,
+	0x4b, 0x52, 0x4b, 0x29, 0x0c, 0x46, 0xbd, 0x83, 0xaf, 0x9b, 0xfc, 0x44, 0xce, 0x0e, 0xa3, 0xe1,
+	0x2a, 0x2e, 0xb1, 0x69, 0x87, 0x51, 0x53, 0x65, 0x14, 0x18, 0xbb, 0x27, 0x8c, 0x96, 0x3f, 0x4d,
+	0x5d, 0xb1, 0xc3, 0xc4, 0xc0, 0x62, 0xfc, 0xae, 0x34, 0x49, 0x2f, 0xe8, 0x72, 0xf1, 0x65, 0x19,
+	0xc1, 0x15, 0x21, 0xa2, 0xb6, 0x4a, 0xf5, 0xcb, 0x41, 0x8b, 0x62, 0xd2, 0xe5, 0xcf, 0xbf, 0xad,
+	0x7d, 0xdb, 0xcf, 0xbf, 0x6d, 0x03, 0x24, 0x41, 0x1a, 0x71, 0x07, 0xc6, 0x8f, 0x75, 0x4a, 0xfc,
+	0x0c, 0xe7, 0xce, 0xbb, 0x50, 0x51, 0x3f, 0x34, 0x4b, 0x8f, 0x70, 0xfc, 0xa9, 0xc3, 0x9f, 0xd8,
+	0xe8, 0xfc, 0xec, 0x23, 0x8e, 0x25, 0xf2, 0xd3, 0x30, 0xb2, 0xb5, 0xb5, 0x3b, 0x06, 0x94, 0x95,
+	0x4f, 0xdd, 0x20, 0x02, 0x3f, 0x8c, 0x22, 0x3e, 0xbc, 0x80, 0x1a, 0x35, 0x2d, 0x73, 0xe7, 0x2d,
+	0xa8, 0x0a, 0x1a, 0xf1, 0xa1, 0x19, 0xfc, 0x9e, 0xbd, 0x1f, 0x4c, 0x2c, 0x4f, 0xd0, 0x39, 0xf3,
+	0x10, 0xe9, 0xee, 0xc1, 0xe5, 0x95, 0x9f, 0xcd, 0x41, 0xfa, 0xbe, 0x8b, 0x6f, 0x66, 0xf8, 0x59,
+	0xd2, 0x93, 0xb3, 0x83, 0xc0, 0xb5, 0xb5, 0xcc, 0x9d, 0x87, 0x0b, 0x9f, 0x55, 0xd8, 0xef, 0xee,
+	0xf4, 0xf6, 0xbb, 0xcd, 0x56, 0x93, 0x1f, 0x0c, 0xb5, 0xbb, 0x8d, 0xce, 0x3e, 0x86, 0x98, 0xe1,
+	0x53, 0xb1, 0xf5, 0x95, 0x4c, 0xae, 0xdd, 0x79, 0x28, 0xe3, 0x32, 0xc8, 0x56, 0x77, 0x7a, 0xf5,
+	0x26, 0x9f, 0xa6, 0x71, 0x50, 0xa0, 0xc1, 0x0e, 0x7f, 0x8e, 0xc1, 0x6c, 0xf5, 0xf7, 0x3b, 0x03,
+	0x11, 0x80, 0xe8, 0xce, 0x8f, 0xa0, 0x76, 0xde, 0xcb, 0x1e, 0xec, 0x4b, 0xe3, 0x49, 0x9d, 0x5e,
+	0x4f, 0xe1, 0xe9, 0xd9, 0x1b, 0x72, 0x8a, 0x74, 0x7c, 0x66, 0xab, 0xd3, 0x22, 0xf7, 0xd7, 0x3b,
+	0x3f, 0xcf, 0x28, 0x92, 0xa4, 0x7c, 0x9d, 0x11, 0x03, 0xc4, 0x50, 0xab, 0x20, 0xd3, 0xb1, 0x6c,
+	0x2d, 0xa3, 0x5f, 0x01, 0x3d, 0x05, 0xea, 0xf8, 0x23, 0xcb, 0xd3, 0xd6, 0xc8, 0xd1, 0x55, 0xc2,
+	0xe9, 0x15, 0x9f, 0x96, 0xd5, 0x5f, 0x85, 0x6b, 0x31, 0xac, 0xe3, 0x9f, 0xec, 0x05, 0x2e, 0x2a,
+	0x22, 0xcf, 0x18, 0x9d, 0xbb, 0xf3, 0xbf, 0x0a, 0x33, 0x6d, 0x6a, 0x7d, 0x61, 0x05, 0x75, 0xdb,
+	0x4e, 0x60, 0xc4, 0xd2, 0xb4, 0x0b, 0xe8, 0x05, 0x4b, 0xfc, 0x7c, 0x01, 0x91, 0x41, 0x07, 0x4a,
+	0x79, 0xdd, 0x5d, 0x44, 0xae, 0x21, 0xd2, 0x74, 0xc8, 0x49, 0x72, 0x09, 0x99, 0xdd, 0xf9, 0xe1,
+	0x9f, 0xfe, 0xe2, 0x66, 0xe6, 0xcf, 0x7f, 0x71, 0x33, 0xf3, 0xef, 0x7e, 0x71, 0xf3, 0xc2, 0x1f,
+	0xfe, 0xfb, 0x9b, 0x99, 0x9f, 0xbe, 0x7f, 0xe8, 0x46, 0x47, 0xf3, 0x83, 0xbb, 0x23, 0x7f, 0x72,
+	0x6f, 0x62, 0x45, 0x81, 0x7b, 0xca, 0x07, 0x8b, 0x4c, 0x4c, 0x9d, 0x7b, 0xb3, 0xe3, 0xc3, 0x7b,
+	0xb3, 0x83, 0x7b, 0xb8, 0xc4, 0x0f, 0xd6, 0x67, 0x81, 0x1f, 0xf9, 0x0f, 0xfe, 0xc7, 0x00, 0x40,
+	0xf5, 0x7b, 0xf8, 0x36, 0x88, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -16354,6 +16370,13 @@ func (m *ColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AlgLevel != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.AlgLevel))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.GeneratedCol != nil {
 		{
 			size, err := m.GeneratedCol.MarshalToSizedBuffer(dAtA[:i])
@@ -16661,6 +16684,16 @@ func (m *GeneratedCol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IsAsync {
+		i--
+		if m.IsAsync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.IsStored {
 		i--
 		if m.IsStored {
@@ -27249,6 +27282,9 @@ func (m *ColDef) ProtoSize() (n int) {
 		l = m.GeneratedCol.ProtoSize()
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if m.AlgLevel != 0 {
+		n += 2 + sovPlan(uint64(m.AlgLevel))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -27315,6 +27351,9 @@ func (m *GeneratedCol) ProtoSize() (n int) {
 	if m.IsStored {
 		n += 2
 	}
+	if m.IsAsync {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -36106,6 +36145,25 @@ func (m *ColDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlgLevel", wireType)
+			}
+			m.AlgLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AlgLevel |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -36503,6 +36561,26 @@ func (m *GeneratedCol) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsStored = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAsync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAsync = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -1330,6 +1330,26 @@ func compareTableDefsAndGenerateAlterStatements(
 	return alterStatements, true, nil
 }
 
+// GenerateInplaceAlterStatements returns the ALTER TABLE statements of `dbName`.`tableName`
+// replaying the changes between the two CREATE TABLE statements, it fails if a change can
+// not be done inplace
+func GenerateInplaceAlterStatements(
+	ctx context.Context,
+	dbName string,
+	tableName string,
+	oldCreateSQL string,
+	newCreateSQL string,
+) ([]string, error) {
+	stmts, canInplace, err := compareTableDefsAndGenerateAlterStatements(ctx, dbName, tableName, oldCreateSQL, newCreateSQL)
+	if err != nil {
+		return nil, err
+	}
+	if !canInplace {
+		return nil, moerr.NewNotSupportedf(ctx, "the changes of %s.%s can not be done inplace", dbName, tableName)
+	}
+	return stmts, nil
+}
+
 // columnInfo stores column information for comparison
 type columnInfo struct {
 	name       string
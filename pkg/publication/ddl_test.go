@@ -514,6 +514,10 @@ func Test_compareTableDefsAndGenerateAlterStatements_GoodPath(t *testing.T) {
 		_, canInplace, err := compareTableDefsAndGenerateAlterStatements(ctx, "testdb", "test", oldSQL, newSQL)
 		assert.Error(t, err)
 		assert.False(t, canInplace)
+
+		stmts, err := GenerateInplaceAlterStatements(ctx, "testdb", "test", oldSQL, newSQL)
+		assert.Error(t, err)
+		assert.Empty(t, stmts)
 	})
 }
 
@@ -30,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/objectio/ioutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/sort"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 
@@ -158,8 +159,8 @@ func filterAppendableObject(
 		return nil, ErrSyncProtectionTTLExpired
 	}
 
-	// Extract sortkey from original object metadata
-	sortKeySeqnum, err := extractSortKeyFromObject(ctx, objectContent, stats)
+	// Extract sortkey and column codecs from original object metadata
+	sortKeySeqnum, codecs, err := extractSortKeyFromObject(ctx, objectContent, stats)
 	if err != nil {
 		return nil, moerr.NewInternalErrorf(ctx, "failed to extract sortkey from object: %v", err)
 	}
@@ -188,7 +189,7 @@ func filterAppendableObject(
 	// Sort batch by primary key, remove commit TS column, write to file, and record ObjectStats
 	// This is data object (not tombstone), so use SchemaData
 	// Use new object name for appendable objects (keepOriginalName=false)
-	objStats, rowOffsetMap, err := createObjectFromBatch(ctx, filteredBat, stats, snapshotTS, isTombstone, localFS, mp, sortKeySeqnum, false)
+	objStats, rowOffsetMap, err := createObjectFromBatch(ctx, filteredBat, stats, snapshotTS, isTombstone, localFS, mp, sortKeySeqnum, codecs, false)
 	if err != nil {
 		return nil, moerr.NewInternalErrorf(ctx, "failed to create object from batch: %v", err)
 	}
@@ -556,29 +557,32 @@ func extractSortKeyFromObject(
 	ctx context.Context,
 	objectContent []byte,
 	stats *objectio.ObjectStats,
-) (uint16, error) {
+) (uint16, []api.ColumnCompress, error) {
 	// Read object meta from objectContent bytes
 	metaExtent := stats.Extent()
 	if int(metaExtent.Offset()+metaExtent.Length()) > len(objectContent) {
-		return 0, moerr.NewInternalErrorf(ctx, "object content too small for meta extent")
+		return 0, nil, moerr.NewInternalErrorf(ctx, "object content too small for meta extent")
+	}
+	metaBytes, metaAlg, err := objectio.DecryptExtentContent(ctx, objectContent, metaExtent)
+	if err != nil {
+		return 0, nil, err
 	}
-	metaBytes := objectContent[metaExtent.Offset() : metaExtent.Offset()+metaExtent.Length()]
 
 	// Check if meta needs decompression
 	var decompressedMetaBytes []byte
 	var decompressedBuf fscache.Data
-	if metaExtent.Alg() == compress.None {
+	if metaAlg == compress.None {
 		decompressedMetaBytes = metaBytes
 	} else {
 		// Allocate buffer for decompressed data
 		allocator := fileservice.DefaultCacheDataAllocator()
 		decompressedBuf = allocator.AllocateCacheDataWithHint(ctx, int(metaExtent.OriginSize()), malloc.NoClear)
-		bs, err := compress.Decompress(metaBytes, decompressedBuf.Bytes(), compress.Lz4)
+		bs, err := compress.Decompress(metaBytes, decompressedBuf.Bytes(), int(metaAlg))
 		if err != nil {
 			if decompressedBuf != nil {
 				decompressedBuf.Release()
 			}
-			return 0, moerr.NewInternalErrorf(ctx, "failed to decompress meta data: %v", err)
+			return 0, nil, moerr.NewInternalErrorf(ctx, "failed to decompress meta data: %v", err)
 		}
 		decompressedMetaBytes = decompressedBuf.Bytes()[:len(bs)]
 		// Clone the data to ensure meta doesn't hold reference to buffer
@@ -593,7 +597,22 @@ func extractSortKeyFromObject(
 
 	// Get sortkey seqnum from block header
 	sortKeySeqnum := dataMeta.BlockHeader().SortKey()
-	return sortKeySeqnum, nil
+
+	// Keep the zstd columns of the original object, the level is not recorded
+	// in the object so the default one is used
+	var codecs []api.ColumnCompress
+	if dataMeta.BlockCount() > 0 {
+		maxSeqnum := dataMeta.BlockHeader().MaxSeqnum()
+		for seqnum := uint16(0); seqnum <= maxSeqnum; seqnum++ {
+			if dataMeta.GetColumnMeta(0, seqnum).Location().Alg() == compress.Zstd {
+				codecs = append(codecs, api.ColumnCompress{
+					Seqnum: uint32(seqnum),
+					Alg:    compress.Zstd,
+				})
+			}
+		}
+	}
+	return sortKeySeqnum, codecs, nil
 }
 
 // rewriteNonAppendableTombstoneWithSinker reads tombstone blocks one by one,
@@ -612,17 +631,20 @@ func rewriteNonAppendableTombstoneWithSinker(
 	if int(metaExtent.Offset()+metaExtent.Length()) > len(objectContent) {
 		return objectio.ObjectStats{}, moerr.NewInternalErrorf(ctx, "object content too small for meta extent")
 	}
-	metaBytes := objectContent[metaExtent.Offset() : metaExtent.Offset()+metaExtent.Length()]
+	metaBytes, metaAlg, err := objectio.DecryptExtentContent(ctx, objectContent, metaExtent)
+	if err != nil {
+		return objectio.ObjectStats{}, err
+	}
 
 	// Decompress meta if needed
 	var decompressedMetaBytes []byte
 	var decompressedMetaBuf fscache.Data
-	if metaExtent.Alg() == compress.None {
+	if metaAlg == compress.None {
 		decompressedMetaBytes = metaBytes
 	} else {
 		allocator := fileservice.DefaultCacheDataAllocator()
 		decompressedMetaBuf = allocator.AllocateCacheDataWithHint(ctx, int(metaExtent.OriginSize()), malloc.NoClear)
-		bs, err := compress.Decompress(metaBytes, decompressedMetaBuf.Bytes(), compress.Lz4)
+		bs, err := compress.Decompress(metaBytes, decompressedMetaBuf.Bytes(), int(metaAlg))
 		if err != nil {
 			if decompressedMetaBuf != nil {
 				decompressedMetaBuf.Release()
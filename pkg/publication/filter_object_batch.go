@@ -31,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/fileservice/fscache"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/objectio/ioutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/sort"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 )
@@ -83,17 +84,23 @@ func readSingleBlockToBatch(
 			}
 			return nil, moerr.NewInternalErrorf(ctx, "object content too small for column extent at seqnum %d, block %d", seqnum, blkIdx)
 		}
-		colData := objectContent[ext.Offset() : ext.Offset()+ext.Length()]
+		colData, alg, err := objectio.DecryptExtentContent(ctx, objectContent, ext)
+		if err != nil {
+			for k := 0; k <= i; k++ {
+				vecs[k].Free(mp)
+			}
+			return nil, err
+		}
 
 		// Decompress if needed
 		var decompressedData []byte
 		var decompressedBuf fscache.Data
 
-		if ext.Alg() == compress.None {
+		if alg == compress.None {
 			decompressedData = append([]byte(nil), colData...)
 		} else {
 			decompressedBuf = allocator.AllocateCacheDataWithHint(ctx, int(ext.OriginSize()), malloc.NoClear)
-			bs, err := compress.Decompress(colData, decompressedBuf.Bytes(), compress.Lz4)
+			bs, err := compress.Decompress(colData, decompressedBuf.Bytes(), int(alg))
 			if err != nil {
 				if decompressedBuf != nil {
 					decompressedBuf.Release()
@@ -216,18 +223,21 @@ func convertObjectToBatch(
 	if int(metaExtent.Offset()+metaExtent.Length()) > len(objectContent) {
 		return nil, moerr.NewInternalErrorf(ctx, "object content too small for meta extent")
 	}
-	metaBytes := objectContent[metaExtent.Offset() : metaExtent.Offset()+metaExtent.Length()]
+	metaBytes, metaAlg, err := objectio.DecryptExtentContent(ctx, objectContent, metaExtent)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check if meta needs decompression (same as ReadExtent does)
 	var decompressedMetaBytes []byte
 	var decompressedMetaBuf fscache.Data
-	if metaExtent.Alg() == compress.None {
+	if metaAlg == compress.None {
 		decompressedMetaBytes = metaBytes
 	} else {
 		// Allocate buffer for decompressed data
 		allocator := fileservice.DefaultCacheDataAllocator()
 		decompressedMetaBuf = allocator.AllocateCacheDataWithHint(ctx, int(metaExtent.OriginSize()), malloc.NoClear)
-		bs, err := compress.Decompress(metaBytes, decompressedMetaBuf.Bytes(), compress.Lz4)
+		bs, err := compress.Decompress(metaBytes, decompressedMetaBuf.Bytes(), int(metaAlg))
 		if err != nil {
 			if decompressedMetaBuf != nil {
 				decompressedMetaBuf.Release()
@@ -317,19 +327,22 @@ func convertObjectToBatch(
 			if int(ext.Offset()+ext.Length()) > len(objectContent) {
 				return nil, moerr.NewInternalErrorf(ctx, "object content too small for column extent at seqnum %d, block %d", seqnum, blkIdx)
 			}
-			colData := objectContent[ext.Offset() : ext.Offset()+ext.Length()]
+			colData, alg, err := objectio.DecryptExtentContent(ctx, objectContent, ext)
+			if err != nil {
+				return nil, err
+			}
 
 			// Decompress if needed
 			var decompressedData []byte
 			var decompressedBuf fscache.Data
 
-			if ext.Alg() == compress.None { // Clone non-compressed data to avoid buffer sharing with objectContent
+			if alg == compress.None { // Clone non-compressed data to avoid buffer sharing with objectContent
 				// objectContent may be reused/pooled, and UnmarshalBinary doesn't copy data
 				decompressedData = append([]byte(nil), colData...)
 			} else {
 				// Allocate buffer for decompressed data
 				decompressedBuf = allocator.AllocateCacheDataWithHint(ctx, int(ext.OriginSize()), malloc.NoClear)
-				bs, err := compress.Decompress(colData, decompressedBuf.Bytes(), compress.Lz4)
+				bs, err := compress.Decompress(colData, decompressedBuf.Bytes(), int(alg))
 				if err != nil {
 					if decompressedBuf != nil {
 						decompressedBuf.Release()
@@ -436,6 +449,7 @@ func createObjectFromBatch(
 	localFS fileservice.FileService,
 	mp *mpool.MPool,
 	sortKeySeqnum uint16,
+	codecs []api.ColumnCompress,
 	keepOriginalName bool,
 ) (objectio.ObjectStats, map[uint32]uint32, error) {
 	if bat == nil || bat.Length() == 0 {
@@ -556,6 +570,7 @@ func createObjectFromBatch(
 			false,      // isTombstone
 			localFS,
 		)
+		writer.SetColumnCompresses(codecs)
 	}
 
 	// Write batch to appropriate schema
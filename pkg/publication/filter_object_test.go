@@ -348,7 +348,7 @@ func TestExtractSortKeyFromObject_ContentTooSmall(t *testing.T) {
 	ext := objectio.NewExtent(0, 100, 50, 50)
 	require.NoError(t, objectio.SetObjectStatsExtent(&stats, ext))
 
-	_, err := extractSortKeyFromObject(context.Background(), []byte("tiny"), &stats)
+	_, _, err := extractSortKeyFromObject(context.Background(), []byte("tiny"), &stats)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "object content too small")
 }
@@ -160,7 +160,7 @@ func TestFilterBatchBySnapshotTS_NilBatchCov(t *testing.T) {
 
 func TestCreateObjectFromBatch_NilBatchCov(t *testing.T) {
 	var stats objectio.ObjectStats
-	result, _, err := createObjectFromBatch(context.Background(), nil, &stats, types.TS{}, false, nil, nil, 0, false)
+	result, _, err := createObjectFromBatch(context.Background(), nil, &stats, types.TS{}, false, nil, nil, 0, nil, false)
 	assert.NoError(t, err)
 	assert.True(t, result.IsZero())
 }
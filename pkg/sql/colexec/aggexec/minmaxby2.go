@@ -0,0 +1,213 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// OrderedAggSupportedTypes are the types which min_by / max_by can order by
+// and mode can return.
+var OrderedAggSupportedTypes = []types.T{
+	types.T_bool, types.T_bit,
+	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+	types.T_date, types.T_datetime, types.T_timestamp, types.T_time, types.T_year,
+	types.T_uuid, types.T_enum,
+	types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob,
+}
+
+func compareRaw[T types.FixedSizeTExceptStrType](compare func(a, b T) int) func(a, b []byte) int {
+	return func(a, b []byte) int {
+		return compare(types.DecodeFixed[T](a), types.DecodeFixed[T](b))
+	}
+}
+
+// rawCompare returns the function comparing the raw bytes of two values of typ.
+func rawCompare(typ types.Type) (func(a, b []byte) int, error) {
+	switch typ.Oid {
+	case types.T_bool:
+		return compareRaw(types.BoolAscCompare), nil
+	case types.T_bit, types.T_uint64:
+		return compareRaw(types.GenericAscCompare[uint64]), nil
+	case types.T_int8:
+		return compareRaw(types.GenericAscCompare[int8]), nil
+	case types.T_int16:
+		return compareRaw(types.GenericAscCompare[int16]), nil
+	case types.T_int32:
+		return compareRaw(types.GenericAscCompare[int32]), nil
+	case types.T_int64:
+		return compareRaw(types.GenericAscCompare[int64]), nil
+	case types.T_uint8:
+		return compareRaw(types.GenericAscCompare[uint8]), nil
+	case types.T_uint16:
+		return compareRaw(types.GenericAscCompare[uint16]), nil
+	case types.T_uint32:
+		return compareRaw(types.GenericAscCompare[uint32]), nil
+	case types.T_float32:
+		return compareRaw(types.GenericAscCompare[float32]), nil
+	case types.T_float64:
+		return compareRaw(types.GenericAscCompare[float64]), nil
+	case types.T_decimal64:
+		return compareRaw(types.Decimal64AscCompare), nil
+	case types.T_decimal128:
+		return compareRaw(types.Decimal128AscCompare), nil
+	case types.T_date:
+		return compareRaw(types.GenericAscCompare[types.Date]), nil
+	case types.T_datetime:
+		return compareRaw(types.GenericAscCompare[types.Datetime]), nil
+	case types.T_timestamp:
+		return compareRaw(types.GenericAscCompare[types.Timestamp]), nil
+	case types.T_time:
+		return compareRaw(types.GenericAscCompare[types.Time]), nil
+	case types.T_year:
+		return compareRaw(types.GenericAscCompare[types.MoYear]), nil
+	case types.T_uuid:
+		return compareRaw(types.UuidAscCompare), nil
+	case types.T_enum:
+		return compareRaw(types.GenericAscCompare[types.Enum]), nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
+		return bytes.Compare, nil
+	}
+	return nil, moerr.NewInternalErrorNoCtxf("unsupported type '%v' for ordered aggregation", typ.Oid)
+}
+
+// minMaxByExec is the executor of min_by(x, y) and max_by(x, y), which return
+// the value of x at the row with the smallest or the largest y. Rows where y
+// is NULL are ignored, x may be NULL.
+//
+// The state is the selected x and y of each group, a group is empty as long
+// as its y is NULL.
+type minMaxByExec struct {
+	aggExec
+	isMin   bool
+	compare func(a, b []byte) int
+}
+
+func makeMinMaxByExec(mp *mpool.MPool, aggID int64, isMin bool, params []types.Type) (AggFuncExec, error) {
+	if len(params) != 2 {
+		return nil, moerr.NewInternalErrorNoCtx("min_by/max_by needs exactly two arguments")
+	}
+	compare, err := rawCompare(params[1])
+	if err != nil {
+		return nil, err
+	}
+
+	exec := &minMaxByExec{isMin: isMin, compare: compare}
+	exec.mp = mp
+	exec.aggInfo = aggInfo{
+		aggId:      aggID,
+		isDistinct: false,
+		argTypes:   params,
+		retType:    params[0],
+		stateTypes: []types.Type{params[0], params[1]},
+		emptyNull:  true,
+		saveArg:    false,
+	}
+	return exec, nil
+}
+
+// better reports whether y1 should replace y2.
+func (exec *minMaxByExec) better(y1, y2 []byte) bool {
+	c := exec.compare(y1, y2)
+	if exec.isMin {
+		return c < 0
+	}
+	return c > 0
+}
+
+func (exec *minMaxByExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	return exec.BatchFill(row, []uint64{uint64(groupIndex + 1)}, vectors)
+}
+
+func (exec *minMaxByExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	return exec.BatchFill(0, slices.Repeat([]uint64{uint64(groupIndex + 1)}, vectors[0].Length()), vectors)
+}
+
+func (exec *minMaxByExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	xVec, yVec := vectors[0], vectors[1]
+	for i, grp := range groups {
+		if grp == GroupNotMatched {
+			continue
+		}
+		idx := uint64(i) + uint64(offset)
+		if yVec.IsNull(idx) {
+			continue
+		}
+		x, y := exec.getXY(grp - 1)
+		if err := exec.update(x, y, xVec, yVec, int64(idx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// update replaces the state of group y of chunk x by row idx of the
+// arguments if the group is empty or the row is better.
+func (exec *minMaxByExec) update(x int, y uint16, xVec, yVec *vector.Vector, idx int64) error {
+	stX, stY := exec.state[x].vecs[0], exec.state[x].vecs[1]
+	if !stY.IsNull(uint64(y)) && !exec.better(yVec.GetRawBytesAt(int(idx)), stY.GetRawBytesAt(int(y))) {
+		return nil
+	}
+	if err := stX.Copy(xVec, int64(y), idx, exec.mp); err != nil {
+		return err
+	}
+	return stY.Copy(yVec, int64(y), idx, exec.mp)
+}
+
+func (exec *minMaxByExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	return exec.BatchMerge(next, groupIdx2, []uint64{uint64(groupIdx1 + 1)})
+}
+
+func (exec *minMaxByExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*minMaxByExec)
+	for i, grp := range groups {
+		if grp == GroupNotMatched {
+			continue
+		}
+		x1, y1 := exec.getXY(grp - 1)
+		x2, y2 := other.getXY(uint64(offset + i))
+		if other.state[x2].vecs[1].IsNull(uint64(y2)) {
+			continue
+		}
+		if err := exec.update(x1, y1, other.state[x2].vecs[0], other.state[x2].vecs[1], int64(y2)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *minMaxByExec) SetExtraInformation(partialResult any, _ int) error {
+	return nil
+}
+
+func (exec *minMaxByExec) Flush() ([]*vector.Vector, error) {
+	// transfer the x vectors to result, x is NULL for the empty groups.
+	vecs := make([]*vector.Vector, len(exec.state))
+	for i := range vecs {
+		vecs[i] = exec.state[i].vecs[0]
+		exec.state[i].vecs[0] = nil
+		exec.state[i].length = 0
+		exec.state[i].capacity = 0
+	}
+	return vecs, nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawCompare(t *testing.T) {
+	for _, oid := range OrderedAggSupportedTypes {
+		_, err := rawCompare(oid.ToType())
+		require.NoError(t, err, oid.String())
+	}
+	_, err := rawCompare(types.T_json.ToType())
+	require.Error(t, err)
+
+	compare, err := rawCompare(types.T_int32.ToType())
+	require.NoError(t, err)
+	require.Equal(t, -1, compare(types.EncodeFixed(int32(-5)), types.EncodeFixed(int32(3))))
+	compare, err = rawCompare(types.T_varchar.ToType())
+	require.NoError(t, err)
+	require.Equal(t, 1, compare([]byte("b"), []byte("ab")))
+}
+
+func TestMinMaxByExec(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	// x is a string with a NULL, y an int with a NULL which is skipped.
+	xs := vector.NewVec(types.T_varchar.ToType())
+	ys := vector.NewVec(types.T_int64.ToType())
+	for i, x := range []string{"a", "b", "", "a-long-value-not-inlined-in-varlena", "e"} {
+		require.NoError(t, vector.AppendBytes(xs, []byte(x), i == 2, mp))
+	}
+	for i, y := range []int64{3, 9, -1, 1, 100} {
+		require.NoError(t, vector.AppendFixed(ys, y, i == 4, mp))
+	}
+	defer xs.Free(mp)
+	defer ys.Free(mp)
+	params := []types.Type{types.T_varchar.ToType(), types.T_int64.ToType()}
+
+	// group 1 gets rows 0, 2, 4, group 2 gets rows 1, 3, group 3 is empty.
+	groups := []uint64{1, 2, 1, 2, 1}
+	check := func(isMin bool, expect []string, nulls []bool) {
+		exec, err := makeMinMaxByExec(mp, AggIdOfMinBy, isMin, params)
+		require.NoError(t, err)
+		require.NoError(t, exec.GroupGrow(3))
+		require.NoError(t, exec.BatchFill(0, groups, []*vector.Vector{xs, ys}))
+		res := flushRegr(t, exec)
+		for i := range expect {
+			require.Equal(t, nulls[i], res.IsNull(uint64(i)))
+			if !nulls[i] {
+				require.Equal(t, expect[i], res.GetStringAt(i))
+			}
+		}
+		res.Free(mp)
+		exec.Free()
+	}
+	// min_by picks the NULL x at y = -1.
+	check(true, []string{"", "a-long-value-not-inlined-in-varlena", ""}, []bool{true, false, true})
+	check(false, []string{"a", "b", ""}, []bool{false, false, true})
+
+	_, err := makeMinMaxByExec(mp, AggIdOfMinBy, true, params[:1])
+	require.Error(t, err)
+	_, err = makeMinMaxByExec(mp, AggIdOfMinBy, true, []types.Type{params[0], types.T_json.ToType()})
+	require.Error(t, err)
+}
+
+func TestMinMaxByMergeAcrossIntermediateResults(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	newVecs := func(xs []int32, ys []float64) []*vector.Vector {
+		xv := vector.NewVec(types.T_int32.ToType())
+		yv := vector.NewVec(types.T_float64.ToType())
+		for i := range xs {
+			require.NoError(t, vector.AppendFixed(xv, xs[i], false, mp))
+			require.NoError(t, vector.AppendFixed(yv, ys[i], false, mp))
+		}
+		return []*vector.Vector{xv, yv}
+	}
+	params := []types.Type{types.T_int32.ToType(), types.T_float64.ToType()}
+
+	vecs1 := newVecs([]int32{1, 2}, []float64{0.5, 1.5})
+	vecs2 := newVecs([]int32{3, 4}, []float64{2.5, -0.5})
+	defer func() {
+		for _, v := range append(vecs1, vecs2...) {
+			v.Free(mp)
+		}
+	}()
+
+	part1, err := makeMinMaxByExec(mp, AggIdOfMaxBy, false, params)
+	require.NoError(t, err)
+	require.NoError(t, part1.GroupGrow(2))
+	require.NoError(t, part1.BulkFill(0, vecs1))
+	part2, err := makeMinMaxByExec(mp, AggIdOfMaxBy, false, params)
+	require.NoError(t, err)
+	require.NoError(t, part2.GroupGrow(2))
+	require.NoError(t, part2.BulkFill(0, vecs2))
+
+	var buf bytes.Buffer
+	require.NoError(t, part2.SaveIntermediateResult(2, [][]uint8{{1, 1}}, &buf))
+	restored, err := makeMinMaxByExec(mp, AggIdOfMaxBy, false, params)
+	require.NoError(t, err)
+	require.NoError(t, restored.UnmarshalFromReader(bytes.NewReader(buf.Bytes()), mp))
+
+	// the second group of part1 is empty and takes the value of the other,
+	// merging an empty group changes nothing.
+	require.NoError(t, part1.BatchMerge(restored, 0, []uint64{1, 2}))
+	require.NoError(t, part1.Merge(restored, 1, 0))
+	res := flushRegr(t, part1)
+	require.Equal(t, int32(3), vector.GetFixedAtNoTypeCheck[int32](res, 0))
+	require.Equal(t, int32(3), vector.GetFixedAtNoTypeCheck[int32](res, 1))
+	require.False(t, res.IsNull(1))
+	res.Free(mp)
+	part1.Free()
+	part2.Free()
+	restored.Free()
+}
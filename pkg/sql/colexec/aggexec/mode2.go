@@ -0,0 +1,228 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"encoding/binary"
+	"io"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// frequencies counts the occurrences of the raw values of a group.
+type frequencies struct {
+	counts map[string]int64
+	size   int64
+}
+
+func makeFrequencies(_ *mpool.MPool) (MarshalerUnmarshaler, error) {
+	return &frequencies{counts: make(map[string]int64)}, nil
+}
+
+func (f *frequencies) add(key string, n int64) {
+	if _, ok := f.counts[key]; !ok {
+		f.size += int64(len(key)) + 16
+	}
+	f.counts[key] += n
+}
+
+// MarshalBinary encodes the number of values followed by each value and its
+// count. The result is never empty, so that an empty map is restored too.
+func (f *frequencies) MarshalBinary() ([]byte, error) {
+	buf := binary.AppendUvarint(make([]byte, 0, f.size+8), uint64(len(f.counts)))
+	for k, n := range f.counts {
+		buf = binary.AppendUvarint(buf, uint64(len(k)))
+		buf = append(buf, k...)
+		buf = binary.AppendVarint(buf, n)
+	}
+	return buf, nil
+}
+
+func (f *frequencies) UnmarshalBinary(data []byte) error {
+	f.counts = make(map[string]int64)
+	f.size = 0
+	cnt, m := binary.Uvarint(data)
+	if m <= 0 {
+		return moerr.NewInternalErrorNoCtx("invalid mode state")
+	}
+	data = data[m:]
+	for i := uint64(0); i < cnt; i++ {
+		l, m := binary.Uvarint(data)
+		if m <= 0 || uint64(len(data)-m) < l {
+			return moerr.NewInternalErrorNoCtx("invalid mode state")
+		}
+		key := string(data[m : m+int(l)])
+		data = data[m+int(l):]
+		n, m := binary.Varint(data)
+		if m <= 0 {
+			return moerr.NewInternalErrorNoCtx("invalid mode state")
+		}
+		data = data[m:]
+		f.add(key, n)
+	}
+	return nil
+}
+
+func (f *frequencies) UnmarshalFromReader(r io.Reader) error {
+	bs, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return f.UnmarshalBinary(bs)
+}
+
+// modeExec is the executor of mode(x), which returns the most frequent
+// non-NULL value of x, the smallest one if several values are the most
+// frequent. The counts of each group are kept in its state, so partial
+// results merge across nodes.
+type modeExec struct {
+	aggExec
+	compare func(a, b []byte) int
+}
+
+func makeModeExec(mp *mpool.MPool, aggID int64, param types.Type) (AggFuncExec, error) {
+	compare, err := rawCompare(param)
+	if err != nil {
+		return nil, err
+	}
+	exec := &modeExec{compare: compare}
+	exec.mp = mp
+	exec.aggInfo = aggInfo{
+		aggId:                    aggID,
+		isDistinct:               false,
+		argTypes:                 []types.Type{param},
+		retType:                  param,
+		emptyNull:                true,
+		saveArg:                  false,
+		makeMarshalerUnmarshaler: makeFrequencies,
+	}
+	return exec, nil
+}
+
+func (exec *modeExec) GroupGrow(more int) error {
+	start := exec.GetNumGroups()
+	if err := exec.aggExec.GroupGrow(more); err != nil {
+		return err
+	}
+	for i := start; i < start+more; i++ {
+		x, y := exec.getXY(uint64(i))
+		if exec.state[x].mobs[y] == nil {
+			exec.state[x].mobs[y], _ = makeFrequencies(exec.mp)
+		}
+	}
+	return nil
+}
+
+func (exec *modeExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	return exec.BatchFill(row, []uint64{uint64(groupIndex + 1)}, vectors)
+}
+
+func (exec *modeExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	return exec.BatchFill(0, slices.Repeat([]uint64{uint64(groupIndex + 1)}, vectors[0].Length()), vectors)
+}
+
+func (exec *modeExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	for i, grp := range groups {
+		if grp == GroupNotMatched {
+			continue
+		}
+		idx := offset + i
+		if vectors[0].IsNull(uint64(idx)) {
+			continue
+		}
+		x, y := exec.getXY(grp - 1)
+		exec.state[x].mobs[y].(*frequencies).add(string(vectors[0].GetRawBytesAt(idx)), 1)
+	}
+	return nil
+}
+
+func (exec *modeExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	return exec.BatchMerge(next, groupIdx2, []uint64{uint64(groupIdx1 + 1)})
+}
+
+func (exec *modeExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*modeExec)
+	for i, grp := range groups {
+		if grp == GroupNotMatched {
+			continue
+		}
+		x1, y1 := exec.getXY(grp - 1)
+		x2, y2 := other.getXY(uint64(offset + i))
+		f := exec.state[x1].mobs[y1].(*frequencies)
+		for k, n := range other.state[x2].mobs[y2].(*frequencies).counts {
+			f.add(k, n)
+		}
+	}
+	return nil
+}
+
+func (exec *modeExec) SetExtraInformation(partialResult any, _ int) error {
+	return nil
+}
+
+func (exec *modeExec) Flush() (_ []*vector.Vector, retErr error) {
+	vecs := make([]*vector.Vector, len(exec.state))
+	defer func() {
+		if retErr != nil {
+			for _, v := range vecs {
+				if v != nil {
+					v.Free(exec.mp)
+				}
+			}
+		}
+	}()
+	for i, st := range exec.state {
+		vecs[i] = vector.NewOffHeapVecWithType(exec.retType)
+		if err := vecs[i].PreExtend(int(st.length), exec.mp); err != nil {
+			return nil, err
+		}
+		vecs[i].SetLength(int(st.length))
+		for j := 0; j < int(st.length); j++ {
+			var (
+				best  []byte
+				count int64
+			)
+			for k, n := range st.mobs[j].(*frequencies).counts {
+				if n > count || (n == count && exec.compare([]byte(k), best) < 0) {
+					best, count = []byte(k), n
+				}
+			}
+			if err := vecs[i].SetRawBytesAt(j, best, exec.mp); err != nil {
+				return nil, err
+			}
+			if count == 0 {
+				vecs[i].GetNulls().Set(uint64(j))
+			}
+		}
+	}
+	return vecs, nil
+}
+
+func (exec *modeExec) Size() int64 {
+	var size int64
+	for _, st := range exec.state {
+		size += int64(cap(st.mobs)) * 8
+		for _, mob := range st.mobs {
+			if mob != nil {
+				size += mob.(*frequencies).size
+			}
+		}
+	}
+	return size
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrequenciesMarshal(t *testing.T) {
+	f := &frequencies{counts: make(map[string]int64)}
+	bs, err := f.MarshalBinary()
+	require.NoError(t, err)
+	require.NotEmpty(t, bs)
+
+	f.add("a", 2)
+	f.add("", 1)
+	f.add("a", 1)
+	bs, err = f.MarshalBinary()
+	require.NoError(t, err)
+	g := &frequencies{}
+	require.NoError(t, g.UnmarshalBinary(bs))
+	require.Equal(t, map[string]int64{"a": 3, "": 1}, g.counts)
+	require.Equal(t, f.size, g.size)
+
+	require.Error(t, g.UnmarshalBinary(nil))
+	require.Error(t, g.UnmarshalBinary(bs[:len(bs)-1]))
+}
+
+func TestModeExec(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	vec := vector.NewVec(types.T_int64.ToType())
+	// group 1: 5 and 7 are both the most frequent, the smaller one wins.
+	// group 2: only NULLs.
+	for _, v := range []int64{7, 5, 0, 7, 5, 0, 1} {
+		require.NoError(t, vector.AppendFixed(vec, v, v == 0, mp))
+	}
+	defer vec.Free(mp)
+	groups := []uint64{1, 1, 2, 1, 1, 2, 1}
+
+	exec, err := makeModeExec(mp, AggIdOfMode, types.T_int64.ToType())
+	require.NoError(t, err)
+	require.NoError(t, exec.GroupGrow(2))
+	require.NoError(t, exec.BatchFill(0, groups, []*vector.Vector{vec}))
+	require.Greater(t, exec.Size(), int64(0))
+	res := flushRegr(t, exec)
+	require.Equal(t, int64(5), vector.GetFixedAtNoTypeCheck[int64](res, 0))
+	require.True(t, res.IsNull(1))
+	res.Free(mp)
+	exec.Free()
+
+	_, err = makeModeExec(mp, AggIdOfMode, types.T_json.ToType())
+	require.Error(t, err)
+}
+
+func TestModeMergeAcrossIntermediateResults(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	newVec := func(vals ...string) *vector.Vector {
+		vec := vector.NewVec(types.T_varchar.ToType())
+		for _, v := range vals {
+			require.NoError(t, vector.AppendBytes(vec, []byte(v), false, mp))
+		}
+		return vec
+	}
+	vec1 := newVec("x", "x", "y")
+	defer vec1.Free(mp)
+	vec2 := newVec("y", "y", "z")
+	defer vec2.Free(mp)
+
+	part1, err := makeModeExec(mp, AggIdOfMode, types.T_varchar.ToType())
+	require.NoError(t, err)
+	require.NoError(t, part1.GroupGrow(1))
+	require.NoError(t, part1.BulkFill(0, []*vector.Vector{vec1}))
+	part2, err := makeModeExec(mp, AggIdOfMode, types.T_varchar.ToType())
+	require.NoError(t, err)
+	require.NoError(t, part2.GroupGrow(2))
+	require.NoError(t, part2.BulkFill(0, []*vector.Vector{vec2}))
+
+	var buf bytes.Buffer
+	require.NoError(t, part2.SaveIntermediateResult(2, [][]uint8{{1, 1}}, &buf))
+	restored, err := makeModeExec(mp, AggIdOfMode, types.T_varchar.ToType())
+	require.NoError(t, err)
+	require.NoError(t, restored.UnmarshalFromReader(bytes.NewReader(buf.Bytes()), mp))
+
+	// x appears twice in part1, y once in part1 and twice in part2.
+	require.NoError(t, part1.Merge(restored, 0, 0))
+	res := flushRegr(t, part1)
+	require.Equal(t, "y", res.GetStringAt(0))
+	res.Free(mp)
+	part1.Free()
+	part2.Free()
+	restored.Free()
+}
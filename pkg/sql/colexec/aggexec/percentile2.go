@@ -0,0 +1,293 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"cmp"
+	"encoding/binary"
+	"math"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+var PercentileDiscSupportedTypes = []types.T{
+	types.T_bit, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+	types.T_date, types.T_datetime, types.T_timestamp, types.T_time,
+}
+
+// EncodePercentileConfig encodes the constant arguments of the percentile
+// aggregations, it is passed to the executor by SetExtraInformation.
+func EncodePercentileConfig(fraction float64, desc bool) []byte {
+	cfg := make([]byte, 9)
+	binary.LittleEndian.PutUint64(cfg, math.Float64bits(fraction))
+	if desc {
+		cfg[8] = 1
+	}
+	return cfg
+}
+
+func decodePercentileConfig(cfg []byte) (fraction float64, desc bool, err error) {
+	if len(cfg) != 9 {
+		return 0, false, moerr.NewInternalErrorNoCtx("invalid percentile config")
+	}
+	fraction = math.Float64frombits(binary.LittleEndian.Uint64(cfg))
+	if !(fraction >= 0 && fraction <= 1) {
+		return 0, false, moerr.NewInvalidInputNoCtxf("percentile value %v is not between 0 and 1", fraction)
+	}
+	return fraction, cfg[8] == 1, nil
+}
+
+// percentileConfig is the fraction and the sort direction of a percentile
+// aggregation, set by SetExtraInformation before the result is flushed.
+type percentileConfig struct {
+	fraction float64
+	desc     bool
+	set      bool
+}
+
+func (c *percentileConfig) setFrom(partialResult any) error {
+	cfg, ok := partialResult.([]byte)
+	if !ok {
+		return nil
+	}
+	fraction, desc, err := decodePercentileConfig(cfg)
+	if err != nil {
+		return err
+	}
+	c.fraction, c.desc, c.set = fraction, desc, true
+	return nil
+}
+
+func (c *percentileConfig) check(name string) error {
+	if !c.set {
+		return moerr.NewInternalErrorNoCtxf("%s: percentile value is not set", name)
+	}
+	return nil
+}
+
+// pickKthFunc appends the k-th smallest of the raw values to vec.
+type pickKthFunc func(raws [][]byte, k int, vec *vector.Vector, mp *mpool.MPool) error
+
+func pickKth[T types.FixedSizeTExceptStrType](compare func(a, b T) int) pickKthFunc {
+	return func(raws [][]byte, k int, vec *vector.Vector, mp *mpool.MPool) error {
+		vals := make([]T, len(raws))
+		for i, raw := range raws {
+			vals[i] = types.DecodeFixed[T](raw)
+		}
+		return vector.AppendFixed(vec, selectKthFunc(vals, k, compare), false, mp)
+	}
+}
+
+func compareDecimal64(a, b types.Decimal64) int {
+	return a.Compare(b)
+}
+
+func compareDecimal128(a, b types.Decimal128) int {
+	return a.Compare(b)
+}
+
+// percentileExec is the executor of the ordered-set aggregations
+// percentile_cont and percentile_disc. All the values of a group are kept
+// as its arguments and the requested one is selected at flush.
+//
+// percentile_cont interpolates between the two nearest values, so its
+// argument is always float64. percentile_disc returns the first value
+// whose position in the ordering reaches the fraction, it keeps the type
+// of its argument.
+type percentileExec struct {
+	aggExec
+	percentileConfig
+	disc bool
+	pick pickKthFunc
+}
+
+func makePercentileExec(mp *mpool.MPool, disc bool, aggID int64, isDistinct bool, param types.Type) (AggFuncExec, error) {
+	exec := &percentileExec{disc: disc}
+	retType := types.T_float64.ToType()
+	if disc {
+		retType = param
+		switch param.Oid {
+		case types.T_bit, types.T_uint64:
+			exec.pick = pickKth[uint64](cmp.Compare[uint64])
+		case types.T_int8:
+			exec.pick = pickKth[int8](cmp.Compare[int8])
+		case types.T_int16:
+			exec.pick = pickKth[int16](cmp.Compare[int16])
+		case types.T_int32:
+			exec.pick = pickKth[int32](cmp.Compare[int32])
+		case types.T_int64:
+			exec.pick = pickKth[int64](cmp.Compare[int64])
+		case types.T_uint8:
+			exec.pick = pickKth[uint8](cmp.Compare[uint8])
+		case types.T_uint16:
+			exec.pick = pickKth[uint16](cmp.Compare[uint16])
+		case types.T_uint32:
+			exec.pick = pickKth[uint32](cmp.Compare[uint32])
+		case types.T_float32:
+			exec.pick = pickKth[float32](cmp.Compare[float32])
+		case types.T_float64:
+			exec.pick = pickKth[float64](cmp.Compare[float64])
+		case types.T_decimal64:
+			exec.pick = pickKth[types.Decimal64](compareDecimal64)
+		case types.T_decimal128:
+			exec.pick = pickKth[types.Decimal128](compareDecimal128)
+		case types.T_date:
+			exec.pick = pickKth[types.Date](cmp.Compare[types.Date])
+		case types.T_datetime:
+			exec.pick = pickKth[types.Datetime](cmp.Compare[types.Datetime])
+		case types.T_timestamp:
+			exec.pick = pickKth[types.Timestamp](cmp.Compare[types.Timestamp])
+		case types.T_time:
+			exec.pick = pickKth[types.Time](cmp.Compare[types.Time])
+		default:
+			return nil, moerr.NewInternalErrorNoCtxf("unsupported type '%v' for percentile_disc", param.Oid)
+		}
+	} else if param.Oid != types.T_float64 {
+		return nil, moerr.NewInternalErrorNoCtxf("unsupported type '%v' for percentile_cont", param.Oid)
+	}
+
+	exec.mp = mp
+	exec.aggInfo = aggInfo{
+		aggId:      aggID,
+		isDistinct: isDistinct,
+		argTypes:   []types.Type{param},
+		retType:    retType,
+		emptyNull:  true,
+		saveArg:    true,
+	}
+	return exec, nil
+}
+
+func (exec *percentileExec) name() string {
+	if exec.disc {
+		return "percentile_disc"
+	}
+	return "percentile_cont"
+}
+
+func (exec *percentileExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	return exec.BatchFill(row, []uint64{uint64(groupIndex + 1)}, vectors)
+}
+
+func (exec *percentileExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	return exec.BatchFill(0, slices.Repeat([]uint64{uint64(groupIndex + 1)}, vectors[0].Length()), vectors)
+}
+
+func (exec *percentileExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	return exec.batchFillArgs(offset, groups, vectors, exec.IsDistinct())
+}
+
+func (exec *percentileExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	return exec.BatchMerge(next, groupIdx2, []uint64{uint64(groupIdx1 + 1)})
+}
+
+func (exec *percentileExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*percentileExec)
+	return exec.batchMergeArgs(&other.aggExec, offset, groups, exec.IsDistinct())
+}
+
+func (exec *percentileExec) SetExtraInformation(partialResult any, _ int) error {
+	return exec.setFrom(partialResult)
+}
+
+// discPosition returns the 0-based position in ascending order of the
+// first of n values whose position reaches the fraction.
+func (c *percentileConfig) discPosition(n int) int {
+	k := int(math.Ceil(c.fraction * float64(n)))
+	if k < 1 {
+		k = 1
+	}
+	if c.desc {
+		return n - k
+	}
+	return k - 1
+}
+
+// contValue interpolates the value at the fraction of the values, which
+// are reordered in place.
+func (c *percentileConfig) contValue(vals []float64) float64 {
+	fraction := c.fraction
+	if c.desc {
+		fraction = 1 - fraction
+	}
+	pos := fraction * float64(len(vals)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	v1 := selectKthNumeric(vals, lo)
+	if lo == hi {
+		return v1
+	}
+	v2 := selectKthNumeric(vals, hi)
+	return v1 + (v2-v1)*(pos-float64(lo))
+}
+
+func (exec *percentileExec) Flush() (_ []*vector.Vector, retErr error) {
+	if err := exec.check(exec.name()); err != nil {
+		return nil, err
+	}
+
+	vecs := make([]*vector.Vector, len(exec.state))
+	defer func() {
+		if retErr != nil {
+			for _, v := range vecs {
+				if v != nil {
+					v.Free(exec.mp)
+				}
+			}
+		}
+	}()
+
+	var raws [][]byte
+	for i, st := range exec.state {
+		vecs[i] = vector.NewOffHeapVecWithType(exec.retType)
+		if err := vecs[i].PreExtend(int(st.length), exec.mp); err != nil {
+			return nil, err
+		}
+
+		for j := 0; j < int(st.length); j++ {
+			raws = raws[:0]
+			if err := st.iter(uint16(j), func(k []byte) error {
+				raws = append(raws, aggPayloadFromKey(&exec.aggInfo, k))
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+
+			var err error
+			switch {
+			case len(raws) == 0:
+				err = vector.AppendNull(vecs[i], exec.mp)
+			case exec.disc:
+				err = exec.pick(raws, exec.discPosition(len(raws)), vecs[i], exec.mp)
+			default:
+				vals := make([]float64, len(raws))
+				for r, raw := range raws {
+					vals[r] = types.DecodeFixed[float64](raw)
+				}
+				err = vector.AppendFixed(vecs[i], exec.contValue(vals), false, exec.mp)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return vecs, nil
+}
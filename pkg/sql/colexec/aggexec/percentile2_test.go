@@ -0,0 +1,211 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentileConfig(t *testing.T) {
+	fraction, desc, err := decodePercentileConfig(EncodePercentileConfig(0.25, true))
+	require.NoError(t, err)
+	require.Equal(t, 0.25, fraction)
+	require.True(t, desc)
+
+	_, _, err = decodePercentileConfig(EncodePercentileConfig(1.5, false))
+	require.Error(t, err)
+	_, _, err = decodePercentileConfig([]byte{1})
+	require.Error(t, err)
+
+	// the partial results of other operators are not configs.
+	var c percentileConfig
+	require.NoError(t, c.setFrom(int64(1)))
+	require.Error(t, c.check("percentile_cont"))
+}
+
+func TestPercentilePositions(t *testing.T) {
+	// the values are 10, 20, 30, 40.
+	for _, tc := range []struct {
+		fraction float64
+		desc     bool
+		disc     int
+		cont     float64
+	}{
+		{0, false, 0, 10},
+		{0.25, false, 0, 17.5},
+		{0.5, false, 1, 25},
+		{0.9, false, 3, 37},
+		{1, false, 3, 40},
+		{0, true, 3, 40},
+		{0.25, true, 3, 32.5},
+		{0.5, true, 2, 25},
+		{1, true, 0, 10},
+	} {
+		c := percentileConfig{fraction: tc.fraction, desc: tc.desc, set: true}
+		require.Equal(t, tc.disc, c.discPosition(4), "%+v", tc)
+		require.InDelta(t, tc.cont, c.contValue([]float64{40, 10, 30, 20}), 1e-12, "%+v", tc)
+	}
+}
+
+func TestPercentileContExec(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	vec := vector.NewVec(types.T_float64.ToType())
+	require.NoError(t, vector.AppendFixedList(vec, []float64{4, 1, 3, 2, 7, 0}, nil, mp))
+	vec.GetNulls().Add(5)
+	defer vec.Free(mp)
+
+	exec, err := makePercentileExec(mp, false, AggIdOfPercentileCont, false, types.T_float64.ToType())
+	require.NoError(t, err)
+	require.NoError(t, exec.SetExtraInformation(EncodePercentileConfig(0.5, false), 0))
+	require.NoError(t, exec.GroupGrow(3))
+	require.NoError(t, exec.BatchFill(0, []uint64{1, 1, 1, 1, 2, 3}, []*vector.Vector{vec}))
+
+	res := flushRegr(t, exec)
+	require.Equal(t, types.T_float64, res.GetType().Oid)
+	require.Equal(t, 2.5, vector.GetFixedAtNoTypeCheck[float64](res, 0))
+	require.Equal(t, float64(7), vector.GetFixedAtNoTypeCheck[float64](res, 1))
+	require.True(t, res.IsNull(2))
+	res.Free(mp)
+	exec.Free()
+
+	_, err = makePercentileExec(mp, false, AggIdOfPercentileCont, false, types.T_int64.ToType())
+	require.Error(t, err)
+
+	exec, err = makePercentileExec(mp, false, AggIdOfPercentileCont, false, types.T_float64.ToType())
+	require.NoError(t, err)
+	require.NoError(t, exec.GroupGrow(1))
+	_, err = exec.Flush()
+	require.Error(t, err)
+	exec.Free()
+}
+
+func TestPercentileDiscExec(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	typ := types.New(types.T_decimal64, 10, 2)
+	vec := vector.NewVec(typ)
+	require.NoError(t, vector.AppendFixedList(vec, []types.Decimal64{300, 100, 400, 200}, nil, mp))
+	defer vec.Free(mp)
+
+	for _, tc := range []struct {
+		desc   bool
+		expect types.Decimal64
+	}{
+		{false, 200},
+		{true, 300},
+	} {
+		exec, err := makePercentileExec(mp, true, AggIdOfPercentileDisc, false, typ)
+		require.NoError(t, err)
+		require.NoError(t, exec.SetExtraInformation(EncodePercentileConfig(0.5, tc.desc), 0))
+		require.NoError(t, exec.GroupGrow(1))
+		require.NoError(t, exec.BulkFill(0, []*vector.Vector{vec}))
+
+		res := flushRegr(t, exec)
+		require.Equal(t, typ, *res.GetType())
+		require.Equal(t, tc.expect, vector.GetFixedAtNoTypeCheck[types.Decimal64](res, 0))
+		res.Free(mp)
+		exec.Free()
+	}
+
+	dates := vector.NewVec(types.T_date.ToType())
+	require.NoError(t, vector.AppendFixedList(dates, []types.Date{30, 10, 20}, nil, mp))
+	defer dates.Free(mp)
+	exec, err := makePercentileExec(mp, true, AggIdOfPercentileDisc, false, types.T_date.ToType())
+	require.NoError(t, err)
+	require.NoError(t, exec.SetExtraInformation(EncodePercentileConfig(1, false), 0))
+	require.NoError(t, exec.GroupGrow(1))
+	require.NoError(t, exec.BulkFill(0, []*vector.Vector{dates}))
+	res := flushRegr(t, exec)
+	require.Equal(t, types.Date(30), vector.GetFixedAtNoTypeCheck[types.Date](res, 0))
+	res.Free(mp)
+	exec.Free()
+
+	_, err = makePercentileExec(mp, true, AggIdOfPercentileDisc, false, types.T_varchar.ToType())
+	require.Error(t, err)
+}
+
+func TestPercentileExecMergeAndIntermediateRoundTrip(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	vec := vector.NewVec(types.T_int32.ToType())
+	require.NoError(t, vector.AppendFixedList(vec, []int32{5, 1, 4, 2, 3}, nil, mp))
+	defer vec.Free(mp)
+
+	cfg := EncodePercentileConfig(0.5, false)
+	newExec := func() AggFuncExec {
+		exec, err := makePercentileExec(mp, true, AggIdOfPercentileDisc, false, types.T_int32.ToType())
+		require.NoError(t, err)
+		require.NoError(t, exec.SetExtraInformation(cfg, 0))
+		require.NoError(t, exec.GroupGrow(1))
+		return exec
+	}
+
+	part1, part2 := newExec(), newExec()
+	require.NoError(t, part1.BatchFill(0, []uint64{1, 1, GroupNotMatched, GroupNotMatched, GroupNotMatched}, []*vector.Vector{vec}))
+	require.NoError(t, part2.BatchFill(2, []uint64{1, 1, 1}, []*vector.Vector{vec}))
+
+	var buf bytes.Buffer
+	require.NoError(t, part2.SaveIntermediateResult(1, [][]uint8{{1}}, &buf))
+	restored, err := makePercentileExec(mp, true, AggIdOfPercentileDisc, false, types.T_int32.ToType())
+	require.NoError(t, err)
+	require.NoError(t, restored.SetExtraInformation(cfg, 0))
+	require.NoError(t, restored.UnmarshalFromReader(bytes.NewReader(buf.Bytes()), mp))
+
+	require.NoError(t, part1.Merge(restored, 0, 0))
+	res := flushRegr(t, part1)
+	require.Equal(t, int32(3), vector.GetFixedAtNoTypeCheck[int32](res, 0))
+	res.Free(mp)
+	part1.Free()
+	part2.Free()
+	restored.Free()
+}
+
+func TestMakeAggOfPercentile(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	RegisterPercentileCont(AggIdOfPercentileCont)
+	RegisterPercentileDisc(AggIdOfPercentileDisc)
+
+	exec, err := MakeAgg(mp, AggIdOfPercentileCont, false, types.T_float64.ToType())
+	require.NoError(t, err)
+	_, ret := exec.TypesInfo()
+	require.Equal(t, types.T_float64, ret.Oid)
+	exec.Free()
+
+	exec, err = MakeAgg(mp, AggIdOfPercentileDisc, false, types.T_timestamp.ToType())
+	require.NoError(t, err)
+	_, ret = exec.TypesInfo()
+	require.Equal(t, types.T_timestamp, ret.Oid)
+	exec.Free()
+}
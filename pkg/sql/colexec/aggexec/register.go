@@ -139,6 +139,116 @@ func RegisterAvgTwResult(id int64) {
 	AggIdOfAvgTwResult = id
 }
 
+func RegisterArrayAgg(id int64) {
+	specialAgg[id] = true
+	AggIdOfArrayAgg = id
+}
+
+func RegisterCorr(id int64) {
+	specialAgg[id] = true
+	AggIdOfCorr = id
+}
+
+func RegisterCovarPop(id int64) {
+	specialAgg[id] = true
+	AggIdOfCovarPop = id
+}
+
+func RegisterCovarSample(id int64) {
+	specialAgg[id] = true
+	AggIdOfCovarSample = id
+}
+
+func RegisterRegrSlope(id int64) {
+	specialAgg[id] = true
+	AggIdOfRegrSlope = id
+}
+
+func RegisterRegrIntercept(id int64) {
+	specialAgg[id] = true
+	AggIdOfRegrIntercept = id
+}
+
+func RegisterRegrR2(id int64) {
+	specialAgg[id] = true
+	AggIdOfRegrR2 = id
+}
+
+func RegisterRegrCount(id int64) {
+	specialAgg[id] = true
+	AggIdOfRegrCount = id
+}
+
+func RegisterRegrAvgX(id int64) {
+	specialAgg[id] = true
+	AggIdOfRegrAvgX = id
+}
+
+func RegisterRegrAvgY(id int64) {
+	specialAgg[id] = true
+	AggIdOfRegrAvgY = id
+}
+
+func RegisterRegrSXX(id int64) {
+	specialAgg[id] = true
+	AggIdOfRegrSXX = id
+}
+
+func RegisterRegrSYY(id int64) {
+	specialAgg[id] = true
+	AggIdOfRegrSYY = id
+}
+
+func RegisterRegrSXY(id int64) {
+	specialAgg[id] = true
+	AggIdOfRegrSXY = id
+}
+
+func RegisterPercentileCont(id int64) {
+	specialAgg[id] = true
+	AggIdOfPercentileCont = id
+}
+
+func RegisterPercentileDisc(id int64) {
+	specialAgg[id] = true
+	AggIdOfPercentileDisc = id
+}
+
+func RegisterApproxPercentile(id int64) {
+	specialAgg[id] = true
+	AggIdOfApproxPercentile = id
+}
+
+func RegisterTDigestAddAgg(id int64) {
+	specialAgg[id] = true
+	AggIdOfTDigestAdd = id
+}
+
+func RegisterTDigestMergeAgg(id int64) {
+	specialAgg[id] = true
+	AggIdOfTDigestMerge = id
+}
+
+func RegisterMinBy(id int64) {
+	specialAgg[id] = true
+	AggIdOfMinBy = id
+}
+
+func RegisterMaxBy(id int64) {
+	specialAgg[id] = true
+	AggIdOfMaxBy = id
+}
+
+func RegisterMode(id int64) {
+	specialAgg[id] = true
+	AggIdOfMode = id
+}
+
+func RegisterApproxTopK(id int64) {
+	specialAgg[id] = true
+	AggIdOfApproxTopK = id
+}
+
 func RegisterRowNumberWin(id int64) {
 	specialAgg[id] = true
 	WinIdOfRowNumber = id
@@ -198,44 +308,66 @@ var (
 	specialAgg = make(map[int64]bool)
 
 	// list of special aggregation function IDs.
-	AggIdOfCountColumn     = int64(-1)
-	AggIdOfCountStar       = int64(-2)
-	AggIdOfGroupConcat     = int64(-3)
-	AggIdOfApproxCount     = int64(-4)
-	AggIdOfMedian          = int64(-5)
-	AggIdOfJsonArrayAgg    = int64(-6)
-	AggIdOfJsonObjectAgg   = int64(-7)
-	WinIdOfRowNumber       = int64(-8)
-	WinIdOfRank            = int64(-9)
-	WinIdOfDenseRank       = int64(-10)
-	WinIdOfLag             = int64(-11)
-	WinIdOfLead            = int64(-12)
-	WinIdOfFirstValue      = int64(-13)
-	WinIdOfLastValue       = int64(-14)
-	WinIdOfNthValue        = int64(-15)
-	AggIdOfSum             = int64(-16)
-	AggIdOfAvg             = int64(-17)
-	AggIdOfMin             = int64(-18)
-	AggIdOfMax             = int64(-19)
-	AggIdOfAny             = int64(-20)
-	AggIdOfVarPop          = int64(-21)
-	AggIdOfStdDevPop       = int64(-22)
-	AggIdOfVarSample       = int64(-23)
-	AggIdOfStdDevSample    = int64(-24)
-	AggIdOfBitXor          = int64(-25)
-	AggIdOfBitAnd          = int64(-26)
-	AggIdOfBitOr           = int64(-27)
-	AggIdOfBitmapConstruct = int64(-28)
-	AggIdOfBitmapOr        = int64(-29)
-	WinIdOfCumeDist        = int64(-30)
-	WinIdOfNtile           = int64(-31)
-	WinIdOfPercentRank     = int64(-32)
-	AggIdOfAvgTwCache      = int64(-33)
-	AggIdOfAvgTwResult     = int64(-34)
-	AggIdOfHllAdd          = int64(-35)
-	AggIdOfHllMerge        = int64(-36)
-	groupConcatSep         = ","
-	getGroupConcatRet      = func(args ...types.Type) types.Type {
+	AggIdOfCountColumn      = int64(-1)
+	AggIdOfCountStar        = int64(-2)
+	AggIdOfGroupConcat      = int64(-3)
+	AggIdOfApproxCount      = int64(-4)
+	AggIdOfMedian           = int64(-5)
+	AggIdOfJsonArrayAgg     = int64(-6)
+	AggIdOfJsonObjectAgg    = int64(-7)
+	WinIdOfRowNumber        = int64(-8)
+	WinIdOfRank             = int64(-9)
+	WinIdOfDenseRank        = int64(-10)
+	WinIdOfLag              = int64(-11)
+	WinIdOfLead             = int64(-12)
+	WinIdOfFirstValue       = int64(-13)
+	WinIdOfLastValue        = int64(-14)
+	WinIdOfNthValue         = int64(-15)
+	AggIdOfSum              = int64(-16)
+	AggIdOfAvg              = int64(-17)
+	AggIdOfMin              = int64(-18)
+	AggIdOfMax              = int64(-19)
+	AggIdOfAny              = int64(-20)
+	AggIdOfVarPop           = int64(-21)
+	AggIdOfStdDevPop        = int64(-22)
+	AggIdOfVarSample        = int64(-23)
+	AggIdOfStdDevSample     = int64(-24)
+	AggIdOfBitXor           = int64(-25)
+	AggIdOfBitAnd           = int64(-26)
+	AggIdOfBitOr            = int64(-27)
+	AggIdOfBitmapConstruct  = int64(-28)
+	AggIdOfBitmapOr         = int64(-29)
+	WinIdOfCumeDist         = int64(-30)
+	WinIdOfNtile            = int64(-31)
+	WinIdOfPercentRank      = int64(-32)
+	AggIdOfAvgTwCache       = int64(-33)
+	AggIdOfAvgTwResult      = int64(-34)
+	AggIdOfHllAdd           = int64(-35)
+	AggIdOfHllMerge         = int64(-36)
+	AggIdOfArrayAgg         = int64(-37)
+	AggIdOfCorr             = int64(-38)
+	AggIdOfCovarPop         = int64(-39)
+	AggIdOfCovarSample      = int64(-40)
+	AggIdOfRegrSlope        = int64(-41)
+	AggIdOfRegrIntercept    = int64(-42)
+	AggIdOfRegrR2           = int64(-43)
+	AggIdOfRegrCount        = int64(-44)
+	AggIdOfRegrAvgX         = int64(-45)
+	AggIdOfRegrAvgY         = int64(-46)
+	AggIdOfRegrSXX          = int64(-47)
+	AggIdOfRegrSYY          = int64(-48)
+	AggIdOfRegrSXY          = int64(-49)
+	AggIdOfPercentileCont   = int64(-50)
+	AggIdOfPercentileDisc   = int64(-51)
+	AggIdOfApproxPercentile = int64(-52)
+	AggIdOfTDigestAdd       = int64(-53)
+	AggIdOfTDigestMerge     = int64(-54)
+	AggIdOfMinBy            = int64(-55)
+	AggIdOfMaxBy            = int64(-56)
+	AggIdOfMode             = int64(-57)
+	AggIdOfApproxTopK       = int64(-58)
+	groupConcatSep          = ","
+	getGroupConcatRet       = func(args ...types.Type) types.Type {
 		for _, p := range args {
 			if p.Oid == types.T_binary || p.Oid == types.T_varbinary || p.Oid == types.T_blob {
 				return types.T_blob.ToType()
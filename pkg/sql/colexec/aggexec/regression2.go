@@ -0,0 +1,385 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"encoding/binary"
+	"math"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// regrKind selects the final function of the two-argument statistical
+// aggregations, which all share the same partial state.
+type regrKind int
+
+const (
+	regrCorr regrKind = iota
+	regrCovarPop
+	regrCovarSample
+	regrSlope
+	regrIntercept
+	regrR2
+	regrCount
+	regrAvgX
+	regrAvgY
+	regrSXX
+	regrSYY
+	regrSXY
+)
+
+// regrState is the partial state of the aggregation over (y, x) pairs.
+// Besides the sums of x and y, it keeps the sums of squared deviations and
+// of cross products updated with the Youngs-Cramer algorithm, which does
+// not suffer from the cancellation of the naive sum of squares.
+type regrState struct {
+	n   int64
+	sx  float64
+	sxx float64
+	sy  float64
+	syy float64
+	sxy float64
+}
+
+func (s *regrState) add(y, x float64) {
+	s.n++
+	s.sx += x
+	s.sy += y
+	if s.n > 1 {
+		n := float64(s.n)
+		tmpX := x*n - s.sx
+		tmpY := y*n - s.sy
+		scale := 1.0 / (n * (n - 1))
+		s.sxx += tmpX * tmpX * scale
+		s.syy += tmpY * tmpY * scale
+		s.sxy += tmpX * tmpY * scale
+	}
+}
+
+func (s *regrState) merge(o regrState) {
+	if o.n == 0 {
+		return
+	}
+	if s.n == 0 {
+		*s = o
+		return
+	}
+	n1, n2 := float64(s.n), float64(o.n)
+	n := n1 + n2
+	dx := s.sx/n1 - o.sx/n2
+	dy := s.sy/n1 - o.sy/n2
+	s.sxx += o.sxx + n1*n2*dx*dx/n
+	s.syy += o.syy + n1*n2*dy*dy/n
+	s.sxy += o.sxy + n1*n2*dx*dy/n
+	s.n += o.n
+	s.sx += o.sx
+	s.sy += o.sy
+}
+
+// result returns the value of the aggregation, or false if it is NULL.
+// regr_count reads n directly.
+func (s *regrState) result(kind regrKind) (float64, bool) {
+	n := float64(s.n)
+	if kind == regrCovarSample {
+		if s.n < 2 {
+			return 0, false
+		}
+		return s.sxy / (n - 1), true
+	}
+	if s.n < 1 {
+		return 0, false
+	}
+	switch kind {
+	case regrCovarPop:
+		return s.sxy / n, true
+	case regrCorr:
+		if s.sxx == 0 || s.syy == 0 {
+			return 0, false
+		}
+		return s.sxy / math.Sqrt(s.sxx*s.syy), true
+	case regrSlope:
+		if s.sxx == 0 {
+			return 0, false
+		}
+		return s.sxy / s.sxx, true
+	case regrIntercept:
+		if s.sxx == 0 {
+			return 0, false
+		}
+		return (s.sy - s.sx*s.sxy/s.sxx) / n, true
+	case regrR2:
+		if s.sxx == 0 {
+			return 0, false
+		}
+		if s.syy == 0 {
+			return 1, true
+		}
+		return s.sxy * s.sxy / (s.sxx * s.syy), true
+	case regrAvgX:
+		return s.sx / n, true
+	case regrAvgY:
+		return s.sy / n, true
+	case regrSXX:
+		return s.sxx, true
+	case regrSYY:
+		return s.syy, true
+	default:
+		return s.sxy, true
+	}
+}
+
+// regrStateCols are the state vectors of one chunk of groups.
+type regrStateCols struct {
+	n   []int64
+	sx  []float64
+	sxx []float64
+	sy  []float64
+	syy []float64
+	sxy []float64
+}
+
+func (c *regrStateCols) get(y uint16) regrState {
+	return regrState{n: c.n[y], sx: c.sx[y], sxx: c.sxx[y], sy: c.sy[y], syy: c.syy[y], sxy: c.sxy[y]}
+}
+
+func (c *regrStateCols) set(y uint16, s regrState) {
+	c.n[y], c.sx[y], c.sxx[y], c.sy[y], c.syy[y], c.sxy[y] = s.n, s.sx, s.sxx, s.sy, s.syy, s.sxy
+}
+
+// regrExec is the executor of corr, covar_pop, covar_samp and the regr_xxx
+// family. The first argument is the dependent variable y and the second one
+// is the independent variable x, rows where either of them is NULL are
+// ignored.
+type regrExec struct {
+	aggExec
+	kind regrKind
+	y2f  func([]byte) float64
+	x2f  func([]byte) float64
+}
+
+// rawToFloat64 returns the function converting the raw bytes of a value of
+// typ to float64.
+func rawToFloat64(typ types.Type) (func([]byte) float64, error) {
+	scale := typ.Scale
+	switch typ.Oid {
+	case types.T_int8:
+		return func(b []byte) float64 { return float64(types.DecodeFixed[int8](b)) }, nil
+	case types.T_int16:
+		return func(b []byte) float64 { return float64(types.DecodeFixed[int16](b)) }, nil
+	case types.T_int32:
+		return func(b []byte) float64 { return float64(types.DecodeFixed[int32](b)) }, nil
+	case types.T_int64:
+		return func(b []byte) float64 { return float64(types.DecodeFixed[int64](b)) }, nil
+	case types.T_uint8:
+		return func(b []byte) float64 { return float64(types.DecodeFixed[uint8](b)) }, nil
+	case types.T_uint16:
+		return func(b []byte) float64 { return float64(types.DecodeFixed[uint16](b)) }, nil
+	case types.T_uint32:
+		return func(b []byte) float64 { return float64(types.DecodeFixed[uint32](b)) }, nil
+	case types.T_uint64, types.T_bit:
+		return func(b []byte) float64 { return float64(types.DecodeFixed[uint64](b)) }, nil
+	case types.T_float32:
+		return func(b []byte) float64 { return float64(types.DecodeFixed[float32](b)) }, nil
+	case types.T_float64:
+		return func(b []byte) float64 { return types.DecodeFixed[float64](b) }, nil
+	case types.T_decimal64:
+		return func(b []byte) float64 { return dec64ToF(types.DecodeFixed[types.Decimal64](b), scale) }, nil
+	case types.T_decimal128:
+		return func(b []byte) float64 { return dec128ToF(types.DecodeFixed[types.Decimal128](b), scale) }, nil
+	default:
+		return nil, moerr.NewInternalErrorNoCtxf("unsupported type '%v' for corr/covar/regr", typ.Oid)
+	}
+}
+
+func makeRegrExec(mp *mpool.MPool, kind regrKind, aggID int64, isDistinct bool, params []types.Type) (AggFuncExec, error) {
+	if len(params) != 2 {
+		return nil, moerr.NewInternalErrorNoCtx("corr/covar/regr needs exactly two arguments")
+	}
+	y2f, err := rawToFloat64(params[0])
+	if err != nil {
+		return nil, err
+	}
+	x2f, err := rawToFloat64(params[1])
+	if err != nil {
+		return nil, err
+	}
+
+	retType := types.T_float64.ToType()
+	if kind == regrCount {
+		retType = types.T_int64.ToType()
+	}
+
+	exec := &regrExec{kind: kind, y2f: y2f, x2f: x2f}
+	exec.mp = mp
+	exec.aggInfo = aggInfo{
+		aggId:      aggID,
+		isDistinct: isDistinct,
+		argTypes:   params,
+		retType:    retType,
+		stateTypes: []types.Type{
+			types.T_int64.ToType(),
+			types.T_float64.ToType(), types.T_float64.ToType(), types.T_float64.ToType(),
+			types.T_float64.ToType(), types.T_float64.ToType(),
+		},
+		emptyNull: false,
+		saveArg:   isDistinct,
+	}
+	return exec, nil
+}
+
+func (exec *regrExec) cols(x int) regrStateCols {
+	vecs := exec.state[x].vecs
+	return regrStateCols{
+		n:   vector.MustFixedColNoTypeCheck[int64](vecs[0]),
+		sx:  vector.MustFixedColNoTypeCheck[float64](vecs[1]),
+		sxx: vector.MustFixedColNoTypeCheck[float64](vecs[2]),
+		sy:  vector.MustFixedColNoTypeCheck[float64](vecs[3]),
+		syy: vector.MustFixedColNoTypeCheck[float64](vecs[4]),
+		sxy: vector.MustFixedColNoTypeCheck[float64](vecs[5]),
+	}
+}
+
+func (exec *regrExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	return exec.BatchFill(row, []uint64{uint64(groupIndex + 1)}, vectors)
+}
+
+func (exec *regrExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	return exec.BatchFill(0, slices.Repeat([]uint64{uint64(groupIndex + 1)}, vectors[0].Length()), vectors)
+}
+
+func (exec *regrExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	if exec.IsDistinct() {
+		return exec.batchFillArgs(offset, groups, vectors, true)
+	}
+
+	yVec, xVec := vectors[0], vectors[1]
+	lastX := -1
+	var cols regrStateCols
+	for i, grp := range groups {
+		if grp == GroupNotMatched {
+			continue
+		}
+		idx := uint64(i) + uint64(offset)
+		if yVec.IsNull(idx) || xVec.IsNull(idx) {
+			continue
+		}
+
+		x, y := exec.getXY(grp - 1)
+		if x != lastX {
+			lastX = x
+			cols = exec.cols(x)
+		}
+		s := cols.get(y)
+		s.add(exec.y2f(yVec.GetRawBytesAt(int(idx))), exec.x2f(xVec.GetRawBytesAt(int(idx))))
+		cols.set(y, s)
+	}
+	return nil
+}
+
+func (exec *regrExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	return exec.BatchMerge(next, groupIdx2, []uint64{uint64(groupIdx1 + 1)})
+}
+
+func (exec *regrExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*regrExec)
+	if exec.IsDistinct() {
+		return exec.batchMergeArgs(&other.aggExec, offset, groups, true)
+	}
+
+	for i, grp := range groups {
+		if grp == GroupNotMatched {
+			continue
+		}
+		x1, y1 := exec.getXY(grp - 1)
+		x2, y2 := other.getXY(uint64(offset + i))
+		cols1, cols2 := exec.cols(x1), other.cols(x2)
+		s := cols1.get(y1)
+		s.merge(cols2.get(y2))
+		cols1.set(y1, s)
+	}
+	return nil
+}
+
+func (exec *regrExec) SetExtraInformation(partialResult any, _ int) error {
+	return nil
+}
+
+// distinctState rebuilds the state of group y of chunk x from its distinct
+// (y, x) pairs, encoded as [len][raw y][len][raw x] by batchFillArgs.
+func (exec *regrExec) distinctState(x int, y uint16) (regrState, error) {
+	var s regrState
+	err := exec.state[x].iter(y, func(k []byte) error {
+		k = k[kAggArgPrefixSz:]
+		ly := binary.BigEndian.Uint32(k)
+		rawY := k[4 : 4+ly]
+		rawX := k[4+ly+4:]
+		s.add(exec.y2f(rawY), exec.x2f(rawX))
+		return nil
+	})
+	return s, err
+}
+
+func (exec *regrExec) Flush() (_ []*vector.Vector, retErr error) {
+	vecs := make([]*vector.Vector, len(exec.state))
+	defer func() {
+		if retErr != nil {
+			for _, v := range vecs {
+				if v != nil {
+					v.Free(exec.mp)
+				}
+			}
+		}
+	}()
+
+	for i := range vecs {
+		vecs[i] = vector.NewOffHeapVecWithType(exec.retType)
+		if err := vecs[i].PreExtend(int(exec.state[i].length), exec.mp); err != nil {
+			return nil, err
+		}
+
+		var cols regrStateCols
+		if !exec.IsDistinct() {
+			cols = exec.cols(i)
+		}
+		for j := 0; j < int(exec.state[i].length); j++ {
+			var s regrState
+			if exec.IsDistinct() {
+				var err error
+				if s, err = exec.distinctState(i, uint16(j)); err != nil {
+					return nil, err
+				}
+			} else {
+				s = cols.get(uint16(j))
+			}
+
+			var err error
+			if exec.kind == regrCount {
+				err = vector.AppendFixed(vecs[i], s.n, false, exec.mp)
+			} else if r, ok := s.result(exec.kind); ok {
+				err = vector.AppendFixed(vecs[i], r, false, exec.mp)
+			} else {
+				err = vector.AppendNull(vecs[i], exec.mp)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return vecs, nil
+}
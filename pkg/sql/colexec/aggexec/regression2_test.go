@@ -0,0 +1,324 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func newRegrTestVecs(t *testing.T, mp *mpool.MPool, ys []float64, xs []int64, nulls []uint64) (*vector.Vector, *vector.Vector) {
+	yVec := vector.NewVec(types.T_float64.ToType())
+	xVec := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(yVec, ys, nil, mp))
+	require.NoError(t, vector.AppendFixedList(xVec, xs, nil, mp))
+	for _, row := range nulls {
+		xVec.GetNulls().Add(row)
+	}
+	return yVec, xVec
+}
+
+func flushRegr(t *testing.T, exec AggFuncExec) *vector.Vector {
+	vecs, err := exec.Flush()
+	require.NoError(t, err)
+	require.Len(t, vecs, 1)
+	return vecs[0]
+}
+
+func TestRegrStateResults(t *testing.T) {
+	// y = 2x + 1, with a perfect fit.
+	var line regrState
+	for x := 1.0; x <= 4; x++ {
+		line.add(2*x+1, x)
+	}
+	check := func(s *regrState, kind regrKind, expect float64) {
+		got, ok := s.result(kind)
+		require.True(t, ok)
+		require.InDelta(t, expect, got, 1e-12)
+	}
+	check(&line, regrCorr, 1)
+	check(&line, regrSlope, 2)
+	check(&line, regrIntercept, 1)
+	check(&line, regrR2, 1)
+	check(&line, regrAvgX, 2.5)
+	check(&line, regrAvgY, 6)
+	check(&line, regrSXX, 5)
+	check(&line, regrSYY, 20)
+	check(&line, regrSXY, 10)
+	check(&line, regrCovarPop, 2.5)
+	check(&line, regrCovarSample, 10.0/3)
+
+	// a constant x leaves the slope undefined.
+	var flat regrState
+	flat.add(1, 3)
+	flat.add(2, 3)
+	_, ok := flat.result(regrSlope)
+	require.False(t, ok)
+	_, ok = flat.result(regrCorr)
+	require.False(t, ok)
+
+	// a constant y is fitted perfectly.
+	var horizontal regrState
+	horizontal.add(5, 1)
+	horizontal.add(5, 2)
+	check(&horizontal, regrR2, 1)
+	check(&horizontal, regrSlope, 0)
+
+	var empty regrState
+	_, ok = empty.result(regrCovarPop)
+	require.False(t, ok)
+	var single regrState
+	single.add(1, 1)
+	_, ok = single.result(regrCovarSample)
+	require.False(t, ok)
+	check(&single, regrCovarPop, 0)
+}
+
+func TestRegrStateMergeMatchesSinglePass(t *testing.T) {
+	ys := []float64{1e9 + 3, 1e9 + 1, 1e9 + 4, 1e9 + 1, 1e9 + 5, 1e9 + 9}
+	xs := []float64{2, 7, 1, 8, 2, 8}
+
+	var all, left, right regrState
+	for i := range ys {
+		all.add(ys[i], xs[i])
+		if i < 2 {
+			left.add(ys[i], xs[i])
+		} else {
+			right.add(ys[i], xs[i])
+		}
+	}
+	var merged regrState
+	merged.merge(left)
+	merged.merge(regrState{})
+	merged.merge(right)
+
+	require.Equal(t, all.n, merged.n)
+	require.InDelta(t, all.sx, merged.sx, 1e-9)
+	require.InDelta(t, all.sy, merged.sy, 1e-3)
+	require.InDelta(t, all.sxx, merged.sxx, 1e-9)
+	require.InDelta(t, all.syy, merged.syy, 1e-6)
+	require.InDelta(t, all.sxy, merged.sxy, 1e-6)
+	// the deviations do not suffer from the large offset of y.
+	require.InDelta(t, 269.0/6, all.syy, 1e-6)
+}
+
+func TestRegrExecFillAndFlush(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	// group 1 holds y = 2x + 1, group 2 only has a row with a NULL x.
+	yVec, xVec := newRegrTestVecs(t, mp, []float64{3, 5, 7, 100}, []int64{1, 2, 3, 0}, []uint64{3})
+	defer yVec.Free(mp)
+	defer xVec.Free(mp)
+
+	params := []types.Type{types.T_float64.ToType(), types.T_int64.ToType()}
+	for _, tc := range []struct {
+		kind   regrKind
+		expect float64
+	}{
+		{regrSlope, 2},
+		{regrIntercept, 1},
+		{regrCorr, 1},
+		{regrCovarSample, 2},
+	} {
+		exec, err := makeRegrExec(mp, tc.kind, 0, false, params)
+		require.NoError(t, err)
+		require.NoError(t, exec.GroupGrow(2))
+		require.NoError(t, exec.BatchFill(0, []uint64{1, 1, 1, 2}, []*vector.Vector{yVec, xVec}))
+
+		res := flushRegr(t, exec)
+		require.Equal(t, types.T_float64, res.GetType().Oid)
+		require.InDelta(t, tc.expect, vector.GetFixedAtNoTypeCheck[float64](res, 0), 1e-12)
+		require.True(t, res.IsNull(1))
+		res.Free(mp)
+		exec.Free()
+	}
+
+	exec, err := makeRegrExec(mp, regrCount, 0, false, params)
+	require.NoError(t, err)
+	require.NoError(t, exec.GroupGrow(2))
+	require.NoError(t, exec.BatchFill(0, []uint64{1, 1, 1, 2}, []*vector.Vector{yVec, xVec}))
+	res := flushRegr(t, exec)
+	require.Equal(t, []int64{3, 0}, vector.MustFixedColNoTypeCheck[int64](res))
+	res.Free(mp)
+	exec.Free()
+
+	_, err = makeRegrExec(mp, regrCorr, 0, false, params[:1])
+	require.Error(t, err)
+	_, err = makeRegrExec(mp, regrCorr, 0, false, []types.Type{types.T_varchar.ToType(), types.T_int64.ToType()})
+	require.Error(t, err)
+}
+
+func TestRegrExecDecimalAndConst(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	yTyp := types.New(types.T_decimal64, 10, 2)
+	yVec := vector.NewVec(yTyp)
+	require.NoError(t, vector.AppendFixedList(yVec, []types.Decimal64{150, 250}, nil, mp))
+	defer yVec.Free(mp)
+	xVec, err := vector.NewConstFixed(types.T_int32.ToType(), int32(4), 2, mp)
+	require.NoError(t, err)
+	defer xVec.Free(mp)
+
+	exec, err := makeRegrExec(mp, regrAvgY, 0, false, []types.Type{yTyp, types.T_int32.ToType()})
+	require.NoError(t, err)
+	require.NoError(t, exec.GroupGrow(1))
+	require.NoError(t, exec.BulkFill(0, []*vector.Vector{yVec, xVec}))
+	res := flushRegr(t, exec)
+	require.InDelta(t, 2.0, vector.GetFixedAtNoTypeCheck[float64](res, 0), 1e-12)
+	res.Free(mp)
+	exec.Free()
+}
+
+func TestRegrExecMergeAndIntermediateRoundTrip(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	params := []types.Type{types.T_float64.ToType(), types.T_int64.ToType()}
+	yVec, xVec := newRegrTestVecs(t, mp, []float64{3, 5, 7, 9}, []int64{1, 2, 3, 4}, nil)
+	defer yVec.Free(mp)
+	defer xVec.Free(mp)
+
+	// two partial aggregations over halves of the rows, as on two CNs.
+	part1, err := makeRegrExec(mp, regrSlope, AggIdOfRegrSlope, false, params)
+	require.NoError(t, err)
+	require.NoError(t, part1.GroupGrow(1))
+	require.NoError(t, part1.BatchFill(0, []uint64{1, 1, GroupNotMatched, GroupNotMatched}, []*vector.Vector{yVec, xVec}))
+	part2, err := makeRegrExec(mp, regrSlope, AggIdOfRegrSlope, false, params)
+	require.NoError(t, err)
+	require.NoError(t, part2.GroupGrow(1))
+	require.NoError(t, part2.BatchFill(2, []uint64{1, 1}, []*vector.Vector{yVec, xVec}))
+
+	var buf bytes.Buffer
+	require.NoError(t, part2.SaveIntermediateResult(1, [][]uint8{{1}}, &buf))
+	restored, err := makeRegrExec(mp, regrSlope, AggIdOfRegrSlope, false, params)
+	require.NoError(t, err)
+	require.NoError(t, restored.UnmarshalFromReader(bytes.NewReader(buf.Bytes()), mp))
+
+	require.NoError(t, part1.Merge(restored, 0, 0))
+	res := flushRegr(t, part1)
+	require.InDelta(t, 2.0, vector.GetFixedAtNoTypeCheck[float64](res, 0), 1e-12)
+	res.Free(mp)
+	part1.Free()
+	part2.Free()
+	restored.Free()
+}
+
+func TestRegrExecDistinct(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	params := []types.Type{types.T_float64.ToType(), types.T_int64.ToType()}
+	// the duplicated (3, 1) pair only counts once.
+	yVec, xVec := newRegrTestVecs(t, mp, []float64{3, 3, 5, 7, 11}, []int64{1, 1, 2, 3, 0}, []uint64{4})
+	defer yVec.Free(mp)
+	defer xVec.Free(mp)
+
+	exec, err := makeRegrExec(mp, regrCount, 0, true, params)
+	require.NoError(t, err)
+	require.NoError(t, exec.GroupGrow(1))
+	require.NoError(t, exec.BulkFill(0, []*vector.Vector{yVec, xVec}))
+
+	other, err := makeRegrExec(mp, regrCount, 0, true, params)
+	require.NoError(t, err)
+	require.NoError(t, other.GroupGrow(1))
+	require.NoError(t, other.Fill(0, 2, []*vector.Vector{yVec, xVec}))
+	require.NoError(t, exec.BatchMerge(other, 0, []uint64{1}))
+
+	res := flushRegr(t, exec)
+	require.Equal(t, int64(3), vector.GetFixedAtNoTypeCheck[int64](res, 0))
+	res.Free(mp)
+	exec.Free()
+	other.Free()
+
+	exec, err = makeRegrExec(mp, regrCovarPop, 0, true, params)
+	require.NoError(t, err)
+	require.NoError(t, exec.GroupGrow(1))
+	require.NoError(t, exec.BulkFill(0, []*vector.Vector{yVec, xVec}))
+	res = flushRegr(t, exec)
+	require.InDelta(t, 4.0/3, vector.GetFixedAtNoTypeCheck[float64](res, 0), 1e-12)
+	res.Free(mp)
+	exec.Free()
+}
+
+func TestMakeAggOfRegrAndArrayAgg(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	RegisterCorr(AggIdOfCorr)
+	RegisterRegrCount(AggIdOfRegrCount)
+	RegisterArrayAgg(AggIdOfArrayAgg)
+
+	params := []types.Type{types.T_int64.ToType(), types.T_float32.ToType()}
+	exec, err := MakeAgg(mp, AggIdOfCorr, false, params...)
+	require.NoError(t, err)
+	_, ret := exec.TypesInfo()
+	require.Equal(t, types.T_float64, ret.Oid)
+	exec.Free()
+
+	exec, err = MakeAgg(mp, AggIdOfRegrCount, false, params...)
+	require.NoError(t, err)
+	_, ret = exec.TypesInfo()
+	require.Equal(t, types.T_int64, ret.Oid)
+	exec.Free()
+
+	_, err = MakeAgg(mp, AggIdOfCorr, false, params[0])
+	require.Error(t, err)
+
+	vec := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(vec, []int64{1, 2}, nil, mp))
+	require.NoError(t, vector.AppendNull(vec, mp))
+	defer vec.Free(mp)
+
+	exec, err = MakeAgg(mp, AggIdOfArrayAgg, false, types.T_int64.ToType())
+	require.NoError(t, err)
+	require.NoError(t, exec.GroupGrow(1))
+	require.NoError(t, exec.BulkFill(0, []*vector.Vector{vec}))
+	res := flushRegr(t, exec)
+	text, err := types.DecodeJson(res.GetBytesAt(0)).MarshalJSON()
+	require.NoError(t, err)
+	require.JSONEq(t, `[1, 2, null]`, string(text))
+	res.Free(mp)
+	exec.Free()
+}
+
+func TestRegrStateNaN(t *testing.T) {
+	var s regrState
+	s.add(math.NaN(), 1)
+	s.add(1, 2)
+	got, ok := s.result(regrAvgX)
+	require.True(t, ok)
+	require.Equal(t, 1.5, got)
+	got, ok = s.result(regrAvgY)
+	require.True(t, ok)
+	require.True(t, math.IsNaN(got))
+}
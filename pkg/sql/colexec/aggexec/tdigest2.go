@@ -0,0 +1,220 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"io"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/common/tdigest"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+type tdigestSketch struct {
+	*tdigest.TDigest
+}
+
+func makeTDigestSketch(_ *mpool.MPool) (MarshalerUnmarshaler, error) {
+	return &tdigestSketch{TDigest: tdigest.New(tdigest.DefaultCompression)}, nil
+}
+
+func (s *tdigestSketch) UnmarshalFromReader(r io.Reader) error {
+	bs, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalBinary(bs)
+}
+
+func (s *tdigestSketch) mergeBytes(data []byte) error {
+	other := tdigest.New(tdigest.DefaultCompression)
+	if err := other.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	s.Merge(other)
+	return nil
+}
+
+// tdigestKind selects what the t-digest executor reads and returns.
+type tdigestKind int
+
+const (
+	// approx_percentile(x, p) estimates the percentile p of x.
+	tdigestApproxPercentile tdigestKind = iota
+	// tdigest_add_agg(x) returns the sketch of x.
+	tdigestAdd
+	// tdigest_merge_agg(sketch) returns the union of the sketches.
+	tdigestMerge
+)
+
+// tdigestExec keeps a t-digest per group. Its state serializes with the
+// sketch, so partial results merge across nodes, and the sketch returned
+// by tdigest_add_agg can be stored and merged again later, the same way as
+// the HLL sketches.
+type tdigestExec struct {
+	aggExec
+	percentileConfig
+	kind tdigestKind
+}
+
+func makeTDigestExec(mp *mpool.MPool, kind tdigestKind, aggID int64, param types.Type) AggFuncExec {
+	retType := types.T_varbinary.ToType()
+	if kind == tdigestApproxPercentile {
+		retType = types.T_float64.ToType()
+	}
+	exec := &tdigestExec{kind: kind}
+	exec.mp = mp
+	exec.aggInfo = aggInfo{
+		aggId:                    aggID,
+		isDistinct:               false,
+		argTypes:                 []types.Type{param},
+		retType:                  retType,
+		emptyNull:                false,
+		saveArg:                  false,
+		makeMarshalerUnmarshaler: makeTDigestSketch,
+	}
+	return exec
+}
+
+func (exec *tdigestExec) GroupGrow(more int) error {
+	start := exec.GetNumGroups()
+	if err := exec.aggExec.GroupGrow(more); err != nil {
+		return err
+	}
+	for i := start; i < start+more; i++ {
+		x, y := exec.getXY(uint64(i))
+		if exec.state[x].mobs[y] == nil {
+			exec.state[x].mobs[y], _ = makeTDigestSketch(exec.mp)
+		}
+	}
+	return nil
+}
+
+func (exec *tdigestExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	return exec.BatchFill(row, []uint64{uint64(groupIndex + 1)}, vectors)
+}
+
+func (exec *tdigestExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	return exec.BatchFill(0, slices.Repeat([]uint64{uint64(groupIndex + 1)}, vectors[0].Length()), vectors)
+}
+
+func (exec *tdigestExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	for i, grp := range groups {
+		if grp == GroupNotMatched {
+			continue
+		}
+		idx := offset + i
+		if vectors[0].IsConst() {
+			idx = 0
+		}
+		if vectors[0].IsNull(uint64(idx)) {
+			continue
+		}
+		x, y := exec.getXY(grp - 1)
+		sketch := exec.state[x].mobs[y].(*tdigestSketch)
+		if exec.kind == tdigestMerge {
+			if err := sketch.mergeBytes(vectors[0].GetBytesAt(idx)); err != nil {
+				return err
+			}
+			continue
+		}
+		sketch.Add(types.DecodeFixed[float64](vectors[0].GetRawBytesAt(idx)))
+	}
+	return nil
+}
+
+func (exec *tdigestExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	return exec.BatchMerge(next, groupIdx2, []uint64{uint64(groupIdx1 + 1)})
+}
+
+func (exec *tdigestExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*tdigestExec)
+	for i, grp := range groups {
+		if grp == GroupNotMatched {
+			continue
+		}
+		x1, y1 := exec.getXY(grp - 1)
+		x2, y2 := other.getXY(uint64(offset + i))
+		exec.state[x1].mobs[y1].(*tdigestSketch).Merge(other.state[x2].mobs[y2].(*tdigestSketch).TDigest)
+	}
+	return nil
+}
+
+func (exec *tdigestExec) SetExtraInformation(partialResult any, _ int) error {
+	if exec.kind != tdigestApproxPercentile {
+		return nil
+	}
+	return exec.setFrom(partialResult)
+}
+
+func (exec *tdigestExec) Flush() (_ []*vector.Vector, retErr error) {
+	if exec.kind == tdigestApproxPercentile {
+		if err := exec.check("approx_percentile"); err != nil {
+			return nil, err
+		}
+	}
+
+	vecs := make([]*vector.Vector, len(exec.state))
+	defer func() {
+		if retErr != nil {
+			for _, v := range vecs {
+				if v != nil {
+					v.Free(exec.mp)
+				}
+			}
+		}
+	}()
+	for i, st := range exec.state {
+		vecs[i] = vector.NewOffHeapVecWithType(exec.retType)
+		if err := vecs[i].PreExtend(int(st.length), exec.mp); err != nil {
+			return nil, err
+		}
+		for j := 0; j < int(st.length); j++ {
+			sketch := st.mobs[j].(*tdigestSketch)
+			var err error
+			if exec.kind == tdigestApproxPercentile {
+				if sketch.Count() == 0 {
+					err = vector.AppendNull(vecs[i], exec.mp)
+				} else {
+					err = vector.AppendFixed(vecs[i], sketch.Quantile(exec.fraction), false, exec.mp)
+				}
+			} else {
+				var bs []byte
+				if bs, err = sketch.MarshalBinary(); err == nil {
+					err = vector.AppendBytes(vecs[i], bs, false, exec.mp)
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return vecs, nil
+}
+
+func (exec *tdigestExec) Size() int64 {
+	var size int64
+	for _, st := range exec.state {
+		size += int64(cap(st.mobs)) * 8
+		for _, mob := range st.mobs {
+			if mob != nil {
+				size += int64(mob.(*tdigestSketch).Size())
+			}
+		}
+	}
+	return size
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/common/tdigest"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func newFloat64TestVec(t *testing.T, mp *mpool.MPool, from, to int) *vector.Vector {
+	vec := vector.NewVec(types.T_float64.ToType())
+	for i := from; i < to; i++ {
+		require.NoError(t, vector.AppendFixed(vec, float64(i), false, mp))
+	}
+	return vec
+}
+
+func TestApproxPercentileExec(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	vec := newFloat64TestVec(t, mp, 0, 1001)
+	require.NoError(t, vector.AppendNull(vec, mp))
+	defer vec.Free(mp)
+
+	exec := makeTDigestExec(mp, tdigestApproxPercentile, AggIdOfApproxPercentile, types.T_float64.ToType())
+	require.NoError(t, exec.SetExtraInformation(EncodePercentileConfig(0.95, false), 0))
+	require.NoError(t, exec.GroupGrow(2))
+	require.NoError(t, exec.BulkFill(0, []*vector.Vector{vec}))
+	require.Greater(t, exec.Size(), int64(0))
+
+	res := flushRegr(t, exec)
+	require.Equal(t, types.T_float64, res.GetType().Oid)
+	require.InDelta(t, 950, vector.GetFixedAtNoTypeCheck[float64](res, 0), 5)
+	require.True(t, res.IsNull(1))
+	res.Free(mp)
+	exec.Free()
+
+	exec = makeTDigestExec(mp, tdigestApproxPercentile, AggIdOfApproxPercentile, types.T_float64.ToType())
+	require.NoError(t, exec.GroupGrow(1))
+	_, err := exec.Flush()
+	require.Error(t, err)
+	exec.Free()
+}
+
+func TestApproxPercentileMergeAcrossIntermediateResults(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	vec1 := newFloat64TestVec(t, mp, 0, 500)
+	defer vec1.Free(mp)
+	vec2 := newFloat64TestVec(t, mp, 500, 1001)
+	defer vec2.Free(mp)
+
+	cfg := EncodePercentileConfig(0.5, false)
+	part1 := makeTDigestExec(mp, tdigestApproxPercentile, AggIdOfApproxPercentile, types.T_float64.ToType())
+	require.NoError(t, part1.SetExtraInformation(cfg, 0))
+	require.NoError(t, part1.GroupGrow(1))
+	require.NoError(t, part1.BulkFill(0, []*vector.Vector{vec1}))
+	part2 := makeTDigestExec(mp, tdigestApproxPercentile, AggIdOfApproxPercentile, types.T_float64.ToType())
+	require.NoError(t, part2.GroupGrow(1))
+	require.NoError(t, part2.BulkFill(0, []*vector.Vector{vec2}))
+
+	var buf bytes.Buffer
+	require.NoError(t, part2.SaveIntermediateResult(1, [][]uint8{{1}}, &buf))
+	restored := makeTDigestExec(mp, tdigestApproxPercentile, AggIdOfApproxPercentile, types.T_float64.ToType())
+	require.NoError(t, restored.UnmarshalFromReader(bytes.NewReader(buf.Bytes()), mp))
+
+	require.NoError(t, part1.Merge(restored, 0, 0))
+	res := flushRegr(t, part1)
+	require.InDelta(t, 500, vector.GetFixedAtNoTypeCheck[float64](res, 0), 5)
+	res.Free(mp)
+	part1.Free()
+	part2.Free()
+	restored.Free()
+}
+
+func TestTDigestAddAndMergeAgg(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	vec := newFloat64TestVec(t, mp, 1, 101)
+	defer vec.Free(mp)
+
+	// one sketch per group, as stored in a table.
+	add := makeTDigestExec(mp, tdigestAdd, AggIdOfTDigestAdd, types.T_float64.ToType())
+	require.NoError(t, add.GroupGrow(3))
+	groups := make([]uint64, vec.Length())
+	for i := range groups {
+		groups[i] = uint64(i%2 + 1)
+	}
+	require.NoError(t, add.BatchFill(0, groups, []*vector.Vector{vec}))
+	sketches := flushRegr(t, add)
+	require.Equal(t, types.T_varbinary, sketches.GetType().Oid)
+	require.False(t, sketches.IsNull(2))
+	add.Free()
+	defer sketches.Free(mp)
+
+	// the empty sketch of the third group merges as a no-op.
+	merge := makeTDigestExec(mp, tdigestMerge, AggIdOfTDigestMerge, types.T_varbinary.ToType())
+	require.NoError(t, merge.GroupGrow(1))
+	require.NoError(t, merge.BulkFill(0, []*vector.Vector{sketches}))
+	res := flushRegr(t, merge)
+
+	td := tdigest.New(tdigest.DefaultCompression)
+	require.NoError(t, td.UnmarshalBinary(res.GetBytesAt(0)))
+	require.Equal(t, float64(100), td.Count())
+	require.InDelta(t, 50.5, td.Quantile(0.5), 1)
+	require.Equal(t, float64(1), td.Quantile(0))
+	require.Equal(t, float64(100), td.Quantile(1))
+	res.Free(mp)
+	merge.Free()
+
+	bad := vector.NewVec(types.T_varbinary.ToType())
+	require.NoError(t, vector.AppendBytes(bad, []byte("not a sketch"), false, mp))
+	defer bad.Free(mp)
+	merge = makeTDigestExec(mp, tdigestMerge, AggIdOfTDigestMerge, types.T_varbinary.ToType())
+	require.NoError(t, merge.GroupGrow(1))
+	require.Error(t, merge.Fill(0, 0, []*vector.Vector{bad}))
+	merge.Free()
+}
+
+func TestMakeAggOfTDigest(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	RegisterApproxPercentile(AggIdOfApproxPercentile)
+	RegisterTDigestAddAgg(AggIdOfTDigestAdd)
+	RegisterTDigestMergeAgg(AggIdOfTDigestMerge)
+
+	for id, oid := range map[int64]types.T{
+		AggIdOfApproxPercentile: types.T_float64,
+		AggIdOfTDigestAdd:       types.T_varbinary,
+		AggIdOfTDigestMerge:     types.T_varbinary,
+	} {
+		exec, err := MakeAgg(mp, id, false, types.T_float64.ToType())
+		require.NoError(t, err)
+		_, ret := exec.TypesInfo()
+		require.Equal(t, oid, ret.Oid)
+		exec.Free()
+	}
+}
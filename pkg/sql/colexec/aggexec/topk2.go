@@ -0,0 +1,425 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const (
+	// MaxApproxTopK is the largest k accepted by approx_top_k.
+	MaxApproxTopK = 10000
+
+	// the sketch keeps more counters than k to make the top k more accurate.
+	topKCapacityFactor = 3
+	topKMinCapacity    = 64
+)
+
+func EncodeTopKConfig(k int64) []byte {
+	return binary.LittleEndian.AppendUint64(nil, uint64(k))
+}
+
+func decodeTopKConfig(cfg []byte) (int64, error) {
+	if len(cfg) != 8 {
+		return 0, moerr.NewInternalErrorNoCtx("invalid approx_top_k config")
+	}
+	k := int64(binary.LittleEndian.Uint64(cfg))
+	if k < 1 || k > MaxApproxTopK {
+		return 0, moerr.NewInvalidInputNoCtxf("approx_top_k k %d is not between 1 and %d", k, MaxApproxTopK)
+	}
+	return k, nil
+}
+
+type topKCounter struct {
+	key   string
+	count int64
+	// err is the largest over-estimation of count.
+	err   int64
+	index int
+}
+
+// topKHeap is a min-heap of the counters by count.
+type topKHeap []*topKCounter
+
+func (h topKHeap) Len() int           { return len(h) }
+func (h topKHeap) Less(i, j int) bool { return h[i].count < h[j].count }
+func (h topKHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *topKHeap) Push(x any) {
+	c := x.(*topKCounter)
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+
+func (h *topKHeap) Pop() any {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
+
+// spaceSaving is the Space-Saving sketch of Metwally et al. with at most
+// capacity counters. A value not in a full sketch replaces the counter with
+// the smallest count and inherits that count as its error.
+//
+// Two sketches merge with the rule of Agarwal et al.: a value missing from a
+// full sketch is counted with the smallest count of that sketch, and only the
+// capacity largest counters of the union are kept.
+type spaceSaving struct {
+	capacity int
+	counters map[string]*topKCounter
+	heap     topKHeap
+	size     int64
+}
+
+func makeSpaceSaving(_ *mpool.MPool) (MarshalerUnmarshaler, error) {
+	return &spaceSaving{counters: make(map[string]*topKCounter)}, nil
+}
+
+func (s *spaceSaving) full() bool {
+	return s.capacity > 0 && len(s.heap) >= s.capacity
+}
+
+func (s *spaceSaving) add(key string, n int64) {
+	if c, ok := s.counters[key]; ok {
+		c.count += n
+		heap.Fix(&s.heap, c.index)
+		return
+	}
+	if !s.full() {
+		c := &topKCounter{key: key, count: n}
+		s.counters[key] = c
+		heap.Push(&s.heap, c)
+		s.size += int64(len(key)) + 48
+		return
+	}
+	c := s.heap[0]
+	delete(s.counters, c.key)
+	s.size += int64(len(key) - len(c.key))
+	c.key, c.err = key, c.count
+	c.count += n
+	s.counters[key] = c
+	heap.Fix(&s.heap, 0)
+}
+
+func (s *spaceSaving) minCount() int64 {
+	if !s.full() {
+		return 0
+	}
+	return s.heap[0].count
+}
+
+func (s *spaceSaving) merge(other *spaceSaving) {
+	if s.capacity == 0 {
+		s.capacity = other.capacity
+	}
+	m1, m2 := s.minCount(), other.minCount()
+	union := make([]*topKCounter, 0, len(s.heap)+len(other.heap))
+	for _, c := range s.heap {
+		if o, ok := other.counters[c.key]; ok {
+			union = append(union, &topKCounter{key: c.key, count: c.count + o.count, err: c.err + o.err})
+		} else {
+			union = append(union, &topKCounter{key: c.key, count: c.count + m2, err: c.err + m2})
+		}
+	}
+	for _, o := range other.heap {
+		if _, ok := s.counters[o.key]; !ok {
+			union = append(union, &topKCounter{key: o.key, count: o.count + m1, err: o.err + m1})
+		}
+	}
+	if s.capacity > 0 && len(union) > s.capacity {
+		sortTopKCounters(union)
+		union = union[:s.capacity]
+	}
+	s.reset(union)
+}
+
+func (s *spaceSaving) reset(counters []*topKCounter) {
+	s.counters = make(map[string]*topKCounter, len(counters))
+	s.heap = s.heap[:0]
+	s.size = 0
+	for _, c := range counters {
+		s.counters[c.key] = c
+		c.index = len(s.heap)
+		s.heap = append(s.heap, c)
+		s.size += int64(len(c.key)) + 48
+	}
+	heap.Init(&s.heap)
+}
+
+// sortTopKCounters sorts the counters by count desc, and by value for the
+// same count to keep the result deterministic.
+func sortTopKCounters(counters []*topKCounter) {
+	slices.SortFunc(counters, func(a, b *topKCounter) int {
+		if a.count != b.count {
+			if a.count > b.count {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.key, b.key)
+	})
+}
+
+// MarshalBinary encodes the capacity and the number of counters followed by
+// the value, count and error of each counter.
+func (s *spaceSaving) MarshalBinary() ([]byte, error) {
+	buf := binary.AppendUvarint(make([]byte, 0, s.size+16), uint64(s.capacity))
+	buf = binary.AppendUvarint(buf, uint64(len(s.heap)))
+	for _, c := range s.heap {
+		buf = binary.AppendUvarint(buf, uint64(len(c.key)))
+		buf = append(buf, c.key...)
+		buf = binary.AppendVarint(buf, c.count)
+		buf = binary.AppendVarint(buf, c.err)
+	}
+	return buf, nil
+}
+
+func (s *spaceSaving) UnmarshalBinary(data []byte) error {
+	invalid := moerr.NewInternalErrorNoCtx("invalid approx_top_k state")
+	capacity, m := binary.Uvarint(data)
+	if m <= 0 {
+		return invalid
+	}
+	data = data[m:]
+	cnt, m := binary.Uvarint(data)
+	if m <= 0 {
+		return invalid
+	}
+	data = data[m:]
+	counters := make([]*topKCounter, 0, cnt)
+	for i := uint64(0); i < cnt; i++ {
+		l, m := binary.Uvarint(data)
+		if m <= 0 || uint64(len(data)-m) < l {
+			return invalid
+		}
+		c := &topKCounter{key: string(data[m : m+int(l)])}
+		data = data[m+int(l):]
+		if c.count, m = binary.Varint(data); m <= 0 {
+			return invalid
+		}
+		data = data[m:]
+		if c.err, m = binary.Varint(data); m <= 0 {
+			return invalid
+		}
+		data = data[m:]
+		counters = append(counters, c)
+	}
+	s.capacity = int(capacity)
+	s.reset(counters)
+	return nil
+}
+
+func (s *spaceSaving) UnmarshalFromReader(r io.Reader) error {
+	bs, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return s.UnmarshalBinary(bs)
+}
+
+// approxTopKExec is the executor of approx_top_k(x, k), which returns the k
+// most frequent non-NULL values of x with their estimated counts as a json
+// array of {"value": x, "count": n} ordered by count. Each group keeps a
+// space-saving sketch in its state, so partial results merge across nodes.
+type approxTopKExec struct {
+	aggExec
+	k int64
+}
+
+func makeApproxTopKExec(mp *mpool.MPool, aggID int64, param types.Type) AggFuncExec {
+	exec := &approxTopKExec{}
+	exec.mp = mp
+	exec.aggInfo = aggInfo{
+		aggId:                    aggID,
+		isDistinct:               false,
+		argTypes:                 []types.Type{param},
+		retType:                  types.T_json.ToType(),
+		emptyNull:                true,
+		saveArg:                  false,
+		makeMarshalerUnmarshaler: makeSpaceSaving,
+	}
+	return exec
+}
+
+func (exec *approxTopKExec) capacity() int {
+	return max(int(exec.k)*topKCapacityFactor, topKMinCapacity)
+}
+
+func (exec *approxTopKExec) GroupGrow(more int) error {
+	start := exec.GetNumGroups()
+	if err := exec.aggExec.GroupGrow(more); err != nil {
+		return err
+	}
+	for i := start; i < start+more; i++ {
+		x, y := exec.getXY(uint64(i))
+		if exec.state[x].mobs[y] == nil {
+			exec.state[x].mobs[y], _ = makeSpaceSaving(exec.mp)
+		}
+	}
+	return nil
+}
+
+func (exec *approxTopKExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	return exec.BatchFill(row, []uint64{uint64(groupIndex + 1)}, vectors)
+}
+
+func (exec *approxTopKExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	return exec.BatchFill(0, slices.Repeat([]uint64{uint64(groupIndex + 1)}, vectors[0].Length()), vectors)
+}
+
+func (exec *approxTopKExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	if exec.k == 0 {
+		return moerr.NewInternalErrorNoCtx("approx_top_k: k is not set")
+	}
+	for i, grp := range groups {
+		if grp == GroupNotMatched {
+			continue
+		}
+		idx := offset + i
+		if vectors[0].IsConst() {
+			idx = 0
+		}
+		if vectors[0].IsNull(uint64(idx)) {
+			continue
+		}
+		bj, err := buildValueByteJson(vectors[0], uint64(idx))
+		if err != nil {
+			return err
+		}
+		key, err := bj.Marshal()
+		if err != nil {
+			return err
+		}
+		x, y := exec.getXY(grp - 1)
+		sketch := exec.state[x].mobs[y].(*spaceSaving)
+		if sketch.capacity == 0 {
+			sketch.capacity = exec.capacity()
+		}
+		sketch.add(string(key), 1)
+	}
+	return nil
+}
+
+func (exec *approxTopKExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	return exec.BatchMerge(next, groupIdx2, []uint64{uint64(groupIdx1 + 1)})
+}
+
+func (exec *approxTopKExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*approxTopKExec)
+	for i, grp := range groups {
+		if grp == GroupNotMatched {
+			continue
+		}
+		x1, y1 := exec.getXY(grp - 1)
+		x2, y2 := other.getXY(uint64(offset + i))
+		exec.state[x1].mobs[y1].(*spaceSaving).merge(other.state[x2].mobs[y2].(*spaceSaving))
+	}
+	return nil
+}
+
+func (exec *approxTopKExec) SetExtraInformation(partialResult any, _ int) error {
+	cfg, ok := partialResult.([]byte)
+	if !ok {
+		return nil
+	}
+	k, err := decodeTopKConfig(cfg)
+	if err != nil {
+		return err
+	}
+	exec.k = k
+	return nil
+}
+
+func (exec *approxTopKExec) Flush() (_ []*vector.Vector, retErr error) {
+	if exec.k == 0 {
+		return nil, moerr.NewInternalErrorNoCtx("approx_top_k: k is not set")
+	}
+
+	vecs := make([]*vector.Vector, len(exec.state))
+	defer func() {
+		if retErr != nil {
+			for _, v := range vecs {
+				if v != nil {
+					v.Free(exec.mp)
+				}
+			}
+		}
+	}()
+	for i, st := range exec.state {
+		vecs[i] = vector.NewOffHeapVecWithType(exec.retType)
+		if err := vecs[i].PreExtend(int(st.length), exec.mp); err != nil {
+			return nil, err
+		}
+		for j := 0; j < int(st.length); j++ {
+			sketch := st.mobs[j].(*spaceSaving)
+			if len(sketch.heap) == 0 {
+				if err := vector.AppendNull(vecs[i], exec.mp); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			counters := slices.Clone(sketch.heap)
+			sortTopKCounters(counters)
+			counters = counters[:min(len(counters), int(exec.k))]
+			arr := make([]any, len(counters))
+			for n, c := range counters {
+				arr[n] = map[string]any{
+					"value": types.DecodeJson([]byte(c.key)),
+					"count": c.count,
+				}
+			}
+			bj, err := bytejson.CreateByteJSONWithCheck(arr)
+			if err != nil {
+				return nil, err
+			}
+			bs, err := bj.Marshal()
+			if err != nil {
+				return nil, err
+			}
+			if err := vector.AppendBytes(vecs[i], bs, false, exec.mp); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return vecs, nil
+}
+
+func (exec *approxTopKExec) Size() int64 {
+	var size int64
+	for _, st := range exec.state {
+		size += int64(cap(st.mobs)) * 8
+		for _, mob := range st.mobs {
+			if mob != nil {
+				size += mob.(*spaceSaving).size
+			}
+		}
+	}
+	return size
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpaceSaving(t *testing.T) {
+	s := &spaceSaving{capacity: 3, counters: make(map[string]*topKCounter)}
+	for _, k := range []string{"a", "a", "a", "b", "b", "c", "d"} {
+		s.add(k, 1)
+	}
+	// d replaced c, the counter with the smallest count, and inherits it.
+	require.Len(t, s.heap, 3)
+	require.NotContains(t, s.counters, "c")
+	require.Equal(t, int64(2), s.counters["d"].count)
+	require.Equal(t, int64(1), s.counters["d"].err)
+	require.Equal(t, int64(2), s.minCount())
+
+	bs, err := s.MarshalBinary()
+	require.NoError(t, err)
+	r := &spaceSaving{}
+	require.NoError(t, r.UnmarshalBinary(bs))
+	require.Equal(t, 3, r.capacity)
+	require.Equal(t, int64(3), r.counters["a"].count)
+	require.Equal(t, int64(1), r.counters["d"].err)
+	require.Error(t, r.UnmarshalBinary(bs[:len(bs)-1]))
+
+	// e is missing from the full s, so it is counted with the min of s.
+	o := &spaceSaving{capacity: 3, counters: make(map[string]*topKCounter)}
+	o.add("a", 1)
+	o.add("e", 4)
+	s.merge(o)
+	require.Len(t, s.heap, 3)
+	require.Equal(t, int64(4), s.counters["a"].count)
+	require.Equal(t, int64(6), s.counters["e"].count)
+	require.Equal(t, int64(2), s.counters["e"].err)
+	require.NotContains(t, s.counters, "d")
+}
+
+func TestApproxTopKExec(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	// i appears i times for i in [1, 50], and a NULL, the sketch of k = 3
+	// keeps all of them so the counts are exact.
+	vec := vector.NewVec(types.T_int64.ToType())
+	for i := int64(1); i <= 50; i++ {
+		for j := int64(0); j < i; j++ {
+			require.NoError(t, vector.AppendFixed(vec, i, false, mp))
+		}
+	}
+	require.NoError(t, vector.AppendNull(vec, mp))
+	defer vec.Free(mp)
+
+	exec := makeApproxTopKExec(mp, AggIdOfApproxTopK, types.T_int64.ToType())
+	require.NoError(t, exec.SetExtraInformation(EncodeTopKConfig(3), 0))
+	require.NoError(t, exec.GroupGrow(2))
+	require.NoError(t, exec.BulkFill(0, []*vector.Vector{vec}))
+	require.Greater(t, exec.Size(), int64(0))
+
+	res := flushRegr(t, exec)
+	require.Equal(t, types.T_json, res.GetType().Oid)
+	require.Equal(t,
+		`[{"count": 50, "value": 50}, {"count": 49, "value": 49}, {"count": 48, "value": 48}]`,
+		types.DecodeJson(res.GetBytesAt(0)).String())
+	require.True(t, res.IsNull(1))
+	res.Free(mp)
+	exec.Free()
+
+	exec = makeApproxTopKExec(mp, AggIdOfApproxTopK, types.T_int64.ToType())
+	require.Error(t, exec.SetExtraInformation(EncodeTopKConfig(0), 0))
+	require.Error(t, exec.SetExtraInformation(EncodeTopKConfig(MaxApproxTopK+1), 0))
+	require.NoError(t, exec.GroupGrow(1))
+	require.Error(t, exec.Fill(0, 0, []*vector.Vector{vec}))
+	_, err := exec.Flush()
+	require.Error(t, err)
+	exec.Free()
+}
+
+func TestApproxTopKMergeAcrossIntermediateResults(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	newVec := func(counts map[string]int) *vector.Vector {
+		vec := vector.NewVec(types.T_varchar.ToType())
+		for v, n := range counts {
+			for i := 0; i < n; i++ {
+				require.NoError(t, vector.AppendBytes(vec, []byte(v), false, mp))
+			}
+		}
+		return vec
+	}
+	// many distinct values overflow the sketch of part2.
+	counts := map[string]int{"hot": 50}
+	for i := 0; i < 200; i++ {
+		counts[fmt.Sprintf("cold-%d", i)] = 1
+	}
+	vec1 := newVec(map[string]int{"hot": 10, "warm": 30})
+	defer vec1.Free(mp)
+	vec2 := newVec(counts)
+	defer vec2.Free(mp)
+
+	cfg := EncodeTopKConfig(2)
+	part1 := makeApproxTopKExec(mp, AggIdOfApproxTopK, types.T_varchar.ToType())
+	require.NoError(t, part1.SetExtraInformation(cfg, 0))
+	require.NoError(t, part1.GroupGrow(1))
+	require.NoError(t, part1.BulkFill(0, []*vector.Vector{vec1}))
+	part2 := makeApproxTopKExec(mp, AggIdOfApproxTopK, types.T_varchar.ToType())
+	require.NoError(t, part2.SetExtraInformation(cfg, 0))
+	require.NoError(t, part2.GroupGrow(1))
+	require.NoError(t, part2.BulkFill(0, []*vector.Vector{vec2}))
+
+	var buf bytes.Buffer
+	require.NoError(t, part2.SaveIntermediateResult(1, [][]uint8{{1}}, &buf))
+	restored := makeApproxTopKExec(mp, AggIdOfApproxTopK, types.T_varchar.ToType())
+	require.NoError(t, restored.UnmarshalFromReader(bytes.NewReader(buf.Bytes()), mp))
+	require.Equal(t, topKMinCapacity, restored.(*approxTopKExec).state[0].mobs[0].(*spaceSaving).capacity)
+
+	require.NoError(t, part1.Merge(restored, 0, 0))
+	res := flushRegr(t, part1)
+	var top []map[string]any
+	require.NoError(t, json.Unmarshal([]byte(types.DecodeJson(res.GetBytesAt(0)).String()), &top))
+	require.Len(t, top, 2)
+	require.Equal(t, "hot", top[0]["value"])
+	require.Equal(t, "warm", top[1]["value"])
+	res.Free(mp)
+	part1.Free()
+	part2.Free()
+	restored.Free()
+}
+
+func TestMakeAggOfValueAndFrequency(t *testing.T) {
+	mp := mpool.MustNewZero()
+	defer func() {
+		require.Equal(t, int64(0), mp.CurrNB())
+	}()
+
+	RegisterMinBy(AggIdOfMinBy)
+	RegisterMaxBy(AggIdOfMaxBy)
+	RegisterMode(AggIdOfMode)
+	RegisterApproxTopK(AggIdOfApproxTopK)
+
+	for _, c := range []struct {
+		id     int64
+		params []types.Type
+		ret    types.T
+	}{
+		{AggIdOfMinBy, []types.Type{types.T_varchar.ToType(), types.T_date.ToType()}, types.T_varchar},
+		{AggIdOfMaxBy, []types.Type{types.T_float64.ToType(), types.T_uuid.ToType()}, types.T_float64},
+		{AggIdOfMode, []types.Type{types.T_decimal64.ToType()}, types.T_decimal64},
+		{AggIdOfApproxTopK, []types.Type{types.T_int32.ToType()}, types.T_json},
+	} {
+		exec, err := MakeAgg(mp, c.id, false, c.params...)
+		require.NoError(t, err)
+		_, ret := exec.TypesInfo()
+		require.Equal(t, c.ret, ret.Oid)
+		exec.Free()
+	}
+}
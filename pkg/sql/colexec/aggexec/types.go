@@ -220,6 +220,68 @@ func makeSpecialAggExec(
 		case AggIdOfJsonObjectAgg:
 			exec, err := makeJsonObjectAgg(mp, id, isDistinct, params)
 			return exec, true, err
+		case AggIdOfArrayAgg:
+			exec, err := makeJsonArrayAgg(mp, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfCorr:
+			exec, err := makeRegrExec(mp, regrCorr, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfCovarPop:
+			exec, err := makeRegrExec(mp, regrCovarPop, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfCovarSample:
+			exec, err := makeRegrExec(mp, regrCovarSample, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfRegrSlope:
+			exec, err := makeRegrExec(mp, regrSlope, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfRegrIntercept:
+			exec, err := makeRegrExec(mp, regrIntercept, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfRegrR2:
+			exec, err := makeRegrExec(mp, regrR2, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfRegrCount:
+			exec, err := makeRegrExec(mp, regrCount, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfRegrAvgX:
+			exec, err := makeRegrExec(mp, regrAvgX, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfRegrAvgY:
+			exec, err := makeRegrExec(mp, regrAvgY, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfRegrSXX:
+			exec, err := makeRegrExec(mp, regrSXX, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfRegrSYY:
+			exec, err := makeRegrExec(mp, regrSYY, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfRegrSXY:
+			exec, err := makeRegrExec(mp, regrSXY, id, isDistinct, params)
+			return exec, true, err
+		case AggIdOfPercentileCont:
+			exec, err := makePercentileExec(mp, false, id, isDistinct, params[0])
+			return exec, true, err
+		case AggIdOfPercentileDisc:
+			exec, err := makePercentileExec(mp, true, id, isDistinct, params[0])
+			return exec, true, err
+		case AggIdOfApproxPercentile:
+			return makeTDigestExec(mp, tdigestApproxPercentile, id, params[0]), true, nil
+		case AggIdOfTDigestAdd:
+			return makeTDigestExec(mp, tdigestAdd, id, params[0]), true, nil
+		case AggIdOfTDigestMerge:
+			return makeTDigestExec(mp, tdigestMerge, id, params[0]), true, nil
+		case AggIdOfMinBy:
+			exec, err := makeMinMaxByExec(mp, id, true, params)
+			return exec, true, err
+		case AggIdOfMaxBy:
+			exec, err := makeMinMaxByExec(mp, id, false, params)
+			return exec, true, err
+		case AggIdOfMode:
+			exec, err := makeModeExec(mp, id, params[0])
+			return exec, true, err
+		case AggIdOfApproxTopK:
+			return makeApproxTopKExec(mp, id, params[0]), true, nil
 		case AggIdOfAvgTwCache:
 			exec, err := makeAvgTwCacheExec(mp, id, params[0])
 			return exec, true, err
@@ -836,6 +836,12 @@ func generateConstExpressionExecutor(proc *process.Process, typ types.Type, con
 					return nil, err1
 				}
 				vec, err = vector.NewConstArray(typ, array, 1, proc.Mp())
+			} else if typ.Oid.IsQuantizedArray() {
+				array, err1 := types.StringToQuantizedArrayBytes(typ.Oid, sval)
+				if err1 != nil {
+					return nil, err1
+				}
+				vec, err = vector.NewConstBytes(typ, array, 1, proc.Mp())
 			} else if typ.Oid == types.T_datalink {
 				_, _, err1 := datalink.ParseDatalink(sval, proc)
 				if err1 != nil {
@@ -855,6 +861,8 @@ func generateConstExpressionExecutor(proc *process.Process, typ types.Type, con
 				vec, err = vector.NewConstArray(typ, types.BytesToArray[float32]([]byte(val.VecVal)), 1, proc.Mp())
 			} else if typ.Oid == types.T_array_float64 {
 				vec, err = vector.NewConstArray(typ, types.BytesToArray[float64]([]byte(val.VecVal)), 1, proc.Mp())
+			} else if typ.Oid.IsQuantizedArray() {
+				vec, err = vector.NewConstBytes(typ, []byte(val.VecVal), 1, proc.Mp())
 			}
 		default:
 			return nil, moerr.NewNYI(proc.Ctx, fmt.Sprintf("const expression %v", con.GetValue()))
@@ -0,0 +1,676 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/common/util"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+)
+
+var maxArrowBatchCnt int64 = 100000
+
+// arrowFileMagic starts (and ends) the Arrow IPC file format, a.k.a. Feather v2.
+// Anything else is read as an Arrow IPC stream.
+const arrowFileMagic = "ARROW1"
+
+// arrowMapper appends rows [start, end) of an Arrow array to vec.
+type arrowMapper func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error
+
+// ArrowHandler reads the record batches of one Arrow IPC file or stream.
+type ArrowHandler struct {
+	// next returns the next record batch, owned by the caller, or io.EOF
+	next    func() (arrow.Record, error)
+	release func()
+	schema  *arrow.Schema
+
+	record   arrow.Record
+	offset   int64
+	batchCnt int64
+
+	// indexed by param.Cols position, -1 / nil for columns not in the file
+	fields  []int
+	mappers []arrowMapper
+
+	filepathColIndex int
+	hasPhysicalCol   bool
+	// zonemappable is true if every column of the filter is read from the file
+	zonemappable bool
+	// Arrow IPC carries no statistics, so the zonemaps of the current record
+	// batch slice are computed from the filter columns before the other
+	// columns are decoded.
+	metas columnMetas
+}
+
+func newArrowHandler(param *ExternalParam) (*ArrowHandler, error) {
+	h := &ArrowHandler{
+		batchCnt:         maxArrowBatchCnt,
+		filepathColIndex: -1,
+	}
+	if err := h.openFile(param); err != nil {
+		return nil, err
+	}
+	if err := h.prepare(param); err != nil {
+		h.close()
+		return nil, err
+	}
+	if err := h.nextRecord(param.Ctx); err != nil {
+		h.close()
+		return nil, err
+	}
+	// Caller treats (nil, nil) as "empty file, advance to next".
+	if h.record == nil {
+		h.close()
+		return nil, nil
+	}
+	return h, nil
+}
+
+func (h *ArrowHandler) openFile(param *ExternalParam) error {
+	r, fileSize, err := openFileReaderAt(param)
+	if err != nil {
+		return err
+	}
+
+	sr := io.NewSectionReader(r, 0, fileSize)
+	magic := make([]byte, len(arrowFileMagic))
+	if n, _ := sr.ReadAt(magic, 0); n == len(magic) && string(magic) == arrowFileMagic {
+		fr, err := ipc.NewFileReader(sr)
+		if err != nil {
+			return moerr.ConvertGoError(param.Ctx, err)
+		}
+		i := 0
+		h.schema = fr.Schema()
+		h.next = func() (arrow.Record, error) {
+			if i >= fr.NumRecords() {
+				return nil, io.EOF
+			}
+			i++
+			return fr.RecordAt(i - 1)
+		}
+		h.release = func() { _ = fr.Close() }
+		return nil
+	}
+
+	rr, err := ipc.NewReader(bufio.NewReaderSize(sr, 1<<20))
+	if err != nil {
+		return moerr.ConvertGoError(param.Ctx, err)
+	}
+	h.schema = rr.Schema()
+	h.next = func() (arrow.Record, error) {
+		rec, err := rr.Read()
+		if err != nil {
+			return nil, err
+		}
+		rec.Retain()
+		return rec, nil
+	}
+	h.release = rr.Release
+	return nil
+}
+
+// findField finds a field of the Arrow schema with case-insensitive matching,
+// the same way as parquetColumnLookup.find.
+func (h *ArrowHandler) findField(ctx context.Context, name string) (int, error) {
+	exact, found := -1, -1
+	for i, f := range h.schema.Fields() {
+		if f.Name == name {
+			exact = i
+		}
+		if strings.EqualFold(f.Name, name) {
+			if found >= 0 {
+				return -1, moerr.NewInvalidInputf(ctx,
+					"ambiguous column name %s: multiple columns match case-insensitively (%s and %s)",
+					name, h.schema.Field(found).Name, f.Name)
+			}
+			found = i
+		}
+	}
+	if exact >= 0 {
+		return exact, nil
+	}
+	return found, nil
+}
+
+func (h *ArrowHandler) prepare(param *ExternalParam) error {
+	h.fields = make([]int, len(param.Cols))
+	h.mappers = make([]arrowMapper, len(param.Cols))
+	for i := range h.fields {
+		h.fields[i] = -1
+	}
+	for _, attr := range param.Attrs {
+		colIdx := int(attr.ColIndex)
+		if colIdx < 0 || colIdx >= len(param.Cols) {
+			return moerr.NewInvalidInputf(param.Ctx, "invalid column index %d for column %s", attr.ColIndex, attr.ColName)
+		}
+		def := param.Cols[colIdx]
+		if def.Hidden {
+			continue
+		}
+		if catalog.ContainExternalHidenCol(attr.ColName) {
+			h.filepathColIndex = colIdx
+			continue
+		}
+		h.hasPhysicalCol = true
+
+		field, err := h.findField(param.Ctx, attr.ColName)
+		if err != nil {
+			return err
+		}
+		if field < 0 {
+			return moerr.NewInvalidInputf(param.Ctx, "column %s not found", attr.ColName)
+		}
+		dt := h.schema.Field(field).Type
+		fn := getArrowMapper(dt, def.Typ)
+		if fn == nil {
+			return moerr.NewNYIf(param.Ctx, "load %s to %s", dt.String(), types.T(def.Typ.Id).String())
+		}
+		h.fields[colIdx] = field
+		h.mappers[colIdx] = fn
+	}
+
+	filter := param.Filter
+	if filter != nil && filter.zonemappable && filter.FilterExpr != nil && len(filter.columnMap) > 0 {
+		h.zonemappable = true
+		h.metas = make(columnMetas, len(param.Cols))
+		for _, colIdx := range filter.columnMap {
+			if colIdx < 0 || colIdx >= len(param.Cols) || h.mappers[colIdx] == nil {
+				h.zonemappable = false
+				break
+			}
+			h.metas[colIdx] = objectio.BuildColumnMeta()
+		}
+	}
+	return nil
+}
+
+// nextRecord releases the current record batch and loads the next non-empty one,
+// leaving h.record nil at the end of the file.
+func (h *ArrowHandler) nextRecord(ctx context.Context) error {
+	if h.record != nil {
+		h.record.Release()
+		h.record = nil
+	}
+	h.offset = 0
+	for {
+		rec, err := h.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return moerr.ConvertGoError(ctx, err)
+		}
+		if rec.NumRows() > 0 {
+			h.record = rec
+			return nil
+		}
+		rec.Release()
+	}
+}
+
+func (h *ArrowHandler) isFinished() bool {
+	return h.record == nil
+}
+
+func (h *ArrowHandler) close() {
+	if h.record != nil {
+		h.record.Release()
+		h.record = nil
+	}
+	if h.release != nil {
+		h.release()
+		h.release = nil
+	}
+}
+
+// mapColumn appends rows [start, end) of the current record batch to the
+// vector of column colIdx.
+func (h *ArrowHandler) mapColumn(ctx context.Context, colIdx int, start, end int64, vec *vector.Vector, mp *mpool.MPool) error {
+	arr := h.record.Column(h.fields[colIdx])
+	return h.mappers[colIdx](ctx, arr, int(start), int(end), vec, mp)
+}
+
+// updateZoneMaps fills the zonemaps of the filter columns from their vectors.
+func (h *ArrowHandler) updateZoneMaps(columnMap map[int]int, vecs []*vector.Vector) error {
+	for _, colIdx := range columnMap {
+		vec := vecs[colIdx]
+		zm := index.NewZM(vec.GetType().Oid, vec.GetType().Scale)
+		if err := index.BatchUpdateZM(zm, vec); err != nil {
+			return err
+		}
+		h.metas[colIdx].SetZoneMap(zm)
+		h.metas[colIdx].SetNullCnt(uint32(vec.GetNulls().Count()))
+	}
+	return nil
+}
+
+func getArrowMapper(dt arrow.DataType, typ plan.Type) arrowMapper {
+	switch types.T(typ.Id) {
+	case types.T_bool:
+		if dt.ID() == arrow.BOOL {
+			return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				a := arr.(*array.Boolean)
+				return appendArrowFixed(arr, start, end, vec, mp, func(i int) (bool, error) {
+					return a.Value(i), nil
+				})
+			}
+		}
+	case types.T_int8:
+		return arrowIntMapper[int8](dt, math.MinInt8, math.MaxInt8)
+	case types.T_int16:
+		return arrowIntMapper[int16](dt, math.MinInt16, math.MaxInt16)
+	case types.T_int32:
+		return arrowIntMapper[int32](dt, math.MinInt32, math.MaxInt32)
+	case types.T_int64:
+		return arrowIntMapper[int64](dt, math.MinInt64, math.MaxInt64)
+	case types.T_uint8:
+		return arrowUintMapper[uint8](dt, math.MaxUint8)
+	case types.T_uint16:
+		return arrowUintMapper[uint16](dt, math.MaxUint16)
+	case types.T_uint32:
+		return arrowUintMapper[uint32](dt, math.MaxUint32)
+	case types.T_uint64, types.T_bit:
+		return arrowUintMapper[uint64](dt, math.MaxUint64)
+	case types.T_float32:
+		return arrowFloatMapper[float32](dt)
+	case types.T_float64:
+		return arrowFloatMapper[float64](dt)
+	case types.T_char, types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob, types.T_datalink:
+		if isArrowBinaryLike(dt) {
+			return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				get := arrowBytesGetter(arr)
+				return appendArrowBytes(arr, start, end, vec, mp, func(i int) ([]byte, error) {
+					return get(i), nil
+				})
+			}
+		}
+	case types.T_json:
+		if isArrowBinaryLike(dt) {
+			return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				get := arrowBytesGetter(arr)
+				return appendArrowBytes(arr, start, end, vec, mp, func(i int) ([]byte, error) {
+					bj, err := types.ParseSliceToByteJson(get(i))
+					if err != nil {
+						return nil, err
+					}
+					return types.EncodeJson(bj)
+				})
+			}
+		}
+	case types.T_date:
+		switch dt.ID() {
+		case arrow.DATE32:
+			return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				a := arr.(*array.Date32)
+				return appendArrowFixed(arr, start, end, vec, mp, func(i int) (types.Date, error) {
+					return types.DaysFromUnixEpochToDate(int32(a.Value(i))), nil
+				})
+			}
+		case arrow.DATE64:
+			return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				a := arr.(*array.Date64)
+				return appendArrowFixed(arr, start, end, vec, mp, func(i int) (types.Date, error) {
+					return types.DaysFromUnixEpochToDate(int32(floorDiv(int64(a.Value(i)), 24*3600*1000))), nil
+				})
+			}
+		}
+	case types.T_datetime:
+		switch dt.ID() {
+		case arrow.TIMESTAMP:
+			return arrowTimestampMapper[types.Datetime](dt)
+		case arrow.DATE32:
+			return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				a := arr.(*array.Date32)
+				return appendArrowFixed(arr, start, end, vec, mp, func(i int) (types.Datetime, error) {
+					return types.DaysFromUnixEpochToDate(int32(a.Value(i))).ToDatetime(), nil
+				})
+			}
+		}
+	case types.T_timestamp:
+		if dt.ID() == arrow.TIMESTAMP {
+			return arrowTimestampMapper[types.Timestamp](dt)
+		}
+	case types.T_time:
+		var unit arrow.TimeUnit
+		switch t := dt.(type) {
+		case *arrow.Time32Type:
+			unit = t.Unit
+		case *arrow.Time64Type:
+			unit = t.Unit
+		default:
+			return nil
+		}
+		return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+			return appendArrowFixed(arr, start, end, vec, mp, func(i int) (types.Time, error) {
+				var v int64
+				switch a := arr.(type) {
+				case *array.Time32:
+					v = int64(a.Value(i))
+				case *array.Time64:
+					v = int64(a.Value(i))
+				}
+				return types.Time(arrowUnitToMicros(v, unit)), nil
+			})
+		}
+	case types.T_decimal64, types.T_decimal128:
+		if t, ok := dt.(*arrow.Decimal128Type); ok {
+			return arrowDecimalMapper(t, typ)
+		}
+	case types.T_array_float32:
+		if elem := arrowListElemType(dt); elem != nil && (elem.ID() == arrow.FLOAT32 || elem.ID() == arrow.FLOAT64) {
+			return arrowListToArrayMapper[float32]()
+		}
+	case types.T_array_float64:
+		if elem := arrowListElemType(dt); elem != nil && (elem.ID() == arrow.FLOAT32 || elem.ID() == arrow.FLOAT64) {
+			return arrowListToArrayMapper[float64]()
+		}
+	}
+	return nil
+}
+
+func appendArrowFixed[T any](arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool, get func(i int) (T, error)) error {
+	if err := vec.PreExtend(end-start, mp); err != nil {
+		return err
+	}
+	var zero T
+	for i := start; i < end; i++ {
+		if arr.IsNull(i) {
+			if err := vector.AppendFixed(vec, zero, true, mp); err != nil {
+				return err
+			}
+			continue
+		}
+		v, err := get(i)
+		if err != nil {
+			return err
+		}
+		if err := vector.AppendFixed(vec, v, false, mp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendArrowBytes(arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool, get func(i int) ([]byte, error)) error {
+	if err := vec.PreExtend(end-start, mp); err != nil {
+		return err
+	}
+	for i := start; i < end; i++ {
+		if arr.IsNull(i) {
+			if err := vector.AppendBytes(vec, nil, true, mp); err != nil {
+				return err
+			}
+			continue
+		}
+		v, err := get(i)
+		if err != nil {
+			return err
+		}
+		if err := vector.AppendBytes(vec, v, false, mp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func arrowSignedGetter(arr arrow.Array) func(i int) int64 {
+	switch a := arr.(type) {
+	case *array.Int8:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Int16:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Int32:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Int64:
+		return a.Value
+	}
+	return nil
+}
+
+func arrowUnsignedGetter(arr arrow.Array) func(i int) uint64 {
+	switch a := arr.(type) {
+	case *array.Uint8:
+		return func(i int) uint64 { return uint64(a.Value(i)) }
+	case *array.Uint16:
+		return func(i int) uint64 { return uint64(a.Value(i)) }
+	case *array.Uint32:
+		return func(i int) uint64 { return uint64(a.Value(i)) }
+	case *array.Uint64:
+		return a.Value
+	}
+	return nil
+}
+
+func arrowIntMapper[T int8 | int16 | int32 | int64](dt arrow.DataType, minVal, maxVal int64) arrowMapper {
+	if !arrow.IsInteger(dt.ID()) {
+		return nil
+	}
+	return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+		if get := arrowSignedGetter(arr); get != nil {
+			return appendArrowFixed(arr, start, end, vec, mp, func(i int) (T, error) {
+				v := get(i)
+				if v < minVal || v > maxVal {
+					return 0, moerr.NewOutOfRangef(ctx, vec.GetType().String(), "value '%d'", v)
+				}
+				return T(v), nil
+			})
+		}
+		get := arrowUnsignedGetter(arr)
+		return appendArrowFixed(arr, start, end, vec, mp, func(i int) (T, error) {
+			v := get(i)
+			if v > uint64(maxVal) {
+				return 0, moerr.NewOutOfRangef(ctx, vec.GetType().String(), "value '%d'", v)
+			}
+			return T(v), nil
+		})
+	}
+}
+
+func arrowUintMapper[T uint8 | uint16 | uint32 | uint64](dt arrow.DataType, maxVal uint64) arrowMapper {
+	if !arrow.IsInteger(dt.ID()) {
+		return nil
+	}
+	return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+		if get := arrowUnsignedGetter(arr); get != nil {
+			return appendArrowFixed(arr, start, end, vec, mp, func(i int) (T, error) {
+				v := get(i)
+				if v > maxVal {
+					return 0, moerr.NewOutOfRangef(ctx, vec.GetType().String(), "value '%d'", v)
+				}
+				return T(v), nil
+			})
+		}
+		get := arrowSignedGetter(arr)
+		return appendArrowFixed(arr, start, end, vec, mp, func(i int) (T, error) {
+			v := get(i)
+			if v < 0 || uint64(v) > maxVal {
+				return 0, moerr.NewOutOfRangef(ctx, vec.GetType().String(), "value '%d'", v)
+			}
+			return T(v), nil
+		})
+	}
+}
+
+func arrowFloatMapper[T float32 | float64](dt arrow.DataType) arrowMapper {
+	if dt.ID() != arrow.FLOAT32 && dt.ID() != arrow.FLOAT64 && !arrow.IsInteger(dt.ID()) {
+		return nil
+	}
+	return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+		var get func(i int) T
+		switch a := arr.(type) {
+		case *array.Float32:
+			get = func(i int) T { return T(a.Value(i)) }
+		case *array.Float64:
+			get = func(i int) T { return T(a.Value(i)) }
+		default:
+			if signed := arrowSignedGetter(arr); signed != nil {
+				get = func(i int) T { return T(signed(i)) }
+			} else {
+				unsigned := arrowUnsignedGetter(arr)
+				get = func(i int) T { return T(unsigned(i)) }
+			}
+		}
+		return appendArrowFixed(arr, start, end, vec, mp, func(i int) (T, error) {
+			return get(i), nil
+		})
+	}
+}
+
+func isArrowBinaryLike(dt arrow.DataType) bool {
+	switch dt.ID() {
+	case arrow.STRING, arrow.LARGE_STRING, arrow.BINARY, arrow.LARGE_BINARY:
+		return true
+	case arrow.DICTIONARY:
+		return isArrowBinaryLike(dt.(*arrow.DictionaryType).ValueType)
+	}
+	return false
+}
+
+func arrowBytesGetter(arr arrow.Array) func(i int) []byte {
+	switch a := arr.(type) {
+	case *array.String:
+		return func(i int) []byte { return util.UnsafeStringToBytes(a.Value(i)) }
+	case *array.LargeString:
+		return func(i int) []byte { return util.UnsafeStringToBytes(a.Value(i)) }
+	case *array.Binary:
+		return a.Value
+	case *array.LargeBinary:
+		return a.Value
+	case *array.Dictionary:
+		get := arrowBytesGetter(a.Dictionary())
+		return func(i int) []byte { return get(a.GetValueIndex(i)) }
+	}
+	return nil
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func arrowUnitToMicros(v int64, unit arrow.TimeUnit) int64 {
+	switch unit {
+	case arrow.Second:
+		return v * types.MicroSecsPerSec
+	case arrow.Millisecond:
+		return v * 1000
+	case arrow.Nanosecond:
+		return floorDiv(v, 1000)
+	default:
+		return v
+	}
+}
+
+// arrowTimestampMapper maps Arrow timestamps, which count from the Unix epoch
+// in UTC, to DATETIME or TIMESTAMP.
+func arrowTimestampMapper[T types.Datetime | types.Timestamp](dt arrow.DataType) arrowMapper {
+	unit := dt.(*arrow.TimestampType).Unit
+	return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+		a := arr.(*array.Timestamp)
+		return appendArrowFixed(arr, start, end, vec, mp, func(i int) (T, error) {
+			return T(arrowUnitToMicros(int64(a.Value(i)), unit) + types.GetUnixEpochSecs()), nil
+		})
+	}
+}
+
+func arrowDecimalMapper(dt *arrow.Decimal128Type, typ plan.Type) arrowMapper {
+	diff := typ.Scale - dt.Scale
+	toDecimal128 := func(ctx context.Context, a *array.Decimal128, i int) (types.Decimal128, error) {
+		n := a.Value(i)
+		d := types.Decimal128{B0_63: n.LowBits(), B64_127: uint64(n.HighBits())}
+		if diff == 0 {
+			return d, nil
+		}
+		return d.Scale(diff)
+	}
+	if types.T(typ.Id) == types.T_decimal128 {
+		return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+			a := arr.(*array.Decimal128)
+			return appendArrowFixed(arr, start, end, vec, mp, func(i int) (types.Decimal128, error) {
+				return toDecimal128(ctx, a, i)
+			})
+		}
+	}
+	return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+		a := arr.(*array.Decimal128)
+		return appendArrowFixed(arr, start, end, vec, mp, func(i int) (types.Decimal64, error) {
+			d, err := toDecimal128(ctx, a, i)
+			if err != nil {
+				return 0, err
+			}
+			// fits in 64 bits only if the high word is the sign extension of the low word
+			if int64(d.B64_127) != int64(d.B0_63)>>63 {
+				return 0, moerr.NewOutOfRangef(ctx, vec.GetType().String(), "value '%s'", d.Format(dt.Scale+diff))
+			}
+			return types.Decimal64(d.B0_63), nil
+		})
+	}
+}
+
+func arrowListElemType(dt arrow.DataType) arrow.DataType {
+	switch t := dt.(type) {
+	case *arrow.ListType:
+		return t.Elem()
+	case *arrow.LargeListType:
+		return t.Elem()
+	case *arrow.FixedSizeListType:
+		return t.Elem()
+	}
+	return nil
+}
+
+func arrowListToArrayMapper[T float32 | float64]() arrowMapper {
+	return func(ctx context.Context, arr arrow.Array, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+		list := arr.(array.ListLike)
+		var get func(i int) T
+		switch values := list.ListValues().(type) {
+		case *array.Float32:
+			get = func(i int) T { return T(values.Value(i)) }
+		case *array.Float64:
+			get = func(i int) T { return T(values.Value(i)) }
+		}
+		width := int(vec.GetType().Width)
+		var buf []T
+		return appendArrowBytes(arr, start, end, vec, mp, func(i int) ([]byte, error) {
+			from, to := list.ValueOffsets(i)
+			if width != types.MaxArrayDimension && width != int(to-from) {
+				return nil, moerr.NewArrayDefMismatchNoCtx(width, int(to-from))
+			}
+			buf = buf[:0]
+			for j := from; j < to; j++ {
+				buf = append(buf, get(int(j)))
+			}
+			return types.ArrayToBytes[T](buf), nil
+		})
+	}
+}
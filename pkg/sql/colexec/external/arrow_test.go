@@ -0,0 +1,245 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// writeArrowIPC writes one record batch per entry of ids, with columns
+// id int64, Name utf8, ts timestamp[ms], price decimal128(10, 2), emb list<float32>.
+func writeArrowIPC(t *testing.T, stream bool, ids ...[]int64) []byte {
+	t.Helper()
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "Name", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Millisecond}, Nullable: true},
+		{Name: "price", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}, Nullable: true},
+		{Name: "emb", Type: arrow.ListOf(arrow.PrimitiveTypes.Float32), Nullable: true},
+	}, nil)
+
+	var buf bytes.Buffer
+	var w interface {
+		Write(arrow.Record) error
+		Close() error
+	}
+	if stream {
+		w = ipc.NewWriter(&buf, ipc.WithSchema(schema))
+	} else {
+		fw, err := ipc.NewFileWriter(&buf, ipc.WithSchema(schema), ipc.WithZstd())
+		require.NoError(t, err)
+		w = fw
+	}
+
+	b := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer b.Release()
+	for _, part := range ids {
+		for _, id := range part {
+			b.Field(0).(*array.Int64Builder).Append(id)
+			if id%2 == 0 {
+				b.Field(1).(*array.StringBuilder).Append("even")
+			} else {
+				b.Field(1).AppendNull()
+			}
+			b.Field(2).(*array.TimestampBuilder).Append(arrow.Timestamp(id * 1000))
+			b.Field(3).(*array.Decimal128Builder).Append(decimal128.FromI64(id*100 + 5))
+			lb := b.Field(4).(*array.ListBuilder)
+			lb.Append(true)
+			lb.ValueBuilder().(*array.Float32Builder).AppendValues([]float32{float32(id), -1}, nil)
+		}
+		rec := b.NewRecord()
+		require.NoError(t, w.Write(rec))
+		rec.Release()
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func newArrowTestParam(data []byte, names []string, typs []types.Type) *ExternalParam {
+	attrs := make([]plan.ExternAttr, len(names))
+	cols := make([]*plan.ColDef, len(names))
+	for i, name := range names {
+		attrs[i] = plan.ExternAttr{ColName: name, ColIndex: int32(i)}
+		cols[i] = &plan.ColDef{
+			Name: name,
+			Typ:  plan.Type{Id: int32(typs[i].Oid), Width: typs[i].Width, Scale: typs[i].Scale},
+		}
+	}
+	param := &ExternalParam{
+		ExParamConst: ExParamConst{
+			Ctx:      context.Background(),
+			Attrs:    attrs,
+			Cols:     cols,
+			Extern:   &tree.ExternParam{ExParamConst: tree.ExParamConst{ScanType: tree.INLINE, Format: tree.ARROW}},
+			FileSize: []int64{int64(len(data))},
+		},
+		ExParam: ExParam{
+			Fileparam: &ExFileparam{FileIndex: 1, FileCnt: 1},
+			Filter:    &FilterParam{},
+		},
+	}
+	param.Extern.Data = string(data)
+	return param
+}
+
+func readAllArrow(t *testing.T, param *ExternalParam, proc *process.Process, typs []types.Type) ([]*batch.Batch, error) {
+	r := NewArrowReader(param, proc)
+	fileEmpty, err := r.Open(param, proc)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	if fileEmpty {
+		return nil, nil
+	}
+	var bats []*batch.Batch
+	for attempts := 0; attempts < 10; attempts++ {
+		bat := vectorBatch(typs)
+		finished, err := r.ReadBatch(context.Background(), bat, proc, nil)
+		if err != nil {
+			return nil, err
+		}
+		bats = append(bats, bat)
+		if finished {
+			return bats, nil
+		}
+	}
+	t.Fatal("arrow reader did not finish")
+	return nil, nil
+}
+
+func TestArrow_ReadFileAndStream(t *testing.T) {
+	save := maxArrowBatchCnt
+	maxArrowBatchCnt = 2
+	defer func() { maxArrowBatchCnt = save }()
+
+	names := []string{"id", "name", "ts", "price", "emb"}
+	typs := []types.Type{
+		types.T_int32.ToType(),
+		types.T_varchar.ToType(),
+		types.New(types.T_datetime, 0, 3),
+		types.New(types.T_decimal64, 12, 3),
+		types.New(types.T_array_float32, 2, 0),
+	}
+	proc := testutil.NewProc(t)
+
+	for _, stream := range []bool{false, true} {
+		data := writeArrowIPC(t, stream, []int64{1, 2, 3}, nil, []int64{4})
+		param := newArrowTestParam(data, names, typs)
+		bats, err := readAllArrow(t, param, proc, typs)
+		require.NoError(t, err)
+		// 3 rows are read as 2 + 1, the empty record batch is skipped
+		require.Len(t, bats, 3)
+		require.Equal(t, []int{2, 1, 1}, []int{bats[0].RowCount(), bats[1].RowCount(), bats[2].RowCount()})
+
+		var ids []int32
+		for _, bat := range bats {
+			ids = append(ids, vector.MustFixedColWithTypeCheck[int32](bat.Vecs[0])...)
+		}
+		require.Equal(t, []int32{1, 2, 3, 4}, ids)
+
+		bat := bats[0]
+		require.True(t, bat.Vecs[1].IsNull(0))
+		require.Equal(t, "even", bat.Vecs[1].GetStringAt(1))
+		dt := vector.GetFixedAtNoTypeCheck[types.Datetime](bat.Vecs[2], 1)
+		require.Equal(t, "1970-01-01 00:00:02.000", dt.String2(3))
+		dec := vector.GetFixedAtNoTypeCheck[types.Decimal64](bat.Vecs[3], 1)
+		require.Equal(t, "2.050", dec.Format(3))
+		require.Equal(t, []float32{2, -1}, types.BytesToArray[float32](bat.Vecs[4].GetBytesAt(1)))
+	}
+}
+
+func TestArrow_ZonemapSkipsRecordBatches(t *testing.T) {
+	proc := testutil.NewProc(t)
+	names := []string{"id", "name"}
+	typs := []types.Type{types.T_int64.ToType(), types.T_varchar.ToType()}
+	data := writeArrowIPC(t, false, []int64{1, 2, 3}, []int64{100, 101}, []int64{4})
+
+	ctx := context.Background()
+	colExpr := &plan.Expr{
+		Typ: plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{ColPos: 0, Name: "id"},
+		},
+	}
+	constExpr := &plan.Expr{
+		Typ: plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Lit{
+			Lit: &plan.Literal{Value: &plan.Literal_I64Val{I64Val: 50}},
+		},
+	}
+	filter, err := plan2.BindFuncExprImplByPlanExpr(ctx, ">", []*plan.Expr{colExpr, constExpr})
+	require.NoError(t, err)
+
+	param := newArrowTestParam(data, names, typs)
+	param.Filter.FilterExpr = filter
+	param.Filter.columnMap, _, _, _ = plan2.GetColumnsByExpr(filter, &plan.TableDef{
+		Name2ColIndex: map[string]int32{"id": 0, "name": 1},
+	})
+	param.Filter.AuxIdCnt = plan2.AssignAuxIdForExpr(filter, 0)
+
+	bats, err := readAllArrow(t, param, proc, typs)
+	require.NoError(t, err)
+	require.Len(t, bats, 2)
+	require.Equal(t, []int64{100, 101}, vector.MustFixedColWithTypeCheck[int64](bats[0].Vecs[0]))
+	require.Equal(t, 2, bats[0].Vecs[1].Length())
+	require.Equal(t, "even", bats[0].Vecs[1].GetStringAt(0))
+	// the last record batch is skipped as well, leaving an empty batch
+	require.Equal(t, 0, bats[1].RowCount())
+	require.Equal(t, 0, bats[1].Vecs[0].Length())
+}
+
+func TestArrow_Errors(t *testing.T) {
+	proc := testutil.NewProc(t)
+	data := writeArrowIPC(t, true, []int64{1, 300})
+
+	// out of range
+	typs := []types.Type{types.T_int8.ToType()}
+	_, err := readAllArrow(t, newArrowTestParam(data, []string{"id"}, typs), proc, typs)
+	require.ErrorContains(t, err, "out of range")
+
+	// unsupported conversion
+	typs = []types.Type{types.T_int64.ToType()}
+	_, err = readAllArrow(t, newArrowTestParam(data, []string{"name"}, typs), proc, typs)
+	require.ErrorContains(t, err, "load utf8 to BIGINT")
+
+	// missing column
+	_, err = readAllArrow(t, newArrowTestParam(data, []string{"nope"}, typs), proc, typs)
+	require.ErrorContains(t, err, "column nope not found")
+
+	// no rows
+	typs = []types.Type{types.T_int64.ToType()}
+	bats, err := readAllArrow(t, newArrowTestParam(writeArrowIPC(t, false), []string{"id"}, typs), proc, typs)
+	require.NoError(t, err)
+	require.Nil(t, bats)
+}
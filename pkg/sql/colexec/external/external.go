@@ -154,6 +154,12 @@ func (external *External) Prepare(proc *process.Process) error {
 		external.reader = NewZonemapReader(param, proc)
 	case param.Extern.Format == tree.PARQUET:
 		external.reader = NewParquetReader(param, proc)
+	case param.Extern.Format == tree.ARROW:
+		external.reader = NewArrowReader(param, proc)
+	case param.Extern.Format == tree.ORC:
+		external.reader = NewOrcReader(param, proc)
+	case param.Extern.Format == tree.ICEBERG:
+		external.reader = NewIcebergReader(param, proc)
 	default:
 		r, err := NewCsvReader(param, proc)
 		if err != nil {
@@ -176,7 +182,8 @@ func (external *External) Prepare(proc *process.Process) error {
 		}
 		external.ctr.buf = batch.NewOffHeap(attrs)
 		flag := param.ParallelLoad
-		if param.Extern.Format == tree.PARQUET {
+		if param.Extern.Format == tree.PARQUET || param.Extern.Format == tree.ARROW || param.Extern.Format == tree.ORC ||
+			param.Extern.Format == tree.ICEBERG {
 			flag = false
 		}
 		//alloc space for vector
@@ -738,6 +745,11 @@ func isLegalLine(param *tree.ExternParam, cols []*plan.ColDef, fields []csvparse
 			if err != nil {
 				return false
 			}
+		case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+			_, err := types.StringToQuantizedArrayBytes(id, field.Val)
+			if err != nil {
+				return false
+			}
 		case types.T_json:
 			if param.Format == tree.CSV {
 				field.Val = fmt.Sprintf("%v", strings.Trim(field.Val, "\""))
@@ -1404,6 +1416,18 @@ func getColData(bat *batch.Batch, line []csvparser.Field, rowIdx int, param *Ext
 		if err = vector.AppendBytes(vec, types.ArrayToBytes[float64](arr), false, mp); err != nil {
 			return err
 		}
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		arr, err := types.StringToQuantizedArrayBytes(vec.GetType().Oid, field.Val)
+		if err != nil {
+			return err
+		}
+		dim := types.QuantizedArrayDimension(vec.GetType().Oid, arr)
+		if int(vec.GetType().Width) != types.MaxArrayDimension && int(vec.GetType().Width) != dim {
+			return moerr.NewArrayDefMismatchNoCtx(int(vec.GetType().Width), dim)
+		}
+		if err = vector.AppendBytes(vec, arr, false, mp); err != nil {
+			return err
+		}
 	case types.T_json:
 		var jsonBytes []byte
 		if param.Extern.Format != tree.CSV {
@@ -1584,7 +1608,7 @@ func parseLoadDataYear(field csvparser.Field) (types.MoYear, error) {
 
 func loadFormatIsValid(param *tree.ExternParam) bool {
 	switch param.Format {
-	case tree.JSONLINE, tree.CSV, tree.PARQUET:
+	case tree.JSONLINE, tree.CSV, tree.PARQUET, tree.ARROW, tree.ORC, tree.ICEBERG:
 		return true
 	}
 	return false
@@ -227,9 +227,11 @@ type DiscoverOptions struct {
 	CacheKeyPrefix  string
 	CacheMaxEntries int
 	CacheMaxBytes   int64
-	listSemaphore   chan struct{}
-	statsMu         *sync.Mutex
-	cancel          context.CancelCauseFunc
+	// Format selects the data files by suffix, "" is tree.PARQUET
+	Format        string
+	listSemaphore chan struct{}
+	statsMu       *sync.Mutex
+	cancel        context.CancelCauseFunc
 }
 
 type childPartition struct {
@@ -443,6 +445,19 @@ func IsParquetFile(name string) bool {
 	return strings.HasSuffix(lower, ".parquet")
 }
 
+// IsOrcFile returns true for files with .orc suffix.
+func IsOrcFile(name string) bool {
+	return strings.HasSuffix(strings.ToLower(name), ".orc")
+}
+
+// isDataFile returns true if name is a data file of the given format.
+func isDataFile(name, format string) bool {
+	if format == tree.ORC {
+		return IsOrcFile(name)
+	}
+	return IsParquetFile(name)
+}
+
 // DiscoverHivePartitions performs recursive list-and-filter partition discovery.
 func DiscoverHivePartitions(
 	ctx context.Context,
@@ -535,6 +550,7 @@ func normalizeDiscoverOptions(opts *DiscoverOptions) DiscoverOptions {
 	options.CacheKeyPrefix = opts.CacheKeyPrefix
 	options.CacheMaxEntries = opts.CacheMaxEntries
 	options.CacheMaxBytes = opts.CacheMaxBytes
+	options.Format = opts.Format
 	return options
 }
 
@@ -809,7 +825,7 @@ func collectFiles(
 		if entry.IsDir || IsHiddenFile(entry.Name) {
 			continue
 		}
-		if IsParquetFile(entry.Name) {
+		if isDataFile(entry.Name, options.Format) {
 			addDiscoveryFile(result, options, PartitionFileEntry{
 				FilePath: path.Join(prefix, entry.Name),
 				FileSize: entry.Size,
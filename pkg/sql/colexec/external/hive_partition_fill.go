@@ -122,24 +122,37 @@ func validatePartitionValue(strVal, colName string, colType tree.HivePartColType
 
 // fillVirtualColumns fills partition columns and __mo_filepath for a batch.
 func (h *ParquetHandler) fillVirtualColumns(bat *batch.Batch, param *ExternalParam, proc *process.Process) error {
+	return fillVirtualColumns(bat, h.filepathColIndex, h.partitionColIndices, param, proc)
+}
+
+// fillPartitionColumns fills partition column vectors with constant values from the path.
+func (h *ParquetHandler) fillPartitionColumns(bat *batch.Batch, param *ExternalParam, proc *process.Process) error {
+	return fillPartitionColumns(bat, h.partitionColIndices, param, proc)
+}
+
+// fillVirtualColumns fills the partition columns and __mo_filepath of a batch
+// read by any file format; filepathColIndex is -1 if __mo_filepath is not projected.
+func fillVirtualColumns(
+	bat *batch.Batch, filepathColIndex int, partitionColIndices []int,
+	param *ExternalParam, proc *process.Process,
+) error {
 	rowCount := bat.RowCount()
 	mp := proc.Mp()
 
-	if h.filepathColIndex >= 0 {
-		vec := bat.Vecs[h.filepathColIndex]
+	if filepathColIndex >= 0 {
+		vec := bat.Vecs[filepathColIndex]
 		if err := vector.SetConstBytes(vec, []byte(param.Fileparam.Filepath), rowCount, mp); err != nil {
 			return err
 		}
 	}
 
-	if len(h.partitionColIndices) > 0 {
-		return h.fillPartitionColumns(bat, param, proc)
+	if len(partitionColIndices) > 0 {
+		return fillPartitionColumns(bat, partitionColIndices, param, proc)
 	}
 	return nil
 }
 
-// fillPartitionColumns fills partition column vectors with constant values from the path.
-func (h *ParquetHandler) fillPartitionColumns(bat *batch.Batch, param *ExternalParam, proc *process.Process) error {
+func fillPartitionColumns(bat *batch.Batch, partitionColIndices []int, param *ExternalParam, proc *process.Process) error {
 	partValues := param.currentPartValues
 	rowCount := bat.RowCount()
 	mp := proc.Mp()
@@ -152,7 +165,7 @@ func (h *ParquetHandler) fillPartitionColumns(bat *batch.Batch, param *ExternalP
 		relPath = relPartitionPath(param.Fileparam.Filepath, param.Extern.Filepath)
 	}
 
-	for _, idx := range h.partitionColIndices {
+	for _, idx := range partitionColIndices {
 		col := param.Cols[idx]
 		colName := strings.ToLower(col.Name)
 		strVal, present := partValues[colName]
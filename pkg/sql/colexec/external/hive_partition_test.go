@@ -200,6 +200,14 @@ func TestIsParquetFile(t *testing.T) {
 	assert.False(t, IsParquetFile(""))
 }
 
+func TestIsOrcFile(t *testing.T) {
+	assert.True(t, IsOrcFile("data.orc"))
+	assert.True(t, IsOrcFile("DATA.ORC"))
+	assert.False(t, IsOrcFile("data.parquet"))
+	assert.False(t, IsOrcFile("data.orc.crc"))
+	assert.False(t, IsOrcFile(""))
+}
+
 // --- matchPartitionValue tests ---
 
 func TestMatchPartitionValue_IntMatch(t *testing.T) {
@@ -359,6 +367,31 @@ func TestDiscoverHivePartitions_SingleLevel(t *testing.T) {
 	assert.Equal(t, int64(1000), result.Files[0].FileSize)
 }
 
+func TestDiscoverHivePartitions_OrcFormat(t *testing.T) {
+	dirs := map[string][]fileservice.DirEntry{
+		"/data": {
+			{Name: "year=2024", IsDir: true},
+		},
+		"/data/year=2024": {
+			{Name: "part-0000.orc", IsDir: false, Size: 1000},
+			{Name: "part-0001.parquet", IsDir: false, Size: 2000},
+		},
+	}
+
+	result, err := DiscoverHivePartitionsWithPruneExpr(
+		context.Background(),
+		mockListDir(dirs),
+		"/data",
+		[]string{"year"},
+		[]tree.HivePartColType{{Id: int32(types.T_int32)}},
+		nil,
+		&DiscoverOptions{Format: tree.ORC},
+	)
+	require.NoError(t, err)
+	require.Len(t, result.Files, 1)
+	assert.Equal(t, "/data/year=2024/part-0000.orc", result.Files[0].FilePath)
+}
+
 func TestDiscoverHivePartitions_MultiLevel(t *testing.T) {
 	dirs := map[string][]fileservice.DirEntry{
 		"/data": {
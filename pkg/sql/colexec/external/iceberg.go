@@ -0,0 +1,376 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/big"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external/iceberg"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/errutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// icebergFileIO reads the files of an Iceberg table through the file service
+// of an external table. The metadata refers to files by absolute URIs under
+// the table location, which are mapped to paths under the table directory
+// given by the FILEPATH of the external table.
+type icebergFileIO struct {
+	param *tree.ExternParam
+	// root is the table directory, location is the table location in the
+	// metadata, known once the metadata is loaded
+	root     string
+	location string
+}
+
+func newIcebergFileIO(param *tree.ExternParam) *icebergFileIO {
+	root := strings.TrimSuffix(strings.TrimSpace(param.Filepath), "/")
+	if strings.HasSuffix(root, ".metadata.json") || strings.HasSuffix(root, ".metadata.json.gz") {
+		// <root>/metadata/<version>.metadata.json
+		root = path.Dir(path.Dir(root))
+	}
+	return &icebergFileIO{param: param, root: root}
+}
+
+// resolve maps a path of the metadata to a path of the file service.
+func (f *icebergFileIO) resolve(p string) string {
+	if f.location != "" && (p == f.location || strings.HasPrefix(p, f.location+"/")) {
+		return f.root + strings.TrimPrefix(p, f.location)
+	}
+	if scheme, rest, ok := strings.Cut(p, "://"); ok {
+		if scheme == "file" {
+			return rest
+		}
+		// the bucket of an object store URI is given by the table options
+		if _, key, ok := strings.Cut(rest, "/"); ok {
+			return key
+		}
+		return rest
+	}
+	return strings.TrimPrefix(p, "file:")
+}
+
+func (f *icebergFileIO) ReadFile(ctx context.Context, p string) ([]byte, error) {
+	fs, readPath, err := plan2.GetForETLWithType(f.param, f.resolve(p))
+	if err != nil {
+		return nil, err
+	}
+	var r io.ReadCloser
+	vec := fileservice.IOVector{
+		FilePath: readPath,
+		Entries: []fileservice.IOEntry{
+			0: {
+				Offset:            0,
+				Size:              -1,
+				ReadCloserForRead: &r,
+			},
+		},
+	}
+	if err = fs.Read(ctx, &vec); err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func (f *icebergFileIO) Open(ctx context.Context, p string, size int64) (io.ReaderAt, error) {
+	fs, readPath, err := plan2.GetForETLWithType(f.param, f.resolve(p))
+	if err != nil {
+		return nil, err
+	}
+	return &fsReaderAt{fs: fs, readPath: readPath, ctx: ctx}, nil
+}
+
+func (f *icebergFileIO) List(ctx context.Context, dir string) ([]string, error) {
+	fs, readPath, err := plan2.GetForETLWithType(f.param, f.resolve(dir))
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for entry, err := range fs.List(ctx, readPath) {
+		if err != nil {
+			return nil, err
+		}
+		if !entry.IsDir {
+			names = append(names, entry.Name)
+		}
+	}
+	return names, nil
+}
+
+// loadIcebergTable loads the table of an external table and the snapshot to
+// read, which are pinned by the options set by PlanIcebergFileList.
+func loadIcebergTable(ctx context.Context, param *tree.ExternParam) (*icebergFileIO, *iceberg.Table, *iceberg.Snapshot, error) {
+	fio := newIcebergFileIO(param)
+	location := param.Filepath
+	if file, ok := plan2.GetExternalOption(param, plan2.IcebergMetadataFileKey); ok {
+		location = file
+	}
+	table, err := iceberg.LoadTable(ctx, fio, location)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	fio.location = strings.TrimSuffix(table.Metadata.Location, "/")
+
+	var snapshotID *int64
+	if v, ok := plan2.GetExternalOption(param, plan2.IcebergSnapshotIDKey); ok {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, nil, nil, moerr.NewInvalidInputf(ctx, "invalid %s '%s'", plan2.IcebergSnapshotIDKey, v)
+		}
+		snapshotID = &id
+	}
+	snapshot, err := table.Snapshot(snapshotID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return fio, table, snapshot, nil
+}
+
+func setExternalOption(param *tree.ExternParam, key, value string) {
+	for i := 0; i+1 < len(param.Option); i += 2 {
+		if strings.ToLower(param.Option[i]) == key {
+			param.Option[i+1] = value
+			return
+		}
+	}
+	param.Option = append(param.Option, key, value)
+}
+
+// PlanIcebergFileList returns the data files of an Iceberg external table
+// that may satisfy the filters of node, pruned by the partition summaries of
+// the manifests and the column bounds of the files. The metadata file and the
+// snapshot planned with are pinned in the options of param for the readers.
+func PlanIcebergFileList(ctx context.Context, proc *process.Process, node *plan.Node, param *tree.ExternParam) ([]string, []int64, error) {
+	fio, table, snapshot, err := loadIcebergTable(ctx, param)
+	if err != nil {
+		return nil, nil, err
+	}
+	setExternalOption(param, plan2.IcebergMetadataFileKey, table.MetadataFile)
+	if snapshot == nil {
+		return nil, nil, nil
+	}
+	setExternalOption(param, plan2.IcebergSnapshotIDKey, strconv.FormatInt(snapshot.ID, 10))
+
+	schema := table.Metadata.CurrentSchema()
+	tasks, err := table.PlanFiles(ctx, snapshot, schema, newIcebergFileFilter(ctx, proc, node, schema))
+	if err != nil {
+		return nil, nil, err
+	}
+	fileList := make([]string, len(tasks))
+	fileSize := make([]int64, len(tasks))
+	for i, task := range tasks {
+		fileList[i] = fio.resolve(task.File.Path)
+		fileSize[i] = task.File.FileSize
+	}
+	return fileList, fileSize, nil
+}
+
+// newIcebergFileFilter returns a filter evaluating the filters of node on
+// zonemaps built from the statistics of the files, or nil if they cannot be
+// evaluated on zonemaps.
+func newIcebergFileFilter(ctx context.Context, proc *process.Process, node *plan.Node, schema *iceberg.Schema) iceberg.FileFilter {
+	if len(node.FilterList) == 0 || node.TableDef == nil {
+		return nil
+	}
+	expr := colexec.RewriteFilterExprList(node.FilterList)
+	if !plan2.ExprIsZonemappable(ctx, expr) {
+		return nil
+	}
+	cols := node.TableDef.Cols
+	name2ColIndex := make(map[string]int32, len(cols))
+	for i, col := range cols {
+		name2ColIndex[col.Name] = int32(i)
+	}
+	columnMap, _, _, _ := plan2.GetColumnsByExpr(expr, &plan.TableDef{Name2ColIndex: name2ColIndex})
+	if len(columnMap) == 0 {
+		return nil
+	}
+	expr = plan2.DeepCopyExpr(expr)
+	auxIdCnt := plan2.AssignAuxIdForExpr(expr, 0)
+
+	return func(stats *iceberg.FileStats) (bool, error) {
+		mp := proc.Mp()
+		metas := make(columnMetas, len(cols))
+		for _, colIdx := range columnMap {
+			if colIdx < 0 || colIdx >= len(cols) {
+				return true, nil
+			}
+			field := schema.FieldByName(cols[colIdx].Name)
+			if field == nil {
+				return true, nil
+			}
+			cs := stats.Columns[field.ID]
+			if cs == nil {
+				return true, nil
+			}
+			meta := objectio.BuildColumnMeta()
+			metas[colIdx] = meta
+			if cs.Lower == nil {
+				if cs.NullCount < 0 || cs.NullCount != stats.RecordCount {
+					return true, nil
+				}
+				// all null, the zonemap is left uninitialized
+				meta.SetNullCnt(uint32(min(cs.NullCount, math.MaxUint32)))
+				continue
+			}
+			if cs.NullCount != 0 {
+				// unknown counts are taken as some nulls
+				meta.SetNullCnt(uint32(max(min(cs.NullCount, math.MaxUint32), 1)))
+			}
+			zm, err := icebergBoundsZoneMap(cs, cols[colIdx].Typ, mp)
+			if err != nil {
+				return false, err
+			}
+			if zm == nil {
+				return true, nil
+			}
+			meta.SetZoneMap(zm)
+		}
+		var (
+			zms  []objectio.ZoneMap
+			vecs []*vector.Vector
+		)
+		if auxIdCnt > 0 {
+			zms = make([]objectio.ZoneMap, auxIdCnt)
+			vecs = make([]*vector.Vector, auxIdCnt)
+		}
+		return colexec.EvaluateFilterByZoneMap(
+			errutil.ContextWithNoReport(ctx, true), proc, expr, metas, columnMap, zms, vecs), nil
+	}
+}
+
+// icebergBoundsZoneMap builds the zonemap of a column of type typ from the
+// bounds of cs, or returns nil if the bounds cannot be mapped to values of
+// the column in order.
+func icebergBoundsZoneMap(cs *iceberg.ColumnStats, typ plan.Type, mp *mpool.MPool) (objectio.ZoneMap, error) {
+	vec := vector.NewVec(types.New(types.T(typ.Id), typ.Width, typ.Scale))
+	defer vec.Free(mp)
+	for _, v := range []any{cs.Lower, cs.Upper} {
+		ok, err := appendIcebergBound(vec, cs.Type, v, mp)
+		if err != nil || !ok {
+			return nil, err
+		}
+	}
+	zm := index.NewZM(vec.GetType().Oid, vec.GetType().Scale)
+	if err := index.BatchUpdateZM(zm, vec); err != nil {
+		return nil, err
+	}
+	return zm, nil
+}
+
+func appendIcebergBound(vec *vector.Vector, t iceberg.Type, v any, mp *mpool.MPool) (bool, error) {
+	oid := vec.GetType().Oid
+	switch x := v.(type) {
+	case bool:
+		if oid == types.T_bool && t.Kind == "boolean" {
+			return true, vector.AppendFixed(vec, x, false, mp)
+		}
+	case int64:
+		switch t.Kind {
+		case "int", "long":
+			return appendIcebergInt(vec, x, mp)
+		case "date":
+			if oid == types.T_date && x >= math.MinInt32 && x <= math.MaxInt32 {
+				return true, vector.AppendFixed(vec, types.DaysFromUnixEpochToDate(int32(x)), false, mp)
+			}
+		case "timestamptz":
+			if oid == types.T_timestamp {
+				return true, vector.AppendFixed(vec, types.UnixMicroToTimestamp(x), false, mp)
+			}
+		}
+	case float64:
+		switch {
+		case oid == types.T_float64:
+			return true, vector.AppendFixed(vec, x, false, mp)
+		case oid == types.T_float32 && t.Kind == "float":
+			return true, vector.AppendFixed(vec, float32(x), false, mp)
+		}
+	case string:
+		switch oid {
+		case types.T_char, types.T_varchar, types.T_text:
+			return true, vector.AppendBytes(vec, []byte(x), false, mp)
+		}
+	case *big.Int:
+		if t.Kind != "decimal" || int32(t.Scale) != vec.GetType().Scale {
+			return false, nil
+		}
+		switch oid {
+		case types.T_decimal64:
+			if x.IsInt64() {
+				return true, vector.AppendFixed(vec, types.Decimal64(x.Int64()), false, mp)
+			}
+		case types.T_decimal128:
+			if x.BitLen() < 128 {
+				lo := new(big.Int).And(x, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+				hi := new(big.Int).Rsh(x, 64).Int64()
+				return true, vector.AppendFixed(vec, types.Decimal128{B0_63: lo, B64_127: uint64(hi)}, false, mp)
+			}
+		}
+	}
+	return false, nil
+}
+
+func appendIcebergInt(vec *vector.Vector, x int64, mp *mpool.MPool) (bool, error) {
+	switch vec.GetType().Oid {
+	case types.T_int8:
+		if x >= math.MinInt8 && x <= math.MaxInt8 {
+			return true, vector.AppendFixed(vec, int8(x), false, mp)
+		}
+	case types.T_int16:
+		if x >= math.MinInt16 && x <= math.MaxInt16 {
+			return true, vector.AppendFixed(vec, int16(x), false, mp)
+		}
+	case types.T_int32:
+		if x >= math.MinInt32 && x <= math.MaxInt32 {
+			return true, vector.AppendFixed(vec, int32(x), false, mp)
+		}
+	case types.T_int64:
+		return true, vector.AppendFixed(vec, x, false, mp)
+	case types.T_uint8:
+		if x >= 0 && x <= math.MaxUint8 {
+			return true, vector.AppendFixed(vec, uint8(x), false, mp)
+		}
+	case types.T_uint16:
+		if x >= 0 && x <= math.MaxUint16 {
+			return true, vector.AppendFixed(vec, uint16(x), false, mp)
+		}
+	case types.T_uint32:
+		if x >= 0 && x <= math.MaxUint32 {
+			return true, vector.AppendFixed(vec, uint32(x), false, mp)
+		}
+	case types.T_uint64:
+		if x >= 0 {
+			return true, vector.AppendFixed(vec, uint64(x), false, mp)
+		}
+	}
+	return false, nil
+}
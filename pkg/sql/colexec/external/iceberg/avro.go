@@ -0,0 +1,624 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"hash/crc32"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Manifest lists and manifests are Avro object container files. Only the
+// parts of Avro they use are supported: values are decoded generically, with
+// records as map[string]any, arrays as []any, maps as map[string]any, enums
+// as their symbol, int as int32, long as int64, float as float32, double as
+// float64, bytes and fixed as []byte and unions as the value of the branch.
+
+var avroMagic = []byte{'O', 'b', 'j', 1}
+
+const avroSyncSize = 16
+
+func errCorruptAvro(what string) error {
+	return moerr.NewInvalidInputNoCtxf("corrupt avro file: bad %s", what)
+}
+
+type avroSchema struct {
+	typ         string
+	name        string
+	fields      []avroField
+	items       *avroSchema
+	values      *avroSchema
+	branches    []*avroSchema
+	size        int
+	symbols     []string
+	logicalType string
+}
+
+type avroField struct {
+	name   string
+	schema *avroSchema
+}
+
+// parseAvroSchema parses the JSON form of a schema.
+func parseAvroSchema(data []byte) (*avroSchema, error) {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, moerr.NewInvalidInputNoCtxf("invalid avro schema: %v", err)
+	}
+	return parseAvroSchemaValue(v, make(map[string]*avroSchema), "")
+}
+
+func parseAvroSchemaValue(v any, names map[string]*avroSchema, namespace string) (*avroSchema, error) {
+	switch t := v.(type) {
+	case string:
+		switch t {
+		case "null", "boolean", "int", "long", "float", "double", "bytes", "string":
+			return &avroSchema{typ: t}, nil
+		}
+		if s, ok := names[t]; ok {
+			return s, nil
+		}
+		if s, ok := names[namespace+"."+t]; ok {
+			return s, nil
+		}
+		return nil, moerr.NewInvalidInputNoCtxf("invalid avro schema: unknown type %s", t)
+	case []any:
+		s := &avroSchema{typ: "union"}
+		for _, b := range t {
+			branch, err := parseAvroSchemaValue(b, names, namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.branches = append(s.branches, branch)
+		}
+		return s, nil
+	case map[string]any:
+		typ, _ := t["type"].(string)
+		s := &avroSchema{typ: typ}
+		s.logicalType, _ = t["logicalType"].(string)
+		switch typ {
+		case "record", "error", "enum", "fixed":
+			s.typ = strings.Replace(typ, "error", "record", 1)
+			s.name, _ = t["name"].(string)
+			if ns, ok := t["namespace"].(string); ok {
+				namespace = ns
+			}
+			names[s.name] = s
+			if namespace != "" && !strings.Contains(s.name, ".") {
+				names[namespace+"."+s.name] = s
+			}
+		}
+		switch s.typ {
+		case "record":
+			fields, _ := t["fields"].([]any)
+			for _, f := range fields {
+				fm, ok := f.(map[string]any)
+				if !ok {
+					return nil, moerr.NewInvalidInputNoCtx("invalid avro schema: bad record field")
+				}
+				name, _ := fm["name"].(string)
+				fs, err := parseAvroSchemaValue(fm["type"], names, namespace)
+				if err != nil {
+					return nil, err
+				}
+				s.fields = append(s.fields, avroField{name: name, schema: fs})
+			}
+		case "enum":
+			symbols, _ := t["symbols"].([]any)
+			for _, sym := range symbols {
+				str, _ := sym.(string)
+				s.symbols = append(s.symbols, str)
+			}
+		case "fixed":
+			size, _ := t["size"].(float64)
+			s.size = int(size)
+		case "array":
+			items, err := parseAvroSchemaValue(t["items"], names, namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.items = items
+		case "map":
+			values, err := parseAvroSchemaValue(t["values"], names, namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.values = values
+		case "null", "boolean", "int", "long", "float", "double", "bytes", "string":
+		default:
+			return nil, moerr.NewInvalidInputNoCtxf("invalid avro schema: unknown type %s", typ)
+		}
+		return s, nil
+	}
+	return nil, moerr.NewInvalidInputNoCtx("invalid avro schema")
+}
+
+type avroDecoder struct {
+	buf []byte
+}
+
+func (d *avroDecoder) readLong() (int64, error) {
+	u, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		return 0, errCorruptAvro("varint")
+	}
+	d.buf = d.buf[n:]
+	return int64(u>>1) ^ -int64(u&1), nil
+}
+
+func (d *avroDecoder) readN(n int) ([]byte, error) {
+	if n < 0 || n > len(d.buf) {
+		return nil, errCorruptAvro("length")
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b, nil
+}
+
+func (d *avroDecoder) readBytes() ([]byte, error) {
+	n, err := d.readLong()
+	if err != nil {
+		return nil, err
+	}
+	return d.readN(int(n))
+}
+
+// readBlockCount reads the item count of the next block of an array or a
+// map. A negative count is followed by the size of the block in bytes.
+func (d *avroDecoder) readBlockCount() (int64, error) {
+	n, err := d.readLong()
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		if _, err = d.readLong(); err != nil {
+			return 0, err
+		}
+		n = -n
+	}
+	return n, nil
+}
+
+func (d *avroDecoder) decode(s *avroSchema) (any, error) {
+	switch s.typ {
+	case "null":
+		return nil, nil
+	case "boolean":
+		b, err := d.readN(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0] != 0, nil
+	case "int":
+		v, err := d.readLong()
+		return int32(v), err
+	case "long":
+		return d.readLong()
+	case "float":
+		b, err := d.readN(4)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+	case "double":
+		b, err := d.readN(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case "bytes":
+		b, err := d.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		return bytes.Clone(b), nil
+	case "string":
+		b, err := d.readBytes()
+		return string(b), err
+	case "fixed":
+		b, err := d.readN(s.size)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.Clone(b), nil
+	case "enum":
+		i, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || int(i) >= len(s.symbols) {
+			return nil, errCorruptAvro("enum index")
+		}
+		return s.symbols[i], nil
+	case "union":
+		i, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || int(i) >= len(s.branches) {
+			return nil, errCorruptAvro("union index")
+		}
+		return d.decode(s.branches[i])
+	case "record":
+		rec := make(map[string]any, len(s.fields))
+		for _, f := range s.fields {
+			v, err := d.decode(f.schema)
+			if err != nil {
+				return nil, err
+			}
+			rec[f.name] = v
+		}
+		return rec, nil
+	case "array":
+		var arr []any
+		for {
+			n, err := d.readBlockCount()
+			if err != nil {
+				return nil, err
+			}
+			if n == 0 {
+				return arr, nil
+			}
+			for ; n > 0; n-- {
+				v, err := d.decode(s.items)
+				if err != nil {
+					return nil, err
+				}
+				arr = append(arr, v)
+			}
+		}
+	case "map":
+		m := make(map[string]any)
+		for {
+			n, err := d.readBlockCount()
+			if err != nil {
+				return nil, err
+			}
+			if n == 0 {
+				return m, nil
+			}
+			for ; n > 0; n-- {
+				k, err := d.readBytes()
+				if err != nil {
+					return nil, err
+				}
+				v, err := d.decode(s.values)
+				if err != nil {
+					return nil, err
+				}
+				m[string(k)] = v
+			}
+		}
+	}
+	return nil, moerr.NewNYINoCtxf("avro type %s", s.typ)
+}
+
+// avroFile is a decoded object container file.
+type avroFile struct {
+	meta    map[string][]byte
+	schema  *avroSchema
+	records []any
+}
+
+func readAvroFile(data []byte) (*avroFile, error) {
+	if !bytes.HasPrefix(data, avroMagic) {
+		return nil, moerr.NewInvalidInputNoCtx("not an avro file")
+	}
+	d := &avroDecoder{buf: data[len(avroMagic):]}
+	meta, err := d.decode(&avroSchema{typ: "map", values: &avroSchema{typ: "bytes"}})
+	if err != nil {
+		return nil, err
+	}
+	f := &avroFile{meta: make(map[string][]byte)}
+	for k, v := range meta.(map[string]any) {
+		f.meta[k] = v.([]byte)
+	}
+	if f.schema, err = parseAvroSchema(f.meta["avro.schema"]); err != nil {
+		return nil, err
+	}
+	codec := string(f.meta["avro.codec"])
+	sync, err := d.readN(avroSyncSize)
+	if err != nil {
+		return nil, err
+	}
+	for len(d.buf) > 0 {
+		count, err := d.readLong()
+		if err != nil {
+			return nil, err
+		}
+		block, err := d.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		if block, err = avroDecompress(codec, block); err != nil {
+			return nil, err
+		}
+		marker, err := d.readN(avroSyncSize)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(marker, sync) {
+			return nil, errCorruptAvro("sync marker")
+		}
+		bd := &avroDecoder{buf: block}
+		for ; count > 0; count-- {
+			rec, err := bd.decode(f.schema)
+			if err != nil {
+				return nil, err
+			}
+			f.records = append(f.records, rec)
+		}
+	}
+	return f, nil
+}
+
+func avroDecompress(codec string, block []byte) ([]byte, error) {
+	switch codec {
+	case "", "null":
+		return block, nil
+	case "deflate":
+		r := flate.NewReader(bytes.NewReader(block))
+		defer r.Close()
+		out, err := io.ReadAll(r)
+		if err != nil {
+			return nil, errCorruptAvro("deflate block")
+		}
+		return out, nil
+	case "snappy":
+		// the compressed data is followed by the CRC32 of the uncompressed data
+		if len(block) < 4 {
+			return nil, errCorruptAvro("snappy block")
+		}
+		out, err := snappy.Decode(nil, block[:len(block)-4])
+		if err != nil || crc32.ChecksumIEEE(out) != binary.BigEndian.Uint32(block[len(block)-4:]) {
+			return nil, errCorruptAvro("snappy block")
+		}
+		return out, nil
+	case "zstandard":
+		dec, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		defer dec.Close()
+		out, err := dec.DecodeAll(block, nil)
+		if err != nil {
+			return nil, errCorruptAvro("zstandard block")
+		}
+		return out, nil
+	}
+	return nil, moerr.NewNYINoCtxf("avro codec %s", codec)
+}
+
+type avroEncoder struct {
+	buf []byte
+}
+
+func (e *avroEncoder) writeLong(v int64) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(v<<1)^uint64(v>>63))
+}
+
+func (e *avroEncoder) writeBytes(b []byte) {
+	e.writeLong(int64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+func (e *avroEncoder) encode(s *avroSchema, v any) error {
+	switch s.typ {
+	case "null":
+		return nil
+	case "boolean":
+		b, _ := v.(bool)
+		if b {
+			e.buf = append(e.buf, 1)
+		} else {
+			e.buf = append(e.buf, 0)
+		}
+		return nil
+	case "int", "long":
+		i, ok := avroInt(v)
+		if !ok {
+			return moerr.NewInvalidInputNoCtxf("cannot encode %T as avro %s", v, s.typ)
+		}
+		e.writeLong(i)
+		return nil
+	case "float":
+		f, _ := v.(float32)
+		e.buf = binary.LittleEndian.AppendUint32(e.buf, math.Float32bits(f))
+		return nil
+	case "double":
+		f, _ := v.(float64)
+		e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(f))
+		return nil
+	case "bytes":
+		b, _ := v.([]byte)
+		e.writeBytes(b)
+		return nil
+	case "string":
+		str, _ := v.(string)
+		e.writeBytes([]byte(str))
+		return nil
+	case "fixed":
+		b, _ := v.([]byte)
+		if len(b) != s.size {
+			return moerr.NewInvalidInputNoCtxf("avro fixed %s needs %d bytes, got %d", s.name, s.size, len(b))
+		}
+		e.buf = append(e.buf, b...)
+		return nil
+	case "enum":
+		str, _ := v.(string)
+		for i, sym := range s.symbols {
+			if sym == str {
+				e.writeLong(int64(i))
+				return nil
+			}
+		}
+		return moerr.NewInvalidInputNoCtxf("unknown avro enum symbol %s", str)
+	case "union":
+		for i, b := range s.branches {
+			if avroMatches(b, v) {
+				e.writeLong(int64(i))
+				return e.encode(b, v)
+			}
+		}
+		return moerr.NewInvalidInputNoCtxf("no avro union branch for %T", v)
+	case "record":
+		rec, _ := v.(map[string]any)
+		for _, f := range s.fields {
+			if err := e.encode(f.schema, rec[f.name]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "array":
+		arr, _ := v.([]any)
+		if len(arr) > 0 {
+			e.writeLong(int64(len(arr)))
+			for _, item := range arr {
+				if err := e.encode(s.items, item); err != nil {
+					return err
+				}
+			}
+		}
+		e.writeLong(0)
+		return nil
+	case "map":
+		m, _ := v.(map[string]any)
+		if len(m) > 0 {
+			e.writeLong(int64(len(m)))
+			for k, item := range m {
+				e.writeBytes([]byte(k))
+				if err := e.encode(s.values, item); err != nil {
+					return err
+				}
+			}
+		}
+		e.writeLong(0)
+		return nil
+	}
+	return moerr.NewNYINoCtxf("avro type %s", s.typ)
+}
+
+func avroInt(v any) (int64, bool) {
+	switch i := v.(type) {
+	case int:
+		return int64(i), true
+	case int32:
+		return int64(i), true
+	case int64:
+		return i, true
+	}
+	return 0, false
+}
+
+// avroMatches reports whether v can be encoded as s, to pick a union branch.
+func avroMatches(s *avroSchema, v any) bool {
+	switch s.typ {
+	case "null":
+		return v == nil
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "int", "long":
+		_, ok := avroInt(v)
+		return ok
+	case "float":
+		_, ok := v.(float32)
+		return ok
+	case "double":
+		_, ok := v.(float64)
+		return ok
+	case "bytes", "fixed":
+		_, ok := v.([]byte)
+		return ok
+	case "string", "enum":
+		_, ok := v.(string)
+		return ok
+	case "record", "map":
+		_, ok := v.(map[string]any)
+		return ok
+	case "array":
+		_, ok := v.([]any)
+		return ok
+	}
+	return false
+}
+
+// writeAvroFile writes records as an uncompressed or deflated object
+// container file with a single block.
+func writeAvroFile(w io.Writer, schema string, codec string, meta map[string]string, records []any) error {
+	s, err := parseAvroSchema([]byte(schema))
+	if err != nil {
+		return err
+	}
+	header := make(map[string]any, len(meta)+2)
+	for k, v := range meta {
+		header[k] = []byte(v)
+	}
+	header["avro.schema"] = []byte(schema)
+	header["avro.codec"] = []byte(codec)
+
+	e := &avroEncoder{buf: append([]byte{}, avroMagic...)}
+	if err = e.encode(&avroSchema{typ: "map", values: &avroSchema{typ: "bytes"}}, header); err != nil {
+		return err
+	}
+	sync := make([]byte, avroSyncSize)
+	if _, err = rand.Read(sync); err != nil {
+		return err
+	}
+	e.buf = append(e.buf, sync...)
+
+	body := &avroEncoder{}
+	for _, rec := range records {
+		if err = body.encode(s, rec); err != nil {
+			return err
+		}
+	}
+	block := body.buf
+	switch codec {
+	case "null":
+	case "deflate":
+		var buf bytes.Buffer
+		fw, err := flate.NewWriter(&buf, flate.DefaultCompression)
+		if err != nil {
+			return err
+		}
+		if _, err = fw.Write(block); err != nil {
+			return err
+		}
+		if err = fw.Close(); err != nil {
+			return err
+		}
+		block = buf.Bytes()
+	default:
+		return moerr.NewNYINoCtxf("avro codec %s", codec)
+	}
+	if len(records) > 0 {
+		e.writeLong(int64(len(records)))
+		e.writeBytes(block)
+		e.buf = append(e.buf, sync...)
+	}
+	_, err = w.Write(e.buf)
+	return err
+}
@@ -0,0 +1,151 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+const avroTestSchema = `{"type":"record","name":"r","namespace":"ns","fields":[
+{"name":"id","type":"long"},
+{"name":"name","type":["null","string"]},
+{"name":"score","type":"double"},
+{"name":"kind","type":{"type":"enum","name":"kind","symbols":["A","B"]}},
+{"name":"tags","type":{"type":"array","items":"string"}},
+{"name":"attrs","type":{"type":"map","values":"int"}},
+{"name":"hash","type":{"type":"fixed","name":"md5","size":4}},
+{"name":"child","type":["null",{"type":"record","name":"child","fields":[{"name":"v","type":"float"}]}]},
+{"name":"other","type":["null","ns.child"]}]}`
+
+func avroTestRecords() []any {
+	return []any{
+		map[string]any{
+			"id": int64(1), "name": "a", "score": 1.5, "kind": "B",
+			"tags":  []any{"x", "y"},
+			"attrs": map[string]any{"k": int32(7)},
+			"hash":  []byte{1, 2, 3, 4},
+			"child": map[string]any{"v": float32(2.5)},
+		},
+		map[string]any{
+			"id": int64(-2), "name": nil, "score": -0.25, "kind": "A",
+			"tags":  []any{},
+			"attrs": map[string]any{},
+			"hash":  []byte{5, 6, 7, 8},
+			"other": map[string]any{"v": float32(-1)},
+		},
+	}
+}
+
+func TestAvro_RoundTrip(t *testing.T) {
+	for _, codec := range []string{"null", "deflate"} {
+		var buf bytes.Buffer
+		require.NoError(t, writeAvroFile(&buf, avroTestSchema, codec, map[string]string{"k": "v"}, avroTestRecords()))
+		f, err := readAvroFile(buf.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, "v", string(f.meta["k"]))
+		require.Len(t, f.records, 2)
+
+		r0 := f.records[0].(map[string]any)
+		require.Equal(t, int64(1), r0["id"])
+		require.Equal(t, "a", r0["name"])
+		require.Equal(t, 1.5, r0["score"])
+		require.Equal(t, "B", r0["kind"])
+		require.Equal(t, []any{"x", "y"}, r0["tags"])
+		require.Equal(t, map[string]any{"k": int32(7)}, r0["attrs"])
+		require.Equal(t, []byte{1, 2, 3, 4}, r0["hash"])
+		require.Equal(t, map[string]any{"v": float32(2.5)}, r0["child"])
+		require.Nil(t, r0["other"])
+
+		r1 := f.records[1].(map[string]any)
+		require.Equal(t, int64(-2), r1["id"])
+		require.Nil(t, r1["name"])
+		require.Empty(t, r1["tags"])
+		require.Equal(t, map[string]any{"v": float32(-1)}, r1["other"])
+	}
+}
+
+// writeCompressedAvro writes records in one block compressed by compress.
+func writeCompressedAvro(t *testing.T, codec string, records []any, compress func([]byte) []byte) []byte {
+	s, err := parseAvroSchema([]byte(avroTestSchema))
+	require.NoError(t, err)
+	e := &avroEncoder{buf: append([]byte{}, avroMagic...)}
+	require.NoError(t, e.encode(&avroSchema{typ: "map", values: &avroSchema{typ: "bytes"}}, map[string]any{
+		"avro.schema": []byte(avroTestSchema),
+		"avro.codec":  []byte(codec),
+	}))
+	sync := bytes.Repeat([]byte{9}, avroSyncSize)
+	e.buf = append(e.buf, sync...)
+	body := &avroEncoder{}
+	for _, rec := range records {
+		require.NoError(t, body.encode(s, rec))
+	}
+	e.writeLong(int64(len(records)))
+	e.writeBytes(compress(body.buf))
+	e.buf = append(e.buf, sync...)
+	return e.buf
+}
+
+func TestAvro_Codecs(t *testing.T) {
+	records := avroTestRecords()
+	snappyData := writeCompressedAvro(t, "snappy", records, func(b []byte) []byte {
+		return binary.BigEndian.AppendUint32(snappy.Encode(nil, b), crc32.ChecksumIEEE(b))
+	})
+	zstdData := writeCompressedAvro(t, "zstandard", records, func(b []byte) []byte {
+		enc, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+		defer enc.Close()
+		return enc.EncodeAll(b, nil)
+	})
+	for _, data := range [][]byte{snappyData, zstdData} {
+		f, err := readAvroFile(data)
+		require.NoError(t, err)
+		require.Len(t, f.records, 2)
+		require.Equal(t, "a", f.records[0].(map[string]any)["name"])
+	}
+
+	// a bad checksum is detected
+	bad := writeCompressedAvro(t, "snappy", records, func(b []byte) []byte {
+		return binary.BigEndian.AppendUint32(snappy.Encode(nil, b), 0)
+	})
+	_, err := readAvroFile(bad)
+	require.Error(t, err)
+}
+
+func TestAvro_Errors(t *testing.T) {
+	_, err := readAvroFile([]byte("PAR1"))
+	require.Error(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeAvroFile(&buf, avroTestSchema, "null", nil, avroTestRecords()))
+	data := buf.Bytes()
+	// truncated block
+	_, err = readAvroFile(data[:len(data)-20])
+	require.Error(t, err)
+	// bad sync marker
+	data[len(data)-1] ^= 0xff
+	_, err = readAvroFile(data)
+	require.Error(t, err)
+
+	_, err = parseAvroSchema([]byte(`{"type":"record","name":"r","fields":[{"name":"a","type":"unknown"}]}`))
+	require.Error(t, err)
+	require.Error(t, writeAvroFile(&buf, avroTestSchema, "bzip2", nil, nil))
+}
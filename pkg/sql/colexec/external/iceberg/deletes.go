@@ -0,0 +1,437 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external/orc"
+	"github.com/parquet-go/parquet-go"
+)
+
+// DeleteLoader resolves the rows removed from data files by delete files.
+// Delete files are read once and cached, as they usually apply to many
+// data files.
+type DeleteLoader struct {
+	io     FileIO
+	schema *Schema
+	// delete file path -> data file path -> deleted positions
+	positions map[string]map[string][]int64
+	// delete file path -> keys of the deleted rows
+	equalities map[string]map[string]struct{}
+}
+
+func NewDeleteLoader(fio FileIO, schema *Schema) *DeleteLoader {
+	return &DeleteLoader{
+		io:         fio,
+		schema:     schema,
+		positions:  make(map[string]map[string][]int64),
+		equalities: make(map[string]map[string]struct{}),
+	}
+}
+
+// DeletedRows returns the sorted positions of the deleted rows of the data
+// file of task.
+func (l *DeleteLoader) DeletedRows(ctx context.Context, task *FileScanTask) ([]int64, error) {
+	var rows []int64
+	var eqDeletes []*DataFile
+	for _, del := range task.Deletes {
+		switch del.Content {
+		case ContentPositionDeletes:
+			positions, err := l.loadPositions(ctx, del)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, positions[task.File.Path]...)
+		case ContentEqualityDeletes:
+			eqDeletes = append(eqDeletes, del)
+		}
+	}
+	if len(eqDeletes) > 0 {
+		deleted, err := l.matchEqualities(ctx, task.File, eqDeletes)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, deleted...)
+	}
+	slices.Sort(rows)
+	return slices.Compact(rows), nil
+}
+
+func (l *DeleteLoader) loadPositions(ctx context.Context, del *DataFile) (map[string][]int64, error) {
+	if positions, ok := l.positions[del.Path]; ok {
+		return positions, nil
+	}
+	cols, err := readColumns(ctx, l.io, del, []Field{
+		{ID: positionDeleteFilePathID, Name: "file_path", Type: Type{Kind: "string"}},
+		{ID: positionDeletePosID, Name: "pos", Type: Type{Kind: "long"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	positions := make(map[string][]int64)
+	for i := range cols[0] {
+		path, ok1 := cols[0][i].(string)
+		pos, ok2 := cols[1][i].(int64)
+		if !ok1 || !ok2 {
+			return nil, moerr.NewInvalidInputNoCtxf("invalid iceberg position delete file %s", del.Path)
+		}
+		positions[path] = append(positions[path], pos)
+	}
+	l.positions[del.Path] = positions
+	return positions, nil
+}
+
+func (l *DeleteLoader) equalityFields(del *DataFile) ([]Field, error) {
+	fields := make([]Field, len(del.EqualityIDs))
+	for i, id := range del.EqualityIDs {
+		var f *Field
+		if del.Schema != nil {
+			f = del.Schema.FieldByID(id)
+		}
+		if f == nil {
+			f = l.schema.FieldByID(id)
+		}
+		if f == nil || f.Type.IsNested() {
+			return nil, moerr.NewInvalidInputNoCtxf("invalid equality field %d of iceberg delete file %s", id, del.Path)
+		}
+		fields[i] = *f
+	}
+	return fields, nil
+}
+
+func (l *DeleteLoader) loadEqualities(ctx context.Context, del *DataFile, fields []Field) (map[string]struct{}, error) {
+	if keys, ok := l.equalities[del.Path]; ok {
+		return keys, nil
+	}
+	cols, err := readColumns(ctx, l.io, del, fields)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]struct{})
+	if len(cols) > 0 {
+		for i := range cols[0] {
+			keys[rowKey(cols, i)] = struct{}{}
+		}
+	}
+	l.equalities[del.Path] = keys
+	return keys, nil
+}
+
+// matchEqualities returns the positions of the rows of df equal to a row of
+// one of the equality delete files on its equality fields.
+func (l *DeleteLoader) matchEqualities(ctx context.Context, df *DataFile, deletes []*DataFile) ([]int64, error) {
+	var rows []int64
+	// the data file columns read for a set of equality ids
+	dataCols := make(map[string][][]any)
+	for _, del := range deletes {
+		fields, err := l.equalityFields(del)
+		if err != nil {
+			return nil, err
+		}
+		keys, err := l.loadEqualities(ctx, del, fields)
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		// read the columns of the data file by the field ids of its own schema
+		dataFields := make([]Field, len(fields))
+		for i := range fields {
+			dataFields[i] = fields[i]
+			if df.Schema != nil {
+				if f := df.Schema.FieldByID(fields[i].ID); f != nil {
+					dataFields[i].Name = f.Name
+				}
+			}
+		}
+		idsKey := idsKey(del.EqualityIDs)
+		cols, ok := dataCols[idsKey]
+		if !ok {
+			if cols, err = readColumns(ctx, l.io, df, dataFields); err != nil {
+				return nil, err
+			}
+			dataCols[idsKey] = cols
+		}
+		for i := range cols[0] {
+			if _, ok := keys[rowKey(cols, i)]; ok {
+				rows = append(rows, int64(i))
+			}
+		}
+	}
+	return rows, nil
+}
+
+func idsKey(ids []int) string {
+	b := make([]byte, 0, len(ids)*4)
+	for _, id := range ids {
+		b = binary.LittleEndian.AppendUint32(b, uint32(id))
+	}
+	return string(b)
+}
+
+// rowKey encodes row i of cols, values of the same type and value encode to
+// the same key. Nulls are equal to each other.
+func rowKey(cols [][]any, i int) string {
+	var b []byte
+	for _, col := range cols {
+		switch v := col[i].(type) {
+		case nil:
+			b = append(b, 0)
+		case bool:
+			if v {
+				b = append(b, 1, 1)
+			} else {
+				b = append(b, 1, 0)
+			}
+		case int64:
+			b = binary.LittleEndian.AppendUint64(append(b, 2), uint64(v))
+		case float64:
+			b = binary.LittleEndian.AppendUint64(append(b, 3), math.Float64bits(v))
+		case string:
+			b = binary.AppendUvarint(append(b, 4), uint64(len(v)))
+			b = append(b, v...)
+		case []byte:
+			b = binary.AppendUvarint(append(b, 5), uint64(len(v)))
+			b = append(b, v...)
+		case *big.Int:
+			s := v.String()
+			b = binary.AppendUvarint(append(b, 6), uint64(len(s)))
+			b = append(b, s...)
+		}
+	}
+	return string(b)
+}
+
+// readColumns reads the values of fields from all rows of a data or delete
+// file, in the form of DecodeValue. A field the file lacks reads as nulls.
+func readColumns(ctx context.Context, fio FileIO, df *DataFile, fields []Field) ([][]any, error) {
+	r, err := fio.Open(ctx, df.Path, df.FileSize)
+	if err != nil {
+		return nil, err
+	}
+	switch df.Format {
+	case "PARQUET":
+		return readParquetColumns(r, df, fields)
+	case "ORC":
+		return readOrcColumns(r, df, fields)
+	}
+	return nil, moerr.NewNYINoCtxf("iceberg %s file %s", strings.ToLower(df.Format), df.Path)
+}
+
+// findParquetColumn finds the column of a field by its field id, or by its
+// name in files written without field ids.
+func findParquetColumn(root *parquet.Column, f *Field) *parquet.Column {
+	var byName *parquet.Column
+	hasIDs := false
+	for _, col := range root.Columns() {
+		if col.ID() != 0 {
+			hasIDs = true
+			if col.ID() == f.ID {
+				return col
+			}
+		}
+		if byName == nil && strings.EqualFold(col.Name(), f.Name) {
+			byName = col
+		}
+	}
+	if hasIDs {
+		return nil
+	}
+	return byName
+}
+
+func readParquetColumns(r io.ReaderAt, df *DataFile, fields []Field) ([][]any, error) {
+	pf, err := parquet.OpenFile(r, df.FileSize)
+	if err != nil {
+		return nil, err
+	}
+	rows := pf.NumRows()
+	cols := make([][]any, len(fields))
+	for i := range fields {
+		col := findParquetColumn(pf.Root(), &fields[i])
+		if col == nil {
+			cols[i] = make([]any, rows)
+			continue
+		}
+		if !col.Leaf() {
+			return nil, moerr.NewInvalidInputNoCtxf("iceberg column %s of %s is not a primitive column", fields[i].Name, df.Path)
+		}
+		values := make([]any, 0, rows)
+		buf := make([]parquet.Value, 1024)
+		for _, rg := range pf.RowGroups() {
+			pages := rg.ColumnChunks()[col.Index()].Pages()
+			for {
+				page, err := pages.ReadPage()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					pages.Close()
+					return nil, err
+				}
+				vr := page.Values()
+				for {
+					n, err := vr.ReadValues(buf)
+					for _, v := range buf[:n] {
+						value, convErr := parquetValue(fields[i].Type, v)
+						if convErr != nil {
+							pages.Close()
+							return nil, convErr
+						}
+						values = append(values, value)
+					}
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					if err != nil {
+						pages.Close()
+						return nil, err
+					}
+				}
+			}
+			pages.Close()
+		}
+		cols[i] = values
+	}
+	return cols, nil
+}
+
+func parquetValue(t Type, v parquet.Value) (any, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+	switch v.Kind() {
+	case parquet.Boolean:
+		return v.Boolean(), nil
+	case parquet.Int32:
+		if t.Kind == "decimal" {
+			return big.NewInt(int64(v.Int32())), nil
+		}
+		return int64(v.Int32()), nil
+	case parquet.Int64:
+		if t.Kind == "decimal" {
+			return big.NewInt(v.Int64()), nil
+		}
+		return v.Int64(), nil
+	case parquet.Float:
+		return float64(v.Float()), nil
+	case parquet.Double:
+		return v.Double(), nil
+	case parquet.ByteArray, parquet.FixedLenByteArray:
+		switch t.Kind {
+		case "string":
+			return string(v.ByteArray()), nil
+		case "decimal":
+			return decimalFromBytes(v.ByteArray()), nil
+		}
+		return slices.Clone(v.ByteArray()), nil
+	}
+	return nil, moerr.NewNYINoCtxf("iceberg %s column of parquet %s", t, v.Kind())
+}
+
+func readOrcColumns(r io.ReaderAt, df *DataFile, fields []Field) ([][]any, error) {
+	f, err := orc.Open(r, df.FileSize)
+	if err != nil {
+		return nil, err
+	}
+	typs := f.Types()
+	root := &typs[0]
+	ids := make([]int, 0, len(fields))
+	found := make([]bool, len(fields))
+	for i := range fields {
+		for j, name := range root.FieldNames {
+			if j < len(root.Subtypes) && strings.EqualFold(name, fields[i].Name) {
+				ids = append(ids, int(root.Subtypes[j]))
+				found[i] = true
+				break
+			}
+		}
+	}
+	rows := f.NumRows()
+	cols := make([][]any, len(fields))
+	for i := range cols {
+		cols[i] = make([]any, 0, rows)
+	}
+	for s := 0; s < f.NumStripes(); s++ {
+		stripe, err := f.ReadStripe(s, ids)
+		if err != nil {
+			return nil, err
+		}
+		n := int(f.Footer.Stripes[s].NumberOfRows)
+		k := 0
+		for i := range fields {
+			if !found[i] {
+				cols[i] = append(cols[i], make([]any, n)...)
+				continue
+			}
+			col := stripe[k]
+			k++
+			for j := 0; j < n; j++ {
+				v, err := orcValue(fields[i].Type, col, j)
+				if err != nil {
+					return nil, err
+				}
+				cols[i] = append(cols[i], v)
+			}
+		}
+	}
+	return cols, nil
+}
+
+func orcValue(t Type, col *orc.Column, i int) (any, error) {
+	if col.IsNull(i) {
+		return nil, nil
+	}
+	switch col.Type.Kind {
+	case orc.KindBoolean:
+		return col.Ints[i] != 0, nil
+	case orc.KindByte, orc.KindShort, orc.KindInt, orc.KindLong, orc.KindDate:
+		return col.Ints[i], nil
+	case orc.KindFloat, orc.KindDouble:
+		return col.Floats[i], nil
+	case orc.KindString, orc.KindVarchar, orc.KindChar:
+		return string(col.Bytes[i]), nil
+	case orc.KindBinary:
+		return slices.Clone(col.Bytes[i]), nil
+	case orc.KindDecimal:
+		d := col.Decimals[i]
+		v := new(big.Int).Lsh(big.NewInt(d.Hi), 64)
+		v.Add(v, new(big.Int).SetUint64(d.Lo))
+		// rescale to the scale of the type
+		if diff := t.Scale - int(col.Scales[i]); diff > 0 {
+			v.Mul(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(diff)), nil))
+		} else if diff < 0 {
+			v.Quo(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-diff)), nil))
+		}
+		return v, nil
+	case orc.KindTimestamp, orc.KindTimestampInstant:
+		ts := col.Timestamps[i]
+		if strings.HasSuffix(t.Kind, "_ns") {
+			return ts.Seconds*1000000000 + int64(ts.Nanos), nil
+		}
+		return ts.Seconds*1000000 + int64(ts.Nanos)/1000, nil
+	}
+	return nil, moerr.NewNYINoCtxf("iceberg %s column of orc kind %d", t, col.Type.Kind)
+}
@@ -0,0 +1,411 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Content of manifests
+const (
+	ManifestContentData    = 0
+	ManifestContentDeletes = 1
+)
+
+// Content of data files
+const (
+	ContentData            = 0
+	ContentPositionDeletes = 1
+	ContentEqualityDeletes = 2
+)
+
+const (
+	entryStatusDeleted = 2
+
+	// reserved field ids of the columns of position delete files
+	positionDeleteFilePathID = 2147483546
+	positionDeletePosID      = 2147483545
+)
+
+// FieldSummary summarizes the values of a partition field in a manifest.
+type FieldSummary struct {
+	ContainsNull bool
+	ContainsNaN  bool
+	Lower, Upper []byte
+}
+
+// ManifestFile is an entry of a manifest list.
+type ManifestFile struct {
+	Path              string
+	Length            int64
+	SpecID            int
+	Content           int
+	SequenceNumber    int64
+	MinSequenceNumber int64
+	AddedSnapshotID   int64
+	Partitions        []FieldSummary
+}
+
+// DataFile is a live data or delete file of a manifest.
+type DataFile struct {
+	Content int
+	Path    string
+	// PARQUET, ORC, AVRO or PUFFIN
+	Format string
+	SpecID int
+	// Partition holds the partition values in the order of the fields of the
+	// spec, in the form of DecodeValue
+	Partition       []any
+	RecordCount     int64
+	FileSize        int64
+	ValueCounts     map[int]int64
+	NullValueCounts map[int]int64
+	NaNValueCounts  map[int]int64
+	LowerBounds     map[int][]byte
+	UpperBounds     map[int][]byte
+	EqualityIDs     []int
+	// SequenceNumber is the data sequence number, inherited from the
+	// manifest for files added by its snapshot
+	SequenceNumber int64
+	// Schema is the schema the manifest was written with, nil if unknown
+	Schema *Schema
+}
+
+func recordInt(rec map[string]any, name string) (int64, bool) {
+	return avroInt(rec[name])
+}
+
+func readManifestList(data []byte) ([]*ManifestFile, error) {
+	f, err := readAvroFile(data)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*ManifestFile, 0, len(f.records))
+	for _, r := range f.records {
+		rec, _ := r.(map[string]any)
+		mf := &ManifestFile{}
+		var ok bool
+		if mf.Path, ok = rec["manifest_path"].(string); !ok {
+			return nil, moerr.NewInvalidInputNoCtx("invalid iceberg manifest list: no manifest_path")
+		}
+		mf.Length, _ = recordInt(rec, "manifest_length")
+		specID, _ := recordInt(rec, "partition_spec_id")
+		mf.SpecID = int(specID)
+		content, _ := recordInt(rec, "content")
+		mf.Content = int(content)
+		mf.SequenceNumber, _ = recordInt(rec, "sequence_number")
+		mf.MinSequenceNumber, _ = recordInt(rec, "min_sequence_number")
+		mf.AddedSnapshotID, _ = recordInt(rec, "added_snapshot_id")
+		partitions, _ := rec["partitions"].([]any)
+		for _, p := range partitions {
+			pr, _ := p.(map[string]any)
+			s := FieldSummary{}
+			s.ContainsNull, _ = pr["contains_null"].(bool)
+			s.ContainsNaN, _ = pr["contains_nan"].(bool)
+			s.Lower, _ = pr["lower_bound"].([]byte)
+			s.Upper, _ = pr["upper_bound"].([]byte)
+			mf.Partitions = append(mf.Partitions, s)
+		}
+		files = append(files, mf)
+	}
+	return files, nil
+}
+
+// readIntMap reads the array of key-value records Iceberg writes for maps
+// keyed by field id.
+func readIntMap[T any](v any, conv func(any) (T, bool)) map[int]T {
+	arr, _ := v.([]any)
+	if len(arr) == 0 {
+		return nil
+	}
+	m := make(map[int]T, len(arr))
+	for _, kv := range arr {
+		rec, _ := kv.(map[string]any)
+		k, ok := avroInt(rec["key"])
+		if !ok {
+			continue
+		}
+		if val, ok := conv(rec["value"]); ok {
+			m[int(k)] = val
+		}
+	}
+	return m
+}
+
+func asBytes(v any) ([]byte, bool) {
+	b, ok := v.([]byte)
+	return b, ok
+}
+
+// readManifest returns the live files of a manifest.
+func readManifest(data []byte, mf *ManifestFile, spec *PartitionSpec, schema *Schema) ([]*DataFile, error) {
+	f, err := readAvroFile(data)
+	if err != nil {
+		return nil, err
+	}
+	var fileSchema *Schema
+	if b, ok := f.meta["schema"]; ok {
+		fileSchema = &Schema{}
+		if err = json.Unmarshal(b, fileSchema); err != nil {
+			fileSchema = nil
+		}
+	}
+	var files []*DataFile
+	for _, r := range f.records {
+		entry, _ := r.(map[string]any)
+		status, _ := recordInt(entry, "status")
+		if status == entryStatusDeleted {
+			continue
+		}
+		rec, ok := entry["data_file"].(map[string]any)
+		if !ok {
+			return nil, moerr.NewInvalidInputNoCtxf("invalid iceberg manifest %s: no data_file", mf.Path)
+		}
+		df := &DataFile{SpecID: mf.SpecID, Schema: fileSchema}
+		content, _ := recordInt(rec, "content")
+		df.Content = int(content)
+		df.Path, _ = rec["file_path"].(string)
+		df.Format, _ = rec["file_format"].(string)
+		df.Format = strings.ToUpper(df.Format)
+		df.RecordCount, _ = recordInt(rec, "record_count")
+		df.FileSize, _ = recordInt(rec, "file_size_in_bytes")
+		df.ValueCounts = readIntMap(rec["value_counts"], avroInt)
+		df.NullValueCounts = readIntMap(rec["null_value_counts"], avroInt)
+		df.NaNValueCounts = readIntMap(rec["nan_value_counts"], avroInt)
+		df.LowerBounds = readIntMap(rec["lower_bounds"], asBytes)
+		df.UpperBounds = readIntMap(rec["upper_bounds"], asBytes)
+		ids, _ := rec["equality_ids"].([]any)
+		for _, id := range ids {
+			if i, ok := avroInt(id); ok {
+				df.EqualityIDs = append(df.EqualityIDs, int(i))
+			}
+		}
+
+		// files added by the snapshot of the manifest inherit its sequence
+		// number, format version 1 tables have none
+		seq, ok := recordInt(entry, "sequence_number")
+		if !ok {
+			seq = mf.SequenceNumber
+		}
+		df.SequenceNumber = seq
+
+		partition, _ := rec["partition"].(map[string]any)
+		if spec != nil {
+			df.Partition = make([]any, len(spec.Fields))
+			for i := range spec.Fields {
+				pf := &spec.Fields[i]
+				if df.Partition[i], err = avroToValue(pf.ResultType(schema), partition[pf.Name]); err != nil {
+					return nil, err
+				}
+			}
+		}
+		files = append(files, df)
+	}
+	return files, nil
+}
+
+// The functions below write manifests and manifest lists of format version
+// 2 with the fields the scan reads.
+
+const manifestListSchema = `{"type":"record","name":"manifest_file","fields":[
+{"name":"manifest_path","type":"string","field-id":500},
+{"name":"manifest_length","type":"long","field-id":501},
+{"name":"partition_spec_id","type":"int","field-id":502},
+{"name":"content","type":"int","field-id":517},
+{"name":"sequence_number","type":"long","field-id":515},
+{"name":"min_sequence_number","type":"long","field-id":516},
+{"name":"added_snapshot_id","type":"long","field-id":503},
+{"name":"added_files_count","type":"int","field-id":504},
+{"name":"existing_files_count","type":"int","field-id":505},
+{"name":"deleted_files_count","type":"int","field-id":506},
+{"name":"added_rows_count","type":"long","field-id":512},
+{"name":"existing_rows_count","type":"long","field-id":513},
+{"name":"deleted_rows_count","type":"long","field-id":514},
+{"name":"partitions","type":["null",{"type":"array","items":{"type":"record","name":"r508","fields":[
+{"name":"contains_null","type":"boolean","field-id":509},
+{"name":"contains_nan","type":["null","boolean"],"field-id":518},
+{"name":"lower_bound","type":["null","bytes"],"field-id":510},
+{"name":"upper_bound","type":["null","bytes"],"field-id":511}]},"element-id":508}],"field-id":507}]}`
+
+// WriteManifestList writes a manifest list.
+func WriteManifestList(w io.Writer, snapshotID int64, files []*ManifestFile) error {
+	records := make([]any, len(files))
+	for i, mf := range files {
+		var partitions []any
+		for _, s := range mf.Partitions {
+			p := map[string]any{"contains_null": s.ContainsNull, "contains_nan": s.ContainsNaN}
+			if s.Lower != nil {
+				p["lower_bound"] = s.Lower
+			}
+			if s.Upper != nil {
+				p["upper_bound"] = s.Upper
+			}
+			partitions = append(partitions, p)
+		}
+		rec := map[string]any{
+			"manifest_path":        mf.Path,
+			"manifest_length":      mf.Length,
+			"partition_spec_id":    mf.SpecID,
+			"content":              mf.Content,
+			"sequence_number":      mf.SequenceNumber,
+			"min_sequence_number":  mf.MinSequenceNumber,
+			"added_snapshot_id":    mf.AddedSnapshotID,
+			"added_files_count":    0,
+			"existing_files_count": 0,
+			"deleted_files_count":  0,
+			"added_rows_count":     int64(0),
+			"existing_rows_count":  int64(0),
+			"deleted_rows_count":   int64(0),
+		}
+		if partitions != nil {
+			rec["partitions"] = partitions
+		}
+		records[i] = rec
+	}
+	return writeAvroFile(w, manifestListSchema, "deflate", map[string]string{
+		"snapshot-id":    strconv.FormatInt(snapshotID, 10),
+		"format-version": "2",
+	}, records)
+}
+
+func avroTypeJSON(t Type) string {
+	switch t.Kind {
+	case "boolean", "int", "long", "float", "double", "string":
+		return strconv.Quote(t.Kind)
+	case "date":
+		return `{"type":"int","logicalType":"date"}`
+	case "time":
+		return `{"type":"long","logicalType":"time-micros"}`
+	case "timestamp", "timestamptz":
+		return `{"type":"long","logicalType":"timestamp-micros"}`
+	case "timestamp_ns", "timestamptz_ns":
+		return `{"type":"long","logicalType":"timestamp-nanos"}`
+	case "decimal":
+		return fmt.Sprintf(`{"type":"bytes","logicalType":"decimal","precision":%d,"scale":%d}`, t.Precision, t.Scale)
+	}
+	return `"bytes"`
+}
+
+func intMapSchema(keyID, valueID int, value string) string {
+	return fmt.Sprintf(`["null",{"type":"array","logicalType":"map","items":{"type":"record","name":"k%d_v%d","fields":[`+
+		`{"name":"key","type":"int","field-id":%d},{"name":"value","type":%s,"field-id":%d}]}}]`,
+		keyID, valueID, keyID, value, valueID)
+}
+
+// WriteManifest writes a manifest of files added by snapshotID. Files with
+// a zero SequenceNumber inherit the sequence number of the manifest.
+func WriteManifest(w io.Writer, schema *Schema, spec *PartitionSpec, content int, snapshotID int64, files []*DataFile) error {
+	partitionFields := make([]string, len(spec.Fields))
+	for i := range spec.Fields {
+		pf := &spec.Fields[i]
+		partitionFields[i] = fmt.Sprintf(`{"name":%q,"type":["null",%s],"field-id":%d}`,
+			pf.Name, avroTypeJSON(pf.ResultType(schema)), pf.FieldID)
+	}
+	avroSchema := `{"type":"record","name":"manifest_entry","fields":[
+{"name":"status","type":"int","field-id":0},
+{"name":"snapshot_id","type":["null","long"],"field-id":1},
+{"name":"sequence_number","type":["null","long"],"field-id":3},
+{"name":"file_sequence_number","type":["null","long"],"field-id":4},
+{"name":"data_file","type":{"type":"record","name":"r2","fields":[
+{"name":"content","type":"int","field-id":134},
+{"name":"file_path","type":"string","field-id":100},
+{"name":"file_format","type":"string","field-id":101},
+{"name":"partition","type":{"type":"record","name":"r102","fields":[` + strings.Join(partitionFields, ",") + `]},"field-id":102},
+{"name":"record_count","type":"long","field-id":103},
+{"name":"file_size_in_bytes","type":"long","field-id":104},
+{"name":"value_counts","type":` + intMapSchema(119, 120, `"long"`) + `,"field-id":109},
+{"name":"null_value_counts","type":` + intMapSchema(121, 122, `"long"`) + `,"field-id":110},
+{"name":"nan_value_counts","type":` + intMapSchema(138, 139, `"long"`) + `,"field-id":137},
+{"name":"lower_bounds","type":` + intMapSchema(126, 127, `"bytes"`) + `,"field-id":125},
+{"name":"upper_bounds","type":` + intMapSchema(129, 130, `"bytes"`) + `,"field-id":128},
+{"name":"equality_ids","type":["null",{"type":"array","items":"int","element-id":136}],"field-id":135}
+]},"field-id":2}]}`
+
+	records := make([]any, len(files))
+	for i, df := range files {
+		partition := make(map[string]any, len(spec.Fields))
+		for j := range spec.Fields {
+			if j < len(df.Partition) && df.Partition[j] != nil {
+				pf := &spec.Fields[j]
+				partition[pf.Name] = valueToAvro(pf.ResultType(schema), df.Partition[j])
+			}
+		}
+		rec := map[string]any{
+			"content":            df.Content,
+			"file_path":          df.Path,
+			"file_format":        df.Format,
+			"partition":          partition,
+			"record_count":       df.RecordCount,
+			"file_size_in_bytes": df.FileSize,
+			"value_counts":       writeIntMap(df.ValueCounts),
+			"null_value_counts":  writeIntMap(df.NullValueCounts),
+			"nan_value_counts":   writeIntMap(df.NaNValueCounts),
+			"lower_bounds":       writeIntMap(df.LowerBounds),
+			"upper_bounds":       writeIntMap(df.UpperBounds),
+		}
+		if df.EqualityIDs != nil {
+			ids := make([]any, len(df.EqualityIDs))
+			for j, id := range df.EqualityIDs {
+				ids[j] = id
+			}
+			rec["equality_ids"] = ids
+		}
+		entry := map[string]any{
+			"status":      1,
+			"snapshot_id": snapshotID,
+			"data_file":   rec,
+		}
+		if df.SequenceNumber != 0 {
+			entry["sequence_number"] = df.SequenceNumber
+		}
+		records[i] = entry
+	}
+	schemaJSON, err := json.Marshal(schema)
+	if err != nil {
+		return err
+	}
+	specJSON, err := json.Marshal(spec.Fields)
+	if err != nil {
+		return err
+	}
+	contentName := "data"
+	if content == ManifestContentDeletes {
+		contentName = "deletes"
+	}
+	return writeAvroFile(w, avroSchema, "deflate", map[string]string{
+		"schema":            string(schemaJSON),
+		"partition-spec":    string(specJSON),
+		"partition-spec-id": strconv.Itoa(spec.ID),
+		"format-version":    "2",
+		"content":           contentName,
+	}, records)
+}
+
+func writeIntMap[T any](m map[int]T) any {
+	if m == nil {
+		return nil
+	}
+	arr := make([]any, 0, len(m))
+	for k, v := range m {
+		arr = append(arr, map[string]any{"key": k, "value": v})
+	}
+	return arr
+}
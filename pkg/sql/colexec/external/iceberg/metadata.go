@@ -0,0 +1,277 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Type is an Iceberg type. Nested types only keep their kind, the scan does
+// not look into them.
+type Type struct {
+	// boolean, int, long, float, double, decimal, date, time, timestamp,
+	// timestamptz, timestamp_ns, timestamptz_ns, string, uuid, fixed,
+	// binary, struct, list or map
+	Kind      string
+	Precision int
+	Scale     int
+	// Length is the length of fixed
+	Length int
+}
+
+func (t *Type) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err != nil {
+		var nested struct {
+			Type string `json:"type"`
+		}
+		if err = json.Unmarshal(b, &nested); err != nil {
+			return err
+		}
+		*t = Type{Kind: nested.Type}
+		return nil
+	}
+	parsed, err := ParseType(name)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+func (t Type) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// ParseType parses the name of a primitive type, such as decimal(9,2) or
+// fixed[16].
+func ParseType(name string) (Type, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	switch {
+	case strings.HasPrefix(name, "decimal(") && strings.HasSuffix(name, ")"):
+		parts := strings.Split(name[len("decimal("):len(name)-1], ",")
+		if len(parts) == 2 {
+			p, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
+			s, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
+			if err1 == nil && err2 == nil {
+				return Type{Kind: "decimal", Precision: p, Scale: s}, nil
+			}
+		}
+	case strings.HasPrefix(name, "fixed[") && strings.HasSuffix(name, "]"):
+		if l, err := strconv.Atoi(name[len("fixed[") : len(name)-1]); err == nil {
+			return Type{Kind: "fixed", Length: l}, nil
+		}
+	case name == "boolean", name == "int", name == "long", name == "float", name == "double",
+		name == "date", name == "time", name == "timestamp", name == "timestamptz",
+		name == "timestamp_ns", name == "timestamptz_ns", name == "string", name == "uuid",
+		name == "binary", name == "struct", name == "list", name == "map":
+		return Type{Kind: name}, nil
+	}
+	return Type{}, moerr.NewInvalidInputNoCtxf("invalid iceberg type %s", name)
+}
+
+func (t Type) String() string {
+	switch t.Kind {
+	case "decimal":
+		return fmt.Sprintf("decimal(%d,%d)", t.Precision, t.Scale)
+	case "fixed":
+		return fmt.Sprintf("fixed[%d]", t.Length)
+	}
+	return t.Kind
+}
+
+func (t Type) IsNested() bool {
+	return t.Kind == "struct" || t.Kind == "list" || t.Kind == "map"
+}
+
+type Field struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+	Type     Type   `json:"type"`
+}
+
+// Schema is an Iceberg schema. Only the top-level fields are kept.
+type Schema struct {
+	ID     int     `json:"schema-id"`
+	Fields []Field `json:"fields"`
+}
+
+func (s *Schema) FieldByID(id int) *Field {
+	for i := range s.Fields {
+		if s.Fields[i].ID == id {
+			return &s.Fields[i]
+		}
+	}
+	return nil
+}
+
+// FieldByName finds a field by its name, ignoring case like the column
+// lookup of the readers.
+func (s *Schema) FieldByName(name string) *Field {
+	var found *Field
+	for i := range s.Fields {
+		if s.Fields[i].Name == name {
+			return &s.Fields[i]
+		}
+		if found == nil && strings.EqualFold(s.Fields[i].Name, name) {
+			found = &s.Fields[i]
+		}
+	}
+	return found
+}
+
+type PartitionField struct {
+	Name      string `json:"name"`
+	Transform string `json:"transform"`
+	SourceID  int    `json:"source-id"`
+	FieldID   int    `json:"field-id"`
+}
+
+type PartitionSpec struct {
+	ID     int              `json:"spec-id"`
+	Fields []PartitionField `json:"fields"`
+}
+
+// IsUnpartitioned reports whether all data of the spec is in one partition.
+func (s *PartitionSpec) IsUnpartitioned() bool {
+	for _, f := range s.Fields {
+		if f.Transform != "void" {
+			return false
+		}
+	}
+	return true
+}
+
+// ResultType returns the type of the partition values of field f.
+func (f *PartitionField) ResultType(schema *Schema) Type {
+	switch {
+	case f.Transform == "identity", strings.HasPrefix(f.Transform, "truncate["):
+		if src := schema.FieldByID(f.SourceID); src != nil {
+			return src.Type
+		}
+		return Type{Kind: "binary"}
+	case f.Transform == "day":
+		return Type{Kind: "date"}
+	}
+	// bucket, year, month, hour and void
+	return Type{Kind: "int"}
+}
+
+type Snapshot struct {
+	ID             int64             `json:"snapshot-id"`
+	ParentID       *int64            `json:"parent-snapshot-id,omitempty"`
+	SequenceNumber int64             `json:"sequence-number"`
+	TimestampMs    int64             `json:"timestamp-ms"`
+	ManifestList   string            `json:"manifest-list"`
+	Summary        map[string]string `json:"summary,omitempty"`
+	SchemaID       *int              `json:"schema-id,omitempty"`
+}
+
+// Metadata is the content of a table metadata file.
+type Metadata struct {
+	FormatVersion      int             `json:"format-version"`
+	TableUUID          string          `json:"table-uuid"`
+	Location           string          `json:"location"`
+	LastSequenceNumber int64           `json:"last-sequence-number"`
+	CurrentSchemaID    int             `json:"current-schema-id"`
+	Schemas            []Schema        `json:"schemas"`
+	DefaultSpecID      int             `json:"default-spec-id"`
+	PartitionSpecs     []PartitionSpec `json:"partition-specs"`
+	CurrentSnapshotID  *int64          `json:"current-snapshot-id"`
+	Snapshots          []Snapshot      `json:"snapshots"`
+
+	// format version 1 tables may only have these
+	Schema        *Schema          `json:"schema,omitempty"`
+	PartitionSpec []PartitionField `json:"partition-spec,omitempty"`
+}
+
+// ParseMetadata parses a metadata file. The fields of format version 1
+// are moved to their version 2 places.
+func ParseMetadata(data []byte) (*Metadata, error) {
+	m := &Metadata{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, moerr.NewInvalidInputNoCtxf("invalid iceberg metadata: %v", err)
+	}
+	if m.FormatVersion < 1 || m.FormatVersion > 2 {
+		return nil, moerr.NewNYINoCtxf("iceberg format version %d", m.FormatVersion)
+	}
+	if len(m.Schemas) == 0 && m.Schema != nil {
+		m.Schemas = []Schema{*m.Schema}
+		m.CurrentSchemaID = m.Schema.ID
+	}
+	if len(m.PartitionSpecs) == 0 {
+		m.PartitionSpecs = []PartitionSpec{{ID: m.DefaultSpecID, Fields: m.PartitionSpec}}
+	}
+	m.Schema, m.PartitionSpec = nil, nil
+	if m.CurrentSchema() == nil {
+		return nil, moerr.NewInvalidInputNoCtxf("invalid iceberg metadata: no schema %d", m.CurrentSchemaID)
+	}
+	return m, nil
+}
+
+func (m *Metadata) SchemaByID(id int) *Schema {
+	for i := range m.Schemas {
+		if m.Schemas[i].ID == id {
+			return &m.Schemas[i]
+		}
+	}
+	return nil
+}
+
+func (m *Metadata) CurrentSchema() *Schema {
+	return m.SchemaByID(m.CurrentSchemaID)
+}
+
+// SnapshotSchema returns the schema the snapshot was written with.
+func (m *Metadata) SnapshotSchema(s *Snapshot) *Schema {
+	if s.SchemaID != nil {
+		if schema := m.SchemaByID(*s.SchemaID); schema != nil {
+			return schema
+		}
+	}
+	return m.CurrentSchema()
+}
+
+func (m *Metadata) Spec(id int) *PartitionSpec {
+	for i := range m.PartitionSpecs {
+		if m.PartitionSpecs[i].ID == id {
+			return &m.PartitionSpecs[i]
+		}
+	}
+	return nil
+}
+
+func (m *Metadata) SnapshotByID(id int64) *Snapshot {
+	for i := range m.Snapshots {
+		if m.Snapshots[i].ID == id {
+			return &m.Snapshots[i]
+		}
+	}
+	return nil
+}
+
+// CurrentSnapshot returns nil for tables without data.
+func (m *Metadata) CurrentSnapshot() *Snapshot {
+	if m.CurrentSnapshotID == nil || *m.CurrentSnapshotID == -1 {
+		return nil
+	}
+	return m.SnapshotByID(*m.CurrentSnapshotID)
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMetadata_V1(t *testing.T) {
+	m, err := ParseMetadata([]byte(`{
+		"format-version": 1,
+		"location": "s3://bucket/db/t",
+		"schema": {"schema-id": 0, "fields": [
+			{"id": 1, "name": "id", "required": true, "type": "long"},
+			{"id": 2, "name": "price", "required": false, "type": "decimal(10, 2)"},
+			{"id": 3, "name": "tags", "required": false, "type": {"type": "list", "element-id": 4, "element": "string"}}
+		]},
+		"partition-spec": [{"name": "id", "transform": "identity", "source-id": 1, "field-id": 1000}],
+		"current-snapshot-id": -1,
+		"snapshots": []
+	}`))
+	require.NoError(t, err)
+	schema := m.CurrentSchema()
+	require.NotNil(t, schema)
+	require.Len(t, schema.Fields, 3)
+	require.Equal(t, Type{Kind: "decimal", Precision: 10, Scale: 2}, schema.Fields[1].Type)
+	require.True(t, schema.Fields[2].Type.IsNested())
+	require.Equal(t, "price", schema.FieldByName("PRICE").Name)
+	require.Nil(t, schema.FieldByID(9))
+
+	spec := m.Spec(0)
+	require.NotNil(t, spec)
+	require.False(t, spec.IsUnpartitioned())
+	require.Equal(t, Type{Kind: "long"}, spec.Fields[0].ResultType(schema))
+	require.Nil(t, m.CurrentSnapshot())
+}
+
+func TestParseMetadata_V2(t *testing.T) {
+	m, err := ParseMetadata([]byte(`{
+		"format-version": 2,
+		"last-sequence-number": 2,
+		"current-schema-id": 1,
+		"schemas": [
+			{"schema-id": 0, "fields": [{"id": 1, "name": "a", "required": true, "type": "int"}]},
+			{"schema-id": 1, "fields": [
+				{"id": 1, "name": "a", "required": true, "type": "long"},
+				{"id": 2, "name": "d", "required": false, "type": "date"}
+			]}
+		],
+		"default-spec-id": 1,
+		"partition-specs": [
+			{"spec-id": 0, "fields": []},
+			{"spec-id": 1, "fields": [{"name": "d_day", "transform": "day", "source-id": 2, "field-id": 1000}]}
+		],
+		"current-snapshot-id": 20,
+		"snapshots": [
+			{"snapshot-id": 10, "sequence-number": 1, "timestamp-ms": 1, "manifest-list": "m1.avro", "schema-id": 0},
+			{"snapshot-id": 20, "parent-snapshot-id": 10, "sequence-number": 2, "timestamp-ms": 2, "manifest-list": "m2.avro"}
+		]
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, 1, m.CurrentSchema().ID)
+	require.True(t, m.Spec(0).IsUnpartitioned())
+	require.Equal(t, Type{Kind: "date"}, m.Spec(1).Fields[0].ResultType(m.CurrentSchema()))
+	require.Equal(t, int64(20), m.CurrentSnapshot().ID)
+	require.Equal(t, 0, m.SnapshotSchema(m.SnapshotByID(10)).ID)
+	require.Equal(t, 1, m.SnapshotSchema(m.SnapshotByID(20)).ID)
+}
+
+func TestParseMetadata_Errors(t *testing.T) {
+	_, err := ParseMetadata([]byte(`{`))
+	require.Error(t, err)
+	_, err = ParseMetadata([]byte(`{"format-version": 3}`))
+	require.Error(t, err)
+	_, err = ParseMetadata([]byte(`{"format-version": 2, "current-schema-id": 1, "schemas": []}`))
+	require.Error(t, err)
+	_, err = ParseMetadata([]byte(`{"format-version": 2, "schemas": [{"schema-id": 0, "fields": [{"id": 1, "name": "a", "type": "varchar"}]}]}`))
+	require.Error(t, err)
+}
+
+func TestType_JSON(t *testing.T) {
+	for _, name := range []string{"boolean", "timestamptz_ns", "decimal(38,10)", "fixed[16]"} {
+		typ, err := ParseType(name)
+		require.NoError(t, err)
+		b, err := json.Marshal(typ)
+		require.NoError(t, err)
+		var back Type
+		require.NoError(t, json.Unmarshal(b, &back))
+		require.Equal(t, typ, back)
+		require.Equal(t, name, back.String())
+	}
+	_, err := ParseType("decimal(1)")
+	require.Error(t, err)
+}
+
+func TestValue_RoundTrip(t *testing.T) {
+	cases := []struct {
+		typ Type
+		val any
+	}{
+		{Type{Kind: "boolean"}, true},
+		{Type{Kind: "int"}, int64(-7)},
+		{Type{Kind: "date"}, int64(19000)},
+		{Type{Kind: "long"}, int64(1) << 40},
+		{Type{Kind: "timestamptz"}, int64(-1)},
+		{Type{Kind: "float"}, 1.5},
+		{Type{Kind: "double"}, -2.25},
+		{Type{Kind: "string"}, "héllo"},
+		{Type{Kind: "binary"}, []byte{0, 1}},
+		{Type{Kind: "decimal", Precision: 9, Scale: 2}, big.NewInt(12345)},
+		{Type{Kind: "decimal", Precision: 9, Scale: 2}, big.NewInt(-12345)},
+		{Type{Kind: "decimal", Precision: 9, Scale: 2}, big.NewInt(-128)},
+		{Type{Kind: "decimal", Precision: 9, Scale: 2}, big.NewInt(128)},
+		{Type{Kind: "decimal", Precision: 9, Scale: 2}, big.NewInt(0)},
+	}
+	for _, c := range cases {
+		b, err := EncodeValue(c.typ, c.val)
+		require.NoError(t, err)
+		v, err := DecodeValue(c.typ, b)
+		require.NoError(t, err)
+		if d, ok := c.val.(*big.Int); ok {
+			require.Zero(t, d.Cmp(v.(*big.Int)), "%s %v", c.typ, c.val)
+			continue
+		}
+		require.Equal(t, c.val, v, "%s %v", c.typ, c.val)
+	}
+
+	// minimum length two's complement
+	require.Equal(t, []byte{0x80}, decimalToBytes(big.NewInt(-128)))
+	require.Equal(t, []byte{0xff, 0x7f}, decimalToBytes(big.NewInt(-129)))
+	require.Equal(t, []byte{0x00, 0x80}, decimalToBytes(big.NewInt(128)))
+
+	// int bounds of promoted long columns
+	v, err := DecodeValue(Type{Kind: "long"}, []byte{0xff, 0xff, 0xff, 0xff})
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), v)
+
+	_, err = DecodeValue(Type{Kind: "int"}, []byte{1})
+	require.Error(t, err)
+	_, err = EncodeValue(Type{Kind: "int"}, "1")
+	require.Error(t, err)
+}
@@ -0,0 +1,364 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"math/big"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// FileIO reads the files of a table. Paths are the absolute paths written
+// in the metadata.
+type FileIO interface {
+	ReadFile(ctx context.Context, path string) ([]byte, error)
+	Open(ctx context.Context, path string, size int64) (io.ReaderAt, error)
+	// List returns the names of the files in dir
+	List(ctx context.Context, dir string) ([]string, error)
+}
+
+type Table struct {
+	Metadata     *Metadata
+	MetadataFile string
+	io           FileIO
+}
+
+// LoadTable loads a table from a metadata file, or from the table
+// directory, in which case the version in metadata/version-hint.text or
+// else the highest version in metadata/ is loaded.
+func LoadTable(ctx context.Context, fio FileIO, location string) (*Table, error) {
+	file := location
+	if !isMetadataFile(location) {
+		var err error
+		if file, err = findMetadataFile(ctx, fio, strings.TrimSuffix(location, "/")+"/metadata"); err != nil {
+			return nil, err
+		}
+	}
+	data, err := fio.ReadFile(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = io.ReadAll(r); err != nil {
+			return nil, err
+		}
+	}
+	m, err := ParseMetadata(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Table{Metadata: m, MetadataFile: file, io: fio}, nil
+}
+
+func isMetadataFile(name string) bool {
+	return strings.HasSuffix(name, ".metadata.json") || strings.HasSuffix(name, ".metadata.json.gz")
+}
+
+// metadataVersion returns the version of metadata files named vN.metadata.json
+// or NNNNN-uuid.metadata.json.
+func metadataVersion(name string) (int64, bool) {
+	if !isMetadataFile(name) {
+		return 0, false
+	}
+	name = strings.TrimPrefix(name, "v")
+	end := strings.IndexAny(name, "-.")
+	if end <= 0 {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(name[:end], 10, 64)
+	return v, err == nil
+}
+
+func findMetadataFile(ctx context.Context, fio FileIO, dir string) (string, error) {
+	if hint, err := fio.ReadFile(ctx, dir+"/version-hint.text"); err == nil {
+		if v, err := strconv.ParseInt(strings.TrimSpace(string(hint)), 10, 64); err == nil {
+			file := dir + "/v" + strconv.FormatInt(v, 10) + ".metadata.json"
+			if _, err = fio.ReadFile(ctx, file); err == nil {
+				return file, nil
+			}
+		}
+	}
+	names, err := fio.List(ctx, dir)
+	if err != nil {
+		return "", err
+	}
+	latest, version := "", int64(-1)
+	for _, name := range names {
+		if v, ok := metadataVersion(path.Base(name)); ok && v > version {
+			latest, version = name, v
+		}
+	}
+	if latest == "" {
+		return "", moerr.NewInvalidInputNoCtxf("no iceberg metadata file in %s", dir)
+	}
+	return dir + "/" + path.Base(latest), nil
+}
+
+// ColumnStats is what is known about the values of a column in a file or
+// manifest. Lower and Upper are nil if unknown.
+type ColumnStats struct {
+	Type         Type
+	Lower, Upper any
+	// NullCount is -1 if unknown
+	NullCount int64
+}
+
+// FileStats holds the statistics of a data file or of the files of a
+// manifest, by field id. RecordCount is -1 for manifests.
+type FileStats struct {
+	RecordCount int64
+	Columns     map[int]*ColumnStats
+}
+
+// FileFilter returns false if no row of the files described by stats can
+// satisfy the filter of the scan.
+type FileFilter func(stats *FileStats) (bool, error)
+
+// FileScanTask is a data file with the delete files that apply to it.
+type FileScanTask struct {
+	File    *DataFile
+	Deletes []*DataFile
+}
+
+// Snapshot returns the snapshot with the given id, or the current snapshot if
+// id is nil. The snapshot is nil if the table has no data.
+func (t *Table) Snapshot(id *int64) (*Snapshot, error) {
+	if id == nil {
+		return t.Metadata.CurrentSnapshot(), nil
+	}
+	s := t.Metadata.SnapshotByID(*id)
+	if s == nil {
+		return nil, moerr.NewInvalidInputNoCtxf("iceberg snapshot %d not found", *id)
+	}
+	return s, nil
+}
+
+// PlanFiles returns the data files of snapshot that may satisfy filter,
+// with their delete files. Statistics are resolved by the field ids of
+// schema.
+func (t *Table) PlanFiles(ctx context.Context, snapshot *Snapshot, schema *Schema, filter FileFilter) ([]*FileScanTask, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	data, err := t.io.ReadFile(ctx, snapshot.ManifestList)
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := readManifestList(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var dataFiles, deleteFiles []*DataFile
+	for _, mf := range manifests {
+		spec := t.Metadata.Spec(mf.SpecID)
+		if spec == nil {
+			return nil, moerr.NewInvalidInputNoCtxf("iceberg partition spec %d not found", mf.SpecID)
+		}
+		if mf.Content == ManifestContentData && filter != nil {
+			ok, err := filter(manifestStats(mf, spec, schema))
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		data, err := t.io.ReadFile(ctx, mf.Path)
+		if err != nil {
+			return nil, err
+		}
+		files, err := readManifest(data, mf, spec, schema)
+		if err != nil {
+			return nil, err
+		}
+		for _, df := range files {
+			switch df.Content {
+			case ContentData:
+				if filter != nil {
+					ok, err := filter(dataFileStats(df, spec, schema))
+					if err != nil {
+						return nil, err
+					}
+					if !ok {
+						continue
+					}
+				}
+				dataFiles = append(dataFiles, df)
+			case ContentPositionDeletes, ContentEqualityDeletes:
+				if df.Format == "PUFFIN" {
+					return nil, moerr.NewNYINoCtx("iceberg deletion vectors")
+				}
+				deleteFiles = append(deleteFiles, df)
+			}
+		}
+	}
+
+	tasks := make([]*FileScanTask, len(dataFiles))
+	for i, df := range dataFiles {
+		tasks[i] = &FileScanTask{File: df}
+		for _, del := range deleteFiles {
+			if t.deleteApplies(del, df) {
+				tasks[i].Deletes = append(tasks[i].Deletes, del)
+			}
+		}
+	}
+	return tasks, nil
+}
+
+// deleteApplies reports whether delete file del may delete rows of df.
+// Position deletes apply to data files of the same or an older sequence
+// number, equality deletes only to older ones. Both are scoped to their
+// partition unless written with an unpartitioned spec.
+func (t *Table) deleteApplies(del, df *DataFile) bool {
+	if del.Content == ContentEqualityDeletes {
+		if df.SequenceNumber >= del.SequenceNumber {
+			return false
+		}
+	} else {
+		if df.SequenceNumber > del.SequenceNumber {
+			return false
+		}
+		// the bounds of the file_path column tell which files are referenced
+		pathType := Type{Kind: "string"}
+		if lower, ok := del.LowerBounds[positionDeleteFilePathID]; ok {
+			if v, err := DecodeValue(pathType, lower); err == nil && df.Path < v.(string) {
+				return false
+			}
+		}
+		if upper, ok := del.UpperBounds[positionDeleteFilePathID]; ok {
+			if v, err := DecodeValue(pathType, upper); err == nil && df.Path > v.(string) {
+				return false
+			}
+		}
+	}
+	spec := t.Metadata.Spec(del.SpecID)
+	if spec == nil || spec.IsUnpartitioned() {
+		return true
+	}
+	if del.SpecID != df.SpecID || len(del.Partition) != len(df.Partition) {
+		return false
+	}
+	for i := range del.Partition {
+		if !valuesEqual(del.Partition[i], df.Partition[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func valuesEqual(a, b any) bool {
+	switch x := a.(type) {
+	case []byte:
+		y, ok := b.([]byte)
+		return ok && bytes.Equal(x, y)
+	case *big.Int:
+		y, ok := b.(*big.Int)
+		return ok && x.Cmp(y) == 0
+	}
+	return a == b
+}
+
+// manifestStats builds statistics from the summaries of identity partition
+// fields.
+func manifestStats(mf *ManifestFile, spec *PartitionSpec, schema *Schema) *FileStats {
+	stats := &FileStats{RecordCount: -1, Columns: make(map[int]*ColumnStats)}
+	for i := range spec.Fields {
+		pf := &spec.Fields[i]
+		if pf.Transform != "identity" || i >= len(mf.Partitions) {
+			continue
+		}
+		src := schema.FieldByID(pf.SourceID)
+		if src == nil {
+			continue
+		}
+		s := &mf.Partitions[i]
+		if s.ContainsNaN {
+			continue
+		}
+		cs := &ColumnStats{Type: src.Type, NullCount: -1}
+		if !s.ContainsNull {
+			cs.NullCount = 0
+		}
+		if s.Lower != nil && s.Upper != nil {
+			lower, err1 := DecodeValue(src.Type, s.Lower)
+			upper, err2 := DecodeValue(src.Type, s.Upper)
+			if err1 == nil && err2 == nil {
+				cs.Lower, cs.Upper = lower, upper
+			}
+		}
+		stats.Columns[src.ID] = cs
+	}
+	return stats
+}
+
+// dataFileStats builds statistics from the column metrics of a data file,
+// falling back to its identity partition values.
+func dataFileStats(df *DataFile, spec *PartitionSpec, schema *Schema) *FileStats {
+	stats := &FileStats{RecordCount: df.RecordCount, Columns: make(map[int]*ColumnStats)}
+	for i := range schema.Fields {
+		f := &schema.Fields[i]
+		if f.Type.IsNested() || df.NaNValueCounts[f.ID] > 0 {
+			continue
+		}
+		cs := &ColumnStats{Type: f.Type, NullCount: -1}
+		if n, ok := df.NullValueCounts[f.ID]; ok {
+			cs.NullCount = n
+		}
+		lower, ok1 := df.LowerBounds[f.ID]
+		upper, ok2 := df.UpperBounds[f.ID]
+		if ok1 && ok2 {
+			l, err1 := DecodeValue(f.Type, lower)
+			u, err2 := DecodeValue(f.Type, upper)
+			if err1 == nil && err2 == nil {
+				cs.Lower, cs.Upper = l, u
+			}
+		}
+		if cs.Lower != nil || cs.NullCount >= 0 {
+			stats.Columns[f.ID] = cs
+		}
+	}
+	for i := range spec.Fields {
+		pf := &spec.Fields[i]
+		if pf.Transform != "identity" || i >= len(df.Partition) {
+			continue
+		}
+		src := schema.FieldByID(pf.SourceID)
+		if src == nil {
+			continue
+		}
+		cs := stats.Columns[src.ID]
+		if cs != nil && cs.Lower != nil {
+			continue
+		}
+		if df.Partition[i] == nil {
+			stats.Columns[src.ID] = &ColumnStats{Type: src.Type, NullCount: df.RecordCount}
+		} else {
+			stats.Columns[src.ID] = &ColumnStats{Type: src.Type, Lower: df.Partition[i], Upper: df.Partition[i], NullCount: 0}
+		}
+	}
+	return stats
+}
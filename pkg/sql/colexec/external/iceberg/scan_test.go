@@ -0,0 +1,367 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+)
+
+// memIO is a FileIO over files in memory.
+type memIO map[string][]byte
+
+func (m memIO) ReadFile(_ context.Context, path string) ([]byte, error) {
+	data, ok := m[path]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return data, nil
+}
+
+func (m memIO) Open(ctx context.Context, path string, _ int64) (io.ReaderAt, error) {
+	data, err := m.ReadFile(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+func (m memIO) List(_ context.Context, dir string) ([]string, error) {
+	var names []string
+	for path := range m {
+		if name, ok := strings.CutPrefix(path, dir+"/"); ok && !strings.Contains(name, "/") {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+type testDataRow struct {
+	ID     int64  `parquet:"id,id(1)"`
+	Name   string `parquet:"name,id(2)"`
+	Region string `parquet:"region,id(3)"`
+}
+
+type testPositionDeleteRow struct {
+	FilePath string `parquet:"file_path,id(2147483546)"`
+	Pos      int64  `parquet:"pos,id(2147483545)"`
+}
+
+type testEqualityDeleteRow struct {
+	ID int64 `parquet:"id,id(1)"`
+}
+
+var (
+	testSchema = &Schema{ID: 0, Fields: []Field{
+		{ID: 1, Name: "id", Required: true, Type: Type{Kind: "long"}},
+		{ID: 2, Name: "name", Type: Type{Kind: "string"}},
+		{ID: 3, Name: "region", Type: Type{Kind: "string"}},
+	}}
+	testUnpartitioned = &PartitionSpec{ID: 0}
+	testByRegion      = &PartitionSpec{ID: 1, Fields: []PartitionField{
+		{Name: "region", Transform: "identity", SourceID: 3, FieldID: 1000},
+	}}
+)
+
+func encodeTestValue(t *testing.T, typ Type, v any) []byte {
+	b, err := EncodeValue(typ, v)
+	require.NoError(t, err)
+	return b
+}
+
+func writeTestParquet[T any](t *testing.T, fio memIO, path string, rows []T) int64 {
+	var buf bytes.Buffer
+	require.NoError(t, parquet.Write(&buf, rows))
+	fio[path] = buf.Bytes()
+	return int64(buf.Len())
+}
+
+// writeTestDataFile writes rows, all of one region, as a data file.
+func writeTestDataFile(t *testing.T, fio memIO, path string, rows []testDataRow) *DataFile {
+	long := Type{Kind: "long"}
+	return &DataFile{
+		Content:         ContentData,
+		Path:            path,
+		Format:          "PARQUET",
+		SpecID:          testByRegion.ID,
+		Partition:       []any{rows[0].Region},
+		RecordCount:     int64(len(rows)),
+		FileSize:        writeTestParquet(t, fio, path, rows),
+		NullValueCounts: map[int]int64{1: 0},
+		LowerBounds:     map[int][]byte{1: encodeTestValue(t, long, rows[0].ID)},
+		UpperBounds:     map[int][]byte{1: encodeTestValue(t, long, rows[len(rows)-1].ID)},
+	}
+}
+
+func writeTestManifest(t *testing.T, fio memIO, path string, spec *PartitionSpec, content int, snapshotID, seq int64, files []*DataFile) *ManifestFile {
+	var buf bytes.Buffer
+	require.NoError(t, WriteManifest(&buf, testSchema, spec, content, snapshotID, files))
+	fio[path] = buf.Bytes()
+	mf := &ManifestFile{
+		Path:              path,
+		Length:            int64(buf.Len()),
+		SpecID:            spec.ID,
+		Content:           content,
+		SequenceNumber:    seq,
+		MinSequenceNumber: seq,
+		AddedSnapshotID:   snapshotID,
+	}
+	if len(spec.Fields) > 0 {
+		typ := Type{Kind: "string"}
+		lower, upper := "", ""
+		for _, df := range files {
+			region := df.Partition[0].(string)
+			if lower == "" || region < lower {
+				lower = region
+			}
+			if region > upper {
+				upper = region
+			}
+		}
+		mf.Partitions = []FieldSummary{{Lower: encodeTestValue(t, typ, lower), Upper: encodeTestValue(t, typ, upper)}}
+	}
+	return mf
+}
+
+func writeTestManifestList(t *testing.T, fio memIO, path string, snapshotID int64, files []*ManifestFile) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteManifestList(&buf, snapshotID, files))
+	fio[path] = buf.Bytes()
+}
+
+func writeTestMetadata(t *testing.T, fio memIO, path string, snapshots []Snapshot) {
+	current := snapshots[len(snapshots)-1].ID
+	m := &Metadata{
+		FormatVersion:      2,
+		Location:           "s3://bucket/t",
+		LastSequenceNumber: snapshots[len(snapshots)-1].SequenceNumber,
+		Schemas:            []Schema{*testSchema},
+		DefaultSpecID:      testByRegion.ID,
+		PartitionSpecs:     []PartitionSpec{*testUnpartitioned, *testByRegion},
+		CurrentSnapshotID:  &current,
+		Snapshots:          snapshots,
+	}
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	fio[path] = data
+}
+
+// newTestTable builds a table of two snapshots. The first adds data files
+// eu.parquet and us.parquet. The second adds eu2.parquet, a position delete
+// of row 1 of eu.parquet and an unpartitioned equality delete of id 11.
+func newTestTable(t *testing.T) memIO {
+	fio := memIO{}
+	const root = "s3://bucket/t"
+	eu := writeTestDataFile(t, fio, root+"/data/eu.parquet", []testDataRow{
+		{1, "a", "eu"}, {2, "b", "eu"}, {3, "c", "eu"},
+	})
+	us := writeTestDataFile(t, fio, root+"/data/us.parquet", []testDataRow{
+		{10, "x", "us"}, {11, "y", "us"}, {12, "z", "us"},
+	})
+	eu2 := writeTestDataFile(t, fio, root+"/data/eu2.parquet", []testDataRow{
+		{11, "p", "eu"}, {21, "q", "eu"},
+	})
+
+	str := Type{Kind: "string"}
+	posDelete := &DataFile{
+		Content:     ContentPositionDeletes,
+		Path:        root + "/data/pos-delete.parquet",
+		Format:      "PARQUET",
+		SpecID:      testByRegion.ID,
+		Partition:   []any{"eu"},
+		RecordCount: 1,
+		FileSize: writeTestParquet(t, fio, root+"/data/pos-delete.parquet", []testPositionDeleteRow{
+			{eu.Path, 1},
+		}),
+		LowerBounds: map[int][]byte{positionDeleteFilePathID: encodeTestValue(t, str, eu.Path)},
+		UpperBounds: map[int][]byte{positionDeleteFilePathID: encodeTestValue(t, str, eu.Path)},
+	}
+	eqDelete := &DataFile{
+		Content:     ContentEqualityDeletes,
+		Path:        root + "/data/eq-delete.parquet",
+		Format:      "PARQUET",
+		SpecID:      testUnpartitioned.ID,
+		RecordCount: 1,
+		FileSize: writeTestParquet(t, fio, root+"/data/eq-delete.parquet", []testEqualityDeleteRow{
+			{11},
+		}),
+		EqualityIDs: []int{1},
+	}
+
+	m1 := writeTestManifest(t, fio, root+"/metadata/m1.avro", testByRegion, ManifestContentData, 1, 1, []*DataFile{eu, us})
+	m2 := writeTestManifest(t, fio, root+"/metadata/m2.avro", testByRegion, ManifestContentData, 2, 2, []*DataFile{eu2})
+	m3 := writeTestManifest(t, fio, root+"/metadata/m3.avro", testByRegion, ManifestContentDeletes, 2, 2, []*DataFile{posDelete})
+	m4 := writeTestManifest(t, fio, root+"/metadata/m4.avro", testUnpartitioned, ManifestContentDeletes, 2, 2, []*DataFile{eqDelete})
+	writeTestManifestList(t, fio, root+"/metadata/snap-1.avro", 1, []*ManifestFile{m1})
+	writeTestManifestList(t, fio, root+"/metadata/snap-2.avro", 2, []*ManifestFile{m1, m2, m3, m4})
+
+	s1 := Snapshot{ID: 1, SequenceNumber: 1, TimestampMs: 1000, ManifestList: root + "/metadata/snap-1.avro"}
+	s2 := Snapshot{ID: 2, ParentID: &s1.ID, SequenceNumber: 2, TimestampMs: 2000, ManifestList: root + "/metadata/snap-2.avro"}
+	writeTestMetadata(t, fio, root+"/metadata/v1.metadata.json", []Snapshot{s1})
+	writeTestMetadata(t, fio, root+"/metadata/v2.metadata.json", []Snapshot{s1, s2})
+	return fio
+}
+
+func taskPaths(tasks []*FileScanTask) []string {
+	paths := make([]string, len(tasks))
+	for i, task := range tasks {
+		paths[i] = task.File.Path[strings.LastIndex(task.File.Path, "/")+1:]
+	}
+	return paths
+}
+
+func TestLoadTable(t *testing.T) {
+	ctx := context.Background()
+	fio := newTestTable(t)
+
+	// the highest version without a hint
+	table, err := LoadTable(ctx, fio, "s3://bucket/t/")
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/t/metadata/v2.metadata.json", table.MetadataFile)
+	require.Equal(t, int64(2), table.Metadata.CurrentSnapshot().ID)
+
+	// the version of the hint
+	fio["s3://bucket/t/metadata/version-hint.text"] = []byte("1\n")
+	table, err = LoadTable(ctx, fio, "s3://bucket/t")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), table.Metadata.CurrentSnapshot().ID)
+
+	// a gzipped metadata file
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err = w.Write(fio["s3://bucket/t/metadata/v2.metadata.json"])
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	fio["s3://bucket/t/metadata/00003-abc.metadata.json.gz"] = buf.Bytes()
+	table, err = LoadTable(ctx, fio, "s3://bucket/t/metadata/00003-abc.metadata.json.gz")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), table.Metadata.CurrentSnapshot().ID)
+
+	_, err = LoadTable(ctx, fio, "s3://bucket/other")
+	require.Error(t, err)
+
+	_, err = table.Snapshot(new(int64))
+	require.Error(t, err)
+	id := int64(1)
+	s, err := table.Snapshot(&id)
+	require.NoError(t, err)
+	require.Equal(t, "s3://bucket/t/metadata/snap-1.avro", s.ManifestList)
+}
+
+func TestPlanFiles(t *testing.T) {
+	ctx := context.Background()
+	fio := newTestTable(t)
+	table, err := LoadTable(ctx, fio, "s3://bucket/t")
+	require.NoError(t, err)
+	schema := table.Metadata.CurrentSchema()
+
+	// time travel to the first snapshot
+	id := int64(1)
+	s1, err := table.Snapshot(&id)
+	require.NoError(t, err)
+	tasks, err := table.PlanFiles(ctx, s1, schema, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"eu.parquet", "us.parquet"}, taskPaths(tasks))
+	require.Empty(t, tasks[0].Deletes)
+	require.Equal(t, int64(1), tasks[0].File.SequenceNumber)
+	require.Equal(t, testSchema, tasks[0].File.Schema)
+	require.Equal(t, []any{"eu"}, tasks[0].File.Partition)
+
+	s2, err := table.Snapshot(nil)
+	require.NoError(t, err)
+	tasks, err = table.PlanFiles(ctx, s2, schema, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"eu.parquet", "us.parquet", "eu2.parquet"}, taskPaths(tasks))
+	// the position delete is limited to eu.parquet by its bounds, the
+	// equality delete to the files older than itself
+	require.Equal(t, []string{"pos-delete.parquet", "eq-delete.parquet"}, taskPaths([]*FileScanTask{
+		{File: tasks[0].Deletes[0]}, {File: tasks[0].Deletes[1]},
+	}))
+	require.Len(t, tasks[1].Deletes, 1)
+	require.Equal(t, ContentEqualityDeletes, tasks[1].Deletes[0].Content)
+	require.Empty(t, tasks[2].Deletes)
+
+	// pruning by the column bounds of the files
+	tasks, err = table.PlanFiles(ctx, s2, schema, func(stats *FileStats) (bool, error) {
+		cs := stats.Columns[1]
+		return cs == nil || cs.Upper.(int64) > 15, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"eu2.parquet"}, taskPaths(tasks))
+
+	// pruning of whole manifests by their partition summaries
+	var manifests int
+	tasks, err = table.PlanFiles(ctx, s2, schema, func(stats *FileStats) (bool, error) {
+		if stats.RecordCount < 0 {
+			manifests++
+		}
+		cs := stats.Columns[3]
+		return cs == nil || cs.Upper.(string) >= "us", nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"us.parquet"}, taskPaths(tasks))
+	require.Equal(t, 2, manifests)
+}
+
+func TestDeleteLoader(t *testing.T) {
+	ctx := context.Background()
+	fio := newTestTable(t)
+	table, err := LoadTable(ctx, fio, "s3://bucket/t")
+	require.NoError(t, err)
+	schema := table.Metadata.CurrentSchema()
+	tasks, err := table.PlanFiles(ctx, table.Metadata.CurrentSnapshot(), schema, nil)
+	require.NoError(t, err)
+	require.Len(t, tasks, 3)
+
+	loader := NewDeleteLoader(fio, schema)
+	expected := [][]int64{{1}, {1}, nil}
+	for i, task := range tasks {
+		rows, err := loader.DeletedRows(ctx, task)
+		require.NoError(t, err)
+		require.Equal(t, expected[i], rows, task.File.Path)
+	}
+	require.Len(t, loader.positions, 1)
+	require.Len(t, loader.equalities, 1)
+
+	// the delete files are cached
+	delete(fio, "s3://bucket/t/data/pos-delete.parquet")
+	rows, err := loader.DeletedRows(ctx, tasks[0])
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, rows)
+
+	// equality deletes match rows by value, row 0 of eu2.parquet has id 11
+	task := &FileScanTask{File: tasks[2].File, Deletes: []*DataFile{{
+		Content:     ContentEqualityDeletes,
+		Path:        "s3://bucket/t/data/eq-delete.parquet",
+		Format:      "PARQUET",
+		FileSize:    int64(len(fio["s3://bucket/t/data/eq-delete.parquet"])),
+		EqualityIDs: []int{1},
+	}}}
+	rows, err = loader.DeletedRows(ctx, task)
+	require.NoError(t, err)
+	require.Equal(t, []int64{0}, rows)
+
+	_, err = readColumns(ctx, fio, &DataFile{Path: "s3://bucket/t/data/eu.parquet", Format: "AVRO"}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iceberg
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Column bounds and partition summaries hold values in the single-value
+// binary serialization of the spec. Decoded values are bool for boolean,
+// int64 for int, long, date (days), time and timestamps (micro or nano
+// seconds), float64 for float and double, string for string, []byte for
+// uuid, fixed and binary, and *big.Int, the unscaled value, for decimal.
+
+// DecodeValue decodes a value in single-value serialization.
+func DecodeValue(t Type, b []byte) (any, error) {
+	switch t.Kind {
+	case "boolean":
+		if len(b) == 1 {
+			return b[0] != 0, nil
+		}
+	case "int", "date":
+		if len(b) == 4 {
+			return int64(int32(binary.LittleEndian.Uint32(b))), nil
+		}
+	case "long", "time", "timestamp", "timestamptz", "timestamp_ns", "timestamptz_ns":
+		// int values promoted to long keep their 4-byte bounds
+		switch len(b) {
+		case 4:
+			return int64(int32(binary.LittleEndian.Uint32(b))), nil
+		case 8:
+			return int64(binary.LittleEndian.Uint64(b)), nil
+		}
+	case "float":
+		if len(b) == 4 {
+			return float64(math.Float32frombits(binary.LittleEndian.Uint32(b))), nil
+		}
+	case "double":
+		switch len(b) {
+		case 4:
+			return float64(math.Float32frombits(binary.LittleEndian.Uint32(b))), nil
+		case 8:
+			return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+		}
+	case "string":
+		return string(b), nil
+	case "uuid", "fixed", "binary":
+		return b, nil
+	case "decimal":
+		if len(b) > 0 {
+			return decimalFromBytes(b), nil
+		}
+	}
+	return nil, moerr.NewInvalidInputNoCtxf("invalid iceberg %s value of %d bytes", t, len(b))
+}
+
+// EncodeValue encodes a value, in the form returned by DecodeValue, in
+// single-value serialization.
+func EncodeValue(t Type, v any) ([]byte, error) {
+	switch t.Kind {
+	case "boolean":
+		if b, ok := v.(bool); ok {
+			if b {
+				return []byte{1}, nil
+			}
+			return []byte{0}, nil
+		}
+	case "int", "date":
+		if i, ok := v.(int64); ok {
+			return binary.LittleEndian.AppendUint32(nil, uint32(int32(i))), nil
+		}
+	case "long", "time", "timestamp", "timestamptz", "timestamp_ns", "timestamptz_ns":
+		if i, ok := v.(int64); ok {
+			return binary.LittleEndian.AppendUint64(nil, uint64(i)), nil
+		}
+	case "float":
+		if f, ok := v.(float64); ok {
+			return binary.LittleEndian.AppendUint32(nil, math.Float32bits(float32(f))), nil
+		}
+	case "double":
+		if f, ok := v.(float64); ok {
+			return binary.LittleEndian.AppendUint64(nil, math.Float64bits(f)), nil
+		}
+	case "string":
+		if s, ok := v.(string); ok {
+			return []byte(s), nil
+		}
+	case "uuid", "fixed", "binary":
+		if b, ok := v.([]byte); ok {
+			return b, nil
+		}
+	case "decimal":
+		if d, ok := v.(*big.Int); ok {
+			return decimalToBytes(d), nil
+		}
+	}
+	return nil, moerr.NewInvalidInputNoCtxf("cannot encode %T as iceberg %s", v, t)
+}
+
+// decimalFromBytes decodes a big-endian two's complement unscaled value.
+func decimalFromBytes(b []byte) *big.Int {
+	d := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		d.Sub(d, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return d
+}
+
+// decimalToBytes encodes an unscaled value in the minimum number of bytes
+// of big-endian two's complement.
+func decimalToBytes(d *big.Int) []byte {
+	if d.Sign() >= 0 {
+		b := d.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b
+	}
+	// -d-1 has the bits of d inverted
+	n := (new(big.Int).Not(d).BitLen())/8 + 1
+	mod := new(big.Int).Lsh(big.NewInt(1), uint(n*8))
+	b := new(big.Int).Add(mod, d).Bytes()
+	for len(b) < n {
+		b = append([]byte{0xff}, b...)
+	}
+	return b
+}
+
+// avroToValue converts a partition value decoded from a manifest to the
+// form of DecodeValue.
+func avroToValue(t Type, v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	switch x := v.(type) {
+	case bool, string, int64, float64:
+		return x, nil
+	case int32:
+		return int64(x), nil
+	case float32:
+		return float64(x), nil
+	case []byte:
+		if t.Kind == "decimal" {
+			return decimalFromBytes(x), nil
+		}
+		return x, nil
+	}
+	return nil, moerr.NewInvalidInputNoCtxf("invalid iceberg %s partition value %T", t, v)
+}
+
+// valueToAvro converts a value in the form of DecodeValue to the form
+// written to manifests.
+func valueToAvro(t Type, v any) any {
+	switch x := v.(type) {
+	case int64:
+		switch t.Kind {
+		case "int", "date":
+			return int32(x)
+		}
+	case float64:
+		if t.Kind == "float" {
+			return float32(x)
+		}
+	case *big.Int:
+		return decimalToBytes(x)
+	}
+	return v
+}
@@ -0,0 +1,315 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external/iceberg"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const icebergTestLocation = "s3://warehouse/db/t"
+
+type icebergRowV1 struct {
+	ID   int64  `parquet:"id,id(1)"`
+	Name string `parquet:"name,id(2)"`
+}
+
+type icebergRowV2 struct {
+	ID    int64   `parquet:"id,id(1)"`
+	Label string  `parquet:"label,id(2)"`
+	Score float64 `parquet:"score,id(3)"`
+}
+
+type icebergPositionDelete struct {
+	FilePath string `parquet:"file_path,id(2147483546)"`
+	Pos      int64  `parquet:"pos,id(2147483545)"`
+}
+
+func writeIcebergTestFile(t *testing.T, dir, name string, data []byte) int64 {
+	p := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+	require.NoError(t, os.WriteFile(p, data, 0644))
+	return int64(len(data))
+}
+
+func writeIcebergTestParquet[T any](t *testing.T, dir, name string, rows []T) int64 {
+	var buf bytes.Buffer
+	require.NoError(t, parquet.Write(&buf, rows))
+	return writeIcebergTestFile(t, dir, name, buf.Bytes())
+}
+
+func writeIcebergTestManifest(t *testing.T, dir, name string, schema *iceberg.Schema, content int, snapshotID, seq int64, files []*iceberg.DataFile) *iceberg.ManifestFile {
+	var buf bytes.Buffer
+	spec := &iceberg.PartitionSpec{}
+	require.NoError(t, iceberg.WriteManifest(&buf, schema, spec, content, snapshotID, files))
+	return &iceberg.ManifestFile{
+		Path:              icebergTestLocation + "/" + name,
+		Length:            writeIcebergTestFile(t, dir, name, buf.Bytes()),
+		Content:           content,
+		SequenceNumber:    seq,
+		MinSequenceNumber: seq,
+		AddedSnapshotID:   snapshotID,
+	}
+}
+
+// newIcebergTestTable writes a table of two snapshots to dir. The first adds
+// rows 1 to 3 with schema 0, the second renames column name to label, adds
+// column score, adds rows 4 and 5 and deletes row 1.
+func newIcebergTestTable(t *testing.T, dir string) {
+	long := iceberg.Type{Kind: "long"}
+	str := iceberg.Type{Kind: "string"}
+	schema0 := iceberg.Schema{ID: 0, Fields: []iceberg.Field{
+		{ID: 1, Name: "id", Required: true, Type: long},
+		{ID: 2, Name: "name", Type: str},
+	}}
+	schema1 := iceberg.Schema{ID: 1, Fields: []iceberg.Field{
+		{ID: 1, Name: "id", Required: true, Type: long},
+		{ID: 2, Name: "label", Type: str},
+		{ID: 3, Name: "score", Type: iceberg.Type{Kind: "double"}},
+	}}
+	bound := func(v int64) []byte {
+		b, err := iceberg.EncodeValue(long, v)
+		require.NoError(t, err)
+		return b
+	}
+
+	f1 := &iceberg.DataFile{
+		Content:     iceberg.ContentData,
+		Path:        icebergTestLocation + "/data/f1.parquet",
+		Format:      "PARQUET",
+		RecordCount: 3,
+		FileSize: writeIcebergTestParquet(t, dir, "data/f1.parquet", []icebergRowV1{
+			{1, "a"}, {2, "b"}, {3, "c"},
+		}),
+		NullValueCounts: map[int]int64{1: 0},
+		LowerBounds:     map[int][]byte{1: bound(1)},
+		UpperBounds:     map[int][]byte{1: bound(3)},
+	}
+	f2 := &iceberg.DataFile{
+		Content:     iceberg.ContentData,
+		Path:        icebergTestLocation + "/data/f2.parquet",
+		Format:      "PARQUET",
+		RecordCount: 2,
+		FileSize: writeIcebergTestParquet(t, dir, "data/f2.parquet", []icebergRowV2{
+			{4, "d", 0.5}, {5, "e", 1.5},
+		}),
+		NullValueCounts: map[int]int64{1: 0},
+		LowerBounds:     map[int][]byte{1: bound(4)},
+		UpperBounds:     map[int][]byte{1: bound(5)},
+	}
+	del := &iceberg.DataFile{
+		Content:     iceberg.ContentPositionDeletes,
+		Path:        icebergTestLocation + "/data/delete.parquet",
+		Format:      "PARQUET",
+		RecordCount: 1,
+		FileSize: writeIcebergTestParquet(t, dir, "data/delete.parquet", []icebergPositionDelete{
+			{f1.Path, 0},
+		}),
+	}
+
+	m1 := writeIcebergTestManifest(t, dir, "metadata/m1.avro", &schema0, iceberg.ManifestContentData, 1, 1, []*iceberg.DataFile{f1})
+	m2 := writeIcebergTestManifest(t, dir, "metadata/m2.avro", &schema1, iceberg.ManifestContentData, 2, 2, []*iceberg.DataFile{f2})
+	m3 := writeIcebergTestManifest(t, dir, "metadata/m3.avro", &schema1, iceberg.ManifestContentDeletes, 2, 2, []*iceberg.DataFile{del})
+	for i, files := range [][]*iceberg.ManifestFile{{m1}, {m1, m2, m3}} {
+		var buf bytes.Buffer
+		require.NoError(t, iceberg.WriteManifestList(&buf, int64(i+1), files))
+		writeIcebergTestFile(t, dir, "metadata/snap-"+strconv.Itoa(i+1)+".avro", buf.Bytes())
+	}
+
+	schemaID0 := 0
+	current := int64(2)
+	data, err := json.Marshal(&iceberg.Metadata{
+		FormatVersion:      2,
+		Location:           icebergTestLocation,
+		LastSequenceNumber: 2,
+		CurrentSchemaID:    1,
+		Schemas:            []iceberg.Schema{schema0, schema1},
+		PartitionSpecs:     []iceberg.PartitionSpec{{}},
+		CurrentSnapshotID:  &current,
+		Snapshots: []iceberg.Snapshot{
+			{ID: 1, SequenceNumber: 1, ManifestList: icebergTestLocation + "/metadata/snap-1.avro", SchemaID: &schemaID0},
+			{ID: 2, SequenceNumber: 2, ManifestList: icebergTestLocation + "/metadata/snap-2.avro"},
+		},
+	})
+	require.NoError(t, err)
+	writeIcebergTestFile(t, dir, "metadata/v2.metadata.json", data)
+	writeIcebergTestFile(t, dir, "metadata/version-hint.text", []byte("2"))
+}
+
+var (
+	icebergTestNames = []string{"id", "label", "score"}
+	icebergTestTypes = []types.Type{types.T_int64.ToType(), types.T_varchar.ToType(), types.T_float64.ToType()}
+)
+
+func newIcebergTestNode(filters ...*plan.Expr) *plan.Node {
+	cols := make([]*plan.ColDef, len(icebergTestNames))
+	for i, name := range icebergTestNames {
+		cols[i] = &plan.ColDef{Name: name, Typ: plan.Type{Id: int32(icebergTestTypes[i].Oid)}}
+	}
+	return &plan.Node{TableDef: &plan.TableDef{Cols: cols}, FilterList: filters}
+}
+
+// readAllIceberg reads the files of the table like an external scan.
+func readAllIceberg(t *testing.T, extern *tree.ExternParam, files []string, sizes []int64, proc *process.Process) (ids []int64, labels []string, nullScores int) {
+	param := newArrowTestParam(nil, icebergTestNames, icebergTestTypes)
+	param.Extern = extern
+	param.FileSize = sizes
+	param.Fileparam.FileCnt = len(files)
+	r := NewIcebergReader(param, proc)
+	for i, file := range files {
+		param.Fileparam.FileIndex = i + 1
+		param.Fileparam.Filepath = file
+		fileEmpty, err := r.Open(param, proc)
+		require.NoError(t, err)
+		require.False(t, fileEmpty)
+		for finished := false; !finished; {
+			bat := vectorBatch(icebergTestTypes)
+			finished, err = r.ReadBatch(context.Background(), bat, proc, nil)
+			require.NoError(t, err)
+			for _, vec := range bat.Vecs {
+				require.Equal(t, bat.RowCount(), vec.Length())
+			}
+			ids = append(ids, vector.MustFixedColWithTypeCheck[int64](bat.Vecs[0])...)
+			for j := 0; j < bat.RowCount(); j++ {
+				labels = append(labels, bat.Vecs[1].GetStringAt(j))
+				if bat.Vecs[2].IsNull(uint64(j)) {
+					nullScores++
+				}
+			}
+			bat.Clean(proc.Mp())
+		}
+		require.NoError(t, r.Close())
+	}
+	return
+}
+
+func newIcebergTestParam(dir string, options ...string) *tree.ExternParam {
+	return &tree.ExternParam{ExParamConst: tree.ExParamConst{
+		ScanType: tree.INFILE,
+		Filepath: dir,
+		Format:   tree.ICEBERG,
+		Option:   options,
+	}}
+}
+
+func TestIceberg_ReadSnapshot(t *testing.T) {
+	ctx := context.Background()
+	proc := testutil.NewProc(t)
+	dir := t.TempDir()
+	newIcebergTestTable(t, dir)
+
+	extern := newIcebergTestParam(dir)
+	files, sizes, err := PlanIcebergFileList(ctx, proc, newIcebergTestNode(), extern)
+	require.NoError(t, err)
+	require.Equal(t, []string{dir + "/data/f1.parquet", dir + "/data/f2.parquet"}, files)
+	snapshotID, ok := plan2.GetExternalOption(extern, plan2.IcebergSnapshotIDKey)
+	require.True(t, ok)
+	require.Equal(t, "2", snapshotID)
+	metadataFile, ok := plan2.GetExternalOption(extern, plan2.IcebergMetadataFileKey)
+	require.True(t, ok)
+	require.Equal(t, dir+"/metadata/v2.metadata.json", metadataFile)
+
+	// row 1 is deleted, the renamed column is read by field id and the
+	// added column is null in the older file
+	ids, labels, nullScores := readAllIceberg(t, extern, files, sizes, proc)
+	require.Equal(t, []int64{2, 3, 4, 5}, ids)
+	require.Equal(t, []string{"b", "c", "d", "e"}, labels)
+	require.Equal(t, 2, nullScores)
+}
+
+func TestIceberg_TimeTravel(t *testing.T) {
+	ctx := context.Background()
+	proc := testutil.NewProc(t)
+	dir := t.TempDir()
+	newIcebergTestTable(t, dir)
+
+	extern := newIcebergTestParam(dir, plan2.IcebergSnapshotIDKey, "1")
+	files, sizes, err := PlanIcebergFileList(ctx, proc, newIcebergTestNode(), extern)
+	require.NoError(t, err)
+	require.Equal(t, []string{dir + "/data/f1.parquet"}, files)
+	ids, labels, nullScores := readAllIceberg(t, extern, files, sizes, proc)
+	require.Equal(t, []int64{1, 2, 3}, ids)
+	require.Equal(t, []string{"a", "b", "c"}, labels)
+	require.Equal(t, 3, nullScores)
+
+	// a file of another snapshot is rejected
+	param := newArrowTestParam(nil, icebergTestNames, icebergTestTypes)
+	param.Extern = extern
+	param.Fileparam.Filepath = dir + "/data/f2.parquet"
+	_, err = NewIcebergReader(param, proc).Open(param, proc)
+	require.ErrorContains(t, err, "not in the iceberg snapshot")
+
+	_, _, err = PlanIcebergFileList(ctx, proc, newIcebergTestNode(), newIcebergTestParam(dir, plan2.IcebergSnapshotIDKey, "9"))
+	require.ErrorContains(t, err, "snapshot 9 not found")
+}
+
+func TestIceberg_PruneFiles(t *testing.T) {
+	ctx := context.Background()
+	proc := testutil.NewProc(t)
+	dir := t.TempDir()
+	newIcebergTestTable(t, dir)
+
+	colExpr := &plan.Expr{
+		Typ:  plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0, Name: "id"}},
+	}
+	constExpr := &plan.Expr{
+		Typ:  plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Lit{Lit: &plan.Literal{Value: &plan.Literal_I64Val{I64Val: 3}}},
+	}
+	filter, err := plan2.BindFuncExprImplByPlanExpr(ctx, ">", []*plan.Expr{colExpr, constExpr})
+	require.NoError(t, err)
+	files, _, err := PlanIcebergFileList(ctx, proc, newIcebergTestNode(filter), newIcebergTestParam(dir))
+	require.NoError(t, err)
+	require.Equal(t, []string{dir + "/data/f2.parquet"}, files)
+
+	// unknown columns are not pruned
+	colExpr.Expr = &plan.Expr_Col{Col: &plan.ColRef{ColPos: 2, Name: "score"}}
+	colExpr.Typ = plan.Type{Id: int32(types.T_float64)}
+	constExpr.Typ = colExpr.Typ
+	constExpr.Expr = &plan.Expr_Lit{Lit: &plan.Literal{Value: &plan.Literal_Dval{Dval: 100}}}
+	filter, err = plan2.BindFuncExprImplByPlanExpr(ctx, ">", []*plan.Expr{colExpr, constExpr})
+	require.NoError(t, err)
+	files, _, err = PlanIcebergFileList(ctx, proc, newIcebergTestNode(filter), newIcebergTestParam(dir))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+}
+
+func TestIcebergFileIO_Resolve(t *testing.T) {
+	fio := newIcebergFileIO(newIcebergTestParam("/data/t/metadata/v3.metadata.json"))
+	require.Equal(t, "/data/t", fio.root)
+	fio.location = icebergTestLocation
+	require.Equal(t, "/data/t/data/f.parquet", fio.resolve(icebergTestLocation+"/data/f.parquet"))
+	require.Equal(t, "/other/f.parquet", fio.resolve("file:///other/f.parquet"))
+	require.Equal(t, "b/f.parquet", fio.resolve("s3a://a/b/f.parquet"))
+	require.Equal(t, "/x/f.parquet", fio.resolve("file:/x/f.parquet"))
+}
@@ -0,0 +1,784 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external/orc"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var maxOrcBatchCnt int64 = 100000
+
+// orcMapper appends slots [start, end) of an ORC column to vec.
+type orcMapper func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error
+
+// OrcHandler reads the stripes of one ORC file.
+type OrcHandler struct {
+	file *orc.File
+	// next stripe to read
+	stripe int
+
+	// columns of the current stripe, indexed by param.Cols position
+	cols     []*orc.Column
+	rows     int64
+	offset   int64
+	batchCnt int64
+	finished bool
+
+	// indexed by param.Cols position, 0 / nil for columns not in the file
+	fields  []int
+	mappers []orcMapper
+	// type ids of the mapped columns, in param.Cols order
+	ids []int
+
+	partitionColIndices []int
+	filepathColIndex    int
+	hasPhysicalCol      bool
+	// zonemappable is true if the stripe statistics of every column of the
+	// filter can be used
+	zonemappable bool
+	metas        columnMetas
+	// session time zone, for TIMESTAMP columns
+	loc *time.Location
+}
+
+func newOrcHandler(param *ExternalParam, proc *process.Process) (*OrcHandler, error) {
+	h := &OrcHandler{
+		batchCnt:         maxOrcBatchCnt,
+		filepathColIndex: -1,
+		loc:              time.Local,
+	}
+	if tz := proc.GetSessionInfo().TimeZone; tz != nil {
+		h.loc = tz
+	}
+	r, fileSize, err := openFileReaderAt(param)
+	if err != nil {
+		return nil, err
+	}
+	if h.file, err = orc.Open(r, fileSize); err != nil {
+		return nil, err
+	}
+	if err = h.prepare(param); err != nil {
+		return nil, err
+	}
+	if err = h.nextStripe(param, proc); err != nil {
+		return nil, err
+	}
+	// Caller treats (nil, nil) as "empty file, advance to next".
+	if h.finished {
+		return nil, nil
+	}
+	return h, nil
+}
+
+// findField finds a top-level field of the ORC schema with case-insensitive
+// matching, the same way as parquetColumnLookup.find. It returns the type id
+// of the field, or 0 if there is none.
+func (h *OrcHandler) findField(ctx context.Context, name string) (int, error) {
+	root := &h.file.Types()[0]
+	exact, found := -1, -1
+	for i, fieldName := range root.FieldNames {
+		if i >= len(root.Subtypes) {
+			break
+		}
+		if fieldName == name {
+			exact = i
+		}
+		if strings.EqualFold(fieldName, name) {
+			if found >= 0 {
+				return 0, moerr.NewInvalidInputf(ctx,
+					"ambiguous column name %s: multiple columns match case-insensitively (%s and %s)",
+					name, root.FieldNames[found], fieldName)
+			}
+			found = i
+		}
+	}
+	if exact >= 0 {
+		return int(root.Subtypes[exact]), nil
+	}
+	if found >= 0 {
+		return int(root.Subtypes[found]), nil
+	}
+	return 0, nil
+}
+
+func (h *OrcHandler) prepare(param *ExternalParam) error {
+	typs := h.file.Types()
+	h.fields = make([]int, len(param.Cols))
+	h.mappers = make([]orcMapper, len(param.Cols))
+	for _, attr := range param.Attrs {
+		colIdx := int(attr.ColIndex)
+		if colIdx < 0 || colIdx >= len(param.Cols) {
+			return moerr.NewInvalidInputf(param.Ctx, "invalid column index %d for column %s", attr.ColIndex, attr.ColName)
+		}
+		def := param.Cols[colIdx]
+		if def.Hidden {
+			continue
+		}
+		if param.isHivePartitionCol(attr.ColName) {
+			h.partitionColIndices = append(h.partitionColIndices, colIdx)
+			continue
+		}
+		if catalog.ContainExternalHidenCol(attr.ColName) {
+			h.filepathColIndex = colIdx
+			continue
+		}
+		name, inFile := param.fileColumnName(attr.ColName)
+		if !inFile {
+			continue
+		}
+		h.hasPhysicalCol = true
+
+		field, err := h.findField(param.Ctx, name)
+		if err != nil {
+			return err
+		}
+		if field == 0 {
+			return moerr.NewInvalidInputf(param.Ctx, "column %s not found", name)
+		}
+		typ := &typs[field]
+		fn := h.getMapper(typ, def.Typ)
+		if fn == nil {
+			return moerr.NewNYIf(param.Ctx, "load %s to %s", typ.Kind, types.T(def.Typ.Id).String())
+		}
+		h.fields[colIdx] = field
+		h.mappers[colIdx] = fn
+		h.ids = append(h.ids, field)
+	}
+
+	filter := param.Filter
+	if filter != nil && filter.zonemappable && filter.FilterExpr != nil && len(filter.columnMap) > 0 {
+		h.zonemappable = true
+		h.metas = make(columnMetas, len(param.Cols))
+		for _, colIdx := range filter.columnMap {
+			if colIdx < 0 || colIdx >= len(param.Cols) || h.mappers[colIdx] == nil ||
+				!h.hasUsableStats(&typs[h.fields[colIdx]], types.T(param.Cols[colIdx].Typ.Id)) {
+				h.zonemappable = false
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// nextStripe loads the next non-empty stripe whose statistics may satisfy the
+// filter, setting h.finished at the end of the file.
+func (h *OrcHandler) nextStripe(param *ExternalParam, proc *process.Process) error {
+	h.cols = nil
+	h.offset = 0
+	for ; h.stripe < h.file.NumStripes(); h.stripe++ {
+		rows := int64(h.file.Footer.Stripes[h.stripe].NumberOfRows)
+		if rows == 0 {
+			continue
+		}
+		if h.zonemappable {
+			read, err := h.stripeMayMatch(param, proc, h.stripe, rows)
+			if err != nil {
+				return err
+			}
+			if !read {
+				continue
+			}
+		}
+		if h.hasPhysicalCol {
+			cols, err := h.file.ReadStripe(h.stripe, h.ids)
+			if err != nil {
+				return err
+			}
+			h.cols = make([]*orc.Column, len(param.Cols))
+			k := 0
+			for colIdx, fn := range h.mappers {
+				if fn != nil {
+					h.cols[colIdx] = cols[k]
+					k++
+				}
+			}
+		}
+		h.rows = rows
+		h.stripe++
+		return nil
+	}
+	h.finished = true
+	return nil
+}
+
+func (h *OrcHandler) isFinished() bool {
+	return h.finished
+}
+
+func (h *OrcHandler) close() {
+	h.cols = nil
+	h.file = nil
+}
+
+// hasUsableStats reports whether the statistics of a column of type typ can
+// be mapped to zonemaps of a column of type target. The statistics must be
+// mapped by an order preserving conversion.
+func (h *OrcHandler) hasUsableStats(typ *orc.Type, target types.T) bool {
+	switch typ.Kind {
+	case orc.KindByte, orc.KindShort, orc.KindInt, orc.KindLong,
+		orc.KindFloat, orc.KindDouble, orc.KindDate, orc.KindDecimal:
+		return true
+	case orc.KindString, orc.KindVarchar:
+		// string statistics are wrong before HIVE-8732
+		return h.file.PostScript.WriterVersion >= orc.WriterVersionHive8732 && target != types.T_json
+	case orc.KindTimestamp:
+		// timestamp statistics are in the writer time zone before ORC-135,
+		// and local times do not map to TIMESTAMP in order across DST changes
+		return h.file.PostScript.WriterVersion >= orc.WriterVersionOrc135 && target == types.T_datetime
+	case orc.KindTimestampInstant:
+		return h.file.PostScript.WriterVersion >= orc.WriterVersionOrc135 && target == types.T_timestamp
+	}
+	return false
+}
+
+// statsColumn builds a column of two slots, the minimum and the maximum of a
+// column statistics, or returns nil if they are missing.
+func statsColumn(typ *orc.Type, stats *orc.ColumnStatistics) *orc.Column {
+	col := &orc.Column{Type: typ, Len: 2}
+	switch typ.Kind {
+	case orc.KindByte, orc.KindShort, orc.KindInt, orc.KindLong:
+		s := stats.Int
+		if s == nil || !s.HasMinimum || !s.HasMaximum {
+			return nil
+		}
+		col.Ints = []int64{s.Minimum, s.Maximum}
+	case orc.KindFloat, orc.KindDouble:
+		s := stats.Double
+		if s == nil || !s.HasMinimum || !s.HasMaximum || math.IsNaN(s.Minimum) || math.IsNaN(s.Maximum) {
+			return nil
+		}
+		col.Floats = []float64{s.Minimum, s.Maximum}
+	case orc.KindString, orc.KindVarchar:
+		s := stats.String
+		if s == nil || !s.HasMinimum || !s.HasMaximum {
+			return nil
+		}
+		col.Bytes = [][]byte{[]byte(s.Minimum), []byte(s.Maximum)}
+	case orc.KindDate:
+		s := stats.Date
+		if s == nil || !s.HasMinimum || !s.HasMaximum {
+			return nil
+		}
+		col.Ints = []int64{int64(s.Minimum), int64(s.Maximum)}
+	case orc.KindDecimal:
+		s := stats.Decimal
+		if s == nil || s.Minimum == "" || s.Maximum == "" {
+			return nil
+		}
+		scale := int32(typ.Scale)
+		col.Decimals = make([]orc.Decimal128, 2)
+		col.Scales = []int32{scale, scale}
+		for i, str := range []string{s.Minimum, s.Maximum} {
+			d, err := types.ParseDecimal128(str, 38, scale)
+			if err != nil {
+				return nil
+			}
+			col.Decimals[i] = orc.Decimal128{Lo: d.B0_63, Hi: int64(d.B64_127)}
+		}
+	case orc.KindTimestamp, orc.KindTimestampInstant:
+		s := stats.Timestamp
+		if s == nil || !s.HasMinimum || !s.HasMaximum {
+			return nil
+		}
+		// the statistics are in milliseconds, round the maximum up
+		col.Timestamps = []orc.Timestamp{
+			msToOrcTimestamp(s.MinimumUtc, 0),
+			msToOrcTimestamp(s.MaximumUtc, 999999),
+		}
+	default:
+		return nil
+	}
+	return col
+}
+
+func msToOrcTimestamp(ms int64, extraNanos int32) orc.Timestamp {
+	secs := floorDiv(ms, 1000)
+	return orc.Timestamp{Seconds: secs, Nanos: int32(ms-secs*1000)*1000000 + extraNanos}
+}
+
+// stripeMayMatch evaluates the filter against the zonemaps built from the
+// statistics of stripe i. Stripes without usable statistics are read.
+func (h *OrcHandler) stripeMayMatch(param *ExternalParam, proc *process.Process, i int, rows int64) (bool, error) {
+	stats := h.file.StripeStatistics(i)
+	if stats == nil {
+		return true, nil
+	}
+	typs := h.file.Types()
+	mp := proc.Mp()
+	for _, colIdx := range param.Filter.columnMap {
+		meta := objectio.BuildColumnMeta()
+		h.metas[colIdx] = meta
+		s := &stats[h.fields[colIdx]]
+		meta.SetNullCnt(uint32(rows - int64(min(s.NumberOfValues, uint64(rows)))))
+		if s.NumberOfValues == 0 {
+			// all null, the zonemap is left uninitialized
+			continue
+		}
+		col := statsColumn(&typs[h.fields[colIdx]], s)
+		if col == nil {
+			return true, nil
+		}
+		typ := param.Cols[colIdx].Typ
+		vec := vector.NewVec(types.New(types.T(typ.Id), typ.Width, typ.Scale))
+		err := h.mappers[colIdx](param.Ctx, col, 0, 2, vec, mp)
+		if err != nil {
+			// statistics out of the range of the column are not used
+			vec.Free(mp)
+			return true, nil
+		}
+		zm := index.NewZM(vec.GetType().Oid, vec.GetType().Scale)
+		err = index.BatchUpdateZM(zm, vec)
+		vec.Free(mp)
+		if err != nil {
+			return false, err
+		}
+		meta.SetZoneMap(zm)
+	}
+	return h.needRead(param.Ctx, param, proc), nil
+}
+
+// mapColumn appends rows [start, end) of the current stripe to the vector of
+// column colIdx.
+func (h *OrcHandler) mapColumn(ctx context.Context, colIdx int, start, end int64, vec *vector.Vector, mp *mpool.MPool) error {
+	return h.mappers[colIdx](ctx, h.cols[colIdx], int(start), int(end), vec, mp)
+}
+
+func (h *OrcHandler) getMapper(typ *orc.Type, def plan.Type) orcMapper {
+	kind := typ.Kind
+	switch types.T(def.Id) {
+	case types.T_bool:
+		if kind == orc.KindBoolean {
+			return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				return appendOrcFixed(col, start, end, vec, mp, func(i int) (bool, error) {
+					return col.Ints[i] != 0, nil
+				})
+			}
+		}
+	case types.T_int8:
+		return orcIntMapper[int8](kind, math.MinInt8, math.MaxInt8)
+	case types.T_int16:
+		return orcIntMapper[int16](kind, math.MinInt16, math.MaxInt16)
+	case types.T_int32:
+		return orcIntMapper[int32](kind, math.MinInt32, math.MaxInt32)
+	case types.T_int64:
+		return orcIntMapper[int64](kind, math.MinInt64, math.MaxInt64)
+	case types.T_uint8:
+		return orcUintMapper[uint8](kind, math.MaxUint8)
+	case types.T_uint16:
+		return orcUintMapper[uint16](kind, math.MaxUint16)
+	case types.T_uint32:
+		return orcUintMapper[uint32](kind, math.MaxUint32)
+	case types.T_uint64, types.T_bit:
+		return orcUintMapper[uint64](kind, math.MaxUint64)
+	case types.T_float32:
+		return orcFloatMapper[float32](kind)
+	case types.T_float64:
+		return orcFloatMapper[float64](kind)
+	case types.T_char, types.T_varchar, types.T_text:
+		if isOrcNested(kind) {
+			return orcNestedMapper(false)
+		}
+		return orcBytesMapper(kind)
+	case types.T_binary, types.T_varbinary, types.T_blob, types.T_datalink:
+		return orcBytesMapper(kind)
+	case types.T_json:
+		if isOrcNested(kind) {
+			return orcNestedMapper(true)
+		}
+		if isOrcString(kind) {
+			return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				return appendOrcBytes(col, start, end, vec, mp, func(i int) ([]byte, error) {
+					bj, err := types.ParseSliceToByteJson(col.Bytes[i])
+					if err != nil {
+						return nil, err
+					}
+					return types.EncodeJson(bj)
+				})
+			}
+		}
+	case types.T_date:
+		if kind == orc.KindDate {
+			return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				return appendOrcFixed(col, start, end, vec, mp, func(i int) (types.Date, error) {
+					return types.DaysFromUnixEpochToDate(int32(col.Ints[i])), nil
+				})
+			}
+		}
+	case types.T_datetime:
+		switch kind {
+		case orc.KindTimestamp:
+			return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				return appendOrcFixed(col, start, end, vec, mp, func(i int) (types.Datetime, error) {
+					return orcDatetime(col.Timestamps[i]), nil
+				})
+			}
+		case orc.KindTimestampInstant:
+			return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				return appendOrcFixed(col, start, end, vec, mp, func(i int) (types.Datetime, error) {
+					return types.Timestamp(orcDatetime(col.Timestamps[i])).ToDatetime(h.loc), nil
+				})
+			}
+		case orc.KindDate:
+			return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				return appendOrcFixed(col, start, end, vec, mp, func(i int) (types.Datetime, error) {
+					return types.DaysFromUnixEpochToDate(int32(col.Ints[i])).ToDatetime(), nil
+				})
+			}
+		}
+	case types.T_timestamp:
+		switch kind {
+		case orc.KindTimestamp:
+			// local times are taken in the session time zone
+			return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				return appendOrcFixed(col, start, end, vec, mp, func(i int) (types.Timestamp, error) {
+					return orcDatetime(col.Timestamps[i]).ToTimestamp(h.loc), nil
+				})
+			}
+		case orc.KindTimestampInstant:
+			return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+				return appendOrcFixed(col, start, end, vec, mp, func(i int) (types.Timestamp, error) {
+					return types.Timestamp(orcDatetime(col.Timestamps[i])), nil
+				})
+			}
+		}
+	case types.T_decimal64, types.T_decimal128:
+		if kind == orc.KindDecimal {
+			return orcDecimalMapper(def)
+		}
+	case types.T_array_float32:
+		if isOrcFloatList(typ, h.file.Types()) {
+			return orcListToArrayMapper[float32]()
+		}
+	case types.T_array_float64:
+		if isOrcFloatList(typ, h.file.Types()) {
+			return orcListToArrayMapper[float64]()
+		}
+	}
+	return nil
+}
+
+func appendOrcFixed[T any](col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool, get func(i int) (T, error)) error {
+	if err := vec.PreExtend(end-start, mp); err != nil {
+		return err
+	}
+	var zero T
+	for i := start; i < end; i++ {
+		if col.IsNull(i) {
+			if err := vector.AppendFixed(vec, zero, true, mp); err != nil {
+				return err
+			}
+			continue
+		}
+		v, err := get(i)
+		if err != nil {
+			return err
+		}
+		if err := vector.AppendFixed(vec, v, false, mp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendOrcBytes(col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool, get func(i int) ([]byte, error)) error {
+	if err := vec.PreExtend(end-start, mp); err != nil {
+		return err
+	}
+	for i := start; i < end; i++ {
+		if col.IsNull(i) {
+			if err := vector.AppendBytes(vec, nil, true, mp); err != nil {
+				return err
+			}
+			continue
+		}
+		v, err := get(i)
+		if err != nil {
+			return err
+		}
+		if err := vector.AppendBytes(vec, v, false, mp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isOrcInteger(kind orc.Kind) bool {
+	switch kind {
+	case orc.KindByte, orc.KindShort, orc.KindInt, orc.KindLong:
+		return true
+	}
+	return false
+}
+
+func isOrcString(kind orc.Kind) bool {
+	switch kind {
+	case orc.KindString, orc.KindVarchar, orc.KindChar:
+		return true
+	}
+	return false
+}
+
+func isOrcNested(kind orc.Kind) bool {
+	switch kind {
+	case orc.KindStruct, orc.KindList, orc.KindMap:
+		return true
+	}
+	return false
+}
+
+func orcIntMapper[T int8 | int16 | int32 | int64](kind orc.Kind, minVal, maxVal int64) orcMapper {
+	if !isOrcInteger(kind) {
+		return nil
+	}
+	return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+		return appendOrcFixed(col, start, end, vec, mp, func(i int) (T, error) {
+			v := col.Ints[i]
+			if v < minVal || v > maxVal {
+				return 0, moerr.NewOutOfRangef(ctx, vec.GetType().String(), "value '%d'", v)
+			}
+			return T(v), nil
+		})
+	}
+}
+
+func orcUintMapper[T uint8 | uint16 | uint32 | uint64](kind orc.Kind, maxVal uint64) orcMapper {
+	if !isOrcInteger(kind) {
+		return nil
+	}
+	return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+		return appendOrcFixed(col, start, end, vec, mp, func(i int) (T, error) {
+			v := col.Ints[i]
+			if v < 0 || uint64(v) > maxVal {
+				return 0, moerr.NewOutOfRangef(ctx, vec.GetType().String(), "value '%d'", v)
+			}
+			return T(v), nil
+		})
+	}
+}
+
+func orcFloatMapper[T float32 | float64](kind orc.Kind) orcMapper {
+	switch {
+	case kind == orc.KindFloat || kind == orc.KindDouble:
+		return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+			return appendOrcFixed(col, start, end, vec, mp, func(i int) (T, error) {
+				return T(col.Floats[i]), nil
+			})
+		}
+	case isOrcInteger(kind):
+		return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+			return appendOrcFixed(col, start, end, vec, mp, func(i int) (T, error) {
+				return T(col.Ints[i]), nil
+			})
+		}
+	}
+	return nil
+}
+
+func orcBytesMapper(kind orc.Kind) orcMapper {
+	switch kind {
+	case orc.KindString, orc.KindVarchar, orc.KindBinary:
+		return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+			return appendOrcBytes(col, start, end, vec, mp, func(i int) ([]byte, error) {
+				return col.Bytes[i], nil
+			})
+		}
+	case orc.KindChar:
+		// CHAR values are padded with spaces to their length
+		return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+			return appendOrcBytes(col, start, end, vec, mp, func(i int) ([]byte, error) {
+				return bytes.TrimRight(col.Bytes[i], " "), nil
+			})
+		}
+	}
+	return nil
+}
+
+// orcDatetime returns the datetime of a timestamp taken as UTC, which is
+// also the Unix time of TIMESTAMP_INSTANT values.
+func orcDatetime(ts orc.Timestamp) types.Datetime {
+	return types.Datetime(ts.Seconds*types.MicroSecsPerSec + int64(ts.Nanos)/1000 + types.GetUnixEpochSecs())
+}
+
+func orcDecimal(col *orc.Column, i int) types.Decimal128 {
+	d := col.Decimals[i]
+	return types.Decimal128{B0_63: d.Lo, B64_127: uint64(d.Hi)}
+}
+
+// orcDecimalMapper rescales the decimals of a column, whose scale may vary from
+// value to value, to the scale of typ.
+func orcDecimalMapper(typ plan.Type) orcMapper {
+	toDecimal128 := func(col *orc.Column, i int) (types.Decimal128, error) {
+		d := orcDecimal(col, i)
+		if diff := typ.Scale - col.Scales[i]; diff != 0 {
+			return d.Scale(diff)
+		}
+		return d, nil
+	}
+	if types.T(typ.Id) == types.T_decimal128 {
+		return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+			return appendOrcFixed(col, start, end, vec, mp, func(i int) (types.Decimal128, error) {
+				return toDecimal128(col, i)
+			})
+		}
+	}
+	return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+		return appendOrcFixed(col, start, end, vec, mp, func(i int) (types.Decimal64, error) {
+			d, err := toDecimal128(col, i)
+			if err != nil {
+				return 0, err
+			}
+			// fits in 64 bits only if the high word is the sign extension of the low word
+			if int64(d.B64_127) != int64(d.B0_63)>>63 {
+				return 0, moerr.NewOutOfRangef(ctx, vec.GetType().String(), "value '%s'", d.Format(typ.Scale))
+			}
+			return types.Decimal64(d.B0_63), nil
+		})
+	}
+}
+
+func isOrcFloatList(typ *orc.Type, typs []orc.Type) bool {
+	if typ.Kind != orc.KindList || len(typ.Subtypes) != 1 {
+		return false
+	}
+	elem := typs[typ.Subtypes[0]].Kind
+	return elem == orc.KindFloat || elem == orc.KindDouble
+}
+
+func orcListToArrayMapper[T float32 | float64]() orcMapper {
+	return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+		values := col.Children[0]
+		width := int(vec.GetType().Width)
+		var buf []T
+		return appendOrcBytes(col, start, end, vec, mp, func(i int) ([]byte, error) {
+			from, to := int(col.Offsets[i]), int(col.Offsets[i+1])
+			if width != types.MaxArrayDimension && width != to-from {
+				return nil, moerr.NewArrayDefMismatchNoCtx(width, to-from)
+			}
+			buf = buf[:0]
+			for j := from; j < to; j++ {
+				if values.IsNull(j) {
+					return nil, moerr.NewInvalidInputf(ctx, "null element in vector column %s", vec.GetType().String())
+				}
+				buf = append(buf, T(values.Floats[j]))
+			}
+			return types.ArrayToBytes[T](buf), nil
+		})
+	}
+}
+
+// orcNestedMapper maps structs, lists and maps to JSON, or to its text for
+// the string types, like writeNestedToVector.
+func orcNestedMapper(toJson bool) orcMapper {
+	return func(ctx context.Context, col *orc.Column, start, end int, vec *vector.Vector, mp *mpool.MPool) error {
+		return appendOrcBytes(col, start, end, vec, mp, func(i int) ([]byte, error) {
+			nested, err := orcValueToGo(ctx, col, i)
+			if err != nil {
+				return nil, err
+			}
+			bj, err := bytejson.CreateByteJSON(nested)
+			if err != nil {
+				return nil, moerr.NewInternalErrorf(ctx, "failed to create JSON: %v", err)
+			}
+			if toJson {
+				return types.EncodeJson(bj)
+			}
+			return []byte(bj.String()), nil
+		})
+	}
+}
+
+// orcValueToGo converts slot i of a column to the Go value of its JSON
+// representation: structs and maps become objects, lists become arrays.
+func orcValueToGo(ctx context.Context, col *orc.Column, i int) (any, error) {
+	if col.IsNull(i) {
+		return nil, nil
+	}
+	switch col.Type.Kind {
+	case orc.KindBoolean:
+		return col.Ints[i] != 0, nil
+	case orc.KindByte, orc.KindShort, orc.KindInt, orc.KindLong:
+		return col.Ints[i], nil
+	case orc.KindFloat, orc.KindDouble:
+		return col.Floats[i], nil
+	case orc.KindString, orc.KindVarchar, orc.KindBinary:
+		return string(col.Bytes[i]), nil
+	case orc.KindChar:
+		return string(bytes.TrimRight(col.Bytes[i], " ")), nil
+	case orc.KindDecimal:
+		return json.Number(orcDecimal(col, i).Format(col.Scales[i])), nil
+	case orc.KindDate:
+		return types.DaysFromUnixEpochToDate(int32(col.Ints[i])).String(), nil
+	case orc.KindTimestamp, orc.KindTimestampInstant:
+		return orcDatetime(col.Timestamps[i]).String2(6), nil
+	case orc.KindStruct:
+		obj := make(map[string]any, len(col.Children))
+		for j, child := range col.Children {
+			v, err := orcValueToGo(ctx, child, i)
+			if err != nil {
+				return nil, err
+			}
+			obj[col.Type.FieldNames[j]] = v
+		}
+		return obj, nil
+	case orc.KindList:
+		arr := make([]any, 0, col.Offsets[i+1]-col.Offsets[i])
+		for j := int(col.Offsets[i]); j < int(col.Offsets[i+1]); j++ {
+			v, err := orcValueToGo(ctx, col.Children[0], j)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		return arr, nil
+	case orc.KindMap:
+		obj := make(map[string]any, col.Offsets[i+1]-col.Offsets[i])
+		for j := int(col.Offsets[i]); j < int(col.Offsets[i+1]); j++ {
+			k, err := orcValueToGo(ctx, col.Children[0], j)
+			if err != nil {
+				return nil, err
+			}
+			key := "null"
+			if k != nil {
+				key = fmt.Sprint(k)
+			}
+			if _, ok := obj[key]; ok {
+				return nil, moerr.NewInternalErrorf(ctx, "duplicate map key: %s", key)
+			}
+			if obj[key], err = orcValueToGo(ctx, col.Children[1], j); err != nil {
+				return nil, err
+			}
+		}
+		return obj, nil
+	}
+	return nil, moerr.NewNYIf(ctx, "orc type %s in nested column", col.Type.Kind)
+}
@@ -0,0 +1,447 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Timestamps are stored as seconds since 2015-01-01 00:00:00.
+const timestampBase = 1420070400
+
+// Decimal128 is a 128-bit two's complement unscaled decimal value.
+type Decimal128 struct {
+	Lo uint64
+	Hi int64
+}
+
+type Timestamp struct {
+	Seconds int64
+	Nanos   int32
+}
+
+// Column holds the values of one column of a stripe, aligned with the slots
+// of the column: slot i of a value slice is only set if Nulls is nil or
+// Nulls[i] is false. The children of a struct share the slots of the struct,
+// the children of a list or a map have one slot per entry.
+type Column struct {
+	ID    int
+	Type  *Type
+	Len   int
+	Nulls []bool
+
+	// boolean, tinyint, smallint, int, bigint and date, as days since the
+	// Unix epoch
+	Ints   []int64
+	Floats []float64
+	// string, varchar, char and binary
+	Bytes    [][]byte
+	Decimals []Decimal128
+	// decimal scales, which may differ from the scale of the type
+	Scales []int32
+	// Timestamps of TIMESTAMP columns hold the wall clock time in seconds as
+	// if it were UTC, those of TIMESTAMP_INSTANT columns hold Unix times.
+	Timestamps []Timestamp
+	// Offsets holds Len+1 offsets into the children of a list or a map
+	Offsets  []int32
+	Children []*Column
+}
+
+func (c *Column) IsNull(i int) bool {
+	return c.Nulls != nil && c.Nulls[i]
+}
+
+// timeZone converts TIMESTAMP values, stored relative to the writer time
+// zone, back to wall clock times.
+type timeZone struct {
+	loc  *time.Location
+	base int64
+}
+
+func loadTimeZone(name string) (*timeZone, error) {
+	if name == "" || name == "UTC" || name == "GMT" {
+		return &timeZone{base: timestampBase}, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, moerr.NewInvalidInputNoCtxf("unknown orc writer time zone %s", name)
+	}
+	base := time.Date(2015, 1, 1, 0, 0, 0, 0, loc).Unix()
+	return &timeZone{loc: loc, base: base}, nil
+}
+
+// wallClock returns the wall clock time of the given Unix time in seconds as
+// if it were UTC.
+func (tz *timeZone) wallClock(secs int64) int64 {
+	if tz.loc == nil {
+		return secs
+	}
+	_, offset := time.Unix(secs, 0).In(tz.loc).Zone()
+	return secs + int64(offset)
+}
+
+func (sr *stripeReader) stream(id int, kind StreamKind) ([]byte, bool) {
+	b, ok := sr.streams[streamKey{column: uint32(id), kind: kind}]
+	return b, ok
+}
+
+func (sr *stripeReader) mustStream(id int, kind StreamKind, n int) ([]byte, error) {
+	b, ok := sr.stream(id, kind)
+	if !ok && n > 0 {
+		return nil, errCorrupt("stripe, missing stream")
+	}
+	return b, nil
+}
+
+func (sr *stripeReader) ints(id int, kind StreamKind, n int, signed bool) ([]int64, error) {
+	b, err := sr.mustStream(id, kind, n)
+	if err != nil {
+		return nil, err
+	}
+	switch sr.encodings[id].Kind {
+	case EncodingDirect, EncodingDictionary:
+		return decodeIntRLEv1(b, n, signed)
+	}
+	return decodeIntRLEv2(b, n, signed)
+}
+
+// readColumn decodes n slots of column id. Slots that are null in
+// parentNulls have no entry in the streams of the column.
+func (sr *stripeReader) readColumn(id int, n int, parentNulls []bool) (*Column, error) {
+	typ := &sr.f.Footer.Types[id]
+	col := &Column{ID: id, Type: typ, Len: n}
+
+	cnt := n
+	if parentNulls != nil {
+		col.Nulls = make([]bool, n)
+		copy(col.Nulls, parentNulls)
+		cnt = countNotNull(parentNulls)
+	}
+	if b, ok := sr.stream(id, StreamPresent); ok {
+		present, err := decodeBoolRLE(b, cnt)
+		if err != nil {
+			return nil, err
+		}
+		if col.Nulls == nil {
+			col.Nulls = make([]bool, n)
+		}
+		k := 0
+		for i := range col.Nulls {
+			if col.Nulls[i] {
+				continue
+			}
+			col.Nulls[i] = !present[k]
+			k++
+		}
+	}
+	values := countNotNull(col.Nulls)
+	if col.Nulls == nil {
+		values = n
+	}
+
+	var err error
+	switch typ.Kind {
+	case KindBoolean:
+		var b []byte
+		if b, err = sr.mustStream(id, StreamData, values); err != nil {
+			return nil, err
+		}
+		var bools []bool
+		if bools, err = decodeBoolRLE(b, values); err != nil {
+			return nil, err
+		}
+		col.Ints = make([]int64, n)
+		col.scatter(func(i, k int) {
+			if bools[k] {
+				col.Ints[i] = 1
+			}
+		})
+
+	case KindByte:
+		var b []byte
+		if b, err = sr.mustStream(id, StreamData, values); err != nil {
+			return nil, err
+		}
+		if b, err = decodeByteRLE(b, values); err != nil {
+			return nil, err
+		}
+		col.Ints = make([]int64, n)
+		col.scatter(func(i, k int) { col.Ints[i] = int64(int8(b[k])) })
+
+	case KindShort, KindInt, KindLong, KindDate:
+		var v []int64
+		if v, err = sr.ints(id, StreamData, values, true); err != nil {
+			return nil, err
+		}
+		col.Ints = make([]int64, n)
+		col.scatter(func(i, k int) { col.Ints[i] = v[k] })
+
+	case KindFloat, KindDouble:
+		size := 8
+		if typ.Kind == KindFloat {
+			size = 4
+		}
+		var b []byte
+		if b, err = sr.mustStream(id, StreamData, values); err != nil {
+			return nil, err
+		}
+		if len(b) < values*size {
+			return nil, errCorrupt("floating point stream")
+		}
+		col.Floats = make([]float64, n)
+		col.scatter(func(i, k int) {
+			if size == 4 {
+				col.Floats[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(b[k*4:])))
+			} else {
+				col.Floats[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[k*8:]))
+			}
+		})
+
+	case KindString, KindVarchar, KindChar, KindBinary:
+		err = sr.readBytes(col, values)
+
+	case KindDecimal:
+		err = sr.readDecimals(col, values)
+
+	case KindTimestamp, KindTimestampInstant:
+		err = sr.readTimestamps(col, values)
+
+	case KindStruct:
+		col.Children = make([]*Column, len(typ.Subtypes))
+		for j, sub := range typ.Subtypes {
+			if col.Children[j], err = sr.readColumn(int(sub), n, col.Nulls); err != nil {
+				return nil, err
+			}
+		}
+
+	case KindList, KindMap:
+		var lengths []int64
+		if lengths, err = sr.ints(id, StreamLength, values, false); err != nil {
+			return nil, err
+		}
+		col.Offsets = make([]int32, n+1)
+		var total int64
+		k := 0
+		for i := 0; i < n; i++ {
+			if !col.IsNull(i) {
+				if lengths[k] < 0 {
+					return nil, errCorrupt("list length")
+				}
+				total += lengths[k]
+				k++
+			}
+			if total > math.MaxInt32 {
+				return nil, errCorrupt("list length")
+			}
+			col.Offsets[i+1] = int32(total)
+		}
+		col.Children = make([]*Column, len(typ.Subtypes))
+		for j, sub := range typ.Subtypes {
+			if col.Children[j], err = sr.readColumn(int(sub), int(total), nil); err != nil {
+				return nil, err
+			}
+		}
+
+	default:
+		return nil, moerr.NewNYINoCtxf("orc type %s", typ.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return col, nil
+}
+
+// scatter calls fn for every non-null slot i with the index k of its value in
+// the streams.
+func (c *Column) scatter(fn func(i, k int)) {
+	k := 0
+	for i := 0; i < c.Len; i++ {
+		if c.IsNull(i) {
+			continue
+		}
+		fn(i, k)
+		k++
+	}
+}
+
+func countNotNull(nulls []bool) int {
+	cnt := 0
+	for _, null := range nulls {
+		if !null {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+func (sr *stripeReader) readBytes(col *Column, values int) error {
+	id := col.ID
+	enc := sr.encodings[id].Kind
+	col.Bytes = make([][]byte, col.Len)
+
+	if enc == EncodingDictionary || enc == EncodingDictionaryV2 {
+		dictSize := int(sr.encodings[id].DictionarySize)
+		lengths, err := sr.ints(id, StreamLength, dictSize, false)
+		if err != nil {
+			return err
+		}
+		data, err := sr.mustStream(id, StreamDictionaryData, dictSize)
+		if err != nil {
+			return err
+		}
+		dict, err := splitBytes(data, lengths)
+		if err != nil {
+			return err
+		}
+		refs, err := sr.ints(id, StreamData, values, false)
+		if err != nil {
+			return err
+		}
+		for _, ref := range refs {
+			if ref < 0 || ref >= int64(len(dict)) {
+				return errCorrupt("dictionary reference")
+			}
+		}
+		col.scatter(func(i, k int) { col.Bytes[i] = dict[refs[k]] })
+		return nil
+	}
+
+	lengths, err := sr.ints(id, StreamLength, values, false)
+	if err != nil {
+		return err
+	}
+	data, err := sr.mustStream(id, StreamData, values)
+	if err != nil {
+		return err
+	}
+	vals, err := splitBytes(data, lengths)
+	if err != nil {
+		return err
+	}
+	col.scatter(func(i, k int) { col.Bytes[i] = vals[k] })
+	return nil
+}
+
+func splitBytes(data []byte, lengths []int64) ([][]byte, error) {
+	vals := make([][]byte, len(lengths))
+	for i, l := range lengths {
+		if l < 0 || l > int64(len(data)) {
+			return nil, errCorrupt("string length")
+		}
+		vals[i] = data[:l:l]
+		data = data[l:]
+	}
+	return vals, nil
+}
+
+func (sr *stripeReader) readDecimals(col *Column, values int) error {
+	data, err := sr.mustStream(col.ID, StreamData, values)
+	if err != nil {
+		return err
+	}
+	scales, err := sr.ints(col.ID, StreamSecondary, values, true)
+	if err != nil {
+		return err
+	}
+	decs := make([]Decimal128, values)
+	for k := range decs {
+		if decs[k], data, err = readDecimal(data); err != nil {
+			return err
+		}
+	}
+	col.Decimals = make([]Decimal128, col.Len)
+	col.Scales = make([]int32, col.Len)
+	col.scatter(func(i, k int) {
+		col.Decimals[i] = decs[k]
+		col.Scales[i] = int32(scales[k])
+	})
+	return nil
+}
+
+// readDecimal reads an unbounded base 128 varint of a zigzag encoded value.
+func readDecimal(src []byte) (Decimal128, []byte, error) {
+	var lo, hi uint64
+	for i := 0; ; i++ {
+		if i >= len(src) || i*7 >= 128 {
+			return Decimal128{}, nil, errCorrupt("decimal")
+		}
+		b := uint64(src[i] & 0x7f)
+		shift := uint(i * 7)
+		if shift < 64 {
+			lo |= b << shift
+			if shift > 57 {
+				hi |= b >> (64 - shift)
+			}
+		} else {
+			hi |= b << (shift - 64)
+		}
+		if src[i]&0x80 == 0 {
+			src = src[i+1:]
+			break
+		}
+	}
+	neg := lo&1 == 1
+	lo = lo>>1 | hi<<63
+	hi >>= 1
+	if neg {
+		lo, hi = ^lo, ^hi
+	}
+	return Decimal128{Lo: lo, Hi: int64(hi)}, src, nil
+}
+
+func (sr *stripeReader) readTimestamps(col *Column, values int) error {
+	secs, err := sr.ints(col.ID, StreamData, values, true)
+	if err != nil {
+		return err
+	}
+	nanos, err := sr.ints(col.ID, StreamSecondary, values, false)
+	if err != nil {
+		return err
+	}
+	instant := col.Type.Kind == KindTimestampInstant
+	col.Timestamps = make([]Timestamp, col.Len)
+	col.scatter(func(i, k int) {
+		ns := decodeNanos(nanos[k])
+		var s int64
+		if instant {
+			s = secs[k] + timestampBase
+		} else {
+			s = sr.tz.wallClock(secs[k] + sr.tz.base)
+		}
+		// writers truncate negative times towards zero
+		if s < 0 && ns > 999999 {
+			s--
+		}
+		col.Timestamps[i] = Timestamp{Seconds: s, Nanos: int32(ns)}
+	})
+	return nil
+}
+
+// decodeNanos undoes the trailing zeros encoding of nanoseconds: if the low
+// three bits z are not zero, the value is multiplied by 10^(z+1).
+func decodeNanos(v int64) int64 {
+	zeros := v & 7
+	v >>= 3
+	if zeros != 0 {
+		for i := int64(0); i <= zeros; i++ {
+			v *= 10
+		}
+	}
+	return v
+}
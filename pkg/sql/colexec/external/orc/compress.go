@@ -0,0 +1,96 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+)
+
+// chunkHeaderSize is the size of the header of every compressed chunk:
+// a little endian 23-bit length followed by an is-original flag bit.
+const chunkHeaderSize = 3
+
+func errCorrupt(what string) error {
+	return moerr.NewInvalidInputNoCtxf("corrupt orc file: bad %s", what)
+}
+
+// decompress undoes the chunked compression of a stream or a file section.
+// Data of uncompressed files is returned as is.
+func decompress(kind CompressionKind, blockSize uint64, src []byte) ([]byte, error) {
+	if kind == CompressionNone {
+		return src, nil
+	}
+	var dst []byte
+	for len(src) > 0 {
+		if len(src) < chunkHeaderSize {
+			return nil, errCorrupt("compression chunk header")
+		}
+		header := uint32(src[0]) | uint32(src[1])<<8 | uint32(src[2])<<16
+		src = src[chunkHeaderSize:]
+		n := int(header >> 1)
+		if n > len(src) {
+			return nil, errCorrupt("compression chunk length")
+		}
+		chunk := src[:n]
+		src = src[n:]
+		if header&1 == 1 {
+			dst = append(dst, chunk...)
+			continue
+		}
+		var err error
+		if dst, err = decompressChunk(kind, blockSize, chunk, dst); err != nil {
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+func decompressChunk(kind CompressionKind, blockSize uint64, chunk, dst []byte) ([]byte, error) {
+	switch kind {
+	case CompressionZlib:
+		r := flate.NewReader(bytes.NewReader(chunk))
+		defer r.Close()
+		var buf bytes.Buffer
+		if _, err := io.Copy(&buf, r); err != nil {
+			return nil, errCorrupt("zlib chunk")
+		}
+		return append(dst, buf.Bytes()...), nil
+	case CompressionSnappy:
+		out, err := snappy.Decode(nil, chunk)
+		if err != nil {
+			return nil, errCorrupt("snappy chunk")
+		}
+		return append(dst, out...), nil
+	case CompressionLz4:
+		out, err := compress.Decompress(chunk, make([]byte, blockSize), compress.Lz4)
+		if err != nil {
+			return nil, errCorrupt("lz4 chunk")
+		}
+		return append(dst, out...), nil
+	case CompressionZstd:
+		out, err := compress.Decompress(chunk, make([]byte, 0, blockSize), compress.Zstd)
+		if err != nil {
+			return nil, errCorrupt("zstd chunk")
+		}
+		return append(dst, out...), nil
+	}
+	return nil, moerr.NewNYINoCtxf("orc compression %s", kind)
+}
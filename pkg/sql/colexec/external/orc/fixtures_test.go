@@ -0,0 +1,290 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"flag"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The orc files under test/distributed/resources/orc are read by the tests
+// of this package and of the external table reader. They are regenerated by
+//
+//	go test ./pkg/sql/colexec/external/orc -run TestFixtures -update-fixtures
+var updateFixtures = flag.Bool("update-fixtures", false, "rewrite the orc fixture files")
+
+const fixtureDir = "../../../../../test/distributed/resources/orc"
+
+// ordersSchema is struct<id:bigint,Name:string,ts:timestamp,price:decimal(10,2),
+// emb:array<float>,info:struct<a:int,tags:array<string>>,attrs:map<string,int>>
+var ordersSchema = []Type{
+	{Kind: KindStruct, Subtypes: []uint32{1, 2, 3, 4, 5, 7, 11},
+		FieldNames: []string{"id", "Name", "ts", "price", "emb", "info", "attrs"}},
+	{Kind: KindLong},
+	{Kind: KindString},
+	{Kind: KindTimestamp},
+	{Kind: KindDecimal, Precision: 10, Scale: 2},
+	{Kind: KindList, Subtypes: []uint32{6}},
+	{Kind: KindFloat},
+	{Kind: KindStruct, Subtypes: []uint32{8, 9}, FieldNames: []string{"a", "tags"}},
+	{Kind: KindInt},
+	{Kind: KindList, Subtypes: []uint32{10}},
+	{Kind: KindString},
+	{Kind: KindMap, Subtypes: []uint32{12, 13}},
+	{Kind: KindString},
+	{Kind: KindInt},
+}
+
+// ordersStripes are the stripes of the orders files, the second one is
+// empty.
+var ordersStripes = [][]int64{{1, 2, 3}, nil, {4}, {100, 300}}
+
+// ordersColumns builds one stripe: Name is "even" for even ids and null
+// otherwise, info is null for multiples of 3.
+func ordersColumns(ids []int64) []*Column {
+	n := len(ids)
+	col := func(id int) *Column {
+		return &Column{ID: id, Type: &ordersSchema[id], Len: n}
+	}
+	id, name, ts, price := col(1), col(2), col(3), col(4)
+	emb, info, a, tags := col(5), col(7), col(8), col(9)
+	attrs := col(11)
+	id.Ints = ids
+	name.Nulls, name.Bytes = make([]bool, n), make([][]byte, n)
+	ts.Timestamps = make([]Timestamp, n)
+	price.Decimals, price.Scales = make([]Decimal128, n), make([]int32, n)
+	emb.Offsets, tags.Offsets, attrs.Offsets = make([]int32, n+1), make([]int32, n+1), make([]int32, n+1)
+	info.Nulls, a.Nulls, tags.Nulls = make([]bool, n), make([]bool, n), make([]bool, n)
+	a.Ints = make([]int64, n)
+
+	elem := &Column{ID: 6, Type: &ordersSchema[6]}
+	tag := &Column{ID: 10, Type: &ordersSchema[10]}
+	key := &Column{ID: 12, Type: &ordersSchema[12]}
+	val := &Column{ID: 13, Type: &ordersSchema[13]}
+	for i, v := range ids {
+		if v%2 == 0 {
+			name.Bytes[i] = []byte("even")
+		} else {
+			name.Nulls[i] = true
+		}
+		ts.Timestamps[i] = Timestamp{Seconds: v, Nanos: 500000000}
+		price.Decimals[i] = Decimal128{Lo: uint64(v*100 + 5)}
+		price.Scales[i] = 2
+		elem.Floats = append(elem.Floats, float64(v), -1)
+		emb.Offsets[i+1] = int32(len(elem.Floats))
+		if v%3 == 0 {
+			info.Nulls[i], a.Nulls[i], tags.Nulls[i] = true, true, true
+		} else {
+			a.Ints[i] = -v
+			tag.Bytes = append(tag.Bytes, []byte("x"), []byte("y"))
+		}
+		tags.Offsets[i+1] = int32(len(tag.Bytes))
+		key.Bytes = append(key.Bytes, []byte("k"))
+		val.Ints = append(val.Ints, v)
+		attrs.Offsets[i+1] = int32(len(key.Bytes))
+	}
+	elem.Len, tag.Len, key.Len, val.Len = len(elem.Floats), len(tag.Bytes), len(key.Bytes), len(val.Ints)
+	emb.Children = []*Column{elem}
+	tags.Children = []*Column{tag}
+	info.Children = []*Column{a, tags}
+	attrs.Children = []*Column{key, val}
+	return []*Column{id, name, ts, price, emb, info, attrs}
+}
+
+// trickySchema is struct<big:bigint,dec:decimal(38,10),bin:binary,
+// vc:varchar(8),ch:char(4),ts:timestamp,dbl:double,s:string,nothing:int,d:date>
+var trickySchema = []Type{
+	{Kind: KindStruct, Subtypes: []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		FieldNames: []string{"big", "dec", "bin", "vc", "ch", "ts", "dbl", "s", "nothing", "d"}},
+	{Kind: KindLong},
+	{Kind: KindDecimal, Precision: 38, Scale: 10},
+	{Kind: KindBinary},
+	{Kind: KindVarchar, MaximumLength: 8},
+	{Kind: KindChar, MaximumLength: 4},
+	{Kind: KindTimestamp},
+	{Kind: KindDouble},
+	{Kind: KindString},
+	{Kind: KindInt},
+	{Kind: KindDate},
+}
+
+// trickyColumns builds n rows cycling through edge values: extreme
+// integers and decimals, pre-epoch timestamps with nanoseconds, NaN and
+// infinities, empty and multi-byte strings and a column without values.
+// big holds long runs in the first half and a sequence in the second one
+// to exercise the different integer encodings.
+func trickyColumns(n int) []*Column {
+	col := func(id int) *Column {
+		return &Column{ID: id, Type: &trickySchema[id], Len: n, Nulls: make([]bool, n)}
+	}
+	big, dec, bin, vc, ch := col(1), col(2), col(3), col(4), col(5)
+	ts, dbl, s, nothing, d := col(6), col(7), col(8), col(9), col(10)
+
+	bigs := []int64{math.MinInt64, math.MaxInt64, 0, -1}
+	decs := []Decimal128{
+		{Lo: 0x098a223fffffffff, Hi: 0x4b3b4ca85a86c47a}, // 10^38-1
+		{Lo: ^uint64(0), Hi: -1},
+		{},
+		{Lo: 1, Hi: 1 << 40},
+		{Lo: 0, Hi: -1 << 62},
+	}
+	bins := [][]byte{{0x00, 0xff, 0x00}, {}, []byte("ORC\n")}
+	strs := []string{"", "日本語", "emoji 😀", "a\x00b", "plain"}
+	tss := []Timestamp{
+		{Seconds: -1, Nanos: 1},
+		{Seconds: -86401, Nanos: 999999999},
+		{Seconds: 0, Nanos: 0},
+		{Seconds: 253402300799, Nanos: 999999999},
+		{Seconds: -62135596800, Nanos: 0},
+		{Seconds: 1420070400, Nanos: 123456789},
+	}
+	dbls := []float64{math.NaN(), math.Inf(1), math.Inf(-1), math.Copysign(0, -1), math.SmallestNonzeroFloat64, math.MaxFloat64}
+	dates := []int64{-719162, 0, -1, 2932896}
+
+	big.Ints = make([]int64, n)
+	dec.Decimals = make([]Decimal128, n)
+	bin.Bytes, vc.Bytes, ch.Bytes, s.Bytes = make([][]byte, n), make([][]byte, n), make([][]byte, n), make([][]byte, n)
+	ts.Timestamps = make([]Timestamp, n)
+	dbl.Floats = make([]float64, n)
+	nothing.Ints = make([]int64, n)
+	d.Ints = make([]int64, n)
+	for i := 0; i < n; i++ {
+		if i < n/2 {
+			big.Ints[i] = bigs[i/100%len(bigs)]
+		} else {
+			big.Ints[i] = int64(i) * 7
+		}
+		dec.Decimals[i] = decs[i%len(decs)]
+		dec.Nulls[i] = i%7 == 6
+		bin.Bytes[i] = bins[i%len(bins)]
+		vc.Bytes[i] = []byte(strs[i%len(strs)])
+		vc.Nulls[i] = i%11 == 10
+		ch.Bytes[i] = []byte("ab  ")
+		ts.Timestamps[i] = tss[i%len(tss)]
+		dbl.Floats[i] = dbls[i%len(dbls)]
+		s.Bytes[i] = []byte(strs[(i+1)%len(strs)])
+		nothing.Nulls[i] = true
+		d.Ints[i] = dates[i%len(dates)]
+	}
+	big.Nulls, bin.Nulls, ch.Nulls, ts.Nulls, dbl.Nulls, s.Nulls, d.Nulls = nil, nil, nil, nil, nil, nil, nil
+	return []*Column{big, dec, bin, vc, ch, ts, dbl, s, nothing, d}
+}
+
+type fixture struct {
+	name    string
+	schema  []Type
+	opts    WriterOptions
+	stripes [][]*Column
+}
+
+func fixtures() []fixture {
+	var ret []fixture
+	for _, c := range []struct {
+		suffix      string
+		compression CompressionKind
+	}{
+		{"none", CompressionNone},
+		{"zlib", CompressionZlib},
+		{"snappy", CompressionSnappy},
+		{"zstd", CompressionZstd},
+	} {
+		var orders [][]*Column
+		for _, ids := range ordersStripes {
+			orders = append(orders, ordersColumns(ids))
+		}
+		ret = append(ret,
+			fixture{
+				name:    "orders_" + c.suffix + ".orc",
+				schema:  ordersSchema,
+				opts:    WriterOptions{Compression: c.compression},
+				stripes: orders,
+			},
+			fixture{
+				// a small block size splits the streams into many chunks
+				name:    "types_" + c.suffix + ".orc",
+				schema:  testSchema,
+				opts:    WriterOptions{Compression: c.compression, BlockSize: 1000, Dictionary: c.compression != CompressionNone},
+				stripes: [][]*Column{testColumns(1, 1000), testColumns(5000, 10)},
+			},
+		)
+	}
+	ret = append(ret,
+		fixture{
+			name:    "tricky_zstd.orc",
+			schema:  trickySchema,
+			opts:    WriterOptions{Compression: CompressionZstd, BlockSize: 4096, Dictionary: true},
+			stripes: [][]*Column{trickyColumns(3000)},
+		},
+		fixture{
+			name:   "empty_zstd.orc",
+			schema: ordersSchema,
+			opts:   WriterOptions{Compression: CompressionZstd},
+		},
+	)
+	return ret
+}
+
+func TestFixtures(t *testing.T) {
+	for _, fx := range fixtures() {
+		path := filepath.Join(fixtureDir, fx.name)
+		if *updateFixtures {
+			var buf bytes.Buffer
+			w, err := NewWriter(&buf, fx.schema, fx.opts)
+			require.NoError(t, err)
+			for _, cols := range fx.stripes {
+				require.NoError(t, w.WriteStripe(cols))
+			}
+			require.NoError(t, w.Close())
+			require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+		}
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err, fx.name)
+		f, err := Open(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err, fx.name)
+		require.Equal(t, fx.opts.Compression, f.PostScript.Compression, fx.name)
+		require.Equal(t, fx.schema, f.Types(), fx.name)
+		require.Equal(t, len(fx.stripes), f.NumStripes(), fx.name)
+
+		var rows int64
+		ids := fx.schema[0].Subtypes
+		for i, want := range fx.stripes {
+			cols, err := f.ReadStripe(i, toInts(ids))
+			require.NoError(t, err, fx.name)
+			require.Len(t, cols, len(want))
+			for j := range want {
+				requireColumnEqual(t, want[j], cols[j])
+			}
+			if len(want) > 0 {
+				rows += int64(want[0].Len)
+			}
+		}
+		require.Equal(t, rows, f.NumRows(), fx.name)
+	}
+}
+
+func toInts(ids []uint32) []int {
+	ret := make([]int, len(ids))
+	for i, id := range ids {
+		ret[i] = int(id)
+	}
+	return ret
+}
@@ -0,0 +1,587 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The messages below mirror orc_proto.proto. Only the fields MatrixOne uses
+// are decoded; unknown fields are skipped.
+
+type CompressionKind uint64
+
+const (
+	CompressionNone   CompressionKind = 0
+	CompressionZlib   CompressionKind = 1
+	CompressionSnappy CompressionKind = 2
+	CompressionLzo    CompressionKind = 3
+	CompressionLz4    CompressionKind = 4
+	CompressionZstd   CompressionKind = 5
+)
+
+func (c CompressionKind) String() string {
+	switch c {
+	case CompressionNone:
+		return "NONE"
+	case CompressionZlib:
+		return "ZLIB"
+	case CompressionSnappy:
+		return "SNAPPY"
+	case CompressionLzo:
+		return "LZO"
+	case CompressionLz4:
+		return "LZ4"
+	case CompressionZstd:
+		return "ZSTD"
+	}
+	return "UNKNOWN"
+}
+
+type Kind uint64
+
+const (
+	KindBoolean          Kind = 0
+	KindByte             Kind = 1
+	KindShort            Kind = 2
+	KindInt              Kind = 3
+	KindLong             Kind = 4
+	KindFloat            Kind = 5
+	KindDouble           Kind = 6
+	KindString           Kind = 7
+	KindBinary           Kind = 8
+	KindTimestamp        Kind = 9
+	KindList             Kind = 10
+	KindMap              Kind = 11
+	KindStruct           Kind = 12
+	KindUnion            Kind = 13
+	KindDecimal          Kind = 14
+	KindDate             Kind = 15
+	KindVarchar          Kind = 16
+	KindChar             Kind = 17
+	KindTimestampInstant Kind = 18
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindBoolean:
+		return "boolean"
+	case KindByte:
+		return "tinyint"
+	case KindShort:
+		return "smallint"
+	case KindInt:
+		return "int"
+	case KindLong:
+		return "bigint"
+	case KindFloat:
+		return "float"
+	case KindDouble:
+		return "double"
+	case KindString:
+		return "string"
+	case KindBinary:
+		return "binary"
+	case KindTimestamp:
+		return "timestamp"
+	case KindList:
+		return "array"
+	case KindMap:
+		return "map"
+	case KindStruct:
+		return "struct"
+	case KindUnion:
+		return "uniontype"
+	case KindDecimal:
+		return "decimal"
+	case KindDate:
+		return "date"
+	case KindVarchar:
+		return "varchar"
+	case KindChar:
+		return "char"
+	case KindTimestampInstant:
+		return "timestamp with local time zone"
+	}
+	return "unknown"
+}
+
+type StreamKind uint64
+
+const (
+	StreamPresent        StreamKind = 0
+	StreamData           StreamKind = 1
+	StreamLength         StreamKind = 2
+	StreamDictionaryData StreamKind = 3
+	StreamDictionaryCnt  StreamKind = 4
+	StreamSecondary      StreamKind = 5
+	StreamRowIndex       StreamKind = 6
+	StreamBloomFilter    StreamKind = 7
+	StreamBloomFilterUtf StreamKind = 8
+)
+
+type EncodingKind uint64
+
+const (
+	EncodingDirect       EncodingKind = 0
+	EncodingDictionary   EncodingKind = 1
+	EncodingDirectV2     EncodingKind = 2
+	EncodingDictionaryV2 EncodingKind = 3
+)
+
+type PostScript struct {
+	FooterLength         uint64
+	Compression          CompressionKind
+	CompressionBlockSize uint64
+	Version              []uint32
+	MetadataLength       uint64
+	WriterVersion        uint32
+	Magic                string
+}
+
+// Writer versions that fixed statistics MatrixOne relies on.
+const (
+	// HIVE-8732: string minimum and maximum
+	WriterVersionHive8732 = 1
+	// ORC-135: timestamp statistics in UTC
+	WriterVersionOrc135 = 6
+)
+
+type StripeInformation struct {
+	Offset       uint64
+	IndexLength  uint64
+	DataLength   uint64
+	FooterLength uint64
+	NumberOfRows uint64
+}
+
+type Type struct {
+	Kind          Kind
+	Subtypes      []uint32
+	FieldNames    []string
+	MaximumLength uint32
+	Precision     uint32
+	Scale         uint32
+}
+
+type IntegerStatistics struct {
+	HasMinimum, HasMaximum bool
+	Minimum, Maximum       int64
+}
+
+type DoubleStatistics struct {
+	HasMinimum, HasMaximum bool
+	Minimum, Maximum       float64
+}
+
+type StringStatistics struct {
+	HasMinimum, HasMaximum bool
+	Minimum, Maximum       string
+}
+
+type DecimalStatistics struct {
+	Minimum, Maximum string
+}
+
+type DateStatistics struct {
+	HasMinimum, HasMaximum bool
+	Minimum, Maximum       int32
+}
+
+// TimestampStatistics holds milliseconds since the Unix epoch; the Utc
+// variants are set by writers since ORC 1.5 (HIVE-12055).
+type TimestampStatistics struct {
+	HasMinimum, HasMaximum       bool
+	Minimum, Maximum             int64
+	HasMinimumUtc, HasMaximumUtc bool
+	MinimumUtc, MaximumUtc       int64
+}
+
+type ColumnStatistics struct {
+	NumberOfValues uint64
+	HasNull        bool
+	Int            *IntegerStatistics
+	Double         *DoubleStatistics
+	String         *StringStatistics
+	Decimal        *DecimalStatistics
+	Date           *DateStatistics
+	Timestamp      *TimestampStatistics
+}
+
+type Footer struct {
+	HeaderLength   uint64
+	ContentLength  uint64
+	Stripes        []StripeInformation
+	Types          []Type
+	NumberOfRows   uint64
+	Statistics     []ColumnStatistics
+	RowIndexStride uint32
+}
+
+type StripeStatistics struct {
+	ColStats []ColumnStatistics
+}
+
+type Metadata struct {
+	StripeStats []StripeStatistics
+}
+
+type Stream struct {
+	Kind   StreamKind
+	Column uint32
+	Length uint64
+}
+
+type ColumnEncoding struct {
+	Kind           EncodingKind
+	DictionarySize uint32
+}
+
+type StripeFooter struct {
+	Streams        []Stream
+	Columns        []ColumnEncoding
+	WriterTimezone string
+}
+
+// field is one decoded protobuf field; u holds varint and fixed values,
+// b length-delimited values.
+type field struct {
+	num protowire.Number
+	typ protowire.Type
+	u   uint64
+	b   []byte
+}
+
+func (f field) sint64() int64 {
+	return protowire.DecodeZigZag(f.u)
+}
+
+func (f field) double() float64 {
+	return math.Float64frombits(f.u)
+}
+
+// uint32s decodes a repeated uint32 field, packed or not
+func (f field) uint32s(dst []uint32) ([]uint32, error) {
+	if f.typ != protowire.BytesType {
+		return append(dst, uint32(f.u)), nil
+	}
+	b := f.b
+	for len(b) > 0 {
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return nil, errCorrupt("packed varint")
+		}
+		dst = append(dst, uint32(v))
+		b = b[n:]
+	}
+	return dst, nil
+}
+
+func (f field) uint64s(dst []uint64) ([]uint64, error) {
+	if f.typ != protowire.BytesType {
+		return append(dst, f.u), nil
+	}
+	b := f.b
+	for len(b) > 0 {
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return nil, errCorrupt("packed varint")
+		}
+		dst = append(dst, v)
+		b = b[n:]
+	}
+	return dst, nil
+}
+
+// walk calls fn for every field of the message encoded in b
+func walk(b []byte, fn func(f field) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return errCorrupt("protobuf tag")
+		}
+		b = b[n:]
+		f := field{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.u, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed64Type:
+			f.u, n = protowire.ConsumeFixed64(b)
+		case protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			f.u = uint64(v)
+		case protowire.BytesType:
+			f.b, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return errCorrupt("protobuf field")
+		}
+		b = b[n:]
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ps *PostScript) unmarshal(b []byte) error {
+	return walk(b, func(f field) (err error) {
+		switch f.num {
+		case 1:
+			ps.FooterLength = f.u
+		case 2:
+			ps.Compression = CompressionKind(f.u)
+		case 3:
+			ps.CompressionBlockSize = f.u
+		case 4:
+			ps.Version, err = f.uint32s(ps.Version)
+		case 5:
+			ps.MetadataLength = f.u
+		case 6:
+			ps.WriterVersion = uint32(f.u)
+		case 8000:
+			ps.Magic = string(f.b)
+		}
+		return
+	})
+}
+
+func (s *StripeInformation) unmarshal(b []byte) error {
+	return walk(b, func(f field) error {
+		switch f.num {
+		case 1:
+			s.Offset = f.u
+		case 2:
+			s.IndexLength = f.u
+		case 3:
+			s.DataLength = f.u
+		case 4:
+			s.FooterLength = f.u
+		case 5:
+			s.NumberOfRows = f.u
+		}
+		return nil
+	})
+}
+
+func (t *Type) unmarshal(b []byte) error {
+	return walk(b, func(f field) (err error) {
+		switch f.num {
+		case 1:
+			t.Kind = Kind(f.u)
+		case 2:
+			t.Subtypes, err = f.uint32s(t.Subtypes)
+		case 3:
+			t.FieldNames = append(t.FieldNames, string(f.b))
+		case 4:
+			t.MaximumLength = uint32(f.u)
+		case 5:
+			t.Precision = uint32(f.u)
+		case 6:
+			t.Scale = uint32(f.u)
+		}
+		return
+	})
+}
+
+func (s *ColumnStatistics) unmarshal(b []byte) error {
+	return walk(b, func(f field) error {
+		switch f.num {
+		case 1:
+			s.NumberOfValues = f.u
+		case 2:
+			s.Int = &IntegerStatistics{}
+			return walk(f.b, func(f field) error {
+				switch f.num {
+				case 1:
+					s.Int.HasMinimum, s.Int.Minimum = true, f.sint64()
+				case 2:
+					s.Int.HasMaximum, s.Int.Maximum = true, f.sint64()
+				}
+				return nil
+			})
+		case 3:
+			s.Double = &DoubleStatistics{}
+			return walk(f.b, func(f field) error {
+				switch f.num {
+				case 1:
+					s.Double.HasMinimum, s.Double.Minimum = true, f.double()
+				case 2:
+					s.Double.HasMaximum, s.Double.Maximum = true, f.double()
+				}
+				return nil
+			})
+		case 4:
+			s.String = &StringStatistics{}
+			return walk(f.b, func(f field) error {
+				switch f.num {
+				case 1:
+					s.String.HasMinimum, s.String.Minimum = true, string(f.b)
+				case 2:
+					s.String.HasMaximum, s.String.Maximum = true, string(f.b)
+				}
+				return nil
+			})
+		case 6:
+			s.Decimal = &DecimalStatistics{}
+			return walk(f.b, func(f field) error {
+				switch f.num {
+				case 1:
+					s.Decimal.Minimum = string(f.b)
+				case 2:
+					s.Decimal.Maximum = string(f.b)
+				}
+				return nil
+			})
+		case 7:
+			s.Date = &DateStatistics{}
+			return walk(f.b, func(f field) error {
+				switch f.num {
+				case 1:
+					s.Date.HasMinimum, s.Date.Minimum = true, int32(f.sint64())
+				case 2:
+					s.Date.HasMaximum, s.Date.Maximum = true, int32(f.sint64())
+				}
+				return nil
+			})
+		case 9:
+			s.Timestamp = &TimestampStatistics{}
+			return walk(f.b, func(f field) error {
+				switch f.num {
+				case 1:
+					s.Timestamp.HasMinimum, s.Timestamp.Minimum = true, f.sint64()
+				case 2:
+					s.Timestamp.HasMaximum, s.Timestamp.Maximum = true, f.sint64()
+				case 3:
+					s.Timestamp.HasMinimumUtc, s.Timestamp.MinimumUtc = true, f.sint64()
+				case 4:
+					s.Timestamp.HasMaximumUtc, s.Timestamp.MaximumUtc = true, f.sint64()
+				}
+				return nil
+			})
+		case 10:
+			s.HasNull = f.u != 0
+		}
+		return nil
+	})
+}
+
+func (ft *Footer) unmarshal(b []byte) error {
+	return walk(b, func(f field) error {
+		switch f.num {
+		case 1:
+			ft.HeaderLength = f.u
+		case 2:
+			ft.ContentLength = f.u
+		case 3:
+			var s StripeInformation
+			if err := s.unmarshal(f.b); err != nil {
+				return err
+			}
+			ft.Stripes = append(ft.Stripes, s)
+		case 4:
+			var t Type
+			if err := t.unmarshal(f.b); err != nil {
+				return err
+			}
+			ft.Types = append(ft.Types, t)
+		case 6:
+			ft.NumberOfRows = f.u
+		case 7:
+			var s ColumnStatistics
+			if err := s.unmarshal(f.b); err != nil {
+				return err
+			}
+			ft.Statistics = append(ft.Statistics, s)
+		case 8:
+			ft.RowIndexStride = uint32(f.u)
+		}
+		return nil
+	})
+}
+
+func (m *Metadata) unmarshal(b []byte) error {
+	return walk(b, func(f field) error {
+		if f.num != 1 {
+			return nil
+		}
+		var ss StripeStatistics
+		err := walk(f.b, func(f field) error {
+			if f.num != 1 {
+				return nil
+			}
+			var s ColumnStatistics
+			if err := s.unmarshal(f.b); err != nil {
+				return err
+			}
+			ss.ColStats = append(ss.ColStats, s)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		m.StripeStats = append(m.StripeStats, ss)
+		return nil
+	})
+}
+
+func (sf *StripeFooter) unmarshal(b []byte) error {
+	return walk(b, func(f field) error {
+		switch f.num {
+		case 1:
+			var s Stream
+			err := walk(f.b, func(f field) error {
+				switch f.num {
+				case 1:
+					s.Kind = StreamKind(f.u)
+				case 2:
+					s.Column = uint32(f.u)
+				case 3:
+					s.Length = f.u
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			sf.Streams = append(sf.Streams, s)
+		case 2:
+			var e ColumnEncoding
+			err := walk(f.b, func(f field) error {
+				switch f.num {
+				case 1:
+					e.Kind = EncodingKind(f.u)
+				case 2:
+					e.DictionarySize = uint32(f.u)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			sf.Columns = append(sf.Columns, e)
+		case 3:
+			sf.WriterTimezone = string(f.b)
+		}
+		return nil
+	})
+}
+
+// Encoders of the messages above, used by Writer.
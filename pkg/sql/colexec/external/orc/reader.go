@@ -0,0 +1,294 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"io"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Magic starts every ORC file and ends its postscript.
+const Magic = "ORC"
+
+const (
+	// maxPostScriptSize bounds the postscript, whose length is a single byte
+	maxPostScriptSize = 256
+	// tailReadSize is read at once from the end of the file, usually enough
+	// for the postscript, the footer and the metadata
+	tailReadSize = 16 * 1024
+	// maxReadGap merges stream reads separated by less than this many bytes
+	maxReadGap = 1 << 20
+)
+
+// File is an open ORC file. Only the file tail is read by Open, stripes are
+// read on demand.
+type File struct {
+	r    io.ReaderAt
+	size int64
+
+	PostScript PostScript
+	Footer     Footer
+	Metadata   Metadata
+}
+
+// Open reads the postscript, the footer and the metadata of an ORC file.
+func Open(r io.ReaderAt, size int64) (*File, error) {
+	if size < int64(len(Magic))+1 {
+		return nil, moerr.NewInvalidInputNoCtx("not an orc file: file too small")
+	}
+	head := make([]byte, len(Magic))
+	if _, err := r.ReadAt(head, 0); err != nil {
+		return nil, err
+	}
+	if string(head) != Magic {
+		return nil, moerr.NewInvalidInputNoCtx("not an orc file: bad magic")
+	}
+
+	tailSize := min(size, tailReadSize)
+	tail := make([]byte, tailSize)
+	if _, err := r.ReadAt(tail, size-tailSize); err != nil {
+		return nil, err
+	}
+	f := &File{r: r, size: size}
+
+	psLen := int64(tail[len(tail)-1])
+	if psLen+1 > tailSize {
+		return nil, errCorrupt("postscript length")
+	}
+	if err := f.PostScript.unmarshal(tail[tailSize-1-psLen : tailSize-1]); err != nil {
+		return nil, err
+	}
+	ps := &f.PostScript
+	if ps.Magic != "" && ps.Magic != Magic {
+		return nil, errCorrupt("postscript magic")
+	}
+	if ps.Compression == CompressionLzo {
+		return nil, moerr.NewNYINoCtx("orc compression LZO")
+	}
+	if ps.Compression != CompressionNone && ps.CompressionBlockSize == 0 {
+		ps.CompressionBlockSize = 256 * 1024
+	}
+
+	// the footer and the metadata precede the postscript
+	footerLen := int64(ps.FooterLength)
+	metaLen := int64(ps.MetadataLength)
+	need := footerLen + metaLen + psLen + 1
+	if need > size {
+		return nil, errCorrupt("footer length")
+	}
+	if need > tailSize {
+		tail = make([]byte, need)
+		if _, err := r.ReadAt(tail, size-need); err != nil {
+			return nil, err
+		}
+		tailSize = need
+	}
+	footerStart := tailSize - 1 - psLen - footerLen
+	footer, err := f.decompress(tail[footerStart : footerStart+footerLen])
+	if err != nil {
+		return nil, err
+	}
+	if err = f.Footer.unmarshal(footer); err != nil {
+		return nil, err
+	}
+	if len(f.Footer.Types) == 0 || f.Footer.Types[0].Kind != KindStruct {
+		return nil, errCorrupt("schema")
+	}
+	for i := range f.Footer.Types {
+		for _, sub := range f.Footer.Types[i].Subtypes {
+			if int(sub) <= i || int(sub) >= len(f.Footer.Types) {
+				return nil, errCorrupt("schema")
+			}
+		}
+	}
+	if metaLen > 0 {
+		meta, err := f.decompress(tail[footerStart-metaLen : footerStart])
+		if err != nil {
+			return nil, err
+		}
+		if err = f.Metadata.unmarshal(meta); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+func (f *File) decompress(b []byte) ([]byte, error) {
+	return decompress(f.PostScript.Compression, f.PostScript.CompressionBlockSize, b)
+}
+
+// Types returns the flattened schema; type 0 is the root struct.
+func (f *File) Types() []Type {
+	return f.Footer.Types
+}
+
+func (f *File) NumRows() int64 {
+	return int64(f.Footer.NumberOfRows)
+}
+
+func (f *File) NumStripes() int {
+	return len(f.Footer.Stripes)
+}
+
+// StripeStatistics returns the column statistics of stripe i indexed by type
+// id, or nil if the file has none.
+func (f *File) StripeStatistics(i int) []ColumnStatistics {
+	if i >= len(f.Metadata.StripeStats) {
+		return nil
+	}
+	stats := f.Metadata.StripeStats[i].ColStats
+	if len(stats) != len(f.Footer.Types) {
+		return nil
+	}
+	return stats
+}
+
+type streamKey struct {
+	column uint32
+	kind   StreamKind
+}
+
+type streamRange struct {
+	offset, length int64
+}
+
+// stripeReader holds the decompressed streams of one stripe.
+type stripeReader struct {
+	f         *File
+	footer    StripeFooter
+	streams   map[streamKey][]byte
+	encodings []ColumnEncoding
+	// location of the writer time zone
+	tz *timeZone
+}
+
+// ReadStripe reads the columns of stripe i with the given type ids, including
+// their descendants. The columns are returned in the order of ids.
+func (f *File) ReadStripe(i int, ids []int) ([]*Column, error) {
+	if i < 0 || i >= len(f.Footer.Stripes) {
+		return nil, moerr.NewInvalidInputNoCtxf("orc stripe %d out of range", i)
+	}
+	info := f.Footer.Stripes[i]
+	typs := f.Footer.Types
+
+	include := make([]bool, len(typs))
+	var mark func(id int)
+	mark = func(id int) {
+		include[id] = true
+		for _, sub := range typs[id].Subtypes {
+			mark(int(sub))
+		}
+	}
+	for _, id := range ids {
+		if id <= 0 || id >= len(typs) {
+			return nil, moerr.NewInvalidInputNoCtxf("orc column %d out of range", id)
+		}
+		mark(id)
+	}
+
+	// stripe footer
+	footerOff := int64(info.Offset + info.IndexLength + info.DataLength)
+	if footerOff+int64(info.FooterLength) > f.size {
+		return nil, errCorrupt("stripe information")
+	}
+	buf := make([]byte, info.FooterLength)
+	if _, err := f.r.ReadAt(buf, footerOff); err != nil {
+		return nil, err
+	}
+	buf, err := f.decompress(buf)
+	if err != nil {
+		return nil, err
+	}
+	sr := &stripeReader{f: f, streams: make(map[streamKey][]byte)}
+	if err = sr.footer.unmarshal(buf); err != nil {
+		return nil, err
+	}
+	sr.encodings = sr.footer.Columns
+	if len(sr.encodings) < len(typs) {
+		return nil, errCorrupt("stripe footer")
+	}
+	if sr.tz, err = loadTimeZone(sr.footer.WriterTimezone); err != nil {
+		return nil, err
+	}
+
+	// streams are stored back to back in the order of the stripe footer
+	var (
+		keys   []streamKey
+		ranges []streamRange
+	)
+	off := int64(info.Offset)
+	for _, s := range sr.footer.Streams {
+		length := int64(s.Length)
+		if int(s.Column) < len(include) && include[s.Column] &&
+			s.Kind != StreamRowIndex && s.Kind != StreamBloomFilter && s.Kind != StreamBloomFilterUtf {
+			keys = append(keys, streamKey{column: s.Column, kind: s.Kind})
+			ranges = append(ranges, streamRange{offset: off, length: length})
+		}
+		off += length
+	}
+	if off > footerOff {
+		return nil, errCorrupt("stripe streams")
+	}
+	if err = sr.readStreams(keys, ranges); err != nil {
+		return nil, err
+	}
+
+	rows := int(info.NumberOfRows)
+	cols := make([]*Column, len(ids))
+	for j, id := range ids {
+		if cols[j], err = sr.readColumn(id, rows, nil); err != nil {
+			return nil, err
+		}
+	}
+	return cols, nil
+}
+
+// readStreams reads the given streams with as few reads as possible and
+// decompresses them.
+func (sr *stripeReader) readStreams(keys []streamKey, ranges []streamRange) error {
+	order := make([]int, len(ranges))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return ranges[order[a]].offset < ranges[order[b]].offset
+	})
+	for start := 0; start < len(order); {
+		first := ranges[order[start]]
+		end := start + 1
+		limit := first.offset + first.length
+		for end < len(order) && ranges[order[end]].offset-limit < maxReadGap {
+			r := ranges[order[end]]
+			limit = max(limit, r.offset+r.length)
+			end++
+		}
+		buf := make([]byte, limit-first.offset)
+		if _, err := sr.f.r.ReadAt(buf, first.offset); err != nil {
+			return err
+		}
+		for _, idx := range order[start:end] {
+			r := ranges[idx]
+			data, err := sr.f.decompress(buf[r.offset-first.offset : r.offset-first.offset+r.length])
+			if err != nil {
+				return err
+			}
+			sr.streams[keys[idx]] = data
+		}
+		start = end
+	}
+	return nil
+}
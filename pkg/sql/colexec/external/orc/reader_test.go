@@ -0,0 +1,258 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testSchema is
+// struct<id:bigint,name:string,price:decimal(20,3),ts:timestamp,
+// tags:array<string>,s:struct<a:int,b:double>,m:map<string,int>,
+// flag:boolean,d:date,t:tinyint,f:float>
+var testSchema = []Type{
+	{Kind: KindStruct, Subtypes: []uint32{1, 2, 3, 4, 5, 7, 10, 13, 14, 15, 16},
+		FieldNames: []string{"id", "name", "price", "ts", "tags", "s", "m", "flag", "d", "t", "f"}},
+	{Kind: KindLong},
+	{Kind: KindString},
+	{Kind: KindDecimal, Precision: 20, Scale: 3},
+	{Kind: KindTimestamp},
+	{Kind: KindList, Subtypes: []uint32{6}},
+	{Kind: KindString},
+	{Kind: KindStruct, Subtypes: []uint32{8, 9}, FieldNames: []string{"a", "b"}},
+	{Kind: KindInt},
+	{Kind: KindDouble},
+	{Kind: KindMap, Subtypes: []uint32{11, 12}},
+	{Kind: KindString},
+	{Kind: KindInt},
+	{Kind: KindBoolean},
+	{Kind: KindDate},
+	{Kind: KindByte},
+	{Kind: KindFloat},
+}
+
+// testColumns builds n rows starting at id first; every third row has
+// nulls in the nullable columns.
+func testColumns(first, n int) []*Column {
+	col := func(id int) *Column {
+		return &Column{ID: id, Type: &testSchema[id], Len: n, Nulls: make([]bool, n)}
+	}
+	id, name, price, ts := col(1), col(2), col(3), col(4)
+	tags, tag := col(5), col(6)
+	s, a, b := col(7), col(8), col(9)
+	m, key, val := col(10), col(11), col(12)
+	flag, d, t, f := col(13), col(14), col(15), col(16)
+
+	id.Ints = make([]int64, n)
+	name.Bytes = make([][]byte, n)
+	price.Decimals = make([]Decimal128, n)
+	ts.Timestamps = make([]Timestamp, n)
+	tags.Offsets = make([]int32, n+1)
+	s.Children = []*Column{a, b}
+	a.Ints = make([]int64, n)
+	b.Floats = make([]float64, n)
+	m.Offsets = make([]int32, n+1)
+	flag.Ints = make([]int64, n)
+	d.Ints = make([]int64, n)
+	t.Ints = make([]int64, n)
+	f.Floats = make([]float64, n)
+
+	var tagVals [][]byte
+	var keys [][]byte
+	var vals []int64
+	for i := 0; i < n; i++ {
+		v := int64(first + i)
+		id.Ints[i] = v
+		null := v%3 == 0
+		name.Nulls[i] = null
+		price.Nulls[i] = null
+		ts.Nulls[i] = null
+		tags.Nulls[i] = null
+		s.Nulls[i] = null
+		a.Nulls[i] = null
+		b.Nulls[i] = null || v%2 == 0
+		m.Nulls[i] = null
+		if !null {
+			name.Bytes[i] = []byte(fmt.Sprintf("name-%d", v%5))
+			price.Decimals[i] = Decimal128{Lo: uint64(-v * 1001), Hi: -1}
+			ts.Timestamps[i] = Timestamp{Seconds: -86400 + v*3600, Nanos: int32(v) * 1000000}
+			for j := int64(0); j < v%3; j++ {
+				tagVals = append(tagVals, []byte(fmt.Sprintf("t%d", j)))
+			}
+			a.Ints[i] = -v
+			b.Floats[i] = float64(v) / 2
+			keys = append(keys, []byte("k"))
+			vals = append(vals, v)
+		}
+		tags.Offsets[i+1] = int32(len(tagVals))
+		m.Offsets[i+1] = int32(len(keys))
+		flag.Ints[i] = v % 2
+		d.Ints[i] = v - 100
+		t.Ints[i] = v%256 - 128
+		f.Floats[i] = float64(v) + 0.5
+	}
+	tag.Len, tag.Nulls, tag.Bytes = len(tagVals), nil, tagVals
+	key.Len, key.Nulls, key.Bytes = len(keys), nil, keys
+	val.Len, val.Nulls, val.Ints = len(vals), nil, vals
+	m.Children = []*Column{key, val}
+	tags.Children = []*Column{tag}
+	flag.Nulls, d.Nulls, t.Nulls, f.Nulls, id.Nulls = nil, nil, nil, nil, nil
+	return []*Column{id, name, price, ts, tags, s, m, flag, d, t, f}
+}
+
+func writeTestFile(t *testing.T, opts WriterOptions, stripes ...[]*Column) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, testSchema, opts)
+	require.NoError(t, err)
+	for _, cols := range stripes {
+		require.NoError(t, w.WriteStripe(cols))
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+// requireColumnEqual compares the non-null slots of two columns
+func requireColumnEqual(t *testing.T, want, got *Column) {
+	require.Equal(t, want.ID, got.ID)
+	require.Equal(t, want.Len, got.Len, "column %d", want.ID)
+	for i := 0; i < want.Len; i++ {
+		require.Equal(t, want.IsNull(i), got.IsNull(i), "column %d row %d", want.ID, i)
+		if want.IsNull(i) {
+			continue
+		}
+		switch {
+		case want.Ints != nil:
+			require.Equal(t, want.Ints[i], got.Ints[i])
+		case want.Floats != nil:
+			// bitwise, so that NaN and -0 are compared too
+			require.Equal(t, math.Float64bits(want.Floats[i]), math.Float64bits(got.Floats[i]))
+		case want.Bytes != nil:
+			require.Equal(t, string(want.Bytes[i]), string(got.Bytes[i]))
+		case want.Decimals != nil:
+			require.Equal(t, want.Decimals[i], got.Decimals[i])
+			scale := int32(want.Type.Scale)
+			if want.Scales != nil {
+				scale = want.Scales[i]
+			}
+			require.Equal(t, scale, got.Scales[i])
+		case want.Timestamps != nil:
+			require.Equal(t, want.Timestamps[i], got.Timestamps[i])
+		case want.Offsets != nil:
+			require.Equal(t, want.Offsets[i+1]-want.Offsets[i], got.Offsets[i+1]-got.Offsets[i])
+		}
+	}
+	require.Equal(t, len(want.Children), len(got.Children))
+	for j := range want.Children {
+		requireColumnEqual(t, want.Children[j], got.Children[j])
+	}
+}
+
+func TestReadWriteRoundTrip(t *testing.T) {
+	for _, compression := range []CompressionKind{
+		CompressionNone, CompressionZlib, CompressionSnappy, CompressionLz4, CompressionZstd,
+	} {
+		for _, dict := range []bool{false, true} {
+			// a small block size splits the streams into many chunks
+			opts := WriterOptions{Compression: compression, BlockSize: 1000, Dictionary: dict}
+			stripe1, stripe2 := testColumns(1, 1000), testColumns(5000, 10)
+			data := writeTestFile(t, opts, stripe1, stripe2)
+
+			f, err := Open(bytes.NewReader(data), int64(len(data)))
+			require.NoError(t, err)
+			require.Equal(t, compression, f.PostScript.Compression)
+			require.Equal(t, int64(1010), f.NumRows())
+			require.Equal(t, 2, f.NumStripes())
+			require.Equal(t, testSchema, f.Types())
+
+			ids := []int{1, 2, 3, 4, 5, 7, 10, 13, 14, 15, 16}
+			for i, want := range [][]*Column{stripe1, stripe2} {
+				cols, err := f.ReadStripe(i, ids)
+				require.NoError(t, err)
+				for j := range want {
+					requireColumnEqual(t, want[j], cols[j])
+				}
+			}
+
+			// a subset of the columns
+			cols, err := f.ReadStripe(1, []int{7})
+			require.NoError(t, err)
+			require.Len(t, cols, 1)
+			requireColumnEqual(t, stripe2[5], cols[0])
+		}
+	}
+}
+
+func TestStatistics(t *testing.T) {
+	data := writeTestFile(t, WriterOptions{Compression: CompressionZlib}, testColumns(1, 10), testColumns(100, 5))
+	f, err := Open(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	stats := f.StripeStatistics(0)
+	require.Len(t, stats, len(testSchema))
+	require.Equal(t, uint64(10), stats[0].NumberOfValues)
+	require.Equal(t, &IntegerStatistics{HasMinimum: true, HasMaximum: true, Minimum: 1, Maximum: 10}, stats[1].Int)
+	require.False(t, stats[1].HasNull)
+	require.True(t, stats[2].HasNull)
+	require.Equal(t, uint64(7), stats[2].NumberOfValues)
+	require.Equal(t, "name-0", stats[2].String.Minimum)
+	require.Equal(t, "name-4", stats[2].String.Maximum)
+	require.Equal(t, &DecimalStatistics{Minimum: "-10.010", Maximum: "-1.001"}, stats[3].Decimal)
+	require.Equal(t, int64(-86400000+3600000+1), stats[4].Timestamp.Minimum)
+	require.Equal(t, int32(-99), stats[14].Date.Minimum)
+
+	stats = f.StripeStatistics(1)
+	require.Equal(t, int64(104), stats[1].Int.Maximum)
+	require.Nil(t, f.StripeStatistics(2))
+
+	require.Equal(t, int64(1), f.Footer.Statistics[1].Int.Minimum)
+	require.Equal(t, int64(104), f.Footer.Statistics[1].Int.Maximum)
+}
+
+func TestOpenErrors(t *testing.T) {
+	_, err := Open(bytes.NewReader([]byte("PAR1")), 4)
+	require.ErrorContains(t, err, "not an orc file")
+
+	data := writeTestFile(t, WriterOptions{}, testColumns(1, 10))
+	_, err = Open(bytes.NewReader(data[:len(data)-5]), int64(len(data)-5))
+	require.Error(t, err)
+
+	f, err := Open(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	_, err = f.ReadStripe(1, []int{1})
+	require.ErrorContains(t, err, "out of range")
+	_, err = f.ReadStripe(0, []int{100})
+	require.ErrorContains(t, err, "out of range")
+}
+
+func TestDecimalAndNanos(t *testing.T) {
+	for _, d := range []Decimal128{
+		{}, {Lo: 1}, {Lo: ^uint64(0), Hi: -1}, {Lo: 12345, Hi: 678}, {Lo: 0, Hi: -1 << 62}, {Lo: ^uint64(0), Hi: 1<<63 - 1},
+	} {
+		got, rest, err := readDecimal(appendDecimal(nil, d))
+		require.NoError(t, err)
+		require.Empty(t, rest)
+		require.Equal(t, d, got)
+	}
+	for _, ns := range []int64{0, 1, 100, 1000, 120000000, 999999999, 500} {
+		require.Equal(t, ns, decodeNanos(encodeNanos(ns)))
+	}
+	// 1000 nanos is 1 followed by 3 zeros
+	require.Equal(t, int64(1<<3|2), encodeNanos(1000))
+}
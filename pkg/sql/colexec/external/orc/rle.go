@@ -0,0 +1,350 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Run length encodings of the ORC spec, see
+// https://orc.apache.org/specification/ORCv1/#run-length-encoding
+
+const (
+	rleV2ShortRepeat = 0
+	rleV2Direct      = 1
+	rleV2PatchedBase = 2
+	rleV2Delta       = 3
+
+	rleV2MaxLiterals    = 512
+	rleV2MaxShortRepeat = 10
+	rleMinRepeat        = 3
+)
+
+// decodeByteRLE decodes n bytes.
+func decodeByteRLE(src []byte, n int) ([]byte, error) {
+	dst := make([]byte, 0, n)
+	for len(dst) < n {
+		if len(src) == 0 {
+			return nil, errCorrupt("byte rle")
+		}
+		control := int8(src[0])
+		src = src[1:]
+		if control >= 0 {
+			if len(src) == 0 {
+				return nil, errCorrupt("byte rle")
+			}
+			for i := 0; i < int(control)+rleMinRepeat; i++ {
+				dst = append(dst, src[0])
+			}
+			src = src[1:]
+			continue
+		}
+		cnt := -int(control)
+		if cnt > len(src) {
+			return nil, errCorrupt("byte rle")
+		}
+		dst = append(dst, src[:cnt]...)
+		src = src[cnt:]
+	}
+	if len(dst) > n {
+		return nil, errCorrupt("byte rle")
+	}
+	return dst, nil
+}
+
+// decodeBoolRLE decodes n bits, most significant bit first.
+func decodeBoolRLE(src []byte, n int) ([]bool, error) {
+	b, err := decodeByteRLE(src, (n+7)/8)
+	if err != nil {
+		return nil, err
+	}
+	dst := make([]bool, n)
+	for i := range dst {
+		dst[i] = b[i/8]&(0x80>>(i%8)) != 0
+	}
+	return dst, nil
+}
+
+func readVarint(src []byte, signed bool) (int64, []byte, error) {
+	v, n := protowire.ConsumeVarint(src)
+	if n < 0 {
+		return 0, nil, errCorrupt("varint")
+	}
+	if signed {
+		return protowire.DecodeZigZag(v), src[n:], nil
+	}
+	return int64(v), src[n:], nil
+}
+
+// decodeIntRLEv1 decodes n integers of the version 1 encoding.
+func decodeIntRLEv1(src []byte, n int, signed bool) ([]int64, error) {
+	dst := make([]int64, 0, n)
+	var err error
+	for len(dst) < n {
+		if len(src) == 0 {
+			return nil, errCorrupt("integer rle")
+		}
+		control := int8(src[0])
+		src = src[1:]
+		if control >= 0 {
+			if len(src) == 0 {
+				return nil, errCorrupt("integer rle")
+			}
+			delta := int64(int8(src[0]))
+			var base int64
+			if base, src, err = readVarint(src[1:], signed); err != nil {
+				return nil, err
+			}
+			for i := 0; i < int(control)+rleMinRepeat; i++ {
+				dst = append(dst, base+int64(i)*delta)
+			}
+			continue
+		}
+		for i := 0; i < -int(control); i++ {
+			var v int64
+			if v, src, err = readVarint(src, signed); err != nil {
+				return nil, err
+			}
+			dst = append(dst, v)
+		}
+	}
+	if len(dst) > n {
+		return nil, errCorrupt("integer rle")
+	}
+	return dst, nil
+}
+
+// decodeWidth maps the 5-bit width codes of RLE v2 to bit widths.
+func decodeWidth(code byte) int {
+	switch {
+	case code <= 23:
+		return int(code) + 1
+	case code == 24:
+		return 26
+	case code == 25:
+		return 28
+	case code == 26:
+		return 30
+	case code == 27:
+		return 32
+	case code == 28:
+		return 40
+	case code == 29:
+		return 48
+	case code == 30:
+		return 56
+	}
+	return 64
+}
+
+// closestFixedBits rounds width up to a width that has a width code.
+func closestFixedBits(width int) int {
+	switch {
+	case width == 0:
+		return 1
+	case width <= 24:
+		return width
+	case width <= 26:
+		return 26
+	case width <= 28:
+		return 28
+	case width <= 30:
+		return 30
+	case width <= 32:
+		return 32
+	case width <= 40:
+		return 40
+	case width <= 48:
+		return 48
+	case width <= 56:
+		return 56
+	}
+	return 64
+}
+
+// unpack reads n big endian bit-packed values of width bits. Runs are padded
+// to whole bytes.
+func unpack(src []byte, n, width int) ([]uint64, []byte, error) {
+	size := (n*width + 7) / 8
+	if size > len(src) {
+		return nil, nil, errCorrupt("bit-packed run")
+	}
+	dst := make([]uint64, n)
+	var acc uint64
+	accBits := 0
+	pos := 0
+	for i := range dst {
+		var v uint64
+		need := width
+		for need > 0 {
+			if accBits == 0 {
+				acc = uint64(src[pos])
+				pos++
+				accBits = 8
+			}
+			take := min(need, accBits)
+			v = v<<take | (acc>>(accBits-take))&(1<<take-1)
+			accBits -= take
+			need -= take
+		}
+		dst[i] = v
+	}
+	return dst, src[size:], nil
+}
+
+func bigEndian(src []byte) uint64 {
+	var v uint64
+	for _, b := range src {
+		v = v<<8 | uint64(b)
+	}
+	return v
+}
+
+// decodeIntRLEv2 decodes n integers of the version 2 encoding.
+func decodeIntRLEv2(src []byte, n int, signed bool) ([]int64, error) {
+	dst := make([]int64, 0, n)
+	unzigzag := func(v uint64) int64 {
+		if signed {
+			return protowire.DecodeZigZag(v)
+		}
+		return int64(v)
+	}
+	var err error
+	for len(dst) < n {
+		if len(src) == 0 {
+			return nil, errCorrupt("integer rle")
+		}
+		header := src[0]
+		switch header >> 6 {
+		case rleV2ShortRepeat:
+			size := int(header>>3&7) + 1
+			cnt := int(header&7) + rleMinRepeat
+			if len(src) < 1+size {
+				return nil, errCorrupt("integer rle")
+			}
+			v := unzigzag(bigEndian(src[1 : 1+size]))
+			src = src[1+size:]
+			for i := 0; i < cnt; i++ {
+				dst = append(dst, v)
+			}
+
+		case rleV2Direct:
+			if len(src) < 2 {
+				return nil, errCorrupt("integer rle")
+			}
+			width := decodeWidth(header >> 1 & 0x1f)
+			cnt := (int(header&1)<<8 | int(src[1])) + 1
+			var vals []uint64
+			if vals, src, err = unpack(src[2:], cnt, width); err != nil {
+				return nil, err
+			}
+			for _, v := range vals {
+				dst = append(dst, unzigzag(v))
+			}
+
+		case rleV2PatchedBase:
+			if len(src) < 4 {
+				return nil, errCorrupt("integer rle")
+			}
+			width := decodeWidth(header >> 1 & 0x1f)
+			cnt := (int(header&1)<<8 | int(src[1])) + 1
+			baseSize := int(src[2]>>5) + 1
+			patchWidth := decodeWidth(src[2] & 0x1f)
+			gapWidth := int(src[3]>>5) + 1
+			patchCnt := int(src[3] & 0x1f)
+			src = src[4:]
+			if len(src) < baseSize || patchCnt == 0 || patchWidth+gapWidth > 64 {
+				return nil, errCorrupt("integer rle")
+			}
+			// the base is stored in sign-magnitude form
+			base := bigEndian(src[:baseSize])
+			signBit := uint64(1) << (baseSize*8 - 1)
+			baseVal := int64(base &^ signBit)
+			if base&signBit != 0 {
+				baseVal = -baseVal
+			}
+			src = src[baseSize:]
+			var vals, patches []uint64
+			if vals, src, err = unpack(src, cnt, width); err != nil {
+				return nil, err
+			}
+			if patches, src, err = unpack(src, patchCnt, closestFixedBits(patchWidth+gapWidth)); err != nil {
+				return nil, err
+			}
+			patchMask := uint64(1)<<patchWidth - 1
+			pos := 0
+			for _, p := range patches {
+				pos += int(p >> patchWidth)
+				if p&patchMask == 0 {
+					// a gap longer than 255 is split with empty patches
+					continue
+				}
+				if pos >= cnt {
+					return nil, errCorrupt("integer rle patch")
+				}
+				vals[pos] |= (p & patchMask) << width
+			}
+			for _, v := range vals {
+				dst = append(dst, baseVal+int64(v))
+			}
+
+		case rleV2Delta:
+			if len(src) < 2 {
+				return nil, errCorrupt("integer rle")
+			}
+			var width int
+			if code := header >> 1 & 0x1f; code != 0 {
+				width = decodeWidth(code)
+			}
+			cnt := (int(header&1)<<8 | int(src[1])) + 1
+			var base, delta int64
+			if base, src, err = readVarint(src[2:], signed); err != nil {
+				return nil, err
+			}
+			if delta, src, err = readVarint(src, true); err != nil {
+				return nil, err
+			}
+			dst = append(dst, base)
+			if width == 0 {
+				for i := 1; i < cnt; i++ {
+					base += delta
+					dst = append(dst, base)
+				}
+				break
+			}
+			if cnt < 2 {
+				return nil, errCorrupt("integer rle")
+			}
+			base += delta
+			dst = append(dst, base)
+			var vals []uint64
+			if vals, src, err = unpack(src, cnt-2, width); err != nil {
+				return nil, err
+			}
+			for _, v := range vals {
+				if delta < 0 {
+					base -= int64(v)
+				} else {
+					base += int64(v)
+				}
+				dst = append(dst, base)
+			}
+		}
+	}
+	if len(dst) > n {
+		return nil, errCorrupt("integer rle")
+	}
+	return dst, nil
+}
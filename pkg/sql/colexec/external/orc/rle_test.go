@@ -0,0 +1,142 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The encoded examples below are taken from the ORC specification.
+
+func TestByteRLE(t *testing.T) {
+	zeros := make([]byte, 100)
+	got, err := decodeByteRLE([]byte{0x61, 0x00}, 100)
+	require.NoError(t, err)
+	require.Equal(t, zeros, got)
+	require.Equal(t, []byte{0x61, 0x00}, encodeByteRLE(nil, zeros))
+
+	got, err = decodeByteRLE([]byte{0xfe, 0x44, 0x45}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x44, 0x45}, got)
+	require.Equal(t, []byte{0xfe, 0x44, 0x45}, encodeByteRLE(nil, got))
+
+	_, err = decodeByteRLE([]byte{0xfe, 0x44}, 2)
+	require.Error(t, err)
+
+	bools, err := decodeBoolRLE([]byte{0xff, 0x80}, 8)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, false, false, false, false, false, false}, bools)
+	require.Equal(t, []byte{0xff, 0x80}, encodeBoolRLE(nil, bools))
+
+	src := make([]byte, 1000)
+	for i := range src {
+		src[i] = byte(rand.Intn(3))
+	}
+	got, err = decodeByteRLE(encodeByteRLE(nil, src), len(src))
+	require.NoError(t, err)
+	require.Equal(t, src, got)
+}
+
+func TestIntRLEv1(t *testing.T) {
+	cases := []struct {
+		data   []byte
+		signed bool
+		want   []int64
+	}{
+		{[]byte{0x61, 0x00, 0x07}, false, repeat(7, 100)},
+		{[]byte{0xfb, 0x02, 0x03, 0x06, 0x07, 0x0b}, false, []int64{2, 3, 6, 7, 11}},
+		{[]byte{0x61, 0xff, 0x64}, false, sequence(100, -1, 100)},
+		{[]byte{0x00, 0x01, 0x03}, true, []int64{-2, -1, 0}},
+	}
+	for _, c := range cases {
+		got, err := decodeIntRLEv1(c.data, len(c.want), c.signed)
+		require.NoError(t, err)
+		require.Equal(t, c.want, got)
+	}
+}
+
+func TestIntRLEv2(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+		want []int64
+	}{
+		{"short repeat", []byte{0x0a, 0x27, 0x10}, repeat(10000, 5)},
+		{"direct", []byte{0x5e, 0x03, 0x5c, 0xa1, 0xab, 0x1e, 0xde, 0xad, 0xbe, 0xef},
+			[]int64{23713, 43806, 57005, 48879}},
+		{"patched base", []byte{0x8e, 0x13, 0x2b, 0x21, 0x07, 0xd0, 0x1e, 0x00, 0x14, 0x70, 0x28, 0x32, 0x3c, 0x46, 0x50,
+			0x5a, 0x64, 0x6e, 0x78, 0x82, 0x8c, 0x96, 0xa0, 0xaa, 0xb4, 0xbe, 0xfc, 0xe8},
+			[]int64{2030, 2000, 2020, 1000000, 2040, 2050, 2060, 2070, 2080, 2090,
+				2100, 2110, 2120, 2130, 2140, 2150, 2160, 2170, 2180, 2190}},
+		{"delta", []byte{0xc6, 0x09, 0x02, 0x02, 0x22, 0x42, 0x42, 0x46},
+			[]int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}},
+	}
+	for _, c := range cases {
+		got, err := decodeIntRLEv2(c.data, len(c.want), false)
+		require.NoError(t, err, c.name)
+		require.Equal(t, c.want, got, c.name)
+	}
+
+	require.Equal(t, []byte{0x0a, 0x27, 0x10}, encodeIntRLEv2(nil, repeat(10000, 5), false))
+	require.Equal(t, []byte{0x5e, 0x03, 0x5c, 0xa1, 0xab, 0x1e, 0xde, 0xad, 0xbe, 0xef},
+		encodeIntRLEv2(nil, []int64{23713, 43806, 57005, 48879}, false))
+
+	_, err := decodeIntRLEv2([]byte{0x5e, 0x03, 0x5c}, 4, false)
+	require.Error(t, err)
+}
+
+func TestIntRLEv2RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, signed := range []bool{false, true} {
+		var src []int64
+		for len(src) < 5000 {
+			switch r.Intn(4) {
+			case 0:
+				src = append(src, repeat(r.Int63n(1000), 1+r.Intn(700))...)
+			case 1:
+				src = append(src, r.Int63())
+			case 2:
+				if signed {
+					src = append(src, -r.Int63n(1<<40), math.MinInt64, math.MaxInt64)
+				}
+			default:
+				src = append(src, r.Int63n(100))
+			}
+		}
+		got, err := decodeIntRLEv2(encodeIntRLEv2(nil, src, signed), len(src), signed)
+		require.NoError(t, err)
+		require.Equal(t, src, got)
+	}
+}
+
+func repeat(v int64, n int) []int64 {
+	s := make([]int64, n)
+	for i := range s {
+		s[i] = v
+	}
+	return s
+}
+
+func sequence(start, delta int64, n int) []int64 {
+	s := make([]int64, n)
+	for i := range s {
+		s[i] = start + int64(i)*delta
+	}
+	return s
+}
@@ -0,0 +1,918 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"io"
+	"math"
+	"math/big"
+	"math/bits"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type WriterOptions struct {
+	Compression CompressionKind
+	// BlockSize is the size of compression chunks, 256KB by default
+	BlockSize uint64
+	// Dictionary encodes string columns with a dictionary
+	Dictionary bool
+}
+
+// Writer writes ORC files stripe by stripe, without row indexes. Timestamps
+// are written with UTC as the writer time zone.
+type Writer struct {
+	w    io.Writer
+	opts WriterOptions
+
+	types       []Type
+	offset      uint64
+	stripes     []StripeInformation
+	stripeStats []StripeStatistics
+	fileStats   []*statsBuilder
+	rows        uint64
+}
+
+// NewWriter writes the file header. types is the flattened schema, with the
+// root struct as type 0.
+func NewWriter(w io.Writer, types []Type, opts WriterOptions) (*Writer, error) {
+	if len(types) == 0 || types[0].Kind != KindStruct {
+		return nil, moerr.NewInvalidInputNoCtx("orc schema must be a struct")
+	}
+	if opts.BlockSize == 0 {
+		opts.BlockSize = 256 * 1024
+	}
+	if _, err := w.Write([]byte(Magic)); err != nil {
+		return nil, err
+	}
+	wr := &Writer{
+		w:         w,
+		opts:      opts,
+		types:     types,
+		offset:    uint64(len(Magic)),
+		fileStats: make([]*statsBuilder, len(types)),
+	}
+	for i := range wr.fileStats {
+		wr.fileStats[i] = newStatsBuilder(&types[i])
+	}
+	return wr, nil
+}
+
+type stripeWriter struct {
+	opts      WriterOptions
+	types     []Type
+	streams   []Stream
+	data      [][]byte
+	encodings []ColumnEncoding
+	stats     []*statsBuilder
+	fileStats []*statsBuilder
+}
+
+// WriteStripe writes one stripe holding the given columns of the root
+// struct, in schema order.
+func (w *Writer) WriteStripe(cols []*Column) error {
+	root := &w.types[0]
+	if len(cols) != len(root.Subtypes) {
+		return moerr.NewInvalidInputNoCtxf("orc stripe has %d columns, schema has %d", len(cols), len(root.Subtypes))
+	}
+	rows := 0
+	if len(cols) > 0 {
+		rows = cols[0].Len
+	}
+	sw := &stripeWriter{
+		opts:      w.opts,
+		types:     w.types,
+		encodings: make([]ColumnEncoding, len(w.types)),
+		stats:     make([]*statsBuilder, len(w.types)),
+		fileStats: w.fileStats,
+	}
+	for i := range sw.stats {
+		sw.stats[i] = newStatsBuilder(&w.types[i])
+	}
+	sw.stats[0].count += uint64(rows)
+	w.fileStats[0].count += uint64(rows)
+	for i, col := range cols {
+		if col.Len != rows || col.ID != int(root.Subtypes[i]) {
+			return moerr.NewInvalidInputNoCtxf("orc column %d does not match the schema", i)
+		}
+		if err := sw.writeColumn(col, nil); err != nil {
+			return err
+		}
+	}
+
+	info := StripeInformation{Offset: w.offset, NumberOfRows: uint64(rows)}
+	for i, data := range sw.data {
+		data, err := compressChunks(w.opts.Compression, w.opts.BlockSize, data)
+		if err != nil {
+			return err
+		}
+		sw.streams[i].Length = uint64(len(data))
+		if _, err = w.w.Write(data); err != nil {
+			return err
+		}
+		info.DataLength += uint64(len(data))
+	}
+	footer := StripeFooter{Streams: sw.streams, Columns: sw.encodings, WriterTimezone: "UTC"}
+	buf, err := compressChunks(w.opts.Compression, w.opts.BlockSize, footer.marshal())
+	if err != nil {
+		return err
+	}
+	if _, err = w.w.Write(buf); err != nil {
+		return err
+	}
+	info.FooterLength = uint64(len(buf))
+	w.offset += info.DataLength + info.FooterLength
+	w.rows += uint64(rows)
+	w.stripes = append(w.stripes, info)
+
+	ss := StripeStatistics{ColStats: make([]ColumnStatistics, len(w.types))}
+	for i, st := range sw.stats {
+		ss.ColStats[i] = st.build()
+	}
+	w.stripeStats = append(w.stripeStats, ss)
+	return nil
+}
+
+func (sw *stripeWriter) addStream(id int, kind StreamKind, data []byte) {
+	sw.streams = append(sw.streams, Stream{Kind: kind, Column: uint32(id)})
+	sw.data = append(sw.data, data)
+}
+
+func (sw *stripeWriter) writeColumn(col *Column, parentNulls []bool) error {
+	id := col.ID
+	typ := &sw.types[id]
+	if col.Type != nil && col.Type.Kind != typ.Kind {
+		return moerr.NewInvalidInputNoCtxf("orc column %d is not a %s", id, typ.Kind)
+	}
+	sw.encodings[id] = ColumnEncoding{Kind: EncodingDirectV2}
+	if typ.Kind == KindStruct {
+		sw.encodings[id].Kind = EncodingDirect
+	}
+
+	// present bits of the slots that are not null in the parent
+	var present []bool
+	hasNull := false
+	for i := 0; i < col.Len; i++ {
+		if parentNulls != nil && parentNulls[i] {
+			continue
+		}
+		present = append(present, !col.IsNull(i))
+		hasNull = hasNull || col.IsNull(i)
+	}
+	if hasNull {
+		sw.addStream(id, StreamPresent, encodeBoolRLE(nil, present))
+	}
+	st, fst := sw.stats[id], sw.fileStats[id]
+	st.hasNull, fst.hasNull = st.hasNull || hasNull, fst.hasNull || hasNull
+
+	var ints []int64
+	col.scatter(func(i, _ int) {
+		st.count++
+		fst.count++
+	})
+	switch typ.Kind {
+	case KindBoolean:
+		var bools []bool
+		col.scatter(func(i, _ int) { bools = append(bools, col.Ints[i] != 0) })
+		sw.addStream(id, StreamData, encodeBoolRLE(nil, bools))
+
+	case KindByte:
+		var b []byte
+		col.scatter(func(i, _ int) {
+			b = append(b, byte(col.Ints[i]))
+			st.addInt(col.Ints[i])
+			fst.addInt(col.Ints[i])
+		})
+		sw.addStream(id, StreamData, encodeByteRLE(nil, b))
+
+	case KindShort, KindInt, KindLong, KindDate:
+		col.scatter(func(i, _ int) {
+			ints = append(ints, col.Ints[i])
+			st.addInt(col.Ints[i])
+			fst.addInt(col.Ints[i])
+		})
+		sw.addStream(id, StreamData, encodeIntRLEv2(nil, ints, true))
+
+	case KindFloat, KindDouble:
+		var b []byte
+		col.scatter(func(i, _ int) {
+			v := col.Floats[i]
+			if typ.Kind == KindFloat {
+				b = binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(v)))
+				v = float64(float32(v))
+			} else {
+				b = binary.LittleEndian.AppendUint64(b, math.Float64bits(v))
+			}
+			st.addFloat(v)
+			fst.addFloat(v)
+		})
+		sw.addStream(id, StreamData, b)
+
+	case KindString, KindVarchar, KindChar, KindBinary:
+		var vals [][]byte
+		col.scatter(func(i, _ int) {
+			vals = append(vals, col.Bytes[i])
+			if typ.Kind != KindBinary {
+				st.addString(col.Bytes[i])
+				fst.addString(col.Bytes[i])
+			}
+		})
+		sw.writeBytes(id, typ.Kind != KindBinary && sw.opts.Dictionary, vals)
+
+	case KindDecimal:
+		var b []byte
+		col.scatter(func(i, _ int) {
+			scale := int32(typ.Scale)
+			if col.Scales != nil {
+				scale = col.Scales[i]
+			}
+			b = appendDecimal(b, col.Decimals[i])
+			ints = append(ints, int64(scale))
+			st.addDecimal(col.Decimals[i], scale)
+			fst.addDecimal(col.Decimals[i], scale)
+		})
+		sw.addStream(id, StreamData, b)
+		sw.addStream(id, StreamSecondary, encodeIntRLEv2(nil, ints, true))
+
+	case KindTimestamp, KindTimestampInstant:
+		var nanos []int64
+		col.scatter(func(i, _ int) {
+			ts := col.Timestamps[i]
+			secs := ts.Seconds
+			// readers expect seconds truncated towards zero, see readTimestamps
+			if secs < 0 && ts.Nanos > 999999 {
+				secs++
+			}
+			ints = append(ints, secs-timestampBase)
+			nanos = append(nanos, encodeNanos(int64(ts.Nanos)))
+			st.addTimestamp(ts)
+			fst.addTimestamp(ts)
+		})
+		sw.addStream(id, StreamData, encodeIntRLEv2(nil, ints, true))
+		sw.addStream(id, StreamSecondary, encodeIntRLEv2(nil, nanos, false))
+
+	case KindStruct:
+		if len(col.Children) != len(typ.Subtypes) {
+			return moerr.NewInvalidInputNoCtxf("orc struct column %d has %d children", id, len(col.Children))
+		}
+		for _, child := range col.Children {
+			if child.Len != col.Len {
+				return moerr.NewInvalidInputNoCtxf("orc column %d does not match its struct", child.ID)
+			}
+			// children inherit the nulls of the struct
+			if col.Nulls != nil {
+				merged := *child
+				merged.Nulls = make([]bool, col.Len)
+				for i := range merged.Nulls {
+					merged.Nulls[i] = col.Nulls[i] || child.IsNull(i)
+				}
+				child = &merged
+			}
+			if err := sw.writeColumn(child, col.Nulls); err != nil {
+				return err
+			}
+		}
+
+	case KindList, KindMap:
+		col.scatter(func(i, _ int) {
+			ints = append(ints, int64(col.Offsets[i+1]-col.Offsets[i]))
+		})
+		sw.addStream(id, StreamLength, encodeIntRLEv2(nil, ints, false))
+		for _, child := range col.Children {
+			if child.Len != int(col.Offsets[col.Len]) {
+				return moerr.NewInvalidInputNoCtxf("orc column %d does not match its parent", child.ID)
+			}
+			if err := sw.writeColumn(child, nil); err != nil {
+				return err
+			}
+		}
+
+	default:
+		return moerr.NewNYINoCtxf("orc type %s", typ.Kind)
+	}
+	return nil
+}
+
+func (sw *stripeWriter) writeBytes(id int, dictionary bool, vals [][]byte) {
+	if !dictionary {
+		var data []byte
+		lengths := make([]int64, len(vals))
+		for i, v := range vals {
+			data = append(data, v...)
+			lengths[i] = int64(len(v))
+		}
+		sw.addStream(id, StreamData, data)
+		sw.addStream(id, StreamLength, encodeIntRLEv2(nil, lengths, false))
+		return
+	}
+
+	refs := make([]int64, len(vals))
+	index := make(map[string]int64)
+	var (
+		dict    []byte
+		lengths []int64
+	)
+	for i, v := range vals {
+		ref, ok := index[string(v)]
+		if !ok {
+			ref = int64(len(lengths))
+			index[string(v)] = ref
+			dict = append(dict, v...)
+			lengths = append(lengths, int64(len(v)))
+		}
+		refs[i] = ref
+	}
+	sw.encodings[id] = ColumnEncoding{Kind: EncodingDictionaryV2, DictionarySize: uint32(len(lengths))}
+	sw.addStream(id, StreamData, encodeIntRLEv2(nil, refs, false))
+	sw.addStream(id, StreamDictionaryData, dict)
+	sw.addStream(id, StreamLength, encodeIntRLEv2(nil, lengths, false))
+}
+
+// Close writes the metadata, the footer and the postscript.
+func (w *Writer) Close() error {
+	ps := PostScript{
+		Compression:          w.opts.Compression,
+		CompressionBlockSize: w.opts.BlockSize,
+		Version:              []uint32{0, 12},
+		WriterVersion:        WriterVersionOrc135,
+	}
+
+	meta := Metadata{StripeStats: w.stripeStats}
+	buf, err := compressChunks(w.opts.Compression, w.opts.BlockSize, meta.marshal())
+	if err != nil {
+		return err
+	}
+	if _, err = w.w.Write(buf); err != nil {
+		return err
+	}
+	ps.MetadataLength = uint64(len(buf))
+
+	footer := Footer{
+		HeaderLength:  uint64(len(Magic)),
+		ContentLength: w.offset - uint64(len(Magic)),
+		Stripes:       w.stripes,
+		Types:         w.types,
+		NumberOfRows:  w.rows,
+		Statistics:    make([]ColumnStatistics, len(w.types)),
+	}
+	for i, st := range w.fileStats {
+		footer.Statistics[i] = st.build()
+	}
+	if buf, err = compressChunks(w.opts.Compression, w.opts.BlockSize, footer.marshal()); err != nil {
+		return err
+	}
+	if _, err = w.w.Write(buf); err != nil {
+		return err
+	}
+	ps.FooterLength = uint64(len(buf))
+
+	buf = ps.marshal()
+	buf = append(buf, byte(len(buf)))
+	_, err = w.w.Write(buf)
+	return err
+}
+
+// statsBuilder collects the statistics of a column.
+type statsBuilder struct {
+	typ     *Type
+	count   uint64
+	hasNull bool
+	hasMin  bool
+
+	minInt, maxInt     int64
+	minFloat, maxFloat float64
+	minStr, maxStr     []byte
+	minDec, maxDec     *big.Rat
+	minTs, maxTs       int64
+}
+
+func newStatsBuilder(typ *Type) *statsBuilder {
+	return &statsBuilder{typ: typ}
+}
+
+func (s *statsBuilder) addInt(v int64) {
+	if !s.hasMin || v < s.minInt {
+		s.minInt = v
+	}
+	if !s.hasMin || v > s.maxInt {
+		s.maxInt = v
+	}
+	s.hasMin = true
+}
+
+func (s *statsBuilder) addFloat(v float64) {
+	if !s.hasMin || v < s.minFloat {
+		s.minFloat = v
+	}
+	if !s.hasMin || v > s.maxFloat {
+		s.maxFloat = v
+	}
+	s.hasMin = true
+}
+
+func (s *statsBuilder) addString(v []byte) {
+	if !s.hasMin || bytes.Compare(v, s.minStr) < 0 {
+		s.minStr = v
+	}
+	if !s.hasMin || bytes.Compare(v, s.maxStr) > 0 {
+		s.maxStr = v
+	}
+	s.hasMin = true
+}
+
+func (s *statsBuilder) addDecimal(d Decimal128, scale int32) {
+	v := new(big.Rat).SetFrac(decimalToBig(d), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil))
+	if !s.hasMin || v.Cmp(s.minDec) < 0 {
+		s.minDec = v
+	}
+	if !s.hasMin || v.Cmp(s.maxDec) > 0 {
+		s.maxDec = v
+	}
+	s.hasMin = true
+}
+
+func (s *statsBuilder) addTimestamp(ts Timestamp) {
+	ms := ts.Seconds*1000 + int64(ts.Nanos)/1000000
+	if !s.hasMin || ms < s.minTs {
+		s.minTs = ms
+	}
+	if !s.hasMin || ms > s.maxTs {
+		s.maxTs = ms
+	}
+	s.hasMin = true
+}
+
+func (s *statsBuilder) build() ColumnStatistics {
+	cs := ColumnStatistics{NumberOfValues: s.count, HasNull: s.hasNull}
+	has := s.hasMin
+	switch s.typ.Kind {
+	case KindByte, KindShort, KindInt, KindLong:
+		cs.Int = &IntegerStatistics{HasMinimum: has, HasMaximum: has, Minimum: s.minInt, Maximum: s.maxInt}
+	case KindFloat, KindDouble:
+		cs.Double = &DoubleStatistics{HasMinimum: has, HasMaximum: has, Minimum: s.minFloat, Maximum: s.maxFloat}
+	case KindString, KindVarchar, KindChar:
+		cs.String = &StringStatistics{HasMinimum: has, HasMaximum: has, Minimum: string(s.minStr), Maximum: string(s.maxStr)}
+	case KindDate:
+		cs.Date = &DateStatistics{HasMinimum: has, HasMaximum: has, Minimum: int32(s.minInt), Maximum: int32(s.maxInt)}
+	case KindDecimal:
+		cs.Decimal = &DecimalStatistics{}
+		if has {
+			cs.Decimal.Minimum = s.minDec.FloatString(int(s.typ.Scale))
+			cs.Decimal.Maximum = s.maxDec.FloatString(int(s.typ.Scale))
+		}
+	case KindTimestamp, KindTimestampInstant:
+		// the writer time zone is UTC, local and UTC statistics are the same
+		cs.Timestamp = &TimestampStatistics{
+			HasMinimum: has, HasMaximum: has, Minimum: s.minTs, Maximum: s.maxTs,
+			HasMinimumUtc: has, HasMaximumUtc: has, MinimumUtc: s.minTs, MaximumUtc: s.maxTs,
+		}
+	}
+	return cs
+}
+
+func decimalToBig(d Decimal128) *big.Int {
+	v := new(big.Int).SetInt64(d.Hi)
+	v.Lsh(v, 64)
+	return v.Or(v, new(big.Int).SetUint64(d.Lo))
+}
+
+func encodeByteRLE(dst, src []byte) []byte {
+	for len(src) > 0 {
+		// length of the run starting at src[0]
+		run := 1
+		for run < len(src) && run < 127+rleMinRepeat && src[run] == src[0] {
+			run++
+		}
+		if run >= rleMinRepeat {
+			dst = append(dst, byte(run-rleMinRepeat), src[0])
+			src = src[run:]
+			continue
+		}
+		// literals up to the next run
+		lit := 0
+		for lit < len(src) && lit < 128 {
+			if lit+rleMinRepeat <= len(src) && src[lit] == src[lit+1] && src[lit] == src[lit+2] {
+				break
+			}
+			lit++
+		}
+		dst = append(dst, byte(-lit))
+		dst = append(dst, src[:lit]...)
+		src = src[lit:]
+	}
+	return dst
+}
+
+func encodeBoolRLE(dst []byte, src []bool) []byte {
+	b := make([]byte, (len(src)+7)/8)
+	for i, v := range src {
+		if v {
+			b[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return encodeByteRLE(dst, b)
+}
+
+func appendVarint(dst []byte, v int64, signed bool) []byte {
+	if signed {
+		return protowire.AppendVarint(dst, protowire.EncodeZigZag(v))
+	}
+	return protowire.AppendVarint(dst, uint64(v))
+}
+
+func encodeWidth(width int) byte {
+	switch {
+	case width <= 24:
+		return byte(width - 1)
+	case width == 26:
+		return 24
+	case width == 28:
+		return 25
+	case width == 30:
+		return 26
+	case width == 32:
+		return 27
+	case width == 40:
+		return 28
+	case width == 48:
+		return 29
+	case width == 56:
+		return 30
+	}
+	return 31
+}
+
+func pack(dst []byte, src []uint64, width int) []byte {
+	var acc byte
+	accBits := 0
+	for _, v := range src {
+		for left := width; left > 0; {
+			take := min(left, 8-accBits)
+			acc = acc<<take | byte(v>>(left-take))&(1<<take-1)
+			accBits += take
+			left -= take
+			if accBits == 8 {
+				dst = append(dst, acc)
+				acc, accBits = 0, 0
+			}
+		}
+	}
+	if accBits > 0 {
+		dst = append(dst, acc<<(8-accBits))
+	}
+	return dst
+}
+
+// encodeIntRLEv2 uses SHORT_REPEAT and fixed DELTA runs for repeated
+// values and DIRECT runs for everything else.
+func encodeIntRLEv2(dst []byte, src []int64, signed bool) []byte {
+	zigzag := func(v int64) uint64 {
+		if signed {
+			return protowire.EncodeZigZag(v)
+		}
+		return uint64(v)
+	}
+	for len(src) > 0 {
+		run := 1
+		for run < len(src) && run < rleV2MaxLiterals && src[run] == src[0] {
+			run++
+		}
+		if run >= rleMinRepeat {
+			if run <= rleV2MaxShortRepeat {
+				v := zigzag(src[0])
+				size := max((bits.Len64(v)+7)/8, 1)
+				dst = append(dst, byte(size-1)<<3|byte(run-rleMinRepeat))
+				for i := size - 1; i >= 0; i-- {
+					dst = append(dst, byte(v>>(8*i)))
+				}
+			} else {
+				dst = append(dst, rleV2Delta<<6|byte((run-1)>>8), byte(run-1))
+				dst = appendVarint(dst, src[0], signed)
+				dst = appendVarint(dst, 0, true)
+			}
+			src = src[run:]
+			continue
+		}
+
+		lit := 0
+		for lit < len(src) && lit < rleV2MaxLiterals {
+			if lit+rleMinRepeat <= len(src) && src[lit] == src[lit+1] && src[lit] == src[lit+2] {
+				break
+			}
+			lit++
+		}
+		vals := make([]uint64, lit)
+		width := 1
+		for i, v := range src[:lit] {
+			vals[i] = zigzag(v)
+			width = max(width, bits.Len64(vals[i]))
+		}
+		width = closestFixedBits(width)
+		dst = append(dst, rleV2Direct<<6|encodeWidth(width)<<1|byte((lit-1)>>8), byte(lit-1))
+		dst = pack(dst, vals, width)
+		src = src[lit:]
+	}
+	return dst
+}
+
+// compressChunks splits src into chunks of at most blockSize bytes and
+// compresses each of them, keeping the original bytes of chunks that do not
+// shrink.
+func compressChunks(kind CompressionKind, blockSize uint64, src []byte) ([]byte, error) {
+	if kind == CompressionNone {
+		return src, nil
+	}
+	var dst []byte
+	for len(src) > 0 {
+		chunk := src[:min(uint64(len(src)), blockSize)]
+		src = src[len(chunk):]
+
+		var out []byte
+		switch kind {
+		case CompressionZlib:
+			var buf bytes.Buffer
+			w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+			if err != nil {
+				return nil, err
+			}
+			if _, err = w.Write(chunk); err != nil {
+				return nil, err
+			}
+			if err = w.Close(); err != nil {
+				return nil, err
+			}
+			out = buf.Bytes()
+		case CompressionSnappy:
+			out = snappy.Encode(nil, chunk)
+		case CompressionLz4:
+			n, err := compress.Compress(chunk, make([]byte, compress.CompressBound(compress.Lz4, len(chunk))), compress.Lz4)
+			if err != nil {
+				return nil, err
+			}
+			out = n
+		case CompressionZstd:
+			n, err := compress.Compress(chunk, nil, compress.Zstd)
+			if err != nil {
+				return nil, err
+			}
+			out = n
+		default:
+			return nil, moerr.NewNYINoCtxf("orc compression %s", kind)
+		}
+
+		header := uint32(len(out)) << 1
+		// lz4 returns an empty block for incompressible input
+		if len(out) == 0 || len(out) >= len(chunk) {
+			out = chunk
+			header = uint32(len(chunk))<<1 | 1
+		}
+		dst = append(dst, byte(header), byte(header>>8), byte(header>>16))
+		dst = append(dst, out...)
+	}
+	return dst, nil
+}
+
+func appendDecimal(dst []byte, d Decimal128) []byte {
+	// zigzag
+	lo, hi := d.Lo<<1, uint64(d.Hi)<<1|d.Lo>>63
+	if d.Hi < 0 {
+		lo, hi = ^lo, ^hi
+	}
+	for {
+		b := byte(lo & 0x7f)
+		lo = lo>>7 | hi<<57
+		hi >>= 7
+		if lo == 0 && hi == 0 {
+			return append(dst, b)
+		}
+		dst = append(dst, b|0x80)
+	}
+}
+
+func encodeNanos(v int64) int64 {
+	if v == 0 {
+		return 0
+	}
+	if v%100 != 0 {
+		return v << 3
+	}
+	v /= 100
+	zeros := int64(1)
+	for v%10 == 0 && zeros < 7 {
+		v /= 10
+		zeros++
+	}
+	return v<<3 | zeros
+}
+
+func appendVarintField(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendSint64Field(b []byte, num protowire.Number, v int64) []byte {
+	return appendVarintField(b, num, protowire.EncodeZigZag(v))
+}
+
+func appendBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func appendPackedField(b []byte, num protowire.Number, vals []uint32) []byte {
+	if len(vals) == 0 {
+		return b
+	}
+	var packed []byte
+	for _, v := range vals {
+		packed = protowire.AppendVarint(packed, uint64(v))
+	}
+	return appendBytesField(b, num, packed)
+}
+
+func (ps *PostScript) marshal() []byte {
+	var b []byte
+	b = appendVarintField(b, 1, ps.FooterLength)
+	b = appendVarintField(b, 2, uint64(ps.Compression))
+	if ps.Compression != CompressionNone {
+		b = appendVarintField(b, 3, ps.CompressionBlockSize)
+	}
+	b = appendPackedField(b, 4, ps.Version)
+	b = appendVarintField(b, 5, ps.MetadataLength)
+	b = appendVarintField(b, 6, uint64(ps.WriterVersion))
+	return appendBytesField(b, 8000, []byte(Magic))
+}
+
+func (s *StripeInformation) marshal() []byte {
+	var b []byte
+	b = appendVarintField(b, 1, s.Offset)
+	b = appendVarintField(b, 2, s.IndexLength)
+	b = appendVarintField(b, 3, s.DataLength)
+	b = appendVarintField(b, 4, s.FooterLength)
+	return appendVarintField(b, 5, s.NumberOfRows)
+}
+
+func (t *Type) marshal() []byte {
+	var b []byte
+	b = appendVarintField(b, 1, uint64(t.Kind))
+	b = appendPackedField(b, 2, t.Subtypes)
+	for _, name := range t.FieldNames {
+		b = appendBytesField(b, 3, []byte(name))
+	}
+	if t.MaximumLength != 0 {
+		b = appendVarintField(b, 4, uint64(t.MaximumLength))
+	}
+	if t.Kind == KindDecimal {
+		b = appendVarintField(b, 5, uint64(t.Precision))
+		b = appendVarintField(b, 6, uint64(t.Scale))
+	}
+	return b
+}
+
+func (s *ColumnStatistics) marshal() []byte {
+	var b []byte
+	b = appendVarintField(b, 1, s.NumberOfValues)
+	if st := s.Int; st != nil {
+		var m []byte
+		if st.HasMinimum {
+			m = appendSint64Field(m, 1, st.Minimum)
+		}
+		if st.HasMaximum {
+			m = appendSint64Field(m, 2, st.Maximum)
+		}
+		b = appendBytesField(b, 2, m)
+	}
+	if st := s.Double; st != nil {
+		var m []byte
+		if st.HasMinimum {
+			m = protowire.AppendTag(m, 1, protowire.Fixed64Type)
+			m = protowire.AppendFixed64(m, math.Float64bits(st.Minimum))
+		}
+		if st.HasMaximum {
+			m = protowire.AppendTag(m, 2, protowire.Fixed64Type)
+			m = protowire.AppendFixed64(m, math.Float64bits(st.Maximum))
+		}
+		b = appendBytesField(b, 3, m)
+	}
+	if st := s.String; st != nil {
+		var m []byte
+		if st.HasMinimum {
+			m = appendBytesField(m, 1, []byte(st.Minimum))
+		}
+		if st.HasMaximum {
+			m = appendBytesField(m, 2, []byte(st.Maximum))
+		}
+		b = appendBytesField(b, 4, m)
+	}
+	if st := s.Decimal; st != nil {
+		var m []byte
+		if st.Minimum != "" {
+			m = appendBytesField(m, 1, []byte(st.Minimum))
+		}
+		if st.Maximum != "" {
+			m = appendBytesField(m, 2, []byte(st.Maximum))
+		}
+		b = appendBytesField(b, 6, m)
+	}
+	if st := s.Date; st != nil {
+		var m []byte
+		if st.HasMinimum {
+			m = appendSint64Field(m, 1, int64(st.Minimum))
+		}
+		if st.HasMaximum {
+			m = appendSint64Field(m, 2, int64(st.Maximum))
+		}
+		b = appendBytesField(b, 7, m)
+	}
+	if st := s.Timestamp; st != nil {
+		var m []byte
+		if st.HasMinimum {
+			m = appendSint64Field(m, 1, st.Minimum)
+		}
+		if st.HasMaximum {
+			m = appendSint64Field(m, 2, st.Maximum)
+		}
+		if st.HasMinimumUtc {
+			m = appendSint64Field(m, 3, st.MinimumUtc)
+		}
+		if st.HasMaximumUtc {
+			m = appendSint64Field(m, 4, st.MaximumUtc)
+		}
+		b = appendBytesField(b, 9, m)
+	}
+	var hasNull uint64
+	if s.HasNull {
+		hasNull = 1
+	}
+	return appendVarintField(b, 10, hasNull)
+}
+
+func (ft *Footer) marshal() []byte {
+	var b []byte
+	b = appendVarintField(b, 1, ft.HeaderLength)
+	b = appendVarintField(b, 2, ft.ContentLength)
+	for i := range ft.Stripes {
+		b = appendBytesField(b, 3, ft.Stripes[i].marshal())
+	}
+	for i := range ft.Types {
+		b = appendBytesField(b, 4, ft.Types[i].marshal())
+	}
+	b = appendVarintField(b, 6, ft.NumberOfRows)
+	for i := range ft.Statistics {
+		b = appendBytesField(b, 7, ft.Statistics[i].marshal())
+	}
+	return appendVarintField(b, 8, uint64(ft.RowIndexStride))
+}
+
+func (m *Metadata) marshal() []byte {
+	var b []byte
+	for _, ss := range m.StripeStats {
+		var sb []byte
+		for i := range ss.ColStats {
+			sb = appendBytesField(sb, 1, ss.ColStats[i].marshal())
+		}
+		b = appendBytesField(b, 1, sb)
+	}
+	return b
+}
+
+func (sf *StripeFooter) marshal() []byte {
+	var b []byte
+	for _, s := range sf.Streams {
+		var m []byte
+		m = appendVarintField(m, 1, uint64(s.Kind))
+		m = appendVarintField(m, 2, uint64(s.Column))
+		m = appendVarintField(m, 3, s.Length)
+		b = appendBytesField(b, 1, m)
+	}
+	for _, e := range sf.Columns {
+		var m []byte
+		m = appendVarintField(m, 1, uint64(e.Kind))
+		if e.DictionarySize != 0 {
+			m = appendVarintField(m, 2, uint64(e.DictionarySize))
+		}
+		b = appendBytesField(b, 2, m)
+	}
+	if sf.WriterTimezone != "" {
+		b = appendBytesField(b, 3, []byte(sf.WriterTimezone))
+	}
+	return b
+}
@@ -0,0 +1,237 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// orcFixture reads one of the files generated by the orc package, see
+// orc.TestFixtures. The orders files have the stripes {1, 2, 3}, {}, {4} and
+// {100, 300} of struct<id:bigint,Name:string,ts:timestamp,price:decimal(10,2),
+// emb:array<float>,info:struct<a:int,tags:array<string>>,attrs:map<string,int>>,
+// where Name is "even" for even ids and null otherwise and info is null for
+// multiples of 3.
+func orcFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("../../../../test/distributed/resources/orc/" + name)
+	require.NoError(t, err)
+	return data
+}
+
+func newOrcTestParam(data []byte, names []string, typs []types.Type) *ExternalParam {
+	param := newArrowTestParam(data, names, typs)
+	param.Extern.Format = tree.ORC
+	return param
+}
+
+func readAllOrc(t *testing.T, param *ExternalParam, proc *process.Process, typs []types.Type) ([]*batch.Batch, error) {
+	r := NewOrcReader(param, proc)
+	fileEmpty, err := r.Open(param, proc)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	if fileEmpty {
+		return nil, nil
+	}
+	var bats []*batch.Batch
+	for attempts := 0; attempts < 10; attempts++ {
+		bat := vectorBatch(typs)
+		finished, err := r.ReadBatch(context.Background(), bat, proc, nil)
+		if err != nil {
+			return nil, err
+		}
+		bats = append(bats, bat)
+		if finished {
+			return bats, nil
+		}
+	}
+	t.Fatal("orc reader did not finish")
+	return nil, nil
+}
+
+func TestOrc_Read(t *testing.T) {
+	save := maxOrcBatchCnt
+	maxOrcBatchCnt = 2
+	defer func() { maxOrcBatchCnt = save }()
+
+	names := []string{"id", "name", "ts", "price", "emb", "info", "attrs"}
+	typs := []types.Type{
+		types.T_int32.ToType(),
+		types.T_varchar.ToType(),
+		types.New(types.T_datetime, 0, 3),
+		types.New(types.T_decimal64, 12, 3),
+		types.New(types.T_array_float32, 2, 0),
+		types.T_json.ToType(),
+		types.T_text.ToType(),
+	}
+	proc := testutil.NewProc(t)
+
+	for _, compression := range []string{"none", "zlib", "snappy", "zstd"} {
+		param := newOrcTestParam(orcFixture(t, "orders_"+compression+".orc"), names, typs)
+		bats, err := readAllOrc(t, param, proc, typs)
+		require.NoError(t, err)
+		// 3 rows are read as 2 + 1, the empty stripe is skipped
+		require.Len(t, bats, 4)
+		require.Equal(t, []int{2, 1, 1, 2}, []int{bats[0].RowCount(), bats[1].RowCount(), bats[2].RowCount(), bats[3].RowCount()})
+
+		var ids []int32
+		for _, bat := range bats {
+			ids = append(ids, vector.MustFixedColWithTypeCheck[int32](bat.Vecs[0])...)
+		}
+		require.Equal(t, []int32{1, 2, 3, 4, 100, 300}, ids)
+
+		bat := bats[0]
+		require.True(t, bat.Vecs[1].IsNull(0))
+		require.Equal(t, "even", bat.Vecs[1].GetStringAt(1))
+		dt := vector.GetFixedAtNoTypeCheck[types.Datetime](bat.Vecs[2], 1)
+		require.Equal(t, "1970-01-01 00:00:02.500", dt.String2(3))
+		dec := vector.GetFixedAtNoTypeCheck[types.Decimal64](bat.Vecs[3], 1)
+		require.Equal(t, "2.050", dec.Format(3))
+		require.Equal(t, []float32{2, -1}, types.BytesToArray[float32](bat.Vecs[4].GetBytesAt(1)))
+		require.Equal(t, `{"a": -1, "tags": ["x", "y"]}`, types.DecodeJson(bat.Vecs[5].GetBytesAt(0)).String())
+		require.Equal(t, `{"k": 2}`, bat.Vecs[6].GetStringAt(1))
+		// a null struct
+		require.True(t, bats[1].Vecs[5].IsNull(0))
+	}
+}
+
+func TestOrc_StripeStatisticsSkipStripes(t *testing.T) {
+	proc := testutil.NewProc(t)
+	names := []string{"id", "name"}
+	typs := []types.Type{types.T_int64.ToType(), types.T_varchar.ToType()}
+	data := orcFixture(t, "orders_zstd.orc")
+
+	ctx := context.Background()
+	colExpr := &plan.Expr{
+		Typ: plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{ColPos: 0, Name: "id"},
+		},
+	}
+	newParam := func(val int64) *ExternalParam {
+		constExpr := &plan.Expr{
+			Typ: plan.Type{Id: int32(types.T_int64)},
+			Expr: &plan.Expr_Lit{
+				Lit: &plan.Literal{Value: &plan.Literal_I64Val{I64Val: val}},
+			},
+		}
+		filter, err := plan2.BindFuncExprImplByPlanExpr(ctx, ">", []*plan.Expr{colExpr, constExpr})
+		require.NoError(t, err)
+		param := newOrcTestParam(data, names, typs)
+		param.Filter.FilterExpr = filter
+		param.Filter.columnMap, _, _, _ = plan2.GetColumnsByExpr(filter, &plan.TableDef{
+			Name2ColIndex: map[string]int32{"id": 0, "name": 1},
+		})
+		param.Filter.AuxIdCnt = plan2.AssignAuxIdForExpr(filter, 0)
+		return param
+	}
+
+	// only the last stripe is read
+	bats, err := readAllOrc(t, newParam(50), proc, typs)
+	require.NoError(t, err)
+	require.Len(t, bats, 1)
+	require.Equal(t, []int64{100, 300}, vector.MustFixedColWithTypeCheck[int64](bats[0].Vecs[0]))
+	require.Equal(t, "even", bats[0].Vecs[1].GetStringAt(0))
+
+	// no stripe matches
+	bats, err = readAllOrc(t, newParam(500), proc, typs)
+	require.NoError(t, err)
+	require.Nil(t, bats)
+}
+
+func TestOrc_HivePartition(t *testing.T) {
+	proc := testutil.NewProc(t)
+	names := []string{"id", "year", catalog.ExternalFilePath}
+	typs := []types.Type{types.T_int64.ToType(), types.T_int32.ToType(), types.T_varchar.ToType()}
+	data := orcFixture(t, "orders_zstd.orc")
+	param := newOrcTestParam(data, names, typs)
+	param.Extern.HivePartitioning = true
+	param.Extern.HivePartitionCols = []string{"year"}
+	param.Extern.Filepath = "/data"
+	param.Fileparam.Filepath = "/data/year=2024/part-0000.orc"
+
+	bats, err := readAllOrc(t, param, proc, typs)
+	require.NoError(t, err)
+	require.Len(t, bats, 3)
+	bat := bats[0]
+	require.Equal(t, 3, bat.RowCount())
+	require.Equal(t, []int64{1, 2, 3}, vector.MustFixedColWithTypeCheck[int64](bat.Vecs[0]))
+	require.Equal(t, int32(2024), vector.GetFixedAtNoTypeCheck[int32](bat.Vecs[1], 0))
+	require.Equal(t, "/data/year=2024/part-0000.orc", bat.Vecs[2].GetStringAt(1))
+
+	// only virtual columns
+	param = newOrcTestParam(data, names[1:], typs[1:])
+	param.Extern.HivePartitioning = true
+	param.Extern.HivePartitionCols = []string{"year"}
+	param.Extern.Filepath = "/data"
+	param.Fileparam.Filepath = "/data/year=2024/part-0000.orc"
+	bats, err = readAllOrc(t, param, proc, typs[1:])
+	require.NoError(t, err)
+	rows := 0
+	for _, bat := range bats {
+		rows += bat.RowCount()
+	}
+	require.Equal(t, 6, rows)
+}
+
+func TestOrc_Errors(t *testing.T) {
+	proc := testutil.NewProc(t)
+	data := orcFixture(t, "orders_zstd.orc")
+
+	// out of range
+	typs := []types.Type{types.T_int8.ToType()}
+	_, err := readAllOrc(t, newOrcTestParam(data, []string{"id"}, typs), proc, typs)
+	require.ErrorContains(t, err, "out of range")
+
+	// unsupported conversion
+	typs = []types.Type{types.T_int64.ToType()}
+	_, err = readAllOrc(t, newOrcTestParam(data, []string{"name"}, typs), proc, typs)
+	require.ErrorContains(t, err, "load string to BIGINT")
+
+	// missing column
+	_, err = readAllOrc(t, newOrcTestParam(data, []string{"nope"}, typs), proc, typs)
+	require.ErrorContains(t, err, "column nope not found")
+
+	// vector width
+	typs = []types.Type{types.New(types.T_array_float32, 3, 0)}
+	_, err = readAllOrc(t, newOrcTestParam(data, []string{"emb"}, typs), proc, typs)
+	require.Error(t, err)
+
+	// not an orc file
+	typs = []types.Type{types.T_int64.ToType()}
+	_, err = readAllOrc(t, newOrcTestParam([]byte("PAR1 not orc"), []string{"id"}, typs), proc, typs)
+	require.ErrorContains(t, err, "not an orc file")
+
+	// no rows
+	bats, err := readAllOrc(t, newOrcTestParam(orcFixture(t, "empty_zstd.orc"), []string{"id"}, typs), proc, typs)
+	require.NoError(t, err)
+	require.Nil(t, bats)
+}
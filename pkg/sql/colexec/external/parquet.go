@@ -68,7 +68,8 @@ func newParquetHandler(param *ExternalParam) (*ParquetHandler, error) {
 		}
 		// Skip column count check in Hive mode: partition-only projections have
 		// 0 expected physical columns while the empty file still has schema columns.
-		if !param.Extern.HivePartitioning {
+		// Files of table formats are checked against their own schema.
+		if !param.Extern.HivePartitioning && param.fileColumnNames == nil {
 			parquetColCnt := len(h.file.Root().Columns())
 			tableColCnt := getParquetExpectedColCnt(param)
 			if parquetColCnt != tableColCnt {
@@ -298,15 +299,19 @@ func (h *ParquetHandler) prepare(param *ExternalParam) error {
 			continue
 		}
 
+		name, inFile := param.fileColumnName(attr.ColName)
+		if !inFile {
+			continue
+		}
 		h.hasPhysicalCol = true
 
 		// Use case-insensitive column lookup (fix for issue #15621)
-		col, err := columnLookup.find(param.Ctx, attr.ColName)
+		col, err := columnLookup.find(param.Ctx, name)
 		if err != nil {
 			return err
 		}
 		if col == nil {
-			return moerr.NewInvalidInputf(param.Ctx, "column %s not found", attr.ColName)
+			return moerr.NewInvalidInputf(param.Ctx, "column %s not found", name)
 		}
 		physicalCol := col
 		var fn *columnMapper
@@ -2893,6 +2898,51 @@ func (r *fsReaderAt) ReadAt(p []byte, off int64) (n int, err error) {
 	return n, nil
 }
 
+// openFileReaderAt opens the current file for random access, for the formats
+// that are read from their footer. Files up to maxParquetS3PrefetchSize are
+// read at once.
+func openFileReaderAt(param *ExternalParam) (io.ReaderAt, int64, error) {
+	if param.Extern.ScanType == tree.INLINE {
+		data := util.UnsafeStringToBytes(param.Extern.Data)
+		return bytes.NewReader(data), int64(len(data)), nil
+	}
+	if param.Extern.Local {
+		return nil, 0, moerr.NewNYIf(param.Ctx, "load %s local", param.Extern.Format)
+	}
+	fs, readPath, err := plan2.GetForETLWithType(param.Extern, param.Fileparam.Filepath)
+	if err != nil {
+		return nil, 0, err
+	}
+	if param.Fileparam.FileIndex <= 0 || param.Fileparam.FileIndex > len(param.FileSize) {
+		return nil, 0, moerr.NewInternalErrorf(param.Ctx, "invalid FileIndex %d for FileSize length %d",
+			param.Fileparam.FileIndex, len(param.FileSize))
+	}
+	fileSize := param.FileSize[param.Fileparam.FileIndex-1]
+	if fileSize > maxParquetS3PrefetchSize {
+		return &fsReaderAt{
+			fs:       fs,
+			readPath: readPath,
+			ctx:      param.Ctx,
+			param:    param,
+		}, fileSize, nil
+	}
+	data := make([]byte, int(fileSize))
+	vec := fileservice.IOVector{
+		FilePath: readPath,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   fileSize,
+				Data:   data,
+			},
+		},
+	}
+	if err := fs.Read(param.Ctx, &vec); err != nil {
+		return nil, 0, err
+	}
+	return bytes.NewReader(data), fileSize, nil
+}
+
 // parseStringToDecimal64 converts a string to DECIMAL64 with given precision and scale.
 // It supports:
 // - Normal decimal numbers: "123.45"
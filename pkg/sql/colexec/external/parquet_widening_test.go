@@ -500,3 +500,30 @@ func TestParquet_WideningConversion_Float32ToFloat64(t *testing.T) {
 		}
 	}
 }
+
+// TestParquet_WideningRules checks that the parquet reader accepts every
+// widening of types.CanWiden, which other readers such as the stream source
+// follow too.
+func TestParquet_WideningRules(t *testing.T) {
+	sources := map[types.T]struct {
+		node  parquet.Node
+		value parquet.Value
+	}{
+		types.T_int32:   {parquet.Leaf(parquet.Int32Type), parquet.ValueOf(int32(1))},
+		types.T_int64:   {parquet.Leaf(parquet.Int64Type), parquet.ValueOf(int64(1))},
+		types.T_uint32:  {parquet.Uint(32), parquet.ValueOf(uint32(1))},
+		types.T_float32: {parquet.Leaf(parquet.FloatType), parquet.ValueOf(float32(1))},
+	}
+	targets := []types.T{types.T_int32, types.T_int64, types.T_uint32, types.T_uint64, types.T_float32, types.T_float64}
+	for from, src := range sources {
+		f, _ := writeDictAndGetPage(t, src.node, []parquet.Value{src.value})
+		for _, to := range targets {
+			if !types.CanWiden(from, to) {
+				continue
+			}
+			var h ParquetHandler
+			mp := h.getMapper(f.Root().Column("c"), plan.Type{Id: int32(to), NotNullable: true})
+			require.NotNil(t, mp, "%s → %s", from, to)
+		}
+	}
+}
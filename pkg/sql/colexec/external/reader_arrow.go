@@ -0,0 +1,168 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/errutil"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// ArrowReader handles Arrow IPC files (Feather v2) and streams.
+type ArrowReader struct {
+	param *ExternalParam
+	h     *ArrowHandler
+}
+
+// NewArrowReader creates an ArrowReader.
+// Record batches are skipped by zonemaps built from the filter columns, so
+// zonemappable is computed here as in NewZonemapReader.
+func NewArrowReader(param *ExternalParam, proc *process.Process) *ArrowReader {
+	param.Filter.zonemappable = plan2.ExprIsZonemappable(
+		proc.Ctx, param.Filter.FilterExpr)
+	return &ArrowReader{}
+}
+
+func (r *ArrowReader) Open(param *ExternalParam, proc *process.Process) (fileEmpty bool, err error) {
+	r.param = param
+	r.h, err = newArrowHandler(param)
+	if err != nil {
+		return false, err
+	}
+	// newArrowHandler returns (nil, nil) for files without rows
+	if r.h == nil {
+		return true, nil
+	}
+	return false, nil
+}
+
+func (r *ArrowReader) ReadBatch(
+	ctx context.Context, buf *batch.Batch,
+	proc *process.Process, analyzer process.Analyzer,
+) (fileFinished bool, err error) {
+	_, span := trace.Start(ctx, "ArrowReader.ReadBatch")
+	defer span.End()
+
+	if r.h == nil {
+		return true, nil
+	}
+
+	if err = r.h.getData(ctx, buf, r.param, proc); err != nil {
+		return false, err
+	}
+
+	if buf.RowCount() > 0 && r.h.filepathColIndex >= 0 {
+		vec := buf.Vecs[r.h.filepathColIndex]
+		if err = vector.SetConstBytes(vec, []byte(r.param.Fileparam.Filepath), buf.RowCount(), proc.Mp()); err != nil {
+			return false, err
+		}
+	}
+	return r.h.isFinished(), nil
+}
+
+func (r *ArrowReader) Close() error {
+	if r.h != nil {
+		r.h.close()
+		r.h = nil
+	}
+	r.param = nil
+	return nil
+}
+
+// getData fills bat with the next slice of at most batchCnt rows of the
+// current record batch. Slices whose zonemaps cannot satisfy the filter are
+// skipped; bat is left empty if the rest of the file is skipped.
+func (h *ArrowHandler) getData(ctx context.Context, bat *batch.Batch, param *ExternalParam, proc *process.Process) error {
+	mp := proc.Mp()
+	for !h.isFinished() {
+		start := h.offset
+		end := min(start+h.batchCnt, h.record.NumRows())
+
+		if !h.hasPhysicalCol {
+			bat.SetRowCount(int(end - start))
+			return h.advance(ctx, end)
+		}
+
+		var filtered map[int]bool
+		if h.zonemappable {
+			filtered = make(map[int]bool, len(param.Filter.columnMap))
+			for _, colIdx := range param.Filter.columnMap {
+				if filtered[colIdx] {
+					continue
+				}
+				filtered[colIdx] = true
+				if err := h.mapColumn(ctx, colIdx, start, end, bat.Vecs[colIdx], mp); err != nil {
+					return err
+				}
+			}
+			if err := h.updateZoneMaps(param.Filter.columnMap, bat.Vecs); err != nil {
+				return err
+			}
+			if !h.needRead(ctx, param, proc) {
+				bat.CleanOnlyData()
+				if err := h.advance(ctx, end); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		for colIdx, fn := range h.mappers {
+			if fn == nil || filtered[colIdx] {
+				continue
+			}
+			if err := h.mapColumn(ctx, colIdx, start, end, bat.Vecs[colIdx], mp); err != nil {
+				return err
+			}
+		}
+		bat.SetRowCount(int(end - start))
+		return h.advance(ctx, end)
+	}
+	return nil
+}
+
+// advance moves past the rows before end, loading the next record batch
+// once the current one is consumed so that isFinished is exact.
+func (h *ArrowHandler) advance(ctx context.Context, end int64) error {
+	h.offset = end
+	if h.offset < h.record.NumRows() {
+		return nil
+	}
+	return h.nextRecord(ctx)
+}
+
+func (h *ArrowHandler) needRead(ctx context.Context, param *ExternalParam, proc *process.Process) bool {
+	_, span := trace.Start(ctx, "ArrowHandler.needRead")
+	defer span.End()
+
+	notReportErrCtx := errutil.ContextWithNoReport(ctx, true)
+	var (
+		zms  []objectio.ZoneMap
+		vecs []*vector.Vector
+	)
+	if param.Filter.AuxIdCnt > 0 {
+		zms = make([]objectio.ZoneMap, param.Filter.AuxIdCnt)
+		vecs = make([]*vector.Vector, param.Filter.AuxIdCnt)
+	}
+	return colexec.EvaluateFilterByZoneMap(
+		notReportErrCtx, proc, param.Filter.FilterExpr, h.metas, param.Filter.columnMap, zms, vecs)
+}
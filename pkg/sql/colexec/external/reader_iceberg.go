@@ -0,0 +1,184 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external/iceberg"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// IcebergReader handles the data files of Iceberg tables. The files are read
+// by the reader of their format, then the rows removed by delete files are
+// dropped and the columns missing from older files are filled with nulls.
+type IcebergReader struct {
+	param *ExternalParam
+	inner ExternalFileReader
+
+	// tasks of the snapshot planned by PlanIcebergFileList, by file path
+	tasks   map[string]*iceberg.FileScanTask
+	schema  *iceberg.Schema
+	deletes *iceberg.DeleteLoader
+
+	// deleted holds the sorted positions of the deleted rows of the current
+	// file, row the position of the next row read from it
+	deleted []int64
+	row     int64
+}
+
+// NewIcebergReader creates an IcebergReader.
+func NewIcebergReader(param *ExternalParam, proc *process.Process) *IcebergReader {
+	return &IcebergReader{}
+}
+
+// plan loads the tasks of the snapshot pinned in the options of the table.
+func (r *IcebergReader) plan(ctx context.Context, param *ExternalParam) error {
+	fio, table, snapshot, err := loadIcebergTable(ctx, param.Extern)
+	if err != nil {
+		return err
+	}
+	r.schema = table.Metadata.CurrentSchema()
+	tasks, err := table.PlanFiles(ctx, snapshot, r.schema, nil)
+	if err != nil {
+		return err
+	}
+	r.tasks = make(map[string]*iceberg.FileScanTask, len(tasks))
+	for _, task := range tasks {
+		r.tasks[fio.resolve(task.File.Path)] = task
+	}
+	r.deletes = iceberg.NewDeleteLoader(fio, r.schema)
+	return nil
+}
+
+func (r *IcebergReader) Open(param *ExternalParam, proc *process.Process) (fileEmpty bool, err error) {
+	r.param = param
+	if r.tasks == nil {
+		if err = r.plan(param.Ctx, param); err != nil {
+			return false, err
+		}
+	}
+	task := r.tasks[param.Fileparam.Filepath]
+	if task == nil {
+		return false, moerr.NewInvalidInputf(param.Ctx, "file %s is not in the iceberg snapshot", param.Fileparam.Filepath)
+	}
+
+	// columns are matched by field id, as they may have been renamed since
+	// the file was written
+	fileSchema := task.File.Schema
+	if fileSchema == nil {
+		fileSchema = r.schema
+	}
+	param.fileColumnNames = make(map[string]string, len(param.Attrs))
+	for _, attr := range param.Attrs {
+		if param.isHivePartitionCol(attr.ColName) || catalog.ContainExternalHidenCol(attr.ColName) {
+			continue
+		}
+		name := ""
+		if field := r.schema.FieldByName(attr.ColName); field != nil {
+			if f := fileSchema.FieldByID(field.ID); f != nil {
+				name = f.Name
+			}
+		}
+		param.fileColumnNames[attr.ColName] = name
+	}
+
+	if r.deleted, err = r.deletes.DeletedRows(param.Ctx, task); err != nil {
+		return false, err
+	}
+	r.row = 0
+
+	switch task.File.Format {
+	case "PARQUET":
+		r.inner = NewParquetReader(param, proc)
+	case "ORC":
+		r.inner = NewOrcReader(param, proc)
+	default:
+		return false, moerr.NewNYIf(param.Ctx, "iceberg %s data files", task.File.Format)
+	}
+	// positions of rows are counted from the start of the file, so no part of
+	// a file with deletes may be skipped
+	if len(r.deleted) > 0 {
+		zonemappable := param.Filter.zonemappable
+		param.Filter.zonemappable = false
+		defer func() {
+			param.Filter.zonemappable = zonemappable
+		}()
+	}
+	return r.inner.Open(param, proc)
+}
+
+func (r *IcebergReader) ReadBatch(
+	ctx context.Context, buf *batch.Batch,
+	proc *process.Process, analyzer process.Analyzer,
+) (fileFinished bool, err error) {
+	_, span := trace.Start(ctx, "IcebergReader.ReadBatch")
+	defer span.End()
+
+	if fileFinished, err = r.inner.ReadBatch(ctx, buf, proc, analyzer); err != nil {
+		return false, err
+	}
+	rows := buf.RowCount()
+	if rows == 0 {
+		return fileFinished, nil
+	}
+
+	mp := proc.Mp()
+	for _, attr := range r.param.Attrs {
+		vec := buf.Vecs[attr.ColIndex]
+		if n := vec.Length(); n < rows {
+			if err = vector.AppendMultiFixed(vec, 0, true, rows-n, mp); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	start := r.row
+	r.row += int64(rows)
+	i := sort.Search(len(r.deleted), func(i int) bool { return r.deleted[i] >= start })
+	var sels []int64
+	for ; i < len(r.deleted) && r.deleted[i] < r.row; i++ {
+		sels = append(sels, r.deleted[i]-start)
+	}
+	if len(sels) > 0 {
+		for _, vec := range buf.Vecs {
+			if vec.Length() == rows {
+				vec.Shrink(sels, true)
+			}
+		}
+		buf.SetRowCount(rows - len(sels))
+	}
+	return fileFinished, nil
+}
+
+func (r *IcebergReader) Close() error {
+	var err error
+	if r.inner != nil {
+		err = r.inner.Close()
+		r.inner = nil
+	}
+	if r.param != nil {
+		r.param.fileColumnNames = nil
+		r.param = nil
+	}
+	r.deleted = nil
+	return err
+}
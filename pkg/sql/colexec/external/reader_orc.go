@@ -0,0 +1,135 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/errutil"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// OrcReader handles ORC files.
+type OrcReader struct {
+	param *ExternalParam
+	h     *OrcHandler
+}
+
+// NewOrcReader creates an OrcReader.
+// Stripes are skipped by zonemaps built from their statistics, so
+// zonemappable is computed here as in NewZonemapReader.
+func NewOrcReader(param *ExternalParam, proc *process.Process) *OrcReader {
+	param.Filter.zonemappable = plan2.ExprIsZonemappable(
+		proc.Ctx, param.Filter.FilterExpr)
+	return &OrcReader{}
+}
+
+func (r *OrcReader) Open(param *ExternalParam, proc *process.Process) (fileEmpty bool, err error) {
+	r.param = param
+	if err = param.refreshPartitionValues(proc); err != nil {
+		return false, err
+	}
+	r.h, err = newOrcHandler(param, proc)
+	if err != nil {
+		return false, err
+	}
+	// newOrcHandler returns (nil, nil) for files without rows to read
+	if r.h == nil {
+		return true, nil
+	}
+	return false, nil
+}
+
+func (r *OrcReader) ReadBatch(
+	ctx context.Context, buf *batch.Batch,
+	proc *process.Process, analyzer process.Analyzer,
+) (fileFinished bool, err error) {
+	_, span := trace.Start(ctx, "OrcReader.ReadBatch")
+	defer span.End()
+
+	if r.h == nil {
+		return true, nil
+	}
+
+	if err = r.h.getData(ctx, buf, r.param, proc); err != nil {
+		return false, err
+	}
+
+	if buf.RowCount() > 0 && (r.h.filepathColIndex >= 0 || len(r.h.partitionColIndices) > 0) {
+		if err = fillVirtualColumns(buf, r.h.filepathColIndex, r.h.partitionColIndices, r.param, proc); err != nil {
+			return false, err
+		}
+	}
+	return r.h.isFinished(), nil
+}
+
+func (r *OrcReader) Close() error {
+	if r.h != nil {
+		r.h.close()
+		r.h = nil
+	}
+	r.param = nil
+	return nil
+}
+
+// getData fills bat with the next slice of at most batchCnt rows of the
+// current stripe, loading the next stripe once the current one is consumed
+// so that isFinished is exact.
+func (h *OrcHandler) getData(ctx context.Context, bat *batch.Batch, param *ExternalParam, proc *process.Process) error {
+	if h.isFinished() {
+		return nil
+	}
+	mp := proc.Mp()
+	start := h.offset
+	end := min(start+h.batchCnt, h.rows)
+	for colIdx, fn := range h.mappers {
+		if fn == nil {
+			continue
+		}
+		if err := h.mapColumn(ctx, colIdx, start, end, bat.Vecs[colIdx], mp); err != nil {
+			return err
+		}
+	}
+	bat.SetRowCount(int(end - start))
+
+	h.offset = end
+	if h.offset < h.rows {
+		return nil
+	}
+	return h.nextStripe(param, proc)
+}
+
+func (h *OrcHandler) needRead(ctx context.Context, param *ExternalParam, proc *process.Process) bool {
+	_, span := trace.Start(ctx, "OrcHandler.needRead")
+	defer span.End()
+
+	notReportErrCtx := errutil.ContextWithNoReport(ctx, true)
+	var (
+		zms  []objectio.ZoneMap
+		vecs []*vector.Vector
+	)
+	if param.Filter.AuxIdCnt > 0 {
+		zms = make([]objectio.ZoneMap, param.Filter.AuxIdCnt)
+		vecs = make([]*vector.Vector, param.Filter.AuxIdCnt)
+	}
+	return colexec.EvaluateFilterByZoneMap(
+		notReportErrCtx, proc, param.Filter.FilterExpr, h.metas, param.Filter.columnMap, zms, vecs)
+}
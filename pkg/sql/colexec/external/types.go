@@ -79,6 +79,10 @@ type ExParam struct {
 	Filter            *FilterParam
 	currentPartValues map[string]string
 	parquetProfile    process.ParquetProfileStats
+	// fileColumnNames maps the columns of the table to their names in the
+	// current file, set by readers of table formats whose files may have
+	// renamed or lack columns. Columns mapped to "" are read as nulls.
+	fileColumnNames map[string]string
 }
 
 type ExFileparam struct {
@@ -160,6 +164,19 @@ func NewArgument() *External {
 	return reuse.Alloc[External](nil)
 }
 
+// fileColumnName returns the name of a column in the current file, and false
+// if the file lacks it.
+func (param *ExternalParam) fileColumnName(colName string) (string, bool) {
+	if param.fileColumnNames == nil {
+		return colName, true
+	}
+	name, ok := param.fileColumnNames[colName]
+	if !ok {
+		return colName, true
+	}
+	return name, name != ""
+}
+
 func (param *ExternalParam) addParquetProfile(stats process.ParquetProfileStats) {
 	if param == nil || param.Extern == nil || !strings.EqualFold(param.Extern.Format, tree.PARQUET) || stats.Empty() {
 		return
@@ -344,3 +361,11 @@ type columnMapper struct {
 
 	mapper func(mp *columnMapper, page parquet.Page, proc *process.Process, vec *vector.Vector) error
 }
+
+// columnMetas exposes zonemaps built by a reader, indexed by param.Cols
+// position, to colexec.EvaluateFilterByZoneMap.
+type columnMetas []objectio.ColumnMeta
+
+func (m columnMetas) MustGetColumn(seqnum uint16) objectio.ColumnMeta {
+	return m[seqnum]
+}
@@ -180,6 +180,12 @@ func GetKeyWidth(id types.T, width0 int32, nullable bool) (width int) {
 		if id == types.T_array_float64 {
 			width *= 8
 		}
+		if id == types.T_array_float16 {
+			width *= 2
+		}
+		if id == types.T_array_bit {
+			width = (width + 7) / 8
+		}
 	} else {
 		width = id.TypeLen()
 	}
@@ -141,7 +141,13 @@ func getIndex[T types.RealNumbers](ap *Productl2, proc *process.Process, analyze
 	centroidVec := ctr.bat.Vecs[centroidColPos]
 
 	dim := centroidVec.GetType().Width
-	elemSize := uint(centroidVec.GetType().GetArrayElementSize())
+	var elemSize uint
+	if centroidVec.GetType().Oid.IsQuantizedArray() {
+		// decoded to float32 by arrayAt
+		elemSize = 4
+	} else {
+		elemSize = uint(centroidVec.GetType().GetArrayElementSize())
+	}
 
 	if len(nullvec) > 0 {
 		nullvec[0] = 1
@@ -156,7 +162,7 @@ func getIndex[T types.RealNumbers](ap *Productl2, proc *process.Process, analyze
 			continue
 		}
 
-		c := types.BytesToArray[T](centroidVec.GetBytesAt(i))
+		c := arrayAt[T](centroidVec, i)
 		centers[i] = c
 	}
 
@@ -199,7 +205,7 @@ func (productl2 *Productl2) build(proc *process.Process, analyzer process.Analyz
 	centroidVec := ctr.bat.Vecs[centroidColPos]
 
 	switch centroidVec.GetType().Oid {
-	case types.T_array_float32:
+	case types.T_array_float32, types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		ctr.centersF32 = get1D[[]float32](&pool2DF32, ctr.bat.RowCount())
 		ctr.nullvecF32 = get1D[float32](&pool1DF32, int(centroidVec.GetType().Width))
 		ctr.brute_force, err = getIndex[float32](productl2, proc, analyzer, *ctr.centersF32, *ctr.nullvecF32)
@@ -279,17 +285,26 @@ func newMat[T types.RealNumbers](ctr *container, ap *Productl2, probes [][]T, nu
 			probes[j] = nullvec
 			continue
 		}
-		v := types.BytesToArray[T](tblColVec.GetBytesAt(j))
+		v := arrayAt[T](tblColVec, j)
 		probes[j] = v
 	}
 
 	return probes, nil
 }
 
+// arrayAt returns the i-th vector of vec.  vecf16, veci8 and vecbit are
+// decoded to float32 and clustered like vecf32.
+func arrayAt[T types.RealNumbers](vec *vector.Vector, i int) []T {
+	if oid := vec.GetType().Oid; oid.IsQuantizedArray() {
+		return any(types.QuantizedBytesToFloat32(oid, vec.GetBytesAt(i))).([]T)
+	}
+	return types.BytesToArray[T](vec.GetBytesAt(i))
+}
+
 func (ctr *container) probe(ap *Productl2, proc *process.Process, result *vm.CallResult) error {
 	tblColPos := ap.OnExpr.GetF().GetArgs()[1].GetCol().GetColPos()
 	switch ctr.inBat.Vecs[tblColPos].GetType().Oid {
-	case types.T_array_float32:
+	case types.T_array_float32, types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		return probeRun[float32](ctr, ap, proc, result)
 	case types.T_array_float64:
 		return probeRun[float64](ctr, ap, proc, result)
@@ -178,6 +178,9 @@ func NewCNS3DataWriter(
 	sequms, attrTypes, attrs, sortKeyIdx, isPrimaryKey := GetSequmsAttrsSortKeyIdxFromTableDef(tableDef)
 
 	factor := ioutil.NewFSinkerImplFactory(sequms, sortKeyIdx, isPrimaryKey, false, tableDef.Version)
+	if codecs := catalog.GenColumnCompressFromPlanCols(tableDef.Cols); len(codecs) > 0 {
+		factor = ioutil.NewFSinkerImplFactoryWithCompress(sequms, sortKeyIdx, isPrimaryKey, tableDef.Version, codecs)
+	}
 	if memoryThreshold < 0 {
 		memoryThreshold = WriteS3Threshold
 	}
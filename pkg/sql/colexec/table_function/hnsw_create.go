@@ -64,7 +64,7 @@ func (u *hnswCreateState) end(tf *TableFunction, proc *process.Process) error {
 	)
 
 	switch u.idxcfg.Usearch.Quantization {
-	case usearch.F32:
+	case usearch.F32, usearch.F16, usearch.I8, usearch.B1:
 		if u.buildf32 == nil {
 			return nil
 		}
@@ -223,6 +223,10 @@ func (u *hnswCreateState) start(tf *TableFunction, proc *process.Process, nthRow
 		if err != nil {
 			return err
 		}
+		u.idxcfg.Usearch.Metric, err = hnsw.MetricForQuantization(u.idxcfg.Usearch.Quantization, u.idxcfg.Usearch.Metric)
+		if err != nil {
+			return err
+		}
 
 		// dimension
 		dimension := faVec.GetType().Width
@@ -233,7 +237,7 @@ func (u *hnswCreateState) start(tf *TableFunction, proc *process.Process, nthRow
 		uid := fmt.Sprintf("%s:%d:%d", tf.CnAddr, tf.MaxParallel, tf.ParallelID)
 
 		switch u.idxcfg.Usearch.Quantization {
-		case usearch.F32:
+		case usearch.F32, usearch.F16, usearch.I8, usearch.B1:
 			u.buildf32, err = hnsw.NewHnswBuild[float32](sqlexec.NewSqlProcess(proc), uid, tf.MaxParallel, u.idxcfg, u.tblcfg)
 		case usearch.F64:
 			u.buildf64, err = hnsw.NewHnswBuild[float64](sqlexec.NewSqlProcess(proc), uid, tf.MaxParallel, u.idxcfg, u.tblcfg)
@@ -284,6 +288,19 @@ func (u *hnswCreateState) start(tf *TableFunction, proc *process.Process, nthRow
 			return err
 		}
 		return nil
+	case usearch.F16, usearch.I8, usearch.B1:
+		// vecf16, veci8 and vecbit are decoded here and encoded back by the index
+		f32a := types.QuantizedBytesToFloat32(faVec.GetType().Oid, faVec.GetBytesAt(nthRow))
+
+		if uint(len(f32a)) != u.idxcfg.Usearch.Dimensions {
+			return moerr.NewInternalError(proc.Ctx, "vector dimension mismatch")
+		}
+
+		err = u.buildf32.Add(id, f32a)
+		if err != nil {
+			return err
+		}
+		return nil
 	default:
 		// should not go here
 		panic("invalid quantization")
@@ -52,7 +52,7 @@ var newHnswAlgo = newHnswAlgoFn
 
 func newHnswAlgoFn(idxcfg vectorindex.IndexConfig, tblcfg vectorindex.IndexTableConfig) veccache.VectorIndexSearchIf {
 	switch idxcfg.Usearch.Quantization {
-	case usearch.F32:
+	case usearch.F32, usearch.F16, usearch.I8, usearch.B1:
 		return hnsw.NewHnswSearch[float32](idxcfg, tblcfg)
 	case usearch.F64:
 		return hnsw.NewHnswSearch[float64](idxcfg, tblcfg)
@@ -190,6 +190,10 @@ func (u *hnswSearchState) start(tf *TableFunction, proc *process.Process, nthRow
 		if err != nil {
 			return err
 		}
+		u.idxcfg.Usearch.Metric, err = hnsw.MetricForQuantization(u.idxcfg.Usearch.Quantization, u.idxcfg.Usearch.Metric)
+		if err != nil {
+			return err
+		}
 
 		// dimension
 		dimension := faVec.GetType().Width
@@ -220,6 +224,8 @@ func (u *hnswSearchState) start(tf *TableFunction, proc *process.Process, nthRow
 		return runHnswSearch[float32](proc, u, faVec, nthRow)
 	case usearch.F64:
 		return runHnswSearch[float64](proc, u, faVec, nthRow)
+	case usearch.F16, usearch.I8, usearch.B1:
+		return runHnswSearch[float32](proc, u, faVec, nthRow)
 	default:
 		// should not go here
 		panic("invalid Quantization")
@@ -228,7 +234,13 @@ func (u *hnswSearchState) start(tf *TableFunction, proc *process.Process, nthRow
 
 func runHnswSearch[T types.RealNumbers](proc *process.Process, u *hnswSearchState, faVec *vector.Vector, nthRow int) (err error) {
 
-	fa := types.BytesToArray[T](faVec.GetBytesAt(nthRow))
+	var fa []T
+	if oid := faVec.GetType().Oid; oid.IsQuantizedArray() {
+		// the index encodes the query back to vecf16, veci8 or vecbit
+		fa = any(types.QuantizedBytesToFloat32(oid, faVec.GetBytesAt(nthRow))).([]T)
+	} else {
+		fa = types.BytesToArray[T](faVec.GetBytesAt(nthRow))
+	}
 	if uint(len(fa)) != u.idxcfg.Usearch.Dimensions {
 		return moerr.NewInvalidInput(proc.Ctx, fmt.Sprintf("vector ops between different dimensions (%d, %d) is not permitted.", u.idxcfg.Usearch.Dimensions, len(fa)))
 	}
@@ -474,7 +474,11 @@ func TestNewHnswAlgoFn(t *testing.T) {
 	algo = newHnswAlgoFn(idxcfg, tblcfg)
 	require.NotNil(t, algo)
 
-	// invalid I8 quantization in MO
 	idxcfg.Usearch.Quantization = usearch.I8
+	algo = newHnswAlgoFn(idxcfg, tblcfg)
+	require.NotNil(t, algo)
+
+	// invalid BF16 quantization in MO
+	idxcfg.Usearch.Quantization = usearch.BF16
 	assert.Panics(t, func() { newHnswAlgoFn(idxcfg, tblcfg) }, "panic")
 }
@@ -66,6 +66,7 @@ type ivfCreateState struct {
 	idxcfg       vectorindex.IndexConfig
 	data32       [][]float32
 	data64       [][]float64
+	vectype      types.T
 	nsample      uint
 	sample_ratio float64
 	offset       int
@@ -126,7 +127,14 @@ func clustering[T types.RealNumbers](u *ivfCreateState, tf *TableFunction, proc
 	// insert into centroid table
 	values := make([]string, 0, len(centers))
 	for i, c := range centers {
-		s := types.ArrayToString(c)
+		var s string
+		if u.vectype.IsQuantizedArray() {
+			if s, err = quantizedCentroidString(u.vectype, any(c).([]float32)); err != nil {
+				return err
+			}
+		} else {
+			s = types.ArrayToString(c)
+		}
 		values = append(values, fmt.Sprintf("(%d, %d, '%s')", version, i, s))
 	}
 
@@ -156,6 +164,24 @@ func clustering[T types.RealNumbers](u *ivfCreateState, tf *TableFunction, proc
 	return nil
 }
 
+// quantizedCentroidString rounds the centroid to a value of the vecf16, veci8
+// or vecbit centroid column. A vecbit centroid keeps the bits that are set in
+// the majority of its cluster.
+func quantizedCentroidString(oid types.T, c []float32) (string, error) {
+	if oid == types.T_array_bit {
+		shifted := make([]float32, len(c))
+		for i, v := range c {
+			shifted[i] = v - 0.5
+		}
+		c = shifted
+	}
+	b, err := types.Float32ToQuantizedBytes(oid, c)
+	if err != nil {
+		return "", err
+	}
+	return types.QuantizedArrayBytesToString(oid, b), nil
+}
+
 func (u *ivfCreateState) end(tf *TableFunction, proc *process.Process) error {
 
 	if !u.inited || (len(u.data32) == 0 && len(u.data64) == 0) {
@@ -329,7 +355,8 @@ func (u *ivfCreateState) start(tf *TableFunction, proc *process.Process, nthRow
 			return moerr.NewInvalidInput(proc.Ctx, "Second argument (vector must be a vecf32 or vecf64 type")
 		}
 
-		if embedvec.GetType().Oid == types.T_array_float32 {
+		u.vectype = embedvec.GetType().Oid
+		if u.vectype == types.T_array_float32 || u.vectype.IsQuantizedArray() {
 			u.data32 = make([][]float32, 0, u.nsample)
 		} else {
 			u.data64 = make([][]float64, 0, u.nsample)
@@ -356,6 +383,13 @@ func (u *ivfCreateState) start(tf *TableFunction, proc *process.Process, nthRow
 						return moerr.NewInternalError(proc.Ctx, "vector dimension mismatch")
 					}
 					u.data64 = append(u.data64, append(make([]float64, 0, len(f64a)), f64a...))
+				case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+					// clustered as float32, the decoded slice is already a copy
+					f32a := types.QuantizedBytesToFloat32(evec.GetType().Oid, evec.GetBytesAt(i))
+					if uint(len(f32a)) != u.idxcfg.Ivfflat.Dimensions {
+						return moerr.NewInternalError(proc.Ctx, "vector dimension mismatch")
+					}
+					u.data32 = append(u.data32, f32a)
 				}
 			}
 		}
@@ -59,7 +59,7 @@ var (
 
 func newIvfAlgoFn(idxcfg vectorindex.IndexConfig, tblcfg vectorindex.IndexTableConfig) (veccache.VectorIndexSearchIf, error) {
 	switch idxcfg.Ivfflat.VectorType {
-	case int32(types.T_array_float32):
+	case int32(types.T_array_float32), int32(types.T_array_float16), int32(types.T_array_int8), int32(types.T_array_bit):
 		return ivfflat.NewIvfflatSearch[float32](idxcfg, tblcfg), nil
 	case int32(types.T_array_float64):
 		return ivfflat.NewIvfflatSearch[float64](idxcfg, tblcfg), nil
@@ -204,7 +204,7 @@ func (u *ivfSearchState) start(tf *TableFunction, proc *process.Process, nthRow
 			return err
 		}
 		u.idxcfg.Ivfflat.Version = version                 // version from meta table
-		u.idxcfg.Ivfflat.VectorType = u.tblcfg.KeyPartType // array float32 or array float64
+		u.idxcfg.Ivfflat.VectorType = u.tblcfg.KeyPartType // vector type of the key part
 
 		u.batch = tf.createResultBatch()
 		u.inited = true
@@ -228,8 +228,10 @@ func (u *ivfSearchState) start(tf *TableFunction, proc *process.Process, nthRow
 		return runIvfSearchVector[float32](tf, u, proc, faVec, nthRow)
 	case types.T_array_float64:
 		return runIvfSearchVector[float64](tf, u, proc, faVec, nthRow)
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return runIvfSearchVector[float32](tf, u, proc, faVec, nthRow)
 	default:
-		return moerr.NewInternalError(proc.Ctx, "vector is not array_float32 or array_float64")
+		return moerr.NewInternalError(proc.Ctx, "vector is not a vector type")
 	}
 }
 
@@ -238,7 +240,13 @@ func runIvfSearchVector[T types.RealNumbers](tf *TableFunction, u *ivfSearchStat
 		return nil
 	}
 
-	fa := types.BytesToArray[T](faVec.GetBytesAt(nthRow))
+	var fa []T
+	if oid := faVec.GetType().Oid; oid.IsQuantizedArray() {
+		// vecf16, veci8 and vecbit are searched as float32
+		fa = any(types.QuantizedBytesToFloat32(oid, faVec.GetBytesAt(nthRow))).([]T)
+	} else {
+		fa = types.BytesToArray[T](faVec.GetBytesAt(nthRow))
+	}
 	if uint(len(fa)) != u.idxcfg.Ivfflat.Dimensions {
 		return moerr.NewInvalidInput(proc.Ctx, fmt.Sprintf("vector ops between different dimensions (%d, %d) is not permitted.", u.idxcfg.Ivfflat.Dimensions, len(fa)))
 	}
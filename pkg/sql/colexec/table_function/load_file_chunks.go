@@ -17,6 +17,7 @@ package table_function
 import (
 	"io"
 	"math"
+	"sort"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -25,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/datalink"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/monlp/chunker"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -38,6 +40,16 @@ type loadFileChunksState struct {
 	chunkSize   int64
 	nextChunk   int64
 	initialized bool
+	// with the chunking options, the text of the file, or the extracted text
+	// of a document such as pdf or docx, is cut into chunks instead of reading
+	// the file chunk by chunk.
+	chunking bool
+	text     []byte
+	chunks   []chunker.Chunk
+	// offset of the text in the file
+	textOffset int64
+	// start offset of every page of the text
+	pages []int64
 }
 
 func loadFileChunksPrepare(proc *process.Process, tableFunction *TableFunction) (tvfState, error) {
@@ -54,6 +66,10 @@ func (st *loadFileChunksState) reset(tf *TableFunction, proc *process.Process) {
 	if st.batch != nil {
 		st.batch.CleanOnlyData()
 	}
+	st.chunking = false
+	st.text = nil
+	st.chunks = nil
+	st.pages = nil
 	st.initialized = false
 }
 
@@ -70,8 +86,8 @@ func (st *loadFileChunksState) end(tf *TableFunction, proc *process.Process) err
 func (st *loadFileChunksState) start(tf *TableFunction, proc *process.Process, nthRow int, analyzer process.Analyzer) error {
 	st.initialized = false
 
-	if len(tf.ctr.argVecs) < 2 {
-		return moerr.NewInvalidInput(proc.Ctx, "load_file_chunks requires 2 arguments (datalink, chunk_size)")
+	if len(tf.ctr.argVecs) < 2 || len(tf.ctr.argVecs) > 3 {
+		return moerr.NewInvalidInput(proc.Ctx, "load_file_chunks requires 2 or 3 arguments (datalink, chunk_size [, options])")
 	}
 
 	srcVec := tf.ctr.argVecs[0]
@@ -99,11 +115,63 @@ func (st *loadFileChunksState) start(tf *TableFunction, proc *process.Process, n
 		return moerr.NewInvalidInput(proc.Ctx, "load_file_chunks: chunk_size exceeds max blob length")
 	}
 
+	// the chunking options, e.g. {"strategy": "paragraph", "overlap": 64}
+	hasOptions := false
+	var options string
+	if len(tf.ctr.argVecs) > 2 {
+		optVec := tf.ctr.argVecs[2]
+		switch optVec.GetType().Oid {
+		case types.T_varchar, types.T_text, types.T_char, types.T_json:
+		default:
+			return moerr.NewInvalidInput(proc.Ctx, "load_file_chunks: options must be string type")
+		}
+		if !optVec.GetNulls().Contains(uint64(nthRow)) {
+			hasOptions = true
+			if optVec.GetType().Oid == types.T_json {
+				options = types.DecodeJson(optVec.GetBytesAt(nthRow)).String()
+			} else {
+				options = optVec.GetStringAt(nthRow)
+			}
+		}
+	}
+	opts, err := chunker.ParseOptions(options, int(chunkSize))
+	if err != nil {
+		return err
+	}
+
 	dl, err := datalink.NewDatalink(src, proc)
 	if err != nil {
 		return err
 	}
 
+	if st.batch == nil {
+		st.batch = tf.createResultBatch()
+	} else {
+		st.batch.CleanOnlyData()
+	}
+
+	st.dl = dl
+	st.chunkSize = chunkSize
+	st.nextChunk = 0
+	st.chunking = false
+	st.text = nil
+	st.chunks = nil
+	st.pages = nil
+	st.textOffset = 0
+
+	// without the chunking options the raw bytes of any file are read
+	if hasOptions {
+		if err = st.loadText(proc, opts); err != nil {
+			return err
+		}
+		if st.chunks, err = chunker.Split(st.text, opts); err != nil {
+			return err
+		}
+		st.chunking = true
+		st.initialized = true
+		return nil
+	}
+
 	endOffset := int64(0)
 	if dl.Size < 0 {
 		etlFS, readPath, err := fileservice.GetForETL(proc.Ctx, proc.GetFileService(), dl.MoPath)
@@ -122,26 +190,60 @@ func (st *loadFileChunksState) start(tf *TableFunction, proc *process.Process, n
 		endOffset = dl.Offset + dl.Size
 	}
 
-	if st.batch == nil {
-		st.batch = tf.createResultBatch()
-	} else {
-		st.batch.CleanOnlyData()
-	}
-
-	st.dl = dl
 	st.curOffset = dl.Offset
 	st.endOffset = endOffset
-	st.chunkSize = chunkSize
-	st.nextChunk = 0
 	st.initialized = true
 
 	return nil
 }
 
+// loadText loads the plain text of the datalink.  The offsets of the chunks
+// of a document are offsets in its extracted text.  A markdown document is
+// chunked by the markdown strategy as is, so that its headings are kept.
+func (st *loadFileChunksState) loadText(proc *process.Process, opts chunker.Options) error {
+	var err error
+	if !st.dl.HasPlainTextExtractor() ||
+		(opts.Strategy == chunker.StrategyMarkdown && st.dl.IsMarkdown()) {
+		st.textOffset = st.dl.Offset
+		st.text, err = st.dl.GetBytes(proc)
+		return err
+	}
+
+	pages, err := st.dl.GetPlainTextPages(proc)
+	if err != nil {
+		return err
+	}
+	if pages == nil {
+		st.text, err = st.dl.GetPlainText(proc)
+		return err
+	}
+
+	// pages are separated by a newline
+	st.pages = make([]int64, len(pages))
+	for i, page := range pages {
+		if i > 0 {
+			st.text = append(st.text, '\n')
+		}
+		st.pages[i] = int64(len(st.text))
+		st.text = append(st.text, page...)
+	}
+	return nil
+}
+
+// pageOf returns the 1-based page number of the text offset.
+func (st *loadFileChunksState) pageOf(offset int64) int64 {
+	return int64(sort.Search(len(st.pages), func(i int) bool {
+		return st.pages[i] > offset
+	}))
+}
+
 func (st *loadFileChunksState) call(tf *TableFunction, proc *process.Process) (vm.CallResult, error) {
 	if !st.initialized {
 		return vm.CancelResult, nil
 	}
+	if st.chunking {
+		return st.callText(tf, proc)
+	}
 	if st.curOffset >= st.endOffset {
 		return vm.CancelResult, nil
 	}
@@ -179,27 +281,11 @@ func (st *loadFileChunksState) call(tf *TableFunction, proc *process.Process) (v
 			return vm.CancelResult, moerr.NewInternalError(proc.Ctx, "read size mismatch in load_file_chunks")
 		}
 
-		for colIdx, attr := range tf.Attrs {
-			switch strings.ToLower(attr) {
-			case "chunk_id":
-				if err := vector.AppendFixed[int64](st.batch.Vecs[colIdx], st.nextChunk, false, proc.Mp()); err != nil {
-					return vm.CancelResult, err
-				}
-			case "offset":
-				if err := vector.AppendFixed[int64](st.batch.Vecs[colIdx], st.curOffset, false, proc.Mp()); err != nil {
-					return vm.CancelResult, err
-				}
-			case "data":
-				if err := vector.AppendBytes(st.batch.Vecs[colIdx], data, false, proc.Mp()); err != nil {
-					return vm.CancelResult, err
-				}
-			default:
-				return vm.CancelResult, moerr.NewInvalidInput(proc.Ctx, "load_file_chunks: invalid column name")
-			}
+		if err = st.appendChunk(tf, proc, st.curOffset, data, 0, ""); err != nil {
+			return vm.CancelResult, err
 		}
 
 		st.curOffset += size
-		st.nextChunk++
 		cnt++
 	}
 
@@ -210,6 +296,66 @@ func (st *loadFileChunksState) call(tf *TableFunction, proc *process.Process) (v
 	return vm.CallResult{Status: vm.ExecNext, Batch: st.batch}, nil
 }
 
+// callText returns the next batch of the chunks of the text.
+func (st *loadFileChunksState) callText(tf *TableFunction, proc *process.Process) (vm.CallResult, error) {
+	if st.nextChunk >= int64(len(st.chunks)) {
+		return vm.CancelResult, nil
+	}
+
+	st.batch.CleanOnlyData()
+
+	var cnt, size int
+	for cnt < 1024 && st.nextChunk < int64(len(st.chunks)) {
+		c := st.chunks[st.nextChunk]
+		// keep the batch under the max blob length
+		if cnt > 0 && size+c.End-c.Start > types.MaxBlobLen {
+			break
+		}
+		var page int64
+		if st.pages != nil {
+			page = st.pageOf(int64(c.Start))
+		}
+		if err := st.appendChunk(tf, proc, st.textOffset+int64(c.Start), st.text[c.Start:c.End], page, c.Heading); err != nil {
+			return vm.CancelResult, err
+		}
+		size += c.End - c.Start
+		cnt++
+	}
+
+	st.batch.SetRowCount(cnt)
+	return vm.CallResult{Status: vm.ExecNext, Batch: st.batch}, nil
+}
+
+// appendChunk appends the chunk at offset to the batch, page 0 and an empty
+// heading are NULL.
+func (st *loadFileChunksState) appendChunk(tf *TableFunction, proc *process.Process, offset int64, data []byte, page int64, heading string) error {
+	for colIdx, attr := range tf.Attrs {
+		vec := st.batch.Vecs[colIdx]
+		var err error
+		switch strings.ToLower(attr) {
+		case "chunk_id":
+			err = vector.AppendFixed[int64](vec, st.nextChunk, false, proc.Mp())
+		case "offset":
+			err = vector.AppendFixed[int64](vec, offset, false, proc.Mp())
+		case "end_offset":
+			err = vector.AppendFixed[int64](vec, offset+int64(len(data)), false, proc.Mp())
+		case "data":
+			err = vector.AppendBytes(vec, data, false, proc.Mp())
+		case "page":
+			err = vector.AppendFixed[int64](vec, page, page == 0, proc.Mp())
+		case "heading":
+			err = vector.AppendBytes(vec, []byte(heading), heading == "", proc.Mp())
+		default:
+			return moerr.NewInvalidInput(proc.Ctx, "load_file_chunks: invalid column name")
+		}
+		if err != nil {
+			return err
+		}
+	}
+	st.nextChunk++
+	return nil
+}
+
 func getInt64FromVector(proc *process.Process, vec *vector.Vector, nthRow int) (int64, error) {
 	if vec.GetNulls().Contains(uint64(nthRow)) {
 		return 0, moerr.NewInvalidInput(proc.Ctx, "load_file_chunks: chunk_size cannot be NULL")
@@ -17,6 +17,7 @@ package table_function
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -34,9 +35,114 @@ func TestLoadFileChunks(t *testing.T) {
 	err := os.WriteFile(filePath, []byte("abcdef"), 0o600)
 	require.NoError(t, err)
 
+	rows := runLoadFileChunks(t, filePath, 4, nil)
+	require.Equal(t, []loadFileChunksRow{
+		{id: 0, offset: 0, endOffset: 4, data: "abcd"},
+		{id: 1, offset: 4, endOffset: 6, data: "ef"},
+	}, rows)
+}
+
+func TestLoadFileChunksDocument(t *testing.T) {
+	dir := t.TempDir()
+	options := `{}`
+
+	// chunks are taken from the extracted text
+	filePath := filepath.Join(dir, "test.html")
+	err := os.WriteFile(filePath, []byte("<html><script>x()</script><p>abc</p><p>def</p></html>"), 0o600)
+	require.NoError(t, err)
+	rows := runLoadFileChunks(t, filePath, 4, &options)
+	require.Equal(t, []loadFileChunksRow{
+		{id: 0, offset: 0, endOffset: 3, data: "abc"},
+		{id: 1, offset: 5, endOffset: 8, data: "def"},
+	}, rows)
+
+	// the raw bytes are read without the options
+	rows = runLoadFileChunks(t, filePath, 32, nil)
+	require.Equal(t, []loadFileChunksRow{
+		{id: 0, offset: 0, endOffset: 32, data: "<html><script>x()</script><p>abc"},
+		{id: 1, offset: 32, endOffset: 53, data: "</p><p>def</p></html>"},
+	}, rows)
+
+	// utf8 characters are not split
+	filePath = filepath.Join(dir, "test.md")
+	err = os.WriteFile(filePath, []byte("# 你好世界"), 0o600)
+	require.NoError(t, err)
+	rows = runLoadFileChunks(t, filePath, 4, &options)
+	require.Equal(t, []loadFileChunksRow{
+		{id: 0, offset: 0, endOffset: 3, data: "你"},
+		{id: 1, offset: 3, endOffset: 6, data: "好"},
+		{id: 2, offset: 6, endOffset: 9, data: "世"},
+		{id: 3, offset: 9, endOffset: 12, data: "界"},
+	}, rows)
+}
+
+func TestLoadFileChunksOptions(t *testing.T) {
+	dir := t.TempDir()
+
+	// markdown headings of a plain text file
+	filePath := filepath.Join(dir, "notes.txt")
+	err := os.WriteFile(filePath, []byte("# A\none. two.\n## B\nthree."), 0o600)
+	require.NoError(t, err)
+	options := `{"strategy": "markdown"}`
+	rows := runLoadFileChunks(t, filePath, 100, &options)
+	require.Equal(t, []loadFileChunksRow{
+		{id: 0, offset: 0, endOffset: 13, data: "# A\none. two.", heading: "A"},
+		{id: 1, offset: 14, endOffset: 25, data: "## B\nthree.", heading: "A > B"},
+	}, rows)
+
+	// the headings of a markdown document are kept by the markdown strategy
+	filePath = filepath.Join(dir, "notes.md")
+	err = os.WriteFile(filePath, []byte("# A\none. two.\n## B\nthree."), 0o600)
+	require.NoError(t, err)
+	rows = runLoadFileChunks(t, filePath, 100, &options)
+	require.Equal(t, []loadFileChunksRow{
+		{id: 0, offset: 0, endOffset: 13, data: "# A\none. two.", heading: "A"},
+		{id: 1, offset: 14, endOffset: 25, data: "## B\nthree.", heading: "A > B"},
+	}, rows)
+
+	// sentences with overlap, offsets are in the file
+	filePath = filepath.Join(dir, "sentences.txt")
+	err = os.WriteFile(filePath, []byte("a1. b2. c3. d4."), 0o600)
+	require.NoError(t, err)
+	options = `{"strategy": "sentence", "overlap": 4}`
+	rows = runLoadFileChunks(t, "file://"+filePath+"?offset=4", 8, &options)
+	require.Equal(t, []loadFileChunksRow{
+		{id: 0, offset: 4, endOffset: 11, data: "b2. c3."},
+		{id: 1, offset: 8, endOffset: 15, data: "c3. d4."},
+	}, rows)
+
+	options = `{"strategy": "nonsense"}`
+	_, err = tryLoadFileChunks(t, filePath, 100, &options)
+	require.Error(t, err)
+}
+
+func TestLoadFileChunksPageOf(t *testing.T) {
+	st := &loadFileChunksState{pages: []int64{0, 10, 25}}
+	require.Equal(t, int64(1), st.pageOf(0))
+	require.Equal(t, int64(1), st.pageOf(9))
+	require.Equal(t, int64(2), st.pageOf(10))
+	require.Equal(t, int64(3), st.pageOf(100))
+}
+
+type loadFileChunksRow struct {
+	id        int64
+	offset    int64
+	endOffset int64
+	data      string
+	page      int64
+	heading   string
+}
+
+func runLoadFileChunks(t *testing.T, filePath string, chunkSize int64, options *string) []loadFileChunksRow {
+	rows, err := tryLoadFileChunks(t, filePath, chunkSize, options)
+	require.NoError(t, err)
+	return rows
+}
+
+func tryLoadFileChunks(t *testing.T, filePath string, chunkSize int64, options *string) ([]loadFileChunksRow, error) {
 	proc := testutil.NewProc(t)
 	tf := &TableFunction{
-		Attrs:    []string{"chunk_id", "offset", "data"},
+		Attrs:    []string{"chunk_id", "offset", "end_offset", "data", "page", "heading"},
 		Rets:     loadFileChunksTestCols(),
 		FuncName: "load_file_chunks",
 		OperatorBase: vm.OperatorBase{
@@ -48,6 +154,10 @@ func TestLoadFileChunks(t *testing.T) {
 		},
 	}
 
+	src := filePath
+	if !strings.HasPrefix(src, "file://") {
+		src = "file://" + src
+	}
 	tf.Args = []*plan.Expr{
 		{
 			Typ: plan.Type{
@@ -57,7 +167,7 @@ func TestLoadFileChunks(t *testing.T) {
 			Expr: &plan.Expr_Lit{
 				Lit: &plan.Literal{
 					Value: &plan.Literal_Sval{
-						Sval: "file://" + filePath,
+						Sval: src,
 					},
 				},
 			},
@@ -69,12 +179,27 @@ func TestLoadFileChunks(t *testing.T) {
 			Expr: &plan.Expr_Lit{
 				Lit: &plan.Literal{
 					Value: &plan.Literal_I64Val{
-						I64Val: 4,
+						I64Val: chunkSize,
 					},
 				},
 			},
 		},
 	}
+	if options != nil {
+		tf.Args = append(tf.Args, &plan.Expr{
+			Typ: plan.Type{
+				Id:    int32(types.T_varchar),
+				Width: 256,
+			},
+			Expr: &plan.Expr_Lit{
+				Lit: &plan.Literal{
+					Value: &plan.Literal_Sval{
+						Sval: *options,
+					},
+				},
+			},
+		})
+	}
 
 	retSchema := make([]types.Type, len(tf.Rets))
 	for i := range tf.Rets {
@@ -85,45 +210,57 @@ func TestLoadFileChunks(t *testing.T) {
 
 	tvfst, err := loadFileChunksPrepare(proc, tf)
 	require.NoError(t, err)
+	defer tvfst.free(tf, proc, false, nil)
 
 	for i := range tf.ctr.executorsForArgs {
 		tf.ctr.argVecs[i], err = tf.ctr.executorsForArgs[i].Eval(proc, []*batch.Batch{batch.EmptyForConstFoldBatch}, nil)
 		require.NoError(t, err)
 	}
 
-	err = tvfst.start(tf, proc, 0, nil)
-	require.NoError(t, err)
+	if err = tvfst.start(tf, proc, 0, nil); err != nil {
+		return nil, err
+	}
 
-	var ids []int64
-	var offsets []int64
-	var chunks []string
+	var rows []loadFileChunksRow
 	for {
 		res, err := tvfst.call(tf, proc)
-		require.NoError(t, err)
+		if err != nil {
+			return nil, err
+		}
 		if res.Batch.IsDone() {
 			break
 		}
 		bat := res.Batch
 		for i := 0; i < bat.RowCount(); i++ {
-			ids = append(ids, vector.GetFixedAtNoTypeCheck[int64](bat.Vecs[0], i))
-			offsets = append(offsets, vector.GetFixedAtNoTypeCheck[int64](bat.Vecs[1], i))
-			chunks = append(chunks, string(bat.Vecs[2].GetBytesAt(i)))
+			row := loadFileChunksRow{
+				id:        vector.GetFixedAtNoTypeCheck[int64](bat.Vecs[0], i),
+				offset:    vector.GetFixedAtNoTypeCheck[int64](bat.Vecs[1], i),
+				endOffset: vector.GetFixedAtNoTypeCheck[int64](bat.Vecs[2], i),
+				data:      string(bat.Vecs[3].GetBytesAt(i)),
+			}
+			if !bat.Vecs[4].IsNull(uint64(i)) {
+				row.page = vector.GetFixedAtNoTypeCheck[int64](bat.Vecs[4], i)
+			}
+			if !bat.Vecs[5].IsNull(uint64(i)) {
+				row.heading = bat.Vecs[5].GetStringAt(i)
+			}
+			rows = append(rows, row)
 		}
 	}
-	tvfst.free(tf, proc, false, nil)
-
-	require.Equal(t, []int64{0, 1}, ids)
-	require.Equal(t, []int64{0, 4}, offsets)
-	require.Equal(t, []string{"abcd", "ef"}, chunks)
+	return rows, nil
 }
 
 func loadFileChunksTestCols() []*plan.ColDef {
 	i64Typ := types.T_int64.ToType()
 	blobTyp := types.T_blob.ToType()
+	varcharTyp := types.T_varchar.ToType()
 	return []*plan.ColDef{
 		{Name: "chunk_id", Typ: makeTestPlanType(&i64Typ)},
 		{Name: "offset", Typ: makeTestPlanType(&i64Typ)},
+		{Name: "end_offset", Typ: makeTestPlanType(&i64Typ)},
 		{Name: "data", Typ: makeTestPlanType(&blobTyp)},
+		{Name: "page", Typ: makeTestPlanType(&i64Typ)},
+		{Name: "heading", Typ: makeTestPlanType(&varcharTyp)},
 	}
 }
 
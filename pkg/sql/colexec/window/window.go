@@ -131,7 +131,7 @@ func (window *Window) Call(proc *process.Process) (vm.CallResult, error) {
 				if function.GetFunctionIsWinValueFunByName(winName) {
 					continue
 				}
-				ctr.batAggs[i], err = aggexec.MakeAgg(proc.Mp(), ag.GetAggID(), ag.IsDistinct(), window.Types[i])
+				ctr.batAggs[i], err = aggexec.MakeAgg(proc.Mp(), ag.GetAggID(), ag.IsDistinct(), aggArgTypes(window, i)...)
 				if err != nil {
 					return result, err
 				}
@@ -192,6 +192,22 @@ func (window *Window) Call(proc *process.Process) (vm.CallResult, error) {
 	}
 }
 
+// aggArgTypes returns the argument types of the i-th window function.
+// Types only records the type of the first argument, the types of the
+// aggregations with more arguments, such as corr(y, x), come from their
+// argument expressions.
+func aggArgTypes(window *Window, i int) []types.Type {
+	args := window.Aggs[i].GetArgExpressions()
+	if len(args) <= 1 {
+		return []types.Type{window.Types[i]}
+	}
+	typs := make([]types.Type, len(args))
+	for j, e := range args {
+		typs[j] = types.New(types.T(e.Typ.Id), e.Typ.Width, e.Typ.Scale)
+	}
+	return typs
+}
+
 func (ctr *container) makeResultBatch(bat *batch.Batch, vec *vector.Vector) *batch.Batch {
 	ctr.rBat = batch.NewWithSize(len(bat.Vecs) + 1)
 	i := 0
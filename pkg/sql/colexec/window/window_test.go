@@ -807,3 +807,52 @@ func TestSearchLeftRightDateTimeTypes(t *testing.T) {
 		require.Equal(t, 1, r)
 	})
 }
+
+func TestWinTwoArgumentAggregate(t *testing.T) {
+	mp := mpool.MustNewZero()
+	proc := testutil.NewProcessWithMPool(t, "", mp)
+
+	argTypes := []types.Type{types.T_int32.ToType(), types.T_int32.ToType()}
+	fn, err := function.GetFunctionByName(context.Background(), "regr_slope", argTypes)
+	require.NoError(t, err)
+	args := []*plan.Expr{newColExpr(0), newColExpr(1)}
+	for _, arg := range args {
+		arg.Typ = plan.Type{Id: int32(types.T_int32)}
+	}
+	agg := aggexec.MakeAggFunctionExpression(fn.GetEncodedOverloadID(), false, args, nil)
+
+	// a running frame over y = 2x + 1.
+	spec := makeWindowSpec()
+	w := spec.Expr.(*plan.Expr_W).W
+	w.Name = "regr_slope"
+	w.WindowFunc = &plan.Expr{
+		Typ:  plan.Type{Id: int32(types.T_float64)},
+		Expr: &plan.Expr_F{F: &plan.Function{Func: &plan.ObjectRef{ObjName: "regr_slope"}}},
+	}
+	w.Frame.End = &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW}
+
+	bat := batch.New([]string{"y", "x"})
+	bat.Vecs[0] = testutil.MakeInt32Vector([]int32{3, 5, 7, 9}, nil, mp)
+	bat.Vecs[1] = testutil.MakeInt32Vector([]int32{1, 2, 3, 4}, nil, mp)
+	bat.SetRowCount(4)
+
+	arg := &Window{
+		WinSpecList: []*plan.Expr{spec},
+		Types:       argTypes[:1],
+		Aggs:        []aggexec.AggFuncExecExpression{agg},
+	}
+	arg.AppendChild(colexec.NewMockOperator().WithBatchs([]*batch.Batch{bat}))
+	require.NoError(t, arg.Prepare(proc))
+
+	result, err := vm.Exec(arg, proc)
+	require.NoError(t, err)
+	res := result.Batch.Vecs[len(result.Batch.Vecs)-1]
+	require.Equal(t, 4, res.Length())
+	// the first frame holds one row only, which leaves the slope undefined.
+	require.True(t, res.IsNull(0))
+	require.Equal(t, []float64{2, 2, 2}, vector.MustFixedColNoTypeCheck[float64](res)[1:])
+
+	arg.Free(proc, false, nil)
+	proc.Free()
+	require.Equal(t, int64(0), mp.CurrNB())
+}
@@ -399,6 +399,10 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 	if err != nil {
 		return err
 	}
+	err = DropAllGeneratedColCdcTasks(c, newTmpTableDef, dbName, qry.CopyTableDef.Name)
+	if err != nil {
+		return err
+	}
 
 	// Idxcron: remove index update tasks with temp table id
 	err = DropAllIndexUpdateTasks(c, newTmpTableDef, dbName, qry.CopyTableDef.Name)
@@ -495,6 +499,12 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 	}
 
 	newTableDef := newRel.CopyTableDef(c.proc.Ctx)
+	// ISCP: re-register the async generated column jobs under the original
+	// table name, they fill the rows copied without the column value.
+	err = CreateAllGeneratedColCdcTasks(c, dbName, newTableDef.Name, newTableDef.TblId, false, newTableDef)
+	if err != nil {
+		return err
+	}
 	//--------------------------------------------------------------------------------------------------------------
 	{
 		// 9. invoke reindex for the new table, if it contains ivf index.
@@ -1544,7 +1544,7 @@ func (c *Compile) getReadWriteParallelFlag(param *tree.ExternParam, fileList []s
 	if !param.Parallel {
 		return false, false
 	}
-	if param.Format == tree.PARQUET {
+	if param.Format == tree.PARQUET || param.Format == tree.ARROW || param.Format == tree.ORC || param.Format == tree.ICEBERG {
 		return false, true
 	}
 	if param.Local || crt.GetCompressType(param.CompressType, fileList[0]) != tree.NOCOMPRESS {
@@ -1559,6 +1559,12 @@ func (c *Compile) getExternalFileListAndSize(node *plan.Node, param *tree.Extern
 	if param.HivePartitioning {
 		return c.getHivePartitionFileList(node, param)
 	}
+	// Iceberg tables list their data files in their metadata.
+	if param.Format == tree.ICEBERG {
+		_, span := trace.Start(c.proc.Ctx, "compileExternScan.PlanIcebergFileList")
+		defer span.End()
+		return external.PlanIcebergFileList(c.proc.Ctx, c.proc, node, param)
+	}
 	switch node.ExternScan.Type {
 	case int32(plan.ExternType_EXTERNAL_TB):
 		t := time.Now()
@@ -1611,6 +1617,10 @@ func (c *Compile) getHivePartitionFileList(node *plan.Node, param *tree.ExternPa
 	if err != nil {
 		return nil, nil, err
 	}
+	if options == nil {
+		options = &external.DiscoverOptions{}
+	}
+	options.Format = param.Format
 	result, err := external.DiscoverHivePartitionsWithPruneExpr(
 		c.proc.Ctx, listDir, param.Filepath,
 		param.HivePartitionCols, param.HivePartitionColTypes, pruneExpr, options)
@@ -2494,8 +2504,8 @@ func makeWholeFileOffsets(count int) []*pipeline.FileOffset {
 }
 
 func (c *Compile) compileExternScanParallelReadWrite(node *plan.Node, param *tree.ExternParam, fileList []string, fileSize []int64, strictSqlMode bool) ([]*Scope, error) {
-	if param.Format == tree.PARQUET {
-		return nil, moerr.NewInternalError(c.proc.Ctx, "parquet load cannot use byte-offset parallel read")
+	if param.Format == tree.PARQUET || param.Format == tree.ARROW || param.Format == tree.ORC || param.Format == tree.ICEBERG {
+		return nil, moerr.NewInternalErrorf(c.proc.Ctx, "%s load cannot use byte-offset parallel read", param.Format)
 	}
 	visibleCols := make([]*plan.ColDef, 0)
 	if param.Strict {
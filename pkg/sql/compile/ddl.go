@@ -59,6 +59,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/stage/stageutil"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
@@ -1203,6 +1204,13 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 		reqs = append([]*api.AlterTableReq{
 			api.NewReplaceDefReq(did, tid, qry.GetCopyTableDef()),
 		}, reqs...)
+
+		// the codecs live in the schema extra, which ReplaceDef leaves as is
+		newCodecs := catalog.GenColumnCompressFromPlanCols(qry.GetCopyTableDef().GetCols())
+		oldCodecs := catalog.GenColumnCompressFromPlanCols(qry.GetTableDef().GetCols())
+		if !catalog.EqualColumnCompress(newCodecs, oldCodecs) {
+			reqs = append(reqs, api.NewUpdateColumnCompressReq(did, tid, newCodecs))
+		}
 	}
 
 	if hasUpdateConstraints {
@@ -1870,6 +1878,11 @@ func (s *Scope) CreateTable(c *Compile) error {
 			}
 		}
 
+		// create iscp jobs for async generated columns
+		err = CreateAllGeneratedColCdcTasks(c, dbName, tblName, newRelation.GetTableID(c.proc.Ctx), false, qry.GetTableDef())
+		if err != nil {
+			return err
+		}
 	}
 
 	if c.keepAutoIncrement == 0 {
@@ -3167,6 +3180,12 @@ func (s *Scope) dropTableSingle(c *Compile, qry *plan.DropTable) error {
 		return err
 	}
 
+	// delete cdc task of the async generated columns
+	err = DropAllGeneratedColCdcTasks(c, rel.GetTableDef(c.proc.Ctx), qry.Database, qry.Table)
+	if err != nil {
+		return err
+	}
+
 	// unregister index update by Table Id
 	err = DropAllIndexUpdateTasks(c, rel.GetTableDef(c.proc.Ctx), qry.Database, qry.Table)
 	if err != nil {
@@ -5308,7 +5327,7 @@ func (opts *CDCCreateTaskOptions) ValidateAndFill(
 
 	// 1. Converts sink type to lowercase
 	// 2. Enables console sink if configured and requested
-	// 3. For non-console sinks, validates that only mysql or matrixone sinks are used
+	// 3. For non-console sinks, validates that only mysql, matrixone, kafka, stage or postgres sinks are used
 	// 4. Returns error for unsupported sink types
 	// target field: SinkType, UseConsole
 	{
@@ -5316,21 +5335,53 @@ func (opts *CDCCreateTaskOptions) ValidateAndFill(
 		if cdc.EnableConsoleSink && opts.SinkType == cdc.CDCSinkType_Console {
 			opts.UseConsole = true
 		}
-		if !opts.UseConsole && opts.SinkType != cdc.CDCSinkType_MySQL && opts.SinkType != cdc.CDCSinkType_MO {
+		if !opts.UseConsole && opts.SinkType != cdc.CDCSinkType_MySQL && opts.SinkType != cdc.CDCSinkType_MO &&
+			opts.SinkType != cdc.CDCSinkType_Kafka && opts.SinkType != cdc.CDCSinkType_Stage &&
+			opts.SinkType != cdc.CDCSinkType_Postgres {
 			err = moerr.NewInternalErrorf(ctx, "unsupported sink type: %s", planCDC.SinkType)
 			return
 		}
 
-		if opts.SinkUri, opts.SinkUriInfo, err = cdc.ExtractUriInfo(
-			ctx, planCDC.SinkUri, cdc.CDCSinkUriPrefix,
-		); err != nil {
-			return
-		}
-		if _, err = cdc.OpenDbConn(
-			opts.SinkUriInfo.User, opts.SinkUriInfo.Password, opts.SinkUriInfo.Ip, opts.SinkUriInfo.Port, cdc.CDCDefaultSendSqlTimeout,
-		); err != nil {
-			err = moerr.NewInternalErrorf(ctx, "failed to connect to sink, please check the connection, err: %v", err)
-			return
+		if opts.SinkType == cdc.CDCSinkType_Kafka {
+			if opts.SinkUri, opts.SinkUriInfo, err = cdc.ExtractKafkaUriInfo(ctx, planCDC.SinkUri); err != nil {
+				return
+			}
+			if err = cdc.CheckKafkaSink(ctx, &opts.SinkUriInfo); err != nil {
+				err = moerr.NewInternalErrorf(ctx, "failed to connect to sink, please check the connection, err: %v", err)
+				return
+			}
+		} else if opts.SinkType == cdc.CDCSinkType_Stage {
+			if opts.SinkUri, opts.SinkUriInfo, err = cdc.ExtractStageUriInfo(ctx, planCDC.SinkUri); err != nil {
+				return
+			}
+			// the sinkers write to the path resolved with the credentials of the stage
+			if opts.SinkUriInfo.StagePath, _, err = stageutil.UrlToPath(opts.SinkUriInfo.Stage, c.proc); err != nil {
+				return
+			}
+			if err = cdc.CheckStageSink(ctx, &opts.SinkUriInfo); err != nil {
+				err = moerr.NewInternalErrorf(ctx, "failed to access the sink stage, err: %v", err)
+				return
+			}
+		} else if opts.SinkType == cdc.CDCSinkType_Postgres {
+			if opts.SinkUri, opts.SinkUriInfo, err = cdc.ExtractPostgresUriInfo(ctx, planCDC.SinkUri); err != nil {
+				return
+			}
+			if err = cdc.CheckPostgresSink(ctx, &opts.SinkUriInfo); err != nil {
+				err = moerr.NewInternalErrorf(ctx, "failed to connect to sink, please check the connection, err: %v", err)
+				return
+			}
+		} else {
+			if opts.SinkUri, opts.SinkUriInfo, err = cdc.ExtractUriInfo(
+				ctx, planCDC.SinkUri, cdc.CDCSinkUriPrefix,
+			); err != nil {
+				return
+			}
+			if _, err = cdc.OpenDbConn(
+				opts.SinkUriInfo.User, opts.SinkUriInfo.Password, opts.SinkUriInfo.Ip, opts.SinkUriInfo.Port, cdc.CDCDefaultSendSqlTimeout,
+			); err != nil {
+				err = moerr.NewInternalErrorf(ctx, "failed to connect to sink, please check the connection, err: %v", err)
+				return
+			}
 		}
 	}
 
@@ -5405,6 +5456,23 @@ func (opts *CDCCreateTaskOptions) ValidateAndFill(
 				}
 			}
 			extraOpts[cdc.CDCTaskExtraOptions_Frequency] = value
+		case cdc.CDCRequestOptions_DDLPolicy:
+			if value != "" {
+				value = strings.ToLower(value)
+				if value != cdc.CDCDDLPolicy_Propagate && value != cdc.CDCDDLPolicy_Pause && value != cdc.CDCDDLPolicy_Ignore {
+					err = moerr.NewInternalErrorf(ctx, "invalid ddlPolicy: %s, supported: %s, %s, %s",
+						value, cdc.CDCDDLPolicy_Propagate, cdc.CDCDDLPolicy_Pause, cdc.CDCDDLPolicy_Ignore)
+					return
+				}
+				extraOpts[cdc.CDCTaskExtraOptions_DDLPolicy] = value
+			}
+		case cdc.CDCRequestOptions_Transforms:
+			if value != "" {
+				if _, err = cdc.ParseTransforms(ctx, value); err != nil {
+					return
+				}
+				extraOpts[cdc.CDCTaskExtraOptions_Transforms] = value
+			}
 		}
 	}
 
@@ -5423,6 +5491,9 @@ func (opts *CDCCreateTaskOptions) ValidateAndFill(
 	if _, ok := extraOpts[cdc.CDCTaskExtraOptions_MaxSqlLength]; !ok {
 		extraOpts[cdc.CDCTaskExtraOptions_MaxSqlLength] = cdc.CDCDefaultTaskExtra_MaxSQLLen
 	}
+	if _, ok := extraOpts[cdc.CDCTaskExtraOptions_DDLPolicy]; !ok {
+		extraOpts[cdc.CDCTaskExtraOptions_DDLPolicy] = cdc.CDCDefaultTaskExtra_DDLPolicy
+	}
 
 	var extraOptsBytes []byte
 	if extraOptsBytes, err = json.Marshal(extraOpts); err != nil {
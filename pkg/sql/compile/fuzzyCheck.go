@@ -523,6 +523,8 @@ func vectorToString(vec *vector.Vector, rowIndex int) (string, error) {
 		return types.ArrayToString[float32](vector.GetArrayAt[float32](vec, rowIndex)), nil
 	case types.T_array_float64:
 		return types.ArrayToString[float64](vector.GetArrayAt[float64](vec, rowIndex)), nil
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return types.QuantizedArrayBytesToString(vec.GetType().Oid, vec.GetBytesAt(rowIndex)), nil
 	case types.T_decimal64:
 		val := vector.GetFixedAtNoTypeCheck[types.Decimal64](vec, rowIndex)
 		return val.Format(vec.GetType().Scale), nil
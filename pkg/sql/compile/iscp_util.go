@@ -254,6 +254,53 @@ func CreateAllIndexCdcTasks(c *Compile, indexes []*plan.IndexDef, dbname string,
 	return nil
 }
 
+// CreateAllGeneratedColCdcTasks registers the ISCP job that fills each async
+// generated column of tableDef.
+func CreateAllGeneratedColCdcTasks(c *Compile, dbname string, tablename string, tableid uint64, startFromNow bool, tableDef *plan.TableDef) error {
+	for _, col := range tableDef.Cols {
+		if col.GeneratedCol == nil || !col.GeneratedCol.IsAsync {
+			continue
+		}
+
+		// same as index cdc tasks, CCPR tables get their data from upstream
+		if isTableFromPublication(tableDef) || isTableInCCPR(c, tableid) {
+			logutil.Infof("skip creating generated column cdc task for CCPR table (%s, %s, %s)", dbname, tablename, col.Name)
+			return nil
+		}
+
+		spec := &iscp.JobSpec{
+			ConsumerInfo: iscp.ConsumerInfo{ConsumerType: int8(iscp.ConsumerType_GeneratedCol),
+				DBName:    dbname,
+				TableName: tablename,
+				Columns:   []string{col.Name}},
+		}
+		job := &iscp.JobID{DBName: dbname, TableName: tablename, JobName: iscp.GenColJobName(col.Name)}
+		ok, err := CreateCdcTask(c, spec, job, startFromNow)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			logutil.Infof("generated column cdc task (%s, %s, %s) already exists", dbname, tablename, col.Name)
+		}
+	}
+	return nil
+}
+
+// DropAllGeneratedColCdcTasks unregisters the ISCP jobs of the async
+// generated columns of tabledef.
+func DropAllGeneratedColCdcTasks(c *Compile, tabledef *plan.TableDef, dbname string, tablename string) error {
+	for _, col := range tabledef.Cols {
+		if col.GeneratedCol == nil || !col.GeneratedCol.IsAsync {
+			continue
+		}
+		_, err := DeleteCdcTask(c, &iscp.JobID{DBName: dbname, TableName: tablename, JobName: iscp.GenColJobName(col.Name)})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func checkValidIndexUpdateByIndexdef(idx *plan.IndexDef) (bool, error) {
 	if !idx.TableExist {
 		return false, nil
@@ -1406,25 +1406,8 @@ func constructWindow(_ context.Context, node *plan.Node, proc *process.Process)
 		functionID := int64(uint64(f.F.Func.Obj) & function.DistinctMask)
 
 		var e *plan.Expr = nil
-		var cfg []byte = nil
-		var args = f.F.Args
+		args, cfg := aggArgsAndConfig(proc, f.F)
 		if len(f.F.Args) > 0 {
-
-			//for group_concat, the last arg is separator string
-			//for cluster_centers, the last arg is kmeans_args string
-			if (f.F.Func.ObjName == plan2.NameGroupConcat ||
-				f.F.Func.ObjName == plan2.NameClusterCenters) && len(f.F.Args) > 1 {
-				argExpr := f.F.Args[len(f.F.Args)-1]
-				vec, free, err := colexec.GetReadonlyResultFromNoColumnExpression(proc, argExpr)
-				if err != nil {
-					panic(err)
-				}
-				cfg = []byte(vec.GetStringAt(0))
-				free()
-
-				args = f.F.Args[:len(f.F.Args)-1]
-			}
-
 			e = f.F.Args[0]
 		}
 		aggregationExpressions[i] = aggexec.MakeAggFunctionExpression(
@@ -1441,6 +1424,69 @@ func constructWindow(_ context.Context, node *plan.Node, proc *process.Process)
 	return arg
 }
 
+// aggArgsAndConfig splits the constant arguments of an aggregation off into
+// the config passed to its executor.
+func aggArgsAndConfig(proc *process.Process, f *plan.Function) ([]*plan.Expr, []byte) {
+	args := f.Args
+	switch f.Func.ObjName {
+	case plan2.NameGroupConcat, plan2.NameClusterCenters:
+		//for group_concat, the last arg is separator string
+		//for cluster_centers, the last arg is kmeans_args string
+		if len(args) > 1 {
+			vec, free, err := colexec.GetReadonlyResultFromNoColumnExpression(proc, args[len(args)-1])
+			if err != nil {
+				panic(err)
+			}
+			cfg := []byte(vec.GetStringAt(0))
+			free()
+			return args[:len(args)-1], cfg
+		}
+
+	case plan2.NamePercentileCont, plan2.NamePercentileDisc, plan2.NameApproxPercentile:
+		//the args are (x, percentile value[, desc])
+		if len(args) > 1 {
+			vec, free, err := colexec.GetReadonlyResultFromNoColumnExpression(proc, args[1])
+			if err != nil {
+				panic(err)
+			}
+			// a null percentile value is rejected by the executor.
+			fraction := math.NaN()
+			if !vec.IsNull(0) {
+				fraction = vector.GetFixedAtNoTypeCheck[float64](vec, 0)
+			}
+			free()
+
+			desc := false
+			if len(args) > 2 {
+				vec, free, err = colexec.GetReadonlyResultFromNoColumnExpression(proc, args[2])
+				if err != nil {
+					panic(err)
+				}
+				desc = !vec.IsNull(0) && vector.GetFixedAtNoTypeCheck[bool](vec, 0)
+				free()
+			}
+			return args[:1], aggexec.EncodePercentileConfig(fraction, desc)
+		}
+
+	case plan2.NameApproxTopK:
+		//the args are (x, k)
+		if len(args) > 1 {
+			vec, free, err := colexec.GetReadonlyResultFromNoColumnExpression(proc, args[1])
+			if err != nil {
+				panic(err)
+			}
+			// a null k is rejected by the executor.
+			var k int64
+			if !vec.IsNull(0) {
+				k = vector.GetFixedAtNoTypeCheck[int64](vec, 0)
+			}
+			free()
+			return args[:1], aggexec.EncodeTopKConfig(k)
+		}
+	}
+	return args, nil
+}
+
 func constructOffset(node *plan.Node) *offset.Offset {
 	arg := offset.NewArgument().WithOffset(node.Offset)
 	return arg
@@ -1468,25 +1514,7 @@ func constructGroup(_ context.Context, node, childNode *plan.Node, needEval bool
 			isDistinct := (uint64(f.F.Func.Obj) & function.Distinct) != 0
 			functionID := int64(uint64(f.F.Func.Obj) & function.DistinctMask)
 
-			var cfg []byte = nil
-			var args = f.F.Args
-			if len(f.F.Args) > 0 {
-				//for group_concat, the last arg is separator string
-				//for cluster_centers, the last arg is kmeans_args string
-				if (f.F.Func.ObjName == plan2.NameGroupConcat ||
-					f.F.Func.ObjName == plan2.NameClusterCenters) && len(f.F.Args) > 1 {
-					argExpr := f.F.Args[len(f.F.Args)-1]
-					vec, free, err := colexec.GetReadonlyResultFromNoColumnExpression(proc, argExpr)
-					if err != nil {
-						panic(err)
-					}
-					cfg = []byte(vec.GetStringAt(0))
-					free()
-
-					args = f.F.Args[:len(f.F.Args)-1]
-				}
-			}
-
+			args, cfg := aggArgsAndConfig(proc, f.F)
 			aggregationExpressions[i] = aggexec.MakeAggFunctionExpression(
 				functionID, isDistinct, args, cfg)
 		}
@@ -18,6 +18,7 @@ package crt
 
 import (
 	"archive/tar"
+	"bufio"
 	"bytes"
 	"compress/bzip2"
 	"compress/flate"
@@ -28,6 +29,8 @@ import (
 	"math"
 	"strings"
 
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -119,11 +122,88 @@ func GetCompressType(compressType string, filepath string) string {
 		return tree.BZIP2
 	case strings.HasSuffix(filepath, ".lz4"):
 		return tree.LZ4
+	case strings.HasSuffix(filepath, ".zst") || strings.HasSuffix(filepath, ".zstd"):
+		return tree.ZSTD
+	case strings.HasSuffix(filepath, ".sz") || strings.HasSuffix(filepath, ".snappy"):
+		return tree.SNAPPY
 	default:
 		return tree.NOCOMPRESS
 	}
 }
 
+var compressMagics = []struct {
+	compType string
+	magic    []byte
+}{
+	{tree.GZIP, []byte{0x1f, 0x8b}},
+	{tree.ZSTD, []byte{0x28, 0xb5, 0x2f, 0xfd}},
+	{tree.SNAPPY, []byte{0xff, 0x06, 0x00, 0x00, 's', 'N', 'a', 'P', 'p', 'Y'}},
+	{tree.LZ4, []byte{0x04, 0x22, 0x4d, 0x18}},
+}
+
+var (
+	bzip2BlockMagic = []byte{0x31, 0x41, 0x59, 0x26, 0x53, 0x59}
+	bzip2EOSMagic   = []byte{0x17, 0x72, 0x45, 0x38, 0x50, 0x90}
+)
+
+// isBzip2Head reports whether head starts a bzip2 stream. "BZh" alone is
+// too common at the start of a text file, so the block size digit and the
+// magic of the first block (or of the end of an empty stream) are required
+// too.
+func isBzip2Head(head []byte) bool {
+	if len(head) < 10 || !bytes.HasPrefix(head, []byte("BZh")) || head[3] < '1' || head[3] > '9' {
+		return false
+	}
+	return bytes.Equal(head[4:10], bzip2BlockMagic) || bytes.Equal(head[4:10], bzip2EOSMagic)
+}
+
+// sniffCompressType detects the compress type from the leading bytes of a
+// file whose suffix says nothing about it, e.g. an export named "a.csv"
+// that was written with COMPRESSION 'zstd'.
+func sniffCompressType(r io.ReadCloser) (string, io.ReadCloser) {
+	br := bufio.NewReader(r)
+	head, _ := br.Peek(10)
+	rc := struct {
+		io.Reader
+		io.Closer
+	}{br, r}
+	for _, m := range compressMagics {
+		if bytes.HasPrefix(head, m.magic) {
+			return m.compType, rc
+		}
+	}
+	if isBzip2Head(head) {
+		return tree.BZIP2, rc
+	}
+	return tree.NOCOMPRESS, rc
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// GetCompressWriter wraps w with a writer producing compType compressed data.
+// Closing the returned writer flushes the compressed stream, but does not
+// close w.
+func GetCompressWriter(ctx context.Context, compType string, w io.Writer) (io.WriteCloser, error) {
+	switch strings.ToLower(compType) {
+	case "", tree.NOCOMPRESS:
+		return nopWriteCloser{w}, nil
+	case tree.GZIP, tree.GZ:
+		return gzip.NewWriter(w), nil
+	case tree.ZSTD, tree.ZST:
+		return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+	case tree.SNAPPY:
+		return snappy.NewBufferedWriter(w), nil
+	case tree.LZ4:
+		return lz4.NewWriter(w), nil
+	default:
+		return nil, moerr.NewNotSupportedf(ctx, "the compress type '%s' for writing", compType)
+	}
+}
+
 func getTarReader(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
 	tarReader := tar.NewReader(r)
 	// move to first file
@@ -143,7 +223,11 @@ func getTarReader(ctx context.Context, r io.Reader) (io.ReadCloser, error) {
 }
 
 func getUnCompressReader(ctx context.Context, compType string, filepath string, r io.ReadCloser) (io.ReadCloser, error) {
-	switch strings.ToLower(GetCompressType(compType, filepath)) {
+	typ := strings.ToLower(GetCompressType(compType, filepath))
+	if typ == tree.NOCOMPRESS && (compType == "" || compType == tree.AUTO) && r != nil {
+		typ, r = sniffCompressType(r)
+	}
+	switch typ {
 	case tree.NOCOMPRESS:
 		return r, nil
 	case tree.GZIP, tree.GZ:
@@ -156,6 +240,14 @@ func getUnCompressReader(ctx context.Context, compType string, filepath string,
 		return zlib.NewReader(r)
 	case tree.LZ4:
 		return io.NopCloser(lz4.NewReader(r)), nil
+	case tree.ZSTD, tree.ZST:
+		zr, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return zr.IOReadCloser(), nil
+	case tree.SNAPPY:
+		return io.NopCloser(snappy.NewReader(r)), nil
 	case tree.LZW:
 		return nil, moerr.NewInternalErrorf(ctx, "the compress type '%s' is not support now", compType)
 	case tree.TAR_GZ:
@@ -15,12 +15,15 @@
 package crt
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"os"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_getCompressType(t *testing.T) {
@@ -49,6 +52,14 @@ func Test_getCompressType(t *testing.T) {
 		compress = GetCompressType(param.CompressType, param.Filepath)
 		convey.So(compress, convey.ShouldEqual, tree.LZ4)
 
+		param.Filepath = "a.csv.zst"
+		compress = GetCompressType(param.CompressType, param.Filepath)
+		convey.So(compress, convey.ShouldEqual, tree.ZSTD)
+
+		param.Filepath = "a.jsonl.snappy"
+		compress = GetCompressType(param.CompressType, param.Filepath)
+		convey.So(compress, convey.ShouldEqual, tree.SNAPPY)
+
 		param.Filepath = "a.csv"
 		compress = GetCompressType(param.CompressType, param.Filepath)
 		convey.So(compress, convey.ShouldEqual, tree.NOCOMPRESS)
@@ -99,3 +110,77 @@ func Test_getUnCompressReader(t *testing.T) {
 		convey.So(err, convey.ShouldNotBeNil)
 	})
 }
+
+func TestCompressRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("1,abc,2024-01-01 00:00:00\n"), 1000)
+	for _, compType := range []string{tree.GZIP, tree.ZSTD, tree.SNAPPY, tree.LZ4} {
+		var buf bytes.Buffer
+		w, err := GetCompressWriter(ctx, compType, &buf)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		require.Less(t, buf.Len(), len(data))
+
+		// explicit type, file suffix and magic bytes all decode the same stream
+		for _, c := range []struct {
+			compType string
+			filepath string
+		}{
+			{compType, "a.csv"},
+			{tree.AUTO, "a.csv." + compType},
+			{tree.AUTO, "a.csv"},
+			{"", "a"},
+		} {
+			r, err := getUnCompressReader(ctx, c.compType, c.filepath, io.NopCloser(bytes.NewReader(buf.Bytes())))
+			require.NoError(t, err)
+			got, err := io.ReadAll(r)
+			require.NoError(t, err, "%s %s", c.compType, c.filepath)
+			require.Equal(t, data, got)
+			require.NoError(t, r.Close())
+		}
+	}
+
+	r, err := getUnCompressReader(ctx, tree.AUTO, "a.csv", io.NopCloser(bytes.NewReader(data)))
+	require.NoError(t, err)
+	got, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+
+	_, err = GetCompressWriter(ctx, tree.BZIP2, io.Discard)
+	require.Error(t, err)
+}
+
+func TestSniffBzip2(t *testing.T) {
+	// a bzip2 stream of "hello\n"
+	stream := []byte{
+		0x42, 0x5a, 0x68, 0x39, 0x31, 0x41, 0x59, 0x26, 0x53, 0x59, 0xc1, 0xc0,
+		0x80, 0xe2, 0x00, 0x00, 0x01, 0x41, 0x00, 0x00, 0x10, 0x02, 0x44, 0xa0,
+		0x00, 0x30, 0xcd, 0x00, 0xc3, 0x46, 0x29, 0x97, 0x17, 0x72, 0x45, 0x38,
+		0x50, 0x90, 0xc1, 0xc0, 0x80, 0xe2,
+	}
+	empty := []byte{0x42, 0x5a, 0x68, 0x39, 0x17, 0x72, 0x45, 0x38, 0x50, 0x90, 0x00, 0x00, 0x00, 0x00}
+	for _, c := range []struct {
+		head     []byte
+		compType string
+	}{
+		{stream, tree.BZIP2},
+		{empty, tree.BZIP2},
+		{[]byte("BZh,1,2\nBZh,3,4\n"), tree.NOCOMPRESS},
+		{[]byte("BZh9 is not a stream"), tree.NOCOMPRESS},
+		{[]byte("BZh"), tree.NOCOMPRESS},
+	} {
+		typ, r := sniffCompressType(io.NopCloser(bytes.NewReader(c.head)))
+		require.Equal(t, c.compType, typ, "%q", c.head)
+		got, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, c.head, got)
+	}
+
+	r, err := getUnCompressReader(context.Background(), tree.AUTO, "a.csv", io.NopCloser(bytes.NewReader(stream)))
+	require.NoError(t, err)
+	got, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "hello\n", string(got))
+}
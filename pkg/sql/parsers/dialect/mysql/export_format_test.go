@@ -72,6 +72,15 @@ func TestExportFormat(t *testing.T) {
 			expectedSplit:  0,
 		},
 
+		// FORMAT 'arrow'
+		{
+			name:           "outfile with format arrow",
+			input:          "select * from t1 into outfile 'output.arrow' format 'Arrow' compression 'lz4'",
+			output:         "select * from t1 into outfile output.arrow format arrow compression lz4 header true",
+			expectedFormat: "arrow",
+			expectedSplit:  0,
+		},
+
 		// FORMAT case insensitive
 		{
 			name:           "outfile with format CSV uppercase",
@@ -157,6 +166,36 @@ func TestExportFormat(t *testing.T) {
 			expectedSplit:  10 * 1024 * 1024 * 1024,
 		},
 
+		// COMPRESSION cases
+		{
+			name:           "outfile with compression gzip",
+			input:          "select * from t1 into outfile 'output.csv.gz' format 'csv' compression 'gzip'",
+			output:         "select * from t1 into outfile output.csv.gz format csv compression gzip fields terminated by , enclosed by \" lines terminated by \n header true",
+			expectedFormat: "csv",
+			expectedSplit:  0,
+		},
+		{
+			name:           "outfile with compression alias",
+			input:          "select * from t1 into outfile 'output.jsonl.zst' format 'jsonline' compression 'ZST' splitsize '1M'",
+			output:         "select * from t1 into outfile output.jsonl.zst format jsonline compression zstd splitsize 1048576 header true",
+			expectedFormat: "jsonline",
+			expectedSplit:  1024 * 1024,
+		},
+		{
+			name:           "outfile with parquet codec",
+			input:          "select * from t1 into outfile 'output.parquet' format 'parquet' compression 'brotli'",
+			output:         "select * from t1 into outfile output.parquet format parquet compression brotli header true",
+			expectedFormat: "parquet",
+			expectedSplit:  0,
+		},
+		{
+			name:           "outfile with compression only",
+			input:          "select * from t1 into outfile 'output.csv.sz' compression 'snappy'",
+			output:         "select * from t1 into outfile output.csv.sz compression snappy fields terminated by , enclosed by \" lines terminated by \n header true",
+			expectedFormat: "",
+			expectedSplit:  0,
+		},
+
 		// Invalid format should fail
 		{
 			name:       "invalid format",
@@ -220,6 +259,10 @@ func TestExportFormatInvalidCases(t *testing.T) {
 			name:  "invalid format value avro",
 			input: "select * from t1 into outfile 'output.avro' format 'avro'",
 		},
+		{
+			name:  "invalid compression value",
+			input: "select * from t1 into outfile 'output.csv' format 'csv' compression 'rar'",
+		},
 		{
 			name:  "invalid splitsize format",
 			input: "select * from t1 into outfile 'output.csv' format 'csv' splitsize 'abc'",
@@ -553,6 +553,7 @@ func init() {
 		"where":                      WHERE,
 		"while":                      WHILE,
 		"with":                       WITH,
+		"within":                     WITHIN,
 		"without":                    WITHOUT,
 		"validation":                 VALIDATION,
 		"write":                      WRITE,
@@ -688,6 +689,9 @@ func init() {
 		"array":                      ARRAY,
 		"vecf32":                     VECF32,
 		"vecf64":                     VECF64,
+		"vecf16":                     VECF16,
+		"veci8":                      VECI8,
+		"vecbit":                     VECBIT,
 		"backup":                     BACKUP,
 		"filesystem":                 FILESYSTEM,
 		"handler":                    HANDLER,
@@ -345,6 +345,7 @@ func sqlTaskInt64(v any) int64 {
 %left <str> ')'
 %nonassoc LOWER_THAN_STRING
 %nonassoc <str> ID AT_ID AT_AT_ID STRING VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD QUOTE_ID STAGE CREDENTIALS STAGES SNAPSHOTS
+%nonassoc <str> WITHIN
 %token <item> INTEGRAL HEX FLOAT
 %token <str>  HEXNUM BIT_LITERAL
 %token <str> NULL TRUE FALSE
@@ -390,7 +391,7 @@ func sqlTaskInt64(v any) int64 {
 %token <str> TIME TIMESTAMP DATETIME YEAR
 %token <str> CHAR VARCHAR BOOL CHARACTER VARBINARY NCHAR
 %token <str> TEXT TINYTEXT MEDIUMTEXT LONGTEXT DATALINK
-%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID VECF32 VECF64
+%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID VECF32 VECF64 VECF16 VECI8 VECBIT
 %token <str> GEOMETRY POINT LINESTRING POLYGON GEOMETRYCOLLECTION MULTIPOINT MULTILINESTRING MULTIPOLYGON
 %token <str> GEOMETRY32 GEOGRAPHY GEOGRAPHY32 POINT32 LINESTRING32 POLYGON32 GEOMETRYCOLLECTION32 MULTIPOINT32 MULTILINESTRING32 MULTIPOLYGON32
 %token <str> INT1 INT2 INT3 INT4 INT8 S3OPTION STAGEOPTION
@@ -844,7 +845,7 @@ func sqlTaskInt64(v any) int64 {
 %type <fieldsList> field_item_list
 %type <str> field_terminator starting_opt lines_terminated_opt starting lines_terminated
 %type <lines> load_lines export_lines_opt
-%type <str> export_format_opt
+%type <str> export_format_opt export_compression_opt
 %type <uint64Val> export_splitsize_opt
 %type <int64Val> ignore_lines
 %type <varExpr> user_variable variable system_variable
@@ -5696,18 +5697,19 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_format_opt export_splitsize_opt export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING export_format_opt export_compression_opt export_splitsize_opt export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
     {
         $$ = &tree.ExportParam{
             Outfile:      true,
             FilePath:     $3,
             ExportFormat: $4,
-            SplitSize:    $5,
-            Fields:       $6,
-            Lines:        $7,
-            Header:       $8,
-            MaxFileSize:  uint64($9)*1024,
-            ForceQuote:   $10,
+            Compression:  $5,
+            SplitSize:    $6,
+            Fields:       $7,
+            Lines:        $8,
+            Header:       $9,
+            MaxFileSize:  uint64($10)*1024,
+            ForceQuote:   $11,
         }
     }
 
@@ -5718,8 +5720,28 @@ export_format_opt:
 |   FORMAT STRING
     {
         str := strings.ToLower($2)
-        if str != "csv" && str != "jsonline" && str != "parquet" {
-            yylex.Error("invalid format, must be csv, jsonline or parquet")
+        if str != "csv" && str != "jsonline" && str != "parquet" && str != "arrow" {
+            yylex.Error("invalid format, must be csv, jsonline, parquet or arrow")
+            goto ret1
+        }
+        $$ = str
+    }
+
+export_compression_opt:
+    {
+        $$ = ""
+    }
+|   COMPRESSION STRING
+    {
+        str := strings.ToLower($2)
+        switch str {
+        case tree.GZ:
+            str = tree.GZIP
+        case tree.ZST:
+            str = tree.ZSTD
+        case tree.NOCOMPRESS, tree.GZIP, tree.ZSTD, tree.SNAPPY, tree.BROTLI, tree.LZ4:
+        default:
+            yylex.Error("invalid compression, must be none, gzip, zstd, snappy, brotli or lz4")
             goto ret1
         }
         $$ = str
@@ -9117,6 +9139,20 @@ create_table_stmt:
         t.Param = $9
         $$ = t
     }
+|   CREATE EXTERNAL TABLE not_exists_opt table_name '(' table_elem_list_opt ')' ENGINE equal_opt table_alias load_param_opt_2
+    {
+        if !strings.EqualFold($11, tree.ICEBERG) {
+            yylex.Error("unsupported external table engine")
+            goto ret1
+        }
+        t := tree.NewCreateTable()
+        t.IfNotExists = $4
+        t.Table = *$5
+        t.Defs = $7
+        t.Param = $12
+        t.Param.Format = tree.ICEBERG
+        $$ = t
+    }
 |   CREATE CLUSTER TABLE not_exists_opt table_name '(' table_elem_list_opt ')' table_option_list_opt partition_by_opt cluster_by_opt
     {
         t := tree.NewCreateTable()
@@ -10666,6 +10702,10 @@ column_attribute_elem:
     {
         $$ = tree.NewAttributeStorage($2)
     }
+|   COMPRESSION STRING
+    {
+        $$ = tree.NewAttributeCompression($2)
+    }
 |   AUTO_RANDOM field_length_opt
     {
         $$ = tree.NewAttributeAutoRandom(int($2))
@@ -10737,10 +10777,18 @@ column_attribute_elem:
     {
         $$ = tree.NewAttributeGeneratedAlways($5, $7)
     }
+|   GENERATED ALWAYS AS '(' expression ')' STORED ASYNC
+    {
+        $$ = tree.NewAttributeGeneratedAlwaysAsync($5)
+    }
 |   AS '(' expression ')' generated_column_type_opt
     {
         $$ = tree.NewAttributeGeneratedAlways($3, $5)
     }
+|   AS '(' expression ')' STORED ASYNC
+    {
+        $$ = tree.NewAttributeGeneratedAlwaysAsync($3)
+    }
 
 generated_column_type_opt:
     {
@@ -11857,6 +11905,16 @@ function_call_aggregate:
             WindowSpec: $5,
         }
     }
+|   MODE '(' expression ')' window_spec_opt
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            WindowSpec: $5,
+        }
+    }
 |   BIT_AND '(' func_type_opt expression ')' window_spec_opt
     {
         name := tree.NewUnresolvedColName($1)
@@ -12055,6 +12113,27 @@ function_call_generic:
             Exprs: $3,
         }
     }
+|   ID '(' expression_list_opt ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|   ID '(' expression_list_opt ')' WITHIN GROUP '(' order_by_clause ')' window_spec_opt
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WithinGroup: $8,
+            WindowSpec: $10,
+        }
+    }
 |   INTERVAL '(' bit_expr ',' expression_list ')'
     {
         name := tree.NewUnresolvedColName($1)
@@ -13660,6 +13739,45 @@ char_type:
             },
         }
     }
+|   VECF16 length_option_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.ArrayFamily,
+                Locale: &locale,
+                FamilyString: $1,
+                DisplayWith: $2,
+                Oid:uint32(defines.MYSQL_TYPE_VARCHAR),
+            },
+        }
+    }
+|   VECI8 length_option_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.ArrayFamily,
+                Locale: &locale,
+                FamilyString: $1,
+                DisplayWith: $2,
+                Oid:uint32(defines.MYSQL_TYPE_VARCHAR),
+            },
+        }
+    }
+|   VECBIT length_option_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.ArrayFamily,
+                Locale: &locale,
+                FamilyString: $1,
+                DisplayWith: $2,
+                Oid:uint32(defines.MYSQL_TYPE_VARCHAR),
+            },
+        }
+    }
 | ENUM '(' enum_values ')'
     {
         locale := ""
@@ -14178,6 +14296,9 @@ non_reserved_keyword:
 |   JSON
 |   VECF32
 |   VECF64
+|   VECF16
+|   VECI8
+|   VECBIT
 |   KEY_BLOCK_SIZE
 |   LISTS
 |   OP_TYPE
@@ -14487,6 +14608,7 @@ non_reserved_keyword:
 |   FIRST_VALUE
 |   LAST_VALUE
 |   NTH_VALUE
+|   WITHIN
 
 func_not_keyword:
     DATE_ADD
@@ -309,6 +309,12 @@ var (
 	}{{
 		input:  "select period from t1",
 		output: "select period from t1",
+	}, {
+		input:  "create table t1 (a int primary key, b text, c vecf32(3) as (llm_embedding('ollama', '', 'm', null, b)) stored async)",
+		output: "create table t1 (a int primary key, b text, c vecf32(3) generated always as (llm_embedding(ollama, , m, null, b)) stored async)",
+	}, {
+		input:  "create table t1 (a int, b int generated always as (a + 1) stored)",
+		output: "create table t1 (a int, b int generated always as (a + 1) stored)",
 	}, {
 		input:  "create account 0b6d35cc_11ab_4da5_a5c5_c4c09917c11 admin_name='admin' identified by '123456';",
 		output: "create account 0b6d35cc_11ab_4da5_a5c5_c4c09917c11 admin_name 'admin' identified by '******'",
@@ -437,6 +443,22 @@ var (
 	}, {
 		input:  "select rank() over(partition by a order by b desc) from t1",
 		output: "select rank() over (partition by a order by b desc) from t1",
+	}, {
+		input:  "select percentile_cont(0.5) within group (order by a) from t1 group by b",
+		output: "select percentile_cont(0.5) within group (order by a) from t1 group by b",
+	}, {
+		input:  "select percentile_disc(0.9) WITHIN GROUP (ORDER BY a DESC) over (partition by b) from t1",
+		output: "select percentile_disc(0.9) within group (order by a desc) over (partition by b) from t1",
+	}, {
+		input:  "select a within from t1",
+		output: "select a as within from t1",
+	}, {
+		input:  "select mode(a), mode(b) over (partition by c) from t1",
+		output: "select mode(a), mode(b) over (partition by c) from t1",
+	}, {
+		input: "select mode from t1",
+	}, {
+		input: "select min_by(a, b), max_by(a, b), approx_top_k(a, 10) from t1 group by c",
 	}, {
 		input:  "load data url s3option {\"bucket\"='dan-test1', \"filepath\"='ex_table_dan_gzip.gz',\"role_arn\"='arn:aws:iam::468413122987:role/dev-cross-s3', \"external_id\"='5404f91c_4e59_4898_85b3', \"compression\"='auto'} into table hx3.t2 fields terminated by ',' enclosed by '\\\"' lines terminated by '\\n';\n",
 		output: "load data url s3option {'bucket'='dan-test1', 'filepath'='ex_table_dan_gzip.gz', 'role_arn'='arn:aws:iam::468413122987:role/dev-cross-s3', 'external_id'='5404f91c_4e59_4898_85b3', 'compression'='auto'} into table hx3.t2 fields terminated by , enclosed by \" lines terminated by \n",
@@ -1190,6 +1212,12 @@ var (
 		}, {
 			input:  "create external table t (a int) infile 'data.txt' FIELDS TERMINATED BY '' OPTIONALLY ENCLOSED BY '' LINES TERMINATED BY ''",
 			output: "create external table t (a int) infile 'data.txt' fields terminated by '' optionally enclosed by '' lines terminated by ''",
+		}, {
+			input:  "create external table t (a int) engine = ICEBERG infile 'warehouse/db/t'",
+			output: "create external table t (a int) engine = iceberg infile 'warehouse/db/t'",
+		}, {
+			input:  "create external table t (a int) engine iceberg infile {'filepath'='warehouse/db/t', 'snapshot_id'='123'}",
+			output: "create external table t (a int) engine = iceberg infile {'filepath'='warehouse/db/t', 'snapshot_id'='123'}",
 		}, {
 			input:  "SET NAMES 'utf8mb4' COLLATE 'utf8mb4_general_ci'",
 			output: "set names = utf8mb4 utf8mb4_general_ci",
@@ -1533,6 +1561,9 @@ var (
 			input: "create table a (a int storage disk, b int references b(a asc, b desc) match full on delete cascade on update restrict)",
 		}, {
 			input: "create table a (a int storage disk, b int)",
+		}, {
+			input:  "create table a (a int compression 'zstd(9)', b varchar(10) COMPRESSION 'lz4') compression = 'zstd'",
+			output: "create table a (a int compression 'zstd(9)', b varchar(10) compression 'lz4') compression = zstd",
 		}, {
 			input: "create table a (a int not null default 1 auto_increment unique primary key collate utf8_bin storage disk)",
 		},
@@ -3415,6 +3446,10 @@ var (
 			input:  "create table t1(a vecf32(3), b vecf64(3), c int)",
 			output: "create table t1 (a vecf32(3), b vecf64(3), c int)",
 		},
+		{
+			input:  "create table t1(a vecf16(3), b veci8(3), c vecbit(16))",
+			output: "create table t1 (a vecf16(3), b veci8(3), c vecbit(16))",
+		},
 		{
 			input:  "create table t1 (id bigint primary key, embedding vecf32(3), payload json, tags array(varchar(20)))",
 			output: "create table t1 (id bigint primary key, embedding vecf32(3), payload json, tags array(varchar(20)))",
@@ -4025,6 +4060,9 @@ var (
 	invalidSQL = []struct {
 		input string
 	}{
+		{
+			input: "create external table t (a int) engine = delta infile 'warehouse/db/t'",
+		},
 		{
 			input: "alter table t1 add constraint index (col3, col4)",
 		},
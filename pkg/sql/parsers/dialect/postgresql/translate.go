@@ -0,0 +1,556 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresql
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Translate rewrites the statements of the PostgreSQL dialect into the ones
+// of the MySQL dialect, which MO parses and executes. It only handles the
+// lexical differences of the two dialects:
+//
+//   - the placeholder $n is replaced by ?. The numbers of the placeholders
+//     are returned in the order they appear, as $n may be used many times.
+//   - the quoted identifier "a" is replaced by `a`.
+//   - the backslash in the standard string 'a\b' is escaped. The escape string
+//     E'a\nb' and the dollar-quoted string $$a$$ become the strings of MySQL.
+//   - the cast expr::type is replaced by cast(expr as type).
+//   - the comment -- is removed, as MySQL requires a space after it.
+//
+// The operators that MySQL parses with another meaning are rejected rather
+// than run differently: || (concat in PostgreSQL, at the precedence of OR in
+// MO), ^ (power, xor in MySQL), # (xor, a comment in MySQL) and && (overlap,
+// and in MySQL). So is the cast to a type without a counterpart in MO.
+func Translate(sql string) (string, []int, error) {
+	t := translator{
+		in:           sql,
+		out:          make([]byte, 0, len(sql)+16),
+		operandStart: -1,
+		operandEnd:   -1,
+		identEnd:     -1,
+	}
+	if err := t.translate(); err != nil {
+		return "", nil, err
+	}
+	return string(t.out), t.ordinals, nil
+}
+
+type translator struct {
+	in  string
+	pos int
+	out []byte
+
+	ordinals []int
+
+	// [operandStart, operandEnd) is the last operand in out.
+	// It is the left side of the cast ::.
+	operandStart, operandEnd int
+	// [identStart, identEnd) is the last identifier in out.
+	// It is the function name if a '(' follows it.
+	identStart, identEnd int
+	// the start of the operands ending with the ')'
+	parens []int
+}
+
+func (t *translator) translate() error {
+	for t.pos < len(t.in) {
+		c := t.in[t.pos]
+		switch {
+		case c == '\'':
+			if err := t.standardString(); err != nil {
+				return err
+			}
+		case c == '"':
+			if err := t.quotedIdentifier(); err != nil {
+				return err
+			}
+		case c == '`':
+			if err := t.copyQuoted('`'); err != nil {
+				return err
+			}
+		case c == '$':
+			if err := t.dollar(); err != nil {
+				return err
+			}
+		case c == '-' && t.peek(1) == '-':
+			for t.pos < len(t.in) && t.in[t.pos] != '\n' {
+				t.pos++
+			}
+			t.out = append(t.out, ' ')
+		case c == '/' && t.peek(1) == '*':
+			end := strings.Index(t.in[t.pos+2:], "*/")
+			if end < 0 {
+				return moerr.NewSyntaxErrorNoCtx("unterminated /* comment")
+			}
+			end += t.pos + 4
+			t.out = append(t.out, t.in[t.pos:end]...)
+			t.pos = end
+		case c == ':' && t.peek(1) == ':':
+			if err := t.cast(); err != nil {
+				return err
+			}
+		case c == '(':
+			start := len(t.out)
+			if t.identEnd == len(t.out) {
+				// function call
+				start = t.identStart
+			}
+			t.parens = append(t.parens, start)
+			t.out = append(t.out, c)
+			t.pos++
+		case c == ')':
+			t.out = append(t.out, c)
+			t.pos++
+			if n := len(t.parens); n > 0 {
+				t.setOperand(t.parens[n-1])
+				t.parens = t.parens[:n-1]
+			}
+		case c == '|' && t.peek(1) == '|':
+			return moerr.NewNotSupportedNoCtx("operator || over the PostgreSQL protocol, use concat()")
+		case c == '&' && t.peek(1) == '&':
+			return moerr.NewNotSupportedNoCtx("operator && over the PostgreSQL protocol")
+		case c == '^':
+			return moerr.NewNotSupportedNoCtx("operator ^ over the PostgreSQL protocol, use power()")
+		case c == '#':
+			return moerr.NewNotSupportedNoCtx("operator # over the PostgreSQL protocol")
+		case isASCIIDigit(c) || (c == '.' && isASCIIDigit(t.peek(1))):
+			t.number()
+		case isIdentByte(c):
+			if err := t.identifier(); err != nil {
+				return err
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			t.out = append(t.out, c)
+			t.pos++
+		default:
+			t.out = append(t.out, c)
+			t.pos++
+			if c != '.' {
+				t.operandStart = -1
+			}
+		}
+	}
+	return nil
+}
+
+func (t *translator) peek(n int) byte {
+	if t.pos+n < len(t.in) {
+		return t.in[t.pos+n]
+	}
+	return 0
+}
+
+// setOperand records that out[start:] is an operand.
+// The qualified name a.b is one operand.
+func (t *translator) setOperand(start int) {
+	if start > 0 && t.out[start-1] == '.' && t.operandEnd == start-1 && t.operandStart >= 0 {
+		start = t.operandStart
+	}
+	t.operandStart = start
+	t.operandEnd = len(t.out)
+}
+
+// standardString translates 'a\b' into 'a\\b'.
+func (t *translator) standardString() error {
+	start := len(t.out)
+	t.out = append(t.out, '\'')
+	t.pos++
+	for {
+		if t.pos >= len(t.in) {
+			return moerr.NewSyntaxErrorNoCtx("unterminated quoted string")
+		}
+		c := t.in[t.pos]
+		t.pos++
+		if c == '\'' {
+			if t.pos < len(t.in) && t.in[t.pos] == '\'' {
+				t.out = append(t.out, '\'', '\'')
+				t.pos++
+				continue
+			}
+			break
+		}
+		if c == '\\' {
+			t.out = append(t.out, '\\')
+		}
+		t.out = append(t.out, c)
+	}
+	t.out = append(t.out, '\'')
+	t.setOperand(start)
+	return nil
+}
+
+// escapeString copies E'a\nb' as 'a\nb'.
+func (t *translator) escapeString() error {
+	start := len(t.out)
+	t.out = append(t.out, '\'')
+	t.pos++
+	for {
+		if t.pos >= len(t.in) {
+			return moerr.NewSyntaxErrorNoCtx("unterminated quoted string")
+		}
+		c := t.in[t.pos]
+		t.pos++
+		switch c {
+		case '\\':
+			if t.pos >= len(t.in) {
+				return moerr.NewSyntaxErrorNoCtx("unterminated quoted string")
+			}
+			t.out = append(t.out, c, t.in[t.pos])
+			t.pos++
+			continue
+		case '\'':
+			if t.pos < len(t.in) && t.in[t.pos] == '\'' {
+				t.out = append(t.out, '\'', '\'')
+				t.pos++
+				continue
+			}
+			t.out = append(t.out, '\'')
+			t.setOperand(start)
+			return nil
+		}
+		t.out = append(t.out, c)
+	}
+}
+
+// quotedIdentifier translates "a""b" into `a"b`.
+func (t *translator) quotedIdentifier() error {
+	start := len(t.out)
+	t.out = append(t.out, '`')
+	t.pos++
+	for {
+		if t.pos >= len(t.in) {
+			return moerr.NewSyntaxErrorNoCtx("unterminated quoted identifier")
+		}
+		c := t.in[t.pos]
+		t.pos++
+		if c == '"' {
+			if t.pos < len(t.in) && t.in[t.pos] == '"' {
+				t.out = append(t.out, '"')
+				t.pos++
+				continue
+			}
+			break
+		}
+		if c == '`' {
+			t.out = append(t.out, '`')
+		}
+		t.out = append(t.out, c)
+	}
+	t.out = append(t.out, '`')
+	t.setOperand(start)
+	t.identStart, t.identEnd = start, len(t.out)
+	return nil
+}
+
+// copyQuoted copies the quoted text as it is.
+func (t *translator) copyQuoted(quote byte) error {
+	start := len(t.out)
+	end := strings.IndexByte(t.in[t.pos+1:], quote)
+	if end < 0 {
+		return moerr.NewSyntaxErrorNoCtx("unterminated quoted identifier")
+	}
+	end += t.pos + 2
+	t.out = append(t.out, t.in[t.pos:end]...)
+	t.pos = end
+	t.setOperand(start)
+	return nil
+}
+
+// dollar translates the placeholder $n and the dollar-quoted string $tag$...$tag$.
+func (t *translator) dollar() error {
+	start := len(t.out)
+	// $ may be a part of the identifier, like a$1
+	if t.pos > 0 && isIdentByte(t.in[t.pos-1]) {
+		t.out = append(t.out, '$')
+		t.pos++
+		return nil
+	}
+	if isASCIIDigit(t.peek(1)) {
+		end := t.pos + 1
+		for end < len(t.in) && isASCIIDigit(t.in[end]) {
+			end++
+		}
+		n, err := strconv.Atoi(t.in[t.pos+1 : end])
+		if err != nil || n == 0 {
+			return moerr.NewSyntaxErrorNoCtxf("invalid parameter %s", t.in[t.pos:end])
+		}
+		t.ordinals = append(t.ordinals, n)
+		t.out = append(t.out, '?')
+		t.pos = end
+		t.setOperand(start)
+		return nil
+	}
+
+	end := t.pos + 1
+	for end < len(t.in) && isIdentByte(t.in[end]) && !isASCIIDigit(t.in[t.pos+1]) {
+		end++
+	}
+	if end >= len(t.in) || t.in[end] != '$' {
+		t.out = append(t.out, '$')
+		t.pos++
+		return nil
+	}
+	tag := t.in[t.pos : end+1]
+	body := t.in[end+1:]
+	bodyEnd := strings.Index(body, tag)
+	if bodyEnd < 0 {
+		return moerr.NewSyntaxErrorNoCtx("unterminated dollar-quoted string")
+	}
+	t.out = append(t.out, '\'')
+	for _, c := range []byte(body[:bodyEnd]) {
+		switch c {
+		case '\'', '\\':
+			t.out = append(t.out, c)
+		}
+		t.out = append(t.out, c)
+	}
+	t.out = append(t.out, '\'')
+	t.pos = end + 1 + bodyEnd + len(tag)
+	t.setOperand(start)
+	return nil
+}
+
+func (t *translator) number() {
+	start := len(t.out)
+	end := t.pos
+	for end < len(t.in) && (isASCIIDigit(t.in[end]) || t.in[end] == '.') {
+		end++
+	}
+	if end < len(t.in) && (t.in[end] == 'e' || t.in[end] == 'E') {
+		exp := end + 1
+		if exp < len(t.in) && (t.in[exp] == '+' || t.in[exp] == '-') {
+			exp++
+		}
+		if exp < len(t.in) && isASCIIDigit(t.in[exp]) {
+			end = exp
+			for end < len(t.in) && isASCIIDigit(t.in[end]) {
+				end++
+			}
+		}
+	}
+	// the identifier starting with digits, like 1a
+	for end < len(t.in) && isIdentByte(t.in[end]) {
+		end++
+	}
+	t.out = append(t.out, t.in[t.pos:end]...)
+	t.pos = end
+	t.operandStart, t.operandEnd = start, len(t.out)
+}
+
+func (t *translator) identifier() error {
+	start := len(t.out)
+	end := t.pos
+	for end < len(t.in) && (isIdentByte(t.in[end]) || t.in[end] == '$') {
+		end++
+	}
+	word := t.in[t.pos:end]
+	if (word == "E" || word == "e") && end < len(t.in) && t.in[end] == '\'' {
+		t.pos = end
+		return t.escapeString()
+	}
+	t.out = append(t.out, word...)
+	t.pos = end
+	t.setOperand(start)
+	t.identStart, t.identEnd = start, len(t.out)
+	return nil
+}
+
+// cast translates expr::type into cast(expr as type).
+func (t *translator) cast() error {
+	if t.operandStart < 0 || t.operandEnd != len(t.out) {
+		return moerr.NewSyntaxErrorNoCtx("syntax error at or near \"::\"")
+	}
+	t.pos += 2
+	name, args := t.typeName()
+	if name == "" {
+		return moerr.NewSyntaxErrorNoCtx("syntax error at or near \"::\"")
+	}
+	if strings.HasPrefix(t.in[t.pos:], "[]") {
+		return moerr.NewSyntaxErrorNoCtxf("type %s[] is not supported", name)
+	}
+	typ, ok := mysqlTypeOf(name, args)
+	if !ok {
+		return moerr.NewNotSupportedNoCtxf("cast to type %s over the PostgreSQL protocol", name)
+	}
+	start := t.operandStart
+	operand := string(t.out[start:])
+	t.out = append(t.out[:start], "cast("...)
+	t.out = append(t.out, operand...)
+	t.out = append(t.out, " as "...)
+	t.out = append(t.out, typ...)
+	t.out = append(t.out, ')')
+	t.operandStart, t.operandEnd = start, len(t.out)
+	return nil
+}
+
+// typeName reads the name and the modifiers of the type, like
+// numeric(10, 2) and timestamp with time zone.
+func (t *translator) typeName() (string, string) {
+	word := func() string {
+		p := t.pos
+		for p < len(t.in) && (t.in[p] == ' ' || t.in[p] == '\t' || t.in[p] == '\n' || t.in[p] == '\r') {
+			p++
+		}
+		end := p
+		for end < len(t.in) && isIdentByte(t.in[end]) {
+			end++
+		}
+		return strings.ToLower(t.in[p:end])
+	}
+	next := func() string {
+		w := word()
+		p := t.pos
+		for p < len(t.in) && (t.in[p] == ' ' || t.in[p] == '\t' || t.in[p] == '\n' || t.in[p] == '\r') {
+			p++
+		}
+		t.pos = p + len(w)
+		return w
+	}
+	quoted := t.pos < len(t.in) && t.in[t.pos] == '"'
+	if quoted {
+		end := strings.IndexByte(t.in[t.pos+1:], '"')
+		if end < 0 {
+			return "", ""
+		}
+		name := strings.ToLower(t.in[t.pos+1 : t.pos+1+end])
+		t.pos += end + 2
+		return name, ""
+	}
+
+	name := next()
+	var args string
+	if name == "time" || name == "timestamp" {
+		args = t.typeArgs()
+	}
+	switch name {
+	case "double":
+		if word() == "precision" {
+			name += " " + next()
+		}
+	case "character":
+		if word() == "varying" {
+			name += " " + next()
+		}
+	case "time", "timestamp":
+		save := t.pos
+		switch next() {
+		case "with":
+			if next() == "time" && next() == "zone" {
+				name += " with time zone"
+			} else {
+				t.pos = save
+			}
+		case "without":
+			if next() == "time" && next() == "zone" {
+				name += " without time zone"
+			} else {
+				t.pos = save
+			}
+		default:
+			t.pos = save
+		}
+	}
+	if args == "" {
+		args = t.typeArgs()
+	}
+	return name, args
+}
+
+// typeArgs reads the modifiers of the type, like (10, 2).
+func (t *translator) typeArgs() string {
+	p := t.pos
+	for p < len(t.in) && t.in[p] == ' ' {
+		p++
+	}
+	if p >= len(t.in) || t.in[p] != '(' {
+		return ""
+	}
+	end := strings.IndexByte(t.in[p:], ')')
+	if end < 0 {
+		return ""
+	}
+	args := t.in[p : p+end+1]
+	t.pos = p + end + 1
+	return args
+}
+
+// mysqlTypeOf maps the PostgreSQL type in the cast to the one of MO, false
+// if MO has no such type.
+func mysqlTypeOf(name, args string) (string, bool) {
+	switch name {
+	case "int2", "smallint":
+		return "smallint", true
+	case "int", "int4", "integer":
+		return "int", true
+	case "int8", "bigint":
+		return "bigint", true
+	case "float4", "real":
+		return "float", true
+	case "float8", "float", "double precision":
+		return "double", true
+	case "numeric", "decimal":
+		if args == "" {
+			// numeric without the precision keeps the scale of the value
+			return "decimal(38, 10)", true
+		}
+		return "decimal" + args, true
+	case "text", "name":
+		return "text", true
+	case "varchar", "character varying":
+		if args == "" {
+			return "text", true
+		}
+		return "varchar" + args, true
+	case "char", "character", "bpchar":
+		return "char" + args, true
+	case "bool", "boolean":
+		return "bool", true
+	case "time", "time without time zone":
+		if args == "" {
+			return "time(6)", true
+		}
+		return "time" + args, true
+	case "timestamp", "timestamp without time zone":
+		if args == "" {
+			return "datetime(6)", true
+		}
+		return "datetime" + args, true
+	case "timestamptz", "timestamp with time zone":
+		if args == "" {
+			return "timestamp(6)", true
+		}
+		return "timestamp" + args, true
+	case "jsonb":
+		return "json", true
+	case "bytea":
+		return "blob", true
+	case "date", "json", "uuid":
+		return name, args == ""
+	default:
+		return "", false
+	}
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || isASCIIDigit(c) || c >= 0x80
+}
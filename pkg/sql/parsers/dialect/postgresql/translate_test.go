@@ -0,0 +1,91 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresql
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTranslate(t *testing.T) {
+	cases := []struct {
+		input    string
+		output   string
+		ordinals []int
+	}{
+		{input: "select 1", output: "select 1"},
+		{input: "select * from t where a = $1 and b = $2", output: "select * from t where a = ? and b = ?", ordinals: []int{1, 2}},
+		{input: "select $2, $1, $2", output: "select ?, ?, ?", ordinals: []int{2, 1, 2}},
+		{input: "select a$1 from t", output: "select a$1 from t"},
+		{input: `select "A""b", "c` + "`" + `d" from "T"`, output: "select `A\"b`, `c``d` from `T`"},
+		{input: `select 'a\b', 'it''s'`, output: `select 'a\\b', 'it''s'`},
+		{input: `select E'a\nb', e'it\'s'`, output: `select 'a\nb', 'it\'s'`},
+		{input: `select $$it's a\b$$, $tag$x$$y$tag$`, output: `select 'it''s a\\b', 'x$$y'`},
+		{input: "select '$1', \"$2\"", output: "select '$1', `$2`"},
+		{input: "select 1 -- comment\n, 2", output: "select 1  \n, 2"},
+		{input: "select /* $1 */ 1", output: "select /* $1 */ 1"},
+		{input: "select a::int from t", output: "select cast(a as int) from t"},
+		{input: "select t.a::text from t", output: "select cast(t.a as text) from t"},
+		{input: "select '1.5'::numeric(10, 2)", output: "select cast('1.5' as decimal(10, 2))"},
+		{input: "select $1::bigint", output: "select cast(? as bigint)", ordinals: []int{1}},
+		{input: "select (a + b)::float8 from t", output: "select cast((a + b) as double) from t"},
+		{input: "select abs(a)::int2 from t", output: "select cast(abs(a) as smallint) from t"},
+		{input: "select now()::timestamp with time zone", output: "select cast(now() as timestamp(6))"},
+		{input: "select '2020-01-01'::timestamp", output: "select cast('2020-01-01' as datetime(6))"},
+		{input: "select 'x'::character varying(10)", output: "select cast('x' as varchar(10))"},
+		{input: "select 1::double precision", output: "select cast(1 as double)"},
+		{input: "select a::int::text from t", output: "select cast(cast(a as int) as text) from t"},
+		{input: "select '2020-01-01'::date, $1::uuid", output: "select cast('2020-01-01' as date), cast(? as uuid)", ordinals: []int{1}},
+		{input: "select 'a || b', \"^#\", $$&&$$", output: "select 'a || b', `^#`, '&&'"},
+		{input: "select a | b, a & b from t", output: "select a | b, a & b from t"},
+	}
+	for _, c := range cases {
+		output, ordinals, err := Translate(c.input)
+		if err != nil {
+			t.Errorf("Translate(%q) err: %v", c.input, err)
+			continue
+		}
+		if output != c.output {
+			t.Errorf("Translate(%q) = %q, want %q", c.input, output, c.output)
+		}
+		if !reflect.DeepEqual(ordinals, c.ordinals) {
+			t.Errorf("Translate(%q) ordinals = %v, want %v", c.input, ordinals, c.ordinals)
+		}
+	}
+}
+
+func TestTranslateError(t *testing.T) {
+	cases := []string{
+		"select 'a",
+		`select "a`,
+		"select E'a",
+		"select $$a",
+		"select /* a",
+		"select $0",
+		"select ::int",
+		"select a::int[]",
+		"select 'a' || 'b'",
+		"select a && b from t",
+		"select 2 ^ 3",
+		"select 5 # 3",
+		"select a::interval from t",
+		"select a::date(1) from t",
+	}
+	for _, c := range cases {
+		if _, _, err := Translate(c); err == nil {
+			t.Errorf("Translate(%q) should fail", c)
+		}
+	}
+}
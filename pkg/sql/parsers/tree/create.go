@@ -179,6 +179,12 @@ func init() {
 		reuse.DefaultOptions[AttributeSRID](), //.
 	) //WithEnableChecker()
 
+	reuse.CreatePool[AttributeCompression](
+		func() *AttributeCompression { return &AttributeCompression{} },
+		func(a *AttributeCompression) { a.reset() },
+		reuse.DefaultOptions[AttributeCompression](), //.
+	) //WithEnableChecker()
+
 	reuse.CreatePool[IndexOption](
 		func() *IndexOption { return &IndexOption{} },
 		func(i *IndexOption) { i.reset() },
@@ -1034,6 +1040,9 @@ func (node *CreateTable) Format(ctx *FmtCtx) {
 	}
 
 	if node.Param != nil {
+		if node.Param.Format == ICEBERG {
+			ctx.WriteString(" engine = iceberg")
+		}
 		if len(node.Param.Option) == 0 {
 			ctx.WriteString(" infile ")
 			ctx.WriteString("'" + node.Param.Filepath + "'")
@@ -1064,6 +1073,8 @@ func (node *CreateTable) Format(ctx *FmtCtx) {
 					ctx.WriteString("'format'='" + node.Param.Option[i+1] + "'")
 				case "jsondata":
 					ctx.WriteString("'jsondata'='" + node.Param.Option[i+1] + "'")
+				case "snapshot_id":
+					ctx.WriteString("'snapshot_id'='" + node.Param.Option[i+1] + "'")
 				}
 				if i != len(node.Param.Option)-2 {
 					ctx.WriteString(", ")
@@ -1388,6 +1399,8 @@ func (node *ColumnTableDef) reset() {
 				opt.Free()
 			case *AttributeSRID:
 				opt.Free()
+			case *AttributeCompression:
+				opt.Free()
 			case *AttributeVisable:
 				opt.Free()
 			case *KeyPart:
@@ -1742,6 +1755,9 @@ type AttributeGeneratedAlways struct {
 	columnAttributeImpl
 	Expr   Expr
 	Stored bool
+	// Async columns are stored as NULL by DML and filled in later by a
+	// background job, for expressions too slow to evaluate inline.
+	Async bool
 }
 
 func (node *AttributeGeneratedAlways) Format(ctx *FmtCtx) {
@@ -1750,6 +1766,9 @@ func (node *AttributeGeneratedAlways) Format(ctx *FmtCtx) {
 	ctx.WriteByte(')')
 	if node.Stored {
 		ctx.WriteString(" stored")
+		if node.Async {
+			ctx.WriteString(" async")
+		}
 	} else {
 		ctx.WriteString(" virtual")
 	}
@@ -1772,6 +1791,12 @@ func NewAttributeGeneratedAlways(e Expr, s bool) *AttributeGeneratedAlways {
 	return ag
 }
 
+func NewAttributeGeneratedAlwaysAsync(e Expr) *AttributeGeneratedAlways {
+	ag := NewAttributeGeneratedAlways(e, true)
+	ag.Async = true
+	return ag
+}
+
 type AttributeLowCardinality struct {
 	columnAttributeImpl
 }
@@ -2041,6 +2066,33 @@ func NewAttributeSRID(v uint32) *AttributeSRID {
 	return a
 }
 
+// AttributeCompression is the codec of a column, e.g. COMPRESSION 'zstd(9)'
+type AttributeCompression struct {
+	columnAttributeImpl
+	Compression string
+}
+
+func (node *AttributeCompression) Format(ctx *FmtCtx) {
+	ctx.WriteString("compression ")
+	ctx.WriteString("'" + node.Compression + "'")
+}
+
+func (node AttributeCompression) TypeName() string { return "tree.AttributeCompression" }
+
+func (node *AttributeCompression) reset() {
+	*node = AttributeCompression{}
+}
+
+func (node *AttributeCompression) Free() {
+	reuse.Free[AttributeCompression](node, nil)
+}
+
+func NewAttributeCompression(c string) *AttributeCompression {
+	a := reuse.Alloc[AttributeCompression](nil)
+	a.Compression = c
+	return a
+}
+
 type IndexType int
 
 func (it IndexType) ToString() string {
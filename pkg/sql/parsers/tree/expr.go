@@ -951,6 +951,10 @@ type FuncExpr struct {
 	WindowSpec *WindowSpec
 
 	OrderBy OrderBy
+
+	// WithinGroup is the ordering of an ordered-set aggregation,
+	// e.g. percentile_cont(0.5) WITHIN GROUP (ORDER BY x).
+	WithinGroup OrderBy
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -994,6 +998,12 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 
 	ctx.WriteByte(')')
 
+	if node.WithinGroup != nil {
+		ctx.WriteString(" within group (")
+		node.WithinGroup.Format(ctx)
+		ctx.WriteByte(')')
+	}
+
 	if node.WindowSpec != nil {
 		ctx.WriteString(" ")
 		node.WindowSpec.Format(ctx)
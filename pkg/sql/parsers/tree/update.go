@@ -189,6 +189,10 @@ const (
 	LZW        = "lzw"
 	ZLIB       = "zlib"
 	LZ4        = "lz4"
+	ZSTD       = "zstd"
+	ZST        = "zst" // alias of zstd
+	SNAPPY     = "snappy"
+	BROTLI     = "brotli"
 	TAR_GZ     = "tar.gz"
 	TAR_BZ2    = "tar.bz2"
 )
@@ -198,6 +202,9 @@ const (
 	CSV      = "csv"
 	JSONLINE = "jsonline"
 	PARQUET  = "parquet"
+	ARROW    = "arrow"
+	ORC      = "orc"
+	ICEBERG  = "iceberg"
 )
 
 // if $format is jsonline
@@ -589,6 +596,8 @@ type ExportParam struct {
 	StageFilePath string
 	// ExportFormat: "csv", "jsonline", "parquet", default is ""(csv)
 	ExportFormat string
+	// Compression: "none", "gzip", "zstd", "snappy", ..., default is ""(inferred from the file suffix)
+	Compression string
 	// SplitSize: split file size in bytes, 0 means no split
 	SplitSize uint64
 }
@@ -661,6 +670,11 @@ func (ep *ExportParam) format(ctx *FmtCtx, withOutfile bool) {
 		ctx.WriteString(" format ")
 		ctx.WriteString(ep.ExportFormat)
 	}
+	// Compression option
+	if ep.Compression != "" {
+		ctx.WriteString(" compression ")
+		ctx.WriteString(ep.Compression)
+	}
 	// SplitSize option
 	if ep.SplitSize != 0 {
 		ctx.WriteString(" splitsize ")
@@ -305,7 +305,7 @@ func (builder *QueryBuilder) getArgsFromDistFn(distFnExpr *plan.Function, partPo
 	}
 
 	distFnArgs := distFnExpr.Args
-	if distFnArgs[0].Typ.GetId() != int32(types.T_array_float32) && distFnArgs[0].Typ.GetId() != int32(types.T_array_float64) {
+	if !isVectorType(types.T(distFnArgs[0].Typ.GetId())) {
 		return
 	}
 
@@ -352,8 +352,7 @@ func (builder *QueryBuilder) getArgsFromDistFnForJoin(
 	}
 
 	distFnArgs := distFnExpr.Args
-	if distFnArgs[0].Typ.GetId() != int32(types.T_array_float32) &&
-		distFnArgs[0].Typ.GetId() != int32(types.T_array_float64) {
+	if !isVectorType(types.T(distFnArgs[0].Typ.GetId())) {
 		return
 	}
 
@@ -1187,6 +1187,10 @@ func (b *baseBinder) bindFuncExpr(astExpr *tree.FuncExpr, depth int32, isRoot bo
 		return expr, err
 	} else if function.GetFunctionIsWinFunByName(funcName) {
 		return b.impl.BindWinFunc(funcName, astExpr, depth, isRoot)
+	} else if astExpr.WindowSpec != nil {
+		return nil, moerr.NewSyntaxErrorf(b.GetContext(), "function %s does not support OVER clause", funcName)
+	} else if astExpr.WithinGroup != nil {
+		return nil, moerr.NewSyntaxErrorf(b.GetContext(), "function %s does not support WITHIN GROUP", funcName)
 	}
 
 	return b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
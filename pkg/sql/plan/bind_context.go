@@ -526,6 +526,12 @@ func (bc *BindContext) qualifyColumnNames(astExpr tree.Expr, expandAlias ExpandA
 				}
 			}
 		}
+		for i := range exprImpl.WithinGroup {
+			exprImpl.WithinGroup[i].Expr, err = bc.qualifyColumnNames(exprImpl.WithinGroup[i].Expr, expandAlias)
+			if err != nil {
+				return nil, err
+			}
+		}
 
 	case *tree.RangeCond:
 		exprImpl.Left, err = bc.qualifyColumnNames(exprImpl.Left, expandAlias)
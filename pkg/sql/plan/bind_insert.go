@@ -1372,6 +1372,19 @@ func (builder *QueryBuilder) appendDedupAndMultiUpdateNodesForBindInsert(
 				continue
 			}
 			genExpr := substituteColRefsInExpr(col.GeneratedCol.Expr, finalRowExprs, 0)
+			if col.GeneratedCol.IsAsync {
+				// An async column keeps its value unless its inputs change,
+				// then it is reset to NULL for its ISCP job to fill in.
+				if !generatedColRefsUpdated(tableDef, col.GeneratedCol.Expr, func(name string) bool {
+					_, ok := updateExprs[name]
+					return ok
+				}) {
+					continue
+				}
+				if genExpr, err = getDefaultExpr(builder.GetContext(), col); err != nil {
+					return 0, err
+				}
+			}
 			finalRowExprs[i] = genExpr
 			updateExprs[col.Name] = genExpr
 		}
@@ -2894,8 +2907,18 @@ func (builder *QueryBuilder) appendNodesForInsertStmt(
 
 	for _, i := range generatedColIdxs {
 		col := tableDef.Cols[i]
-		genExpr := DeepCopyExpr(col.GeneratedCol.Expr)
-		inlineGeneratedColExpr(genExpr, colIdxToProjPos, projList1)
+		var genExpr *plan.Expr
+		if col.GeneratedCol.IsAsync {
+			// Async generated columns are written as NULL and filled in by
+			// their ISCP job, so the write does not wait for the expression.
+			var err error
+			if genExpr, err = getDefaultExpr(builder.GetContext(), col); err != nil {
+				return 0, nil, nil, err
+			}
+		} else {
+			genExpr = DeepCopyExpr(col.GeneratedCol.Expr)
+			inlineGeneratedColExpr(genExpr, colIdxToProjPos, projList1)
+		}
 		proj1Pos := genColIdxToProj1Pos[i]
 		projList1[proj1Pos] = genExpr
 		pos := int32(proj1Pos)
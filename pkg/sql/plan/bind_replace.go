@@ -1095,8 +1095,18 @@ func (builder *QueryBuilder) appendNodesForReplaceStmt(
 
 	for _, i := range generatedColIdxs {
 		col := tableDef.Cols[i]
-		genExpr := DeepCopyExpr(col.GeneratedCol.Expr)
-		inlineGeneratedColExpr(genExpr, colIdxToProjPos, projList1)
+		var genExpr *plan.Expr
+		if col.GeneratedCol.IsAsync {
+			// Async generated columns are written as NULL and filled in by
+			// their ISCP job, so the write does not wait for the expression.
+			var err error
+			if genExpr, err = getDefaultExpr(builder.GetContext(), col); err != nil {
+				return 0, nil, nil, err
+			}
+		} else {
+			genExpr = DeepCopyExpr(col.GeneratedCol.Expr)
+			inlineGeneratedColExpr(genExpr, colIdxToProjPos, projList1)
+		}
 		proj1Pos := genColIdxToProj1Pos[i]
 		projList1[proj1Pos] = genExpr
 		pos := int32(proj1Pos)
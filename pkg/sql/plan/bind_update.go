@@ -109,20 +109,24 @@ func (builder *QueryBuilder) bindUpdate(stmt *tree.Update, bindCtx *BindContext)
 			}
 
 			// Check: cannot update a generated column (unless SET gen_col = DEFAULT)
-			isGenCol := false
+			var genCol *plan.GeneratedCol
 			for _, colDef := range tableDef.Cols {
 				if colDef.Name == colName && colDef.GeneratedCol != nil {
-					isGenCol = true
+					genCol = colDef.GeneratedCol
 					break
 				}
 			}
-			if isGenCol {
-				if _, ok := updateExpr.(*tree.DefaultVal); ok {
+			if genCol != nil {
+				if _, ok := updateExpr.(*tree.DefaultVal); !ok {
+					return 0, moerr.NewInvalidInputf(builder.compCtx.GetContext(), "the value specified for generated column '%s' in table '%s' is not allowed", colName, tableDef.Name)
+				}
+				if !genCol.IsAsync {
 					// SET gen_col = DEFAULT is allowed — silently remove from update set
 					delete(dmlCtx.updateCol2Expr[i], colName)
 					continue
 				}
-				return 0, moerr.NewInvalidInputf(builder.compCtx.GetContext(), "the value specified for generated column '%s' in table '%s' is not allowed", colName, tableDef.Name)
+				// SET async_col = DEFAULT computes the column now, it is
+				// kept in the update set and evaluated below.
 			}
 
 			if !dmlCtx.updatePartCol[i] {
@@ -318,6 +322,25 @@ func (builder *QueryBuilder) bindUpdate(stmt *tree.Update, bindCtx *BindContext)
 			}
 			genExpr := substituteColRefsInExpr(col.GeneratedCol.Expr, selectNode.ProjectList, colOffsets[i])
 
+			if col.GeneratedCol.IsAsync {
+				if _, ok := dmlCtx.updateCol2Expr[i][col.Name]; ok {
+					// SET async_col = DEFAULT
+					selectNode.ProjectList[newColName2Idx[alias+"."+col.Name]] = genExpr
+					continue
+				}
+				// An async column keeps its value unless its inputs change,
+				// then it is reset to NULL for its ISCP job to fill in.
+				if !generatedColRefsUpdated(tableDef, col.GeneratedCol.Expr, func(name string) bool {
+					_, ok := dmlCtx.updateCol2Expr[i][name]
+					return ok
+				}) {
+					continue
+				}
+				if genExpr, err = getDefaultExpr(builder.GetContext(), col); err != nil {
+					return 0, err
+				}
+			}
+
 			oldPos := oldColName2Idx[alias+"."+col.Name]
 			newColName2Idx[alias+"."+col.Name] = oldPos
 			oldColName2Idx[alias+"."+col.Name] = int32(len(selectNode.ProjectList))
@@ -143,6 +143,10 @@ func buildAddColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTable
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "comment for column '%s' is too long", newColNameOrigin)
 			}
 			newCol.Comment = comment
+		case *tree.AttributeCompression:
+			if newCol.Alg, newCol.AlgLevel, err = buildCompressType(ctx.GetContext(), attribute.Compression); err != nil {
+				return nil, err
+			}
 		case *tree.AttributeAutoIncrement:
 			auto_incr = true
 			if !types.T(colType.GetId()).IsInteger() {
@@ -197,6 +201,9 @@ func buildAddColumnAndConstraint(ctx CompilerContext, alterPlan *plan.AlterTable
 	}
 
 	if newCol.GeneratedCol != nil {
+		if err := checkAsyncGeneratedColTable(ctx.GetContext(), alterPlan.CopyTableDef, newCol); err != nil {
+			return nil, err
+		}
 		// Generated columns preserve declared nullability but use no default expr for storage layer compatibility
 		newCol.Default = &plan.Default{
 			NullAbility:  getColumnNullAbility(specNewColumn),
@@ -233,8 +240,7 @@ func checkTypeCapSize(ctx context.Context, ty *plan.Type, name string) error {
 		}
 	}
 
-	if ty.Id == int32(types.T_array_float32) ||
-		ty.Id == int32(types.T_array_float64) {
+	if isVectorType(types.T(ty.Id)) {
 		if ty.GetWidth() > types.MaxArrayDimension {
 			return moerr.NewInvalidInputf(
 				ctx,
@@ -122,6 +122,7 @@ func buildColumnAndConstraint(
 
 	newCol := &ColDef{
 		ColId:      oldCol.ColId,
+		Seqnum:     oldCol.Seqnum,
 		Primary:    oldCol.Primary,
 		ClusterBy:  oldCol.ClusterBy,
 		Name:       newColName,
@@ -129,6 +130,10 @@ func buildColumnAndConstraint(
 		Typ:        colType,
 		Alg:        plan.CompressType_Lz4,
 	}
+	// the column keeps its codec unless a new one is given
+	if oldCol.Alg == plan.CompressType_Zstd {
+		newCol.Alg, newCol.AlgLevel = oldCol.Alg, oldCol.AlgLevel
+	}
 
 	// If the column null property is not specified, it defaults to allowing null
 	hasNullFlag := false
@@ -159,6 +164,10 @@ func buildColumnAndConstraint(
 				return nil, moerr.NewInvalidInputf(ctx.GetContext(), "comment for column '%s' is too long", newColNameOrigin)
 			}
 			newCol.Comment = comment
+		case *tree.AttributeCompression:
+			if newCol.Alg, newCol.AlgLevel, err = buildCompressType(ctx.GetContext(), attribute.Compression); err != nil {
+				return nil, err
+			}
 		case *tree.AttributeAutoIncrement:
 			auto_incr = true
 			if !types.T(colType.GetId()).IsInteger() {
@@ -232,6 +241,9 @@ func buildColumnAndConstraint(
 			return nil, moerr.NewNotSupportedf(ctx.GetContext(),
 				"defining a virtual generated column '%s' as primary key", newColNameOrigin)
 		}
+		if err := checkAsyncGeneratedColTable(ctx.GetContext(), targetTableDef, newCol); err != nil {
+			return nil, err
+		}
 		// Generated columns preserve declared nullability but use no default expr for storage layer compatibility
 		newCol.Default = &plan.Default{
 			NullAbility:  getColumnNullAbility(specNewColumn),
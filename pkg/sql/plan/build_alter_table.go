@@ -723,6 +723,15 @@ func storageAgnosticType(
 		return
 	}
 
+	// an unchanged type keeps the data as is, e.g. when only the codec of
+	// the column is modified, which applies to the objects written later
+	sameType := oTy.Width == nTy.Width && oTy.Scale == nTy.Scale &&
+		oTy.Enumvalues == nTy.Enumvalues && !oTy.AutoIncr
+	if sameType {
+		ok = true
+		return
+	}
+
 	if nTy.Id != int32(types.T_varchar) && nTy.Id != int32(types.T_char) {
 		return
 	}
@@ -773,8 +782,8 @@ func storageAgnosticAttrs(
 			if oExpr != nExpr {
 				ok = false
 			}
-		case *tree.AttributeComment, *tree.AttributeDefault:
-			// keep ok true, we don't care about what comment or default is
+		case *tree.AttributeComment, *tree.AttributeDefault, *tree.AttributeCompression:
+			// keep ok true, we don't care about what comment, default or codec is
 			ok = true
 		default:
 			// key, primary key, unique key, auto increment, reference etc.
@@ -834,7 +843,7 @@ func buildNotNullColumnVal(col *ColDef) string {
 	} else if isEnumPlanType(&col.Typ) {
 		enumvalues := strings.Split(col.Typ.Enumvalues, ",")
 		defaultValue = enumvalues[0]
-	} else if col.Typ.Id == int32(types.T_array_float32) || col.Typ.Id == int32(types.T_array_float64) {
+	} else if isVectorType(types.T(col.Typ.Id)) {
 		if col.Typ.Width > 0 {
 			zerosWithCommas := strings.Repeat("0,", int(col.Typ.Width)-1)
 			arrayAsString := zerosWithCommas + "0" // final zero
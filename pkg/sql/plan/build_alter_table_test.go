@@ -620,6 +620,44 @@ func TestAlterTableVarcharLengthBumped(t *testing.T) {
 			wantOk:  false,
 			wantErr: false,
 		},
+
+		{
+			name: "compression changed only",
+			clause: &tree.AlterTableModifyColumnClause{
+				NewColumn: &tree.ColumnTableDef{
+					Name: tree.NewUnresolvedColName("col1"),
+					Type: &tree.T{
+						InternalType: tree.InternalType{
+							Family:       tree.IntFamily,
+							FamilyString: "int",
+							Oid:          uint32(defines.MYSQL_TYPE_LONG),
+							Width:        32,
+						},
+					},
+					Attributes: []tree.ColumnAttribute{
+						tree.NewAttributeCompression("zstd(3)"),
+					},
+				},
+			},
+			tableDef: &TableDef{
+				Cols: []*ColDef{
+					{
+						Name:  "col1",
+						ColId: 1,
+						Typ: plan.Type{
+							Id:    int32(types.T_int32),
+							Width: 32,
+							Scale: -1,
+						},
+						Default: &plan.Default{
+							NullAbility: true,
+						},
+					},
+				},
+			},
+			wantOk:  true,
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -119,7 +119,9 @@ func getUpdateTableInfo(ctx CompilerContext, stmt *tree.Update) (*dmlTableInfo,
 	// checkSetGeneratedCol returns skip=true when the SET assigns DEFAULT to a
 	// stored generated column (which should be silently dropped so the base
 	// column's recomputation fills it), or an error when a non-DEFAULT value
-	// is assigned to any generated column. Mirrors bind_update.go's behaviour.
+	// is assigned to any generated column. DEFAULT on an async generated column
+	// is kept, rewriteGeneratedColumnsForUpdate computes it. Mirrors
+	// bind_update.go's behaviour.
 	checkSetGeneratedCol := func(alias, column string, expr tree.Expr) (bool, error) {
 		idx, ok := tblInfo.alias[alias]
 		if !ok {
@@ -131,7 +133,7 @@ func getUpdateTableInfo(ctx CompilerContext, stmt *tree.Update) (*dmlTableInfo,
 				continue
 			}
 			if _, ok := expr.(*tree.DefaultVal); ok {
-				return true, nil
+				return !col.GeneratedCol.IsAsync, nil
 			}
 			return false, moerr.NewInvalidInputf(ctx.GetContext(),
 				"the value specified for generated column '%s' in table '%s' is not allowed",
@@ -1173,7 +1175,7 @@ func MakeInsertValueConstExpr(proc *process.Process, numVal *tree.NumVal, colTyp
 		planType := MakePlan2TypeValue(colType)
 		return MakePlan2Decimal128ExprWithType(num, &planType), err
 	case types.T_char, types.T_varchar, types.T_blob, types.T_binary, types.T_varbinary, types.T_text, types.T_datalink, types.T_geometry,
-		types.T_array_float32, types.T_array_float64:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		canInsert, num, err := util.SetInsertValueString(proc, numVal, colType)
 		if err != nil || !canInsert {
 			return nil, err
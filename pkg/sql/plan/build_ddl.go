@@ -29,6 +29,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -955,6 +956,10 @@ func buildCreateTable(
 			if opt.Value != 0 {
 				createTable.TableDef.AutoIncrOffset = opt.Value - 1
 			}
+		case *tree.TableOptionCompression:
+			if err = applyTableCompression(ctx.GetContext(), stmt, createTable.TableDef, opt.Compression); err != nil {
+				return nil, err
+			}
 
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
@@ -968,7 +973,7 @@ func buildCreateTable(
 		// 	*tree.TableOptionUnion, *tree.TableOptionEncryption:
 		// 	return nil, moerr.NewNotSupported("statement: '%v'", tree.String(stmt, dialect.MYSQL))
 		case *tree.TableOptionAUTOEXTEND_SIZE, *tree.TableOptionAvgRowLength,
-			*tree.TableOptionCharset, *tree.TableOptionChecksum, *tree.TableOptionCollate, *tree.TableOptionCompression,
+			*tree.TableOptionCharset, *tree.TableOptionChecksum, *tree.TableOptionCollate,
 			*tree.TableOptionConnection, *tree.TableOptionDataDirectory, *tree.TableOptionIndexDirectory,
 			*tree.TableOptionDelayKeyWrite, *tree.TableOptionEncryption, *tree.TableOptionEngine, *tree.TableOptionEngineAttr,
 			*tree.TableOptionKeyBlockSize, *tree.TableOptionMaxRows, *tree.TableOptionMinRows, *tree.TableOptionPackKeys,
@@ -985,7 +990,7 @@ func buildCreateTable(
 	if stmt.Param != nil {
 		for i := 0; i < len(stmt.Param.Option); i += 2 {
 			switch strings.ToLower(stmt.Param.Option[i]) {
-			case "endpoint", "region", "access_key_id", "secret_access_key", "bucket", "filepath", "compression", "format", "jsondata", "provider", "role_arn", "external_id", "hive_partitioning", "hive_partition_columns", ExternalWriteFilePatternKey, CSVCommentKey:
+			case "endpoint", "region", "access_key_id", "secret_access_key", "bucket", "filepath", "compression", "format", "jsondata", "provider", "role_arn", "external_id", "hive_partitioning", "hive_partition_columns", ExternalWriteFilePatternKey, CSVCommentKey, IcebergSnapshotIDKey:
 			default:
 				return nil, moerr.NewBadConfigf(ctx.GetContext(), "the keyword '%s' is not support", strings.ToLower(stmt.Param.Option[i]))
 			}
@@ -995,6 +1000,10 @@ func buildCreateTable(
 			return nil, err
 		}
 
+		if err := validateIcebergOptions(ctx.GetContext(), stmt.Param); err != nil {
+			return nil, err
+		}
+
 		if err := validateAndSetHivePartitionOptions(ctx.GetContext(), stmt, createTable); err != nil {
 			return nil, err
 		}
@@ -1099,6 +1108,53 @@ func buildCreateTable(
 	}, nil
 }
 
+// buildCompressType parses a column codec spec like 'zstd(9)'
+func buildCompressType(ctx context.Context, spec string) (plan.CompressType, int32, error) {
+	alg, level, err := compress.ParseAlgorithm(spec)
+	if err != nil {
+		return plan.CompressType_None, 0, moerr.NewInvalidInput(ctx, err.Error())
+	}
+	switch alg {
+	case compress.Lz4:
+		return plan.CompressType_Lz4, 0, nil
+	case compress.Zstd:
+		return plan.CompressType_Zstd, int32(level), nil
+	}
+	return plan.CompressType_None, 0, moerr.NewNotSupportedf(ctx, "compression '%s'", spec)
+}
+
+// applyTableCompression sets the codec of the table option COMPRESSION on the
+// columns that do not declare a codec of their own. Codecs MO does not know,
+// like 'zlib' or 'none' of MySQL, are still accepted and ignored.
+func applyTableCompression(ctx context.Context, stmt *tree.CreateTable, tableDef *TableDef, spec string) error {
+	name := strings.ToLower(strings.TrimSpace(strings.SplitN(spec, "(", 2)[0]))
+	if name != "lz4" && name != "zstd" {
+		return nil
+	}
+	alg, level, err := buildCompressType(ctx, spec)
+	if err != nil {
+		return err
+	}
+	explicit := make(map[string]bool)
+	for _, def := range stmt.Defs {
+		colDef, ok := def.(*tree.ColumnTableDef)
+		if !ok {
+			continue
+		}
+		for _, attr := range colDef.Attributes {
+			if _, ok = attr.(*tree.AttributeCompression); ok {
+				explicit[colDef.Name.ColName()] = true
+			}
+		}
+	}
+	for _, col := range tableDef.Cols {
+		if !explicit[col.Name] {
+			col.Alg, col.AlgLevel = alg, level
+		}
+	}
+	return nil
+}
+
 func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *plan.CreateTable, asSelectCols []*ColDef) error {
 	// all below fields' key is lower case
 	var primaryKeys []string
@@ -1157,7 +1213,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 					return moerr.NewInvalidInputf(ctx.GetContext(), "string width (%d) is too long", colType.GetWidth())
 				}
 			}
-			if colType.Id == int32(types.T_array_float32) || colType.Id == int32(types.T_array_float64) {
+			if isVectorType(types.T(colType.Id)) {
 				if colType.GetWidth() > types.MaxArrayDimension {
 					return moerr.NewInvalidInputf(ctx.GetContext(), "vector width (%d) is too long", colType.GetWidth())
 				}
@@ -1174,6 +1230,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			var comment string
 			var auto_incr bool
 			var isGenerated bool
+			alg, algLevel := plan.CompressType_Lz4, int32(0)
 			colName := def.Name.ColName()
 			// only used in error message and ColDef.OriginName
 			colNameOrigin := def.Name.ColNameOrigin()
@@ -1194,7 +1251,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 					if colType.GetId() == int32(types.T_json) {
 						return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in primary key", colNameOrigin))
 					}
-					if colType.GetId() == int32(types.T_array_float32) || colType.GetId() == int32(types.T_array_float64) {
+					if isVectorType(types.T(colType.GetId())) {
 						return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in primary key", colNameOrigin))
 					}
 					if isEnumPlanType(&colType) {
@@ -1213,6 +1270,10 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 					if getNumOfCharacters(comment) > maxLengthOfColumnComment {
 						return moerr.NewInvalidInputf(ctx.GetContext(), "comment for column '%s' is too long", colNameOrigin)
 					}
+				case *tree.AttributeCompression:
+					if alg, algLevel, err = buildCompressType(ctx.GetContext(), attribute.Compression); err != nil {
+						return err
+					}
 				case *tree.AttributeAutoIncrement:
 					auto_incr = true
 					if !types.T(colType.GetId()).IsInteger() {
@@ -1287,7 +1348,8 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			col := &ColDef{
 				Name:         colName,
 				OriginName:   colNameOrigin,
-				Alg:          plan.CompressType_Lz4,
+				Alg:          alg,
+				AlgLevel:     algLevel,
 				Typ:          colType,
 				Default:      defaultValue,
 				OnUpdate:     onUpdateExpr,
@@ -1532,6 +1594,10 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 				return moerr.NewNotSupported(ctx.GetContext(),
 					fmt.Sprintf("defining a virtual generated column '%s' as primary key", col.OriginName))
 			}
+			if col.GeneratedCol != nil && col.GeneratedCol.IsAsync {
+				return moerr.NewNotSupported(ctx.GetContext(),
+					fmt.Sprintf("defining an async generated column '%s' as primary key", col.OriginName))
+			}
 		}
 		if len(primaryKeys) == 1 {
 			pkeyName = primaryKeys[0]
@@ -1565,6 +1631,13 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			colMap[primaryKey].NotNull = true
 		}
 	} else {
+		// The background job of an async generated column addresses rows by primary key.
+		for _, col := range createTable.TableDef.Cols {
+			if col.GeneratedCol != nil && col.GeneratedCol.IsAsync {
+				return moerr.NewNotSupported(ctx.GetContext(),
+					fmt.Sprintf("async generated column '%s' in a table without primary key", col.OriginName))
+			}
+		}
 		// If table does not have a explicit primary key in the ddl statement, a new hidden primary key column will be add,
 		// which will not be sorted or used for any other purpose, but will only be used to add
 		// locks to the Lock operator in pessimistic transaction mode.
@@ -4706,7 +4779,7 @@ func effectiveWriteCompression(comp string, paths ...string) (string, bool) {
 	if comp != "" && comp != tree.AUTO {
 		return comp, comp != tree.NOCOMPRESS
 	}
-	suffixes := []string{".tar.gz", ".tar.gzip", ".tar.bz2", ".tar.bzip2", ".gz", ".gzip", ".bz2", ".bzip2", ".lz4"}
+	suffixes := []string{".tar.gz", ".tar.gzip", ".tar.bz2", ".tar.bzip2", ".gz", ".gzip", ".bz2", ".bzip2", ".lz4", ".zst", ".zstd", ".sz", ".snappy"}
 	for _, p := range paths {
 		p = strings.ToLower(p)
 		for _, suf := range suffixes {
@@ -4718,6 +4791,44 @@ func effectiveWriteCompression(comp string, paths ...string) (string, bool) {
 	return tree.NOCOMPRESS, false
 }
 
+// validateIcebergOptions checks the options of an Iceberg external table,
+// declared by ENGINE=ICEBERG or FORMAT='iceberg'. Its files are found through
+// the table metadata under FILEPATH, which may also name a metadata file.
+func validateIcebergOptions(ctx context.Context, param *tree.ExternParam) error {
+	raw := param.Option
+	if strings.EqualFold(getRawOption(raw, "format"), tree.ICEBERG) {
+		param.Format = tree.ICEBERG
+	}
+	if param.Format != tree.ICEBERG {
+		if _, ok := GetExternalOption(param, IcebergSnapshotIDKey); ok {
+			return moerr.NewBadConfigf(ctx, "the keyword '%s' is only supported by iceberg tables", IcebergSnapshotIDKey)
+		}
+		return nil
+	}
+	if err := rejectDuplicateKeys(ctx, raw, []string{"format", "filepath", IcebergSnapshotIDKey}); err != nil {
+		return err
+	}
+	for i := 0; i+1 < len(raw); i += 2 {
+		switch key := strings.ToLower(raw[i]); key {
+		case "format":
+			if !strings.EqualFold(raw[i+1], tree.ICEBERG) {
+				return moerr.NewBadConfigf(ctx, "the format '%s' conflicts with ENGINE=ICEBERG", raw[i+1])
+			}
+		case "compression", "jsondata", "hive_partitioning", "hive_partition_columns",
+			ExternalWriteFilePatternKey, CSVCommentKey:
+			return moerr.NewBadConfigf(ctx, "the keyword '%s' is not supported by iceberg tables", key)
+		case IcebergSnapshotIDKey:
+			if _, err := strconv.ParseInt(raw[i+1], 10, 64); err != nil {
+				return moerr.NewBadConfigf(ctx, "invalid %s '%s'", IcebergSnapshotIDKey, raw[i+1])
+			}
+		}
+	}
+	if tail := param.Tail; tail != nil && (tail.Fields != nil || tail.Lines != nil || tail.IgnoredLines != 0) {
+		return moerr.NewBadConfig(ctx, "iceberg tables do not support FIELDS, LINES or IGNORE clauses")
+	}
+	return nil
+}
+
 func validateWriteFilePattern(ctx context.Context, param *tree.ExternParam, tableDef *TableDef) error {
 	pattern, ok := GetWriteFilePattern(param)
 	if !ok {
@@ -5011,8 +5122,8 @@ func validateAndSetHivePartitionOptions(ctx context.Context, stmt *tree.CreateTa
 	}
 
 	rawFormat := strings.ToLower(getRawOption(raw, "format"))
-	if rawFormat != "parquet" {
-		return moerr.NewBadConfigf(ctx, "hive_partitioning currently only supports format='parquet', got '%s'", rawFormat)
+	if rawFormat != tree.PARQUET && rawFormat != tree.ORC {
+		return moerr.NewBadConfigf(ctx, "hive_partitioning currently only supports format='parquet' or 'orc', got '%s'", rawFormat)
 	}
 
 	rawFilepath := getRawOption(raw, "filepath")
@@ -5043,7 +5154,7 @@ func validateAndSetHivePartitionOptions(ctx context.Context, stmt *tree.CreateTa
 			return moerr.NewBadConfigf(ctx, "partition column '%s' cannot be a generated column", pc)
 		}
 		typId := types.T(col.Typ.Id)
-		if typId == types.T_array_float32 || typId == types.T_array_float64 {
+		if isVectorType(typId) {
 			return moerr.NewBadConfigf(ctx, "partition column '%s' cannot be a VECTOR type", pc)
 		}
 		canonical := strings.ToLower(col.Name)
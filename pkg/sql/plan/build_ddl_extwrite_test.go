@@ -472,3 +472,38 @@ func TestEffectiveWriteCompression(t *testing.T) {
 	require.False(t, yes)
 	require.Equal(t, "none", eff)
 }
+
+func TestValidateIcebergOptions(t *testing.T) {
+	ctx := context.Background()
+
+	// not iceberg: snapshot_id is rejected
+	require.NoError(t, validateIcebergOptions(ctx, &tree.ExternParam{}))
+	require.Error(t, validateIcebergOptions(ctx, &tree.ExternParam{ExParamConst: tree.ExParamConst{
+		Option: []string{"filepath", "/t", "snapshot_id", "1"},
+	}}))
+
+	// format taken from Option
+	p := &tree.ExternParam{ExParamConst: tree.ExParamConst{
+		Option: []string{"filepath", "/t", "format", "ICEBERG", "snapshot_id", "123"},
+	}}
+	require.NoError(t, validateIcebergOptions(ctx, p))
+	require.Equal(t, tree.ICEBERG, p.Format)
+
+	for _, opts := range [][]string{
+		{"filepath", "/t", "format", "parquet"},
+		{"filepath", "/t", "snapshot_id", "abc"},
+		{"filepath", "/t", "snapshot_id", "1", "snapshot_id", "2"},
+		{"filepath", "/t", "compression", "gzip"},
+		{"filepath", "/t", "hive_partitioning", "true"},
+	} {
+		p = &tree.ExternParam{ExParamConst: tree.ExParamConst{Format: tree.ICEBERG, Option: opts}}
+		require.Error(t, validateIcebergOptions(ctx, p), opts)
+	}
+
+	p = &tree.ExternParam{ExParamConst: tree.ExParamConst{
+		Format: tree.ICEBERG,
+		Option: []string{"filepath", "/t"},
+		Tail:   &tree.TailParameter{IgnoredLines: 1},
+	}}
+	require.Error(t, validateIcebergOptions(ctx, p))
+}
@@ -630,6 +630,33 @@ func TestCreateTableAsSelect(t *testing.T) {
 	runTestShouldPass(mock, t, sqls, false, false)
 }
 
+func TestCreateTableCompression(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sql := "create table t_codec (a int compression 'lz4', b int, c varchar(10) compression 'zstd(19)') compression = 'zstd(3)'"
+	logicPlan, err := runOneStmt(mock, t, sql)
+	require.NoError(t, err)
+	cols := logicPlan.GetDdl().GetCreateTable().GetTableDef().GetCols()
+	algs := make(map[string][2]int32)
+	for _, col := range cols {
+		algs[col.Name] = [2]int32{int32(col.Alg), col.AlgLevel}
+	}
+	require.Equal(t, [2]int32{int32(plan.CompressType_Lz4), 0}, algs["a"])
+	require.Equal(t, [2]int32{int32(plan.CompressType_Zstd), 3}, algs["b"])
+	require.Equal(t, [2]int32{int32(plan.CompressType_Zstd), 19}, algs["c"])
+
+	// codecs of MySQL are accepted and ignored at the table level
+	runTestShouldPass(mock, t, []string{
+		"create table t_codec2 (a int) compression = 'zlib'",
+		"create table t_codec3 (a int) compression = 'none'",
+	}, false, false)
+	runTestShouldError(mock, t, []string{
+		"create table t_codec4 (a int compression 'zlib')",
+		"create table t_codec5 (a int compression 'none')",
+		"create table t_codec6 (a int compression 'zstd(30)')",
+		"create table t_codec7 (a int) compression = 'zstd(-1)'",
+	})
+}
+
 func TestCreateTableAsSelectPreservesIntervalSyntax(t *testing.T) {
 	got := restoreIntervalSyntaxForCTAS(
 		"select date_add(col2, interval(45, day)), date_sub(col2, interval(5, day)) from time01",
@@ -212,7 +212,8 @@ func checkIndexColumnSupportability(ctx context.Context, col *ColDef, keyPart *t
 		return moerr.NewNotSupported(ctx, fmt.Sprintf("DATALINK column '%s' cannot be in index", colName))
 	case int32(types.T_json):
 		return moerr.NewNotSupported(ctx, fmt.Sprintf("JSON column '%s' cannot be in index", colName))
-	case int32(types.T_array_float32), int32(types.T_array_float64):
+	case int32(types.T_array_float32), int32(types.T_array_float64),
+		int32(types.T_array_float16), int32(types.T_array_int8), int32(types.T_array_bit):
 		if indexKind == "ivfflat" || indexKind == "hnsw" {
 			return nil
 		}
@@ -218,54 +218,68 @@ func IgnoredLines(param *tree.ExternParam, ctx CompilerContext) (offset int64, e
 }
 
 func validateLoadParquetOptions(param *tree.ExternParam, ctx CompilerContext) error {
-	if param == nil || !isLoadParquetFormat(param) {
+	if param == nil {
+		return nil
+	}
+	format := loadColumnarFormat(param)
+	if format == "" {
 		return nil
 	}
 	if param.Local {
-		return moerr.NewNYI(ctx.GetContext(), "load parquet local")
+		return moerr.NewNYIf(ctx.GetContext(), "load %s local", format)
 	}
 	if loadOptionExists(param, "compression") || hasExplicitLoadCompression(param.CompressType) {
-		return moerr.NewBadConfig(ctx.GetContext(), "LOAD DATA with format='parquet' does not support compression option")
+		return moerr.NewBadConfigf(ctx.GetContext(), "LOAD DATA with format='%s' does not support compression option", format)
 	}
 	if loadOptionExists(param, "jsondata") || param.JsonData != "" {
-		return moerr.NewBadConfig(ctx.GetContext(), "LOAD DATA with format='parquet' does not support jsondata option")
+		return moerr.NewBadConfigf(ctx.GetContext(), "LOAD DATA with format='%s' does not support jsondata option", format)
 	}
 	if loadOptionExists(param, "hive_partitioning") || loadOptionExists(param, "hive_partition_columns") ||
 		param.HivePartitioning || len(param.HivePartitionCols) > 0 {
-		return moerr.NewBadConfig(ctx.GetContext(), "LOAD DATA with format='parquet' does not support hive partitioning options")
+		return moerr.NewBadConfigf(ctx.GetContext(), "LOAD DATA with format='%s' does not support hive partitioning options", format)
 	}
 	if param.Tail == nil {
 		return nil
 	}
 	if param.Tail.Fields != nil {
-		return moerr.NewBadConfig(ctx.GetContext(), "LOAD DATA with format='parquet' does not support FIELDS option")
+		return moerr.NewBadConfigf(ctx.GetContext(), "LOAD DATA with format='%s' does not support FIELDS option", format)
 	}
 	if param.Tail.Lines != nil {
-		return moerr.NewBadConfig(ctx.GetContext(), "LOAD DATA with format='parquet' does not support LINES option")
+		return moerr.NewBadConfigf(ctx.GetContext(), "LOAD DATA with format='%s' does not support LINES option", format)
 	}
 	if param.Tail.IgnoredLines > 0 {
-		return moerr.NewBadConfig(ctx.GetContext(), "LOAD DATA with format='parquet' does not support IGNORE LINES")
+		return moerr.NewBadConfigf(ctx.GetContext(), "LOAD DATA with format='%s' does not support IGNORE LINES", format)
 	}
 	if hasLoadUserVariable(param.Tail.ColumnList) {
-		return moerr.NewNYI(ctx.GetContext(), "parquet load with @variables in column list")
+		return moerr.NewNYIf(ctx.GetContext(), "%s load with @variables in column list", format)
 	}
 	if len(param.Tail.Assignments) > 0 {
-		return moerr.NewNYI(ctx.GetContext(), "parquet load with SET clause")
+		return moerr.NewNYIf(ctx.GetContext(), "%s load with SET clause", format)
 	}
 	return nil
 }
 
-func isLoadParquetFormat(param *tree.ExternParam) bool {
-	if param.Format == tree.PARQUET {
-		return true
+// loadColumnarFormat returns the self-describing binary format (parquet,
+// arrow or orc) of a LOAD, or "" for the text formats.
+func loadColumnarFormat(param *tree.ExternParam) string {
+	if param.Format == tree.PARQUET || param.Format == tree.ARROW || param.Format == tree.ORC {
+		return param.Format
 	}
 	for i := 0; i+1 < len(param.Option); i += 2 {
-		if strings.EqualFold(param.Option[i], "format") &&
-			strings.EqualFold(param.Option[i+1], tree.PARQUET) {
-			return true
+		if !strings.EqualFold(param.Option[i], "format") {
+			continue
+		}
+		if strings.EqualFold(param.Option[i+1], tree.PARQUET) {
+			return tree.PARQUET
+		}
+		if strings.EqualFold(param.Option[i+1], tree.ARROW) {
+			return tree.ARROW
+		}
+		if strings.EqualFold(param.Option[i+1], tree.ORC) {
+			return tree.ORC
 		}
 	}
-	return false
+	return ""
 }
 
 func loadOptionExists(param *tree.ExternParam, key string) bool {
@@ -369,6 +383,8 @@ func estimateLoadRowsizeFromFirstLine(param *tree.ExternParam, inputSize int64,
 		param.ScanType == tree.INLINE ||
 		param.Local ||
 		param.Format == tree.PARQUET ||
+		param.Format == tree.ARROW ||
+		param.Format == tree.ORC ||
 		getCompressType(param, param.Filepath) != tree.NOCOMPRESS ||
 		(lineTerminator != "\n" && lineTerminator != "\r\n") ||
 		strings.HasPrefix(param.Filepath, "SHARED:/query_result/") {
@@ -613,7 +629,7 @@ func buildLoad(stmt *tree.Load, ctx CompilerContext, isPrepareStmt bool) (*Plan,
 		builder.qry.LoadWriteS3 = false
 	}
 
-	if stmt.Param.Parallel && noCompress && stmt.Param.Format != tree.PARQUET {
+	if stmt.Param.Parallel && noCompress && stmt.Param.Format != tree.PARQUET && stmt.Param.Format != tree.ARROW && stmt.Param.Format != tree.ORC {
 		projectNode.ProjectList = makeCastExpr(stmt, fileName, originTableDef, projectNode)
 	}
 	lastNodeId = builder.appendNode(projectNode, bindCtx)
@@ -697,6 +713,10 @@ func checkFileExist(param *tree.ExternParam, ctx CompilerContext) (string, error
 			return "", err
 		}
 	}
+	if param.Format == tree.ICEBERG {
+		// Iceberg tables are queried through external tables
+		return "", moerr.NewNotSupported(ctx.GetContext(), "load data from an iceberg table")
+	}
 	if param.Local {
 		return param.Filepath, nil
 	}
@@ -838,6 +858,10 @@ func getCompressType(param *tree.ExternParam, filepath string) string {
 		return tree.BZIP2
 	case "lz4":
 		return tree.LZ4
+	case "zst", "zstd":
+		return tree.ZSTD
+	case "sz", "snappy":
+		return tree.SNAPPY
 	default:
 		return tree.NOCOMPRESS
 	}
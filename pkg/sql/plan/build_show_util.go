@@ -120,7 +120,9 @@ func ConstructCreateTableSQL(
 				buf.WriteString(" NOT NULL")
 			}
 			buf.WriteString(" GENERATED ALWAYS AS (" + col.GeneratedCol.OriginString + ")")
-			if col.GeneratedCol.IsStored {
+			if col.GeneratedCol.IsAsync {
+				buf.WriteString(" STORED ASYNC")
+			} else if col.GeneratedCol.IsStored {
 				buf.WriteString(" STORED")
 			} else {
 				buf.WriteString(" VIRTUAL")
@@ -153,6 +155,15 @@ func ConstructCreateTableSQL(
 			buf.WriteString(" COMMENT '" + col.Comment + "'")
 		}
 
+		// lz4 is the default codec and is not shown
+		if col.Alg == plan.CompressType_Zstd {
+			if col.AlgLevel == 0 {
+				buf.WriteString(" COMPRESSION 'zstd'")
+			} else {
+				fmt.Fprintf(buf, " COMPRESSION 'zstd(%d)'", col.AlgLevel)
+			}
+		}
+
 		createStr += buf.String()
 		rowCount++
 		if col.Primary {
@@ -938,7 +949,7 @@ func FormatColType(colType plan.Type) string {
 	case types.T_bit, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
 		suffix = fmt.Sprintf("(%d)", colType.Width)
 
-	case types.T_array_float32, types.T_array_float64:
+	case types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		suffix = fmt.Sprintf("(%d)", colType.Width)
 
 	}
@@ -1001,6 +1012,17 @@ func formatInfileExternalOptionsForShowCreate(param *tree.ExternParam) string {
 		appendInfileOptionForShowCreate(&parts, "COMMENT", GetCSVComment(param))
 		return " INFILE{" + strings.Join(parts, ",") + "}"
 	}
+	if param.Format == tree.ICEBERG {
+		// the location of the table is part of its definition, like the base
+		// path of a hive partitioned table
+		parts := []string{
+			"'FILEPATH'=" + formatStrLit(param.Filepath),
+			"'FORMAT'=" + formatStrLit(param.Format),
+		}
+		snapshotID, _ := GetExternalOption(param, IcebergSnapshotIDKey)
+		appendInfileOptionForShowCreate(&parts, "SNAPSHOT_ID", snapshotID)
+		return " INFILE{" + strings.Join(parts, ",") + "}"
+	}
 	filepath := ""
 	if param.HivePartitioning {
 		filepath = param.Filepath
@@ -1057,6 +1079,8 @@ func formatS3ExternalOptionsForShowCreate(param *tree.ExternParam) string {
 	// changes which rows are returned; round-trip it (omitted when unset).
 	appendExternalOptionForShowCreate(&parts, CSVCommentKey, GetCSVComment(param), false)
 	appendHivePartitionOptionsForShowCreate(&parts, param, false)
+	snapshotID, _ := GetExternalOption(param, IcebergSnapshotIDKey)
+	appendExternalOptionForShowCreate(&parts, IcebergSnapshotIDKey, snapshotID, false)
 	return " URL s3option{" + strings.Join(parts, ",") + "}"
 }
 
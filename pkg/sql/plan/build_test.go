@@ -788,6 +788,18 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"SELECT MAX(n_nationkey) - INTERVAL 7 DAY FROM nation",
 		"select 2222332222222223333333333333333333, 0x616263,-10, bit_and(2), bit_or(2), 'aaa' like '%a',str_to_date('04/31/2004', '%m/%d/%Y'),unix_timestamp(from_unixtime(2147483647))",
 		"select max(n_nationkey) over  (partition by N_REGIONKEY) from nation",
+		"select corr(n_nationkey, n_regionkey), covar_pop(n_nationkey, n_regionkey), covar_samp(n_nationkey, n_regionkey) from nation",
+		"select n_name, regr_slope(n_nationkey, n_regionkey), regr_intercept(n_nationkey, n_regionkey), regr_r2(n_nationkey, n_regionkey), regr_count(n_nationkey, n_regionkey) from nation group by n_name",
+		"select array_agg(n_name) from nation group by n_regionkey",
+		"select corr(n_nationkey, n_regionkey) over (partition by n_name order by n_nationkey) from nation",
+		"select array_agg(n_name) over (order by n_nationkey rows between 1 preceding and current row) from nation",
+		"select n_regionkey, percentile_cont(0.5) within group (order by n_nationkey), percentile_disc(0.9) within group (order by n_nationkey desc) from nation group by n_regionkey",
+		"select percentile_cont(0.5) within group (order by n_nationkey) from nation having percentile_cont(0.5) within group (order by n_nationkey) > 1",
+		"select percentile_disc(0.5) within group (order by n_nationkey) over (partition by n_regionkey) from nation",
+		"select approx_percentile(n_nationkey, 0.99), tdigest_percentile(tdigest_add_agg(n_nationkey), 0.5) from nation",
+		"select tdigest_percentile(tdigest_merge_agg(s), 0.95) from (select tdigest_add_agg(n_nationkey) as s from nation group by n_regionkey) t",
+		"select n_regionkey, min_by(n_name, n_nationkey), max_by(n_name, n_nationkey), mode(n_name), approx_top_k(n_name, 3) from nation group by n_regionkey",
+		"select max_by(n_nationkey, n_name) over (partition by n_regionkey), mode(n_regionkey) over () from nation",
 		"select * from generate_series(1, 5) g",
 		"prepare stmt1 from select * from nation where n_name like ? or n_nationkey > 10 order by 2 limit '10'",
 
@@ -816,6 +828,19 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"select n_nationkey, sum(n_nationkey) from nation",
 		"SET @var = abs(a)", // can't use column
 		"SET @var = avg(2)", // can't use agg function
+		"select abs(n_nationkey) over (partition by n_regionkey) from nation", // not a window function
+		"select corr(n_nationkey) from nation",
+		"select regr_slope(n_name, n_comment) from nation",
+		"select percentile_cont(0.5) from nation",
+		"select percentile_cont(0.5, 0.9) within group (order by n_nationkey) from nation",
+		"select percentile_cont(0.5) within group (order by n_name) from nation",
+		"select percentile_cont(n_nationkey) within group (order by n_nationkey) from nation",
+		"select approx_percentile(n_nationkey, n_regionkey) from nation",
+		"select approx_top_k(n_name, n_regionkey) from nation",
+		"select approx_top_k(n_name, 'a') from nation",
+		"select min_by(n_name) from nation",
+		"select corr(n_nationkey, n_regionkey) within group (order by n_nationkey) from nation",
+		"select abs(n_nationkey) within group (order by n_nationkey) from nation",
 
 		"SELECT DISTINCT N_NAME FROM NATION GROUP BY N_REGIONKEY", //test distinct with group by
 		"SELECT DISTINCT N_NAME FROM NATION ORDER BY N_REGIONKEY", //test distinct with order by
@@ -3656,3 +3681,30 @@ func TestSubqueryInJoinOn(t *testing.T) {
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 }
+
+func TestAsyncGeneratedColumnDML(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	setMockGeneratedColumn(t, mock, "emp", "ename", "job")
+	for _, col := range mock.ctxt.tables["emp"].Cols {
+		if col.Name == "ename" {
+			col.GeneratedCol.IsAsync = true
+		}
+	}
+
+	sqls := []string{
+		"INSERT INTO emp (empno, job) VALUES (1, 'a')",
+		"UPDATE emp SET job = 'b' WHERE empno = 1",
+		"UPDATE emp SET comm = 1 WHERE empno = 1",
+		"UPDATE emp SET ename = DEFAULT WHERE empno = 1 AND ename IS NULL",
+		"UPDATE emp, dept SET emp.job = dept.loc WHERE emp.deptno = dept.deptno",
+	}
+	for _, sql := range sqls {
+		if _, err := runOneStmt(mock, t, sql); err != nil {
+			t.Fatalf("build %q with async generated column: %v", sql, err)
+		}
+	}
+
+	if _, err := runOneStmt(mock, t, "UPDATE emp SET ename = 'x' WHERE empno = 1"); err == nil {
+		t.Fatalf("async generated column should not accept explicit values")
+	}
+}
@@ -241,12 +241,31 @@ func rewriteGeneratedColumnsForUpdate(builder *QueryBuilder, planCtxs []*dmlPlan
 				if col.GeneratedCol == nil {
 					continue
 				}
-				if _, alreadySet := upPlanCtx.updateColPosMap[col.Name]; alreadySet {
+				genExpr := substituteColRefsInExpr(col.GeneratedCol.Expr, baseLookup, 0)
+				if off, alreadySet := upPlanCtx.updateColPosMap[col.Name]; alreadySet {
 					// SET on a stored generated column was rejected earlier
-					// (or dropped for SET = DEFAULT); should not happen here.
+					// (or dropped for SET = DEFAULT); only SET async_col =
+					// DEFAULT gets here, which computes the column now.
+					if col.GeneratedCol.IsAsync {
+						selectNode.ProjectList[tableBase+int32(off)] = genExpr
+						baseLookup[ci] = genExpr
+					}
 					continue
 				}
-				genExpr := substituteColRefsInExpr(col.GeneratedCol.Expr, baseLookup, 0)
+				if col.GeneratedCol.IsAsync {
+					// An async column keeps its value unless its inputs change,
+					// then it is reset to NULL for its ISCP job to fill in.
+					if !generatedColRefsUpdated(tableDef, col.GeneratedCol.Expr, func(name string) bool {
+						_, ok := upPlanCtx.updateColPosMap[name]
+						return ok
+					}) {
+						continue
+					}
+					var err error
+					if genExpr, err = getDefaultExpr(builder.GetContext(), col); err != nil {
+						return err
+					}
+				}
 				insertPos := int(tableBase) + len(tableDef.Cols) + upPlanCtx.updateColLength
 				selectNode.ProjectList = append(selectNode.ProjectList, nil)
 				copy(selectNode.ProjectList[insertPos+1:], selectNode.ProjectList[insertPos:])
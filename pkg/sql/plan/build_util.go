@@ -157,7 +157,7 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (plan
 				// create table t1(a char) -> DisplayWith = -1；but get width=1 in MySQL and PgSQL
 				if fstr == "char" || fstr == "binary" {
 					width = 1
-				} else if fstr == "vecf32" || fstr == "vecf64" {
+				} else if isVectorFamily(fstr) {
 					width = types.MaxArrayDimension
 				} else {
 					width = types.MaxVarcharLen
@@ -168,13 +168,16 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (plan
 				return plan.Type{}, moerr.NewOutOfRangef(ctx, fstr, " typeLen is over the MaxCharLen: %v", types.MaxCharLen)
 			} else if (fstr == "varchar" || fstr == "varbinary") && width > types.MaxVarcharLen {
 				return plan.Type{}, moerr.NewOutOfRangef(ctx, fstr, " typeLen is over the MaxVarcharLen: %v", types.MaxVarcharLen)
-			} else if fstr == "vecf32" || fstr == "vecf64" {
+			} else if isVectorFamily(fstr) {
 				if width > types.MaxArrayDimension {
 					return plan.Type{}, moerr.NewOutOfRangef(ctx, fstr, " typeLen is over the MaxVectorLen : %v", types.MaxArrayDimension)
 				}
 				if width < 1 {
 					return plan.Type{}, moerr.NewOutOfRange(ctx, fstr, " typeLen cannot be less than 1")
 				}
+				if fstr == "vecbit" && width != types.MaxArrayDimension && width%8 != 0 {
+					return plan.Type{}, moerr.NewOutOfRange(ctx, fstr, " typeLen must be a multiple of 8")
+				}
 			}
 			switch fstr {
 			case "char":
@@ -187,6 +190,12 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (plan
 				return plan.Type{Id: int32(types.T_array_float32), Width: width}, nil
 			case "vecf64":
 				return plan.Type{Id: int32(types.T_array_float64), Width: width}, nil
+			case "vecf16":
+				return plan.Type{Id: int32(types.T_array_float16), Width: width}, nil
+			case "veci8":
+				return plan.Type{Id: int32(types.T_array_int8), Width: width}, nil
+			case "vecbit":
+				return plan.Type{Id: int32(types.T_array_bit), Width: width}, nil
 			}
 			// varbinary
 			return plan.Type{Id: int32(types.T_varbinary), Width: width}, nil
@@ -475,6 +484,11 @@ func buildGeneratedExpr(col *tree.ColumnTableDef, typ plan.Type, existingCols []
 		}
 	}
 
+	// Validate: an async column is NULL until its background job fills it
+	if genAttr.Async && !getColumnNullAbility(col) {
+		return nil, moerr.NewInvalidInputf(proc.Ctx, "async generated column '%s' cannot be NOT NULL", colNameOrigin)
+	}
+
 	// Collect column names and types from existing (non-generated or already-defined generated) columns
 	colNames := make([]string, len(existingCols))
 	colTypes := make([]plan.Type, len(existingCols))
@@ -512,9 +526,45 @@ func buildGeneratedExpr(col *tree.ColumnTableDef, typ plan.Type, existingCols []
 		Expr:         genExpr,
 		OriginString: fmtCtx.String(),
 		IsStored:     genAttr.Stored,
+		IsAsync:      genAttr.Async,
 	}, nil
 }
 
+// checkAsyncGeneratedColTable checks that the async generated column col can
+// be added to tableDef: its background job updates rows by primary key, so the
+// table needs a real primary key and the column cannot be part of it.
+func checkAsyncGeneratedColTable(ctx context.Context, tableDef *TableDef, col *ColDef) error {
+	if col.GeneratedCol == nil || !col.GeneratedCol.IsAsync {
+		return nil
+	}
+	if col.Primary {
+		return moerr.NewNotSupportedf(ctx, "defining an async generated column '%s' as primary key", col.GetOriginCaseName())
+	}
+	if tableDef.Pkey == nil || tableDef.Pkey.PkeyColName == catalog.FakePrimaryKeyColName {
+		return moerr.NewNotSupportedf(ctx, "async generated column '%s' in a table without primary key", col.GetOriginCaseName())
+	}
+	return nil
+}
+
+// generatedColRefsUpdated reports whether expr reads, directly or through
+// other generated columns, a column of tableDef for which updated is true.
+// UPDATE uses it to reset async generated columns whose inputs change.
+func generatedColRefsUpdated(tableDef *TableDef, expr *plan.Expr, updated func(name string) bool) bool {
+	for _, pos := range collectRefColPos(expr) {
+		if int(pos) >= len(tableDef.Cols) {
+			continue
+		}
+		ref := tableDef.Cols[pos]
+		if updated(ref.Name) {
+			return true
+		}
+		if ref.GeneratedCol != nil && generatedColRefsUpdated(tableDef, ref.GeneratedCol.Expr, updated) {
+			return true
+		}
+	}
+	return false
+}
+
 // checkGeneratedExprReferences rejects variable references and auto-increment
 // dependencies in generated-column expressions, including indirect references
 // through earlier generated columns.
@@ -531,6 +581,9 @@ func checkGeneratedExprReferences(ctx context.Context, expr *plan.Expr, currentC
 		if refCol.Typ.AutoIncr {
 			return moerr.NewInvalidInputf(ctx, "generated column '%s' cannot refer to auto-increment column", currentColName)
 		}
+		if refCol.GeneratedCol != nil && refCol.GeneratedCol.IsAsync {
+			return moerr.NewInvalidInputf(ctx, "generated column '%s' cannot refer to async generated column '%s'", currentColName, refCol.GetOriginCaseName())
+		}
 		if refCol.GeneratedCol != nil && refCol.GeneratedCol.Expr != nil && !visited[e.Col.ColPos] {
 			visited[e.Col.ColPos] = true
 			return checkGeneratedExprReferences(ctx, refCol.GeneratedCol.Expr, currentColName, cols, visited)
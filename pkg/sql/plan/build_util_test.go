@@ -18,6 +18,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/stretchr/testify/assert"
@@ -564,3 +565,84 @@ func TestBuildGeneratedExprUsesStrictForCharVarchar(t *testing.T) {
 	require.NotNil(t, gen)
 	require.Equal(t, "cast_strict", gen.Expr.GetF().GetFunc().GetObjName())
 }
+
+func TestBuildGeneratedExprAsync(t *testing.T) {
+	proc := testutil.NewProcess(t)
+	getGenCol := func(sql string) *tree.ColumnTableDef {
+		stmt, err := mysql.ParseOne(context.Background(), sql, 1)
+		require.NoError(t, err)
+		createTable, ok := stmt.(*tree.CreateTable)
+		require.True(t, ok)
+		for _, def := range createTable.Defs {
+			if cd, ok := def.(*tree.ColumnTableDef); ok && cd.Name.ColNameOrigin() == "g" {
+				return cd
+			}
+		}
+		t.Fatal("missing generated column g")
+		return nil
+	}
+	existingCols := []*ColDef{{Name: "t", Typ: plan.Type{Id: int32(types.T_text)}}}
+	typ := plan.Type{Id: int32(types.T_text)}
+
+	gen, err := buildGeneratedExpr(getGenCol(
+		"create table t (t text, g text generated always as (concat(t, 'x')) stored async)"), typ, existingCols, proc)
+	require.NoError(t, err)
+	require.True(t, gen.IsStored)
+	require.True(t, gen.IsAsync)
+
+	_, err = buildGeneratedExpr(getGenCol(
+		"create table t (t text, g text generated always as (concat(t, 'x')) stored async not null)"), typ, existingCols, proc)
+	require.Error(t, err)
+
+	// a generated column cannot read an async one, it may still be NULL
+	asyncCols := []*ColDef{
+		{Name: "t", Typ: typ},
+		{Name: "a", Typ: typ, GeneratedCol: &plan.GeneratedCol{IsStored: true, IsAsync: true}},
+	}
+	_, err = buildGeneratedExpr(getGenCol(
+		"create table t (t text, a text, g text generated always as (concat(a, 'x')) stored)"), typ, asyncCols, proc)
+	require.Error(t, err)
+}
+
+func TestCheckAsyncGeneratedColTable(t *testing.T) {
+	ctx := context.Background()
+	col := &ColDef{Name: "g", GeneratedCol: &plan.GeneratedCol{IsStored: true, IsAsync: true}}
+
+	require.NoError(t, checkAsyncGeneratedColTable(ctx, &TableDef{Pkey: &plan.PrimaryKeyDef{PkeyColName: "id"}}, col))
+	require.Error(t, checkAsyncGeneratedColTable(ctx, &TableDef{}, col))
+	require.Error(t, checkAsyncGeneratedColTable(ctx, &TableDef{Pkey: &plan.PrimaryKeyDef{PkeyColName: catalog.FakePrimaryKeyColName}}, col))
+
+	pkCol := &ColDef{Name: "g", Primary: true, GeneratedCol: col.GeneratedCol}
+	require.Error(t, checkAsyncGeneratedColTable(ctx, &TableDef{Pkey: &plan.PrimaryKeyDef{PkeyColName: "g"}}, pkCol))
+
+	// sync generated columns are not restricted
+	syncCol := &ColDef{Name: "g", GeneratedCol: &plan.GeneratedCol{IsStored: true}}
+	require.NoError(t, checkAsyncGeneratedColTable(ctx, &TableDef{}, syncCol))
+}
+
+func TestGeneratedColRefsUpdated(t *testing.T) {
+	colRef := func(pos int32, name string) *plan.Expr {
+		return &plan.Expr{Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos, Name: name}}}
+	}
+	tableDef := &TableDef{Cols: []*ColDef{
+		{Name: "a"},
+		{Name: "b"},
+		{Name: "c", GeneratedCol: &plan.GeneratedCol{Expr: colRef(1, "b")}},
+	}}
+	updated := func(names ...string) func(string) bool {
+		return func(name string) bool {
+			for _, n := range names {
+				if n == name {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	require.True(t, generatedColRefsUpdated(tableDef, colRef(0, "a"), updated("a")))
+	require.False(t, generatedColRefsUpdated(tableDef, colRef(0, "a"), updated("b")))
+	// indirect reference through generated column c
+	require.True(t, generatedColRefsUpdated(tableDef, colRef(2, "c"), updated("b")))
+	require.False(t, generatedColRefsUpdated(tableDef, colRef(2, "c"), updated("a")))
+}
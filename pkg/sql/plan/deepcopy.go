@@ -337,6 +337,7 @@ func DeepCopyColDef(col *plan.ColDef) *plan.ColDef {
 		Name:         col.Name,
 		OriginName:   col.OriginName,
 		Alg:          col.Alg,
+		AlgLevel:     col.AlgLevel,
 		Typ:          col.Typ,
 		Default:      DeepCopyDefault(col.Default),
 		Primary:      col.Primary,
@@ -428,6 +429,7 @@ func DeepCopyGeneratedCol(old *plan.GeneratedCol) *plan.GeneratedCol {
 		Expr:         DeepCopyExpr(old.Expr),
 		OriginString: old.OriginString,
 		IsStored:     old.IsStored,
+		IsAsync:      old.IsAsync,
 	}
 }
 
@@ -176,7 +176,7 @@ func getExternalStats(node *plan.Node, builder *QueryBuilder) *Stats {
 		return DefaultHugeStats()
 	}
 
-	if param.HivePartitioning {
+	if param.HivePartitioning || param.Format == tree.ICEBERG {
 		return DefaultHugeStats()
 	}
 
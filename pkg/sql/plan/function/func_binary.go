@@ -8171,6 +8171,27 @@ func L2DistanceArray[T types.RealNumbers](ivecs []*vector.Vector, result vector.
 	}, selectList)
 }
 
+// QuantizedDistanceArray returns the distance function of metric m for vecf16, veci8 and vecbit.
+func QuantizedDistanceArray(m metric.MetricType) executeLogicOfOverload {
+	return func(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+		oid := ivecs[0].GetType().Oid
+		distFn, err := metric.ResolveQuantizedDistanceFn(oid, m)
+		if err != nil {
+			return err
+		}
+		return opBinaryBytesBytesToFixedWithErrorCheck[float64](ivecs, result, proc, length, func(v1, v2 []byte) (out float64, err error) {
+			if len(v1) != len(v2) {
+				return 0, moerr.NewArrayInvalidOpNoCtx(types.QuantizedArrayDimension(oid, v1), types.QuantizedArrayDimension(oid, v2))
+			}
+			out, err = distFn(v1, v2)
+			if m == metric.Metric_L2Distance {
+				out = math.Sqrt(out)
+			}
+			return out, err
+		}, selectList)
+	}
+}
+
 // StGeoHashFromPoint is ST_GeoHash(point, max_length): the geohash of a point.
 func StGeoHashFromPoint(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
 	return opBinaryStrFixedToStrWithErrorCheck[int64](ivecs, result, proc, length, func(v string, maxLen int64) (string, error) {
@@ -30,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/geo"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vectorindex/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
@@ -8649,6 +8650,89 @@ func TestCosineDistanceArray(t *testing.T) {
 	}
 }
 
+func TestQuantizedDistanceArray(t *testing.T) {
+	quantize := func(oid types.T, vs ...[]float32) []string {
+		res := make([]string, len(vs))
+		for i, v := range vs {
+			b, err := types.Float32ToQuantizedBytes(oid, v)
+			require.NoError(t, err)
+			res[i] = string(b)
+		}
+		return res
+	}
+
+	testCases := []struct {
+		info   string
+		typ    types.T
+		metric metric.MetricType
+		v1, v2 [][]float32
+		expect []float64
+	}{
+		{
+			info:   "test L2Distance float16 array",
+			typ:    types.T_array_float16,
+			metric: metric.Metric_L2Distance,
+			v1:     [][]float32{{1, 2, 3}, {4, 5, 6}},
+			v2:     [][]float32{{1, 2, 3}, {4, 5, 10}},
+			expect: []float64{0, 4},
+		},
+		{
+			info:   "test L2DistanceSq int8 array",
+			typ:    types.T_array_int8,
+			metric: metric.Metric_L2sqDistance,
+			v1:     [][]float32{{1, 2, 3}, {-4, 5, 6}},
+			v2:     [][]float32{{1, 2, 3}, {4, 5, 7}},
+			expect: []float64{0, 65},
+		},
+		{
+			info:   "test InnerProduct int8 array",
+			typ:    types.T_array_int8,
+			metric: metric.Metric_InnerProduct,
+			v1:     [][]float32{{1, 2, 3}, {-4, 5, 6}},
+			v2:     [][]float32{{1, 2, 3}, {4, 5, 7}},
+			expect: []float64{-14, -51},
+		},
+		{
+			info:   "test Hamming bit array",
+			typ:    types.T_array_bit,
+			metric: metric.Metric_L1Distance,
+			v1:     [][]float32{{1, 0, 1, 0, 1, 0, 1, 0}, {1, 1, 1, 1, 0, 0, 0, 0}},
+			v2:     [][]float32{{1, 0, 1, 0, 1, 0, 1, 0}, {0, 0, 0, 0, 1, 1, 1, 1}},
+			expect: []float64{0, 8},
+		},
+		{
+			info:   "test CosineDistance bit array",
+			typ:    types.T_array_bit,
+			metric: metric.Metric_CosineDistance,
+			v1:     [][]float32{{1, 1, 0, 0, 0, 0, 0, 0}, {1, 1, 1, 1, 0, 0, 0, 0}},
+			v2:     [][]float32{{1, 1, 0, 0, 0, 0, 0, 0}, {0, 0, 0, 0, 1, 1, 1, 1}},
+			expect: []float64{0, 1},
+		},
+	}
+
+	proc := testutil.NewProcess(t)
+	for _, tc := range testCases {
+		inputs := []FunctionTestInput{
+			NewFunctionTestInput(tc.typ.ToType(), quantize(tc.typ, tc.v1...), []bool{false, false}),
+			NewFunctionTestInput(tc.typ.ToType(), quantize(tc.typ, tc.v2...), []bool{false, false}),
+		}
+		expect := NewFunctionTestResult(types.T_float64.ToType(), false, tc.expect, []bool{false, false})
+		fcTC := NewFunctionTestCase(proc, inputs, expect, fEvalFn(QuantizedDistanceArray(tc.metric)))
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+
+	// the dimensions must match
+	inputs := []FunctionTestInput{
+		NewFunctionTestInput(types.T_array_int8.ToType(), quantize(types.T_array_int8, []float32{1, 2}), []bool{false}),
+		NewFunctionTestInput(types.T_array_int8.ToType(), quantize(types.T_array_int8, []float32{1, 2, 3}), []bool{false}),
+	}
+	expect := NewFunctionTestResult(types.T_float64.ToType(), true, []float64{0}, []bool{false})
+	fcTC := NewFunctionTestCase(proc, inputs, expect, fEvalFn(QuantizedDistanceArray(metric.Metric_L2Distance)))
+	s, info := fcTC.Run()
+	require.True(t, s, info)
+}
+
 // Extract
 func initExtractTestCase() []tcTemp {
 	MakeDates := func(values ...string) []types.Date {
@@ -111,22 +111,36 @@ func (op *opBuiltInLlmFunction) llmEmbedding(params []*vector.Vector, result vec
 	queryWrapper := vector.GenerateFunctionStrParameter(queryVec)
 	rs := vector.MustFunctionResult[types.Varlena](result)
 
+	// embed all non-null rows of the batch in one request, the server is
+	// far more efficient on batches than on single texts.
+	texts := make([]string, 0, length)
 	for i := uint64(0); i < uint64(length); i++ {
 		query, isNull := queryWrapper.GetStrValue(i)
-		if isNull {
+		if !isNull {
+			texts = append(texts, string(query))
+		}
+	}
+	var embs [][]float32
+	if len(texts) > 0 {
+		if embs, err = op.llmClient.CreateEmbeddings(proc.Ctx, texts); err != nil {
+			return err
+		}
+		if len(embs) != len(texts) {
+			return moerr.NewInternalErrorf(proc.Ctx, "llm_embedding: got %d embeddings for %d texts", len(embs), len(texts))
+		}
+	}
+
+	for i, j := uint64(0), 0; i < uint64(length); i++ {
+		if _, isNull := queryWrapper.GetStrValue(i); isNull {
 			if err = rs.AppendBytes(nil, true); err != nil {
 				return err
 			}
 			continue
-		} else {
-			reply, err := op.llmClient.CreateEmbedding(proc.Ctx, string(query))
-			if err != nil {
-				return err
-			}
-			if err = rs.AppendBytes(types.ArrayToBytes(reply), false); err != nil {
-				return err
-			}
 		}
+		if err = rs.AppendBytes(types.ArrayToBytes(embs[j]), false); err != nil {
+			return err
+		}
+		j++
 	}
 	return nil
 }
@@ -0,0 +1,48 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/monlp/llm"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLlmEmbeddingBatch(t *testing.T) {
+	proc := testutil.NewProcess(t)
+	client, err := llm.NewMockClient(llm.MockEchoModel, "")
+	require.NoError(t, err)
+	texts := []string{"a", "", "hello world", "b"}
+	embs, err := client.CreateEmbeddings(context.Background(), texts)
+	require.NoError(t, err)
+	embs[1] = []float32{}
+
+	tcc := NewFunctionTestCase(proc,
+		[]FunctionTestInput{
+			NewFunctionTestConstInput(types.T_varchar.ToType(), []string{llm.MockServer}, []bool{false}),
+			NewFunctionTestConstInput(types.T_varchar.ToType(), []string{""}, []bool{false}),
+			NewFunctionTestConstInput(types.T_varchar.ToType(), []string{llm.MockEchoModel}, []bool{false}),
+			NewFunctionTestConstInput(types.T_varchar.ToType(), []string{""}, []bool{true}),
+			NewFunctionTestInput(types.T_varchar.ToType(), texts, []bool{false, true, false, false}),
+		},
+		NewFunctionTestResult(types.T_array_float32.ToType(), false, embs, []bool{false, true, false, false}),
+		newOpBuiltInLlmFunction().llmEmbedding)
+	succeed, info := tcc.Run()
+	require.True(t, succeed, info)
+}
@@ -55,6 +55,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_year,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 		types.T_datalink, types.T_geometry, types.T_geometry32,
 	},
 
@@ -299,6 +300,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 		types.T_datalink, types.T_geometry, types.T_geometry32,
 		types.T_TS,
 	},
@@ -347,6 +349,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 		types.T_datalink, types.T_geometry, types.T_geometry32,
 	},
 
@@ -365,6 +368,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 		types.T_datalink, types.T_geometry, types.T_geometry32,
 	},
 	types.T_geometry: {
@@ -427,9 +431,23 @@ var supportedTypeCast = map[types.T][]types.T{
 
 	types.T_array_float32: {
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 	},
 	types.T_array_float64: {
 		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
+	},
+	types.T_array_float16: {
+		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
+	},
+	types.T_array_int8: {
+		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
+	},
+	types.T_array_bit: {
+		types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 	},
 }
 
@@ -528,7 +546,8 @@ func newCast(parameters []*vector.Vector, result vector.FunctionResultWrapper, p
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_datalink, types.T_geometry, types.T_geometry32:
 		s := vector.GenerateFunctionStrParameter(from)
 		err = strTypeToOthers(proc, s, *toType, result, length, selectList, strictStringWidth)
-	case types.T_array_float32, types.T_array_float64:
+	case types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		//NOTE: Don't mix T_array and T_varchar.
 		// T_varchar will have "[1,2,3]" string
 		// T_array will have "@@@#@!#@!@#!" binary.
@@ -647,7 +666,8 @@ func scalarNullToOthers(ctx context.Context,
 		return appendNulls[uint64](result, length, selectList)
 	case types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_varbinary, types.T_text, types.T_json,
-		types.T_array_float32, types.T_array_float64, types.T_datalink, types.T_geometry:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit,
+		types.T_datalink, types.T_geometry:
 		return appendNulls[types.Varlena](result, length, selectList)
 	case types.T_float32:
 		return appendNulls[float32](result, length, selectList)
@@ -1970,6 +1990,9 @@ func strTypeToOthers(proc *process.Process,
 	case types.T_array_float64:
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return strToArray[float64](ctx, source, rs, length, toType)
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return strToQuantizedArray(ctx, source, rs, length, toType)
 	case types.T_year:
 		rs := vector.MustFunctionResult[types.MoYear](result)
 		return strToYear(ctx, source, rs, length, selectList)
@@ -1984,6 +2007,10 @@ func arrayTypeToOthers(proc *process.Process,
 	rs := vector.MustFunctionResult[types.Varlena](result)
 	fromType := source.GetType()
 
+	if fromType.Oid.IsQuantizedArray() || toType.Oid.IsQuantizedArray() {
+		return arrayToQuantizedArray(proc.Ctx, source, rs, length, toType)
+	}
+
 	switch fromType.Oid {
 	case types.T_array_float32:
 		switch toType.Oid {
@@ -5912,6 +5939,102 @@ func arrayToArray[I types.RealNumbers, O types.RealNumbers](
 	return nil
 }
 
+func strToQuantizedArray(
+	_ context.Context,
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, _ types.Type) error {
+
+	toType := to.GetType()
+
+	var i uint64
+	var l = uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetStrValue(i)
+		if null || len(v) == 0 {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		b, err := types.StringToQuantizedArrayBytes(toType.Oid, convertByteSliceToString(v))
+		if err != nil {
+			return err
+		}
+
+		// bypass the dimension check if width is max dimension
+		dim := types.QuantizedArrayDimension(toType.Oid, b)
+		if int(toType.Width) != types.MaxArrayDimension && int(toType.Width) != dim {
+			return moerr.NewArrayDefMismatchNoCtx(int(toType.Width), dim)
+		}
+		if err = to.AppendBytes(b, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// arrayToQuantizedArray casts between the vector types when either side is
+// vecf16, veci8 or vecbit. The values go through float32, the float vectors
+// are rounded to veci8 as the string input, the scale is fixed to 1.
+func arrayToQuantizedArray(
+	_ context.Context,
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, _ types.Type) error {
+
+	fromOid, toOid := from.GetType().Oid, to.GetType().Oid
+
+	var i uint64
+	var l = uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if fromOid == toOid {
+			if err := to.AppendBytes(v, false); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var f32 []float32
+		var err error
+		switch fromOid {
+		case types.T_array_float32:
+			f32 = types.BytesToArray[float32](v)
+		case types.T_array_float64:
+			if f32, err = moarray.Cast[float64, float32](types.BytesToArray[float64](v)); err != nil {
+				return err
+			}
+		default:
+			f32 = types.QuantizedBytesToFloat32(fromOid, v)
+		}
+
+		var bytes []byte
+		switch toOid {
+		case types.T_array_float32:
+			bytes = types.ArrayToBytes[float32](f32)
+		case types.T_array_float64:
+			f64, err := moarray.Cast[float32, float64](f32)
+			if err != nil {
+				return err
+			}
+			bytes = types.ArrayToBytes[float64](f64)
+		default:
+			if bytes, err = types.Float32ToQuantizedBytes(toOid, f32); err != nil {
+				return err
+			}
+		}
+		if err = to.AppendBytes(bytes, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func uuidToStr(
 	ctx context.Context,
 	from vector.FunctionParameterWrapper[types.Uuid],
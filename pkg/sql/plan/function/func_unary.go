@@ -50,6 +50,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/common/system"
+	"github.com/matrixorigin/matrixone/pkg/common/tdigest"
 	"github.com/matrixorigin/matrixone/pkg/common/util"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -7682,6 +7683,42 @@ func HllCardinality(parameters []*vector.Vector, result vector.FunctionResultWra
 	}, selectList)
 }
 
+// TDigestPercentile estimates the percentile of a t-digest sketch returned by
+// tdigest_add_agg or tdigest_merge_agg, an empty sketch returns NULL.
+func TDigestPercentile(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	sketches := vector.GenerateFunctionStrParameter(parameters[0])
+	fractions := vector.GenerateFunctionFixedTypeParameter[float64](parameters[1])
+	rs := vector.MustFunctionResult[float64](result)
+
+	td := tdigest.New(tdigest.DefaultCompression)
+	for i := uint64(0); i < uint64(length); i++ {
+		if selectList != nil && selectList.Contains(i) {
+			if err := rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		sketch, null1 := sketches.GetStrValue(i)
+		fraction, null2 := fractions.GetValue(i)
+		if null1 || null2 {
+			if err := rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if !(fraction >= 0 && fraction <= 1) {
+			return moerr.NewInvalidInputf(proc.Ctx, "percentile value %v is not between 0 and 1", fraction)
+		}
+		if err := td.UnmarshalBinary(sketch); err != nil {
+			return err
+		}
+		if err := rs.Append(td.Quantile(fraction), td.Count() == 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func SHA1Func(
 	parameters []*vector.Vector,
 	result vector.FunctionResultWrapper,
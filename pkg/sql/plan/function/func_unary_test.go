@@ -37,6 +37,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/tdigest"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -8236,3 +8237,66 @@ func TestHllCardinality(t *testing.T) {
 		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
 	}
 }
+
+func TestTDigestPercentile(t *testing.T) {
+	td := tdigest.New(tdigest.DefaultCompression)
+	for _, v := range []float64{10, 20, 30, 40} {
+		td.Add(v)
+	}
+	data, err := td.MarshalBinary()
+	require.NoError(t, err)
+	empty, err := tdigest.New(tdigest.DefaultCompression).MarshalBinary()
+	require.NoError(t, err)
+
+	testCases := []tcTemp{
+		{
+			info: "test tdigest percentile",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varbinary.ToType(),
+					[]string{string(data), string(data), "", string(empty)},
+					[]bool{false, false, true, false}),
+				NewFunctionTestInput(types.T_float64.ToType(),
+					[]float64{0.5, 1, 0.5, 0.5},
+					[]bool{false, false, false, false}),
+			},
+			expect: NewFunctionTestResult(types.T_float64.ToType(), false,
+				[]float64{25, 40, 0, 0},
+				[]bool{false, false, true, true}),
+		},
+		{
+			info: "test invalid percentile value",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varbinary.ToType(),
+					[]string{string(data)},
+					[]bool{false}),
+				NewFunctionTestInput(types.T_float64.ToType(),
+					[]float64{2},
+					[]bool{false}),
+			},
+			expect: NewFunctionTestResult(types.T_float64.ToType(), true,
+				[]float64{0},
+				[]bool{false}),
+		},
+		{
+			info: "test invalid tdigest sketch",
+			inputs: []FunctionTestInput{
+				NewFunctionTestInput(types.T_varbinary.ToType(),
+					[]string{"bad"},
+					[]bool{false}),
+				NewFunctionTestInput(types.T_float64.ToType(),
+					[]float64{0.5},
+					[]bool{false}),
+			},
+			expect: NewFunctionTestResult(types.T_float64.ToType(), true,
+				[]float64{0},
+				[]bool{false}),
+		},
+	}
+
+	proc := testutil.NewProcess(t)
+	for _, tc := range testCases {
+		fcTC := NewFunctionTestCase(proc, tc.inputs, tc.expect, TDigestPercentile)
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+}
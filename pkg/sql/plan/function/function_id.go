@@ -765,9 +765,35 @@ const (
 	JSON_CONTAINS = 543
 	JSON_REMOVE   = 544
 
+	// linear regression aggregations over (y, x) pairs
+	REGR_SLOPE     = 545
+	REGR_INTERCEPT = 546
+	REGR_R2        = 547
+	REGR_COUNT     = 548
+	REGR_AVGX      = 549
+	REGR_AVGY      = 550
+	REGR_SXX       = 551
+	REGR_SYY       = 552
+	REGR_SXY       = 553
+
+	// percentile aggregations and the t-digest sketch functions
+	PERCENTILE_CONT    = 554
+	PERCENTILE_DISC    = 555
+	APPROX_PERCENTILE  = 556
+	TDIGEST_ADD_AGG    = 557
+	TDIGEST_MERGE_AGG  = 558
+	TDIGEST_PERCENTILE = 559
+
+	// value selecting and frequency aggregations, `MODE` uses the reserved id above
+	MIN_BY       = 560
+	MAX_BY       = 561
+	APPROX_TOP_K = 562
+
+	HAMMING_DISTANCE = 563
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
-	FUNCTION_END_NUMBER = 545
+	FUNCTION_END_NUMBER = 564
 )
 
 // functionIdRegister is what function we have registered already.
@@ -862,6 +888,28 @@ var functionIdRegister = map[string]int32{
 	"hll_merge_agg":         HLL_MERGE_AGG,
 	"any_value":             ANY_VALUE,
 	"median":                MEDIAN,
+	"array_agg":             ARRAY_AGG,
+	"corr":                  CORR,
+	"covar_pop":             COVAR_POP,
+	"covar_samp":            COVAR_SAMPLE,
+	"regr_slope":            REGR_SLOPE,
+	"regr_intercept":        REGR_INTERCEPT,
+	"regr_r2":               REGR_R2,
+	"regr_count":            REGR_COUNT,
+	"regr_avgx":             REGR_AVGX,
+	"regr_avgy":             REGR_AVGY,
+	"regr_sxx":              REGR_SXX,
+	"regr_syy":              REGR_SYY,
+	"regr_sxy":              REGR_SXY,
+	"percentile_cont":       PERCENTILE_CONT,
+	"percentile_disc":       PERCENTILE_DISC,
+	"approx_percentile":     APPROX_PERCENTILE,
+	"tdigest_add_agg":       TDIGEST_ADD_AGG,
+	"tdigest_merge_agg":     TDIGEST_MERGE_AGG,
+	"min_by":                MIN_BY,
+	"max_by":                MAX_BY,
+	"mode":                  MODE,
+	"approx_top_k":          APPROX_TOP_K,
 	// count window
 	"rank":         RANK,
 	"row_number":   ROW_NUMBER,
@@ -1047,6 +1095,7 @@ var functionIdRegister = map[string]int32{
 	"json_replace":                   JSON_REPLACE,
 	"json_remove":                    JSON_REMOVE,
 	"hll_cardinality":                HLL_CARDINALITY,
+	"tdigest_percentile":             TDIGEST_PERCENTILE,
 	"json_type":                      JSON_TYPE,
 	"json_valid":                     JSON_VALID,
 	"json_length":                    JSON_LENGTH,
@@ -1327,6 +1376,7 @@ var functionIdRegister = map[string]int32{
 	"l2_distance_sq":    L2_DISTANCE_SQ,
 	"l2_distance_sq_xc": L2_DISTANCE_SQ_XC,
 	"cosine_distance":   COSINE_DISTANCE,
+	"hamming_distance":  HAMMING_DISTANCE,
 
 	"python_user_defined_function": PYTHON_UDF,
 
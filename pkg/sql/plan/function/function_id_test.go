@@ -598,9 +598,28 @@ var predefinedFunids = map[int]int{
 	DATE_TRUNC:                    542,
 	JSON_CONTAINS:                 543,
 	JSON_REMOVE:                   544,
+	REGR_SLOPE:                    545,
+	REGR_INTERCEPT:                546,
+	REGR_R2:                       547,
+	REGR_COUNT:                    548,
+	REGR_AVGX:                     549,
+	REGR_AVGY:                     550,
+	REGR_SXX:                      551,
+	REGR_SYY:                      552,
+	REGR_SXY:                      553,
+	PERCENTILE_CONT:               554,
+	PERCENTILE_DISC:               555,
+	APPROX_PERCENTILE:             556,
+	TDIGEST_ADD_AGG:               557,
+	TDIGEST_MERGE_AGG:             558,
+	TDIGEST_PERCENTILE:            559,
+	MIN_BY:                        560,
+	MAX_BY:                        561,
+	APPROX_TOP_K:                  562,
+	HAMMING_DISTANCE:              563,
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
-	FUNCTION_END_NUMBER: 545,
+	FUNCTION_END_NUMBER: 564,
 }
 
 func Test_funids(t *testing.T) {
@@ -15,6 +15,8 @@
 package function
 
 import (
+	"slices"
+
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggexec"
@@ -695,6 +697,316 @@ var supportedAggInNewFramework = []FuncNew{
 			},
 		},
 	},
+
+	// function `ARRAY_AGG`
+	// the result is a JSON array of the values as JSON_ARRAYAGG, not a typed
+	// array, the NULL values are kept as JSON null and NULL is returned for no rows.
+	{
+		functionId: ARRAY_AGG,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(_ []overload, inputs []types.Type) checkResult {
+			if len(inputs) != 1 {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			if inputs[0].Oid == types.T_any {
+				return newCheckResultWithCast(0, []types.Type{types.T_text.ToType()})
+			}
+			switch inputs[0].Oid {
+			case types.T_binary, types.T_varbinary, types.T_blob:
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			return newCheckResultWithSuccess(0)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "array_agg",
+					aggRegister: aggexec.RegisterArrayAgg,
+				},
+			},
+		},
+	},
+
+	newRegrAggFunction(CORR, "corr", aggexec.RegisterCorr, false),
+	newRegrAggFunction(COVAR_POP, "covar_pop", aggexec.RegisterCovarPop, false),
+	newRegrAggFunction(COVAR_SAMPLE, "covar_samp", aggexec.RegisterCovarSample, false),
+	newRegrAggFunction(REGR_SLOPE, "regr_slope", aggexec.RegisterRegrSlope, false),
+	newRegrAggFunction(REGR_INTERCEPT, "regr_intercept", aggexec.RegisterRegrIntercept, false),
+	newRegrAggFunction(REGR_R2, "regr_r2", aggexec.RegisterRegrR2, false),
+	newRegrAggFunction(REGR_COUNT, "regr_count", aggexec.RegisterRegrCount, true),
+	newRegrAggFunction(REGR_AVGX, "regr_avgx", aggexec.RegisterRegrAvgX, false),
+	newRegrAggFunction(REGR_AVGY, "regr_avgy", aggexec.RegisterRegrAvgY, false),
+	newRegrAggFunction(REGR_SXX, "regr_sxx", aggexec.RegisterRegrSXX, false),
+	newRegrAggFunction(REGR_SYY, "regr_syy", aggexec.RegisterRegrSYY, false),
+	newRegrAggFunction(REGR_SXY, "regr_sxy", aggexec.RegisterRegrSXY, false),
+
+	// function `PERCENTILE_CONT`
+	{
+		functionId: PERCENTILE_CONT,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(_ []overload, inputs []types.Type) checkResult {
+			return percentileAggTypeCheck(inputs, nil, true)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "percentile_cont",
+					aggRegister: aggexec.RegisterPercentileCont,
+				},
+			},
+		},
+	},
+
+	// function `PERCENTILE_DISC`
+	{
+		functionId: PERCENTILE_DISC,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(_ []overload, inputs []types.Type) checkResult {
+			return percentileAggTypeCheck(inputs, aggexec.PercentileDiscSupportedTypes, true)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "percentile_disc",
+					aggRegister: aggexec.RegisterPercentileDisc,
+				},
+			},
+		},
+	},
+
+	// function `APPROX_PERCENTILE`
+	{
+		functionId: APPROX_PERCENTILE,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(_ []overload, inputs []types.Type) checkResult {
+			return percentileAggTypeCheck(inputs, nil, false)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "approx_percentile",
+					aggRegister: aggexec.RegisterApproxPercentile,
+				},
+			},
+		},
+	},
+
+	// function `TDIGEST_ADD_AGG`
+	{
+		functionId: TDIGEST_ADD_AGG,
+		class:      plan.Function_AGG | plan.Function_PRODUCE_NO_NULL,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(_ []overload, inputs []types.Type) checkResult {
+			if len(inputs) != 1 {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			if inputs[0].Oid == types.T_float64 {
+				return newCheckResultWithSuccess(0)
+			}
+			if inputs[0].Oid != types.T_any && !inputs[0].IsNumeric() {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			return newCheckResultWithCast(0, []types.Type{types.T_float64.ToType()})
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_varbinary.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "tdigest_add_agg",
+					aggRegister: aggexec.RegisterTDigestAddAgg,
+				},
+			},
+		},
+	},
+
+	// function `TDIGEST_MERGE_AGG`
+	{
+		functionId: TDIGEST_MERGE_AGG,
+		class:      plan.Function_AGG | plan.Function_PRODUCE_NO_NULL,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(_ []overload, inputs []types.Type) checkResult {
+			if len(inputs) != 1 {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			switch inputs[0].Oid {
+			case types.T_any:
+				return newCheckResultWithCast(0, []types.Type{types.T_varbinary.ToType()})
+			case types.T_binary, types.T_varbinary, types.T_blob:
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedAggParametersWrong)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_varbinary.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "tdigest_merge_agg",
+					aggRegister: aggexec.RegisterTDigestMergeAgg,
+				},
+			},
+		},
+	},
+
+	// function `MIN_BY`
+	{
+		functionId: MIN_BY,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(_ []overload, inputs []types.Type) checkResult {
+			return minMaxByTypeCheck(inputs)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "min_by",
+					aggRegister: aggexec.RegisterMinBy,
+				},
+			},
+		},
+	},
+
+	// function `MAX_BY`
+	{
+		functionId: MAX_BY,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(_ []overload, inputs []types.Type) checkResult {
+			return minMaxByTypeCheck(inputs)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "max_by",
+					aggRegister: aggexec.RegisterMaxBy,
+				},
+			},
+		},
+	},
+
+	// function `MODE`
+	{
+		functionId: MODE,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(_ []overload, inputs []types.Type) checkResult {
+			if len(inputs) != 1 {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			if inputs[0].Oid == types.T_any {
+				return newCheckResultWithCast(0, []types.Type{types.T_text.ToType()})
+			}
+			if !slices.Contains(aggexec.OrderedAggSupportedTypes, inputs[0].Oid) {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			return newCheckResultWithSuccess(0)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "mode",
+					aggRegister: aggexec.RegisterMode,
+				},
+			},
+		},
+	},
+
+	// function `APPROX_TOP_K`
+	{
+		functionId: APPROX_TOP_K,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(_ []overload, inputs []types.Type) checkResult {
+			if len(inputs) != 2 {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			castTypes := slices.Clone(inputs)
+			switch inputs[0].Oid {
+			case types.T_any:
+				castTypes[0] = types.T_text.ToType()
+			case types.T_binary, types.T_varbinary, types.T_blob:
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			if inputs[1].Oid != types.T_any && !inputs[1].Oid.IsInteger() {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			castTypes[1] = types.T_int64.ToType()
+			for i := range inputs {
+				if inputs[i].Oid != castTypes[i].Oid {
+					return newCheckResultWithCast(0, castTypes)
+				}
+			}
+			return newCheckResultWithSuccess(0)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         "approx_top_k",
+					aggRegister: aggexec.RegisterApproxTopK,
+				},
+			},
+		},
+	},
 }
 
 var SumSupportedTypes = []types.T{
@@ -755,3 +1067,110 @@ var BitOpsReturnType = func(typs []types.Type) types.Type {
 	}
 	return types.T_uint64.ToType()
 }
+
+// RegrSupportedTypes are the argument types read directly by corr, covar_pop,
+// covar_samp and the regr_xxx family, other numeric types are cast to float64.
+var RegrSupportedTypes = []types.T{
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_float32, types.T_float64,
+	types.T_decimal64, types.T_decimal128,
+	types.T_bit,
+}
+
+// newRegrAggFunction makes the two-argument aggregation `name(y, x)` of the
+// corr, covar and regr_xxx family. regr_count returns bigint, the others
+// return double.
+func newRegrAggFunction(id int, name string, register func(int64), isCount bool) FuncNew {
+	class := plan.Function_AGG
+	if isCount {
+		class |= plan.Function_PRODUCE_NO_NULL
+	}
+	return FuncNew{
+		functionId: id,
+		class:      class,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(_ []overload, inputs []types.Type) checkResult {
+			return fixedBinaryNumericAggTypeCheck(inputs, RegrSupportedTypes)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType: func(parameters []types.Type) types.Type {
+					if isCount {
+						return types.T_int64.ToType()
+					}
+					return types.T_float64.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str:         name,
+					aggRegister: register,
+				},
+			},
+		},
+	}
+}
+
+// minMaxByTypeCheck checks the value and the ordering argument of min_by and
+// max_by, the value is returned as it is.
+func minMaxByTypeCheck(inputs []types.Type) checkResult {
+	if len(inputs) != 2 {
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	}
+	castTypes := slices.Clone(inputs)
+	if inputs[0].Oid == types.T_any {
+		castTypes[0] = types.T_text.ToType()
+	}
+	if inputs[1].Oid == types.T_any {
+		castTypes[1] = types.T_text.ToType()
+	} else if !slices.Contains(aggexec.OrderedAggSupportedTypes, inputs[1].Oid) {
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	}
+	for i := range inputs {
+		if inputs[i].Oid != castTypes[i].Oid {
+			return newCheckResultWithCast(0, castTypes)
+		}
+	}
+	return newCheckResultWithSuccess(0)
+}
+
+// percentileAggTypeCheck checks `name(x, fraction[, desc])` of the percentile
+// aggregations. x is kept if its type is in supported and is cast to float64
+// otherwise, the fraction is cast to float64. The desc flag is added by the
+// binder from the WITHIN GROUP ordering of percentile_cont and percentile_disc.
+func percentileAggTypeCheck(inputs []types.Type, supported []types.T, withDesc bool) checkResult {
+	n := 2
+	if withDesc {
+		n = 3
+	}
+	if len(inputs) != n {
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	}
+
+	castTypes := slices.Clone(inputs)
+	if !slices.Contains(supported, inputs[0].Oid) {
+		if inputs[0].Oid != types.T_any && !inputs[0].IsNumeric() {
+			return newCheckResultWithFailure(failedAggParametersWrong)
+		}
+		castTypes[0] = types.T_float64.ToType()
+	}
+	if inputs[1].Oid != types.T_any && !inputs[1].IsNumeric() {
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	}
+	castTypes[1] = types.T_float64.ToType()
+	if withDesc {
+		if inputs[2].Oid != types.T_any && inputs[2].Oid != types.T_bool {
+			return newCheckResultWithFailure(failedAggParametersWrong)
+		}
+		castTypes[2] = types.T_bool.ToType()
+	}
+
+	for i := range inputs {
+		if inputs[i].Oid != castTypes[i].Oid {
+			return newCheckResultWithCast(0, castTypes)
+		}
+	}
+	return newCheckResultWithSuccess(0)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/ctl"
 	fj "github.com/matrixorigin/matrixone/pkg/sql/plan/function/fault"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/functionUtil"
+	"github.com/matrixorigin/matrixone/pkg/vectorindex/metric"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -6160,6 +6161,36 @@ var supportedArrayOperations = []FuncNew{
 					return InnerProductArray[float64]
 				},
 			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_array_float16, types.T_array_float16},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_InnerProduct)
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_array_int8, types.T_array_int8},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_InnerProduct)
+				},
+			},
+			{
+				overloadId: 4,
+				args:       []types.T{types.T_array_bit, types.T_array_bit},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_InnerProduct)
+				},
+			},
 		},
 	},
 
@@ -6222,6 +6253,36 @@ var supportedArrayOperations = []FuncNew{
 					return L2DistanceArray[float64]
 				},
 			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_array_float16, types.T_array_float16},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_L2Distance)
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_array_int8, types.T_array_int8},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_L2Distance)
+				},
+			},
+			{
+				overloadId: 4,
+				args:       []types.T{types.T_array_bit, types.T_array_bit},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_L2Distance)
+				},
+			},
 		},
 	},
 
@@ -6284,6 +6345,36 @@ var supportedArrayOperations = []FuncNew{
 					return L2DistanceSqArray[float64]
 				},
 			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_array_float16, types.T_array_float16},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_L2sqDistance)
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_array_int8, types.T_array_int8},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_L2sqDistance)
+				},
+			},
+			{
+				overloadId: 4,
+				args:       []types.T{types.T_array_bit, types.T_array_bit},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_L2sqDistance)
+				},
+			},
 		},
 	},
 
@@ -6346,6 +6437,56 @@ var supportedArrayOperations = []FuncNew{
 					return CosineDistanceArray[float64]
 				},
 			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_array_float16, types.T_array_float16},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_CosineDistance)
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_array_int8, types.T_array_int8},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_CosineDistance)
+				},
+			},
+			{
+				overloadId: 4,
+				args:       []types.T{types.T_array_bit, types.T_array_bit},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_CosineDistance)
+				},
+			},
+		},
+	},
+	// function `hamming_distance`
+	{
+		functionId: HAMMING_DISTANCE,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_array_bit, types.T_array_bit},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return QuantizedDistanceArray(metric.Metric_L1Distance)
+				},
+			},
 		},
 	},
 	// function `normalize_l2`
@@ -13692,6 +13833,45 @@ var supportedOthersBuiltIns = []FuncNew{
 		},
 	},
 
+	// function `TDIGEST_PERCENTILE`
+	{
+		functionId: TDIGEST_PERCENTILE,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) != 2 {
+				return newCheckResultWithFailure(failedFunctionParametersWrong)
+			}
+			castTypes := []types.Type{inputs[0], types.T_float64.ToType()}
+			switch inputs[0].Oid {
+			case types.T_any:
+				castTypes[0] = types.T_varbinary.ToType()
+			case types.T_binary, types.T_varbinary, types.T_blob:
+			default:
+				return newCheckResultWithFailure(failedFunctionParametersWrong)
+			}
+			if inputs[1].Oid != types.T_any && !inputs[1].IsNumeric() {
+				return newCheckResultWithFailure(failedFunctionParametersWrong)
+			}
+			if castTypes[0].Oid == inputs[0].Oid && inputs[1].Oid == types.T_float64 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithCast(0, castTypes)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return TDigestPercentile
+				},
+			},
+		},
+	},
+
 	// function `SHA1`
 	{
 		functionId: SHA1,
@@ -16,6 +16,7 @@ package function
 
 import (
 	"math"
+	"slices"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
@@ -278,6 +279,34 @@ func fixedUnaryAggTypeCheck(inputs []types.Type, supported []types.T) checkResul
 	return newCheckResultWithFailure(failedAggParametersWrong)
 }
 
+// a fixed type check method for Agg with two numeric columns.
+// the numeric types out of supported and null are cast to float64.
+func fixedBinaryNumericAggTypeCheck(inputs []types.Type, supported []types.T) checkResult {
+	if len(inputs) != 2 {
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	}
+	needCast := false
+	castTypes := make([]types.Type, 2)
+	for i, t := range inputs {
+		castTypes[i] = t
+		if slices.Contains(supported, t.Oid) {
+			continue
+		}
+		if t.Oid != types.T_any && !t.IsNumeric() && t.Oid != types.T_year {
+			return newCheckResultWithFailure(failedAggParametersWrong)
+		}
+		if canCast, _ := fixedImplicitTypeCast(t, types.T_float64); !canCast {
+			return newCheckResultWithFailure(failedAggParametersWrong)
+		}
+		castTypes[i] = types.T_float64.ToType()
+		needCast = true
+	}
+	if needCast {
+		return newCheckResultWithCast(0, castTypes)
+	}
+	return newCheckResultWithSuccess(0)
+}
+
 var fixedBinaryCastRule1 [300][300]tarTypes
 var fixedBinaryCastRule2 [300][300]tarTypes
 var fixedCanImplicitCastRule [300]implicitTypeCastRule
@@ -2275,6 +2304,9 @@ func initFixed3() {
 				//C
 				{toType: types.T_array_float32, preferLevel: 2},
 				{toType: types.T_array_float64, preferLevel: 2},
+				{toType: types.T_array_float16, preferLevel: 2},
+				{toType: types.T_array_int8, preferLevel: 2},
+				{toType: types.T_array_bit, preferLevel: 2},
 			},
 		},
 
@@ -2393,6 +2425,9 @@ func initFixed3() {
 				{toType: types.T_blob, preferLevel: 2},
 				{toType: types.T_array_float32, preferLevel: 2},
 				{toType: types.T_array_float64, preferLevel: 2},
+				{toType: types.T_array_float16, preferLevel: 2},
+				{toType: types.T_array_int8, preferLevel: 2},
+				{toType: types.T_array_bit, preferLevel: 2},
 			},
 		},
 		{
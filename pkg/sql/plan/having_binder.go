@@ -16,6 +16,7 @@ package plan
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -177,6 +178,54 @@ func validateCountArgs(ctx context.Context, funcName string, astExpr *tree.FuncE
 	return nil
 }
 
+// orderedSetAggArgs returns the arguments an aggregation is bound with. The
+// ordered-set aggregations are written as
+// percentile_cont(fraction) WITHIN GROUP (ORDER BY x [DESC]) and are bound as
+// percentile_cont(x, fraction, desc), the others keep their arguments.
+//
+// Like validateCountArgs, call this from HavingBinder.BindAggFunc and
+// bindWindowFuncExpr.
+func orderedSetAggArgs(ctx context.Context, funcName string, astExpr *tree.FuncExpr) (tree.Exprs, error) {
+	if funcName != NamePercentileCont && funcName != NamePercentileDisc {
+		if astExpr.WithinGroup != nil {
+			return nil, moerr.NewSyntaxErrorf(ctx, "function %s does not support WITHIN GROUP", funcName)
+		}
+		return astExpr.Exprs, nil
+	}
+	if astExpr.WithinGroup == nil {
+		return nil, moerr.NewSyntaxErrorf(ctx, "function %s requires WITHIN GROUP (ORDER BY ...)", funcName)
+	}
+	if len(astExpr.Exprs) != 1 || len(astExpr.WithinGroup) != 1 {
+		return nil, moerr.NewSyntaxErrorf(ctx, "function %s takes one percentile value and one ORDER BY expression", funcName)
+	}
+	order := astExpr.WithinGroup[0]
+	desc := order.Direction == tree.Descending
+	return tree.Exprs{
+		order.Expr,
+		astExpr.Exprs[0],
+		tree.NewNumVal(desc, strconv.FormatBool(desc), false, tree.P_bool),
+	}, nil
+}
+
+// checkConstAggArg checks that the percentile value of a bound percentile
+// aggregation, or the k of approx_top_k, is a constant, it is evaluated once
+// when the operator is built.
+func checkConstAggArg(ctx context.Context, funcName string, expr *plan.Expr) error {
+	var what string
+	switch funcName {
+	case NamePercentileCont, NamePercentileDisc, NameApproxPercentile:
+		what = "the percentile value"
+	case NameApproxTopK:
+		what = "k"
+	default:
+		return nil
+	}
+	if args := expr.GetF().GetArgs(); len(args) < 2 || !isRuntimeConstExpr(args[1]) {
+		return moerr.NewInvalidInputf(ctx, "%s of %s must be a constant", what, funcName)
+	}
+	return nil
+}
+
 func (b *HavingBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
 	if b.insideAgg {
 		return nil, moerr.NewSyntaxErrorf(b.GetContext(), "aggregate function %s calls cannot be nested", funcName)
@@ -193,11 +242,19 @@ func (b *HavingBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, dept
 		return nil, err
 	}
 
+	args, err := orderedSetAggArgs(b.GetContext(), funcName, astExpr)
+	if err != nil {
+		return nil, err
+	}
+
 	b.insideAgg = true
-	expr, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	expr, err := b.bindFuncExprImplByAstExpr(funcName, args, depth)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkConstAggArg(b.GetContext(), funcName, expr); err != nil {
+		return nil, err
+	}
 
 	// Normalize COUNT(DISTINCT (a, b)) → COUNT(DISTINCT a, b) by expanding
 	// the tuple arg into separate args. This must happen for every aggregate
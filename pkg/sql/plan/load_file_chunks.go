@@ -25,6 +25,7 @@ var loadFileChunksColDefs []*plan.ColDef
 func init() {
 	i64Typ := types.T_int64.ToType()
 	blobTyp := types.T_blob.ToType()
+	varcharTyp := types.T_varchar.ToType()
 	loadFileChunksColDefs = []*plan.ColDef{
 		{
 			Name: "chunk_id",
@@ -38,6 +39,18 @@ func init() {
 			Name: "data",
 			Typ:  makePlan2Type(&blobTyp),
 		},
+		{
+			Name: "end_offset",
+			Typ:  makePlan2Type(&i64Typ),
+		},
+		{
+			Name: "page",
+			Typ:  makePlan2Type(&i64Typ),
+		},
+		{
+			Name: "heading",
+			Typ:  makePlan2Type(&varcharTyp),
+		},
 	}
 }
 
@@ -58,6 +58,20 @@ func isGeometryPlanType(typ *plan.Type) bool {
 	return typ != nil && (typ.Id == int32(types.T_geometry) || typ.Id == int32(types.T_geometry32))
 }
 
+// isVectorType returns true for the vector column types, vecf32, vecf64,
+// vecf16, veci8 and vecbit.
+func isVectorType(t types.T) bool {
+	return t.IsArrayRelate() || t.IsQuantizedArray()
+}
+
+func isVectorFamily(fstr string) bool {
+	switch fstr {
+	case "vecf32", "vecf64", "vecf16", "veci8", "vecbit":
+		return true
+	}
+	return false
+}
+
 func isTypedArrayPlanType(typ *plan.Type) bool {
 	return typ != nil && typ.Id == int32(types.T_json) && arrayPlanTypeString(typ) != ""
 }
@@ -345,7 +345,7 @@ func TestAggregateDependsOnInputOrder(t *testing.T) {
 		return &plan.Expr{Expr: &plan.Expr_F{F: &plan.Function{Func: &plan.ObjectRef{ObjName: name}}}}
 	}
 
-	for _, name := range []string{"group_concat", "json_arrayagg", "json_objectagg"} {
+	for _, name := range []string{"group_concat", "json_arrayagg", "json_objectagg", "array_agg"} {
 		t.Run(name, func(t *testing.T) {
 			require.True(t, aggregateDependsOnInputOrder(makeAgg(name)))
 		})
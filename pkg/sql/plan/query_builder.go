@@ -351,7 +351,7 @@ func aggregateDependsOnInputOrder(expr *plan.Expr) bool {
 		return false
 	}
 	switch fn.Func.ObjName {
-	case "group_concat", "json_arrayagg", "json_objectagg":
+	case "group_concat", "json_arrayagg", "json_objectagg", "array_agg":
 		return true
 	default:
 		return false
@@ -2847,6 +2847,10 @@ func (builder *QueryBuilder) buildUnion(stmt *tree.UnionClause, astOrderBy tree.
 
 const NameGroupConcat = "group_concat"
 const NameClusterCenters = "cluster_centers"
+const NamePercentileCont = "percentile_cont"
+const NamePercentileDisc = "percentile_disc"
+const NameApproxPercentile = "approx_percentile"
+const NameApproxTopK = "approx_top_k"
 
 func (builder *QueryBuilder) bindNoRecursiveCte(
 	ctx *BindContext,
@@ -1964,11 +1964,9 @@ func InitInfileParam(param *tree.ExternParam) error {
 		case "compression":
 			param.CompressType = param.Option[i+1]
 		case "format":
-			format := strings.ToLower(param.Option[i+1])
-			if format != tree.CSV && format != tree.JSONLINE && format != tree.PARQUET {
-				return moerr.NewBadConfigf(param.Ctx, "the format '%s' is not supported", format)
+			if err := setFormatOption(param, param.Option[i+1]); err != nil {
+				return err
 			}
-			param.Format = format
 		case "jsondata":
 			jsondata := strings.ToLower(param.Option[i+1])
 			if jsondata != tree.OBJECT && jsondata != tree.ARRAY {
@@ -1979,6 +1977,8 @@ func InitInfileParam(param *tree.ExternParam) error {
 		case ExternalWriteFilePatternKey, CSVCommentKey:
 			// write_file_pattern is write-only; comment is read at parse time. Both
 			// are kept in Option and consumed elsewhere, ignored here.
+		case IcebergSnapshotIDKey, IcebergMetadataFileKey:
+			// read by the Iceberg scan planner
 		default:
 			return moerr.NewBadConfigf(param.Ctx, "the keyword '%s' is not support", key)
 		}
@@ -2030,11 +2030,9 @@ func InitS3Param(param *tree.ExternParam) error {
 		case "external_id":
 			param.S3Param.ExternalId = param.Option[i+1]
 		case "format":
-			format := strings.ToLower(param.Option[i+1])
-			if format != tree.CSV && format != tree.JSONLINE && format != tree.PARQUET {
-				return moerr.NewBadConfigf(param.Ctx, "the format '%s' is not supported", format)
+			if err := setFormatOption(param, param.Option[i+1]); err != nil {
+				return err
 			}
-			param.Format = format
 		case "jsondata":
 			jsondata := strings.ToLower(param.Option[i+1])
 			if jsondata != tree.OBJECT && jsondata != tree.ARRAY {
@@ -2045,6 +2043,8 @@ func InitS3Param(param *tree.ExternParam) error {
 		case ExternalWriteFilePatternKey, CSVCommentKey:
 			// write_file_pattern is write-only; comment is read at parse time. Both
 			// are kept in Option and consumed elsewhere, ignored here.
+		case IcebergSnapshotIDKey, IcebergMetadataFileKey:
+			// read by the Iceberg scan planner
 		default:
 			return moerr.NewBadConfigf(param.Ctx, "the keyword '%s' is not support", key)
 		}
@@ -2112,6 +2112,45 @@ func GetCSVComment(param *tree.ExternParam) string {
 	return ""
 }
 
+// IcebergSnapshotIDKey is the option of an Iceberg external table that pins
+// the snapshot it reads. The current snapshot is read when it is unset.
+const IcebergSnapshotIDKey = "snapshot_id"
+
+// IcebergMetadataFileKey is set by the Iceberg scan planner to the metadata
+// file it planned with, so that the readers see the same table version.
+const IcebergMetadataFileKey = "metadata_file"
+
+// GetExternalOption returns the value of an option of an external table and
+// whether it was set.
+func GetExternalOption(param *tree.ExternParam, key string) (string, bool) {
+	if param == nil {
+		return "", false
+	}
+	for i := 0; i+1 < len(param.Option); i += 2 {
+		if strings.ToLower(param.Option[i]) == key {
+			return param.Option[i+1], true
+		}
+	}
+	return "", false
+}
+
+// setFormatOption sets the format of param from the FORMAT option. The format
+// of an ENGINE=ICEBERG table cannot be changed, its data files are read in
+// the format recorded in the table metadata.
+func setFormatOption(param *tree.ExternParam, value string) error {
+	format := strings.ToLower(value)
+	switch format {
+	case tree.CSV, tree.JSONLINE, tree.PARQUET, tree.ARROW, tree.ORC, tree.ICEBERG:
+	default:
+		return moerr.NewBadConfigf(param.Ctx, "the format '%s' is not supported", format)
+	}
+	if param.Format == tree.ICEBERG && format != tree.ICEBERG {
+		return moerr.NewBadConfigf(param.Ctx, "the format '%s' conflicts with ENGINE=ICEBERG", format)
+	}
+	param.Format = format
+	return nil
+}
+
 func InitStageS3Param(param *tree.ExternParam, s stage.StageDef) error {
 
 	param.ScanType = tree.S3
@@ -2179,11 +2218,9 @@ func InitStageS3Param(param *tree.ExternParam, s stage.StageDef) error {
 			// compatibility, but never let it override the resolved S3 prefix.
 			continue
 		case "format":
-			format := strings.ToLower(param.Option[i+1])
-			if format != tree.CSV && format != tree.JSONLINE && format != tree.PARQUET {
-				return moerr.NewBadConfigf(param.Ctx, "the format '%s' is not supported", format)
+			if err := setFormatOption(param, param.Option[i+1]); err != nil {
+				return err
 			}
-			param.Format = format
 		case "jsondata":
 			jsondata := strings.ToLower(param.Option[i+1])
 			if jsondata != tree.OBJECT && jsondata != tree.ARRAY {
@@ -2194,6 +2231,8 @@ func InitStageS3Param(param *tree.ExternParam, s stage.StageDef) error {
 		case ExternalWriteFilePatternKey, CSVCommentKey:
 			// write_file_pattern is write-only; comment is read at parse time. Both
 			// are kept in Option and consumed elsewhere, ignored here.
+		case IcebergSnapshotIDKey, IcebergMetadataFileKey:
+			// read by the Iceberg scan planner
 		default:
 			return moerr.NewBadConfigf(param.Ctx, "the keyword '%s' is not support", key)
 		}
@@ -617,7 +617,7 @@ func TestInitInfileParam_Errors(t *testing.T) {
 	param := &tree.ExternParam{}
 	param.Ctx = context.Background()
 	// Unknown format
-	param.Option = []string{"filepath", "/x", "format", "orc"}
+	param.Option = []string{"filepath", "/x", "format", "avro"}
 	require.Error(t, InitInfileParam(param))
 
 	// Unknown jsondata
@@ -713,7 +713,7 @@ func TestInitS3Param_Errors(t *testing.T) {
 	param := &tree.ExternParam{}
 	param.Ctx = context.Background()
 	// Bad format
-	param.Option = []string{"bucket", "b", "format", "orc"}
+	param.Option = []string{"bucket", "b", "format", "avro"}
 	require.Error(t, InitS3Param(param))
 
 	// Bad jsondata
@@ -1455,7 +1455,7 @@ func TestInitStageS3Param_HappyAndErrors(t *testing.T) {
 	t.Run("option_format_csv_invalid", func(t *testing.T) {
 		param := &tree.ExternParam{}
 		param.Ctx = context.Background()
-		param.Option = []string{"format", "orc"}
+		param.Option = []string{"format", "avro"}
 		sd := stage.StageDef{
 			Url:         parse("s3://b/p/"),
 			Credentials: baseCreds,
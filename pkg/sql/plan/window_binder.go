@@ -59,6 +59,11 @@ func bindWindowFuncExpr(b windowFuncExprBinder, ctx *BindContext, funcName strin
 		return nil, err
 	}
 
+	args, err := orderedSetAggArgs(b.GetContext(), funcName, astExpr)
+	if err != nil {
+		return nil, err
+	}
+
 	astStr := windowExprAstKey(astExpr)
 
 	w := &plan.WindowSpec{}
@@ -69,10 +74,13 @@ func bindWindowFuncExpr(b windowFuncExprBinder, ctx *BindContext, funcName strin
 	}
 
 	// window function
-	windowFunc, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	windowFunc, err := b.bindFuncExprImplByAstExpr(funcName, args, depth)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkConstAggArg(b.GetContext(), funcName, windowFunc); err != nil {
+		return nil, err
+	}
 	w.WindowFunc = windowFunc
 	w.Name = funcName
 
@@ -220,6 +220,12 @@ func SetBytesToAnyVector(ctx context.Context, val string, row int,
 			return err
 		}
 		return vector.SetBytesAt(vec, row, v, proc.Mp())
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		v, err := types.StringToQuantizedArrayBytes(vec.GetType().Oid, val)
+		if err != nil {
+			return err
+		}
+		return vector.SetBytesAt(vec, row, v, proc.Mp())
 	case types.T_json:
 		val, err := function.ConvertJsonBytes([]byte(val))
 		if err != nil {
@@ -550,7 +556,7 @@ func SetInsertValueString(proc *process.Process, numVal *tree.NumVal, typ *types
 	checkStrLen := func(s string, binaryLiteral bool) ([]byte, error) {
 		destLen := int(typ.Width)
 		checkWidth := typ.Oid != types.T_text && typ.Oid != types.T_datalink &&
-			(typ.Oid != types.T_binary || binaryLiteral) && destLen != 0 && !typ.Oid.IsArrayRelate()
+			(typ.Oid != types.T_binary || binaryLiteral) && destLen != 0 && !typ.Oid.IsArrayRelate() && !typ.Oid.IsQuantizedArray()
 		if checkWidth {
 			srcLen := utf8.RuneCountInString(s)
 			if binaryLiteral && (typ.Oid == types.T_binary || typ.Oid == types.T_varbinary) {
@@ -600,6 +606,15 @@ func SetInsertValueString(proc *process.Process, numVal *tree.NumVal, typ *types
 
 			}
 
+		} else if typ.Oid.IsQuantizedArray() {
+			_v, err := types.StringToQuantizedArrayBytes(typ.Oid, s)
+			if err != nil {
+				return nil, err
+			}
+			if dim := types.QuantizedArrayDimension(typ.Oid, _v); dim != destLen {
+				return nil, moerr.NewArrayDefMismatchNoCtx(int(typ.Width), dim)
+			}
+			v = _v
 		} else {
 			v = []byte(s)
 		}
@@ -0,0 +1,152 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package parquetexport maps the columns of MatrixOne to parquet columns the
+// same way for every writer of parquet files, SELECT ... INTO OUTFILE and the
+// stage sink of CDC.
+package parquetexport
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/compress"
+)
+
+// Node returns the parquet node of a column of oid, all columns are optional.
+// Integers narrower than 32 bits are INT32, unsigned 32 and 64 bit integers
+// INT64, and decimals, times, json, uuid, enums and vectors are strings.
+func Node(oid types.T) parquet.Node {
+	switch oid {
+	case types.T_bool:
+		return parquet.Optional(parquet.Leaf(parquet.BooleanType))
+	case types.T_int8, types.T_int16, types.T_int32, types.T_uint8, types.T_uint16:
+		return parquet.Optional(parquet.Leaf(parquet.Int32Type))
+	case types.T_int64, types.T_uint32, types.T_uint64, types.T_bit:
+		return parquet.Optional(parquet.Leaf(parquet.Int64Type))
+	case types.T_float32:
+		return parquet.Optional(parquet.Leaf(parquet.FloatType))
+	case types.T_float64:
+		return parquet.Optional(parquet.Leaf(parquet.DoubleType))
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return parquet.Optional(parquet.Leaf(parquet.ByteArrayType))
+	default:
+		return parquet.Optional(parquet.String())
+	}
+}
+
+// Value converts a value of a column of typ into the go type of its parquet
+// node. The value is either as returned by vector.GetAny, or already
+// formatted as a string. Timestamps are formatted in timeZone, UTC if nil.
+func Value(val any, typ *types.Type, timeZone *time.Location) (any, error) {
+	if val == nil {
+		return nil, nil
+	}
+	if typ.Oid == types.T_enum {
+		return fmt.Sprint(val), nil
+	}
+	switch v := val.(type) {
+	case bool, int32, int64, float32, float64, string:
+		return v, nil
+	case int8:
+		return int32(v), nil
+	case int16:
+		return int32(v), nil
+	case uint8:
+		return int32(v), nil
+	case uint16:
+		return int32(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+	case []byte:
+		switch typ.Oid {
+		case types.T_binary, types.T_varbinary, types.T_blob:
+			return v, nil
+		case types.T_json:
+			return types.DecodeJson(v).String(), nil
+		}
+		return string(v), nil
+	case bytejson.ByteJson:
+		return v.String(), nil
+	case types.Date:
+		return v.String(), nil
+	case types.Datetime:
+		return v.String2(typ.Scale), nil
+	case types.Time:
+		return v.String2(typ.Scale), nil
+	case types.Timestamp:
+		if timeZone == nil {
+			timeZone = time.UTC
+		}
+		return v.String2(timeZone, typ.Scale), nil
+	case types.Decimal64:
+		return v.Format(typ.Scale), nil
+	case types.Decimal128:
+		return v.Format(typ.Scale), nil
+	case types.Decimal256:
+		return v.Format(typ.Scale), nil
+	case types.Uuid:
+		return v.String(), nil
+	case []float32:
+		return types.ArrayToString(v), nil
+	case []float64:
+		return types.ArrayToString(v), nil
+	default:
+		return nil, moerr.NewInternalErrorf(context.Background(), "unsupported type for parquet export: %v", typ.Oid)
+	}
+}
+
+// VectorValue returns the parquet value of the row i of vec, which is not null.
+func VectorValue(vec *vector.Vector, i int, timeZone *time.Location) (any, error) {
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_array_float32:
+		return Value(vector.GetArrayAt[float32](vec, i), typ, timeZone)
+	case types.T_array_float64:
+		return Value(vector.GetArrayAt[float64](vec, i), typ, timeZone)
+	}
+	if typ.IsVarlen() {
+		return Value(vec.GetBytesAt(i), typ, timeZone)
+	}
+	return Value(vector.GetAny(vec, i, false), typ, timeZone)
+}
+
+// Codec maps a COMPRESSION option to a parquet page codec
+func Codec(ctx context.Context, compression string) (compress.Codec, error) {
+	switch compression {
+	case tree.NOCOMPRESS:
+		return &parquet.Uncompressed, nil
+	case tree.SNAPPY:
+		return &parquet.Snappy, nil
+	case tree.GZIP:
+		return &parquet.Gzip, nil
+	case tree.ZSTD:
+		return &parquet.Zstd, nil
+	case tree.BROTLI:
+		return &parquet.Brotli, nil
+	case tree.LZ4:
+		return &parquet.Lz4Raw, nil
+	default:
+		return nil, moerr.NewNotSupportedf(ctx, "compression '%s' for parquet export", compression)
+	}
+}
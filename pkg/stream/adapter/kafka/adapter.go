@@ -39,6 +39,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"go.uber.org/zap"
 )
 
 type ValueType string
@@ -64,6 +65,9 @@ const (
 	ProtobufMessagekey  = "protobuf.message"
 
 	SchemaRegistryKey = "schema.registry"
+	// SchemaAutoAddColumnsKey approves adding the message fields without a
+	// column as nullable columns.
+	SchemaAutoAddColumnsKey = "schema.auto_add_columns"
 
 	JSON       ValueType = "json"
 	AVRO       ValueType = "avro"
@@ -114,6 +118,7 @@ type KafkaAdapterInterface interface {
 	ReadMessagesFromPartition(topic string, partition int32, offset int64, limit int) ([]*kafka.Message, error)
 	ReadMessagesFromTopic(topic string, offset int64, limit int64, configs map[string]interface{}) ([]*kafka.Message, error)
 	GetSchemaForTopic(topic string, isKey bool) (schemaregistry.SchemaMetadata, error)
+	GetSchemaByID(topic string, id int, isKey bool) (schemaregistry.SchemaInfo, error)
 
 	GetKafkaConsumer() (*kafka.Consumer, error)
 	ProduceMessage(topic string, key, value []byte) (int64, error)
@@ -398,14 +403,25 @@ func (ka *KafkaAdapter) GetSchemaForTopic(topic string, isKey bool) (schemaregis
 		return schemaregistry.SchemaMetadata{}, moerr.NewInternalError(context.Background(), "schema registry not initialized")
 	}
 
+	// Fetch the schema for the subject
+	return ka.SchemaRegistry.GetLatestSchemaMetadata(topicSubject(topic, isKey))
+}
+
+// GetSchemaByID returns the schema version registered under id, which is the
+// one a message carrying id in its header was serialized with.
+func (ka *KafkaAdapter) GetSchemaByID(topic string, id int, isKey bool) (schemaregistry.SchemaInfo, error) {
+	if ka.SchemaRegistry == nil {
+		return schemaregistry.SchemaInfo{}, moerr.NewInternalError(context.Background(), "schema registry not initialized")
+	}
+	return ka.SchemaRegistry.GetBySubjectAndID(topicSubject(topic, isKey), id)
+}
+
+func topicSubject(topic string, isKey bool) string {
 	subjectSuffix := "value"
 	if isKey {
 		subjectSuffix = "key"
 	}
-	subject := fmt.Sprintf("%s-%s", topic, subjectSuffix)
-
-	// Fetch the schema for the subject
-	return ka.SchemaRegistry.GetLatestSchemaMetadata(subject)
+	return fmt.Sprintf("%s-%s", topic, subjectSuffix)
 }
 
 func (ka *KafkaAdapter) ProduceMessage(topic string, key, value []byte) (int64, error) {
@@ -433,6 +449,82 @@ func (ka *KafkaAdapter) ProduceMessage(topic string, key, value []byte) (int64,
 	return int64(m.TopicPartition.Offset), nil
 }
 
+// KafkaTxnProducerInterface is a transactional producer. The messages
+// produced between BeginTransaction and CommitTransaction are seen by
+// read_committed consumers all together or not at all.
+type KafkaTxnProducerInterface interface {
+	BeginTransaction() error
+	// Produce queues the message without waiting for its delivery
+	Produce(topic string, key, value []byte) error
+	// CommitTransaction waits for the delivery of the queued messages and
+	// commits them
+	CommitTransaction(ctx context.Context) error
+	AbortTransaction(ctx context.Context) error
+	Close()
+}
+
+type KafkaTxnProducer struct {
+	Producer *kafka.Producer
+}
+
+// NewKafkaTxnProducer creates a transactional producer of transactionalId.
+// A producer created later with the same transactionalId fences this one.
+func NewKafkaTxnProducer(ctx context.Context, configMap *kafka.ConfigMap, transactionalId string) (KafkaTxnProducerInterface, error) {
+	if err := configMap.SetKey("transactional.id", transactionalId); err != nil {
+		return nil, err
+	}
+	// the delivery of the messages is checked by CommitTransaction
+	if err := configMap.SetKey("go.delivery.reports", false); err != nil {
+		return nil, err
+	}
+	producer, err := kafka.NewProducer(configMap)
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, fmt.Sprintf("unable to create confluent producer client: %s", err))
+	}
+	// drain the events, they are only errors already returned by the
+	// transaction calls
+	go func() {
+		for e := range producer.Events() {
+			if kerr, ok := e.(kafka.Error); ok {
+				logutil.Warnf("kafka transactional producer %s: %v", transactionalId, kerr)
+			}
+		}
+	}()
+	if err = producer.InitTransactions(ctx); err != nil {
+		producer.Close()
+		return nil, err
+	}
+	return &KafkaTxnProducer{Producer: producer}, nil
+}
+
+func (tp *KafkaTxnProducer) BeginTransaction() error {
+	return tp.Producer.BeginTransaction()
+}
+
+func (tp *KafkaTxnProducer) Produce(topic string, key, value []byte) error {
+	err := tp.Producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: kafka.PartitionAny},
+		Key:            key,
+		Value:          value,
+	}, nil)
+	if err != nil {
+		return moerr.NewInternalError(context.Background(), fmt.Sprintf("failed to produce message: %s", err))
+	}
+	return nil
+}
+
+func (tp *KafkaTxnProducer) CommitTransaction(ctx context.Context) error {
+	return tp.Producer.CommitTransaction(ctx)
+}
+
+func (tp *KafkaTxnProducer) AbortTransaction(ctx context.Context) error {
+	return tp.Producer.AbortTransaction(ctx)
+}
+
+func (tp *KafkaTxnProducer) Close() {
+	tp.Producer.Close()
+}
+
 func newBatch(batchSize int, typs []types.Type, pool *mpool.MPool) (*batch.Batch, error) {
 
 	//alloc space for vector
@@ -473,33 +565,52 @@ func PopulateBatchFromMSG(ctx context.Context, ka KafkaAdapterInterface, typs []
 		if err != nil {
 			return nil, err
 		}
-		for i, msg := range msgs {
+		rows := 0
+		for _, msg := range msgs {
 			msgValue, err := deserializeProtobuf(md, msg.Value, false)
 			if err != nil {
-				return nil, err
+				skipUndecodable(msg, err)
+				continue
 			}
-			err = populateOneRowData(ctx, b, attrKeys, &ProtoDataGetter{Value: msgValue, Key: msg.Key}, i, typs, mp)
+			err = populateOneRowData(ctx, b, attrKeys, &ProtoDataGetter{Value: msgValue, Key: msg.Key}, rows, typs, mp)
 			if err != nil {
 				return nil, err
 			}
+			rows++
 		}
-
+		setBatchLength(b, rows)
 	case PROTOBUFSR:
-		schema, err := ka.GetSchemaForTopic(configs[TopicKey].(string), false)
-		if err != nil {
-			return nil, err
-		}
-		md, err := convertProtobufSchemaToMD(schema.Schema, schema.SchemaInfo.Schema)
-		if err != nil {
-			return nil, err
+		schemas := newSchemaCache(ka, configs[TopicKey].(string))
+		rows := 0
+		for _, msg := range msgs {
+			msgValue, err := schemas.decode(ctx, msg.Value)
+			if err != nil {
+				skipUndecodable(msg, err)
+				continue
+			}
+			err = populateOneRowData(ctx, b, attrKeys, &ProtoDataGetter{Value: msgValue, Key: msg.Key}, rows, typs, mp)
+			if err != nil {
+				return nil, err
+			}
+			rows++
 		}
-		for i, msg := range msgs {
-			msgValue, _ := deserializeProtobuf(md, msg.Value, true)
-			err := populateOneRowData(ctx, b, attrKeys, &ProtoDataGetter{Value: msgValue, Key: msg.Key}, i, typs, mp)
+		setBatchLength(b, rows)
+	case AVRO:
+		schemas := newSchemaCache(ka, configs[TopicKey].(string))
+		rows := 0
+		for _, msg := range msgs {
+			record, err := schemas.decodeAvro(ctx, msg.Value)
+			if err != nil {
+				skipUndecodable(msg, err)
+				continue
+			}
+			err = populateOneRowData(ctx, b, attrKeys, &AvroDataGetter{Value: record, Key: msg.Key}, rows, typs, mp)
 			if err != nil {
 				return nil, err
 			}
+			rows++
 		}
+		setBatchLength(b, rows)
 	default:
 		return nil, moerr.NewInternalErrorf(ctx, "Unsupported value for key: %s", ValueKey)
 	}
@@ -515,6 +626,25 @@ func PopulateBatchFromMSG(ctx context.Context, ka KafkaAdapterInterface, typs []
 	b.SetRowCount(n)
 	return b, nil
 }
+
+// skipUndecodable logs a message which can not be decoded. The message is
+// skipped instead of failing the batch so that one bad record does not stall
+// the stream, the connector moves such messages to its dead-letter table.
+func skipUndecodable(msg *kafka.Message, err error) {
+	logutil.Error("skip undecodable message",
+		zap.Stringp("topic", msg.TopicPartition.Topic),
+		zap.Int32("partition", msg.TopicPartition.Partition),
+		zap.Int64("offset", int64(msg.TopicPartition.Offset)),
+		zap.Error(err))
+}
+
+// setBatchLength shrinks the vectors of b to the n rows populated.
+func setBatchLength(b *batch.Batch, n int) {
+	for _, vec := range b.Vecs {
+		vec.SetLength(n)
+	}
+}
+
 func populateOneRowData(ctx context.Context, bat *batch.Batch, attrKeys []string, getter DataGetter, rowIdx int, typs []types.Type, mp *mpool.MPool) error {
 	for colIdx, typ := range typs {
 		id := typ.Oid
@@ -645,6 +775,10 @@ func populateOneRowData(ctx context.Context, bat *batch.Batch, attrKeys []string
 			var val int64
 			var strVal string
 			switch v := fieldValue.(type) {
+			case int64:
+				val = v
+			case int32:
+				val = int64(v)
 			case float64:
 				if v < math.MinInt64 || v > math.MaxInt64 {
 					nulls.Add(vec.GetNulls(), uint64(rowIdx))
@@ -749,6 +883,10 @@ func populateOneRowData(ctx context.Context, bat *batch.Batch, attrKeys []string
 		case types.T_uint64:
 			var val uint64
 			switch v := fieldValue.(type) {
+			case uint64:
+				val = v
+			case uint32:
+				val = uint64(v)
 			case float64:
 				if v < 0 || v > math.MaxUint64 {
 					nulls.Add(vec.GetNulls(), uint64(rowIdx))
@@ -805,7 +943,7 @@ func populateOneRowData(ctx context.Context, bat *batch.Batch, attrKeys []string
 				val = float64(v)
 			default:
 				strVal := fmt.Sprintf("%v", v)
-				parsedValue, err := strconv.ParseFloat(strVal, 32)
+				parsedValue, err := strconv.ParseFloat(strVal, 64)
 				if err != nil {
 					nulls.Add(vec.GetNulls(), uint64(rowIdx))
 					continue
@@ -954,6 +1092,15 @@ func populateOneRowData(ctx context.Context, bat *batch.Batch, attrKeys []string
 }
 
 func convertProtobufSchemaToMD(schema string, msgTypeName string) (*desc.MessageDescriptor, error) {
+	fd, err := parseProtobufSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	md := fd.FindMessage(msgTypeName)
+	return md, nil
+}
+
+func parseProtobufSchema(schema string) (*desc.FileDescriptor, error) {
 	files := map[string]string{
 		"test.proto": schema,
 	}
@@ -966,9 +1113,7 @@ func convertProtobufSchemaToMD(schema string, msgTypeName string) (*desc.Message
 	if err != nil {
 		return nil, err
 	}
-	fd := fds[0]
-	md := fd.FindMessage(msgTypeName)
-	return md, nil
+	return fds[0], nil
 }
 
 func deserializeProtobuf(md *desc.MessageDescriptor, in []byte, isKafkSR bool) (*dynamic.Message, error) {
@@ -1043,6 +1188,8 @@ func ValidateConfig(ctx context.Context, configs map[string]interface{}, factory
 		RelkindKey,
 		ProtobufMessagekey,
 		ProtobufSchemaKey,
+		SchemaRegistryKey,
+		SchemaAutoAddColumnsKey,
 	}
 
 	// Create a set of allowed keys
@@ -1067,6 +1214,10 @@ func ValidateConfig(ctx context.Context, configs map[string]interface{}, factory
 		}
 	}
 
+	if v, ok := configs[SchemaAutoAddColumnsKey]; ok && v != "true" && v != "false" {
+		return moerr.NewInternalErrorf(ctx, "invalid value for key %s: %v", SchemaAutoAddColumnsKey, v)
+	}
+
 	value, ok := configs[ValueKey].(string)
 	if !ok {
 		return moerr.NewInternalErrorf(ctx, "expected string value for key: %s", ValueKey)
@@ -1090,6 +1241,11 @@ func ValidateConfig(ctx context.Context, configs map[string]interface{}, factory
 		if _, ok := configs[SchemaRegistryKey]; !ok {
 			return moerr.NewInternalErrorf(ctx, "missing required key: %s", SchemaRegistryKey)
 		}
+	case AVRO:
+		// the avro values are in the schema registry wire format
+		if _, ok := configs[SchemaRegistryKey]; !ok {
+			return moerr.NewInternalErrorf(ctx, "missing required key: %s", SchemaRegistryKey)
+		}
 	default:
 		return moerr.NewInternalErrorf(ctx, "Unsupported value for key: %s", ValueKey)
 	}
@@ -0,0 +1,416 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mokafka
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	avroNull    = "null"
+	avroBoolean = "boolean"
+	avroInt     = "int"
+	avroLong    = "long"
+	avroFloat   = "float"
+	avroDouble  = "double"
+	avroBytes   = "bytes"
+	avroString  = "string"
+	avroRecord  = "record"
+	avroEnum    = "enum"
+	avroArray   = "array"
+	avroMap     = "map"
+	avroFixed   = "fixed"
+	avroUnion   = "union"
+)
+
+// avroSchema is a parsed Avro schema. Logical types are decoded as their
+// underlying type.
+type avroSchema struct {
+	typ    string
+	name   string
+	fields []avroField
+	// items are the items of an array and the values of a map.
+	items    *avroSchema
+	symbols  []string
+	size     int
+	branches []*avroSchema
+}
+
+type avroField struct {
+	name   string
+	schema *avroSchema
+}
+
+// parseAvroSchema parses the JSON text of an Avro schema.
+func parseAvroSchema(ctx context.Context, text string) (*avroSchema, error) {
+	var v any
+	if err := json.Unmarshal([]byte(text), &v); err != nil {
+		return nil, moerr.NewInvalidInputf(ctx, "invalid avro schema: %v", err)
+	}
+	p := avroSchemaParser{ctx: ctx, names: make(map[string]*avroSchema)}
+	return p.parse(v, "")
+}
+
+type avroSchemaParser struct {
+	ctx context.Context
+	// names are the named types parsed so far, by full and by short name.
+	names map[string]*avroSchema
+}
+
+func (p *avroSchemaParser) parse(v any, namespace string) (*avroSchema, error) {
+	switch x := v.(type) {
+	case string:
+		switch x {
+		case avroNull, avroBoolean, avroInt, avroLong, avroFloat, avroDouble, avroBytes, avroString:
+			return &avroSchema{typ: x}, nil
+		}
+		if s, ok := p.names[x]; ok {
+			return s, nil
+		}
+		if s, ok := p.names[namespace+"."+x]; ok {
+			return s, nil
+		}
+		return nil, moerr.NewInvalidInputf(p.ctx, "unknown avro type %s", x)
+	case []any:
+		s := &avroSchema{typ: avroUnion}
+		for _, b := range x {
+			branch, err := p.parse(b, namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.branches = append(s.branches, branch)
+		}
+		return s, nil
+	case map[string]any:
+		typ, ok := x["type"].(string)
+		if !ok {
+			return p.parse(x["type"], namespace)
+		}
+		switch typ {
+		case avroRecord, "error":
+			s := &avroSchema{typ: avroRecord}
+			ns := p.register(s, x, namespace)
+			fields, _ := x["fields"].([]any)
+			for _, f := range fields {
+				fm, ok := f.(map[string]any)
+				if !ok {
+					return nil, moerr.NewInvalidInputf(p.ctx, "invalid avro field of %s", s.name)
+				}
+				name, _ := fm["name"].(string)
+				fs, err := p.parse(fm["type"], ns)
+				if err != nil {
+					return nil, err
+				}
+				s.fields = append(s.fields, avroField{name: name, schema: fs})
+			}
+			return s, nil
+		case avroEnum:
+			s := &avroSchema{typ: avroEnum}
+			p.register(s, x, namespace)
+			symbols, _ := x["symbols"].([]any)
+			for _, sym := range symbols {
+				name, _ := sym.(string)
+				s.symbols = append(s.symbols, name)
+			}
+			return s, nil
+		case avroFixed:
+			s := &avroSchema{typ: avroFixed}
+			p.register(s, x, namespace)
+			size, _ := x["size"].(float64)
+			s.size = int(size)
+			return s, nil
+		case avroArray:
+			items, err := p.parse(x["items"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &avroSchema{typ: avroArray, items: items}, nil
+		case avroMap:
+			values, err := p.parse(x["values"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &avroSchema{typ: avroMap, items: values}, nil
+		default:
+			return p.parse(typ, namespace)
+		}
+	default:
+		return nil, moerr.NewInvalidInputf(p.ctx, "invalid avro schema %v", v)
+	}
+}
+
+// register names the named type s of the schema x and returns its namespace.
+func (p *avroSchemaParser) register(s *avroSchema, x map[string]any, namespace string) string {
+	name, _ := x["name"].(string)
+	if ns, ok := x["namespace"].(string); ok {
+		namespace = ns
+	}
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		namespace, name = name[:i], name[i+1:]
+	}
+	s.name = name
+	p.names[name] = s
+	if namespace != "" {
+		p.names[namespace+"."+name] = s
+	}
+	return namespace
+}
+
+// avroReader reads the Avro binary encoding.
+type avroReader struct {
+	ctx context.Context
+	buf []byte
+}
+
+func (r *avroReader) truncated() error {
+	return moerr.NewInvalidInput(r.ctx, "avro data is truncated")
+}
+
+func (r *avroReader) long() (int64, error) {
+	v, n := binary.Varint(r.buf)
+	if n <= 0 {
+		return 0, r.truncated()
+	}
+	r.buf = r.buf[n:]
+	return v, nil
+}
+
+func (r *avroReader) next(n int64) ([]byte, error) {
+	if n < 0 || n > int64(len(r.buf)) {
+		return nil, r.truncated()
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b, nil
+}
+
+// blockCount returns the number of items of the next block of an array or a
+// map, skipping the byte size of the blocks which carry it.
+func (r *avroReader) blockCount() (int64, error) {
+	n, err := r.long()
+	if err != nil || n >= 0 {
+		return n, err
+	}
+	if _, err = r.long(); err != nil {
+		return 0, err
+	}
+	return -n, nil
+}
+
+// decode returns the value of s read from r: nil, bool, int32, int64,
+// float32, float64, []byte, string, []any for arrays and map[string]any for
+// maps and records. Enums decode to their symbol.
+func (r *avroReader) decode(s *avroSchema) (any, error) {
+	switch s.typ {
+	case avroNull:
+		return nil, nil
+	case avroBoolean:
+		b, err := r.next(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0] != 0, nil
+	case avroInt:
+		v, err := r.long()
+		return int32(v), err
+	case avroLong:
+		return r.long()
+	case avroFloat:
+		b, err := r.next(4)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+	case avroDouble:
+		b, err := r.next(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case avroBytes, avroString:
+		n, err := r.long()
+		if err != nil {
+			return nil, err
+		}
+		b, err := r.next(n)
+		if err != nil {
+			return nil, err
+		}
+		if s.typ == avroString {
+			return string(b), nil
+		}
+		return append([]byte(nil), b...), nil
+	case avroFixed:
+		b, err := r.next(int64(s.size))
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(nil), b...), nil
+	case avroEnum:
+		idx, err := r.long()
+		if err != nil {
+			return nil, err
+		}
+		if idx < 0 || idx >= int64(len(s.symbols)) {
+			return nil, moerr.NewInvalidInputf(r.ctx, "avro enum index %d out of range", idx)
+		}
+		return s.symbols[idx], nil
+	case avroUnion:
+		idx, err := r.long()
+		if err != nil {
+			return nil, err
+		}
+		if idx < 0 || idx >= int64(len(s.branches)) {
+			return nil, moerr.NewInvalidInputf(r.ctx, "avro union index %d out of range", idx)
+		}
+		return r.decode(s.branches[idx])
+	case avroRecord:
+		obj := make(map[string]any, len(s.fields))
+		for _, f := range s.fields {
+			v, err := r.decode(f.schema)
+			if err != nil {
+				return nil, err
+			}
+			obj[f.name] = v
+		}
+		return obj, nil
+	case avroArray:
+		var items []any
+		for {
+			n, err := r.blockCount()
+			if err != nil {
+				return nil, err
+			}
+			if n == 0 {
+				return items, nil
+			}
+			for ; n > 0; n-- {
+				v, err := r.decode(s.items)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, v)
+			}
+		}
+	case avroMap:
+		obj := make(map[string]any)
+		for {
+			n, err := r.blockCount()
+			if err != nil {
+				return nil, err
+			}
+			if n == 0 {
+				return obj, nil
+			}
+			for ; n > 0; n-- {
+				key, err := r.decode(&avroSchema{typ: avroString})
+				if err != nil {
+					return nil, err
+				}
+				v, err := r.decode(s.items)
+				if err != nil {
+					return nil, err
+				}
+				obj[key.(string)] = v
+			}
+		}
+	default:
+		return nil, moerr.NewInvalidInputf(r.ctx, "unsupported avro type %s", s.typ)
+	}
+}
+
+// decodeAvroRecord decodes data written with the record schema s.
+func decodeAvroRecord(ctx context.Context, s *avroSchema, data []byte) (map[string]any, error) {
+	if s.typ != avroRecord {
+		return nil, moerr.NewInvalidInputf(ctx, "avro schema %s is not a record", s.typ)
+	}
+	r := avroReader{ctx: ctx, buf: data}
+	v, err := r.decode(s)
+	if err != nil {
+		return nil, err
+	}
+	return v.(map[string]any), nil
+}
+
+// avroSQLType returns the column type storing an Avro field, false for a
+// field which is always null.
+func avroSQLType(s *avroSchema) (types.T, bool) {
+	switch s.typ {
+	case avroNull:
+		return 0, false
+	case avroBoolean:
+		return types.T_bool, true
+	case avroInt:
+		return types.T_int32, true
+	case avroLong:
+		return types.T_int64, true
+	case avroFloat:
+		return types.T_float32, true
+	case avroDouble:
+		return types.T_float64, true
+	case avroBytes, avroFixed:
+		return types.T_blob, true
+	case avroUnion:
+		// an optional field is a union of null and its type.
+		var typ *avroSchema
+		for _, b := range s.branches {
+			if b.typ == avroNull {
+				continue
+			}
+			if typ != nil {
+				return types.T_text, true
+			}
+			typ = b
+		}
+		if typ == nil {
+			return 0, false
+		}
+		return avroSQLType(typ)
+	default:
+		return types.T_text, true
+	}
+}
+
+// AvroDataGetter gets the fields of a decoded Avro record. Arrays, maps and
+// records are returned as their JSON text.
+type AvroDataGetter struct {
+	Value map[string]any
+	Key   any
+}
+
+func (a *AvroDataGetter) GetFieldValue(name string) (interface{}, bool) {
+	val, ok := a.Value[name]
+	if !ok || val == nil {
+		return nil, false
+	}
+	switch v := val.(type) {
+	case []byte:
+		return string(v), true
+	case []any, map[string]any:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, false
+		}
+		return string(data), true
+	}
+	return val, true
+}
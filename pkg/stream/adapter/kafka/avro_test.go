@@ -0,0 +1,183 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mokafka
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const (
+	avroUserV1 = `{"type": "record", "name": "User", "namespace": "test", "fields": [
+		{"name": "name", "type": "string"},
+		{"name": "age", "type": "int"}]}`
+	// v2 widens age and adds email and tags.
+	avroUserV2 = `{"type": "record", "name": "User", "namespace": "test", "fields": [
+		{"name": "name", "type": "string"},
+		{"name": "age", "type": "long"},
+		{"name": "email", "type": ["null", "string"], "default": null},
+		{"name": "tags", "type": {"type": "array", "items": "string"}}]}`
+)
+
+func avroLongBytes(b []byte, v int64) []byte {
+	return binary.AppendVarint(b, v)
+}
+
+func avroStringBytes(b []byte, v string) []byte {
+	return append(avroLongBytes(b, int64(len(v))), v...)
+}
+
+// avroPayload prefixes body with the schema registry header of id.
+func avroPayload(id int, body []byte) []byte {
+	header := make([]byte, srHeaderLen, srHeaderLen+len(body))
+	binary.BigEndian.PutUint32(header[1:], uint32(id))
+	return append(header, body...)
+}
+
+func avroUserV1Body(name string, age int64) []byte {
+	return avroLongBytes(avroStringBytes(nil, name), age)
+}
+
+func avroUserV2Body(name string, age int64, email string, tags ...string) []byte {
+	b := avroLongBytes(avroStringBytes(nil, name), age)
+	b = avroStringBytes(avroLongBytes(b, 1), email)
+	if len(tags) > 0 {
+		b = avroLongBytes(b, int64(len(tags)))
+		for _, tag := range tags {
+			b = avroStringBytes(b, tag)
+		}
+	}
+	return avroLongBytes(b, 0)
+}
+
+func TestDecodeAvroRecord(t *testing.T) {
+	ctx := context.Background()
+	schema, err := parseAvroSchema(ctx, `{"type": "record", "name": "R", "fields": [
+		{"name": "b", "type": "boolean"},
+		{"name": "f", "type": "float"},
+		{"name": "d", "type": "double"},
+		{"name": "e", "type": {"type": "enum", "name": "E", "symbols": ["X", "Y"]}},
+		{"name": "x", "type": {"type": "fixed", "name": "F", "size": 2}},
+		{"name": "m", "type": {"type": "map", "values": "long"}},
+		{"name": "r", "type": ["null", "R"]}]}`)
+	require.NoError(t, err)
+
+	var data []byte
+	data = append(data, 1)
+	data = binary.LittleEndian.AppendUint32(data, math.Float32bits(1.5))
+	data = binary.LittleEndian.AppendUint64(data, math.Float64bits(2.25))
+	data = avroLongBytes(data, 1)
+	data = append(data, 'a', 'b')
+	// a map block with its byte size
+	data = avroLongBytes(data, -1)
+	data = avroLongBytes(data, 3)
+	data = avroStringBytes(data, "k")
+	data = avroLongBytes(data, 7)
+	data = avroLongBytes(data, 0)
+	// the recursive record is null
+	data = avroLongBytes(data, 0)
+
+	record, err := decodeAvroRecord(ctx, schema, data)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"b": true,
+		"f": float32(1.5),
+		"d": 2.25,
+		"e": "Y",
+		"x": []byte("ab"),
+		"m": map[string]any{"k": int64(7)},
+		"r": nil,
+	}, record)
+
+	_, err = decodeAvroRecord(ctx, schema, data[:len(data)-3])
+	assert.Error(t, err)
+	_, err = parseAvroSchema(ctx, `{"type": "record", "name": "R", "fields": [{"name": "a", "type": "Unknown"}]}`)
+	assert.Error(t, err)
+}
+
+func TestPopulateBatchFromMSG_Avro(t *testing.T) {
+	ka := &registryMockAdapter{schemas: map[int]string{1: avroUserV1, 2: avroUserV2}}
+	msgs := []*kafka.Message{
+		{Value: avroPayload(1, avroUserV1Body("a", 7))},
+		{Value: avroPayload(2, avroUserV2Body("b", 1<<40, "b@x", "t1", "t2"))},
+		{Value: avroPayload(2, []byte{2})},
+	}
+	configs := map[string]interface{}{
+		ValueKey: string(AVRO),
+		TopicKey: "users",
+	}
+	attrs := []string{"name", "age", "email", "tags"}
+	typs := []types.Type{
+		types.New(types.T_varchar, 30, 0),
+		types.New(types.T_int64, 0, 0),
+		types.New(types.T_varchar, 30, 0),
+		types.New(types.T_text, 0, 0),
+	}
+	mp := mpool.MustNewZero()
+	bat, err := PopulateBatchFromMSG(context.Background(), ka, typs, attrs, msgs, configs, mp)
+	require.NoError(t, err)
+	defer bat.Clean(mp)
+
+	// the truncated message is skipped.
+	assert.Equal(t, 2, bat.RowCount())
+	assert.Equal(t, "a", bat.Vecs[0].GetStringAt(0))
+	assert.Equal(t, "b", bat.Vecs[0].GetStringAt(1))
+	assert.Equal(t, []int64{7, 1 << 40}, vector.MustFixedColNoTypeCheck[int64](bat.Vecs[1]))
+	assert.True(t, bat.Vecs[2].IsNull(0))
+	assert.Equal(t, "b@x", bat.Vecs[2].GetStringAt(1))
+	assert.Equal(t, `["t1","t2"]`, bat.Vecs[3].GetStringAt(1))
+}
+
+func TestDetectFieldChanges_Avro(t *testing.T) {
+	ka := &registryMockAdapter{schemas: map[int]string{1: avroUserV1, 2: avroUserV2}}
+	configs := map[string]interface{}{
+		ValueKey: string(AVRO),
+		TopicKey: "users",
+	}
+	added, widened, err := DetectFieldChanges(context.Background(), ka, configs, []Column{
+		{Name: "name", Type: types.T_varchar},
+		{Name: "age", Type: types.T_int32},
+	}, []*kafka.Message{
+		{Value: avroPayload(1, avroUserV1Body("a", 7))},
+		{Value: avroPayload(2, avroUserV2Body("b", 8, "b@x"))},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []NewField{
+		{Name: "email", Type: types.T_text},
+		{Name: "tags", Type: types.T_text},
+	}, added)
+	assert.Equal(t, []NewField{{Name: "age", Type: types.T_int64}}, widened)
+}
+
+func TestNewAvroDecoder(t *testing.T) {
+	ka := &registryMockAdapter{schemas: map[int]string{1: avroUserV1}}
+	decode := NewAvroDecoder(ka, map[string]interface{}{TopicKey: "users"})
+	record, err := decode(context.Background(), avroPayload(1, avroUserV1Body("a", 7)))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"name": "a", "age": int32(7)}, record)
+
+	_, err = decode(context.Background(), avroPayload(3, avroUserV1Body("a", 7)))
+	assert.Error(t, err)
+}
@@ -0,0 +1,400 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mokafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/gogo/protobuf/proto"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/dynamic"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/sqlquote"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// srHeaderLen is the length of the schema registry wire format header: a
+// zero magic byte followed by the big-endian id of the writer schema.
+const srHeaderLen = 5
+
+// parseSchemaID returns the id of the registry schema payload was serialized
+// with.
+func parseSchemaID(ctx context.Context, payload []byte) (int, error) {
+	if len(payload) < srHeaderLen || payload[0] != 0 {
+		return 0, moerr.NewInvalidInput(ctx, "message is not in schema registry wire format")
+	}
+	return int(binary.BigEndian.Uint32(payload[1:srHeaderLen])), nil
+}
+
+// schemaCache resolves the registry schema every message was written with,
+// so that messages produced under older schema versions keep decoding after
+// the producers evolve the schema.
+type schemaCache struct {
+	ka    KafkaAdapterInterface
+	topic string
+	files map[int]*desc.FileDescriptor
+	avros map[int]*avroSchema
+}
+
+func newSchemaCache(ka KafkaAdapterInterface, topic string) *schemaCache {
+	return &schemaCache{
+		ka:    ka,
+		topic: topic,
+		files: make(map[int]*desc.FileDescriptor),
+		avros: make(map[int]*avroSchema),
+	}
+}
+
+// descriptor returns the descriptor of the message type payload was written
+// with, and the length of the header preceding the encoded message.
+func (c *schemaCache) descriptor(ctx context.Context, payload []byte) (*desc.MessageDescriptor, int, error) {
+	id, err := parseSchemaID(ctx, payload)
+	if err != nil {
+		return nil, 0, err
+	}
+	fd, ok := c.files[id]
+	if !ok {
+		info, err := c.ka.GetSchemaByID(c.topic, id, false)
+		if err != nil {
+			return nil, 0, err
+		}
+		if fd, err = parseProtobufSchema(info.Schema); err != nil {
+			return nil, 0, err
+		}
+		c.files[id] = fd
+	}
+	n, indexes, err := readMessageIndexes(payload[srHeaderLen:])
+	if err != nil {
+		return nil, 0, err
+	}
+	md := findMessageByIndexes(fd, indexes)
+	if md == nil {
+		return nil, 0, moerr.NewInvalidInputf(ctx, "message indexes %v not found in schema %d", indexes, id)
+	}
+	return md, srHeaderLen + n, nil
+}
+
+// decode deserializes payload with the schema it was written with.
+func (c *schemaCache) decode(ctx context.Context, payload []byte) (*dynamic.Message, error) {
+	md, n, err := c.descriptor(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	dm := dynamic.NewMessage(md)
+	if err := proto.Unmarshal(payload[n:], dm); err != nil {
+		return nil, err
+	}
+	return dm, nil
+}
+
+// avro returns the Avro schema payload was written with.
+func (c *schemaCache) avro(ctx context.Context, payload []byte) (*avroSchema, error) {
+	id, err := parseSchemaID(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	schema, ok := c.avros[id]
+	if !ok {
+		info, err := c.ka.GetSchemaByID(c.topic, id, false)
+		if err != nil {
+			return nil, err
+		}
+		if schema, err = parseAvroSchema(ctx, info.Schema); err != nil {
+			return nil, err
+		}
+		c.avros[id] = schema
+	}
+	return schema, nil
+}
+
+// decodeAvro deserializes the Avro record payload with the schema it was
+// written with.
+func (c *schemaCache) decodeAvro(ctx context.Context, payload []byte) (map[string]any, error) {
+	schema, err := c.avro(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	return decodeAvroRecord(ctx, schema, payload[srHeaderLen:])
+}
+
+// AvroDecoder decodes the Avro value of a message.
+type AvroDecoder func(ctx context.Context, value []byte) (map[string]any, error)
+
+// NewAvroDecoder returns the decoder of the values of configs the way
+// PopulateBatchFromMSG decodes them, with the registry schema every message
+// was written with.
+func NewAvroDecoder(ka KafkaAdapterInterface, configs map[string]interface{}) AvroDecoder {
+	topic, _ := configs[TopicKey].(string)
+	return newSchemaCache(ka, topic).decodeAvro
+}
+
+// ProtobufDecoder decodes the protobuf value of a message.
+type ProtobufDecoder func(ctx context.Context, value []byte) (*dynamic.Message, error)
+
+// NewProtobufDecoder returns the decoder of the values of configs the way
+// PopulateBatchFromMSG decodes them, with the schema of the configs for
+// PROTOBUF, and with the registry schema every message was written with for
+// PROTOBUFSR.
+func NewProtobufDecoder(ctx context.Context, ka KafkaAdapterInterface, configs map[string]interface{}) (ProtobufDecoder, error) {
+	switch value, _ := configs[ValueKey].(string); ValueType(value) {
+	case PROTOBUF:
+		schema, _ := configs[ProtobufSchemaKey].(string)
+		message, _ := configs[ProtobufMessagekey].(string)
+		md, err := convertProtobufSchemaToMD(schema, message)
+		if err != nil {
+			return nil, err
+		}
+		if md == nil {
+			return nil, moerr.NewInvalidInputf(ctx, "message %s not found in the protobuf schema", message)
+		}
+		return func(_ context.Context, value []byte) (*dynamic.Message, error) {
+			return deserializeProtobuf(md, value, false)
+		}, nil
+	case PROTOBUFSR:
+		topic, _ := configs[TopicKey].(string)
+		return newSchemaCache(ka, topic).decode, nil
+	default:
+		return nil, moerr.NewInvalidInputf(ctx, "value %s is not protobuf", value)
+	}
+}
+
+// findMessageByIndexes follows the message indexes of the wire format, the
+// first one into the top level messages of the file and the following ones
+// into the nested messages.
+func findMessageByIndexes(fd *desc.FileDescriptor, indexes []int) *desc.MessageDescriptor {
+	mds := fd.GetMessageTypes()
+	var md *desc.MessageDescriptor
+	for _, idx := range indexes {
+		if idx < 0 || idx >= len(mds) {
+			return nil
+		}
+		md = mds[idx]
+		mds = md.GetNestedMessageTypes()
+	}
+	return md
+}
+
+// Column is a column of the table the messages are ingested into.
+type Column struct {
+	Name    string
+	Type    types.T
+	NotNull bool
+}
+
+// NewField is a message field without a column in the table, or whose
+// column is widened to store it.
+type NewField struct {
+	Name string
+	// Type is the type of the column storing the field.
+	Type types.T
+	// NotNull keeps the widened column NOT NULL.
+	NotNull bool
+}
+
+// ColumnDef returns the definition of the column for the field, new columns
+// are nullable.
+func (f NewField) ColumnDef() string {
+	null := "NULL"
+	if f.NotNull {
+		null = "NOT NULL"
+	}
+	return fmt.Sprintf("%s %s %s", sqlquote.Ident(f.Name), f.Type.String(), null)
+}
+
+// DetectFieldChanges returns the fields of msgs that have no column in
+// columns, and the columns whose type is widened by the type of their field,
+// both sorted by name. Column names are matched case-insensitively and types
+// are widened by the rules of types.CanWiden. The registry formats follow the
+// schema version every message was written with. Fields whose type can not
+// be told yet, such as JSON nulls, are left out, so are the messages which
+// can not be decoded, the stream scan skips them too.
+func DetectFieldChanges(ctx context.Context, ka KafkaAdapterInterface, configs map[string]interface{}, columns []Column, msgs []*kafka.Message) (added []NewField, widened []NewField, err error) {
+	known := make(map[string]Column, len(columns))
+	for _, col := range columns {
+		known[strings.ToLower(col.Name)] = col
+	}
+	found := make(map[string]NewField)
+	widen := make(map[string]NewField)
+	// add records the type of a field, exact is false for the JSON numbers
+	// whose width is unknown, which never widen a column.
+	add := func(name string, typ types.T, exact bool) {
+		key := strings.ToLower(name)
+		if col, ok := known[key]; ok {
+			if !exact {
+				return
+			}
+			if w, ok := widen[key]; ok {
+				col.Type = w.Type
+			}
+			if types.CanWiden(col.Type, typ) {
+				widen[key] = NewField{Name: col.Name, Type: typ, NotNull: col.NotNull}
+			}
+			return
+		}
+		f, ok := found[key]
+		if !ok {
+			found[key] = NewField{Name: name, Type: typ}
+			return
+		}
+		if types.CanWiden(f.Type, typ) {
+			f.Type = typ
+			found[key] = f
+		}
+	}
+
+	value, ok := configs[ValueKey].(string)
+	if !ok {
+		return nil, nil, moerr.NewInternalErrorf(ctx, "expected string value for key: %s", ValueKey)
+	}
+	switch ValueType(value) {
+	case JSON:
+		for _, msg := range msgs {
+			var obj map[string]any
+			dec := json.NewDecoder(bytes.NewReader(msg.Value))
+			dec.UseNumber()
+			if err := dec.Decode(&obj); err != nil {
+				continue
+			}
+			for name, v := range obj {
+				if typ, exact, ok := jsonSQLType(v); ok {
+					add(name, typ, exact)
+				}
+			}
+		}
+	case PROTOBUF:
+		md, err := convertProtobufSchemaToMD(configs[ProtobufSchemaKey].(string), configs[ProtobufMessagekey].(string))
+		if err != nil {
+			return nil, nil, err
+		}
+		if md == nil {
+			return nil, nil, moerr.NewInvalidInputf(ctx, "message %v not found in protobuf schema", configs[ProtobufMessagekey])
+		}
+		for _, fd := range md.GetFields() {
+			add(fd.GetName(), protoSQLType(fd), true)
+		}
+	case PROTOBUFSR:
+		schemas := newSchemaCache(ka, configs[TopicKey].(string))
+		seen := make(map[*desc.MessageDescriptor]struct{})
+		for _, msg := range msgs {
+			md, _, err := schemas.descriptor(ctx, msg.Value)
+			if err != nil {
+				continue
+			}
+			if _, ok := seen[md]; ok {
+				continue
+			}
+			seen[md] = struct{}{}
+			for _, fd := range md.GetFields() {
+				add(fd.GetName(), protoSQLType(fd), true)
+			}
+		}
+	case AVRO:
+		schemas := newSchemaCache(ka, configs[TopicKey].(string))
+		seen := make(map[*avroSchema]struct{})
+		for _, msg := range msgs {
+			schema, err := schemas.avro(ctx, msg.Value)
+			if err != nil {
+				continue
+			}
+			if _, ok := seen[schema]; ok {
+				continue
+			}
+			seen[schema] = struct{}{}
+			for _, f := range schema.fields {
+				if typ, ok := avroSQLType(f.schema); ok {
+					add(f.name, typ, true)
+				}
+			}
+		}
+	default:
+		return nil, nil, moerr.NewInternalErrorf(ctx, "Unsupported value for key: %s", ValueKey)
+	}
+	return sortedFields(found), sortedFields(widen), nil
+}
+
+func sortedFields(m map[string]NewField) []NewField {
+	fields := make([]NewField, 0, len(m))
+	for _, f := range m {
+		fields = append(fields, f)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name < fields[j].Name
+	})
+	return fields
+}
+
+// jsonSQLType returns the column type storing a JSON value decoded with
+// json.Decoder.UseNumber, false for null. Integers are stored as BIGINT,
+// which is not exact as their width is unknown.
+func jsonSQLType(v any) (typ types.T, exact bool, ok bool) {
+	switch x := v.(type) {
+	case nil:
+		return 0, false, false
+	case bool:
+		return types.T_bool, true, true
+	case json.Number:
+		if _, err := x.Int64(); err == nil {
+			return types.T_int64, false, true
+		}
+		return types.T_float64, true, true
+	case string:
+		return types.T_text, true, true
+	default:
+		return types.T_json, true, true
+	}
+}
+
+// protoSQLType returns the column type storing a protobuf field.
+func protoSQLType(fd *desc.FieldDescriptor) types.T {
+	if fd.IsRepeated() || fd.IsMap() {
+		return types.T_text
+	}
+	switch fd.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		return types.T_bool
+	case descriptorpb.FieldDescriptorProto_TYPE_INT32,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		return types.T_int32
+	case descriptorpb.FieldDescriptorProto_TYPE_INT64,
+		descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return types.T_int64
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED32:
+		return types.T_uint32
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+		descriptorpb.FieldDescriptorProto_TYPE_FIXED64:
+		return types.T_uint64
+	case descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+		return types.T_float32
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return types.T_float64
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		return types.T_blob
+	default:
+		return types.T_text
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mokafka
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/jhump/protoreflect/dynamic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const (
+	userSchemaV1 = "syntax = \"proto3\";\npackage test;\n\nmessage User {\n  string name = 1;\n  int32 age = 2;\n  float score = 3;\n}"
+	// v2 widens age and score and adds email.
+	userSchemaV2 = "syntax = \"proto3\";\npackage test;\n\nmessage User {\n  string name = 1;\n  int64 age = 2;\n  double score = 3;\n  string email = 4;\n}"
+)
+
+// registryMockAdapter serves the registry schemas by id.
+type registryMockAdapter struct {
+	MockKafkaAdapter
+	schemas map[int]string
+}
+
+func (m *registryMockAdapter) GetSchemaByID(topic string, id int, isKey bool) (schemaregistry.SchemaInfo, error) {
+	schema, ok := m.schemas[id]
+	if !ok {
+		return schemaregistry.SchemaInfo{}, moerr.NewInternalErrorNoCtxf("schema %d not found", id)
+	}
+	return schemaregistry.SchemaInfo{Schema: schema}, nil
+}
+
+// srPayload serializes fields with schema id in the schema registry wire
+// format.
+func srPayload(t *testing.T, schema string, id int, fields map[string]any) []byte {
+	md, err := convertProtobufSchemaToMD(schema, "test.User")
+	require.NoError(t, err)
+	dm := dynamic.NewMessage(md)
+	for name, v := range fields {
+		require.NoError(t, dm.TrySetFieldByName(name, v))
+	}
+	data, err := dm.Marshal()
+	require.NoError(t, err)
+	header := make([]byte, srHeaderLen, srHeaderLen+1+len(data))
+	binary.BigEndian.PutUint32(header[1:], uint32(id))
+	// a single zero message index stands for the first message of the schema.
+	return append(append(header, 0), data...)
+}
+
+func TestParseSchemaID(t *testing.T) {
+	ctx := context.Background()
+	id, err := parseSchemaID(ctx, []byte{0, 0, 0, 1, 2, 0})
+	assert.NoError(t, err)
+	assert.Equal(t, 258, id)
+
+	_, err = parseSchemaID(ctx, []byte{1, 0, 0, 0, 1})
+	assert.Error(t, err)
+	_, err = parseSchemaID(ctx, []byte{0, 0})
+	assert.Error(t, err)
+}
+
+func TestFindMessageByIndexes(t *testing.T) {
+	fd, err := parseProtobufSchema("syntax = \"proto3\";\npackage test;\n" +
+		"message A { message Inner { int32 x = 1; } }\nmessage B { int32 y = 1; }")
+	require.NoError(t, err)
+	assert.Equal(t, "test.A", findMessageByIndexes(fd, []int{0}).GetFullyQualifiedName())
+	assert.Equal(t, "test.B", findMessageByIndexes(fd, []int{1}).GetFullyQualifiedName())
+	assert.Equal(t, "test.A.Inner", findMessageByIndexes(fd, []int{0, 0}).GetFullyQualifiedName())
+	assert.Nil(t, findMessageByIndexes(fd, []int{2}))
+}
+
+func TestPopulateBatchFromMSG_SchemaEvolution(t *testing.T) {
+	ka := &registryMockAdapter{schemas: map[int]string{1: userSchemaV1, 2: userSchemaV2}}
+	msgs := []*kafka.Message{
+		{Value: srPayload(t, userSchemaV1, 1, map[string]any{"name": "a", "age": int32(7), "score": float32(1.5)})},
+		{Value: srPayload(t, userSchemaV2, 2, map[string]any{"name": "b", "age": int64(1) << 40, "score": 2.25, "email": "b@x"})},
+	}
+	configs := map[string]interface{}{
+		ValueKey: string(PROTOBUFSR),
+		TopicKey: "users",
+	}
+	attrs := []string{"name", "age", "score"}
+	typs := []types.Type{
+		types.New(types.T_varchar, 30, 0),
+		types.New(types.T_int64, 0, 0),
+		types.New(types.T_float64, 0, 0),
+	}
+	mp := mpool.MustNewZero()
+	bat, err := PopulateBatchFromMSG(context.Background(), ka, typs, attrs, msgs, configs, mp)
+	require.NoError(t, err)
+	defer bat.Clean(mp)
+
+	assert.Equal(t, 2, bat.RowCount())
+	assert.Equal(t, "a", bat.Vecs[0].GetStringAt(0))
+	assert.Equal(t, "b", bat.Vecs[0].GetStringAt(1))
+	// the messages of both versions promote to the wider columns.
+	assert.Equal(t, []int64{7, 1 << 40}, vector.MustFixedColNoTypeCheck[int64](bat.Vecs[1]))
+	assert.Equal(t, []float64{1.5, 2.25}, vector.MustFixedColNoTypeCheck[float64](bat.Vecs[2]))
+}
+
+func TestPopulateBatchFromMSG_SkipsUndecodable(t *testing.T) {
+	ka := &registryMockAdapter{schemas: map[int]string{1: userSchemaV1}}
+	msgs := []*kafka.Message{
+		{Value: srPayload(t, userSchemaV1, 3, map[string]any{"name": "a"})},
+		{Value: srPayload(t, userSchemaV1, 1, map[string]any{"name": "b"})},
+		{Value: []byte("not in wire format")},
+	}
+	configs := map[string]interface{}{
+		ValueKey: string(PROTOBUFSR),
+		TopicKey: "users",
+	}
+	mp := mpool.MustNewZero()
+	bat, err := PopulateBatchFromMSG(context.Background(), ka,
+		[]types.Type{types.New(types.T_varchar, 30, 0)}, []string{"name"}, msgs, configs, mp)
+	require.NoError(t, err)
+	defer bat.Clean(mp)
+	// the messages of an unknown schema or not in the wire format are skipped.
+	assert.Equal(t, 1, bat.RowCount())
+	assert.Equal(t, "b", bat.Vecs[0].GetStringAt(0))
+}
+
+func TestDetectFieldChanges(t *testing.T) {
+	ctx := context.Background()
+
+	added, widened, err := DetectFieldChanges(ctx, nil, map[string]interface{}{ValueKey: string(JSON)}, []Column{
+		{Name: "ID", Type: types.T_int32},
+		{Name: "amount", Type: types.T_int64, NotNull: true},
+	}, []*kafka.Message{
+		{Value: []byte(`{"id": 1, "n": 1, "ok": true, "tags": [1], "v": null, "amount": 1}`)},
+		{Value: []byte(`{"id": 2, "n": 1.5, "s": "x", "amount": 2.5}`)},
+		{Value: []byte(`not json`)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []NewField{
+		{Name: "n", Type: types.T_float64},
+		{Name: "ok", Type: types.T_bool},
+		{Name: "s", Type: types.T_text},
+		{Name: "tags", Type: types.T_json},
+	}, added)
+	// the JSON integers do not widen id, the fractional amount does.
+	assert.Equal(t, []NewField{{Name: "amount", Type: types.T_float64, NotNull: true}}, widened)
+	assert.Equal(t, "`amount` DOUBLE NOT NULL", widened[0].ColumnDef())
+
+	ka := &registryMockAdapter{schemas: map[int]string{1: userSchemaV1, 2: userSchemaV2}}
+	configs := map[string]interface{}{
+		ValueKey: string(PROTOBUFSR),
+		TopicKey: "users",
+	}
+	added, widened, err = DetectFieldChanges(ctx, ka, configs, []Column{
+		{Name: "name", Type: types.T_varchar},
+		{Name: "age", Type: types.T_int32},
+	}, []*kafka.Message{
+		{Value: srPayload(t, userSchemaV1, 1, map[string]any{"name": "a"})},
+		{Value: srPayload(t, userSchemaV2, 2, map[string]any{"name": "b"})},
+		{Value: []byte("not in wire format")},
+	})
+	assert.NoError(t, err)
+	// score widens to the type of v2.
+	assert.Equal(t, []NewField{
+		{Name: "email", Type: types.T_text},
+		{Name: "score", Type: types.T_float64},
+	}, added)
+	assert.Equal(t, "`email` TEXT NULL", added[0].ColumnDef())
+	assert.Equal(t, []NewField{{Name: "age", Type: types.T_int64}}, widened)
+}
+
+func TestValidateConfig_SchemaRegistry(t *testing.T) {
+	factory := func(configMap *kafka.ConfigMap) (KafkaAdapterInterface, error) {
+		return &MockKafkaAdapter{}, nil
+	}
+	configs := map[string]interface{}{
+		TypeKey:                 "kafka",
+		TopicKey:                "users",
+		ValueKey:                string(PROTOBUFSR),
+		BootstrapServersKey:     "localhost:9092",
+		ProtobufMessagekey:      "test.User",
+		SchemaRegistryKey:       "http://localhost:8081",
+		SchemaAutoAddColumnsKey: "true",
+	}
+	assert.NoError(t, ValidateConfig(context.Background(), configs, factory))
+
+	configs[SchemaAutoAddColumnsKey] = "maybe"
+	assert.Error(t, ValidateConfig(context.Background(), configs, factory))
+}
@@ -110,6 +110,22 @@ type KafkaMoConnector struct {
 	cancelC      chan struct{}
 	pauseC       chan struct{}
 	bufferLimit  int
+
+	groupId string
+	// deadLetterTable receives the messages which can not be decoded. If it
+	// is empty, such messages are left to the stream scan which skips them.
+	deadLetterTable string
+	// offsets is the next offset of every partition committed to MO.
+	offsets partitionOffsets
+
+	// autoAddColumns approves adding the message fields without a column in
+	// the source table as nullable columns, and widening the columns whose
+	// fields are written with a wider type.
+	autoAddColumns bool
+	// sourceColumns and tableColumns cache the columns of the source table
+	// and the dynamic table, nil until loaded.
+	sourceColumns []mokafka.Column
+	tableColumns  []mokafka.Column
 }
 
 func convertToKafkaConfig(configs map[string]string) *kafka.ConfigMap {
@@ -128,24 +144,34 @@ func convertToKafkaConfig(configs map[string]string) *kafka.ConfigMap {
 			kafkaConfigs.SetKey(key, value)
 		}
 	}
-	groupId := configs[mokafka.TopicKey] + "-" + configs[mokafka.DatabaseKey] + "-" + configs[mokafka.TableKey] + "-" + configs[mokafka.PartitionKey] + "-" + configs[mokafka.CREATED_AT]
-	kafkaConfigs.SetKey("group.id", groupId)
+	kafkaConfigs.SetKey("group.id", getGroupId(configs))
+	// The offsets are committed to MO together with the inserted rows.
+	kafkaConfigs.SetKey("enable.auto.commit", false)
 	return kafkaConfigs
 }
 
+func getGroupId(configs map[string]string) string {
+	return configs[mokafka.TopicKey] + "-" + configs[mokafka.DatabaseKey] + "-" + configs[mokafka.TableKey] + "-" + configs[mokafka.PartitionKey] + "-" + configs[mokafka.CREATED_AT]
+}
+
 func NewKafkaMoConnector(logger *zap.Logger, options map[string]string, ie ie.InternalExecutor, buffer_limit int) (*KafkaMoConnector, error) {
 	// Validate options before proceeding
 	kmc := &KafkaMoConnector{
 		logger:      logger,
 		options:     options,
 		ie:          ie,
-		decoder:     newJsonDecoder(),
 		bufferLimit: buffer_limit,
+		groupId:     getGroupId(options),
+		offsets:     make(partitionOffsets),
 	}
 	if err := kmc.validateParams(); err != nil {
 		return nil, err
 	}
 	kmc.converter = newSQLConverter(options[mokafka.DatabaseKey], options[mokafka.TableKey])
+	if name := options[OptConnectorDeadLetterTable]; name != "" {
+		kmc.deadLetterTable = deadLetterTableName(name, options[mokafka.DatabaseKey])
+	}
+	kmc.autoAddColumns = options[OptConnectorAutoAddColumns] == "true"
 
 	// Create a Kafka consumer using the provided options
 	kafkaAdapter, err := mokafka.NewKafkaAdapter(convertToKafkaConfig(options))
@@ -153,6 +179,15 @@ func NewKafkaMoConnector(logger *zap.Logger, options map[string]string, ie ie.In
 		return nil, err
 	}
 
+	if valueType := mokafka.ValueType(options[OptConnectorValue]); valueType == mokafka.PROTOBUFSR || valueType == mokafka.AVRO {
+		if err = kafkaAdapter.InitSchemaRegistry(options[mokafka.SchemaRegistryKey]); err != nil {
+			return nil, err
+		}
+	}
+	if kmc.decoder, err = newDecoder(context.Background(), kafkaAdapter, options); err != nil {
+		return nil, err
+	}
+
 	kmc.kafkaAdapter = kafkaAdapter
 	kmc.resumeC = make(chan struct{})
 	kmc.cancelC = make(chan struct{})
@@ -179,7 +214,21 @@ func (k *KafkaMoConnector) validateParams() error {
 	}
 
 	// 3. Check for supported value format
-	if k.options["value"] != "json" {
+	switch mokafka.ValueType(k.options[OptConnectorValue]) {
+	case mokafka.JSON:
+	case mokafka.PROTOBUF:
+		if k.options[mokafka.ProtobufSchemaKey] == "" || k.options[mokafka.ProtobufMessagekey] == "" {
+			return moerr.NewInternalError(context.Background(), "missing required params")
+		}
+	case mokafka.PROTOBUFSR:
+		if k.options[mokafka.ProtobufMessagekey] == "" || k.options[mokafka.SchemaRegistryKey] == "" {
+			return moerr.NewInternalError(context.Background(), "missing required params")
+		}
+	case mokafka.AVRO:
+		if k.options[mokafka.SchemaRegistryKey] == "" {
+			return moerr.NewInternalError(context.Background(), "missing required params")
+		}
+	default:
 		return moerr.NewInternalError(context.Background(), "Unsupported value format")
 	}
 
@@ -197,6 +246,24 @@ func (k *KafkaMoConnector) Start(ctx context.Context) error {
 	if err != nil {
 		return moerr.NewInternalError(ctx, "Kafka Adapter Consumer not initialized")
 	}
+	// Resume from the offsets committed together with the inserted rows
+	// rather than the offsets of the kafka consumer group.
+	k.offsets, err = loadOffsets(ctx, k.ie, k.groupId)
+	if err != nil {
+		return err
+	}
+	if k.deadLetterTable != "" {
+		sql := fmt.Sprintf(createDeadLetterTableFormat, k.deadLetterTable)
+		if err := k.ie.Exec(ctx, sql, ie.SessionOverrideOptions{}); err != nil {
+			return err
+		}
+	}
+
+	var buffered_messages []*kafka.Message
+	var mutex sync.Mutex
+	// pausedParts is not empty while a failed flush waits for a retry.
+	var pausedParts []kafka.TopicPartition
+
 	// Define the topic to consume from
 	topic := k.options[mokafka.TopicKey]
 
@@ -206,21 +273,75 @@ func (k *KafkaMoConnector) Start(ctx context.Context) error {
 		if err != nil {
 			return moerr.NewInternalError(ctx, "Invalid partition")
 		}
-		if err := ct.Assign([]kafka.TopicPartition{{Topic: &topic, Partition: int32(partition)}}); err != nil {
+		parts := withStoredOffsets([]kafka.TopicPartition{{Topic: &topic, Partition: int32(partition)}}, k.offsets)
+		if err := ct.Assign(parts); err != nil {
 			return moerr.NewInternalError(ctx, "Failed to assign partition")
 		}
 	} else {
-		if err := ct.Subscribe(topic, nil); err != nil {
+		rebalance := func(c *kafka.Consumer, ev kafka.Event) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			switch e := ev.(type) {
+			case kafka.AssignedPartitions:
+				// The buffered messages are consumed again from the stored offsets.
+				buffered_messages = buffered_messages[:0]
+				pausedParts = nil
+				return c.Assign(withStoredOffsets(e.Partitions, k.offsets))
+			case kafka.RevokedPartitions:
+				buffered_messages = buffered_messages[:0]
+				pausedParts = nil
+				return c.Unassign()
+			}
+			return nil
+		}
+		if err := ct.Subscribe(topic, rebalance); err != nil {
 			return moerr.NewInternalError(ctx, "Failed to subscribe to topic")
 		}
 	}
 	// Continuously listen for messages
-	var buffered_messages []*kafka.Message
 	timeWindow := getTimeWindow(k.options[mokafka.TimeWindowKey])
 	var timer *time.Timer
 	timer = time.NewTimer(time.Duration(timeWindow) * time.Millisecond)
 	timerRunning := false
-	var mutex sync.Mutex
+
+	// flush inserts the buffered messages and must be called with mutex held.
+	// On failure the messages are kept and the consumer is paused until a
+	// retry succeeds, so that no message is skipped.
+	var flush func()
+	scheduleFlush := func() {
+		if timerRunning {
+			return
+		}
+		timer.Stop()
+		timer = time.AfterFunc(time.Duration(timeWindow)*time.Millisecond, func() {
+			mutex.Lock()
+			defer mutex.Unlock()
+			timerRunning = false
+			flush()
+		})
+		timerRunning = true
+	}
+	flush = func() {
+		if len(buffered_messages) == 0 {
+			return
+		}
+		if err := k.insertRow(buffered_messages); err != nil {
+			if len(pausedParts) == 0 {
+				if parts, err := ct.Assignment(); err == nil && len(parts) > 0 && ct.Pause(parts) == nil {
+					pausedParts = parts
+				}
+			}
+			scheduleFlush()
+			return
+		}
+		buffered_messages = buffered_messages[:0]
+		if len(pausedParts) > 0 {
+			if err := ct.Resume(pausedParts); err != nil {
+				k.logger.Error("failed to resume partitions", zap.Error(err))
+			}
+			pausedParts = nil
+		}
+	}
 
 	for {
 		select {
@@ -258,28 +379,16 @@ func (k *KafkaMoConnector) Start(ctx context.Context) error {
 				buffered_messages = append(buffered_messages, e)
 
 				// Start the timer if it's not already running
-				if !timerRunning {
-					timer.Stop()
-					timer = time.AfterFunc(time.Duration(timeWindow)*time.Millisecond, func() {
-						mutex.Lock()
-						if len(buffered_messages) > 0 {
-							k.insertRow(buffered_messages)
-							buffered_messages = buffered_messages[:0]
-						}
-						timerRunning = false
-						mutex.Unlock()
-					})
-					timerRunning = true
-				}
+				scheduleFlush()
 
-				// Flush the buffer if the limit is reached
-				if len(buffered_messages) >= k.bufferLimit {
+				// Flush the buffer if the limit is reached, a failed flush
+				// is only retried by the timer.
+				if len(buffered_messages) >= k.bufferLimit && len(pausedParts) == 0 {
 					if timerRunning {
 						timer.Stop()
 						timerRunning = false
 					}
-					k.insertRow(buffered_messages)
-					buffered_messages = buffered_messages[:0]
+					flush()
 				}
 				mutex.Unlock()
 			case kafka.Error:
@@ -328,21 +437,57 @@ func (k *KafkaMoConnector) Close() error {
 	return nil
 }
 
-func (k *KafkaMoConnector) insertRow(msgs []*kafka.Message) {
+// insertRow inserts msgs into the table and saves the offsets following them
+// in the same transaction, so a message is neither lost nor inserted twice
+// across restarts. The messages which can not be decoded go to the
+// dead-letter table if there is one.
+func (k *KafkaMoConnector) insertRow(msgs []*kafka.Message) error {
 	opts := ie.SessionOverrideOptions{}
 	ctx := context.Background()
 	sql := k.options["sql"]
 	dbName := k.options[mokafka.DatabaseKey]
 	tableName := k.options[mokafka.TableKey]
 	if sql == "" {
-		return
+		return nil
 	}
-	ctx = context.WithValue(ctx, defines.SourceScanResKey{}, msgs)
 
-	sql = fmt.Sprintf("USE %s; INSERT INTO %s.%s %s ",
-		dbName, dbName, tableName, sql)
+	rows := msgs
+	var dead []deadLetter
+	if k.deadLetterTable != "" {
+		rows, dead = splitMessages(k.decoder, msgs)
+	}
+	ctx = context.WithValue(ctx, defines.SourceScanResKey{}, rows)
+
+	offsets := nextOffsets(msgs)
+	// the columns are added or widened in the transaction inserting the
+	// messages, so they are not changed without the data needing them.
+	stmts := []string{fmt.Sprintf("USE %s", dbName), "BEGIN"}
+	if k.autoAddColumns && len(rows) > 0 {
+		ddl, err := k.evolveSchema(ctx, rows)
+		if err != nil {
+			k.logger.Error("failed to detect new fields", zap.Error(err))
+			return err
+		}
+		stmts = append(stmts, ddl...)
+	}
+	if len(rows) > 0 {
+		stmts = append(stmts, fmt.Sprintf("INSERT INTO %s.%s %s", dbName, tableName, sql))
+	}
+	if len(dead) > 0 {
+		stmts = append(stmts, insertDeadLetterSQL(k.deadLetterTable, dead))
+	}
+	stmts = append(stmts, saveOffsetsSQL(k.groupId, dbName, tableName, offsets), "COMMIT")
+	sql = strings.Join(stmts, "; ")
+
 	err := k.ie.Exec(ctx, sql, opts)
 	if err != nil {
 		k.logger.Error("failed to insert row", zap.String("SQL", sql), zap.Error(err))
+		// reload the columns as the new ones may not have been added.
+		k.sourceColumns, k.tableColumns = nil, nil
+		return err
+	}
+	for key, offset := range offsets {
+		k.offsets[key] = offset
 	}
+	return nil
 }
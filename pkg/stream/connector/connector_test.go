@@ -17,6 +17,7 @@ package moconnector
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/pb/task"
 	"github.com/matrixorigin/matrixone/pkg/taskservice"
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
@@ -34,14 +36,28 @@ import (
 type MockSQLExecutor struct {
 	execCount    int
 	executedSQLs []string
-	wg           *sync.WaitGroup
+	// scanMsgs records the messages handed to the stream scan of each Exec.
+	scanMsgs [][]*kafka.Message
+	// execErrs is returned by the Exec calls in order.
+	execErrs    []error
+	queryResult *internalExecResult
+	// queryFunc answers the queries when it is set.
+	queryFunc func(sql string) *internalExecResult
+	wg        *sync.WaitGroup
 }
 
 func (m *MockSQLExecutor) Exec(ctx context.Context, sql string, opts ie.SessionOverrideOptions) error {
 	m.execCount++
 	m.executedSQLs = append(m.executedSQLs, sql)
+	msgs, _ := ctx.Value(defines.SourceScanResKey{}).([]*kafka.Message)
+	m.scanMsgs = append(m.scanMsgs, append([]*kafka.Message(nil), msgs...))
+	var err error
+	if len(m.execErrs) > 0 {
+		err = m.execErrs[0]
+		m.execErrs = m.execErrs[1:]
+	}
 	m.wg.Done() // Decrement the WaitGroup counter after processing a message
-	return nil
+	return err
 }
 
 type MysqlResultSet struct {
@@ -60,8 +76,8 @@ type internalExecResult struct {
 	err          error
 }
 
-func (res *internalExecResult) GetUint64(ctx context.Context, u uint64, u2 uint64) (uint64, error) {
-	return 0, nil
+func (res *internalExecResult) GetUint64(ctx context.Context, ridx uint64, cid uint64) (uint64, error) {
+	return res.resultSet.Data[ridx][cid].(uint64), nil
 }
 
 func (res *internalExecResult) Error() error {
@@ -77,7 +93,10 @@ func (res *internalExecResult) Column(ctx context.Context, i uint64) (name strin
 }
 
 func (res *internalExecResult) RowCount() uint64 {
-	return 1
+	if res.resultSet == nil {
+		return 0
+	}
+	return uint64(len(res.resultSet.Data))
 }
 
 func (res *internalExecResult) Row(ctx context.Context, i uint64) ([]interface{}, error) {
@@ -92,11 +111,17 @@ func (res *internalExecResult) GetFloat64(ctx context.Context, ridx uint64, cid
 	return 0.0, nil
 }
 func (res *internalExecResult) GetString(ctx context.Context, ridx uint64, cid uint64) (string, error) {
-	return "", nil
+	return res.resultSet.Data[ridx][cid].(string), nil
 }
 
 func (m *MockSQLExecutor) Query(ctx context.Context, sql string, pts ie.SessionOverrideOptions) ie.InternalExecResult {
-	return &internalExecResult{affectedRows: 1, resultSet: nil, err: moerr.NewInternalError(context.TODO(), "random")}
+	if m.queryFunc != nil {
+		return m.queryFunc(sql)
+	}
+	if m.queryResult != nil {
+		return m.queryResult
+	}
+	return &internalExecResult{}
 }
 
 func (m *MockSQLExecutor) ApplySessionOverride(opts ie.SessionOverrideOptions) {}
@@ -185,6 +210,236 @@ func TestKafkaMoConnector(t *testing.T) {
 	if mockExecutor.execCount != msg_num {
 		t.Errorf("Expected SQL to be executed 10 times, but got %d", mockExecutor.execCount)
 	}
+	// Every insert commits the offsets in the same transaction
+	for _, sql := range mockExecutor.executedSQLs {
+		assert.True(t, strings.HasPrefix(sql, "USE testDB; BEGIN; INSERT INTO testDB.testTable select * from testDB.testStream; "))
+		assert.Contains(t, sql, "INSERT INTO `mo_catalog`.`mo_stream_offsets`")
+		assert.True(t, strings.HasSuffix(sql, "; COMMIT"))
+	}
+}
+
+func newTestConnector(t *testing.T, broker, topic string, executor *MockSQLExecutor, bufferLimit int, extra map[string]string) *KafkaMoConnector {
+	options := map[string]string{
+		"type":              "kafka",
+		"topic":             topic,
+		"database":          "testDB",
+		"table":             "testTable",
+		"value":             "json",
+		"bootstrap.servers": broker,
+		"sql":               "select * from testDB.testStream",
+		"time_window":       "100",
+	}
+	for key, value := range extra {
+		options[key] = value
+	}
+	rt := runtime.DefaultRuntime()
+	connector, err := NewKafkaMoConnector(rt.Logger().RawLogger(), options, executor, bufferLimit)
+	if err != nil {
+		t.Fatalf("Failed to create KafkaMoConnector: %s", err)
+	}
+	return connector
+}
+
+func produceMessages(t *testing.T, broker, topic string, values ...string) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": broker})
+	if err != nil {
+		t.Fatalf("Failed to create producer: %s", err)
+	}
+	defer p.Close()
+	delivered := make(chan kafka.Event, len(values))
+	for _, value := range values {
+		err = p.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0},
+			Value:          []byte(value),
+		}, delivered)
+		assert.NoError(t, err)
+	}
+	for range values {
+		msg := (<-delivered).(*kafka.Message)
+		assert.NoError(t, msg.TopicPartition.Error)
+	}
+}
+
+func waitExecuted(t *testing.T, wg *sync.WaitGroup) {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatal("Timed out waiting for messages to be processed")
+	}
+}
+
+func messageOffsets(msgs []*kafka.Message) []int64 {
+	offsets := make([]int64, 0, len(msgs))
+	for _, msg := range msgs {
+		offsets = append(offsets, int64(msg.TopicPartition.Offset))
+	}
+	return offsets
+}
+
+func TestKafkaMoConnector_ResumeFromStoredOffsets(t *testing.T) {
+	mockCluster, err := kafka.NewMockCluster(1)
+	assert.NoError(t, err)
+	defer mockCluster.Close()
+	broker := mockCluster.BootstrapServers()
+	topic := "resumeTopic"
+	assert.NoError(t, mockCluster.CreateTopic(topic, 1, 1))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	mockExecutor := &MockSQLExecutor{
+		wg: &wg,
+		queryResult: &internalExecResult{resultSet: &MysqlResultSet{
+			Data: [][]interface{}{{topic, uint64(0), uint64(3)}},
+		}},
+	}
+	connector := newTestConnector(t, broker, topic, mockExecutor, 2, map[string]string{"partition": "0"})
+	produceMessages(t, broker, topic, `{"a":0}`, `{"a":1}`, `{"a":2}`, `{"a":3}`, `{"a":4}`)
+
+	go func() {
+		_ = connector.Start(context.Background())
+	}()
+	waitExecuted(t, &wg)
+	assert.NoError(t, connector.Cancel())
+
+	// The messages before the stored offset are not consumed again
+	assert.Equal(t, []int64{3, 4}, messageOffsets(mockExecutor.scanMsgs[0]))
+	assert.Contains(t, mockExecutor.executedSQLs[0], "'resumeTopic', 0, 'testDB', 'testTable', 5, now()")
+}
+
+func TestKafkaMoConnector_DeadLetter(t *testing.T) {
+	mockCluster, err := kafka.NewMockCluster(1)
+	assert.NoError(t, err)
+	defer mockCluster.Close()
+	broker := mockCluster.BootstrapServers()
+	topic := "deadLetterTopic"
+	assert.NoError(t, mockCluster.CreateTopic(topic, 1, 1))
+
+	var wg sync.WaitGroup
+	// create the dead-letter table and flush the messages
+	wg.Add(2)
+	mockExecutor := &MockSQLExecutor{wg: &wg}
+	connector := newTestConnector(t, broker, topic, mockExecutor, 3, map[string]string{"dead_letter_table": "dlq"})
+	produceMessages(t, broker, topic, `{"a":0}`, `{"a":`, `{"a":2}`)
+
+	go func() {
+		_ = connector.Start(context.Background())
+	}()
+	waitExecuted(t, &wg)
+	assert.NoError(t, connector.Cancel())
+
+	assert.True(t, strings.HasPrefix(mockExecutor.executedSQLs[0], "CREATE TABLE IF NOT EXISTS `testDB`.`dlq`"))
+	assert.Equal(t, []int64{0, 2}, messageOffsets(mockExecutor.scanMsgs[1]))
+	sql := mockExecutor.executedSQLs[1]
+	assert.Contains(t, sql, "INSERT INTO `testDB`.`dlq` (topic, partition_id, msg_offset, msg_key, payload, error_msg) VALUES ('deadLetterTopic', 0, 1, NULL, unhex('7b2261223a'),")
+	assert.Contains(t, sql, "'deadLetterTopic', 0, 'testDB', 'testTable', 3, now()")
+}
+
+func TestKafkaMoConnector_DeadLetterProtobuf(t *testing.T) {
+	mockCluster, err := kafka.NewMockCluster(1)
+	assert.NoError(t, err)
+	defer mockCluster.Close()
+	broker := mockCluster.BootstrapServers()
+	topic := "deadLetterProtobufTopic"
+	assert.NoError(t, mockCluster.CreateTopic(topic, 1, 1))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	mockExecutor := &MockSQLExecutor{wg: &wg}
+	connector := newTestConnector(t, broker, topic, mockExecutor, 3, map[string]string{
+		"dead_letter_table": "dlq",
+		"value":             "protobuf",
+		"protobuf.schema":   `syntax = "proto3"; message User { string name = 1; int32 age = 2; }`,
+		"protobuf.message":  "User",
+	})
+	// a valid json message is not a valid protobuf one
+	produceMessages(t, broker, topic, "\x0a\x02ab\x10\x01", `{"a":1}`, "\x0a\x02cd\x10\x02")
+
+	go func() {
+		_ = connector.Start(context.Background())
+	}()
+	waitExecuted(t, &wg)
+	assert.NoError(t, connector.Cancel())
+
+	assert.Equal(t, []int64{0, 2}, messageOffsets(mockExecutor.scanMsgs[1]))
+	sql := mockExecutor.executedSQLs[1]
+	assert.Contains(t, sql, "INSERT INTO `testDB`.`dlq` (topic, partition_id, msg_offset, msg_key, payload, error_msg) VALUES ('deadLetterProtobufTopic', 0, 1, NULL, unhex('7b2261223a317d'),")
+	assert.Contains(t, sql, "'deadLetterProtobufTopic', 0, 'testDB', 'testTable', 3, now()")
+}
+
+func TestNewDecoder(t *testing.T) {
+	ctx := context.Background()
+	decoder, err := newDecoder(ctx, nil, map[string]string{"value": "json"})
+	assert.NoError(t, err)
+	obj, err := decoder.Decode([]byte(`{"a":1}`))
+	assert.NoError(t, err)
+	assert.Equal(t, RawObject{"a": float64(1)}, obj)
+
+	decoder, err = newDecoder(ctx, nil, map[string]string{
+		"value":            "protobuf",
+		"protobuf.schema":  `syntax = "proto3"; message User { string name = 1; int32 age = 2; }`,
+		"protobuf.message": "User",
+	})
+	assert.NoError(t, err)
+	obj, err = decoder.Decode([]byte("\x0a\x02ab\x10\x01"))
+	assert.NoError(t, err)
+	assert.Equal(t, RawObject{"name": "ab", "age": int32(1)}, obj)
+
+	// the message is not in the schema
+	_, err = newDecoder(ctx, nil, map[string]string{
+		"value":            "protobuf",
+		"protobuf.schema":  `syntax = "proto3"; message User { string name = 1; }`,
+		"protobuf.message": "Order",
+	})
+	assert.Error(t, err)
+	_, err = newDecoder(ctx, nil, map[string]string{"value": "csv"})
+	assert.Error(t, err)
+}
+
+func TestKafkaMoConnector_RetryFailedFlush(t *testing.T) {
+	mockCluster, err := kafka.NewMockCluster(1)
+	assert.NoError(t, err)
+	defer mockCluster.Close()
+	broker := mockCluster.BootstrapServers()
+	topic := "retryTopic"
+	assert.NoError(t, mockCluster.CreateTopic(topic, 1, 1))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	mockExecutor := &MockSQLExecutor{
+		wg:       &wg,
+		execErrs: []error{moerr.NewInternalErrorNoCtx("insert failed")},
+	}
+	connector := newTestConnector(t, broker, topic, mockExecutor, 2, map[string]string{"partition": "0"})
+	produceMessages(t, broker, topic, `{"a":0}`, `{"a":1}`)
+
+	go func() {
+		_ = connector.Start(context.Background())
+	}()
+	waitExecuted(t, &wg)
+	assert.NoError(t, connector.Cancel())
+
+	// The failed batch is inserted again instead of being dropped
+	assert.Equal(t, []int64{0, 1}, messageOffsets(mockExecutor.scanMsgs[0]))
+	assert.Equal(t, []int64{0, 1}, messageOffsets(mockExecutor.scanMsgs[1]))
+	assert.Equal(t, mockExecutor.executedSQLs[0], mockExecutor.executedSQLs[1])
+}
+
+func TestKafkaMoConnector_LoadOffsetsFailed(t *testing.T) {
+	mockCluster, err := kafka.NewMockCluster(1)
+	assert.NoError(t, err)
+	defer mockCluster.Close()
+
+	mockExecutor := &MockSQLExecutor{
+		queryResult: &internalExecResult{err: moerr.NewInternalErrorNoCtx("random")},
+	}
+	connector := newTestConnector(t, mockCluster.BootstrapServers(), "topic", mockExecutor, 1, nil)
+	assert.Error(t, connector.Start(context.Background()))
+	assert.NoError(t, connector.Close())
 }
 
 var _ taskservice.TaskService = new(testTaskService)
@@ -0,0 +1,97 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+
+	"github.com/matrixorigin/matrixone/pkg/common/sqlquote"
+)
+
+const (
+	createDeadLetterTableFormat = "CREATE TABLE IF NOT EXISTS %s (" +
+		"topic varchar(256), " +
+		"partition_id int, " +
+		"msg_offset bigint, " +
+		"msg_key blob, " +
+		"payload blob, " +
+		"error_msg text, " +
+		"create_time timestamp default current_timestamp, " +
+		"primary key(topic, partition_id, msg_offset))"
+
+	insertDeadLetterFormat = "INSERT INTO %s " +
+		"(topic, partition_id, msg_offset, msg_key, payload, error_msg) VALUES %s"
+)
+
+// deadLetter is a message that could not be decoded.
+type deadLetter struct {
+	msg *kafka.Message
+	err error
+}
+
+// deadLetterTableName returns the quoted name of the dead-letter table.
+// A table name without database falls into the database of the connector.
+func deadLetterTableName(name, dbName string) string {
+	if db, tbl, ok := strings.Cut(name, "."); ok {
+		return sqlquote.QualifiedIdent(db, tbl)
+	}
+	return sqlquote.QualifiedIdent(dbName, name)
+}
+
+// splitMessages decodes msgs and separates those failing to decode.
+func splitMessages(decoder Decoder, msgs []*kafka.Message) ([]*kafka.Message, []deadLetter) {
+	var (
+		rows []*kafka.Message
+		dead []deadLetter
+	)
+	for _, msg := range msgs {
+		if _, err := decoder.Decode(msg.Value); err != nil {
+			dead = append(dead, deadLetter{msg: msg, err: err})
+			continue
+		}
+		rows = append(rows, msg)
+	}
+	return rows, dead
+}
+
+// insertDeadLetterSQL builds the statement writing dead letters into table.
+func insertDeadLetterSQL(table string, dead []deadLetter) string {
+	values := make([]string, 0, len(dead))
+	for _, d := range dead {
+		topic := ""
+		if d.msg.TopicPartition.Topic != nil {
+			topic = *d.msg.TopicPartition.Topic
+		}
+		values = append(values, fmt.Sprintf("(%s, %d, %d, %s, %s, %s)",
+			sqlquote.String(topic),
+			d.msg.TopicPartition.Partition,
+			int64(d.msg.TopicPartition.Offset),
+			blobLiteral(d.msg.Key),
+			blobLiteral(d.msg.Value),
+			sqlquote.String(d.err.Error())))
+	}
+	return fmt.Sprintf(insertDeadLetterFormat, table, strings.Join(values, ", "))
+}
+
+func blobLiteral(data []byte) string {
+	if data == nil {
+		return "NULL"
+	}
+	return fmt.Sprintf("unhex('%s')", hex.EncodeToString(data))
+}
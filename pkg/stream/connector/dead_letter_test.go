@@ -0,0 +1,52 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadLetterTableName(t *testing.T) {
+	assert.Equal(t, "`db`.`dlq`", deadLetterTableName("dlq", "db"))
+	assert.Equal(t, "`other`.`dlq`", deadLetterTableName("other.dlq", "db"))
+}
+
+func TestSplitMessages(t *testing.T) {
+	msgs := []*kafka.Message{
+		newTestMessage("t1", 0, 0, `{"a": 1}`),
+		newTestMessage("t1", 0, 1, `{"a": `),
+		newTestMessage("t1", 0, 2, `{"a": 2}`),
+	}
+	rows, dead := splitMessages(newJsonDecoder(), msgs)
+	assert.Equal(t, []*kafka.Message{msgs[0], msgs[2]}, rows)
+	assert.Equal(t, 1, len(dead))
+	assert.Equal(t, msgs[1], dead[0].msg)
+	assert.Error(t, dead[0].err)
+}
+
+func TestInsertDeadLetterSQL(t *testing.T) {
+	msg := newTestMessage("t1", 2, 5, "ab")
+	msg.Key = []byte("k")
+	sql := insertDeadLetterSQL("`db`.`dlq`", []deadLetter{
+		{msg: msg, err: assert.AnError},
+		{msg: newTestMessage("t1", 2, 6, ""), err: assert.AnError},
+	})
+	assert.Equal(t, "INSERT INTO `db`.`dlq` (topic, partition_id, msg_offset, msg_key, payload, error_msg) VALUES "+
+		"('t1', 2, 5, unhex('6b'), unhex('6162'), 'assert.AnError general error for testing'), "+
+		"('t1', 2, 6, NULL, unhex(''), 'assert.AnError general error for testing')", sql)
+}
@@ -14,8 +14,43 @@
 
 package moconnector
 
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+)
+
 type RawObject map[string]any
 
 type Decoder interface {
 	Decode([]byte) (RawObject, error)
 }
+
+// newDecoder returns the decoder of the value format of the options, the
+// messages are decoded the same way as the stream scan does.
+func newDecoder(ctx context.Context, ka mokafka.KafkaAdapterInterface, options map[string]string) (Decoder, error) {
+	switch mokafka.ValueType(options[OptConnectorValue]) {
+	case mokafka.JSON:
+		return newJsonDecoder(), nil
+	case mokafka.PROTOBUF, mokafka.PROTOBUFSR:
+		decode, err := mokafka.NewProtobufDecoder(ctx, ka, toConfigs(options))
+		if err != nil {
+			return nil, err
+		}
+		return newProtobufDecoder(decode), nil
+	case mokafka.AVRO:
+		return newAvroDecoder(mokafka.NewAvroDecoder(ka, toConfigs(options))), nil
+	default:
+		return nil, moerr.NewInternalError(ctx, "Unsupported value format")
+	}
+}
+
+// toConfigs converts the options to the configs of the kafka adapter.
+func toConfigs(options map[string]string) map[string]interface{} {
+	configs := make(map[string]interface{}, len(options))
+	for key, value := range options {
+		configs[key] = value
+	}
+	return configs
+}
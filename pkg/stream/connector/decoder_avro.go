@@ -13,3 +13,25 @@
 // limitations under the License.
 
 package moconnector
+
+import (
+	"context"
+
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+)
+
+type avroDecoder struct {
+	decode mokafka.AvroDecoder
+}
+
+func newAvroDecoder(decode mokafka.AvroDecoder) Decoder {
+	return &avroDecoder{decode: decode}
+}
+
+func (d *avroDecoder) Decode(data []byte) (RawObject, error) {
+	record, err := d.decode(context.Background(), data)
+	if err != nil {
+		return nil, err
+	}
+	return RawObject(record), nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"context"
+
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+)
+
+type protobufDecoder struct {
+	decode mokafka.ProtobufDecoder
+}
+
+func newProtobufDecoder(decode mokafka.ProtobufDecoder) Decoder {
+	return &protobufDecoder{decode: decode}
+}
+
+func (d *protobufDecoder) Decode(data []byte) (RawObject, error) {
+	msg, err := d.decode(context.Background(), data)
+	if err != nil {
+		return nil, err
+	}
+	obj := make(RawObject)
+	for _, fd := range msg.GetKnownFields() {
+		obj[fd.GetName()] = msg.GetField(fd)
+	}
+	return obj, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/sqlquote"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+// partitionKey identifies a kafka partition in the offset store.
+type partitionKey struct {
+	topic     string
+	partition int32
+}
+
+// partitionOffsets maps a partition to the offset of the next message to
+// consume from it.
+type partitionOffsets map[partitionKey]kafka.Offset
+
+const (
+	loadOffsetsFormat = "SELECT topic, partition_id, next_offset FROM `%s`.`%s` WHERE group_id = %s"
+
+	saveOffsetsFormat = "INSERT INTO `%s`.`%s` " +
+		"(group_id, topic, partition_id, db_name, table_name, next_offset, update_time) VALUES %s " +
+		"ON DUPLICATE KEY UPDATE next_offset = VALUES(next_offset), update_time = VALUES(update_time)"
+)
+
+// loadOffsets reads the offsets committed by the connector group.
+func loadOffsets(ctx context.Context, exec ie.InternalExecutor, groupId string) (partitionOffsets, error) {
+	sql := fmt.Sprintf(loadOffsetsFormat, catalog.MO_CATALOG, catalog.MO_STREAM_OFFSETS, sqlquote.String(groupId))
+	res := exec.Query(ctx, sql, ie.SessionOverrideOptions{})
+	if err := res.Error(); err != nil {
+		return nil, err
+	}
+	offsets := make(partitionOffsets, res.RowCount())
+	for i := uint64(0); i < res.RowCount(); i++ {
+		topic, err := res.GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		partition, err := res.GetUint64(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		offset, err := res.GetUint64(ctx, i, 2)
+		if err != nil {
+			return nil, err
+		}
+		offsets[partitionKey{topic: topic, partition: int32(partition)}] = kafka.Offset(offset)
+	}
+	return offsets, nil
+}
+
+// nextOffsets returns, for every partition in msgs, the offset following the
+// last message.
+func nextOffsets(msgs []*kafka.Message) partitionOffsets {
+	offsets := make(partitionOffsets)
+	for _, msg := range msgs {
+		if msg.TopicPartition.Topic == nil {
+			continue
+		}
+		key := partitionKey{topic: *msg.TopicPartition.Topic, partition: msg.TopicPartition.Partition}
+		if next := msg.TopicPartition.Offset + 1; next > offsets[key] {
+			offsets[key] = next
+		}
+	}
+	return offsets
+}
+
+// saveOffsetsSQL builds the statement upserting offsets for the group.
+func saveOffsetsSQL(groupId, dbName, tableName string, offsets partitionOffsets) string {
+	keys := make([]partitionKey, 0, len(offsets))
+	for key := range offsets {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].topic != keys[j].topic {
+			return keys[i].topic < keys[j].topic
+		}
+		return keys[i].partition < keys[j].partition
+	})
+	values := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, fmt.Sprintf("(%s, %s, %d, %s, %s, %d, now())",
+			sqlquote.String(groupId),
+			sqlquote.String(key.topic),
+			key.partition,
+			sqlquote.String(dbName),
+			sqlquote.String(tableName),
+			int64(offsets[key])))
+	}
+	return fmt.Sprintf(saveOffsetsFormat, catalog.MO_CATALOG, catalog.MO_STREAM_OFFSETS, strings.Join(values, ", "))
+}
+
+// withStoredOffsets positions the given partitions at their stored offsets.
+// Partitions without a stored offset keep the offset kafka assigned to them.
+func withStoredOffsets(parts []kafka.TopicPartition, offsets partitionOffsets) []kafka.TopicPartition {
+	ret := make([]kafka.TopicPartition, len(parts))
+	for i, tp := range parts {
+		ret[i] = tp
+		if tp.Topic == nil {
+			continue
+		}
+		if offset, ok := offsets[partitionKey{topic: *tp.Topic, partition: tp.Partition}]; ok {
+			ret[i].Offset = offset
+		}
+	}
+	return ret
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMessage(topic string, partition int32, offset int64, value string) *kafka.Message {
+	return &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: partition, Offset: kafka.Offset(offset)},
+		Value:          []byte(value),
+	}
+}
+
+func TestNextOffsets(t *testing.T) {
+	offsets := nextOffsets([]*kafka.Message{
+		newTestMessage("t1", 0, 7, ""),
+		newTestMessage("t1", 1, 3, ""),
+		newTestMessage("t1", 0, 5, ""),
+		{Value: []byte("no topic")},
+	})
+	assert.Equal(t, partitionOffsets{
+		{topic: "t1", partition: 0}: 8,
+		{topic: "t1", partition: 1}: 4,
+	}, offsets)
+}
+
+func TestSaveOffsetsSQL(t *testing.T) {
+	sql := saveOffsetsSQL("g'1", "db", "tbl", partitionOffsets{
+		{topic: "t2", partition: 0}: 1,
+		{topic: "t1", partition: 1}: 9,
+		{topic: "t1", partition: 0}: 4,
+	})
+	assert.Equal(t, "INSERT INTO `mo_catalog`.`mo_stream_offsets` "+
+		"(group_id, topic, partition_id, db_name, table_name, next_offset, update_time) VALUES "+
+		"('g''1', 't1', 0, 'db', 'tbl', 4, now()), "+
+		"('g''1', 't1', 1, 'db', 'tbl', 9, now()), "+
+		"('g''1', 't2', 0, 'db', 'tbl', 1, now()) "+
+		"ON DUPLICATE KEY UPDATE next_offset = VALUES(next_offset), update_time = VALUES(update_time)", sql)
+}
+
+func TestWithStoredOffsets(t *testing.T) {
+	topic := "t1"
+	parts := []kafka.TopicPartition{
+		{Topic: &topic, Partition: 0, Offset: kafka.OffsetInvalid},
+		{Topic: &topic, Partition: 1, Offset: kafka.OffsetInvalid},
+	}
+	ret := withStoredOffsets(parts, partitionOffsets{{topic: "t1", partition: 1}: 10})
+	assert.Equal(t, kafka.OffsetInvalid, ret[0].Offset)
+	assert.Equal(t, kafka.Offset(10), ret[1].Offset)
+	// the assigned partitions are left untouched
+	assert.Equal(t, kafka.OffsetInvalid, parts[1].Offset)
+}
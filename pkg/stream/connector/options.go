@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
 )
 
 const (
@@ -102,18 +103,36 @@ const (
 
 	OptConnectorBufferLimit = "buffer_limit"
 	OptConnectorTimeWindow  = "time_window"
+
+	OptConnectorDeadLetterTable = "dead_letter_table"
+
+	// the protobuf options of the stream table
+	OptConnectorProtobufSchema  = mokafka.ProtobufSchemaKey
+	OptConnectorProtobufMessage = mokafka.ProtobufMessagekey
+	OptConnectorSchemaRegistry  = mokafka.SchemaRegistryKey
+
+	// OptConnectorSourceTable is the stream table a dynamic table reads from.
+	OptConnectorSourceTable    = "source_table"
+	OptConnectorAutoAddColumns = mokafka.SchemaAutoAddColumnsKey
 )
 
 var ConnectorOptConstraint = map[string]OptConstraint{
 	OptConnectorType:        enumOpt(SourceKafka),
 	OptConnectorServers:     addressOpt,
 	OptConnectorTopic:       stringOpt,
-	OptConnectorValue:       enumOpt(FormatJson),
+	OptConnectorValue:       enumOpt(FormatJson, string(mokafka.PROTOBUF), string(mokafka.PROTOBUFSR), string(mokafka.AVRO)),
 	OptConnectorSql:         stringOpt,
 	OptConnectorRel:         stringOpt,
 	OptConnectorPartition:   integerOpt,
 	OptConnectorBufferLimit: integerOpt,
 	OptConnectorTimeWindow:  integerOpt,
+
+	OptConnectorDeadLetterTable: stringOpt,
+	OptConnectorProtobufSchema:  stringOpt,
+	OptConnectorProtobufMessage: stringOpt,
+	OptConnectorSchemaRegistry:  stringOpt,
+	OptConnectorSourceTable:     stringOpt,
+	OptConnectorAutoAddColumns:  enumOpt("true", "false"),
 }
 
 var ConnectorEssentialOpts = map[string]struct{}{
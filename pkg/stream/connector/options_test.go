@@ -51,6 +51,7 @@ func TestMakeStmtOpts(t *testing.T) {
 		{"type": "my"},
 		{"type": "kafka", "bootstrap.servers": "localhost"},
 		{"type": "kafka", "value": "a"},
+		{"type": "kafka", "dead_letter_table": ""},
 	}
 	for _, opt := range invalidValueOptList {
 		_, err = MakeStmtOpts(context.Background(), opt)
@@ -71,6 +72,7 @@ func TestMakeStmtOpts(t *testing.T) {
 		"topic":             "t1",
 		"value":             "json",
 		"partition":         "1",
+		"dead_letter_table": "dlq",
 	}
 	o, err = MakeStmtOpts(context.Background(), okOpts)
 	assert.NoError(t, err)
@@ -0,0 +1,179 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/sqlquote"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	loadColumnsFormat = "SELECT %s, mo_show_visible_bin(%s, 2), CASE WHEN %s != 0 THEN 'NO' ELSE 'YES' END FROM `%s`.`%s` WHERE %s = %s AND %s = %s AND %s = 0 ORDER BY %s"
+
+	addColumnFormat    = "ALTER TABLE %s ADD COLUMN %s"
+	modifyColumnFormat = "ALTER TABLE %s MODIFY COLUMN %s"
+)
+
+// widenableTypes are the column types types.CanWiden widens, the types of
+// the other columns are not told apart.
+var widenableTypes = []types.T{
+	types.T_int32, types.T_int64, types.T_uint32, types.T_uint64, types.T_float32, types.T_float64,
+}
+
+// columnType returns the type of a column shown as name.
+func columnType(name string) types.T {
+	for _, t := range widenableTypes {
+		if t.String() == name {
+			return t
+		}
+	}
+	return types.T_any
+}
+
+// loadColumns reads the visible columns of dbName.tableName.
+func loadColumns(ctx context.Context, exec ie.InternalExecutor, dbName, tableName string) ([]mokafka.Column, error) {
+	sql := fmt.Sprintf(loadColumnsFormat,
+		catalog.SystemColAttr_Name, catalog.SystemColAttr_Type, catalog.SystemColAttr_NullAbility,
+		catalog.MO_CATALOG, catalog.MO_COLUMNS,
+		catalog.SystemColAttr_DBName, sqlquote.String(dbName),
+		catalog.SystemColAttr_RelName, sqlquote.String(tableName),
+		catalog.SystemColAttr_IsHidden,
+		catalog.SystemColAttr_Num)
+	res := exec.Query(ctx, sql, ie.SessionOverrideOptions{})
+	if err := res.Error(); err != nil {
+		return nil, err
+	}
+	columns := make([]mokafka.Column, 0, res.RowCount())
+	for i := uint64(0); i < res.RowCount(); i++ {
+		name, err := res.GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		typ, err := res.GetString(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		nullable, err := res.GetString(ctx, i, 2)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, mokafka.Column{
+			Name:    name,
+			Type:    columnType(typ),
+			NotNull: nullable == "NO",
+		})
+	}
+	return columns, nil
+}
+
+// addColumnsSQL builds the statements adding fields to table as nullable
+// columns.
+func addColumnsSQL(table string, fields []mokafka.NewField) []string {
+	stmts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		stmts = append(stmts, fmt.Sprintf(addColumnFormat, table, f.ColumnDef()))
+	}
+	return stmts
+}
+
+// widenColumnsSQL builds the statements widening the columns of fields in
+// table to the types of the fields.
+func widenColumnsSQL(table string, fields []mokafka.NewField) []string {
+	stmts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		stmts = append(stmts, fmt.Sprintf(modifyColumnFormat, table, f.ColumnDef()))
+	}
+	return stmts
+}
+
+// sameColumns reports whether a and b have the same column names and types in
+// the same order.
+func sameColumns(a, b []mokafka.Column) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !strings.EqualFold(a[i].Name, b[i].Name) || a[i].Type != b[i].Type {
+			return false
+		}
+	}
+	return true
+}
+
+// applyFieldChanges adds the added fields to columns and widens the columns
+// of the widened ones.
+func applyFieldChanges(columns []mokafka.Column, added, widened []mokafka.NewField) []mokafka.Column {
+	for _, f := range widened {
+		for i := range columns {
+			if strings.EqualFold(columns[i].Name, f.Name) {
+				columns[i].Type = f.Type
+			}
+		}
+	}
+	for _, f := range added {
+		columns = append(columns, mokafka.Column{Name: f.Name, Type: f.Type})
+	}
+	return columns
+}
+
+// evolveSchema returns the statements adding the fields of msgs which have no
+// column in the source table, and widening the columns whose fields are
+// written with a wider type. The dynamic table is changed too if it mirrors
+// the columns of the source, which is the case of a dynamic table selecting
+// all of them.
+func (k *KafkaMoConnector) evolveSchema(ctx context.Context, msgs []*kafka.Message) ([]string, error) {
+	srcDb, srcTbl, ok := strings.Cut(k.options[OptConnectorSourceTable], ".")
+	if !ok {
+		return nil, nil
+	}
+	dbName := k.options[mokafka.DatabaseKey]
+	tableName := k.options[mokafka.TableKey]
+	if k.sourceColumns == nil {
+		var err error
+		if k.sourceColumns, err = loadColumns(ctx, k.ie, srcDb, srcTbl); err != nil {
+			return nil, err
+		}
+		if k.tableColumns, err = loadColumns(ctx, k.ie, dbName, tableName); err != nil {
+			k.sourceColumns = nil
+			return nil, err
+		}
+	}
+
+	added, widened, err := mokafka.DetectFieldChanges(ctx, k.kafkaAdapter, toConfigs(k.options), k.sourceColumns, msgs)
+	if err != nil || len(added)+len(widened) == 0 {
+		return nil, err
+	}
+
+	srcName := sqlquote.QualifiedIdent(srcDb, srcTbl)
+	stmts := append(widenColumnsSQL(srcName, widened), addColumnsSQL(srcName, added)...)
+	mirror := sameColumns(k.sourceColumns, k.tableColumns)
+	if mirror {
+		tableName := sqlquote.QualifiedIdent(dbName, tableName)
+		stmts = append(stmts, widenColumnsSQL(tableName, widened)...)
+		stmts = append(stmts, addColumnsSQL(tableName, added)...)
+		k.tableColumns = applyFieldChanges(k.tableColumns, added, widened)
+	}
+	k.sourceColumns = applyFieldChanges(k.sourceColumns, added, widened)
+	return stmts, nil
+}
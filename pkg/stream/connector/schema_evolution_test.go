@@ -0,0 +1,182 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moconnector
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	mokafka "github.com/matrixorigin/matrixone/pkg/stream/adapter/kafka"
+)
+
+// columnsResult answers loadColumns with the columns given as name and type
+// pairs, all nullable.
+func columnsResult(columns ...string) *internalExecResult {
+	data := make([][]interface{}, 0, len(columns)/2)
+	for i := 0; i < len(columns); i += 2 {
+		data = append(data, []interface{}{columns[i], columns[i+1], "YES"})
+	}
+	return &internalExecResult{resultSet: &MysqlResultSet{Data: data}}
+}
+
+func newEvolvingConnector(exec *MockSQLExecutor) *KafkaMoConnector {
+	return &KafkaMoConnector{
+		logger: zap.NewNop(),
+		ie:     exec,
+		options: map[string]string{
+			mokafka.ValueKey:           "json",
+			mokafka.DatabaseKey:        "db",
+			mokafka.TableKey:           "dt",
+			OptConnectorSourceTable:    "db.src",
+			OptConnectorAutoAddColumns: "true",
+		},
+		autoAddColumns: true,
+	}
+}
+
+func TestAddColumnsSQL(t *testing.T) {
+	stmts := addColumnsSQL("`db`.`t`", []mokafka.NewField{
+		{Name: "a", Type: types.T_int64},
+		{Name: "b`c", Type: types.T_text},
+	})
+	assert.Equal(t, []string{
+		"ALTER TABLE `db`.`t` ADD COLUMN `a` BIGINT NULL",
+		"ALTER TABLE `db`.`t` ADD COLUMN `b``c` TEXT NULL",
+	}, stmts)
+	stmts = widenColumnsSQL("`db`.`t`", []mokafka.NewField{
+		{Name: "a", Type: types.T_float64, NotNull: true},
+	})
+	assert.Equal(t, []string{"ALTER TABLE `db`.`t` MODIFY COLUMN `a` DOUBLE NOT NULL"}, stmts)
+}
+
+func TestSameColumns(t *testing.T) {
+	a := []mokafka.Column{{Name: "a", Type: types.T_int32}, {Name: "B"}}
+	assert.True(t, sameColumns(a, []mokafka.Column{{Name: "A", Type: types.T_int32}, {Name: "b"}}))
+	assert.False(t, sameColumns(a, []mokafka.Column{{Name: "a", Type: types.T_int64}, {Name: "b"}}))
+	assert.False(t, sameColumns(a, []mokafka.Column{{Name: "B"}, {Name: "a", Type: types.T_int32}}))
+	assert.False(t, sameColumns(a, a[:1]))
+}
+
+func TestLoadColumns(t *testing.T) {
+	exec := &MockSQLExecutor{queryResult: &internalExecResult{resultSet: &MysqlResultSet{Data: [][]interface{}{
+		{"id", "INT", "NO"},
+		{"name", "VARCHAR", "YES"},
+	}}}}
+	columns, err := loadColumns(context.Background(), exec, "db", "t")
+	assert.NoError(t, err)
+	assert.Equal(t, []mokafka.Column{
+		{Name: "id", Type: types.T_int32, NotNull: true},
+		{Name: "name", Type: types.T_any},
+	}, columns)
+}
+
+func TestEvolveSchema(t *testing.T) {
+	exec := &MockSQLExecutor{queryResult: columnsResult("id", "BIGINT", "name", "TEXT")}
+	k := newEvolvingConnector(exec)
+	msgs := []*kafka.Message{
+		newTestMessage("t1", 0, 0, `{"id": 1, "name": "a"}`),
+		newTestMessage("t1", 0, 1, `{"id": 2, "name": "b", "score": 1.5, "tags": null}`),
+	}
+
+	stmts, err := k.evolveSchema(context.Background(), msgs)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `db`.`src` ADD COLUMN `score` DOUBLE NULL",
+		"ALTER TABLE `db`.`dt` ADD COLUMN `score` DOUBLE NULL",
+	}, stmts)
+	columns := []mokafka.Column{
+		{Name: "id", Type: types.T_int64},
+		{Name: "name", Type: types.T_any},
+		{Name: "score", Type: types.T_float64},
+	}
+	assert.Equal(t, columns, k.sourceColumns)
+	assert.Equal(t, columns, k.tableColumns)
+
+	// The added columns are known afterwards.
+	stmts, err = k.evolveSchema(context.Background(), msgs)
+	assert.NoError(t, err)
+	assert.Empty(t, stmts)
+}
+
+func TestEvolveSchema_ProjectingTable(t *testing.T) {
+	exec := &MockSQLExecutor{
+		queryFunc: func(sql string) *internalExecResult {
+			if strings.Contains(sql, "'src'") {
+				return columnsResult("id", "BIGINT", "name", "TEXT")
+			}
+			return columnsResult("id", "BIGINT")
+		},
+	}
+	k := newEvolvingConnector(exec)
+	stmts, err := k.evolveSchema(context.Background(), []*kafka.Message{
+		newTestMessage("t1", 0, 0, `{"id": 1, "ok": true}`),
+	})
+	assert.NoError(t, err)
+	// The dynamic table selects some columns only, so it is left as it is.
+	assert.Equal(t, []string{"ALTER TABLE `db`.`src` ADD COLUMN `ok` BOOL NULL"}, stmts)
+}
+
+func TestEvolveSchema_WidensColumns(t *testing.T) {
+	exec := &MockSQLExecutor{queryResult: columnsResult("id", "BIGINT", "score", "BIGINT")}
+	k := newEvolvingConnector(exec)
+	stmts, err := k.evolveSchema(context.Background(), []*kafka.Message{
+		newTestMessage("t1", 0, 0, `{"id": 1, "score": 1.5}`),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `db`.`src` MODIFY COLUMN `score` DOUBLE NULL",
+		"ALTER TABLE `db`.`dt` MODIFY COLUMN `score` DOUBLE NULL",
+	}, stmts)
+	assert.Equal(t, types.T_float64, k.sourceColumns[1].Type)
+	assert.Equal(t, types.T_float64, k.tableColumns[1].Type)
+}
+
+func TestEvolveSchema_LoadColumnsFailed(t *testing.T) {
+	exec := &MockSQLExecutor{
+		queryResult: &internalExecResult{err: moerr.NewInternalErrorNoCtx("random")},
+	}
+	k := newEvolvingConnector(exec)
+	_, err := k.evolveSchema(context.Background(), []*kafka.Message{
+		newTestMessage("t1", 0, 0, `{"id": 1}`),
+	})
+	assert.Error(t, err)
+	assert.Nil(t, k.sourceColumns)
+}
+
+func TestInsertRow_AddsColumns(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	exec := &MockSQLExecutor{wg: &wg, queryResult: columnsResult("a", "BIGINT")}
+	k := newEvolvingConnector(exec)
+	k.options[OptConnectorSql] = "SELECT * FROM src"
+	k.offsets = make(partitionOffsets)
+
+	assert.NoError(t, k.insertRow([]*kafka.Message{
+		newTestMessage("t1", 0, 0, `{"a": 1, "b": 2}`),
+	}))
+	// the columns are added in the transaction inserting the messages.
+	assert.True(t, strings.HasPrefix(exec.executedSQLs[0], "USE db; BEGIN; "+
+		"ALTER TABLE `db`.`src` ADD COLUMN `b` BIGINT NULL; "+
+		"ALTER TABLE `db`.`dt` ADD COLUMN `b` BIGINT NULL; "+
+		"INSERT INTO db.dt SELECT * FROM src"), exec.executedSQLs[0])
+}
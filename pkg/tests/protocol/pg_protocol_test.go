@@ -0,0 +1,110 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/embed"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/tests"
+)
+
+func TestPgProtocol(t *testing.T) {
+	port, err := tests.GetAvailablePort("127.0.0.1")
+	require.NoError(t, err)
+	pgPort, err := strconv.ParseInt(port, 10, 64)
+	require.NoError(t, err)
+
+	c, err := embed.NewCluster(
+		embed.WithCNCount(1),
+		embed.WithTesting(),
+		embed.WithPreStart(func(svc embed.ServiceOperator) {
+			if svc.ServiceType() == metadata.ServiceType_CN {
+				svc.Adjust(func(config *embed.ServiceConfig) {
+					config.CN.Frontend.EnablePgProtocol = true
+					config.CN.Frontend.PgPort = pgPort
+				})
+			}
+		}),
+	)
+	require.NoError(t, err)
+	require.NoError(t, c.Start())
+	defer func() {
+		require.NoError(t, c.Close())
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+	defer cancel()
+
+	dsn := func(user, password string) string {
+		return fmt.Sprintf("postgres://%s:%s@127.0.0.1:%d/?sslmode=disable", user, password, pgPort)
+	}
+
+	t.Run("authentication", func(t *testing.T) {
+		// the wrong password and the unknown user fail the same way
+		_, err := pgx.Connect(ctx, dsn("dump", "222"))
+		require.Error(t, err)
+		_, err = pgx.Connect(ctx, dsn("no_such_user", "111"))
+		require.Error(t, err)
+	})
+
+	conn, err := pgx.Connect(ctx, dsn("dump", "111"))
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+
+	t.Run("simple query", func(t *testing.T) {
+		results, err := conn.PgConn().Exec(ctx,
+			"create database pg_db; create table pg_db.t (a int, b varchar(10)); insert into pg_db.t values (1, 'a'), (2, 'b')").ReadAll()
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		results, err = conn.PgConn().Exec(ctx, "select count(*) from pg_db.t").ReadAll()
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		require.Equal(t, [][][]byte{{[]byte("2")}}, results[0].Rows)
+	})
+
+	t.Run("extended query", func(t *testing.T) {
+		var b string
+		require.NoError(t, conn.QueryRow(ctx, "select b from pg_db.t where a = $1", 2).Scan(&b))
+		require.Equal(t, "b", b)
+
+		var n int64
+		require.NoError(t, conn.QueryRow(ctx, "select count(*) from pg_db.t where a >= $1 and b <> $2", 1, "a").Scan(&n))
+		require.Equal(t, int64(1), n)
+	})
+
+	t.Run("error then sync", func(t *testing.T) {
+		var a int32
+		err := conn.QueryRow(ctx, "select a from pg_db.no_such_table where a = $1", 1).Scan(&a)
+		require.Error(t, err)
+
+		// the operator which means another thing in MySQL is rejected
+		var s string
+		require.Error(t, conn.QueryRow(ctx, "select 'a' || 'b'").Scan(&s))
+
+		// the connection recovers after the Sync of the failed query
+		require.NoError(t, conn.QueryRow(ctx, "select a from pg_db.t where b = $1", "a").Scan(&a))
+		require.Equal(t, int32(1), a)
+	})
+}
@@ -213,7 +213,7 @@ func TypeMatch(v any, typ types.T) bool {
 		// NOTE 2: If you are ever going to use vector in catalog (which you would not be needing in the most part),
 		// make sure to convert []float32 to []byte. Else this check will fail.
 		_, ok = v.([]byte)
-	case types.T_array_float64:
+	case types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		_, ok = v.([]byte)
 	case types.T_varbinary:
 		_, ok = v.([]byte)
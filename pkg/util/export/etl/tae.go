@@ -416,7 +416,7 @@ func GetVectorArrayLen(ctx context.Context, vec *vector.Vector) (int, error) {
 		cols := vector.MustFixedColNoTypeCheck[float64](vec)
 		return len(cols), nil
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		cols := vector.MustFixedColNoTypeCheck[types.Varlena](vec)
 		return len(cols), nil
 	case types.T_json:
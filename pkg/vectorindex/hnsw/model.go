@@ -46,6 +46,11 @@ type HnswModel[T types.RealNumbers] struct {
 	Path     string
 	FileSize int64
 
+	// QuantizedType is vecf16, veci8 or vecbit if Index is quantized from these
+	// types, otherwise 0.  The vectors are added and searched as float32 and
+	// encoded back before they are passed to usearch.
+	QuantizedType types.T
+
 	// info required for build
 	MaxCapacity uint
 	NThread     uint
@@ -92,6 +97,7 @@ func (idx *HnswModel[T]) initIndex(cfg vectorindex.IndexConfig) (err error) {
 	if err != nil {
 		return err
 	}
+	idx.QuantizedType, _ = QuantizedVectorType(cfg.Usearch.Quantization)
 
 	defer func() {
 		if err != nil {
@@ -364,6 +370,13 @@ func (idx *HnswModel[T]) Add(key int64, vec []T) error {
 		return moerr.NewInternalErrorNoCtx(fmt.Sprintf("usearch dimension not match (expected %d but got %d)", dim, len(vec)))
 	}
 
+	if qvec, ok, err := idx.encodeQuantized(vec); err != nil {
+		return err
+	} else if ok {
+		defer runtime.KeepAlive(qvec)
+		return idx.Index.AddUnsafe(uint64(key), util.UnsafePointer(&qvec[0]))
+	}
+
 	defer runtime.KeepAlive(vec)
 	return idx.Index.AddUnsafe(uint64(key), util.UnsafePointer(&vec[0]))
 }
@@ -393,6 +406,13 @@ func (idx *HnswModel[T]) AddWithoutIncr(key int64, vec []T) error {
 		return moerr.NewInternalErrorNoCtx("usearch dimension not match")
 	}
 
+	if qvec, ok, err := idx.encodeQuantized(vec); err != nil {
+		return err
+	} else if ok {
+		defer runtime.KeepAlive(qvec)
+		return idx.Index.AddUnsafe(uint64(key), util.UnsafePointer(&qvec[0]))
+	}
+
 	defer runtime.KeepAlive(vec)
 	return idx.Index.AddUnsafe(uint64(key), util.UnsafePointer(&vec[0]))
 }
@@ -540,6 +560,7 @@ func (idx *HnswModel[T]) LoadIndexFromBuffer(
 	if err != nil {
 		return err
 	}
+	idx.QuantizedType, _ = QuantizedVectorType(idxcfg.Usearch.Quantization)
 	defer func() {
 		if err != nil {
 			usearchidx.Destroy()
@@ -769,6 +790,7 @@ func (idx *HnswModel[T]) LoadIndex(
 	if err != nil {
 		return err
 	}
+	idx.QuantizedType, _ = QuantizedVectorType(idxcfg.Usearch.Quantization)
 	defer func() {
 		if err != nil {
 			usearchidx.Destroy()
@@ -883,6 +905,27 @@ func (idx *HnswModel[T]) Search(query []T, limit uint) (keys []usearch.Key, dist
 		return nil, nil, moerr.NewInternalErrorNoCtx("usearch dimension not match")
 	}
 
+	if qquery, ok, err := idx.encodeQuantized(query); err != nil {
+		return nil, nil, err
+	} else if ok {
+		defer runtime.KeepAlive(qquery)
+		return idx.Index.SearchUnsafe(util.UnsafePointer(&qquery[0]), limit)
+	}
+
 	defer runtime.KeepAlive(query)
 	return idx.Index.SearchUnsafe(util.UnsafePointer(&query[0]), limit)
 }
+
+// encodeQuantized encodes the float32 vector into the vecf16, veci8 or vecbit
+// representation expected by usearch.  ok is false if the index is not quantized.
+func (idx *HnswModel[T]) encodeQuantized(vec []T) (qvec []byte, ok bool, err error) {
+	if idx.QuantizedType == 0 {
+		return nil, false, nil
+	}
+	f32, ok := any(vec).([]float32)
+	if !ok {
+		return nil, false, moerr.NewInternalErrorNoCtx("quantized usearch index only accepts float32 vectors")
+	}
+	qvec, err = types.Float32ToQuantizedBytes(idx.QuantizedType, f32)
+	return qvec, true, err
+}
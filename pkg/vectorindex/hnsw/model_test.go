@@ -607,3 +607,36 @@ func TestCorruptedIndexFile(t *testing.T) {
 	require.Error(t, err, "Load() should fail on empty file (view=false)")
 	require.Nil(t, idx2.Index)
 }
+
+func TestModelQuantized(t *testing.T) {
+	cfg := vectorindex.IndexConfig{Type: "hnsw", Usearch: usearch.DefaultConfig(16)}
+	cfg.Usearch.Quantization = usearch.B1
+	cfg.Usearch.Metric = usearch.Hamming
+
+	idx, err := NewHnswModelForBuild[float32]("bit-test", cfg, 1, 16)
+	require.NoError(t, err)
+	defer idx.Destroy()
+	require.Equal(t, types.T_array_bit, idx.QuantizedType)
+
+	vecs := [][]float32{
+		{1, 1, 1, 1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0},
+		{0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 0, 1, 1, 1, 1},
+		{1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0},
+	}
+	for i, v := range vecs {
+		require.NoError(t, idx.Add(int64(i), v))
+	}
+
+	query := []float32{1, 1, 1, 1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 0, 0, 1}
+	keys, dists, err := idx.Search(query, 1)
+	require.NoError(t, err)
+	require.Equal(t, []usearch.Key{0}, keys)
+	require.Equal(t, []float32{1}, dists)
+
+	// a float32 index is not encoded
+	_, ok, err := (&HnswModel[float32]{}).encodeQuantized(query)
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, _, err = (&HnswModel[float64]{QuantizedType: types.T_array_bit}).encodeQuantized([]float64{1})
+	require.Error(t, err)
+}
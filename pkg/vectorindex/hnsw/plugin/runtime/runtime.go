@@ -85,9 +85,11 @@ const HnswIndexFlag = "experimental_hnsw_index"
 // ExperimentalFlag: HNSW DDL is gated by HnswIndexFlag.
 func (CatalogHooks) ExperimentalFlag() string { return HnswIndexFlag }
 
-// SupportedVectorTypes: HNSW (usearch) indexes f32 or f64 vectors.
+// SupportedVectorTypes: HNSW (usearch) indexes f32 or f64 vectors, and
+// f16, i8 or bit vectors with the matching usearch quantization.
 func (CatalogHooks) SupportedVectorTypes() []types.T {
-	return []types.T{types.T_array_float32, types.T_array_float64}
+	return []types.T{types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit}
 }
 
 // SupportedPrimaryKeyTypes: requires an int64 primary key.
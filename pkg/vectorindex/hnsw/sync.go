@@ -156,6 +156,10 @@ func NewHnswSync[T types.RealNumbers](sqlproc *sqlexec.SqlProcess,
 		return nil, moerr.NewInternalError(sqlproc.GetContext(), "Invalid op_type")
 	}
 	idxcfg.Usearch.Metric = metrictype
+	idxcfg.Usearch.Metric, err = MetricForQuantization(idxcfg.Usearch.Quantization, idxcfg.Usearch.Metric)
+	if err != nil {
+		return nil, err
+	}
 
 	if len(param.EfConstruction) > 0 {
 		val, err := strconv.Atoi(param.EfConstruction)
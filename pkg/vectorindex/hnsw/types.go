@@ -28,7 +28,45 @@ func QuantizationToUsearch(typid int32) (usearch.Quantization, error) {
 		return usearch.F32, nil
 	case int32(types.T_array_float64):
 		return usearch.F64, nil
+	case int32(types.T_array_float16):
+		return usearch.F16, nil
+	case int32(types.T_array_int8):
+		return usearch.I8, nil
+	case int32(types.T_array_bit):
+		return usearch.B1, nil
 	default:
 		return usearch.F32, moerr.NewInternalErrorNoCtx(fmt.Sprintf("HNSW Quantization: type id not supported (type id = %d)", typid))
 	}
 }
+
+// QuantizedVectorType returns the vector type of the usearch quantization q if q
+// is one of vecf16, veci8 and vecbit. These vectors are decoded to float32 when
+// read from the table and encoded back before they are handed to usearch.
+func QuantizedVectorType(q usearch.Quantization) (types.T, bool) {
+	switch q {
+	case usearch.F16:
+		return types.T_array_float16, true
+	case usearch.I8:
+		return types.T_array_int8, true
+	case usearch.B1:
+		return types.T_array_bit, true
+	}
+	return 0, false
+}
+
+// MetricForQuantization returns the usearch metric used to index vectors with
+// quantization q. usearch only supports bit metrics for B1, so vecbit is indexed
+// by the Hamming distance, which is the squared L2 distance of 0/1 vectors.
+// Like L2sq of the other types, the index keeps the squared distance for
+// vector_l2_ops, and metric.DistanceTransformHnsw returns its square root when
+// the query orders by l2_distance, so the index agrees with l2_distance, while
+// l2_distance_sq and hamming_distance get the Hamming distance as is.
+func MetricForQuantization(q usearch.Quantization, m usearch.Metric) (usearch.Metric, error) {
+	if q != usearch.B1 {
+		return m, nil
+	}
+	if m != usearch.L2sq {
+		return m, moerr.NewInvalidInputNoCtx("HNSW index on vecbit only supports vector_l2_ops and vector_l2sq_ops")
+	}
+	return usearch.Hamming, nil
+}
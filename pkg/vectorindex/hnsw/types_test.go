@@ -29,6 +29,32 @@ func TestQuantization(t *testing.T) {
 	q, err = QuantizationToUsearch(int32(types.T_array_float64))
 	require.Nil(t, err)
 	require.Equal(t, q, usearch.F64)
+	q, err = QuantizationToUsearch(int32(types.T_array_float16))
+	require.Nil(t, err)
+	require.Equal(t, q, usearch.F16)
+	q, err = QuantizationToUsearch(int32(types.T_array_int8))
+	require.Nil(t, err)
+	require.Equal(t, q, usearch.I8)
+	q, err = QuantizationToUsearch(int32(types.T_array_bit))
+	require.Nil(t, err)
+	require.Equal(t, q, usearch.B1)
 	_, err = QuantizationToUsearch(int32(types.T_varchar))
 	require.NotNil(t, err)
+
+	oid, ok := QuantizedVectorType(usearch.I8)
+	require.True(t, ok)
+	require.Equal(t, types.T_array_int8, oid)
+	_, ok = QuantizedVectorType(usearch.F32)
+	require.False(t, ok)
+}
+
+func TestMetricForQuantization(t *testing.T) {
+	m, err := MetricForQuantization(usearch.F16, usearch.Cosine)
+	require.Nil(t, err)
+	require.Equal(t, usearch.Cosine, m)
+	m, err = MetricForQuantization(usearch.B1, usearch.L2sq)
+	require.Nil(t, err)
+	require.Equal(t, usearch.Hamming, m)
+	_, err = MetricForQuantization(usearch.B1, usearch.InnerProduct)
+	require.NotNil(t, err)
 }
@@ -127,9 +127,11 @@ func (CatalogHooks) ExperimentalFlag() string { return "" }
 
 // SupportedOpTypes returns IVF-FLAT's metric registry. IVF uses a
 // distinct metric table from HNSW/USearch (OpTypeToIvfMetric).
-// SupportedVectorTypes: IVF-FLAT indexes f32 or f64 vectors.
+// SupportedVectorTypes: IVF-FLAT indexes f32 or f64 vectors, and f16, i8 or
+// bit vectors which are clustered as f32 and keep their type in the entries.
 func (CatalogHooks) SupportedVectorTypes() []types.T {
-	return []types.T{types.T_array_float32, types.T_array_float64}
+	return []types.T{types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit}
 }
 
 // SupportedPrimaryKeyTypes: IVF-FLAT imposes no PK-type constraint — the
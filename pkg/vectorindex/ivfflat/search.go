@@ -84,7 +84,14 @@ func (idx *IvfflatSearchIndex[T]) LoadCentroids(proc *sqlexec.SqlProcess, idxcfg
 
 	ncenters := 0
 	centroids := make([][]T, idxcfg.Ivfflat.Lists)
-	elemsz := res.Batches[0].Vecs[1].GetType().GetArrayElementSize()
+	oid := res.Batches[0].Vecs[1].GetType().Oid
+	var elemsz int
+	if oid.IsQuantizedArray() {
+		// vecf16, veci8 and vecbit centroids are decoded to float32
+		elemsz = 4
+	} else {
+		elemsz = res.Batches[0].Vecs[1].GetType().GetArrayElementSize()
+	}
 	for _, bat := range res.Batches {
 		faVec := bat.Vecs[1]
 		idVec := bat.Vecs[0]
@@ -95,7 +102,12 @@ func (idx *IvfflatSearchIndex[T]) LoadCentroids(proc *sqlexec.SqlProcess, idxcfg
 				continue
 			}
 			val := faVec.GetStringAt(i)
-			vec := types.BytesToArray[T](util.UnsafeStringToBytes(val))
+			var vec []T
+			if oid.IsQuantizedArray() {
+				vec = any(types.QuantizedBytesToFloat32(oid, util.UnsafeStringToBytes(val))).([]T)
+			} else {
+				vec = types.BytesToArray[T](util.UnsafeStringToBytes(val))
+			}
 			centroids[id] = vec
 			ncenters += 1
 		}
@@ -279,6 +291,13 @@ func (idx *IvfflatSearchIndex[T]) Search(
 	case []float64:
 		vecFromB64Fn = "vecf64_from_base64"
 	}
+	queryExpr := fmt.Sprintf("%s('%s')", vecFromB64Fn, queryB64)
+	if oid := types.T(idxcfg.Ivfflat.VectorType); oid.IsQuantizedArray() {
+		// vecf16, veci8 and vecbit are searched as vecf32, cast the query back
+		// to the type of the entries
+		typ := types.New(oid, int32(idxcfg.Ivfflat.Dimensions), 0)
+		queryExpr = fmt.Sprintf("CAST(%s AS %s)", queryExpr, typ.DescString())
+	}
 
 	if sqlproc != nil && sqlproc.ExactPkFilter != "" {
 		// Exact PK path: WaitUniqueJoinKeys converted small key set into ExactPkFilter.
@@ -294,12 +313,11 @@ func (idx *IvfflatSearchIndex[T]) Search(
 		// a plain filtered read that returns the full candidate set; the downstream
 		// Node_SORT + LIMIT k does the ranking and truncation.
 		sql = fmt.Sprintf(
-			"SELECT `%s`, %s(`%s`, %s('%s')) as vec_dist FROM `%s`.`%s` WHERE `%s` = %d AND `%s` IN (%s)",
+			"SELECT `%s`, %s(`%s`, %s) as vec_dist FROM `%s`.`%s` WHERE `%s` = %d AND `%s` IN (%s)",
 			catalog.SystemSI_IVFFLAT_TblCol_Entries_pk,
 			metric.MetricTypeToDistFuncName[metric.MetricType(idxcfg.Ivfflat.Metric)],
 			catalog.SystemSI_IVFFLAT_TblCol_Entries_entry,
-			vecFromB64Fn,
-			queryB64,
+			queryExpr,
 			tblcfg.DbName, tblcfg.EntriesTable,
 			catalog.SystemSI_IVFFLAT_TblCol_Entries_version,
 			idx.Version,
@@ -309,12 +327,11 @@ func (idx *IvfflatSearchIndex[T]) Search(
 	} else {
 		// Standard centroid-based path with optional CBloomFilter pre-filtering.
 		sql = fmt.Sprintf(
-			"SELECT `%s`, %s(`%s`, %s('%s')) as vec_dist FROM `%s`.`%s` WHERE `%s` = %d AND `%s` IN (%s) ORDER BY vec_dist LIMIT %d",
+			"SELECT `%s`, %s(`%s`, %s) as vec_dist FROM `%s`.`%s` WHERE `%s` = %d AND `%s` IN (%s) ORDER BY vec_dist LIMIT %d",
 			catalog.SystemSI_IVFFLAT_TblCol_Entries_pk,
 			metric.MetricTypeToDistFuncName[metric.MetricType(idxcfg.Ivfflat.Metric)],
 			catalog.SystemSI_IVFFLAT_TblCol_Entries_entry,
-			vecFromB64Fn,
-			queryB64,
+			queryExpr,
 			tblcfg.DbName, tblcfg.EntriesTable,
 			catalog.SystemSI_IVFFLAT_TblCol_Entries_version,
 			idx.Version,
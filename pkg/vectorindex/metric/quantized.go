@@ -0,0 +1,193 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// QuantizedDistanceFunction computes the distance between two vecf16, veci8 or
+// vecbit values in their stored encoding.
+type QuantizedDistanceFunction func(v1, v2 []byte) (float64, error)
+
+// ResolveQuantizedDistanceFn is ResolveDistanceFn for vecf16, veci8 and vecbit.
+// Like ResolveDistanceFn, Metric_L2Distance returns the squared distance.
+//
+// vecf16 is computed in float32, veci8 in integers without decoding and vecbit
+// with popcount. For vecbit the squared L2 and L1 distances are both the Hamming
+// distance.
+func ResolveQuantizedDistanceFn(oid types.T, metric MetricType) (QuantizedDistanceFunction, error) {
+	switch oid {
+	case types.T_array_float16:
+		fn, err := ResolveDistanceFn[float32](metric)
+		if err != nil {
+			return nil, err
+		}
+		return func(v1, v2 []byte) (float64, error) {
+			dist, err := fn(
+				types.QuantizedBytesToFloat32(oid, v1),
+				types.QuantizedBytesToFloat32(oid, v2),
+			)
+			return float64(dist), err
+		}, nil
+	case types.T_array_int8:
+		switch metric {
+		case Metric_L2Distance, Metric_L2sqDistance:
+			return L2DistanceSqInt8, nil
+		case Metric_InnerProduct:
+			return InnerProductInt8, nil
+		case Metric_CosineDistance:
+			return CosineDistanceInt8, nil
+		case Metric_L1Distance:
+			return L1DistanceInt8, nil
+		}
+	case types.T_array_bit:
+		switch metric {
+		case Metric_L2Distance, Metric_L2sqDistance, Metric_L1Distance:
+			return HammingDistance, nil
+		case Metric_InnerProduct:
+			return InnerProductBit, nil
+		case Metric_CosineDistance:
+			return CosineDistanceBit, nil
+		}
+	default:
+		return nil, moerr.NewInternalErrorNoCtxf("%s is not a quantized vector type", oid.String())
+	}
+	return nil, moerr.NewInternalErrorNoCtx("invalid distance type")
+}
+
+func L2DistanceSqInt8(p, q []byte) (float64, error) {
+	if len(p) != len(q) {
+		return 0, moerr.NewInternalErrorNoCtx("vector dimension not matched")
+	}
+	var sum int64
+	for i := range p {
+		diff := int32(int8(p[i])) - int32(int8(q[i]))
+		sum += int64(diff * diff)
+	}
+	return float64(sum), nil
+}
+
+func L1DistanceInt8(p, q []byte) (float64, error) {
+	if len(p) != len(q) {
+		return 0, moerr.NewInternalErrorNoCtx("vector dimension not matched")
+	}
+	var sum int64
+	for i := range p {
+		diff := int32(int8(p[i])) - int32(int8(q[i]))
+		if diff < 0 {
+			diff = -diff
+		}
+		sum += int64(diff)
+	}
+	return float64(sum), nil
+}
+
+// InnerProductInt8 returns the negative inner product like InnerProduct.
+func InnerProductInt8(p, q []byte) (float64, error) {
+	if len(p) != len(q) {
+		return 0, moerr.NewInternalErrorNoCtx("vector dimension not matched")
+	}
+	var sum int64
+	for i := range p {
+		sum += int64(int32(int8(p[i])) * int32(int8(q[i])))
+	}
+	return -float64(sum), nil
+}
+
+func CosineDistanceInt8(p, q []byte) (float64, error) {
+	if len(p) != len(q) {
+		return 0, moerr.NewInternalErrorNoCtx("vector dimension not matched")
+	}
+	var dot, norm1, norm2 int64
+	for i := range p {
+		a, b := int64(int8(p[i])), int64(int8(q[i]))
+		dot += a * b
+		norm1 += a * a
+		norm2 += b * b
+	}
+	return cosineDistanceFromParts(float64(dot), float64(norm1), float64(norm2)), nil
+}
+
+// HammingDistance returns the number of different bits between two vecbit values.
+func HammingDistance(p, q []byte) (float64, error) {
+	if len(p) != len(q) {
+		return 0, moerr.NewInternalErrorNoCtx("vector dimension not matched")
+	}
+	var sum int
+	i := 0
+	for ; i+8 <= len(p); i += 8 {
+		sum += bits.OnesCount64(binary.LittleEndian.Uint64(p[i:]) ^ binary.LittleEndian.Uint64(q[i:]))
+	}
+	for ; i < len(p); i++ {
+		sum += bits.OnesCount8(p[i] ^ q[i])
+	}
+	return float64(sum), nil
+}
+
+// InnerProductBit returns the negative number of bits set in both values.
+func InnerProductBit(p, q []byte) (float64, error) {
+	if len(p) != len(q) {
+		return 0, moerr.NewInternalErrorNoCtx("vector dimension not matched")
+	}
+	dot, _, _ := popcountBit(p, q)
+	return -float64(dot), nil
+}
+
+func CosineDistanceBit(p, q []byte) (float64, error) {
+	if len(p) != len(q) {
+		return 0, moerr.NewInternalErrorNoCtx("vector dimension not matched")
+	}
+	dot, norm1, norm2 := popcountBit(p, q)
+	return cosineDistanceFromParts(float64(dot), float64(norm1), float64(norm2)), nil
+}
+
+// popcountBit returns popcount(p & q), popcount(p) and popcount(q).
+func popcountBit(p, q []byte) (dot, norm1, norm2 int) {
+	i := 0
+	for ; i+8 <= len(p); i += 8 {
+		a, b := binary.LittleEndian.Uint64(p[i:]), binary.LittleEndian.Uint64(q[i:])
+		dot += bits.OnesCount64(a & b)
+		norm1 += bits.OnesCount64(a)
+		norm2 += bits.OnesCount64(b)
+	}
+	for ; i < len(p); i++ {
+		dot += bits.OnesCount8(p[i] & q[i])
+		norm1 += bits.OnesCount8(p[i])
+		norm2 += bits.OnesCount8(q[i])
+	}
+	return
+}
+
+// cosineDistanceFromParts follows the conventions of CosineDistance: the
+// distance is 1 if either vector is all zeros.
+func cosineDistanceFromParts(dot, norm1Sq, norm2Sq float64) float64 {
+	denominator := math.Sqrt(norm1Sq) * math.Sqrt(norm2Sq)
+	if denominator == 0 {
+		return 1
+	}
+	similarity := dot / denominator
+	if similarity > 1 {
+		similarity = 1
+	} else if similarity < -1 {
+		similarity = -1
+	}
+	return 1 - similarity
+}
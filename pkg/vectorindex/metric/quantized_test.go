@@ -0,0 +1,93 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_QuantizedDistance(t *testing.T) {
+	metrics := []MetricType{
+		Metric_L2Distance, Metric_L2sqDistance, Metric_InnerProduct, Metric_CosineDistance, Metric_L1Distance,
+	}
+
+	// the quantized distances agree with the float32 distances on the decoded values
+	for _, oid := range []types.T{types.T_array_float16, types.T_array_int8, types.T_array_bit} {
+		for _, dim := range []int{8, 24, 136} {
+			v1, v2 := make([]float32, dim), make([]float32, dim)
+			for i := range v1 {
+				v1[i] = float32(rand.Intn(200) - 100)
+				v2[i] = float32(rand.Intn(200) - 100)
+			}
+			b1, err := types.Float32ToQuantizedBytes(oid, v1)
+			require.NoError(t, err)
+			b2, err := types.Float32ToQuantizedBytes(oid, v2)
+			require.NoError(t, err)
+			f1, f2 := types.QuantizedBytesToFloat32(oid, b1), types.QuantizedBytesToFloat32(oid, b2)
+
+			for _, m := range metrics {
+				fn, err := ResolveQuantizedDistanceFn(oid, m)
+				require.NoError(t, err)
+				dist, err := fn(b1, b2)
+				require.NoError(t, err)
+
+				expectFn, err := ResolveDistanceFn[float64](m)
+				require.NoError(t, err)
+				g1, g2 := make([]float64, dim), make([]float64, dim)
+				for i := range f1 {
+					g1[i], g2[i] = float64(f1[i]), float64(f2[i])
+				}
+				expect, err := expectFn(g1, g2)
+				require.NoError(t, err)
+				require.InDelta(t, expect, dist, 1e-4, "%s %d", oid.String(), m)
+
+				_, err = fn(b1, b2[1:])
+				require.Error(t, err)
+			}
+		}
+	}
+
+	_, err := ResolveQuantizedDistanceFn(types.T_array_float32, Metric_L2Distance)
+	require.Error(t, err)
+	_, err = ResolveQuantizedDistanceFn(types.T_array_int8, Metric_TypeCount)
+	require.Error(t, err)
+	_, err = ResolveQuantizedDistanceFn(types.T_array_bit, Metric_TypeCount)
+	require.Error(t, err)
+	_, err = ResolveQuantizedDistanceFn(types.T_array_float16, Metric_TypeCount)
+	require.Error(t, err)
+}
+
+func Test_HammingDistance(t *testing.T) {
+	p := []byte{0xff, 0x00, 0x0f, 0x01, 0, 0, 0, 0, 0x80}
+	q := []byte{0x00, 0x00, 0xff, 0x01, 0, 0, 0, 0, 0x01}
+	dist, err := HammingDistance(p, q)
+	require.NoError(t, err)
+	require.Equal(t, float64(8+4+2), dist)
+
+	dist, err = InnerProductBit(p, q)
+	require.NoError(t, err)
+	require.Equal(t, float64(-5), dist)
+
+	dist, err = CosineDistanceBit(p, p)
+	require.NoError(t, err)
+	require.InDelta(t, 0, dist, 1e-9)
+	dist, err = CosineDistanceBit(p, make([]byte, len(p)))
+	require.NoError(t, err)
+	require.Equal(t, float64(1), dist)
+}
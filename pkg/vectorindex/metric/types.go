@@ -36,6 +36,8 @@ const (
 	DistFn_InnerProduct   = "inner_product"
 	DistFn_CosineDistance = "cosine_distance"
 	DistFn_L1Distance     = "l1_distance"
+	// DistFn_HammingDistance is only defined for vecbit, where it equals l2_distance_sq.
+	DistFn_HammingDistance = "hamming_distance"
 
 	DistIntFn_L2Distance     = "l2_distance_sq"
 	DistIntFn_InnerProduct   = "inner_product"
@@ -102,10 +104,11 @@ func ValidQuantization(val string) bool {
 
 var (
 	DistFuncOpTypes = map[string]string{
-		DistFn_L2Distance:     OpType_L2Distance,
-		DistFn_L2sqDistance:   OpType_L2Distance,
-		DistFn_InnerProduct:   OpType_InnerProduct,
-		DistFn_CosineDistance: OpType_CosineDistance,
+		DistFn_L2Distance:      OpType_L2Distance,
+		DistFn_L2sqDistance:    OpType_L2Distance,
+		DistFn_InnerProduct:    OpType_InnerProduct,
+		DistFn_CosineDistance:  OpType_CosineDistance,
+		DistFn_HammingDistance: OpType_L2Distance,
 	}
 
 	OpTypeToIvfMetric = map[string]MetricType{
@@ -147,11 +150,12 @@ var (
 	}
 
 	DistFuncNameToMetricType = map[string]MetricType{
-		DistFn_L2Distance:     Metric_L2Distance,
-		DistFn_L2sqDistance:   Metric_L2sqDistance,
-		DistFn_InnerProduct:   Metric_InnerProduct,
-		DistFn_CosineDistance: Metric_CosineDistance,
-		DistFn_L1Distance:     Metric_L1Distance,
+		DistFn_L2Distance:      Metric_L2Distance,
+		DistFn_L2sqDistance:    Metric_L2sqDistance,
+		DistFn_InnerProduct:    Metric_InnerProduct,
+		DistFn_CosineDistance:  Metric_CosineDistance,
+		DistFn_L1Distance:      Metric_L1Distance,
+		DistFn_HammingDistance: Metric_L2sqDistance,
 	}
 )
 
@@ -176,8 +180,8 @@ func MaxFloat[T types.RealNumbers]() T {
 }
 
 func DistanceTransformHnsw(dist float64, origMetricType MetricType, metricType usearch.Metric) float64 {
-	if origMetricType == Metric_L2Distance && metricType == usearch.L2sq {
-		// metric is l2sq but origin is l2_distance
+	if origMetricType == Metric_L2Distance && (metricType == usearch.L2sq || metricType == usearch.Hamming) {
+		// metric is l2sq but origin is l2_distance.  hamming is l2sq of vecbit
 		return math.Sqrt(dist)
 	}
 	return dist
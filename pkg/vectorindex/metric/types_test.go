@@ -67,6 +67,9 @@ func TestDistanceTransformHnsw(t *testing.T) {
 	in := 9.0
 	out := DistanceTransformHnsw(in, Metric_L2Distance, usearch.L2sq)
 	require.InDelta(t, 3.0, out, 1e-9)
+	// Hamming of vecbit is l2sq -> sqrt
+	out = DistanceTransformHnsw(in, Metric_L2Distance, usearch.Hamming)
+	require.InDelta(t, 3.0, out, 1e-9)
 
 	// non-matching combinations -> identity
 	out = DistanceTransformHnsw(in, Metric_L2sqDistance, usearch.L2sq)
@@ -744,6 +744,7 @@ func getTableDef(tblItem *TableItem, coldefs []engine.TableDef) (*plan.TableDef,
 			i++
 		}
 	}
+	catalog.ApplyColumnCompressToPlanCols(cols, tblItem.ExtraInfo.GetColumnCompress())
 
 	if tblItem.Comment != "" {
 		properties = append(properties, &plan.Property{
@@ -294,6 +294,7 @@ func (t *cnMergeTask) PrepareNewWriter() *ioutil.BlockWriter {
 		t.fs,
 		t.arena,
 	)
+	writer.SetColumnCompresses(t.host.extraInfo.GetColumnCompress())
 	t.num++
 	return writer // TODO obj.isTombstone
 }
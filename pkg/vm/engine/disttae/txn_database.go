@@ -470,6 +470,11 @@ func (db *txnDatabase) createWithID(
 			}
 		}
 		tbl.extraInfo.NextColSeqnum = uint32(len(cols) - 1 /*rowid doesn't occupy seqnum*/)
+		if extra == nil {
+			// an inplace alter passes the current extra, whose codecs are only
+			// changed by AlterKind_UpdateColumnCompress
+			tbl.extraInfo.ColumnCompress = catalog.GenColumnCompressFromDefs(defs)
+		}
 		if tbl.extraInfo.BlockMaxRows == 0 {
 			tbl.extraInfo.BlockMaxRows = options.DefaultBlockMaxRows
 		}
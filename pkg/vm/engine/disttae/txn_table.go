@@ -1491,6 +1491,7 @@ func (tbl *txnTable) GetTableDef(ctx context.Context) *plan.TableDef {
 				i++
 			}
 		}
+		catalog.ApplyColumnCompressToPlanCols(cols, tbl.extraInfo.GetColumnCompress())
 
 		if tbl.comment != "" {
 			properties = append(properties, &plan.Property{
@@ -1652,6 +1653,7 @@ func (tbl *txnTable) AlterTable(ctx context.Context, c *engine.ConstraintDef, re
 	oldPartInfo := tbl.partition
 	oldComment := tbl.comment
 	oldConstraint := tbl.constraint
+	oldExtra := tbl.extraInfo
 	// The fact that the tableDef brought by alter requests can appended to the tail of original defs presupposes:
 	// 1. late arriving tableDef will overwrite the existing tableDef
 	// 2. any TableDef about columns, like AttritebuteDef, PrimaryKeyDef, or CluterbyDef do not change, ensuring genColumnsFromDefs works well
@@ -1670,6 +1672,8 @@ func (tbl *txnTable) AlterTable(ctx context.Context, c *engine.ConstraintDef, re
 				tbl.constraint = oldConstraint
 			case api.AlterKind_RenameTable:
 				tbl.tableName = oldTableName
+			case api.AlterKind_UpdateColumnCompress:
+				tbl.extraInfo = oldExtra
 			case api.AlterKind_ReplaceDef:
 				// Rollback for ReplaceDef is handled by restoring defs
 			case api.AlterKind_RenameColumn:
@@ -1711,6 +1715,11 @@ func (tbl *txnTable) AlterTable(ctx context.Context, c *engine.ConstraintDef, re
 			hasReplaceDef = true
 			re := req.GetRenameCol()
 			renameColMap[re.OldName] = re.NewName
+		case api.AlterKind_UpdateColumnCompress:
+			// the extra may be shared with the catalog cache, copy before modifying
+			extra := *tbl.extraInfo
+			extra.ColumnCompress = req.GetUpdateColumnCompress().ColumnCompress
+			tbl.extraInfo = &extra
 		default:
 			panic("not supported")
 		}
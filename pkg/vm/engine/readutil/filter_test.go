@@ -1313,6 +1313,11 @@ func TestConstructBlockPKFilter(t *testing.T) {
 				require.True(t, blkPKFilter.Valid, basePKFilter.String())
 				require.NotNil(t, blkPKFilter.SortedSearchFunc)
 				require.NotNil(t, blkPKFilter.UnSortedSearchFunc)
+				if op == function.EQUAL || op == function.IN {
+					require.NotNil(t, blkPKFilter.DictSearchFunc)
+				} else {
+					require.Nil(t, blkPKFilter.DictSearchFunc)
+				}
 
 				inputVec := vector.NewVec(ty.ToType())
 
@@ -3732,3 +3737,84 @@ func TestCompileFilterExpr_PrefixSortedSeekOps(t *testing.T) {
 		})
 	}
 }
+
+func TestConstructBlockColumnFilter(t *testing.T) {
+	mp := mpool.MustNew(t.Name())
+	tableDef := &plan.TableDef{
+		Name:          "test",
+		Name2ColIndex: map[string]int32{"a": 0, "b": 1, "c": 2},
+		Pkey:          &plan.PrimaryKeyDef{Names: []string{"a"}, PkeyColName: "a"},
+		Cols: []*plan.ColDef{
+			{Name: "a", Seqnum: 0, Typ: plan.Type{Id: int32(types.T_int64)}},
+			{Name: "b", Seqnum: 1, Typ: plan.Type{Id: int32(types.T_int64)}},
+			{Name: "c", Seqnum: 2, Typ: plan.Type{Id: int32(types.T_float64)}},
+		},
+	}
+	column := func(idx int32, oid types.T) *plan.Expr { return MakeColExprForTest(idx, oid) }
+	eq := func(idx int32, val int64) *plan.Expr {
+		return MakeFunctionExprForTest("=", []*plan.Expr{
+			column(idx, types.T_int64),
+			plan2.MakePlan2Int64ConstExprWithType(val),
+		})
+	}
+	proc := testutil.NewProcessWithMPool(t, "", mp)
+	fold := func(expr *plan.Expr) *plan.Expr {
+		var executors []colexec.ExpressionExecutor
+		plan2.ReplaceFoldExpr(proc, expr, &executors)
+		plan2.EvalFoldExpr(proc, expr, &executors)
+		for _, executor := range executors {
+			executor.Free()
+		}
+		return expr
+	}
+
+	vec := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(vec, []int64{1, 3, 5, 3, 7}, nil, mp))
+	defer vec.Free(mp)
+	baseline := mp.CurrNB()
+
+	// a = 1 and b in (3, 5): the primary key filter on a and the column
+	// filter on b are both set up
+	expr := fold(MakeFunctionExprForTest("and", []*plan.Expr{
+		eq(0, 1),
+		MakeInExprForTest(column(1, types.T_int64), []int64{3, 5}, types.T_int64, mp),
+	}))
+	base, err := ConstructBasePKFilter(expr, tableDef, mp)
+	require.NoError(t, err)
+	filter, err := ConstructBlockPKFilter(false, base, nil)
+	require.NoError(t, err)
+	require.True(t, filter.Valid)
+	require.NoError(t, ConstructBlockColumnFilter(expr, tableDef, mp, &filter))
+	require.NotNil(t, filter.ColumnSearchFunc)
+	require.Equal(t, uint16(1), filter.ColumnSeqnum)
+	require.Equal(t, types.T_int64, filter.ColumnType.Oid)
+	require.Equal(t, []int64{1, 2, 3}, filter.ColumnSearchFunc(vec))
+	filter.Cleanup()
+	require.Equal(t, baseline, mp.CurrNB())
+
+	// b = 7 or b = 1
+	expr = fold(MakeFunctionExprForTest("or", []*plan.Expr{eq(1, 7), eq(1, 1)}))
+	filter = objectio.BlockReadFilter{}
+	require.NoError(t, ConstructBlockColumnFilter(expr, tableDef, mp, &filter))
+	require.NotNil(t, filter.ColumnSearchFunc)
+	require.Equal(t, []int64{0, 4}, filter.ColumnSearchFunc(vec))
+
+	// a range, a disjunction over two columns and a column that is never
+	// encoded leave the column filter unset
+	for _, expr := range []*plan.Expr{
+		MakeFunctionExprForTest(">", []*plan.Expr{
+			column(1, types.T_int64),
+			plan2.MakePlan2Int64ConstExprWithType(3),
+		}),
+		MakeFunctionExprForTest("or", []*plan.Expr{eq(1, 3), eq(0, 1)}),
+		MakeFunctionExprForTest("=", []*plan.Expr{
+			column(2, types.T_float64),
+			plan2.MakePlan2Float64ConstExprWithType(1.5),
+		}),
+	} {
+		filter = objectio.BlockReadFilter{}
+		require.NoError(t, ConstructBlockColumnFilter(fold(expr), tableDef, mp, &filter))
+		require.Nil(t, filter.ColumnSearchFunc)
+	}
+	require.Equal(t, baseline, mp.CurrNB())
+}
@@ -443,7 +443,7 @@ func EncodePrimaryKeyVector(vec *vector.Vector, packer *types.Packer) (ret [][]b
 
 	case types.T_json, types.T_char, types.T_varchar,
 		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_Rowid,
-		types.T_array_float32, types.T_array_float64, types.T_datalink:
+		types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 		for i := 0; i < vec.Length(); i++ {
 			packer.EncodeStringType(vec.GetBytesAt(i))
 			ret = append(ret, packer.Bytes())
@@ -24,6 +24,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
@@ -142,6 +144,11 @@ func ConstructBlockPKFilter(
 		if sortedSearchFunc != nil {
 			readFilter.SortedSearchFunc = wrapInner(sortedSearchFunc)
 			readFilter.UnSortedSearchFunc = wrapInner(unSortedSearchFunc)
+			// equality and IN can be evaluated against the dictionary of a
+			// dictionary encoded block without decoding the column
+			if unSortedSearchFunc != nil && allEqualOrIn(disjuncts) {
+				readFilter.DictSearchFunc = unSortedSearchFunc
+			}
 			readFilter.Valid = true
 			return readFilter, nil
 		}
@@ -273,6 +280,91 @@ func ConstructBlockPKFilter(
 	return readFilter, nil
 }
 
+// ConstructBlockColumnFilter adds to readFilter the search of the first non
+// primary key column of tableDef that expr compares with `=` or `in` only.
+// It is evaluated on the blocks where this column is dictionary or
+// run-length encoded and narrows the rows read from them, the filter
+// expression still has to be evaluated on the returned rows.
+func ConstructBlockColumnFilter(
+	expr *plan.Expr,
+	tableDef *plan.TableDef,
+	mp *mpool.MPool,
+	readFilter *objectio.BlockReadFilter,
+) error {
+	if expr == nil {
+		return nil
+	}
+	for _, col := range tableDef.Cols {
+		if col.Hidden || col.Name == tableDef.Pkey.PkeyColName {
+			continue
+		}
+		typ := plan2.ExprType2Type(&col.Typ)
+		if !objectio.IsSearchableEncodingType(typ) {
+			continue
+		}
+
+		// reuse the primary key filter construction for this column
+		colDef := *tableDef
+		colDef.Pkey = &plan.PrimaryKeyDef{PkeyColName: col.Name}
+		base, err := ConstructBasePKFilter(expr, &colDef, mp)
+		if err != nil {
+			return err
+		}
+		if !base.Valid {
+			continue
+		}
+		cleanup := base.cleanup
+		disjuncts := base.Disjuncts
+		if len(disjuncts) == 0 {
+			disjuncts = []BasePKFilter{base}
+		}
+		var funcs []func(*vector.Vector) []int64
+		if allEqualOrIn(disjuncts) {
+			for idx := range disjuncts {
+				_, unSortedFunc, err := buildBlockPKSearchFuncs(disjuncts[idx])
+				if err != nil {
+					cleanup.run()
+					return err
+				}
+				if unSortedFunc == nil {
+					funcs = nil
+					break
+				}
+				funcs = append(funcs, unSortedFunc)
+			}
+		}
+		if len(funcs) == 0 {
+			cleanup.run()
+			continue
+		}
+
+		readFilter.ColumnSearchFunc = combineOffsetFuncs(funcs)
+		readFilter.ColumnSeqnum = uint16(col.Seqnum)
+		readFilter.ColumnType = typ
+		if cleanup != nil {
+			if prev := readFilter.Cleanup; prev != nil {
+				readFilter.Cleanup = func() {
+					prev()
+					cleanup.run()
+				}
+			} else {
+				readFilter.Cleanup = cleanup.run
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func allEqualOrIn(filters []BasePKFilter) bool {
+	for i := range filters {
+		if filters[i].Op != function.EQUAL && filters[i].Op != function.IN {
+			return false
+		}
+	}
+	return true
+}
+
 func linearBoolSearchOffsetByValFactory(values []bool) func(*vector.Vector) []int64 {
 	return func(vec *vector.Vector) []int64 {
 		rows := vector.MustFixedColNoTypeCheck[bool](vec)
@@ -552,7 +644,7 @@ func buildBlockPKSearchFuncs(
 			sortedSearchFunc = vector.FixedSizedBinarySearchOffsetByValFactory(vector.MustFixedColNoTypeCheck[types.Decimal256](vec), types.CompareDecimal256)
 			unSortedSearchFunc = vector.FixedSizeLinearSearchOffsetByValFactory(vector.MustFixedColNoTypeCheck[types.Decimal256](vec), types.CompareDecimal256)
 		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text,
-			types.T_array_float32, types.T_array_float64, types.T_datalink:
+			types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit, types.T_datalink:
 			sortedSearchFunc = vector.VarlenBinarySearchOffsetByValFactory(vector.InefficientMustBytesCol(vec))
 			unSortedSearchFunc = vector.VarlenLinearSearchOffsetByValFactory(vector.InefficientMustBytesCol(vec))
 		case types.T_enum:
@@ -1248,6 +1340,7 @@ func validEncodedBasePKValue(oid types.T, value []byte) bool {
 		return true
 	case types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_text,
 		types.T_binary, types.T_varbinary, types.T_array_float32, types.T_array_float64,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit,
 		types.T_datalink, types.T_geometry, types.T_geometry32:
 		return true
 	case types.T_bool, types.T_bit,
@@ -1311,7 +1404,7 @@ func supportedPKInType(oid types.T) bool {
 		types.T_date, types.T_time, types.T_datetime, types.T_timestamp, types.T_year,
 		types.T_decimal64, types.T_decimal128, types.T_decimal256,
 		types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json,
-		types.T_blob, types.T_text, types.T_array_float32, types.T_array_float64,
+		types.T_blob, types.T_text, types.T_array_float32, types.T_array_float64, types.T_array_float16, types.T_array_int8, types.T_array_bit,
 		types.T_datalink, types.T_enum, types.T_uuid:
 		return true
 	default:
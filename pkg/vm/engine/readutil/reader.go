@@ -441,6 +441,13 @@ func NewReader(
 		return nil, err
 	}
 
+	if err = ConstructBlockColumnFilter(expr, tableDef, mp, &blockFilter); err != nil {
+		if blockFilter.Cleanup != nil {
+			blockFilter.Cleanup()
+		}
+		return nil, err
+	}
+
 	r := &reader{
 		withFilterMixin: withFilterMixin{
 			fs:         fs,
@@ -119,6 +119,60 @@ func ReadDataByFilter(
 	return
 }
 
+// ReadDataByDict evaluates the filter against the distinct values of the
+// dictionary or run-length encoded filter column without decoding it. ok is
+// false if the block cannot be searched this way and ReadDataByFilter must be
+// used instead.
+func ReadDataByDict(
+	ctx context.Context,
+	info *objectio.BlockInfo,
+	ds engine.DataSource,
+	columns []uint16,
+	colTypes []types.Type,
+	dictSearchFunc objectio.ReadFilterDictSearchFuncType,
+	mp *mpool.MPool,
+	fs fileservice.FileService,
+) (sels []int64, ok bool, err error) {
+	// appendable blocks need the commit ts column to filter out the
+	// invisible rows, and a second filter column is probed by the
+	// membership filter, both need the decoded vectors
+	if info.IsAppendable() || len(columns) != 1 {
+		return
+	}
+	if sels, ok, err = ioutil.SearchEncodedColumn(
+		ctx,
+		columns[0],
+		colTypes[0],
+		fs,
+		info.MetaLocation(),
+		mp,
+		fileservice.Policy(0),
+		dictSearchFunc,
+	); err != nil || !ok || len(sels) == 0 {
+		return
+	}
+	sels, err = ds.ApplyTombstones(ctx, &info.BlockID, sels, engine.Policy_CheckAll)
+	return
+}
+
+// intersectSels returns the offsets in both of the ascending offsets a and b.
+func intersectSels(a, b []int64) []int64 {
+	out := a[:0]
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+	return out
+}
+
 // BlockDataReadNoCopy only read block data from storage, don't apply deletes.
 func BlockDataReadNoCopy(
 	ctx context.Context,
@@ -246,7 +300,30 @@ func BlockDataRead(
 
 	searchFunc := filter.DecideSearchFunc(info.IsSorted())
 
-	if searchFunc != nil {
+	dictSearched := false
+	if searchFunc != nil && filter.DictSearchFunc != nil {
+		if sels, dictSearched, err = ReadDataByDict(
+			ctx,
+			info,
+			ds,
+			filterSeqnums,
+			filterColTypes,
+			filter.DictSearchFunc,
+			mp,
+			fs,
+		); err != nil {
+			return err
+		}
+		if dictSearched {
+			v2.TxnSelReadFilterTotal.Observe(1.0)
+			if len(sels) == 0 {
+				v2.TxnSelReadFilterFiltered.Observe(1.0)
+				return nil
+			}
+		}
+	}
+
+	if searchFunc != nil && !dictSearched {
 		if sels, err = ReadDataByFilter(
 			ctx,
 			tableName,
@@ -270,6 +347,33 @@ func BlockDataRead(
 		}
 	}
 
+	if filter.ColumnSearchFunc != nil {
+		colSels, colSearched, err := ReadDataByDict(
+			ctx,
+			info,
+			ds,
+			[]uint16{filter.ColumnSeqnum},
+			[]types.Type{filter.ColumnType},
+			filter.ColumnSearchFunc,
+			mp,
+			fs,
+		)
+		if err != nil {
+			return err
+		}
+		if colSearched {
+			if searchFunc != nil {
+				colSels = intersectSels(sels, colSels)
+			}
+			sels = colSels
+			v2.TxnSelReadFilterTotal.Observe(1.0)
+			if len(sels) == 0 {
+				v2.TxnSelReadFilterFiltered.Observe(1.0)
+				return nil
+			}
+		}
+	}
+
 	err = BlockDataReadInner(
 		ctx,
 		info,
@@ -513,8 +617,58 @@ func HandleOrderByLimitOnIVFFlatIndex(
 			})
 		}
 
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		distFunc, err := metric.ResolveQuantizedDistanceFn(orderByLimit.Typ, orderByLimit.MetricType)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rhs := orderByLimit.NumVec
+
+		for _, row := range selectRows {
+			dist64, err := distFunc(vecCol.GetBytesAt(int(row)), rhs)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if orderByLimit.LowerBoundType == plan.BoundType_INCLUSIVE {
+				if dist64 < orderByLimit.LowerBound {
+					continue
+				}
+			} else if orderByLimit.LowerBoundType == plan.BoundType_EXCLUSIVE {
+				if dist64 <= orderByLimit.LowerBound {
+					continue
+				}
+			}
+			if orderByLimit.UpperBoundType == plan.BoundType_INCLUSIVE {
+				if dist64 > orderByLimit.UpperBound {
+					continue
+				}
+			} else if orderByLimit.UpperBoundType == plan.BoundType_EXCLUSIVE {
+				if dist64 >= orderByLimit.UpperBound {
+					continue
+				}
+			}
+
+			if len(orderByLimit.DistHeap) >= topLimit {
+				if dist64 < orderByLimit.DistHeap[0] {
+					orderByLimit.DistHeap[0] = dist64
+					heap.Fix(&orderByLimit.DistHeap, 0)
+				} else {
+					continue
+				}
+			} else {
+				heap.Push(&orderByLimit.DistHeap, dist64)
+			}
+
+			searchResults = append(searchResults, vectorindex.SearchResult{
+				Id:       row,
+				Distance: dist64,
+			})
+		}
+
 	default:
-		return nil, nil, moerr.NewInternalError(ctx, fmt.Sprintf("only support float32/float64 type for topn: %s", orderByLimit.Typ))
+		return nil, nil, moerr.NewInternalError(ctx, fmt.Sprintf("only support float32/float64 and quantized vector types for topn: %s", orderByLimit.Typ))
 	}
 
 	searchResults = slices.DeleteFunc(searchResults, func(res vectorindex.SearchResult) bool {
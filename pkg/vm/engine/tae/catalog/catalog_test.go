@@ -23,9 +23,11 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -420,6 +422,36 @@ func TestAlterSchema(t *testing.T) {
 
 }
 
+func TestAlterSchemaColumnCompress(t *testing.T) {
+	schema := MockSchema(4, 0)
+	req := api.NewUpdateColumnCompressReq(0, 0, []api.ColumnCompress{
+		{Seqnum: 1, Alg: uint32(compress.Zstd), Level: 3},
+		{Seqnum: 2, Alg: uint32(compress.Zstd)},
+	})
+	require.NoError(t, schema.ApplyAlterTable(req))
+	require.Len(t, schema.Extra.ColumnCompress, 2)
+
+	req = api.NewAddColumnReq(0, 0, "xyz", types.NewProtoType(types.T_int32), 2)
+	req.GetAddColumn().Column.Alg = plan.CompressType_Zstd
+	req.GetAddColumn().Column.AlgLevel = 9
+	require.NoError(t, schema.ApplyAlterTable(req))
+	require.Len(t, schema.Extra.ColumnCompress, 3)
+	require.Equal(t, uint32(schema.GetSeqnum("xyz")), schema.Extra.ColumnCompress[2].Seqnum)
+	require.Equal(t, int32(9), schema.Extra.ColumnCompress[2].Level)
+
+	// drop mock_1, whose seqnum is 1
+	req = api.NewRemoveColumnReq(0, 0, uint32(schema.GetColIdx("mock_1")), 1)
+	require.NoError(t, schema.ApplyAlterTable(req))
+	require.Len(t, schema.Extra.ColumnCompress, 2)
+	for _, c := range schema.Extra.ColumnCompress {
+		require.NotEqual(t, uint32(1), c.Seqnum)
+	}
+
+	req = api.NewUpdateColumnCompressReq(0, 0, nil)
+	require.NoError(t, schema.ApplyAlterTable(req))
+	require.Empty(t, schema.Extra.ColumnCompress)
+}
+
 func randomTxnID(t *testing.T) []byte {
 	bytes := make([]byte, 8)
 	_, err := rand.Read(bytes)
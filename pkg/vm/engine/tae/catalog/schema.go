@@ -26,6 +26,7 @@ import (
 
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
@@ -204,6 +205,9 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 		s.Constraint = req.GetUpdateCstr().GetConstraints()
 	case apipb.AlterKind_UpdateComment:
 		s.Comment = req.GetUpdateComment().GetComment()
+	case apipb.AlterKind_UpdateColumnCompress:
+		s.Extra.ColumnCompress = req.GetUpdateColumnCompress().GetColumnCompress()
+		logutil.Infof("[Alter] update column compress %v", s.Extra.ColumnCompress)
 	case apipb.AlterKind_RenameColumn:
 		rename := req.GetRenameCol()
 		var targetCol *ColDef
@@ -249,6 +253,14 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 			s.ColDefs = append(s.ColDefs, col)
 		}
 
+		if add.Column.Alg == plan.CompressType_Zstd {
+			s.Extra.ColumnCompress = append(s.Extra.ColumnCompress, apipb.ColumnCompress{
+				Seqnum: uint32(newcol.SeqNum),
+				Alg:    uint32(compress.Zstd),
+				Level:  add.Column.AlgLevel,
+			})
+		}
+
 		s.Extra.ColumnChanged = true
 		s.Extra.NextColSeqnum += 1
 		return s.Finalize(true) // rebuild sortkey
@@ -272,6 +284,13 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 			s.ColDefs = append(s.ColDefs, col)
 		}
 		s.Extra.DroppedAttrs = append(s.Extra.DroppedAttrs, coldef.Name)
+		codecs := make([]apipb.ColumnCompress, 0, len(s.Extra.ColumnCompress))
+		for _, c := range s.Extra.ColumnCompress {
+			if c.Seqnum != uint32(coldef.SeqNum) {
+				codecs = append(codecs, c)
+			}
+		}
+		s.Extra.ColumnCompress = codecs
 		s.Extra.ColumnChanged = true
 		return s.Finalize(true)
 	case apipb.AlterKind_RenameTable:
@@ -159,6 +159,8 @@ func TypeStringValue(t types.Type, v any, isNull bool, opts ...TypePrintOpt) str
 		return types.BytesToArrayToString[float32](v.([]byte))
 	case types.T_array_float64:
 		return types.BytesToArrayToString[float64](v.([]byte))
+	case types.T_array_float16, types.T_array_int8, types.T_array_bit:
+		return types.QuantizedArrayBytesToString(t.Oid, v.([]byte))
 	case types.T_date:
 		val := v.(types.Date)
 		return val.String()
@@ -779,6 +779,7 @@ func (c *Controller) AssembleDB(ctx context.Context) (err error) {
 		merge.NewTNMergeExecutor(db.Runtime),
 		merge.NewStdClock(),
 	)
+	db.MergeScheduler.EnableRekey(db.Runtime.Fs)
 	db.MergeScheduler.Start()
 	rollbackSteps.Add("stop merge scheduler", func() error {
 		db.MergeScheduler.Stop()
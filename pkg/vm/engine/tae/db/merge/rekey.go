@@ -0,0 +1,64 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort"
+)
+
+// GatherRekeyTasks returns the tasks to rewrite the objects of the table which
+// are not encrypted by the current master key. Every object is rewritten by a
+// single object task at its own level, so the settled objects which are never
+// merged by the policies are rekeyed too.
+func GatherRekeyTasks(
+	ctx context.Context,
+	fs fileservice.FileService,
+	table catalog.MergeTable,
+) ([]mergeTask, error) {
+	var data, tombstone []*objectio.ObjectStats
+	for item := range table.IterDataItem() {
+		data = append(data, item.GetObjectStats())
+	}
+	for item := range table.IterTombstoneItem() {
+		tombstone = append(tombstone, item.GetObjectStats())
+	}
+
+	var ret []mergeTask
+	for _, isTombstone := range []bool{false, true} {
+		objs := data
+		if isTombstone {
+			objs = tombstone
+		}
+		rekeys, err := mergesort.FilterRekeyObjects(ctx, fs, IterStats(objs))
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range rekeys {
+			ret = append(ret, mergeTask{
+				objs:        []*objectio.ObjectStats{obj},
+				kind:        taskHostDN,
+				isTombstone: isTombstone,
+				level:       int8(obj.GetLevel()),
+				note:        "rekey",
+			})
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encryption"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/objectio/ioutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort"
+	"github.com/stretchr/testify/require"
+)
+
+// rekeyExecutor rewrites the objects of the tasks with the current master key
+type rekeyExecutor struct {
+	write   func() (*objectio.ObjectStats, error)
+	rekeyed atomic.Int32
+	err     atomic.Pointer[error]
+}
+
+func (e *rekeyExecutor) ExecuteFor(target catalog.MergeTable, task mergeTask) bool {
+	table := target.(*STable)
+	table.Lock()
+	for _, obj := range task.objs {
+		stats, err := e.write()
+		if err != nil {
+			e.err.Store(&err)
+			table.Unlock()
+			return false
+		}
+		table.DeleteDataLocked(obj)
+		table.AddDataLocked(SData{stats: stats})
+	}
+	table.Unlock()
+	if task.note == "rekey" {
+		e.rekeyed.Add(int32(len(task.objs)))
+	}
+	if task.doneCB != nil {
+		task.doneCB.OnExecDone(nil)
+	}
+	return true
+}
+
+func TestRekey(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	fs, err := fileservice.NewMemoryFS("memory", fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+
+	kms := encryption.NewMockKMS()
+	require.NoError(t, kms.CreateKey("k1"))
+	require.NoError(t, kms.CreateKey("k2"))
+	provider := encryption.NewKMSProvider(kms, "k1")
+	encryption.SetKeyProvider(provider, true)
+	defer encryption.SetKeyProvider(nil, false)
+
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vector.NewVec(types.T_int32.ToType())
+	require.NoError(t, vector.AppendFixedList(bat.Vecs[0], []int32{1, 2, 3}, nil, mp))
+	bat.SetRowCount(3)
+	defer bat.Clean(mp)
+
+	writeObject := func() (*objectio.ObjectStats, error) {
+		name := objectio.BuildObjectName(objectio.NewSegmentid(), 0)
+		writer, err := ioutil.NewBlockWriterNew(fs, name, 0, nil, false)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = writer.WriteBatch(bat); err != nil {
+			return nil, err
+		}
+		if _, _, err = writer.Sync(ctx); err != nil {
+			return nil, err
+		}
+		stats := writer.GetObjectStats()
+		return &stats, nil
+	}
+
+	cata := NewSCatalog()
+	for range 3 {
+		stats, err := writeObject()
+		require.NoError(t, err)
+		cata.hero.AddDataLocked(SData{stats: stats})
+	}
+
+	needRekey := func() int {
+		var objs []*objectio.ObjectStats
+		for item := range cata.hero.IterDataItem() {
+			objs = append(objs, item.GetObjectStats())
+		}
+		rekeys, err := mergesort.FilterRekeyObjects(ctx, fs, IterStats(objs))
+		require.NoError(t, err)
+		return len(rekeys)
+	}
+
+	executor := &rekeyExecutor{write: writeObject}
+	sched := NewMergeScheduler(time.Hour, cata, executor, NewStdClock())
+	sched.PatchTestRscController(newSimRscController(100 * common.Const1GBytes))
+	sched.EnableRekey(fs)
+	sched.Start()
+	defer sched.Stop()
+
+	tasks, err := GatherRekeyTasks(ctx, fs, cata.hero)
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+
+	// rotate the master key, all objects need rekey
+	provider.SetCurrentKeyID("k2")
+	require.Equal(t, 3, needRekey())
+	tasks, err = GatherRekeyTasks(ctx, fs, cata.hero)
+	require.NoError(t, err)
+	require.Len(t, tasks, 3)
+	for _, task := range tasks {
+		require.Len(t, task.objs, 1)
+		require.False(t, task.isTombstone)
+	}
+
+	// the rekey check rewrites all objects with the new master key
+	sched.ioChan <- &MMsg{
+		Kind:  MMsgKindRekeyCheck,
+		Value: MMsgRekeyCheck{Tables: []catalog.MergeTable{cata.hero}},
+	}
+	require.Eventually(t, func() bool {
+		return executor.rekeyed.Load() == 3
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Nil(t, executor.err.Load())
+	require.Equal(t, 0, needRekey())
+	for item := range cata.hero.IterDataItem() {
+		envelope, encrypted, err := objectio.ReadObjectEnvelope(ctx, item.GetObjectStats().ObjectName().String(), fs)
+		require.NoError(t, err)
+		require.True(t, encrypted)
+		require.Equal(t, "k2", envelope.KeyID)
+	}
+
+	// the objects are not read again until the master key is rotated
+	checkAll := func() {
+		sched.ioChan <- &MMsg{
+			Kind:  MMsgKindRekeyCheck,
+			Value: MMsgRekeyCheck{Tables: []catalog.MergeTable{cata.hero}, Complete: true},
+		}
+	}
+	checkAll()
+	require.Eventually(t, sched.rekeyDone, 10*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, kms.CreateKey("k3"))
+	provider.SetCurrentKeyID("k3")
+	require.False(t, sched.rekeyDone())
+	checkAll()
+	require.Eventually(t, func() bool {
+		return executor.rekeyed.Load() == 6
+	}, 10*time.Second, 10*time.Millisecond)
+	require.False(t, sched.rekeyDone())
+	checkAll()
+	require.Eventually(t, sched.rekeyDone, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, 0, needRekey())
+}
@@ -26,6 +26,8 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/rscthrottler"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/encryption"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -37,6 +39,9 @@ import (
 const (
 	bigDataTaskCntThreshold   = 4
 	objectOpsTriggerThreshold = 5
+
+	rekeyCheckInterval = time.Hour
+	rekeyCheckTimeout  = time.Minute * 5
 )
 
 type mergeTask struct {
@@ -79,6 +84,13 @@ type MergeScheduler struct {
 	rc           rscthrottler.RSCThrottler
 	executor     MergeTaskExecutor
 
+	// rekeyFS reads the objects to check whether they need rekey, the
+	// rekey check is disabled if it is nil
+	rekeyFS fileservice.FileService
+	// rekeyedKeyID is the master key all the objects are encrypted by. The
+	// objects are not read again until the master key is rotated.
+	rekeyedKeyID atomic.Pointer[string]
+
 	clock Clock
 }
 
@@ -134,6 +146,14 @@ func (a *MergeScheduler) PatchTestRscController(rc rscthrottler.RSCThrottler) {
 	a.rc = rc
 }
 
+// EnableRekey makes the scheduler rewrite the objects which are not encrypted
+// by the current master key, the objects are read from fs. The tables are
+// checked after start and after the master key is rotated, until no object
+// needs rekey.
+func (a *MergeScheduler) EnableRekey(fs fileservice.FileService) {
+	a.rekeyFS = fs
+}
+
 func (a *MergeScheduler) Stop() {
 	if a.stopped.CompareAndSwap(false, true) {
 		ch := a.stopCh.Load()
@@ -219,6 +239,7 @@ const (
 	MMsgKindConfig
 	MMsgKindVacuumCheck
 	MMsgKindConfigBootstrap
+	MMsgKindRekeyCheck
 )
 
 type MMsgSwitch struct {
@@ -263,6 +284,12 @@ type MMsgVacuumCheck struct {
 	opts  *VacuumOpts
 }
 
+type MMsgRekeyCheck struct {
+	Tables []catalog.MergeTable
+	// Complete is true if no table is left out of the check
+	Complete bool
+}
+
 type MMsgConfigBootstrap struct {
 	ReadSettingsBatch func() (*batch.Batch, func())
 }
@@ -686,6 +713,59 @@ func (a *MergeScheduler) ioConfigBootstrap(msg MMsgConfigBootstrap) {
 	)
 }
 
+// rekeyDone reports whether all the objects are encrypted by the current
+// master key.
+func (a *MergeScheduler) rekeyDone() bool {
+	keyID := a.rekeyedKeyID.Load()
+	return keyID != nil && *keyID == encryption.GetKeyProvider().CurrentKeyID()
+}
+
+func (a *MergeScheduler) ioRekeyCheck(msg MMsgRekeyCheck) {
+	if !encryption.Enabled() || a.rekeyDone() {
+		return
+	}
+	keyID := encryption.GetKeyProvider().CurrentKeyID()
+	done := msg.Complete
+	for _, table := range msg.Tables {
+		if table.HasDropCommitted() {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), rekeyCheckTimeout)
+		tasks, err := GatherRekeyTasks(ctx, a.rekeyFS, table)
+		cancel()
+		if err != nil {
+			logutil.Warn("MergeExecutorEvent",
+				zap.String("warn", "gather rekey tasks"),
+				zap.String("table", table.GetNameDesc()),
+				zap.Error(err),
+			)
+			done = false
+			continue
+		}
+		if len(tasks) == 0 {
+			continue
+		}
+		done = false
+		a.SendTrigger(
+			NewMMsgTaskTrigger(table).
+				WithAssignedTasks(tasks),
+		)
+		logutil.Info(
+			"MergeExecutorEvent-RekeyCheck",
+			zap.String("table", table.GetNameDesc()),
+			zap.Int("rekey-tasks", len(tasks)),
+		)
+	}
+	// the master key is not rotated during the check
+	if done && keyID == encryption.GetKeyProvider().CurrentKeyID() {
+		a.rekeyedKeyID.Store(&keyID)
+		logutil.Info(
+			"MergeExecutorEvent-RekeyDone",
+			zap.String("key-id", keyID),
+		)
+	}
+}
+
 func (a *MergeScheduler) handleIOLoop() {
 	stopCh := *a.stopCh.Load()
 	for {
@@ -698,6 +778,8 @@ func (a *MergeScheduler) handleIOLoop() {
 				a.ioVacuumCheck(msg.Value.(MMsgVacuumCheck))
 			case MMsgKindConfigBootstrap:
 				a.ioConfigBootstrap(msg.Value.(MMsgConfigBootstrap))
+			case MMsgKindRekeyCheck:
+				a.ioRekeyCheck(msg.Value.(MMsgRekeyCheck))
 			}
 		}
 	}
@@ -723,6 +805,33 @@ func (a *MergeScheduler) fallbackSchedVacuumCheck() {
 	}
 }
 
+func (a *MergeScheduler) fallbackSchedRekeyCheck() {
+	if a.rekeyFS == nil || !encryption.Enabled() || a.allPaused || a.rekeyDone() {
+		return
+	}
+	complete := true
+	tables := make([]catalog.MergeTable, 0, len(a.supps))
+	for _, supp := range a.supps {
+		if supp.paused {
+			complete = false
+			continue
+		}
+		tables = append(tables, supp.todo.table)
+	}
+	// do not block the main loop, the tables will be checked next time
+	select {
+	case a.ioChan <- &MMsg{
+		Kind:  MMsgKindRekeyCheck,
+		Value: MMsgRekeyCheck{Tables: tables, Complete: complete},
+	}:
+	default:
+		logutil.Info(
+			"MergeExecutorEvent-SkipRekeyCheck",
+			zap.Int("io-len", len(a.ioChan)),
+		)
+	}
+}
+
 func (a *MergeScheduler) handleMainLoop() {
 	var nextReadyAtTimer = a.clock.NewTimer(time.Hour * 24)
 	never := make(<-chan time.Time)
@@ -732,9 +841,12 @@ func (a *MergeScheduler) handleMainLoop() {
 
 	vacuumCheckTicker := a.clock.NewTicker(time.Hour * 1)
 
+	rekeyCheckTicker := a.clock.NewTicker(rekeyCheckInterval)
+
 	stopCh := *a.stopCh.Load()
 
 	a.fallbackSchedVacuumCheck()
+	a.fallbackSchedRekeyCheck()
 
 	for {
 
@@ -786,6 +898,7 @@ func (a *MergeScheduler) handleMainLoop() {
 		case <-stopCh:
 			// stop the loop
 			heartbeat.Stop()
+			rekeyCheckTicker.Stop()
 			a.stopRecv <- struct{}{}
 			return
 		case <-nextReadyAt:
@@ -795,6 +908,8 @@ func (a *MergeScheduler) handleMainLoop() {
 		// continue the loop
 		case <-vacuumCheckTicker.Chan():
 			a.fallbackSchedVacuumCheck()
+		case <-rekeyCheckTicker.Chan():
+			a.fallbackSchedRekeyCheck()
 		case msg := <-a.msgChan:
 			a.dispatchMsg(msg)
 			drained := false
@@ -442,7 +442,8 @@ func (zm ZM) getValue(buf []byte) any {
 	case types.T_Blockid:
 		return types.DecodeFixed[types.Rowid](buf)
 	case types.T_char, types.T_varchar, types.T_json,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_datalink:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_datalink,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		return buf
 	case types.T_array_float32:
 		// Used by MO_TABLE_COL_MAX and ZoneMap.String()
@@ -1007,7 +1008,8 @@ func (zm ZM) SubVecIn(vec *vector.Vector) (int, int) {
 		})
 		return lowerBound, upperBound
 
-	case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_datalink:
+	case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_datalink,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		col, area := vector.MustVarlenaRawData(vec)
 		minVal, maxVal := zm.GetMinBuf(), zm.GetMaxBuf()
 		lowerBound := sort.Search(len(col), func(i int) bool {
@@ -1260,7 +1262,8 @@ func (zm ZM) AnyIn(vec *vector.Vector) bool {
 
 		return lowerBound < len(col) && col[lowerBound].LE(&maxVal)
 
-	case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_datalink:
+	case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_datalink,
+		types.T_array_float16, types.T_array_int8, types.T_array_bit:
 		col, area := vector.MustVarlenaRawData(vec)
 		minVal, maxVal := zm.GetMinBuf(), zm.GetMaxBuf()
 		lowerBound := sort.Search(len(col), func(i int) bool {
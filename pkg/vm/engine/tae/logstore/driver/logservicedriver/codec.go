@@ -15,6 +15,7 @@
 package logservicedriver
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/objectio"
@@ -24,6 +25,8 @@ import (
 const (
 	IOET_WALRecord_V1 uint16 = 1
 	IOET_WALRecord_V2 uint16 = 2
+	// IOET_WALRecord_V3 is the encrypted V2 log entry, see EncryptLogEntry
+	IOET_WALRecord_V3 uint16 = 3
 	IOET_WALRecord    uint16 = 1000
 
 	IOET_WALRecord_CurrVer = IOET_WALRecord_V2
@@ -77,6 +80,11 @@ func DecodeLogEntry(b []byte, entryHandle func(en *entry.Entry)) (e LogEntry, er
 		}
 
 		return
+	case IOET_WALRecord_V3:
+		if b, err = decryptLogEntry(context.Background(), b); err != nil {
+			return
+		}
+		return DecodeLogEntry(b, entryHandle)
 	default:
 		panic(fmt.Sprintf("unsupported version %d", header.Version))
 	}
@@ -0,0 +1,125 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservicedriver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/encryption"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+)
+
+// An encrypted log entry is a V2 log entry sealed by a data key:
+//
+// IOEntryHeader(IOET_WALRecord, IOET_WALRecord_V3) | Envelope | Sealed V2 LogEntry
+//
+// The data key is shared by the log entries written by the process in an
+// epoch. A new one is generated when the epoch ends, the data key has sealed
+// too many log entries, or the master key is rotated.
+
+const (
+	// walDataKeyEpoch is how long a data key is shared by the log entries
+	walDataKeyEpoch = time.Hour
+	// walDataKeyMaxSeals is the max number of log entries sealed by a data key,
+	// well below the 2^32 seals a key with random AES-GCM nonces is limited to
+	walDataKeyMaxSeals = 1 << 28
+)
+
+var walDataKey struct {
+	sync.Mutex
+	provider encryption.KeyProvider
+	keyID    string
+	expireAt time.Time
+	seals    int
+	key      []byte
+	envelope []byte
+}
+
+func getWALDataKey(ctx context.Context) (key []byte, envelope []byte, err error) {
+	provider := encryption.GetKeyProvider()
+	keyID := provider.CurrentKeyID()
+	now := time.Now()
+
+	walDataKey.Lock()
+	defer walDataKey.Unlock()
+	if walDataKey.key == nil ||
+		walDataKey.provider != provider ||
+		walDataKey.keyID != keyID ||
+		!now.Before(walDataKey.expireAt) ||
+		walDataKey.seals >= walDataKeyMaxSeals {
+		var e encryption.Envelope
+		if key, e, err = encryption.NewDataKey(ctx, provider); err != nil {
+			return
+		}
+		walDataKey.provider = provider
+		walDataKey.keyID = e.KeyID
+		walDataKey.expireAt = now.Add(walDataKeyEpoch)
+		walDataKey.seals = 0
+		walDataKey.key = key
+		walDataKey.envelope = e.Marshal()
+	}
+	walDataKey.seals++
+	return walDataKey.key, walDataKey.envelope, nil
+}
+
+// EncryptLogEntry returns the encrypted log entry if the encryption is enabled,
+// otherwise returns e itself.
+func EncryptLogEntry(ctx context.Context, e LogEntry) (LogEntry, error) {
+	if !encryption.Enabled() {
+		return e, nil
+	}
+	key, envelope, err := getWALDataKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := encryption.Seal(key, e)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, objectio.IOEntryHeaderSize+len(envelope)+len(sealed))
+	buf = append(buf, objectio.EncodeIOEntryHeader(&objectio.IOEntryHeader{
+		Type:    IOET_WALRecord,
+		Version: IOET_WALRecord_V3,
+	})...)
+	buf = append(buf, envelope...)
+	buf = append(buf, sealed...)
+	return buf, nil
+}
+
+func decryptLogEntry(ctx context.Context, b []byte) ([]byte, error) {
+	var envelope encryption.Envelope
+	n, err := envelope.Unmarshal(b[objectio.IOEntryHeaderSize:])
+	if err != nil {
+		return nil, err
+	}
+	key, err := encryption.UnwrapDataKey(ctx, encryption.GetKeyProvider(), envelope)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := encryption.Open(key, b[objectio.IOEntryHeaderSize+n:])
+	if err != nil {
+		return nil, err
+	}
+	if len(plain) < objectio.IOEntryHeaderSize {
+		return nil, moerr.NewInternalErrorNoCtxf("invalid decrypted log entry length %d", len(plain))
+	}
+	if header := objectio.DecodeIOEntryHeader(plain); header.Version == IOET_WALRecord_V3 {
+		return nil, moerr.NewInternalErrorNoCtx("nested encrypted log entry")
+	}
+	return plain, nil
+}
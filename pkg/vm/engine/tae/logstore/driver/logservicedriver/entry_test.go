@@ -15,10 +15,15 @@
 package logservicedriver
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/encryption"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/entry"
 	"github.com/stretchr/testify/assert"
 )
@@ -160,3 +165,81 @@ func TestCompatibility2(t *testing.T) {
 	assert.Equal(t, []uint64{1, 2, 3}, skipCmd.GetDSNSlice())
 	assert.Equal(t, []uint64{2, 3, 4}, skipCmd.GetPSNSlice())
 }
+
+func TestEncryptLogEntry(t *testing.T) {
+	ctx := context.Background()
+	writer := NewLogEntryWriter()
+	defer writer.Close()
+	var entries [][]byte
+	for i := 0; i < 10; i++ {
+		entries = append(entries, []byte(fmt.Sprintf("secret entry %d", i)))
+		writer.Append(entries[i])
+	}
+	writer.SetStartDSN(100)
+	e, err := writer.Finish()
+	assert.NoError(t, err)
+
+	// encryption disabled
+	record, err := EncryptLogEntry(ctx, e)
+	assert.NoError(t, err)
+	assert.Equal(t, e, record)
+
+	kms := encryption.NewMockKMS()
+	assert.NoError(t, kms.CreateKey("k1"))
+	assert.NoError(t, kms.CreateKey("k2"))
+	provider := encryption.NewKMSProvider(kms, "k1")
+	encryption.SetKeyProvider(provider, true)
+	defer encryption.SetKeyProvider(nil, false)
+
+	check := func(keyID string) {
+		record, err := EncryptLogEntry(ctx, e)
+		assert.NoError(t, err)
+		header := objectio.DecodeIOEntryHeader(record[:objectio.IOEntryHeaderSize])
+		assert.Equal(t, IOET_WALRecord, header.Type)
+		assert.Equal(t, IOET_WALRecord_V3, header.Version)
+		var envelope encryption.Envelope
+		_, err = envelope.Unmarshal(record[objectio.IOEntryHeaderSize:])
+		assert.NoError(t, err)
+		assert.Equal(t, keyID, envelope.KeyID)
+		assert.False(t, bytes.Contains(record, []byte("secret entry")))
+
+		decoded, err := DecodeLogEntry(record, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, IOET_WALRecord_V2, decoded.GetVersion())
+		assert.Equal(t, uint64(100), decoded.GetStartDSN())
+		assert.Equal(t, uint32(10), decoded.GetEntryCount())
+		for i := range entries {
+			assert.Equal(t, entries[i], decoded.GetEntry(i))
+		}
+	}
+	check("k1")
+	numEncrypt := kms.NumEncrypt.Load()
+	// the data key is reused in the epoch until the master key is rotated
+	check("k1")
+	assert.Equal(t, numEncrypt, kms.NumEncrypt.Load())
+	provider.SetCurrentKeyID("k2")
+	check("k2")
+	assert.Equal(t, numEncrypt+1, kms.NumEncrypt.Load())
+
+	// a new data key after the epoch or too many log entries
+	walDataKey.Lock()
+	walDataKey.expireAt = time.Now()
+	walDataKey.Unlock()
+	check("k2")
+	assert.Equal(t, numEncrypt+2, kms.NumEncrypt.Load())
+	walDataKey.Lock()
+	walDataKey.seals = walDataKeyMaxSeals
+	walDataKey.Unlock()
+	check("k2")
+	assert.Equal(t, numEncrypt+3, kms.NumEncrypt.Load())
+	check("k2")
+	assert.Equal(t, numEncrypt+3, kms.NumEncrypt.Load())
+
+	// the skip cmd is encrypted too
+	skip, err := EncryptLogEntry(ctx, SkipMapToLogEntry(map[uint64]uint64{1: 2}))
+	assert.NoError(t, err)
+	decoded, err := DecodeLogEntry(skip, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, Cmd_SkipDSN, CmdType(decoded.GetCmdType()))
+	assert.Equal(t, []uint64{1}, SkipCmd(decoded.GetEntry(0)).GetDSNSlice())
+}
@@ -111,8 +111,14 @@ func (a *groupCommitter) Commit() error {
 	)
 	defer timeoutSpan.End()
 
+	// e is kept in plaintext for the slow log
+	var record LogEntry
+	if record, err = EncryptLogEntry(ctx, e); err != nil {
+		return err
+	}
+
 	a.psn, err = a.client.Append(
-		ctx, e, moerr.CauseDriverAppender1,
+		ctx, record, moerr.CauseDriverAppender1,
 	)
 
 	return err
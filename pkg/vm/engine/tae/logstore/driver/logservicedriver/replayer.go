@@ -897,7 +897,10 @@ func (r *replayer) AppendSkipCmd(
 	}
 	defer client.Putback()
 
-	entry := SkipMapToLogEntry(skipMap)
+	var entry LogEntry
+	if entry, err = EncryptLogEntry(ctx, SkipMapToLogEntry(skipMap)); err != nil {
+		return
+	}
 
 	_, err = client.Append(
 		ctx, entry, moerr.CauseAppendSkipCmd,
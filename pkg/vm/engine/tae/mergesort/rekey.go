@@ -0,0 +1,75 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"context"
+	"iter"
+
+	"github.com/matrixorigin/matrixone/pkg/encryption"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+)
+
+// The master key is rotated by rewriting the objects on merge. The merged
+// objects are written with new data keys wrapped by the current master key,
+// so the old master key can be retired once no object needs rekey. The merge
+// scheduler checks the tables after start and after the master key is rotated,
+// and rewrites the objects need rekey by single object merge tasks until no
+// object needs rekey.
+
+// NeedRekey reports whether the object is not encrypted by the current master
+// key and should be rewritten by merge.
+func NeedRekey(
+	ctx context.Context,
+	fs fileservice.FileService,
+	stats *objectio.ObjectStats,
+) (bool, error) {
+	if !encryption.Enabled() {
+		return false, nil
+	}
+	envelope, encrypted, err := objectio.ReadObjectEnvelope(ctx, stats.ObjectName().String(), fs)
+	if err != nil {
+		return false, err
+	}
+	return !encrypted || envelope.KeyID != encryption.GetKeyProvider().CurrentKeyID(), nil
+}
+
+// FilterRekeyObjects returns the objects need rekey, each of them can be
+// rewritten by a single object merge task.
+func FilterRekeyObjects(
+	ctx context.Context,
+	fs fileservice.FileService,
+	objs iter.Seq[*objectio.ObjectStats],
+) ([]*objectio.ObjectStats, error) {
+	var ret []*objectio.ObjectStats
+	for obj := range objs {
+		need, err := NeedRekey(ctx, fs, obj)
+		if err != nil {
+			return nil, err
+		}
+		if need {
+			ret = append(ret, obj)
+		}
+	}
+	return ret, nil
+}
+
+func currentKeyID() string {
+	if !encryption.Enabled() {
+		return ""
+	}
+	return encryption.GetKeyProvider().CurrentKeyID()
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergesort
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encryption"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/objectio/ioutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNeedRekey(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	fs, err := fileservice.NewMemoryFS("memory", fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vector.NewVec(types.T_int32.ToType())
+	require.NoError(t, vector.AppendFixedList(bat.Vecs[0], []int32{1, 2, 3}, nil, mp))
+	bat.SetRowCount(3)
+	defer bat.Clean(mp)
+
+	writeObject := func() *objectio.ObjectStats {
+		name := objectio.BuildObjectName(objectio.NewSegmentid(), 0)
+		writer, err := ioutil.NewBlockWriterNew(fs, name, 0, nil, false)
+		require.NoError(t, err)
+		_, err = writer.WriteBatch(bat)
+		require.NoError(t, err)
+		_, _, err = writer.Sync(ctx)
+		require.NoError(t, err)
+		stats := writer.GetObjectStats()
+		return &stats
+	}
+
+	plain := writeObject()
+	// encryption disabled
+	need, err := NeedRekey(ctx, fs, plain)
+	require.NoError(t, err)
+	require.False(t, need)
+
+	kms := encryption.NewMockKMS()
+	require.NoError(t, kms.CreateKey("k1"))
+	require.NoError(t, kms.CreateKey("k2"))
+	provider := encryption.NewKMSProvider(kms, "k1")
+	encryption.SetKeyProvider(provider, true)
+	defer encryption.SetKeyProvider(nil, false)
+
+	encrypted1 := writeObject()
+	need, err = NeedRekey(ctx, fs, plain)
+	require.NoError(t, err)
+	require.True(t, need)
+	need, err = NeedRekey(ctx, fs, encrypted1)
+	require.NoError(t, err)
+	require.False(t, need)
+	require.Equal(t, "k1", currentKeyID())
+
+	provider.SetCurrentKeyID("k2")
+	encrypted2 := writeObject()
+	objs, err := FilterRekeyObjects(ctx, fs, slices.Values([]*objectio.ObjectStats{plain, encrypted1, encrypted2}))
+	require.NoError(t, err)
+	require.Equal(t, []*objectio.ObjectStats{plain, encrypted1}, objs)
+}
@@ -526,6 +526,7 @@ func logMergeEnd(name string, start time.Time, objs [][]byte) {
 		zap.String("task", name),
 		common.AnyField("to-objs", toObjsDesc),
 		common.AnyField("to-size", units.BytesSize(toSize)),
+		zap.String("key-id", currentKeyID()),
 		common.DurationField(time.Since(start)),
 	)
 }
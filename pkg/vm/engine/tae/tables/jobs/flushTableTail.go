@@ -721,6 +721,9 @@ func (task *flushTableTailTask) mergeAObjs(ctx context.Context, isTombstone bool
 	if !isTombstone && schema.HasFakePK() {
 		writer.SetFakePK(uint16(schema.GetPrimaryKey().Idx))
 	}
+	if !isTombstone {
+		writer.SetColumnCompresses(schema.Extra.GetColumnCompress())
+	}
 	for _, bat := range writtenBatches {
 		_, err = writer.WriteBatch(bat)
 		if err != nil {
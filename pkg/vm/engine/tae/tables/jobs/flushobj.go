@@ -142,6 +142,7 @@ func (task *flushObjTask) Execute(ctx context.Context) (err error) {
 		if task.meta.GetSchema().HasFakePK() {
 			writer.SetFakePK(uint16(task.meta.GetSchema().GetPrimaryKey().Idx))
 		}
+		writer.SetColumnCompresses(task.meta.GetSchema().Extra.GetColumnCompress())
 	}
 
 	dataRows := cnBatch.RowCount()
@@ -403,6 +403,11 @@ func (task *mergeObjectsTask) PrepareNewWriter() *ioutil.BlockWriter {
 	if !task.isTombstone && task.schema.HasFakePK() {
 		writer.SetFakePK(uint16(task.schema.GetPrimaryKey().Idx))
 	}
+	if !task.isTombstone {
+		// merged objects are always written with the current codecs of the
+		// schema, which recompresses inputs written with other codecs
+		writer.SetColumnCompresses(task.schema.Extra.GetColumnCompress())
+	}
 	task.num++
 	return writer
 }
@@ -202,12 +202,15 @@ func PlanColsToExeCols(planCols []*plan.ColDef) []TableDef {
 			alg = compress.None
 		case plan.CompressType_Lz4:
 			alg = compress.Lz4
+		case plan.CompressType_Zstd:
+			alg = compress.Zstd
 		}
 		colTyp := col.GetTyp()
 		exeCols[i] = &AttributeDef{
 			Attr: Attribute{
 				Name:          col.GetOriginCaseName(),
 				Alg:           alg,
+				AlgLevel:      col.AlgLevel,
 				Type:          types.New(types.T(colTyp.GetId()), colTyp.GetWidth(), colTyp.GetScale()),
 				Default:       planCols[i].GetDefault(),
 				OnUpdate:      planCols[i].GetOnUpdate(),
@@ -237,6 +240,8 @@ type Attribute struct {
 	Name string
 	// Alg compression algorithm
 	Alg compress.T
+	// AlgLevel compression level of Alg, 0 means the codec default
+	AlgLevel int32
 	// Type attribute's type
 	Type types.Type
 	// DefaultExpr default value of this attribute
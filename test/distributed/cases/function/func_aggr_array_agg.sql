@@ -0,0 +1,15 @@
+drop table if exists array_agg_t;
+create table array_agg_t(g int, v int, s varchar(10));
+insert into array_agg_t values (1, 1, 'a'), (1, null, 'b'), (1, 3, null), (2, null, null), (2, null, null), (3, 5, 'e');
+
+#GROUP BY, the result is a json array, NULLs are kept as json null
+select g, array_agg(v) as vs, array_agg(s) as ss from array_agg_t group by g order by g;
+select array_agg(g) as gs from array_agg_t where v is not null;
+
+#EMPTY, NULL for no rows, no group for an empty GROUP BY
+select array_agg(v) as vs from array_agg_t where g = 4;
+select g, array_agg(v) as vs from array_agg_t where g = 4 group by g;
+
+#ERROR
+select array_agg(v, s) from array_agg_t;
+drop table array_agg_t;